@@ -2,12 +2,17 @@ package main
 
 import (
 	"context"
+	"flag"
 	"log"
 
+	"backend/internal/platform/seeder"
 	"backend/internal/server"
 )
 
 func main() {
+	seed := flag.String("seed", "", "run the prod or demo seeders before serving")
+	flag.Parse()
+
 	// Create context for the application
 	ctx := context.Background()
 
@@ -16,10 +21,29 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to initialize app: %v", err)
 	}
-	defer cleanup()
 
-	// Run the application
-	if err := app.Run(); err != nil {
+	// Seed before serving so the first requests see the seeded data
+	if *seed != "" {
+		if err := seedApp(ctx, app, *seed); err != nil {
+			cleanup()
+			log.Fatalf("Failed to seed: %v", err)
+		}
+	}
+
+	// Run the application; cleanup (e.g. closing the database pool) must run
+	// after shutdown has drained, and before log.Fatalf would skip deferred calls
+	err = app.Run()
+	cleanup()
+	if err != nil {
 		log.Fatalf("Failed to run app: %v", err)
 	}
 }
+
+// seedApp runs the seeders of the named profile
+func seedApp(ctx context.Context, app *server.App, name string) error {
+	profile, err := seeder.ParseProfile(name)
+	if err != nil {
+		return err
+	}
+	return app.Seed(ctx, profile)
+}
@@ -0,0 +1,180 @@
+// Command loadtest drives k6 or vegeta through the scenarios under
+// loadtest/scenarios and reports how the run compares with the scenarios'
+// budgets and with an earlier run.
+//
+//	go run ./cmd/loadtest run -url=http://localhost:8080 -tool=vegeta
+//	go run ./cmd/loadtest run -baseline=loadtest/results/baseline.json public-reads
+//	go run ./cmd/loadtest report -baseline=old.json new.json
+//
+// Scenarios with authenticated requests send the token in LOADTEST_TOKEN.
+// The command exits with status 1 when any scenario breaks its budget.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"backend/internal/loadtest"
+)
+
+const usage = `usage:
+  loadtest run [flags] [scenario ...]   run scenarios and report
+  loadtest report [flags] results.json  report on saved results`
+
+// errBudget marks a run that completed but broke a budget
+var errBudget = errors.New("performance budget exceeded")
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	var err error
+	switch os.Args[1] {
+	case "run":
+		err = runCommand(ctx, os.Args[2:])
+	case "report":
+		err = reportCommand(os.Args[2:])
+	default:
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	if errors.Is(err, errBudget) {
+		log.Print(err)
+		os.Exit(1)
+	}
+	if err != nil {
+		log.Fatalf("loadtest: %v", err)
+	}
+}
+
+// runCommand runs the scenarios, saves their results and reports on them
+func runCommand(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("run", flag.ExitOnError)
+	tool := fs.String("tool", "vegeta", "load generator to drive: "+strings.Join(loadtest.Tools, " or "))
+	baseURL := fs.String("url", "http://localhost:8080", "base URL of the server under test")
+	dir := fs.String("scenarios", "loadtest/scenarios", "directory of scenario files")
+	out := fs.String("out", "", "file to save results to (default loadtest/results/<time>.json)")
+	baseline := fs.String("baseline", "", "results of an earlier run to compare with")
+	tolerance := fs.Float64("tolerance", loadtest.DefaultTolerance, "slowdown against the baseline reported as a regression")
+	_ = fs.Parse(args)
+
+	runner, err := loadtest.NewRunner(*tool)
+	if err != nil {
+		return err
+	}
+
+	scenarios, err := selectScenarios(*dir, fs.Args())
+	if err != nil {
+		return err
+	}
+
+	token := os.Getenv("LOADTEST_TOKEN")
+	results := make([]*loadtest.Result, 0, len(scenarios))
+	for _, s := range scenarios {
+		if s.NeedsAuth() && token == "" {
+			return fmt.Errorf("scenario %s sends authenticated requests; set LOADTEST_TOKEN", s.Name)
+		}
+
+		log.Printf("Running %s at %d/s for %s with %s", s.Name, s.Rate, time.Duration(s.Duration), *tool)
+		result, err := runner.Run(ctx, s, *baseURL, token)
+		if err != nil {
+			return fmt.Errorf("scenario %s: %w", s.Name, err)
+		}
+		results = append(results, result)
+	}
+
+	path := *out
+	if path == "" {
+		path = filepath.Join("loadtest", "results", time.Now().UTC().Format("20060102T150405Z")+".json")
+	}
+	if err := loadtest.SaveResults(path, results); err != nil {
+		return err
+	}
+	log.Printf("Saved results to %s", path)
+
+	return report(scenarios, results, *baseline, *tolerance)
+}
+
+// reportCommand reports on results saved by an earlier run
+func reportCommand(args []string) error {
+	fs := flag.NewFlagSet("report", flag.ExitOnError)
+	dir := fs.String("scenarios", "loadtest/scenarios", "directory of scenario files holding the budgets")
+	baseline := fs.String("baseline", "", "results of an earlier run to compare with")
+	tolerance := fs.Float64("tolerance", loadtest.DefaultTolerance, "slowdown against the baseline reported as a regression")
+	_ = fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		return fmt.Errorf("report takes one results file")
+	}
+
+	scenarios, err := loadtest.LoadScenarios(*dir)
+	if err != nil {
+		return err
+	}
+	results, err := loadtest.LoadResults(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	return report(scenarios, results, *baseline, *tolerance)
+}
+
+// report prints the Markdown report and returns errBudget if a budget was broken
+func report(scenarios []*loadtest.Scenario, results []*loadtest.Result, baselinePath string, tolerance float64) error {
+	var baseline []*loadtest.Result
+	if baselinePath != "" {
+		var err error
+		if baseline, err = loadtest.LoadResults(baselinePath); err != nil {
+			return err
+		}
+	}
+
+	r := loadtest.Compare(scenarios, results, baseline, tolerance)
+	if err := r.WriteMarkdown(os.Stdout); err != nil {
+		return err
+	}
+	if r.Failed() {
+		return errBudget
+	}
+	return nil
+}
+
+// selectScenarios loads the scenarios in dir, keeping only the named ones if any are
+func selectScenarios(dir string, names []string) ([]*loadtest.Scenario, error) {
+	all, err := loadtest.LoadScenarios(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return all, nil
+	}
+
+	byName := make(map[string]*loadtest.Scenario, len(all))
+	for _, s := range all {
+		byName[s.Name] = s
+	}
+
+	selected := make([]*loadtest.Scenario, 0, len(names))
+	for _, name := range names {
+		s, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("no scenario named %s in %s", name, dir)
+		}
+		selected = append(selected, s)
+	}
+	return selected, nil
+}
@@ -0,0 +1,58 @@
+// Command seed runs data seeders against the configured database and exits.
+//
+// With no arguments it runs the seeders of -profile; otherwise it runs the
+// named seeders, even run-once seeders that already ran:
+//
+//	go run ./cmd/seed -profile=demo
+//	go run ./cmd/seed SettingsSeeder DemoPostsSeeder
+//	go run ./cmd/seed -list
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+
+	"backend/internal/platform/seeder"
+	"backend/internal/server"
+)
+
+func main() {
+	profileName := flag.String("profile", string(seeder.ProfileProd), "seeders to run when none are named: prod or demo")
+	list := flag.Bool("list", false, "list the registered seeders in run order and exit")
+	flag.Parse()
+
+	ctx := context.Background()
+
+	registry, cleanup, err := server.InitializeSeeders(ctx)
+	if err != nil {
+		log.Fatalf("Failed to initialize seeders: %v", err)
+	}
+
+	err = run(ctx, registry, *profileName, *list, flag.Args())
+	cleanup()
+	if err != nil {
+		log.Fatalf("Failed to seed: %v", err)
+	}
+}
+
+// run lists the seeders, runs the named ones, or runs a profile
+func run(ctx context.Context, registry *seeder.Registry, profileName string, list bool, names []string) error {
+	if list {
+		for _, name := range registry.Names() {
+			fmt.Println(name)
+		}
+		return nil
+	}
+
+	if len(names) > 0 {
+		return registry.RunNamed(ctx, names)
+	}
+
+	profile, err := seeder.ParseProfile(profileName)
+	if err != nil {
+		return err
+	}
+	return registry.Run(ctx, profile)
+}
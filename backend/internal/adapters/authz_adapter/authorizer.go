@@ -3,8 +3,24 @@ package authz_adapter
 import (
 	"context"
 
+	apiclientsPorts "backend/internal/apiclients/ports"
 	authzApp "backend/internal/authz/application"
+	blogsPorts "backend/internal/blogs/ports"
+	bookmarksPorts "backend/internal/bookmarks/ports"
+	exportPorts "backend/internal/export/ports"
+	followsPorts "backend/internal/follows/ports"
+	impersonationPorts "backend/internal/impersonation/ports"
+	integrityPorts "backend/internal/integrity/ports"
+	linkreportsPorts "backend/internal/linkreports/ports"
+	organizationsPorts "backend/internal/organizations/ports"
 	postsPorts "backend/internal/posts/ports"
+	privacyPorts "backend/internal/privacy/ports"
+	quotasPorts "backend/internal/quotas/ports"
+	reactionsPorts "backend/internal/reactions/ports"
+	reportsPorts "backend/internal/reports/ports"
+	retentionPorts "backend/internal/retention/ports"
+	seriesPorts "backend/internal/series/ports"
+	settingsPorts "backend/internal/settings/ports"
 	themesPorts "backend/internal/themes/ports"
 	"github.com/google/uuid"
 )
@@ -27,6 +43,20 @@ func NewAuthzAdapter(authzService *authzApp.AuthzService) *AuthzAdapter {
 // This method satisfies multiple interfaces:
 // - themes/ports.Authorizer
 // - posts/ports.Authorizer
+// - series/ports.Authorizer
+// - reactions/ports.Authorizer
+// - reports/ports.Authorizer
+// - bookmarks/ports.Authorizer
+// - follows/ports.Authorizer
+// - export/ports.Authorizer
+// - blogs/ports.Authorizer
+// - settings/ports.Authorizer
+// - retention/ports.Authorizer
+// - linkreports/ports.Authorizer
+// - apiclients/ports.Authorizer
+// - quotas/ports.Authorizer
+// - impersonation/ports.Authorizer
+// - organizations/ports.Authorizer
 // - any other module's Authorizer interface
 func (a *AuthzAdapter) Can(ctx context.Context, userID uuid.UUID, resource string, action string, resourceID *uuid.UUID) (bool, error) {
 	return a.authzService.Can(ctx, userID, resource, action, resourceID)
@@ -34,6 +64,22 @@ func (a *AuthzAdapter) Can(ctx context.Context, userID uuid.UUID, resource strin
 
 // Compile-time checks to ensure we implement the interfaces
 var (
-	_ postsPorts.Authorizer  = (*AuthzAdapter)(nil)
-	_ themesPorts.Authorizer = (*AuthzAdapter)(nil)
+	_ postsPorts.Authorizer         = (*AuthzAdapter)(nil)
+	_ themesPorts.Authorizer        = (*AuthzAdapter)(nil)
+	_ seriesPorts.Authorizer        = (*AuthzAdapter)(nil)
+	_ reactionsPorts.Authorizer     = (*AuthzAdapter)(nil)
+	_ reportsPorts.Authorizer       = (*AuthzAdapter)(nil)
+	_ bookmarksPorts.Authorizer     = (*AuthzAdapter)(nil)
+	_ followsPorts.Authorizer       = (*AuthzAdapter)(nil)
+	_ exportPorts.Authorizer        = (*AuthzAdapter)(nil)
+	_ blogsPorts.Authorizer         = (*AuthzAdapter)(nil)
+	_ integrityPorts.Authorizer     = (*AuthzAdapter)(nil)
+	_ settingsPorts.Authorizer      = (*AuthzAdapter)(nil)
+	_ retentionPorts.Authorizer     = (*AuthzAdapter)(nil)
+	_ linkreportsPorts.Authorizer   = (*AuthzAdapter)(nil)
+	_ apiclientsPorts.Authorizer    = (*AuthzAdapter)(nil)
+	_ quotasPorts.Authorizer        = (*AuthzAdapter)(nil)
+	_ impersonationPorts.Authorizer = (*AuthzAdapter)(nil)
+	_ organizationsPorts.Authorizer = (*AuthzAdapter)(nil)
+	_ privacyPorts.Authorizer       = (*AuthzAdapter)(nil)
 )
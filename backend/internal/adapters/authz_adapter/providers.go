@@ -1,7 +1,29 @@
 package authz_adapter
 
 import (
+	analyticsPorts "backend/internal/analytics/ports"
+	announcementsPorts "backend/internal/announcements/ports"
+	apiclientsPorts "backend/internal/apiclients/ports"
+	blogsPorts "backend/internal/blogs/ports"
+	bookmarksPorts "backend/internal/bookmarks/ports"
+	exportPorts "backend/internal/export/ports"
+	feedbackPorts "backend/internal/feedback/ports"
+	followsPorts "backend/internal/follows/ports"
+	impersonationPorts "backend/internal/impersonation/ports"
+	integrityPorts "backend/internal/integrity/ports"
+	linkreportsPorts "backend/internal/linkreports/ports"
+	mentionsPorts "backend/internal/mentions/ports"
+	organizationsPorts "backend/internal/organizations/ports"
 	postsPorts "backend/internal/posts/ports"
+	privacyPorts "backend/internal/privacy/ports"
+	quotasPorts "backend/internal/quotas/ports"
+	reactionsPorts "backend/internal/reactions/ports"
+	reportsPorts "backend/internal/reports/ports"
+	retentionPorts "backend/internal/retention/ports"
+	searchPorts "backend/internal/search/ports"
+	seriesPorts "backend/internal/series/ports"
+	serviceaccountsPorts "backend/internal/serviceaccounts/ports"
+	settingsPorts "backend/internal/settings/ports"
 	themesPorts "backend/internal/themes/ports"
 	"github.com/google/wire"
 )
@@ -9,7 +31,29 @@ import (
 // ProviderSet is the wire provider set for the authorization adapter
 var ProviderSet = wire.NewSet(
 	NewAuthzAdapter,
-	// Bind the AuthzAdapter to both ports interfaces
+	// Bind the AuthzAdapter to each module's ports interface
 	wire.Bind(new(postsPorts.Authorizer), new(*AuthzAdapter)),
 	wire.Bind(new(themesPorts.Authorizer), new(*AuthzAdapter)),
+	wire.Bind(new(seriesPorts.Authorizer), new(*AuthzAdapter)),
+	wire.Bind(new(reactionsPorts.Authorizer), new(*AuthzAdapter)),
+	wire.Bind(new(reportsPorts.Authorizer), new(*AuthzAdapter)),
+	wire.Bind(new(bookmarksPorts.Authorizer), new(*AuthzAdapter)),
+	wire.Bind(new(followsPorts.Authorizer), new(*AuthzAdapter)),
+	wire.Bind(new(exportPorts.Authorizer), new(*AuthzAdapter)),
+	wire.Bind(new(blogsPorts.Authorizer), new(*AuthzAdapter)),
+	wire.Bind(new(announcementsPorts.Authorizer), new(*AuthzAdapter)),
+	wire.Bind(new(feedbackPorts.Authorizer), new(*AuthzAdapter)),
+	wire.Bind(new(mentionsPorts.Authorizer), new(*AuthzAdapter)),
+	wire.Bind(new(analyticsPorts.Authorizer), new(*AuthzAdapter)),
+	wire.Bind(new(integrityPorts.Authorizer), new(*AuthzAdapter)),
+	wire.Bind(new(retentionPorts.Authorizer), new(*AuthzAdapter)),
+	wire.Bind(new(linkreportsPorts.Authorizer), new(*AuthzAdapter)),
+	wire.Bind(new(settingsPorts.Authorizer), new(*AuthzAdapter)),
+	wire.Bind(new(apiclientsPorts.Authorizer), new(*AuthzAdapter)),
+	wire.Bind(new(quotasPorts.Authorizer), new(*AuthzAdapter)),
+	wire.Bind(new(impersonationPorts.Authorizer), new(*AuthzAdapter)),
+	wire.Bind(new(organizationsPorts.Authorizer), new(*AuthzAdapter)),
+	wire.Bind(new(serviceaccountsPorts.Authorizer), new(*AuthzAdapter)),
+	wire.Bind(new(privacyPorts.Authorizer), new(*AuthzAdapter)),
+	wire.Bind(new(searchPorts.Authorizer), new(*AuthzAdapter)),
 )
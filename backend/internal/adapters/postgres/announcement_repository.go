@@ -0,0 +1,223 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"backend/internal/announcements/domain"
+	"backend/internal/announcements/ports"
+	"backend/internal/platform/postgres"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// AnnouncementRepository implements the announcements.AnnouncementRepository interface using PostgreSQL
+// Announcements are read and written only within the request's blog
+type AnnouncementRepository struct {
+	postgres.BaseRepository // Embed the base repository for common functionality
+}
+
+// NewAnnouncementRepository creates a new PostgreSQL announcements repository
+func NewAnnouncementRepository(db *pgxpool.Pool) *AnnouncementRepository {
+	return &AnnouncementRepository{
+		BaseRepository: postgres.NewBaseRepository(db),
+	}
+}
+
+// WithTx returns a new repository instance that uses the provided transaction
+func (r *AnnouncementRepository) WithTx(tx pgx.Tx) *AnnouncementRepository {
+	return &AnnouncementRepository{
+		BaseRepository: r.BaseRepository.WithTx(tx),
+	}
+}
+
+// Create inserts a new announcement into the current blog
+func (r *AnnouncementRepository) Create(ctx context.Context, announcement *domain.Announcement) error {
+	query, args, err := r.SB.
+		Insert("announcements").
+		Columns("id", "blog_id", "kind", "title", "message", "starts_at", "ends_at", "created_by", "created_at", "updated_at").
+		Values(
+			pgtype.UUID{Bytes: announcement.ID, Valid: true},
+			currentBlogID(ctx),
+			string(announcement.Kind),
+			announcement.Title,
+			announcement.Message,
+			pgtype.Timestamptz{Time: announcement.StartsAt, Valid: true},
+			pgtype.Timestamptz{Time: announcement.EndsAt, Valid: true},
+			toNullableUUID(announcement.CreatedBy),
+			pgtype.Timestamptz{Time: announcement.CreatedAt, Valid: true},
+			pgtype.Timestamptz{Time: announcement.UpdatedAt, Valid: true},
+		).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("AnnouncementRepository.Create: build query: %w", err)
+	}
+
+	if _, err := r.DB.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("AnnouncementRepository.Create: %w", err)
+	}
+
+	return nil
+}
+
+// Update saves changes to an announcement of the current blog
+func (r *AnnouncementRepository) Update(ctx context.Context, announcement *domain.Announcement) error {
+	query, args, err := r.SB.
+		Update("announcements").
+		Set("kind", string(announcement.Kind)).
+		Set("title", announcement.Title).
+		Set("message", announcement.Message).
+		Set("starts_at", pgtype.Timestamptz{Time: announcement.StartsAt, Valid: true}).
+		Set("ends_at", pgtype.Timestamptz{Time: announcement.EndsAt, Valid: true}).
+		Set("updated_at", pgtype.Timestamptz{Time: announcement.UpdatedAt, Valid: true}).
+		Where(sq.Eq{
+			"id":      pgtype.UUID{Bytes: announcement.ID, Valid: true},
+			"blog_id": currentBlogID(ctx),
+		}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("AnnouncementRepository.Update: build query: %w", err)
+	}
+
+	result, err := r.DB.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("AnnouncementRepository.Update: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ports.ErrAnnouncementNotFound
+	}
+
+	return nil
+}
+
+// Delete removes an announcement of the current blog
+func (r *AnnouncementRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query, args, err := r.SB.
+		Delete("announcements").
+		Where(sq.Eq{
+			"id":      pgtype.UUID{Bytes: id, Valid: true},
+			"blog_id": currentBlogID(ctx),
+		}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("AnnouncementRepository.Delete: build query: %w", err)
+	}
+
+	result, err := r.DB.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("AnnouncementRepository.Delete: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ports.ErrAnnouncementNotFound
+	}
+
+	return nil
+}
+
+// FindByID retrieves an announcement of the current blog
+func (r *AnnouncementRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Announcement, error) {
+	query, args, err := r.selectAnnouncements(ctx).
+		Where(sq.Eq{"id": pgtype.UUID{Bytes: id, Valid: true}}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("AnnouncementRepository.FindByID: build query: %w", err)
+	}
+
+	announcement, err := scanAnnouncement(r.DB.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrAnnouncementNotFound
+		}
+		return nil, fmt.Errorf("AnnouncementRepository.FindByID: %w", err)
+	}
+
+	return announcement, nil
+}
+
+// List returns every announcement of the current blog, latest start first
+func (r *AnnouncementRepository) List(ctx context.Context) ([]*domain.Announcement, error) {
+	return r.list(ctx, "AnnouncementRepository.List", r.selectAnnouncements(ctx).
+		OrderBy("starts_at DESC", "id ASC"))
+}
+
+// ListActive returns the announcements of the current blog shown at now, earliest start first
+func (r *AnnouncementRepository) ListActive(ctx context.Context, now time.Time) ([]*domain.Announcement, error) {
+	at := pgtype.Timestamptz{Time: now, Valid: true}
+	return r.list(ctx, "AnnouncementRepository.ListActive", r.selectAnnouncements(ctx).
+		Where(sq.LtOrEq{"starts_at": at}).
+		Where(sq.Gt{"ends_at": at}).
+		OrderBy("starts_at ASC", "id ASC"))
+}
+
+// Helper methods
+
+// selectAnnouncements builds the SELECT shared by all announcement queries, limited to the current blog
+func (r *AnnouncementRepository) selectAnnouncements(ctx context.Context) sq.SelectBuilder {
+	return r.SB.
+		Select("id", "kind", "title", "message", "starts_at", "ends_at", "created_by", "created_at", "updated_at").
+		From("announcements").
+		Where(sq.Eq{"blog_id": currentBlogID(ctx)})
+}
+
+// list runs a query returning many announcements
+func (r *AnnouncementRepository) list(ctx context.Context, op string, builder sq.SelectBuilder) ([]*domain.Announcement, error) {
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	rows, err := r.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var announcements []*domain.Announcement
+	for rows.Next() {
+		announcement, err := scanAnnouncement(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: scan: %w", op, err)
+		}
+		announcements = append(announcements, announcement)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: rows error: %w", op, err)
+	}
+
+	return announcements, nil
+}
+
+// scanAnnouncement scans a single row into a domain.Announcement
+func scanAnnouncement(row pgx.Row) (*domain.Announcement, error) {
+	var announcement domain.Announcement
+	var id, createdBy pgtype.UUID
+	var kind string
+	var startsAt, endsAt, createdAt, updatedAt pgtype.Timestamptz
+
+	if err := row.Scan(&id, &kind, &announcement.Title, &announcement.Message, &startsAt, &endsAt, &createdBy, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+
+	announcement.ID = uuid.UUID(id.Bytes)
+	announcement.Kind = domain.Kind(kind)
+	announcement.StartsAt = startsAt.Time
+	announcement.EndsAt = endsAt.Time
+	if createdBy.Valid {
+		creator := uuid.UUID(createdBy.Bytes)
+		announcement.CreatedBy = &creator
+	}
+	announcement.CreatedAt = createdAt.Time
+	announcement.UpdatedAt = updatedAt.Time
+	return &announcement, nil
+}
+
+// Compile-time check to ensure AnnouncementRepository implements ports.AnnouncementRepository
+var _ ports.AnnouncementRepository = (*AnnouncementRepository)(nil)
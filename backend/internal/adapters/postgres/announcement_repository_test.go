@@ -0,0 +1,50 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"backend/internal/adapters/postgres"
+	"backend/internal/announcements/domain"
+	"backend/internal/announcements/ports"
+	"backend/internal/platform/tenant"
+	"backend/internal/testing/factory"
+	"backend/internal/testing/pgtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnnouncementRepository_ListActive(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewAnnouncementRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	admin := factory.NewUser().Create(t, tx)
+	now := time.Now().Truncate(time.Second)
+	create := func(ctx context.Context, title string, startsAt, endsAt time.Time) *domain.Announcement {
+		announcement, err := domain.NewAnnouncement(domain.KindInfo, title, "Details", startsAt, endsAt, admin.ID)
+		require.NoError(t, err)
+		require.NoError(t, repo.Create(ctx, announcement))
+		return announcement
+	}
+
+	current := create(ctx, "Current", now.Add(-time.Hour), now.Add(time.Hour))
+	create(ctx, "Scheduled", now.Add(time.Hour), now.Add(2*time.Hour))
+	create(ctx, "Over", now.Add(-2*time.Hour), now.Add(-time.Hour))
+	other := factory.NewBlog().Create(t, tx)
+	create(tenant.WithBlogID(ctx, other.ID), "Elsewhere", now.Add(-time.Hour), now.Add(time.Hour))
+
+	active, err := repo.ListActive(ctx, now)
+	require.NoError(t, err)
+	require.Len(t, active, 1, "only announcements of this blog in their window are shown")
+	assert.Equal(t, current.ID, active[0].ID)
+
+	all, err := repo.List(ctx)
+	require.NoError(t, err)
+	assert.Len(t, all, 3)
+
+	require.NoError(t, repo.Delete(ctx, current.ID))
+	_, err = repo.FindByID(ctx, current.ID)
+	assert.ErrorIs(t, err, ports.ErrAnnouncementNotFound)
+}
@@ -0,0 +1,235 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"backend/internal/apiclients/domain"
+	"backend/internal/apiclients/ports"
+	"backend/internal/platform/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// apiClientColumns are the columns scanned by scanAPIClient, in order
+const apiClientColumns = `id, owner_id, name, token_prefix, token_hash, scopes, rate_limit, created_at, last_used_at, revoked_at`
+
+// APIClientRepository implements the apiclients.ClientRepository interface using PostgreSQL
+type APIClientRepository struct {
+	postgres.BaseRepository
+}
+
+// NewAPIClientRepository creates a new PostgreSQL API client repository
+func NewAPIClientRepository(db *pgxpool.Pool) *APIClientRepository {
+	return &APIClientRepository{
+		BaseRepository: postgres.NewBaseRepository(db),
+	}
+}
+
+// WithTx returns a new repository instance that uses the provided transaction
+func (r *APIClientRepository) WithTx(tx pgx.Tx) *APIClientRepository {
+	return &APIClientRepository{
+		BaseRepository: r.BaseRepository.WithTx(tx),
+	}
+}
+
+// Create stores a newly registered client in the current blog
+func (r *APIClientRepository) Create(ctx context.Context, client *domain.Client) error {
+	_, err := r.DB.Exec(ctx, `
+		INSERT INTO api_clients (id, blog_id, owner_id, name, token_prefix, token_hash, scopes, rate_limit, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		pgtype.UUID{Bytes: client.ID, Valid: true},
+		currentBlogID(ctx),
+		pgtype.UUID{Bytes: client.OwnerID, Valid: true},
+		client.Name,
+		client.TokenPrefix,
+		client.TokenHash,
+		scopeNames(client.Scopes),
+		client.RateLimit,
+		client.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("APIClientRepository.Create: %w", err)
+	}
+	return nil
+}
+
+// FindByID retrieves a client of the current blog
+func (r *APIClientRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Client, error) {
+	row := r.DB.QueryRow(ctx,
+		`SELECT `+apiClientColumns+` FROM api_clients WHERE id = $1 AND blog_id = $2`,
+		pgtype.UUID{Bytes: id, Valid: true}, currentBlogID(ctx),
+	)
+	client, err := scanAPIClient(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrClientNotFound
+		}
+		return nil, fmt.Errorf("APIClientRepository.FindByID: %w", err)
+	}
+	return client, nil
+}
+
+// FindByTokenHash retrieves the client of the current blog holding a token
+func (r *APIClientRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*domain.Client, error) {
+	row := r.DB.QueryRow(ctx,
+		`SELECT `+apiClientColumns+` FROM api_clients WHERE token_hash = $1 AND blog_id = $2`,
+		tokenHash, currentBlogID(ctx),
+	)
+	client, err := scanAPIClient(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrClientNotFound
+		}
+		return nil, fmt.Errorf("APIClientRepository.FindByTokenHash: %w", err)
+	}
+	return client, nil
+}
+
+// ListByOwner retrieves an owner's clients in the current blog, newest first
+func (r *APIClientRepository) ListByOwner(ctx context.Context, ownerID uuid.UUID) ([]*domain.Client, error) {
+	rows, err := r.DB.Query(ctx,
+		`SELECT `+apiClientColumns+` FROM api_clients
+		WHERE blog_id = $1 AND owner_id = $2
+		ORDER BY created_at DESC, id`,
+		currentBlogID(ctx), pgtype.UUID{Bytes: ownerID, Valid: true},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("APIClientRepository.ListByOwner: %w", err)
+	}
+	defer rows.Close()
+
+	clients := make([]*domain.Client, 0)
+	for rows.Next() {
+		client, err := scanAPIClient(rows)
+		if err != nil {
+			return nil, fmt.Errorf("APIClientRepository.ListByOwner: scan: %w", err)
+		}
+		clients = append(clients, client)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("APIClientRepository.ListByOwner: %w", err)
+	}
+	return clients, nil
+}
+
+// CountActiveByOwner counts an owner's unrevoked clients in the current blog
+func (r *APIClientRepository) CountActiveByOwner(ctx context.Context, ownerID uuid.UUID) (int, error) {
+	var count int
+	err := r.DB.QueryRow(ctx,
+		`SELECT COUNT(*) FROM api_clients WHERE blog_id = $1 AND owner_id = $2 AND revoked_at IS NULL`,
+		currentBlogID(ctx), pgtype.UUID{Bytes: ownerID, Valid: true},
+	).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("APIClientRepository.CountActiveByOwner: %w", err)
+	}
+	return count, nil
+}
+
+// Revoke stores the client's revocation time
+func (r *APIClientRepository) Revoke(ctx context.Context, client *domain.Client) error {
+	result, err := r.DB.Exec(ctx,
+		`UPDATE api_clients SET revoked_at = $1 WHERE id = $2 AND blog_id = $3`,
+		client.RevokedAt, pgtype.UUID{Bytes: client.ID, Valid: true}, currentBlogID(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("APIClientRepository.Revoke: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return ports.ErrClientNotFound
+	}
+	return nil
+}
+
+// RecordUsage adds the counts to each client's day in one batch. Usage of
+// clients deleted in the meantime is dropped.
+func (r *APIClientRepository) RecordUsage(ctx context.Context, usage []ports.UsageDelta) error {
+	batch := &pgx.Batch{}
+	for _, delta := range usage {
+		id := pgtype.UUID{Bytes: delta.ClientID, Valid: true}
+		batch.Queue(`
+			INSERT INTO api_client_usage (client_id, day, requests, throttled)
+			SELECT id, $2, $3, $4 FROM api_clients WHERE id = $1
+			ON CONFLICT (client_id, day) DO UPDATE SET
+				requests = api_client_usage.requests + EXCLUDED.requests,
+				throttled = api_client_usage.throttled + EXCLUDED.throttled`,
+			id, pgtype.Date{Time: delta.Day, Valid: true}, delta.Requests, delta.Throttled,
+		)
+		batch.Queue(`
+			UPDATE api_clients SET last_used_at = GREATEST(last_used_at, $2)
+			WHERE id = $1`,
+			id, delta.LastUsedAt,
+		)
+	}
+
+	if err := r.DB.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("APIClientRepository.RecordUsage: %w", err)
+	}
+	return nil
+}
+
+// ListUsage retrieves a client's daily usage from since onwards, oldest first
+// Days without requests are left out
+func (r *APIClientRepository) ListUsage(ctx context.Context, clientID uuid.UUID, since time.Time) ([]domain.DailyUsage, error) {
+	rows, err := r.DB.Query(ctx, `
+		SELECT day, requests, throttled FROM api_client_usage
+		WHERE client_id = $1 AND day >= $2
+		ORDER BY day`,
+		pgtype.UUID{Bytes: clientID, Valid: true}, pgtype.Date{Time: since, Valid: true},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("APIClientRepository.ListUsage: %w", err)
+	}
+	defer rows.Close()
+
+	usage := make([]domain.DailyUsage, 0)
+	for rows.Next() {
+		var day pgtype.Date
+		var u domain.DailyUsage
+		if err := rows.Scan(&day, &u.Requests, &u.Throttled); err != nil {
+			return nil, fmt.Errorf("APIClientRepository.ListUsage: scan: %w", err)
+		}
+		u.Day = day.Time
+		usage = append(usage, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("APIClientRepository.ListUsage: %w", err)
+	}
+	return usage, nil
+}
+
+// scanAPIClient reads the apiClientColumns of a row
+func scanAPIClient(row pgx.Row) (*domain.Client, error) {
+	var id, ownerID pgtype.UUID
+	var scopes []string
+	var client domain.Client
+	if err := row.Scan(
+		&id, &ownerID, &client.Name, &client.TokenPrefix, &client.TokenHash, &scopes,
+		&client.RateLimit, &client.CreatedAt, &client.LastUsedAt, &client.RevokedAt,
+	); err != nil {
+		return nil, err
+	}
+	client.ID = uuid.UUID(id.Bytes)
+	client.OwnerID = uuid.UUID(ownerID.Bytes)
+	client.Scopes = make([]domain.Scope, len(scopes))
+	for i, scope := range scopes {
+		client.Scopes[i] = domain.Scope(scope)
+	}
+	return &client, nil
+}
+
+// scopeNames converts scopes to the TEXT[] they are stored as
+func scopeNames(scopes []domain.Scope) []string {
+	names := make([]string, len(scopes))
+	for i, scope := range scopes {
+		names[i] = string(scope)
+	}
+	return names
+}
+
+// Compile-time check to ensure APIClientRepository implements ports.ClientRepository
+var _ ports.ClientRepository = (*APIClientRepository)(nil)
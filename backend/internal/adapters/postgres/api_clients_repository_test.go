@@ -0,0 +1,92 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"backend/internal/adapters/postgres"
+	"backend/internal/apiclients/domain"
+	"backend/internal/apiclients/ports"
+	"backend/internal/testing/factory"
+	"backend/internal/testing/pgtest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIClientRepository_CreateAndRevoke(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewAPIClientRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	owner := factory.NewUser().Create(t, tx)
+	client, token, err := domain.NewClient(owner.ID, "Reader", []domain.Scope{domain.ScopePostsRead, domain.ScopeSeriesRead}, 600)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, client))
+
+	found, err := repo.FindByTokenHash(ctx, domain.HashToken(token))
+	require.NoError(t, err)
+	assert.Equal(t, client.ID, found.ID)
+	assert.Equal(t, client.Scopes, found.Scopes)
+	assert.Nil(t, found.RevokedAt)
+
+	count, err := repo.CountActiveByOwner(ctx, owner.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	require.NoError(t, client.Revoke())
+	require.NoError(t, repo.Revoke(ctx, client))
+
+	found, err = repo.FindByID(ctx, client.ID)
+	require.NoError(t, err)
+	assert.True(t, found.Revoked())
+
+	count, err = repo.CountActiveByOwner(ctx, owner.ID)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	_, err = repo.FindByID(ctx, uuid.New())
+	assert.ErrorIs(t, err, ports.ErrClientNotFound)
+}
+
+func TestAPIClientRepository_RecordUsage(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewAPIClientRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	owner := factory.NewUser().Create(t, tx)
+	client, _, err := domain.NewClient(owner.ID, "Reader", []domain.Scope{domain.ScopePostsRead}, 600)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, client))
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	yesterday := today.AddDate(0, 0, -1)
+	usedAt := time.Now().UTC().Truncate(time.Second)
+
+	// Flushes add up, and usage of a deleted client is dropped
+	require.NoError(t, repo.RecordUsage(ctx, []ports.UsageDelta{
+		{ClientID: client.ID, Day: yesterday, Requests: 5, LastUsedAt: usedAt.Add(-time.Hour)},
+		{ClientID: client.ID, Day: today, Requests: 3, Throttled: 1, LastUsedAt: usedAt},
+		{ClientID: uuid.New(), Day: today, Requests: 9, LastUsedAt: usedAt},
+	}))
+	require.NoError(t, repo.RecordUsage(ctx, []ports.UsageDelta{
+		{ClientID: client.ID, Day: today, Requests: 2, LastUsedAt: usedAt.Add(-time.Minute)},
+	}))
+
+	usage, err := repo.ListUsage(ctx, client.ID, yesterday)
+	require.NoError(t, err)
+	require.Len(t, usage, 2)
+	assert.Equal(t, 5, usage[0].Requests)
+	assert.Equal(t, 5, usage[1].Requests)
+	assert.Equal(t, 1, usage[1].Throttled)
+
+	usage, err = repo.ListUsage(ctx, client.ID, today)
+	require.NoError(t, err)
+	assert.Len(t, usage, 1)
+
+	found, err := repo.FindByID(ctx, client.ID)
+	require.NoError(t, err)
+	require.NotNil(t, found.LastUsedAt)
+	assert.True(t, usedAt.Equal(*found.LastUsedAt), "last use never moves backwards")
+}
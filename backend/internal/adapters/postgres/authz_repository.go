@@ -4,10 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"backend/internal/authz/domain"
+	"backend/internal/authz/ports"
+	"backend/internal/platform/postgres"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -15,13 +19,15 @@ import (
 // AuthzRepository implements the authorization repository using PostgreSQL
 // It acts as a pure data mapper without any domain logic
 type AuthzRepository struct {
-	db *pgxpool.Pool
+	db  postgres.Querier // Runs in the caller's unit of work, if any
+	uow postgres.UnitOfWork
 }
 
 // NewAuthzRepository creates a new PostgreSQL authorization repository
 func NewAuthzRepository(db *pgxpool.Pool) *AuthzRepository {
 	return &AuthzRepository{
-		db: db,
+		db:  postgres.NewContextQuerier(db),
+		uow: postgres.NewUnitOfWork(db),
 	}
 }
 
@@ -41,6 +47,7 @@ func (r *AuthzRepository) HasPermission(ctx context.Context, userID uuid.UUID, p
 			JOIN role_permissions rp ON ur.role_id = rp.role_id
 			JOIN permissions p ON rp.permission_id = p.id
 			WHERE ur.user_id = $1 
+				AND (ur.blog_id IS NULL OR ur.blog_id = $5)
 				AND p.resource = $2 
 				AND p.action = $3
 				AND (p.scope = $4 OR ($4 IS NULL AND p.scope IS NULL))
@@ -64,7 +71,7 @@ func (r *AuthzRepository) HasPermission(ctx context.Context, userID uuid.UUID, p
 	}
 
 	var hasPermission bool
-	err := r.db.QueryRow(ctx, query, userID, resource, action, scopeParam).Scan(&hasPermission)
+	err := r.db.QueryRow(ctx, query, userID, resource, action, scopeParam, currentBlogID(ctx)).Scan(&hasPermission)
 	if err != nil {
 		return false, fmt.Errorf("failed to check permission: %w", err)
 	}
@@ -83,7 +90,7 @@ func (r *AuthzRepository) HasAnyPermission(ctx context.Context, userID uuid.UUID
 				FROM user_roles ur
 				JOIN role_permissions rp ON ur.role_id = rp.role_id
 				JOIN permissions p ON rp.permission_id = p.id
-				WHERE ur.user_id = $1
+				WHERE ur.user_id = $1 AND (ur.blog_id IS NULL OR ur.blog_id = $2)
 				
 				UNION
 				
@@ -97,8 +104,8 @@ func (r *AuthzRepository) HasAnyPermission(ctx context.Context, userID uuid.UUID
 
 	// Build WHERE conditions for each permission
 	conditions := make([]string, 0, len(permissionIDs))
-	args := []interface{}{userID}
-	argCount := 1
+	args := []interface{}{userID, currentBlogID(ctx)}
+	argCount := 2
 
 	for _, permID := range permissionIDs {
 		resource, action, scope := domain.ParsePermissionID(permID)
@@ -141,18 +148,58 @@ func (r *AuthzRepository) HasAnyPermission(ctx context.Context, userID uuid.UUID
 }
 
 // HasAllPermissions checks if a user has all of the specified permissions
+// The requested set is passed as arrays and matched in one round trip
 func (r *AuthzRepository) HasAllPermissions(ctx context.Context, userID uuid.UUID, permissionIDs []string) (bool, error) {
-	// For each permission, check if user has it
-	for _, permID := range permissionIDs {
-		hasPermission, err := r.HasPermission(ctx, userID, permID)
-		if err != nil {
-			return false, err
-		}
-		if !hasPermission {
-			return false, nil
-		}
+	if len(permissionIDs) == 0 {
+		return true, nil
 	}
-	return true, nil
+
+	query := `
+		WITH requested AS (
+			SELECT DISTINCT resource, action, scope
+			FROM unnest($3::text[], $4::text[], $5::text[]) AS req(resource, action, scope)
+		)
+		SELECT COUNT(*) = (SELECT COUNT(*) FROM requested)
+		FROM requested req
+		WHERE EXISTS (
+			-- Check permissions from roles
+			SELECT 1
+			FROM user_roles ur
+			JOIN role_permissions rp ON ur.role_id = rp.role_id
+			JOIN permissions p ON rp.permission_id = p.id
+			WHERE ur.user_id = $1
+				AND (ur.blog_id IS NULL OR ur.blog_id = $2)
+				AND p.resource = req.resource
+				AND p.action = req.action
+				AND p.scope IS NOT DISTINCT FROM req.scope
+		) OR EXISTS (
+			-- Check direct user permissions
+			SELECT 1
+			FROM user_permissions up
+			JOIN permissions p ON up.permission_id = p.id
+			WHERE up.user_id = $1
+				AND p.resource = req.resource
+				AND p.action = req.action
+				AND p.scope IS NOT DISTINCT FROM req.scope
+		)
+	`
+
+	resources := make([]string, len(permissionIDs))
+	actions := make([]string, len(permissionIDs))
+	scopes := make([]pgtype.Text, len(permissionIDs))
+	for i, permID := range permissionIDs {
+		resource, action, scope := domain.ParsePermissionID(permID)
+		resources[i], actions[i] = resource, action
+		scopes[i] = pgtype.Text{String: scope, Valid: scope != ""}
+	}
+
+	var hasAll bool
+	err := r.db.QueryRow(ctx, query, userID, currentBlogID(ctx), resources, actions, scopes).Scan(&hasAll)
+	if err != nil {
+		return false, fmt.Errorf("failed to check all permissions: %w", err)
+	}
+
+	return hasAll, nil
 }
 
 // HasRole checks if a user has a specific role
@@ -163,11 +210,12 @@ func (r *AuthzRepository) HasRole(ctx context.Context, userID uuid.UUID, roleNam
 			FROM user_roles ur
 			JOIN roles r ON ur.role_id = r.id
 			WHERE ur.user_id = $1 AND r.name = $2
+				AND (ur.blog_id IS NULL OR ur.blog_id = $3)
 		)
 	`
 
 	var hasRole bool
-	err := r.db.QueryRow(ctx, query, userID, roleName).Scan(&hasRole)
+	err := r.db.QueryRow(ctx, query, userID, roleName, currentBlogID(ctx)).Scan(&hasRole)
 	if err != nil {
 		return false, fmt.Errorf("failed to check role: %w", err)
 	}
@@ -185,7 +233,7 @@ func (r *AuthzRepository) GetUserPermissionIDs(ctx context.Context, userID uuid.
 			FROM user_roles ur
 			JOIN role_permissions rp ON ur.role_id = rp.role_id
 			JOIN permissions p ON rp.permission_id = p.id
-			WHERE ur.user_id = $1
+			WHERE ur.user_id = $1 AND (ur.blog_id IS NULL OR ur.blog_id = $2)
 			
 			UNION
 			
@@ -198,7 +246,7 @@ func (r *AuthzRepository) GetUserPermissionIDs(ctx context.Context, userID uuid.
 		ORDER BY p.resource, p.action, p.scope
 	`
 
-	rows, err := r.db.Query(ctx, query, userID)
+	rows, err := r.db.Query(ctx, query, userID, currentBlogID(ctx))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user permissions: %w", err)
 	}
@@ -223,17 +271,64 @@ func (r *AuthzRepository) GetUserPermissionIDs(ctx context.Context, userID uuid.
 	return permissions, rows.Err()
 }
 
+// ListUserIDsWithPermission lists the users holding a permission in the current blog
+func (r *AuthzRepository) ListUserIDsWithPermission(ctx context.Context, permissionID string) ([]uuid.UUID, error) {
+	resource, action, scope := domain.ParsePermissionID(permissionID)
+
+	query := `
+		SELECT ur.user_id
+		FROM user_roles ur
+		JOIN role_permissions rp ON ur.role_id = rp.role_id
+		JOIN permissions p ON rp.permission_id = p.id
+		WHERE (ur.blog_id IS NULL OR ur.blog_id = $4)
+			AND p.resource = $1
+			AND p.action = $2
+			AND (p.scope = $3 OR ($3 IS NULL AND p.scope IS NULL))
+
+		UNION
+
+		SELECT up.user_id
+		FROM user_permissions up
+		JOIN permissions p ON up.permission_id = p.id
+		WHERE p.resource = $1
+			AND p.action = $2
+			AND (p.scope = $3 OR ($3 IS NULL AND p.scope IS NULL))
+	`
+
+	var scopeParam pgtype.Text
+	if scope != "" {
+		scopeParam = pgtype.Text{String: scope, Valid: true}
+	}
+
+	rows, err := r.db.Query(ctx, query, resource, action, scopeParam, currentBlogID(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users with permission: %w", err)
+	}
+	defer rows.Close()
+
+	var userIDs []uuid.UUID
+	for rows.Next() {
+		var userID uuid.UUID
+		if err := rows.Scan(&userID); err != nil {
+			return nil, fmt.Errorf("failed to scan user ID: %w", err)
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	return userIDs, rows.Err()
+}
+
 // GetUserRoleNames gets all role names for a user (optimized)
 func (r *AuthzRepository) GetUserRoleNames(ctx context.Context, userID uuid.UUID) ([]string, error) {
 	query := `
 		SELECT r.name
 		FROM user_roles ur
 		JOIN roles r ON ur.role_id = r.id
-		WHERE ur.user_id = $1
+		WHERE ur.user_id = $1 AND (ur.blog_id IS NULL OR ur.blog_id = $2)
 		ORDER BY r.name
 	`
 
-	rows, err := r.db.Query(ctx, query, userID)
+	rows, err := r.db.Query(ctx, query, userID, currentBlogID(ctx))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user roles: %w", err)
 	}
@@ -274,7 +369,7 @@ func (r *AuthzRepository) GetPermissionByID(ctx context.Context, id uuid.UUID) (
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("permission not found: %w", err)
+			return nil, ports.ErrPermissionNotFound
 		}
 		return nil, fmt.Errorf("failed to get permission: %w", err)
 	}
@@ -315,7 +410,7 @@ func (r *AuthzRepository) GetPermissionByIDString(ctx context.Context, permissio
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("permission not found: %w", err)
+			return nil, ports.ErrPermissionNotFound
 		}
 		return nil, fmt.Errorf("failed to get permission: %w", err)
 	}
@@ -421,7 +516,7 @@ func (r *AuthzRepository) UpdatePermission(ctx context.Context, permission *doma
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("permission not found")
+		return ports.ErrPermissionNotFound
 	}
 
 	return nil
@@ -437,8 +532,28 @@ func (r *AuthzRepository) DeletePermission(ctx context.Context, id uuid.UUID) er
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("permission not found")
+		return ports.ErrPermissionNotFound
 	}
 
 	return nil
 }
+
+// missingReference translates a foreign key violation on an assignment table
+// into the repository error for the missing user, role or permission; other
+// errors are returned unchanged
+func missingReference(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != foreignKeyViolation {
+		return err
+	}
+
+	switch {
+	case strings.HasSuffix(pgErr.ConstraintName, "_user_id_fkey"):
+		return ports.ErrUserNotFound
+	case strings.HasSuffix(pgErr.ConstraintName, "_role_id_fkey"):
+		return ports.ErrRoleNotFound
+	case strings.HasSuffix(pgErr.ConstraintName, "_permission_id_fkey"):
+		return ports.ErrPermissionNotFound
+	}
+	return err
+}
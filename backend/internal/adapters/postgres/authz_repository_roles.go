@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"backend/internal/authz/domain"
+	"backend/internal/authz/ports"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -91,7 +92,7 @@ func (r *AuthzRepository) GetRoleByID(ctx context.Context, id uuid.UUID) (*domai
 	}
 
 	if role == nil {
-		return nil, fmt.Errorf("role not found")
+		return nil, ports.ErrRoleNotFound
 	}
 
 	role.Permissions = permissions
@@ -177,7 +178,7 @@ func (r *AuthzRepository) GetRoleByName(ctx context.Context, name string) (*doma
 	}
 
 	if role == nil {
-		return nil, fmt.Errorf("role not found")
+		return nil, ports.ErrRoleNotFound
 	}
 
 	role.Permissions = permissions
@@ -369,128 +370,110 @@ func (r *AuthzRepository) GetRoleTemplates(ctx context.Context) ([]*domain.Role,
 
 // CreateRole creates a new role (fully transactional)
 func (r *AuthzRepository) CreateRole(ctx context.Context, role *domain.Role) error {
-	// Start a transaction for atomicity
-	tx, err := r.db.Begin(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
-	}
-	defer func() { _ = tx.Rollback(ctx) }()
-
-	// Insert the role
-	query := `
-		INSERT INTO roles (id, name, description, is_template, is_system, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
-	`
-
-	_, err = tx.Exec(ctx, query,
-		role.ID,
-		role.Name,
-		role.Description,
-		role.IsTemplate,
-		role.IsSystem,
-		role.CreatedAt,
-		role.UpdatedAt,
-	)
-	if err != nil {
-		return fmt.Errorf("failed to create role: %w", err)
-	}
-
-	// If the role has permissions, add them using batch insert
-	if len(role.Permissions) > 0 {
-		batch := &pgx.Batch{}
-		for _, perm := range role.Permissions {
-			batch.Queue(
-				"INSERT INTO role_permissions (role_id, permission_id) VALUES ($1, $2)",
-				role.ID, perm.ID,
-			)
+	// Start a unit of work for atomicity
+	return r.uow.Do(ctx, func(ctx context.Context) error {
+		// Insert the role
+		query := `
+			INSERT INTO roles (id, name, description, is_template, is_system, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+		`
+
+		_, err := r.db.Exec(ctx, query,
+			role.ID,
+			role.Name,
+			role.Description,
+			role.IsTemplate,
+			role.IsSystem,
+			role.CreatedAt,
+			role.UpdatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create role: %w", err)
 		}
 
-		br := tx.SendBatch(ctx, batch)
-		for i := 0; i < len(role.Permissions); i++ {
-			if _, err := br.Exec(); err != nil {
-				_ = br.Close()
-				return fmt.Errorf("failed to assign permission to role: %w", err)
+		// If the role has permissions, add them using batch insert
+		if len(role.Permissions) > 0 {
+			batch := &pgx.Batch{}
+			for _, perm := range role.Permissions {
+				batch.Queue(
+					"INSERT INTO role_permissions (role_id, permission_id) VALUES ($1, $2)",
+					role.ID, perm.ID,
+				)
 			}
-		}
-		if err := br.Close(); err != nil {
-			return fmt.Errorf("failed to close batch: %w", err)
-		}
-	}
 
-	// Commit transaction
-	if err := tx.Commit(ctx); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
-	}
+			br := r.db.SendBatch(ctx, batch)
+			for i := 0; i < len(role.Permissions); i++ {
+				if _, err := br.Exec(); err != nil {
+					_ = br.Close()
+					return fmt.Errorf("failed to assign permission to role: %w", err)
+				}
+			}
+			if err := br.Close(); err != nil {
+				return fmt.Errorf("failed to close batch: %w", err)
+			}
+		}
 
-	return nil
+		return nil
+	})
 }
 
 // UpdateRole updates an existing role (fully transactional, including permissions)
 func (r *AuthzRepository) UpdateRole(ctx context.Context, role *domain.Role) error {
-	// Start a transaction for atomicity
-	tx, err := r.db.Begin(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
-	}
-	defer func() { _ = tx.Rollback(ctx) }()
-
-	// Update the role
-	query := `
-		UPDATE roles
-		SET name = $2, description = $3, is_template = $4, is_system = $5, updated_at = $6
-		WHERE id = $1
-	`
-
-	result, err := tx.Exec(ctx, query,
-		role.ID,
-		role.Name,
-		role.Description,
-		role.IsTemplate,
-		role.IsSystem,
-		role.UpdatedAt,
-	)
-	if err != nil {
-		return fmt.Errorf("failed to update role: %w", err)
-	}
-
-	if result.RowsAffected() == 0 {
-		return fmt.Errorf("role not found")
-	}
+	// Start a unit of work for atomicity
+	return r.uow.Do(ctx, func(ctx context.Context) error {
+		// Update the role
+		query := `
+			UPDATE roles
+			SET name = $2, description = $3, is_template = $4, is_system = $5, updated_at = $6
+			WHERE id = $1
+		`
+
+		result, err := r.db.Exec(ctx, query,
+			role.ID,
+			role.Name,
+			role.Description,
+			role.IsTemplate,
+			role.IsSystem,
+			role.UpdatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update role: %w", err)
+		}
 
-	// Update permissions: delete existing and insert new ones
-	deleteQuery := `DELETE FROM role_permissions WHERE role_id = $1`
-	if _, err := tx.Exec(ctx, deleteQuery, role.ID); err != nil {
-		return fmt.Errorf("failed to delete existing permissions: %w", err)
-	}
+		if result.RowsAffected() == 0 {
+			return ports.ErrRoleNotFound
+		}
 
-	// Insert new permissions using batch
-	if len(role.Permissions) > 0 {
-		batch := &pgx.Batch{}
-		for _, perm := range role.Permissions {
-			batch.Queue(
-				"INSERT INTO role_permissions (role_id, permission_id) VALUES ($1, $2)",
-				role.ID, perm.ID,
-			)
+		// Update permissions: delete existing and insert new ones
+		deleteQuery := `DELETE FROM role_permissions WHERE role_id = $1`
+		if _, err := r.db.Exec(ctx, deleteQuery, role.ID); err != nil {
+			return fmt.Errorf("failed to delete existing permissions: %w", err)
 		}
 
-		br := tx.SendBatch(ctx, batch)
-		for i := 0; i < len(role.Permissions); i++ {
-			if _, err := br.Exec(); err != nil {
-				_ = br.Close()
-				return fmt.Errorf("failed to assign permission to role: %w", err)
+		// Insert new permissions using batch
+		if len(role.Permissions) > 0 {
+			batch := &pgx.Batch{}
+			for _, perm := range role.Permissions {
+				batch.Queue(
+					"INSERT INTO role_permissions (role_id, permission_id) VALUES ($1, $2)",
+					role.ID, perm.ID,
+				)
 			}
-		}
-		if err := br.Close(); err != nil {
-			return fmt.Errorf("failed to close batch: %w", err)
-		}
-	}
 
-	// Commit transaction
-	if err := tx.Commit(ctx); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
-	}
+			br := r.db.SendBatch(ctx, batch)
+			for i := 0; i < len(role.Permissions); i++ {
+				if _, err := br.Exec(); err != nil {
+					_ = br.Close()
+					return fmt.Errorf("failed to assign permission to role: %w", err)
+				}
+			}
+			if err := br.Close(); err != nil {
+				return fmt.Errorf("failed to close batch: %w", err)
+			}
+		}
 
-	return nil
+		return nil
+	})
 }
 
 // DeleteRole deletes a role by ID
@@ -503,7 +486,7 @@ func (r *AuthzRepository) DeleteRole(ctx context.Context, id uuid.UUID) error {
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("role not found")
+		return ports.ErrRoleNotFound
 	}
 
 	return nil
@@ -511,47 +494,38 @@ func (r *AuthzRepository) DeleteRole(ctx context.Context, id uuid.UUID) error {
 
 // AssignPermissionsToRole assigns permissions to a role (replaces existing)
 func (r *AuthzRepository) AssignPermissionsToRole(ctx context.Context, roleID uuid.UUID, permissionIDs []uuid.UUID) error {
-	// Start a transaction
-	tx, err := r.db.Begin(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
-	}
-	defer func() { _ = tx.Rollback(ctx) }()
-
-	// Delete existing permissions
-	deleteQuery := `DELETE FROM role_permissions WHERE role_id = $1`
-	if _, err := tx.Exec(ctx, deleteQuery, roleID); err != nil {
-		return fmt.Errorf("failed to delete existing permissions: %w", err)
-	}
-
-	// Insert new permissions using batch
-	if len(permissionIDs) > 0 {
-		batch := &pgx.Batch{}
-		for _, permID := range permissionIDs {
-			batch.Queue(
-				"INSERT INTO role_permissions (role_id, permission_id) VALUES ($1, $2)",
-				roleID, permID,
-			)
+	// Start a unit of work for atomicity
+	return r.uow.Do(ctx, func(ctx context.Context) error {
+		// Delete existing permissions
+		deleteQuery := `DELETE FROM role_permissions WHERE role_id = $1`
+		if _, err := r.db.Exec(ctx, deleteQuery, roleID); err != nil {
+			return fmt.Errorf("failed to delete existing permissions: %w", err)
 		}
 
-		br := tx.SendBatch(ctx, batch)
-		for i := 0; i < len(permissionIDs); i++ {
-			if _, err := br.Exec(); err != nil {
-				_ = br.Close()
-				return fmt.Errorf("failed to assign permission: %w", err)
+		// Insert new permissions using batch
+		if len(permissionIDs) > 0 {
+			batch := &pgx.Batch{}
+			for _, permID := range permissionIDs {
+				batch.Queue(
+					"INSERT INTO role_permissions (role_id, permission_id) VALUES ($1, $2)",
+					roleID, permID,
+				)
 			}
-		}
-		if err := br.Close(); err != nil {
-			return fmt.Errorf("failed to close batch: %w", err)
-		}
-	}
 
-	// Commit transaction
-	if err := tx.Commit(ctx); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
-	}
+			br := r.db.SendBatch(ctx, batch)
+			for i := 0; i < len(permissionIDs); i++ {
+				if _, err := br.Exec(); err != nil {
+					_ = br.Close()
+					return fmt.Errorf("failed to assign permission: %w", err)
+				}
+			}
+			if err := br.Close(); err != nil {
+				return fmt.Errorf("failed to close batch: %w", err)
+			}
+		}
 
-	return nil
+		return nil
+	})
 }
 
 // AddPermissionToRole adds a single permission to a role
@@ -564,7 +538,7 @@ func (r *AuthzRepository) AddPermissionToRole(ctx context.Context, roleID uuid.U
 
 	_, err := r.db.Exec(ctx, query, roleID, permissionID)
 	if err != nil {
-		return fmt.Errorf("failed to add permission to role: %w", err)
+		return fmt.Errorf("failed to add permission to role: %w", missingReference(err))
 	}
 
 	return nil
@@ -583,8 +557,106 @@ func (r *AuthzRepository) RemovePermissionFromRole(ctx context.Context, roleID u
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("permission not found in role")
+		return fmt.Errorf("permission not in role: %w", ports.ErrPermissionNotFound)
 	}
 
 	return nil
 }
+
+// GetRoleUsage counts the holders of a role on every blog and finds the
+// permissions some of them hold only through it
+func (r *AuthzRepository) GetRoleUsage(ctx context.Context, roleID uuid.UUID) (*domain.RoleUsage, error) {
+	usage := &domain.RoleUsage{RoleID: roleID, UniquePermissions: []domain.UniquePermission{}}
+
+	var lastGrantedAt pgtype.Timestamptz
+	err := r.db.QueryRow(ctx, `
+		SELECT COUNT(DISTINCT user_id), COUNT(*), MAX(granted_at)
+		FROM user_roles
+		WHERE role_id = $1
+	`, roleID).Scan(&usage.HolderCount, &usage.AssignmentCount, &lastGrantedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count role holders: %w", err)
+	}
+	if lastGrantedAt.Valid {
+		usage.LastGrantedAt = &lastGrantedAt.Time
+	}
+
+	// A holder keeps a permission when another of their roles grants it on the
+	// same blog or on every blog, or when it was granted to them directly
+	rows, err := r.db.Query(ctx, `
+		SELECT p.resource, p.action, p.scope, COUNT(DISTINCT ur.user_id)
+		FROM user_roles ur
+		JOIN role_permissions rp ON rp.role_id = ur.role_id
+		JOIN permissions p ON p.id = rp.permission_id
+		WHERE ur.role_id = $1
+			AND NOT EXISTS (
+				SELECT 1
+				FROM user_roles other
+				JOIN role_permissions orp ON orp.role_id = other.role_id
+				WHERE other.user_id = ur.user_id
+					AND other.role_id <> ur.role_id
+					AND orp.permission_id = rp.permission_id
+					AND (other.blog_id IS NULL OR other.blog_id IS NOT DISTINCT FROM ur.blog_id)
+			)
+			AND NOT EXISTS (
+				SELECT 1
+				FROM user_permissions up
+				WHERE up.user_id = ur.user_id AND up.permission_id = rp.permission_id
+			)
+		GROUP BY p.resource, p.action, p.scope
+		ORDER BY p.resource, p.action, p.scope
+	`, roleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get permissions unique to role: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var resource, action string
+		var scope pgtype.Text
+		var holders int
+		if err := rows.Scan(&resource, &action, &scope, &holders); err != nil {
+			return nil, fmt.Errorf("failed to scan unique permission: %w", err)
+		}
+		perm := domain.Permission{Resource: resource, Action: action, Scope: scope.String}
+		usage.UniquePermissions = append(usage.UniquePermissions, domain.UniquePermission{
+			PermissionID: perm.IDString(),
+			HolderCount:  holders,
+		})
+	}
+
+	return usage, rows.Err()
+}
+
+// ListRoleAssignments returns a page of a role's assignments, most recently granted first
+func (r *AuthzRepository) ListRoleAssignments(ctx context.Context, roleID uuid.UUID, limit, offset int) ([]*domain.RoleAssignment, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT ur.user_id, u.username, ur.blog_id, ur.granted_at, ur.granted_by
+		FROM user_roles ur
+		JOIN users u ON u.id = ur.user_id
+		WHERE ur.role_id = $1
+		ORDER BY ur.granted_at DESC, ur.user_id
+		LIMIT $2 OFFSET $3
+	`, roleID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list role assignments: %w", err)
+	}
+	defer rows.Close()
+
+	assignments := make([]*domain.RoleAssignment, 0, limit)
+	for rows.Next() {
+		var assignment domain.RoleAssignment
+		if err := rows.Scan(
+			&assignment.UserID,
+			&assignment.Username,
+			&assignment.BlogID,
+			&assignment.GrantedAt,
+			&assignment.GrantedBy,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan role assignment: %w", err)
+		}
+		assignments = append(assignments, &assignment)
+	}
+
+	return assignments, rows.Err()
+}
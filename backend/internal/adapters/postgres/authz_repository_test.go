@@ -0,0 +1,296 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"backend/internal/adapters/postgres"
+	"backend/internal/authz/domain"
+	"backend/internal/authz/permission"
+	"backend/internal/authz/ports"
+	"backend/internal/platform/tenant"
+	"backend/internal/testing/factory"
+	"backend/internal/testing/pgtest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createUser makes a user on the shared pool, which AuthzRepository reads
+// from, and deletes it with its role assignments when the test ends
+func createUser(tb testing.TB, builder *factory.UserBuilder) factory.User {
+	tb.Helper()
+	pool := pgtest.Pool(tb)
+
+	user := builder.Create(tb, pool)
+	tb.Cleanup(func() {
+		_, _ = pool.Exec(context.Background(), `DELETE FROM users WHERE id = $1`, user.ID)
+	})
+	return user
+}
+
+func TestAuthzRepository_HasPermission(t *testing.T) {
+	repo := postgres.NewAuthzRepository(pgtest.Pool(t))
+	ctx := context.Background()
+
+	author := createUser(t, factory.NewUser().WithRole("author"))
+
+	has, err := repo.HasPermission(ctx, author.ID, permission.PostsCreate)
+	require.NoError(t, err)
+	assert.True(t, has)
+
+	has, err = repo.HasPermission(ctx, author.ID, permission.PostsReadDraftOwn)
+	require.NoError(t, err)
+	assert.True(t, has, "qualified actions are found by ID")
+
+	has, err = repo.HasPermission(ctx, author.ID, permission.AuthzRolesCreate)
+	require.NoError(t, err)
+	assert.False(t, has)
+}
+
+func TestAuthzRepository_ListUserIDsWithPermission(t *testing.T) {
+	pool := pgtest.Pool(t)
+	repo := postgres.NewAuthzRepository(pool)
+	ctx := context.Background()
+
+	role, err := repo.GetRoleByName(ctx, "admin")
+	require.NoError(t, err)
+	admin := createUser(t, factory.NewUser().WithRole("admin"))
+	author := createUser(t, factory.NewUser().WithRole("author"))
+	otherAdmin := createUser(t, factory.NewUser())
+	other := factory.NewBlog().Create(t, pool)
+	t.Cleanup(func() {
+		_, _ = pool.Exec(context.Background(), `DELETE FROM blogs WHERE id = $1`, other.ID)
+	})
+	require.NoError(t, repo.AssignRoleToUser(tenant.WithBlogID(ctx, other.ID), otherAdmin.ID, role.ID, admin.ID))
+
+	userIDs, err := repo.ListUserIDsWithPermission(ctx, permission.SettingsBlog)
+	require.NoError(t, err)
+	assert.Contains(t, userIDs, admin.ID)
+	assert.NotContains(t, userIDs, author.ID)
+	assert.NotContains(t, userIDs, otherAdmin.ID, "roles on other blogs do not count")
+
+	userIDs, err = repo.ListUserIDsWithPermission(tenant.WithBlogID(ctx, other.ID), permission.SettingsBlog)
+	require.NoError(t, err)
+	assert.Contains(t, userIDs, otherAdmin.ID)
+}
+
+func BenchmarkAuthzRepository_HasPermission(b *testing.B) {
+	repo := postgres.NewAuthzRepository(pgtest.Pool(b))
+	ctx := context.Background()
+
+	cases := []struct {
+		name       string
+		permission string
+	}{
+		{"granted", permission.PostsCreate},
+		{"scoped", permission.PostsUpdateOwn},
+		{"denied", permission.AuthzRolesCreate},
+	}
+
+	author := createUser(b, factory.NewUser().WithRole("author"))
+
+	for _, tc := range cases {
+		b.Run(tc.name, func(b *testing.B) {
+			for b.Loop() {
+				if _, err := repo.HasPermission(ctx, author.ID, tc.permission); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestAuthzRepository_HasAllPermissions(t *testing.T) {
+	repo := postgres.NewAuthzRepository(pgtest.Pool(t))
+	ctx := context.Background()
+
+	author := createUser(t, factory.NewUser().WithRole("author"))
+
+	has, err := repo.HasAllPermissions(ctx, author.ID, []string{
+		permission.PostsCreate, permission.PostsUpdateOwn, permission.PostsReadDraftOwn,
+	})
+	require.NoError(t, err)
+	assert.True(t, has)
+
+	has, err = repo.HasAllPermissions(ctx, author.ID, []string{permission.PostsCreate, permission.PostsCreate})
+	require.NoError(t, err)
+	assert.True(t, has, "repeated permissions are counted once")
+
+	has, err = repo.HasAllPermissions(ctx, author.ID, []string{permission.PostsCreate, permission.AuthzRolesCreate})
+	require.NoError(t, err)
+	assert.False(t, has)
+
+	has, err = repo.HasAllPermissions(ctx, author.ID, nil)
+	require.NoError(t, err)
+	assert.True(t, has)
+}
+
+// BenchmarkAuthzRepository_HasAllPermissions compares the single query with
+// one HasPermission round trip per permission, as middleware chains did before
+func BenchmarkAuthzRepository_HasAllPermissions(b *testing.B) {
+	repo := postgres.NewAuthzRepository(pgtest.Pool(b))
+	ctx := context.Background()
+
+	permissions := []string{permission.PostsCreate, permission.PostsUpdateOwn, permission.PostsReadDraftOwn}
+	author := createUser(b, factory.NewUser().WithRole("author"))
+
+	b.Run("single_query", func(b *testing.B) {
+		for b.Loop() {
+			if _, err := repo.HasAllPermissions(ctx, author.ID, permissions); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("per_permission", func(b *testing.B) {
+		for b.Loop() {
+			for _, perm := range permissions {
+				if _, err := repo.HasPermission(ctx, author.ID, perm); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
+
+func TestAuthzRepository_GetRoleUsage(t *testing.T) {
+	pool := pgtest.Pool(t)
+	repo := postgres.NewAuthzRepository(pool)
+	ctx := context.Background()
+
+	role := domain.NewRole("usage_"+uuid.NewString()[:8], "Role usage test")
+	for _, id := range []string{permission.PostsCreate, permission.PostsFeature} {
+		perm, err := repo.GetPermissionByIDString(ctx, id)
+		require.NoError(t, err)
+		role.Permissions = append(role.Permissions, perm)
+	}
+	require.NoError(t, repo.CreateRole(ctx, role))
+	t.Cleanup(func() {
+		_, _ = pool.Exec(context.Background(), `DELETE FROM roles WHERE id = $1`, role.ID)
+	})
+
+	// The author role also grants posts:create, so only the second holder depends on this role for it
+	author := createUser(t, factory.NewUser().WithRole("author"))
+	plain := createUser(t, factory.NewUser())
+	require.NoError(t, repo.AssignRoleToUser(ctx, author.ID, role.ID, author.ID))
+	require.NoError(t, repo.AssignRoleToUser(ctx, plain.ID, role.ID, author.ID))
+
+	usage, err := repo.GetRoleUsage(ctx, role.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, usage.HolderCount)
+	assert.Equal(t, 2, usage.AssignmentCount)
+	assert.NotNil(t, usage.LastGrantedAt)
+	assert.Equal(t, []domain.UniquePermission{
+		{PermissionID: permission.PostsCreate, HolderCount: 1},
+		{PermissionID: permission.PostsFeature, HolderCount: 2},
+	}, usage.UniquePermissions)
+
+	assignments, err := repo.ListRoleAssignments(ctx, role.ID, 1, 0)
+	require.NoError(t, err)
+	require.Len(t, assignments, 1)
+	assert.Nil(t, assignments[0].BlogID, "assigned outside a request, so on every blog")
+}
+
+func TestAuthzRepository_UserRoleHistory(t *testing.T) {
+	pool := pgtest.Pool(t)
+	repo := postgres.NewAuthzRepository(pool)
+	ctx := context.Background()
+
+	role := domain.NewRole("history_"+uuid.NewString()[:8], "Role history test")
+	require.NoError(t, repo.CreateRole(ctx, role))
+	t.Cleanup(func() {
+		_, _ = pool.Exec(context.Background(), `DELETE FROM roles WHERE id = $1`, role.ID)
+	})
+
+	admin := createUser(t, factory.NewUser())
+	user := createUser(t, factory.NewUser())
+	require.NoError(t, repo.AssignRoleToUser(ctx, user.ID, role.ID, admin.ID))
+
+	grants, err := repo.GetUserRoleGrants(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, grants, 1)
+	assert.Equal(t, role.ID, grants[0].RoleID)
+	assert.Equal(t, admin.ID, grants[0].GrantedBy)
+	assert.Equal(t, admin.Username, grants[0].GrantedByUsername)
+	assert.False(t, grants[0].GrantedAt.IsZero())
+
+	require.NoError(t, repo.RemoveRoleFromUser(ctx, user.ID, role.ID, admin.ID))
+
+	grants, err = repo.GetUserRoleGrants(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Empty(t, grants)
+
+	history, total, err := repo.ListUserRoleHistory(ctx, user.ID, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	require.Len(t, history, 2)
+	changes := map[domain.RoleChange]*domain.RoleHistoryEntry{}
+	for _, entry := range history {
+		changes[entry.Change] = entry
+	}
+	require.Contains(t, changes, domain.RoleGranted)
+	require.Contains(t, changes, domain.RoleRevoked)
+	revoked := changes[domain.RoleRevoked]
+	assert.Equal(t, role.Name, revoked.RoleName)
+	require.NotNil(t, revoked.ActorID)
+	assert.Equal(t, admin.ID, *revoked.ActorID)
+	assert.Equal(t, admin.Username, revoked.ActorUsername)
+}
+
+func TestAuthzRepository_UserRolesInEveryBlog(t *testing.T) {
+	pool := pgtest.Pool(t)
+	repo := postgres.NewAuthzRepository(pool)
+	ctx := context.Background()
+
+	role, err := repo.GetRoleByName(ctx, "author")
+	require.NoError(t, err)
+	admin := createUser(t, factory.NewUser())
+	user := createUser(t, factory.NewUser())
+	other := factory.NewBlog().Create(t, pool)
+	t.Cleanup(func() {
+		_, _ = pool.Exec(context.Background(), `DELETE FROM blogs WHERE id = $1`, other.ID)
+	})
+
+	require.NoError(t, repo.AssignRoleToUser(tenant.WithBlogID(ctx, other.ID), user.ID, role.ID, admin.ID))
+
+	roles, err := repo.ListUserRolesInEveryBlog(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, roles, 1, "roles on other blogs are included")
+	assert.Equal(t, "author", roles[0].Role.Name)
+	require.NotNil(t, roles[0].BlogID)
+	assert.Equal(t, other.ID, *roles[0].BlogID)
+
+	history, err := repo.ListUserRoleHistoryInEveryBlog(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, domain.RoleGranted, history[0].Change)
+}
+
+func TestAuthzRepository_NotFoundErrors(t *testing.T) {
+	pool := pgtest.Pool(t)
+	repo := postgres.NewAuthzRepository(pool)
+	ctx := context.Background()
+
+	_, err := repo.GetRoleByID(ctx, uuid.New())
+	assert.ErrorIs(t, err, ports.ErrRoleNotFound)
+
+	_, err = repo.GetRoleByName(ctx, "missing_"+uuid.NewString()[:8])
+	assert.ErrorIs(t, err, ports.ErrRoleNotFound)
+
+	_, err = repo.GetPermissionByIDString(ctx, "missing:permission")
+	assert.ErrorIs(t, err, ports.ErrPermissionNotFound)
+
+	role, err := repo.GetRoleByName(ctx, "author")
+	require.NoError(t, err)
+	user := createUser(t, factory.NewUser())
+
+	err = repo.RemoveRoleFromUser(ctx, user.ID, role.ID, user.ID)
+	assert.ErrorIs(t, err, ports.ErrRoleNotAssigned)
+
+	err = repo.AssignRoleToUser(ctx, uuid.New(), role.ID, user.ID)
+	assert.ErrorIs(t, err, ports.ErrUserNotFound)
+
+	err = repo.AssignRoleToUser(ctx, user.ID, uuid.New(), user.ID)
+	assert.ErrorIs(t, err, ports.ErrRoleNotFound)
+}
@@ -3,8 +3,10 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"backend/internal/authz/domain"
+	"backend/internal/authz/ports"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -13,6 +15,7 @@ import (
 // ===== USER AUTHORIZATION OPERATIONS =====
 
 // GetUserAuthz retrieves full authorization data for a user (for commands)
+// Roles assigned to other blogs are left out
 func (r *AuthzRepository) GetUserAuthz(ctx context.Context, userID uuid.UUID) (*domain.UserAuthz, error) {
 	// Create the user authz object
 	userAuthz := domain.NewUserAuthz(userID)
@@ -28,11 +31,11 @@ func (r *AuthzRepository) GetUserAuthz(ctx context.Context, userID uuid.UUID) (*
 		JOIN roles r ON ur.role_id = r.id
 		LEFT JOIN role_permissions rp ON r.id = rp.role_id
 		LEFT JOIN permissions p ON rp.permission_id = p.id
-		WHERE ur.user_id = $1
+		WHERE ur.user_id = $1 AND (ur.blog_id IS NULL OR ur.blog_id = $2)
 		ORDER BY r.name, p.resource, p.action, p.scope
 	`
 
-	rows, err := r.db.Query(ctx, roleQuery, userID)
+	rows, err := r.db.Query(ctx, roleQuery, userID, currentBlogID(ctx))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user roles: %w", err)
 	}
@@ -145,42 +148,48 @@ func (r *AuthzRepository) GetUserAuthz(ctx context.Context, userID uuid.UUID) (*
 	return userAuthz, nil
 }
 
-// AssignRoleToUser assigns a role to a user
+// AssignRoleToUser assigns a role to a user on the request's blog, recording the grant
+// Assignments made on the default blog apply to every blog
 func (r *AuthzRepository) AssignRoleToUser(ctx context.Context, userID uuid.UUID, roleID uuid.UUID, grantedBy uuid.UUID) error {
-	query := `
-		INSERT INTO user_roles (user_id, role_id, granted_by, granted_at)
-		VALUES ($1, $2, $3, NOW())
-		ON CONFLICT (user_id, role_id) 
-		DO UPDATE SET 
-			granted_by = EXCLUDED.granted_by,
-			granted_at = EXCLUDED.granted_at
-	`
-
-	_, err := r.db.Exec(ctx, query, userID, roleID, grantedBy)
-	if err != nil {
-		return fmt.Errorf("failed to assign role to user: %w", err)
-	}
+	return r.uow.Do(ctx, func(ctx context.Context) error {
+		query := `
+			INSERT INTO user_roles (user_id, role_id, blog_id, granted_by, granted_at)
+			VALUES ($1, $2, $3, $4, NOW())
+			ON CONFLICT (user_id, role_id, blog_id) 
+			DO UPDATE SET 
+				granted_by = EXCLUDED.granted_by,
+				granted_at = EXCLUDED.granted_at
+		`
+
+		scope := roleScope(ctx)
+		if _, err := r.db.Exec(ctx, query, userID, roleID, scope, grantedBy); err != nil {
+			return fmt.Errorf("failed to assign role to user: %w", missingReference(err))
+		}
 
-	return nil
+		return r.recordRoleChange(ctx, userID, roleID, scope, domain.RoleGranted, grantedBy)
+	})
 }
 
-// RemoveRoleFromUser removes a role from a user
-func (r *AuthzRepository) RemoveRoleFromUser(ctx context.Context, userID uuid.UUID, roleID uuid.UUID) error {
-	query := `
-		DELETE FROM user_roles
-		WHERE user_id = $1 AND role_id = $2
-	`
+// RemoveRoleFromUser removes a role assigned to a user on the request's blog, recording the revocation
+func (r *AuthzRepository) RemoveRoleFromUser(ctx context.Context, userID uuid.UUID, roleID uuid.UUID, revokedBy uuid.UUID) error {
+	return r.uow.Do(ctx, func(ctx context.Context) error {
+		query := `
+			DELETE FROM user_roles
+			WHERE user_id = $1 AND role_id = $2 AND blog_id IS NOT DISTINCT FROM $3
+		`
 
-	result, err := r.db.Exec(ctx, query, userID, roleID)
-	if err != nil {
-		return fmt.Errorf("failed to remove role from user: %w", err)
-	}
+		scope := roleScope(ctx)
+		result, err := r.db.Exec(ctx, query, userID, roleID, scope)
+		if err != nil {
+			return fmt.Errorf("failed to remove role from user: %w", err)
+		}
 
-	if result.RowsAffected() == 0 {
-		return fmt.Errorf("user does not have this role")
-	}
+		if result.RowsAffected() == 0 {
+			return ports.ErrRoleNotAssigned
+		}
 
-	return nil
+		return r.recordRoleChange(ctx, userID, roleID, scope, domain.RoleRevoked, revokedBy)
+	})
 }
 
 // GrantPermissionToUser grants a custom permission to a user
@@ -196,7 +205,7 @@ func (r *AuthzRepository) GrantPermissionToUser(ctx context.Context, userID uuid
 
 	_, err := r.db.Exec(ctx, query, userID, permissionID, grantedBy)
 	if err != nil {
-		return fmt.Errorf("failed to grant permission to user: %w", err)
+		return fmt.Errorf("failed to grant permission to user: %w", missingReference(err))
 	}
 
 	return nil
@@ -215,55 +224,69 @@ func (r *AuthzRepository) RevokePermissionFromUser(ctx context.Context, userID u
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("user does not have this permission")
+		return fmt.Errorf("permission not granted to user: %w", ports.ErrPermissionNotFound)
 	}
 
 	return nil
 }
 
-// ReplaceUserRoles replaces all user roles atomically
+// ReplaceUserRoles replaces all user roles on the request's blog atomically
+// Assignments on other blogs are left untouched
 func (r *AuthzRepository) ReplaceUserRoles(ctx context.Context, userID uuid.UUID, roleIDs []uuid.UUID, grantedBy uuid.UUID) error {
-	// Start a transaction for atomicity
-	tx, err := r.db.Begin(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
-	}
-	defer func() { _ = tx.Rollback(ctx) }()
-
-	// Delete existing roles
-	deleteQuery := `DELETE FROM user_roles WHERE user_id = $1`
-	if _, err := tx.Exec(ctx, deleteQuery, userID); err != nil {
-		return fmt.Errorf("failed to delete existing roles: %w", err)
-	}
-
-	// Insert new roles using batch
-	if len(roleIDs) > 0 {
-		batch := &pgx.Batch{}
-		for _, roleID := range roleIDs {
-			batch.Queue(
-				"INSERT INTO user_roles (user_id, role_id, granted_by, granted_at) VALUES ($1, $2, $3, NOW())",
-				userID, roleID, grantedBy,
-			)
+	// Start a unit of work for atomicity
+	return r.uow.Do(ctx, func(ctx context.Context) error {
+		// Delete existing roles
+		scope := roleScope(ctx)
+		deleteQuery := `DELETE FROM user_roles WHERE user_id = $1 AND blog_id IS NOT DISTINCT FROM $2 RETURNING role_id`
+		rows, err := r.db.Query(ctx, deleteQuery, userID, scope)
+		if err != nil {
+			return fmt.Errorf("failed to delete existing roles: %w", err)
+		}
+		previous, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+		if err != nil {
+			return fmt.Errorf("failed to delete existing roles: %w", err)
 		}
 
-		br := tx.SendBatch(ctx, batch)
-		for i := 0; i < len(roleIDs); i++ {
-			if _, err := br.Exec(); err != nil {
-				_ = br.Close()
-				return fmt.Errorf("failed to assign role: %w", err)
+		// Record only what changed; roles kept are granted again without a history entry
+		for _, roleID := range previous {
+			if !slices.Contains(roleIDs, roleID) {
+				if err := r.recordRoleChange(ctx, userID, roleID, scope, domain.RoleRevoked, grantedBy); err != nil {
+					return err
+				}
 			}
 		}
-		if err := br.Close(); err != nil {
-			return fmt.Errorf("failed to close batch: %w", err)
+		for _, roleID := range roleIDs {
+			if !slices.Contains(previous, roleID) {
+				if err := r.recordRoleChange(ctx, userID, roleID, scope, domain.RoleGranted, grantedBy); err != nil {
+					return err
+				}
+			}
 		}
-	}
 
-	// Commit transaction
-	if err := tx.Commit(ctx); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
-	}
+		// Insert new roles using batch
+		if len(roleIDs) > 0 {
+			batch := &pgx.Batch{}
+			for _, roleID := range roleIDs {
+				batch.Queue(
+					"INSERT INTO user_roles (user_id, role_id, blog_id, granted_by, granted_at) VALUES ($1, $2, $3, $4, NOW())",
+					userID, roleID, scope, grantedBy,
+				)
+			}
 
-	return nil
+			br := r.db.SendBatch(ctx, batch)
+			for i := 0; i < len(roleIDs); i++ {
+				if _, err := br.Exec(); err != nil {
+					_ = br.Close()
+					return fmt.Errorf("failed to assign role: %w", missingReference(err))
+				}
+			}
+			if err := br.Close(); err != nil {
+				return fmt.Errorf("failed to close batch: %w", err)
+			}
+		}
+
+		return nil
+	})
 }
 
 // ClearUserPermissions removes all custom permissions from a user
@@ -277,3 +300,172 @@ func (r *AuthzRepository) ClearUserPermissions(ctx context.Context, userID uuid.
 
 	return nil
 }
+
+// GetUserRoleGrants returns when, and by whom, each of the user's roles on the
+// request's blog was granted; Role is left for the caller to fill in
+func (r *AuthzRepository) GetUserRoleGrants(ctx context.Context, userID uuid.UUID) ([]*domain.UserRole, error) {
+	query := `
+		SELECT ur.role_id, ur.blog_id, ur.granted_at, ur.granted_by, COALESCE(g.username, '')
+		FROM user_roles ur
+		LEFT JOIN users g ON g.id = ur.granted_by
+		WHERE ur.user_id = $1 AND (ur.blog_id IS NULL OR ur.blog_id = $2)
+		ORDER BY ur.granted_at
+	`
+
+	rows, err := r.db.Query(ctx, query, userID, currentBlogID(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user role grants: %w", err)
+	}
+	defer rows.Close()
+
+	var grants []*domain.UserRole
+	for rows.Next() {
+		grant := &domain.UserRole{UserID: userID}
+		var grantedBy pgtype.UUID
+		if err := rows.Scan(&grant.RoleID, &grant.BlogID, &grant.GrantedAt, &grantedBy, &grant.GrantedByUsername); err != nil {
+			return nil, fmt.Errorf("failed to scan user role grant: %w", err)
+		}
+		if grantedBy.Valid {
+			grant.GrantedBy = grantedBy.Bytes
+		}
+		grants = append(grants, grant)
+	}
+
+	return grants, rows.Err()
+}
+
+// ListUserRoleHistory returns a page of the grants and revocations of the
+// user's roles on the request's blog, newest first, and how many there are
+func (r *AuthzRepository) ListUserRoleHistory(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*domain.RoleHistoryEntry, int, error) {
+	blogID := currentBlogID(ctx)
+
+	var total int
+	countQuery := `
+		SELECT COUNT(*)
+		FROM user_role_history
+		WHERE user_id = $1 AND (blog_id IS NULL OR blog_id = $2)
+	`
+	if err := r.db.QueryRow(ctx, countQuery, userID, blogID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count user role history: %w", err)
+	}
+
+	query := `
+		SELECT h.id, h.role_id, r.name, h.blog_id, h.action, h.actor_id, COALESCE(a.username, ''), h.occurred_at
+		FROM user_role_history h
+		JOIN roles r ON r.id = h.role_id
+		LEFT JOIN users a ON a.id = h.actor_id
+		WHERE h.user_id = $1 AND (h.blog_id IS NULL OR h.blog_id = $2)
+		ORDER BY h.occurred_at DESC, h.id
+		LIMIT $3 OFFSET $4
+	`
+
+	rows, err := r.db.Query(ctx, query, userID, blogID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list user role history: %w", err)
+	}
+	defer rows.Close()
+
+	entries := make([]*domain.RoleHistoryEntry, 0, limit)
+	for rows.Next() {
+		entry := &domain.RoleHistoryEntry{UserID: userID}
+		var change string
+		if err := rows.Scan(
+			&entry.ID, &entry.RoleID, &entry.RoleName, &entry.BlogID,
+			&change, &entry.ActorID, &entry.ActorUsername, &entry.OccurredAt,
+		); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan user role history: %w", err)
+		}
+		entry.Change = domain.RoleChange(change)
+		entries = append(entries, entry)
+	}
+
+	return entries, total, rows.Err()
+}
+
+// ListUserRolesInEveryBlog returns the user's role assignments on every blog
+// with the names of their roles, for a copy of the user's data
+func (r *AuthzRepository) ListUserRolesInEveryBlog(ctx context.Context, userID uuid.UUID) ([]*domain.UserRole, error) {
+	query := `
+		SELECT ur.role_id, r.name, ur.blog_id, ur.granted_at, ur.granted_by, COALESCE(g.username, '')
+		FROM user_roles ur
+		JOIN roles r ON r.id = ur.role_id
+		LEFT JOIN users g ON g.id = ur.granted_by
+		WHERE ur.user_id = $1
+		ORDER BY ur.granted_at, r.name
+	`
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user roles: %w", err)
+	}
+	defer rows.Close()
+
+	roles := make([]*domain.UserRole, 0)
+	for rows.Next() {
+		assignment := &domain.UserRole{UserID: userID, Role: &domain.Role{}}
+		var grantedBy pgtype.UUID
+		if err := rows.Scan(
+			&assignment.RoleID, &assignment.Role.Name, &assignment.BlogID,
+			&assignment.GrantedAt, &grantedBy, &assignment.GrantedByUsername,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan user role: %w", err)
+		}
+		assignment.Role.ID = assignment.RoleID
+		if grantedBy.Valid {
+			assignment.GrantedBy = grantedBy.Bytes
+		}
+		roles = append(roles, assignment)
+	}
+
+	return roles, rows.Err()
+}
+
+// ListUserRoleHistoryInEveryBlog returns the user's whole role history across
+// blogs, oldest first, for a copy of the user's data
+func (r *AuthzRepository) ListUserRoleHistoryInEveryBlog(ctx context.Context, userID uuid.UUID) ([]*domain.RoleHistoryEntry, error) {
+	query := `
+		SELECT h.id, h.role_id, r.name, h.blog_id, h.action, h.actor_id, COALESCE(a.username, ''), h.occurred_at
+		FROM user_role_history h
+		JOIN roles r ON r.id = h.role_id
+		LEFT JOIN users a ON a.id = h.actor_id
+		WHERE h.user_id = $1
+		ORDER BY h.occurred_at, h.id
+	`
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user role history: %w", err)
+	}
+	defer rows.Close()
+
+	entries := make([]*domain.RoleHistoryEntry, 0)
+	for rows.Next() {
+		entry := &domain.RoleHistoryEntry{UserID: userID}
+		var change string
+		if err := rows.Scan(
+			&entry.ID, &entry.RoleID, &entry.RoleName, &entry.BlogID,
+			&change, &entry.ActorID, &entry.ActorUsername, &entry.OccurredAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan user role history: %w", err)
+		}
+		entry.Change = domain.RoleChange(change)
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
+
+// recordRoleChange adds a grant or revocation to the user's role history
+func (r *AuthzRepository) recordRoleChange(ctx context.Context, userID, roleID uuid.UUID, scope pgtype.UUID, change domain.RoleChange, actorID uuid.UUID) error {
+	query := `
+		INSERT INTO user_role_history (user_id, role_id, blog_id, action, actor_id)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	actor := pgtype.UUID{Bytes: actorID, Valid: actorID != uuid.Nil}
+	if _, err := r.db.Exec(ctx, query, userID, roleID, scope, string(change), actor); err != nil {
+		return fmt.Errorf("failed to record role %s: %w", change, missingReference(err))
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"backend/internal/blogs/domain"
+	"backend/internal/blogs/ports"
+	"backend/internal/platform/postgres"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// uniqueViolation is the PostgreSQL error code for a unique constraint violation
+const uniqueViolation = "23505"
+
+// BlogRepository implements the blogs.BlogRepository interface using PostgreSQL
+type BlogRepository struct {
+	postgres.BaseRepository // Embed the base repository for common functionality
+}
+
+// NewBlogRepository creates a new PostgreSQL blogs repository
+func NewBlogRepository(db *pgxpool.Pool) *BlogRepository {
+	return &BlogRepository{
+		BaseRepository: postgres.NewBaseRepository(db),
+	}
+}
+
+// Create inserts a new blog into the database
+func (r *BlogRepository) Create(ctx context.Context, blog *domain.Blog) error {
+	query, args, err := r.SB.
+		Insert("blogs").
+		Columns("id", "name", "slug", "host", "created_at", "updated_at").
+		Values(
+			pgtype.UUID{Bytes: blog.ID, Valid: true},
+			blog.Name,
+			blog.Slug,
+			toNullableText(blog.Host),
+			pgtype.Timestamptz{Time: blog.CreatedAt, Valid: true},
+			pgtype.Timestamptz{Time: blog.UpdatedAt, Valid: true},
+		).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("BlogRepository.Create: build query: %w", err)
+	}
+
+	if _, err := r.DB.Exec(ctx, query, args...); err != nil {
+		if isUniqueViolation(err) {
+			return ports.ErrBlogConflict
+		}
+		return fmt.Errorf("BlogRepository.Create: %w", err)
+	}
+
+	return nil
+}
+
+// Update saves changes to an existing blog
+func (r *BlogRepository) Update(ctx context.Context, blog *domain.Blog) error {
+	query, args, err := r.SB.
+		Update("blogs").
+		Set("name", blog.Name).
+		Set("slug", blog.Slug).
+		Set("host", toNullableText(blog.Host)).
+		Set("updated_at", pgtype.Timestamptz{Time: blog.UpdatedAt, Valid: true}).
+		Where(sq.Eq{"id": pgtype.UUID{Bytes: blog.ID, Valid: true}}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("BlogRepository.Update: build query: %w", err)
+	}
+
+	result, err := r.DB.Exec(ctx, query, args...)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ports.ErrBlogConflict
+		}
+		return fmt.Errorf("BlogRepository.Update: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ports.ErrBlogNotFound
+	}
+
+	return nil
+}
+
+// FindByID retrieves a blog by its ID
+func (r *BlogRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Blog, error) {
+	return r.findOne(ctx, "BlogRepository.FindByID", sq.Eq{"id": pgtype.UUID{Bytes: id, Valid: true}})
+}
+
+// FindBySlug retrieves the blog selected by a path prefix
+func (r *BlogRepository) FindBySlug(ctx context.Context, slug string) (*domain.Blog, error) {
+	return r.findOne(ctx, "BlogRepository.FindBySlug", sq.Eq{"slug": slug})
+}
+
+// FindByHost retrieves the blog selected by a normalized host name
+func (r *BlogRepository) FindByHost(ctx context.Context, host string) (*domain.Blog, error) {
+	return r.findOne(ctx, "BlogRepository.FindByHost", sq.Eq{"host": host})
+}
+
+// List returns every blog ordered by name
+func (r *BlogRepository) List(ctx context.Context) ([]*domain.Blog, error) {
+	query, args, err := r.selectBlogs().
+		OrderBy("name ASC", "id ASC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("BlogRepository.List: build query: %w", err)
+	}
+
+	rows, err := r.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("BlogRepository.List: %w", err)
+	}
+	defer rows.Close()
+
+	var blogs []*domain.Blog
+	for rows.Next() {
+		blog, err := scanBlog(rows)
+		if err != nil {
+			return nil, fmt.Errorf("BlogRepository.List: scan: %w", err)
+		}
+		blogs = append(blogs, blog)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("BlogRepository.List: rows error: %w", err)
+	}
+
+	return blogs, nil
+}
+
+// Helper methods
+
+// selectBlogs builds the SELECT shared by all blog queries
+func (r *BlogRepository) selectBlogs() sq.SelectBuilder {
+	return r.SB.
+		Select("id", "name", "slug", "host", "created_at", "updated_at").
+		From("blogs")
+}
+
+// findOne retrieves the single blog matching where
+func (r *BlogRepository) findOne(ctx context.Context, op string, where sq.Sqlizer) (*domain.Blog, error) {
+	query, args, err := r.selectBlogs().Where(where).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	blog, err := scanBlog(r.DB.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrBlogNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return blog, nil
+}
+
+// scanBlog scans a single row into a domain.Blog
+func scanBlog(row pgx.Row) (*domain.Blog, error) {
+	var blog domain.Blog
+	var idBytes pgtype.UUID
+	var host pgtype.Text
+	var createdAt, updatedAt pgtype.Timestamptz
+
+	if err := row.Scan(&idBytes, &blog.Name, &blog.Slug, &host, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+
+	blog.ID = uuid.UUID(idBytes.Bytes)
+	blog.Host = host.String
+	blog.CreatedAt = createdAt.Time
+	blog.UpdatedAt = updatedAt.Time
+	return &blog, nil
+}
+
+// isUniqueViolation reports whether err was raised by a unique constraint
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation
+}
+
+// Compile-time check to ensure BlogRepository implements ports.BlogRepository
+var _ ports.BlogRepository = (*BlogRepository)(nil)
@@ -0,0 +1,184 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"backend/internal/bookmarks/domain"
+	"backend/internal/bookmarks/ports"
+	"backend/internal/platform/postgres"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// BookmarkRepository implements the bookmarks.BookmarkRepository interface using PostgreSQL
+type BookmarkRepository struct {
+	postgres.BaseRepository // Embed the base repository for common functionality
+}
+
+// NewBookmarkRepository creates a new PostgreSQL bookmarks repository
+func NewBookmarkRepository(db *pgxpool.Pool) *BookmarkRepository {
+	return &BookmarkRepository{
+		BaseRepository: postgres.NewBaseRepository(db),
+	}
+}
+
+// WithTx returns a new repository instance that uses the provided transaction
+func (r *BookmarkRepository) WithTx(tx pgx.Tx) *BookmarkRepository {
+	return &BookmarkRepository{
+		BaseRepository: r.BaseRepository.WithTx(tx),
+	}
+}
+
+// Save stores a bookmark, leaving an existing bookmark untouched
+func (r *BookmarkRepository) Save(ctx context.Context, bookmark *domain.Bookmark) error {
+	query, args, err := r.SB.
+		Insert("bookmarks").
+		Columns("user_id", "post_id", "created_at").
+		Values(
+			pgtype.UUID{Bytes: bookmark.UserID, Valid: true},
+			pgtype.UUID{Bytes: bookmark.PostID, Valid: true},
+			pgtype.Timestamptz{Time: bookmark.CreatedAt, Valid: true},
+		).
+		Suffix("ON CONFLICT (user_id, post_id) DO NOTHING").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("BookmarkRepository.Save: build query: %w", err)
+	}
+
+	_, err = r.DB.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("BookmarkRepository.Save: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes a user's bookmark on a post
+func (r *BookmarkRepository) Delete(ctx context.Context, userID, postID uuid.UUID) error {
+	query, args, err := r.SB.
+		Delete("bookmarks").
+		Where(sq.Eq{
+			"user_id": pgtype.UUID{Bytes: userID, Valid: true},
+			"post_id": pgtype.UUID{Bytes: postID, Valid: true},
+		}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("BookmarkRepository.Delete: build query: %w", err)
+	}
+
+	result, err := r.DB.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("BookmarkRepository.Delete: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ports.ErrBookmarkNotFound
+	}
+
+	return nil
+}
+
+// ListByUser retrieves a user's bookmarked published posts, most recently bookmarked first
+func (r *BookmarkRepository) ListByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*ports.BookmarkedPost, error) {
+	qb := r.SB.
+		Select(
+			"p.id", "p.title", "p.slug", "p.excerpt",
+			"p.author_id", "p.author_name",
+			"p.published_at", "b.created_at",
+		).
+		From("bookmarks b").
+		Join("posts p ON p.id = b.post_id").
+		Where(sq.Eq{
+			"b.user_id": pgtype.UUID{Bytes: userID, Valid: true},
+			"p.status":  "published",
+		}).
+		OrderBy("b.created_at DESC")
+
+	if limit > 0 {
+		qb = qb.Limit(uint64(limit))
+	}
+	if offset > 0 {
+		qb = qb.Offset(uint64(offset))
+	}
+
+	query, args, err := qb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("BookmarkRepository.ListByUser: build query: %w", err)
+	}
+
+	rows, err := r.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("BookmarkRepository.ListByUser: %w", err)
+	}
+	defer rows.Close()
+
+	var bookmarks []*ports.BookmarkedPost
+	for rows.Next() {
+		var bookmark ports.BookmarkedPost
+		var postIDBytes, authorIDBytes pgtype.UUID
+		var authorName pgtype.Text
+		var publishedAt pgtype.Timestamptz
+
+		err := rows.Scan(
+			&postIDBytes,
+			&bookmark.Title,
+			&bookmark.Slug,
+			&bookmark.Excerpt,
+			&authorIDBytes,
+			&authorName,
+			&publishedAt,
+			&bookmark.BookmarkedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("BookmarkRepository.ListByUser: scan: %w", err)
+		}
+
+		bookmark.PostID = uuid.UUID(postIDBytes.Bytes)
+		bookmark.AuthorID = uuid.UUID(authorIDBytes.Bytes)
+		if authorName.Valid {
+			bookmark.AuthorName = authorName.String
+		}
+		if publishedAt.Valid {
+			bookmark.PublishedAt = &publishedAt.Time
+		}
+
+		bookmarks = append(bookmarks, &bookmark)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("BookmarkRepository.ListByUser: rows error: %w", err)
+	}
+
+	return bookmarks, nil
+}
+
+// CountByUser returns the number of bookmarked published posts for a user
+func (r *BookmarkRepository) CountByUser(ctx context.Context, userID uuid.UUID) (int, error) {
+	query, args, err := r.SB.
+		Select("COUNT(*)").
+		From("bookmarks b").
+		Join("posts p ON p.id = b.post_id").
+		Where(sq.Eq{
+			"b.user_id": pgtype.UUID{Bytes: userID, Valid: true},
+			"p.status":  "published",
+		}).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("BookmarkRepository.CountByUser: build query: %w", err)
+	}
+
+	var count int
+	err = r.DB.QueryRow(ctx, query, args...).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("BookmarkRepository.CountByUser: %w", err)
+	}
+
+	return count, nil
+}
+
+// Compile-time check to ensure BookmarkRepository implements ports.BookmarkRepository
+var _ ports.BookmarkRepository = (*BookmarkRepository)(nil)
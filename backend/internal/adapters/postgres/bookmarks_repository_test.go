@@ -0,0 +1,56 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"backend/internal/adapters/postgres"
+	"backend/internal/bookmarks/domain"
+	"backend/internal/bookmarks/ports"
+	postsPorts "backend/internal/posts/ports"
+	"backend/internal/testing/factory"
+	"backend/internal/testing/pgtest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBookmarkRepository_ReadingList(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewBookmarkRepository(pgtest.Pool(t)).WithTx(tx)
+	posts := postgres.NewPostRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	author := factory.NewUser().WithRole("author").Create(t, tx)
+	reader := factory.NewUser().Create(t, tx)
+	published := factory.NewPost(author.ID).Published().Create(t, tx)
+	archived := factory.NewPost(author.ID).Published().Create(t, tx)
+
+	for _, postID := range []uuid.UUID{published.ID, archived.ID, published.ID} {
+		bookmark, err := domain.NewBookmark(reader.ID, postID)
+		require.NoError(t, err)
+		require.NoError(t, repo.Save(ctx, bookmark), "saving a bookmark again is a no-op")
+	}
+	_, err := tx.Exec(ctx, `UPDATE posts SET status = 'archived' WHERE id = $1`, archived.ID)
+	require.NoError(t, err)
+
+	list, err := repo.ListByUser(ctx, reader.ID, 20, 0)
+	require.NoError(t, err)
+	require.Len(t, list, 1, "posts no longer published drop off the reading list")
+	assert.Equal(t, published.ID, list[0].PostID)
+	count, err := repo.CountByUser(ctx, reader.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	// Listings flag the posts the viewer bookmarked
+	filter := postsPorts.DefaultListFilter()
+	filter.AuthorID = &author.ID
+	filter.ViewerID = &reader.ID
+	summaries, err := posts.ListSummaries(ctx, filter)
+	require.NoError(t, err)
+	require.Len(t, summaries, 1)
+	assert.True(t, summaries[0].Bookmarked)
+
+	require.NoError(t, repo.Delete(ctx, reader.ID, published.ID))
+	assert.ErrorIs(t, repo.Delete(ctx, reader.ID, published.ID), ports.ErrBookmarkNotFound)
+}
@@ -0,0 +1,208 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"backend/internal/platform/postgres"
+	"backend/internal/privacy/domain"
+	"backend/internal/privacy/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// dataExportColumns are the columns scanned by scanDataExport, in order; the archive is read on its own
+const dataExportColumns = `id, user_id, status, size, last_error, created_at, completed_at, expires_at`
+
+// DataExportRepository implements the privacy.DataExportRepository interface using PostgreSQL
+type DataExportRepository struct {
+	postgres.BaseRepository
+}
+
+// NewDataExportRepository creates a new PostgreSQL data export repository
+func NewDataExportRepository(db *pgxpool.Pool) *DataExportRepository {
+	return &DataExportRepository{
+		BaseRepository: postgres.NewBaseRepository(db),
+	}
+}
+
+// WithTx returns a new repository instance that uses the provided transaction
+func (r *DataExportRepository) WithTx(tx pgx.Tx) *DataExportRepository {
+	return &DataExportRepository{
+		BaseRepository: r.BaseRepository.WithTx(tx),
+	}
+}
+
+// Create stores a new export for an existing user
+func (r *DataExportRepository) Create(ctx context.Context, export *domain.Export) error {
+	result, err := r.DB.Exec(ctx, `
+		INSERT INTO data_exports (id, user_id, status, created_at)
+		SELECT $1, $2, $3, $4
+		WHERE EXISTS (SELECT 1 FROM users WHERE id = $2)`,
+		pgtype.UUID{Bytes: export.ID, Valid: true},
+		pgtype.UUID{Bytes: export.UserID, Valid: true},
+		string(export.Status),
+		export.CreatedAt,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ports.ErrExportPending
+		}
+		return fmt.Errorf("DataExportRepository.Create: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return ports.ErrSubjectNotFound
+	}
+	return nil
+}
+
+// FindByID retrieves an export without its archive
+func (r *DataExportRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Export, error) {
+	row := r.DB.QueryRow(ctx,
+		`SELECT `+dataExportColumns+` FROM data_exports WHERE id = $1`,
+		pgtype.UUID{Bytes: id, Valid: true},
+	)
+	export, err := scanDataExport(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrExportNotFound
+		}
+		return nil, fmt.Errorf("DataExportRepository.FindByID: %w", err)
+	}
+	return export, nil
+}
+
+// FindLatestByUser retrieves the user's most recent export without its archive
+func (r *DataExportRepository) FindLatestByUser(ctx context.Context, userID uuid.UUID) (*domain.Export, error) {
+	row := r.DB.QueryRow(ctx,
+		`SELECT `+dataExportColumns+` FROM data_exports WHERE user_id = $1 ORDER BY created_at DESC, id LIMIT 1`,
+		pgtype.UUID{Bytes: userID, Valid: true},
+	)
+	export, err := scanDataExport(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrExportNotFound
+		}
+		return nil, fmt.Errorf("DataExportRepository.FindLatestByUser: %w", err)
+	}
+	return export, nil
+}
+
+// ListPending retrieves the oldest exports waiting to be built
+func (r *DataExportRepository) ListPending(ctx context.Context, limit int) ([]*domain.Export, error) {
+	rows, err := r.DB.Query(ctx, `
+		SELECT `+dataExportColumns+` FROM data_exports
+		WHERE status = 'pending'
+		ORDER BY created_at, id
+		LIMIT $1`,
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("DataExportRepository.ListPending: %w", err)
+	}
+	defer rows.Close()
+
+	exports := make([]*domain.Export, 0)
+	for rows.Next() {
+		export, err := scanDataExport(rows)
+		if err != nil {
+			return nil, fmt.Errorf("DataExportRepository.ListPending: scan: %w", err)
+		}
+		exports = append(exports, export)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("DataExportRepository.ListPending: %w", err)
+	}
+	return exports, nil
+}
+
+// Update stores an export's state; a nil archive leaves the stored one alone
+func (r *DataExportRepository) Update(ctx context.Context, export *domain.Export, archive []byte) error {
+	result, err := r.DB.Exec(ctx, `
+		UPDATE data_exports
+		SET status = $2, size = $3, last_error = $4, completed_at = $5, expires_at = $6,
+			archive = COALESCE($7, archive)
+		WHERE id = $1`,
+		pgtype.UUID{Bytes: export.ID, Valid: true},
+		string(export.Status),
+		export.Size,
+		pgtype.Text{String: export.LastError, Valid: export.LastError != ""},
+		export.CompletedAt,
+		export.ExpiresAt,
+		archive,
+	)
+	if err != nil {
+		return fmt.Errorf("DataExportRepository.Update: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return ports.ErrExportNotFound
+	}
+	return nil
+}
+
+// FindArchive retrieves the archive of a ready export
+func (r *DataExportRepository) FindArchive(ctx context.Context, id uuid.UUID) ([]byte, error) {
+	var archive []byte
+	err := r.DB.QueryRow(ctx,
+		`SELECT archive FROM data_exports WHERE id = $1 AND status = 'ready' AND archive IS NOT NULL`,
+		pgtype.UUID{Bytes: id, Valid: true},
+	).Scan(&archive)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrExportNotFound
+		}
+		return nil, fmt.Errorf("DataExportRepository.FindArchive: %w", err)
+	}
+	return archive, nil
+}
+
+// ExpireArchives deletes the archives whose exports expired by now, keeping the rows
+func (r *DataExportRepository) ExpireArchives(ctx context.Context, now time.Time) (int, error) {
+	result, err := r.DB.Exec(ctx, `
+		UPDATE data_exports SET status = 'expired', archive = NULL
+		WHERE status = 'ready' AND expires_at <= $1`,
+		now,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("DataExportRepository.ExpireArchives: %w", err)
+	}
+	return int(result.RowsAffected()), nil
+}
+
+// DeleteByUser deletes every export of a user, archives and all
+func (r *DataExportRepository) DeleteByUser(ctx context.Context, userID uuid.UUID) (int, error) {
+	result, err := r.DB.Exec(ctx,
+		`DELETE FROM data_exports WHERE user_id = $1`,
+		pgtype.UUID{Bytes: userID, Valid: true},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("DataExportRepository.DeleteByUser: %w", err)
+	}
+	return int(result.RowsAffected()), nil
+}
+
+// scanDataExport reads the dataExportColumns of a row
+func scanDataExport(row pgx.Row) (*domain.Export, error) {
+	var id, userID pgtype.UUID
+	var status string
+	var lastError pgtype.Text
+	var export domain.Export
+	if err := row.Scan(
+		&id, &userID, &status, &export.Size, &lastError,
+		&export.CreatedAt, &export.CompletedAt, &export.ExpiresAt,
+	); err != nil {
+		return nil, err
+	}
+	export.ID = uuid.UUID(id.Bytes)
+	export.UserID = uuid.UUID(userID.Bytes)
+	export.Status = domain.ExportStatus(status)
+	export.LastError = lastError.String
+	return &export, nil
+}
+
+// Compile-time check to ensure DataExportRepository implements ports.DataExportRepository
+var _ ports.DataExportRepository = (*DataExportRepository)(nil)
@@ -0,0 +1,74 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"backend/internal/adapters/postgres"
+	"backend/internal/privacy/domain"
+	"backend/internal/privacy/ports"
+	"backend/internal/testing/factory"
+	"backend/internal/testing/pgtest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataExportRepository_Lifecycle(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewDataExportRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	user := factory.NewUser().Create(t, tx)
+
+	export := domain.NewExport(user.ID)
+	require.NoError(t, repo.Create(ctx, export))
+	assert.ErrorIs(t, repo.Create(ctx, domain.NewExport(user.ID)), ports.ErrExportPending, "one pending export per user")
+	assert.ErrorIs(t, repo.Create(ctx, domain.NewExport(uuid.New())), ports.ErrSubjectNotFound)
+
+	pending, err := repo.ListPending(ctx, 100)
+	require.NoError(t, err)
+	ids := make([]uuid.UUID, 0, len(pending))
+	for _, p := range pending {
+		ids = append(ids, p.ID)
+	}
+	assert.Contains(t, ids, export.ID)
+
+	_, err = repo.FindArchive(ctx, export.ID)
+	assert.ErrorIs(t, err, ports.ErrExportNotFound, "nothing to download before the export is ready")
+
+	now := time.Now()
+	archive := []byte("PK\x03\x04 archive")
+	require.NoError(t, export.Finish(int64(len(archive)), now, time.Hour))
+	require.NoError(t, repo.Update(ctx, export, archive))
+
+	found, err := repo.FindLatestByUser(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ExportReady, found.Status)
+	assert.Equal(t, int64(len(archive)), found.Size)
+	require.NotNil(t, found.ExpiresAt)
+
+	stored, err := repo.FindArchive(ctx, export.ID)
+	require.NoError(t, err)
+	assert.Equal(t, archive, stored)
+
+	expired, err := repo.ExpireArchives(ctx, now)
+	require.NoError(t, err)
+	assert.Zero(t, expired, "the archive has an hour left")
+	expired, err = repo.ExpireArchives(ctx, now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, expired)
+
+	found, err = repo.FindByID(ctx, export.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ExportExpired, found.Status)
+	_, err = repo.FindArchive(ctx, export.ID)
+	assert.ErrorIs(t, err, ports.ErrExportNotFound)
+
+	deleted, err := repo.DeleteByUser(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+	_, err = repo.FindLatestByUser(ctx, user.ID)
+	assert.ErrorIs(t, err, ports.ErrExportNotFound)
+}
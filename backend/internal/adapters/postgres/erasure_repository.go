@@ -0,0 +1,311 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"backend/internal/platform/postgres"
+	"backend/internal/privacy/domain"
+	"backend/internal/privacy/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// erasureRequestColumns are the columns scanned by scanErasureRequest, in order
+const erasureRequestColumns = `id, subject_id, requested_by, pseudonym, status, total_steps, last_error, created_at, started_at, completed_at`
+
+// ErasureRepository implements the privacy.ErasureRepository interface using PostgreSQL
+type ErasureRepository struct {
+	postgres.BaseRepository
+}
+
+// NewErasureRepository creates a new PostgreSQL erasure request repository
+func NewErasureRepository(db *pgxpool.Pool) *ErasureRepository {
+	return &ErasureRepository{
+		BaseRepository: postgres.NewBaseRepository(db),
+	}
+}
+
+// WithTx returns a new repository instance that uses the provided transaction
+func (r *ErasureRepository) WithTx(tx pgx.Tx) *ErasureRepository {
+	return &ErasureRepository{
+		BaseRepository: r.BaseRepository.WithTx(tx),
+	}
+}
+
+// Create stores a new request for an existing user
+func (r *ErasureRepository) Create(ctx context.Context, request *domain.Request) error {
+	result, err := r.DB.Exec(ctx, `
+		INSERT INTO erasure_requests (id, subject_id, requested_by, pseudonym, status, created_at)
+		SELECT $1, $2, $3, $4, $5, $6
+		WHERE EXISTS (SELECT 1 FROM users WHERE id = $2)`,
+		pgtype.UUID{Bytes: request.ID, Valid: true},
+		pgtype.UUID{Bytes: request.SubjectID, Valid: true},
+		pgtype.UUID{Bytes: request.RequestedBy, Valid: request.RequestedBy != uuid.Nil},
+		request.Pseudonym,
+		string(request.Status),
+		request.CreatedAt,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ports.ErrRequestOpen
+		}
+		return fmt.Errorf("ErasureRepository.Create: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return ports.ErrSubjectNotFound
+	}
+	return nil
+}
+
+// FindByID retrieves a request with its finished steps
+func (r *ErasureRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Request, error) {
+	row := r.DB.QueryRow(ctx,
+		`SELECT `+erasureRequestColumns+` FROM erasure_requests WHERE id = $1`,
+		pgtype.UUID{Bytes: id, Valid: true},
+	)
+	return r.findOne(ctx, row, "FindByID")
+}
+
+// FindLatestBySubject retrieves the subject's most recent request
+func (r *ErasureRepository) FindLatestBySubject(ctx context.Context, subjectID uuid.UUID) (*domain.Request, error) {
+	row := r.DB.QueryRow(ctx,
+		`SELECT `+erasureRequestColumns+` FROM erasure_requests WHERE subject_id = $1 ORDER BY created_at DESC, id LIMIT 1`,
+		pgtype.UUID{Bytes: subjectID, Valid: true},
+	)
+	return r.findOne(ctx, row, "FindLatestBySubject")
+}
+
+// List retrieves the requests matching filter, newest first
+func (r *ErasureRepository) List(ctx context.Context, filter ports.ListFilter) ([]*domain.Request, error) {
+	rows, err := r.DB.Query(ctx, `
+		SELECT `+erasureRequestColumns+` FROM erasure_requests
+		WHERE ($1::text = '' OR status = $1)
+		ORDER BY created_at DESC, id
+		LIMIT $2 OFFSET $3`,
+		string(filter.Status), filter.Limit, filter.Offset,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ErasureRepository.List: %w", err)
+	}
+	return r.collect(ctx, rows, "List")
+}
+
+// ListRunnable retrieves the oldest pending and running requests
+func (r *ErasureRepository) ListRunnable(ctx context.Context, limit int) ([]*domain.Request, error) {
+	rows, err := r.DB.Query(ctx, `
+		SELECT `+erasureRequestColumns+` FROM erasure_requests
+		WHERE status IN ('pending', 'running')
+		ORDER BY created_at, id
+		LIMIT $1`,
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ErasureRepository.ListRunnable: %w", err)
+	}
+	return r.collect(ctx, rows, "ListRunnable")
+}
+
+// Update stores a request's status, progress total, error and timestamps
+func (r *ErasureRepository) Update(ctx context.Context, request *domain.Request) error {
+	result, err := r.DB.Exec(ctx, `
+		UPDATE erasure_requests
+		SET status = $2, total_steps = $3, last_error = $4, started_at = $5, completed_at = $6
+		WHERE id = $1`,
+		pgtype.UUID{Bytes: request.ID, Valid: true},
+		string(request.Status),
+		request.TotalSteps,
+		pgtype.Text{String: request.LastError, Valid: request.LastError != ""},
+		request.StartedAt,
+		request.CompletedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("ErasureRepository.Update: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return ports.ErrRequestNotFound
+	}
+	return nil
+}
+
+// RecordStep stores a finished eraser; recording it again keeps the first record
+func (r *ErasureRepository) RecordStep(ctx context.Context, requestID uuid.UUID, step domain.Step) error {
+	_, err := r.DB.Exec(ctx, `
+		INSERT INTO erasure_request_steps (request_id, name, records, completed_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (request_id, name) DO NOTHING`,
+		pgtype.UUID{Bytes: requestID, Valid: true}, step.Name, step.Records, step.CompletedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("ErasureRepository.RecordStep: %w", err)
+	}
+	return nil
+}
+
+// certificateStep is how a step is kept in a certificate's steps column
+type certificateStep struct {
+	Name        string    `json:"name"`
+	Records     int       `json:"records"`
+	CompletedAt time.Time `json:"completedAt"`
+}
+
+// SaveCertificate stores the certificate of a completed request
+func (r *ErasureRepository) SaveCertificate(ctx context.Context, certificate *domain.Certificate) error {
+	steps := make([]certificateStep, len(certificate.Steps))
+	for i, step := range certificate.Steps {
+		steps[i] = certificateStep{Name: step.Name, Records: step.Records, CompletedAt: step.CompletedAt}
+	}
+	raw, err := json.Marshal(steps)
+	if err != nil {
+		return fmt.Errorf("ErasureRepository.SaveCertificate: marshal steps: %w", err)
+	}
+
+	_, err = r.DB.Exec(ctx, `
+		INSERT INTO erasure_certificates (id, request_id, subject_id, steps, requested_at, completed_at, digest)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		pgtype.UUID{Bytes: certificate.ID, Valid: true},
+		pgtype.UUID{Bytes: certificate.RequestID, Valid: true},
+		pgtype.UUID{Bytes: certificate.SubjectID, Valid: true},
+		raw,
+		certificate.RequestedAt,
+		certificate.CompletedAt,
+		certificate.Digest,
+	)
+	if err != nil {
+		return fmt.Errorf("ErasureRepository.SaveCertificate: %w", err)
+	}
+	return nil
+}
+
+// FindCertificate retrieves the certificate of a request
+func (r *ErasureRepository) FindCertificate(ctx context.Context, requestID uuid.UUID) (*domain.Certificate, error) {
+	var id, reqID, subjectID pgtype.UUID
+	var raw []byte
+	var certificate domain.Certificate
+	err := r.DB.QueryRow(ctx, `
+		SELECT id, request_id, subject_id, steps, requested_at, completed_at, digest
+		FROM erasure_certificates WHERE request_id = $1`,
+		pgtype.UUID{Bytes: requestID, Valid: true},
+	).Scan(&id, &reqID, &subjectID, &raw, &certificate.RequestedAt, &certificate.CompletedAt, &certificate.Digest)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrCertificateNotFound
+		}
+		return nil, fmt.Errorf("ErasureRepository.FindCertificate: %w", err)
+	}
+
+	var steps []certificateStep
+	if err := json.Unmarshal(raw, &steps); err != nil {
+		return nil, fmt.Errorf("ErasureRepository.FindCertificate: unmarshal steps: %w", err)
+	}
+	certificate.ID = uuid.UUID(id.Bytes)
+	certificate.RequestID = uuid.UUID(reqID.Bytes)
+	certificate.SubjectID = uuid.UUID(subjectID.Bytes)
+	certificate.Steps = make([]domain.Step, len(steps))
+	for i, step := range steps {
+		certificate.Steps[i] = domain.Step{Name: step.Name, Records: step.Records, CompletedAt: step.CompletedAt}
+	}
+	return &certificate, nil
+}
+
+// findOne scans a single request and loads its steps
+func (r *ErasureRepository) findOne(ctx context.Context, row pgx.Row, method string) (*domain.Request, error) {
+	request, err := scanErasureRequest(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrRequestNotFound
+		}
+		return nil, fmt.Errorf("ErasureRepository.%s: %w", method, err)
+	}
+	if err := r.loadSteps(ctx, []*domain.Request{request}); err != nil {
+		return nil, fmt.Errorf("ErasureRepository.%s: %w", method, err)
+	}
+	return request, nil
+}
+
+// collect scans a list of requests and loads their steps
+func (r *ErasureRepository) collect(ctx context.Context, rows pgx.Rows, method string) ([]*domain.Request, error) {
+	defer rows.Close()
+
+	requests := make([]*domain.Request, 0)
+	for rows.Next() {
+		request, err := scanErasureRequest(rows)
+		if err != nil {
+			return nil, fmt.Errorf("ErasureRepository.%s: scan: %w", method, err)
+		}
+		requests = append(requests, request)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ErasureRepository.%s: %w", method, err)
+	}
+	rows.Close()
+
+	if err := r.loadSteps(ctx, requests); err != nil {
+		return nil, fmt.Errorf("ErasureRepository.%s: %w", method, err)
+	}
+	return requests, nil
+}
+
+// loadSteps fills in the finished steps of requests, in the order they finished
+func (r *ErasureRepository) loadSteps(ctx context.Context, requests []*domain.Request) error {
+	if len(requests) == 0 {
+		return nil
+	}
+	ids := make([]pgtype.UUID, len(requests))
+	byID := make(map[uuid.UUID]*domain.Request, len(requests))
+	for i, request := range requests {
+		ids[i] = pgtype.UUID{Bytes: request.ID, Valid: true}
+		byID[request.ID] = request
+	}
+
+	rows, err := r.DB.Query(ctx, `
+		SELECT request_id, name, records, completed_at
+		FROM erasure_request_steps
+		WHERE request_id = ANY($1)
+		ORDER BY completed_at, name`,
+		ids,
+	)
+	if err != nil {
+		return fmt.Errorf("load steps: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var requestID pgtype.UUID
+		var step domain.Step
+		if err := rows.Scan(&requestID, &step.Name, &step.Records, &step.CompletedAt); err != nil {
+			return fmt.Errorf("load steps: scan: %w", err)
+		}
+		request := byID[uuid.UUID(requestID.Bytes)]
+		request.Steps = append(request.Steps, step)
+	}
+	return rows.Err()
+}
+
+// scanErasureRequest reads the erasureRequestColumns of a row
+func scanErasureRequest(row pgx.Row) (*domain.Request, error) {
+	var id, subjectID, requestedBy pgtype.UUID
+	var status string
+	var lastError pgtype.Text
+	var request domain.Request
+	if err := row.Scan(
+		&id, &subjectID, &requestedBy, &request.Pseudonym, &status, &request.TotalSteps,
+		&lastError, &request.CreatedAt, &request.StartedAt, &request.CompletedAt,
+	); err != nil {
+		return nil, err
+	}
+	request.ID = uuid.UUID(id.Bytes)
+	request.SubjectID = uuid.UUID(subjectID.Bytes)
+	request.RequestedBy = uuid.UUID(requestedBy.Bytes) // uuid.Nil once the requester is deleted
+	request.Status = domain.Status(status)
+	request.LastError = lastError.String
+	return &request, nil
+}
+
+// Compile-time check to ensure ErasureRepository implements ports.ErasureRepository
+var _ ports.ErasureRepository = (*ErasureRepository)(nil)
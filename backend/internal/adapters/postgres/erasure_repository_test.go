@@ -0,0 +1,90 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"backend/internal/adapters/postgres"
+	"backend/internal/privacy/domain"
+	"backend/internal/privacy/ports"
+	"backend/internal/testing/factory"
+	"backend/internal/testing/pgtest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErasureRepository_RequestLifecycle(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewErasureRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	admin := factory.NewUser().Create(t, tx)
+	subject := factory.NewUser().Create(t, tx)
+
+	request, err := domain.NewRequest(subject.ID, admin.ID)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, request))
+
+	again, err := domain.NewRequest(subject.ID, subject.ID)
+	require.NoError(t, err)
+	assert.ErrorIs(t, repo.Create(ctx, again), ports.ErrRequestOpen, "one open request per subject")
+
+	missing, err := domain.NewRequest(uuid.New(), admin.ID)
+	require.NoError(t, err)
+	assert.ErrorIs(t, repo.Create(ctx, missing), ports.ErrSubjectNotFound)
+
+	runnable, err := repo.ListRunnable(ctx, 100)
+	require.NoError(t, err)
+	assert.Contains(t, requestIDs(runnable), request.ID)
+
+	now := time.Now()
+	require.NoError(t, request.Start(2, now))
+	require.NoError(t, repo.Update(ctx, request))
+	require.NoError(t, repo.RecordStep(ctx, request.ID, request.CompleteStep("users", 1, now)))
+	require.NoError(t, repo.RecordStep(ctx, request.ID, request.CompleteStep("posts", 4, now.Add(time.Second))))
+
+	found, err := repo.FindByID(ctx, request.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusRunning, found.Status)
+	assert.Equal(t, request.Pseudonym, found.Pseudonym)
+	require.Len(t, found.Steps, 2)
+	assert.Equal(t, "users", found.Steps[0].Name, "steps in the order they finished")
+	assert.Equal(t, 4, found.Steps[1].Records)
+
+	_, err = repo.FindCertificate(ctx, request.ID)
+	assert.ErrorIs(t, err, ports.ErrCertificateNotFound)
+
+	certificate, err := found.Complete([]string{"users", "posts"}, now.Add(2*time.Second))
+	require.NoError(t, err)
+	require.NoError(t, repo.SaveCertificate(ctx, certificate))
+	require.NoError(t, repo.Update(ctx, found))
+
+	stored, err := repo.FindCertificate(ctx, request.ID)
+	require.NoError(t, err)
+	assert.Equal(t, certificate.Digest, stored.Digest)
+	assert.NoError(t, stored.Verify(), "a stored certificate still matches its digest")
+
+	latest, err := repo.FindLatestBySubject(ctx, subject.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusCompleted, latest.Status)
+
+	runnable, err = repo.ListRunnable(ctx, 100)
+	require.NoError(t, err)
+	assert.NotContains(t, requestIDs(runnable), request.ID)
+
+	require.NoError(t, repo.Create(ctx, again), "a completed request no longer blocks a new one")
+	completed, err := repo.List(ctx, ports.ListFilter{Status: domain.StatusCompleted, Limit: 100})
+	require.NoError(t, err)
+	assert.Contains(t, requestIDs(completed), request.ID)
+	assert.NotContains(t, requestIDs(completed), again.ID)
+}
+
+func requestIDs(requests []*domain.Request) []uuid.UUID {
+	ids := make([]uuid.UUID, len(requests))
+	for i, request := range requests {
+		ids[i] = request.ID
+	}
+	return ids
+}
@@ -0,0 +1,208 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"backend/internal/export/ports"
+	"backend/internal/platform/postgres"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ExportRepository implements the export.ExportRepository interface using PostgreSQL
+type ExportRepository struct {
+	postgres.BaseRepository // Embed the base repository for common functionality
+}
+
+// NewExportRepository creates a new PostgreSQL export repository
+func NewExportRepository(db *pgxpool.Pool) *ExportRepository {
+	return &ExportRepository{
+		BaseRepository: postgres.NewBaseRepository(db),
+	}
+}
+
+// exportPostColumns are the columns scanned by scanExportPost, in order
+var exportPostColumns = []string{
+	"p.id", "p.title", "p.slug", "p.content", "p.excerpt", "p.status",
+	"p.author_id", "u.username",
+	"p.language", "p.translation_group_id", "p.featured",
+	"p.meta_title", "p.meta_description", "p.canonical_url", "p.og_image_url",
+	"p.published_at", "p.created_at", "p.updated_at",
+}
+
+// StreamPosts yields every post of the request's blog, oldest first, one row at a time
+func (r *ExportRepository) StreamPosts(ctx context.Context, fn func(*ports.Post) error) error {
+	query, args, err := r.SB.
+		Select(exportPostColumns...).
+		From("posts p").
+		LeftJoin("users u ON p.author_id = u.id").
+		Where(sq.Eq{"p.blog_id": currentBlogID(ctx)}).
+		OrderBy("p.created_at ASC", "p.id ASC").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("ExportRepository.StreamPosts: build query: %w", err)
+	}
+
+	rows, err := r.DB.Query(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("ExportRepository.StreamPosts: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		post, err := scanExportPost(rows)
+		if err != nil {
+			return fmt.Errorf("ExportRepository.StreamPosts: scan: %w", err)
+		}
+		if err := fn(post); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("ExportRepository.StreamPosts: rows error: %w", err)
+	}
+
+	return nil
+}
+
+// FindPost retrieves one post of the request's blog regardless of its status
+func (r *ExportRepository) FindPost(ctx context.Context, id uuid.UUID) (*ports.Post, error) {
+	query, args, err := r.SB.
+		Select(exportPostColumns...).
+		From("posts p").
+		LeftJoin("users u ON p.author_id = u.id").
+		Where(sq.Eq{"p.id": pgtype.UUID{Bytes: id, Valid: true}, "p.blog_id": currentBlogID(ctx)}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("ExportRepository.FindPost: build query: %w", err)
+	}
+
+	post, err := scanExportPost(r.DB.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrPostNotFound
+		}
+		return nil, fmt.Errorf("ExportRepository.FindPost: %w", err)
+	}
+	return post, nil
+}
+
+// scanExportPost reads a post from a row of exportPostColumns
+func scanExportPost(row pgx.Row) (*ports.Post, error) {
+	var post ports.Post
+	var idBytes, authorIDBytes, translationGroupID pgtype.UUID
+	var authorName, metaTitle, metaDescription, canonicalURL, ogImageURL pgtype.Text
+	var publishedAt pgtype.Timestamptz
+
+	err := row.Scan(
+		&idBytes,
+		&post.Title,
+		&post.Slug,
+		&post.Content,
+		&post.Excerpt,
+		&post.Status,
+		&authorIDBytes,
+		&authorName,
+		&post.Language,
+		&translationGroupID,
+		&post.Featured,
+		&metaTitle,
+		&metaDescription,
+		&canonicalURL,
+		&ogImageURL,
+		&publishedAt,
+		&post.CreatedAt,
+		&post.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	post.ID = uuid.UUID(idBytes.Bytes)
+	post.AuthorID = uuid.UUID(authorIDBytes.Bytes)
+	post.AuthorName = authorName.String
+	post.MetaTitle = metaTitle.String
+	post.MetaDescription = metaDescription.String
+	post.CanonicalURL = canonicalURL.String
+	post.OGImageURL = ogImageURL.String
+	if translationGroupID.Valid {
+		groupID := uuid.UUID(translationGroupID.Bytes)
+		post.TranslationGroupID = &groupID
+	}
+	if publishedAt.Valid {
+		post.PublishedAt = &publishedAt.Time
+	}
+	return &post, nil
+}
+
+// StreamThemes yields every theme of the request's blog outside the trash with its articles, oldest first, one row at a time
+// Articles are aggregated into JSON so each theme arrives as a single row
+func (r *ExportRepository) StreamThemes(ctx context.Context, fn func(*ports.Theme) error) error {
+	query, args, err := r.SB.
+		Select(
+			"t.id", "t.name", "t.slug", "t.description",
+			"t.curator_id", "t.status", "t.created_at", "t.updated_at",
+			`COALESCE((
+				SELECT json_agg(json_build_object(
+					'postId', ta.post_id, 'position', ta.position, 'isPinned', ta.is_pinned,
+					'note', NULLIF(ta.note, '')
+				) ORDER BY ta.position)
+				FROM theme_articles ta WHERE ta.theme_id = t.id
+			), '[]'::json) AS articles`,
+		).
+		From("themes t").
+		Where(sq.Eq{"t.blog_id": currentBlogID(ctx), "t.deleted_at": nil}).
+		OrderBy("t.created_at ASC", "t.id ASC").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("ExportRepository.StreamThemes: build query: %w", err)
+	}
+
+	rows, err := r.DB.Query(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("ExportRepository.StreamThemes: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var theme ports.Theme
+		var idBytes, curatorIDBytes pgtype.UUID
+
+		err := rows.Scan(
+			&idBytes,
+			&theme.Name,
+			&theme.Slug,
+			&theme.Description,
+			&curatorIDBytes,
+			&theme.Status,
+			&theme.CreatedAt,
+			&theme.UpdatedAt,
+			&theme.Articles,
+		)
+		if err != nil {
+			return fmt.Errorf("ExportRepository.StreamThemes: scan: %w", err)
+		}
+
+		theme.ID = uuid.UUID(idBytes.Bytes)
+		theme.CuratorID = uuid.UUID(curatorIDBytes.Bytes)
+
+		if err := fn(&theme); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("ExportRepository.StreamThemes: rows error: %w", err)
+	}
+
+	return nil
+}
+
+// Compile-time check to ensure ExportRepository implements ports.ExportRepository
+var _ ports.ExportRepository = (*ExportRepository)(nil)
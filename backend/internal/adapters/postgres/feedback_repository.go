@@ -0,0 +1,202 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"backend/internal/feedback/domain"
+	"backend/internal/feedback/ports"
+	"backend/internal/platform/postgres"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// FeedbackRepository implements the feedback.FeedbackRepository interface using PostgreSQL
+// Messages are read and written only within the request's blog
+type FeedbackRepository struct {
+	postgres.BaseRepository // Embed the base repository for common functionality
+}
+
+// NewFeedbackRepository creates a new PostgreSQL feedback repository
+func NewFeedbackRepository(db *pgxpool.Pool) *FeedbackRepository {
+	return &FeedbackRepository{
+		BaseRepository: postgres.NewBaseRepository(db),
+	}
+}
+
+// WithTx returns a new repository instance that uses the provided transaction
+func (r *FeedbackRepository) WithTx(tx pgx.Tx) *FeedbackRepository {
+	return &FeedbackRepository{
+		BaseRepository: r.BaseRepository.WithTx(tx),
+	}
+}
+
+// Create inserts a new message into the current blog
+func (r *FeedbackRepository) Create(ctx context.Context, feedback *domain.Feedback) error {
+	query, args, err := r.SB.
+		Insert("feedback").
+		Columns("id", "blog_id", "name", "email", "subject", "message", "status", "spam_reason", "created_at", "updated_at").
+		Values(
+			pgtype.UUID{Bytes: feedback.ID, Valid: true},
+			currentBlogID(ctx),
+			feedback.Name,
+			feedback.Email,
+			feedback.Subject,
+			feedback.Message,
+			string(feedback.Status),
+			pgtype.Text{String: feedback.SpamReason, Valid: feedback.IsSpam()},
+			pgtype.Timestamptz{Time: feedback.CreatedAt, Valid: true},
+			pgtype.Timestamptz{Time: feedback.UpdatedAt, Valid: true},
+		).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("FeedbackRepository.Create: build query: %w", err)
+	}
+
+	if _, err := r.DB.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("FeedbackRepository.Create: %w", err)
+	}
+
+	return nil
+}
+
+// Update saves a message's status
+func (r *FeedbackRepository) Update(ctx context.Context, feedback *domain.Feedback) error {
+	query, args, err := r.SB.
+		Update("feedback").
+		Set("status", string(feedback.Status)).
+		Set("updated_at", pgtype.Timestamptz{Time: feedback.UpdatedAt, Valid: true}).
+		Where(sq.Eq{
+			"id":      pgtype.UUID{Bytes: feedback.ID, Valid: true},
+			"blog_id": currentBlogID(ctx),
+		}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("FeedbackRepository.Update: build query: %w", err)
+	}
+
+	result, err := r.DB.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("FeedbackRepository.Update: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ports.ErrFeedbackNotFound
+	}
+
+	return nil
+}
+
+// FindByID retrieves a message of the current blog
+func (r *FeedbackRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Feedback, error) {
+	query, args, err := r.selectFeedback(ctx).
+		Where(sq.Eq{"id": pgtype.UUID{Bytes: id, Valid: true}}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("FeedbackRepository.FindByID: build query: %w", err)
+	}
+
+	feedback, err := scanFeedback(r.DB.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrFeedbackNotFound
+		}
+		return nil, fmt.Errorf("FeedbackRepository.FindByID: %w", err)
+	}
+
+	return feedback, nil
+}
+
+// List retrieves messages of the current blog matching the filter, newest first
+func (r *FeedbackRepository) List(ctx context.Context, filter ports.ListFilter) ([]*domain.Feedback, int, error) {
+	where := sq.And{sq.Eq{"spam_reason": nil}}
+	if filter.Spam {
+		where = sq.And{sq.NotEq{"spam_reason": nil}}
+	}
+	if filter.Status != nil {
+		where = append(where, sq.Eq{"status": string(*filter.Status)})
+	}
+
+	countQuery, countArgs, err := r.SB.
+		Select("COUNT(*)").
+		From("feedback").
+		Where(sq.Eq{"blog_id": currentBlogID(ctx)}).
+		Where(where).
+		ToSql()
+	if err != nil {
+		return nil, 0, fmt.Errorf("FeedbackRepository.List: build count query: %w", err)
+	}
+
+	var total int
+	if err := r.DB.QueryRow(ctx, countQuery, countArgs...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("FeedbackRepository.List: count: %w", err)
+	}
+
+	query, args, err := r.selectFeedback(ctx).
+		Where(where).
+		OrderBy("created_at DESC", "id ASC").
+		Limit(uint64(filter.Limit)).
+		Offset(uint64(filter.Offset)).
+		ToSql()
+	if err != nil {
+		return nil, 0, fmt.Errorf("FeedbackRepository.List: build query: %w", err)
+	}
+
+	rows, err := r.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("FeedbackRepository.List: %w", err)
+	}
+	defer rows.Close()
+
+	var messages []*domain.Feedback
+	for rows.Next() {
+		feedback, err := scanFeedback(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("FeedbackRepository.List: scan: %w", err)
+		}
+		messages = append(messages, feedback)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("FeedbackRepository.List: rows error: %w", err)
+	}
+
+	return messages, total, nil
+}
+
+// Helper methods
+
+// selectFeedback builds the SELECT shared by all feedback queries, limited to the current blog
+func (r *FeedbackRepository) selectFeedback(ctx context.Context) sq.SelectBuilder {
+	return r.SB.
+		Select("id", "name", "email", "subject", "message", "status", "spam_reason", "created_at", "updated_at").
+		From("feedback").
+		Where(sq.Eq{"blog_id": currentBlogID(ctx)})
+}
+
+// scanFeedback scans a single row into a domain.Feedback
+func scanFeedback(row pgx.Row) (*domain.Feedback, error) {
+	var feedback domain.Feedback
+	var id pgtype.UUID
+	var status string
+	var spamReason pgtype.Text
+	var createdAt, updatedAt pgtype.Timestamptz
+
+	if err := row.Scan(&id, &feedback.Name, &feedback.Email, &feedback.Subject, &feedback.Message, &status, &spamReason, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+
+	feedback.ID = uuid.UUID(id.Bytes)
+	feedback.Status = domain.Status(status)
+	feedback.SpamReason = spamReason.String
+	feedback.CreatedAt = createdAt.Time
+	feedback.UpdatedAt = updatedAt.Time
+	return &feedback, nil
+}
+
+// Compile-time check to ensure FeedbackRepository implements ports.FeedbackRepository
+var _ ports.FeedbackRepository = (*FeedbackRepository)(nil)
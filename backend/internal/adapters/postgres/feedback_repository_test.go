@@ -0,0 +1,66 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"backend/internal/adapters/postgres"
+	"backend/internal/feedback/domain"
+	"backend/internal/feedback/ports"
+	"backend/internal/platform/tenant"
+	"backend/internal/testing/factory"
+	"backend/internal/testing/pgtest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeedbackRepository_List(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewFeedbackRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	create := func(ctx context.Context, message, spamReason string) *domain.Feedback {
+		feedback, err := domain.NewFeedback("Ada", "ada@example.com", "", message)
+		require.NoError(t, err)
+		if spamReason != "" {
+			feedback.Quarantine(spamReason)
+		}
+		require.NoError(t, repo.Create(ctx, feedback))
+		return feedback
+	}
+
+	first := create(ctx, "First", "")
+	create(ctx, "Second", "")
+	spam := create(ctx, "Buy now", "contains 5 links")
+	other := factory.NewBlog().Create(t, tx)
+	create(tenant.WithBlogID(ctx, other.ID), "Elsewhere", "")
+
+	require.NoError(t, first.Triage(domain.StatusClosed))
+	require.NoError(t, repo.Update(ctx, first))
+
+	messages, total, err := repo.List(ctx, ports.ListFilter{Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, 2, total, "only messages of this blog that passed the spam check are queued")
+	assert.Len(t, messages, 2)
+
+	status := domain.StatusNew
+	messages, total, err = repo.List(ctx, ports.ListFilter{Status: &status, Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, messages, 1)
+	assert.Equal(t, "Second", messages[0].Message)
+
+	messages, _, err = repo.List(ctx, ports.ListFilter{Spam: true, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	assert.Equal(t, spam.ID, messages[0].ID)
+	assert.Equal(t, "contains 5 links", messages[0].SpamReason)
+
+	found, err := repo.FindByID(ctx, first.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusClosed, found.Status)
+
+	_, err = repo.FindByID(ctx, uuid.New())
+	assert.ErrorIs(t, err, ports.ErrFeedbackNotFound)
+}
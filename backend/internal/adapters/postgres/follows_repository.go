@@ -0,0 +1,171 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"backend/internal/follows/domain"
+	"backend/internal/follows/ports"
+	"backend/internal/platform/postgres"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// FollowRepository implements the follows.FollowRepository interface using PostgreSQL
+type FollowRepository struct {
+	postgres.BaseRepository // Embed the base repository for common functionality
+}
+
+// NewFollowRepository creates a new PostgreSQL follows repository
+func NewFollowRepository(db *pgxpool.Pool) *FollowRepository {
+	return &FollowRepository{
+		BaseRepository: postgres.NewBaseRepository(db),
+	}
+}
+
+// Save stores a follow, leaving an existing follow untouched
+func (r *FollowRepository) Save(ctx context.Context, follow *domain.Follow) error {
+	query, args, err := r.SB.
+		Insert("follows").
+		Columns("follower_id", "followee_id", "created_at").
+		Values(
+			pgtype.UUID{Bytes: follow.FollowerID, Valid: true},
+			pgtype.UUID{Bytes: follow.FolloweeID, Valid: true},
+			pgtype.Timestamptz{Time: follow.CreatedAt, Valid: true},
+		).
+		Suffix("ON CONFLICT (follower_id, followee_id) DO NOTHING").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("FollowRepository.Save: build query: %w", err)
+	}
+
+	_, err = r.DB.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("FollowRepository.Save: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes a follow relationship
+func (r *FollowRepository) Delete(ctx context.Context, followerID, followeeID uuid.UUID) error {
+	query, args, err := r.SB.
+		Delete("follows").
+		Where(sq.Eq{
+			"follower_id": pgtype.UUID{Bytes: followerID, Valid: true},
+			"followee_id": pgtype.UUID{Bytes: followeeID, Valid: true},
+		}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("FollowRepository.Delete: build query: %w", err)
+	}
+
+	result, err := r.DB.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("FollowRepository.Delete: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ports.ErrFollowNotFound
+	}
+
+	return nil
+}
+
+// Exists reports whether followerID follows followeeID
+func (r *FollowRepository) Exists(ctx context.Context, followerID, followeeID uuid.UUID) (bool, error) {
+	subQuery, args, err := r.SB.
+		Select("1").
+		From("follows").
+		Where(sq.Eq{
+			"follower_id": pgtype.UUID{Bytes: followerID, Valid: true},
+			"followee_id": pgtype.UUID{Bytes: followeeID, Valid: true},
+		}).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("FollowRepository.Exists: build subquery: %w", err)
+	}
+
+	var exists bool
+	err = r.DB.QueryRow(ctx, fmt.Sprintf("SELECT EXISTS(%s)", subQuery), args...).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("FollowRepository.Exists: %w", err)
+	}
+
+	return exists, nil
+}
+
+// CountFollowers returns how many users follow the user
+func (r *FollowRepository) CountFollowers(ctx context.Context, userID uuid.UUID) (int, error) {
+	count, err := r.count(ctx, "followee_id", userID)
+	if err != nil {
+		return 0, fmt.Errorf("FollowRepository.CountFollowers: %w", err)
+	}
+	return count, nil
+}
+
+// CountFollowing returns how many users the user follows
+func (r *FollowRepository) CountFollowing(ctx context.Context, userID uuid.UUID) (int, error) {
+	count, err := r.count(ctx, "follower_id", userID)
+	if err != nil {
+		return 0, fmt.Errorf("FollowRepository.CountFollowing: %w", err)
+	}
+	return count, nil
+}
+
+// ListFollowerIDs returns the IDs of every follower of the user
+func (r *FollowRepository) ListFollowerIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	query, args, err := r.SB.
+		Select("follower_id").
+		From("follows").
+		Where(sq.Eq{"followee_id": pgtype.UUID{Bytes: userID, Valid: true}}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("FollowRepository.ListFollowerIDs: build query: %w", err)
+	}
+
+	rows, err := r.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("FollowRepository.ListFollowerIDs: %w", err)
+	}
+	defer rows.Close()
+
+	var followerIDs []uuid.UUID
+	for rows.Next() {
+		var idBytes pgtype.UUID
+		if err := rows.Scan(&idBytes); err != nil {
+			return nil, fmt.Errorf("FollowRepository.ListFollowerIDs: scan: %w", err)
+		}
+		followerIDs = append(followerIDs, uuid.UUID(idBytes.Bytes))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("FollowRepository.ListFollowerIDs: rows error: %w", err)
+	}
+
+	return followerIDs, nil
+}
+
+// count returns the number of follow rows where column matches the user
+func (r *FollowRepository) count(ctx context.Context, column string, userID uuid.UUID) (int, error) {
+	query, args, err := r.SB.
+		Select("COUNT(*)").
+		From("follows").
+		Where(sq.Eq{column: pgtype.UUID{Bytes: userID, Valid: true}}).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("build query: %w", err)
+	}
+
+	var count int
+	if err := r.DB.QueryRow(ctx, query, args...).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// Compile-time check to ensure FollowRepository implements ports.FollowRepository
+var _ ports.FollowRepository = (*FollowRepository)(nil)
@@ -0,0 +1,252 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"backend/internal/impersonation/domain"
+	"backend/internal/impersonation/ports"
+	"backend/internal/platform/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// impersonationSessionColumns are the columns scanned by scanImpersonationSession, in order
+const impersonationSessionColumns = `id, impersonator_id, target_id, reason, token_hash, created_at, expires_at, ended_at`
+
+// impersonationActionColumns are the columns scanned by scanImpersonationActions, in order
+const impersonationActionColumns = `id, session_id, impersonator_id, target_id, method, route, path, COALESCE(status, 0), request_id, occurred_at`
+
+// ImpersonationRepository implements the impersonation.SessionRepository interface using PostgreSQL
+type ImpersonationRepository struct {
+	postgres.BaseRepository
+}
+
+// NewImpersonationRepository creates a new PostgreSQL impersonation repository
+func NewImpersonationRepository(db *pgxpool.Pool) *ImpersonationRepository {
+	return &ImpersonationRepository{
+		BaseRepository: postgres.NewBaseRepository(db),
+	}
+}
+
+// WithTx returns a new repository instance that uses the provided transaction
+func (r *ImpersonationRepository) WithTx(tx pgx.Tx) *ImpersonationRepository {
+	return &ImpersonationRepository{
+		BaseRepository: r.BaseRepository.WithTx(tx),
+	}
+}
+
+// Create stores a newly started session in the current blog
+func (r *ImpersonationRepository) Create(ctx context.Context, session *domain.Session) error {
+	_, err := r.DB.Exec(ctx, `
+		INSERT INTO impersonation_sessions (id, blog_id, impersonator_id, target_id, reason, token_hash, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		pgtype.UUID{Bytes: session.ID, Valid: true},
+		currentBlogID(ctx),
+		pgtype.UUID{Bytes: session.ImpersonatorID, Valid: true},
+		pgtype.UUID{Bytes: session.TargetID, Valid: true},
+		session.Reason,
+		session.TokenHash,
+		session.CreatedAt,
+		session.ExpiresAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return ports.ErrUserNotFound
+		}
+		return fmt.Errorf("ImpersonationRepository.Create: %w", err)
+	}
+	return nil
+}
+
+// FindByID retrieves a session of the current blog
+func (r *ImpersonationRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Session, error) {
+	row := r.DB.QueryRow(ctx,
+		`SELECT `+impersonationSessionColumns+` FROM impersonation_sessions WHERE id = $1 AND blog_id = $2`,
+		pgtype.UUID{Bytes: id, Valid: true}, currentBlogID(ctx),
+	)
+	session, err := scanImpersonationSession(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrSessionNotFound
+		}
+		return nil, fmt.Errorf("ImpersonationRepository.FindByID: %w", err)
+	}
+	return session, nil
+}
+
+// FindByTokenHash retrieves the session of the current blog holding a token
+func (r *ImpersonationRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*domain.Session, error) {
+	row := r.DB.QueryRow(ctx,
+		`SELECT `+impersonationSessionColumns+` FROM impersonation_sessions WHERE token_hash = $1 AND blog_id = $2`,
+		tokenHash, currentBlogID(ctx),
+	)
+	session, err := scanImpersonationSession(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrSessionNotFound
+		}
+		return nil, fmt.Errorf("ImpersonationRepository.FindByTokenHash: %w", err)
+	}
+	return session, nil
+}
+
+// End stores the session's end time
+func (r *ImpersonationRepository) End(ctx context.Context, session *domain.Session) error {
+	result, err := r.DB.Exec(ctx,
+		`UPDATE impersonation_sessions SET ended_at = $1 WHERE id = $2 AND blog_id = $3`,
+		session.EndedAt, pgtype.UUID{Bytes: session.ID, Valid: true}, currentBlogID(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("ImpersonationRepository.End: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return ports.ErrSessionNotFound
+	}
+	return nil
+}
+
+// RecordAction appends a request to a session's audit trail. A zero status
+// is stored as NULL until CompleteAction fills it in.
+func (r *ImpersonationRepository) RecordAction(ctx context.Context, action *domain.Action) error {
+	err := r.DB.QueryRow(ctx, `
+		INSERT INTO impersonation_actions (session_id, impersonator_id, target_id, method, route, path, status, request_id, occurred_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, 0), $8, $9)
+		RETURNING id`,
+		pgtype.UUID{Bytes: action.SessionID, Valid: true},
+		pgtype.UUID{Bytes: action.ImpersonatorID, Valid: true},
+		pgtype.UUID{Bytes: action.TargetID, Valid: true},
+		action.Method,
+		action.Route,
+		action.Path,
+		action.Status,
+		action.RequestID,
+		action.OccurredAt,
+	).Scan(&action.ID)
+	if err != nil {
+		return fmt.Errorf("ImpersonationRepository.RecordAction: %w", err)
+	}
+	return nil
+}
+
+// CompleteAction stores the response status of a recorded request
+func (r *ImpersonationRepository) CompleteAction(ctx context.Context, action *domain.Action) error {
+	_, err := r.DB.Exec(ctx,
+		`UPDATE impersonation_actions SET status = $2 WHERE id = $1`,
+		action.ID,
+		action.Status,
+	)
+	if err != nil {
+		return fmt.Errorf("ImpersonationRepository.CompleteAction: %w", err)
+	}
+	return nil
+}
+
+// ListActions retrieves the requests made through a session, oldest first
+func (r *ImpersonationRepository) ListActions(ctx context.Context, sessionID uuid.UUID) ([]*domain.Action, error) {
+	rows, err := r.DB.Query(ctx,
+		`SELECT `+impersonationActionColumns+` FROM impersonation_actions WHERE session_id = $1 ORDER BY occurred_at, id`,
+		pgtype.UUID{Bytes: sessionID, Valid: true},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ImpersonationRepository.ListActions: %w", err)
+	}
+	actions, err := scanImpersonationActions(rows)
+	if err != nil {
+		return nil, fmt.Errorf("ImpersonationRepository.ListActions: %w", err)
+	}
+	return actions, nil
+}
+
+// ListActionsByUser retrieves the requests a user made as someone else, and
+// those made as them, in every blog, oldest first
+func (r *ImpersonationRepository) ListActionsByUser(ctx context.Context, userID uuid.UUID) ([]*domain.Action, error) {
+	rows, err := r.DB.Query(ctx,
+		`SELECT `+impersonationActionColumns+` FROM impersonation_actions
+		WHERE impersonator_id = $1 OR target_id = $1
+		ORDER BY occurred_at, id`,
+		pgtype.UUID{Bytes: userID, Valid: true},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ImpersonationRepository.ListActionsByUser: %w", err)
+	}
+	actions, err := scanImpersonationActions(rows)
+	if err != nil {
+		return nil, fmt.Errorf("ImpersonationRepository.ListActionsByUser: %w", err)
+	}
+	return actions, nil
+}
+
+// Pseudonymize replaces the paths a user's requests were recorded under with
+// their route patterns, which hold no usernames or other values, and redacts
+// the reasons of sessions targeting them. Rows already stripped are skipped.
+func (r *ImpersonationRepository) Pseudonymize(ctx context.Context, userID uuid.UUID) (int, error) {
+	query := `
+		WITH actions AS (
+			UPDATE impersonation_actions SET path = route
+			WHERE (impersonator_id = $1 OR target_id = $1) AND path <> route
+			RETURNING 1
+		),
+		sessions AS (
+			UPDATE impersonation_sessions SET reason = $2
+			WHERE target_id = $1 AND reason <> $2
+			RETURNING 1
+		)
+		SELECT (SELECT COUNT(*) FROM actions) + (SELECT COUNT(*) FROM sessions)`
+
+	var changed int
+	err := r.DB.QueryRow(ctx, query, pgtype.UUID{Bytes: userID, Valid: true}, domain.ErasedReason).Scan(&changed)
+	if err != nil {
+		return 0, fmt.Errorf("ImpersonationRepository.Pseudonymize: %w", err)
+	}
+	return changed, nil
+}
+
+// scanImpersonationSession reads the impersonationSessionColumns of a row
+func scanImpersonationSession(row pgx.Row) (*domain.Session, error) {
+	var id, impersonatorID, targetID pgtype.UUID
+	var session domain.Session
+	if err := row.Scan(
+		&id, &impersonatorID, &targetID, &session.Reason, &session.TokenHash,
+		&session.CreatedAt, &session.ExpiresAt, &session.EndedAt,
+	); err != nil {
+		return nil, err
+	}
+	session.ID = uuid.UUID(id.Bytes)
+	session.ImpersonatorID = uuid.UUID(impersonatorID.Bytes)
+	session.TargetID = uuid.UUID(targetID.Bytes)
+	return &session, nil
+}
+
+// scanImpersonationActions reads the impersonationActionColumns of every row and closes rows
+func scanImpersonationActions(rows pgx.Rows) ([]*domain.Action, error) {
+	defer rows.Close()
+
+	actions := make([]*domain.Action, 0)
+	for rows.Next() {
+		var sid, impersonatorID, targetID pgtype.UUID
+		var action domain.Action
+		if err := rows.Scan(
+			&action.ID, &sid, &impersonatorID, &targetID, &action.Method, &action.Route, &action.Path,
+			&action.Status, &action.RequestID, &action.OccurredAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+		action.SessionID = uuid.UUID(sid.Bytes)
+		action.ImpersonatorID = uuid.UUID(impersonatorID.Bytes)
+		action.TargetID = uuid.UUID(targetID.Bytes)
+		actions = append(actions, &action)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return actions, nil
+}
+
+// Compile-time check to ensure ImpersonationRepository implements ports.SessionRepository
+var _ ports.SessionRepository = (*ImpersonationRepository)(nil)
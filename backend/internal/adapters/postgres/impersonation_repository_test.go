@@ -0,0 +1,127 @@
+package postgres_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"backend/internal/adapters/postgres"
+	"backend/internal/impersonation/domain"
+	"backend/internal/impersonation/ports"
+	"backend/internal/testing/factory"
+	"backend/internal/testing/pgtest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImpersonationRepository_SessionLifecycle(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewImpersonationRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	admin := factory.NewUser().Create(t, tx)
+	author := factory.NewUser().Create(t, tx)
+
+	session, token, err := domain.NewSession(admin.ID, author.ID, "Reproduce ticket 42", 15*time.Minute)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, session))
+
+	found, err := repo.FindByTokenHash(ctx, domain.HashToken(token))
+	require.NoError(t, err)
+	assert.Equal(t, session.ID, found.ID)
+	assert.Equal(t, author.ID, found.TargetID)
+	assert.Nil(t, found.EndedAt)
+
+	for _, status := range []int{http.StatusOK, http.StatusForbidden} {
+		action := &domain.Action{
+			SessionID:      session.ID,
+			ImpersonatorID: admin.ID,
+			TargetID:       author.ID,
+			Method:         http.MethodGet,
+			Route:          "/api/v1/posts/{id}",
+			Path:           "/api/v1/posts/" + uuid.NewString(),
+			RequestID:      uuid.NewString(),
+			OccurredAt:     time.Now(),
+		}
+		require.NoError(t, repo.RecordAction(ctx, action))
+		assert.NotZero(t, action.ID)
+
+		pending, err := repo.ListActions(ctx, session.ID)
+		require.NoError(t, err)
+		assert.Zero(t, pending[len(pending)-1].Status, "recorded before the response is sent")
+
+		action.Status = status
+		require.NoError(t, repo.CompleteAction(ctx, action))
+	}
+	actions, err := repo.ListActions(ctx, session.ID)
+	require.NoError(t, err)
+	require.Len(t, actions, 2)
+	assert.Equal(t, http.StatusOK, actions[0].Status, "oldest first")
+	assert.Equal(t, http.StatusForbidden, actions[1].Status)
+	assert.Equal(t, admin.ID, actions[1].ImpersonatorID)
+
+	for _, userID := range []uuid.UUID{admin.ID, author.ID} {
+		byUser, err := repo.ListActionsByUser(ctx, userID)
+		require.NoError(t, err)
+		assert.Len(t, byUser, 2, "requests made by the impersonator and as the target")
+	}
+	byUser, err := repo.ListActionsByUser(ctx, uuid.New())
+	require.NoError(t, err)
+	assert.Empty(t, byUser)
+
+	require.NoError(t, session.End())
+	require.NoError(t, repo.End(ctx, session))
+	found, err = repo.FindByID(ctx, session.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, found.EndedAt)
+
+	_, err = repo.FindByTokenHash(ctx, domain.HashToken("abi_unknown"))
+	assert.ErrorIs(t, err, ports.ErrSessionNotFound)
+
+	session, _, err = domain.NewSession(admin.ID, uuid.New(), "Missing user", time.Minute)
+	require.NoError(t, err)
+	assert.ErrorIs(t, repo.Create(ctx, session), ports.ErrUserNotFound)
+}
+
+func TestImpersonationRepository_Pseudonymize(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewImpersonationRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	admin := factory.NewUser().Create(t, tx)
+	author := factory.NewUser().Create(t, tx)
+
+	session, _, err := domain.NewSession(admin.ID, author.ID, "Ada reported a broken editor", 15*time.Minute)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, session))
+	require.NoError(t, repo.RecordAction(ctx, &domain.Action{
+		SessionID:      session.ID,
+		ImpersonatorID: admin.ID,
+		TargetID:       author.ID,
+		Method:         http.MethodGet,
+		Route:          "/api/v1/users/{username}",
+		Path:           "/api/v1/users/ada",
+		Status:         http.StatusOK,
+		RequestID:      uuid.NewString(),
+		OccurredAt:     time.Now(),
+	}))
+
+	changed, err := repo.Pseudonymize(ctx, author.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, changed)
+
+	found, err := repo.FindByID(ctx, session.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ErasedReason, found.Reason)
+	actions, err := repo.ListActions(ctx, session.ID)
+	require.NoError(t, err)
+	require.Len(t, actions, 1)
+	assert.Equal(t, "/api/v1/users/{username}", actions[0].Path)
+	assert.Equal(t, author.ID, actions[0].TargetID, "the trail still shows who was impersonated")
+
+	changed, err = repo.Pseudonymize(ctx, author.ID)
+	require.NoError(t, err)
+	assert.Zero(t, changed, "running again changes nothing")
+}
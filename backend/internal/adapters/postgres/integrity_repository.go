@@ -0,0 +1,260 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"backend/internal/integrity/domain"
+	"backend/internal/integrity/ports"
+	"backend/internal/platform/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// integrityQuery holds the statements behind one check. Both select the holder
+// of the broken reference or wrong value, the missing row's ID and a detail,
+// in that order; checks of values select a NULL ID and references a NULL detail.
+type integrityQuery struct {
+	find   string
+	repair string
+	scope  func(ctx context.Context) pgtype.UUID
+}
+
+var integrityQueries = map[domain.Check]integrityQuery{
+	domain.CheckOrphanedThemeArticles: {
+		find: `
+			SELECT ta.theme_id, ta.post_id, NULL::text
+			FROM theme_articles ta
+			JOIN themes t ON t.id = ta.theme_id
+			WHERE t.blog_id = $1
+				AND NOT EXISTS (SELECT 1 FROM posts p WHERE p.id = ta.post_id)
+			ORDER BY ta.theme_id, ta.position
+		`,
+		repair: `
+			DELETE FROM theme_articles ta
+			USING themes t
+			WHERE t.id = ta.theme_id
+				AND t.blog_id = $1
+				AND NOT EXISTS (SELECT 1 FROM posts p WHERE p.id = ta.post_id)
+			RETURNING ta.theme_id, ta.post_id, NULL::text
+		`,
+		scope: currentBlogID,
+	},
+	domain.CheckDanglingUserRoles: {
+		find: `
+			SELECT ur.user_id, ur.role_id, NULL::text
+			FROM user_roles ur
+			WHERE ur.blog_id IS NOT DISTINCT FROM $1
+				AND NOT EXISTS (SELECT 1 FROM roles r WHERE r.id = ur.role_id)
+			ORDER BY ur.user_id, ur.role_id
+		`,
+		repair: `
+			DELETE FROM user_roles ur
+			WHERE ur.blog_id IS NOT DISTINCT FROM $1
+				AND NOT EXISTS (SELECT 1 FROM roles r WHERE r.id = ur.role_id)
+			RETURNING ur.user_id, ur.role_id, NULL::text
+		`,
+		scope: roleScope,
+	},
+	domain.CheckOrphanedPosts: {
+		find: `
+			SELECT p.id, p.author_id, NULL::text
+			FROM posts p
+			WHERE p.blog_id = $1
+				AND NOT EXISTS (SELECT 1 FROM users u WHERE u.id = p.author_id)
+			ORDER BY p.created_at, p.id
+		`,
+		repair: `
+			DELETE FROM posts p
+			WHERE p.blog_id = $1
+				AND NOT EXISTS (SELECT 1 FROM users u WHERE u.id = p.author_id)
+			RETURNING p.id, p.author_id, NULL::text
+		`,
+		scope: currentBlogID,
+	},
+	domain.CheckThemeArticleCounts: {
+		find: `
+			SELECT s.id, NULL::uuid, format('article_count is %s but the theme has %s articles', s.recorded, s.actual)
+			FROM (
+				SELECT t.id, t.created_at, t.article_count AS recorded,
+					(SELECT COUNT(*) FROM theme_articles ta WHERE ta.theme_id = t.id) AS actual
+				FROM themes t
+				WHERE t.blog_id = $1
+			) s
+			WHERE s.recorded <> s.actual
+			ORDER BY s.created_at, s.id
+		`,
+		repair: `
+			WITH counted AS (
+				SELECT t.id, t.article_count AS recorded,
+					(SELECT COUNT(*) FROM theme_articles ta WHERE ta.theme_id = t.id) AS actual
+				FROM themes t
+				WHERE t.blog_id = $1
+			)
+			UPDATE themes t
+			SET article_count = c.actual
+			FROM counted c
+			WHERE t.id = c.id AND c.recorded <> c.actual
+			RETURNING t.id, NULL::uuid, format('article_count was %s, recounted as %s', c.recorded, c.actual)
+		`,
+		scope: currentBlogID,
+	},
+	domain.CheckPublishedPosts: {
+		find: publishedPostDrift + `
+			ORDER BY post_id
+		`,
+		repair: `
+			WITH drift AS (` + publishedPostDrift + `),
+			removed AS (
+				DELETE FROM published_posts pp
+				WHERE pp.post_id IN (SELECT post_id FROM drift)
+					AND NOT EXISTS (SELECT 1 FROM posts p WHERE p.id = pp.post_id AND p.status = 'published')
+			),
+			refreshed AS (
+				INSERT INTO published_posts (` + publishedPostColumns + `)` +
+			publishedPostSource + ` AND p.id IN (SELECT post_id FROM drift)` +
+			publishedPostUpsert + `
+			)
+			SELECT post_id, NULL::uuid, detail FROM drift
+		`,
+		scope: currentBlogID,
+	},
+	domain.CheckPostAuthorNames: {
+		find: `
+			SELECT p.id, NULL::uuid, format('author_name is %s but the author is %s', COALESCE(p.author_name, 'unset'), u.username)
+			FROM posts p
+			JOIN users u ON u.id = p.author_id
+			WHERE p.blog_id = $1 AND p.author_name IS DISTINCT FROM u.username
+			ORDER BY p.created_at, p.id
+		`,
+		repair: `
+			WITH stale AS (
+				SELECT p.id, p.author_name AS recorded, u.username AS actual
+				FROM posts p
+				JOIN users u ON u.id = p.author_id
+				WHERE p.blog_id = $1 AND p.author_name IS DISTINCT FROM u.username
+			),
+			published AS (
+				UPDATE published_posts pp SET author_name = s.actual
+				FROM stale s WHERE pp.post_id = s.id
+			)
+			UPDATE posts p SET author_name = s.actual
+			FROM stale s
+			WHERE p.id = s.id
+			RETURNING p.id, NULL::uuid, format('author_name was %s, set to %s', COALESCE(s.recorded, 'unset'), s.actual)
+		`,
+		scope: currentBlogID,
+	},
+	domain.CheckThemeCuratorNames: {
+		find: `
+			SELECT t.id, NULL::uuid, format('curator_name is %s but the curator is %s', COALESCE(t.curator_name, 'unset'), u.username)
+			FROM themes t
+			JOIN users u ON u.id = t.curator_id
+			WHERE t.blog_id = $1 AND t.curator_name IS DISTINCT FROM u.username
+			ORDER BY t.created_at, t.id
+		`,
+		repair: `
+			WITH stale AS (
+				SELECT t.id, t.curator_name AS recorded, u.username AS actual
+				FROM themes t
+				JOIN users u ON u.id = t.curator_id
+				WHERE t.blog_id = $1 AND t.curator_name IS DISTINCT FROM u.username
+			)
+			UPDATE themes t SET curator_name = s.actual
+			FROM stale s
+			WHERE t.id = s.id
+			RETURNING t.id, NULL::uuid, format('curator_name was %s, set to %s', COALESCE(s.recorded, 'unset'), s.actual)
+		`,
+		scope: currentBlogID,
+	},
+}
+
+// publishedPostDrift selects the blog's posts whose read model row is missing,
+// outdated or no longer wanted. Every post change bumps updated_at; the author
+// name is compared as well because renaming the author leaves updated_at alone.
+const publishedPostDrift = `
+	SELECT p.id AS post_id, NULL::uuid,
+		CASE WHEN pp.post_id IS NULL THEN 'missing from the read model' ELSE 'outdated in the read model' END AS detail
+	FROM posts p
+	LEFT JOIN published_posts pp ON pp.post_id = p.id
+	WHERE p.blog_id = $1 AND p.status = 'published'
+		AND (pp.post_id IS NULL OR pp.updated_at <> p.updated_at OR pp.author_name IS DISTINCT FROM p.author_name)
+	UNION ALL
+	SELECT pp.post_id, NULL::uuid, format('kept in the read model while %s', p.status)
+	FROM published_posts pp
+	JOIN posts p ON p.id = pp.post_id
+	WHERE pp.blog_id = $1 AND p.status <> 'published'`
+
+// IntegrityRepository implements the integrity.IntegrityRepository interface using PostgreSQL
+type IntegrityRepository struct {
+	postgres.BaseRepository
+}
+
+// NewIntegrityRepository creates a new PostgreSQL integrity repository
+func NewIntegrityRepository(db *pgxpool.Pool) *IntegrityRepository {
+	return &IntegrityRepository{
+		BaseRepository: postgres.NewBaseRepository(db),
+	}
+}
+
+// WithTx returns a new repository instance that uses the provided transaction
+func (r *IntegrityRepository) WithTx(tx pgx.Tx) ports.IntegrityRepository {
+	return &IntegrityRepository{
+		BaseRepository: r.BaseRepository.WithTx(tx),
+	}
+}
+
+// FindViolations returns every row that fails the check
+func (r *IntegrityRepository) FindViolations(ctx context.Context, check domain.Check) ([]domain.Finding, error) {
+	q, ok := integrityQueries[check]
+	if !ok {
+		return nil, fmt.Errorf("IntegrityRepository.FindViolations: unknown check %q", check)
+	}
+
+	findings, err := r.collect(ctx, check, q.find, q.scope(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("IntegrityRepository.FindViolations: %s: %w", check, err)
+	}
+	return findings, nil
+}
+
+// Repair deletes every row that fails the check, or corrects its value, and returns the fixed rows
+func (r *IntegrityRepository) Repair(ctx context.Context, check domain.Check) ([]domain.Finding, error) {
+	q, ok := integrityQueries[check]
+	if !ok {
+		return nil, fmt.Errorf("IntegrityRepository.Repair: unknown check %q", check)
+	}
+
+	findings, err := r.collect(ctx, check, q.repair, q.scope(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("IntegrityRepository.Repair: %s: %w", check, err)
+	}
+	return findings, nil
+}
+
+// collect runs a check's statement and reads its (owner, missing, detail) rows
+func (r *IntegrityRepository) collect(ctx context.Context, check domain.Check, query string, scope pgtype.UUID) ([]domain.Finding, error) {
+	rows, err := r.DB.Query(ctx, query, scope)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	findings := make([]domain.Finding, 0)
+	for rows.Next() {
+		var ownerID, missingID pgtype.UUID
+		var detail pgtype.Text
+		if err := rows.Scan(&ownerID, &missingID, &detail); err != nil {
+			return nil, err
+		}
+		findings = append(findings, domain.Finding{
+			Check:     check,
+			OwnerID:   uuid.UUID(ownerID.Bytes),
+			MissingID: uuid.UUID(missingID.Bytes),
+			Detail:    detail.String,
+		})
+	}
+	return findings, rows.Err()
+}
@@ -0,0 +1,200 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"backend/internal/linkreports/domain"
+	"backend/internal/linkreports/ports"
+	"backend/internal/platform/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// LinkReportRepository implements the linkreports.LinkReportRepository interface using PostgreSQL
+type LinkReportRepository struct {
+	postgres.BaseRepository
+}
+
+// NewLinkReportRepository creates a new PostgreSQL link report repository
+func NewLinkReportRepository(db *pgxpool.Pool) *LinkReportRepository {
+	return &LinkReportRepository{
+		BaseRepository: postgres.NewBaseRepository(db),
+	}
+}
+
+// WithTx returns a new repository instance that uses the provided transaction
+func (r *LinkReportRepository) WithTx(tx pgx.Tx) ports.LinkReportRepository {
+	return &LinkReportRepository{
+		BaseRepository: r.BaseRepository.WithTx(tx),
+	}
+}
+
+// ListPublishedContent returns the content of every published post
+func (r *LinkReportRepository) ListPublishedContent(ctx context.Context) ([]ports.PostContent, error) {
+	rows, err := r.DB.Query(ctx, `
+		SELECT id, content FROM posts
+		WHERE blog_id = $1 AND status = 'published'
+		ORDER BY published_at, id`,
+		currentBlogID(ctx),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("LinkReportRepository.ListPublishedContent: %w", err)
+	}
+	defer rows.Close()
+
+	var posts []ports.PostContent
+	for rows.Next() {
+		var id pgtype.UUID
+		var post ports.PostContent
+		if err := rows.Scan(&id, &post.Content); err != nil {
+			return nil, fmt.Errorf("LinkReportRepository.ListPublishedContent: scan: %w", err)
+		}
+		post.ID = uuid.UUID(id.Bytes)
+		posts = append(posts, post)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("LinkReportRepository.ListPublishedContent: %w", err)
+	}
+	return posts, nil
+}
+
+// ReplaceLinks deletes the post's links and inserts the new ones in one batch
+func (r *LinkReportRepository) ReplaceLinks(ctx context.Context, postID uuid.UUID, links []domain.Link) error {
+	id := pgtype.UUID{Bytes: postID, Valid: true}
+	blogID := currentBlogID(ctx)
+
+	batch := &pgx.Batch{}
+	batch.Queue(`DELETE FROM post_links WHERE post_id = $1 AND blog_id = $2`, id, blogID)
+	for _, link := range links {
+		batch.Queue(`
+			INSERT INTO post_links (post_id, blog_id, url, status_code, error, broken, checked_at)
+			VALUES ($1, $2, $3, NULLIF($4, 0), NULLIF($5, ''), $6, $7)
+			ON CONFLICT (post_id, url) DO NOTHING`,
+			id, blogID, link.URL, link.StatusCode, link.Error, link.Broken, link.CheckedAt,
+		)
+	}
+
+	if err := r.DB.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("LinkReportRepository.ReplaceLinks: %w", err)
+	}
+	return nil
+}
+
+// DeleteUnpublished drops the links of posts that are no longer published
+func (r *LinkReportRepository) DeleteUnpublished(ctx context.Context) error {
+	_, err := r.DB.Exec(ctx, `
+		DELETE FROM post_links pl
+		USING posts p
+		WHERE p.id = pl.post_id AND pl.blog_id = $1 AND p.status <> 'published'`,
+		currentBlogID(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("LinkReportRepository.DeleteUnpublished: %w", err)
+	}
+	return nil
+}
+
+// FindByPost returns the post's links, broken ones first
+func (r *LinkReportRepository) FindByPost(ctx context.Context, postID uuid.UUID) ([]domain.Link, error) {
+	id := pgtype.UUID{Bytes: postID, Valid: true}
+
+	var exists bool
+	err := r.DB.QueryRow(ctx,
+		`SELECT EXISTS(SELECT 1 FROM posts WHERE id = $1 AND blog_id = $2)`,
+		id, currentBlogID(ctx),
+	).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("LinkReportRepository.FindByPost: %w", err)
+	}
+	if !exists {
+		return nil, ports.ErrPostNotFound
+	}
+
+	rows, err := r.DB.Query(ctx, `
+		SELECT url, COALESCE(status_code, 0), COALESCE(error, ''), broken, checked_at
+		FROM post_links
+		WHERE post_id = $1
+		ORDER BY broken DESC, url`,
+		id,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("LinkReportRepository.FindByPost: %w", err)
+	}
+	defer rows.Close()
+
+	links := make([]domain.Link, 0)
+	for rows.Next() {
+		var link domain.Link
+		if err := rows.Scan(&link.URL, &link.StatusCode, &link.Error, &link.Broken, &link.CheckedAt); err != nil {
+			return nil, fmt.Errorf("LinkReportRepository.FindByPost: scan: %w", err)
+		}
+		links = append(links, link)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("LinkReportRepository.FindByPost: %w", err)
+	}
+	return links, nil
+}
+
+// ListBroken returns a page of broken links with their posts, most recently checked first
+func (r *LinkReportRepository) ListBroken(ctx context.Context, limit, offset int) ([]domain.BrokenLink, int, error) {
+	blogID := currentBlogID(ctx)
+
+	var total int
+	err := r.DB.QueryRow(ctx,
+		`SELECT COUNT(*) FROM post_links WHERE blog_id = $1 AND broken`,
+		blogID,
+	).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("LinkReportRepository.ListBroken: count: %w", err)
+	}
+
+	rows, err := r.DB.Query(ctx, `
+		SELECT p.id, p.title, p.slug, p.author_id,
+			pl.url, COALESCE(pl.status_code, 0), COALESCE(pl.error, ''), pl.broken, pl.checked_at
+		FROM post_links pl
+		JOIN posts p ON p.id = pl.post_id
+		WHERE pl.blog_id = $1 AND pl.broken
+		ORDER BY pl.checked_at DESC, p.id, pl.url
+		LIMIT $2 OFFSET $3`,
+		blogID, limit, offset,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("LinkReportRepository.ListBroken: %w", err)
+	}
+	defer rows.Close()
+
+	links := make([]domain.BrokenLink, 0)
+	for rows.Next() {
+		var postID, authorID pgtype.UUID
+		var broken domain.BrokenLink
+		if err := rows.Scan(
+			&postID, &broken.PostTitle, &broken.PostSlug, &authorID,
+			&broken.Link.URL, &broken.Link.StatusCode, &broken.Link.Error, &broken.Link.Broken, &broken.Link.CheckedAt,
+		); err != nil {
+			return nil, 0, fmt.Errorf("LinkReportRepository.ListBroken: scan: %w", err)
+		}
+		broken.PostID = uuid.UUID(postID.Bytes)
+		broken.AuthorID = uuid.UUID(authorID.Bytes)
+		links = append(links, broken)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("LinkReportRepository.ListBroken: %w", err)
+	}
+	return links, total, nil
+}
+
+// BlogIDs lists every blog
+func (r *LinkReportRepository) BlogIDs(ctx context.Context) ([]uuid.UUID, error) {
+	blogIDs, err := listBlogIDs(ctx, r.DB)
+	if err != nil {
+		return nil, fmt.Errorf("LinkReportRepository.BlogIDs: %w", err)
+	}
+	return blogIDs, nil
+}
+
+// Compile-time check to ensure LinkReportRepository implements ports.LinkReportRepository
+var _ ports.LinkReportRepository = (*LinkReportRepository)(nil)
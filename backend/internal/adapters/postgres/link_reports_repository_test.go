@@ -0,0 +1,76 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"backend/internal/adapters/postgres"
+	"backend/internal/linkreports/domain"
+	"backend/internal/linkreports/ports"
+	"backend/internal/testing/factory"
+	"backend/internal/testing/pgtest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinkReportRepository_ReplaceLinks(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewLinkReportRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	author := factory.NewUser().WithRole("author").Create(t, tx)
+	post := factory.NewPost(author.ID).Published().Create(t, tx)
+	checkedAt := time.Now().UTC().Truncate(time.Second)
+
+	require.NoError(t, repo.ReplaceLinks(ctx, post.ID, []domain.Link{
+		{URL: "https://ok.example", StatusCode: 200, CheckedAt: checkedAt},
+		{URL: "https://gone.example", StatusCode: 404, Broken: true, CheckedAt: checkedAt},
+	}))
+
+	links, err := repo.FindByPost(ctx, post.ID)
+	require.NoError(t, err)
+	require.Len(t, links, 2)
+	assert.Equal(t, "https://gone.example", links[0].URL, "broken links come first")
+
+	broken, total, err := repo.ListBroken(ctx, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, broken, 1)
+	assert.Equal(t, post.ID, broken[0].PostID)
+	assert.Equal(t, post.Title, broken[0].PostTitle)
+
+	// A later check replaces the earlier findings
+	require.NoError(t, repo.ReplaceLinks(ctx, post.ID, []domain.Link{
+		{URL: "https://timeout.example", Error: "timed out", Broken: true, CheckedAt: checkedAt},
+	}))
+	links, err = repo.FindByPost(ctx, post.ID)
+	require.NoError(t, err)
+	require.Len(t, links, 1)
+	assert.Zero(t, links[0].StatusCode)
+	assert.Equal(t, "timed out", links[0].Error)
+
+	_, err = repo.FindByPost(ctx, uuid.New())
+	assert.ErrorIs(t, err, ports.ErrPostNotFound)
+}
+
+func TestLinkReportRepository_DeleteUnpublished(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewLinkReportRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	author := factory.NewUser().WithRole("author").Create(t, tx)
+	post := factory.NewPost(author.ID).Published().Create(t, tx)
+	require.NoError(t, repo.ReplaceLinks(ctx, post.ID, []domain.Link{
+		{URL: "https://gone.example", StatusCode: 404, Broken: true, CheckedAt: time.Now()},
+	}))
+
+	_, err := tx.Exec(ctx, `UPDATE posts SET status = 'archived' WHERE id = $1`, post.ID)
+	require.NoError(t, err)
+	require.NoError(t, repo.DeleteUnpublished(ctx))
+
+	links, err := repo.FindByPost(ctx, post.ID)
+	require.NoError(t, err)
+	assert.Empty(t, links)
+}
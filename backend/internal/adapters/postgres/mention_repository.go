@@ -0,0 +1,283 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"backend/internal/mentions/domain"
+	"backend/internal/mentions/ports"
+	"backend/internal/platform/postgres"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// MentionRepository implements the mentions.MentionRepository interface using PostgreSQL
+// Mentions are read and written only within the request's blog
+type MentionRepository struct {
+	postgres.BaseRepository // Embed the base repository for common functionality
+}
+
+// NewMentionRepository creates a new PostgreSQL webmention repository
+func NewMentionRepository(db *pgxpool.Pool) *MentionRepository {
+	return &MentionRepository{
+		BaseRepository: postgres.NewBaseRepository(db),
+	}
+}
+
+// WithTx returns a new repository instance that uses the provided transaction
+func (r *MentionRepository) WithTx(tx pgx.Tx) *MentionRepository {
+	return &MentionRepository{
+		BaseRepository: r.BaseRepository.WithTx(tx),
+	}
+}
+
+// Receive inserts a mention into the current blog, or queues the post's
+// existing mention from the same source for verification again
+func (r *MentionRepository) Receive(ctx context.Context, mention *domain.Mention) error {
+	query, args, err := r.SB.
+		Insert("webmentions").
+		Columns("id", "blog_id", "post_id", "source", "target", "verification", "status", "received_at", "updated_at").
+		Values(
+			pgtype.UUID{Bytes: mention.ID, Valid: true},
+			currentBlogID(ctx),
+			pgtype.UUID{Bytes: mention.PostID, Valid: true},
+			mention.Source,
+			mention.Target,
+			string(mention.Verification),
+			string(mention.Status),
+			pgtype.Timestamptz{Time: mention.ReceivedAt, Valid: true},
+			pgtype.Timestamptz{Time: mention.UpdatedAt, Valid: true},
+		).
+		Suffix(`ON CONFLICT (post_id, source) DO UPDATE SET
+			target = EXCLUDED.target,
+			verification = EXCLUDED.verification,
+			failure = '',
+			attempts = 0,
+			received_at = EXCLUDED.received_at`).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("MentionRepository.Receive: build query: %w", err)
+	}
+
+	if _, err := r.DB.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("MentionRepository.Receive: %w", err)
+	}
+
+	return nil
+}
+
+// Update saves a mention's verification and moderation
+func (r *MentionRepository) Update(ctx context.Context, mention *domain.Mention) error {
+	verifiedAt := pgtype.Timestamptz{}
+	if mention.VerifiedAt != nil {
+		verifiedAt = pgtype.Timestamptz{Time: *mention.VerifiedAt, Valid: true}
+	}
+
+	query, args, err := r.SB.
+		Update("webmentions").
+		Set("verification", string(mention.Verification)).
+		Set("status", string(mention.Status)).
+		Set("title", mention.Title).
+		Set("failure", mention.Failure).
+		Set("attempts", mention.Attempts).
+		Set("verified_at", verifiedAt).
+		Set("updated_at", pgtype.Timestamptz{Time: mention.UpdatedAt, Valid: true}).
+		Where(sq.Eq{
+			"id":      pgtype.UUID{Bytes: mention.ID, Valid: true},
+			"blog_id": currentBlogID(ctx),
+		}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("MentionRepository.Update: build query: %w", err)
+	}
+
+	result, err := r.DB.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("MentionRepository.Update: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ports.ErrMentionNotFound
+	}
+
+	return nil
+}
+
+// FindByID retrieves a mention of the current blog
+func (r *MentionRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Mention, error) {
+	query, args, err := r.selectMentions(ctx).
+		Where(sq.Eq{"id": pgtype.UUID{Bytes: id, Valid: true}}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("MentionRepository.FindByID: build query: %w", err)
+	}
+
+	mention, err := scanMention(r.DB.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrMentionNotFound
+		}
+		return nil, fmt.Errorf("MentionRepository.FindByID: %w", err)
+	}
+
+	return mention, nil
+}
+
+// List retrieves verified mentions of the current blog matching the filter, newest first
+func (r *MentionRepository) List(ctx context.Context, filter ports.ListFilter) ([]*domain.Mention, int, error) {
+	where := sq.And{sq.Eq{"verification": string(domain.VerificationVerified)}}
+	if filter.PostID != nil {
+		where = append(where, sq.Eq{"post_id": pgtype.UUID{Bytes: *filter.PostID, Valid: true}})
+	}
+	if filter.Status != nil {
+		where = append(where, sq.Eq{"status": string(*filter.Status)})
+	}
+
+	countQuery, countArgs, err := r.SB.
+		Select("COUNT(*)").
+		From("webmentions").
+		Where(sq.Eq{"blog_id": currentBlogID(ctx)}).
+		Where(where).
+		ToSql()
+	if err != nil {
+		return nil, 0, fmt.Errorf("MentionRepository.List: build count query: %w", err)
+	}
+
+	var total int
+	if err := r.DB.QueryRow(ctx, countQuery, countArgs...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("MentionRepository.List: count: %w", err)
+	}
+
+	query, args, err := r.selectMentions(ctx).
+		Where(where).
+		OrderBy("received_at DESC", "id ASC").
+		Limit(uint64(filter.Limit)).
+		Offset(uint64(filter.Offset)).
+		ToSql()
+	if err != nil {
+		return nil, 0, fmt.Errorf("MentionRepository.List: build query: %w", err)
+	}
+
+	mentions, err := r.queryMentions(ctx, query, args)
+	if err != nil {
+		return nil, 0, fmt.Errorf("MentionRepository.List: %w", err)
+	}
+
+	return mentions, total, nil
+}
+
+// ListUnverified retrieves the current blog's mentions awaiting verification, oldest first
+func (r *MentionRepository) ListUnverified(ctx context.Context, limit int) ([]*domain.Mention, error) {
+	query, args, err := r.selectMentions(ctx).
+		Where(sq.Eq{"verification": string(domain.VerificationPending)}).
+		OrderBy("received_at ASC", "id ASC").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("MentionRepository.ListUnverified: build query: %w", err)
+	}
+
+	mentions, err := r.queryMentions(ctx, query, args)
+	if err != nil {
+		return nil, fmt.Errorf("MentionRepository.ListUnverified: %w", err)
+	}
+
+	return mentions, nil
+}
+
+// BlogIDs lists the blogs with mentions awaiting verification
+func (r *MentionRepository) BlogIDs(ctx context.Context) ([]uuid.UUID, error) {
+	rows, err := r.DB.Query(ctx,
+		`SELECT DISTINCT blog_id FROM webmentions WHERE verification = $1 ORDER BY blog_id`,
+		string(domain.VerificationPending),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("MentionRepository.BlogIDs: %w", err)
+	}
+	defer rows.Close()
+
+	var blogIDs []uuid.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("MentionRepository.BlogIDs: scan: %w", err)
+		}
+		blogIDs = append(blogIDs, uuid.UUID(id.Bytes))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("MentionRepository.BlogIDs: rows error: %w", err)
+	}
+
+	return blogIDs, nil
+}
+
+// Helper methods
+
+// selectMentions builds the SELECT shared by all mention queries, limited to the current blog
+func (r *MentionRepository) selectMentions(ctx context.Context) sq.SelectBuilder {
+	return r.SB.
+		Select(
+			"id", "post_id", "source", "target", "verification", "status",
+			"title", "failure", "attempts", "received_at", "verified_at", "updated_at",
+		).
+		From("webmentions").
+		Where(sq.Eq{"blog_id": currentBlogID(ctx)})
+}
+
+// queryMentions runs a query built on selectMentions and scans every row
+func (r *MentionRepository) queryMentions(ctx context.Context, query string, args []any) ([]*domain.Mention, error) {
+	rows, err := r.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var mentions []*domain.Mention
+	for rows.Next() {
+		mention, err := scanMention(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+		mentions = append(mentions, mention)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return mentions, nil
+}
+
+// scanMention scans a single row into a domain.Mention
+func scanMention(row pgx.Row) (*domain.Mention, error) {
+	var mention domain.Mention
+	var id, postID pgtype.UUID
+	var verification, status string
+	var receivedAt, verifiedAt, updatedAt pgtype.Timestamptz
+
+	if err := row.Scan(
+		&id, &postID, &mention.Source, &mention.Target, &verification, &status,
+		&mention.Title, &mention.Failure, &mention.Attempts, &receivedAt, &verifiedAt, &updatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	mention.ID = uuid.UUID(id.Bytes)
+	mention.PostID = uuid.UUID(postID.Bytes)
+	mention.Verification = domain.Verification(verification)
+	mention.Status = domain.Status(status)
+	mention.ReceivedAt = receivedAt.Time
+	if verifiedAt.Valid {
+		mention.VerifiedAt = &verifiedAt.Time
+	}
+	mention.UpdatedAt = updatedAt.Time
+	return &mention, nil
+}
+
+// Compile-time check to ensure MentionRepository implements ports.MentionRepository
+var _ ports.MentionRepository = (*MentionRepository)(nil)
@@ -0,0 +1,94 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"backend/internal/adapters/postgres"
+	"backend/internal/mentions/domain"
+	"backend/internal/mentions/ports"
+	"backend/internal/testing/factory"
+	"backend/internal/testing/pgtest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMentionRepository_ReceiveAgainReverifiesAndKeepsModeration(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewMentionRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	author := factory.NewUser().WithRole("author").Create(t, tx)
+	post := factory.NewPost(author.ID).Published().Create(t, tx)
+
+	mention, err := domain.NewMention(post.ID, "https://other.example/reply", "https://blog.example/posts/hello")
+	require.NoError(t, err)
+	require.NoError(t, repo.Receive(ctx, mention))
+
+	unverified, err := repo.ListUnverified(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, unverified, 1)
+
+	mention.Verify("A reply", time.Now())
+	require.NoError(t, mention.Moderate(domain.StatusApproved))
+	require.NoError(t, repo.Update(ctx, mention))
+
+	unverified, err = repo.ListUnverified(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, unverified)
+
+	// The same source sent again is queued for verification, still approved
+	again, err := domain.NewMention(post.ID, "https://other.example/reply", "https://blog.example/posts/hello/")
+	require.NoError(t, err)
+	require.NoError(t, repo.Receive(ctx, again))
+
+	stored, err := repo.FindByID(ctx, mention.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.VerificationPending, stored.Verification)
+	assert.Equal(t, domain.StatusApproved, stored.Status)
+	assert.Equal(t, "https://blog.example/posts/hello/", stored.Target)
+
+	_, err = repo.FindByID(ctx, again.ID)
+	assert.ErrorIs(t, err, ports.ErrMentionNotFound)
+}
+
+func TestMentionRepository_ListShowsOnlyVerifiedMentions(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewMentionRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	author := factory.NewUser().WithRole("author").Create(t, tx)
+	post := factory.NewPost(author.ID).Published().Create(t, tx)
+	other := factory.NewPost(author.ID).Published().Create(t, tx)
+
+	receive := func(postID uuid.UUID, source string, verified bool, status domain.Status) {
+		t.Helper()
+		mention, err := domain.NewMention(postID, source, "https://blog.example/posts/hello")
+		require.NoError(t, err)
+		require.NoError(t, repo.Receive(ctx, mention))
+		if verified {
+			mention.Verify("", time.Now())
+		} else {
+			mention.FailVerification("source does not link to target", false, time.Now())
+		}
+		require.NoError(t, mention.Moderate(status))
+		require.NoError(t, repo.Update(ctx, mention))
+	}
+	receive(post.ID, "https://a.example/", true, domain.StatusApproved)
+	receive(post.ID, "https://b.example/", true, domain.StatusPending)
+	receive(post.ID, "https://c.example/", false, domain.StatusPending)
+	receive(other.ID, "https://d.example/", true, domain.StatusApproved)
+
+	approved := domain.StatusApproved
+	mentions, total, err := repo.List(ctx, ports.ListFilter{PostID: &post.ID, Status: &approved, Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, mentions, 1)
+	assert.Equal(t, "https://a.example/", mentions[0].Source)
+
+	_, total, err = repo.List(ctx, ports.ListFilter{Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, 3, total, "failed verifications are left out")
+}
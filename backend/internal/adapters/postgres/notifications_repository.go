@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"backend/internal/notifications/domain"
+	"backend/internal/notifications/ports"
+	"backend/internal/platform/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// notificationInsertBatchSize caps rows per INSERT to stay well under
+// PostgreSQL's bind parameter limit when fanning out to many followers
+const notificationInsertBatchSize = 500
+
+// NotificationRepository implements the notifications.NotificationRepository interface using PostgreSQL
+type NotificationRepository struct {
+	postgres.BaseRepository // Embed the base repository for common functionality
+}
+
+// NewNotificationRepository creates a new PostgreSQL notifications repository
+func NewNotificationRepository(db *pgxpool.Pool) *NotificationRepository {
+	return &NotificationRepository{
+		BaseRepository: postgres.NewBaseRepository(db),
+	}
+}
+
+// CreateMany inserts notifications in batches
+func (r *NotificationRepository) CreateMany(ctx context.Context, notifications []*domain.Notification) error {
+	for start := 0; start < len(notifications); start += notificationInsertBatchSize {
+		end := min(start+notificationInsertBatchSize, len(notifications))
+
+		qb := r.SB.
+			Insert("notifications").
+			Columns("id", "recipient_id", "type", "actor_id", "subject_id", "message", "created_at")
+
+		for _, n := range notifications[start:end] {
+			qb = qb.Values(
+				pgtype.UUID{Bytes: n.ID, Valid: true},
+				pgtype.UUID{Bytes: n.RecipientID, Valid: true},
+				string(n.Type),
+				pgtype.UUID{Bytes: n.ActorID, Valid: n.ActorID != uuid.Nil},
+				pgtype.UUID{Bytes: n.SubjectID, Valid: true},
+				n.Message,
+				pgtype.Timestamptz{Time: n.CreatedAt, Valid: true},
+			)
+		}
+
+		query, args, err := qb.ToSql()
+		if err != nil {
+			return fmt.Errorf("NotificationRepository.CreateMany: build query: %w", err)
+		}
+
+		if _, err := r.DB.Exec(ctx, query, args...); err != nil {
+			return fmt.Errorf("NotificationRepository.CreateMany: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Compile-time check to ensure NotificationRepository implements ports.NotificationRepository
+var _ ports.NotificationRepository = (*NotificationRepository)(nil)
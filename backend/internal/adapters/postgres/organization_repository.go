@@ -0,0 +1,373 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"backend/internal/organizations/domain"
+	"backend/internal/organizations/ports"
+	"backend/internal/platform/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// organizationColumns are the columns scanned by scanOrganization, in order
+const organizationColumns = `o.id, o.name, o.slug, o.description, o.created_by, o.created_at, o.updated_at`
+
+// organizationMemberColumns are the columns scanned by scanOrganizationMember, in order
+const organizationMemberColumns = `m.organization_id, m.user_id, m.role, m.created_at`
+
+// organizationContentTables maps the resource types organizations can own to
+// their tables; table names are never taken from input
+var organizationContentTables = map[string]string{
+	"posts":  "posts",
+	"themes": "themes",
+}
+
+// OrganizationRepository implements the organizations.OrganizationRepository interface using PostgreSQL
+type OrganizationRepository struct {
+	postgres.BaseRepository
+}
+
+// NewOrganizationRepository creates a new PostgreSQL organization repository
+func NewOrganizationRepository(db *pgxpool.Pool) *OrganizationRepository {
+	return &OrganizationRepository{
+		BaseRepository: postgres.NewBaseRepository(db),
+	}
+}
+
+// WithTx returns a new repository instance that uses the provided transaction
+func (r *OrganizationRepository) WithTx(tx pgx.Tx) ports.OrganizationRepository {
+	return &OrganizationRepository{
+		BaseRepository: r.BaseRepository.WithTx(tx),
+	}
+}
+
+// Create stores a new organization in the current blog
+func (r *OrganizationRepository) Create(ctx context.Context, org *domain.Organization) error {
+	_, err := r.DB.Exec(ctx, `
+		INSERT INTO organizations (id, blog_id, name, slug, description, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		pgtype.UUID{Bytes: org.ID, Valid: true},
+		currentBlogID(ctx),
+		org.Name,
+		org.Slug,
+		org.Description,
+		pgtype.UUID{Bytes: org.CreatedBy, Valid: true},
+		org.CreatedAt,
+		org.UpdatedAt,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ports.ErrSlugExists
+		}
+		return fmt.Errorf("OrganizationRepository.Create: %w", err)
+	}
+	return nil
+}
+
+// Update saves the editable fields of an organization of the current blog
+func (r *OrganizationRepository) Update(ctx context.Context, org *domain.Organization) error {
+	tag, err := r.DB.Exec(ctx, `
+		UPDATE organizations SET name = $3, slug = $4, description = $5, updated_at = $6
+		WHERE id = $1 AND blog_id = $2`,
+		pgtype.UUID{Bytes: org.ID, Valid: true},
+		currentBlogID(ctx),
+		org.Name,
+		org.Slug,
+		org.Description,
+		org.UpdatedAt,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ports.ErrSlugExists
+		}
+		return fmt.Errorf("OrganizationRepository.Update: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ports.ErrOrganizationNotFound
+	}
+	return nil
+}
+
+// Delete removes an organization of the current blog
+// Members go with it; posts and themes it owned are released by ON DELETE SET NULL
+func (r *OrganizationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	tag, err := r.DB.Exec(ctx,
+		`DELETE FROM organizations WHERE id = $1 AND blog_id = $2`,
+		pgtype.UUID{Bytes: id, Valid: true}, currentBlogID(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("OrganizationRepository.Delete: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ports.ErrOrganizationNotFound
+	}
+	return nil
+}
+
+// FindByID retrieves an organization of the current blog
+func (r *OrganizationRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Organization, error) {
+	row := r.DB.QueryRow(ctx,
+		`SELECT `+organizationColumns+` FROM organizations o WHERE o.id = $1 AND o.blog_id = $2`,
+		pgtype.UUID{Bytes: id, Valid: true}, currentBlogID(ctx),
+	)
+	org, err := scanOrganization(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrOrganizationNotFound
+		}
+		return nil, fmt.Errorf("OrganizationRepository.FindByID: %w", err)
+	}
+	return org, nil
+}
+
+// ListByMember returns the organizations of the current blog a user belongs to
+func (r *OrganizationRepository) ListByMember(ctx context.Context, userID uuid.UUID) ([]*domain.Organization, error) {
+	rows, err := r.DB.Query(ctx, `
+		SELECT `+organizationColumns+`
+		FROM organizations o
+		JOIN organization_members m ON m.organization_id = o.id
+		WHERE m.user_id = $1 AND o.blog_id = $2
+		ORDER BY o.name, o.id`,
+		pgtype.UUID{Bytes: userID, Valid: true}, currentBlogID(ctx),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("OrganizationRepository.ListByMember: %w", err)
+	}
+	defer rows.Close()
+
+	var orgs []*domain.Organization
+	for rows.Next() {
+		org, err := scanOrganization(rows)
+		if err != nil {
+			return nil, fmt.Errorf("OrganizationRepository.ListByMember: scan: %w", err)
+		}
+		orgs = append(orgs, org)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("OrganizationRepository.ListByMember: %w", err)
+	}
+	return orgs, nil
+}
+
+// ListMembers returns the members of an organization of the current blog, earliest joined first
+func (r *OrganizationRepository) ListMembers(ctx context.Context, orgID uuid.UUID) ([]*domain.Member, error) {
+	rows, err := r.DB.Query(ctx, `
+		SELECT `+organizationMemberColumns+`
+		FROM organization_members m
+		JOIN organizations o ON o.id = m.organization_id
+		WHERE m.organization_id = $1 AND o.blog_id = $2
+		ORDER BY m.created_at, m.user_id`,
+		pgtype.UUID{Bytes: orgID, Valid: true}, currentBlogID(ctx),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("OrganizationRepository.ListMembers: %w", err)
+	}
+	defer rows.Close()
+
+	var members []*domain.Member
+	for rows.Next() {
+		member, err := scanOrganizationMember(rows)
+		if err != nil {
+			return nil, fmt.Errorf("OrganizationRepository.ListMembers: scan: %w", err)
+		}
+		members = append(members, member)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("OrganizationRepository.ListMembers: %w", err)
+	}
+	return members, nil
+}
+
+// FindMember returns one member of an organization of the current blog
+func (r *OrganizationRepository) FindMember(ctx context.Context, orgID, userID uuid.UUID) (*domain.Member, error) {
+	row := r.DB.QueryRow(ctx, `
+		SELECT `+organizationMemberColumns+`
+		FROM organization_members m
+		JOIN organizations o ON o.id = m.organization_id
+		WHERE m.organization_id = $1 AND m.user_id = $2 AND o.blog_id = $3`,
+		pgtype.UUID{Bytes: orgID, Valid: true},
+		pgtype.UUID{Bytes: userID, Valid: true},
+		currentBlogID(ctx),
+	)
+	member, err := scanOrganizationMember(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrMemberNotFound
+		}
+		return nil, fmt.Errorf("OrganizationRepository.FindMember: %w", err)
+	}
+	return member, nil
+}
+
+// SaveMember adds a member to an organization of the current blog, or changes their role
+func (r *OrganizationRepository) SaveMember(ctx context.Context, member *domain.Member) error {
+	tag, err := r.DB.Exec(ctx, `
+		INSERT INTO organization_members (organization_id, user_id, role, created_at)
+		SELECT o.id, $2, $3, $4
+		FROM organizations o
+		WHERE o.id = $1 AND o.blog_id = $5
+		ON CONFLICT (organization_id, user_id) DO UPDATE SET role = EXCLUDED.role`,
+		pgtype.UUID{Bytes: member.OrganizationID, Valid: true},
+		pgtype.UUID{Bytes: member.UserID, Valid: true},
+		string(member.Role),
+		member.JoinedAt,
+		currentBlogID(ctx),
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return ports.ErrUserNotFound
+		}
+		return fmt.Errorf("OrganizationRepository.SaveMember: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ports.ErrOrganizationNotFound
+	}
+	return nil
+}
+
+// RemoveMember removes a member from an organization of the current blog
+func (r *OrganizationRepository) RemoveMember(ctx context.Context, orgID, userID uuid.UUID) error {
+	tag, err := r.DB.Exec(ctx, `
+		DELETE FROM organization_members m
+		USING organizations o
+		WHERE o.id = m.organization_id AND m.organization_id = $1 AND m.user_id = $2 AND o.blog_id = $3`,
+		pgtype.UUID{Bytes: orgID, Valid: true},
+		pgtype.UUID{Bytes: userID, Valid: true},
+		currentBlogID(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("OrganizationRepository.RemoveMember: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ports.ErrMemberNotFound
+	}
+	return nil
+}
+
+// CountOwners counts the owners of an organization
+func (r *OrganizationRepository) CountOwners(ctx context.Context, orgID uuid.UUID) (int, error) {
+	var count int
+	err := r.DB.QueryRow(ctx,
+		`SELECT COUNT(*) FROM organization_members WHERE organization_id = $1 AND role = $2`,
+		pgtype.UUID{Bytes: orgID, Valid: true}, string(domain.RoleOwner),
+	).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("OrganizationRepository.CountOwners: %w", err)
+	}
+	return count, nil
+}
+
+// ContentOrganization returns the organization owning a post or theme of the current blog
+func (r *OrganizationRepository) ContentOrganization(ctx context.Context, resourceType string, resourceID uuid.UUID) (*uuid.UUID, error) {
+	table, ok := organizationContentTables[resourceType]
+	if !ok {
+		return nil, ports.ErrUnsupportedContent
+	}
+
+	var orgID pgtype.UUID
+	err := r.DB.QueryRow(ctx,
+		`SELECT organization_id FROM `+table+` WHERE id = $1 AND blog_id = $2`,
+		pgtype.UUID{Bytes: resourceID, Valid: true}, currentBlogID(ctx),
+	).Scan(&orgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrContentNotFound
+		}
+		return nil, fmt.Errorf("OrganizationRepository.ContentOrganization: %w", err)
+	}
+	if !orgID.Valid {
+		return nil, nil
+	}
+	id := uuid.UUID(orgID.Bytes)
+	return &id, nil
+}
+
+// SetContentOrganization moves a post or theme of the current blog into an organization, or out of one
+func (r *OrganizationRepository) SetContentOrganization(ctx context.Context, resourceType string, resourceID uuid.UUID, orgID *uuid.UUID) error {
+	table, ok := organizationContentTables[resourceType]
+	if !ok {
+		return ports.ErrUnsupportedContent
+	}
+
+	var org pgtype.UUID
+	if orgID != nil {
+		org = pgtype.UUID{Bytes: *orgID, Valid: true}
+	}
+	tag, err := r.DB.Exec(ctx,
+		`UPDATE `+table+` SET organization_id = $3 WHERE id = $1 AND blog_id = $2`,
+		pgtype.UUID{Bytes: resourceID, Valid: true}, currentBlogID(ctx), org,
+	)
+	if err != nil {
+		return fmt.Errorf("OrganizationRepository.SetContentOrganization: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ports.ErrContentNotFound
+	}
+	return nil
+}
+
+// ContentMemberRole returns the role a user holds in the organization owning a post or theme
+func (r *OrganizationRepository) ContentMemberRole(ctx context.Context, resourceType string, resourceID, userID uuid.UUID) (domain.MemberRole, error) {
+	table, ok := organizationContentTables[resourceType]
+	if !ok {
+		return "", ports.ErrUnsupportedContent
+	}
+
+	var role string
+	err := r.DB.QueryRow(ctx, `
+		SELECT m.role
+		FROM `+table+` c
+		JOIN organization_members m ON m.organization_id = c.organization_id
+		WHERE c.id = $1 AND c.blog_id = $2 AND m.user_id = $3`,
+		pgtype.UUID{Bytes: resourceID, Valid: true},
+		currentBlogID(ctx),
+		pgtype.UUID{Bytes: userID, Valid: true},
+	).Scan(&role)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", ports.ErrMemberNotFound
+		}
+		return "", fmt.Errorf("OrganizationRepository.ContentMemberRole: %w", err)
+	}
+	return domain.MemberRole(role), nil
+}
+
+// scanOrganization reads an organization from a row of organizationColumns
+func scanOrganization(row pgx.Row) (*domain.Organization, error) {
+	var (
+		org           domain.Organization
+		id, createdBy pgtype.UUID
+	)
+	if err := row.Scan(&id, &org.Name, &org.Slug, &org.Description, &createdBy, &org.CreatedAt, &org.UpdatedAt); err != nil {
+		return nil, err
+	}
+	org.ID = uuid.UUID(id.Bytes)
+	org.CreatedBy = uuid.UUID(createdBy.Bytes)
+	return &org, nil
+}
+
+// scanOrganizationMember reads a member from a row of organizationMemberColumns
+func scanOrganizationMember(row pgx.Row) (*domain.Member, error) {
+	var (
+		member        domain.Member
+		orgID, userID pgtype.UUID
+		role          string
+	)
+	if err := row.Scan(&orgID, &userID, &role, &member.JoinedAt); err != nil {
+		return nil, err
+	}
+	member.OrganizationID = uuid.UUID(orgID.Bytes)
+	member.UserID = uuid.UUID(userID.Bytes)
+	member.Role = domain.MemberRole(role)
+	return &member, nil
+}
+
+// Compile-time check to ensure OrganizationRepository implements ports.OrganizationRepository
+var _ ports.OrganizationRepository = (*OrganizationRepository)(nil)
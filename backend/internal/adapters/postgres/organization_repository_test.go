@@ -0,0 +1,117 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"backend/internal/adapters/postgres"
+	"backend/internal/organizations/domain"
+	"backend/internal/organizations/ports"
+	"backend/internal/testing/factory"
+	"backend/internal/testing/pgtest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrganizationRepository_Members(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewOrganizationRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	owner := factory.NewUser().WithRole("author").Create(t, tx)
+	editor := factory.NewUser().Create(t, tx)
+
+	org, err := domain.NewOrganization("Platform Team", "platform-team", "", owner.ID)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, org))
+
+	for userID, role := range map[uuid.UUID]domain.MemberRole{owner.ID: domain.RoleOwner, editor.ID: domain.RoleMember} {
+		member, err := domain.NewMember(org.ID, userID, role)
+		require.NoError(t, err)
+		require.NoError(t, repo.SaveMember(ctx, member))
+	}
+
+	// Saving again changes the role
+	promoted, err := domain.NewMember(org.ID, editor.ID, domain.RoleEditor)
+	require.NoError(t, err)
+	require.NoError(t, repo.SaveMember(ctx, promoted))
+	found, err := repo.FindMember(ctx, org.ID, editor.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.RoleEditor, found.Role)
+
+	owners, err := repo.CountOwners(ctx, org.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, owners)
+
+	orgs, err := repo.ListByMember(ctx, editor.ID)
+	require.NoError(t, err)
+	require.Len(t, orgs, 1)
+	assert.Equal(t, org.ID, orgs[0].ID)
+
+	require.NoError(t, repo.RemoveMember(ctx, org.ID, editor.ID))
+	assert.ErrorIs(t, repo.RemoveMember(ctx, org.ID, editor.ID), ports.ErrMemberNotFound)
+
+	// Last, since the failed insert aborts the transaction
+	stranger, err := domain.NewMember(org.ID, uuid.New(), domain.RoleMember)
+	require.NoError(t, err)
+	assert.ErrorIs(t, repo.SaveMember(ctx, stranger), ports.ErrUserNotFound)
+}
+
+func TestOrganizationRepository_SlugUniquePerBlog(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewOrganizationRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	owner := factory.NewUser().WithRole("author").Create(t, tx)
+	first, err := domain.NewOrganization("Platform Team", "platform-team", "", owner.ID)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, first))
+
+	second, err := domain.NewOrganization("Another Team", "platform-team", "", owner.ID)
+	require.NoError(t, err)
+	assert.ErrorIs(t, repo.Create(ctx, second), ports.ErrSlugExists)
+}
+
+func TestOrganizationRepository_Content(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewOrganizationRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	owner := factory.NewUser().WithRole("author").Create(t, tx)
+	teammate := factory.NewUser().Create(t, tx)
+	theme := factory.NewTheme(owner.ID).Create(t, tx)
+
+	org, err := domain.NewOrganization("Platform Team", "platform-team", "", owner.ID)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, org))
+	member, err := domain.NewMember(org.ID, teammate.ID, domain.RoleEditor)
+	require.NoError(t, err)
+	require.NoError(t, repo.SaveMember(ctx, member))
+
+	current, err := repo.ContentOrganization(ctx, "themes", theme.ID)
+	require.NoError(t, err)
+	assert.Nil(t, current)
+	_, err = repo.ContentMemberRole(ctx, "themes", theme.ID, teammate.ID)
+	assert.ErrorIs(t, err, ports.ErrMemberNotFound)
+
+	require.NoError(t, repo.SetContentOrganization(ctx, "themes", theme.ID, &org.ID))
+	current, err = repo.ContentOrganization(ctx, "themes", theme.ID)
+	require.NoError(t, err)
+	require.NotNil(t, current)
+	assert.Equal(t, org.ID, *current)
+
+	role, err := repo.ContentMemberRole(ctx, "themes", theme.ID, teammate.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.RoleEditor, role)
+
+	// Deleting the organization hands the content back to its author
+	require.NoError(t, repo.Delete(ctx, org.ID))
+	current, err = repo.ContentOrganization(ctx, "themes", theme.ID)
+	require.NoError(t, err)
+	assert.Nil(t, current)
+
+	assert.ErrorIs(t, repo.SetContentOrganization(ctx, "themes", uuid.New(), nil), ports.ErrContentNotFound)
+	_, err = repo.ContentOrganization(ctx, "comments", theme.ID)
+	assert.ErrorIs(t, err, ports.ErrUnsupportedContent)
+}
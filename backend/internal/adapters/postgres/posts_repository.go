@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"backend/internal/platform/postgres"
 	"backend/internal/posts/domain"
@@ -47,11 +48,17 @@ func (r *PostRepository) Create(ctx context.Context, post *domain.Post) error {
 	query, args, err := r.SB.
 		Insert("posts").
 		Columns(
-			"id", "title", "content", "excerpt", "slug", "status",
-			"author_id", "published_at", "created_at", "updated_at",
+			"id", "blog_id", "title", "content", "excerpt", "slug", "status",
+			"author_id", "published_at", "featured", "featured_at",
+			"language", "translation_group_id", "target_publish_date",
+			"meta_title", "meta_description", "canonical_url", "og_image_url",
+			"comment_mode", "comment_auto_close_days",
+			"visibility", "embargo_until", "premium",
+			"created_at", "updated_at", "author_name",
 		).
 		Values(
 			pgtype.UUID{Bytes: uuid.UUID(post.ID), Valid: true},
+			currentBlogID(ctx),
 			post.Title,
 			post.Content,
 			post.Excerpt,
@@ -59,8 +66,23 @@ func (r *PostRepository) Create(ctx context.Context, post *domain.Post) error {
 			string(post.Status),
 			pgtype.UUID{Bytes: uuid.UUID(post.AuthorID), Valid: true},
 			publishedAt,
+			post.Featured,
+			toNullableTimestamptz(post.FeaturedAt),
+			post.Language,
+			toNullableUUID(post.TranslationGroupID),
+			toNullableDate(post.TargetPublishDate),
+			toNullableText(post.SEO.MetaTitle),
+			toNullableText(post.SEO.MetaDescription),
+			toNullableText(post.SEO.CanonicalURL),
+			toNullableText(post.SEO.OGImageURL),
+			string(post.CommentPolicy.Mode),
+			post.CommentPolicy.AutoCloseAfterDays,
+			string(post.AccessPolicy.Visibility),
+			toNullableTimestamptz(post.AccessPolicy.EmbargoUntil),
+			post.Premium,
 			pgtype.Timestamptz{Time: post.CreatedAt, Valid: true},
 			pgtype.Timestamptz{Time: post.UpdatedAt, Valid: true},
+			sq.Expr("(SELECT username FROM users WHERE id = ?)", pgtype.UUID{Bytes: uuid.UUID(post.AuthorID), Valid: true}),
 		).
 		ToSql()
 	if err != nil {
@@ -76,6 +98,7 @@ func (r *PostRepository) Create(ctx context.Context, post *domain.Post) error {
 }
 
 // Update updates an existing post in the database
+// A changed slug is recorded in slug_history, so call this within a transaction
 func (r *PostRepository) Update(ctx context.Context, post *domain.Post) error {
 	var publishedAt pgtype.Timestamptz
 	if post.PublishedAt != nil {
@@ -85,6 +108,11 @@ func (r *PostRepository) Update(ctx context.Context, post *domain.Post) error {
 		}
 	}
 
+	// Keep the old slug resolvable before it is overwritten
+	if err := recordSlugChange(ctx, r.BaseRepository, slugEntityPost, "posts", post.ID, post.Slug); err != nil {
+		return fmt.Errorf("PostRepository.Update: %w", err)
+	}
+
 	query, args, err := r.SB.
 		Update("posts").
 		Set("title", post.Title).
@@ -93,8 +121,25 @@ func (r *PostRepository) Update(ctx context.Context, post *domain.Post) error {
 		Set("slug", post.Slug).
 		Set("status", string(post.Status)).
 		Set("published_at", publishedAt).
+		Set("featured", post.Featured).
+		Set("featured_at", toNullableTimestamptz(post.FeaturedAt)).
+		Set("language", post.Language).
+		Set("translation_group_id", toNullableUUID(post.TranslationGroupID)).
+		Set("target_publish_date", toNullableDate(post.TargetPublishDate)).
+		Set("meta_title", toNullableText(post.SEO.MetaTitle)).
+		Set("meta_description", toNullableText(post.SEO.MetaDescription)).
+		Set("canonical_url", toNullableText(post.SEO.CanonicalURL)).
+		Set("og_image_url", toNullableText(post.SEO.OGImageURL)).
+		Set("comment_mode", string(post.CommentPolicy.Mode)).
+		Set("comment_auto_close_days", post.CommentPolicy.AutoCloseAfterDays).
+		Set("visibility", string(post.AccessPolicy.Visibility)).
+		Set("embargo_until", toNullableTimestamptz(post.AccessPolicy.EmbargoUntil)).
+		Set("premium", post.Premium).
 		Set("updated_at", pgtype.Timestamptz{Time: post.UpdatedAt, Valid: true}).
-		Where(sq.Eq{"id": pgtype.UUID{Bytes: uuid.UUID(post.ID), Valid: true}}).
+		Where(sq.Eq{
+			"id":      pgtype.UUID{Bytes: uuid.UUID(post.ID), Valid: true},
+			"blog_id": currentBlogID(ctx),
+		}).
 		ToSql()
 	if err != nil {
 		return fmt.Errorf("PostRepository.Update: build query: %w", err)
@@ -116,7 +161,7 @@ func (r *PostRepository) Update(ctx context.Context, post *domain.Post) error {
 func (r *PostRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	query, args, err := r.SB.
 		Delete("posts").
-		Where(sq.Eq{"id": pgtype.UUID{Bytes: id, Valid: true}}).
+		Where(sq.Eq{"id": pgtype.UUID{Bytes: id, Valid: true}, "blog_id": currentBlogID(ctx)}).
 		ToSql()
 	if err != nil {
 		return fmt.Errorf("PostRepository.Delete: build query: %w", err)
@@ -139,10 +184,15 @@ func (r *PostRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Po
 	query, args, err := r.SB.
 		Select(
 			"id", "title", "content", "excerpt", "slug", "status",
-			"author_id", "published_at", "created_at", "updated_at",
+			"author_id", "published_at", "featured", "featured_at",
+			"language", "translation_group_id", "target_publish_date",
+			"meta_title", "meta_description", "canonical_url", "og_image_url",
+			"comment_mode", "comment_auto_close_days",
+			"visibility", "embargo_until", "premium",
+			"created_at", "updated_at",
 		).
 		From("posts").
-		Where(sq.Eq{"id": pgtype.UUID{Bytes: id, Valid: true}}).
+		Where(sq.Eq{"id": pgtype.UUID{Bytes: id, Valid: true}, "blog_id": currentBlogID(ctx)}).
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("PostRepository.FindByID: build query: %w", err)
@@ -160,15 +210,65 @@ func (r *PostRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Po
 	return post, nil
 }
 
+// FindByIDs retrieves the posts with the given IDs in one query, in no particular order
+func (r *PostRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Post, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	query, args, err := r.SB.
+		Select(
+			"id", "title", "content", "excerpt", "slug", "status",
+			"author_id", "published_at", "featured", "featured_at",
+			"language", "translation_group_id", "target_publish_date",
+			"meta_title", "meta_description", "canonical_url", "og_image_url",
+			"comment_mode", "comment_auto_close_days",
+			"visibility", "embargo_until", "premium",
+			"created_at", "updated_at",
+		).
+		From("posts").
+		Where("id = ANY(?::uuid[])", uuidKeys(ids)).
+		Where(sq.Eq{"blog_id": currentBlogID(ctx)}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("PostRepository.FindByIDs: build query: %w", err)
+	}
+
+	rows, err := r.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("PostRepository.FindByIDs: %w", err)
+	}
+	defer rows.Close()
+
+	posts := make([]*domain.Post, 0, len(ids))
+	for rows.Next() {
+		post, err := scanPost(rows)
+		if err != nil {
+			return nil, fmt.Errorf("PostRepository.FindByIDs: %w", err)
+		}
+		posts = append(posts, post)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("PostRepository.FindByIDs: %w", err)
+	}
+
+	return posts, nil
+}
+
 // FindBySlug retrieves a post by its URL slug
 func (r *PostRepository) FindBySlug(ctx context.Context, slug string) (*domain.Post, error) {
 	query, args, err := r.SB.
 		Select(
 			"id", "title", "content", "excerpt", "slug", "status",
-			"author_id", "published_at", "created_at", "updated_at",
+			"author_id", "published_at", "featured", "featured_at",
+			"language", "translation_group_id", "target_publish_date",
+			"meta_title", "meta_description", "canonical_url", "og_image_url",
+			"comment_mode", "comment_auto_close_days",
+			"visibility", "embargo_until", "premium",
+			"created_at", "updated_at",
 		).
 		From("posts").
-		Where(sq.Eq{"slug": slug}).
+		Where(sq.Eq{"slug": slug, "blog_id": currentBlogID(ctx)}).
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("PostRepository.FindBySlug: build query: %w", err)
@@ -186,27 +286,48 @@ func (r *PostRepository) FindBySlug(ctx context.Context, slug string) (*domain.P
 	return post, nil
 }
 
+// FindByPreviousSlug retrieves the post that used to be published under a slug
+func (r *PostRepository) FindByPreviousSlug(ctx context.Context, slug string) (*domain.Post, error) {
+	query, args, err := r.SB.
+		Select(
+			"p.id", "p.title", "p.content", "p.excerpt", "p.slug", "p.status",
+			"p.author_id", "p.published_at", "p.featured", "p.featured_at",
+			"p.language", "p.translation_group_id", "p.target_publish_date",
+			"p.meta_title", "p.meta_description", "p.canonical_url", "p.og_image_url",
+			"p.comment_mode", "p.comment_auto_close_days",
+			"p.visibility", "p.embargo_until", "p.premium",
+			"p.created_at", "p.updated_at",
+		).
+		From("slug_history h").
+		Join("posts p ON p.id = h.entity_id").
+		Where(sq.Eq{"h.blog_id": currentBlogID(ctx), "h.entity_type": slugEntityPost, "h.slug": slug}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("PostRepository.FindByPreviousSlug: build query: %w", err)
+	}
+
+	row := r.DB.QueryRow(ctx, query, args...)
+	post, err := scanPost(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrPostNotFound
+		}
+		return nil, fmt.Errorf("PostRepository.FindByPreviousSlug: %w", err)
+	}
+
+	return post, nil
+}
+
 // ListSummaries retrieves a list of post summaries based on the filter
 func (r *PostRepository) ListSummaries(ctx context.Context, filter ports.ListFilter) ([]*ports.PostSummary, error) {
 	// Start with a fresh query builder for the main query
-	qb := r.SB.Select(
-		"p.id", "p.title", "p.excerpt", "p.slug", "p.status",
-		"p.author_id", "u.username as author_name",
-		"p.published_at", "p.created_at", "p.updated_at",
-	).
-		From("posts p").
-		LeftJoin("users u ON p.author_id = u.id")
+	qb := r.selectSummaries(filter.ViewerID)
 
 	// Apply filters
-	qb = r.applyFilters(qb, filter)
+	qb = r.applyFilters(ctx, qb, filter)
 
 	// Add sorting
-	orderColumn := getOrderColumn(filter.OrderBy)
-	if filter.OrderDesc {
-		qb = qb.OrderBy(fmt.Sprintf("%s DESC", orderColumn))
-	} else {
-		qb = qb.OrderBy(fmt.Sprintf("%s ASC", orderColumn))
-	}
+	qb = qb.OrderBy(orderClause(filter))
 
 	// Add pagination
 	if filter.Limit > 0 {
@@ -249,7 +370,7 @@ func (r *PostRepository) Count(ctx context.Context, filter ports.ListFilter) (in
 	qb := r.SB.Select("COUNT(*)").From("posts p")
 
 	// Apply filters
-	qb = r.applyFilters(qb, filter)
+	qb = r.applyFilters(ctx, qb, filter)
 
 	query, args, err := qb.ToSql()
 	if err != nil {
@@ -268,7 +389,7 @@ func (r *PostRepository) Count(ctx context.Context, filter ports.ListFilter) (in
 // SlugExists checks if a slug already exists, optionally excluding a specific post ID
 func (r *PostRepository) SlugExists(ctx context.Context, slug string, excludeID *uuid.UUID) (bool, error) {
 	// Build the subquery
-	subQuery := r.SB.Select("1").From("posts").Where(sq.Eq{"slug": slug})
+	subQuery := r.SB.Select("1").From("posts").Where(sq.Eq{"slug": slug, "blog_id": currentBlogID(ctx)})
 
 	if excludeID != nil {
 		subQuery = subQuery.Where(sq.NotEq{"id": pgtype.UUID{Bytes: *excludeID, Valid: true}})
@@ -292,6 +413,159 @@ func (r *PostRepository) SlugExists(ctx context.Context, slug string, excludeID
 	return exists, nil
 }
 
+// ListFeed retrieves published posts by authors the follower follows, newest first
+// Pagination is keyset-based: pass the last item of the previous page as the cursor
+func (r *PostRepository) ListFeed(ctx context.Context, followerID uuid.UUID, cursor *ports.FeedCursor, limit int) ([]*ports.PostSummary, error) {
+	follower := pgtype.UUID{Bytes: followerID, Valid: true}
+
+	qb := r.selectSummaries(&followerID).
+		Where(sq.Eq{"p.blog_id": currentBlogID(ctx)}).
+		Where(listedForReaders(true)).
+		Where(sq.Expr("p.author_id IN (SELECT f.followee_id FROM follows f WHERE f.follower_id = ?)", follower)).
+		OrderBy("p.published_at DESC", "p.id DESC")
+
+	if cursor != nil {
+		qb = qb.Where(sq.Expr(
+			"(p.published_at, p.id) < (?, ?)",
+			pgtype.Timestamptz{Time: cursor.PublishedAt, Valid: true},
+			pgtype.UUID{Bytes: cursor.PostID, Valid: true},
+		))
+	}
+
+	if limit > 0 {
+		qb = qb.Limit(uint64(limit))
+	}
+
+	query, args, err := qb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("PostRepository.ListFeed: build query: %w", err)
+	}
+
+	rows, err := r.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("PostRepository.ListFeed: %w", err)
+	}
+	defer rows.Close()
+
+	var summaries []*ports.PostSummary
+	for rows.Next() {
+		summary, err := scanPostSummaryFromRows(rows)
+		if err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, summary)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("PostRepository.ListFeed: rows error: %w", err)
+	}
+
+	return summaries, nil
+}
+
+// ListTranslations retrieves every post in a translation group
+func (r *PostRepository) ListTranslations(ctx context.Context, groupID uuid.UUID) ([]*ports.Translation, error) {
+	query, args, err := r.SB.
+		Select("id", "language", "title", "slug", "status").
+		From("posts").
+		Where(sq.Eq{
+			"translation_group_id": pgtype.UUID{Bytes: groupID, Valid: true},
+			"blog_id":              currentBlogID(ctx),
+		}).
+		OrderBy("language ASC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("PostRepository.ListTranslations: build query: %w", err)
+	}
+
+	rows, err := r.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("PostRepository.ListTranslations: %w", err)
+	}
+	defer rows.Close()
+
+	var translations []*ports.Translation
+	for rows.Next() {
+		var translation ports.Translation
+		var idBytes pgtype.UUID
+		var statusStr string
+		if err := rows.Scan(&idBytes, &translation.Language, &translation.Title, &translation.Slug, &statusStr); err != nil {
+			return nil, fmt.Errorf("PostRepository.ListTranslations: scan: %w", err)
+		}
+		translation.PostID = uuid.UUID(idBytes.Bytes)
+		translation.Status = domain.PostStatus(statusStr)
+		translations = append(translations, &translation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("PostRepository.ListTranslations: rows error: %w", err)
+	}
+
+	return translations, nil
+}
+
+// ListCalendar retrieves the posts placed on the calendar between from and to, inclusive
+// Archived posts and unpublished posts without a target publish date are left out
+func (r *PostRepository) ListCalendar(ctx context.Context, from, to time.Time, loc *time.Location) ([]domain.CalendarEntry, error) {
+	// Published posts fall on the day their publication instant has in loc
+	const calendarDate = "CASE WHEN status = 'published' THEN (published_at AT TIME ZONE ?)::date ELSE target_publish_date END"
+
+	query, args, err := r.SB.
+		Select("id", "title", "slug", "author_id", "status", "published_at").
+		Column(sq.Expr(calendarDate+" AS calendar_date", loc.String())).
+		From("posts").
+		Where(sq.Eq{"blog_id": currentBlogID(ctx)}).
+		Where(sq.Or{
+			sq.Eq{"status": string(domain.PostStatusPublished)},
+			sq.And{
+				sq.Eq{"status": statusStrings(domain.InProgressStatuses)},
+				sq.NotEq{"target_publish_date": nil},
+			},
+		}).
+		Where(sq.Expr(calendarDate+" BETWEEN ? AND ?",
+			loc.String(),
+			pgtype.Date{Time: from, Valid: true},
+			pgtype.Date{Time: to, Valid: true},
+		)).
+		OrderBy("calendar_date ASC", "published_at ASC NULLS LAST", "title ASC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("PostRepository.ListCalendar: build query: %w", err)
+	}
+
+	rows, err := r.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("PostRepository.ListCalendar: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []domain.CalendarEntry
+	for rows.Next() {
+		var entry domain.CalendarEntry
+		var idBytes, authorIDBytes pgtype.UUID
+		var statusStr string
+		var publishedAt pgtype.Timestamptz
+		var date pgtype.Date
+		if err := rows.Scan(&idBytes, &entry.Title, &entry.Slug, &authorIDBytes, &statusStr, &publishedAt, &date); err != nil {
+			return nil, fmt.Errorf("PostRepository.ListCalendar: scan: %w", err)
+		}
+		entry.PostID = uuid.UUID(idBytes.Bytes)
+		entry.AuthorID = uuid.UUID(authorIDBytes.Bytes)
+		entry.Status = domain.PostStatus(statusStr)
+		entry.Date = date.Time
+		if publishedAt.Valid {
+			entry.PublishedAt = &publishedAt.Time
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("PostRepository.ListCalendar: rows error: %w", err)
+	}
+
+	return entries, nil
+}
+
 // FindSummariesByAuthor retrieves post summaries by a specific author
 func (r *PostRepository) FindSummariesByAuthor(ctx context.Context, authorID uuid.UUID, filter ports.ListFilter) ([]*ports.PostSummary, error) {
 	// Override the filter to include the author
@@ -299,12 +573,54 @@ func (r *PostRepository) FindSummariesByAuthor(ctx context.Context, authorID uui
 	return r.ListSummaries(ctx, filter)
 }
 
+// ListByAuthorInEveryBlog retrieves every post of an author regardless of
+// blog and status, oldest first
+func (r *PostRepository) ListByAuthorInEveryBlog(ctx context.Context, authorID uuid.UUID) ([]*domain.Post, error) {
+	query, args, err := r.SB.
+		Select(
+			"id", "title", "content", "excerpt", "slug", "status",
+			"author_id", "published_at", "featured", "featured_at",
+			"language", "translation_group_id", "target_publish_date",
+			"meta_title", "meta_description", "canonical_url", "og_image_url",
+			"comment_mode", "comment_auto_close_days",
+			"visibility", "embargo_until", "premium",
+			"created_at", "updated_at",
+		).
+		From("posts").
+		Where(sq.Eq{"author_id": pgtype.UUID{Bytes: authorID, Valid: true}}).
+		OrderBy("created_at ASC", "id ASC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("PostRepository.ListByAuthorInEveryBlog: build query: %w", err)
+	}
+
+	rows, err := r.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("PostRepository.ListByAuthorInEveryBlog: %w", err)
+	}
+	defer rows.Close()
+
+	posts := make([]*domain.Post, 0)
+	for rows.Next() {
+		post, err := scanPost(rows)
+		if err != nil {
+			return nil, fmt.Errorf("PostRepository.ListByAuthorInEveryBlog: %w", err)
+		}
+		posts = append(posts, post)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("PostRepository.ListByAuthorInEveryBlog: %w", err)
+	}
+
+	return posts, nil
+}
+
 // GetPostAuthor retrieves just the author ID for a post (for ownership checks)
 func (r *PostRepository) GetPostAuthor(ctx context.Context, postID uuid.UUID) (uuid.UUID, error) {
 	query, args, err := r.SB.
 		Select("author_id").
 		From("posts").
-		Where(sq.Eq{"id": pgtype.UUID{Bytes: postID, Valid: true}}).
+		Where(sq.Eq{"id": pgtype.UUID{Bytes: postID, Valid: true}, "blog_id": currentBlogID(ctx)}).
 		ToSql()
 	if err != nil {
 		return uuid.Nil, fmt.Errorf("PostRepository.GetPostAuthor: build query: %w", err)
@@ -322,20 +638,131 @@ func (r *PostRepository) GetPostAuthor(ctx context.Context, postID uuid.UUID) (u
 	return uuid.UUID(authorIDBytes.Bytes), nil
 }
 
+// RenameAuthor rewrites the author name on up to limit of the author's posts
+// that still carry another one, in every blog, along with their read model rows.
+// Updated at is left alone: the post itself did not change.
+func (r *PostRepository) RenameAuthor(ctx context.Context, authorID uuid.UUID, name string, limit int) (int, error) {
+	query := `
+		WITH batch AS (
+			SELECT id FROM posts
+			WHERE author_id = $1 AND author_name IS DISTINCT FROM $2
+			LIMIT $3
+		),
+		renamed AS (
+			UPDATE posts p SET author_name = $2
+			FROM batch WHERE p.id = batch.id
+			RETURNING p.id
+		),
+		published AS (
+			UPDATE published_posts pp SET author_name = $2
+			FROM renamed WHERE pp.post_id = renamed.id
+		)
+		SELECT COUNT(*) FROM renamed`
+
+	var renamed int
+	err := r.DB.QueryRow(ctx, query, pgtype.UUID{Bytes: authorID, Valid: true}, name, limit).Scan(&renamed)
+	if err != nil {
+		return 0, fmt.Errorf("PostRepository.RenameAuthor: %w", err)
+	}
+	return renamed, nil
+}
+
 // Helper methods
 
+// selectSummaries builds the SELECT shared by all summary queries
+// The bookmarked column is only computed when a viewer is known
+func (r *PostRepository) selectSummaries(viewerID *uuid.UUID) sq.SelectBuilder {
+	qb := r.SB.Select(
+		"p.id", "p.title", "p.excerpt", "p.slug", "p.status",
+		"p.author_id", "p.author_name", // Copied from users, see RenameAuthor
+		"p.published_at", "p.featured", "p.featured_at", "p.language",
+		"p.created_at", "p.updated_at",
+		"(SELECT COUNT(*) FROM reactions rx WHERE rx.target_type = 'post' AND rx.target_id = p.id) AS reaction_count",
+	).
+		From("posts p")
+
+	// Flag posts the viewer has bookmarked
+	if viewerID != nil {
+		return qb.Column(sq.Expr(
+			"EXISTS(SELECT 1 FROM bookmarks b WHERE b.post_id = p.id AND b.user_id = ?) AS bookmarked",
+			pgtype.UUID{Bytes: *viewerID, Valid: true},
+		))
+	}
+	return qb.Column("FALSE AS bookmarked")
+}
+
+// listedForReaders matches the posts listed to readers other than their
+// author: published, out of embargo and not unlisted, with members-only posts
+// included for signed-in readers
+func listedForReaders(member bool) sq.And {
+	visibilities := []string{string(domain.VisibilityPublic)}
+	if member {
+		visibilities = append(visibilities, string(domain.VisibilityMembersOnly))
+	}
+	return sq.And{
+		sq.Eq{"p.status": string(domain.PostStatusPublished), "p.visibility": visibilities},
+		sq.Expr("(p.embargo_until IS NULL OR p.embargo_until <= NOW())"),
+	}
+}
+
 // applyFilters applies common WHERE clauses to a query builder
-func (r *PostRepository) applyFilters(qb sq.SelectBuilder, filter ports.ListFilter) sq.SelectBuilder {
+// Every query is scoped to the request's blog
+func (r *PostRepository) applyFilters(ctx context.Context, qb sq.SelectBuilder, filter ports.ListFilter) sq.SelectBuilder {
+	qb = qb.Where(sq.Eq{"p.blog_id": currentBlogID(ctx)})
+
+	// Restrict to the posts the viewer may read
+	if !filter.Visibility.AllStatuses {
+		published := listedForReaders(filter.Visibility.Member)
+		if filter.Visibility.OwnerID != nil {
+			qb = qb.Where(sq.Or{
+				published,
+				sq.Eq{"p.author_id": pgtype.UUID{Bytes: *filter.Visibility.OwnerID, Valid: true}},
+			})
+		} else {
+			qb = qb.Where(published)
+		}
+	}
+
 	// Add status filter
 	if filter.Status != nil {
 		qb = qb.Where(sq.Eq{"p.status": string(*filter.Status)})
 	}
 
+	// Add post filter
+	if len(filter.IDs) > 0 {
+		qb = qb.Where("p.id = ANY(?::uuid[])", uuidKeys(filter.IDs))
+	}
+
 	// Add author filter
 	if filter.AuthorID != nil {
 		qb = qb.Where(sq.Eq{"p.author_id": pgtype.UUID{Bytes: *filter.AuthorID, Valid: true}})
 	}
 
+	// Add featured filter
+	if filter.Featured != nil {
+		qb = qb.Where(sq.Eq{"p.featured": *filter.Featured})
+	}
+
+	// Add language filter
+	if filter.Language != "" {
+		qb = qb.Where(sq.Eq{"p.language": filter.Language})
+	}
+
+	// Collapse translation groups to the preferred language where one exists
+	if filter.PreferredLanguage != "" {
+		qb = qb.Where(sq.Expr(
+			`(p.translation_group_id IS NULL OR p.language = ? OR NOT EXISTS (
+				SELECT 1 FROM posts t
+				WHERE t.translation_group_id = p.translation_group_id
+				AND t.language = ? AND t.status = p.status
+			))`,
+			filter.PreferredLanguage, filter.PreferredLanguage,
+		))
+	}
+
+	// Add date range filters
+	qb = applyDateRanges(qb, filter)
+
 	// Add search query if provided
 	if filter.SearchQuery != "" {
 		searchPattern := "%" + filter.SearchQuery + "%"
@@ -348,6 +775,33 @@ func (r *PostRepository) applyFilters(qb sq.SelectBuilder, filter ports.ListFilt
 	return qb
 }
 
+// uuidKeys renders IDs as strings, which bind as a uuid[] parameter
+func uuidKeys(ids []uuid.UUID) []string {
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = id.String()
+	}
+	return keys
+}
+
+// applyDateRanges restricts a listing to the filter's publication and creation ranges.
+// Both posts and published_posts carry the columns, aliased as p.
+func applyDateRanges(qb sq.SelectBuilder, filter ports.ListFilter) sq.SelectBuilder {
+	if filter.PublishedAfter != nil {
+		qb = qb.Where(sq.GtOrEq{"p.published_at": *filter.PublishedAfter})
+	}
+	if filter.PublishedBefore != nil {
+		qb = qb.Where(sq.Lt{"p.published_at": *filter.PublishedBefore})
+	}
+	if filter.CreatedAfter != nil {
+		qb = qb.Where(sq.GtOrEq{"p.created_at": *filter.CreatedAfter})
+	}
+	if filter.CreatedBefore != nil {
+		qb = qb.Where(sq.Lt{"p.created_at": *filter.CreatedBefore})
+	}
+	return qb
+}
+
 // getOrderColumn validates and returns the actual column name for ordering
 func getOrderColumn(field ports.OrderField) string {
 	switch field {
@@ -359,17 +813,74 @@ func getOrderColumn(field ports.OrderField) string {
 		return "p.published_at"
 	case ports.OrderByTitle:
 		return "p.title"
+	case ports.OrderByFeaturedAt:
+		return "p.featured_at"
 	default:
 		return "p.created_at"
 	}
 }
 
+// orderClause returns the ORDER BY term for the filter's sort. Posts that were
+// never featured sort last by feature time whichever the direction; the other
+// columns keep PostgreSQL's default placing of NULLs.
+func orderClause(filter ports.ListFilter) string {
+	direction := "ASC"
+	if filter.OrderDesc {
+		direction = "DESC"
+	}
+	clause := getOrderColumn(filter.OrderBy) + " " + direction
+	if filter.OrderBy == ports.OrderByFeaturedAt {
+		clause += " NULLS LAST"
+	}
+	return clause
+}
+
+// toNullableUUID converts an optional UUID into a nullable uuid
+func toNullableUUID(id *uuid.UUID) pgtype.UUID {
+	if id == nil {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Bytes: *id, Valid: true}
+}
+
+// toNullableText stores an empty string as NULL
+func toNullableText(s string) pgtype.Text {
+	return pgtype.Text{String: s, Valid: s != ""}
+}
+
+// toNullableDate converts an optional calendar date into a nullable date
+func toNullableDate(t *time.Time) pgtype.Date {
+	if t == nil {
+		return pgtype.Date{}
+	}
+	return pgtype.Date{Time: *t, Valid: true}
+}
+
+// toNullableTimestamptz converts an optional time into a nullable timestamptz
+func toNullableTimestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, Valid: true}
+}
+
+// statusStrings converts post statuses into query arguments
+func statusStrings(statuses []domain.PostStatus) []string {
+	result := make([]string, len(statuses))
+	for i, status := range statuses {
+		result[i] = string(status)
+	}
+	return result
+}
+
 // scanPost scans a single post from pgx.Row
 func scanPost(row pgx.Row) (*domain.Post, error) {
 	var post domain.Post
-	var publishedAt pgtype.Timestamptz
-	var idBytes, authorIDBytes pgtype.UUID
-	var statusStr string
+	var publishedAt, featuredAt, embargoUntil pgtype.Timestamptz
+	var idBytes, authorIDBytes, translationGroupID pgtype.UUID
+	var targetPublishDate pgtype.Date
+	var statusStr, commentMode, visibility string
+	var metaTitle, metaDescription, canonicalURL, ogImageURL pgtype.Text
 
 	err := row.Scan(
 		&idBytes,
@@ -380,6 +891,20 @@ func scanPost(row pgx.Row) (*domain.Post, error) {
 		&statusStr,
 		&authorIDBytes,
 		&publishedAt,
+		&post.Featured,
+		&featuredAt,
+		&post.Language,
+		&translationGroupID,
+		&targetPublishDate,
+		&metaTitle,
+		&metaDescription,
+		&canonicalURL,
+		&ogImageURL,
+		&commentMode,
+		&post.CommentPolicy.AutoCloseAfterDays,
+		&visibility,
+		&embargoUntil,
+		&post.Premium,
 		&post.CreatedAt,
 		&post.UpdatedAt,
 	)
@@ -401,6 +926,30 @@ func scanPost(row pgx.Row) (*domain.Post, error) {
 	if publishedAt.Valid {
 		post.PublishedAt = &publishedAt.Time
 	}
+	if featuredAt.Valid {
+		post.FeaturedAt = &featuredAt.Time
+	}
+	if translationGroupID.Valid {
+		groupID := uuid.UUID(translationGroupID.Bytes)
+		post.TranslationGroupID = &groupID
+	}
+	if targetPublishDate.Valid {
+		post.TargetPublishDate = &targetPublishDate.Time
+	}
+
+	post.CommentPolicy.Mode = domain.CommentMode(commentMode)
+	post.AccessPolicy.Visibility = domain.PostVisibility(visibility)
+	if embargoUntil.Valid {
+		post.AccessPolicy.EmbargoUntil = &embargoUntil.Time
+	}
+
+	// Unset SEO fields are stored as NULL and read back as empty strings
+	post.SEO = domain.SEOMetadata{
+		MetaTitle:       metaTitle.String,
+		MetaDescription: metaDescription.String,
+		CanonicalURL:    canonicalURL.String,
+		OGImageURL:      ogImageURL.String,
+	}
 
 	return &post, nil
 }
@@ -408,7 +957,7 @@ func scanPost(row pgx.Row) (*domain.Post, error) {
 // scanPostSummaryFromRows scans a post summary from pgx.Rows
 func scanPostSummaryFromRows(rows pgx.Rows) (*ports.PostSummary, error) {
 	var summary ports.PostSummary
-	var publishedAt pgtype.Timestamptz
+	var publishedAt, featuredAt pgtype.Timestamptz
 	var idBytes, authorIDBytes pgtype.UUID
 	var statusStr string
 	var authorName pgtype.Text
@@ -422,8 +971,13 @@ func scanPostSummaryFromRows(rows pgx.Rows) (*ports.PostSummary, error) {
 		&authorIDBytes,
 		&authorName,
 		&publishedAt,
+		&summary.Featured,
+		&featuredAt,
+		&summary.Language,
 		&summary.CreatedAt,
 		&summary.UpdatedAt,
+		&summary.ReactionCount,
+		&summary.Bookmarked,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("scanPostSummaryFromRows: %w", err)
@@ -447,6 +1001,9 @@ func scanPostSummaryFromRows(rows pgx.Rows) (*ports.PostSummary, error) {
 	if publishedAt.Valid {
 		summary.PublishedAt = &publishedAt.Time
 	}
+	if featuredAt.Valid {
+		summary.FeaturedAt = &featuredAt.Time
+	}
 
 	return &summary, nil
 }
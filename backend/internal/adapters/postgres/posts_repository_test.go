@@ -0,0 +1,251 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"backend/internal/adapters/postgres"
+	"backend/internal/platform/tenant"
+	"backend/internal/posts/domain"
+	"backend/internal/posts/ports"
+	"backend/internal/testing/factory"
+	"backend/internal/testing/pgtest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostRepository_FindBySlug(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewPostRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	author := factory.NewUser().WithRole("author").Create(t, tx)
+	post := factory.NewPost(author.ID).Published().Create(t, tx)
+
+	found, err := repo.FindBySlug(ctx, post.Slug)
+	require.NoError(t, err)
+	assert.Equal(t, post.ID, found.ID)
+	assert.Equal(t, author.ID, found.AuthorID)
+	assert.Equal(t, domain.PostStatusPublished, found.Status)
+
+	_, err = repo.FindBySlug(ctx, "no-such-post")
+	assert.ErrorIs(t, err, ports.ErrPostNotFound)
+}
+
+func TestPostRepository_FindBySlugStaysWithinBlog(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewPostRepository(pgtest.Pool(t)).WithTx(tx)
+
+	author := factory.NewUser().Create(t, tx)
+	other := factory.NewBlog().Create(t, tx)
+	post := factory.NewPost(author.ID).Blog(other.ID).Published().Create(t, tx)
+
+	_, err := repo.FindBySlug(context.Background(), post.Slug)
+	assert.ErrorIs(t, err, ports.ErrPostNotFound, "posts of other blogs are invisible")
+
+	found, err := repo.FindBySlug(tenant.WithBlogID(context.Background(), other.ID), post.Slug)
+	require.NoError(t, err)
+	assert.Equal(t, post.ID, found.ID)
+}
+
+func BenchmarkPostRepository_ListSummaries(b *testing.B) {
+	tx := pgtest.Tx(b)
+	repo := postgres.NewPostRepository(pgtest.Pool(b)).WithTx(tx)
+	ctx := context.Background()
+
+	author := factory.NewUser().WithRole("author").Create(b, tx)
+	for i := 0; i < 500; i++ {
+		factory.NewPost(author.ID).Published().Create(b, tx)
+	}
+
+	viewer := factory.NewUser().Create(b, tx)
+	search := ports.DefaultListFilter()
+	search.SearchQuery = "test"
+
+	filters := []struct {
+		name   string
+		filter ports.ListFilter
+	}{
+		{"first page", ports.DefaultListFilter()},
+		{"deep page", ports.ListFilter{Limit: 20, Offset: 400, OrderBy: ports.OrderByPublishedAt, OrderDesc: true}},
+		{"by author", ports.ListFilter{AuthorID: &author.ID, Limit: 20, OrderBy: ports.OrderByCreatedAt}},
+		{"with viewer", ports.ListFilter{ViewerID: &viewer.ID, Limit: 20, OrderBy: ports.OrderByCreatedAt}},
+		{"search", search},
+	}
+
+	for _, f := range filters {
+		b.Run(f.name, func(b *testing.B) {
+			for b.Loop() {
+				if _, err := repo.ListSummaries(ctx, f.filter); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestPostRepository_ListSummariesShowsDraftsToTheirReaders(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewPostRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	author := factory.NewUser().WithRole("author").Create(t, tx)
+	stranger := factory.NewUser().WithRole("author").Create(t, tx)
+	published := factory.NewPost(author.ID).Published().Create(t, tx)
+	draft := factory.NewPost(author.ID).Create(t, tx)
+	strangersDraft := factory.NewPost(stranger.ID).Create(t, tx)
+
+	listed := func(visibility ports.Visibility) []uuid.UUID {
+		filter := ports.DefaultListFilter()
+		filter.Limit = 100
+		filter.Visibility = visibility
+		summaries, err := repo.ListSummaries(ctx, filter)
+		require.NoError(t, err)
+		ids := make([]uuid.UUID, len(summaries))
+		for i, summary := range summaries {
+			ids[i] = summary.ID
+		}
+		return ids
+	}
+
+	anonymous := listed(ports.Visibility{})
+	assert.Contains(t, anonymous, published.ID)
+	assert.NotContains(t, anonymous, draft.ID, "anonymous readers get published posts only")
+	assert.NotContains(t, anonymous, strangersDraft.ID)
+
+	own := listed(ports.Visibility{Member: true, OwnerID: &author.ID})
+	assert.Contains(t, own, published.ID)
+	assert.Contains(t, own, draft.ID, "authors list their own drafts")
+	assert.NotContains(t, own, strangersDraft.ID, "but not anyone else's")
+
+	everything := listed(ports.Visibility{AllStatuses: true})
+	assert.Contains(t, everything, published.ID)
+	assert.Contains(t, everything, draft.ID, "readers of any draft list every draft")
+	assert.Contains(t, everything, strangersDraft.ID)
+}
+
+func TestPostRepository_ListSummariesHonoursAccessPolicy(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewPostRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	author := factory.NewUser().WithRole("author").Create(t, tx)
+	public := factory.NewPost(author.ID).Published().Create(t, tx)
+	membersOnly := factory.NewPost(author.ID).Published().Create(t, tx)
+	unlisted := factory.NewPost(author.ID).Published().Create(t, tx)
+	embargoed := factory.NewPost(author.ID).Published().Create(t, tx)
+	_, err := tx.Exec(ctx, `UPDATE posts SET visibility = 'members_only' WHERE id = $1`, membersOnly.ID)
+	require.NoError(t, err)
+	_, err = tx.Exec(ctx, `UPDATE posts SET visibility = 'unlisted' WHERE id = $1`, unlisted.ID)
+	require.NoError(t, err)
+	_, err = tx.Exec(ctx, `UPDATE posts SET embargo_until = NOW() + INTERVAL '1 day' WHERE id = $1`, embargoed.ID)
+	require.NoError(t, err)
+
+	listed := func(visibility ports.Visibility) []uuid.UUID {
+		filter := ports.DefaultListFilter()
+		filter.AuthorID = &author.ID
+		filter.Visibility = visibility
+		summaries, err := repo.ListSummaries(ctx, filter)
+		require.NoError(t, err)
+		ids := make([]uuid.UUID, len(summaries))
+		for i, summary := range summaries {
+			ids[i] = summary.ID
+		}
+		return ids
+	}
+
+	assert.ElementsMatch(t, []uuid.UUID{public.ID}, listed(ports.Visibility{}))
+	assert.ElementsMatch(t, []uuid.UUID{public.ID, membersOnly.ID}, listed(ports.Visibility{Member: true}))
+	assert.ElementsMatch(t, []uuid.UUID{public.ID, membersOnly.ID, unlisted.ID, embargoed.ID},
+		listed(ports.Visibility{Member: true, OwnerID: &author.ID}), "authors list all of their posts")
+
+	found, err := repo.FindBySlug(ctx, unlisted.Slug)
+	require.NoError(t, err)
+	assert.Equal(t, domain.VisibilityUnlisted, found.AccessPolicy.Visibility, "unlisted posts still resolve by slug")
+}
+
+func TestPostRepository_ListSummariesPlacesNullsByTheRequestedSort(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewPostRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	author := factory.NewUser().WithRole("author").Create(t, tx)
+	older := factory.NewPost(author.ID).PublishedAt(time.Now().Add(-2*time.Hour)).Create(t, tx)
+	newer := factory.NewPost(author.ID).PublishedAt(time.Now().Add(-time.Hour)).Create(t, tx)
+	draft := factory.NewPost(author.ID).Create(t, tx)
+	_, err := tx.Exec(ctx, `UPDATE posts SET featured = TRUE, featured_at = NOW() WHERE id = $1`, older.ID)
+	require.NoError(t, err)
+
+	listed := func(orderBy ports.OrderField, desc bool) []uuid.UUID {
+		filter := ports.DefaultListFilter()
+		filter.AuthorID = &author.ID
+		filter.Visibility = ports.Visibility{AllStatuses: true}
+		filter.OrderBy = orderBy
+		filter.OrderDesc = desc
+		summaries, err := repo.ListSummaries(ctx, filter)
+		require.NoError(t, err)
+		ids := make([]uuid.UUID, len(summaries))
+		for i, summary := range summaries {
+			ids[i] = summary.ID
+		}
+		return ids
+	}
+
+	assert.Equal(t, []uuid.UUID{draft.ID, newer.ID, older.ID}, listed(ports.OrderByPublishedAt, true),
+		"unpublished drafts lead the newest first listing, as they always have")
+	assert.Equal(t, []uuid.UUID{older.ID, newer.ID, draft.ID}, listed(ports.OrderByPublishedAt, false))
+
+	featured := listed(ports.OrderByFeaturedAt, true)
+	require.Len(t, featured, 3)
+	assert.Equal(t, older.ID, featured[0], "posts never featured sort last by feature time")
+}
+
+func TestPostRepository_RenameAuthorWorksInBatches(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewPostRepository(pgtest.Pool(t)).WithTx(tx)
+	published := postgres.NewPublishedPostRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	author := factory.NewUser().WithRole("author").Create(t, tx)
+	first := factory.NewPost(author.ID).Published().Create(t, tx)
+	factory.NewPost(author.ID).Create(t, tx)
+	require.NoError(t, published.Refresh(ctx, first.ID))
+
+	renamed, err := repo.RenameAuthor(ctx, author.ID, "renamed_author", 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, renamed)
+	renamed, err = repo.RenameAuthor(ctx, author.ID, "renamed_author", 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, renamed)
+	renamed, err = repo.RenameAuthor(ctx, author.ID, "renamed_author", 1)
+	require.NoError(t, err)
+	assert.Zero(t, renamed, "every post already carries the name")
+
+	filter := ports.DefaultListFilter()
+	filter.AuthorID = &author.ID
+	summaries, err := published.ListSummaries(ctx, filter)
+	require.NoError(t, err)
+	require.Len(t, summaries, 1)
+	assert.Equal(t, "renamed_author", summaries[0].AuthorName, "the read model row follows the post")
+}
+
+func TestPostRepository_ListByAuthorInEveryBlog(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewPostRepository(pgtest.Pool(t)).WithTx(tx)
+
+	author := factory.NewUser().WithRole("author").Create(t, tx)
+	other := factory.NewBlog().Create(t, tx)
+	draft := factory.NewPost(author.ID).Create(t, tx)
+	elsewhere := factory.NewPost(author.ID).Blog(other.ID).Published().Create(t, tx)
+	factory.NewPost(factory.NewUser().Create(t, tx).ID).Published().Create(t, tx)
+
+	posts, err := repo.ListByAuthorInEveryBlog(context.Background(), author.ID)
+	require.NoError(t, err)
+	ids := make([]uuid.UUID, 0, len(posts))
+	for _, post := range posts {
+		ids = append(ids, post.ID)
+	}
+	assert.ElementsMatch(t, []uuid.UUID{draft.ID, elsewhere.ID}, ids, "only the author's posts, from every blog")
+}
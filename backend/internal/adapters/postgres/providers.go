@@ -1,8 +1,33 @@
 package postgres
 
 import (
+	analyticsPorts "backend/internal/analytics/ports"
+	announcementsPorts "backend/internal/announcements/ports"
+	apiclientsPorts "backend/internal/apiclients/ports"
 	authzPorts "backend/internal/authz/ports"
+	blogsPorts "backend/internal/blogs/ports"
+	bookmarksPorts "backend/internal/bookmarks/ports"
+	exportPorts "backend/internal/export/ports"
+	feedbackPorts "backend/internal/feedback/ports"
+	followsPorts "backend/internal/follows/ports"
+	impersonationPorts "backend/internal/impersonation/ports"
+	integrityPorts "backend/internal/integrity/ports"
+	linkreportsPorts "backend/internal/linkreports/ports"
+	mentionsPorts "backend/internal/mentions/ports"
+	notificationsPorts "backend/internal/notifications/ports"
+	organizationsPorts "backend/internal/organizations/ports"
+	"backend/internal/platform/signedlink"
 	postsPorts "backend/internal/posts/ports"
+	privacyPorts "backend/internal/privacy/ports"
+	quotasPorts "backend/internal/quotas/ports"
+	reactionsPorts "backend/internal/reactions/ports"
+	reportsPorts "backend/internal/reports/ports"
+	retentionPorts "backend/internal/retention/ports"
+	searchPorts "backend/internal/search/ports"
+	securityPorts "backend/internal/security/ports"
+	seriesPorts "backend/internal/series/ports"
+	serviceaccountsPorts "backend/internal/serviceaccounts/ports"
+	settingsPorts "backend/internal/settings/ports"
 	themesPorts "backend/internal/themes/ports"
 	"github.com/google/wire"
 )
@@ -15,6 +40,62 @@ var ProviderSet = wire.NewSet(
 	wire.Bind(new(authzPorts.AuthzRepository), new(*AuthzRepository)),
 	NewPostRepository,
 	wire.Bind(new(postsPorts.PostRepository), new(*PostRepository)),
+	NewPublishedPostRepository,
+	wire.Bind(new(postsPorts.PublishedPostRepository), new(*PublishedPostRepository)),
 	NewThemeRepository,
 	wire.Bind(new(themesPorts.ThemeRepository), new(*ThemeRepository)),
+	NewThemeCollaboratorRepository,
+	wire.Bind(new(themesPorts.CollaboratorRepository), new(*ThemeCollaboratorRepository)),
+	NewSeriesRepository,
+	wire.Bind(new(seriesPorts.SeriesRepository), new(*SeriesRepository)),
+	NewReactionRepository,
+	wire.Bind(new(reactionsPorts.ReactionRepository), new(*ReactionRepository)),
+	NewReportRepository,
+	wire.Bind(new(reportsPorts.ReportRepository), new(*ReportRepository)),
+	NewBookmarkRepository,
+	wire.Bind(new(bookmarksPorts.BookmarkRepository), new(*BookmarkRepository)),
+	NewFollowRepository,
+	wire.Bind(new(followsPorts.FollowRepository), new(*FollowRepository)),
+	NewNotificationRepository,
+	wire.Bind(new(notificationsPorts.NotificationRepository), new(*NotificationRepository)),
+	NewExportRepository,
+	wire.Bind(new(exportPorts.ExportRepository), new(*ExportRepository)),
+	NewBlogRepository,
+	wire.Bind(new(blogsPorts.BlogRepository), new(*BlogRepository)),
+	NewAnnouncementRepository,
+	wire.Bind(new(announcementsPorts.AnnouncementRepository), new(*AnnouncementRepository)),
+	NewFeedbackRepository,
+	wire.Bind(new(feedbackPorts.FeedbackRepository), new(*FeedbackRepository)),
+	NewMentionRepository,
+	wire.Bind(new(mentionsPorts.MentionRepository), new(*MentionRepository)),
+	NewViewRepository,
+	wire.Bind(new(analyticsPorts.ViewRepository), new(*ViewRepository)),
+	NewSearchRepository,
+	wire.Bind(new(searchPorts.SuggestionRepository), new(*SearchRepository)),
+	NewIntegrityRepository,
+	wire.Bind(new(integrityPorts.IntegrityRepository), new(*IntegrityRepository)),
+	NewRetentionRepository,
+	wire.Bind(new(retentionPorts.RetentionRepository), new(*RetentionRepository)),
+	NewLinkReportRepository,
+	wire.Bind(new(linkreportsPorts.LinkReportRepository), new(*LinkReportRepository)),
+	NewSettingsRepository,
+	wire.Bind(new(settingsPorts.SettingsRepository), new(*SettingsRepository)),
+	NewAPIClientRepository,
+	wire.Bind(new(apiclientsPorts.ClientRepository), new(*APIClientRepository)),
+	NewQuotaRepository,
+	wire.Bind(new(quotasPorts.QuotaRepository), new(*QuotaRepository)),
+	NewImpersonationRepository,
+	wire.Bind(new(impersonationPorts.SessionRepository), new(*ImpersonationRepository)),
+	NewSignedLinkRepository,
+	wire.Bind(new(signedlink.NonceStore), new(*SignedLinkRepository)),
+	NewOrganizationRepository,
+	wire.Bind(new(organizationsPorts.OrganizationRepository), new(*OrganizationRepository)),
+	NewServiceAccountRepository,
+	wire.Bind(new(serviceaccountsPorts.AccountRepository), new(*ServiceAccountRepository)),
+	NewErasureRepository,
+	wire.Bind(new(privacyPorts.ErasureRepository), new(*ErasureRepository)),
+	NewDataExportRepository,
+	wire.Bind(new(privacyPorts.DataExportRepository), new(*DataExportRepository)),
+	NewSecurityEventRepository,
+	wire.Bind(new(securityPorts.SecurityEventRepository), new(*SecurityEventRepository)),
 )
@@ -0,0 +1,273 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"backend/internal/platform/postgres"
+	"backend/internal/posts/domain"
+	"backend/internal/posts/ports"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// publishedPostColumns are the read model's columns, in the order publishedPostSource selects them
+const publishedPostColumns = `post_id, blog_id, title, slug, content, excerpt, author_id, author_name,
+	published_at, featured, featured_at, language, translation_group_id, target_publish_date,
+	meta_title, meta_description, canonical_url, og_image_url,
+	comment_mode, comment_auto_close_days, visibility, embargo_until, premium, created_at, updated_at`
+
+// publishedPostSource selects the read model rows of published posts; append
+// AND conditions to narrow it
+const publishedPostSource = `
+	SELECT p.id, p.blog_id, p.title, p.slug, p.content, p.excerpt, p.author_id, p.author_name,
+		p.published_at, p.featured, p.featured_at, p.language, p.translation_group_id, p.target_publish_date,
+		p.meta_title, p.meta_description, p.canonical_url, p.og_image_url,
+		p.comment_mode, p.comment_auto_close_days, p.visibility, p.embargo_until, p.premium, p.created_at, p.updated_at
+	FROM posts p
+	WHERE p.status = 'published'`
+
+// publishedPostUpsert overwrites a post's existing read model row
+const publishedPostUpsert = `
+	ON CONFLICT (post_id) DO UPDATE SET
+		blog_id = EXCLUDED.blog_id, title = EXCLUDED.title, slug = EXCLUDED.slug,
+		content = EXCLUDED.content, excerpt = EXCLUDED.excerpt,
+		author_id = EXCLUDED.author_id, author_name = EXCLUDED.author_name,
+		published_at = EXCLUDED.published_at, featured = EXCLUDED.featured, featured_at = EXCLUDED.featured_at,
+		language = EXCLUDED.language, translation_group_id = EXCLUDED.translation_group_id,
+		target_publish_date = EXCLUDED.target_publish_date,
+		meta_title = EXCLUDED.meta_title, meta_description = EXCLUDED.meta_description,
+		canonical_url = EXCLUDED.canonical_url, og_image_url = EXCLUDED.og_image_url,
+		comment_mode = EXCLUDED.comment_mode, comment_auto_close_days = EXCLUDED.comment_auto_close_days,
+		visibility = EXCLUDED.visibility, embargo_until = EXCLUDED.embargo_until, premium = EXCLUDED.premium,
+		created_at = EXCLUDED.created_at, updated_at = EXCLUDED.updated_at`
+
+// PublishedPostRepository implements the posts.PublishedPostRepository interface using PostgreSQL
+type PublishedPostRepository struct {
+	postgres.BaseRepository
+}
+
+// NewPublishedPostRepository creates a new PostgreSQL published posts repository
+func NewPublishedPostRepository(db *pgxpool.Pool) *PublishedPostRepository {
+	return &PublishedPostRepository{
+		BaseRepository: postgres.NewBaseRepository(db),
+	}
+}
+
+// WithTx returns a new repository instance that uses the provided transaction
+func (r *PublishedPostRepository) WithTx(tx pgx.Tx) ports.PublishedPostRepository {
+	return &PublishedPostRepository{
+		BaseRepository: r.BaseRepository.WithTx(tx),
+	}
+}
+
+// Refresh rebuilds the post's row from the posts table in one statement.
+// Post IDs are unique across blogs, so it needs no blog scope and runs from
+// event handlers whatever blog their context carries.
+func (r *PublishedPostRepository) Refresh(ctx context.Context, postID uuid.UUID) error {
+	query := `
+		WITH removed AS (
+			DELETE FROM published_posts
+			WHERE post_id = $1
+				AND NOT EXISTS (SELECT 1 FROM posts p WHERE p.id = $1 AND p.status = 'published')
+		)
+		INSERT INTO published_posts (` + publishedPostColumns + `)` +
+		publishedPostSource + ` AND p.id = $1` +
+		publishedPostUpsert
+
+	if _, err := r.DB.Exec(ctx, query, pgtype.UUID{Bytes: postID, Valid: true}); err != nil {
+		return fmt.Errorf("PublishedPostRepository.Refresh: %w", err)
+	}
+	return nil
+}
+
+// FindBySlug retrieves a published post by its slug in the current blog
+func (r *PublishedPostRepository) FindBySlug(ctx context.Context, slug string) (*domain.Post, error) {
+	query, args, err := r.SB.
+		Select(
+			"post_id", "title", "content", "excerpt", "slug", "'published'",
+			"author_id", "published_at", "featured", "featured_at",
+			"language", "translation_group_id", "target_publish_date",
+			"meta_title", "meta_description", "canonical_url", "og_image_url",
+			"comment_mode", "comment_auto_close_days",
+			"visibility", "embargo_until", "premium",
+			"created_at", "updated_at",
+		).
+		From("published_posts").
+		Where(sq.Eq{"slug": slug, "blog_id": currentBlogID(ctx)}).
+		// Two rows share a slug only until a rename is refreshed; the newer one holds it now
+		OrderBy("updated_at DESC").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("PublishedPostRepository.FindBySlug: build query: %w", err)
+	}
+
+	post, err := scanPost(r.DB.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrPostNotFound
+		}
+		return nil, fmt.Errorf("PublishedPostRepository.FindBySlug: %w", err)
+	}
+	return post, nil
+}
+
+// ListSummaries retrieves summaries of published posts in the current blog
+func (r *PublishedPostRepository) ListSummaries(ctx context.Context, filter ports.ListFilter) ([]*ports.PostSummary, error) {
+	// Columns match PostRepository.selectSummaries so rows scan the same way
+	qb := r.SB.Select(
+		"p.post_id", "p.title", "p.excerpt", "p.slug", "'published' AS status",
+		"p.author_id", "p.author_name",
+		"p.published_at", "p.featured", "p.featured_at", "p.language",
+		"p.created_at", "p.updated_at",
+		"(SELECT COUNT(*) FROM reactions rx WHERE rx.target_type = 'post' AND rx.target_id = p.post_id) AS reaction_count",
+		"FALSE AS bookmarked",
+	).From("published_posts p")
+	qb = r.applyFilters(ctx, qb, filter)
+
+	qb = qb.OrderBy(orderClause(filter))
+
+	if filter.Limit > 0 {
+		qb = qb.Limit(uint64(filter.Limit))
+	}
+	if filter.Offset > 0 {
+		qb = qb.Offset(uint64(filter.Offset))
+	}
+
+	query, args, err := qb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("PublishedPostRepository.ListSummaries: build query: %w", err)
+	}
+
+	rows, err := r.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("PublishedPostRepository.ListSummaries: %w", err)
+	}
+	defer rows.Close()
+
+	var summaries []*ports.PostSummary
+	for rows.Next() {
+		summary, err := scanPostSummaryFromRows(rows)
+		if err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, summary)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("PublishedPostRepository.ListSummaries: rows error: %w", err)
+	}
+
+	return summaries, nil
+}
+
+// Count returns the number of published posts matching the filter
+func (r *PublishedPostRepository) Count(ctx context.Context, filter ports.ListFilter) (int, error) {
+	qb := r.applyFilters(ctx, r.SB.Select("COUNT(*)").From("published_posts p"), filter)
+
+	query, args, err := qb.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("PublishedPostRepository.Count: build query: %w", err)
+	}
+
+	var count int
+	if err := r.DB.QueryRow(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("PublishedPostRepository.Count: %w", err)
+	}
+	return count, nil
+}
+
+// CountByMonth counts the published posts matching the filter by UTC publication month, newest first
+func (r *PublishedPostRepository) CountByMonth(ctx context.Context, filter ports.ListFilter) ([]ports.ArchiveMonth, error) {
+	qb := r.SB.Select(
+		"EXTRACT(YEAR FROM p.published_at AT TIME ZONE 'UTC')::int AS year",
+		"EXTRACT(MONTH FROM p.published_at AT TIME ZONE 'UTC')::int AS month",
+		"COUNT(*)",
+	).From("published_posts p")
+	qb = r.applyFilters(ctx, qb, filter).
+		GroupBy("year", "month").
+		OrderBy("year DESC", "month DESC")
+
+	query, args, err := qb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("PublishedPostRepository.CountByMonth: build query: %w", err)
+	}
+
+	rows, err := r.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("PublishedPostRepository.CountByMonth: %w", err)
+	}
+	defer rows.Close()
+
+	months := make([]ports.ArchiveMonth, 0)
+	for rows.Next() {
+		var year, month, count int
+		if err := rows.Scan(&year, &month, &count); err != nil {
+			return nil, fmt.Errorf("PublishedPostRepository.CountByMonth: scan: %w", err)
+		}
+		months = append(months, ports.ArchiveMonth{Year: year, Month: time.Month(month), Count: count})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("PublishedPostRepository.CountByMonth: rows error: %w", err)
+	}
+	return months, nil
+}
+
+// applyFilters mirrors PostRepository.applyFilters for rows that are all
+// published. Readers here are anonymous, so they are only shown public posts
+// out of embargo.
+func (r *PublishedPostRepository) applyFilters(ctx context.Context, qb sq.SelectBuilder, filter ports.ListFilter) sq.SelectBuilder {
+	qb = qb.Where(sq.Eq{"p.blog_id": currentBlogID(ctx), "p.visibility": domain.VisibilityPublic}).
+		Where("(p.embargo_until IS NULL OR p.embargo_until <= NOW())")
+
+	// Asking for any other status matches nothing here
+	if filter.Status != nil && *filter.Status != domain.PostStatusPublished {
+		qb = qb.Where("FALSE")
+	}
+
+	if len(filter.IDs) > 0 {
+		qb = qb.Where("p.post_id = ANY(?::uuid[])", uuidKeys(filter.IDs))
+	}
+
+	if filter.AuthorID != nil {
+		qb = qb.Where(sq.Eq{"p.author_id": pgtype.UUID{Bytes: *filter.AuthorID, Valid: true}})
+	}
+
+	if filter.Featured != nil {
+		qb = qb.Where(sq.Eq{"p.featured": *filter.Featured})
+	}
+
+	if filter.Language != "" {
+		qb = qb.Where(sq.Eq{"p.language": filter.Language})
+	}
+
+	// Collapse translation groups to the preferred language where one is published
+	if filter.PreferredLanguage != "" {
+		qb = qb.Where(sq.Expr(
+			`(p.translation_group_id IS NULL OR p.language = ? OR NOT EXISTS (
+				SELECT 1 FROM published_posts t
+				WHERE t.translation_group_id = p.translation_group_id AND t.language = ?
+			))`,
+			filter.PreferredLanguage, filter.PreferredLanguage,
+		))
+	}
+
+	qb = applyDateRanges(qb, filter)
+
+	if filter.SearchQuery != "" {
+		searchPattern := "%" + filter.SearchQuery + "%"
+		qb = qb.Where(sq.Or{
+			sq.Like{"p.title": searchPattern},
+			sq.Like{"p.excerpt": searchPattern},
+		})
+	}
+
+	return qb
+}
@@ -0,0 +1,161 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"backend/internal/adapters/postgres"
+	integrity "backend/internal/integrity/domain"
+	"backend/internal/posts/domain"
+	"backend/internal/posts/ports"
+	"backend/internal/testing/factory"
+	"backend/internal/testing/pgtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishedPostRepository_RefreshFollowsStatus(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewPublishedPostRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	author := factory.NewUser().WithRole("author").Create(t, tx)
+	post := factory.NewPost(author.ID).Published().Create(t, tx)
+
+	_, err := repo.FindBySlug(ctx, post.Slug)
+	assert.ErrorIs(t, err, ports.ErrPostNotFound, "factories write posts only")
+
+	require.NoError(t, repo.Refresh(ctx, post.ID))
+	found, err := repo.FindBySlug(ctx, post.Slug)
+	require.NoError(t, err)
+	assert.Equal(t, post.ID, found.ID)
+	assert.Equal(t, domain.PostStatusPublished, found.Status)
+
+	_, err = tx.Exec(ctx, `UPDATE posts SET status = 'archived' WHERE id = $1`, post.ID)
+	require.NoError(t, err)
+	require.NoError(t, repo.Refresh(ctx, post.ID))
+	_, err = repo.FindBySlug(ctx, post.Slug)
+	assert.ErrorIs(t, err, ports.ErrPostNotFound, "archiving removes the row")
+}
+
+func TestPublishedPostRepository_ListSummaries(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewPublishedPostRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	author := factory.NewUser().WithRole("author").Create(t, tx)
+	published := factory.NewPost(author.ID).Published().Create(t, tx)
+	draft := factory.NewPost(author.ID).Create(t, tx)
+	for _, p := range []factory.Post{published, draft} {
+		require.NoError(t, repo.Refresh(ctx, p.ID))
+	}
+
+	filter := ports.DefaultListFilter()
+	filter.AuthorID = &author.ID
+	summaries, err := repo.ListSummaries(ctx, filter)
+	require.NoError(t, err)
+	require.Len(t, summaries, 1)
+	assert.Equal(t, published.ID, summaries[0].ID)
+	assert.Equal(t, author.Username, summaries[0].AuthorName)
+
+	count, err := repo.Count(ctx, filter)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	draftStatus := domain.PostStatusDraft
+	filter.Status = &draftStatus
+	count, err = repo.Count(ctx, filter)
+	require.NoError(t, err)
+	assert.Zero(t, count, "the read model holds no drafts")
+}
+
+func TestPublishedPostRepository_ListSummariesWithinPublishedRange(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewPublishedPostRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	march := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	april := march.AddDate(0, 1, 0)
+
+	author := factory.NewUser().WithRole("author").Create(t, tx)
+	before := factory.NewPost(author.ID).PublishedAt(march.Add(-time.Second)).Create(t, tx)
+	first := factory.NewPost(author.ID).PublishedAt(march).Create(t, tx)
+	after := factory.NewPost(author.ID).PublishedAt(april).Create(t, tx)
+	for _, p := range []factory.Post{before, first, after} {
+		require.NoError(t, repo.Refresh(ctx, p.ID))
+	}
+
+	filter := ports.DefaultListFilter()
+	filter.AuthorID = &author.ID
+	filter.PublishedAfter = &march
+	filter.PublishedBefore = &april
+	summaries, err := repo.ListSummaries(ctx, filter)
+	require.NoError(t, err)
+	require.Len(t, summaries, 1, "the range includes its start and excludes its end")
+	assert.Equal(t, first.ID, summaries[0].ID)
+
+	count, err := repo.Count(ctx, filter)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestPublishedPostRepository_CountByMonth(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewPublishedPostRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	author := factory.NewUser().WithRole("author").Create(t, tx)
+	posts := []factory.Post{
+		factory.NewPost(author.ID).PublishedAt(time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)).Create(t, tx),
+		factory.NewPost(author.ID).PublishedAt(time.Date(2024, time.March, 31, 23, 59, 0, 0, time.UTC)).Create(t, tx),
+		factory.NewPost(author.ID).PublishedAt(time.Date(2023, time.December, 24, 12, 0, 0, 0, time.UTC)).Create(t, tx),
+	}
+	for _, p := range posts {
+		require.NoError(t, repo.Refresh(ctx, p.ID))
+	}
+
+	months, err := repo.CountByMonth(ctx, ports.ListFilter{AuthorID: &author.ID})
+	require.NoError(t, err)
+	assert.Equal(t, []ports.ArchiveMonth{
+		{Year: 2024, Month: time.March, Count: 2},
+		{Year: 2023, Month: time.December, Count: 1},
+	}, months)
+}
+
+func TestIntegrityRepository_RefreshesPublishedPosts(t *testing.T) {
+	tx := pgtest.Tx(t)
+	published := postgres.NewPublishedPostRepository(pgtest.Pool(t)).WithTx(tx)
+	repo := postgres.NewIntegrityRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	author := factory.NewUser().WithRole("author").Create(t, tx)
+	missing := factory.NewPost(author.ID).Published().Create(t, tx)
+	unpublished := factory.NewPost(author.ID).Published().Create(t, tx)
+	require.NoError(t, published.Refresh(ctx, unpublished.ID))
+
+	// Change the post without the event that would refresh its row
+	_, err := tx.Exec(ctx, `UPDATE posts SET status = 'draft', published_at = NULL WHERE id = $1`, unpublished.ID)
+	require.NoError(t, err)
+
+	findings, err := repo.FindViolations(ctx, integrity.CheckPublishedPosts)
+	require.NoError(t, err)
+	details := make(map[string]string, len(findings))
+	for _, f := range findings {
+		details[f.OwnerID.String()] = f.Detail
+	}
+	assert.Equal(t, "missing from the read model", details[missing.ID.String()])
+	assert.Equal(t, "kept in the read model while draft", details[unpublished.ID.String()])
+
+	_, err = repo.Repair(ctx, integrity.CheckPublishedPosts)
+	require.NoError(t, err)
+
+	findings, err = repo.FindViolations(ctx, integrity.CheckPublishedPosts)
+	require.NoError(t, err)
+	assert.Empty(t, findings)
+
+	_, err = published.FindBySlug(ctx, missing.Slug)
+	assert.NoError(t, err)
+	_, err = published.FindBySlug(ctx, unpublished.Slug)
+	assert.ErrorIs(t, err, ports.ErrPostNotFound)
+}
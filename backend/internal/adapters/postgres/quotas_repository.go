@@ -0,0 +1,134 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"backend/internal/platform/postgres"
+	"backend/internal/quotas/domain"
+	"backend/internal/quotas/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// foreignKeyViolation is the PostgreSQL error code for a foreign key violation
+const foreignKeyViolation = "23503"
+
+// QuotaRepository implements the quotas.QuotaRepository interface using PostgreSQL
+type QuotaRepository struct {
+	postgres.BaseRepository
+}
+
+// NewQuotaRepository creates a new PostgreSQL quota repository
+func NewQuotaRepository(db *pgxpool.Pool) *QuotaRepository {
+	return &QuotaRepository{
+		BaseRepository: postgres.NewBaseRepository(db),
+	}
+}
+
+// WithTx returns a new repository instance that uses the provided transaction
+func (r *QuotaRepository) WithTx(tx pgx.Tx) *QuotaRepository {
+	return &QuotaRepository{
+		BaseRepository: r.BaseRepository.WithTx(tx),
+	}
+}
+
+// FindOverride retrieves a user's override in the current blog
+func (r *QuotaRepository) FindOverride(ctx context.Context, userID uuid.UUID) (*domain.Override, error) {
+	var updatedBy pgtype.UUID
+	override := domain.Override{UserID: userID}
+	err := r.DB.QueryRow(ctx, `
+		SELECT max_drafts, max_post_bytes, max_themes, updated_by, updated_at
+		FROM user_limits WHERE blog_id = $1 AND user_id = $2`,
+		currentBlogID(ctx), pgtype.UUID{Bytes: userID, Valid: true},
+	).Scan(&override.MaxDrafts, &override.MaxPostBytes, &override.MaxThemes, &updatedBy, &override.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrOverrideNotFound
+		}
+		return nil, fmt.Errorf("QuotaRepository.FindOverride: %w", err)
+	}
+	if updatedBy.Valid {
+		override.UpdatedBy = uuid.UUID(updatedBy.Bytes)
+	}
+	return &override, nil
+}
+
+// SaveOverride creates or replaces a user's override in the current blog
+func (r *QuotaRepository) SaveOverride(ctx context.Context, override *domain.Override) error {
+	_, err := r.DB.Exec(ctx, `
+		INSERT INTO user_limits (blog_id, user_id, max_drafts, max_post_bytes, max_themes, updated_by, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (blog_id, user_id) DO UPDATE SET
+			max_drafts = EXCLUDED.max_drafts,
+			max_post_bytes = EXCLUDED.max_post_bytes,
+			max_themes = EXCLUDED.max_themes,
+			updated_by = EXCLUDED.updated_by,
+			updated_at = EXCLUDED.updated_at`,
+		currentBlogID(ctx),
+		pgtype.UUID{Bytes: override.UserID, Valid: true},
+		override.MaxDrafts,
+		override.MaxPostBytes,
+		override.MaxThemes,
+		pgtype.UUID{Bytes: override.UpdatedBy, Valid: true},
+		override.UpdatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return ports.ErrUserNotFound
+		}
+		return fmt.Errorf("QuotaRepository.SaveOverride: %w", err)
+	}
+	return nil
+}
+
+// DeleteOverride removes a user's override in the current blog
+func (r *QuotaRepository) DeleteOverride(ctx context.Context, userID uuid.UUID) error {
+	result, err := r.DB.Exec(ctx,
+		`DELETE FROM user_limits WHERE blog_id = $1 AND user_id = $2`,
+		currentBlogID(ctx), pgtype.UUID{Bytes: userID, Valid: true},
+	)
+	if err != nil {
+		return fmt.Errorf("QuotaRepository.DeleteOverride: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return ports.ErrOverrideNotFound
+	}
+	return nil
+}
+
+// CountDrafts counts the user's posts in the current blog that are not published or archived
+func (r *QuotaRepository) CountDrafts(ctx context.Context, userID uuid.UUID) (int, error) {
+	var count int
+	err := r.DB.QueryRow(ctx, `
+		SELECT COUNT(*) FROM posts
+		WHERE blog_id = $1 AND author_id = $2 AND status NOT IN ('published', 'archived')`,
+		currentBlogID(ctx), pgtype.UUID{Bytes: userID, Valid: true},
+	).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("QuotaRepository.CountDrafts: %w", err)
+	}
+	return count, nil
+}
+
+// CountThemes counts the user's unarchived themes in the current blog, leaving out the trash
+func (r *QuotaRepository) CountThemes(ctx context.Context, userID uuid.UUID) (int, error) {
+	var count int
+	err := r.DB.QueryRow(ctx, `
+		SELECT COUNT(*) FROM themes
+		WHERE blog_id = $1 AND curator_id = $2 AND status <> 'archived' AND deleted_at IS NULL`,
+		currentBlogID(ctx), pgtype.UUID{Bytes: userID, Valid: true},
+	).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("QuotaRepository.CountThemes: %w", err)
+	}
+	return count, nil
+}
+
+// Compile-time check to ensure QuotaRepository implements ports.QuotaRepository
+var _ ports.QuotaRepository = (*QuotaRepository)(nil)
@@ -0,0 +1,79 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"backend/internal/adapters/postgres"
+	"backend/internal/quotas/domain"
+	"backend/internal/quotas/ports"
+	"backend/internal/testing/factory"
+	"backend/internal/testing/pgtest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuotaRepository_OverrideRoundTrip(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewQuotaRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	admin := factory.NewUser().Create(t, tx)
+	author := factory.NewUser().Create(t, tx)
+
+	_, err := repo.FindOverride(ctx, author.ID)
+	assert.ErrorIs(t, err, ports.ErrOverrideNotFound)
+
+	drafts := 5
+	override, err := domain.NewOverride(author.ID, &drafts, nil, nil, admin.ID)
+	require.NoError(t, err)
+	require.NoError(t, repo.SaveOverride(ctx, override))
+
+	// Saving again replaces the limits, clearing those left out
+	themes := 2
+	override, err = domain.NewOverride(author.ID, nil, nil, &themes, admin.ID)
+	require.NoError(t, err)
+	require.NoError(t, repo.SaveOverride(ctx, override))
+
+	found, err := repo.FindOverride(ctx, author.ID)
+	require.NoError(t, err)
+	assert.Nil(t, found.MaxDrafts)
+	require.NotNil(t, found.MaxThemes)
+	assert.Equal(t, 2, *found.MaxThemes)
+	assert.Equal(t, admin.ID, found.UpdatedBy)
+
+	require.NoError(t, repo.DeleteOverride(ctx, author.ID))
+	assert.ErrorIs(t, repo.DeleteOverride(ctx, author.ID), ports.ErrOverrideNotFound)
+
+	override, err = domain.NewOverride(uuid.New(), &drafts, nil, nil, admin.ID)
+	require.NoError(t, err)
+	assert.ErrorIs(t, repo.SaveOverride(ctx, override), ports.ErrUserNotFound)
+}
+
+func TestQuotaRepository_CountDraftsAndThemes(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewQuotaRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	author := factory.NewUser().Create(t, tx)
+	other := factory.NewUser().Create(t, tx)
+
+	factory.NewPost(author.ID).Create(t, tx)
+	factory.NewPost(author.ID).Create(t, tx)
+	factory.NewPost(author.ID).Published().Create(t, tx)
+	factory.NewPost(author.ID).Status("archived").Create(t, tx)
+	factory.NewPost(other.ID).Create(t, tx)
+
+	factory.NewTheme(author.ID).Create(t, tx)
+	factory.NewTheme(author.ID).Active().Create(t, tx)
+	factory.NewTheme(other.ID).Create(t, tx)
+
+	drafts, err := repo.CountDrafts(ctx, author.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, drafts)
+
+	themes, err := repo.CountThemes(ctx, author.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, themes)
+}
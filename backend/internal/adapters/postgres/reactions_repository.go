@@ -0,0 +1,173 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"backend/internal/platform/postgres"
+	"backend/internal/reactions/domain"
+	"backend/internal/reactions/ports"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ReactionRepository implements the reactions.ReactionRepository interface using PostgreSQL
+type ReactionRepository struct {
+	postgres.BaseRepository // Embed the base repository for common functionality
+}
+
+// NewReactionRepository creates a new PostgreSQL reactions repository
+func NewReactionRepository(db *pgxpool.Pool) *ReactionRepository {
+	return &ReactionRepository{
+		BaseRepository: postgres.NewBaseRepository(db),
+	}
+}
+
+// Save inserts a reaction, or replaces the type of the user's existing reaction on the target
+func (r *ReactionRepository) Save(ctx context.Context, reaction *domain.Reaction) error {
+	query, args, err := r.SB.
+		Insert("reactions").
+		Columns(
+			"id", "user_id", "target_type", "target_id", "reaction_type",
+			"created_at", "updated_at",
+		).
+		Values(
+			pgtype.UUID{Bytes: reaction.ID, Valid: true},
+			pgtype.UUID{Bytes: reaction.UserID, Valid: true},
+			string(reaction.TargetType),
+			pgtype.UUID{Bytes: reaction.TargetID, Valid: true},
+			string(reaction.Type),
+			pgtype.Timestamptz{Time: reaction.CreatedAt, Valid: true},
+			pgtype.Timestamptz{Time: reaction.UpdatedAt, Valid: true},
+		).
+		Suffix("ON CONFLICT (user_id, target_type, target_id) DO UPDATE SET reaction_type = EXCLUDED.reaction_type, updated_at = EXCLUDED.updated_at").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("ReactionRepository.Save: build query: %w", err)
+	}
+
+	_, err = r.DB.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("ReactionRepository.Save: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes a user's reaction from a target
+func (r *ReactionRepository) Delete(ctx context.Context, userID uuid.UUID, targetType domain.TargetType, targetID uuid.UUID) error {
+	query, args, err := r.SB.
+		Delete("reactions").
+		Where(sq.Eq{
+			"user_id":     pgtype.UUID{Bytes: userID, Valid: true},
+			"target_type": string(targetType),
+			"target_id":   pgtype.UUID{Bytes: targetID, Valid: true},
+		}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("ReactionRepository.Delete: build query: %w", err)
+	}
+
+	result, err := r.DB.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("ReactionRepository.Delete: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ports.ErrReactionNotFound
+	}
+
+	return nil
+}
+
+// FindByUserAndTarget retrieves a user's reaction on a target
+func (r *ReactionRepository) FindByUserAndTarget(ctx context.Context, userID uuid.UUID, targetType domain.TargetType, targetID uuid.UUID) (*domain.Reaction, error) {
+	query, args, err := r.SB.
+		Select(
+			"id", "user_id", "target_type", "target_id", "reaction_type",
+			"created_at", "updated_at",
+		).
+		From("reactions").
+		Where(sq.Eq{
+			"user_id":     pgtype.UUID{Bytes: userID, Valid: true},
+			"target_type": string(targetType),
+			"target_id":   pgtype.UUID{Bytes: targetID, Valid: true},
+		}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("ReactionRepository.FindByUserAndTarget: build query: %w", err)
+	}
+
+	var reaction domain.Reaction
+	var idBytes, userIDBytes, targetIDBytes pgtype.UUID
+	var targetTypeStr, reactionTypeStr string
+
+	err = r.DB.QueryRow(ctx, query, args...).Scan(
+		&idBytes,
+		&userIDBytes,
+		&targetTypeStr,
+		&targetIDBytes,
+		&reactionTypeStr,
+		&reaction.CreatedAt,
+		&reaction.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrReactionNotFound
+		}
+		return nil, fmt.Errorf("ReactionRepository.FindByUserAndTarget: %w", err)
+	}
+
+	reaction.ID = uuid.UUID(idBytes.Bytes)
+	reaction.UserID = uuid.UUID(userIDBytes.Bytes)
+	reaction.TargetID = uuid.UUID(targetIDBytes.Bytes)
+	reaction.TargetType = domain.TargetType(targetTypeStr)
+	reaction.Type = domain.ReactionType(reactionTypeStr)
+
+	return &reaction, nil
+}
+
+// CountByTarget returns the number of reactions of each type on a target
+func (r *ReactionRepository) CountByTarget(ctx context.Context, targetType domain.TargetType, targetID uuid.UUID) (map[domain.ReactionType]int, error) {
+	query, args, err := r.SB.
+		Select("reaction_type", "COUNT(*)").
+		From("reactions").
+		Where(sq.Eq{
+			"target_type": string(targetType),
+			"target_id":   pgtype.UUID{Bytes: targetID, Valid: true},
+		}).
+		GroupBy("reaction_type").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("ReactionRepository.CountByTarget: build query: %w", err)
+	}
+
+	rows, err := r.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("ReactionRepository.CountByTarget: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[domain.ReactionType]int)
+	for rows.Next() {
+		var reactionTypeStr string
+		var count int
+		if err := rows.Scan(&reactionTypeStr, &count); err != nil {
+			return nil, fmt.Errorf("ReactionRepository.CountByTarget: scan: %w", err)
+		}
+		counts[domain.ReactionType(reactionTypeStr)] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ReactionRepository.CountByTarget: rows error: %w", err)
+	}
+
+	return counts, nil
+}
+
+// Compile-time check to ensure ReactionRepository implements ports.ReactionRepository
+var _ ports.ReactionRepository = (*ReactionRepository)(nil)
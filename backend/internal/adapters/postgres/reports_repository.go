@@ -0,0 +1,236 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"backend/internal/platform/postgres"
+	"backend/internal/reports/domain"
+	"backend/internal/reports/ports"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ReportRepository implements the reports.ReportRepository interface using PostgreSQL
+type ReportRepository struct {
+	postgres.BaseRepository // Embed the base repository for common functionality
+}
+
+// NewReportRepository creates a new PostgreSQL reports repository
+func NewReportRepository(db *pgxpool.Pool) *ReportRepository {
+	return &ReportRepository{
+		BaseRepository: postgres.NewBaseRepository(db),
+	}
+}
+
+// Create stores a new report
+func (r *ReportRepository) Create(ctx context.Context, report *domain.Report) error {
+	query, args, err := r.SB.
+		Insert("reports").
+		Columns(
+			"id", "reporter_id", "target_type", "target_id", "target_author_id",
+			"reason", "details", "status", "created_at", "updated_at",
+		).
+		Values(
+			pgtype.UUID{Bytes: report.ID, Valid: true},
+			pgtype.UUID{Bytes: report.ReporterID, Valid: true},
+			string(report.TargetType),
+			pgtype.UUID{Bytes: report.TargetID, Valid: true},
+			toNullableUUID(report.TargetAuthorID),
+			string(report.Reason),
+			report.Details,
+			string(report.Status),
+			pgtype.Timestamptz{Time: report.CreatedAt, Valid: true},
+			pgtype.Timestamptz{Time: report.UpdatedAt, Valid: true},
+		).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("ReportRepository.Create: build query: %w", err)
+	}
+
+	if _, err := r.DB.Exec(ctx, query, args...); err != nil {
+		// Only the one-open-report-per-target index can be violated
+		if isUniqueViolation(err) {
+			return ports.ErrDuplicateReport
+		}
+		return fmt.Errorf("ReportRepository.Create: %w", err)
+	}
+
+	return nil
+}
+
+// Update saves a report's status and resolution
+func (r *ReportRepository) Update(ctx context.Context, report *domain.Report) error {
+	var resolution pgtype.Text
+	if report.Resolution != nil {
+		resolution = pgtype.Text{String: string(*report.Resolution), Valid: true}
+	}
+
+	query, args, err := r.SB.
+		Update("reports").
+		Set("status", string(report.Status)).
+		Set("resolution", resolution).
+		Set("resolution_note", report.ResolutionNote).
+		Set("resolved_by", toNullableUUID(report.ResolvedBy)).
+		Set("resolved_at", toNullableTimestamptz(report.ResolvedAt)).
+		Set("updated_at", pgtype.Timestamptz{Time: report.UpdatedAt, Valid: true}).
+		Where(sq.Eq{"id": pgtype.UUID{Bytes: report.ID, Valid: true}}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("ReportRepository.Update: build query: %w", err)
+	}
+
+	result, err := r.DB.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("ReportRepository.Update: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ports.ErrReportNotFound
+	}
+
+	return nil
+}
+
+// FindByID retrieves a report
+func (r *ReportRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Report, error) {
+	query, args, err := r.selectReports().
+		Where(sq.Eq{"id": pgtype.UUID{Bytes: id, Valid: true}}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("ReportRepository.FindByID: build query: %w", err)
+	}
+
+	report, err := scanReport(r.DB.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrReportNotFound
+		}
+		return nil, fmt.Errorf("ReportRepository.FindByID: %w", err)
+	}
+
+	return report, nil
+}
+
+// List retrieves reports matching the filter, oldest first, with the total number of matches
+func (r *ReportRepository) List(ctx context.Context, filter ports.ListFilter) ([]*domain.Report, int, error) {
+	where := sq.Eq{}
+	if filter.Status != nil {
+		where["status"] = string(*filter.Status)
+	}
+	if filter.TargetType != nil {
+		where["target_type"] = string(*filter.TargetType)
+	}
+	if filter.TargetID != nil {
+		where["target_id"] = pgtype.UUID{Bytes: *filter.TargetID, Valid: true}
+	}
+
+	countQuery, countArgs, err := r.SB.
+		Select("COUNT(*)").
+		From("reports").
+		Where(where).
+		ToSql()
+	if err != nil {
+		return nil, 0, fmt.Errorf("ReportRepository.List: build count query: %w", err)
+	}
+
+	var total int
+	if err := r.DB.QueryRow(ctx, countQuery, countArgs...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("ReportRepository.List: count: %w", err)
+	}
+
+	query, args, err := r.selectReports().
+		Where(where).
+		OrderBy("created_at ASC", "id ASC").
+		Limit(uint64(filter.Limit)).
+		Offset(uint64(filter.Offset)).
+		ToSql()
+	if err != nil {
+		return nil, 0, fmt.Errorf("ReportRepository.List: build query: %w", err)
+	}
+
+	rows, err := r.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("ReportRepository.List: %w", err)
+	}
+	defer rows.Close()
+
+	var reports []*domain.Report
+	for rows.Next() {
+		report, err := scanReport(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("ReportRepository.List: scan: %w", err)
+		}
+		reports = append(reports, report)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("ReportRepository.List: rows error: %w", err)
+	}
+
+	return reports, total, nil
+}
+
+// Helper methods
+
+// selectReports builds the SELECT shared by all report queries
+func (r *ReportRepository) selectReports() sq.SelectBuilder {
+	return r.SB.
+		Select(
+			"id", "reporter_id", "target_type", "target_id", "target_author_id",
+			"reason", "details", "status", "resolution", "resolution_note",
+			"resolved_by", "resolved_at", "created_at", "updated_at",
+		).
+		From("reports")
+}
+
+// scanReport scans a single row into a domain.Report
+func scanReport(row pgx.Row) (*domain.Report, error) {
+	var report domain.Report
+	var idBytes, reporterID, targetID, targetAuthorID, resolvedBy pgtype.UUID
+	var targetType, reason, status string
+	var resolution pgtype.Text
+	var resolvedAt, createdAt, updatedAt pgtype.Timestamptz
+
+	if err := row.Scan(
+		&idBytes, &reporterID, &targetType, &targetID, &targetAuthorID,
+		&reason, &report.Details, &status, &resolution, &report.ResolutionNote,
+		&resolvedBy, &resolvedAt, &createdAt, &updatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	report.ID = uuid.UUID(idBytes.Bytes)
+	report.ReporterID = uuid.UUID(reporterID.Bytes)
+	report.TargetType = domain.TargetType(targetType)
+	report.TargetID = uuid.UUID(targetID.Bytes)
+	report.Reason = domain.Reason(reason)
+	report.Status = domain.Status(status)
+	report.CreatedAt = createdAt.Time
+	report.UpdatedAt = updatedAt.Time
+
+	if targetAuthorID.Valid {
+		authorID := uuid.UUID(targetAuthorID.Bytes)
+		report.TargetAuthorID = &authorID
+	}
+	if resolution.Valid {
+		action := domain.Action(resolution.String)
+		report.Resolution = &action
+	}
+	if resolvedBy.Valid {
+		moderatorID := uuid.UUID(resolvedBy.Bytes)
+		report.ResolvedBy = &moderatorID
+	}
+	if resolvedAt.Valid {
+		report.ResolvedAt = &resolvedAt.Time
+	}
+
+	return &report, nil
+}
+
+// Compile-time check to ensure ReportRepository implements ports.ReportRepository
+var _ ports.ReportRepository = (*ReportRepository)(nil)
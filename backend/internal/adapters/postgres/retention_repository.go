@@ -0,0 +1,151 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend/internal/platform/postgres"
+	"backend/internal/retention/domain"
+	"backend/internal/retention/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// retentionConditions selects the posts each policy purges. Every condition
+// takes the blog as $1, the cutoff as $2 and the exempt authors as $3.
+// Drafts planned for today or later are kept however old they are.
+var retentionConditions = map[domain.Policy]string{
+	domain.PolicyStaleDrafts: `
+		p.blog_id = $1 AND p.status = 'draft' AND p.published_at IS NULL
+			AND p.updated_at < $2 AND p.author_id <> ALL($3)
+			AND (p.target_publish_date IS NULL OR p.target_publish_date < CURRENT_DATE)`,
+	domain.PolicyArchivedPosts: `
+		p.blog_id = $1 AND p.status = 'archived'
+			AND p.updated_at < $2 AND p.author_id <> ALL($3)`,
+}
+
+// RetentionRepository implements the retention.RetentionRepository interface using PostgreSQL
+type RetentionRepository struct {
+	postgres.BaseRepository
+}
+
+// NewRetentionRepository creates a new PostgreSQL retention repository
+func NewRetentionRepository(db *pgxpool.Pool) *RetentionRepository {
+	return &RetentionRepository{
+		BaseRepository: postgres.NewBaseRepository(db),
+	}
+}
+
+// WithTx returns a new repository instance that uses the provided transaction
+func (r *RetentionRepository) WithTx(tx pgx.Tx) ports.RetentionRepository {
+	return &RetentionRepository{
+		BaseRepository: r.BaseRepository.WithTx(tx),
+	}
+}
+
+// FindCandidates returns the posts the policy selects, oldest change first
+func (r *RetentionRepository) FindCandidates(ctx context.Context, policy domain.Policy, cutoff time.Time, exempt []uuid.UUID) ([]domain.Candidate, error) {
+	condition, ok := retentionConditions[policy]
+	if !ok {
+		return nil, fmt.Errorf("RetentionRepository.FindCandidates: unknown policy %q", policy)
+	}
+
+	query := `
+		SELECT p.id, p.author_id, p.title, p.updated_at
+		FROM posts p
+		WHERE ` + condition + `
+		ORDER BY p.updated_at, p.id`
+
+	candidates, err := r.collect(ctx, query, cutoff, exempt)
+	if err != nil {
+		return nil, fmt.Errorf("RetentionRepository.FindCandidates: %s: %w", policy, err)
+	}
+	return candidates, nil
+}
+
+// Purge deletes the posts the policy selects; their theme articles, series
+// entries, bookmarks and read model rows go with them
+func (r *RetentionRepository) Purge(ctx context.Context, policy domain.Policy, cutoff time.Time, exempt []uuid.UUID) ([]domain.Candidate, error) {
+	condition, ok := retentionConditions[policy]
+	if !ok {
+		return nil, fmt.Errorf("RetentionRepository.Purge: unknown policy %q", policy)
+	}
+
+	query := `
+		DELETE FROM posts p
+		WHERE ` + condition + `
+		RETURNING p.id, p.author_id, p.title, p.updated_at`
+
+	purged, err := r.collect(ctx, query, cutoff, exempt)
+	if err != nil {
+		return nil, fmt.Errorf("RetentionRepository.Purge: %s: %w", policy, err)
+	}
+	return purged, nil
+}
+
+// RecordPurges appends the purged posts to the retention_purges audit trail
+func (r *RetentionRepository) RecordPurges(ctx context.Context, policy domain.Policy, purged []domain.Candidate, actorID uuid.UUID, purgedAt time.Time) error {
+	if len(purged) == 0 {
+		return nil
+	}
+
+	actor := pgtype.UUID{Bytes: actorID, Valid: actorID != uuid.Nil}
+	batch := &pgx.Batch{}
+	for _, post := range purged {
+		batch.Queue(`
+			INSERT INTO retention_purges (blog_id, post_id, author_id, title, policy, post_updated_at, actor_id, purged_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+			currentBlogID(ctx),
+			pgtype.UUID{Bytes: post.PostID, Valid: true},
+			pgtype.UUID{Bytes: post.AuthorID, Valid: true},
+			post.Title,
+			string(policy),
+			post.UpdatedAt,
+			actor,
+			purgedAt,
+		)
+	}
+	if err := r.DB.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("RetentionRepository.RecordPurges: %s: %w", policy, err)
+	}
+	return nil
+}
+
+// BlogIDs lists every blog
+func (r *RetentionRepository) BlogIDs(ctx context.Context) ([]uuid.UUID, error) {
+	blogIDs, err := listBlogIDs(ctx, r.DB)
+	if err != nil {
+		return nil, fmt.Errorf("RetentionRepository.BlogIDs: %w", err)
+	}
+	return blogIDs, nil
+}
+
+// collect runs a policy's statement and reads its (id, author, title, updated) rows
+func (r *RetentionRepository) collect(ctx context.Context, query string, cutoff time.Time, exempt []uuid.UUID) ([]domain.Candidate, error) {
+	exemptIDs := make([]pgtype.UUID, len(exempt))
+	for i, id := range exempt {
+		exemptIDs[i] = pgtype.UUID{Bytes: id, Valid: true}
+	}
+
+	rows, err := r.DB.Query(ctx, query, currentBlogID(ctx), cutoff, exemptIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	candidates := make([]domain.Candidate, 0)
+	for rows.Next() {
+		var id, authorID pgtype.UUID
+		var candidate domain.Candidate
+		if err := rows.Scan(&id, &authorID, &candidate.Title, &candidate.UpdatedAt); err != nil {
+			return nil, err
+		}
+		candidate.PostID = uuid.UUID(id.Bytes)
+		candidate.AuthorID = uuid.UUID(authorID.Bytes)
+		candidates = append(candidates, candidate)
+	}
+	return candidates, rows.Err()
+}
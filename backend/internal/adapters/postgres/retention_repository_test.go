@@ -0,0 +1,98 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"backend/internal/adapters/postgres"
+	"backend/internal/retention/domain"
+	"backend/internal/testing/factory"
+	"backend/internal/testing/pgtest"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// candidateIDs returns the post IDs of the candidates
+func candidateIDs(candidates []domain.Candidate) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(candidates))
+	for _, c := range candidates {
+		ids = append(ids, c.PostID)
+	}
+	return ids
+}
+
+func TestRetentionRepository_FindCandidates(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewRetentionRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	author := factory.NewUser().WithRole("author").Create(t, tx)
+	exempt := factory.NewUser().WithRole("author").Create(t, tx)
+	draft := factory.NewPost(author.ID).Create(t, tx)
+	archived := factory.NewPost(author.ID).Status("archived").Create(t, tx)
+	factory.NewPost(author.ID).Published().Create(t, tx)
+	factory.NewPost(exempt.ID).Create(t, tx)
+
+	// Rows written in the test transaction were last changed when it began
+	cutoff := time.Now().Add(time.Minute)
+	exemptIDs := []uuid.UUID{exempt.ID}
+
+	drafts, err := repo.FindCandidates(ctx, domain.PolicyStaleDrafts, cutoff, exemptIDs)
+	require.NoError(t, err)
+	assert.Contains(t, candidateIDs(drafts), draft.ID)
+	for _, c := range drafts {
+		assert.NotEqual(t, exempt.ID, c.AuthorID, "exempt authors keep their drafts")
+	}
+
+	archives, err := repo.FindCandidates(ctx, domain.PolicyArchivedPosts, cutoff, exemptIDs)
+	require.NoError(t, err)
+	assert.Contains(t, candidateIDs(archives), archived.ID)
+	assert.NotContains(t, candidateIDs(archives), draft.ID)
+
+	recent, err := repo.FindCandidates(ctx, domain.PolicyStaleDrafts, cutoff.Add(-time.Hour), exemptIDs)
+	require.NoError(t, err)
+	assert.NotContains(t, candidateIDs(recent), draft.ID, "posts changed after the cutoff are kept")
+}
+
+func TestRetentionRepository_PurgeDeletesPosts(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewRetentionRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	author := factory.NewUser().WithRole("author").Create(t, tx)
+	archived := factory.NewPost(author.ID).Status("archived").Create(t, tx)
+	theme := factory.NewTheme(author.ID).Articles(archived.ID).Create(t, tx)
+
+	purged, err := repo.Purge(ctx, domain.PolicyArchivedPosts, time.Now().Add(time.Minute), nil)
+	require.NoError(t, err)
+	assert.Contains(t, candidateIDs(purged), archived.ID)
+
+	var remaining int
+	require.NoError(t, tx.QueryRow(ctx, `SELECT COUNT(*) FROM posts WHERE id = $1`, archived.ID).Scan(&remaining))
+	assert.Zero(t, remaining)
+	assert.Equal(t, 0, articleCount(t, tx, theme.ID), "the theme article goes with the post")
+}
+
+func TestRetentionRepository_RecordPurges(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewRetentionRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	author := factory.NewUser().WithRole("author").Create(t, tx)
+	archived := factory.NewPost(author.ID).Status("archived").Create(t, tx)
+
+	purged, err := repo.Purge(ctx, domain.PolicyArchivedPosts, time.Now().Add(time.Minute), nil)
+	require.NoError(t, err)
+	require.NoError(t, repo.RecordPurges(ctx, domain.PolicyArchivedPosts, purged, uuid.Nil, time.Now()))
+
+	var policy string
+	var actorID pgtype.UUID
+	require.NoError(t, tx.QueryRow(ctx,
+		`SELECT policy, actor_id FROM retention_purges WHERE post_id = $1`, archived.ID,
+	).Scan(&policy, &actorID))
+	assert.Equal(t, string(domain.PolicyArchivedPosts), policy)
+	assert.False(t, actorID.Valid, "the scheduled job has no actor")
+}
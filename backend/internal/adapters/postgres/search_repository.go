@@ -0,0 +1,154 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"backend/internal/platform/postgres"
+	"backend/internal/search/domain"
+	"backend/internal/search/ports"
+	themesDomain "backend/internal/themes/domain"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// SearchRepository implements the search.SuggestionRepository interface using PostgreSQL
+// Lookups are ILIKE prefix matches, served by the trigram indexes on titles and names
+type SearchRepository struct {
+	postgres.BaseRepository // Embed the base repository for common functionality
+}
+
+// NewSearchRepository creates a new PostgreSQL search suggestion repository
+func NewSearchRepository(db *pgxpool.Pool) *SearchRepository {
+	return &SearchRepository{
+		BaseRepository: postgres.NewBaseRepository(db),
+	}
+}
+
+// WithTx returns a new repository instance that uses the provided transaction
+func (r *SearchRepository) WithTx(tx pgx.Tx) *SearchRepository {
+	return &SearchRepository{
+		BaseRepository: r.BaseRepository.WithTx(tx),
+	}
+}
+
+// SuggestPosts completes the titles of the current blog's posts the scope allows
+func (r *SearchRepository) SuggestPosts(ctx context.Context, prefix string, scope ports.PostScope, limit int) ([]domain.Suggestion, error) {
+	qb := r.SB.Select("p.id", "p.title", "p.slug").
+		From("posts p").
+		Where(sq.Eq{"p.blog_id": currentBlogID(ctx)}).
+		Where(matchesPrefix("p.title", prefix))
+
+	if !scope.AllStatuses {
+		listed := listedForReaders(scope.Member)
+		if scope.OwnerID != nil {
+			qb = qb.Where(sq.Or{
+				listed,
+				sq.Eq{"p.author_id": pgtype.UUID{Bytes: *scope.OwnerID, Valid: true}},
+			})
+		} else {
+			qb = qb.Where(listed)
+		}
+	}
+
+	qb = orderByPrefix(qb, "p.title", prefix).Limit(uint64(limit))
+	return r.suggest(ctx, qb, domain.KindPost, "SearchRepository.SuggestPosts")
+}
+
+// SuggestThemes completes the names of the current blog's active themes, and
+// of the curator's own themes whatever their status
+func (r *SearchRepository) SuggestThemes(ctx context.Context, prefix string, curatorID *uuid.UUID, limit int) ([]domain.Suggestion, error) {
+	active := sq.Eq{"t.status": string(themesDomain.ThemeStatusActive)}
+
+	qb := r.SB.Select("t.id", "t.name", "t.slug").
+		From("themes t").
+		Where(sq.Eq{"t.blog_id": currentBlogID(ctx), "t.deleted_at": nil}).
+		Where(matchesPrefix("t.name", prefix))
+	if curatorID != nil {
+		qb = qb.Where(sq.Or{active, sq.Eq{"t.curator_id": pgtype.UUID{Bytes: *curatorID, Valid: true}}})
+	} else {
+		qb = qb.Where(active)
+	}
+
+	qb = orderByPrefix(qb, "t.name", prefix).Limit(uint64(limit))
+	return r.suggest(ctx, qb, domain.KindTheme, "SearchRepository.SuggestThemes")
+}
+
+// SuggestAuthors completes the usernames and display names of unsuspended
+// users with a post listed to anonymous readers of the current blog, so
+// accounts that never published are not exposed
+func (r *SearchRepository) SuggestAuthors(ctx context.Context, prefix string, limit int) ([]domain.Suggestion, error) {
+	label := "COALESCE(NULLIF(u.display_name, ''), u.username)"
+
+	// Left with ? placeholders: the outer query numbers them
+	published := sq.Select("1").
+		From("posts p").
+		Where("p.author_id = u.id").
+		Where(sq.Eq{"p.blog_id": currentBlogID(ctx)}).
+		Where(listedForReaders(false))
+
+	qb := r.SB.Select("u.id", label, "u.username").
+		From("users u").
+		Where(sq.Or{
+			sq.ILike{"u.username": escapeLikePattern(prefix) + "%"},
+			matchesPrefix("u.display_name", prefix),
+		}).
+		Where("u.suspended_at IS NULL").
+		Where(sq.Expr("EXISTS (?)", published))
+
+	qb = orderByPrefix(qb, label, prefix).Limit(uint64(limit))
+	return r.suggest(ctx, qb, domain.KindAuthor, "SearchRepository.SuggestAuthors")
+}
+
+// Helper functions
+
+// suggest runs a lookup selecting id, label and slug, in that order
+func (r *SearchRepository) suggest(ctx context.Context, qb sq.SelectBuilder, kind domain.Kind, op string) ([]domain.Suggestion, error) {
+	query, args, err := qb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	rows, err := r.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	suggestions := []domain.Suggestion{}
+	for rows.Next() {
+		var id pgtype.UUID
+		suggestion := domain.Suggestion{Kind: kind}
+		if err := rows.Scan(&id, &suggestion.Label, &suggestion.Slug); err != nil {
+			return nil, fmt.Errorf("%s: scan: %w", op, err)
+		}
+		suggestion.ID = uuid.UUID(id.Bytes)
+		suggestions = append(suggestions, suggestion)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: rows error: %w", op, err)
+	}
+
+	return suggestions, nil
+}
+
+// matchesPrefix matches a column starting with the prefix, or holding a word
+// that does, so "arch" completes "Hexagonal Architecture"
+func matchesPrefix(column, prefix string) sq.Or {
+	escaped := escapeLikePattern(prefix)
+	return sq.Or{
+		sq.ILike{column: escaped + "%"},
+		sq.ILike{column: "% " + escaped + "%"},
+	}
+}
+
+// orderByPrefix ranks labels starting with the prefix first, then shorter
+// labels, the closest completions, before longer ones
+func orderByPrefix(qb sq.SelectBuilder, label, prefix string) sq.SelectBuilder {
+	return qb.OrderByClause("("+label+" ILIKE ?) DESC", escapeLikePattern(prefix)+"%").
+		OrderBy("char_length("+label+")", label)
+}
@@ -0,0 +1,72 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"backend/internal/adapters/postgres"
+	"backend/internal/search/domain"
+	"backend/internal/search/ports"
+	"backend/internal/testing/factory"
+	"backend/internal/testing/pgtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// suggestionLabels lists the labels of suggestions, in order
+func suggestionLabels(suggestions []domain.Suggestion) []string {
+	labels := make([]string, len(suggestions))
+	for i, suggestion := range suggestions {
+		labels[i] = suggestion.Label
+	}
+	return labels
+}
+
+func TestSearchRepository_SuggestPostsMatchesWordStartsTheViewerMayRead(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewSearchRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	author := factory.NewUser().WithRole("author").Create(t, tx)
+	factory.NewPost(author.ID).Title("Hexagonal Architecture in Go").Published().Create(t, tx)
+	factory.NewPost(author.ID).Title("Architecture Decision Records").Published().Create(t, tx)
+	factory.NewPost(author.ID).Title("Microarchitecture").Published().Create(t, tx) // Not at a word start
+	factory.NewPost(author.ID).Title("Architecture draft").Create(t, tx)
+
+	anonymous, err := repo.SuggestPosts(ctx, "arch", ports.PostScope{}, 5)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Architecture Decision Records", "Hexagonal Architecture in Go"}, suggestionLabels(anonymous))
+
+	own, err := repo.SuggestPosts(ctx, "arch", ports.PostScope{Member: true, OwnerID: &author.ID}, 5)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Architecture draft", "Architecture Decision Records", "Hexagonal Architecture in Go"}, suggestionLabels(own))
+
+	limited, err := repo.SuggestPosts(ctx, "arch", ports.PostScope{AllStatuses: true}, 1)
+	require.NoError(t, err)
+	require.Len(t, limited, 1)
+	assert.Equal(t, domain.KindPost, limited[0].Kind)
+}
+
+func TestSearchRepository_SuggestThemesHidesOthersDrafts(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewSearchRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	curator := factory.NewUser().WithRole("author").Create(t, tx)
+	factory.NewTheme(curator.ID).Name("Distributed Systems").Active().Create(t, tx)
+	factory.NewTheme(curator.ID).Name("Distributed Tracing").Create(t, tx)
+	factory.NewTheme(curator.ID).Name("100% Distributed").Active().Create(t, tx)
+
+	anonymous, err := repo.SuggestThemes(ctx, "dist", nil, 5)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Distributed Systems", "100% Distributed"}, suggestionLabels(anonymous))
+
+	curated, err := repo.SuggestThemes(ctx, "dist", &curator.ID, 5)
+	require.NoError(t, err)
+	assert.Len(t, curated, 3)
+
+	// Wildcards typed in the prefix match literally
+	literal, err := repo.SuggestThemes(ctx, "100%", nil, 5)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"100% Distributed"}, suggestionLabels(literal))
+}
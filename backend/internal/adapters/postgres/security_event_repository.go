@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"backend/internal/platform/postgres"
+	"backend/internal/security/domain"
+	"backend/internal/security/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// SecurityEventRepository implements the security.SecurityEventRepository interface using PostgreSQL
+type SecurityEventRepository struct {
+	postgres.BaseRepository
+}
+
+// NewSecurityEventRepository creates a new PostgreSQL security event repository
+func NewSecurityEventRepository(db *pgxpool.Pool) *SecurityEventRepository {
+	return &SecurityEventRepository{
+		BaseRepository: postgres.NewBaseRepository(db),
+	}
+}
+
+// WithTx returns a new repository instance that uses the provided transaction
+func (r *SecurityEventRepository) WithTx(tx pgx.Tx) *SecurityEventRepository {
+	return &SecurityEventRepository{
+		BaseRepository: r.BaseRepository.WithTx(tx),
+	}
+}
+
+// Create stores an event, unless its user has been deleted since it happened
+func (r *SecurityEventRepository) Create(ctx context.Context, event *domain.Event) error {
+	_, err := r.DB.Exec(ctx, `
+		INSERT INTO security_events (id, user_id, kind, actor_id, subject_id, description, occurred_at)
+		SELECT $1, $2, $3, $4, $5, $6, $7
+		WHERE EXISTS (SELECT 1 FROM users WHERE id = $2)`,
+		pgtype.UUID{Bytes: event.ID, Valid: true},
+		pgtype.UUID{Bytes: event.UserID, Valid: true},
+		string(event.Kind),
+		event.ActorID,
+		event.SubjectID,
+		event.Description,
+		event.OccurredAt,
+	)
+	if err != nil {
+		return fmt.Errorf("SecurityEventRepository.Create: %w", err)
+	}
+	return nil
+}
+
+// ListByUser retrieves a page of the user's events, newest first, and how many there are
+func (r *SecurityEventRepository) ListByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*domain.Event, int, error) {
+	id := pgtype.UUID{Bytes: userID, Valid: true}
+
+	var total int
+	if err := r.DB.QueryRow(ctx, `SELECT COUNT(*) FROM security_events WHERE user_id = $1`, id).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("SecurityEventRepository.ListByUser: count: %w", err)
+	}
+
+	rows, err := r.DB.Query(ctx, `
+		SELECT id, kind, actor_id, subject_id, description, occurred_at
+		FROM security_events
+		WHERE user_id = $1
+		ORDER BY occurred_at DESC, id
+		LIMIT $2 OFFSET $3`,
+		id, limit, offset,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("SecurityEventRepository.ListByUser: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]*domain.Event, 0, limit)
+	for rows.Next() {
+		event := &domain.Event{UserID: userID}
+		var kind string
+		if err := rows.Scan(
+			&event.ID, &kind, &event.ActorID, &event.SubjectID, &event.Description, &event.OccurredAt,
+		); err != nil {
+			return nil, 0, fmt.Errorf("SecurityEventRepository.ListByUser: scan: %w", err)
+		}
+		event.Kind = domain.EventKind(kind)
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("SecurityEventRepository.ListByUser: %w", err)
+	}
+	return events, total, nil
+}
+
+// DeleteByUser deletes every event of a user
+func (r *SecurityEventRepository) DeleteByUser(ctx context.Context, userID uuid.UUID) (int, error) {
+	result, err := r.DB.Exec(ctx,
+		`DELETE FROM security_events WHERE user_id = $1`,
+		pgtype.UUID{Bytes: userID, Valid: true},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("SecurityEventRepository.DeleteByUser: %w", err)
+	}
+	return int(result.RowsAffected()), nil
+}
+
+// Compile-time check to ensure SecurityEventRepository implements ports.SecurityEventRepository
+var _ ports.SecurityEventRepository = (*SecurityEventRepository)(nil)
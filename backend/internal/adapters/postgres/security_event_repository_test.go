@@ -0,0 +1,55 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"backend/internal/adapters/postgres"
+	"backend/internal/security/domain"
+	"backend/internal/testing/factory"
+	"backend/internal/testing/pgtest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecurityEventRepository_Lifecycle(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewSecurityEventRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	user := factory.NewUser().Create(t, tx)
+	admin := factory.NewUser().Create(t, tx)
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	granted, err := domain.NewEvent(user.ID, domain.EventRoleGranted, admin.ID, uuid.New(), `Role "editor" granted`, now.Add(-time.Hour))
+	require.NoError(t, err)
+	revoked, err := domain.NewEvent(user.ID, domain.EventSessionsRevoked, uuid.Nil, uuid.Nil, "All sessions revoked", now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, granted))
+	require.NoError(t, repo.Create(ctx, revoked))
+
+	orphan, err := domain.NewEvent(uuid.New(), domain.EventSessionsRevoked, uuid.Nil, uuid.Nil, "", now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, orphan), "events of deleted users are dropped")
+
+	events, total, err := repo.ListByUser(ctx, user.ID, 1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	require.Len(t, events, 1)
+	assert.Equal(t, revoked.ID, events[0].ID, "newest first")
+	assert.Nil(t, events[0].ActorID)
+	assert.Nil(t, events[0].SubjectID)
+
+	events, _, err = repo.ListByUser(ctx, user.ID, 1, 1)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, domain.EventRoleGranted, events[0].Kind)
+	require.NotNil(t, events[0].ActorID)
+	assert.Equal(t, admin.ID, *events[0].ActorID)
+
+	deleted, err := repo.DeleteByUser(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, deleted)
+}
@@ -0,0 +1,534 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"backend/internal/platform/postgres"
+	"backend/internal/series/domain"
+	"backend/internal/series/ports"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// SeriesRepository implements the series.SeriesRepository interface using PostgreSQL
+type SeriesRepository struct {
+	postgres.BaseRepository // Embed the base repository for common functionality
+}
+
+// NewSeriesRepository creates a new PostgreSQL series repository
+func NewSeriesRepository(db *pgxpool.Pool) *SeriesRepository {
+	return &SeriesRepository{
+		BaseRepository: postgres.NewBaseRepository(db),
+	}
+}
+
+// WithTx creates a new repository instance that uses the provided transaction
+func (r *SeriesRepository) WithTx(tx pgx.Tx) ports.SeriesRepository {
+	return &SeriesRepository{
+		BaseRepository: r.BaseRepository.WithTx(tx),
+	}
+}
+
+// Create inserts a new series into the database
+func (r *SeriesRepository) Create(ctx context.Context, series *domain.Series) error {
+	query, args, err := r.SB.
+		Insert("series").
+		Columns("id", "blog_id", "title", "description", "slug", "author_id", "created_at", "updated_at").
+		Values(
+			pgtype.UUID{Bytes: series.ID, Valid: true},
+			currentBlogID(ctx),
+			series.Title,
+			series.Description,
+			series.Slug,
+			pgtype.UUID{Bytes: series.AuthorID, Valid: true},
+			pgtype.Timestamptz{Time: series.CreatedAt, Valid: true},
+			pgtype.Timestamptz{Time: series.UpdatedAt, Valid: true},
+		).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("SeriesRepository.Create: build query: %w", err)
+	}
+
+	_, err = r.DB.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("SeriesRepository.Create: %w", err)
+	}
+
+	return nil
+}
+
+// Save persists the entire aggregate
+// Note: This method assumes it's being called within a transaction context.
+// The service layer is responsible for transaction management.
+func (r *SeriesRepository) Save(ctx context.Context, series *domain.Series) error {
+	query, args, err := r.SB.
+		Update("series").
+		Set("title", series.Title).
+		Set("description", series.Description).
+		Set("slug", series.Slug).
+		Set("updated_at", pgtype.Timestamptz{Time: series.UpdatedAt, Valid: true}).
+		Where(sq.Eq{"id": pgtype.UUID{Bytes: series.ID, Valid: true}, "blog_id": currentBlogID(ctx)}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("SeriesRepository.Save: build update query: %w", err)
+	}
+
+	result, err := r.DB.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("SeriesRepository.Save: update series: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ports.ErrSeriesNotFound
+	}
+
+	if err := r.syncPosts(ctx, series.ID, series.Posts); err != nil {
+		return fmt.Errorf("SeriesRepository.Save: sync posts: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes a series from the database
+func (r *SeriesRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query, args, err := r.SB.
+		Delete("series").
+		Where(sq.Eq{"id": pgtype.UUID{Bytes: id, Valid: true}, "blog_id": currentBlogID(ctx)}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("SeriesRepository.Delete: build query: %w", err)
+	}
+
+	result, err := r.DB.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("SeriesRepository.Delete: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ports.ErrSeriesNotFound
+	}
+
+	return nil
+}
+
+// FindByID retrieves a series by its ID (without posts)
+func (r *SeriesRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Series, error) {
+	return r.findOne(ctx, "SeriesRepository.FindByID", sq.Eq{"id": pgtype.UUID{Bytes: id, Valid: true}})
+}
+
+// FindBySlug retrieves a series by its URL slug (without posts)
+func (r *SeriesRepository) FindBySlug(ctx context.Context, slug string) (*domain.Series, error) {
+	return r.findOne(ctx, "SeriesRepository.FindBySlug", sq.Eq{"slug": slug})
+}
+
+// FindSeriesByPost retrieves the series that contains the given post (without posts)
+func (r *SeriesRepository) FindSeriesByPost(ctx context.Context, postID uuid.UUID) (*domain.Series, error) {
+	return r.findOne(ctx, "SeriesRepository.FindSeriesByPost", sq.Expr(
+		"id = (SELECT series_id FROM series_posts WHERE post_id = ?)",
+		pgtype.UUID{Bytes: postID, Valid: true},
+	))
+}
+
+// LoadSeriesWithPosts loads the full series aggregate including its posts
+func (r *SeriesRepository) LoadSeriesWithPosts(ctx context.Context, id uuid.UUID) (*domain.Series, error) {
+	series, err := r.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := r.SB.
+		Select("post_id", "position", "added_at", "updated_at").
+		From("series_posts").
+		Where(sq.Eq{"series_id": pgtype.UUID{Bytes: id, Valid: true}}).
+		OrderBy("position ASC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("SeriesRepository.LoadSeriesWithPosts: build posts query: %w", err)
+	}
+
+	rows, err := r.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("SeriesRepository.LoadSeriesWithPosts: query posts: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var seriesPost domain.SeriesPost
+		var postIDBytes pgtype.UUID
+
+		if err := rows.Scan(&postIDBytes, &seriesPost.Position, &seriesPost.AddedAt, &seriesPost.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("SeriesRepository.LoadSeriesWithPosts: scan post: %w", err)
+		}
+
+		seriesPost.SeriesID = series.ID
+		seriesPost.PostID = uuid.UUID(postIDBytes.Bytes)
+		series.Posts = append(series.Posts, &seriesPost)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("SeriesRepository.LoadSeriesWithPosts: rows error: %w", err)
+	}
+
+	return series, nil
+}
+
+// ListPublishedEntries retrieves the posts of a series listed for readers, ordered by position
+// Unlisted posts and posts under embargo are left out, as they are from post listings
+func (r *SeriesRepository) ListPublishedEntries(ctx context.Context, seriesID uuid.UUID) ([]*ports.SeriesEntry, error) {
+	query, args, err := r.SB.
+		Select("sp.post_id", "p.title", "p.slug", "sp.position").
+		From("series_posts sp").
+		Join("posts p ON p.id = sp.post_id").
+		Where(sq.Eq{"sp.series_id": pgtype.UUID{Bytes: seriesID, Valid: true}}).
+		Where(listedForReaders(false)).
+		OrderBy("sp.position ASC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("SeriesRepository.ListPublishedEntries: build query: %w", err)
+	}
+
+	rows, err := r.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("SeriesRepository.ListPublishedEntries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*ports.SeriesEntry
+	for rows.Next() {
+		var entry ports.SeriesEntry
+		var postIDBytes pgtype.UUID
+
+		if err := rows.Scan(&postIDBytes, &entry.Title, &entry.Slug, &entry.Position); err != nil {
+			return nil, fmt.Errorf("SeriesRepository.ListPublishedEntries: scan: %w", err)
+		}
+
+		entry.PostID = uuid.UUID(postIDBytes.Bytes)
+		entries = append(entries, &entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("SeriesRepository.ListPublishedEntries: rows error: %w", err)
+	}
+
+	return entries, nil
+}
+
+// ListSeries retrieves a list of series summaries based on the filter
+func (r *SeriesRepository) ListSeries(ctx context.Context, filter ports.ListFilter) ([]*ports.SeriesSummary, error) {
+	qb := r.SB.Select(
+		"s.id", "s.title", "s.description", "s.slug",
+		"s.author_id", "u.username as author_name",
+		"s.created_at", "s.updated_at",
+		"COUNT(sp.post_id) as post_count",
+	).
+		From("series s").
+		LeftJoin("users u ON s.author_id = u.id").
+		LeftJoin("series_posts sp ON s.id = sp.series_id").
+		GroupBy("s.id", "u.username")
+
+	qb = r.applySeriesFilters(ctx, qb, filter)
+	qb = qb.OrderBy("s.created_at DESC")
+
+	if filter.Limit > 0 {
+		qb = qb.Limit(uint64(filter.Limit))
+	}
+	if filter.Offset > 0 {
+		qb = qb.Offset(uint64(filter.Offset))
+	}
+
+	query, args, err := qb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("SeriesRepository.ListSeries: build query: %w", err)
+	}
+
+	rows, err := r.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("SeriesRepository.ListSeries: %w", err)
+	}
+	defer rows.Close()
+
+	var summaries []*ports.SeriesSummary
+	for rows.Next() {
+		summary, err := scanSeriesSummaryFromRows(rows)
+		if err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, summary)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("SeriesRepository.ListSeries: rows error: %w", err)
+	}
+
+	return summaries, nil
+}
+
+// CountSeries returns the total number of series matching the filter
+func (r *SeriesRepository) CountSeries(ctx context.Context, filter ports.ListFilter) (int, error) {
+	qb := r.SB.Select("COUNT(*)").From("series s")
+	qb = r.applySeriesFilters(ctx, qb, filter)
+
+	query, args, err := qb.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("SeriesRepository.CountSeries: build query: %w", err)
+	}
+
+	var count int
+	if err := r.DB.QueryRow(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("SeriesRepository.CountSeries: %w", err)
+	}
+
+	return count, nil
+}
+
+// SlugExists checks if a slug already exists, optionally excluding a specific series ID
+func (r *SeriesRepository) SlugExists(ctx context.Context, slug string, excludeID *uuid.UUID) (bool, error) {
+	subQuery := r.SB.Select("1").From("series").Where(sq.Eq{"slug": slug, "blog_id": currentBlogID(ctx)})
+
+	if excludeID != nil {
+		subQuery = subQuery.Where(sq.NotEq{"id": pgtype.UUID{Bytes: *excludeID, Valid: true}})
+	}
+
+	subQuerySQL, subQueryArgs, err := subQuery.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("SeriesRepository.SlugExists: build subquery: %w", err)
+	}
+
+	var exists bool
+	err = r.DB.QueryRow(ctx, fmt.Sprintf("SELECT EXISTS(%s)", subQuerySQL), subQueryArgs...).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("SeriesRepository.SlugExists: %w", err)
+	}
+
+	return exists, nil
+}
+
+// GetSeriesAuthor retrieves just the author ID for a series (for ownership checks)
+func (r *SeriesRepository) GetSeriesAuthor(ctx context.Context, seriesID uuid.UUID) (uuid.UUID, error) {
+	query, args, err := r.SB.
+		Select("author_id").
+		From("series").
+		Where(sq.Eq{"id": pgtype.UUID{Bytes: seriesID, Valid: true}, "blog_id": currentBlogID(ctx)}).
+		ToSql()
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("SeriesRepository.GetSeriesAuthor: build query: %w", err)
+	}
+
+	var authorIDBytes pgtype.UUID
+	err = r.DB.QueryRow(ctx, query, args...).Scan(&authorIDBytes)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, ports.ErrSeriesNotFound
+		}
+		return uuid.Nil, fmt.Errorf("SeriesRepository.GetSeriesAuthor: %w", err)
+	}
+
+	return uuid.UUID(authorIDBytes.Bytes), nil
+}
+
+// Helper functions
+
+// findOne loads a single series row of the request's blog matching the predicate
+func (r *SeriesRepository) findOne(ctx context.Context, op string, pred sq.Sqlizer) (*domain.Series, error) {
+	query, args, err := r.SB.
+		Select("id", "title", "description", "slug", "author_id", "created_at", "updated_at").
+		From("series").
+		Where(pred).
+		Where(sq.Eq{"blog_id": currentBlogID(ctx)}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	series, err := scanSeries(r.DB.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrSeriesNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return series, nil
+}
+
+// syncPosts performs the diff and sync operation for series posts
+func (r *SeriesRepository) syncPosts(ctx context.Context, seriesID uuid.UUID, desiredPosts []*domain.SeriesPost) error {
+	query, args, err := r.SB.
+		Select("post_id", "position").
+		From("series_posts").
+		Where(sq.Eq{"series_id": pgtype.UUID{Bytes: seriesID, Valid: true}}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("syncPosts: build select query: %w", err)
+	}
+
+	rows, err := r.DB.Query(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("syncPosts: query current posts: %w", err)
+	}
+	defer rows.Close()
+
+	currentPositions := make(map[uuid.UUID]int)
+	for rows.Next() {
+		var postIDBytes pgtype.UUID
+		var position int
+		if err := rows.Scan(&postIDBytes, &position); err != nil {
+			return fmt.Errorf("syncPosts: scan current post: %w", err)
+		}
+		currentPositions[uuid.UUID(postIDBytes.Bytes)] = position
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("syncPosts: rows error: %w", err)
+	}
+
+	desiredMap := make(map[uuid.UUID]*domain.SeriesPost, len(desiredPosts))
+	for _, seriesPost := range desiredPosts {
+		desiredMap[seriesPost.PostID] = seriesPost
+	}
+
+	batch := &pgx.Batch{}
+
+	// Delete posts that are no longer in the series
+	for postID := range currentPositions {
+		if _, exists := desiredMap[postID]; !exists {
+			delQuery, delArgs, err := r.SB.
+				Delete("series_posts").
+				Where(sq.Eq{
+					"series_id": pgtype.UUID{Bytes: seriesID, Valid: true},
+					"post_id":   pgtype.UUID{Bytes: postID, Valid: true},
+				}).
+				ToSql()
+			if err != nil {
+				return fmt.Errorf("syncPosts: build delete query: %w", err)
+			}
+			batch.Queue(delQuery, delArgs...)
+		}
+	}
+
+	// Insert new posts and update moved ones
+	for postID, seriesPost := range desiredMap {
+		position, exists := currentPositions[postID]
+
+		if !exists {
+			insQuery, insArgs, err := r.SB.
+				Insert("series_posts").
+				Columns("series_id", "post_id", "position", "added_at", "updated_at").
+				Values(
+					pgtype.UUID{Bytes: seriesID, Valid: true},
+					pgtype.UUID{Bytes: seriesPost.PostID, Valid: true},
+					seriesPost.Position,
+					pgtype.Timestamptz{Time: seriesPost.AddedAt, Valid: true},
+					pgtype.Timestamptz{Time: seriesPost.UpdatedAt, Valid: true},
+				).
+				ToSql()
+			if err != nil {
+				return fmt.Errorf("syncPosts: build insert query: %w", err)
+			}
+			batch.Queue(insQuery, insArgs...)
+		} else if position != seriesPost.Position {
+			updQuery, updArgs, err := r.SB.
+				Update("series_posts").
+				Set("position", seriesPost.Position).
+				Set("updated_at", pgtype.Timestamptz{Time: seriesPost.UpdatedAt, Valid: true}).
+				Where(sq.Eq{
+					"series_id": pgtype.UUID{Bytes: seriesID, Valid: true},
+					"post_id":   pgtype.UUID{Bytes: seriesPost.PostID, Valid: true},
+				}).
+				ToSql()
+			if err != nil {
+				return fmt.Errorf("syncPosts: build update query: %w", err)
+			}
+			batch.Queue(updQuery, updArgs...)
+		}
+	}
+
+	if batch.Len() > 0 {
+		results := r.DB.SendBatch(ctx, batch)
+		defer func() { _ = results.Close() }()
+
+		for i := 0; i < batch.Len(); i++ {
+			if _, err := results.Exec(); err != nil {
+				return fmt.Errorf("syncPosts: execute batch operation %d: %w", i, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// applySeriesFilters applies common WHERE clauses to a query builder
+// Every query is scoped to the request's blog
+func (r *SeriesRepository) applySeriesFilters(ctx context.Context, qb sq.SelectBuilder, filter ports.ListFilter) sq.SelectBuilder {
+	qb = qb.Where(sq.Eq{"s.blog_id": currentBlogID(ctx)})
+
+	if filter.AuthorID != nil {
+		qb = qb.Where(sq.Eq{"s.author_id": pgtype.UUID{Bytes: *filter.AuthorID, Valid: true}})
+	}
+
+	return qb
+}
+
+// scanSeries scans a single series from pgx.Row
+func scanSeries(row pgx.Row) (*domain.Series, error) {
+	var series domain.Series
+	var idBytes, authorIDBytes pgtype.UUID
+	var description pgtype.Text
+
+	err := row.Scan(
+		&idBytes,
+		&series.Title,
+		&description,
+		&series.Slug,
+		&authorIDBytes,
+		&series.CreatedAt,
+		&series.UpdatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("scanSeries: %w", err)
+	}
+
+	series.ID = uuid.UUID(idBytes.Bytes)
+	series.AuthorID = uuid.UUID(authorIDBytes.Bytes)
+	series.Description = description.String
+	series.Posts = make([]*domain.SeriesPost, 0)
+
+	return &series, nil
+}
+
+// scanSeriesSummaryFromRows scans a series summary from pgx.Rows
+func scanSeriesSummaryFromRows(rows pgx.Rows) (*ports.SeriesSummary, error) {
+	var summary ports.SeriesSummary
+	var idBytes, authorIDBytes pgtype.UUID
+	var description, authorName pgtype.Text
+
+	err := rows.Scan(
+		&idBytes,
+		&summary.Title,
+		&description,
+		&summary.Slug,
+		&authorIDBytes,
+		&authorName,
+		&summary.CreatedAt,
+		&summary.UpdatedAt,
+		&summary.PostCount,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("scanSeriesSummaryFromRows: %w", err)
+	}
+
+	summary.ID = uuid.UUID(idBytes.Bytes)
+	summary.AuthorID = uuid.UUID(authorIDBytes.Bytes)
+	summary.Description = description.String
+	summary.AuthorName = authorName.String
+
+	return &summary, nil
+}
+
+// Compile-time check to ensure SeriesRepository implements ports.SeriesRepository
+var _ ports.SeriesRepository = (*SeriesRepository)(nil)
@@ -0,0 +1,85 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"backend/internal/adapters/postgres"
+	"backend/internal/platform/tenant"
+	"backend/internal/series/domain"
+	"backend/internal/series/ports"
+	"backend/internal/testing/factory"
+	"backend/internal/testing/pgtest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeriesRepository_StaysWithinBlog(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewSeriesRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	author := factory.NewUser().WithRole("author").Create(t, tx)
+	other := factory.NewBlog().Create(t, tx)
+	otherCtx := tenant.WithBlogID(ctx, other.ID)
+
+	here, err := domain.NewSeries("Getting Started", "", author.ID)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, here))
+
+	elsewhere, err := domain.NewSeries("Getting Started", "", author.ID)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(otherCtx, elsewhere), "slugs are unique within a blog")
+
+	found, err := repo.FindBySlug(ctx, here.Slug)
+	require.NoError(t, err)
+	assert.Equal(t, here.ID, found.ID)
+
+	_, err = repo.FindByID(ctx, elsewhere.ID)
+	assert.ErrorIs(t, err, ports.ErrSeriesNotFound, "series of other blogs are invisible")
+
+	summaries, err := repo.ListSeries(ctx, ports.ListFilter{AuthorID: &author.ID})
+	require.NoError(t, err)
+	require.Len(t, summaries, 1)
+	assert.Equal(t, here.ID, summaries[0].ID)
+
+	count, err := repo.CountSeries(otherCtx, ports.ListFilter{AuthorID: &author.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	exists, err := repo.SlugExists(otherCtx, here.Slug, &elsewhere.ID)
+	require.NoError(t, err)
+	assert.False(t, exists, "slugs taken on other blogs are free")
+}
+
+func TestSeriesRepository_ListPublishedEntriesHonoursAccessPolicy(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewSeriesRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	author := factory.NewUser().WithRole("author").Create(t, tx)
+	public := factory.NewPost(author.ID).Published().Create(t, tx)
+	unlisted := factory.NewPost(author.ID).Published().Create(t, tx)
+	embargoed := factory.NewPost(author.ID).Published().Create(t, tx)
+	draft := factory.NewPost(author.ID).Create(t, tx)
+	_, err := tx.Exec(ctx, `UPDATE posts SET visibility = 'unlisted' WHERE id = $1`, unlisted.ID)
+	require.NoError(t, err)
+	_, err = tx.Exec(ctx, `UPDATE posts SET embargo_until = NOW() + INTERVAL '1 day' WHERE id = $1`, embargoed.ID)
+	require.NoError(t, err)
+
+	series, err := domain.NewSeries("Building a Blog", "", author.ID)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, series))
+	for i, postID := range []uuid.UUID{public.ID, unlisted.ID, embargoed.ID, draft.ID} {
+		seriesPost, err := domain.NewSeriesPost(series.ID, postID, i+1)
+		require.NoError(t, err)
+		series.Posts = append(series.Posts, seriesPost)
+	}
+	require.NoError(t, repo.Save(ctx, series))
+
+	entries, err := repo.ListPublishedEntries(ctx, series.ID)
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "unlisted, embargoed and draft posts stay out of series navigation")
+	assert.Equal(t, public.ID, entries[0].PostID)
+}
@@ -0,0 +1,283 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"backend/internal/platform/postgres"
+	"backend/internal/serviceaccounts/domain"
+	"backend/internal/serviceaccounts/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// serviceAccountColumns are the columns scanned by scanServiceAccount, in order
+const serviceAccountColumns = `sa.id, sa.name, sa.description, sa.client_id, sa.secret_hash, sa.created_by, sa.created_at, sa.last_used_at, sa.revoked_at`
+
+// ServiceAccountRepository implements the serviceaccounts.AccountRepository interface using PostgreSQL
+type ServiceAccountRepository struct {
+	postgres.BaseRepository
+}
+
+// NewServiceAccountRepository creates a new PostgreSQL service account repository
+func NewServiceAccountRepository(db *pgxpool.Pool) *ServiceAccountRepository {
+	return &ServiceAccountRepository{
+		BaseRepository: postgres.NewBaseRepository(db),
+	}
+}
+
+// WithTx returns a new repository instance that uses the provided transaction
+func (r *ServiceAccountRepository) WithTx(tx pgx.Tx) *ServiceAccountRepository {
+	return &ServiceAccountRepository{
+		BaseRepository: r.BaseRepository.WithTx(tx),
+	}
+}
+
+// Create stores a new account in the current blog. The user record its roles
+// are granted to is inserted by the same statement, under a subject and an
+// address no one can sign in with.
+func (r *ServiceAccountRepository) Create(ctx context.Context, account *domain.Account) error {
+	id := pgtype.UUID{Bytes: account.ID, Valid: true}
+	compactID := strings.ReplaceAll(account.ID.String(), "-", "")
+	_, err := r.DB.Exec(ctx, `
+		WITH principal AS (
+			INSERT INTO users (id, supabase_id, email, username, display_name, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $9, $9)
+			RETURNING id
+		)
+		INSERT INTO service_accounts (id, blog_id, name, description, client_id, secret_hash, created_by, created_at)
+		SELECT id, $6, $5, $7, $8, $10, $11, $9 FROM principal`,
+		id,
+		"service-account:"+account.ID.String(),
+		account.ID.String()+"@service-accounts.invalid",
+		"svc-"+compactID[:26],
+		account.Name,
+		currentBlogID(ctx),
+		account.Description,
+		account.ClientID,
+		account.CreatedAt,
+		account.SecretHash,
+		pgtype.UUID{Bytes: account.CreatedBy, Valid: account.CreatedBy != uuid.Nil},
+	)
+	if err != nil {
+		return fmt.Errorf("ServiceAccountRepository.Create: %w", err)
+	}
+	return nil
+}
+
+// FindByID retrieves an account of the current blog
+func (r *ServiceAccountRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Account, error) {
+	row := r.DB.QueryRow(ctx,
+		`SELECT `+serviceAccountColumns+` FROM service_accounts sa WHERE sa.id = $1 AND sa.blog_id = $2`,
+		pgtype.UUID{Bytes: id, Valid: true}, currentBlogID(ctx),
+	)
+	account, err := scanServiceAccount(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrAccountNotFound
+		}
+		return nil, fmt.Errorf("ServiceAccountRepository.FindByID: %w", err)
+	}
+	return account, nil
+}
+
+// FindByClientID retrieves the account of the current blog with a client ID
+func (r *ServiceAccountRepository) FindByClientID(ctx context.Context, clientID string) (*domain.Account, error) {
+	row := r.DB.QueryRow(ctx,
+		`SELECT `+serviceAccountColumns+` FROM service_accounts sa WHERE sa.client_id = $1 AND sa.blog_id = $2`,
+		clientID, currentBlogID(ctx),
+	)
+	account, err := scanServiceAccount(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrAccountNotFound
+		}
+		return nil, fmt.Errorf("ServiceAccountRepository.FindByClientID: %w", err)
+	}
+	return account, nil
+}
+
+// List retrieves the accounts of the current blog, newest first
+func (r *ServiceAccountRepository) List(ctx context.Context) ([]*domain.Account, error) {
+	rows, err := r.DB.Query(ctx,
+		`SELECT `+serviceAccountColumns+` FROM service_accounts sa WHERE sa.blog_id = $1 ORDER BY sa.created_at DESC, sa.id`,
+		currentBlogID(ctx),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ServiceAccountRepository.List: %w", err)
+	}
+	defer rows.Close()
+
+	accounts := make([]*domain.Account, 0)
+	for rows.Next() {
+		account, err := scanServiceAccount(rows)
+		if err != nil {
+			return nil, fmt.Errorf("ServiceAccountRepository.List: scan: %w", err)
+		}
+		accounts = append(accounts, account)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ServiceAccountRepository.List: %w", err)
+	}
+	return accounts, nil
+}
+
+// Revoke stores the account's revocation time and deletes the tokens issued to it
+func (r *ServiceAccountRepository) Revoke(ctx context.Context, account *domain.Account) error {
+	var revoked int
+	err := r.DB.QueryRow(ctx, `
+		WITH revoked AS (
+			UPDATE service_accounts SET revoked_at = $1 WHERE id = $2 AND blog_id = $3
+			RETURNING id
+		), discarded AS (
+			DELETE FROM service_account_tokens WHERE account_id IN (SELECT id FROM revoked)
+		)
+		SELECT COUNT(*) FROM revoked`,
+		account.RevokedAt, pgtype.UUID{Bytes: account.ID, Valid: true}, currentBlogID(ctx),
+	).Scan(&revoked)
+	if err != nil {
+		return fmt.Errorf("ServiceAccountRepository.Revoke: %w", err)
+	}
+	if revoked == 0 {
+		return ports.ErrAccountNotFound
+	}
+	return nil
+}
+
+// SaveToken stores a newly issued token and records when its account was last used
+func (r *ServiceAccountRepository) SaveToken(ctx context.Context, token *domain.AccessToken) error {
+	_, err := r.DB.Exec(ctx, `
+		WITH used AS (
+			UPDATE service_accounts SET last_used_at = $3 WHERE id = $2
+		)
+		INSERT INTO service_account_tokens (token_hash, account_id, issued_at, expires_at)
+		VALUES ($1, $2, $3, $4)`,
+		token.TokenHash,
+		pgtype.UUID{Bytes: token.AccountID, Valid: true},
+		token.IssuedAt,
+		token.ExpiresAt,
+	)
+	if err != nil {
+		return fmt.Errorf("ServiceAccountRepository.SaveToken: %w", err)
+	}
+	return nil
+}
+
+// FindByTokenHash retrieves a token and the account of the current blog holding it
+func (r *ServiceAccountRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*domain.Account, *domain.AccessToken, error) {
+	row := r.DB.QueryRow(ctx, `
+		SELECT `+serviceAccountColumns+`, t.token_hash, t.issued_at, t.expires_at
+		FROM service_account_tokens t
+		JOIN service_accounts sa ON sa.id = t.account_id
+		WHERE t.token_hash = $1 AND sa.blog_id = $2`,
+		tokenHash, currentBlogID(ctx),
+	)
+
+	var id, createdBy pgtype.UUID
+	var account domain.Account
+	var token domain.AccessToken
+	if err := row.Scan(
+		&id, &account.Name, &account.Description, &account.ClientID, &account.SecretHash,
+		&createdBy, &account.CreatedAt, &account.LastUsedAt, &account.RevokedAt,
+		&token.TokenHash, &token.IssuedAt, &token.ExpiresAt,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil, ports.ErrTokenNotFound
+		}
+		return nil, nil, fmt.Errorf("ServiceAccountRepository.FindByTokenHash: %w", err)
+	}
+	account.ID = uuid.UUID(id.Bytes)
+	account.CreatedBy = uuid.UUID(createdBy.Bytes)
+	token.AccountID = account.ID
+	return &account, &token, nil
+}
+
+// RecordAction appends a request to an account's audit trail. A zero status
+// is stored as NULL until CompleteAction fills it in.
+func (r *ServiceAccountRepository) RecordAction(ctx context.Context, action *domain.Action) error {
+	err := r.DB.QueryRow(ctx, `
+		INSERT INTO service_account_actions (account_id, method, route, path, status, request_id, occurred_at)
+		VALUES ($1, $2, $3, $4, NULLIF($5, 0), $6, $7)
+		RETURNING id`,
+		pgtype.UUID{Bytes: action.AccountID, Valid: true},
+		action.Method,
+		action.Route,
+		action.Path,
+		action.Status,
+		action.RequestID,
+		action.OccurredAt,
+	).Scan(&action.ID)
+	if err != nil {
+		return fmt.Errorf("ServiceAccountRepository.RecordAction: %w", err)
+	}
+	return nil
+}
+
+// CompleteAction stores the response status of a recorded request
+func (r *ServiceAccountRepository) CompleteAction(ctx context.Context, action *domain.Action) error {
+	_, err := r.DB.Exec(ctx,
+		`UPDATE service_account_actions SET status = $2 WHERE id = $1`,
+		action.ID,
+		action.Status,
+	)
+	if err != nil {
+		return fmt.Errorf("ServiceAccountRepository.CompleteAction: %w", err)
+	}
+	return nil
+}
+
+// ListActions retrieves up to limit of the requests an account made, newest first
+func (r *ServiceAccountRepository) ListActions(ctx context.Context, accountID uuid.UUID, limit int) ([]*domain.Action, error) {
+	rows, err := r.DB.Query(ctx, `
+		SELECT id, account_id, method, route, path, COALESCE(status, 0), request_id, occurred_at
+		FROM service_account_actions
+		WHERE account_id = $1
+		ORDER BY occurred_at DESC, id DESC
+		LIMIT $2`,
+		pgtype.UUID{Bytes: accountID, Valid: true}, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ServiceAccountRepository.ListActions: %w", err)
+	}
+	defer rows.Close()
+
+	actions := make([]*domain.Action, 0)
+	for rows.Next() {
+		var aid pgtype.UUID
+		var action domain.Action
+		if err := rows.Scan(
+			&action.ID, &aid, &action.Method, &action.Route, &action.Path,
+			&action.Status, &action.RequestID, &action.OccurredAt,
+		); err != nil {
+			return nil, fmt.Errorf("ServiceAccountRepository.ListActions: scan: %w", err)
+		}
+		action.AccountID = uuid.UUID(aid.Bytes)
+		actions = append(actions, &action)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ServiceAccountRepository.ListActions: %w", err)
+	}
+	return actions, nil
+}
+
+// scanServiceAccount reads the serviceAccountColumns of a row
+func scanServiceAccount(row pgx.Row) (*domain.Account, error) {
+	var id, createdBy pgtype.UUID
+	var account domain.Account
+	if err := row.Scan(
+		&id, &account.Name, &account.Description, &account.ClientID, &account.SecretHash,
+		&createdBy, &account.CreatedAt, &account.LastUsedAt, &account.RevokedAt,
+	); err != nil {
+		return nil, err
+	}
+	account.ID = uuid.UUID(id.Bytes)
+	account.CreatedBy = uuid.UUID(createdBy.Bytes) // uuid.Nil once the creator is deleted
+	return &account, nil
+}
+
+// Compile-time check to ensure ServiceAccountRepository implements ports.AccountRepository
+var _ ports.AccountRepository = (*ServiceAccountRepository)(nil)
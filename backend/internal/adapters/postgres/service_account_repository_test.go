@@ -0,0 +1,97 @@
+package postgres_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"backend/internal/adapters/postgres"
+	"backend/internal/serviceaccounts/domain"
+	"backend/internal/serviceaccounts/ports"
+	"backend/internal/testing/factory"
+	"backend/internal/testing/pgtest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceAccountRepository_AccountLifecycle(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewServiceAccountRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	admin := factory.NewUser().Create(t, tx)
+
+	account, secret, err := domain.NewAccount(admin.ID, "Search indexer", "Reindexes published posts")
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, account))
+
+	var displayName string
+	err = tx.QueryRow(ctx, `SELECT display_name FROM users WHERE id = $1`, account.ID).Scan(&displayName)
+	require.NoError(t, err, "roles are granted to a user record with the account's ID")
+	assert.Equal(t, "Search indexer", displayName)
+
+	found, err := repo.FindByClientID(ctx, account.ClientID)
+	require.NoError(t, err)
+	assert.Equal(t, account.ID, found.ID)
+	assert.Equal(t, admin.ID, found.CreatedBy)
+	assert.True(t, found.VerifySecret(secret))
+	assert.Nil(t, found.LastUsedAt)
+
+	token, raw, err := domain.NewAccessToken(account.ID, time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, repo.SaveToken(ctx, token))
+
+	holder, stored, err := repo.FindByTokenHash(ctx, domain.HashToken(raw))
+	require.NoError(t, err)
+	assert.Equal(t, account.ID, holder.ID)
+	assert.NotNil(t, holder.LastUsedAt, "issuing a token marks the account as used")
+	assert.WithinDuration(t, token.ExpiresAt, stored.ExpiresAt, time.Millisecond)
+
+	for _, status := range []int{http.StatusOK, http.StatusForbidden} {
+		require.NoError(t, repo.RecordAction(ctx, &domain.Action{
+			AccountID:  account.ID,
+			Method:     http.MethodPost,
+			Route:      "/api/v1/posts",
+			Path:       "/api/v1/posts",
+			Status:     status,
+			RequestID:  uuid.NewString(),
+			OccurredAt: time.Now(),
+		}))
+	}
+	actions, err := repo.ListActions(ctx, account.ID, 1)
+	require.NoError(t, err)
+	require.Len(t, actions, 1)
+	assert.Equal(t, http.StatusForbidden, actions[0].Status, "newest first")
+
+	pending := &domain.Action{
+		AccountID:  account.ID,
+		Method:     http.MethodDelete,
+		Route:      "/api/v1/posts/{id}",
+		Path:       "/api/v1/posts/" + uuid.NewString(),
+		RequestID:  uuid.NewString(),
+		OccurredAt: time.Now(),
+	}
+	require.NoError(t, repo.RecordAction(ctx, pending))
+	actions, err = repo.ListActions(ctx, account.ID, 1)
+	require.NoError(t, err)
+	assert.Zero(t, actions[0].Status, "recorded before the response is sent")
+	pending.Status = http.StatusNoContent
+	require.NoError(t, repo.CompleteAction(ctx, pending))
+	actions, err = repo.ListActions(ctx, account.ID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, actions[0].Status)
+
+	require.NoError(t, account.Revoke())
+	require.NoError(t, repo.Revoke(ctx, account))
+	found, err = repo.FindByID(ctx, account.ID)
+	require.NoError(t, err)
+	assert.True(t, found.Revoked())
+
+	_, _, err = repo.FindByTokenHash(ctx, domain.HashToken(raw))
+	assert.ErrorIs(t, err, ports.ErrTokenNotFound, "revoking discards issued tokens")
+
+	_, err = repo.FindByClientID(ctx, "absa_unknown")
+	assert.ErrorIs(t, err, ports.ErrAccountNotFound)
+}
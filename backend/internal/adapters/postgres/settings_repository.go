@@ -0,0 +1,129 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"backend/internal/platform/postgres"
+	"backend/internal/settings/domain"
+	"backend/internal/settings/ports"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// SettingsRepository implements the settings.SettingsRepository interface using PostgreSQL
+type SettingsRepository struct {
+	postgres.BaseRepository // Embed the base repository for common functionality
+}
+
+// NewSettingsRepository creates a new PostgreSQL settings repository
+func NewSettingsRepository(db *pgxpool.Pool) *SettingsRepository {
+	return &SettingsRepository{
+		BaseRepository: postgres.NewBaseRepository(db),
+	}
+}
+
+// Get retrieves the stored values of a namespace
+// UpdatedBy and UpdatedAt come from the most recently written key
+func (r *SettingsRepository) Get(ctx context.Context, namespace domain.Namespace) (*domain.Settings, error) {
+	query, args, err := r.SB.
+		Select("key", "value", "updated_by", "updated_at").
+		From("settings").
+		Where(sq.Eq{"namespace": string(namespace)}).
+		Where("blog_id IS NOT DISTINCT FROM ?", settingsScope(ctx, namespace)).
+		OrderBy("updated_at ASC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("SettingsRepository.Get: build query: %w", err)
+	}
+
+	rows, err := r.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("SettingsRepository.Get: %w", err)
+	}
+	defer rows.Close()
+
+	settings := &domain.Settings{Namespace: namespace, Values: domain.Values{}}
+	for rows.Next() {
+		var key string
+		var raw []byte
+		var updatedBy pgtype.UUID
+		var updatedAt pgtype.Timestamptz
+		if err := rows.Scan(&key, &raw, &updatedBy, &updatedAt); err != nil {
+			return nil, fmt.Errorf("SettingsRepository.Get: scan: %w", err)
+		}
+
+		var value any
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return nil, fmt.Errorf("SettingsRepository.Get: decode %s: %w", key, err)
+		}
+		settings.Values[key] = value
+
+		// Rows come oldest first, so the last one wins
+		settings.UpdatedAt = &updatedAt.Time
+		settings.UpdatedBy = nil
+		if updatedBy.Valid {
+			actorID := uuid.UUID(updatedBy.Bytes)
+			settings.UpdatedBy = &actorID
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("SettingsRepository.Get: rows error: %w", err)
+	}
+
+	return settings, nil
+}
+
+// Replace stores settings.Values as the namespace's complete set of values
+// The batch runs as one implicit transaction, so readers never see a half-replaced namespace
+func (r *SettingsRepository) Replace(ctx context.Context, settings *domain.Settings) error {
+	scope := settingsScope(ctx, settings.Namespace)
+	updatedAt := toNullableTimestamptz(settings.UpdatedAt)
+	updatedBy := toNullableUUID(settings.UpdatedBy)
+
+	batch := &pgx.Batch{}
+	batch.Queue(
+		"DELETE FROM settings WHERE namespace = $1 AND blog_id IS NOT DISTINCT FROM $2",
+		string(settings.Namespace), scope,
+	)
+	for key, value := range settings.Values {
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("SettingsRepository.Replace: encode %s: %w", key, err)
+		}
+		batch.Queue(
+			"INSERT INTO settings (blog_id, namespace, key, value, updated_by, updated_at) VALUES ($1, $2, $3, $4, $5, $6)",
+			scope, string(settings.Namespace), key, raw, updatedBy, updatedAt,
+		)
+	}
+
+	br := r.DB.SendBatch(ctx, batch)
+	for i := 0; i < batch.Len(); i++ {
+		if _, err := br.Exec(); err != nil {
+			_ = br.Close()
+			return fmt.Errorf("SettingsRepository.Replace: %w", err)
+		}
+	}
+	if err := br.Close(); err != nil {
+		return fmt.Errorf("SettingsRepository.Replace: close batch: %w", err)
+	}
+
+	return nil
+}
+
+// settingsScope returns the settings.blog_id a namespace is stored under in ctx
+// System settings are shared by every blog and stored as NULL
+func settingsScope(ctx context.Context, namespace domain.Namespace) pgtype.UUID {
+	if !namespace.IsBlogScoped() {
+		return pgtype.UUID{}
+	}
+	return currentBlogID(ctx)
+}
+
+// Compile-time check to ensure SettingsRepository implements ports.SettingsRepository
+var _ ports.SettingsRepository = (*SettingsRepository)(nil)
@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend/internal/platform/postgres"
+	"backend/internal/platform/signedlink"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// SignedLinkRepository implements the signedlink.NonceStore interface using PostgreSQL.
+// Nonces are random across blogs, so they are not scoped to the current one.
+type SignedLinkRepository struct {
+	postgres.BaseRepository
+}
+
+// NewSignedLinkRepository creates a new PostgreSQL signed link nonce repository
+func NewSignedLinkRepository(db *pgxpool.Pool) *SignedLinkRepository {
+	return &SignedLinkRepository{
+		BaseRepository: postgres.NewBaseRepository(db),
+	}
+}
+
+// WithTx returns a new repository instance that uses the provided transaction
+func (r *SignedLinkRepository) WithTx(tx pgx.Tx) *SignedLinkRepository {
+	return &SignedLinkRepository{
+		BaseRepository: r.BaseRepository.WithTx(tx),
+	}
+}
+
+// Consume records a nonce unless it is already recorded, pruning expired ones on the way
+func (r *SignedLinkRepository) Consume(ctx context.Context, nonce string, expiresAt time.Time) (bool, error) {
+	if _, err := r.DB.Exec(ctx, `DELETE FROM signed_link_nonces WHERE expires_at < NOW()`); err != nil {
+		return false, fmt.Errorf("SignedLinkRepository.Consume: prune: %w", err)
+	}
+
+	result, err := r.DB.Exec(ctx, `
+		INSERT INTO signed_link_nonces (nonce, expires_at) VALUES ($1, $2)
+		ON CONFLICT (nonce) DO NOTHING`,
+		nonce, expiresAt,
+	)
+	if err != nil {
+		return false, fmt.Errorf("SignedLinkRepository.Consume: %w", err)
+	}
+	return result.RowsAffected() == 1, nil
+}
+
+// Compile-time check to ensure SignedLinkRepository implements signedlink.NonceStore
+var _ signedlink.NonceStore = (*SignedLinkRepository)(nil)
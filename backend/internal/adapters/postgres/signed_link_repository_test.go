@@ -0,0 +1,36 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"backend/internal/adapters/postgres"
+	"backend/internal/testing/pgtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignedLinkRepository_ConsumeOnce(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewSignedLinkRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	fresh, err := repo.Consume(ctx, "nonce-1", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.True(t, fresh)
+
+	fresh, err = repo.Consume(ctx, "nonce-1", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.False(t, fresh, "a nonce is accepted once")
+
+	// Expired nonces are pruned, but their links are refused before reaching the store
+	_, err = repo.Consume(ctx, "nonce-2", time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	fresh, err = repo.Consume(ctx, "nonce-3", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.True(t, fresh)
+	fresh, err = repo.Consume(ctx, "nonce-2", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.True(t, fresh, "pruned nonces are forgotten")
+}
@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"backend/internal/platform/postgres"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Entity types recorded in the slug_history table
+const (
+	slugEntityPost  = "post"
+	slugEntityTheme = "theme"
+)
+
+// recordSlugChange keeps the entity's current slug as a redirect when it is about to change,
+// and drops any redirect for the new slug now that the entity owns it.
+// Slugs are namespaced per blog, so both steps only touch the request's blog.
+// It must run before the entity row is updated, inside the same transaction.
+func recordSlugChange(ctx context.Context, base postgres.BaseRepository, entityType, table string, id uuid.UUID, newSlug string) error {
+	// The subquery keeps ? placeholders; the outer builder rewrites them for PostgreSQL
+	previous := sq.
+		Select().
+		Column("blog_id").
+		Column(sq.Expr("?", entityType)).
+		Columns("id", "slug", "NOW()").
+		From(table).
+		Where(sq.Eq{"id": pgtype.UUID{Bytes: id, Valid: true}, "blog_id": currentBlogID(ctx)}).
+		Where(sq.NotEq{"slug": newSlug})
+
+	query, args, err := base.SB.
+		Insert("slug_history").
+		Columns("blog_id", "entity_type", "entity_id", "slug", "created_at").
+		Select(previous).
+		Suffix("ON CONFLICT (blog_id, entity_type, slug) DO UPDATE SET entity_id = EXCLUDED.entity_id, created_at = EXCLUDED.created_at").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("recordSlugChange: build insert query: %w", err)
+	}
+
+	if _, err := base.DB.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("recordSlugChange: insert history: %w", err)
+	}
+
+	query, args, err = base.SB.
+		Delete("slug_history").
+		Where(sq.Eq{"blog_id": currentBlogID(ctx), "entity_type": entityType, "slug": newSlug}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("recordSlugChange: build delete query: %w", err)
+	}
+
+	if _, err := base.DB.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("recordSlugChange: release new slug: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"backend/internal/adapters/postgres"
+	postsPorts "backend/internal/posts/ports"
+	"backend/internal/testing/factory"
+	"backend/internal/testing/pgtest"
+	themesPorts "backend/internal/themes/ports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostRepository_PreviousSlugsResolveUntilReused(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewPostRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	author := factory.NewUser().WithRole("author").Create(t, tx)
+	renamed := factory.NewPost(author.ID).Slug("old-title").Published().Create(t, tx)
+	other := factory.NewPost(author.ID).Slug("other-title").Published().Create(t, tx)
+
+	post, err := repo.FindByID(ctx, renamed.ID)
+	require.NoError(t, err)
+	post.Slug = "new-title"
+	require.NoError(t, repo.Update(ctx, post))
+
+	found, err := repo.FindByPreviousSlug(ctx, "old-title")
+	require.NoError(t, err)
+	assert.Equal(t, renamed.ID, found.ID)
+	assert.Equal(t, "new-title", found.Slug, "the post answers with its current slug")
+
+	// Another post taking the retired slug owns it from then on
+	post, err = repo.FindByID(ctx, other.ID)
+	require.NoError(t, err)
+	post.Slug = "old-title"
+	require.NoError(t, repo.Update(ctx, post))
+	_, err = repo.FindByPreviousSlug(ctx, "old-title")
+	assert.ErrorIs(t, err, postsPorts.ErrPostNotFound)
+
+	found, err = repo.FindByPreviousSlug(ctx, "other-title")
+	require.NoError(t, err)
+	assert.Equal(t, other.ID, found.ID)
+}
+
+func TestThemeRepository_PreviousSlugsResolve(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewThemeRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	curator := factory.NewUser().WithRole("author").Create(t, tx)
+	created := factory.NewTheme(curator.ID).Slug("go-patterns").Create(t, tx)
+
+	theme, err := repo.LoadThemeWithArticles(ctx, created.ID)
+	require.NoError(t, err)
+	theme.Slug = "go-design-patterns"
+	require.NoError(t, repo.Save(ctx, theme))
+
+	found, err := repo.FindByPreviousSlug(ctx, "go-patterns")
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, found.ID)
+	assert.Equal(t, "go-design-patterns", found.Slug)
+
+	_, err = repo.FindByPreviousSlug(ctx, "never-used")
+	assert.ErrorIs(t, err, themesPorts.ErrThemeNotFound)
+}
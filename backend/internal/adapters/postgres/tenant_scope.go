@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"context"
+
+	"backend/internal/platform/postgres"
+	"backend/internal/platform/tenant"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// currentBlogID returns the blog the request is scoped to, ready to bind as a query argument
+// Posts, themes and their slug history are read and written only within this blog
+func currentBlogID(ctx context.Context) pgtype.UUID {
+	return pgtype.UUID{Bytes: tenant.BlogID(ctx), Valid: true}
+}
+
+// roleScope returns the user_roles.blog_id that assignments made in ctx are stored under
+// Assignments made on the default blog are stored as NULL and apply to every blog
+func roleScope(ctx context.Context) pgtype.UUID {
+	if tenant.IsDefault(ctx) {
+		return pgtype.UUID{}
+	}
+	return currentBlogID(ctx)
+}
+
+// listBlogIDs returns every blog, oldest first, for background jobs that visit each in turn
+func listBlogIDs(ctx context.Context, db postgres.Querier) ([]uuid.UUID, error) {
+	rows, err := db.Query(ctx, `SELECT id FROM blogs ORDER BY created_at, id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var blogIDs []uuid.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		blogIDs = append(blogIDs, uuid.UUID(id.Bytes))
+	}
+	return blogIDs, rows.Err()
+}
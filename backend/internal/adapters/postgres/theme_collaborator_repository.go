@@ -0,0 +1,158 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"backend/internal/platform/postgres"
+	"backend/internal/themes/domain"
+	"backend/internal/themes/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// themeCollaboratorColumns are the columns scanned by scanThemeCollaborator, in order
+const themeCollaboratorColumns = `c.theme_id, c.user_id, c.role, c.added_by, c.created_at`
+
+// ThemeCollaboratorRepository implements the themes.CollaboratorRepository interface using PostgreSQL
+// Collaborators are reached through their theme, so every query is scoped to the themes of the current blog
+type ThemeCollaboratorRepository struct {
+	postgres.BaseRepository
+}
+
+// NewThemeCollaboratorRepository creates a new PostgreSQL theme collaborator repository
+func NewThemeCollaboratorRepository(db *pgxpool.Pool) *ThemeCollaboratorRepository {
+	return &ThemeCollaboratorRepository{
+		BaseRepository: postgres.NewBaseRepository(db),
+	}
+}
+
+// WithTx returns a new repository instance that uses the provided transaction
+func (r *ThemeCollaboratorRepository) WithTx(tx pgx.Tx) ports.CollaboratorRepository {
+	return &ThemeCollaboratorRepository{
+		BaseRepository: r.BaseRepository.WithTx(tx),
+	}
+}
+
+// List returns the collaborators of a theme, earliest added first
+func (r *ThemeCollaboratorRepository) List(ctx context.Context, themeID uuid.UUID) ([]*domain.Collaborator, error) {
+	rows, err := r.DB.Query(ctx, `
+		SELECT `+themeCollaboratorColumns+`
+		FROM theme_collaborators c
+		JOIN themes t ON t.id = c.theme_id
+		WHERE c.theme_id = $1 AND t.blog_id = $2 AND t.deleted_at IS NULL
+		ORDER BY c.created_at, c.user_id`,
+		pgtype.UUID{Bytes: themeID, Valid: true}, currentBlogID(ctx),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ThemeCollaboratorRepository.List: %w", err)
+	}
+	defer rows.Close()
+
+	var collaborators []*domain.Collaborator
+	for rows.Next() {
+		collaborator, err := scanThemeCollaborator(rows)
+		if err != nil {
+			return nil, fmt.Errorf("ThemeCollaboratorRepository.List: scan: %w", err)
+		}
+		collaborators = append(collaborators, collaborator)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ThemeCollaboratorRepository.List: %w", err)
+	}
+	return collaborators, nil
+}
+
+// Find returns one collaborator of a theme
+func (r *ThemeCollaboratorRepository) Find(ctx context.Context, themeID, userID uuid.UUID) (*domain.Collaborator, error) {
+	row := r.DB.QueryRow(ctx, `
+		SELECT `+themeCollaboratorColumns+`
+		FROM theme_collaborators c
+		JOIN themes t ON t.id = c.theme_id
+		WHERE c.theme_id = $1 AND c.user_id = $2 AND t.blog_id = $3`,
+		pgtype.UUID{Bytes: themeID, Valid: true},
+		pgtype.UUID{Bytes: userID, Valid: true},
+		currentBlogID(ctx),
+	)
+	collaborator, err := scanThemeCollaborator(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrCollaboratorNotFound
+		}
+		return nil, fmt.Errorf("ThemeCollaboratorRepository.Find: %w", err)
+	}
+	return collaborator, nil
+}
+
+// Save adds a collaborator to a theme of the current blog, or changes their role
+// The original adder and time are kept when only the role changes
+func (r *ThemeCollaboratorRepository) Save(ctx context.Context, collaborator *domain.Collaborator) error {
+	tag, err := r.DB.Exec(ctx, `
+		INSERT INTO theme_collaborators (theme_id, user_id, role, added_by, created_at)
+		SELECT t.id, $2, $3, $4, $5
+		FROM themes t
+		WHERE t.id = $1 AND t.blog_id = $6 AND t.deleted_at IS NULL
+		ON CONFLICT (theme_id, user_id) DO UPDATE SET role = EXCLUDED.role`,
+		pgtype.UUID{Bytes: collaborator.ThemeID, Valid: true},
+		pgtype.UUID{Bytes: collaborator.UserID, Valid: true},
+		string(collaborator.Role),
+		pgtype.UUID{Bytes: collaborator.AddedBy, Valid: true},
+		collaborator.AddedAt,
+		currentBlogID(ctx),
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return ports.ErrCollaboratorUserNotFound
+		}
+		return fmt.Errorf("ThemeCollaboratorRepository.Save: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ports.ErrThemeNotFound
+	}
+	return nil
+}
+
+// Remove removes a collaborator from a theme of the current blog
+func (r *ThemeCollaboratorRepository) Remove(ctx context.Context, themeID, userID uuid.UUID) error {
+	tag, err := r.DB.Exec(ctx, `
+		DELETE FROM theme_collaborators c
+		USING themes t
+		WHERE t.id = c.theme_id AND c.theme_id = $1 AND c.user_id = $2 AND t.blog_id = $3`,
+		pgtype.UUID{Bytes: themeID, Valid: true},
+		pgtype.UUID{Bytes: userID, Valid: true},
+		currentBlogID(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("ThemeCollaboratorRepository.Remove: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ports.ErrCollaboratorNotFound
+	}
+	return nil
+}
+
+// scanThemeCollaborator reads a collaborator from a row of themeCollaboratorColumns
+func scanThemeCollaborator(row pgx.Row) (*domain.Collaborator, error) {
+	var (
+		collaborator    domain.Collaborator
+		themeID, userID pgtype.UUID
+		addedBy         pgtype.UUID
+		role            string
+	)
+	if err := row.Scan(&themeID, &userID, &role, &addedBy, &collaborator.AddedAt); err != nil {
+		return nil, err
+	}
+	collaborator.ThemeID = uuid.UUID(themeID.Bytes)
+	collaborator.UserID = uuid.UUID(userID.Bytes)
+	collaborator.Role = domain.CollaboratorRole(role)
+	collaborator.AddedBy = uuid.UUID(addedBy.Bytes)
+	return &collaborator, nil
+}
+
+// Compile-time check to ensure ThemeCollaboratorRepository implements ports.CollaboratorRepository
+var _ ports.CollaboratorRepository = (*ThemeCollaboratorRepository)(nil)
@@ -0,0 +1,81 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"backend/internal/adapters/postgres"
+	"backend/internal/testing/factory"
+	"backend/internal/testing/pgtest"
+	"backend/internal/themes/domain"
+	"backend/internal/themes/ports"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThemeCollaboratorRepository_Lifecycle(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewThemeCollaboratorRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	curator := factory.NewUser().WithRole("author").Create(t, tx)
+	editor := factory.NewUser().Create(t, tx)
+	contributor := factory.NewUser().Create(t, tx)
+	theme := factory.NewTheme(curator.ID).Create(t, tx)
+
+	addedAt := time.Now().Add(-time.Minute)
+	require.NoError(t, repo.Save(ctx, &domain.Collaborator{
+		ThemeID: theme.ID, UserID: editor.ID, Role: domain.CollaboratorRoleContributor, AddedBy: curator.ID, AddedAt: addedAt,
+	}))
+	require.NoError(t, repo.Save(ctx, &domain.Collaborator{
+		ThemeID: theme.ID, UserID: contributor.ID, Role: domain.CollaboratorRoleContributor, AddedBy: curator.ID, AddedAt: time.Now(),
+	}))
+
+	// Saving again changes the role only
+	require.NoError(t, repo.Save(ctx, &domain.Collaborator{
+		ThemeID: theme.ID, UserID: editor.ID, Role: domain.CollaboratorRoleEditor, AddedBy: contributor.ID, AddedAt: time.Now(),
+	}))
+	found, err := repo.Find(ctx, theme.ID, editor.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.CollaboratorRoleEditor, found.Role)
+	assert.Equal(t, curator.ID, found.AddedBy)
+
+	collaborators, err := repo.List(ctx, theme.ID)
+	require.NoError(t, err)
+	require.Len(t, collaborators, 2)
+	assert.Equal(t, editor.ID, collaborators[0].UserID, "earliest added first")
+
+	require.NoError(t, repo.Remove(ctx, theme.ID, contributor.ID))
+	assert.ErrorIs(t, repo.Remove(ctx, theme.ID, contributor.ID), ports.ErrCollaboratorNotFound)
+	_, err = repo.Find(ctx, theme.ID, contributor.ID)
+	assert.ErrorIs(t, err, ports.ErrCollaboratorNotFound)
+
+	err = repo.Save(ctx, &domain.Collaborator{
+		ThemeID: uuid.New(), UserID: editor.ID, Role: domain.CollaboratorRoleEditor, AddedBy: curator.ID, AddedAt: time.Now(),
+	})
+	assert.ErrorIs(t, err, ports.ErrThemeNotFound)
+
+	// Last, since the failed insert aborts the transaction
+	err = repo.Save(ctx, &domain.Collaborator{
+		ThemeID: theme.ID, UserID: uuid.New(), Role: domain.CollaboratorRoleEditor, AddedBy: curator.ID, AddedAt: time.Now(),
+	})
+	assert.ErrorIs(t, err, ports.ErrCollaboratorUserNotFound)
+}
+
+func TestThemeCollaboratorRepository_ScopedToBlog(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewThemeCollaboratorRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	curator := factory.NewUser().WithRole("author").Create(t, tx)
+	collaborator := factory.NewUser().Create(t, tx)
+	other := factory.NewBlog().Create(t, tx)
+	theme := factory.NewTheme(curator.ID).Blog(other.ID).Create(t, tx)
+
+	err := repo.Save(ctx, &domain.Collaborator{
+		ThemeID: theme.ID, UserID: collaborator.ID, Role: domain.CollaboratorRoleEditor, AddedBy: curator.ID, AddedAt: time.Now(),
+	})
+	assert.ErrorIs(t, err, ports.ErrThemeNotFound, "themes of other blogs cannot be shared from this one")
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"backend/internal/platform/postgres"
@@ -40,18 +41,20 @@ func (r *ThemeRepository) Create(ctx context.Context, theme *domain.Theme) error
 	query, args, err := r.SB.
 		Insert("themes").
 		Columns(
-			"id", "name", "description", "slug",
-			"curator_id", "is_active", "created_at", "updated_at",
+			"id", "blog_id", "name", "description", "slug",
+			"curator_id", "status", "created_at", "updated_at", "curator_name",
 		).
 		Values(
 			pgtype.UUID{Bytes: uuid.UUID(theme.ID), Valid: true},
+			currentBlogID(ctx),
 			theme.Name,
 			theme.Description,
 			theme.Slug,
 			pgtype.UUID{Bytes: uuid.UUID(theme.CuratorID), Valid: true},
-			theme.IsActive,
+			string(theme.Status),
 			pgtype.Timestamptz{Time: theme.CreatedAt, Valid: true},
 			pgtype.Timestamptz{Time: theme.UpdatedAt, Valid: true},
+			sq.Expr("(SELECT username FROM users WHERE id = ?)", pgtype.UUID{Bytes: uuid.UUID(theme.CuratorID), Valid: true}),
 		).
 		ToSql()
 	if err != nil {
@@ -70,15 +73,24 @@ func (r *ThemeRepository) Create(ctx context.Context, theme *domain.Theme) error
 // Note: This method assumes it's being called within a transaction context.
 // The service layer is responsible for transaction management.
 func (r *ThemeRepository) Save(ctx context.Context, theme *domain.Theme) error {
-	// Step 1: Update the theme entity itself
+	// Step 1: Keep the old slug resolvable before it is overwritten
+	if err := recordSlugChange(ctx, r.BaseRepository, slugEntityTheme, "themes", theme.ID, theme.Slug); err != nil {
+		return fmt.Errorf("ThemeRepository.Save: %w", err)
+	}
+
+	// Step 2: Update the theme entity itself
 	query, args, err := r.SB.
 		Update("themes").
 		Set("name", theme.Name).
 		Set("description", theme.Description).
 		Set("slug", theme.Slug).
-		Set("is_active", theme.IsActive).
+		Set("status", string(theme.Status)).
 		Set("updated_at", pgtype.Timestamptz{Time: theme.UpdatedAt, Valid: true}).
-		Where(sq.Eq{"id": pgtype.UUID{Bytes: uuid.UUID(theme.ID), Valid: true}}).
+		Where(sq.Eq{
+			"id":         pgtype.UUID{Bytes: uuid.UUID(theme.ID), Valid: true},
+			"blog_id":    currentBlogID(ctx),
+			"deleted_at": nil,
+		}).
 		ToSql()
 	if err != nil {
 		return fmt.Errorf("ThemeRepository.Save: build update query: %w", err)
@@ -93,7 +105,7 @@ func (r *ThemeRepository) Save(ctx context.Context, theme *domain.Theme) error {
 		return ports.ErrThemeNotFound
 	}
 
-	// Step 2: Sync the articles collection (diff and sync algorithm)
+	// Step 3: Sync the articles collection (diff and sync algorithm)
 	if err := r.syncArticles(ctx, theme.ID, theme.Articles); err != nil {
 		return fmt.Errorf("ThemeRepository.Save: sync articles: %w", err)
 	}
@@ -101,11 +113,28 @@ func (r *ThemeRepository) Save(ctx context.Context, theme *domain.Theme) error {
 	return nil
 }
 
-// Delete removes a theme from the database
+// CreateWithArticles inserts a new theme and all of its articles
+// Note: Like Save, this assumes the service has opened a transaction,
+// so a failure part way through leaves no half-copied theme behind.
+func (r *ThemeRepository) CreateWithArticles(ctx context.Context, theme *domain.Theme) error {
+	if err := r.Create(ctx, theme); err != nil {
+		return fmt.Errorf("ThemeRepository.CreateWithArticles: %w", err)
+	}
+
+	if err := r.syncArticles(ctx, theme.ID, theme.Articles); err != nil {
+		return fmt.Errorf("ThemeRepository.CreateWithArticles: insert articles: %w", err)
+	}
+
+	return nil
+}
+
+// Delete moves a theme to the trash
+// Its articles, collaborators and slug history stay in place for a restore
 func (r *ThemeRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	query, args, err := r.SB.
-		Delete("themes").
-		Where(sq.Eq{"id": pgtype.UUID{Bytes: id, Valid: true}}).
+		Update("themes").
+		Set("deleted_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"id": pgtype.UUID{Bytes: id, Valid: true}, "blog_id": currentBlogID(ctx), "deleted_at": nil}).
 		ToSql()
 	if err != nil {
 		return fmt.Errorf("ThemeRepository.Delete: build query: %w", err)
@@ -123,15 +152,71 @@ func (r *ThemeRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// Restore takes a theme out of the trash, giving it slug
+func (r *ThemeRepository) Restore(ctx context.Context, id uuid.UUID, slug string) error {
+	query, args, err := r.SB.
+		Update("themes").
+		Set("deleted_at", nil).
+		Set("slug", slug).
+		Where(sq.Eq{"id": pgtype.UUID{Bytes: id, Valid: true}, "blog_id": currentBlogID(ctx)}).
+		Where(sq.NotEq{"deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("ThemeRepository.Restore: build query: %w", err)
+	}
+
+	result, err := r.DB.Exec(ctx, query, args...)
+	if err != nil {
+		// A live theme took the slug after the caller checked it
+		if isUniqueViolation(err) {
+			return ports.ErrThemeSlugExists
+		}
+		return fmt.Errorf("ThemeRepository.Restore: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ports.ErrThemeNotFound
+	}
+
+	return nil
+}
+
+// FindDeletedByID retrieves a theme in the trash by its ID (without articles)
+func (r *ThemeRepository) FindDeletedByID(ctx context.Context, id uuid.UUID) (*domain.Theme, error) {
+	query, args, err := r.SB.
+		Select(
+			"id", "name", "description", "slug",
+			"curator_id", "status", "created_at", "updated_at",
+		).
+		From("themes").
+		Where(sq.Eq{"id": pgtype.UUID{Bytes: id, Valid: true}, "blog_id": currentBlogID(ctx)}).
+		Where(sq.NotEq{"deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("ThemeRepository.FindDeletedByID: build query: %w", err)
+	}
+
+	row := r.DB.QueryRow(ctx, query, args...)
+	theme, err := scanTheme(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrThemeNotFound
+		}
+		return nil, fmt.Errorf("ThemeRepository.FindDeletedByID: %w", err)
+	}
+
+	return theme, nil
+}
+
 // FindByID retrieves a theme by its ID (without articles)
 func (r *ThemeRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Theme, error) {
 	query, args, err := r.SB.
 		Select(
 			"id", "name", "description", "slug",
-			"curator_id", "is_active", "created_at", "updated_at",
+			"curator_id", "status", "created_at", "updated_at",
 		).
 		From("themes").
-		Where(sq.Eq{"id": pgtype.UUID{Bytes: id, Valid: true}}).
+		Where(sq.Eq{"id": pgtype.UUID{Bytes: id, Valid: true}, "blog_id": currentBlogID(ctx), "deleted_at": nil}).
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("ThemeRepository.FindByID: build query: %w", err)
@@ -154,10 +239,10 @@ func (r *ThemeRepository) FindBySlug(ctx context.Context, slug string) (*domain.
 	query, args, err := r.SB.
 		Select(
 			"id", "name", "description", "slug",
-			"curator_id", "is_active", "created_at", "updated_at",
+			"curator_id", "status", "created_at", "updated_at",
 		).
 		From("themes").
-		Where(sq.Eq{"slug": slug}).
+		Where(sq.Eq{"slug": slug, "blog_id": currentBlogID(ctx), "deleted_at": nil}).
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("ThemeRepository.FindBySlug: build query: %w", err)
@@ -175,6 +260,33 @@ func (r *ThemeRepository) FindBySlug(ctx context.Context, slug string) (*domain.
 	return theme, nil
 }
 
+// FindByPreviousSlug retrieves the theme that used to be published under a slug (without articles)
+func (r *ThemeRepository) FindByPreviousSlug(ctx context.Context, slug string) (*domain.Theme, error) {
+	query, args, err := r.SB.
+		Select(
+			"t.id", "t.name", "t.description", "t.slug",
+			"t.curator_id", "t.status", "t.created_at", "t.updated_at",
+		).
+		From("slug_history h").
+		Join("themes t ON t.id = h.entity_id").
+		Where(sq.Eq{"h.blog_id": currentBlogID(ctx), "h.entity_type": slugEntityTheme, "h.slug": slug, "t.deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("ThemeRepository.FindByPreviousSlug: build query: %w", err)
+	}
+
+	row := r.DB.QueryRow(ctx, query, args...)
+	theme, err := scanTheme(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrThemeNotFound
+		}
+		return nil, fmt.Errorf("ThemeRepository.FindByPreviousSlug: %w", err)
+	}
+
+	return theme, nil
+}
+
 // LoadThemeWithArticles loads the full theme aggregate including articles
 func (r *ThemeRepository) LoadThemeWithArticles(ctx context.Context, id uuid.UUID) (*domain.Theme, error) {
 	// First load the theme
@@ -186,11 +298,11 @@ func (r *ThemeRepository) LoadThemeWithArticles(ctx context.Context, id uuid.UUI
 	// Then load its articles
 	query, args, err := r.SB.
 		Select(
-			"ta.post_id", "ta.position", "ta.added_by", "ta.added_at", "ta.updated_at",
+			"ta.post_id", "ta.position", "ta.is_pinned", "ta.note", "ta.added_by", "ta.added_at", "ta.updated_at",
 		).
 		From("theme_articles ta").
 		Where(sq.Eq{"ta.theme_id": pgtype.UUID{Bytes: id, Valid: true}}).
-		OrderBy("ta.position ASC").
+		OrderBy("ta.is_pinned DESC", "ta.position ASC"). // Pinned articles first, then by position
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("ThemeRepository.LoadThemeWithArticles: build articles query: %w", err)
@@ -210,6 +322,8 @@ func (r *ThemeRepository) LoadThemeWithArticles(ctx context.Context, id uuid.UUI
 		err := rows.Scan(
 			&postIDBytes,
 			&article.Position,
+			&article.IsPinned,
+			&article.Note,
 			&addedByBytes,
 			&article.AddedAt,
 			&article.UpdatedAt,
@@ -232,25 +346,172 @@ func (r *ThemeRepository) LoadThemeWithArticles(ctx context.Context, id uuid.UUI
 	return theme, nil
 }
 
+// LockThemeWithArticles locks the theme row, then loads the full aggregate.
+// Save updates the same row, so any other edit of the theme waits for the lock.
+func (r *ThemeRepository) LockThemeWithArticles(ctx context.Context, id uuid.UUID) (*domain.Theme, error) {
+	query, args, err := r.SB.
+		Select("id").
+		From("themes").
+		Where(sq.Eq{"id": pgtype.UUID{Bytes: id, Valid: true}, "blog_id": currentBlogID(ctx), "deleted_at": nil}).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("ThemeRepository.LockThemeWithArticles: build query: %w", err)
+	}
+
+	var locked pgtype.UUID
+	if err := r.DB.QueryRow(ctx, query, args...).Scan(&locked); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrThemeNotFound
+		}
+		return nil, fmt.Errorf("ThemeRepository.LockThemeWithArticles: %w", err)
+	}
+
+	return r.LoadThemeWithArticles(ctx, id)
+}
+
+// ListArticleDetails loads a theme's articles joined with their posts in one
+// query, leaving out the posts readers cannot see
+func (r *ThemeRepository) ListArticleDetails(ctx context.Context, themeID uuid.UUID) ([]*ports.ArticleDetail, error) {
+	query, args, err := r.SB.
+		Select(
+			"ta.position", "ta.is_pinned", "ta.note", "ta.added_at",
+			"p.id", "p.title", "p.slug", "p.excerpt", "p.author_id", "p.author_name", "p.published_at",
+			// Tags are replaced by spaces so words on either side of one stay apart
+			`COALESCE(array_length(regexp_split_to_array(btrim(regexp_replace(p.content, '<[^>]*>', ' ', 'g')), '\s+'), 1), 0)`,
+		).
+		From("theme_articles ta").
+		Join("posts p ON p.id = ta.post_id").
+		Where(sq.Eq{
+			"ta.theme_id": pgtype.UUID{Bytes: themeID, Valid: true},
+			"p.blog_id":   currentBlogID(ctx),
+		}).
+		Where(listedForReaders(false)).
+		OrderBy("ta.is_pinned DESC", "ta.position ASC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("ThemeRepository.ListArticleDetails: build query: %w", err)
+	}
+
+	rows, err := r.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("ThemeRepository.ListArticleDetails: %w", err)
+	}
+	defer rows.Close()
+
+	var details []*ports.ArticleDetail
+	for rows.Next() {
+		var detail ports.ArticleDetail
+		var postIDBytes, authorIDBytes pgtype.UUID
+		var excerpt, authorName pgtype.Text
+		var publishedAt pgtype.Timestamptz
+
+		err := rows.Scan(
+			&detail.Position,
+			&detail.IsPinned,
+			&detail.Note,
+			&detail.AddedAt,
+			&postIDBytes,
+			&detail.PostTitle,
+			&detail.PostSlug,
+			&excerpt,
+			&authorIDBytes,
+			&authorName,
+			&publishedAt,
+			&detail.WordCount,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("ThemeRepository.ListArticleDetails: scan: %w", err)
+		}
+
+		detail.PostID = uuid.UUID(postIDBytes.Bytes)
+		detail.AuthorID = uuid.UUID(authorIDBytes.Bytes)
+		detail.PostExcerpt = excerpt.String
+		detail.AuthorName = authorName.String
+		if publishedAt.Valid {
+			detail.PublishedAt = publishedAt.Time
+		}
+		details = append(details, &detail)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ThemeRepository.ListArticleDetails: rows error: %w", err)
+	}
+
+	return details, nil
+}
+
+// ListRelatedThemes ranks the other active themes of the blog by how many
+// posts they share with the theme, the newest first among equals
+func (r *ThemeRepository) ListRelatedThemes(ctx context.Context, themeID uuid.UUID, limit int) ([]*ports.ThemeSummary, error) {
+	rows, err := r.DB.Query(ctx, `
+		SELECT t.id, t.name, t.description, t.slug, t.curator_id, t.curator_name,
+			t.status, t.created_at, t.updated_at, t.article_count, t.deleted_at
+		FROM themes t
+		JOIN (
+			SELECT other.theme_id, COUNT(*) AS shared
+			FROM theme_articles mine
+			JOIN theme_articles other ON other.post_id = mine.post_id AND other.theme_id <> mine.theme_id
+			WHERE mine.theme_id = $1
+			GROUP BY other.theme_id
+		) s ON s.theme_id = t.id
+		WHERE t.blog_id = $2 AND t.status = $3 AND t.deleted_at IS NULL
+		ORDER BY s.shared DESC, t.created_at DESC, t.id DESC
+		LIMIT $4`,
+		pgtype.UUID{Bytes: themeID, Valid: true},
+		currentBlogID(ctx),
+		string(domain.ThemeStatusActive),
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ThemeRepository.ListRelatedThemes: %w", err)
+	}
+	defer rows.Close()
+
+	var summaries []*ports.ThemeSummary
+	for rows.Next() {
+		summary, err := scanThemeSummaryFromRows(rows)
+		if err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, summary)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ThemeRepository.ListRelatedThemes: rows error: %w", err)
+	}
+
+	return summaries, nil
+}
+
 // ListThemes retrieves a list of theme summaries based on the filter
 func (r *ThemeRepository) ListThemes(ctx context.Context, filter ports.ListFilter) ([]*ports.ThemeSummary, error) {
 	// Start with a fresh query builder for the main query
 	qb := r.SB.Select(
 		"t.id", "t.name", "t.description", "t.slug",
-		"t.curator_id", "u.username as curator_name",
-		"t.is_active", "t.created_at", "t.updated_at",
-		"COUNT(DISTINCT ta.post_id) as article_count",
+		"t.curator_id", "t.curator_name", // Copied from users, see RenameCurator
+		"t.status", "t.created_at", "t.updated_at",
+		"t.article_count", // Kept current by triggers on theme_articles
+		"t.deleted_at",
 	).
-		From("themes t").
-		LeftJoin("users u ON t.curator_id = u.id").
-		LeftJoin("theme_articles ta ON t.id = ta.theme_id").
-		GroupBy("t.id", "t.name", "t.description", "t.slug", "t.curator_id", "u.username", "t.is_active", "t.created_at", "t.updated_at")
+		From("themes t")
 
 	// Apply filters
-	qb = r.applyThemeFilters(qb, filter)
-
-	// Add sorting - default to created_at DESC
-	qb = qb.OrderBy("t.created_at DESC")
+	qb = r.applyThemeFilters(ctx, qb, filter)
+
+	// Add sorting - default to created_at DESC, with the id breaking ties so pages are stable
+	if filter.OrderBy == "" {
+		qb = qb.OrderBy("t.created_at DESC", "t.id DESC")
+	} else {
+		direction := "ASC"
+		if filter.OrderDesc {
+			direction = "DESC"
+		}
+		qb = qb.OrderBy(
+			fmt.Sprintf("%s %s", getThemeOrderColumn(filter.OrderBy), direction),
+			fmt.Sprintf("t.id %s", direction),
+		)
+	}
 
 	// Add pagination
 	if filter.Limit > 0 {
@@ -293,7 +554,7 @@ func (r *ThemeRepository) CountThemes(ctx context.Context, filter ports.ListFilt
 	qb := r.SB.Select("COUNT(*)").From("themes t")
 
 	// Apply filters
-	qb = r.applyThemeFilters(qb, filter)
+	qb = r.applyThemeFilters(ctx, qb, filter)
 
 	query, args, err := qb.ToSql()
 	if err != nil {
@@ -312,7 +573,7 @@ func (r *ThemeRepository) CountThemes(ctx context.Context, filter ports.ListFilt
 // SlugExists checks if a slug already exists, optionally excluding a specific theme ID
 func (r *ThemeRepository) SlugExists(ctx context.Context, slug string, excludeID *uuid.UUID) (bool, error) {
 	// Build the subquery
-	subQuery := r.SB.Select("1").From("themes").Where(sq.Eq{"slug": slug})
+	subQuery := r.SB.Select("1").From("themes").Where(sq.Eq{"slug": slug, "blog_id": currentBlogID(ctx), "deleted_at": nil})
 
 	if excludeID != nil {
 		subQuery = subQuery.Where(sq.NotEq{"id": pgtype.UUID{Bytes: *excludeID, Valid: true}})
@@ -341,7 +602,7 @@ func (r *ThemeRepository) GetThemeCurator(ctx context.Context, themeID uuid.UUID
 	query, args, err := r.SB.
 		Select("curator_id").
 		From("themes").
-		Where(sq.Eq{"id": pgtype.UUID{Bytes: themeID, Valid: true}}).
+		Where(sq.Eq{"id": pgtype.UUID{Bytes: themeID, Valid: true}, "blog_id": currentBlogID(ctx)}).
 		ToSql()
 	if err != nil {
 		return uuid.Nil, fmt.Errorf("ThemeRepository.GetThemeCurator: build query: %w", err)
@@ -367,13 +628,46 @@ func (r *ThemeRepository) ListThemesByCurator(ctx context.Context, curatorID uui
 	return r.ListThemes(ctx, filter)
 }
 
+// RenameCurator rewrites the curator name on up to limit of the curator's
+// themes that still carry another one, in every blog
+func (r *ThemeRepository) RenameCurator(ctx context.Context, curatorID uuid.UUID, name string, limit int) ([]uuid.UUID, error) {
+	query := `
+		UPDATE themes t SET curator_name = $2
+		FROM (
+			SELECT id FROM themes
+			WHERE curator_id = $1 AND curator_name IS DISTINCT FROM $2
+			LIMIT $3
+		) batch
+		WHERE t.id = batch.id
+		RETURNING t.blog_id`
+
+	rows, err := r.DB.Query(ctx, query, pgtype.UUID{Bytes: curatorID, Valid: true}, name, limit)
+	if err != nil {
+		return nil, fmt.Errorf("ThemeRepository.RenameCurator: %w", err)
+	}
+	defer rows.Close()
+
+	var blogIDs []uuid.UUID
+	for rows.Next() {
+		var blogID pgtype.UUID
+		if err := rows.Scan(&blogID); err != nil {
+			return nil, fmt.Errorf("ThemeRepository.RenameCurator: scan: %w", err)
+		}
+		blogIDs = append(blogIDs, uuid.UUID(blogID.Bytes))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ThemeRepository.RenameCurator: rows error: %w", err)
+	}
+	return blogIDs, nil
+}
+
 // Helper functions
 
 // syncArticles performs the diff and sync operation for theme articles
 func (r *ThemeRepository) syncArticles(ctx context.Context, themeID uuid.UUID, desiredArticles []*domain.ThemeArticle) error {
 	// Step 1: Get current state from database
 	query, args, err := r.SB.
-		Select("post_id", "position", "added_by", "added_at", "updated_at").
+		Select("post_id", "position", "is_pinned", "note", "added_by", "added_at", "updated_at").
 		From("theme_articles").
 		Where(sq.Eq{"theme_id": pgtype.UUID{Bytes: themeID, Valid: true}}).
 		ToSql()
@@ -390,6 +684,8 @@ func (r *ThemeRepository) syncArticles(ctx context.Context, themeID uuid.UUID, d
 	// Build map of current articles
 	type articleData struct {
 		position  int
+		isPinned  bool
+		note      string
 		addedBy   uuid.UUID
 		addedAt   time.Time
 		updatedAt time.Time
@@ -400,7 +696,7 @@ func (r *ThemeRepository) syncArticles(ctx context.Context, themeID uuid.UUID, d
 		var postIDBytes, addedByBytes pgtype.UUID
 		var data articleData
 
-		err := rows.Scan(&postIDBytes, &data.position, &addedByBytes, &data.addedAt, &data.updatedAt)
+		err := rows.Scan(&postIDBytes, &data.position, &data.isPinned, &data.note, &addedByBytes, &data.addedAt, &data.updatedAt)
 		if err != nil {
 			return fmt.Errorf("syncArticles: scan current article: %w", err)
 		}
@@ -441,32 +737,28 @@ func (r *ThemeRepository) syncArticles(ctx context.Context, themeID uuid.UUID, d
 	}
 
 	// Insert new articles and update existing ones
+	var inserts [][]any
 	for postID, article := range desiredMap {
 		current, exists := currentArticles[postID]
 
 		if !exists {
-			// Insert new article
-			insQuery, insArgs, err := r.SB.
-				Insert("theme_articles").
-				Columns("theme_id", "post_id", "position", "added_by", "added_at", "updated_at").
-				Values(
-					pgtype.UUID{Bytes: themeID, Valid: true},
-					pgtype.UUID{Bytes: article.PostID, Valid: true},
-					article.Position,
-					pgtype.UUID{Bytes: article.AddedBy, Valid: true},
-					pgtype.Timestamptz{Time: article.AddedAt, Valid: true},
-					pgtype.Timestamptz{Time: article.UpdatedAt, Valid: true},
-				).
-				ToSql()
-			if err != nil {
-				return fmt.Errorf("syncArticles: build insert query: %w", err)
-			}
-			batch.Queue(insQuery, insArgs...)
-		} else if current.position != article.Position {
-			// Update position if it changed
+			inserts = append(inserts, []any{
+				pgtype.UUID{Bytes: themeID, Valid: true},
+				pgtype.UUID{Bytes: article.PostID, Valid: true},
+				article.Position,
+				article.IsPinned,
+				article.Note,
+				pgtype.UUID{Bytes: article.AddedBy, Valid: true},
+				pgtype.Timestamptz{Time: article.AddedAt, Valid: true},
+				pgtype.Timestamptz{Time: article.UpdatedAt, Valid: true},
+			})
+		} else if current.position != article.Position || current.isPinned != article.IsPinned || current.note != article.Note {
+			// Update position, pin state and note if any of them changed
 			updQuery, updArgs, err := r.SB.
 				Update("theme_articles").
 				Set("position", article.Position).
+				Set("is_pinned", article.IsPinned).
+				Set("note", article.Note).
 				Set("updated_at", pgtype.Timestamptz{Time: article.UpdatedAt, Valid: true}).
 				Where(sq.Eq{
 					"theme_id": pgtype.UUID{Bytes: themeID, Valid: true},
@@ -480,41 +772,96 @@ func (r *ThemeRepository) syncArticles(ctx context.Context, themeID uuid.UUID, d
 		}
 	}
 
+	// A few new articles ride along in the batch; many, as when a theme is
+	// filled in one go, are copied in after it
+	articleColumns := []string{"theme_id", "post_id", "position", "is_pinned", "note", "added_by", "added_at", "updated_at"}
+	if len(inserts) < postgres.CopyThreshold {
+		for _, values := range inserts {
+			insQuery, insArgs, err := r.SB.
+				Insert("theme_articles").
+				Columns(articleColumns...).
+				Values(values...).
+				ToSql()
+			if err != nil {
+				return fmt.Errorf("syncArticles: build insert query: %w", err)
+			}
+			batch.Queue(insQuery, insArgs...)
+		}
+		inserts = nil
+	}
+
 	// Step 4: Execute the batch if there are any operations
 	if batch.Len() > 0 {
 		results := r.DB.SendBatch(ctx, batch)
-		defer func() { _ = results.Close() }()
 
 		for i := 0; i < batch.Len(); i++ {
 			_, err := results.Exec()
 			if err != nil {
+				_ = results.Close()
 				return fmt.Errorf("syncArticles: execute batch operation %d: %w", i, err)
 			}
 		}
+		if err := results.Close(); err != nil {
+			return fmt.Errorf("syncArticles: close batch: %w", err)
+		}
+	}
+
+	// Step 5: Copy in the remaining new articles; the batch must be closed first
+	// because the connection serves one operation at a time
+	if _, err := postgres.BulkInsert(ctx, r.DB, "theme_articles", articleColumns, inserts); err != nil {
+		return fmt.Errorf("syncArticles: %w", err)
 	}
 
 	return nil
 }
 
 // applyThemeFilters applies common WHERE clauses to a query builder
-func (r *ThemeRepository) applyThemeFilters(qb sq.SelectBuilder, filter ports.ListFilter) sq.SelectBuilder {
+// Every query is scoped to the request's blog
+func (r *ThemeRepository) applyThemeFilters(ctx context.Context, qb sq.SelectBuilder, filter ports.ListFilter) sq.SelectBuilder {
+	qb = qb.Where(sq.Eq{"t.blog_id": currentBlogID(ctx)})
+
+	if filter.Deleted {
+		qb = qb.Where(sq.NotEq{"t.deleted_at": nil})
+	} else {
+		qb = qb.Where(sq.Eq{"t.deleted_at": nil})
+	}
+
 	if filter.CuratorID != nil {
 		qb = qb.Where(sq.Eq{"t.curator_id": pgtype.UUID{Bytes: *filter.CuratorID, Valid: true}})
 	}
 
-	if filter.IsActive != nil {
-		qb = qb.Where(sq.Eq{"t.is_active": *filter.IsActive})
+	if len(filter.Statuses) > 0 {
+		statuses := make([]string, len(filter.Statuses))
+		for i, status := range filter.Statuses {
+			statuses[i] = string(status)
+		}
+		qb = qb.Where(sq.Eq{"t.status": statuses})
+	}
+
+	// Served by the trigram indexes on name and description
+	if filter.SearchQuery != "" {
+		searchPattern := "%" + escapeLikePattern(filter.SearchQuery) + "%"
+		qb = qb.Where(sq.Or{
+			sq.ILike{"t.name": searchPattern},
+			sq.ILike{"t.description": searchPattern},
+		})
 	}
 
 	return qb
 }
 
+// escapeLikePattern makes LIKE wildcards in term match literally
+func escapeLikePattern(term string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(term)
+}
+
 // Helper functions
 
 // scanTheme scans a single theme from pgx.Row
 func scanTheme(row pgx.Row) (*domain.Theme, error) {
 	var theme domain.Theme
 	var idBytes, curatorIDBytes pgtype.UUID
+	var statusStr string
 
 	err := row.Scan(
 		&idBytes,
@@ -522,7 +869,7 @@ func scanTheme(row pgx.Row) (*domain.Theme, error) {
 		&theme.Description,
 		&theme.Slug,
 		&curatorIDBytes,
-		&theme.IsActive,
+		&statusStr,
 		&theme.CreatedAt,
 		&theme.UpdatedAt,
 	)
@@ -534,6 +881,12 @@ func scanTheme(row pgx.Row) (*domain.Theme, error) {
 	theme.ID = uuid.UUID(idBytes.Bytes)
 	theme.CuratorID = uuid.UUID(curatorIDBytes.Bytes)
 
+	// Parse status
+	theme.Status = domain.ThemeStatus(statusStr)
+	if !theme.Status.IsValid() {
+		return nil, fmt.Errorf("scanTheme: invalid status %s", statusStr)
+	}
+
 	// Initialize empty Articles slice
 	theme.Articles = make([]*domain.ThemeArticle, 0)
 
@@ -545,6 +898,7 @@ func scanThemeSummaryFromRows(rows pgx.Rows) (*ports.ThemeSummary, error) {
 	var summary ports.ThemeSummary
 	var idBytes, curatorIDBytes pgtype.UUID
 	var curatorName pgtype.Text
+	var statusStr string
 
 	err := rows.Scan(
 		&idBytes,
@@ -553,10 +907,11 @@ func scanThemeSummaryFromRows(rows pgx.Rows) (*ports.ThemeSummary, error) {
 		&summary.Slug,
 		&curatorIDBytes,
 		&curatorName,
-		&summary.IsActive,
+		&statusStr,
 		&summary.CreatedAt,
 		&summary.UpdatedAt,
 		&summary.ArticleCount,
+		&summary.DeletedAt,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("scanThemeSummaryFromRows: %w", err)
@@ -566,6 +921,11 @@ func scanThemeSummaryFromRows(rows pgx.Rows) (*ports.ThemeSummary, error) {
 	summary.ID = uuid.UUID(idBytes.Bytes)
 	summary.CuratorID = uuid.UUID(curatorIDBytes.Bytes)
 
+	summary.Status = domain.ThemeStatus(statusStr)
+	if !summary.Status.IsValid() {
+		return nil, fmt.Errorf("scanThemeSummaryFromRows: invalid status %s", statusStr)
+	}
+
 	if curatorName.Valid {
 		summary.CuratorName = curatorName.String
 	}
@@ -573,5 +933,23 @@ func scanThemeSummaryFromRows(rows pgx.Rows) (*ports.ThemeSummary, error) {
 	return &summary, nil
 }
 
+// getThemeOrderColumn validates and returns the actual column name for ordering
+func getThemeOrderColumn(field ports.OrderField) string {
+	switch field {
+	case ports.OrderByCreatedAt:
+		return "t.created_at"
+	case ports.OrderByUpdatedAt:
+		return "t.updated_at"
+	case ports.OrderByName:
+		return "t.name"
+	case ports.OrderByArticleCount:
+		return "t.article_count"
+	case ports.OrderByDeletedAt:
+		return "t.deleted_at"
+	default:
+		return "t.created_at"
+	}
+}
+
 // Compile-time check to ensure ThemeRepository implements ports.ThemeRepository
 var _ ports.ThemeRepository = (*ThemeRepository)(nil)
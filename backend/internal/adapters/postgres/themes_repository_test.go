@@ -0,0 +1,334 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"backend/internal/adapters/postgres"
+	"backend/internal/integrity/domain"
+	"backend/internal/testing/factory"
+	"backend/internal/testing/pgtest"
+	"backend/internal/themes/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// articleCount reads the stored count the triggers maintain
+func articleCount(t *testing.T, tx pgx.Tx, themeID uuid.UUID) int {
+	t.Helper()
+	var count int
+	err := tx.QueryRow(context.Background(), `SELECT article_count FROM themes WHERE id = $1`, themeID).Scan(&count)
+	require.NoError(t, err)
+	return count
+}
+
+func TestThemeArticleCount_FollowsThemeArticles(t *testing.T) {
+	tx := pgtest.Tx(t)
+	ctx := context.Background()
+
+	curator := factory.NewUser().WithRole("author").Create(t, tx)
+	first := factory.NewPost(curator.ID).Published().Create(t, tx)
+	second := factory.NewPost(curator.ID).Published().Create(t, tx)
+	third := factory.NewPost(curator.ID).Published().Create(t, tx)
+	theme := factory.NewTheme(curator.ID).Articles(first.ID, second.ID, third.ID).Create(t, tx)
+
+	assert.Equal(t, 3, articleCount(t, tx, theme.ID))
+
+	_, err := tx.Exec(ctx, `DELETE FROM theme_articles WHERE theme_id = $1 AND post_id = $2`, theme.ID, first.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, articleCount(t, tx, theme.ID))
+
+	_, err = tx.Exec(ctx, `DELETE FROM posts WHERE id = $1`, second.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, articleCount(t, tx, theme.ID), "hard-deleting a post cascades through the count")
+}
+
+func TestThemeRepository_ListThemesReadsArticleCount(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewThemeRepository(pgtest.Pool(t)).WithTx(tx)
+
+	curator := factory.NewUser().WithRole("author").Create(t, tx)
+	post := factory.NewPost(curator.ID).Published().Create(t, tx)
+	theme := factory.NewTheme(curator.ID).Active().Articles(post.ID).Create(t, tx)
+
+	themes, err := repo.ListThemesByCurator(context.Background(), curator.ID)
+	require.NoError(t, err)
+	require.Len(t, themes, 1)
+	assert.Equal(t, theme.ID, themes[0].ID)
+	assert.Equal(t, 1, themes[0].ArticleCount)
+}
+
+func TestThemeRepository_SaveKeepsArticleNotes(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewThemeRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	curator := factory.NewUser().WithRole("author").Create(t, tx)
+	post := factory.NewPost(curator.ID).Published().Create(t, tx)
+	created := factory.NewTheme(curator.ID).Articles(post.ID).Create(t, tx)
+
+	theme, err := repo.LoadThemeWithArticles(ctx, created.ID)
+	require.NoError(t, err)
+	require.NoError(t, theme.SetArticleNote(post.ID, "Start here"))
+	require.NoError(t, repo.Save(ctx, theme))
+
+	reloaded, err := repo.LoadThemeWithArticles(ctx, created.ID)
+	require.NoError(t, err)
+	require.Len(t, reloaded.Articles, 1)
+	assert.Equal(t, "Start here", reloaded.Articles[0].Note)
+
+	require.NoError(t, reloaded.SetArticleNote(post.ID, ""))
+	require.NoError(t, repo.Save(ctx, reloaded))
+
+	cleared, err := repo.LoadThemeWithArticles(ctx, created.ID)
+	require.NoError(t, err)
+	require.Len(t, cleared.Articles, 1)
+	assert.Empty(t, cleared.Articles[0].Note)
+}
+
+func TestThemeRepository_ListThemesSortsByArticleCountAndName(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewThemeRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	curator := factory.NewUser().WithRole("author").Create(t, tx)
+	first := factory.NewPost(curator.ID).Published().Create(t, tx)
+	second := factory.NewPost(curator.ID).Published().Create(t, tx)
+	busy := factory.NewTheme(curator.ID).Name("Busy").Active().Articles(first.ID, second.ID).Create(t, tx)
+	quiet := factory.NewTheme(curator.ID).Name("Quiet").Active().Create(t, tx)
+	apt := factory.NewTheme(curator.ID).Name("Apt").Active().Articles(first.ID).Create(t, tx)
+
+	themes, err := repo.ListThemes(ctx, ports.ListFilter{
+		CuratorID: &curator.ID,
+		OrderBy:   ports.OrderByArticleCount,
+		OrderDesc: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, themes, 3)
+	assert.Equal(t, []uuid.UUID{busy.ID, apt.ID, quiet.ID}, []uuid.UUID{themes[0].ID, themes[1].ID, themes[2].ID})
+
+	themes, err = repo.ListThemes(ctx, ports.ListFilter{
+		CuratorID: &curator.ID,
+		OrderBy:   ports.OrderByName,
+	})
+	require.NoError(t, err)
+	require.Len(t, themes, 3)
+	assert.Equal(t, []uuid.UUID{apt.ID, busy.ID, quiet.ID}, []uuid.UUID{themes[0].ID, themes[1].ID, themes[2].ID})
+}
+
+func TestThemeRepository_ListThemesSearchesNameAndDescription(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewThemeRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	curator := factory.NewUser().WithRole("author").Create(t, tx)
+	byName := factory.NewTheme(curator.ID).Name("Distributed Systems").Active().Create(t, tx)
+	factory.NewTheme(curator.ID).Name("Frontend 100%").Active().Create(t, tx)
+	_, err := tx.Exec(ctx, `UPDATE themes SET description = 'Notes on distributed consensus' WHERE name = 'Frontend 100%'`)
+	require.NoError(t, err)
+
+	filter := ports.ListFilter{CuratorID: &curator.ID, SearchQuery: "DISTRIBUTED", OrderBy: ports.OrderByName}
+	themes, err := repo.ListThemes(ctx, filter)
+	require.NoError(t, err)
+	require.Len(t, themes, 2)
+	assert.Equal(t, byName.ID, themes[0].ID)
+
+	count, err := repo.CountThemes(ctx, filter)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	// Wildcards in the query match literally
+	filter.SearchQuery = "0%"
+	themes, err = repo.ListThemes(ctx, filter)
+	require.NoError(t, err)
+	require.Len(t, themes, 1)
+	assert.Equal(t, "Frontend 100%", themes[0].Name)
+
+	filter.SearchQuery = "s_s"
+	count, err = repo.CountThemes(ctx, filter)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestIntegrityRepository_RecountsThemeArticles(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewIntegrityRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	curator := factory.NewUser().WithRole("author").Create(t, tx)
+	post := factory.NewPost(curator.ID).Published().Create(t, tx)
+	theme := factory.NewTheme(curator.ID).Articles(post.ID).Create(t, tx)
+
+	// Drift the way a restore that skips triggers would
+	_, err := tx.Exec(ctx, `UPDATE themes SET article_count = 7 WHERE id = $1`, theme.ID)
+	require.NoError(t, err)
+
+	findings, err := repo.FindViolations(ctx, domain.CheckThemeArticleCounts)
+	require.NoError(t, err)
+	require.Len(t, findings, 1)
+	assert.Equal(t, theme.ID, findings[0].OwnerID)
+	assert.Equal(t, uuid.Nil, findings[0].MissingID)
+
+	repaired, err := repo.Repair(ctx, domain.CheckThemeArticleCounts)
+	require.NoError(t, err)
+	require.Len(t, repaired, 1)
+	assert.Equal(t, "article_count was 7, recounted as 1", repaired[0].Detail)
+	assert.Equal(t, 1, articleCount(t, tx, theme.ID))
+
+	findings, err = repo.FindViolations(ctx, domain.CheckThemeArticleCounts)
+	require.NoError(t, err)
+	assert.Empty(t, findings)
+}
+
+func TestThemeRepository_RenameCuratorReturnsBlogs(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewThemeRepository(pgtest.Pool(t)).WithTx(tx)
+
+	curator := factory.NewUser().WithRole("author").Create(t, tx)
+	theme := factory.NewTheme(curator.ID).Active().Create(t, tx)
+
+	blogIDs, err := repo.RenameCurator(context.Background(), curator.ID, "renamed_curator", 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{theme.BlogID}, blogIDs)
+
+	themes, err := repo.ListThemesByCurator(context.Background(), curator.ID)
+	require.NoError(t, err)
+	require.Len(t, themes, 1)
+	assert.Equal(t, "renamed_curator", themes[0].CuratorName)
+}
+
+func TestIntegrityRepository_RepairsCuratorNames(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewIntegrityRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	curator := factory.NewUser().WithRole("author").Create(t, tx)
+	theme := factory.NewTheme(curator.ID).Create(t, tx)
+
+	// Rename the user without the event that would rewrite the copy
+	_, err := tx.Exec(ctx, `UPDATE users SET username = 'renamed_curator' WHERE id = $1`, curator.ID)
+	require.NoError(t, err)
+
+	findings, err := repo.FindViolations(ctx, domain.CheckThemeCuratorNames)
+	require.NoError(t, err)
+	require.Len(t, findings, 1)
+	assert.Equal(t, theme.ID, findings[0].OwnerID)
+
+	_, err = repo.Repair(ctx, domain.CheckThemeCuratorNames)
+	require.NoError(t, err)
+
+	findings, err = repo.FindViolations(ctx, domain.CheckThemeCuratorNames)
+	require.NoError(t, err)
+	assert.Empty(t, findings)
+}
+
+func TestThemeRepository_ListArticleDetailsShowsReadablePosts(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewThemeRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	curator := factory.NewUser().WithRole("author").Create(t, tx)
+	first := factory.NewPost(curator.ID).Published().Content("<p>Ports and <em>adapters</em></p><p>in Go</p>").Create(t, tx)
+	second := factory.NewPost(curator.ID).Published().Create(t, tx)
+	draft := factory.NewPost(curator.ID).Create(t, tx)
+	theme := factory.NewTheme(curator.ID).Active().Articles(first.ID, second.ID, draft.ID).Create(t, tx)
+
+	_, err := tx.Exec(ctx, `UPDATE theme_articles SET is_pinned = true WHERE theme_id = $1 AND post_id = $2`, theme.ID, second.ID)
+	require.NoError(t, err)
+
+	details, err := repo.ListArticleDetails(ctx, theme.ID)
+	require.NoError(t, err)
+	require.Len(t, details, 2, "drafts are left out")
+	assert.Equal(t, second.ID, details[0].PostID, "pinned articles come first")
+	assert.True(t, details[0].IsPinned)
+	assert.Equal(t, first.ID, details[1].PostID)
+	assert.Equal(t, first.Title, details[1].PostTitle)
+	assert.Equal(t, curator.Username, details[1].AuthorName)
+	assert.Equal(t, 5, details[1].WordCount)
+}
+
+func TestThemeRepository_ListRelatedThemesRanksBySharedArticles(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewThemeRepository(pgtest.Pool(t)).WithTx(tx)
+
+	curator := factory.NewUser().WithRole("author").Create(t, tx)
+	first := factory.NewPost(curator.ID).Published().Create(t, tx)
+	second := factory.NewPost(curator.ID).Published().Create(t, tx)
+	theme := factory.NewTheme(curator.ID).Active().Articles(first.ID, second.ID).Create(t, tx)
+	overlapping := factory.NewTheme(curator.ID).Active().Articles(first.ID, second.ID).Create(t, tx)
+	loose := factory.NewTheme(curator.ID).Active().Articles(second.ID).Create(t, tx)
+	factory.NewTheme(curator.ID).Articles(first.ID).Create(t, tx) // Drafts are not related
+	factory.NewTheme(curator.ID).Active().Create(t, tx)           // Nor are themes sharing nothing
+
+	related, err := repo.ListRelatedThemes(context.Background(), theme.ID, 5)
+	require.NoError(t, err)
+	require.Len(t, related, 2)
+	assert.Equal(t, []uuid.UUID{overlapping.ID, loose.ID}, []uuid.UUID{related[0].ID, related[1].ID})
+}
+
+func TestThemeRepository_DeleteMovesToTheTrash(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewThemeRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	curator := factory.NewUser().WithRole("author").Create(t, tx)
+	post := factory.NewPost(curator.ID).Published().Create(t, tx)
+	theme := factory.NewTheme(curator.ID).Slug("go-patterns").Active().Articles(post.ID).Create(t, tx)
+
+	require.NoError(t, repo.Delete(ctx, theme.ID))
+	assert.ErrorIs(t, repo.Delete(ctx, theme.ID), ports.ErrThemeNotFound, "a theme is deleted once")
+
+	_, err := repo.FindByID(ctx, theme.ID)
+	assert.ErrorIs(t, err, ports.ErrThemeNotFound)
+	_, err = repo.FindBySlug(ctx, "go-patterns")
+	assert.ErrorIs(t, err, ports.ErrThemeNotFound)
+	live, err := repo.ListThemesByCurator(ctx, curator.ID)
+	require.NoError(t, err)
+	assert.Empty(t, live)
+
+	trash, err := repo.ListThemes(ctx, ports.ListFilter{Deleted: true, CuratorID: &curator.ID})
+	require.NoError(t, err)
+	require.Len(t, trash, 1)
+	assert.NotNil(t, trash[0].DeletedAt)
+
+	// The slug is free again, and curators still own what they deleted
+	exists, err := repo.SlugExists(ctx, "go-patterns", nil)
+	require.NoError(t, err)
+	assert.False(t, exists)
+	curatorID, err := repo.GetThemeCurator(ctx, theme.ID)
+	require.NoError(t, err)
+	assert.Equal(t, curator.ID, curatorID)
+	factory.NewTheme(curator.ID).Slug("go-patterns").Create(t, tx)
+
+	require.NoError(t, repo.Restore(ctx, theme.ID, "go-patterns-1"))
+
+	restored, err := repo.LoadThemeWithArticles(ctx, theme.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "go-patterns-1", restored.Slug)
+	assert.Len(t, restored.Articles, 1, "articles survive the trash")
+	assert.ErrorIs(t, repo.Restore(ctx, theme.ID, "go-patterns-1"), ports.ErrThemeNotFound)
+}
+
+func TestThemeRepository_LockThemeWithArticles(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewThemeRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	curator := factory.NewUser().WithRole("author").Create(t, tx)
+	first := factory.NewPost(curator.ID).Published().Create(t, tx)
+	second := factory.NewPost(curator.ID).Published().Create(t, tx)
+	theme := factory.NewTheme(curator.ID).Articles(first.ID, second.ID).Create(t, tx)
+
+	locked, err := repo.LockThemeWithArticles(ctx, theme.ID)
+	require.NoError(t, err)
+	assert.Len(t, locked.Articles, 2, "the full aggregate is loaded")
+
+	_, err = repo.LockThemeWithArticles(ctx, uuid.New())
+	assert.ErrorIs(t, err, ports.ErrThemeNotFound)
+
+	require.NoError(t, repo.Delete(ctx, theme.ID))
+	_, err = repo.LockThemeWithArticles(ctx, theme.ID)
+	assert.ErrorIs(t, err, ports.ErrThemeNotFound, "themes in the trash cannot be edited")
+}
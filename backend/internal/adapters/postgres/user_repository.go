@@ -5,27 +5,32 @@ import (
 	"errors"
 	"fmt"
 
+	"backend/internal/platform/postgres"
+	"backend/internal/platform/validator"
 	"backend/internal/users/domain"
 	"backend/internal/users/ports"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type UserRepository struct {
-	pool *pgxpool.Pool
+	pool postgres.Querier // Runs in the caller's unit of work, if any
+	uow  postgres.UnitOfWork
 }
 
 func NewUserRepository(pool *pgxpool.Pool) ports.UserRepository {
 	return &UserRepository{
-		pool: pool,
+		pool: postgres.NewContextQuerier(pool),
+		uow:  postgres.NewUnitOfWork(pool),
 	}
 }
 
 func (r *UserRepository) Create(ctx context.Context, user *domain.User) error {
 	query := `
-		INSERT INTO users (id, supabase_id, email, username, display_name, bio, avatar_url, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		INSERT INTO users (id, supabase_id, email, username, display_name, bio, avatar_url, timezone, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 	`
 
 	id := uuid.New()
@@ -39,10 +44,14 @@ func (r *UserRepository) Create(ctx context.Context, user *domain.User) error {
 		nullString(user.DisplayName),
 		nullString(user.Bio),
 		nullString(user.AvatarURL),
+		user.Timezone,
 		user.CreatedAt,
 		user.UpdatedAt,
 	)
 	if err != nil {
+		if isUsernameConflict(err) {
+			return ports.ErrUsernameTaken
+		}
 		return fmt.Errorf("failed to create user: %w", err)
 	}
 
@@ -51,7 +60,7 @@ func (r *UserRepository) Create(ctx context.Context, user *domain.User) error {
 
 func (r *UserRepository) FindByID(ctx context.Context, id string) (*domain.User, error) {
 	query := `
-		SELECT id, supabase_id, email, username, display_name, bio, avatar_url, created_at, updated_at
+		SELECT id, supabase_id, email, username, display_name, bio, avatar_url, timezone, suspended_at, created_at, updated_at
 		FROM users
 		WHERE id = $1
 	`
@@ -67,6 +76,8 @@ func (r *UserRepository) FindByID(ctx context.Context, id string) (*domain.User,
 		&displayName,
 		&bio,
 		&avatarURL,
+		&user.Timezone,
+		&user.SuspendedAt,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -84,9 +95,61 @@ func (r *UserRepository) FindByID(ctx context.Context, id string) (*domain.User,
 	return &user, nil
 }
 
+// FindByIDs returns the users with the given IDs; IDs without a user are skipped
+func (r *UserRepository) FindByIDs(ctx context.Context, ids []string) ([]*domain.User, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	query := `
+		SELECT id, supabase_id, email, username, display_name, bio, avatar_url, timezone, suspended_at, created_at, updated_at
+		FROM users
+		WHERE id = ANY($1::uuid[])
+	`
+
+	rows, err := r.pool.Query(ctx, query, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find users by IDs: %w", err)
+	}
+	defer rows.Close()
+
+	var users []*domain.User
+	for rows.Next() {
+		var user domain.User
+		var displayName, bio, avatarURL *string
+
+		if err := rows.Scan(
+			&user.ID,
+			&user.SupabaseID,
+			&user.Email,
+			&user.Username,
+			&displayName,
+			&bio,
+			&avatarURL,
+			&user.Timezone,
+			&user.SuspendedAt,
+			&user.CreatedAt,
+			&user.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan user: %w", err)
+		}
+
+		user.DisplayName = stringValue(displayName)
+		user.Bio = stringValue(bio)
+		user.AvatarURL = stringValue(avatarURL)
+		users = append(users, &user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate users: %w", err)
+	}
+
+	return users, nil
+}
+
 func (r *UserRepository) FindBySupabaseID(ctx context.Context, supabaseID string) (*domain.User, error) {
 	query := `
-		SELECT id, supabase_id, email, username, display_name, bio, avatar_url, created_at, updated_at
+		SELECT id, supabase_id, email, username, display_name, bio, avatar_url, timezone, suspended_at, created_at, updated_at
 		FROM users
 		WHERE supabase_id = $1
 	`
@@ -102,6 +165,8 @@ func (r *UserRepository) FindBySupabaseID(ctx context.Context, supabaseID string
 		&displayName,
 		&bio,
 		&avatarURL,
+		&user.Timezone,
+		&user.SuspendedAt,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -119,17 +184,18 @@ func (r *UserRepository) FindBySupabaseID(ctx context.Context, supabaseID string
 	return &user, nil
 }
 
+// FindByUsername matches usernames by key, in any letter case
 func (r *UserRepository) FindByUsername(ctx context.Context, username string) (*domain.User, error) {
 	query := `
-		SELECT id, supabase_id, email, username, display_name, bio, avatar_url, created_at, updated_at
+		SELECT id, supabase_id, email, username, display_name, bio, avatar_url, timezone, suspended_at, created_at, updated_at
 		FROM users
-		WHERE username = $1
+		WHERE LOWER(username) = $1
 	`
 
 	var user domain.User
 	var displayName, bio, avatarURL *string
 
-	err := r.pool.QueryRow(ctx, query, username).Scan(
+	err := r.pool.QueryRow(ctx, query, validator.UsernameKey(username)).Scan(
 		&user.ID,
 		&user.SupabaseID,
 		&user.Email,
@@ -137,6 +203,8 @@ func (r *UserRepository) FindByUsername(ctx context.Context, username string) (*
 		&displayName,
 		&bio,
 		&avatarURL,
+		&user.Timezone,
+		&user.SuspendedAt,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -156,7 +224,7 @@ func (r *UserRepository) FindByUsername(ctx context.Context, username string) (*
 
 func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*domain.User, error) {
 	query := `
-		SELECT id, supabase_id, email, username, display_name, bio, avatar_url, created_at, updated_at
+		SELECT id, supabase_id, email, username, display_name, bio, avatar_url, timezone, suspended_at, created_at, updated_at
 		FROM users
 		WHERE email = $1
 	`
@@ -172,6 +240,8 @@ func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*domain
 		&displayName,
 		&bio,
 		&avatarURL,
+		&user.Timezone,
+		&user.SuspendedAt,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -192,7 +262,7 @@ func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*domain
 func (r *UserRepository) Update(ctx context.Context, user *domain.User) error {
 	query := `
 		UPDATE users
-		SET display_name = $2, bio = $3, avatar_url = $4, updated_at = $5
+		SET display_name = $2, bio = $3, avatar_url = $4, timezone = $5, suspended_at = $6, updated_at = $7
 		WHERE id = $1
 	`
 
@@ -201,6 +271,8 @@ func (r *UserRepository) Update(ctx context.Context, user *domain.User) error {
 		nullString(user.DisplayName),
 		nullString(user.Bio),
 		nullString(user.AvatarURL),
+		user.Timezone,
+		user.SuspendedAt,
 		user.UpdatedAt,
 	)
 	if err != nil {
@@ -210,16 +282,120 @@ func (r *UserRepository) Update(ctx context.Context, user *domain.User) error {
 	return nil
 }
 
-func (r *UserRepository) ExistsByUsername(ctx context.Context, username string) (bool, error) {
-	query := `SELECT EXISTS(SELECT 1 FROM users WHERE username = $1)`
+// ChangeUsername saves a rename and keeps the previous username for redirects.
+// A name someone else retired stays theirs; a user may take back their own.
+func (r *UserRepository) ChangeUsername(ctx context.Context, user *domain.User, previous string) error {
+	return r.uow.Do(ctx, func(ctx context.Context) error {
+		newKey := validator.UsernameKey(user.Username)
+
+		var holder string
+		err := r.pool.QueryRow(ctx,
+			`SELECT user_id FROM username_history WHERE username_key = $1 FOR UPDATE`,
+			newKey,
+		).Scan(&holder)
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+		case err != nil:
+			return fmt.Errorf("failed to check retired usernames: %w", err)
+		case holder != user.ID:
+			return ports.ErrUsernameTaken
+		}
 
-	var exists bool
-	err := r.pool.QueryRow(ctx, query, username).Scan(&exists)
+		// A change of letter case only keeps the same name
+		if previousKey := validator.UsernameKey(previous); previousKey != newKey {
+			_, err = r.pool.Exec(ctx, `
+				INSERT INTO username_history (username_key, user_id, username, created_at)
+				VALUES ($1, $2, $3, $4)
+				ON CONFLICT (username_key) DO UPDATE SET
+					username = EXCLUDED.username,
+					created_at = EXCLUDED.created_at
+				WHERE username_history.user_id = EXCLUDED.user_id`,
+				previousKey, user.ID, previous, user.UpdatedAt,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to retire username: %w", err)
+			}
+		}
+
+		if _, err := r.pool.Exec(ctx,
+			`DELETE FROM username_history WHERE username_key = $1 AND user_id = $2`,
+			newKey, user.ID,
+		); err != nil {
+			return fmt.Errorf("failed to reclaim username: %w", err)
+		}
+
+		if _, err := r.pool.Exec(ctx,
+			`UPDATE users SET username = $2, updated_at = $3 WHERE id = $1`,
+			user.ID, user.Username, user.UpdatedAt,
+		); err != nil {
+			if isUsernameConflict(err) {
+				return ports.ErrUsernameTaken
+			}
+			return fmt.Errorf("failed to rename user: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// FindByRetiredUsername finds the user who renamed away from a username
+func (r *UserRepository) FindByRetiredUsername(ctx context.Context, username string) (*domain.User, error) {
+	query := `
+		SELECT u.id, u.supabase_id, u.email, u.username, u.display_name, u.bio, u.avatar_url, u.timezone, u.suspended_at, u.created_at, u.updated_at
+		FROM username_history h
+		JOIN users u ON u.id = h.user_id
+		WHERE h.username_key = $1
+	`
+
+	var user domain.User
+	var displayName, bio, avatarURL *string
+
+	err := r.pool.QueryRow(ctx, query, validator.UsernameKey(username)).Scan(
+		&user.ID,
+		&user.SupabaseID,
+		&user.Email,
+		&user.Username,
+		&displayName,
+		&bio,
+		&avatarURL,
+		&user.Timezone,
+		&user.SuspendedAt,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	)
 	if err != nil {
-		return false, fmt.Errorf("failed to check username existence: %w", err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find user by retired username: %w", err)
 	}
 
-	return exists, nil
+	user.DisplayName = stringValue(displayName)
+	user.Bio = stringValue(bio)
+	user.AvatarURL = stringValue(avatarURL)
+
+	return &user, nil
+}
+
+// UsernameHolder returns who holds a username as their current or a retired name
+func (r *UserRepository) UsernameHolder(ctx context.Context, username string) (string, error) {
+	query := `
+		SELECT id FROM users WHERE LOWER(username) = $1
+		UNION ALL
+		SELECT user_id FROM username_history WHERE username_key = $1
+		LIMIT 1
+	`
+
+	var holder string
+	err := r.pool.QueryRow(ctx, query, validator.UsernameKey(username)).Scan(&holder)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to find username holder: %w", err)
+	}
+
+	return holder, nil
 }
 
 func (r *UserRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
@@ -234,6 +410,138 @@ func (r *UserRepository) ExistsByEmail(ctx context.Context, email string) (bool,
 	return exists, nil
 }
 
+// Erase scrubs a user's personal data but keeps the row, which posts, roles
+// and audit records still point at. The placeholder email and login are
+// derived from the ID, so they stay unique and can never be signed in with.
+func (r *UserRepository) Erase(ctx context.Context, id string, pseudonym string) (int, error) {
+	var changed int
+	err := r.uow.Do(ctx, func(ctx context.Context) error {
+		tag, err := r.pool.Exec(ctx, `
+			UPDATE users
+			SET supabase_id = 'erased:' || id::text,
+				email = id::text || '@erased.invalid',
+				username = $2,
+				display_name = NULL,
+				bio = NULL,
+				avatar_url = NULL,
+				timezone = DEFAULT,
+				updated_at = NOW()
+			WHERE id = $1`,
+			id, pseudonym,
+		)
+		if err != nil {
+			if isUsernameConflict(err) {
+				return ports.ErrUsernameTaken
+			}
+			return fmt.Errorf("failed to erase user: %w", err)
+		}
+		changed = int(tag.RowsAffected())
+
+		tag, err = r.pool.Exec(ctx, `DELETE FROM username_history WHERE user_id = $1`, id)
+		if err != nil {
+			return fmt.Errorf("failed to forget retired usernames: %w", err)
+		}
+		changed += int(tag.RowsAffected())
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return changed, nil
+}
+
+// userListConditions selects users for List: $1 is the search pattern, $2 the
+// role name, $3 the current blog and $4 the suspension state; empty or NULL
+// parameters match every user
+const userListConditions = `
+	($1::text = '' OR u.email ILIKE $1 OR u.username ILIKE $1)
+	AND ($2::text = '' OR EXISTS (
+		SELECT 1
+		FROM user_roles ur
+		JOIN roles r ON r.id = ur.role_id
+		WHERE ur.user_id = u.id AND r.name = $2 AND (ur.blog_id IS NULL OR ur.blog_id = $3)
+	))
+	AND ($4::boolean IS NULL OR (u.suspended_at IS NOT NULL) = $4)
+`
+
+// List returns a page of the users matching filter, newest first, with the
+// roles each holds on the current blog, and how many users match
+func (r *UserRepository) List(ctx context.Context, filter ports.ListFilter) ([]*ports.ListedUser, int, error) {
+	search := ""
+	if filter.Search != "" {
+		search = "%" + escapeLikePattern(filter.Search) + "%"
+	}
+	args := []any{search, filter.Role, currentBlogID(ctx), filter.Suspended}
+
+	var total int
+	countQuery := `SELECT COUNT(*) FROM users u WHERE ` + userListConditions
+	if err := r.pool.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("UserRepository.List: count: %w", err)
+	}
+
+	query := `
+		SELECT u.id, u.supabase_id, u.email, u.username, u.display_name, u.bio, u.avatar_url, u.timezone,
+			u.suspended_at, u.created_at, u.updated_at,
+			ARRAY(
+				SELECT DISTINCT r.name
+				FROM user_roles ur
+				JOIN roles r ON r.id = ur.role_id
+				WHERE ur.user_id = u.id AND (ur.blog_id IS NULL OR ur.blog_id = $3)
+				ORDER BY r.name
+			)
+		FROM users u
+		WHERE ` + userListConditions + `
+		ORDER BY u.created_at DESC, u.id
+		LIMIT $5 OFFSET $6
+	`
+	rows, err := r.pool.Query(ctx, query, append(args, filter.Limit, filter.Offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("UserRepository.List: %w", err)
+	}
+	defer rows.Close()
+
+	users := make([]*ports.ListedUser, 0, filter.Limit)
+	for rows.Next() {
+		var user domain.User
+		var displayName, bio, avatarURL *string
+		var roles []string
+		if err := rows.Scan(
+			&user.ID,
+			&user.SupabaseID,
+			&user.Email,
+			&user.Username,
+			&displayName,
+			&bio,
+			&avatarURL,
+			&user.Timezone,
+			&user.SuspendedAt,
+			&user.CreatedAt,
+			&user.UpdatedAt,
+			&roles,
+		); err != nil {
+			return nil, 0, fmt.Errorf("UserRepository.List: scan: %w", err)
+		}
+
+		user.DisplayName = stringValue(displayName)
+		user.Bio = stringValue(bio)
+		user.AvatarURL = stringValue(avatarURL)
+		users = append(users, &ports.ListedUser{User: &user, Roles: roles})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("UserRepository.List: rows error: %w", err)
+	}
+
+	return users, total, nil
+}
+
+// isUsernameConflict reports whether err is a violation of username uniqueness
+func isUsernameConflict(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation &&
+		(pgErr.ConstraintName == "users_username_key" || pgErr.ConstraintName == "users_username_lower_key")
+}
+
 // Helper functions for handling null values
 func nullString(s string) *string {
 	if s == "" {
@@ -0,0 +1,134 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"backend/internal/adapters/postgres"
+	"backend/internal/testing/factory"
+	"backend/internal/testing/pgtest"
+	"backend/internal/users/ports"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserRepository_ChangeUsernameKeepsRetiredName(t *testing.T) {
+	repo := postgres.NewUserRepository(pgtest.Pool(t))
+	ctx := context.Background()
+
+	suffix := uuid.NewString()[:8]
+	oldName, newName := "Ada_"+suffix, "ada_lovelace_"+suffix
+	created := createUser(t, factory.NewUser().Username(oldName))
+	other := createUser(t, factory.NewUser())
+
+	user, err := repo.FindByUsername(ctx, "ADA_"+suffix)
+	require.NoError(t, err)
+	require.NotNil(t, user, "usernames match in any letter case")
+
+	previous := user.Username
+	user.Username = newName
+	require.NoError(t, repo.ChangeUsername(ctx, user, previous))
+
+	renamed, err := repo.FindByRetiredUsername(ctx, "ada_"+suffix)
+	require.NoError(t, err)
+	require.NotNil(t, renamed)
+	assert.Equal(t, newName, renamed.Username)
+
+	holder, err := repo.UsernameHolder(ctx, oldName)
+	require.NoError(t, err)
+	assert.Equal(t, created.ID.String(), holder, "the retired name stays with its user")
+
+	// Nobody else may take the retired name
+	otherUser, err := repo.FindByID(ctx, other.ID.String())
+	require.NoError(t, err)
+	otherPrevious := otherUser.Username
+	otherUser.Username = oldName
+	assert.ErrorIs(t, repo.ChangeUsername(ctx, otherUser, otherPrevious), ports.ErrUsernameTaken)
+
+	// The user may take it back, which releases it from the history
+	user.Username = oldName
+	require.NoError(t, repo.ChangeUsername(ctx, user, newName))
+
+	renamed, err = repo.FindByRetiredUsername(ctx, oldName)
+	require.NoError(t, err)
+	assert.Nil(t, renamed)
+
+	holder, err = repo.UsernameHolder(ctx, "unused_"+suffix)
+	require.NoError(t, err)
+	assert.Empty(t, holder)
+}
+
+func TestUserRepository_List(t *testing.T) {
+	repo := postgres.NewUserRepository(pgtest.Pool(t))
+	ctx := context.Background()
+
+	suffix := uuid.NewString()[:8]
+	author := createUser(t, factory.NewUser().Username("list_author_"+suffix).WithRole("author"))
+	suspended := createUser(t, factory.NewUser().Username("list_suspended_"+suffix))
+
+	user, err := repo.FindByID(ctx, suspended.ID.String())
+	require.NoError(t, err)
+	user.Suspend()
+	require.NoError(t, repo.Update(ctx, user))
+
+	listed, total, err := repo.List(ctx, ports.ListFilter{Search: "LIST_%" + suffix, Limit: 10})
+	require.NoError(t, err)
+	assert.Zero(t, total, "wildcards in the search match literally")
+	assert.Empty(t, listed)
+
+	listed, total, err = repo.List(ctx, ports.ListFilter{Search: suffix, Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	require.Len(t, listed, 2)
+	roles := map[string][]string{}
+	for _, l := range listed {
+		roles[l.User.ID] = l.Roles
+	}
+	assert.Equal(t, []string{"author"}, roles[author.ID.String()])
+	assert.Empty(t, roles[suspended.ID.String()])
+
+	listed, total, err = repo.List(ctx, ports.ListFilter{Search: suffix, Role: "author", Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, listed, 1)
+	assert.Equal(t, author.ID.String(), listed[0].User.ID)
+
+	isSuspended := true
+	listed, total, err = repo.List(ctx, ports.ListFilter{Search: suffix, Suspended: &isSuspended, Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, listed, 1)
+	assert.NotNil(t, listed[0].User.SuspendedAt)
+}
+
+func TestUserRepository_Erase(t *testing.T) {
+	repo := postgres.NewUserRepository(pgtest.Pool(t))
+	ctx := context.Background()
+
+	suffix := uuid.NewString()[:8]
+	created := createUser(t, factory.NewUser().Username("erase_me_"+suffix).Email("erase_"+suffix+"@example.com"))
+	user, err := repo.FindByID(ctx, created.ID.String())
+	require.NoError(t, err)
+	user.Username = "erase_me_later_" + suffix
+	require.NoError(t, repo.ChangeUsername(ctx, user, "erase_me_"+suffix))
+
+	pseudonym := "erased-" + suffix
+	changed, err := repo.Erase(ctx, created.ID.String(), pseudonym)
+	require.NoError(t, err)
+	assert.Equal(t, 2, changed, "the account and its retired username")
+
+	erased, err := repo.FindByID(ctx, created.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, pseudonym, erased.Username)
+	assert.Equal(t, created.ID.String()+"@erased.invalid", erased.Email)
+	assert.Empty(t, erased.DisplayName)
+
+	holder, err := repo.UsernameHolder(ctx, "erase_me_"+suffix)
+	require.NoError(t, err)
+	assert.Empty(t, holder, "retired usernames are forgotten")
+
+	found, err := repo.FindByEmail(ctx, "erase_"+suffix+"@example.com")
+	require.NoError(t, err)
+	assert.Nil(t, found)
+}
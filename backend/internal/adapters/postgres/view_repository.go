@@ -0,0 +1,236 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend/internal/analytics/domain"
+	"backend/internal/analytics/ports"
+	"backend/internal/platform/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ViewRepository implements the analytics.ViewRepository interface using PostgreSQL
+// Views are recorded and reported within the request's blog; the rollup covers every blog
+type ViewRepository struct {
+	postgres.BaseRepository // Embed the base repository for common functionality
+}
+
+// NewViewRepository creates a new PostgreSQL view tracking repository
+func NewViewRepository(db *pgxpool.Pool) *ViewRepository {
+	return &ViewRepository{
+		BaseRepository: postgres.NewBaseRepository(db),
+	}
+}
+
+// WithTx returns a new repository instance that uses the provided transaction
+func (r *ViewRepository) WithTx(tx pgx.Tx) *ViewRepository {
+	return &ViewRepository{
+		BaseRepository: r.BaseRepository.WithTx(tx),
+	}
+}
+
+// RecordView stores a view of a published post of the current blog
+func (r *ViewRepository) RecordView(ctx context.Context, postID uuid.UUID, source domain.Source, viewedAt time.Time) error {
+	result, err := r.DB.Exec(ctx, `
+		INSERT INTO post_views (blog_id, post_id, viewed_at, channel, referrer_domain, utm_source, utm_medium, utm_campaign)
+		SELECT blog_id, id, $3, $4, $5, $6, $7, $8
+		FROM posts
+		WHERE id = $1 AND blog_id = $2 AND status = 'published'`,
+		pgtype.UUID{Bytes: postID, Valid: true},
+		currentBlogID(ctx),
+		pgtype.Timestamptz{Time: viewedAt, Valid: true},
+		string(source.Channel),
+		source.ReferrerDomain,
+		source.UTMSource,
+		source.UTMMedium,
+		source.UTMCampaign,
+	)
+	if err != nil {
+		return fmt.Errorf("ViewRepository.RecordView: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ports.ErrPostNotFound
+	}
+
+	return nil
+}
+
+// RollUp moves the views recorded before the cutoff into the daily counts in
+// one statement, so a view is never counted twice or lost when runs overlap
+func (r *ViewRepository) RollUp(ctx context.Context, before time.Time) (int64, error) {
+	var views int64
+	err := r.DB.QueryRow(ctx, `
+		WITH moved AS (
+			DELETE FROM post_views
+			WHERE viewed_at < $1
+			RETURNING blog_id, post_id, viewed_at, channel, referrer_domain, utm_source, utm_medium, utm_campaign
+		), folded AS (
+			INSERT INTO post_traffic_daily (blog_id, post_id, day, channel, referrer_domain, utm_source, utm_medium, utm_campaign, views)
+			SELECT blog_id, post_id, (viewed_at AT TIME ZONE 'UTC')::date, channel, referrer_domain, utm_source, utm_medium, utm_campaign, COUNT(*)
+			FROM moved
+			GROUP BY 1, 2, 3, 4, 5, 6, 7, 8
+			ON CONFLICT (post_id, day, channel, referrer_domain, utm_source, utm_medium, utm_campaign)
+			DO UPDATE SET views = post_traffic_daily.views + EXCLUDED.views
+		)
+		SELECT COUNT(*) FROM moved`,
+		pgtype.Timestamptz{Time: before, Valid: true},
+	).Scan(&views)
+	if err != nil {
+		return 0, fmt.Errorf("ViewRepository.RollUp: %w", err)
+	}
+
+	return views, nil
+}
+
+// PostTraffic breaks down the rolled-up views of a post of the current blog
+func (r *ViewRepository) PostTraffic(ctx context.Context, postID uuid.UUID, dateRange domain.DateRange, top int) (*domain.Traffic, error) {
+	id := pgtype.UUID{Bytes: postID, Valid: true}
+	blogID := currentBlogID(ctx)
+	from := pgtype.Date{Time: dateRange.From, Valid: true}
+	to := pgtype.Date{Time: dateRange.To, Valid: true}
+
+	var exists bool
+	if err := r.DB.QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM posts WHERE id = $1 AND blog_id = $2)`,
+		id, blogID,
+	).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("ViewRepository.PostTraffic: find post: %w", err)
+	}
+	if !exists {
+		return nil, ports.ErrPostNotFound
+	}
+
+	traffic := &domain.Traffic{PostID: postID, Range: dateRange}
+
+	// Every query filters the same way: $1-$4 are the post, blog and range
+	const where = `post_id = $1 AND blog_id = $2 AND day BETWEEN $3 AND $4`
+
+	err := r.scanAll(ctx, func(row pgx.Rows) error {
+		var channel domain.ChannelViews
+		if err := row.Scan(&channel.Channel, &channel.Views); err != nil {
+			return err
+		}
+		traffic.Channels = append(traffic.Channels, channel)
+		traffic.Views += channel.Views
+		return nil
+	}, `
+		SELECT channel, SUM(views) FROM post_traffic_daily
+		WHERE `+where+`
+		GROUP BY channel
+		ORDER BY 2 DESC, 1`,
+		id, blogID, from, to,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ViewRepository.PostTraffic: channels: %w", err)
+	}
+
+	err = r.scanAll(ctx, func(row pgx.Rows) error {
+		var referrer domain.ReferrerViews
+		if err := row.Scan(&referrer.Domain, &referrer.Views); err != nil {
+			return err
+		}
+		traffic.Referrers = append(traffic.Referrers, referrer)
+		return nil
+	}, `
+		SELECT referrer_domain, SUM(views) FROM post_traffic_daily
+		WHERE `+where+` AND referrer_domain <> ''
+		GROUP BY referrer_domain
+		ORDER BY 2 DESC, 1
+		LIMIT $5`,
+		id, blogID, from, to, top,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ViewRepository.PostTraffic: referrers: %w", err)
+	}
+
+	err = r.scanAll(ctx, func(row pgx.Rows) error {
+		var campaign domain.CampaignViews
+		if err := row.Scan(&campaign.Source, &campaign.Medium, &campaign.Campaign, &campaign.Views); err != nil {
+			return err
+		}
+		traffic.Campaigns = append(traffic.Campaigns, campaign)
+		return nil
+	}, `
+		SELECT utm_source, utm_medium, utm_campaign, SUM(views) FROM post_traffic_daily
+		WHERE `+where+` AND (utm_source <> '' OR utm_medium <> '' OR utm_campaign <> '')
+		GROUP BY utm_source, utm_medium, utm_campaign
+		ORDER BY 4 DESC, 1, 2, 3
+		LIMIT $5`,
+		id, blogID, from, to, top,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ViewRepository.PostTraffic: campaigns: %w", err)
+	}
+
+	return traffic, nil
+}
+
+// TrendingPosts sums the daily counts from the cutoff's day on with the views
+// still waiting for the rollup, which are never counted in both places
+func (r *ViewRepository) TrendingPosts(ctx context.Context, since time.Time, limit int) ([]domain.PostViews, error) {
+	query, args, err := r.SB.
+		Select("v.post_id", "SUM(v.views)").
+		Prefix(`WITH recent AS (
+			SELECT post_id, views FROM post_traffic_daily
+			WHERE blog_id = ? AND day >= (?::timestamptz AT TIME ZONE 'UTC')::date
+			UNION ALL
+			SELECT post_id, 1 FROM post_views
+			WHERE blog_id = ? AND viewed_at >= ?
+		)`,
+			currentBlogID(ctx), pgtype.Timestamptz{Time: since, Valid: true},
+			currentBlogID(ctx), pgtype.Timestamptz{Time: since, Valid: true},
+		).
+		From("recent v").
+		Join("posts p ON p.id = v.post_id").
+		Where(listedForReaders(false)).
+		GroupBy("v.post_id").
+		OrderBy("2 DESC", "v.post_id").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("ViewRepository.TrendingPosts: build query: %w", err)
+	}
+
+	var trending []domain.PostViews
+	err = r.scanAll(ctx, func(row pgx.Rows) error {
+		var postID pgtype.UUID
+		var views int
+		if err := row.Scan(&postID, &views); err != nil {
+			return err
+		}
+		trending = append(trending, domain.PostViews{PostID: uuid.UUID(postID.Bytes), Views: views})
+		return nil
+	}, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("ViewRepository.TrendingPosts: %w", err)
+	}
+
+	return trending, nil
+}
+
+// Helper methods
+
+// scanAll runs a query and hands each row to scan
+func (r *ViewRepository) scanAll(ctx context.Context, scan func(pgx.Rows) error, query string, args ...any) error {
+	rows, err := r.DB.Query(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := scan(rows); err != nil {
+			return fmt.Errorf("scan: %w", err)
+		}
+	}
+	return rows.Err()
+}
+
+// Compile-time check to ensure ViewRepository implements ports.ViewRepository
+var _ ports.ViewRepository = (*ViewRepository)(nil)
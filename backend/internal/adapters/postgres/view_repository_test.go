@@ -0,0 +1,94 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"backend/internal/adapters/postgres"
+	"backend/internal/analytics/domain"
+	"backend/internal/analytics/ports"
+	"backend/internal/testing/factory"
+	"backend/internal/testing/pgtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestViewRepository_RollUp(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewViewRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	author := factory.NewUser().Create(t, tx)
+	post := factory.NewPost(author.ID).Published().Create(t, tx)
+	draft := factory.NewPost(author.ID).Create(t, tx)
+
+	now := time.Now()
+	search := domain.NewSource("https://www.google.com/search?q=go", domain.UTM{}, "")
+	newsletter := domain.NewSource("", domain.UTM{Source: "digest", Medium: "email", Campaign: "october"}, "")
+	for _, source := range []domain.Source{search, search, newsletter} {
+		require.NoError(t, repo.RecordView(ctx, post.ID, source, now.Add(-time.Minute)))
+	}
+	assert.ErrorIs(t, repo.RecordView(ctx, draft.ID, search, now), ports.ErrPostNotFound, "drafts are not counted")
+
+	views, err := repo.RollUp(ctx, now)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, views)
+
+	// A second rollup adds to the day's counts rather than replacing them
+	require.NoError(t, repo.RecordView(ctx, post.ID, search, now.Add(-time.Second)))
+	views, err = repo.RollUp(ctx, now)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, views)
+
+	traffic, err := repo.PostTraffic(ctx, post.ID, domain.LastDays(now, 7), 10)
+	require.NoError(t, err)
+	assert.Equal(t, 4, traffic.Views)
+	assert.Equal(t, []domain.ChannelViews{
+		{Channel: domain.ChannelSearch, Views: 3},
+		{Channel: domain.ChannelEmail, Views: 1},
+	}, traffic.Channels)
+	assert.Equal(t, []domain.ReferrerViews{{Domain: "google.com", Views: 3}}, traffic.Referrers)
+	assert.Equal(t, []domain.CampaignViews{{Source: "digest", Medium: "email", Campaign: "october", Views: 1}}, traffic.Campaigns)
+
+	traffic, err = repo.PostTraffic(ctx, draft.ID, domain.LastDays(now, 7), 10)
+	require.NoError(t, err)
+	assert.Zero(t, traffic.Views)
+}
+
+func TestViewRepository_TrendingPosts(t *testing.T) {
+	tx := pgtest.Tx(t)
+	repo := postgres.NewViewRepository(pgtest.Pool(t)).WithTx(tx)
+	ctx := context.Background()
+
+	author := factory.NewUser().Create(t, tx)
+	popular := factory.NewPost(author.ID).Published().Create(t, tx)
+	quiet := factory.NewPost(author.ID).Published().Create(t, tx)
+	stale := factory.NewPost(author.ID).Published().Create(t, tx)
+
+	now := time.Now()
+	direct := domain.NewSource("", domain.UTM{}, "")
+	require.NoError(t, repo.RecordView(ctx, popular.ID, direct, now.Add(-2*time.Hour)))
+	require.NoError(t, repo.RecordView(ctx, popular.ID, direct, now.Add(-2*time.Hour)))
+	require.NoError(t, repo.RecordView(ctx, stale.ID, direct, now.AddDate(0, 0, -30)))
+	_, err := repo.RollUp(ctx, now.Add(-time.Hour))
+	require.NoError(t, err)
+
+	// Views waiting for the rollup count alongside the daily counts
+	require.NoError(t, repo.RecordView(ctx, popular.ID, direct, now))
+	require.NoError(t, repo.RecordView(ctx, quiet.ID, direct, now))
+
+	trending, err := repo.TrendingPosts(ctx, now.AddDate(0, 0, -7), 100)
+	require.NoError(t, err)
+
+	var ours []domain.PostViews
+	for _, entry := range trending {
+		if entry.PostID == popular.ID || entry.PostID == quiet.ID || entry.PostID == stale.ID {
+			ours = append(ours, entry)
+		}
+	}
+	assert.Equal(t, []domain.PostViews{
+		{PostID: popular.ID, Views: 3},
+		{PostID: quiet.ID, Views: 1},
+	}, ours, "views before the cutoff are not counted")
+}
@@ -0,0 +1,78 @@
+package rest
+
+import (
+	"net/http"
+
+	"backend/internal/adapters/api"
+	"backend/internal/platform/signedlink"
+	"backend/internal/posts/application"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// ActionLinksHandler issues signed action links and performs the actions they carry
+type ActionLinksHandler struct {
+	*BaseHandler
+	signer       *signedlink.Signer
+	postsService *application.PostsService
+}
+
+// NewActionLinksHandler creates a new action links handler
+func NewActionLinksHandler(base *BaseHandler, signer *signedlink.Signer, postsService *application.PostsService) *ActionLinksHandler {
+	return &ActionLinksHandler{
+		BaseHandler:  base,
+		signer:       signer,
+		postsService: postsService,
+	}
+}
+
+// IssueActionLink issues a single-use link performing an action as the authenticated user
+func (h *ActionLinksHandler) IssueActionLink(w http.ResponseWriter, r *http.Request) {
+	userID := h.GetUserIDFromContext(r)
+
+	var req api.IssueActionLinkRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+	switch req.Action {
+	case api.PostsApprove, api.PostsUnpublish, api.FollowsConfirm:
+	default:
+		h.WriteJSONError(w, r, "validation_error", "Unknown action", http.StatusBadRequest)
+		return
+	}
+
+	link, query, err := h.signer.Issue(string(req.Action), uuid.UUID(req.ResourceId).String(), userID)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, api.ActionLink{
+		Action:     req.Action,
+		ResourceId: req.ResourceId,
+		ExpiresAt:  link.ExpiresAt,
+		Query:      query.Encode(),
+	}, http.StatusCreated)
+}
+
+// ApprovePostViaLink approves a post in review as the reviewer the link was issued to
+// NOTE: Signed link middleware verifies the link and sets its user before this is called
+func (h *ActionLinksHandler) ApprovePostViaLink(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, _ api.ApprovePostViaLinkParams) {
+	post, err := h.postsService.ApprovePost(r.Context(), h.GetUserIDFromContext(r), uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+	h.WriteJSONResponse(w, r, domainPostToAPI(post), http.StatusOK)
+}
+
+// UnpublishPostViaLink takes a post back to draft as the user the link was issued to
+// NOTE: Signed link middleware verifies the link and sets its user before this is called
+func (h *ActionLinksHandler) UnpublishPostViaLink(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, _ api.UnpublishPostViaLinkParams) {
+	post, err := h.postsService.UnpublishPost(r.Context(), h.GetUserIDFromContext(r), uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+	h.WriteJSONResponse(w, r, domainPostToAPI(post), http.StatusOK)
+}
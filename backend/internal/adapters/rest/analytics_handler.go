@@ -0,0 +1,112 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"backend/internal/adapters/api"
+	"backend/internal/analytics/application"
+	"backend/internal/analytics/domain"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// AnalyticsHandler handles HTTP requests for post view tracking and traffic reports
+type AnalyticsHandler struct {
+	*BaseHandler
+	service *application.AnalyticsService
+}
+
+// NewAnalyticsHandler creates a new analytics handler
+func NewAnalyticsHandler(base *BaseHandler, service *application.AnalyticsService) *AnalyticsHandler {
+	return &AnalyticsHandler{
+		BaseHandler: base,
+		service:     service,
+	}
+}
+
+// RecordPostView counts a reader's view of a published post
+// NOTE: Public endpoint - no authorization required
+func (h *AnalyticsHandler) RecordPostView(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var req api.RecordViewRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	var referrer string
+	if req.Referrer != nil {
+		referrer = *req.Referrer
+	}
+	var utm domain.UTM
+	if req.UtmSource != nil {
+		utm.Source = *req.UtmSource
+	}
+	if req.UtmMedium != nil {
+		utm.Medium = *req.UtmMedium
+	}
+	if req.UtmCampaign != nil {
+		utm.Campaign = *req.UtmCampaign
+	}
+
+	if err := h.service.RecordView(r.Context(), uuid.UUID(id), referrer, utm); err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetPostTraffic breaks down where a post's views came from
+// NOTE: Authorization middleware checks analytics:view ownership before this is called
+func (h *AnalyticsHandler) GetPostTraffic(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params api.GetPostTrafficParams) {
+	userID := h.GetUserIDFromContext(r)
+
+	var from, to *time.Time
+	if params.From != nil {
+		from = &params.From.Time
+	}
+	if params.To != nil {
+		to = &params.To.Time
+	}
+
+	traffic, err := h.service.GetPostTraffic(r.Context(), userID, uuid.UUID(id), from, to)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainTrafficToAPI(traffic), http.StatusOK)
+}
+
+func domainTrafficToAPI(traffic *domain.Traffic) api.PostTraffic {
+	response := api.PostTraffic{
+		PostId:    openapi_types.UUID(traffic.PostID),
+		From:      openapi_types.Date{Time: traffic.Range.From},
+		To:        openapi_types.Date{Time: traffic.Range.To},
+		Views:     traffic.Views,
+		Channels:  make([]api.ChannelViews, len(traffic.Channels)),
+		Referrers: make([]api.ReferrerViews, len(traffic.Referrers)),
+		Campaigns: make([]api.CampaignViews, len(traffic.Campaigns)),
+	}
+	for i, channel := range traffic.Channels {
+		response.Channels[i] = api.ChannelViews{
+			Channel: api.TrafficChannel(channel.Channel),
+			Views:   channel.Views,
+		}
+	}
+	for i, referrer := range traffic.Referrers {
+		response.Referrers[i] = api.ReferrerViews{
+			Domain: referrer.Domain,
+			Views:  referrer.Views,
+		}
+	}
+	for i, campaign := range traffic.Campaigns {
+		response.Campaigns[i] = api.CampaignViews{
+			Source:   campaign.Source,
+			Medium:   campaign.Medium,
+			Campaign: campaign.Campaign,
+			Views:    campaign.Views,
+		}
+	}
+	return response
+}
@@ -0,0 +1,155 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"backend/internal/adapters/api"
+	"backend/internal/announcements/application"
+	"backend/internal/announcements/domain"
+	"backend/internal/platform/httpcache"
+	"backend/internal/platform/timezone"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// AnnouncementsHandler handles HTTP requests for a blog's announcements
+type AnnouncementsHandler struct {
+	*BaseHandler
+	service *application.AnnouncementsService
+}
+
+// NewAnnouncementsHandler creates a new announcements handler
+func NewAnnouncementsHandler(base *BaseHandler, service *application.AnnouncementsService) *AnnouncementsHandler {
+	return &AnnouncementsHandler{
+		BaseHandler: base,
+		service:     service,
+	}
+}
+
+// ListActiveAnnouncements returns the announcements readers see now
+// NOTE: Public endpoint - no authorization required
+func (h *AnnouncementsHandler) ListActiveAnnouncements(w http.ResponseWriter, r *http.Request) {
+	announcements, err := h.service.ListActiveAnnouncements(r.Context())
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+	httpcache.AddSurrogateKeys(w.Header(), httpcache.AnnouncementsKey)
+
+	h.WriteJSONResponse(w, r, domainAnnouncementsToAPI(announcements, timezone.FromContext(r.Context())), http.StatusOK)
+}
+
+// ListAnnouncements lists every announcement of the blog
+// NOTE: Authorization middleware checks settings:blog permission before this is called
+func (h *AnnouncementsHandler) ListAnnouncements(w http.ResponseWriter, r *http.Request) {
+	userID := h.GetUserIDFromContext(r)
+
+	announcements, err := h.service.ListAnnouncements(r.Context(), userID)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainAnnouncementsToAPI(announcements, timezone.FromContext(r.Context())), http.StatusOK)
+}
+
+// CreateAnnouncement schedules an announcement
+// NOTE: Authorization middleware checks settings:blog permission before this is called
+func (h *AnnouncementsHandler) CreateAnnouncement(w http.ResponseWriter, r *http.Request) {
+	userID := h.GetUserIDFromContext(r)
+
+	var req api.AnnouncementRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	announcement, err := h.service.CreateAnnouncement(r.Context(), userID, apiAnnouncementRequestToParams(req))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainAnnouncementToAPI(announcement, timezone.FromContext(r.Context())), http.StatusCreated)
+}
+
+// GetAnnouncement retrieves an announcement by ID
+// NOTE: Authorization middleware checks settings:blog permission before this is called
+func (h *AnnouncementsHandler) GetAnnouncement(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	announcement, err := h.service.GetAnnouncement(r.Context(), userID, uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainAnnouncementToAPI(announcement, timezone.FromContext(r.Context())), http.StatusOK)
+}
+
+// UpdateAnnouncement replaces an announcement's content and window
+// NOTE: Authorization middleware checks settings:blog permission before this is called
+func (h *AnnouncementsHandler) UpdateAnnouncement(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	var req api.AnnouncementRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	announcement, err := h.service.UpdateAnnouncement(r.Context(), userID, uuid.UUID(id), apiAnnouncementRequestToParams(req))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainAnnouncementToAPI(announcement, timezone.FromContext(r.Context())), http.StatusOK)
+}
+
+// DeleteAnnouncement removes an announcement
+// NOTE: Authorization middleware checks settings:blog permission before this is called
+func (h *AnnouncementsHandler) DeleteAnnouncement(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	if err := h.service.DeleteAnnouncement(r.Context(), userID, uuid.UUID(id)); err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func apiAnnouncementRequestToParams(req api.AnnouncementRequest) application.AnnouncementParams {
+	return application.AnnouncementParams{
+		Kind:     domain.Kind(req.Kind),
+		Title:    req.Title,
+		Message:  req.Message,
+		StartsAt: req.StartsAt,
+		EndsAt:   req.EndsAt,
+	}
+}
+
+func domainAnnouncementsToAPI(announcements []*domain.Announcement, loc *time.Location) []api.Announcement {
+	response := make([]api.Announcement, 0, len(announcements))
+	for _, announcement := range announcements {
+		response = append(response, domainAnnouncementToAPI(announcement, loc))
+	}
+	return response
+}
+
+// domainAnnouncementToAPI converts an announcement, showing its window both in
+// UTC and in loc, the zone of the caller
+func domainAnnouncementToAPI(announcement *domain.Announcement, loc *time.Location) api.Announcement {
+	return api.Announcement{
+		Id:            openapi_types.UUID(announcement.ID),
+		Kind:          api.AnnouncementKind(announcement.Kind),
+		Title:         announcement.Title,
+		Message:       announcement.Message,
+		StartsAt:      announcement.StartsAt.UTC(),
+		EndsAt:        announcement.EndsAt.UTC(),
+		StartsAtLocal: announcement.StartsAt.In(loc),
+		EndsAtLocal:   announcement.EndsAt.In(loc),
+		CreatedAt:     announcement.CreatedAt,
+		UpdatedAt:     announcement.UpdatedAt,
+	}
+}
@@ -0,0 +1,139 @@
+package rest
+
+import (
+	"net/http"
+
+	"backend/internal/adapters/api"
+	"backend/internal/apiclients/application"
+	"backend/internal/apiclients/domain"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// APIClientsHandler handles HTTP requests for public API clients
+type APIClientsHandler struct {
+	*BaseHandler
+	service *application.ClientService
+}
+
+// NewAPIClientsHandler creates a new API clients handler
+func NewAPIClientsHandler(base *BaseHandler, service *application.ClientService) *APIClientsHandler {
+	return &APIClientsHandler{
+		BaseHandler: base,
+		service:     service,
+	}
+}
+
+// ListMyApiClients returns the authenticated user's API clients
+// NOTE: Authorization middleware checks api_clients:manage permission before this is called
+func (h *APIClientsHandler) ListMyApiClients(w http.ResponseWriter, r *http.Request) {
+	userID := h.GetUserIDFromContext(r)
+
+	clients, err := h.service.ListClients(r.Context(), userID)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	response := make([]api.ApiClient, len(clients))
+	for i, client := range clients {
+		response[i] = domainAPIClientToAPI(client)
+	}
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
+// RegisterApiClient registers an API client and returns its token once
+// NOTE: Authorization middleware checks api_clients:manage permission before this is called
+func (h *APIClientsHandler) RegisterApiClient(w http.ResponseWriter, r *http.Request) {
+	userID := h.GetUserIDFromContext(r)
+
+	var req api.RegisterApiClientRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	scopes := make([]string, len(req.Scopes))
+	for i, scope := range req.Scopes {
+		scopes[i] = string(scope)
+	}
+
+	client, token, err := h.service.RegisterClient(r.Context(), userID, req.Name, scopes)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	registered := domainAPIClientToAPI(client)
+	h.WriteJSONResponse(w, r, api.RegisteredApiClient{
+		Id:          registered.Id,
+		Name:        registered.Name,
+		TokenPrefix: registered.TokenPrefix,
+		Scopes:      registered.Scopes,
+		RateLimit:   registered.RateLimit,
+		CreatedAt:   registered.CreatedAt,
+		Token:       token,
+	}, http.StatusCreated)
+}
+
+// RevokeApiClient revokes one of the authenticated user's API clients
+// NOTE: Authorization middleware checks api_clients:manage permission before this is called
+func (h *APIClientsHandler) RevokeApiClient(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	client, err := h.service.RevokeClient(r.Context(), userID, uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainAPIClientToAPI(client), http.StatusOK)
+}
+
+// GetApiClientUsage returns the daily usage of one of the authenticated user's API clients
+// NOTE: Authorization middleware checks api_clients:manage permission before this is called
+func (h *APIClientsHandler) GetApiClientUsage(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params api.GetApiClientUsageParams) {
+	userID := h.GetUserIDFromContext(r)
+
+	days := 30
+	if params.Days != nil {
+		days = *params.Days
+	}
+
+	usage, err := h.service.GetUsage(r.Context(), userID, uuid.UUID(id), days)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	response := api.ApiClientUsage{
+		ClientId: id,
+		Days:     make([]api.ApiClientDailyUsage, len(usage)),
+	}
+	for i, day := range usage {
+		response.Days[i] = api.ApiClientDailyUsage{
+			Day:       openapi_types.Date{Time: day.Day},
+			Requests:  day.Requests,
+			Throttled: day.Throttled,
+		}
+		response.TotalRequests += day.Requests
+		response.TotalThrottled += day.Throttled
+	}
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
+func domainAPIClientToAPI(client *domain.Client) api.ApiClient {
+	scopes := make([]api.ApiClientScope, len(client.Scopes))
+	for i, scope := range client.Scopes {
+		scopes[i] = api.ApiClientScope(scope)
+	}
+	return api.ApiClient{
+		Id:          openapi_types.UUID(client.ID),
+		Name:        client.Name,
+		TokenPrefix: client.TokenPrefix,
+		Scopes:      scopes,
+		RateLimit:   client.RateLimit,
+		CreatedAt:   client.CreatedAt,
+		LastUsedAt:  client.LastUsedAt,
+		RevokedAt:   client.RevokedAt,
+	}
+}
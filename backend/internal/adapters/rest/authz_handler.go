@@ -1,12 +1,14 @@
 package rest
 
 import (
-	"encoding/json"
 	"net/http"
+	"sort"
+	"strings"
 
 	"backend/internal/adapters/api"
 	"backend/internal/authz/application"
 	"backend/internal/authz/domain"
+	"backend/internal/platform/httpcache"
 	"github.com/google/uuid"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
@@ -69,8 +71,7 @@ func (h *AuthzHandler) CreateRole(w http.ResponseWriter, r *http.Request) {
 
 	// Decode and validate request
 	var req api.CreateRoleRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.WriteJSONError(w, r, "validation_error", "Invalid request body", http.StatusBadRequest)
+	if !h.DecodeJSON(w, r, &req) {
 		return
 	}
 
@@ -126,8 +127,7 @@ func (h *AuthzHandler) UpdateRole(w http.ResponseWriter, r *http.Request, roleId
 
 	// Decode request
 	var req api.UpdateRoleRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.WriteJSONError(w, r, "validation_error", "Invalid request body", http.StatusBadRequest)
+	if !h.DecodeJSON(w, r, &req) {
 		return
 	}
 
@@ -169,8 +169,7 @@ func (h *AuthzHandler) UpdateRolePermissions(w http.ResponseWriter, r *http.Requ
 
 	// Decode request
 	var req api.RolePermissionsRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.WriteJSONError(w, r, "validation_error", "Invalid request body", http.StatusBadRequest)
+	if !h.DecodeJSON(w, r, &req) {
 		return
 	}
 
@@ -191,6 +190,97 @@ func (h *AuthzHandler) UpdateRolePermissions(w http.ResponseWriter, r *http.Requ
 	h.WriteJSONResponse(w, r, h.mapDomainRoleToAPI(role), http.StatusOK)
 }
 
+// GetRoleUsage shows who holds a role and what they would lose without it
+func (h *AuthzHandler) GetRoleUsage(w http.ResponseWriter, r *http.Request, roleId openapi_types.UUID, params api.GetRoleUsageParams) {
+	// Pagination - convert page-based to offset-based
+	limit := 20
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = *params.Limit
+	}
+	offset := 0
+	if params.Page != nil && *params.Page > 0 {
+		offset = (*params.Page - 1) * limit
+	}
+
+	usage, assignments, err := h.service.GetRoleUsage(r.Context(), uuid.UUID(roleId), limit, offset)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	uniquePermissions := make([]api.RoleUniquePermission, len(usage.UniquePermissions))
+	for i, perm := range usage.UniquePermissions {
+		uniquePermissions[i] = api.RoleUniquePermission{
+			Permission:  perm.PermissionID,
+			HolderCount: perm.HolderCount,
+		}
+	}
+
+	data := make([]api.RoleAssignment, len(assignments))
+	for i, assignment := range assignments {
+		data[i] = api.RoleAssignment{
+			UserId:    openapi_types.UUID(assignment.UserID),
+			Username:  assignment.Username,
+			BlogId:    (*openapi_types.UUID)(assignment.BlogID),
+			GrantedAt: assignment.GrantedAt,
+			GrantedBy: (*openapi_types.UUID)(assignment.GrantedBy),
+		}
+	}
+
+	response := api.RoleUsage{
+		RoleId:            openapi_types.UUID(usage.RoleID),
+		HolderCount:       usage.HolderCount,
+		AssignmentCount:   usage.AssignmentCount,
+		LastGrantedAt:     usage.LastGrantedAt,
+		UniquePermissions: uniquePermissions,
+		Data:              data,
+		Meta: api.PaginationMeta{
+			TotalItems:   usage.AssignmentCount,
+			ItemsPerPage: limit,
+			CurrentPage:  (offset / limit) + 1,
+			TotalPages:   (usage.AssignmentCount + limit - 1) / limit,
+		},
+	}
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
+// GetMyPermissions returns the caller's permissions and roles, tagged so
+// clients can revalidate their copy without downloading it again
+func (h *AuthzHandler) GetMyPermissions(w http.ResponseWriter, r *http.Request, _ api.GetMyPermissionsParams) {
+	ctx := r.Context()
+	userID := h.GetUserIDFromContext(r)
+
+	permissions, err := h.service.GetUserPermissions(ctx, userID)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+	roles, err := h.service.GetUserRoles(ctx, userID)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	// Sorted, so the tag depends only on what the user holds
+	permissions = append([]string{}, permissions...)
+	roles = append([]string{}, roles...)
+	sort.Strings(permissions)
+	sort.Strings(roles)
+
+	etag := httpcache.ETag(strings.Join(permissions, " "), strings.Join(roles, " "))
+	header := w.Header()
+	header.Set(httpcache.HeaderETag, etag)
+	header.Set("Cache-Control", "private, no-cache")
+	header.Add("Vary", "Authorization")
+
+	if httpcache.NotModified(r, etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, api.MyPermissions{Permissions: permissions, Roles: roles}, http.StatusOK)
+}
+
 // GetUserRoles returns all roles assigned to a user
 func (h *AuthzHandler) GetUserRoles(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID) {
 	ctx := r.Context()
@@ -212,17 +302,67 @@ func (h *AuthzHandler) GetUserRoles(w http.ResponseWriter, r *http.Request, user
 			UserId:    openapi_types.UUID(ur.UserID),
 			RoleId:    openapi_types.UUID(ur.RoleID),
 			Role:      h.mapDomainRoleToAPI(ur.Role),
+			BlogId:    (*openapi_types.UUID)(ur.BlogID),
 			GrantedAt: ur.GrantedAt,
 		}
 		if ur.GrantedBy != uuid.Nil {
 			grantedBy := openapi_types.UUID(ur.GrantedBy)
 			apiUserRoles[i].GrantedBy = &grantedBy
 		}
+		if ur.GrantedByUsername != "" {
+			apiUserRoles[i].GrantedByUsername = &ur.GrantedByUsername
+		}
 	}
 
 	h.WriteJSONResponse(w, r, apiUserRoles, http.StatusOK)
 }
 
+// ListUserRoleHistory returns the grants and revocations of a user's roles, newest first
+func (h *AuthzHandler) ListUserRoleHistory(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID, params api.ListUserRoleHistoryParams) {
+	// Pagination - convert page-based to offset-based
+	limit := 20
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = *params.Limit
+	}
+	offset := 0
+	if params.Page != nil && *params.Page > 0 {
+		offset = (*params.Page - 1) * limit
+	}
+
+	entries, total, err := h.service.ListUserRoleHistory(r.Context(), uuid.UUID(userId), limit, offset)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	data := make([]api.RoleHistoryEntry, len(entries))
+	for i, entry := range entries {
+		data[i] = api.RoleHistoryEntry{
+			Id:         openapi_types.UUID(entry.ID),
+			RoleId:     openapi_types.UUID(entry.RoleID),
+			RoleName:   entry.RoleName,
+			BlogId:     (*openapi_types.UUID)(entry.BlogID),
+			Action:     api.RoleHistoryEntryAction(entry.Change),
+			ActorId:    (*openapi_types.UUID)(entry.ActorID),
+			OccurredAt: entry.OccurredAt,
+		}
+		if entry.ActorUsername != "" {
+			data[i].ActorUsername = &entry.ActorUsername
+		}
+	}
+
+	response := api.PaginatedRoleHistory{
+		Data: data,
+		Meta: api.PaginationMeta{
+			TotalItems:   total,
+			ItemsPerPage: limit,
+			CurrentPage:  (offset / limit) + 1,
+			TotalPages:   (total + limit - 1) / limit,
+		},
+	}
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
 // AssignRoleToUser assigns a role to a user
 func (h *AuthzHandler) AssignRoleToUser(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID) {
 	ctx := r.Context()
@@ -235,8 +375,7 @@ func (h *AuthzHandler) AssignRoleToUser(w http.ResponseWriter, r *http.Request,
 
 	// Decode request
 	var req api.AssignRoleRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.WriteJSONError(w, r, "validation_error", "Invalid request body", http.StatusBadRequest)
+	if !h.DecodeJSON(w, r, &req) {
 		return
 	}
 
@@ -262,8 +401,8 @@ func (h *AuthzHandler) RevokeRoleFromUser(w http.ResponseWriter, r *http.Request
 	userUUID := uuid.UUID(userId)
 	roleUUID := uuid.UUID(roleId)
 
-	// Revoke the role
-	if err := h.service.RemoveRoleFromUser(ctx, userUUID, roleUUID); err != nil {
+	// Revoke the role, recording the current user as the one revoking it
+	if err := h.service.RemoveRoleFromUser(ctx, userUUID, roleUUID, h.GetUserIDFromContext(r)); err != nil {
 		h.HandleError(w, r, err)
 		return
 	}
@@ -271,6 +410,64 @@ func (h *AuthzHandler) RevokeRoleFromUser(w http.ResponseWriter, r *http.Request
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// GetPermissionMatrix returns the roles against the registered permissions
+func (h *AuthzHandler) GetPermissionMatrix(w http.ResponseWriter, r *http.Request) {
+	matrix, err := h.service.GetPermissionMatrix(r.Context())
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, h.mapDomainMatrixToAPI(matrix), http.StatusOK)
+}
+
+// UpdatePermissionMatrix applies grant and revoke changes to the matrix
+func (h *AuthzHandler) UpdatePermissionMatrix(w http.ResponseWriter, r *http.Request) {
+	var req api.PermissionMatrixUpdate
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	changes := make([]domain.MatrixChange, len(req.Changes))
+	for i, change := range req.Changes {
+		changes[i] = domain.MatrixChange{
+			RoleID:     uuid.UUID(change.RoleId),
+			Permission: change.Permission,
+			Granted:    change.Granted,
+		}
+	}
+
+	matrix, err := h.service.UpdatePermissionMatrix(r.Context(), changes)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, h.mapDomainMatrixToAPI(matrix), http.StatusOK)
+}
+
+// GetPermissionDrift reports permissions declared only in code or only in the database
+func (h *AuthzHandler) GetPermissionDrift(w http.ResponseWriter, r *http.Request) {
+	drift, err := h.service.ReconcilePermissions(r.Context(), false)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, h.mapDomainDriftToAPI(drift), http.StatusOK)
+}
+
+// SeedMissingPermissions creates the declared permissions the database lacks
+func (h *AuthzHandler) SeedMissingPermissions(w http.ResponseWriter, r *http.Request) {
+	drift, err := h.service.ReconcilePermissions(r.Context(), true)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, h.mapDomainDriftToAPI(drift), http.StatusOK)
+}
+
 // Mapper functions to convert domain models to API models
 
 func (h *AuthzHandler) mapDomainPermissionToAPI(perm *domain.Permission) api.Permission {
@@ -309,3 +506,46 @@ func (h *AuthzHandler) mapDomainRoleToAPI(role *domain.Role) api.Role {
 		UpdatedAt:   role.UpdatedAt,
 	}
 }
+
+func (h *AuthzHandler) mapDomainMatrixToAPI(matrix *domain.PermissionMatrix) api.PermissionMatrix {
+	roles := make([]api.PermissionMatrixRole, len(matrix.Roles))
+	for i, role := range matrix.Roles {
+		roles[i] = api.PermissionMatrixRole{
+			Id:         openapi_types.UUID(role.ID),
+			Name:       role.Name,
+			IsTemplate: role.IsTemplate,
+			IsSystem:   role.IsSystem,
+		}
+	}
+
+	resources := make([]api.PermissionMatrixResource, len(matrix.Resources))
+	for i, resource := range matrix.Resources {
+		entries := make([]api.PermissionMatrixEntry, len(resource.Permissions))
+		for j, perm := range resource.Permissions {
+			grantedTo := make([]openapi_types.UUID, len(perm.GrantedTo))
+			for k, roleID := range perm.GrantedTo {
+				grantedTo[k] = openapi_types.UUID(roleID)
+			}
+			entries[j] = api.PermissionMatrixEntry{
+				Id:          perm.ID,
+				Description: perm.Description,
+				Roles:       grantedTo,
+			}
+		}
+		resources[i] = api.PermissionMatrixResource{
+			Resource:    resource.Resource,
+			Permissions: entries,
+		}
+	}
+
+	return api.PermissionMatrix{Roles: roles, Resources: resources}
+}
+
+func (h *AuthzHandler) mapDomainDriftToAPI(drift *domain.PermissionDrift) api.PermissionDrift {
+	return api.PermissionDrift{
+		InSync:              drift.InSync(),
+		MissingFromDatabase: drift.MissingFromDatabase,
+		UnknownToCode:       drift.UnknownToCode,
+		Seeded:              drift.Seeded,
+	}
+}
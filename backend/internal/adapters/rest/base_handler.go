@@ -1,25 +1,34 @@
 package rest
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
+	"path"
 
+	"backend/internal/adapters/api"
 	"backend/internal/adapters/rest/middleware"
 	"backend/internal/platform/apperror"
+	"backend/internal/platform/errreport"
+	"backend/internal/platform/i18n"
 	"backend/internal/platform/logger"
 	"github.com/google/uuid"
 )
 
 // BaseHandler contains common dependencies and helper methods for all handlers
 type BaseHandler struct {
-	logger logger.Logger
+	logger      logger.Logger
+	reporter    errreport.Reporter
+	errorConfig ErrorConfig
 }
 
 // NewBaseHandler creates a new base handler with common dependencies
-func NewBaseHandler(logger logger.Logger) *BaseHandler {
+func NewBaseHandler(logger logger.Logger, reporter errreport.Reporter, errorConfig ErrorConfig) *BaseHandler {
 	return &BaseHandler{
-		logger: logger,
+		logger:      logger,
+		reporter:    reporter,
+		errorConfig: errorConfig,
 	}
 }
 
@@ -29,20 +38,30 @@ func (h *BaseHandler) WriteJSONError(w http.ResponseWriter, r *http.Request, cod
 }
 
 // writeJSONError is the internal method that supports additional details
+// Requests accepting application/problem+json get RFC 7807 problem details instead.
 func (h *BaseHandler) writeJSONError(w http.ResponseWriter, r *http.Request, code string, message string, statusCode int, details map[string]any) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCode)
+	var errorResp map[string]any
+	if h.errorConfig.wantsProblem(r) {
+		w.Header().Set("Content-Type", ProblemContentType)
+		errorResp = h.errorConfig.problemDetails(r, code, message, statusCode, details)
+	} else {
+		w.Header().Set("Content-Type", "application/json")
 
-	// Create base error response
-	errorResp := map[string]any{
-		"error":   code,
-		"message": message,
-	}
+		// Create base error response
+		errorResp = map[string]any{
+			"error":   code,
+			"message": message,
+		}
 
-	// Add details if provided
-	for k, v := range details {
-		errorResp[k] = v
+		// Add details if provided
+		for k, v := range details {
+			errorResp[k] = v
+		}
+	}
+	if h.errorConfig.Format != ErrorFormatProblem {
+		w.Header().Add("Vary", "Accept")
 	}
+	w.WriteHeader(statusCode)
 
 	if err := json.NewEncoder(w).Encode(errorResp); err != nil {
 		h.logger.Error(r.Context(), "failed to encode error response",
@@ -54,10 +73,15 @@ func (h *BaseHandler) writeJSONError(w http.ResponseWriter, r *http.Request, cod
 }
 
 // HandleError is a generic error handler that translates AppError into JSON responses
+// Errors answered with a 5xx status are also sent to the error reporter.
 func (h *BaseHandler) HandleError(w http.ResponseWriter, r *http.Request, err error) {
 	var appErr *apperror.AppError
 
 	if errors.As(err, &appErr) {
+		if appErr.HTTPStatus >= http.StatusInternalServerError {
+			h.reporter.Report(r.Context(), errreport.Report{Err: err, Message: appErr.Message, Request: r})
+		}
+
 		// The error is a known business error
 		details := map[string]any{
 			"business_code": string(appErr.BusinessCode),
@@ -66,14 +90,44 @@ func (h *BaseHandler) HandleError(w http.ResponseWriter, r *http.Request, err er
 			details["context"] = appErr.Details
 		}
 
-		h.writeJSONError(w, r, string(appErr.Code), appErr.Message, appErr.HTTPStatus, details)
+		message := middleware.LocalizedMessage(w, r, appErr.Code, appErr.BusinessCode, appErr.Message)
+		h.writeJSONError(w, r, string(appErr.Code), message, appErr.HTTPStatus, details)
 	} else {
 		// It's an unexpected error. Log it and return a generic 500 response
 		h.logger.Error(r.Context(), "unhandled internal error", "error", err)
-		h.writeJSONError(w, r, "INTERNAL_SERVER_ERROR", "An unexpected error occurred", http.StatusInternalServerError, nil)
+		h.reporter.Report(r.Context(), errreport.Report{Err: err, Message: "unhandled internal error", Request: r})
+		message := middleware.LocalizedMessage(w, r, apperror.CodeInternalError, apperror.BusinessCodeGeneral, "An unexpected error occurred")
+		h.writeJSONError(w, r, "INTERNAL_SERVER_ERROR", message, http.StatusInternalServerError, nil)
 	}
 }
 
+// appErrorToAPI describes an error embedded in a successful response, such as
+// one item's failure in a batch, in the language negotiated for ctx.
+// Unexpected errors are reported generically.
+func appErrorToAPI(ctx context.Context, err error) *api.Error {
+	lang := i18n.FromContext(ctx)
+	var appErr *apperror.AppError
+	if !errors.As(err, &appErr) {
+		message := "An unexpected error occurred"
+		if translated, ok := i18n.ErrorMessage(lang, apperror.CodeInternalError, apperror.BusinessCodeGeneral); ok {
+			message = translated
+		}
+		return &api.Error{Error: "INTERNAL_SERVER_ERROR", Message: message}
+	}
+
+	details := map[string]any{
+		"business_code": string(appErr.BusinessCode),
+	}
+	if appErr.Details != nil {
+		details["context"] = appErr.Details
+	}
+	message := appErr.Message
+	if translated, ok := i18n.ErrorMessage(lang, appErr.Code, appErr.BusinessCode); ok {
+		message = translated
+	}
+	return &api.Error{Error: string(appErr.Code), Message: message, Details: &details}
+}
+
 // WriteJSONResponse writes a successful JSON response
 func (h *BaseHandler) WriteJSONResponse(w http.ResponseWriter, r *http.Request, data interface{}, statusCode int) {
 	w.Header().Set("Content-Type", "application/json")
@@ -87,6 +141,25 @@ func (h *BaseHandler) WriteJSONResponse(w http.ResponseWriter, r *http.Request,
 	}
 }
 
+// WriteSlugRedirect answers a request for a retired slug with a permanent redirect
+// The new location replaces the last path segment of the request with the current slug
+func (h *BaseHandler) WriteSlugRedirect(w http.ResponseWriter, r *http.Request, currentSlug string) {
+	h.writeSlugRedirect(w, r, currentSlug, path.Join(path.Dir(r.URL.Path), currentSlug))
+}
+
+// WriteSubresourceSlugRedirect redirects a request for a resource nested under
+// a retired slug, such as /themes/slug/{slug}/page, to the same resource under
+// the current slug: the second-to-last path segment is the one replaced
+func (h *BaseHandler) WriteSubresourceSlugRedirect(w http.ResponseWriter, r *http.Request, currentSlug string) {
+	parent, name := path.Split(r.URL.Path)
+	h.writeSlugRedirect(w, r, currentSlug, path.Join(path.Dir(path.Clean(parent)), currentSlug, name))
+}
+
+func (h *BaseHandler) writeSlugRedirect(w http.ResponseWriter, r *http.Request, currentSlug, location string) {
+	w.Header().Set("Location", location)
+	h.WriteJSONResponse(w, r, api.SlugRedirect{Slug: currentSlug, Location: location}, http.StatusMovedPermanently)
+}
+
 // ParseUUID parses a UUID from a string and sends an error response if invalid
 func (h *BaseHandler) ParseUUID(w http.ResponseWriter, r *http.Request, value string, paramName string) (uuid.UUID, bool) {
 	parsedUUID, err := uuid.Parse(value)
@@ -106,6 +179,12 @@ func (h *BaseHandler) GetUserIDFromContext(r *http.Request) uuid.UUID {
 	return r.Context().Value(middleware.UserIDKey).(uuid.UUID)
 }
 
+// GetOptionalUserIDFromContext retrieves the user's ID on public endpoints,
+// where the caller may or may not be authenticated.
+func (h *BaseHandler) GetOptionalUserIDFromContext(r *http.Request) (uuid.UUID, bool) {
+	return middleware.GetUserID(r.Context())
+}
+
 // GetUserEmailFromContext retrieves the user's email from the context.
 // It assumes the middleware has already set the email if available.
 func (h *BaseHandler) GetUserEmailFromContext(r *http.Request) (string, bool) {
@@ -6,11 +6,14 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"testing"
 
 	"backend/internal/adapters/rest"
 	"backend/internal/adapters/rest/middleware"
 	"backend/internal/platform/apperror"
+	"backend/internal/platform/errreport"
+	"backend/internal/platform/requestid"
 	"github.com/google/uuid"
 )
 
@@ -22,6 +25,15 @@ func (m *mockLogger) Info(ctx context.Context, msg string, keysAndValues ...inte
 func (m *mockLogger) Warn(ctx context.Context, msg string, keysAndValues ...interface{})  {}
 func (m *mockLogger) Error(ctx context.Context, msg string, keysAndValues ...interface{}) {}
 
+// recordingReporter keeps the errors it is asked to report
+type recordingReporter struct {
+	reports []errreport.Report
+}
+
+func (r *recordingReporter) Report(ctx context.Context, report errreport.Report) {
+	r.reports = append(r.reports, report)
+}
+
 func TestWriteJSONError(t *testing.T) {
 	tests := []struct {
 		name               string
@@ -69,7 +81,7 @@ func TestWriteJSONError(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Create base handler with mock logger
-			handler := rest.NewBaseHandler(&mockLogger{})
+			handler := rest.NewBaseHandler(&mockLogger{}, errreport.Nop{}, rest.ErrorConfig{})
 
 			// Create test request and response recorder
 			req := httptest.NewRequest(http.MethodGet, "/test", nil)
@@ -142,7 +154,7 @@ func TestWriteJSONResponse(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Create base handler with mock logger
-			handler := rest.NewBaseHandler(&mockLogger{})
+			handler := rest.NewBaseHandler(&mockLogger{}, errreport.Nop{}, rest.ErrorConfig{})
 
 			// Create test request and response recorder
 			req := httptest.NewRequest(http.MethodGet, "/test", nil)
@@ -181,6 +193,7 @@ func TestHandleError(t *testing.T) {
 		expectedError      string
 		expectedBizCode    string
 		expectedContext    interface{}
+		expectedReported   bool
 	}{
 		{
 			name: "handles AppError with business code",
@@ -212,6 +225,7 @@ func TestHandleError(t *testing.T) {
 			err:                errors.New("unexpected error"),
 			expectedStatusCode: http.StatusInternalServerError,
 			expectedError:      "INTERNAL_SERVER_ERROR",
+			expectedReported:   true,
 		},
 		{
 			name: "handles wrapped AppError",
@@ -225,13 +239,15 @@ func TestHandleError(t *testing.T) {
 			expectedStatusCode: http.StatusInternalServerError,
 			expectedError:      "INTERNAL_SERVER_ERROR",
 			expectedBizCode:    "GENERAL",
+			expectedReported:   true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Create base handler with mock logger
-			handler := rest.NewBaseHandler(&mockLogger{})
+			reporter := &recordingReporter{}
+			handler := rest.NewBaseHandler(&mockLogger{}, reporter, rest.ErrorConfig{})
 
 			// Create test request and response recorder
 			req := httptest.NewRequest(http.MethodGet, "/test", nil)
@@ -240,6 +256,11 @@ func TestHandleError(t *testing.T) {
 			// Call the method
 			handler.HandleError(rec, req, tt.err)
 
+			// Only 5xx errors are reported
+			if reported := len(reporter.reports) == 1; reported != tt.expectedReported {
+				t.Errorf("expected reported %v, got %d reports", tt.expectedReported, len(reporter.reports))
+			}
+
 			// Check status code
 			if rec.Code != tt.expectedStatusCode {
 				t.Errorf("expected status code %d, got %d", tt.expectedStatusCode, rec.Code)
@@ -281,6 +302,139 @@ func TestHandleError(t *testing.T) {
 	}
 }
 
+func TestHandleErrorProblemDetails(t *testing.T) {
+	notFound := apperror.New(apperror.CodeNotFound, apperror.BusinessCodeUserNotFound, "user not found", http.StatusNotFound)
+
+	serve := func(config rest.ErrorConfig, accept string) *httptest.ResponseRecorder {
+		handler := rest.NewBaseHandler(&mockLogger{}, errreport.Nop{}, config)
+		req := httptest.NewRequest(http.MethodGet, "/test", nil)
+		req = req.WithContext(requestid.WithID(req.Context(), "req-1"))
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		rec := httptest.NewRecorder()
+		handler.HandleError(rec, req, notFound)
+		return rec
+	}
+
+	t.Run("default format without problem in Accept", func(t *testing.T) {
+		rec := serve(rest.ErrorConfig{}, "application/json")
+		if got := rec.Header().Get("Content-Type"); got != "application/json" {
+			t.Errorf("expected application/json, got %s", got)
+		}
+	})
+
+	t.Run("problem details when accepted", func(t *testing.T) {
+		rec := serve(rest.ErrorConfig{}, "application/json;q=0.5, application/problem+json")
+		if got := rec.Header().Get("Content-Type"); got != rest.ProblemContentType {
+			t.Fatalf("expected %s, got %s", rest.ProblemContentType, got)
+		}
+
+		var problem map[string]interface{}
+		if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+			t.Fatalf("failed to parse response body: %v", err)
+		}
+		expected := map[string]interface{}{
+			"type":          "/problems/user-not-found",
+			"title":         "Not Found",
+			"status":        float64(http.StatusNotFound),
+			"detail":        "user not found",
+			"instance":      "req-1",
+			"code":          "NOT_FOUND",
+			"business_code": "USER_NOT_FOUND",
+		}
+		for key, value := range expected {
+			if problem[key] != value {
+				t.Errorf("expected %s %v, got %v", key, value, problem[key])
+			}
+		}
+	})
+
+	t.Run("problem details refused with q=0", func(t *testing.T) {
+		rec := serve(rest.ErrorConfig{}, "application/problem+json;q=0")
+		if got := rec.Header().Get("Content-Type"); got != "application/json" {
+			t.Errorf("expected application/json, got %s", got)
+		}
+	})
+
+	t.Run("configured problem format with custom type base", func(t *testing.T) {
+		rec := serve(rest.ErrorConfig{Format: rest.ErrorFormatProblem, ProblemTypeBase: "https://docs.example.com/problems"}, "")
+
+		var problem map[string]interface{}
+		if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+			t.Fatalf("failed to parse response body: %v", err)
+		}
+		if problem["type"] != "https://docs.example.com/problems/user-not-found" {
+			t.Errorf("unexpected type %v", problem["type"])
+		}
+	})
+}
+
+func TestHandleErrorLocalized(t *testing.T) {
+	serve := func(acceptLanguage string, err error) *httptest.ResponseRecorder {
+		handler := rest.NewBaseHandler(&mockLogger{}, errreport.Nop{}, rest.ErrorConfig{})
+		req := httptest.NewRequest(http.MethodGet, "/test", nil)
+		if acceptLanguage != "" {
+			req.Header.Set("Accept-Language", acceptLanguage)
+		}
+		rec := httptest.NewRecorder()
+		middleware.Language(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handler.HandleError(w, r, err)
+		})).ServeHTTP(rec, req)
+		return rec
+	}
+	decode := func(t *testing.T, rec *httptest.ResponseRecorder) map[string]interface{} {
+		t.Helper()
+		var response map[string]interface{}
+		if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+			t.Fatalf("failed to parse response body: %v", err)
+		}
+		return response
+	}
+	notFound := apperror.New(apperror.CodeNotFound, apperror.BusinessCodeUserNotFound, "user not found", http.StatusNotFound)
+
+	t.Run("message in the accepted language, codes unchanged", func(t *testing.T) {
+		rec := serve("ja-JP,ja;q=0.9,en;q=0.5", notFound)
+		response := decode(t, rec)
+		if response["message"] != "ユーザーが見つかりません" {
+			t.Errorf("unexpected message %v", response["message"])
+		}
+		if response["error"] != "NOT_FOUND" || response["business_code"] != "USER_NOT_FOUND" {
+			t.Errorf("codes changed: %v %v", response["error"], response["business_code"])
+		}
+		if got := rec.Header().Get("Content-Language"); got != "ja" {
+			t.Errorf("expected Content-Language ja, got %q", got)
+		}
+		if got := rec.Header().Values("Vary"); !slices.Contains(got, "Accept-Language") {
+			t.Errorf("expected Vary to name Accept-Language, got %v", got)
+		}
+	})
+
+	t.Run("general errors use the error code's message", func(t *testing.T) {
+		rec := serve("zh-TW", apperror.New(apperror.CodeForbidden, apperror.BusinessCodeGeneral, "cannot edit this post", http.StatusForbidden))
+		if message := decode(t, rec)["message"]; message != "您沒有執行此操作的權限" {
+			t.Errorf("unexpected message %v", message)
+		}
+	})
+
+	t.Run("unexpected errors are localized too", func(t *testing.T) {
+		rec := serve("zh-TW", errors.New("boom"))
+		if message := decode(t, rec)["message"]; message != "發生未預期的錯誤，請稍後再試" {
+			t.Errorf("unexpected message %v", message)
+		}
+	})
+
+	t.Run("English when no supported language is accepted", func(t *testing.T) {
+		rec := serve("fr-FR", notFound)
+		if message := decode(t, rec)["message"]; message != "user not found" {
+			t.Errorf("unexpected message %v", message)
+		}
+		if got := rec.Header().Get("Content-Language"); got != "" {
+			t.Errorf("expected no Content-Language, got %q", got)
+		}
+	})
+}
+
 func TestParseUUID(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -315,7 +469,7 @@ func TestParseUUID(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Create base handler with mock logger
-			handler := rest.NewBaseHandler(&mockLogger{})
+			handler := rest.NewBaseHandler(&mockLogger{}, errreport.Nop{}, rest.ErrorConfig{})
 
 			// Create test request and response recorder
 			req := httptest.NewRequest(http.MethodGet, "/test", nil)
@@ -386,7 +540,7 @@ func TestGetUserIDFromContext(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Create base handler with mock logger
-			handler := rest.NewBaseHandler(&mockLogger{})
+			handler := rest.NewBaseHandler(&mockLogger{}, errreport.Nop{}, rest.ErrorConfig{})
 
 			// Setup context
 			ctx := tt.setupCtx()
@@ -415,3 +569,53 @@ func TestGetUserIDFromContext(t *testing.T) {
 		})
 	}
 }
+
+func TestWriteSlugRedirect(t *testing.T) {
+	tests := []struct {
+		name        string
+		path        string
+		subresource bool
+		location    string
+	}{
+		{
+			name:     "replaces the slug at the end of the path",
+			path:     "/api/v1/posts/slug/old-title",
+			location: "/api/v1/posts/slug/new-title",
+		},
+		{
+			name:        "replaces the slug a subresource is nested under",
+			path:        "/api/v1/themes/slug/old-title/page",
+			subresource: true,
+			location:    "/api/v1/themes/slug/new-title/page",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := rest.NewBaseHandler(&mockLogger{}, errreport.Nop{}, rest.ErrorConfig{})
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			rec := httptest.NewRecorder()
+
+			if tt.subresource {
+				handler.WriteSubresourceSlugRedirect(rec, req, "new-title")
+			} else {
+				handler.WriteSlugRedirect(rec, req, "new-title")
+			}
+
+			if rec.Code != http.StatusMovedPermanently {
+				t.Errorf("expected status code %d, got %d", http.StatusMovedPermanently, rec.Code)
+			}
+			if location := rec.Header().Get("Location"); location != tt.location {
+				t.Errorf("expected Location %s, got %s", tt.location, location)
+			}
+
+			var response map[string]interface{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+				t.Fatalf("failed to parse response body: %v", err)
+			}
+			if response["slug"] != "new-title" || response["location"] != tt.location {
+				t.Errorf("expected slug new-title at %s, got %v", tt.location, response)
+			}
+		})
+	}
+}
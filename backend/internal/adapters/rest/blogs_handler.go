@@ -0,0 +1,121 @@
+package rest
+
+import (
+	"net/http"
+
+	"backend/internal/adapters/api"
+	"backend/internal/blogs/application"
+	"backend/internal/blogs/domain"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// BlogsHandler handles HTTP requests for managing the blogs of a deployment
+type BlogsHandler struct {
+	*BaseHandler
+	service *application.BlogsService
+}
+
+// NewBlogsHandler creates a new blogs handler
+func NewBlogsHandler(base *BaseHandler, service *application.BlogsService) *BlogsHandler {
+	return &BlogsHandler{
+		BaseHandler: base,
+		service:     service,
+	}
+}
+
+// ListBlogs lists every blog in the deployment
+// NOTE: Authorization middleware checks blogs:manage permission before this is called
+func (h *BlogsHandler) ListBlogs(w http.ResponseWriter, r *http.Request) {
+	userID := h.GetUserIDFromContext(r)
+
+	blogs, err := h.service.ListBlogs(r.Context(), userID)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	response := make([]api.Blog, 0, len(blogs))
+	for _, blog := range blogs {
+		response = append(response, domainBlogToAPI(blog))
+	}
+
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
+// CreateBlog adds a blog to the deployment
+// NOTE: Authorization middleware checks blogs:manage permission before this is called
+func (h *BlogsHandler) CreateBlog(w http.ResponseWriter, r *http.Request) {
+	userID := h.GetUserIDFromContext(r)
+
+	var req api.BlogRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	blog, err := h.service.CreateBlog(r.Context(), userID, apiBlogRequestToParams(req))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainBlogToAPI(blog), http.StatusCreated)
+}
+
+// GetBlog retrieves a blog by ID
+// NOTE: Authorization middleware checks blogs:manage permission before this is called
+func (h *BlogsHandler) GetBlog(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	blog, err := h.service.GetBlog(r.Context(), userID, uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainBlogToAPI(blog), http.StatusOK)
+}
+
+// UpdateBlog changes a blog's name, slug and host
+// NOTE: Authorization middleware checks blogs:manage permission before this is called
+func (h *BlogsHandler) UpdateBlog(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	var req api.BlogRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	blog, err := h.service.UpdateBlog(r.Context(), userID, uuid.UUID(id), apiBlogRequestToParams(req))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainBlogToAPI(blog), http.StatusOK)
+}
+
+func apiBlogRequestToParams(req api.BlogRequest) application.BlogParams {
+	params := application.BlogParams{
+		Name: req.Name,
+		Slug: req.Slug,
+	}
+	if req.Host != nil {
+		params.Host = *req.Host
+	}
+	return params
+}
+
+func domainBlogToAPI(blog *domain.Blog) api.Blog {
+	apiBlog := api.Blog{
+		Id:        openapi_types.UUID(blog.ID),
+		Name:      blog.Name,
+		Slug:      blog.Slug,
+		CreatedAt: blog.CreatedAt,
+		UpdatedAt: blog.UpdatedAt,
+	}
+	if blog.Host != "" {
+		apiBlog.Host = &blog.Host
+	}
+	return apiBlog
+}
@@ -0,0 +1,109 @@
+package rest
+
+import (
+	"net/http"
+
+	"backend/internal/adapters/api"
+	"backend/internal/bookmarks/application"
+	"backend/internal/bookmarks/ports"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// BookmarksHandler handles HTTP requests for reading lists
+type BookmarksHandler struct {
+	*BaseHandler
+	service *application.BookmarksService
+}
+
+// NewBookmarksHandler creates a new bookmarks handler
+func NewBookmarksHandler(base *BaseHandler, service *application.BookmarksService) *BookmarksHandler {
+	return &BookmarksHandler{
+		BaseHandler: base,
+		service:     service,
+	}
+}
+
+// BookmarkPost adds a post to the authenticated user's reading list
+// NOTE: Authorization middleware checks bookmarks:manage permission before this is called
+func (h *BookmarksHandler) BookmarkPost(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	if err := h.service.BookmarkPost(r.Context(), userID, uuid.UUID(id)); err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RemoveBookmark removes a post from the authenticated user's reading list
+// NOTE: Authorization middleware checks bookmarks:manage permission before this is called
+func (h *BookmarksHandler) RemoveBookmark(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	if err := h.service.RemoveBookmark(r.Context(), userID, uuid.UUID(id)); err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListMyBookmarks returns the authenticated user's reading list
+// NOTE: Authorization middleware checks bookmarks:manage permission before this is called
+func (h *BookmarksHandler) ListMyBookmarks(w http.ResponseWriter, r *http.Request, params api.ListMyBookmarksParams) {
+	userID := h.GetUserIDFromContext(r)
+
+	// Pagination - convert page-based to offset-based
+	limit := 20
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = *params.Limit
+	}
+	offset := 0
+	if params.Page != nil && *params.Page > 0 {
+		offset = (*params.Page - 1) * limit
+	}
+
+	bookmarks, total, err := h.service.ListBookmarks(r.Context(), userID, limit, offset)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	data := make([]api.Bookmark, len(bookmarks))
+	for i, bookmark := range bookmarks {
+		data[i] = domainBookmarkToAPI(bookmark)
+	}
+
+	response := api.PaginatedBookmarks{
+		Data: data,
+		Meta: api.PaginationMeta{
+			TotalItems:   total,
+			ItemsPerPage: limit,
+			CurrentPage:  (offset / limit) + 1,
+			TotalPages:   (total + limit - 1) / limit,
+		},
+	}
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
+// Helper functions for converting between domain and API types
+
+func domainBookmarkToAPI(bookmark *ports.BookmarkedPost) api.Bookmark {
+	apiBookmark := api.Bookmark{
+		PostId:       openapi_types.UUID(bookmark.PostID),
+		Title:        bookmark.Title,
+		Slug:         bookmark.Slug,
+		Excerpt:      bookmark.Excerpt,
+		AuthorId:     openapi_types.UUID(bookmark.AuthorID),
+		PublishedAt:  bookmark.PublishedAt,
+		BookmarkedAt: bookmark.BookmarkedAt,
+	}
+
+	if bookmark.AuthorName != "" {
+		apiBookmark.AuthorName = &bookmark.AuthorName
+	}
+
+	return apiBookmark
+}
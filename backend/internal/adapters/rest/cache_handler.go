@@ -0,0 +1,59 @@
+package rest
+
+import (
+	"net/http"
+	"strings"
+
+	"backend/internal/adapters/api"
+	"backend/internal/platform/httpcache"
+)
+
+// maxPurgeKeys bounds a single purge request
+const maxPurgeKeys = 1000
+
+// CacheHandler handles HTTP requests for invalidating cached responses
+type CacheHandler struct {
+	*BaseHandler
+	invalidator *httpcache.Invalidator
+}
+
+// NewCacheHandler creates a new cache handler
+func NewCacheHandler(base *BaseHandler, invalidator *httpcache.Invalidator) *CacheHandler {
+	return &CacheHandler{
+		BaseHandler: base,
+		invalidator: invalidator,
+	}
+}
+
+// PurgeCache drops cached responses tagged with the requested surrogate keys
+// NOTE: Authorization middleware checks settings:system permission before this is called
+func (h *CacheHandler) PurgeCache(w http.ResponseWriter, r *http.Request) {
+	var req api.CachePurgeRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	if len(req.Keys) == 0 || len(req.Keys) > maxPurgeKeys {
+		h.WriteJSONError(w, r, "validation_error", "Between 1 and 1000 keys are required", http.StatusBadRequest)
+		return
+	}
+	for _, key := range req.Keys {
+		if key == "" || strings.ContainsFunc(key, isSpace) {
+			h.WriteJSONError(w, r, "validation_error", "Keys must be non-empty and contain no whitespace", http.StatusBadRequest)
+			return
+		}
+	}
+
+	if err := h.invalidator.Purge(r.Context(), req.Keys...); err != nil {
+		h.logger.Error(r.Context(), "failed to purge cached responses", "error", err, "keys", req.Keys)
+		h.WriteJSONError(w, r, "INTERNAL_SERVER_ERROR", "Failed to purge cached responses", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// isSpace reports whether r separates surrogate keys
+func isSpace(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\n' || r == '\r'
+}
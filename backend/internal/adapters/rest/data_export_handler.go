@@ -0,0 +1,106 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"backend/internal/adapters/api"
+	"backend/internal/platform/signedlink"
+	"backend/internal/privacy/application"
+	"backend/internal/privacy/domain"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// DataExportHandler handles HTTP requests for copies of users' own data
+type DataExportHandler struct {
+	*BaseHandler
+	service *application.DataExportService
+	signer  *signedlink.Signer
+}
+
+// NewDataExportHandler creates a new data export handler
+func NewDataExportHandler(base *BaseHandler, service *application.DataExportService, signer *signedlink.Signer) *DataExportHandler {
+	return &DataExportHandler{
+		BaseHandler: base,
+		service:     service,
+		signer:      signer,
+	}
+}
+
+// GetOwnDataExport returns the authenticated user's latest data export, with
+// a freshly signed download link while its archive can be downloaded
+func (h *DataExportHandler) GetOwnDataExport(w http.ResponseWriter, r *http.Request) {
+	userID := h.GetUserIDFromContext(r)
+
+	export, err := h.service.GetOwnExport(r.Context(), userID)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	response := domainDataExportToAPI(export)
+	if export.CheckDownloadable(time.Now()) == nil {
+		link, query, err := h.signer.Issue(string(api.DataExportsDownload), export.ID.String(), userID)
+		if err != nil {
+			h.HandleError(w, r, err)
+			return
+		}
+		response.Download = &api.ActionLink{
+			Action:     api.DataExportsDownload,
+			ResourceId: openapi_types.UUID(export.ID),
+			ExpiresAt:  link.ExpiresAt,
+			Query:      query.Encode(),
+		}
+	}
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
+// RequestOwnDataExport queues an export of the authenticated user's data
+func (h *DataExportHandler) RequestOwnDataExport(w http.ResponseWriter, r *http.Request) {
+	userID := h.GetUserIDFromContext(r)
+
+	export, err := h.service.RequestExport(r.Context(), userID)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+	h.WriteJSONResponse(w, r, domainDataExportToAPI(export), http.StatusAccepted)
+}
+
+// DownloadDataExportViaLink returns the archive of a data export to the user it belongs to
+// NOTE: Signed link middleware verifies the link and sets its user before this is called
+func (h *DataExportHandler) DownloadDataExportViaLink(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, _ api.DownloadDataExportViaLinkParams) {
+	export, archive, err := h.service.GetArchive(r.Context(), h.GetUserIDFromContext(r), uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	filename := fmt.Sprintf("data-export-%s.zip", export.CreatedAt.UTC().Format("20060102-150405"))
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.Header().Set("Content-Length", strconv.Itoa(len(archive)))
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(archive); err != nil {
+		h.logger.Warn(r.Context(), "data export download interrupted", "error", err, "exportID", export.ID)
+	}
+}
+
+func domainDataExportToAPI(export *domain.Export) api.DataExport {
+	response := api.DataExport{
+		Id:          openapi_types.UUID(export.ID),
+		Status:      api.DataExportStatus(export.Status),
+		Size:        export.Size,
+		CreatedAt:   export.CreatedAt,
+		CompletedAt: export.CompletedAt,
+		ExpiresAt:   export.ExpiresAt,
+	}
+	if export.LastError != "" {
+		response.LastError = &export.LastError
+	}
+	return response
+}
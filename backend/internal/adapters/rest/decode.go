@@ -0,0 +1,99 @@
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"backend/internal/adapters/rest/middleware"
+	"backend/internal/platform/apperror"
+)
+
+// DecodeJSON reads the request body into dst and sends an error response if it
+// cannot. The body is capped at middleware.MaxRequestBodyBytes, must hold exactly
+// one JSON value, and may only contain fields dst declares.
+func (h *BaseHandler) DecodeJSON(w http.ResponseWriter, r *http.Request, dst any) bool {
+	body := http.MaxBytesReader(w, r.Body, middleware.MaxRequestBodyBytes)
+	if err := decodeJSONBody(body, dst); err != nil {
+		h.HandleError(w, r, err)
+		return false
+	}
+	return true
+}
+
+// decodeJSONBody decodes a single JSON value strictly, describing what is wrong
+// with the body in an AppError clients can act on
+func decodeJSONBody(body io.Reader, dst any) error {
+	dec := json.NewDecoder(body)
+	dec.DisallowUnknownFields()
+
+	if err := dec.Decode(dst); err != nil {
+		return bodyError(err)
+	}
+	if err := dec.Decode(&struct{}{}); !errors.Is(err, io.EOF) {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			return bodyError(err)
+		}
+		return invalidBody(apperror.BusinessCodeInvalidFormat, "request body must contain a single JSON value")
+	}
+	return nil
+}
+
+// bodyError translates a decoding failure into a validation error
+func bodyError(err error) error {
+	var (
+		maxErr    *http.MaxBytesError
+		syntaxErr *json.SyntaxError
+		typeErr   *json.UnmarshalTypeError
+	)
+	switch {
+	case errors.As(err, &maxErr):
+		return apperror.New(
+			apperror.CodePayloadTooLarge,
+			apperror.BusinessCodeValueTooLong,
+			fmt.Sprintf("request body must not exceed %d bytes", maxErr.Limit),
+			http.StatusRequestEntityTooLarge,
+		)
+	case errors.Is(err, io.EOF):
+		return invalidBody(apperror.BusinessCodeMissingRequiredField, "request body must not be empty")
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		return invalidBody(apperror.BusinessCodeInvalidFormat, "request body contains malformed JSON")
+	case errors.As(err, &syntaxErr):
+		return invalidBody(apperror.BusinessCodeInvalidFormat,
+			fmt.Sprintf("request body contains malformed JSON at offset %d", syntaxErr.Offset))
+	case errors.As(err, &typeErr):
+		if typeErr.Field == "" {
+			return invalidBody(apperror.BusinessCodeInvalidFormat,
+				fmt.Sprintf("request body must be a JSON %s", jsonKind(typeErr.Type.Kind())))
+		}
+		return invalidBody(apperror.BusinessCodeInvalidFormat,
+			fmt.Sprintf("request body field %q has the wrong type", typeErr.Field))
+	case strings.HasPrefix(err.Error(), "json: unknown field "):
+		field := strings.TrimPrefix(err.Error(), "json: unknown field ")
+		return invalidBody(apperror.BusinessCodeInvalidFormat, "request body contains unknown field "+field)
+	default:
+		return invalidBody(apperror.BusinessCodeInvalidFormat, "invalid request body")
+	}
+}
+
+// jsonKind names the JSON type a Go kind decodes from
+func jsonKind(kind reflect.Kind) string {
+	switch kind {
+	case reflect.Struct, reflect.Map:
+		return "object"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	default:
+		return "value"
+	}
+}
+
+// invalidBody creates a validation error about the request body
+func invalidBody(code apperror.BusinessCode, message string) error {
+	return apperror.New(apperror.CodeValidationFailed, code, message, http.StatusBadRequest)
+}
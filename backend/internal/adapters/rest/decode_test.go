@@ -0,0 +1,75 @@
+package rest_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"backend/internal/adapters/rest"
+	"backend/internal/adapters/rest/middleware"
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/errreport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type decodeTarget struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+func decodeBody(t *testing.T, body string) (bool, decodeTarget, *httptest.ResponseRecorder) {
+	t.Helper()
+	h := rest.NewBaseHandler(&mockLogger{}, errreport.Nop{}, rest.ErrorConfig{})
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/themes", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	var dst decodeTarget
+	ok := h.DecodeJSON(rec, req, &dst)
+	return ok, dst, rec
+}
+
+func TestDecodeJSON(t *testing.T) {
+	t.Run("valid body", func(t *testing.T) {
+		ok, dst, _ := decodeBody(t, `{"name": "go", "count": 2}`)
+		assert.True(t, ok)
+		assert.Equal(t, decodeTarget{Name: "go", Count: 2}, dst)
+	})
+
+	tests := []struct {
+		name    string
+		body    string
+		code    apperror.BusinessCode
+		message string
+	}{
+		{"empty body", ``, apperror.BusinessCodeMissingRequiredField, "request body must not be empty"},
+		{"truncated JSON", `{"name": "go"`, apperror.BusinessCodeInvalidFormat, "request body contains malformed JSON"},
+		{"syntax error", `{"name" "go"}`, apperror.BusinessCodeInvalidFormat, "request body contains malformed JSON at offset 9"},
+		{"wrong field type", `{"count": "two"}`, apperror.BusinessCodeInvalidFormat, `request body field "count" has the wrong type`},
+		{"wrong body type", `[1, 2]`, apperror.BusinessCodeInvalidFormat, "request body must be a JSON object"},
+		{"unknown field", `{"name": "go", "admin": true}`, apperror.BusinessCodeInvalidFormat, `request body contains unknown field "admin"`},
+		{"trailing value", `{"name": "go"} {"name": "rust"}`, apperror.BusinessCodeInvalidFormat, "request body must contain a single JSON value"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, _, rec := decodeBody(t, tt.body)
+			assert.False(t, ok)
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+			var resp map[string]any
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, string(apperror.CodeValidationFailed), resp["error"])
+			assert.Equal(t, tt.message, resp["message"])
+			assert.Equal(t, string(tt.code), resp["business_code"])
+		})
+	}
+
+	t.Run("oversized body", func(t *testing.T) {
+		ok, _, rec := decodeBody(t, `{"name": "`+strings.Repeat("a", middleware.MaxRequestBodyBytes)+`"}`)
+		assert.False(t, ok)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+		assert.Contains(t, rec.Body.String(), string(apperror.CodePayloadTooLarge))
+	})
+}
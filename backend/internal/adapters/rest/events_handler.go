@@ -0,0 +1,119 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"backend/internal/adapters/api"
+	"backend/internal/live/application"
+	"backend/internal/live/domain"
+	"github.com/google/uuid"
+)
+
+// HeartbeatInterval is how often an idle event stream sends a comment line,
+// keeping proxies from closing the connection and detecting dead clients
+const HeartbeatInterval = 25 * time.Second
+
+// EventsHandler handles HTTP requests for live event streams
+type EventsHandler struct {
+	*BaseHandler
+	hub *application.Hub
+}
+
+// NewEventsHandler creates a new events handler
+func NewEventsHandler(base *BaseHandler, hub *application.Hub) *EventsHandler {
+	return &EventsHandler{
+		BaseHandler: base,
+		hub:         hub,
+	}
+}
+
+// liveEventData is the data of one message on the stream, the LiveEvent schema
+type liveEventData struct {
+	Type       domain.MessageType `json:"type"`
+	ResourceID uuid.UUID          `json:"resourceId"`
+	ActorID    uuid.UUID          `json:"actorId"`
+	OccurredAt time.Time          `json:"occurredAt"`
+}
+
+// StreamEvents streams changes to the caller's posts and themes as Server-Sent Events
+// until the client disconnects or the server shuts down
+func (h *EventsHandler) StreamEvents(w http.ResponseWriter, r *http.Request, params api.StreamEventsParams) {
+	userID := h.GetUserIDFromContext(r)
+
+	var topics []domain.Topic
+	if params.Topics != nil {
+		for _, name := range *params.Topics {
+			topic, err := domain.ParseTopic(string(name))
+			if err != nil {
+				h.WriteJSONError(w, r, "validation_error", err.Error(), http.StatusBadRequest)
+				return
+			}
+			topics = append(topics, topic)
+		}
+	}
+
+	// The server's write timeout would cut the stream off; it runs until the client leaves
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		h.logger.Error(r.Context(), "event stream not supported by the connection", "error", err)
+		h.WriteJSONError(w, r, "internal_server_error", "Streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	sub, err := h.hub.Connect(r.Context(), userID, topics)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+	defer h.hub.Disconnect(sub)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no") // Stop nginx-style proxies from buffering the stream
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(HeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case msg, ok := <-sub.Messages():
+			if !ok {
+				return
+			}
+			if err := writeLiveEvent(w, msg); err != nil {
+				return
+			}
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// writeLiveEvent writes one message in the event stream format
+func writeLiveEvent(w http.ResponseWriter, msg domain.Message) error {
+	data, err := json.Marshal(liveEventData{
+		Type:       msg.Type,
+		ResourceID: msg.ResourceID,
+		ActorID:    msg.ActorID,
+		OccurredAt: msg.OccurredAt,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", msg.Type, data)
+	return err
+}
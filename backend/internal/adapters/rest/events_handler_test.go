@@ -0,0 +1,33 @@
+package rest
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"backend/internal/live/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteLiveEvent(t *testing.T) {
+	postID := uuid.MustParse("3f0c8a52-7d4e-4f1b-9a63-0e2d8c1b5a77")
+	actorID := uuid.MustParse("9b2e4d61-1c3a-4e8f-b7d5-6a0f2c9e8d13")
+	rec := httptest.NewRecorder()
+
+	err := writeLiveEvent(rec, domain.Message{
+		Type:        domain.MessagePostPublished,
+		RecipientID: uuid.New(),
+		ResourceID:  postID,
+		ActorID:     actorID,
+		OccurredAt:  time.Date(2025, 8, 20, 10, 15, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		"event: post.published\n"+
+			`data: {"type":"post.published","resourceId":"3f0c8a52-7d4e-4f1b-9a63-0e2d8c1b5a77","actorId":"9b2e4d61-1c3a-4e8f-b7d5-6a0f2c9e8d13","occurredAt":"2025-08-20T10:15:00Z"}`+"\n\n",
+		rec.Body.String(),
+	)
+}
@@ -0,0 +1,117 @@
+package rest
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"backend/internal/adapters/api"
+	"backend/internal/adapters/rest/middleware"
+	"backend/internal/export/application"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// ExportHandler handles HTTP requests for site exports
+type ExportHandler struct {
+	*BaseHandler
+	service *application.ExportService
+}
+
+// NewExportHandler creates a new export handler
+func NewExportHandler(base *BaseHandler, service *application.ExportService) *ExportHandler {
+	return &ExportHandler{
+		BaseHandler: base,
+		service:     service,
+	}
+}
+
+// ExportContent streams a zip archive of the current blog's content
+// NOTE: Authorization middleware checks settings:system permission before this is called
+func (h *ExportHandler) ExportContent(w http.ResponseWriter, r *http.Request) {
+	userID := h.GetUserIDFromContext(r)
+
+	// Large exports outlast the server's write timeout, so lift it for this response
+	if err := http.NewResponseController(w).SetWriteDeadline(time.Time{}); err != nil {
+		h.logger.Warn(r.Context(), "failed to clear write deadline for export", "error", err)
+	}
+
+	filename := fmt.Sprintf("blog-export-%s.zip", time.Now().UTC().Format("20060102-150405"))
+	out := &attachmentWriter{w: w, contentType: "application/zip", filename: filename}
+
+	if err := h.service.ExportArchive(r.Context(), userID, out); err != nil {
+		if !out.started {
+			h.HandleError(w, r, err)
+			return
+		}
+		// The status line is already sent; the client sees a truncated archive
+		h.logger.Error(r.Context(), "export aborted mid-stream", "error", err)
+	}
+}
+
+// ExportPost returns a zip archive of one post
+// NOTE: Authorization middleware checks posts:update:own permission before this is called
+func (h *ExportHandler) ExportPost(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	filename := fmt.Sprintf("post-%s.zip", uuid.UUID(id))
+	out := &attachmentWriter{w: w, contentType: "application/zip", filename: filename}
+
+	if err := h.service.ExportPost(r.Context(), userID, uuid.UUID(id), out); err != nil {
+		if !out.started {
+			h.HandleError(w, r, err)
+			return
+		}
+		h.logger.Error(r.Context(), "post export aborted mid-stream", "error", err)
+	}
+}
+
+// ImportPost creates a draft from an uploaded post archive
+// NOTE: Authorization middleware checks posts:create permission before this is called
+func (h *ExportHandler) ImportPost(w http.ResponseWriter, r *http.Request) {
+	userID := h.GetUserIDFromContext(r)
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, middleware.MaxRequestBodyBytes))
+	if err != nil {
+		h.HandleError(w, r, bodyError(err))
+		return
+	}
+
+	imported, err := h.service.ImportPost(r.Context(), userID, data)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	media := imported.Media
+	if media == nil {
+		media = []string{}
+	}
+	response := api.PostImportResult{
+		Id:    openapi_types.UUID(imported.ID),
+		Slug:  imported.Slug,
+		Media: media,
+	}
+	h.WriteJSONResponse(w, r, response, http.StatusCreated)
+}
+
+// attachmentWriter sends download headers on the first write,
+// so an error raised before any output can still become a JSON error response
+type attachmentWriter struct {
+	w           http.ResponseWriter
+	contentType string
+	filename    string
+	started     bool
+}
+
+// Write implements io.Writer
+func (a *attachmentWriter) Write(p []byte) (int, error) {
+	if !a.started {
+		a.started = true
+		a.w.Header().Set("Content-Type", a.contentType)
+		a.w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", a.filename))
+		a.w.WriteHeader(http.StatusOK)
+	}
+	return a.w.Write(p)
+}
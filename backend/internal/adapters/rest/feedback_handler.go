@@ -0,0 +1,151 @@
+package rest
+
+import (
+	"net/http"
+
+	"backend/internal/adapters/api"
+	"backend/internal/feedback/application"
+	"backend/internal/feedback/domain"
+	"backend/internal/feedback/ports"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// FeedbackHandler handles HTTP requests for contact form messages
+type FeedbackHandler struct {
+	*BaseHandler
+	service *application.FeedbackService
+}
+
+// NewFeedbackHandler creates a new feedback handler
+func NewFeedbackHandler(base *BaseHandler, service *application.FeedbackService) *FeedbackHandler {
+	return &FeedbackHandler{
+		BaseHandler: base,
+		service:     service,
+	}
+}
+
+// SubmitFeedback takes in a message sent through the contact form
+// NOTE: Public endpoint - no authorization required
+func (h *FeedbackHandler) SubmitFeedback(w http.ResponseWriter, r *http.Request) {
+	var req api.FeedbackRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	params := application.SubmitParams{
+		Name:      req.Name,
+		Email:     string(req.Email),
+		Message:   req.Message,
+		UserAgent: r.UserAgent(),
+		Referrer:  r.Referer(),
+	}
+	if req.Subject != nil {
+		params.Subject = *req.Subject
+	}
+
+	if err := h.service.SubmitFeedback(r.Context(), params); err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// ListFeedback returns the blog's contact form messages
+// NOTE: Authorization middleware checks settings:blog permission before this is called
+func (h *FeedbackHandler) ListFeedback(w http.ResponseWriter, r *http.Request, params api.ListFeedbackParams) {
+	userID := h.GetUserIDFromContext(r)
+
+	// Pagination - convert page-based to offset-based
+	limit := 20
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = *params.Limit
+	}
+	offset := 0
+	if params.Page != nil && *params.Page > 0 {
+		offset = (*params.Page - 1) * limit
+	}
+
+	filter := ports.ListFilter{Limit: limit, Offset: offset}
+	if params.Status != nil {
+		status := domain.Status(*params.Status)
+		filter.Status = &status
+	}
+	if params.Spam != nil {
+		filter.Spam = *params.Spam
+	}
+
+	messages, total, err := h.service.ListFeedback(r.Context(), userID, filter)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	data := make([]api.Feedback, len(messages))
+	for i, feedback := range messages {
+		data[i] = domainFeedbackToAPI(feedback)
+	}
+
+	response := api.PaginatedFeedback{
+		Data: data,
+		Meta: api.PaginationMeta{
+			TotalItems:   total,
+			ItemsPerPage: limit,
+			CurrentPage:  (offset / limit) + 1,
+			TotalPages:   (total + limit - 1) / limit,
+		},
+	}
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
+// GetFeedback retrieves a contact form message by ID
+// NOTE: Authorization middleware checks settings:blog permission before this is called
+func (h *FeedbackHandler) GetFeedback(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	feedback, err := h.service.GetFeedback(r.Context(), userID, uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainFeedbackToAPI(feedback), http.StatusOK)
+}
+
+// TriageFeedback moves a contact form message to a new status
+// NOTE: Authorization middleware checks settings:blog permission before this is called
+func (h *FeedbackHandler) TriageFeedback(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	var req api.TriageFeedbackRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	feedback, err := h.service.TriageFeedback(r.Context(), userID, uuid.UUID(id), domain.Status(req.Status))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainFeedbackToAPI(feedback), http.StatusOK)
+}
+
+func domainFeedbackToAPI(feedback *domain.Feedback) api.Feedback {
+	response := api.Feedback{
+		Id:        openapi_types.UUID(feedback.ID),
+		Name:      feedback.Name,
+		Email:     openapi_types.Email(feedback.Email),
+		Subject:   feedback.Subject,
+		Message:   feedback.Message,
+		Status:    api.FeedbackStatus(feedback.Status),
+		Spam:      feedback.IsSpam(),
+		CreatedAt: feedback.CreatedAt,
+		UpdatedAt: feedback.UpdatedAt,
+	}
+	if feedback.IsSpam() {
+		response.SpamReason = &feedback.SpamReason
+	}
+	return response
+}
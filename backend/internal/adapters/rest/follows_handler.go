@@ -0,0 +1,93 @@
+package rest
+
+import (
+	"net/http"
+
+	"backend/internal/adapters/api"
+	"backend/internal/follows/application"
+	"backend/internal/follows/ports"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// FollowsHandler handles HTTP requests for following authors
+type FollowsHandler struct {
+	*BaseHandler
+	service *application.FollowsService
+}
+
+// NewFollowsHandler creates a new follows handler
+func NewFollowsHandler(base *BaseHandler, service *application.FollowsService) *FollowsHandler {
+	return &FollowsHandler{
+		BaseHandler: base,
+		service:     service,
+	}
+}
+
+// FollowUser makes the authenticated user follow another user
+// NOTE: Authorization middleware checks follows:manage permission before this is called
+func (h *FollowsHandler) FollowUser(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	stats, err := h.service.Follow(r.Context(), userID, uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainFollowStatsToAPI(stats), http.StatusOK)
+}
+
+// ConfirmFollowViaLink confirms a subscription: the user the link was issued to follows the author
+// NOTE: Signed link middleware verifies the link and sets its user before this is called
+func (h *FollowsHandler) ConfirmFollowViaLink(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, _ api.ConfirmFollowViaLinkParams) {
+	stats, err := h.service.Follow(r.Context(), h.GetUserIDFromContext(r), uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainFollowStatsToAPI(stats), http.StatusOK)
+}
+
+// UnfollowUser makes the authenticated user stop following another user
+// NOTE: Authorization middleware checks follows:manage permission before this is called
+func (h *FollowsHandler) UnfollowUser(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	stats, err := h.service.Unfollow(r.Context(), userID, uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainFollowStatsToAPI(stats), http.StatusOK)
+}
+
+// GetFollowStats returns follower and following counts for a user
+// NOTE: Public endpoint - no authorization required
+func (h *FollowsHandler) GetFollowStats(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var viewerID *uuid.UUID
+	if userID, ok := h.GetOptionalUserIDFromContext(r); ok {
+		viewerID = &userID
+	}
+
+	stats, err := h.service.GetFollowStats(r.Context(), uuid.UUID(id), viewerID)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainFollowStatsToAPI(stats), http.StatusOK)
+}
+
+// Helper functions for converting between domain and API types
+
+func domainFollowStatsToAPI(stats *ports.FollowStats) api.FollowStats {
+	return api.FollowStats{
+		UserId:         openapi_types.UUID(stats.UserID),
+		FollowerCount:  stats.FollowerCount,
+		FollowingCount: stats.FollowingCount,
+		Following:      stats.Following,
+	}
+}
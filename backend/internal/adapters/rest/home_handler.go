@@ -0,0 +1,84 @@
+package rest
+
+import (
+	"net/http"
+
+	"backend/internal/adapters/api"
+	"backend/internal/home/application"
+	"backend/internal/platform/httpcache"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// HomeHandler handles HTTP requests for the home page
+type HomeHandler struct {
+	*BaseHandler
+	service *application.HomeService
+}
+
+// NewHomeHandler creates a new home handler
+func NewHomeHandler(base *BaseHandler, service *application.HomeService) *HomeHandler {
+	return &HomeHandler{
+		BaseHandler: base,
+		service:     service,
+	}
+}
+
+// GetHome returns the sections of the home page
+// NOTE: Public endpoint - no authorization required
+func (h *HomeHandler) GetHome(w http.ResponseWriter, r *http.Request) {
+	home, err := h.service.GetHome(r.Context())
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	// Purged with the listings whenever a post or theme changes
+	httpcache.AddSurrogateKeys(w.Header(), httpcache.PostsKey, httpcache.ThemesKey)
+	h.WriteJSONResponse(w, r, homeToAPI(home), http.StatusOK)
+}
+
+func homeToAPI(home *application.Home) api.Home {
+	themes := make([]api.ThemeSummary, 0, len(home.Themes))
+	for _, theme := range home.Themes {
+		themes = append(themes, api.ThemeSummary{
+			Id:           openapi_types.UUID(theme.ID),
+			Name:         theme.Name,
+			Description:  theme.Description,
+			Slug:         theme.Slug,
+			Status:       api.ThemeStatusActive,
+			IsActive:     true,
+			CuratorId:    openapi_types.UUID(theme.CuratorID),
+			CreatedAt:    theme.CreatedAt,
+			ArticleCount: theme.ArticleCount,
+		})
+	}
+
+	return api.Home{
+		Featured: homePostsToAPI(home.Featured),
+		Latest:   homePostsToAPI(home.Latest),
+		Themes:   themes,
+		Trending: homePostsToAPI(home.Trending),
+	}
+}
+
+func homePostsToAPI(posts []*application.Post) []api.HomePost {
+	items := make([]api.HomePost, 0, len(posts))
+	for _, post := range posts {
+		item := api.HomePost{
+			Id:      openapi_types.UUID(post.ID),
+			Title:   post.Title,
+			Slug:    post.Slug,
+			Excerpt: post.Excerpt,
+			Author: api.AuthorSummary{
+				Id:       openapi_types.UUID(post.AuthorID),
+				Username: post.AuthorName,
+			},
+			PublishedAt: post.PublishedAt,
+		}
+		if post.Views > 0 {
+			item.Views = &post.Views
+		}
+		items = append(items, item)
+	}
+	return items
+}
@@ -0,0 +1,106 @@
+package rest
+
+import (
+	"net/http"
+
+	"backend/internal/adapters/api"
+	"backend/internal/impersonation/application"
+	"backend/internal/impersonation/domain"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// ImpersonationHandler handles HTTP requests for impersonation sessions
+type ImpersonationHandler struct {
+	*BaseHandler
+	service *application.SessionService
+}
+
+// NewImpersonationHandler creates a new impersonation handler
+func NewImpersonationHandler(base *BaseHandler, service *application.SessionService) *ImpersonationHandler {
+	return &ImpersonationHandler{
+		BaseHandler: base,
+		service:     service,
+	}
+}
+
+// StartImpersonation starts a session as another user and returns its token once
+// NOTE: Authorization middleware checks authz:impersonate permission before this is called
+func (h *ImpersonationHandler) StartImpersonation(w http.ResponseWriter, r *http.Request) {
+	userID := h.GetUserIDFromContext(r)
+
+	var req api.StartImpersonationRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	session, token, err := h.service.StartSession(r.Context(), userID, uuid.UUID(req.TargetId), req.Reason)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	started := domainImpersonationSessionToAPI(session)
+	h.WriteJSONResponse(w, r, api.StartedImpersonationSession{
+		Id:             started.Id,
+		ImpersonatorId: started.ImpersonatorId,
+		TargetId:       started.TargetId,
+		Reason:         started.Reason,
+		CreatedAt:      started.CreatedAt,
+		ExpiresAt:      started.ExpiresAt,
+		Token:          token,
+	}, http.StatusCreated)
+}
+
+// EndImpersonation ends one of the authenticated user's sessions
+// NOTE: Authorization middleware checks authz:impersonate permission before this is called
+func (h *ImpersonationHandler) EndImpersonation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	session, err := h.service.EndSession(r.Context(), userID, uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainImpersonationSessionToAPI(session), http.StatusOK)
+}
+
+// ListImpersonationActions returns the audit trail of a session
+// NOTE: Authorization middleware checks authz:audit:view permission before this is called
+func (h *ImpersonationHandler) ListImpersonationActions(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	actions, err := h.service.ListActions(r.Context(), userID, uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	response := make([]api.ImpersonatedAction, len(actions))
+	for i, action := range actions {
+		response[i] = api.ImpersonatedAction{
+			ImpersonatorId: openapi_types.UUID(action.ImpersonatorID),
+			TargetId:       openapi_types.UUID(action.TargetID),
+			Method:         action.Method,
+			Route:          action.Route,
+			Path:           action.Path,
+			Status:         action.Status,
+			RequestId:      action.RequestID,
+			OccurredAt:     action.OccurredAt,
+		}
+	}
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
+func domainImpersonationSessionToAPI(session *domain.Session) api.ImpersonationSession {
+	return api.ImpersonationSession{
+		Id:             openapi_types.UUID(session.ID),
+		ImpersonatorId: openapi_types.UUID(session.ImpersonatorID),
+		TargetId:       openapi_types.UUID(session.TargetID),
+		Reason:         session.Reason,
+		CreatedAt:      session.CreatedAt,
+		ExpiresAt:      session.ExpiresAt,
+		EndedAt:        session.EndedAt,
+	}
+}
@@ -0,0 +1,71 @@
+package rest
+
+import (
+	"net/http"
+
+	"backend/internal/adapters/api"
+	"backend/internal/integrity/application"
+	"backend/internal/integrity/domain"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// IntegrityHandler handles HTTP requests for data consistency checks
+type IntegrityHandler struct {
+	*BaseHandler
+	service *application.IntegrityService
+}
+
+// NewIntegrityHandler creates a new integrity handler
+func NewIntegrityHandler(base *BaseHandler, service *application.IntegrityService) *IntegrityHandler {
+	return &IntegrityHandler{
+		BaseHandler: base,
+		service:     service,
+	}
+}
+
+// CheckIntegrity reports broken cross-module references and optionally repairs them
+// NOTE: Authorization middleware checks settings:system permission before this is called
+func (h *IntegrityHandler) CheckIntegrity(w http.ResponseWriter, r *http.Request, params api.CheckIntegrityParams) {
+	userID := h.GetUserIDFromContext(r)
+	repair := params.Repair != nil && *params.Repair
+
+	report, err := h.service.Check(r.Context(), userID, repair)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, integrityReportToAPI(report), http.StatusOK)
+}
+
+func integrityReportToAPI(report *domain.Report) api.IntegrityReport {
+	checks := make([]api.IntegrityCheckResult, 0, len(report.Results))
+	for _, result := range report.Results {
+		findings := make([]api.IntegrityFinding, 0, len(result.Findings))
+		for _, finding := range result.Findings {
+			item := api.IntegrityFinding{OwnerId: openapi_types.UUID(finding.OwnerID)}
+			if finding.MissingID != uuid.Nil {
+				missingID := openapi_types.UUID(finding.MissingID)
+				item.MissingId = &missingID
+			}
+			if finding.Detail != "" {
+				item.Detail = &finding.Detail
+			}
+			findings = append(findings, item)
+		}
+		checks = append(checks, api.IntegrityCheckResult{
+			Check:       api.IntegrityCheckResultCheck(result.Check),
+			Description: result.Check.Description(),
+			Findings:    findings,
+			Repaired:    result.Repaired,
+		})
+	}
+
+	return api.IntegrityReport{
+		CheckedAt:  report.CheckedAt,
+		Repair:     report.Repair,
+		Consistent: report.Consistent(),
+		Checks:     checks,
+	}
+}
@@ -0,0 +1,107 @@
+package rest
+
+import (
+	"net/http"
+
+	"backend/internal/adapters/api"
+	"backend/internal/linkreports/application"
+	"backend/internal/linkreports/domain"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// LinkReportsHandler handles HTTP requests for the broken link reports
+type LinkReportsHandler struct {
+	*BaseHandler
+	service *application.LinkReportService
+}
+
+// NewLinkReportsHandler creates a new link reports handler
+func NewLinkReportsHandler(base *BaseHandler, service *application.LinkReportService) *LinkReportsHandler {
+	return &LinkReportsHandler{
+		BaseHandler: base,
+		service:     service,
+	}
+}
+
+// GetPostLinkReport returns a post's outbound links as last checked
+// NOTE: Authorization middleware checks the user may update the post before this is called
+func (h *LinkReportsHandler) GetPostLinkReport(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	report, err := h.service.GetPostReport(r.Context(), userID, uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	links := make([]api.PostLink, len(report.Links))
+	for i, link := range report.Links {
+		links[i] = postLinkToAPI(link)
+	}
+	h.WriteJSONResponse(w, r, api.PostLinkReport{
+		PostId:      id,
+		CheckedAt:   report.CheckedAt(),
+		BrokenCount: report.BrokenCount(),
+		Links:       links,
+	}, http.StatusOK)
+}
+
+// ListBrokenLinks returns a page of the blog's broken links
+// NOTE: Authorization middleware checks posts:update:any permission before this is called
+func (h *LinkReportsHandler) ListBrokenLinks(w http.ResponseWriter, r *http.Request, params api.ListBrokenLinksParams) {
+	userID := h.GetUserIDFromContext(r)
+
+	// Pagination - convert page-based to offset-based
+	limit := 20
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = *params.Limit
+	}
+	offset := 0
+	if params.Page != nil && *params.Page > 0 {
+		offset = (*params.Page - 1) * limit
+	}
+
+	broken, total, err := h.service.ListBroken(r.Context(), userID, limit, offset)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	data := make([]api.BrokenLink, len(broken))
+	for i, b := range broken {
+		data[i] = api.BrokenLink{
+			PostId:    openapi_types.UUID(b.PostID),
+			PostTitle: b.PostTitle,
+			PostSlug:  b.PostSlug,
+			AuthorId:  openapi_types.UUID(b.AuthorID),
+			Link:      postLinkToAPI(b.Link),
+		}
+	}
+
+	response := api.PaginatedBrokenLinks{
+		Data: data,
+		Meta: api.PaginationMeta{
+			TotalItems:   total,
+			ItemsPerPage: limit,
+			CurrentPage:  (offset / limit) + 1,
+			TotalPages:   (total + limit - 1) / limit,
+		},
+	}
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
+func postLinkToAPI(link domain.Link) api.PostLink {
+	item := api.PostLink{
+		Url:       link.URL,
+		Broken:    link.Broken,
+		CheckedAt: link.CheckedAt,
+	}
+	if link.StatusCode != 0 {
+		item.StatusCode = &link.StatusCode
+	}
+	if link.Error != "" {
+		item.Error = &link.Error
+	}
+	return item
+}
@@ -0,0 +1,145 @@
+package rest
+
+import (
+	"net/http"
+
+	"backend/internal/adapters/api"
+	"backend/internal/adapters/rest/middleware"
+	"backend/internal/mentions/application"
+	"backend/internal/mentions/domain"
+	"backend/internal/mentions/ports"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// MentionsHandler handles HTTP requests for webmentions
+type MentionsHandler struct {
+	*BaseHandler
+	service *application.MentionsService
+}
+
+// NewMentionsHandler creates a new mentions handler
+func NewMentionsHandler(base *BaseHandler, service *application.MentionsService) *MentionsHandler {
+	return &MentionsHandler{
+		BaseHandler: base,
+		service:     service,
+	}
+}
+
+// ReceiveWebmention accepts a webmention for verification. Webmention senders
+// post a form rather than JSON, as the specification requires.
+// NOTE: Public endpoint - no authorization required
+func (h *MentionsHandler) ReceiveWebmention(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, middleware.MaxRequestBodyBytes)
+	if err := r.ParseForm(); err != nil {
+		h.HandleError(w, r, bodyError(err))
+		return
+	}
+
+	source := r.PostForm.Get("source")
+	target := r.PostForm.Get("target")
+	if err := h.service.Receive(r.Context(), source, target); err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// ListPostMentions returns the verified webmentions of a post
+// NOTE: Public endpoint - unapproved mentions need comments:moderate, checked by the service
+func (h *MentionsHandler) ListPostMentions(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params api.ListPostMentionsParams) {
+	var viewerID *uuid.UUID
+	if userID, ok := h.GetOptionalUserIDFromContext(r); ok {
+		viewerID = &userID
+	}
+
+	filter := mentionsFilter(params.Status, params.Page, params.Limit)
+	mentions, total, err := h.service.ListPostMentions(r.Context(), viewerID, uuid.UUID(id), filter)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, paginatedMentionsToAPI(mentions, total, filter), http.StatusOK)
+}
+
+// ListMentions returns the blog's verified webmentions for moderation
+// NOTE: Authorization middleware checks comments:moderate permission before this is called
+func (h *MentionsHandler) ListMentions(w http.ResponseWriter, r *http.Request, params api.ListMentionsParams) {
+	userID := h.GetUserIDFromContext(r)
+
+	filter := mentionsFilter(params.Status, params.Page, params.Limit)
+	mentions, total, err := h.service.ListMentions(r.Context(), userID, filter)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, paginatedMentionsToAPI(mentions, total, filter), http.StatusOK)
+}
+
+// ModerateMention approves or rejects a webmention of a post
+// NOTE: Authorization middleware checks comments:moderate permission before this is called
+func (h *MentionsHandler) ModerateMention(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, mentionId openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	var req api.ModerateMentionRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	mention, err := h.service.ModerateMention(r.Context(), userID, uuid.UUID(id), uuid.UUID(mentionId), domain.Status(req.Status))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainMentionToAPI(mention), http.StatusOK)
+}
+
+// mentionsFilter converts the listing query parameters, page-based, to an offset-based filter
+func mentionsFilter(status *api.MentionStatus, page, limit *int) ports.ListFilter {
+	filter := ports.ListFilter{Limit: 20}
+	if limit != nil && *limit > 0 {
+		filter.Limit = *limit
+	}
+	if page != nil && *page > 0 {
+		filter.Offset = (*page - 1) * filter.Limit
+	}
+	if status != nil {
+		s := domain.Status(*status)
+		filter.Status = &s
+	}
+	return filter
+}
+
+func paginatedMentionsToAPI(mentions []*domain.Mention, total int, filter ports.ListFilter) api.PaginatedMentions {
+	data := make([]api.Mention, len(mentions))
+	for i, mention := range mentions {
+		data[i] = domainMentionToAPI(mention)
+	}
+
+	return api.PaginatedMentions{
+		Data: data,
+		Meta: api.PaginationMeta{
+			TotalItems:   total,
+			ItemsPerPage: filter.Limit,
+			CurrentPage:  (filter.Offset / filter.Limit) + 1,
+			TotalPages:   (total + filter.Limit - 1) / filter.Limit,
+		},
+	}
+}
+
+func domainMentionToAPI(mention *domain.Mention) api.Mention {
+	return api.Mention{
+		Id:         openapi_types.UUID(mention.ID),
+		PostId:     openapi_types.UUID(mention.PostID),
+		Source:     mention.Source,
+		Target:     mention.Target,
+		Title:      mention.Title,
+		Status:     api.MentionStatus(mention.Status),
+		ReceivedAt: mention.ReceivedAt,
+		VerifiedAt: mention.VerifiedAt,
+	}
+}
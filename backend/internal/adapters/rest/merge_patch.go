@@ -0,0 +1,102 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"backend/internal/adapters/rest/middleware"
+	"backend/internal/platform/apperror"
+)
+
+// mergePatch is a JSON Merge Patch (RFC 7386) document. Members that are absent
+// leave a field alone and null members clear it, a distinction the generated
+// request types cannot express, so PATCH handlers read the raw members instead.
+type mergePatch map[string]json.RawMessage
+
+// DecodeMergePatch reads the request body as a merge patch and sends an error
+// response if it cannot, applying the same limits as DecodeJSON
+func (h *BaseHandler) DecodeMergePatch(w http.ResponseWriter, r *http.Request) (mergePatch, bool) {
+	patch, err := decodeMergePatch(http.MaxBytesReader(w, r.Body, middleware.MaxRequestBodyBytes))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return nil, false
+	}
+	return patch, true
+}
+
+// decodeMergePatch reads a merge patch, which must be a JSON object
+func decodeMergePatch(body io.Reader) (mergePatch, error) {
+	var patch mergePatch
+	if err := decodeJSONBody(body, &patch); err != nil {
+		return nil, err
+	}
+	if patch == nil {
+		return nil, invalidBody(apperror.BusinessCodeInvalidFormat, "merge patch must be a JSON object")
+	}
+	return patch, nil
+}
+
+// isNull reports whether a member is present with a null value
+func isNull(raw json.RawMessage) bool {
+	return bytes.Equal(bytes.TrimSpace(raw), []byte("null"))
+}
+
+// stringField returns nil when the member is absent and "" when it is null
+func (p mergePatch) stringField(name string) (*string, error) {
+	raw, ok := p[name]
+	if !ok {
+		return nil, nil
+	}
+
+	value := ""
+	if !isNull(raw) {
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return nil, fmt.Errorf("%s must be a string", name)
+		}
+	}
+	return &value, nil
+}
+
+// dateField returns nil when the member is absent and the zero time when it is null.
+// Dates use the full-date form of RFC 3339, e.g. "2024-02-01".
+func (p mergePatch) dateField(name string) (*time.Time, error) {
+	raw, ok := p[name]
+	if !ok {
+		return nil, nil
+	}
+
+	var value time.Time
+	if !isNull(raw) {
+		var text string
+		if err := json.Unmarshal(raw, &text); err != nil {
+			return nil, fmt.Errorf("%s must be a date", name)
+		}
+		parsed, err := time.Parse(time.DateOnly, text)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be a date", name)
+		}
+		value = parsed
+	}
+	return &value, nil
+}
+
+// objectField returns the nested patch of an object member. present is false when
+// the member is absent; a null member is present with an empty patch and cleared set.
+func (p mergePatch) objectField(name string) (patch mergePatch, present bool, cleared bool, err error) {
+	raw, ok := p[name]
+	if !ok {
+		return nil, false, false, nil
+	}
+	if isNull(raw) {
+		return mergePatch{}, true, true, nil
+	}
+
+	if err := json.Unmarshal(raw, &patch); err != nil || patch == nil {
+		return nil, false, false, fmt.Errorf("%s must be an object", name)
+	}
+	return patch, true, false, nil
+}
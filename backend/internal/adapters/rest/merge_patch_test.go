@@ -0,0 +1,90 @@
+package rest
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergePatchToPostParams(t *testing.T) {
+	t.Run("absent members are left alone", func(t *testing.T) {
+		patch, err := decodeMergePatch(strings.NewReader(`{"title": "New title"}`))
+		require.NoError(t, err)
+
+		params, err := mergePatchToPostParams(patch)
+		require.NoError(t, err)
+		require.NotNil(t, params.Title)
+		assert.Equal(t, "New title", *params.Title)
+		assert.Nil(t, params.Content)
+		assert.Nil(t, params.Excerpt)
+		assert.Nil(t, params.SEO)
+	})
+
+	t.Run("null clears a member", func(t *testing.T) {
+		patch, err := decodeMergePatch(strings.NewReader(`{"excerpt": null, "seo": {"metaTitle": null, "ogImageUrl": "https://cdn.example.com/a.png"}}`))
+		require.NoError(t, err)
+
+		params, err := mergePatchToPostParams(patch)
+		require.NoError(t, err)
+		require.NotNil(t, params.Excerpt)
+		assert.Empty(t, *params.Excerpt)
+		require.NotNil(t, params.SEO)
+		require.NotNil(t, params.SEO.MetaTitle)
+		assert.Empty(t, *params.SEO.MetaTitle)
+		assert.Equal(t, "https://cdn.example.com/a.png", *params.SEO.OGImageURL)
+		assert.Nil(t, params.SEO.MetaDescription)
+	})
+
+	t.Run("null seo clears every SEO field", func(t *testing.T) {
+		patch, err := decodeMergePatch(strings.NewReader(`{"seo": null}`))
+		require.NoError(t, err)
+
+		params, err := mergePatchToPostParams(patch)
+		require.NoError(t, err)
+		require.NotNil(t, params.SEO)
+		for _, field := range []*string{params.SEO.MetaTitle, params.SEO.MetaDescription, params.SEO.CanonicalURL, params.SEO.OGImageURL} {
+			require.NotNil(t, field)
+			assert.Empty(t, *field)
+		}
+	})
+
+	t.Run("target publish date is set or cleared", func(t *testing.T) {
+		patch, err := decodeMergePatch(strings.NewReader(`{"targetPublishDate": "2025-03-14"}`))
+		require.NoError(t, err)
+
+		params, err := mergePatchToPostParams(patch)
+		require.NoError(t, err)
+		require.NotNil(t, params.TargetPublishDate)
+		assert.Equal(t, "2025-03-14", params.TargetPublishDate.Format(time.DateOnly))
+
+		patch, err = decodeMergePatch(strings.NewReader(`{"targetPublishDate": null}`))
+		require.NoError(t, err)
+
+		params, err = mergePatchToPostParams(patch)
+		require.NoError(t, err)
+		require.NotNil(t, params.TargetPublishDate)
+		assert.True(t, params.TargetPublishDate.IsZero())
+
+		patch, err = decodeMergePatch(strings.NewReader(`{"targetPublishDate": "next week"}`))
+		require.NoError(t, err)
+
+		_, err = mergePatchToPostParams(patch)
+		assert.EqualError(t, err, "targetPublishDate must be a date")
+	})
+
+	t.Run("wrong member types are rejected", func(t *testing.T) {
+		patch, err := decodeMergePatch(strings.NewReader(`{"title": 42}`))
+		require.NoError(t, err)
+
+		_, err = mergePatchToPostParams(patch)
+		assert.EqualError(t, err, "title must be a string")
+	})
+
+	t.Run("the document must be an object", func(t *testing.T) {
+		_, err := decodeMergePatch(strings.NewReader(`null`))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	apiclientsDomain "backend/internal/apiclients/domain"
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/logger"
+	"github.com/go-chi/chi/v5"
+)
+
+// API client headers
+const (
+	APITokenHeader       = "X-API-Token"       // Token of a registered API client
+	headerRateLimitLimit = "X-RateLimit-Limit" // Requests the token may make per window
+)
+
+// APIClientAdmitter decides whether an API token may make a request
+type APIClientAdmitter interface {
+	Admit(ctx context.Context, token string, scope apiclientsDomain.Scope) (*apiclientsDomain.Client, error)
+}
+
+// APIClientMiddleware admits requests made with an API client token.
+// Tokens are read-only: each route a token may call maps to the scope it
+// needs, and any other route is refused. Requests without a token pass
+// through untouched. It must run inside the router, since scopes are looked
+// up by route pattern, and outside the response store, so that stored
+// responses still count against the token's rate limit.
+type APIClientMiddleware struct {
+	admitter APIClientAdmitter
+	logger   logger.Logger
+}
+
+// NewAPIClientMiddleware creates a new API client middleware
+func NewAPIClientMiddleware(admitter APIClientAdmitter, logger logger.Logger) *APIClientMiddleware {
+	return &APIClientMiddleware{
+		admitter: admitter,
+		logger:   logger,
+	}
+}
+
+// Middleware checks tokens against the scope of the matched route, keyed as "METHOD /pattern"
+func (m *APIClientMiddleware) Middleware(scopes map[string]apiclientsDomain.Scope) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token := r.Header.Get(APITokenHeader)
+			if token == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			scope, ok := m.scopeFor(r, scopes)
+			if !ok {
+				WriteJSONError(w, ErrorCodeForbidden, "API tokens are read-only and cannot call this endpoint", http.StatusForbidden)
+				return
+			}
+
+			client, err := m.admitter.Admit(r.Context(), token, scope)
+			if err != nil {
+				var appErr *apperror.AppError
+				if errors.As(err, &appErr) {
+					WriteAppError(w, r, appErr)
+					return
+				}
+				m.logger.Error(r.Context(), "failed to admit api token", "error", err)
+				WriteJSONError(w, ErrorCodeInternalServerError, "Failed to verify API token", http.StatusInternalServerError)
+				return
+			}
+
+			w.Header().Set(headerRateLimitLimit, strconv.Itoa(client.RateLimit))
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// scopeFor returns the scope the matched route needs; HEAD requests need what GET does
+func (m *APIClientMiddleware) scopeFor(r *http.Request, scopes map[string]apiclientsDomain.Scope) (apiclientsDomain.Scope, bool) {
+	routeCtx := chi.RouteContext(r.Context())
+	if routeCtx == nil {
+		return "", false
+	}
+	method := r.Method
+	if method == http.MethodHead {
+		method = http.MethodGet
+	}
+	scope, ok := scopes[method+" "+routeCtx.RoutePattern()]
+	return scope, ok
+}
@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiclientsApp "backend/internal/apiclients/application"
+	apiclientsDomain "backend/internal/apiclients/domain"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubAdmitter admits the token "good" within its scopes and throttles "spent"
+type stubAdmitter struct{}
+
+func (stubAdmitter) Admit(_ context.Context, token string, scope apiclientsDomain.Scope) (*apiclientsDomain.Client, error) {
+	switch token {
+	case "good":
+		if scope != apiclientsDomain.ScopePostsRead {
+			return nil, apiclientsApp.ErrScopeNotGranted
+		}
+		return &apiclientsDomain.Client{RateLimit: 600}, nil
+	case "spent":
+		return nil, apiclientsApp.ErrRateLimited
+	default:
+		return nil, apiclientsApp.ErrInvalidAPIToken
+	}
+}
+
+func TestAPIClientMiddleware(t *testing.T) {
+	mw := NewAPIClientMiddleware(stubAdmitter{}, stubLogger{})
+	scopes := map[string]apiclientsDomain.Scope{
+		"GET /posts":  apiclientsDomain.ScopePostsRead,
+		"GET /themes": apiclientsDomain.ScopeThemesRead,
+	}
+
+	// Inline middleware runs once the route is matched, as in the API router
+	ok := func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) }
+	r := chi.NewRouter()
+	r.Group(func(r chi.Router) {
+		r.Use(mw.Middleware(scopes))
+		r.Get("/posts", ok)
+		r.Get("/themes", ok)
+		r.Head("/posts", ok)
+		r.Post("/posts", ok)
+	})
+
+	do := func(method, path, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		if token != "" {
+			req.Header.Set(APITokenHeader, token)
+		}
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		return rec
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		token  string
+		status int
+	}{
+		{"requests without a token pass through", http.MethodPost, "/posts", "", http.StatusOK},
+		{"token within its scope is admitted", http.MethodGet, "/posts", "good", http.StatusOK},
+		{"HEAD needs the GET scope", http.MethodHead, "/posts", "good", http.StatusOK},
+		{"token outside its scope is refused", http.MethodGet, "/themes", "good", http.StatusForbidden},
+		{"tokens cannot write", http.MethodPost, "/posts", "good", http.StatusForbidden},
+		{"unknown token is rejected", http.MethodGet, "/posts", "bad", http.StatusUnauthorized},
+		{"spent token is throttled", http.MethodGet, "/posts", "spent", http.StatusTooManyRequests},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.status, do(tt.method, tt.path, tt.token).Code)
+		})
+	}
+
+	assert.Equal(t, "600", do(http.MethodGet, "/posts", "good").Header().Get("X-RateLimit-Limit"))
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"backend/internal/platform/logger"
+	"backend/internal/platform/timezone"
 	"backend/internal/users/ports"
 	"github.com/google/uuid"
 )
@@ -63,6 +64,12 @@ func (a *AuthAdapter) Middleware(next http.Handler) http.Handler {
 			return
 		}
 
+		if user.IsSuspended() {
+			a.logger.Warn(ctx, "rejected request from suspended user", "user_id", user.ID)
+			WriteJSONError(w, ErrorCodeAccountSuspended, "Account suspended", http.StatusForbidden)
+			return
+		}
+
 		// Parse the user ID string to UUID and set it in context for authorization middleware
 		userUUID, err := uuid.Parse(user.ID)
 		if err != nil {
@@ -74,6 +81,8 @@ func (a *AuthAdapter) Middleware(next http.Handler) http.Handler {
 			return
 		}
 		ctx = SetUserID(ctx, userUUID)
+		// Scheduling views read and show times in the user's zone
+		ctx = timezone.WithLocation(ctx, user.Location())
 
 		// Also preserve the email if needed
 		if email, ok := GetJWTUserEmail(ctx); ok {
@@ -84,6 +93,48 @@ func (a *AuthAdapter) Middleware(next http.Handler) http.Handler {
 	})
 }
 
+// OptionalMiddleware resolves the internal user ID when the request carries a JWT subject
+// Unlike Middleware, it never rejects the request: callers without a profile continue anonymously
+func (a *AuthAdapter) OptionalMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		subject, ok := GetJWTUserID(ctx)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		user, err := a.userRepo.FindBySupabaseID(ctx, subject)
+		if err != nil {
+			a.logger.Debug(ctx, "continuing anonymously, user profile not resolved",
+				"supabase_id", subject,
+				"error", err,
+			)
+			next.ServeHTTP(w, r)
+			return
+		}
+		if user.IsSuspended() {
+			// Suspended users may still read what anonymous visitors can
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		userUUID, err := uuid.Parse(user.ID)
+		if err != nil {
+			a.logger.Error(ctx, "failed to parse user UUID",
+				"user_id", user.ID,
+				"error", err,
+			)
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx = SetUserID(ctx, userUUID)
+		next.ServeHTTP(w, r.WithContext(timezone.WithLocation(ctx, user.Location())))
+	})
+}
+
 // GetUserEmail is a helper to get the user's email from context
 func GetUserEmail(ctx context.Context) (string, bool) {
 	email, ok := ctx.Value(UserEmailKey).(string)
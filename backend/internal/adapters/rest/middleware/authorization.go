@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"backend/internal/authz/application"
+	"backend/internal/platform/actor"
 	"backend/internal/platform/logger"
 	"github.com/google/uuid"
 )
@@ -280,7 +281,9 @@ func GetResourceID(ctx context.Context) (uuid.UUID, bool) {
 
 // SetUserID is a helper function to set the user ID in the request context
 // This should be called by the authentication middleware after validating the JWT
+// The user also becomes the context's actor, which services record on their events
 func SetUserID(ctx context.Context, userID uuid.UUID) context.Context {
+	ctx = actor.WithUserID(ctx, userID)
 	return context.WithValue(ctx, UserIDKey, userID)
 }
 
@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"net/http"
+
+	"backend/internal/platform/clientip"
+)
+
+// ClientIPConfig lists the proxies whose forwarding headers are trusted
+type ClientIPConfig struct {
+	TrustedProxies []string // CIDRs or single addresses
+}
+
+// ClientIPMiddleware resolves the client address of every request and stores it
+// in the context, where clientip.FromContext reads it back.
+// It must run before anything that logs, so every log line carries the address.
+type ClientIPMiddleware struct {
+	resolver *clientip.Resolver
+}
+
+// NewClientIPMiddleware creates a new client IP middleware
+func NewClientIPMiddleware(resolver *clientip.Resolver) *ClientIPMiddleware {
+	return &ClientIPMiddleware{resolver: resolver}
+}
+
+// Middleware stores the resolved client IP in the request context
+func (m *ClientIPMiddleware) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip := m.resolver.Resolve(r)
+		next.ServeHTTP(w, r.WithContext(clientip.WithIP(r.Context(), ip)))
+	})
+}
@@ -0,0 +1,265 @@
+package middleware
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Compression headers
+const (
+	headerAcceptEncoding  = "Accept-Encoding"
+	headerContentEncoding = "Content-Encoding"
+	headerContentLength   = "Content-Length"
+	headerContentType     = "Content-Type"
+	headerETag            = "ETag"
+)
+
+// CompressionConfig controls which responses are compressed
+type CompressionConfig struct {
+	MinSize      int      // Smaller bodies are sent as they are, since compressing them gains nothing
+	ContentTypes []string // Media types worth compressing; anything else is sent as it is
+}
+
+// encoder compresses response bodies for one content coding
+type encoder struct {
+	name string
+	pool *sync.Pool // Of io.WriteCloser values that also implement resetter
+}
+
+// resetter lets pooled compressors write to a new response
+type resetter interface {
+	io.WriteCloser
+	Reset(w io.Writer)
+	Flush() error
+}
+
+// encoders lists the supported codings in order of preference
+var encoders = []encoder{
+	{name: "gzip", pool: &sync.Pool{New: func() any {
+		w, _ := gzip.NewWriterLevel(io.Discard, gzip.DefaultCompression)
+		return w
+	}}},
+	{name: "deflate", pool: &sync.Pool{New: func() any {
+		w, _ := flate.NewWriter(io.Discard, flate.DefaultCompression)
+		return w
+	}}},
+}
+
+// CompressionMiddleware compresses responses in the coding the client prefers.
+//
+// The decision waits until MinSize bytes are written or the handler finishes,
+// so small bodies go out untouched. Responses that are already encoded, carry
+// no body, or have a media type outside the allowlist are passed through, and
+// so are streams: a handler that flushes before the decision is made opts out.
+type CompressionMiddleware struct {
+	minSize      int
+	contentTypes map[string]bool
+}
+
+// NewCompressionMiddleware creates a new compression middleware
+func NewCompressionMiddleware(cfg CompressionConfig) *CompressionMiddleware {
+	m := &CompressionMiddleware{
+		minSize:      cfg.MinSize,
+		contentTypes: make(map[string]bool, len(cfg.ContentTypes)),
+	}
+	for _, contentType := range cfg.ContentTypes {
+		if contentType = strings.ToLower(strings.TrimSpace(contentType)); contentType != "" {
+			m.contentTypes[contentType] = true
+		}
+	}
+	return m
+}
+
+// Middleware negotiates a coding and compresses the response when it qualifies
+func (m *CompressionMiddleware) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add(headerVary, headerAcceptEncoding)
+
+		enc, ok := negotiateEncoding(r.Header.Get(headerAcceptEncoding))
+		if !ok || r.Method == http.MethodHead {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		cw := &compressWriter{ResponseWriter: w, middleware: m, encoder: enc}
+		defer cw.finish()
+		next.ServeHTTP(cw, r)
+	})
+}
+
+// compressible reports whether a response with these headers should be compressed
+func (m *CompressionMiddleware) compressible(status int, header http.Header) bool {
+	if status < http.StatusOK || status == http.StatusNoContent || status == http.StatusNotModified {
+		return false
+	}
+	if header.Get(headerContentEncoding) != "" {
+		return false
+	}
+	mediaType, _, err := mime.ParseMediaType(header.Get(headerContentType))
+	if err != nil {
+		return false
+	}
+	return m.contentTypes[mediaType]
+}
+
+// negotiateEncoding picks the supported coding with the highest q-value in
+// Accept-Encoding, preferring the earlier encoder on ties
+func negotiateEncoding(accept string) (encoder, bool) {
+	if accept == "" {
+		return encoder{}, false
+	}
+
+	weights := make(map[string]float64)
+	wildcard := -1.0
+	for _, part := range strings.Split(accept, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		name = strings.ToLower(strings.TrimSpace(name))
+		q := 1.0
+		if value, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if name == "*" {
+			wildcard = q
+			continue
+		}
+		weights[name] = q
+	}
+
+	best, bestQ := encoder{}, 0.0
+	for _, enc := range encoders {
+		q, listed := weights[enc.name]
+		if !listed {
+			q = max(wildcard, 0)
+		}
+		if q > bestQ {
+			best, bestQ = enc, q
+		}
+	}
+	return best, bestQ > 0
+}
+
+// compressWriter buffers the start of a response until it can decide whether
+// to compress it, then either compresses or passes everything through
+type compressWriter struct {
+	http.ResponseWriter
+	middleware *CompressionMiddleware
+	encoder    encoder
+
+	status  int
+	buf     bytes.Buffer
+	decided bool
+	writer  resetter // Set once the response is being compressed
+}
+
+// WriteHeader holds the status until the compression decision is made
+func (w *compressWriter) WriteHeader(status int) {
+	if w.status != 0 || w.decided {
+		return
+	}
+	// Informational responses are sent straight away and do not end the header phase
+	if status >= 100 && status < 200 {
+		w.ResponseWriter.WriteHeader(status)
+		return
+	}
+	w.status = status
+}
+
+// Write buffers until MinSize bytes are held, then commits to a decision
+func (w *compressWriter) Write(p []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	if !w.decided {
+		w.buf.Write(p)
+		if w.buf.Len() < w.middleware.minSize {
+			return len(p), nil
+		}
+		if err := w.decide(true); err != nil {
+			return 0, err
+		}
+		return len(p), nil
+	}
+	if w.writer != nil {
+		return w.writer.Write(p)
+	}
+	return w.ResponseWriter.Write(p)
+}
+
+// Flush sends what is held; flushing before the decision marks a stream,
+// which is passed through uncompressed
+func (w *compressWriter) Flush() {
+	if !w.decided {
+		if w.status == 0 {
+			w.status = http.StatusOK
+		}
+		_ = w.decide(false)
+	}
+	if w.writer != nil {
+		_ = w.writer.Flush()
+	}
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Unwrap exposes the underlying writer to http.ResponseController
+func (w *compressWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// decide sends the header and the buffered bytes, compressed when allowed
+func (w *compressWriter) decide(allowCompression bool) error {
+	w.decided = true
+	header := w.Header()
+
+	if allowCompression && w.middleware.compressible(w.status, header) {
+		header.Set(headerContentEncoding, w.encoder.name)
+		header.Del(headerContentLength)
+		// The compressed body differs byte for byte, so a strong validator no longer holds
+		if etag := header.Get(headerETag); etag != "" && !strings.HasPrefix(etag, "W/") {
+			header.Set(headerETag, "W/"+etag)
+		}
+
+		w.writer = w.encoder.pool.Get().(resetter)
+		w.writer.Reset(w.ResponseWriter)
+		w.ResponseWriter.WriteHeader(w.status)
+		_, err := w.writer.Write(w.buf.Bytes())
+		w.buf.Reset()
+		return err
+	}
+
+	w.ResponseWriter.WriteHeader(w.status)
+	_, err := w.ResponseWriter.Write(w.buf.Bytes())
+	w.buf.Reset()
+	return err
+}
+
+// finish sends a response that never reached MinSize and closes the compressor
+func (w *compressWriter) finish() {
+	if !w.decided {
+		if w.status == 0 && w.buf.Len() == 0 {
+			// The handler wrote nothing; let net/http send its default response
+			return
+		}
+		if w.status == 0 {
+			w.status = http.StatusOK
+		}
+		_ = w.decide(false)
+	}
+	if w.writer != nil {
+		_ = w.writer.Close()
+		w.encoder.pool.Put(w.writer)
+		w.writer = nil
+	}
+}
@@ -0,0 +1,120 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func serveCompressed(t *testing.T, acceptEncoding string, handler http.HandlerFunc) *httptest.ResponseRecorder {
+	t.Helper()
+	m := NewCompressionMiddleware(CompressionConfig{
+		MinSize:      64,
+		ContentTypes: []string{"application/json", "text/plain"},
+	})
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/posts", nil)
+	if acceptEncoding != "" {
+		req.Header.Set(headerAcceptEncoding, acceptEncoding)
+	}
+	rec := httptest.NewRecorder()
+	m.Middleware(handler).ServeHTTP(rec, req)
+	return rec
+}
+
+func writeBody(contentType, body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Length", "999")
+		w.Header().Set("ETag", `"v1"`)
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.WriteString(w, body)
+	}
+}
+
+func TestCompressionCompressesLargeBodies(t *testing.T) {
+	body := strings.Repeat(`{"title":"hello"}`, 20)
+	rec := serveCompressed(t, "br;q=1.0, gzip;q=0.8", writeBody("application/json; charset=utf-8", body))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "gzip", rec.Header().Get(headerContentEncoding))
+	assert.Empty(t, rec.Header().Get(headerContentLength))
+	assert.Equal(t, `W/"v1"`, rec.Header().Get(headerETag))
+	assert.Contains(t, rec.Header().Values(headerVary), headerAcceptEncoding)
+
+	reader, err := gzip.NewReader(rec.Body)
+	require.NoError(t, err)
+	decoded, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Equal(t, body, string(decoded))
+}
+
+func TestCompressionPassesThrough(t *testing.T) {
+	large := strings.Repeat("x", 200)
+
+	tests := []struct {
+		name           string
+		acceptEncoding string
+		handler        http.HandlerFunc
+		body           string
+	}{
+		{"no accepted coding", "", writeBody("application/json", large), large},
+		{"only unsupported codings", "br, zstd", writeBody("application/json", large), large},
+		{"gzip refused", "gzip;q=0, deflate;q=0", writeBody("application/json", large), large},
+		{"small body", "gzip", writeBody("application/json", `{"ok":true}`), `{"ok":true}`},
+		{"media type not allowed", "gzip", writeBody("image/png", large), large},
+		{"already encoded", "gzip", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set(headerContentEncoding, "identity")
+			_, _ = io.WriteString(w, large)
+		}, large},
+		{"stream flushed early", "gzip", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = io.WriteString(w, "data: 1\n\n")
+			w.(http.Flusher).Flush()
+			_, _ = io.WriteString(w, large)
+		}, "data: 1\n\n" + large},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serveCompressed(t, tt.acceptEncoding, tt.handler)
+			assert.NotEqual(t, "gzip", rec.Header().Get(headerContentEncoding))
+			assert.Equal(t, tt.body, rec.Body.String())
+		})
+	}
+}
+
+func TestCompressionKeepsStatusWithoutBody(t *testing.T) {
+	rec := serveCompressed(t, "gzip", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Empty(t, rec.Header().Get(headerContentEncoding))
+}
+
+func TestNegotiateEncoding(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   string
+	}{
+		{"gzip, deflate", "gzip"},
+		{"deflate", "deflate"},
+		{"deflate;q=1, gzip;q=0.5", "deflate"},
+		{"*", "gzip"},
+		{"*;q=0.5, gzip;q=0", "deflate"},
+		{"identity", ""},
+		{"GZIP", "gzip"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.accept, func(t *testing.T) {
+			enc, ok := negotiateEncoding(tt.accept)
+			assert.Equal(t, tt.want != "", ok)
+			assert.Equal(t, tt.want, enc.name)
+		})
+	}
+}
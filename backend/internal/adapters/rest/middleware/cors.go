@@ -0,0 +1,175 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend/internal/platform/requestid"
+)
+
+// CORS request and response headers
+const (
+	headerOrigin           = "Origin"
+	headerRequestMethod    = "Access-Control-Request-Method"
+	headerAllowOrigin      = "Access-Control-Allow-Origin"
+	headerAllowMethods     = "Access-Control-Allow-Methods"
+	headerAllowHeaders     = "Access-Control-Allow-Headers"
+	headerAllowCredentials = "Access-Control-Allow-Credentials"
+	headerExposeHeaders    = "Access-Control-Expose-Headers"
+	headerMaxAge           = "Access-Control-Max-Age"
+	headerRequestHeaders   = "Access-Control-Request-Headers"
+)
+
+// CORSConfig carries the cross-origin policy.
+//
+// An origin is either exact ("https://blog.example.com"), "*" for any origin,
+// or a pattern with one "*" in the host ("https://*.preview.example.com"),
+// which matches any non-empty run of subdomain labels. With "*" listed,
+// credentials are never allowed, as browsers reject them for a wildcard origin.
+type CORSConfig struct {
+	AllowedOrigins   []string
+	AllowedMethods   []string
+	AllowedHeaders   []string
+	ExposedHeaders   []string
+	AllowCredentials bool
+	MaxAge           time.Duration // How long browsers may cache a preflight; zero omits the header
+}
+
+// originPattern matches origins against "scheme://prefix*suffix"
+type originPattern struct {
+	prefix string
+	suffix string
+}
+
+func (p originPattern) match(origin string) bool {
+	if len(origin) <= len(p.prefix)+len(p.suffix) {
+		return false
+	}
+	if !strings.HasPrefix(origin, p.prefix) || !strings.HasSuffix(origin, p.suffix) {
+		return false
+	}
+	// The wildcard stands for host labels only, never a port, path or scheme
+	middle := origin[len(p.prefix) : len(origin)-len(p.suffix)]
+	return !strings.ContainsAny(middle, "/:@?#")
+}
+
+// CORSMiddleware answers preflight requests and marks responses readable by
+// the allowed origins. Requests without an Origin header pass through untouched,
+// and disallowed origins get no CORS headers, which the browser enforces.
+// It must run before routing, since preflights never reach a route.
+type CORSMiddleware struct {
+	allowAny         bool
+	origins          map[string]bool
+	patterns         []originPattern
+	allowMethods     string
+	allowHeaders     string
+	exposeHeaders    string
+	allowCredentials bool
+	maxAge           string
+}
+
+// NewCORSMiddleware creates a CORS middleware from the policy
+func NewCORSMiddleware(cfg CORSConfig) *CORSMiddleware {
+	m := &CORSMiddleware{
+		origins:       make(map[string]bool),
+		allowMethods:  strings.Join(cfg.AllowedMethods, ", "),
+		allowHeaders:  strings.Join(cfg.AllowedHeaders, ", "),
+		exposeHeaders: strings.Join(append([]string{requestid.Header}, cfg.ExposedHeaders...), ", "),
+	}
+
+	for _, origin := range cfg.AllowedOrigins {
+		origin = strings.TrimSuffix(strings.TrimSpace(origin), "/")
+		switch {
+		case origin == "":
+			continue
+		case origin == "*":
+			m.allowAny = true
+		case strings.Count(origin, "*") == 1:
+			prefix, suffix, _ := strings.Cut(strings.ToLower(origin), "*")
+			m.patterns = append(m.patterns, originPattern{prefix: prefix, suffix: suffix})
+		default:
+			m.origins[strings.ToLower(origin)] = true
+		}
+	}
+
+	m.allowCredentials = cfg.AllowCredentials && !m.allowAny
+	if cfg.MaxAge > 0 {
+		m.maxAge = strconv.Itoa(int(cfg.MaxAge.Seconds()))
+	}
+	return m
+}
+
+// Middleware applies the CORS policy
+func (m *CORSMiddleware) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get(headerOrigin)
+		if origin == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		preflight := r.Method == http.MethodOptions && r.Header.Get(headerRequestMethod) != ""
+
+		// The response differs by origin, so shared caches must key on it
+		w.Header().Add(headerVary, headerOrigin)
+		if preflight {
+			w.Header().Add(headerVary, headerRequestMethod)
+			w.Header().Add(headerVary, headerRequestHeaders)
+		}
+
+		allowed := m.allowed(origin)
+		if allowed {
+			m.setAllowOrigin(w, origin)
+		}
+
+		if !preflight {
+			if allowed && m.exposeHeaders != "" {
+				w.Header().Set(headerExposeHeaders, m.exposeHeaders)
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if allowed {
+			w.Header().Set(headerAllowMethods, m.allowMethods)
+			if m.allowHeaders != "" {
+				w.Header().Set(headerAllowHeaders, m.allowHeaders)
+			}
+			if m.maxAge != "" {
+				w.Header().Set(headerMaxAge, m.maxAge)
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// allowed reports whether the origin may read responses
+func (m *CORSMiddleware) allowed(origin string) bool {
+	if m.allowAny {
+		return true
+	}
+	origin = strings.ToLower(origin)
+	if m.origins[origin] {
+		return true
+	}
+	for _, pattern := range m.patterns {
+		if pattern.match(origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// setAllowOrigin echoes the origin, or "*" when any origin is allowed
+func (m *CORSMiddleware) setAllowOrigin(w http.ResponseWriter, origin string) {
+	if m.allowAny {
+		w.Header().Set(headerAllowOrigin, "*")
+		return
+	}
+	w.Header().Set(headerAllowOrigin, origin)
+	if m.allowCredentials {
+		w.Header().Set(headerAllowCredentials, "true")
+	}
+}
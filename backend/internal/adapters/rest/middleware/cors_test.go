@@ -0,0 +1,111 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func serveCORS(t *testing.T, cfg CORSConfig, method, origin string) (*httptest.ResponseRecorder, bool) {
+	t.Helper()
+	reached := false
+	handler := NewCORSMiddleware(cfg).Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reached = true
+	}))
+
+	req := httptest.NewRequest(method, "/api/v1/posts", nil)
+	if origin != "" {
+		req.Header.Set(headerOrigin, origin)
+	}
+	if method == http.MethodOptions {
+		req.Header.Set(headerRequestMethod, http.MethodPost)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec, reached
+}
+
+func TestCORSOrigins(t *testing.T) {
+	cfg := CORSConfig{
+		AllowedOrigins:   []string{"https://blog.example.com", "https://*.preview.example.com"},
+		AllowedMethods:   []string{http.MethodGet, http.MethodPost},
+		AllowCredentials: true,
+	}
+
+	tests := []struct {
+		name    string
+		origin  string
+		allowed bool
+	}{
+		{"exact origin", "https://blog.example.com", true},
+		{"exact origin ignores case", "https://Blog.Example.com", true},
+		{"preview subdomain", "https://pr-42.preview.example.com", true},
+		{"nested preview subdomain", "https://a.b.preview.example.com", true},
+		{"bare preview domain", "https://preview.example.com", false},
+		{"other scheme", "http://blog.example.com", false},
+		{"other port", "https://blog.example.com:8443", false},
+		{"suffix trick", "https://evil.com/.preview.example.com", false},
+		{"unlisted origin", "https://evil.example.org", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec, reached := serveCORS(t, cfg, http.MethodGet, tt.origin)
+			assert.True(t, reached)
+			assert.Contains(t, rec.Header().Values(headerVary), headerOrigin)
+			if tt.allowed {
+				assert.Equal(t, tt.origin, rec.Header().Get(headerAllowOrigin))
+				assert.Equal(t, "true", rec.Header().Get(headerAllowCredentials))
+				assert.Contains(t, rec.Header().Get(headerExposeHeaders), "X-Request-ID")
+			} else {
+				assert.Empty(t, rec.Header().Get(headerAllowOrigin))
+				assert.Empty(t, rec.Header().Get(headerAllowCredentials))
+			}
+		})
+	}
+}
+
+func TestCORSPreflight(t *testing.T) {
+	cfg := CORSConfig{
+		AllowedOrigins: []string{"https://blog.example.com"},
+		AllowedMethods: []string{http.MethodGet, http.MethodPost},
+		AllowedHeaders: []string{"Authorization", "Content-Type"},
+		MaxAge:         10 * time.Minute,
+	}
+
+	t.Run("allowed origin", func(t *testing.T) {
+		rec, reached := serveCORS(t, cfg, http.MethodOptions, "https://blog.example.com")
+		assert.False(t, reached)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, "https://blog.example.com", rec.Header().Get(headerAllowOrigin))
+		assert.Equal(t, "GET, POST", rec.Header().Get(headerAllowMethods))
+		assert.Equal(t, "Authorization, Content-Type", rec.Header().Get(headerAllowHeaders))
+		assert.Equal(t, "600", rec.Header().Get(headerMaxAge))
+		assert.Empty(t, rec.Header().Get(headerAllowCredentials))
+	})
+
+	t.Run("disallowed origin", func(t *testing.T) {
+		rec, reached := serveCORS(t, cfg, http.MethodOptions, "https://evil.example.org")
+		assert.False(t, reached)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Empty(t, rec.Header().Get(headerAllowOrigin))
+		assert.Empty(t, rec.Header().Get(headerAllowMethods))
+	})
+}
+
+func TestCORSAnyOrigin(t *testing.T) {
+	cfg := CORSConfig{AllowedOrigins: []string{"*"}, AllowCredentials: true}
+
+	rec, _ := serveCORS(t, cfg, http.MethodGet, "https://anywhere.example.net")
+	assert.Equal(t, "*", rec.Header().Get(headerAllowOrigin))
+	assert.Empty(t, rec.Header().Get(headerAllowCredentials), "credentials are never allowed for any origin")
+}
+
+func TestCORSWithoutOrigin(t *testing.T) {
+	rec, reached := serveCORS(t, CORSConfig{AllowedOrigins: []string{"*"}}, http.MethodGet, "")
+	assert.True(t, reached)
+	assert.Empty(t, rec.Header().Get(headerAllowOrigin))
+	assert.Empty(t, rec.Header().Values(headerVary))
+}
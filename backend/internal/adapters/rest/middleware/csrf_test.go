@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func serveCSRF(t *testing.T, req *http.Request) (*httptest.ResponseRecorder, bool) {
+	t.Helper()
+	reached := false
+	handler := NewCSRFMiddleware(SecurityConfig{SecureCookies: true}).Middleware(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { reached = true }),
+	)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec, reached
+}
+
+func sessionRequest(method, csrfCookie, csrfHeader string) *http.Request {
+	req := httptest.NewRequest(method, "/api/v1/posts", nil)
+	req.AddCookie(&http.Cookie{Name: SessionCookie, Value: "token"})
+	if csrfCookie != "" {
+		req.AddCookie(&http.Cookie{Name: CSRFCookie, Value: csrfCookie})
+	}
+	if csrfHeader != "" {
+		req.Header.Set(CSRFHeader, csrfHeader)
+	}
+	return req
+}
+
+func TestCSRF(t *testing.T) {
+	t.Run("matching token passes", func(t *testing.T) {
+		_, reached := serveCSRF(t, sessionRequest(http.MethodPost, "abc", "abc"))
+		assert.True(t, reached)
+	})
+
+	t.Run("mismatched token is rejected", func(t *testing.T) {
+		rec, reached := serveCSRF(t, sessionRequest(http.MethodPost, "abc", "xyz"))
+		assert.False(t, reached)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Contains(t, rec.Body.String(), ErrorCodeCSRFFailed)
+	})
+
+	t.Run("missing header is rejected", func(t *testing.T) {
+		_, reached := serveCSRF(t, sessionRequest(http.MethodDelete, "abc", ""))
+		assert.False(t, reached)
+	})
+
+	t.Run("missing cookie is rejected", func(t *testing.T) {
+		_, reached := serveCSRF(t, sessionRequest(http.MethodPut, "", "abc"))
+		assert.False(t, reached)
+	})
+
+	t.Run("bearer requests are exempt", func(t *testing.T) {
+		req := sessionRequest(http.MethodPost, "", "")
+		req.Header.Set("Authorization", "Bearer token")
+		_, reached := serveCSRF(t, req)
+		assert.True(t, reached)
+	})
+
+	t.Run("anonymous requests are exempt", func(t *testing.T) {
+		_, reached := serveCSRF(t, httptest.NewRequest(http.MethodPost, "/api/v1/posts", nil))
+		assert.True(t, reached)
+	})
+
+	t.Run("safe request without token is issued one", func(t *testing.T) {
+		rec, reached := serveCSRF(t, sessionRequest(http.MethodGet, "", ""))
+		assert.True(t, reached)
+
+		cookies := rec.Result().Cookies()
+		require.Len(t, cookies, 1)
+		assert.Equal(t, CSRFCookie, cookies[0].Name)
+		assert.NotEmpty(t, cookies[0].Value)
+		assert.True(t, cookies[0].Secure)
+		assert.False(t, cookies[0].HttpOnly, "the frontend must read the token")
+	})
+
+	t.Run("safe request with token keeps it", func(t *testing.T) {
+		rec, _ := serveCSRF(t, sessionRequest(http.MethodGet, "abc", ""))
+		assert.Empty(t, rec.Result().Cookies())
+	})
+}
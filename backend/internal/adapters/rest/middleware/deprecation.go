@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Headers announcing that an endpoint is going away
+const (
+	HeaderDeprecation = "Deprecation" // RFC 9745
+	HeaderSunset      = "Sunset"      // RFC 8594
+	HeaderLink        = "Link"
+)
+
+// Deprecation describes an endpoint a later API version replaces
+type Deprecation struct {
+	Since     time.Time // When the endpoint was deprecated
+	Sunset    time.Time // When it stops being served; zero while no date is set
+	Successor string    // Path of its replacement; empty while there is none yet
+}
+
+// Deprecations marks the responses of deprecated endpoints, keyed by method and
+// the route pattern chi matched, so clients learn of the change before it lands.
+// Responses carry the headers whatever their status, including errors.
+func Deprecations(deprecated map[string]Deprecation) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if routeCtx := chi.RouteContext(r.Context()); routeCtx != nil {
+				method := r.Method
+				if method == http.MethodHead {
+					method = http.MethodGet
+				}
+				if deprecation, ok := deprecated[method+" "+routeCtx.RoutePattern()]; ok {
+					deprecation.writeHeaders(w.Header())
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// writeHeaders sets the Deprecation header, and Sunset and the successor link when known
+func (d Deprecation) writeHeaders(header http.Header) {
+	header.Set(HeaderDeprecation, fmt.Sprintf("@%d", d.Since.Unix()))
+	if !d.Sunset.IsZero() {
+		header.Set(HeaderSunset, d.Sunset.UTC().Format(http.TimeFormat))
+	}
+	if d.Successor != "" {
+		header.Add(HeaderLink, fmt.Sprintf(`<%s>; rel="successor-version"`, d.Successor))
+	}
+}
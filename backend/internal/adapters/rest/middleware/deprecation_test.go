@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeprecations(t *testing.T) {
+	since := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	sunset := time.Date(2027, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	// Like the generated routes, the middleware wraps each handler so the pattern is known
+	r := chi.NewRouter().With(Deprecations(map[string]Deprecation{
+		"GET /api/v1/posts":  {Since: since, Sunset: sunset, Successor: "/api/v2/posts"},
+		"GET /api/v1/themes": {Since: since},
+	}))
+	ok := func(w http.ResponseWriter, r *http.Request) {}
+	r.Get("/api/v1/posts", ok)
+	r.Get("/api/v1/themes", ok)
+	r.Get("/api/v1/series", ok)
+
+	serve := func(method, path string) http.Header {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+		return rec.Header()
+	}
+
+	t.Run("deprecated with sunset and successor", func(t *testing.T) {
+		header := serve(http.MethodGet, "/api/v1/posts")
+		assert.Equal(t, "@1790812800", header.Get(HeaderDeprecation))
+		assert.Equal(t, "Thu, 01 Apr 2027 00:00:00 GMT", header.Get(HeaderSunset))
+		assert.Equal(t, `</api/v2/posts>; rel="successor-version"`, header.Get(HeaderLink))
+	})
+
+	t.Run("deprecated without a date or successor yet", func(t *testing.T) {
+		header := serve(http.MethodGet, "/api/v1/themes")
+		assert.NotEmpty(t, header.Get(HeaderDeprecation))
+		assert.Empty(t, header.Get(HeaderSunset))
+		assert.Empty(t, header.Get(HeaderLink))
+	})
+
+	t.Run("other routes are untouched", func(t *testing.T) {
+		assert.Empty(t, serve(http.MethodGet, "/api/v1/series").Get(HeaderDeprecation))
+	})
+}
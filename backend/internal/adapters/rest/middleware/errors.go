@@ -3,6 +3,8 @@ package middleware
 import (
 	"encoding/json"
 	"net/http"
+
+	"backend/internal/platform/apperror"
 )
 
 // Error codes used by middleware (lower_snake_case convention)
@@ -13,6 +15,7 @@ const (
 	ErrorCodeValidationError     = "validation_error"
 	ErrorCodeInvalidToken        = "invalid_token"
 	ErrorCodeTokenExpired        = "token_expired"
+	ErrorCodeAccountSuspended    = "account_suspended"
 	ErrorCodeInternalServerError = "internal_server_error"
 )
 
@@ -49,3 +52,17 @@ func WriteJSONErrorWithDetails(w http.ResponseWriter, code string, message strin
 	// Ignore encoding errors here as we're already in error handling
 	_ = json.NewEncoder(w).Encode(errorResp)
 }
+
+// WriteAppError writes an AppError in the format BaseHandler.HandleError uses,
+// so errors raised by middleware and handlers look the same to clients.
+// The message is given in the language negotiated for r.
+func WriteAppError(w http.ResponseWriter, r *http.Request, appErr *apperror.AppError) {
+	details := map[string]any{
+		"business_code": string(appErr.BusinessCode),
+	}
+	if appErr.Details != nil {
+		details["context"] = appErr.Details
+	}
+	message := LocalizedMessage(w, r, appErr.Code, appErr.BusinessCode, appErr.Message)
+	WriteJSONErrorWithDetails(w, string(appErr.Code), message, appErr.HTTPStatus, details)
+}
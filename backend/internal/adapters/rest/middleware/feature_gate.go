@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"backend/internal/platform/apperror"
+	"github.com/go-chi/chi/v5"
+)
+
+// FeatureGate answers 501 Not Implemented on routes whose feature this
+// deployment has not enabled, keyed by method and the route pattern chi
+// matched and naming the feature. Such routes stay registered and documented,
+// so an API surface can be merged a piece at a time and turned on per
+// environment once it is complete.
+func FeatureGate(disabled map[string]string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if routeCtx := chi.RouteContext(r.Context()); routeCtx != nil {
+				method := r.Method
+				if method == http.MethodHead {
+					method = http.MethodGet
+				}
+				if feature, ok := disabled[method+" "+routeCtx.RoutePattern()]; ok {
+					appErr := apperror.New(
+						apperror.CodeNotImplemented,
+						apperror.BusinessCodeFeatureDisabled,
+						fmt.Sprintf("this endpoint is part of the %q feature, which is not enabled on this server", feature),
+						http.StatusNotImplemented,
+					)
+					WriteAppError(w, r, appErr.WithDetails(map[string]any{"feature": feature}))
+					return
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
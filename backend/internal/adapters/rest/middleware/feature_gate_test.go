@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeatureGate(t *testing.T) {
+	r := chi.NewRouter().With(FeatureGate(map[string]string{
+		"GET /api/v1/comments": "comments",
+	}))
+	ok := func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) }
+	r.Get("/api/v1/comments", ok)
+	r.Post("/api/v1/comments", ok)
+	r.Get("/api/v1/posts", ok)
+
+	serve := func(method, path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+		return rec
+	}
+
+	t.Run("disabled route", func(t *testing.T) {
+		rec := serve(http.MethodGet, "/api/v1/comments")
+		assert.Equal(t, http.StatusNotImplemented, rec.Code)
+
+		var body struct {
+			Error        string         `json:"error"`
+			BusinessCode string         `json:"business_code"`
+			Context      map[string]any `json:"context"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, "NOT_IMPLEMENTED", body.Error)
+		assert.Equal(t, "FEATURE_DISABLED", body.BusinessCode)
+		assert.Equal(t, "comments", body.Context["feature"])
+	})
+
+	t.Run("other methods and routes are served", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, serve(http.MethodPost, "/api/v1/comments").Code)
+		assert.Equal(t, http.StatusOK, serve(http.MethodGet, "/api/v1/posts").Code)
+	})
+}
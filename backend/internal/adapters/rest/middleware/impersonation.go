@@ -0,0 +1,127 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	impersonationDomain "backend/internal/impersonation/domain"
+	"backend/internal/platform/actor"
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/logger"
+	"backend/internal/platform/requestid"
+	"github.com/go-chi/chi/v5"
+	chimw "github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+)
+
+// Impersonation headers
+const (
+	ImpersonationHeader = "X-Impersonate"   // Token of an impersonation session
+	headerImpersonating = "X-Impersonating" // The user the response was produced for
+)
+
+// ImpersonationResolver opens impersonation sessions and keeps their audit trail
+type ImpersonationResolver interface {
+	Resolve(ctx context.Context, realUserID uuid.UUID, token string) (*impersonationDomain.Session, error)
+	RecordAction(ctx context.Context, action *impersonationDomain.Action) error
+	CompleteAction(ctx context.Context, action *impersonationDomain.Action) error
+}
+
+// ImpersonationMiddleware lets staff make requests as another user with the
+// token of an impersonation session. The request then acts as the target,
+// so authorization and ownership checks see what that user would see, while
+// the context still records the real user. Every such request is written to
+// the session's audit trail before it runs, and refused when the entry cannot
+// be written; its status is filled in once answered. It must be placed
+// AFTER the auth adapter and BEFORE authorization middleware.
+type ImpersonationMiddleware struct {
+	resolver ImpersonationResolver
+	logger   logger.Logger
+}
+
+// NewImpersonationMiddleware creates a new impersonation middleware
+func NewImpersonationMiddleware(resolver ImpersonationResolver, logger logger.Logger) *ImpersonationMiddleware {
+	return &ImpersonationMiddleware{
+		resolver: resolver,
+		logger:   logger,
+	}
+}
+
+// Middleware switches the request to the impersonated user when a session token is sent
+func (m *ImpersonationMiddleware) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.Header.Get(ImpersonationHeader)
+		if token == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := r.Context()
+		realUserID, ok := GetUserID(ctx)
+		if !ok {
+			WriteJSONError(w, ErrorCodeUnauthorized, "Authentication required", http.StatusUnauthorized)
+			return
+		}
+
+		session, err := m.resolver.Resolve(ctx, realUserID, token)
+		if err != nil {
+			var appErr *apperror.AppError
+			if errors.As(err, &appErr) {
+				WriteAppError(w, r, appErr)
+				return
+			}
+			m.logger.Error(ctx, "failed to resolve impersonation token", "error", err)
+			WriteJSONError(w, ErrorCodeInternalServerError, "Failed to verify impersonation token", http.StatusInternalServerError)
+			return
+		}
+
+		ctx = actor.WithImpersonator(SetUserID(ctx, session.TargetID), realUserID)
+		w.Header().Set(headerImpersonating, session.TargetID.String())
+
+		// The action is recorded before the handler runs, so a request that cannot be
+		// audited is refused rather than left unrecorded. The record uses a context that
+		// outlives the request, so a client hanging up does not lose the status.
+		auditCtx := context.WithoutCancel(ctx)
+		action := &impersonationDomain.Action{
+			SessionID:      session.ID,
+			ImpersonatorID: realUserID,
+			TargetID:       session.TargetID,
+			Method:         r.Method,
+			Route:          routePattern(r),
+			Path:           r.URL.Path,
+			RequestID:      requestid.FromContext(ctx),
+			OccurredAt:     time.Now(),
+		}
+		if err := m.resolver.RecordAction(auditCtx, action); err != nil {
+			WriteJSONError(w, ErrorCodeInternalServerError, "Failed to record impersonated action", http.StatusInternalServerError)
+			return
+		}
+
+		wrr := chimw.NewWrapResponseWriter(w, r.ProtoMajor)
+		next.ServeHTTP(wrr, r.WithContext(ctx))
+
+		// A failure leaves the entry without a status; the resolver logs it with the request ID
+		action.Status = responseStatus(wrr)
+		_ = m.resolver.CompleteAction(auditCtx, action)
+	})
+}
+
+// routePattern returns the pattern of the matched route, or the path outside a router
+func routePattern(r *http.Request) string {
+	if routeCtx := chi.RouteContext(r.Context()); routeCtx != nil {
+		if pattern := routeCtx.RoutePattern(); pattern != "" {
+			return pattern
+		}
+	}
+	return r.URL.Path
+}
+
+// responseStatus is the status sent, which is 200 when the handler never set one
+func responseStatus(wrr chimw.WrapResponseWriter) int {
+	if status := wrr.Status(); status != 0 {
+		return status
+	}
+	return http.StatusOK
+}
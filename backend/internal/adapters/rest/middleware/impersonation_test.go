@@ -0,0 +1,130 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	impersonationApp "backend/internal/impersonation/application"
+	impersonationDomain "backend/internal/impersonation/domain"
+	"backend/internal/platform/actor"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubSessionResolver opens the session for the token "good" to its impersonator and keeps recorded actions
+type stubSessionResolver struct {
+	session   *impersonationDomain.Session
+	actions   []*impersonationDomain.Action
+	recordErr error // Returned instead of recording when set
+}
+
+func (s *stubSessionResolver) Resolve(_ context.Context, realUserID uuid.UUID, token string) (*impersonationDomain.Session, error) {
+	if token != "good" || realUserID != s.session.ImpersonatorID {
+		return nil, impersonationApp.ErrInvalidToken
+	}
+	return s.session, nil
+}
+
+func (s *stubSessionResolver) RecordAction(_ context.Context, action *impersonationDomain.Action) error {
+	if s.recordErr != nil {
+		return s.recordErr
+	}
+	action.ID = int64(len(s.actions) + 1)
+	recorded := *action
+	s.actions = append(s.actions, &recorded)
+	return nil
+}
+
+func (s *stubSessionResolver) CompleteAction(_ context.Context, action *impersonationDomain.Action) error {
+	s.actions[action.ID-1].Status = action.Status
+	return nil
+}
+
+func TestImpersonationMiddleware(t *testing.T) {
+	admin, author := uuid.New(), uuid.New()
+	resolver := &stubSessionResolver{session: &impersonationDomain.Session{ID: uuid.New(), ImpersonatorID: admin, TargetID: author}}
+	mw := NewImpersonationMiddleware(resolver, stubLogger{})
+
+	var effective, realID uuid.UUID
+	var pending []int
+	r := chi.NewRouter()
+	r.Group(func(r chi.Router) {
+		r.Use(mw.Middleware)
+		r.Delete("/posts/{id}", func(w http.ResponseWriter, r *http.Request) {
+			effective, _ = GetUserID(r.Context())
+			realID, _ = actor.RealUserID(r.Context())
+			pending = nil
+			for _, action := range resolver.actions {
+				pending = append(pending, action.Status)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		})
+	})
+
+	do := func(caller uuid.UUID, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodDelete, "/posts/42", nil)
+		req = req.WithContext(SetUserID(req.Context(), caller))
+		if token != "" {
+			req.Header.Set(ImpersonationHeader, token)
+		}
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := do(admin, "")
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, admin, effective, "requests without a token act as the caller")
+	assert.Empty(t, resolver.actions)
+
+	rec = do(admin, "good")
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, []int{0}, pending, "the action is recorded before the handler runs")
+	assert.Equal(t, author, effective)
+	assert.Equal(t, admin, realID)
+	assert.Equal(t, author.String(), rec.Header().Get("X-Impersonating"))
+
+	require.Len(t, resolver.actions, 1, "the impersonated request is audited")
+	action := resolver.actions[0]
+	assert.Equal(t, resolver.session.ID, action.SessionID)
+	assert.Equal(t, admin, action.ImpersonatorID)
+	assert.Equal(t, author, action.TargetID)
+	assert.Equal(t, "/posts/{id}", action.Route)
+	assert.Equal(t, "/posts/42", action.Path)
+	assert.Equal(t, http.StatusNoContent, action.Status)
+
+	assert.Equal(t, http.StatusUnauthorized, do(uuid.New(), "good").Code, "tokens only work for their impersonator")
+	assert.Equal(t, http.StatusUnauthorized, do(admin, "bad").Code)
+	assert.Len(t, resolver.actions, 1, "refused requests are not audited as impersonated")
+}
+
+func TestImpersonationMiddleware_FailsWhenTheActionCannotBeAudited(t *testing.T) {
+	admin, author := uuid.New(), uuid.New()
+	resolver := &stubSessionResolver{
+		session:   &impersonationDomain.Session{ID: uuid.New(), ImpersonatorID: admin, TargetID: author},
+		recordErr: errors.New("audit trail unavailable"),
+	}
+	mw := NewImpersonationMiddleware(resolver, stubLogger{})
+
+	ran := false
+	handler := mw.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ran = true
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"secret":"the author's draft"}`))
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/posts/42", nil)
+	req = req.WithContext(SetUserID(req.Context(), admin))
+	req.Header.Set(ImpersonationHeader, "good")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.False(t, ran, "the request is refused before it can change anything")
+	assert.NotContains(t, rec.Body.String(), "secret")
+}
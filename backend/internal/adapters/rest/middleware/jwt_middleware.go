@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/lestrrat-go/httprc/v3"
 	"github.com/lestrrat-go/jwx/v3/jwk"
 	"github.com/lestrrat-go/jwx/v3/jwt"
 )
@@ -20,6 +21,11 @@ var (
 	ErrMissingEmail   = errors.New("missing email in token")
 )
 
+// SessionCookie holds the access token for browser sessions that authenticate by cookie.
+// The Authorization header takes precedence when both are sent; cookie-authenticated
+// requests must also pass the CSRF check, since browsers attach cookies to forged requests.
+const SessionCookie = "arch_session"
+
 type jwtContextKey string
 
 const (
@@ -35,7 +41,7 @@ type JWTMiddleware struct {
 
 func NewJWTMiddleware(ctx context.Context, jwksEndpoint string, issuer string) (*JWTMiddleware, error) {
 	// Create a cache with automatic refresh
-	cache, err := jwk.NewCache(ctx, nil)
+	cache, err := jwk.NewCache(ctx, httprc.NewClient())
 	if err != nil {
 		return nil, fmt.Errorf("failed to create cache: %w", err)
 	}
@@ -60,77 +66,107 @@ func NewJWTMiddleware(ctx context.Context, jwksEndpoint string, issuer string) (
 
 func (m *JWTMiddleware) Middleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// Extract token from Authorization header
-		authHeader := r.Header.Get("Authorization")
-		if authHeader == "" {
-			WriteJSONError(w, ErrorCodeUnauthorized, ErrMissingToken.Error(), http.StatusUnauthorized)
+		ctx, failure := m.authenticate(r)
+		if failure != nil {
+			WriteJSONError(w, failure.code, failure.message, failure.status)
 			return
 		}
 
-		// Remove "Bearer " prefix
-		tokenString := strings.TrimPrefix(authHeader, "Bearer ")
-		if tokenString == authHeader {
-			WriteJSONError(w, ErrorCodeUnauthorized, "Invalid authorization header format", http.StatusUnauthorized)
-			return
-		}
+		// Continue with the request
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
 
-		// Get the cached key set
-		keySet, err := m.cache.Lookup(r.Context(), m.jwksEndpoint)
-		if err != nil {
-			WriteJSONError(w, ErrorCodeInternalServerError, fmt.Sprintf("Failed to get JWKS: %v", err), http.StatusInternalServerError)
+// OptionalMiddleware authenticates the request only when it carries credentials
+// Anonymous requests pass through untouched, and so do requests whose credentials
+// fail to verify: a stale token must not lock a reader out of public content
+func (m *JWTMiddleware) OptionalMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !hasCredentials(r) {
+			next.ServeHTTP(w, r)
 			return
 		}
 
-		// Parse and validate the token
-		token, err := jwt.ParseString(
-			tokenString,
-			jwt.WithKeySet(keySet),
-			jwt.WithValidate(true),
-			jwt.WithIssuer(m.issuer),
-		)
-		if err != nil {
-			// Check if token is expired
-			if err.Error() == "exp not satisfied" || strings.Contains(err.Error(), "expired") {
-				WriteJSONError(w, ErrorCodeTokenExpired, ErrTokenExpired.Error(), http.StatusUnauthorized)
-				return
-			}
-			WriteJSONError(w, ErrorCodeInvalidToken, ErrInvalidToken.Error(), http.StatusUnauthorized)
+		ctx, failure := m.authenticate(r)
+		if failure != nil {
+			next.ServeHTTP(w, r)
 			return
 		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
 
-		// Extract required claims
-		var subject string
-		err = token.Get("sub", &subject)
-		if err != nil {
-			WriteJSONError(w, ErrorCodeInvalidToken, ErrMissingSubject.Error(), http.StatusUnauthorized)
-			return
-		}
+// authFailure is the error response for a request whose credentials were rejected
+type authFailure struct {
+	code    string
+	message string
+	status  int
+}
 
-		var email string
-		err = token.Get("email", &email)
-		if err != nil {
-			WriteJSONError(w, ErrorCodeInvalidToken, ErrMissingEmail.Error(), http.StatusUnauthorized)
-			return
+// authenticate verifies the request's token and returns its context carrying the caller's claims
+func (m *JWTMiddleware) authenticate(r *http.Request) (context.Context, *authFailure) {
+	// Extract token from Authorization header, falling back to the session cookie
+	authHeader := r.Header.Get("Authorization")
+	var tokenString string
+	switch {
+	case authHeader != "":
+		// Remove "Bearer " prefix
+		tokenString = strings.TrimPrefix(authHeader, "Bearer ")
+		if tokenString == authHeader {
+			return nil, &authFailure{ErrorCodeUnauthorized, "Invalid authorization header format", http.StatusUnauthorized}
 		}
+	case usesSessionCookie(r):
+		cookie, _ := r.Cookie(SessionCookie)
+		tokenString = cookie.Value
+	default:
+		return nil, &authFailure{ErrorCodeUnauthorized, ErrMissingToken.Error(), http.StatusUnauthorized}
+	}
 
-		// Convert to strings
-		if subject == "" {
-			WriteJSONError(w, ErrorCodeInvalidToken, "Invalid subject format", http.StatusUnauthorized)
-			return
-		}
+	// Get the cached key set
+	keySet, err := m.cache.Lookup(r.Context(), m.jwksEndpoint)
+	if err != nil {
+		return nil, &authFailure{ErrorCodeInternalServerError, fmt.Sprintf("Failed to get JWKS: %v", err), http.StatusInternalServerError}
+	}
 
-		if email == "" {
-			WriteJSONError(w, ErrorCodeInvalidToken, "Invalid email format", http.StatusUnauthorized)
-			return
+	// Parse and validate the token
+	token, err := jwt.ParseString(
+		tokenString,
+		jwt.WithKeySet(keySet),
+		jwt.WithValidate(true),
+		jwt.WithIssuer(m.issuer),
+	)
+	if err != nil {
+		// Check if token is expired
+		if err.Error() == "exp not satisfied" || strings.Contains(err.Error(), "expired") {
+			return nil, &authFailure{ErrorCodeTokenExpired, ErrTokenExpired.Error(), http.StatusUnauthorized}
 		}
+		return nil, &authFailure{ErrorCodeInvalidToken, ErrInvalidToken.Error(), http.StatusUnauthorized}
+	}
 
-		// Add user info to context
-		ctx := context.WithValue(r.Context(), JWTUserIDContextKey, subject)
-		ctx = context.WithValue(ctx, JWTUserEmailContextKey, email)
+	// Extract required claims
+	var subject string
+	if err := token.Get("sub", &subject); err != nil {
+		return nil, &authFailure{ErrorCodeInvalidToken, ErrMissingSubject.Error(), http.StatusUnauthorized}
+	}
 
-		// Continue with the request
-		next.ServeHTTP(w, r.WithContext(ctx))
-	})
+	var email string
+	if err := token.Get("email", &email); err != nil {
+		return nil, &authFailure{ErrorCodeInvalidToken, ErrMissingEmail.Error(), http.StatusUnauthorized}
+	}
+
+	// Convert to strings
+	if subject == "" {
+		return nil, &authFailure{ErrorCodeInvalidToken, "Invalid subject format", http.StatusUnauthorized}
+	}
+
+	if email == "" {
+		return nil, &authFailure{ErrorCodeInvalidToken, "Invalid email format", http.StatusUnauthorized}
+	}
+
+	// Add user info to context
+	ctx := context.WithValue(r.Context(), JWTUserIDContextKey, subject)
+	ctx = context.WithValue(ctx, JWTUserEmailContextKey, email)
+	return ctx, nil
 }
 
 // GetJWTUserID extracts the user ID from the request context set by JWT middleware
@@ -144,3 +180,17 @@ func GetJWTUserEmail(ctx context.Context) (string, bool) {
 	email, ok := ctx.Value(JWTUserEmailContextKey).(string)
 	return email, ok
 }
+
+// usesSessionCookie reports whether the request authenticates with the session cookie
+func usesSessionCookie(r *http.Request) bool {
+	if r.Header.Get("Authorization") != "" {
+		return false
+	}
+	cookie, err := r.Cookie(SessionCookie)
+	return err == nil && cookie.Value != ""
+}
+
+// hasCredentials reports whether the request carries a bearer token or a session cookie
+func hasCredentials(r *http.Request) bool {
+	return r.Header.Get("Authorization") != "" || usesSessionCookie(r)
+}
@@ -0,0 +1,115 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v3/jwa"
+	"github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/lestrrat-go/jwx/v3/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testIssuer = "https://auth.example.test"
+
+// newTestJWTMiddleware serves a freshly generated key set and returns a middleware trusting it
+// along with a function that signs tokens expiring at the given time
+func newTestJWTMiddleware(t *testing.T) (*JWTMiddleware, func(expires time.Time) string) {
+	t.Helper()
+
+	raw, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	key, err := jwk.Import(raw)
+	require.NoError(t, err)
+	require.NoError(t, key.Set(jwk.KeyIDKey, "test"))
+	require.NoError(t, key.Set(jwk.AlgorithmKey, jwa.RS256()))
+
+	public, err := key.PublicKey()
+	require.NoError(t, err)
+	set := jwk.NewSet()
+	require.NoError(t, set.AddKey(public))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(set)
+	}))
+	t.Cleanup(server.Close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	mw, err := NewJWTMiddleware(ctx, server.URL, testIssuer)
+	require.NoError(t, err)
+
+	sign := func(expires time.Time) string {
+		token, err := jwt.NewBuilder().
+			Issuer(testIssuer).
+			Subject("supabase-user").
+			Claim("email", "reader@example.com").
+			IssuedAt(expires.Add(-time.Hour)).
+			Expiration(expires).
+			Build()
+		require.NoError(t, err)
+		signed, err := jwt.Sign(token, jwt.WithKey(jwa.RS256(), key))
+		require.NoError(t, err)
+		return string(signed)
+	}
+
+	return mw, sign
+}
+
+func TestJWTMiddleware_OptionalIgnoresTokensThatFailToVerify(t *testing.T) {
+	mw, sign := newTestJWTMiddleware(t)
+
+	var subject string
+	var identified bool
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		subject, identified = GetJWTUserID(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+
+	do := func(mw func(http.Handler) http.Handler, authorization string) *httptest.ResponseRecorder {
+		subject, identified = "", false
+		req := httptest.NewRequest(http.MethodGet, "/posts", nil)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		rec := httptest.NewRecorder()
+		mw(handler).ServeHTTP(rec, req)
+		return rec
+	}
+
+	expired := "Bearer " + sign(time.Now().Add(-time.Minute))
+	valid := "Bearer " + sign(time.Now().Add(time.Hour))
+
+	t.Run("required authentication rejects an expired token", func(t *testing.T) {
+		rec := do(mw.Middleware, expired)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Contains(t, rec.Body.String(), ErrorCodeTokenExpired)
+	})
+
+	t.Run("public routes read anonymously with an expired token", func(t *testing.T) {
+		rec := do(mw.OptionalMiddleware, expired)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.False(t, identified)
+	})
+
+	t.Run("public routes read anonymously with a malformed token", func(t *testing.T) {
+		rec := do(mw.OptionalMiddleware, "Bearer not-a-jwt")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.False(t, identified)
+	})
+
+	t.Run("public routes still identify callers with a valid token", func(t *testing.T) {
+		rec := do(mw.OptionalMiddleware, valid)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.True(t, identified)
+		assert.Equal(t, "supabase-user", subject)
+	})
+}
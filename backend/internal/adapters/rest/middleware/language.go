@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"net/http"
+
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/i18n"
+)
+
+// Language negotiates the language of response messages from the request's
+// Accept-Language header and puts it in the request context. Requests
+// accepting no supported language are answered in English.
+func Language(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lang := i18n.Negotiate(r.Header.Get("Accept-Language"))
+		next.ServeHTTP(w, r.WithContext(i18n.WithLanguage(r.Context(), lang)))
+	})
+}
+
+// LocalizedMessage returns the message of an error in the request's language,
+// or message itself when it has no translation. Call it before the response
+// is written: the response is marked as varying by Accept-Language and, once
+// translated, with its Content-Language.
+func LocalizedMessage(w http.ResponseWriter, r *http.Request, code apperror.ErrorCode, businessCode apperror.BusinessCode, message string) string {
+	w.Header().Add("Vary", "Accept-Language")
+
+	lang := i18n.FromContext(r.Context())
+	translated, ok := i18n.ErrorMessage(lang, code, businessCode)
+	if !ok {
+		return message
+	}
+	w.Header().Set("Content-Language", lang.String())
+	return translated
+}
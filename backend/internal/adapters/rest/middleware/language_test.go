@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"backend/internal/platform/apperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLanguage_WriteAppError(t *testing.T) {
+	handler := Language(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		WriteAppError(w, r, apperror.New(
+			apperror.CodeTooManyRequests,
+			apperror.BusinessCodeRateLimited,
+			"too many requests",
+			http.StatusTooManyRequests,
+		))
+	}))
+
+	serve := func(acceptLanguage string) (*httptest.ResponseRecorder, map[string]any) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Accept-Language", acceptLanguage)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		var body map[string]any
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		return rec, body
+	}
+
+	t.Run("translated", func(t *testing.T) {
+		rec, body := serve("zh-Hant-TW")
+		assert.Equal(t, "要求過於頻繁，請稍後再試", body["message"])
+		assert.Equal(t, "RATE_LIMITED", body["business_code"])
+		assert.Equal(t, "zh-TW", rec.Header().Get("Content-Language"))
+		assert.Contains(t, rec.Header().Values("Vary"), "Accept-Language")
+	})
+
+	t.Run("English by default", func(t *testing.T) {
+		rec, body := serve("")
+		assert.Equal(t, "too many requests", body["message"])
+		assert.Empty(t, rec.Header().Get("Content-Language"))
+	})
+}
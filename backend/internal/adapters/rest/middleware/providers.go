@@ -2,9 +2,18 @@ package middleware
 
 import (
 	"context"
+	"fmt"
 
+	"backend/internal/adapters/api"
+	apiclientsApp "backend/internal/apiclients/application"
 	authzApp "backend/internal/authz/application"
+	blogsApp "backend/internal/blogs/application"
+	impersonationApp "backend/internal/impersonation/application"
+	"backend/internal/platform/clientip"
+	"backend/internal/platform/httpcache"
 	"backend/internal/platform/logger"
+	"backend/internal/platform/signedlink"
+	serviceaccountsApp "backend/internal/serviceaccounts/application"
 	"backend/internal/users/ports"
 	"github.com/google/wire"
 )
@@ -14,6 +23,18 @@ var ProviderSet = wire.NewSet(
 	ProvideJWTMiddleware,
 	ProvideAuthAdapter,
 	ProvideAuthorizationMiddleware,
+	ProvideTenantMiddleware,
+	ProvideResponseCacheMiddleware,
+	ProvideRequestValidator,
+	ProvideCORSMiddleware,
+	ProvideSecurityHeadersMiddleware,
+	ProvideCSRFMiddleware,
+	ProvideClientIPMiddleware,
+	ProvideCompressionMiddleware,
+	ProvideAPIClientMiddleware,
+	ProvideImpersonationMiddleware,
+	ProvideServiceAccountMiddleware,
+	ProvideSignedLinkMiddleware,
 )
 
 // JWTConfig carries the minimal settings needed to construct the JWT middleware
@@ -36,3 +57,72 @@ func ProvideAuthAdapter(userRepo ports.UserRepository, log logger.Logger) *AuthA
 func ProvideAuthorizationMiddleware(authzService *authzApp.AuthzService, log logger.Logger) *AuthorizationMiddleware {
 	return NewAuthorizationMiddleware(authzService, log)
 }
+
+// ProvideTenantMiddleware creates the tenant resolution middleware
+func ProvideTenantMiddleware(blogsService *blogsApp.BlogsService, log logger.Logger) *TenantMiddleware {
+	return NewTenantMiddleware(blogsService, log)
+}
+
+// ProvideResponseCacheMiddleware creates the response caching middleware
+func ProvideResponseCacheMiddleware(store *httpcache.Store, log logger.Logger) *ResponseCacheMiddleware {
+	return NewResponseCacheMiddleware(store, log)
+}
+
+// ProvideAPIClientMiddleware creates the API client token middleware
+func ProvideAPIClientMiddleware(clientService *apiclientsApp.ClientService, log logger.Logger) *APIClientMiddleware {
+	return NewAPIClientMiddleware(clientService, log)
+}
+
+// ProvideImpersonationMiddleware creates the impersonation middleware
+func ProvideImpersonationMiddleware(sessionService *impersonationApp.SessionService, log logger.Logger) *ImpersonationMiddleware {
+	return NewImpersonationMiddleware(sessionService, log)
+}
+
+// ProvideServiceAccountMiddleware creates the service account token middleware
+func ProvideServiceAccountMiddleware(accountService *serviceaccountsApp.AccountService, log logger.Logger) *ServiceAccountMiddleware {
+	return NewServiceAccountMiddleware(accountService, log)
+}
+
+// ProvideSignedLinkMiddleware creates the signed action link middleware
+func ProvideSignedLinkMiddleware(signer *signedlink.Signer, userRepo ports.UserRepository, log logger.Logger) *SignedLinkMiddleware {
+	return NewSignedLinkMiddleware(signer, userRepo, log)
+}
+
+// ProvideCORSMiddleware creates the CORS middleware from the configured policy
+func ProvideCORSMiddleware(cfg CORSConfig) *CORSMiddleware {
+	return NewCORSMiddleware(cfg)
+}
+
+// ProvideSecurityHeadersMiddleware creates the security headers middleware
+func ProvideSecurityHeadersMiddleware(cfg SecurityConfig) *SecurityHeadersMiddleware {
+	return NewSecurityHeadersMiddleware(cfg)
+}
+
+// ProvideCSRFMiddleware creates the CSRF protection middleware
+func ProvideCSRFMiddleware(cfg SecurityConfig) *CSRFMiddleware {
+	return NewCSRFMiddleware(cfg)
+}
+
+// ProvideClientIPMiddleware creates the client IP middleware, rejecting malformed proxy ranges
+func ProvideClientIPMiddleware(cfg ClientIPConfig) (*ClientIPMiddleware, error) {
+	resolver, err := clientip.NewResolver(cfg.TrustedProxies)
+	if err != nil {
+		return nil, fmt.Errorf("configure client IP resolution: %w", err)
+	}
+	return NewClientIPMiddleware(resolver), nil
+}
+
+// ProvideCompressionMiddleware creates the response compression middleware
+func ProvideCompressionMiddleware(cfg CompressionConfig) *CompressionMiddleware {
+	return NewCompressionMiddleware(cfg)
+}
+
+// ProvideRequestValidator creates the request validator from the embedded API spec
+// Routes are registered under /api/v1, so the spec's paths are matched there
+func ProvideRequestValidator(log logger.Logger) (*RequestValidator, error) {
+	spec, err := api.GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("load API spec: %w", err)
+	}
+	return NewRequestValidator(spec, "/api/v1", log)
+}
@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"net/http"
+
+	"backend/internal/platform/requestid"
+)
+
+// RequestID assigns every request an ID for correlating its logs and events.
+// A well-formed X-Request-ID from the caller is kept so IDs can span services;
+// otherwise a new one is generated. The ID is echoed in the response header.
+// A valid W3C traceparent header also puts its trace ID in the context.
+// It must be the outermost middleware so everything after it can log the ID.
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(requestid.Header)
+		if !requestid.Valid(id) {
+			id = requestid.New()
+		}
+
+		ctx := requestid.WithID(r.Context(), id)
+		if traceID, ok := requestid.ParseTraceParent(r.Header.Get(requestid.TraceParentHeader)); ok {
+			ctx = requestid.WithTraceID(ctx, traceID)
+		}
+
+		w.Header().Set(requestid.Header, id)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
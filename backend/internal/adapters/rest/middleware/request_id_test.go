@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"backend/internal/platform/requestid"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func serveWithRequestID(t *testing.T, incoming string) (seen string, echoed string) {
+	t.Helper()
+	handler := RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = requestid.FromContext(r.Context())
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/posts", nil)
+	if incoming != "" {
+		req.Header.Set(requestid.Header, incoming)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return seen, rec.Header().Get(requestid.Header)
+}
+
+func TestRequestID(t *testing.T) {
+	t.Run("caller ID is kept", func(t *testing.T) {
+		seen, echoed := serveWithRequestID(t, "edge-7f3a.42")
+		assert.Equal(t, "edge-7f3a.42", seen)
+		assert.Equal(t, "edge-7f3a.42", echoed)
+	})
+
+	t.Run("missing ID is generated", func(t *testing.T) {
+		seen, echoed := serveWithRequestID(t, "")
+		_, err := uuid.Parse(seen)
+		assert.NoError(t, err)
+		assert.Equal(t, seen, echoed)
+	})
+
+	t.Run("malformed or oversized IDs are replaced", func(t *testing.T) {
+		for _, incoming := range []string{"id with spaces", "a=b\"c", strings.Repeat("x", requestid.MaxLength+1)} {
+			seen, echoed := serveWithRequestID(t, incoming)
+			assert.NotEqual(t, incoming, seen)
+			assert.Equal(t, seen, echoed)
+		}
+	})
+}
+
+func TestRequestIDTraceParent(t *testing.T) {
+	serve := func(traceparent string) string {
+		var traceID string
+		handler := RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			traceID = requestid.TraceIDFromContext(r.Context())
+		}))
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/posts", nil)
+		req.Header.Set(requestid.TraceParentHeader, traceparent)
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		return traceID
+	}
+
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736",
+		serve("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"))
+	assert.Empty(t, serve("00-00000000000000000000000000000000-00f067aa0ba902b7-01"))
+	assert.Empty(t, serve("00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01"))
+	assert.Empty(t, serve("not a trace"))
+}
@@ -0,0 +1,204 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/logger"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+)
+
+// MaxRequestBodyBytes caps every JSON request body. The validator and the
+// handlers each read the body in full, so the cap bounds the memory a request can take.
+const MaxRequestBodyBytes = 1 << 20
+
+// FieldError describes one request value that does not match the API spec
+type FieldError struct {
+	Field    string                `json:"field"`    // Dotted path into the body, or the parameter name
+	Location string                `json:"location"` // "body", "query", "path" or "header"
+	Code     apperror.BusinessCode `json:"code"`
+	Message  string                `json:"message"`
+}
+
+// RequestValidator checks request bodies and parameters against the OpenAPI spec
+// before they reach a handler, so constraints such as required fields, lengths and
+// patterns are reported per field instead of surfacing as opaque domain errors.
+// Authentication is left to the JWT middleware, and requests for operations the
+// spec does not describe pass through unchecked.
+type RequestValidator struct {
+	router routers.Router
+	logger logger.Logger
+}
+
+// PATCH endpoints take JSON Merge Patch documents, which decode like any JSON body
+func init() {
+	openapi3filter.RegisterBodyDecoder("application/merge-patch+json", openapi3filter.JSONBodyDecoder)
+	// Post archives are checked by the importer; the validator only enforces the size cap
+	openapi3filter.RegisterBodyDecoder("application/zip", openapi3filter.FileBodyDecoder)
+	// OPML reading lists are parsed by the handler like any other text
+	openapi3filter.RegisterBodyDecoder("text/x-opml", openapi3filter.PlainBodyDecoder)
+}
+
+// NewRequestValidator creates a validator for spec, whose paths are served under baseURL
+func NewRequestValidator(spec *openapi3.T, baseURL string, logger logger.Logger) (*RequestValidator, error) {
+	// Match paths only; the spec's absolute server URLs name hosts this instance may not run on
+	spec.Servers = openapi3.Servers{{URL: baseURL}}
+
+	router, err := gorillamux.NewRouter(spec)
+	if err != nil {
+		return nil, fmt.Errorf("NewRequestValidator: build router: %w", err)
+	}
+
+	return &RequestValidator{
+		router: router,
+		logger: logger,
+	}, nil
+}
+
+// Middleware rejects requests that do not match the spec with a 400 listing every problem
+func (v *RequestValidator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route, pathParams, err := v.router.FindRoute(r)
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if r.Body != nil {
+			r.Body = http.MaxBytesReader(w, r.Body, MaxRequestBodyBytes)
+		}
+
+		input := &openapi3filter.RequestValidationInput{
+			Request:    r,
+			PathParams: pathParams,
+			Route:      route,
+			Options: &openapi3filter.Options{
+				MultiError:          true,
+				SkipSettingDefaults: true,
+				AuthenticationFunc:  openapi3filter.NoopAuthenticationFunc,
+			},
+		}
+
+		// ValidateRequest restores the body it reads, so the handler can decode it again
+		if err := openapi3filter.ValidateRequest(r.Context(), input); err != nil {
+			var maxErr *http.MaxBytesError
+			if errors.As(err, &maxErr) {
+				WriteAppError(w, r, apperror.New(
+					apperror.CodePayloadTooLarge,
+					apperror.BusinessCodeValueTooLong,
+					fmt.Sprintf("request body must not exceed %d bytes", maxErr.Limit),
+					http.StatusRequestEntityTooLarge,
+				))
+				return
+			}
+
+			fields := fieldErrors(err)
+			if len(fields) == 0 {
+				v.logger.Error(r.Context(), "failed to validate request", "error", err)
+				WriteJSONError(w, ErrorCodeInternalServerError, "Failed to validate request", http.StatusInternalServerError)
+				return
+			}
+
+			WriteAppError(w, r, apperror.New(
+				apperror.CodeValidationFailed,
+				apperror.BusinessCodeInvalidFormat,
+				"request does not match the API specification",
+				http.StatusBadRequest,
+			).WithDetails(fields))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// fieldErrors flattens a validation error into one entry per offending value
+// MultiError matches errors.As for any of its members, so it is unpacked by type first
+func fieldErrors(err error) []FieldError {
+	if multi, ok := err.(openapi3.MultiError); ok {
+		var fields []FieldError
+		for _, e := range multi {
+			fields = append(fields, fieldErrors(e)...)
+		}
+		return fields
+	}
+
+	var reqErr *openapi3filter.RequestError
+	if !errors.As(err, &reqErr) {
+		return nil
+	}
+
+	location, name := "body", ""
+	if reqErr.Parameter != nil {
+		location, name = reqErr.Parameter.In, reqErr.Parameter.Name
+	}
+
+	if reqErr.Err == nil {
+		return []FieldError{{Field: name, Location: location, Code: apperror.BusinessCodeInvalidFormat, Message: reqErr.Reason}}
+	}
+	return schemaFieldErrors(reqErr.Err, location, name)
+}
+
+// schemaFieldErrors converts the cause of a RequestError, prefixing fields with prefix
+func schemaFieldErrors(err error, location, prefix string) []FieldError {
+	if multi, ok := err.(openapi3.MultiError); ok {
+		var fields []FieldError
+		for _, e := range multi {
+			fields = append(fields, schemaFieldErrors(e, location, prefix)...)
+		}
+		return fields
+	}
+
+	var schemaErr *openapi3.SchemaError
+	if errors.As(err, &schemaErr) {
+		field := strings.Join(schemaErr.JSONPointer(), ".")
+		if prefix != "" && field != "" {
+			field = prefix + "." + field
+		} else if field == "" {
+			field = prefix
+		}
+		return []FieldError{{
+			Field:    field,
+			Location: location,
+			Code:     businessCodeFor(schemaErr.SchemaField),
+			Message:  schemaErr.Reason,
+		}}
+	}
+
+	var parseErr *openapi3filter.ParseError
+	if errors.As(err, &parseErr) {
+		return []FieldError{{
+			Field:    prefix,
+			Location: location,
+			Code:     apperror.BusinessCodeInvalidFormat,
+			Message:  parseErr.Error(),
+		}}
+	}
+
+	// Missing required parameters and bodies carry a plain error
+	code := apperror.BusinessCodeInvalidFormat
+	if errors.Is(err, openapi3filter.ErrInvalidRequired) {
+		code = apperror.BusinessCodeMissingRequiredField
+	}
+	return []FieldError{{Field: prefix, Location: location, Code: code, Message: err.Error()}}
+}
+
+// businessCodeFor maps the schema keyword that failed to a business code
+func businessCodeFor(schemaField string) apperror.BusinessCode {
+	switch schemaField {
+	case "required":
+		return apperror.BusinessCodeMissingRequiredField
+	case "maxLength", "maxItems", "maximum":
+		return apperror.BusinessCodeValueTooLong
+	case "minLength", "minItems", "minimum":
+		return apperror.BusinessCodeValueTooShort
+	default:
+		return apperror.BusinessCodeInvalidFormat
+	}
+}
@@ -0,0 +1,119 @@
+package middleware
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"backend/internal/adapters/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestValidator(t *testing.T) {
+	spec, err := api.GetSwagger()
+	require.NoError(t, err)
+	validator, err := NewRequestValidator(spec, "/api/v1", stubLogger{})
+	require.NoError(t, err)
+
+	var receivedBody string
+	handler := validator.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		receivedBody = string(body)
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	type fieldError struct {
+		Field    string `json:"field"`
+		Location string `json:"location"`
+		Code     string `json:"code"`
+	}
+
+	tests := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		expectedStatus int
+		expectedFields []fieldError
+	}{
+		{
+			name:           "valid body passes through unchanged",
+			method:         http.MethodPost,
+			target:         "/api/v1/admin/blogs",
+			body:           `{"name":"Travel","slug":"travel"}`,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "missing required field",
+			method:         http.MethodPost,
+			target:         "/api/v1/admin/blogs",
+			body:           `{"name":"Travel"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedFields: []fieldError{{Field: "slug", Location: "body", Code: "MISSING_REQUIRED_FIELD"}},
+		},
+		{
+			name:           "every invalid field is reported",
+			method:         http.MethodPost,
+			target:         "/api/v1/admin/blogs",
+			body:           `{"name":"","slug":"Not A Slug"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedFields: []fieldError{
+				{Field: "name", Location: "body", Code: "VALUE_TOO_SHORT"},
+				{Field: "slug", Location: "body", Code: "INVALID_FORMAT"},
+			},
+		},
+		{
+			name:           "malformed JSON",
+			method:         http.MethodPost,
+			target:         "/api/v1/admin/blogs",
+			body:           `{"name":`,
+			expectedStatus: http.StatusBadRequest,
+			expectedFields: []fieldError{{Field: "", Location: "body", Code: "INVALID_FORMAT"}},
+		},
+		{
+			name:           "invalid query parameter",
+			method:         http.MethodGet,
+			target:         "/api/v1/posts?limit=1000",
+			expectedStatus: http.StatusBadRequest,
+			expectedFields: []fieldError{{Field: "limit", Location: "query", Code: "VALUE_TOO_LONG"}},
+		},
+		{
+			name:           "operations missing from the spec pass through",
+			method:         http.MethodGet,
+			target:         "/not-in-spec",
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			receivedBody = ""
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			if tt.body != "" {
+				req.Header.Set("Content-Type", "application/json")
+			}
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.expectedStatus, rec.Code, rec.Body.String())
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, tt.body, receivedBody)
+				return
+			}
+
+			var resp struct {
+				Error        string       `json:"error"`
+				BusinessCode string       `json:"business_code"`
+				Context      []fieldError `json:"context"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, "VALIDATION_FAILED", resp.Error)
+			assert.ElementsMatch(t, tt.expectedFields, resp.Context)
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package middleware
+
+import (
+	"bytes"
+	"net/http"
+	"slices"
+
+	"backend/internal/platform/httpcache"
+	"backend/internal/platform/logger"
+	"backend/internal/platform/tenant"
+	"github.com/go-chi/chi/v5"
+)
+
+// Response cache headers
+const (
+	headerCacheControl = "Cache-Control"
+	headerVary         = "Vary"
+	headerXCache       = "X-Cache" // HIT or MISS for responses eligible for the response store
+)
+
+// ResponseCacheMiddleware sets caching headers on public reads and serves
+// anonymous ones from the response store.
+//
+// Each route class has a policy; routes without one are left untouched.
+// Responses to authenticated callers may be personalised, so they are marked
+// private and never stored. Handlers tag responses with surrogate keys, which
+// the store and the CDN use to purge them when the content changes.
+// It must run inside the router, since policies are looked up by route pattern.
+type ResponseCacheMiddleware struct {
+	store  *httpcache.Store // nil when the response store is disabled
+	logger logger.Logger
+}
+
+// NewResponseCacheMiddleware creates a new response caching middleware
+func NewResponseCacheMiddleware(store *httpcache.Store, logger logger.Logger) *ResponseCacheMiddleware {
+	return &ResponseCacheMiddleware{
+		store:  store,
+		logger: logger,
+	}
+}
+
+// Middleware applies the policy of the matched route, keyed as "METHOD /pattern"
+func (m *ResponseCacheMiddleware) Middleware(policies map[string]httpcache.Policy) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet && r.Method != http.MethodHead {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			policy, ok := m.policyFor(r, policies)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			anonymous := !hasCredentials(r)
+			storable := anonymous && m.store != nil && r.Method == http.MethodGet && policy.SharedMaxAge > 0
+			key := tenant.BlogID(r.Context()).String() + ":" + r.URL.RequestURI() + ":" + r.Header.Get("Accept-Language")
+
+			if storable && m.serveStored(w, r, key) {
+				return
+			}
+
+			// Outer middlewares set headers for this caller alone, such as rate limits
+			// and the request ID; only what the handler chain adds is stored
+			var outer http.Header
+			if storable {
+				outer = w.Header().Clone()
+			}
+
+			cw := &cachingWriter{
+				ResponseWriter: w,
+				policy:         policy,
+				anonymous:      anonymous,
+				storable:       storable,
+			}
+			next.ServeHTTP(cw, r)
+
+			if cw.recording {
+				header := addedHeaders(outer, w.Header())
+				header.Del(headerXCache)
+				if err := m.store.Set(r.Context(), key, cw.status, header, cw.body.Bytes(), policy.SharedMaxAge); err != nil {
+					m.logger.Warn(r.Context(), "failed to store response", "error", err, "key", key)
+				}
+			}
+		})
+	}
+}
+
+// policyFor looks up the policy of the route chi matched
+func (m *ResponseCacheMiddleware) policyFor(r *http.Request, policies map[string]httpcache.Policy) (httpcache.Policy, bool) {
+	routeCtx := chi.RouteContext(r.Context())
+	if routeCtx == nil {
+		return httpcache.Policy{}, false
+	}
+	policy, ok := policies[http.MethodGet+" "+routeCtx.RoutePattern()]
+	return policy, ok
+}
+
+// serveStored writes the stored response for key, reporting whether there was one
+// Store failures are logged and treated as misses
+func (m *ResponseCacheMiddleware) serveStored(w http.ResponseWriter, r *http.Request, key string) bool {
+	entry, found, err := m.store.Get(r.Context(), key)
+	if err != nil {
+		m.logger.Warn(r.Context(), "failed to read stored response", "error", err, "key", key)
+		return false
+	}
+	if !found {
+		return false
+	}
+
+	for name, values := range entry.Header {
+		w.Header()[name] = values
+	}
+	w.Header().Set(headerXCache, "HIT")
+	w.WriteHeader(entry.Status)
+	if _, err := w.Write(entry.Body); err != nil {
+		m.logger.Warn(r.Context(), "failed to write stored response", "error", err, "key", key)
+	}
+	return true
+}
+
+// addedHeaders returns the headers of after that are missing from before or differ from it
+func addedHeaders(before, after http.Header) http.Header {
+	added := make(http.Header)
+	for name, values := range after {
+		if !slices.Equal(before[name], values) {
+			added[name] = slices.Clone(values)
+		}
+	}
+	return added
+}
+
+// cachingWriter adds caching headers when the status is known and
+// records successful responses for the response store
+type cachingWriter struct {
+	http.ResponseWriter
+	policy      httpcache.Policy
+	anonymous   bool
+	storable    bool
+	wroteHeader bool
+	recording   bool
+	status      int
+	body        bytes.Buffer
+}
+
+// WriteHeader sets the caching headers before sending the status line
+func (w *cachingWriter) WriteHeader(status int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.status = status
+
+	header := w.Header()
+	header.Add(headerVary, "Authorization, Accept-Language")
+	if header.Get(headerCacheControl) == "" {
+		switch {
+		case !w.anonymous:
+			header.Set(headerCacheControl, "private, no-cache")
+		case isCacheableStatus(status):
+			header.Set(headerCacheControl, w.policy.CacheControl())
+		default:
+			header.Set(headerCacheControl, "no-store")
+		}
+	}
+
+	if w.storable && status == http.StatusOK {
+		w.recording = true
+		header.Set(headerXCache, "MISS")
+	}
+
+	w.ResponseWriter.WriteHeader(status)
+}
+
+// Write sends the body, keeping a copy when the response is being recorded
+func (w *cachingWriter) Write(p []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.recording {
+		w.body.Write(p)
+	}
+	return w.ResponseWriter.Write(p)
+}
+
+// isCacheableStatus reports whether shared caches may reuse a response with status
+// Permanent redirects from retired slugs are as stable as the content they point at
+func isCacheableStatus(status int) bool {
+	return status == http.StatusOK || status == http.StatusMovedPermanently || status == http.StatusPermanentRedirect
+}
@@ -0,0 +1,138 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"backend/internal/platform/cache"
+	"backend/internal/platform/httpcache"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseCacheMiddleware(t *testing.T) {
+	postID := uuid.New()
+	store := httpcache.NewStore(cache.NewMemoryCache())
+	policy := httpcache.Policy{MaxAge: time.Minute, SharedMaxAge: 5 * time.Minute}
+	mw := NewResponseCacheMiddleware(store, stubLogger{})
+
+	calls := 0
+	r := chi.NewRouter()
+	r.With(mw.Middleware(map[string]httpcache.Policy{"GET /posts/{id}": policy})).
+		Get("/posts/{id}", func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if chi.URLParam(r, "id") != postID.String() {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			httpcache.AddSurrogateKeys(w.Header(), httpcache.PostKey(postID))
+			_, _ = w.Write([]byte(`{"title":"hello"}`))
+		})
+	r.Get("/uncached", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	})
+
+	get := func(path, authorization string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		return rec
+	}
+	path := "/posts/" + postID.String()
+
+	t.Run("anonymous read gets the route policy and is stored", func(t *testing.T) {
+		rec := get(path, "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "public, max-age=60, s-maxage=300", rec.Header().Get("Cache-Control"))
+		assert.Equal(t, httpcache.PostKey(postID), rec.Header().Get(httpcache.HeaderSurrogateKey))
+		assert.Equal(t, "MISS", rec.Header().Get("X-Cache"))
+
+		rec = get(path, "")
+		assert.Equal(t, "HIT", rec.Header().Get("X-Cache"))
+		assert.Equal(t, `{"title":"hello"}`, rec.Body.String())
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("authenticated read is private and bypasses the store", func(t *testing.T) {
+		before := calls
+		rec := get(path, "Bearer token")
+		assert.Equal(t, "private, no-cache", rec.Header().Get("Cache-Control"))
+		assert.Empty(t, rec.Header().Get("X-Cache"))
+		assert.Equal(t, before+1, calls)
+	})
+
+	t.Run("purging a surrogate key drops the stored response", func(t *testing.T) {
+		require.NoError(t, store.Purge(context.Background(), httpcache.PostKey(postID)))
+		before := calls
+		rec := get(path, "")
+		assert.Equal(t, "MISS", rec.Header().Get("X-Cache"))
+		assert.Equal(t, before+1, calls)
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		rec := get("/posts/"+uuid.NewString(), "")
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+		assert.Empty(t, rec.Header().Get("X-Cache"))
+	})
+
+	t.Run("routes without a policy are untouched", func(t *testing.T) {
+		rec := get("/uncached", "")
+		assert.Empty(t, rec.Header().Get("Cache-Control"))
+	})
+}
+
+func TestResponseCacheMiddleware_LeavesOuterHeadersOutOfTheStore(t *testing.T) {
+	store := httpcache.NewStore(cache.NewMemoryCache())
+	policy := httpcache.Policy{MaxAge: time.Minute, SharedMaxAge: 5 * time.Minute}
+	mw := NewResponseCacheMiddleware(store, stubLogger{})
+
+	// Stands in for the API client middleware, which runs outside the store
+	rateLimits := map[string]string{"client-a": "100", "client-b": "5"}
+	r := chi.NewRouter()
+	r.With(
+		func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if limit, ok := rateLimits[r.Header.Get(APITokenHeader)]; ok {
+					w.Header().Set(headerRateLimitLimit, limit)
+				}
+				next.ServeHTTP(w, r)
+			})
+		},
+		mw.Middleware(map[string]httpcache.Policy{"GET /posts": policy}),
+	).Get("/posts", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[]`))
+	})
+
+	get := func(token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/posts", nil)
+		if token != "" {
+			req.Header.Set(APITokenHeader, token)
+		}
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := get("client-a")
+	assert.Equal(t, "MISS", rec.Header().Get("X-Cache"))
+	assert.Equal(t, "100", rec.Header().Get(headerRateLimitLimit))
+
+	rec = get("client-b")
+	assert.Equal(t, "HIT", rec.Header().Get("X-Cache"))
+	assert.Equal(t, "5", rec.Header().Get(headerRateLimitLimit), "each caller sees their own rate limit")
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"), "headers set by the handler are replayed")
+
+	rec = get("")
+	assert.Equal(t, "HIT", rec.Header().Get("X-Cache"))
+	assert.Empty(t, rec.Header().Get(headerRateLimitLimit), "callers without a token get no rate limit")
+}
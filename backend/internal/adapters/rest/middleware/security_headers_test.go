@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecurityHeaders(t *testing.T) {
+	serve := func(cfg SecurityConfig, policy SecurityPolicy) http.Header {
+		handler := NewSecurityHeadersMiddleware(cfg).Middleware(policy)(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}),
+		)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/posts", nil))
+		return rec.Header()
+	}
+
+	t.Run("API policy", func(t *testing.T) {
+		header := serve(SecurityConfig{HSTSMaxAge: 365 * 24 * time.Hour}, APISecurityPolicy)
+		assert.Equal(t, "nosniff", header.Get("X-Content-Type-Options"))
+		assert.Equal(t, "max-age=31536000; includeSubDomains", header.Get("Strict-Transport-Security"))
+		assert.Equal(t, "default-src 'none'; frame-ancestors 'none'", header.Get("Content-Security-Policy"))
+		assert.Equal(t, "DENY", header.Get("X-Frame-Options"))
+		assert.Equal(t, "no-referrer", header.Get("Referrer-Policy"))
+	})
+
+	t.Run("HSTS off and partial policy", func(t *testing.T) {
+		header := serve(SecurityConfig{}, SecurityPolicy{ReferrerPolicy: "strict-origin-when-cross-origin"})
+		assert.Equal(t, "nosniff", header.Get("X-Content-Type-Options"))
+		assert.Empty(t, header.Get("Strict-Transport-Security"))
+		assert.Empty(t, header.Get("Content-Security-Policy"))
+		assert.Equal(t, "strict-origin-when-cross-origin", header.Get("Referrer-Policy"))
+	})
+}
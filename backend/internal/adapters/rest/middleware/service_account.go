@@ -0,0 +1,135 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"backend/internal/platform/actor"
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/logger"
+	"backend/internal/platform/requestid"
+	serviceaccountsDomain "backend/internal/serviceaccounts/domain"
+	chimw "github.com/go-chi/chi/v5/middleware"
+)
+
+// ServiceAccountAuthenticator verifies machine tokens and keeps the audit trail of the accounts using them
+type ServiceAccountAuthenticator interface {
+	Authenticate(ctx context.Context, token string) (*serviceaccountsDomain.Account, error)
+	RecordAction(ctx context.Context, action *serviceaccountsDomain.Action) error
+	CompleteAction(ctx context.Context, action *serviceaccountsDomain.Action) error
+}
+
+// ServiceAccountMiddleware authenticates requests made with a service
+// account's access token, sent as a bearer token like a user's JWT and told
+// apart by its prefix. The request then acts as the account, so the roles
+// granted to it decide what it may do, and every such request is written to
+// the account's audit trail. Requests that may change state are recorded
+// before they run and refused when the entry cannot be written; reads are
+// recorded once answered. Requests without a machine token go through the
+// chain that authenticates people instead.
+type ServiceAccountMiddleware struct {
+	authenticator ServiceAccountAuthenticator
+	logger        logger.Logger
+}
+
+// NewServiceAccountMiddleware creates a new service account middleware
+func NewServiceAccountMiddleware(authenticator ServiceAccountAuthenticator, logger logger.Logger) *ServiceAccountMiddleware {
+	return &ServiceAccountMiddleware{
+		authenticator: authenticator,
+		logger:        logger,
+	}
+}
+
+// Middleware authenticates machine tokens itself and hands every other
+// request to the human middlewares, applied in the order given
+func (m *ServiceAccountMiddleware) Middleware(human ...func(http.Handler) http.Handler) func(http.Handler) http.Handler {
+	return m.chain(false, human)
+}
+
+// OptionalMiddleware is Middleware for public routes: a machine token that
+// fails to verify is ignored and the request continues anonymously
+func (m *ServiceAccountMiddleware) OptionalMiddleware(human ...func(http.Handler) http.Handler) func(http.Handler) http.Handler {
+	return m.chain(true, human)
+}
+
+// chain builds the middleware; optional decides whether rejected tokens fall back to anonymous access
+func (m *ServiceAccountMiddleware) chain(optional bool, human []func(http.Handler) http.Handler) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		people := next
+		for i := len(human) - 1; i >= 0; i-- {
+			people = human[i](people)
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, ok := machineToken(r)
+			if !ok {
+				people.ServeHTTP(w, r)
+				return
+			}
+
+			ctx := r.Context()
+			account, err := m.authenticator.Authenticate(ctx, token)
+			if err != nil {
+				var appErr *apperror.AppError
+				if errors.As(err, &appErr) {
+					if optional {
+						next.ServeHTTP(w, r)
+						return
+					}
+					WriteAppError(w, r, appErr)
+					return
+				}
+				m.logger.Error(ctx, "failed to authenticate service account token", "error", err)
+				WriteJSONError(w, ErrorCodeInternalServerError, "Failed to verify access token", http.StatusInternalServerError)
+				return
+			}
+
+			ctx = actor.WithServiceAccount(SetUserID(ctx, account.ID))
+
+			// Record with a context that outlives the request, so a client hanging up does not lose the entry
+			auditCtx := context.WithoutCancel(ctx)
+			action := &serviceaccountsDomain.Action{
+				AccountID:  account.ID,
+				Method:     r.Method,
+				Route:      routePattern(r),
+				Path:       r.URL.Path,
+				RequestID:  requestid.FromContext(ctx),
+				OccurredAt: time.Now(),
+			}
+
+			// Requests that may change state are recorded before they run, so one that
+			// cannot be audited is refused rather than left unrecorded
+			recorded := false
+			if !isSafeMethod(r.Method) {
+				if err := m.authenticator.RecordAction(auditCtx, action); err != nil {
+					WriteJSONError(w, ErrorCodeInternalServerError, "Failed to record service account action", http.StatusInternalServerError)
+					return
+				}
+				recorded = true
+			}
+
+			wrr := chimw.NewWrapResponseWriter(w, r.ProtoMajor)
+			next.ServeHTTP(wrr, r.WithContext(ctx))
+
+			// Failures here are logged with the request ID by the authenticator
+			action.Status = responseStatus(wrr)
+			if recorded {
+				_ = m.authenticator.CompleteAction(auditCtx, action)
+			} else {
+				_ = m.authenticator.RecordAction(auditCtx, action)
+			}
+		})
+	}
+}
+
+// machineToken returns the service account token the request is authorized with, if any
+func machineToken(r *http.Request) (string, bool) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || !strings.HasPrefix(token, serviceaccountsDomain.AccessTokenPrefix) {
+		return "", false
+	}
+	return token, true
+}
@@ -0,0 +1,151 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"backend/internal/platform/actor"
+	serviceaccountsApp "backend/internal/serviceaccounts/application"
+	serviceaccountsDomain "backend/internal/serviceaccounts/domain"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubAccountAuthenticator accepts the token "abm_good" for its account and keeps recorded actions
+type stubAccountAuthenticator struct {
+	account   *serviceaccountsDomain.Account
+	actions   []*serviceaccountsDomain.Action
+	recordErr error // Returned instead of recording when set
+}
+
+func (s *stubAccountAuthenticator) Authenticate(_ context.Context, token string) (*serviceaccountsDomain.Account, error) {
+	if token != "abm_good" {
+		return nil, serviceaccountsApp.ErrInvalidAccessToken
+	}
+	return s.account, nil
+}
+
+func (s *stubAccountAuthenticator) RecordAction(_ context.Context, action *serviceaccountsDomain.Action) error {
+	if s.recordErr != nil {
+		return s.recordErr
+	}
+	action.ID = int64(len(s.actions) + 1)
+	recorded := *action
+	s.actions = append(s.actions, &recorded)
+	return nil
+}
+
+func (s *stubAccountAuthenticator) CompleteAction(_ context.Context, action *serviceaccountsDomain.Action) error {
+	s.actions[action.ID-1].Status = action.Status
+	return nil
+}
+
+func TestServiceAccountMiddleware(t *testing.T) {
+	person := uuid.New()
+	authenticator := &stubAccountAuthenticator{account: &serviceaccountsDomain.Account{ID: uuid.New()}}
+	mw := NewServiceAccountMiddleware(authenticator, stubLogger{})
+
+	// Stands in for the JWT chain, which would reject a machine token
+	humanChain := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(SetUserID(r.Context(), person)))
+		})
+	}
+
+	var effective uuid.UUID
+	var machine bool
+	r := chi.NewRouter()
+	r.Group(func(r chi.Router) {
+		r.Use(mw.Middleware(humanChain))
+		r.Post("/posts/{id}/publish", func(w http.ResponseWriter, r *http.Request) {
+			effective, _ = GetUserID(r.Context())
+			machine = actor.IsServiceAccount(r.Context())
+			w.WriteHeader(http.StatusAccepted)
+		})
+	})
+
+	do := func(authorization string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/posts/42/publish", nil)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := do("Bearer eyJhbGciOiJSUzI1NiJ9.person")
+	assert.Equal(t, http.StatusAccepted, rec.Code)
+	assert.Equal(t, person, effective, "other bearer tokens go through the human chain")
+	assert.False(t, machine)
+	assert.Empty(t, authenticator.actions)
+
+	rec = do("Bearer abm_good")
+	assert.Equal(t, http.StatusAccepted, rec.Code)
+	assert.Equal(t, authenticator.account.ID, effective)
+	assert.True(t, machine)
+
+	require.Len(t, authenticator.actions, 1, "machine requests are audited")
+	action := authenticator.actions[0]
+	assert.Equal(t, authenticator.account.ID, action.AccountID)
+	assert.Equal(t, "/posts/{id}/publish", action.Route)
+	assert.Equal(t, "/posts/42/publish", action.Path)
+	assert.Equal(t, http.StatusAccepted, action.Status)
+
+	assert.Equal(t, http.StatusUnauthorized, do("Bearer abm_expired").Code)
+	assert.Len(t, authenticator.actions, 1, "refused requests are not audited")
+}
+
+func TestServiceAccountMiddleware_OptionalIgnoresRejectedTokens(t *testing.T) {
+	authenticator := &stubAccountAuthenticator{account: &serviceaccountsDomain.Account{ID: uuid.New()}}
+	mw := NewServiceAccountMiddleware(authenticator, stubLogger{})
+
+	var identified bool
+	handler := mw.OptionalMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, identified = GetUserID(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/posts", nil)
+	req.Header.Set("Authorization", "Bearer abm_expired")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.False(t, identified, "the request continues anonymously")
+	assert.Empty(t, authenticator.actions)
+}
+
+func TestServiceAccountMiddleware_RefusesChangesThatCannotBeAudited(t *testing.T) {
+	authenticator := &stubAccountAuthenticator{
+		account:   &serviceaccountsDomain.Account{ID: uuid.New()},
+		recordErr: errors.New("audit trail unavailable"),
+	}
+	mw := NewServiceAccountMiddleware(authenticator, stubLogger{})
+
+	var ran bool
+	handler := mw.Middleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ran = true
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	do := func(method string) int {
+		ran = false
+		req := httptest.NewRequest(method, "/posts/42", nil)
+		req.Header.Set("Authorization", "Bearer abm_good")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	assert.Equal(t, http.StatusInternalServerError, do(http.MethodDelete))
+	assert.False(t, ran, "the change is refused before it runs")
+
+	assert.Equal(t, http.StatusOK, do(http.MethodGet))
+	assert.True(t, ran, "reads go ahead, and the failure is logged")
+}
@@ -0,0 +1,105 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	blogsApp "backend/internal/blogs/application"
+	blogsDomain "backend/internal/blogs/domain"
+	"backend/internal/platform/logger"
+	"backend/internal/platform/tenant"
+)
+
+// blogPathPrefix selects a blog by slug, as in /blogs/travel/api/v1/posts
+const blogPathPrefix = "/blogs/"
+
+// BlogResolver looks up the blog a request addresses
+type BlogResolver interface {
+	ResolveBySlug(ctx context.Context, slug string) (*blogsDomain.Blog, error)
+	ResolveByHost(ctx context.Context, host string) (*blogsDomain.Blog, error)
+}
+
+// TenantMiddleware decides which blog a request is served for and stores it in the context.
+// A /blogs/{slug} path prefix wins and is stripped before routing; otherwise the Host
+// header is matched against the blogs' host names; otherwise the default blog is used.
+// It must wrap the router, since the prefix has to be removed before routes are matched.
+//
+// NOTE: Like the AuthAdapter, this adds a database query to every request that
+// carries a prefix or a host name. Blogs change rarely, so the lookups are a
+// good candidate for caching once that becomes a bottleneck.
+type TenantMiddleware struct {
+	resolver BlogResolver
+	logger   logger.Logger
+}
+
+// NewTenantMiddleware creates a new tenant resolution middleware
+func NewTenantMiddleware(resolver BlogResolver, logger logger.Logger) *TenantMiddleware {
+	return &TenantMiddleware{
+		resolver: resolver,
+		logger:   logger,
+	}
+}
+
+// Middleware resolves the blog and scopes the request context to it
+func (m *TenantMiddleware) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		if slug, rest, ok := splitBlogPrefix(r.URL.Path); ok {
+			blog, err := m.resolver.ResolveBySlug(ctx, slug)
+			if err != nil {
+				m.writeResolveError(w, r, err, "slug", slug)
+				return
+			}
+
+			// Rewrite a copy of the URL so outer middleware still sees the original path
+			u := *r.URL
+			u.Path = rest
+			u.RawPath = ""
+			r = r.WithContext(tenant.WithBlogID(ctx, blog.ID))
+			r.URL = &u
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if host := blogsDomain.NormalizeHost(r.Host); host != "" {
+			blog, err := m.resolver.ResolveByHost(ctx, host)
+			switch {
+			case err == nil:
+				next.ServeHTTP(w, r.WithContext(tenant.WithBlogID(ctx, blog.ID)))
+				return
+			case !errors.Is(err, blogsApp.ErrBlogNotFound):
+				m.writeResolveError(w, r, err, "host", host)
+				return
+			}
+			// Hosts that no blog claims fall through to the default blog
+		}
+
+		next.ServeHTTP(w, r.WithContext(tenant.WithBlogID(ctx, tenant.DefaultBlogID)))
+	})
+}
+
+// writeResolveError reports an unknown blog as 404 and any other failure as 500
+func (m *TenantMiddleware) writeResolveError(w http.ResponseWriter, r *http.Request, err error, by string, value string) {
+	if errors.Is(err, blogsApp.ErrBlogNotFound) {
+		WriteJSONError(w, ErrorCodeNotFound, "Blog not found", http.StatusNotFound)
+		return
+	}
+	m.logger.Error(r.Context(), "failed to resolve blog", "error", err, "by", by, "value", value)
+	WriteJSONError(w, ErrorCodeInternalServerError, "Failed to resolve blog", http.StatusInternalServerError)
+}
+
+// splitBlogPrefix splits "/blogs/{slug}/rest" into the slug and "/rest"
+func splitBlogPrefix(path string) (slug string, rest string, ok bool) {
+	if !strings.HasPrefix(path, blogPathPrefix) {
+		return "", "", false
+	}
+
+	slug, rest, _ = strings.Cut(strings.TrimPrefix(path, blogPathPrefix), "/")
+	if slug == "" {
+		return "", "", false
+	}
+	return slug, "/" + rest, true
+}
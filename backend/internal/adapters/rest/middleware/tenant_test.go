@@ -0,0 +1,122 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	blogsApp "backend/internal/blogs/application"
+	blogsDomain "backend/internal/blogs/domain"
+	"backend/internal/platform/tenant"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubLogger discards all log output
+type stubLogger struct{}
+
+func (stubLogger) Debug(ctx context.Context, msg string, args ...any) {}
+func (stubLogger) Info(ctx context.Context, msg string, args ...any)  {}
+func (stubLogger) Warn(ctx context.Context, msg string, args ...any)  {}
+func (stubLogger) Error(ctx context.Context, msg string, args ...any) {}
+
+// stubResolver serves blogs from in-memory maps
+type stubResolver struct {
+	bySlug map[string]*blogsDomain.Blog
+	byHost map[string]*blogsDomain.Blog
+}
+
+func (s stubResolver) ResolveBySlug(ctx context.Context, slug string) (*blogsDomain.Blog, error) {
+	if blog, ok := s.bySlug[slug]; ok {
+		return blog, nil
+	}
+	return nil, blogsApp.ErrBlogNotFound
+}
+
+func (s stubResolver) ResolveByHost(ctx context.Context, host string) (*blogsDomain.Blog, error) {
+	if blog, ok := s.byHost[host]; ok {
+		return blog, nil
+	}
+	return nil, blogsApp.ErrBlogNotFound
+}
+
+func TestTenantMiddleware(t *testing.T) {
+	travel := &blogsDomain.Blog{ID: uuid.New(), Slug: "travel"}
+	food := &blogsDomain.Blog{ID: uuid.New(), Slug: "food", Host: "food.example.com"}
+	resolver := stubResolver{
+		bySlug: map[string]*blogsDomain.Blog{"travel": travel, "food": food},
+		byHost: map[string]*blogsDomain.Blog{"food.example.com": food},
+	}
+
+	tests := []struct {
+		name           string
+		host           string
+		path           string
+		expectedStatus int
+		expectedBlogID uuid.UUID
+		expectedPath   string
+	}{
+		{
+			name:           "path prefix selects blog and is stripped",
+			host:           "api.example.com",
+			path:           "/blogs/travel/api/v1/posts",
+			expectedStatus: http.StatusOK,
+			expectedBlogID: travel.ID,
+			expectedPath:   "/api/v1/posts",
+		},
+		{
+			name:           "path prefix wins over host",
+			host:           "food.example.com",
+			path:           "/blogs/travel/api/v1/posts",
+			expectedStatus: http.StatusOK,
+			expectedBlogID: travel.ID,
+			expectedPath:   "/api/v1/posts",
+		},
+		{
+			name:           "unknown slug is not found",
+			host:           "api.example.com",
+			path:           "/blogs/missing/api/v1/posts",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "host selects blog",
+			host:           "Food.Example.com:8080",
+			path:           "/api/v1/posts",
+			expectedStatus: http.StatusOK,
+			expectedBlogID: food.ID,
+			expectedPath:   "/api/v1/posts",
+		},
+		{
+			name:           "unknown host falls back to default blog",
+			host:           "api.example.com",
+			path:           "/api/v1/posts",
+			expectedStatus: http.StatusOK,
+			expectedBlogID: tenant.DefaultBlogID,
+			expectedPath:   "/api/v1/posts",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotBlogID uuid.UUID
+			var gotPath string
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotBlogID = tenant.BlogID(r.Context())
+				gotPath = r.URL.Path
+			})
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			req.Host = tt.host
+			rec := httptest.NewRecorder()
+
+			NewTenantMiddleware(resolver, stubLogger{}).Middleware(next).ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, tt.expectedBlogID, gotBlogID)
+				assert.Equal(t, tt.expectedPath, gotPath)
+			}
+		})
+	}
+}
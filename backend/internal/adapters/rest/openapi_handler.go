@@ -0,0 +1,69 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"backend/internal/adapters/api"
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/i18n"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// OpenAPIConfig describes the running deployment to publish in the API document
+type OpenAPIConfig struct {
+	// PublicURL is where clients reach the API, without the /api/v1 prefix;
+	// empty publishes the relative base URL
+	PublicURL string
+	// Features maps optional features to whether this deployment enables them
+	Features map[string]bool
+}
+
+// OpenAPIHandler serves the API description, rendered once at startup
+type OpenAPIHandler struct {
+	*BaseHandler
+	document []byte
+}
+
+// NewOpenAPIHandler renders the embedded spec for this deployment: its server URL,
+// build version, enabled features, the catalog of error codes and the languages
+// error messages are translated into
+func NewOpenAPIHandler(base *BaseHandler, version string, config OpenAPIConfig) (*OpenAPIHandler, error) {
+	spec, err := api.GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("NewOpenAPIHandler: load API spec: %w", err)
+	}
+
+	spec.Servers = openapi3.Servers{{URL: strings.TrimRight(config.PublicURL, "/") + "/api/v1"}}
+	spec.Info.Version = version
+	if spec.Extensions == nil {
+		spec.Extensions = map[string]any{}
+	}
+	spec.Extensions["x-features"] = config.Features
+	spec.Extensions["x-error-codes"] = map[string]any{
+		"errorCodes":    apperror.ErrorCodes,
+		"businessCodes": apperror.BusinessCodes,
+	}
+	languages := make([]string, 0, len(i18n.Languages()))
+	for _, lang := range i18n.Languages() {
+		languages = append(languages, lang.String())
+	}
+	spec.Extensions["x-languages"] = languages
+
+	document, err := json.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("NewOpenAPIHandler: render API spec: %w", err)
+	}
+	return &OpenAPIHandler{BaseHandler: base, document: document}, nil
+}
+
+// GetOpenAPIDocument serves the rendered API description
+func (h *OpenAPIHandler) GetOpenAPIDocument(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(h.document); err != nil {
+		h.logger.Error(r.Context(), "failed to write API document", "error", err)
+	}
+}
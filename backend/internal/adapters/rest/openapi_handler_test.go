@@ -0,0 +1,49 @@
+package rest_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"backend/internal/adapters/rest"
+	"backend/internal/platform/errreport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOpenAPIDocument(t *testing.T) {
+	base := rest.NewBaseHandler(&mockLogger{}, errreport.Nop{}, rest.ErrorConfig{})
+	handler, err := rest.NewOpenAPIHandler(base, "1.2.3", rest.OpenAPIConfig{
+		PublicURL: "https://api.example.com/",
+		Features:  map[string]bool{"syntaxHighlighting": true},
+	})
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	handler.GetOpenAPIDocument(rec, httptest.NewRequest(http.MethodGet, "/api/v1/openapi.json", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var document struct {
+		Info struct {
+			Version string `json:"version"`
+		} `json:"info"`
+		Servers []struct {
+			URL string `json:"url"`
+		} `json:"servers"`
+		Features   map[string]bool     `json:"x-features"`
+		ErrorCodes map[string][]string `json:"x-error-codes"`
+		Languages  []string            `json:"x-languages"`
+		Paths      map[string]any      `json:"paths"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &document))
+
+	assert.Equal(t, "1.2.3", document.Info.Version)
+	require.Len(t, document.Servers, 1)
+	assert.Equal(t, "https://api.example.com/api/v1", document.Servers[0].URL)
+	assert.Equal(t, map[string]bool{"syntaxHighlighting": true}, document.Features)
+	assert.Contains(t, document.ErrorCodes["errorCodes"], "NOT_FOUND")
+	assert.Contains(t, document.ErrorCodes["businessCodes"], "POST_NOT_FOUND")
+	assert.Equal(t, []string{"en", "ja", "zh-TW"}, document.Languages)
+	assert.Contains(t, document.Paths, "/openapi.json")
+}
@@ -0,0 +1,241 @@
+package rest
+
+import (
+	"net/http"
+
+	"backend/internal/adapters/api"
+	"backend/internal/organizations/application"
+	"backend/internal/organizations/domain"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// OrganizationsHandler handles HTTP requests for organizations, their members and the content they own
+// Membership and roles are checked by the service, since they are not permissions
+type OrganizationsHandler struct {
+	*BaseHandler
+	service *application.OrganizationsService
+}
+
+// NewOrganizationsHandler creates a new organizations handler
+func NewOrganizationsHandler(base *BaseHandler, service *application.OrganizationsService) *OrganizationsHandler {
+	return &OrganizationsHandler{
+		BaseHandler: base,
+		service:     service,
+	}
+}
+
+// ListMyOrganizations lists the organizations the caller belongs to
+func (h *OrganizationsHandler) ListMyOrganizations(w http.ResponseWriter, r *http.Request) {
+	userID := h.GetUserIDFromContext(r)
+
+	orgs, err := h.service.ListMyOrganizations(r.Context(), userID)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	response := make([]api.Organization, 0, len(orgs))
+	for _, org := range orgs {
+		response = append(response, domainOrganizationToAPI(org))
+	}
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
+// CreateOrganization creates an organization owned by the caller
+// NOTE: Authorization middleware checks organizations:create permission before this is called
+func (h *OrganizationsHandler) CreateOrganization(w http.ResponseWriter, r *http.Request) {
+	userID := h.GetUserIDFromContext(r)
+
+	var req api.OrganizationRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	org, err := h.service.CreateOrganization(r.Context(), userID, apiOrganizationRequestToParams(req))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainOrganizationToAPI(org), http.StatusCreated)
+}
+
+// GetOrganization retrieves an organization the caller belongs to
+func (h *OrganizationsHandler) GetOrganization(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	org, err := h.service.GetOrganization(r.Context(), userID, uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainOrganizationToAPI(org), http.StatusOK)
+}
+
+// UpdateOrganization changes an organization the caller owns
+func (h *OrganizationsHandler) UpdateOrganization(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	var req api.OrganizationRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	org, err := h.service.UpdateOrganization(r.Context(), userID, uuid.UUID(id), apiOrganizationRequestToParams(req))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainOrganizationToAPI(org), http.StatusOK)
+}
+
+// DeleteOrganization removes an organization the caller owns
+func (h *OrganizationsHandler) DeleteOrganization(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	if err := h.service.DeleteOrganization(r.Context(), userID, uuid.UUID(id)); err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListOrganizationMembers lists the members of an organization the caller belongs to
+func (h *OrganizationsHandler) ListOrganizationMembers(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	members, err := h.service.ListMembers(r.Context(), userID, uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	response := make([]api.OrganizationMember, 0, len(members))
+	for _, member := range members {
+		response = append(response, domainMemberToAPI(member))
+	}
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
+// SetOrganizationMember adds a member to an organization or changes their role
+func (h *OrganizationsHandler) SetOrganizationMember(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, userId openapi_types.UUID) {
+	actorID := h.GetUserIDFromContext(r)
+
+	var req api.SetOrganizationMemberRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	member, err := h.service.SetMember(r.Context(), actorID, uuid.UUID(id), uuid.UUID(userId), domain.MemberRole(req.Role))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainMemberToAPI(member), http.StatusOK)
+}
+
+// RemoveOrganizationMember removes a member from an organization
+func (h *OrganizationsHandler) RemoveOrganizationMember(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, userId openapi_types.UUID) {
+	actorID := h.GetUserIDFromContext(r)
+
+	if err := h.service.RemoveMember(r.Context(), actorID, uuid.UUID(id), uuid.UUID(userId)); err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SetPostOrganization moves a post into an organization
+// NOTE: Authorization middleware checks posts:update:own permission before this is called
+func (h *OrganizationsHandler) SetPostOrganization(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	h.assignContent(w, r, application.ResourcePosts, id)
+}
+
+// RemovePostOrganization takes a post out of its organization
+// NOTE: Authorization middleware checks posts:update:own permission before this is called
+func (h *OrganizationsHandler) RemovePostOrganization(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	h.releaseContent(w, r, application.ResourcePosts, id)
+}
+
+// SetThemeOrganization moves a theme into an organization
+// NOTE: Authorization middleware checks themes:update:own permission before this is called
+func (h *OrganizationsHandler) SetThemeOrganization(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	h.assignContent(w, r, application.ResourceThemes, id)
+}
+
+// RemoveThemeOrganization takes a theme out of its organization
+// NOTE: Authorization middleware checks themes:update:own permission before this is called
+func (h *OrganizationsHandler) RemoveThemeOrganization(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	h.releaseContent(w, r, application.ResourceThemes, id)
+}
+
+// assignContent moves a post or theme into the organization named in the request
+func (h *OrganizationsHandler) assignContent(w http.ResponseWriter, r *http.Request, resourceType string, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	var req api.AssignOrganizationRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	orgID := uuid.UUID(req.OrganizationId)
+	if err := h.service.AssignContent(r.Context(), userID, resourceType, uuid.UUID(id), &orgID); err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// releaseContent takes a post or theme out of its organization
+func (h *OrganizationsHandler) releaseContent(w http.ResponseWriter, r *http.Request, resourceType string, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	if err := h.service.AssignContent(r.Context(), userID, resourceType, uuid.UUID(id), nil); err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func apiOrganizationRequestToParams(req api.OrganizationRequest) application.OrganizationParams {
+	params := application.OrganizationParams{
+		Name: req.Name,
+		Slug: req.Slug,
+	}
+	if req.Description != nil {
+		params.Description = *req.Description
+	}
+	return params
+}
+
+func domainOrganizationToAPI(org *domain.Organization) api.Organization {
+	response := api.Organization{
+		Id:          openapi_types.UUID(org.ID),
+		Name:        org.Name,
+		Slug:        org.Slug,
+		Description: org.Description,
+		CreatedAt:   org.CreatedAt,
+		UpdatedAt:   org.UpdatedAt,
+	}
+	if org.CreatedBy != uuid.Nil {
+		createdBy := openapi_types.UUID(org.CreatedBy)
+		response.CreatedBy = &createdBy
+	}
+	return response
+}
+
+func domainMemberToAPI(member *domain.Member) api.OrganizationMember {
+	return api.OrganizationMember{
+		UserId:   openapi_types.UUID(member.UserID),
+		Role:     api.OrganizationRole(member.Role),
+		JoinedAt: member.JoinedAt,
+	}
+}
@@ -1,13 +1,22 @@
 package rest
 
 import (
-	"encoding/json"
+	"encoding/base64"
+	"errors"
+	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
 	"backend/internal/adapters/api"
+	"backend/internal/platform/httpcache"
+	"backend/internal/platform/timezone"
+	"backend/internal/platform/validator"
 	"backend/internal/posts/application"
 	"backend/internal/posts/domain"
 	"backend/internal/posts/ports"
+	seriesApp "backend/internal/series/application"
+	usersApp "backend/internal/users/application"
 	"github.com/google/uuid"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
@@ -15,14 +24,18 @@ import (
 // PostsHandler handles HTTP requests for posts
 type PostsHandler struct {
 	*BaseHandler
-	service *application.PostsService
+	service       *application.PostsService
+	seriesService *seriesApp.SeriesService
+	authors       authorLoader
 }
 
 // NewPostsHandler creates a new posts handler
-func NewPostsHandler(base *BaseHandler, service *application.PostsService) *PostsHandler {
+func NewPostsHandler(base *BaseHandler, service *application.PostsService, seriesService *seriesApp.SeriesService, usersService *usersApp.UserService) *PostsHandler {
 	return &PostsHandler{
-		BaseHandler: base,
-		service:     service,
+		BaseHandler:   base,
+		service:       service,
+		seriesService: seriesService,
+		authors:       authorLoader{BaseHandler: base, users: usersService},
 	}
 }
 
@@ -35,8 +48,7 @@ func (h *PostsHandler) CreatePost(w http.ResponseWriter, r *http.Request) {
 
 	// Parse request body
 	var req api.CreatePostRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.WriteJSONError(w, r, "validation_error", "Invalid request body", http.StatusBadRequest)
+	if !h.DecodeJSON(w, r, &req) {
 		return
 	}
 
@@ -46,6 +58,17 @@ func (h *PostsHandler) CreatePost(w http.ResponseWriter, r *http.Request) {
 		Content: req.Content,
 		Excerpt: req.Excerpt,
 	}
+	if req.Language != nil {
+		params.Language = *req.Language
+	}
+	if req.Slug != nil {
+		params.Slug = *req.Slug
+	}
+	params.SEO = apiSEOToDomain(req.Seo)
+	params.TargetPublishDate = apiDateToTime(req.TargetPublishDate)
+	params.CommentPolicy = apiCommentPolicyToDomain(req.CommentPolicy)
+	params.AccessPolicy = apiAccessPolicyToDomain(req.AccessPolicy)
+	params.Premium = req.Premium
 
 	post, err := h.service.CreatePost(r.Context(), userID, params)
 	if err != nil {
@@ -60,7 +83,7 @@ func (h *PostsHandler) CreatePost(w http.ResponseWriter, r *http.Request) {
 
 // GetPost retrieves a single post by ID
 // NOTE: Public endpoint - no authorization required
-func (h *PostsHandler) GetPost(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+func (h *PostsHandler) GetPost(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params api.GetPostParams) {
 	// Convert openapi UUID to google UUID
 	postID := uuid.UUID(id)
 
@@ -71,24 +94,107 @@ func (h *PostsHandler) GetPost(w http.ResponseWriter, r *http.Request, id openap
 		return
 	}
 
-	// Convert to API response
+	// Unlisted posts are readable by anyone who has the link; embargoed and members-only ones are not
+	var viewerID *uuid.UUID
+	if userID, ok := h.GetOptionalUserIDFromContext(r); ok {
+		viewerID = &userID
+	}
+	if err := h.service.CheckCanRead(r.Context(), post, viewerID); err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+	httpcache.AddSurrogateKeys(w.Header(), httpcache.PostKey(post.ID))
+
+	// Convert to API response, including highlighted content, series navigation and translations when available
 	response := domainPostToAPI(post)
-	h.WriteJSONResponse(w, r, response, http.StatusOK)
+	response.RenderedContent = h.service.RenderContent(r.Context(), post)
+	h.attachSeriesNavigation(r, post, &response)
+	h.attachTranslations(r, post, &response)
+	h.writePost(w, r, post, response, newResponseShape(params.Fields, params.Embed))
 }
 
 // GetPostBySlug retrieves a post by its slug
 // NOTE: Public endpoint - no authorization required
-func (h *PostsHandler) GetPostBySlug(w http.ResponseWriter, r *http.Request, slug string) {
-	// Get the post
-	post, err := h.service.GetPostBySlug(r.Context(), slug)
+func (h *PostsHandler) GetPostBySlug(w http.ResponseWriter, r *http.Request, slug string, params api.GetPostBySlugParams) {
+	// Signed-in readers may be looking at their own edits, which the published
+	// posts read model serving anonymous readers can trail
+	var post *domain.Post
+	var err error
+	var viewerID *uuid.UUID
+	if userID, ok := h.GetOptionalUserIDFromContext(r); ok {
+		viewerID = &userID
+		post, err = h.service.GetPostBySlug(r.Context(), slug)
+	} else {
+		post, err = h.service.GetPublicPostBySlug(r.Context(), slug)
+	}
 	if err != nil {
 		h.HandleError(w, r, err)
 		return
 	}
+	// Checked before redirecting, so a previous slug does not reveal a hidden post's current one
+	if err := h.service.CheckCanRead(r.Context(), post, viewerID); err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+	httpcache.AddSurrogateKeys(w.Header(), httpcache.PostKey(post.ID))
 
-	// Convert to API response
+	// The post was renamed; point clients at its current slug
+	if post.Slug != slug {
+		h.WriteSlugRedirect(w, r, post.Slug)
+		return
+	}
+
+	// Convert to API response, including highlighted content, series navigation and translations when available
 	response := domainPostToAPI(post)
-	h.WriteJSONResponse(w, r, response, http.StatusOK)
+	response.RenderedContent = h.service.RenderContent(r.Context(), post)
+	h.attachSeriesNavigation(r, post, &response)
+	h.attachTranslations(r, post, &response)
+	h.writePost(w, r, post, response, newResponseShape(params.Fields, params.Embed))
+}
+
+// writePost attaches the requested embeds and writes the selected fields of a post
+func (h *PostsHandler) writePost(w http.ResponseWriter, r *http.Request, post *domain.Post, response api.Post, shape responseShape) {
+	if shape.wants(embedAuthor) {
+		if author := h.authors.author(r, post.AuthorID); author != nil {
+			response.Embedded = &api.PostEmbedded{Author: author}
+		}
+	}
+	h.WriteShapedJSONResponse(w, r, response, shape, http.StatusOK)
+}
+
+// attachSeriesNavigation adds previous/next links for posts that are part of a series.
+// Navigation is supplementary, so a lookup failure is logged rather than failing the read.
+func (h *PostsHandler) attachSeriesNavigation(r *http.Request, post *domain.Post, response *api.Post) {
+	nav, err := h.seriesService.GetPostNavigation(r.Context(), post.ID)
+	if err != nil {
+		h.logger.Warn(r.Context(), "failed to load series navigation", "post_id", post.ID, "error", err)
+		return
+	}
+	response.Series = seriesNavigationToAPI(nav)
+}
+
+// attachTranslations lists the published translations of a post, if it has any.
+// Like series navigation, a lookup failure only drops the links from the response.
+func (h *PostsHandler) attachTranslations(r *http.Request, post *domain.Post, response *api.Post) {
+	translations, err := h.service.ListPublishedTranslations(r.Context(), post)
+	if err != nil {
+		h.logger.Warn(r.Context(), "failed to load translations", "post_id", post.ID, "error", err)
+		return
+	}
+	if len(translations) == 0 {
+		return
+	}
+
+	items := make([]api.PostTranslation, len(translations))
+	for i, translation := range translations {
+		items[i] = api.PostTranslation{
+			PostId:   openapi_types.UUID(translation.PostID),
+			Language: translation.Language,
+			Title:    translation.Title,
+			Slug:     translation.Slug,
+		}
+	}
+	response.Translations = &items
 }
 
 // UpdatePost updates an existing post
@@ -102,16 +208,23 @@ func (h *PostsHandler) UpdatePost(w http.ResponseWriter, r *http.Request, id ope
 
 	// Parse request body
 	var req api.UpdatePostRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.WriteJSONError(w, r, "validation_error", "Invalid request body", http.StatusBadRequest)
+	if !h.DecodeJSON(w, r, &req) {
 		return
 	}
 
 	// Update the post through the service
 	params := application.UpdatePostParams{
-		Title:   req.Title,
-		Content: req.Content,
-		Excerpt: req.Excerpt,
+		Title:    req.Title,
+		Content:  req.Content,
+		Excerpt:  req.Excerpt,
+		Language: req.Language,
+		Slug:     req.Slug,
+		SEO:      apiSEOToDomain(req.Seo),
+
+		TargetPublishDate: apiDateToTime(req.TargetPublishDate),
+		CommentPolicy:     apiCommentPolicyToDomain(req.CommentPolicy),
+		AccessPolicy:      apiAccessPolicyToDomain(req.AccessPolicy),
+		Premium:           req.Premium,
 	}
 
 	post, err := h.service.UpdatePost(r.Context(), userID, postID, params)
@@ -125,6 +238,34 @@ func (h *PostsHandler) UpdatePost(w http.ResponseWriter, r *http.Request, id ope
 	h.WriteJSONResponse(w, r, response, http.StatusOK)
 }
 
+// PatchPost applies a JSON Merge Patch to a post, changing only the fields sent
+// NOTE: Authorization middleware checks posts:update:own permission before this is called
+func (h *PostsHandler) PatchPost(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	// Get authenticated user ID - middleware guarantees this exists
+	userID := h.GetUserIDFromContext(r)
+
+	patch, ok := h.DecodeMergePatch(w, r)
+	if !ok {
+		return
+	}
+
+	params, err := mergePatchToPostParams(patch)
+	if err != nil {
+		h.WriteJSONError(w, r, "validation_error", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	post, err := h.service.PatchPost(r.Context(), userID, uuid.UUID(id), params)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	// Convert to API response
+	response := domainPostToAPI(post)
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
 // PublishPost publishes a draft post
 // NOTE: Authorization middleware checks posts:publish:own permission before this is called
 func (h *PostsHandler) PublishPost(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
@@ -167,6 +308,112 @@ func (h *PostsHandler) UnpublishPost(w http.ResponseWriter, r *http.Request, id
 	h.WriteJSONResponse(w, r, response, http.StatusOK)
 }
 
+// TransitionPost moves a post to another status of the workflow
+// NOTE: The service checks the permission of the transition, which depends on the post's current status
+func (h *PostsHandler) TransitionPost(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	// Get authenticated user ID - middleware guarantees this exists
+	userID := h.GetUserIDFromContext(r)
+
+	var req api.TransitionPostRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	post, err := h.service.TransitionPost(r.Context(), userID, uuid.UUID(id), domain.PostStatus(req.Status))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	response := domainPostToAPI(post)
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
+// GetPostWorkflow lists the statuses posts may take and the transitions between them
+// NOTE: Public endpoint
+func (h *PostsHandler) GetPostWorkflow(w http.ResponseWriter, r *http.Request) {
+	workflow := h.service.Workflow()
+
+	response := api.PostWorkflow{
+		Statuses:    []api.PostStatus{},
+		Transitions: []api.PostTransition{},
+	}
+	for _, status := range workflow.States() {
+		response.Statuses = append(response.Statuses, api.PostStatus(status))
+		for _, transition := range workflow.Transitions(status) {
+			response.Transitions = append(response.Transitions, api.PostTransition{
+				From:       api.PostStatus(transition.From),
+				To:         api.PostStatus(transition.To),
+				Permission: transition.Permission,
+			})
+		}
+	}
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
+// SubmitPostForReview puts a post in the review queue
+// NOTE: Authorization middleware checks posts:update:own permission before this is called
+func (h *PostsHandler) SubmitPostForReview(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	// Get authenticated user ID - middleware guarantees this exists
+	userID := h.GetUserIDFromContext(r)
+
+	// Convert openapi UUID to google UUID
+	postID := uuid.UUID(id)
+
+	// Submit the post for review
+	post, err := h.service.SubmitPostForReview(r.Context(), userID, postID)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	// Return success response
+	response := domainPostToAPI(post)
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
+// ApprovePost publishes a post that passed review
+// NOTE: Authorization middleware checks posts:review permission before this is called
+func (h *PostsHandler) ApprovePost(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	// Get authenticated user ID - middleware guarantees this exists
+	userID := h.GetUserIDFromContext(r)
+
+	// Convert openapi UUID to google UUID
+	postID := uuid.UUID(id)
+
+	// Approve the post
+	post, err := h.service.ApprovePost(r.Context(), userID, postID)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	// Return success response
+	response := domainPostToAPI(post)
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
+// RequestPostChanges sends a post in review back to its author
+// NOTE: Authorization middleware checks posts:review permission before this is called
+func (h *PostsHandler) RequestPostChanges(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	// Get authenticated user ID - middleware guarantees this exists
+	userID := h.GetUserIDFromContext(r)
+
+	// Convert openapi UUID to google UUID
+	postID := uuid.UUID(id)
+
+	// Send the post back
+	post, err := h.service.RequestPostChanges(r.Context(), userID, postID)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	// Return success response
+	response := domainPostToAPI(post)
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
 // ArchivePost archives a post
 // NOTE: Authorization middleware checks posts:archive:own permission before this is called
 func (h *PostsHandler) ArchivePost(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
@@ -188,6 +435,80 @@ func (h *PostsHandler) ArchivePost(w http.ResponseWriter, r *http.Request, id op
 	h.WriteJSONResponse(w, r, response, http.StatusOK)
 }
 
+// FeaturePost marks a published post as featured
+// NOTE: Authorization middleware checks posts:feature permission before this is called
+func (h *PostsHandler) FeaturePost(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	// Get authenticated user ID - middleware guarantees this exists
+	userID := h.GetUserIDFromContext(r)
+
+	// Feature the post
+	post, err := h.service.FeaturePost(r.Context(), userID, uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	// Return success response
+	response := domainPostToAPI(post)
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
+// UnfeaturePost removes a post from the featured set
+// NOTE: Authorization middleware checks posts:feature permission before this is called
+func (h *PostsHandler) UnfeaturePost(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	// Get authenticated user ID - middleware guarantees this exists
+	userID := h.GetUserIDFromContext(r)
+
+	// Unfeature the post
+	post, err := h.service.UnfeaturePost(r.Context(), userID, uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	// Return success response
+	response := domainPostToAPI(post)
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
+// LinkPostTranslation marks a post as a translation of another post
+// NOTE: Authorization middleware checks posts:update:own permission before this is called
+func (h *PostsHandler) LinkPostTranslation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	// Get authenticated user ID - middleware guarantees this exists
+	userID := h.GetUserIDFromContext(r)
+
+	// Parse request body
+	var req api.LinkTranslationRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	post, err := h.service.LinkTranslation(r.Context(), userID, uuid.UUID(id), uuid.UUID(req.TranslationOf))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	response := domainPostToAPI(post)
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
+// UnlinkPostTranslation removes a post from its translation group
+// NOTE: Authorization middleware checks posts:update:own permission before this is called
+func (h *PostsHandler) UnlinkPostTranslation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	// Get authenticated user ID - middleware guarantees this exists
+	userID := h.GetUserIDFromContext(r)
+
+	post, err := h.service.UnlinkTranslation(r.Context(), userID, uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	response := domainPostToAPI(post)
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
 // DeletePost deletes a post
 // NOTE: Authorization middleware checks posts:delete:own permission before this is called
 func (h *PostsHandler) DeletePost(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
@@ -213,6 +534,16 @@ func (h *PostsHandler) DeletePost(w http.ResponseWriter, r *http.Request, id ope
 func (h *PostsHandler) ListPosts(w http.ResponseWriter, r *http.Request, params api.ListPostsParams) {
 	// Build filter from query parameters
 	filter := buildListFilter(params)
+	if viewerID, ok := h.GetOptionalUserIDFromContext(r); ok {
+		filter.ViewerID = &viewerID
+	}
+
+	// Without an explicit language, show each post in the reader's preferred language
+	if filter.Language == "" {
+		if preferred := validator.ParseAcceptLanguage(r.Header.Get("Accept-Language")); len(preferred) > 0 {
+			filter.PreferredLanguage = preferred[0]
+		}
+	}
 
 	// Get posts and count
 	summaries, total, err := h.service.ListPosts(r.Context(), filter)
@@ -220,12 +551,65 @@ func (h *PostsHandler) ListPosts(w http.ResponseWriter, r *http.Request, params
 		h.HandleError(w, r, err)
 		return
 	}
+	httpcache.AddSurrogateKeys(w.Header(), httpcache.PostsKey)
 
 	// Reuse the common response building logic
 	response := buildPaginatedPostsResponse(summaries, total, filter)
+	shape := newResponseShape(params.Fields, params.Embed)
+	if shape.wants(embedAuthor) {
+		h.embedPostAuthors(r, summaries, response.Data)
+	}
+	h.WriteShapedListResponse(w, r, response, shape, http.StatusOK)
+}
+
+// GetPostArchive returns the number of published posts in each month
+// NOTE: Public endpoint
+func (h *PostsHandler) GetPostArchive(w http.ResponseWriter, r *http.Request, params api.GetPostArchiveParams) {
+	var filter ports.ListFilter
+	if params.AuthorId != nil {
+		authorID := uuid.UUID(*params.AuthorId)
+		filter.AuthorID = &authorID
+	}
+	if params.Language != nil {
+		if language, err := validator.NormalizeLanguageTag(*params.Language); err == nil {
+			filter.Language = language
+		}
+	}
+
+	months, err := h.service.GetArchive(r.Context(), filter)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+	httpcache.AddSurrogateKeys(w.Header(), httpcache.PostsKey)
+
+	response := api.PostArchive{Months: make([]api.PostArchiveMonth, len(months))}
+	for i, month := range months {
+		response.Months[i] = api.PostArchiveMonth{
+			Year:  month.Year,
+			Month: int(month.Month),
+			Count: month.Count,
+		}
+		response.Total += month.Count
+	}
 	h.WriteJSONResponse(w, r, response, http.StatusOK)
 }
 
+// embedPostAuthors attaches each listed post's author, loading all of them in one query
+func (h *PostsHandler) embedPostAuthors(r *http.Request, summaries []*ports.PostSummary, items []api.PostSummary) {
+	ids := make([]uuid.UUID, len(summaries))
+	for i, summary := range summaries {
+		ids[i] = summary.AuthorID
+	}
+
+	authors := h.authors.load(r, ids...)
+	for i, summary := range summaries {
+		if author, ok := authors[summary.AuthorID]; ok {
+			items[i].Embedded = &api.PostEmbedded{Author: &author}
+		}
+	}
+}
+
 // GetUserPosts returns posts by a specific user
 // NOTE: Public endpoint - shows only published posts unless requesting own posts
 func (h *PostsHandler) GetUserPosts(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
@@ -236,6 +620,9 @@ func (h *PostsHandler) GetUserPosts(w http.ResponseWriter, r *http.Request, id o
 	// Note: In a future API version, we could accept query params here
 	filter := ports.DefaultListFilter()
 	filter.AuthorID = &userID
+	if viewerID, ok := h.GetOptionalUserIDFromContext(r); ok {
+		filter.ViewerID = &viewerID
+	}
 
 	// Get posts and count
 	summaries, total, err := h.service.ListPosts(r.Context(), filter)
@@ -249,13 +636,127 @@ func (h *PostsHandler) GetUserPosts(w http.ResponseWriter, r *http.Request, id o
 	h.WriteJSONResponse(w, r, response, http.StatusOK)
 }
 
+// GetFeed returns published posts from authors the authenticated user follows
+// NOTE: Any authenticated user may read their own feed
+func (h *PostsHandler) GetFeed(w http.ResponseWriter, r *http.Request, params api.GetFeedParams) {
+	userID := h.GetUserIDFromContext(r)
+
+	// The service applies the default and caps the page size
+	limit := 0
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	var cursor *ports.FeedCursor
+	if params.Cursor != nil && *params.Cursor != "" {
+		decoded, err := decodeFeedCursor(*params.Cursor)
+		if err != nil {
+			h.WriteJSONError(w, r, "validation_error", "Invalid feed cursor", http.StatusBadRequest)
+			return
+		}
+		cursor = decoded
+	}
+
+	summaries, next, err := h.service.ListFeed(r.Context(), userID, cursor, limit)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	data := make([]api.PostSummary, len(summaries))
+	for i, summary := range summaries {
+		data[i] = domainSummaryToAPI(summary)
+		data[i].Bookmarked = &summary.Bookmarked
+	}
+
+	response := api.FeedPage{Data: data}
+	if next != nil {
+		encoded := encodeFeedCursor(next)
+		response.NextCursor = &encoded
+	}
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
+// GetPublicationCalendar lays out published and planned posts by day
+// NOTE: Authorization middleware checks posts:read:draft:any permission before this is called
+func (h *PostsHandler) GetPublicationCalendar(w http.ResponseWriter, r *http.Request, params api.GetPublicationCalendarParams) {
+	userID := h.GetUserIDFromContext(r)
+
+	days, err := h.service.GetCalendar(r.Context(), userID, params.From.Time, params.To.Time)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	loc := timezone.FromContext(r.Context())
+	response := api.PublicationCalendar{
+		From:     params.From,
+		To:       params.To,
+		Timezone: loc.String(),
+		Days:     make([]api.CalendarDay, len(days)),
+	}
+	for i, day := range days {
+		entries := make([]api.CalendarEntry, len(day.Entries))
+		for j, entry := range day.Entries {
+			entries[j] = api.CalendarEntry{
+				PostId:   openapi_types.UUID(entry.PostID),
+				Title:    entry.Title,
+				Slug:     entry.Slug,
+				AuthorId: openapi_types.UUID(entry.AuthorID),
+				Kind:     api.CalendarEntryKind(entry.Kind),
+			}
+			if entry.PublishedAt != nil {
+				publishedAt, local := entry.PublishedAt.UTC(), entry.PublishedAt.In(loc)
+				entries[j].PublishedAt, entries[j].PublishedAtLocal = &publishedAt, &local
+			}
+		}
+		response.Days[i] = api.CalendarDay{Date: openapi_types.Date{Time: day.Date}, Entries: entries}
+	}
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
 // Helper functions
 
+// encodeFeedCursor serializes a feed position into an opaque, URL-safe token
+func encodeFeedCursor(cursor *ports.FeedCursor) string {
+	raw := cursor.PublishedAt.UTC().Format(time.RFC3339Nano) + "|" + cursor.PostID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeFeedCursor parses a token produced by encodeFeedCursor
+func decodeFeedCursor(token string) (*ports.FeedCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+
+	publishedAt, postID, found := strings.Cut(string(raw), "|")
+	if !found {
+		return nil, errors.New("malformed feed cursor")
+	}
+
+	at, err := time.Parse(time.RFC3339Nano, publishedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(postID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ports.FeedCursor{PublishedAt: at, PostID: id}, nil
+}
+
 func buildPaginatedPostsResponse(summaries []*ports.PostSummary, total int, filter ports.ListFilter) api.PaginatedPosts {
 	// Convert to API response
 	apiSummaries := make([]api.PostSummary, len(summaries))
 	for i, summary := range summaries {
 		apiSummaries[i] = domainSummaryToAPI(summary)
+		// Only authenticated viewers get a bookmarked flag
+		if filter.ViewerID != nil {
+			apiSummaries[i].Bookmarked = &summary.Bookmarked
+		}
 	}
 
 	// Calculate pagination metadata
@@ -300,6 +801,28 @@ func buildListFilter(params api.ListPostsParams) ports.ListFilter {
 		filter.AuthorID = &authorID
 	}
 
+	// Featured filter - featured listings default to most recently featured first
+	if params.Featured != nil {
+		featured := *params.Featured
+		filter.Featured = &featured
+		if featured && params.SortBy == nil {
+			filter.OrderBy = ports.OrderByFeaturedAt
+		}
+	}
+
+	// Language filter - invalid tags are ignored rather than matching nothing
+	if params.Language != nil {
+		if language, err := validator.NormalizeLanguageTag(*params.Language); err == nil {
+			filter.Language = language
+		}
+	}
+
+	// Date ranges
+	filter.PublishedAfter = params.PublishedAfter
+	filter.PublishedBefore = params.PublishedBefore
+	filter.CreatedAfter = params.CreatedAfter
+	filter.CreatedBefore = params.CreatedBefore
+
 	// Note: The API doesn't have a search parameter yet, but the filter supports it
 	// This could be added to the OpenAPI spec if needed
 
@@ -312,6 +835,8 @@ func buildListFilter(params api.ListPostsParams) ports.ListFilter {
 			filter.OrderBy = ports.OrderByUpdatedAt
 		case api.ListPostsParamsSortByPublishedAt:
 			filter.OrderBy = ports.OrderByPublishedAt
+		case api.ListPostsParamsSortByFeaturedAt:
+			filter.OrderBy = ports.OrderByFeaturedAt
 		case api.ListPostsParamsSortByTitle:
 			filter.OrderBy = ports.OrderByTitle
 		}
@@ -324,36 +849,170 @@ func buildListFilter(params api.ListPostsParams) ports.ListFilter {
 	return filter
 }
 
+// mergePatchToPostParams maps the members of a post merge patch onto a partial update
+func mergePatchToPostParams(patch mergePatch) (application.PatchPostParams, error) {
+	var params application.PatchPostParams
+	var err error
+
+	fields := map[string]**string{
+		"title":    &params.Title,
+		"content":  &params.Content,
+		"excerpt":  &params.Excerpt,
+		"language": &params.Language,
+		"slug":     &params.Slug,
+	}
+	for name, field := range fields {
+		if *field, err = patch.stringField(name); err != nil {
+			return params, err
+		}
+	}
+
+	if params.TargetPublishDate, err = patch.dateField("targetPublishDate"); err != nil {
+		return params, err
+	}
+
+	seo, present, cleared, err := patch.objectField("seo")
+	if err != nil || !present {
+		return params, err
+	}
+
+	params.SEO = &application.SEOPatch{}
+	seoFields := map[string]**string{
+		"metaTitle":       &params.SEO.MetaTitle,
+		"metaDescription": &params.SEO.MetaDescription,
+		"canonicalUrl":    &params.SEO.CanonicalURL,
+		"ogImageUrl":      &params.SEO.OGImageURL,
+	}
+	for name, field := range seoFields {
+		if cleared {
+			*field = new(string)
+			continue
+		}
+		if *field, err = seo.stringField(name); err != nil {
+			return params, fmt.Errorf("seo.%w", err)
+		}
+	}
+
+	return params, nil
+}
+
 func domainPostToAPI(post *domain.Post) api.Post {
 	apiPost := api.Post{
-		Id:        openapi_types.UUID(post.ID),
-		Title:     post.Title,
-		Content:   post.Content,
-		Excerpt:   post.Excerpt,
-		Slug:      post.Slug,
-		Status:    api.PostStatus(post.Status),
-		AuthorId:  openapi_types.UUID(post.AuthorID),
-		CreatedAt: post.CreatedAt,
-		UpdatedAt: post.UpdatedAt,
+		Id:         openapi_types.UUID(post.ID),
+		Title:      post.Title,
+		Content:    post.Content,
+		Excerpt:    post.Excerpt,
+		Slug:       post.Slug,
+		Status:     api.PostStatus(post.Status),
+		AuthorId:   openapi_types.UUID(post.AuthorID),
+		Featured:   post.Featured,
+		FeaturedAt: post.FeaturedAt,
+		Language:   post.Language,
+		Seo:        domainSEOToAPI(post.SEO),
+		CreatedAt:  post.CreatedAt,
+		UpdatedAt:  post.UpdatedAt,
+	}
+
+	if post.TranslationGroupID != nil {
+		groupID := openapi_types.UUID(*post.TranslationGroupID)
+		apiPost.TranslationGroupId = &groupID
 	}
 
 	if post.PublishedAt != nil {
 		apiPost.PublishedAt = post.PublishedAt
 	}
 
+	if post.TargetPublishDate != nil {
+		apiPost.TargetPublishDate = &openapi_types.Date{Time: *post.TargetPublishDate}
+	}
+
+	autoCloseAfterDays := post.CommentPolicy.AutoCloseAfterDays
+	apiPost.CommentPolicy = &api.CommentPolicy{
+		Mode:               api.CommentPolicyMode(post.CommentPolicy.Mode),
+		AutoCloseAfterDays: &autoCloseAfterDays,
+	}
+	apiPost.CommentsCloseAt = post.CommentPolicy.ClosesAt(post.PublishedAt)
+	apiPost.AccessPolicy = &api.AccessPolicy{
+		Visibility:   api.AccessPolicyVisibility(post.AccessPolicy.Visibility),
+		EmbargoUntil: post.AccessPolicy.EmbargoUntil,
+	}
+	apiPost.Premium = post.Premium
+	apiPost.ContentTruncated = post.ContentTruncated
+
 	return apiPost
 }
 
+// apiCommentPolicyToDomain converts optional comment settings from a request;
+// an omitted auto-close delay keeps comments open
+func apiCommentPolicyToDomain(policy *api.CommentPolicy) *domain.CommentPolicy {
+	if policy == nil {
+		return nil
+	}
+	result := &domain.CommentPolicy{Mode: domain.CommentMode(policy.Mode)}
+	if policy.AutoCloseAfterDays != nil {
+		result.AutoCloseAfterDays = *policy.AutoCloseAfterDays
+	}
+	return result
+}
+
+// apiAccessPolicyToDomain converts optional visibility and embargo settings from a request
+func apiAccessPolicyToDomain(policy *api.AccessPolicy) *domain.AccessPolicy {
+	if policy == nil {
+		return nil
+	}
+	return &domain.AccessPolicy{
+		Visibility:   domain.PostVisibility(policy.Visibility),
+		EmbargoUntil: policy.EmbargoUntil,
+	}
+}
+
+// apiDateToTime converts an optional calendar date from a request
+func apiDateToTime(date *openapi_types.Date) *time.Time {
+	if date == nil {
+		return nil
+	}
+	return &date.Time
+}
+
+// domainSEOToAPI omits the SEO object entirely when no field is set
+func domainSEOToAPI(seo domain.SEOMetadata) *api.PostSEO {
+	if seo == (domain.SEOMetadata{}) {
+		return nil
+	}
+	return &api.PostSEO{
+		MetaTitle:       stringToPointer(seo.MetaTitle),
+		MetaDescription: stringToPointer(seo.MetaDescription),
+		CanonicalUrl:    stringToPointer(seo.CanonicalURL),
+		OgImageUrl:      stringToPointer(seo.OGImageURL),
+	}
+}
+
+func apiSEOToDomain(seo *api.PostSEO) *domain.SEOMetadata {
+	if seo == nil {
+		return nil
+	}
+	return &domain.SEOMetadata{
+		MetaTitle:       getStringValue(seo.MetaTitle),
+		MetaDescription: getStringValue(seo.MetaDescription),
+		CanonicalURL:    getStringValue(seo.CanonicalUrl),
+		OGImageURL:      getStringValue(seo.OgImageUrl),
+	}
+}
+
 func domainSummaryToAPI(summary *ports.PostSummary) api.PostSummary {
 	apiSummary := api.PostSummary{
-		Id:        openapi_types.UUID(summary.ID),
-		Title:     summary.Title,
-		Excerpt:   summary.Excerpt,
-		Slug:      summary.Slug,
-		Status:    api.PostSummaryStatus(summary.Status),
-		AuthorId:  openapi_types.UUID(summary.AuthorID),
-		CreatedAt: summary.CreatedAt,
-		ViewCount: 0, // View count not tracked yet
+		Id:            openapi_types.UUID(summary.ID),
+		Title:         summary.Title,
+		Excerpt:       summary.Excerpt,
+		Slug:          summary.Slug,
+		Status:        api.PostStatus(summary.Status),
+		AuthorId:      openapi_types.UUID(summary.AuthorID),
+		Featured:      summary.Featured,
+		FeaturedAt:    summary.FeaturedAt,
+		Language:      summary.Language,
+		CreatedAt:     summary.CreatedAt,
+		ViewCount:     0, // View count not tracked yet
+		ReactionCount: summary.ReactionCount,
 	}
 
 	// Set published date - use created date as fallback if not published
@@ -0,0 +1,182 @@
+package rest
+
+import (
+	"net/http"
+
+	"backend/internal/adapters/api"
+	"backend/internal/privacy/application"
+	"backend/internal/privacy/domain"
+	"backend/internal/privacy/ports"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// PrivacyHandler handles HTTP requests for the erasure of personal data
+type PrivacyHandler struct {
+	*BaseHandler
+	service *application.ErasureService
+}
+
+// NewPrivacyHandler creates a new privacy handler
+func NewPrivacyHandler(base *BaseHandler, service *application.ErasureService) *PrivacyHandler {
+	return &PrivacyHandler{
+		BaseHandler: base,
+		service:     service,
+	}
+}
+
+// GetOwnErasureRequest returns the authenticated user's latest erasure request
+func (h *PrivacyHandler) GetOwnErasureRequest(w http.ResponseWriter, r *http.Request) {
+	userID := h.GetUserIDFromContext(r)
+
+	request, err := h.service.GetOwnRequest(r.Context(), userID)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+	h.WriteJSONResponse(w, r, domainErasureRequestToAPI(request), http.StatusOK)
+}
+
+// RequestOwnErasure queues the erasure of the authenticated user's data
+// NOTE: Authorization middleware checks users:delete:self permission before this is called
+func (h *PrivacyHandler) RequestOwnErasure(w http.ResponseWriter, r *http.Request) {
+	userID := h.GetUserIDFromContext(r)
+
+	request, err := h.service.RequestErasure(r.Context(), userID, userID)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+	h.WriteJSONResponse(w, r, domainErasureRequestToAPI(request), http.StatusAccepted)
+}
+
+// ListErasureRequests returns erasure requests, newest first
+// NOTE: Authorization middleware checks users:delete:any permission before this is called
+func (h *PrivacyHandler) ListErasureRequests(w http.ResponseWriter, r *http.Request, params api.ListErasureRequestsParams) {
+	userID := h.GetUserIDFromContext(r)
+
+	filter := ports.ListFilter{}
+	if params.Status != nil {
+		filter.Status = domain.Status(*params.Status)
+	}
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+	if params.Offset != nil {
+		filter.Offset = *params.Offset
+	}
+
+	requests, err := h.service.ListRequests(r.Context(), userID, filter)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	response := make([]api.ErasureRequest, len(requests))
+	for i, request := range requests {
+		response[i] = domainErasureRequestToAPI(request)
+	}
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
+// CreateErasureRequest queues the erasure of a user's data on their behalf
+// NOTE: Authorization middleware checks users:delete:any permission before this is called
+func (h *PrivacyHandler) CreateErasureRequest(w http.ResponseWriter, r *http.Request) {
+	userID := h.GetUserIDFromContext(r)
+
+	var req api.CreateErasureRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	request, err := h.service.RequestErasure(r.Context(), userID, uuid.UUID(req.UserId))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+	h.WriteJSONResponse(w, r, domainErasureRequestToAPI(request), http.StatusAccepted)
+}
+
+// GetErasureRequest returns an erasure request with its progress
+// NOTE: Authorization middleware checks users:delete:any permission before this is called
+func (h *PrivacyHandler) GetErasureRequest(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	request, err := h.service.GetRequest(r.Context(), userID, uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+	h.WriteJSONResponse(w, r, domainErasureRequestToAPI(request), http.StatusOK)
+}
+
+// RetryErasureRequest queues a failed erasure request again
+// NOTE: Authorization middleware checks users:delete:any permission before this is called
+func (h *PrivacyHandler) RetryErasureRequest(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	request, err := h.service.RetryRequest(r.Context(), userID, uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+	h.WriteJSONResponse(w, r, domainErasureRequestToAPI(request), http.StatusAccepted)
+}
+
+// GetErasureCertificate returns the certificate of a completed erasure request
+// NOTE: Authorization middleware checks users:delete:any permission before this is called
+func (h *PrivacyHandler) GetErasureCertificate(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	certificate, err := h.service.GetCertificate(r.Context(), userID, uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, api.ErasureCertificate{
+		Id:          openapi_types.UUID(certificate.ID),
+		RequestId:   openapi_types.UUID(certificate.RequestID),
+		SubjectId:   openapi_types.UUID(certificate.SubjectID),
+		Steps:       domainErasureStepsToAPI(certificate.Steps),
+		RequestedAt: certificate.RequestedAt,
+		CompletedAt: certificate.CompletedAt,
+		Digest:      certificate.Digest,
+		Verified:    certificate.Verify() == nil,
+	}, http.StatusOK)
+}
+
+func domainErasureRequestToAPI(request *domain.Request) api.ErasureRequest {
+	done, total := request.Progress()
+	response := api.ErasureRequest{
+		Id:          openapi_types.UUID(request.ID),
+		SubjectId:   openapi_types.UUID(request.SubjectID),
+		Status:      api.ErasureRequestStatus(request.Status),
+		StepsDone:   done,
+		StepsTotal:  total,
+		Steps:       domainErasureStepsToAPI(request.Steps),
+		CreatedAt:   request.CreatedAt,
+		StartedAt:   request.StartedAt,
+		CompletedAt: request.CompletedAt,
+	}
+	if request.RequestedBy != uuid.Nil {
+		requestedBy := openapi_types.UUID(request.RequestedBy)
+		response.RequestedBy = &requestedBy
+	}
+	if request.LastError != "" {
+		response.LastError = &request.LastError
+	}
+	return response
+}
+
+func domainErasureStepsToAPI(steps []domain.Step) []api.ErasureStep {
+	response := make([]api.ErasureStep, len(steps))
+	for i, step := range steps {
+		response[i] = api.ErasureStep{
+			Name:        step.Name,
+			Records:     step.Records,
+			CompletedAt: step.CompletedAt,
+		}
+	}
+	return response
+}
@@ -0,0 +1,84 @@
+package rest
+
+import (
+	"mime"
+	"net/http"
+	"strings"
+
+	"backend/internal/platform/requestid"
+)
+
+// ProblemContentType is the media type of RFC 7807 problem details
+const ProblemContentType = "application/problem+json"
+
+// ErrorFormat names how error responses are written
+type ErrorFormat string
+
+const (
+	// ErrorFormatJSON writes {"error", "message", ...}, unless the request accepts problem details
+	ErrorFormatJSON ErrorFormat = "json"
+	// ErrorFormatProblem writes problem details whatever the request accepts
+	ErrorFormatProblem ErrorFormat = "problem"
+)
+
+// DefaultProblemTypeBase prefixes the type URI of problem details
+const DefaultProblemTypeBase = "/problems/"
+
+// ErrorConfig selects the format of error responses
+type ErrorConfig struct {
+	Format ErrorFormat
+	// ProblemTypeBase prefixes the kebab-cased business code in a problem's type,
+	// such as https://docs.example.com/problems/ for /problems/user-not-found
+	ProblemTypeBase string
+}
+
+// wantsProblem reports whether the error response to r should be problem details
+func (c ErrorConfig) wantsProblem(r *http.Request) bool {
+	if c.Format == ErrorFormatProblem {
+		return true
+	}
+	for _, accepted := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(accepted))
+		if err == nil && mediaType == ProblemContentType && params["q"] != "0" {
+			return true
+		}
+	}
+	return false
+}
+
+// problemType derives a problem's type URI from its business code, or from the
+// error code when there is none: USER_NOT_FOUND becomes /problems/user-not-found
+func (c ErrorConfig) problemType(code string) string {
+	base := c.ProblemTypeBase
+	if base == "" {
+		base = DefaultProblemTypeBase
+	}
+	slug := strings.ReplaceAll(strings.ToLower(code), "_", "-")
+	return strings.TrimRight(base, "/") + "/" + slug
+}
+
+// problemDetails builds the body of a problem details response. The code,
+// business code and context of the default format are kept as extension members.
+func (c ErrorConfig) problemDetails(r *http.Request, code, message string, statusCode int, details map[string]any) map[string]any {
+	typeCode := code
+	if businessCode, ok := details["business_code"].(string); ok && businessCode != "" {
+		typeCode = businessCode
+	}
+
+	problem := map[string]any{
+		"type":   c.problemType(typeCode),
+		"title":  http.StatusText(statusCode),
+		"status": statusCode,
+		"detail": message,
+		"code":   code,
+	}
+	if id := requestid.FromContext(r.Context()); id != "" {
+		problem["instance"] = id
+	}
+	for k, v := range details {
+		if _, reserved := problem[k]; !reserved {
+			problem[k] = v
+		}
+	}
+	return problem
+}
@@ -12,5 +12,36 @@ var ProviderSet = wire.NewSet(
 	NewAuthzHandler,
 	NewPostsHandler,
 	NewThemesHandler,
+	NewThemeCollaboratorsHandler,
+	NewSeriesHandler,
+	NewReactionsHandler,
+	NewReportsHandler,
+	NewBookmarksHandler,
+	NewFollowsHandler,
+	NewExportHandler,
+	NewBlogsHandler,
+	NewAnnouncementsHandler,
+	NewFeedbackHandler,
+	NewMentionsHandler,
+	NewAnalyticsHandler,
+	NewShareCardsHandler,
+	NewHomeHandler,
+	NewSearchHandler,
+	NewCacheHandler,
+	NewIntegrityHandler,
+	NewRetentionHandler,
+	NewLinkReportsHandler,
+	NewEventsHandler,
+	NewSettingsHandler,
+	NewAPIClientsHandler,
+	NewQuotasHandler,
+	NewImpersonationHandler,
+	NewServiceAccountsHandler,
+	NewPrivacyHandler,
+	NewDataExportHandler,
+	NewSecurityEventsHandler,
+	NewActionLinksHandler,
+	NewOrganizationsHandler,
+	NewOpenAPIHandler,
 	NewServer, // Combined server that implements api.ServerInterface
 )
@@ -0,0 +1,99 @@
+package rest
+
+import (
+	"net/http"
+
+	"backend/internal/adapters/api"
+	"backend/internal/quotas/application"
+	"backend/internal/quotas/domain"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// QuotasHandler handles HTTP requests for content quotas
+type QuotasHandler struct {
+	*BaseHandler
+	service *application.QuotaService
+}
+
+// NewQuotasHandler creates a new quotas handler
+func NewQuotasHandler(base *BaseHandler, service *application.QuotaService) *QuotasHandler {
+	return &QuotasHandler{
+		BaseHandler: base,
+		service:     service,
+	}
+}
+
+// GetMyLimits returns the authenticated user's limits and usage
+func (h *QuotasHandler) GetMyLimits(w http.ResponseWriter, r *http.Request) {
+	userID := h.GetUserIDFromContext(r)
+
+	quota, err := h.service.GetQuota(r.Context(), userID)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainQuotaToAPI(quota), http.StatusOK)
+}
+
+// GetUserLimits returns another user's limits and usage
+// NOTE: Authorization middleware checks quotas:manage permission before this is called
+func (h *QuotasHandler) GetUserLimits(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	actorID := h.GetUserIDFromContext(r)
+
+	quota, err := h.service.GetUserQuota(r.Context(), actorID, uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainQuotaToAPI(quota), http.StatusOK)
+}
+
+// UpdateUserLimits overrides a user's limits
+// NOTE: Authorization middleware checks quotas:manage permission before this is called
+func (h *QuotasHandler) UpdateUserLimits(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	actorID := h.GetUserIDFromContext(r)
+
+	var req api.UpdateUserLimitsRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	quota, err := h.service.SetUserLimits(r.Context(), actorID, uuid.UUID(id), req.MaxDrafts, req.MaxPostBytes, req.MaxThemes)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainQuotaToAPI(quota), http.StatusOK)
+}
+
+// ResetUserLimits removes a user's override
+// NOTE: Authorization middleware checks quotas:manage permission before this is called
+func (h *QuotasHandler) ResetUserLimits(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	actorID := h.GetUserIDFromContext(r)
+
+	if err := h.service.ClearUserLimits(r.Context(), actorID, uuid.UUID(id)); err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func domainQuotaToAPI(quota *domain.Quota) api.UserLimits {
+	return api.UserLimits{
+		Limits: api.QuotaLimits{
+			MaxDrafts:    quota.Limits.MaxDrafts,
+			MaxPostBytes: quota.Limits.MaxPostBytes,
+			MaxThemes:    quota.Limits.MaxThemes,
+		},
+		Usage: api.QuotaUsage{
+			Drafts: quota.Usage.Drafts,
+			Themes: quota.Usage.Themes,
+		},
+		Overridden: quota.Overridden,
+	}
+}
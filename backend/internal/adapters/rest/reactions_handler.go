@@ -0,0 +1,91 @@
+package rest
+
+import (
+	"net/http"
+
+	"backend/internal/adapters/api"
+	"backend/internal/reactions/application"
+	"backend/internal/reactions/domain"
+	"backend/internal/reactions/ports"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// ReactionsHandler handles HTTP requests for reactions
+type ReactionsHandler struct {
+	*BaseHandler
+	service *application.ReactionsService
+}
+
+// NewReactionsHandler creates a new reactions handler
+func NewReactionsHandler(base *BaseHandler, service *application.ReactionsService) *ReactionsHandler {
+	return &ReactionsHandler{
+		BaseHandler: base,
+		service:     service,
+	}
+}
+
+// GetPostReactions returns the aggregated reactions on a post
+// NOTE: Public endpoint - no authorization required
+func (h *ReactionsHandler) GetPostReactions(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	summary, err := h.service.GetReactionSummary(r.Context(), domain.TargetPost, uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainReactionSummaryToAPI(summary), http.StatusOK)
+}
+
+// SetPostReaction sets the authenticated user's reaction on a post
+// NOTE: Authorization middleware checks reactions:create permission before this is called
+func (h *ReactionsHandler) SetPostReaction(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	var req api.SetReactionRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	summary, err := h.service.React(r.Context(), userID, domain.TargetPost, uuid.UUID(id), domain.ReactionType(req.Type))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainReactionSummaryToAPI(summary), http.StatusOK)
+}
+
+// RemovePostReaction removes the authenticated user's reaction from a post
+// NOTE: Authorization middleware checks reactions:create permission before this is called
+func (h *ReactionsHandler) RemovePostReaction(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	if err := h.service.RemoveReaction(r.Context(), userID, domain.TargetPost, uuid.UUID(id)); err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Helper functions for converting between domain and API types
+
+func domainReactionSummaryToAPI(summary *ports.ReactionSummary) api.ReactionSummary {
+	counts := make(map[string]int, len(summary.Counts))
+	for reactionType, count := range summary.Counts {
+		counts[string(reactionType)] = count
+	}
+
+	apiSummary := api.ReactionSummary{
+		Total:  summary.Total,
+		Counts: counts,
+	}
+
+	if summary.ViewerReaction != nil {
+		viewerReaction := api.ReactionType(*summary.ViewerReaction)
+		apiSummary.ViewerReaction = &viewerReaction
+	}
+
+	return apiSummary
+}
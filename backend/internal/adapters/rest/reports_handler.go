@@ -0,0 +1,160 @@
+package rest
+
+import (
+	"net/http"
+
+	"backend/internal/adapters/api"
+	"backend/internal/reports/application"
+	"backend/internal/reports/domain"
+	"backend/internal/reports/ports"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// ReportsHandler handles HTTP requests for abuse reports
+type ReportsHandler struct {
+	*BaseHandler
+	service *application.ReportsService
+}
+
+// NewReportsHandler creates a new reports handler
+func NewReportsHandler(base *BaseHandler, service *application.ReportsService) *ReportsHandler {
+	return &ReportsHandler{
+		BaseHandler: base,
+		service:     service,
+	}
+}
+
+// ReportPost files the authenticated user's report against a post
+// NOTE: Authorization middleware checks reports:create permission before this is called
+func (h *ReportsHandler) ReportPost(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	var req api.CreateReportRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	var details string
+	if req.Details != nil {
+		details = *req.Details
+	}
+
+	report, err := h.service.FileReport(r.Context(), userID, domain.TargetPost, uuid.UUID(id), domain.Reason(req.Reason), details)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainReportToAPI(report), http.StatusCreated)
+}
+
+// ListReports returns the moderation queue
+// NOTE: Authorization middleware checks comments:moderate permission before this is called
+func (h *ReportsHandler) ListReports(w http.ResponseWriter, r *http.Request, params api.ListReportsParams) {
+	userID := h.GetUserIDFromContext(r)
+
+	// Pagination - convert page-based to offset-based
+	limit := 20
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = *params.Limit
+	}
+	offset := 0
+	if params.Page != nil && *params.Page > 0 {
+		offset = (*params.Page - 1) * limit
+	}
+
+	filter := ports.ListFilter{Limit: limit, Offset: offset}
+	if params.Status != nil {
+		status := domain.Status(*params.Status)
+		filter.Status = &status
+	}
+	if params.TargetType != nil {
+		targetType := domain.TargetType(*params.TargetType)
+		filter.TargetType = &targetType
+	}
+	if params.TargetId != nil {
+		targetID := uuid.UUID(*params.TargetId)
+		filter.TargetID = &targetID
+	}
+
+	reports, total, err := h.service.ListReports(r.Context(), userID, filter)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	data := make([]api.Report, len(reports))
+	for i, report := range reports {
+		data[i] = domainReportToAPI(report)
+	}
+
+	response := api.PaginatedReports{
+		Data: data,
+		Meta: api.PaginationMeta{
+			TotalItems:   total,
+			ItemsPerPage: limit,
+			CurrentPage:  (offset / limit) + 1,
+			TotalPages:   (total + limit - 1) / limit,
+		},
+	}
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
+// ResolveReport carries out a moderator's action on a report and closes it
+// NOTE: Authorization middleware checks comments:moderate permission before this is called
+func (h *ReportsHandler) ResolveReport(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	var req api.ResolveReportRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	var note string
+	if req.Note != nil {
+		note = *req.Note
+	}
+
+	report, err := h.service.ResolveReport(r.Context(), userID, uuid.UUID(id), domain.Action(req.Action), note)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainReportToAPI(report), http.StatusOK)
+}
+
+// Helper functions for converting between domain and API types
+
+func domainReportToAPI(report *domain.Report) api.Report {
+	apiReport := api.Report{
+		Id:         openapi_types.UUID(report.ID),
+		ReporterId: openapi_types.UUID(report.ReporterID),
+		TargetType: api.ReportTargetType(report.TargetType),
+		TargetId:   openapi_types.UUID(report.TargetID),
+		Reason:     api.ReportReason(report.Reason),
+		Details:    report.Details,
+		Status:     api.ReportStatus(report.Status),
+		ResolvedAt: report.ResolvedAt,
+		CreatedAt:  report.CreatedAt,
+	}
+
+	if report.TargetAuthorID != nil {
+		authorID := openapi_types.UUID(*report.TargetAuthorID)
+		apiReport.TargetAuthorId = &authorID
+	}
+	if report.Resolution != nil {
+		resolution := api.ReportAction(*report.Resolution)
+		apiReport.Resolution = &resolution
+	}
+	if report.ResolutionNote != "" {
+		apiReport.ResolutionNote = &report.ResolutionNote
+	}
+	if report.ResolvedBy != nil {
+		moderatorID := openapi_types.UUID(*report.ResolvedBy)
+		apiReport.ResolvedBy = &moderatorID
+	}
+
+	return apiReport
+}
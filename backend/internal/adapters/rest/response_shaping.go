@@ -0,0 +1,193 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"backend/internal/adapters/api"
+	usersApp "backend/internal/users/application"
+	"backend/internal/users/domain"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Embeddable related resources, as named by the embed query parameter
+const (
+	embedAuthor   = "author"
+	embedArticles = "articles"
+)
+
+// alwaysSelected lists the fields returned whatever the fields parameter asks for
+var alwaysSelected = []string{"id", "embedded"}
+
+// responseShape is a client's request to trim a read response with ?fields= and
+// enrich it with ?embed=. Handlers build the full response, attach the embeds the
+// shape asks for, and write it with WriteShapedJSONResponse or WriteShapedListResponse.
+type responseShape struct {
+	fields map[string]bool // nil keeps every field
+	embeds map[string]bool
+}
+
+// newResponseShape builds a shape from the generated fields and embed parameters
+// The spec restricts both, so the values are only split and trimmed here
+func newResponseShape(fields *string, embed *[]string) responseShape {
+	var shape responseShape
+
+	if fields != nil && strings.TrimSpace(*fields) != "" {
+		shape.fields = make(map[string]bool)
+		for _, name := range strings.Split(*fields, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				shape.fields[name] = true
+			}
+		}
+		for _, name := range alwaysSelected {
+			shape.fields[name] = true
+		}
+	}
+
+	if embed != nil {
+		shape.embeds = make(map[string]bool, len(*embed))
+		for _, name := range *embed {
+			shape.embeds[strings.TrimSpace(name)] = true
+		}
+	}
+
+	return shape
+}
+
+// wants reports whether the client asked for the named related resource
+func (s responseShape) wants(embed string) bool {
+	return s.embeds[embed]
+}
+
+// selectFields keeps the selected top-level keys of a JSON object
+func (s responseShape) selectFields(object map[string]json.RawMessage) map[string]json.RawMessage {
+	for key := range object {
+		if !s.fields[key] {
+			delete(object, key)
+		}
+	}
+	return object
+}
+
+// shapeItem applies the field selection to a single resource
+func (s responseShape) shapeItem(data any) (any, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &object); err != nil {
+		return nil, err
+	}
+	return s.selectFields(object), nil
+}
+
+// shapeList applies the field selection to every item of a paginated response,
+// leaving the pagination metadata untouched
+func (s responseShape) shapeList(data any) (any, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var page map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &page); err != nil {
+		return nil, err
+	}
+
+	var items []map[string]json.RawMessage
+	if err := json.Unmarshal(page["data"], &items); err != nil {
+		return nil, err
+	}
+	for i := range items {
+		items[i] = s.selectFields(items[i])
+	}
+
+	shapedItems, err := json.Marshal(items)
+	if err != nil {
+		return nil, err
+	}
+	page["data"] = shapedItems
+	return page, nil
+}
+
+// WriteShapedJSONResponse writes a single resource, keeping only the selected fields
+func (h *BaseHandler) WriteShapedJSONResponse(w http.ResponseWriter, r *http.Request, data any, shape responseShape, statusCode int) {
+	h.writeShaped(w, r, data, shape, shape.shapeItem, statusCode)
+}
+
+// WriteShapedListResponse writes a paginated response, applying the field selection to each item
+func (h *BaseHandler) WriteShapedListResponse(w http.ResponseWriter, r *http.Request, data any, shape responseShape, statusCode int) {
+	h.writeShaped(w, r, data, shape, shape.shapeList, statusCode)
+}
+
+func (h *BaseHandler) writeShaped(w http.ResponseWriter, r *http.Request, data any, shape responseShape, apply func(any) (any, error), statusCode int) {
+	if shape.fields == nil {
+		h.WriteJSONResponse(w, r, data, statusCode)
+		return
+	}
+
+	shaped, err := apply(data)
+	if err != nil {
+		h.logger.Error(r.Context(), "failed to shape response", "error", err)
+		h.WriteJSONError(w, r, "INTERNAL_SERVER_ERROR", "An unexpected error occurred", http.StatusInternalServerError)
+		return
+	}
+	h.WriteJSONResponse(w, r, shaped, statusCode)
+}
+
+// authorLoader fetches the public profiles embedded with embed=author
+type authorLoader struct {
+	*BaseHandler
+	users *usersApp.UserService
+}
+
+// load returns the profiles of the given users in one query, keyed by user ID.
+// Embeds are supplementary, so a lookup failure is logged and yields no authors.
+func (l authorLoader) load(r *http.Request, ids ...uuid.UUID) map[uuid.UUID]api.AuthorSummary {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			keys = append(keys, id.String())
+		}
+	}
+
+	users, err := l.users.GetUsersByIDs(r.Context(), keys)
+	if err != nil {
+		l.logger.Warn(r.Context(), "failed to load embedded authors", "error", err)
+		return nil
+	}
+
+	authors := make(map[uuid.UUID]api.AuthorSummary, len(users))
+	for _, user := range users {
+		id, err := uuid.Parse(user.ID)
+		if err != nil {
+			continue
+		}
+		authors[id] = domainUserToAuthorSummary(id, user)
+	}
+	return authors
+}
+
+// author returns the profile of a single user, or nil when it could not be loaded
+func (l authorLoader) author(r *http.Request, id uuid.UUID) *api.AuthorSummary {
+	author, ok := l.load(r, id)[id]
+	if !ok {
+		return nil
+	}
+	return &author
+}
+
+func domainUserToAuthorSummary(id uuid.UUID, user *domain.User) api.AuthorSummary {
+	return api.AuthorSummary{
+		Id:          openapi_types.UUID(id),
+		Username:    user.Username,
+		DisplayName: stringToPointer(user.DisplayName),
+		AvatarUrl:   stringToPointer(user.AvatarURL),
+	}
+}
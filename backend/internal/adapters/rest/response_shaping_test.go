@@ -0,0 +1,84 @@
+package rest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"backend/internal/adapters/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func stringPtr(s string) *string { return &s }
+
+func TestNewResponseShape(t *testing.T) {
+	t.Run("no parameters keeps every field and embeds nothing", func(t *testing.T) {
+		shape := newResponseShape(nil, nil)
+		assert.Nil(t, shape.fields)
+		assert.False(t, shape.wants(embedAuthor))
+	})
+
+	t.Run("fields always include id and embedded", func(t *testing.T) {
+		shape := newResponseShape(stringPtr("title,slug"), nil)
+		assert.Equal(t, map[string]bool{"id": true, "embedded": true, "title": true, "slug": true}, shape.fields)
+	})
+
+	t.Run("embeds are recognised by name", func(t *testing.T) {
+		shape := newResponseShape(nil, &[]string{"author", "articles"})
+		assert.True(t, shape.wants(embedAuthor))
+		assert.True(t, shape.wants(embedArticles))
+	})
+}
+
+func TestResponseShapeSelectsFields(t *testing.T) {
+	shape := newResponseShape(stringPtr("title"), &[]string{"author"})
+	post := api.Post{
+		Title:    "Hello",
+		Content:  "<p>a long body</p>",
+		Embedded: &api.PostEmbedded{Author: &api.AuthorSummary{Username: "ada"}},
+	}
+
+	shaped, err := shape.shapeItem(post)
+	require.NoError(t, err)
+
+	raw, err := json.Marshal(shaped)
+	require.NoError(t, err)
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(raw, &got))
+	assert.ElementsMatch(t, []string{"id", "title", "embedded"}, keys(got))
+}
+
+func TestResponseShapeSelectsFieldsOfListItems(t *testing.T) {
+	shape := newResponseShape(stringPtr("slug"), nil)
+	page := api.PaginatedPosts{
+		Data: []api.PostSummary{{Slug: "first", Title: "First"}, {Slug: "second", Title: "Second"}},
+		Meta: api.PaginationMeta{TotalItems: 2, ItemsPerPage: 20, CurrentPage: 1, TotalPages: 1},
+	}
+
+	shaped, err := shape.shapeList(page)
+	require.NoError(t, err)
+
+	raw, err := json.Marshal(shaped)
+	require.NoError(t, err)
+
+	var got struct {
+		Data []map[string]any   `json:"data"`
+		Meta api.PaginationMeta `json:"meta"`
+	}
+	require.NoError(t, json.Unmarshal(raw, &got))
+	require.Len(t, got.Data, 2)
+	for _, item := range got.Data {
+		assert.ElementsMatch(t, []string{"id", "slug"}, keys(item))
+	}
+	assert.Equal(t, "second", got.Data[1]["slug"])
+	assert.Equal(t, page.Meta, got.Meta)
+}
+
+func keys(object map[string]any) []string {
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+	return names
+}
@@ -0,0 +1,68 @@
+package rest
+
+import (
+	"net/http"
+
+	"backend/internal/adapters/api"
+	"backend/internal/retention/application"
+	"backend/internal/retention/domain"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// RetentionHandler handles HTTP requests for the post retention policies
+type RetentionHandler struct {
+	*BaseHandler
+	service *application.RetentionService
+}
+
+// NewRetentionHandler creates a new retention handler
+func NewRetentionHandler(base *BaseHandler, service *application.RetentionService) *RetentionHandler {
+	return &RetentionHandler{
+		BaseHandler: base,
+		service:     service,
+	}
+}
+
+// RunRetention applies the retention policies to the current blog, or reports what they would purge
+// NOTE: Authorization middleware checks settings:system permission before this is called
+func (h *RetentionHandler) RunRetention(w http.ResponseWriter, r *http.Request, params api.RunRetentionParams) {
+	userID := h.GetUserIDFromContext(r)
+	dryRun := params.DryRun != nil && *params.DryRun
+
+	report, err := h.service.Run(r.Context(), userID, dryRun)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, retentionReportToAPI(report), http.StatusOK)
+}
+
+func retentionReportToAPI(report *domain.Report) api.RetentionReport {
+	policies := make([]api.RetentionPolicyResult, 0, len(report.Results))
+	for _, result := range report.Results {
+		posts := make([]api.RetentionCandidate, 0, len(result.Candidates))
+		for _, candidate := range result.Candidates {
+			posts = append(posts, api.RetentionCandidate{
+				PostId:    openapi_types.UUID(candidate.PostID),
+				AuthorId:  openapi_types.UUID(candidate.AuthorID),
+				Title:     candidate.Title,
+				UpdatedAt: candidate.UpdatedAt,
+			})
+		}
+		policies = append(policies, api.RetentionPolicyResult{
+			Policy:      api.RetentionPolicyResultPolicy(result.Policy),
+			Description: result.Policy.Description(),
+			Cutoff:      result.Cutoff,
+			Posts:       posts,
+			Purged:      result.Purged,
+		})
+	}
+
+	return api.RetentionReport{
+		RanAt:    report.RanAt,
+		DryRun:   report.DryRun,
+		Purged:   report.PurgedCount(),
+		Policies: policies,
+	}
+}
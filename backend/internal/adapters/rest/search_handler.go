@@ -0,0 +1,75 @@
+package rest
+
+import (
+	"net/http"
+
+	"backend/internal/adapters/api"
+	"backend/internal/platform/httpcache"
+	"backend/internal/search/application"
+	"backend/internal/search/domain"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// SearchHandler handles HTTP requests for search suggestions
+type SearchHandler struct {
+	*BaseHandler
+	service *application.SearchService
+}
+
+// NewSearchHandler creates a new search handler
+func NewSearchHandler(base *BaseHandler, service *application.SearchService) *SearchHandler {
+	return &SearchHandler{
+		BaseHandler: base,
+		service:     service,
+	}
+}
+
+// SuggestSearch completes a search prefix with posts, themes and authors
+// NOTE: Public endpoint - suggestions depend on the optional viewer
+func (h *SearchHandler) SuggestSearch(w http.ResponseWriter, r *http.Request, params api.SuggestSearchParams) {
+	var viewerID *uuid.UUID
+	if userID, ok := h.GetOptionalUserIDFromContext(r); ok {
+		viewerID = &userID
+	}
+
+	var kinds []domain.Kind
+	if params.Types != nil {
+		for _, t := range *params.Types {
+			kinds = append(kinds, domain.Kind(t))
+		}
+	}
+
+	limit := 0
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	suggestions, err := h.service.Suggest(r.Context(), viewerID, params.Q, kinds, limit)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	// Purged with the listings whenever a post or theme changes
+	httpcache.AddSurrogateKeys(w.Header(), httpcache.PostsKey, httpcache.ThemesKey)
+	h.WriteJSONResponse(w, r, api.SearchSuggestions{
+		Query:   suggestions.Query,
+		Posts:   suggestionsToAPI(suggestions.Posts),
+		Themes:  suggestionsToAPI(suggestions.Themes),
+		Authors: suggestionsToAPI(suggestions.Authors),
+	}, http.StatusOK)
+}
+
+func suggestionsToAPI(suggestions []domain.Suggestion) []api.SearchSuggestion {
+	result := make([]api.SearchSuggestion, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		result = append(result, api.SearchSuggestion{
+			Type:  api.SearchSuggestionType(suggestion.Kind),
+			Id:    openapi_types.UUID(suggestion.ID),
+			Label: suggestion.Label,
+			Slug:  suggestion.Slug,
+		})
+	}
+	return result
+}
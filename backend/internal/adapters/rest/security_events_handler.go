@@ -0,0 +1,66 @@
+package rest
+
+import (
+	"net/http"
+
+	"backend/internal/adapters/api"
+	"backend/internal/security/application"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// SecurityEventsHandler handles HTTP requests for users' security logs
+type SecurityEventsHandler struct {
+	*BaseHandler
+	service *application.SecurityLogService
+}
+
+// NewSecurityEventsHandler creates a new security events handler
+func NewSecurityEventsHandler(base *BaseHandler, service *application.SecurityLogService) *SecurityEventsHandler {
+	return &SecurityEventsHandler{
+		BaseHandler: base,
+		service:     service,
+	}
+}
+
+// ListOwnSecurityEvents returns the authenticated user's security log, newest first
+func (h *SecurityEventsHandler) ListOwnSecurityEvents(w http.ResponseWriter, r *http.Request, params api.ListOwnSecurityEventsParams) {
+	// Pagination - convert page-based to offset-based
+	limit := 20
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = *params.Limit
+	}
+	offset := 0
+	if params.Page != nil && *params.Page > 0 {
+		offset = (*params.Page - 1) * limit
+	}
+
+	events, total, err := h.service.ListOwnEvents(r.Context(), h.GetUserIDFromContext(r), limit, offset)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	data := make([]api.SecurityEvent, len(events))
+	for i, event := range events {
+		data[i] = api.SecurityEvent{
+			Id:          openapi_types.UUID(event.ID),
+			Kind:        api.SecurityEventKind(event.Kind),
+			Description: event.Description,
+			ActorId:     (*openapi_types.UUID)(event.ActorID),
+			SubjectId:   (*openapi_types.UUID)(event.SubjectID),
+			HighRisk:    event.Kind.HighRisk(),
+			OccurredAt:  event.OccurredAt,
+		}
+	}
+
+	response := api.PaginatedSecurityEvents{
+		Data: data,
+		Meta: api.PaginationMeta{
+			TotalItems:   total,
+			ItemsPerPage: limit,
+			CurrentPage:  (offset / limit) + 1,
+			TotalPages:   (total + limit - 1) / limit,
+		},
+	}
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
@@ -0,0 +1,301 @@
+package rest
+
+import (
+	"net/http"
+
+	"backend/internal/adapters/api"
+	"backend/internal/platform/httpcache"
+	"backend/internal/series/application"
+	"backend/internal/series/domain"
+	"backend/internal/series/ports"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// SeriesHandler handles HTTP requests for post series
+type SeriesHandler struct {
+	*BaseHandler
+	service *application.SeriesService
+}
+
+// NewSeriesHandler creates a new series handler
+func NewSeriesHandler(base *BaseHandler, service *application.SeriesService) *SeriesHandler {
+	return &SeriesHandler{
+		BaseHandler: base,
+		service:     service,
+	}
+}
+
+// CreateSeries creates a new series owned by the authenticated user
+// NOTE: Authorization middleware checks series:create permission before this is called
+func (h *SeriesHandler) CreateSeries(w http.ResponseWriter, r *http.Request) {
+	userID := h.GetUserIDFromContext(r)
+
+	var req api.CreateSeriesRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	series, err := h.service.CreateSeries(r.Context(), userID, application.CreateSeriesParams{
+		Title:       req.Title,
+		Description: req.Description,
+	})
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainSeriesToAPI(series), http.StatusCreated)
+}
+
+// GetSeries retrieves a series with its posts
+// NOTE: Public endpoint - no authorization required
+func (h *SeriesHandler) GetSeries(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	series, err := h.service.GetSeries(r.Context(), uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+	httpcache.AddSurrogateKeys(w.Header(), httpcache.SeriesItemKey(series.ID))
+
+	h.WriteJSONResponse(w, r, domainSeriesWithPostsToAPI(series), http.StatusOK)
+}
+
+// GetSeriesBySlug retrieves a series with its posts by slug
+// NOTE: Public endpoint - no authorization required
+func (h *SeriesHandler) GetSeriesBySlug(w http.ResponseWriter, r *http.Request, slug string) {
+	series, err := h.service.GetSeriesBySlug(r.Context(), slug)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+	httpcache.AddSurrogateKeys(w.Header(), httpcache.SeriesItemKey(series.ID))
+
+	h.WriteJSONResponse(w, r, domainSeriesWithPostsToAPI(series), http.StatusOK)
+}
+
+// UpdateSeries updates an existing series
+// NOTE: Authorization middleware checks series:update:own permission before this is called
+func (h *SeriesHandler) UpdateSeries(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	var req api.UpdateSeriesRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	series, err := h.service.UpdateSeries(r.Context(), userID, uuid.UUID(id), application.UpdateSeriesParams{
+		Title:       req.Title,
+		Description: req.Description,
+	})
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainSeriesToAPI(series), http.StatusOK)
+}
+
+// DeleteSeries deletes a series
+// NOTE: Authorization middleware checks series:delete:own permission before this is called
+func (h *SeriesHandler) DeleteSeries(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	if err := h.service.DeleteSeries(r.Context(), userID, uuid.UUID(id)); err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListSeries returns a paginated list of series
+// NOTE: Public endpoint - no authorization required
+func (h *SeriesHandler) ListSeries(w http.ResponseWriter, r *http.Request, params api.ListSeriesParams) {
+	filter := buildSeriesListFilter(params)
+
+	summaries, total, err := h.service.ListSeries(r.Context(), filter)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+	httpcache.AddSurrogateKeys(w.Header(), httpcache.SeriesKey)
+
+	h.WriteJSONResponse(w, r, buildPaginatedSeriesResponse(summaries, total, filter), http.StatusOK)
+}
+
+// AddPostToSeries appends a post to a series
+// NOTE: Authorization middleware checks series:update:own permission before this is called
+func (h *SeriesHandler) AddPostToSeries(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	var req api.AddSeriesPostRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	if err := h.service.AddPostToSeries(r.Context(), userID, uuid.UUID(id), uuid.UUID(req.PostId)); err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RemovePostFromSeries removes a post from a series
+// NOTE: Authorization middleware checks series:update:own permission before this is called
+func (h *SeriesHandler) RemovePostFromSeries(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, postId openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	if err := h.service.RemovePostFromSeries(r.Context(), userID, uuid.UUID(id), uuid.UUID(postId)); err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ReorderSeriesPosts reorders the posts within a series
+// NOTE: Authorization middleware checks series:update:own permission before this is called
+func (h *SeriesHandler) ReorderSeriesPosts(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	var req api.ReorderSeriesPostsRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	postIDs := make([]uuid.UUID, len(req.PostIds))
+	for i, postID := range req.PostIds {
+		postIDs[i] = uuid.UUID(postID)
+	}
+
+	if err := h.service.ReorderSeriesPosts(r.Context(), userID, uuid.UUID(id), postIDs); err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Helper functions
+
+func buildSeriesListFilter(params api.ListSeriesParams) ports.ListFilter {
+	filter := ports.ListFilter{
+		Limit:  20,
+		Offset: 0,
+	}
+
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+	if params.Page != nil && *params.Page > 0 {
+		filter.Offset = (*params.Page - 1) * filter.Limit
+	}
+
+	if params.AuthorId != nil {
+		authorID := uuid.UUID(*params.AuthorId)
+		filter.AuthorID = &authorID
+	}
+
+	return filter
+}
+
+func buildPaginatedSeriesResponse(summaries []*ports.SeriesSummary, total int, filter ports.ListFilter) api.PaginatedSeries {
+	data := make([]api.SeriesSummary, len(summaries))
+	for i, summary := range summaries {
+		data[i] = api.SeriesSummary{
+			Id:          openapi_types.UUID(summary.ID),
+			Title:       summary.Title,
+			Description: summary.Description,
+			Slug:        summary.Slug,
+			AuthorId:    openapi_types.UUID(summary.AuthorID),
+			PostCount:   summary.PostCount,
+			CreatedAt:   summary.CreatedAt,
+		}
+	}
+
+	itemsPerPage := filter.Limit
+	if itemsPerPage == 0 {
+		itemsPerPage = 20
+	}
+	currentPage := (filter.Offset / itemsPerPage) + 1
+	totalPages := (total + itemsPerPage - 1) / itemsPerPage
+
+	return api.PaginatedSeries{
+		Data: data,
+		Meta: api.PaginationMeta{
+			TotalItems:   total,
+			ItemsPerPage: itemsPerPage,
+			CurrentPage:  currentPage,
+			TotalPages:   totalPages,
+		},
+	}
+}
+
+func domainSeriesToAPI(series *domain.Series) api.Series {
+	return api.Series{
+		Id:          openapi_types.UUID(series.ID),
+		Title:       series.Title,
+		Description: series.Description,
+		Slug:        series.Slug,
+		AuthorId:    openapi_types.UUID(series.AuthorID),
+		PostCount:   series.PostCount(),
+		CreatedAt:   series.CreatedAt,
+		UpdatedAt:   series.UpdatedAt,
+	}
+}
+
+func domainSeriesWithPostsToAPI(series *domain.Series) api.SeriesWithPosts {
+	apiSeries := api.SeriesWithPosts{
+		Id:          openapi_types.UUID(series.ID),
+		Title:       series.Title,
+		Description: series.Description,
+		Slug:        series.Slug,
+		AuthorId:    openapi_types.UUID(series.AuthorID),
+		PostCount:   series.PostCount(),
+		CreatedAt:   series.CreatedAt,
+		UpdatedAt:   series.UpdatedAt,
+		Posts:       make([]api.SeriesPost, 0, len(series.Posts)),
+	}
+
+	for _, seriesPost := range series.Posts {
+		apiSeries.Posts = append(apiSeries.Posts, api.SeriesPost{
+			PostId:   openapi_types.UUID(seriesPost.PostID),
+			Position: seriesPost.Position,
+			AddedAt:  seriesPost.AddedAt,
+		})
+	}
+
+	return apiSeries
+}
+
+func seriesNavigationToAPI(nav *ports.PostNavigation) *api.PostSeriesNavigation {
+	if nav == nil {
+		return nil
+	}
+
+	apiNav := &api.PostSeriesNavigation{
+		SeriesId: openapi_types.UUID(nav.SeriesID),
+		Title:    nav.SeriesTitle,
+		Slug:     nav.SeriesSlug,
+		Position: nav.Position,
+		Total:    nav.Total,
+	}
+	if nav.Previous != nil {
+		apiNav.Previous = seriesEntryToAPI(nav.Previous)
+	}
+	if nav.Next != nil {
+		apiNav.Next = seriesEntryToAPI(nav.Next)
+	}
+
+	return apiNav
+}
+
+func seriesEntryToAPI(entry *ports.SeriesEntry) *api.SeriesNavigationItem {
+	return &api.SeriesNavigationItem{
+		PostId: openapi_types.UUID(entry.PostID),
+		Title:  entry.Title,
+		Slug:   entry.Slug,
+	}
+}
@@ -11,6 +11,37 @@ type Server struct {
 	*AuthzHandler
 	*PostsHandler
 	*ThemesHandler
+	*ThemeCollaboratorsHandler
+	*SeriesHandler
+	*ReactionsHandler
+	*ReportsHandler
+	*BookmarksHandler
+	*FollowsHandler
+	*ExportHandler
+	*BlogsHandler
+	*AnnouncementsHandler
+	*FeedbackHandler
+	*MentionsHandler
+	*AnalyticsHandler
+	*ShareCardsHandler
+	*HomeHandler
+	*SearchHandler
+	*CacheHandler
+	*IntegrityHandler
+	*RetentionHandler
+	*LinkReportsHandler
+	*EventsHandler
+	*SettingsHandler
+	*APIClientsHandler
+	*QuotasHandler
+	*ImpersonationHandler
+	*ServiceAccountsHandler
+	*PrivacyHandler
+	*DataExportHandler
+	*SecurityEventsHandler
+	*ActionLinksHandler
+	*OrganizationsHandler
+	*OpenAPIHandler
 }
 
 // NewServer creates a new server that implements api.ServerInterface
@@ -20,13 +51,75 @@ func NewServer(
 	authzHandler *AuthzHandler,
 	postsHandler *PostsHandler,
 	themesHandler *ThemesHandler,
+	themeCollaboratorsHandler *ThemeCollaboratorsHandler,
+	seriesHandler *SeriesHandler,
+	reactionsHandler *ReactionsHandler,
+	reportsHandler *ReportsHandler,
+	bookmarksHandler *BookmarksHandler,
+	followsHandler *FollowsHandler,
+	exportHandler *ExportHandler,
+	blogsHandler *BlogsHandler,
+	announcementsHandler *AnnouncementsHandler,
+	feedbackHandler *FeedbackHandler,
+	mentionsHandler *MentionsHandler,
+	analyticsHandler *AnalyticsHandler,
+	shareCardsHandler *ShareCardsHandler,
+	homeHandler *HomeHandler,
+	searchHandler *SearchHandler,
+	cacheHandler *CacheHandler,
+	integrityHandler *IntegrityHandler,
+	retentionHandler *RetentionHandler,
+	linkReportsHandler *LinkReportsHandler,
+	eventsHandler *EventsHandler,
+	settingsHandler *SettingsHandler,
+	apiClientsHandler *APIClientsHandler,
+	quotasHandler *QuotasHandler,
+	impersonationHandler *ImpersonationHandler,
+	serviceAccountsHandler *ServiceAccountsHandler,
+	privacyHandler *PrivacyHandler,
+	dataExportHandler *DataExportHandler,
+	securityEventsHandler *SecurityEventsHandler,
+	actionLinksHandler *ActionLinksHandler,
+	organizationsHandler *OrganizationsHandler,
+	openAPIHandler *OpenAPIHandler,
 ) api.ServerInterface {
 	return &Server{
-		UserHandler:   userHandler,
-		HealthHandler: healthHandler,
-		AuthzHandler:  authzHandler,
-		PostsHandler:  postsHandler,
-		ThemesHandler: themesHandler,
+		UserHandler:               userHandler,
+		HealthHandler:             healthHandler,
+		AuthzHandler:              authzHandler,
+		PostsHandler:              postsHandler,
+		ThemesHandler:             themesHandler,
+		ThemeCollaboratorsHandler: themeCollaboratorsHandler,
+		SeriesHandler:             seriesHandler,
+		ReactionsHandler:          reactionsHandler,
+		ReportsHandler:            reportsHandler,
+		BookmarksHandler:          bookmarksHandler,
+		FollowsHandler:            followsHandler,
+		ExportHandler:             exportHandler,
+		BlogsHandler:              blogsHandler,
+		AnnouncementsHandler:      announcementsHandler,
+		FeedbackHandler:           feedbackHandler,
+		MentionsHandler:           mentionsHandler,
+		AnalyticsHandler:          analyticsHandler,
+		ShareCardsHandler:         shareCardsHandler,
+		HomeHandler:               homeHandler,
+		SearchHandler:             searchHandler,
+		CacheHandler:              cacheHandler,
+		IntegrityHandler:          integrityHandler,
+		RetentionHandler:          retentionHandler,
+		LinkReportsHandler:        linkReportsHandler,
+		EventsHandler:             eventsHandler,
+		SettingsHandler:           settingsHandler,
+		APIClientsHandler:         apiClientsHandler,
+		QuotasHandler:             quotasHandler,
+		ImpersonationHandler:      impersonationHandler,
+		ServiceAccountsHandler:    serviceAccountsHandler,
+		PrivacyHandler:            privacyHandler,
+		DataExportHandler:         dataExportHandler,
+		SecurityEventsHandler:     securityEventsHandler,
+		ActionLinksHandler:        actionLinksHandler,
+		OrganizationsHandler:      organizationsHandler,
+		OpenAPIHandler:            openAPIHandler,
 	}
 }
 
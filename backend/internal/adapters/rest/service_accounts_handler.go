@@ -0,0 +1,161 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"backend/internal/adapters/api"
+	"backend/internal/serviceaccounts/application"
+	"backend/internal/serviceaccounts/domain"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// ServiceAccountsHandler handles HTTP requests for service accounts and their tokens
+type ServiceAccountsHandler struct {
+	*BaseHandler
+	service *application.AccountService
+}
+
+// NewServiceAccountsHandler creates a new service accounts handler
+func NewServiceAccountsHandler(base *BaseHandler, service *application.AccountService) *ServiceAccountsHandler {
+	return &ServiceAccountsHandler{
+		BaseHandler: base,
+		service:     service,
+	}
+}
+
+// IssueMachineToken trades a service account's client credentials for an access token
+// This endpoint is public; the credentials in the body authenticate the caller
+func (h *ServiceAccountsHandler) IssueMachineToken(w http.ResponseWriter, r *http.Request) {
+	var req api.ClientCredentialsRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	token, raw, err := h.service.IssueToken(r.Context(), req.ClientId, req.ClientSecret)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, api.MachineToken{
+		AccessToken: raw,
+		TokenType:   api.Bearer,
+		ExpiresIn:   int(time.Until(token.ExpiresAt).Seconds()),
+		ExpiresAt:   token.ExpiresAt,
+	}, http.StatusOK)
+}
+
+// ListServiceAccounts returns the blog's service accounts
+// NOTE: Authorization middleware checks authz:service_accounts permission before this is called
+func (h *ServiceAccountsHandler) ListServiceAccounts(w http.ResponseWriter, r *http.Request) {
+	userID := h.GetUserIDFromContext(r)
+
+	accounts, err := h.service.ListAccounts(r.Context(), userID)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	response := make([]api.ServiceAccount, len(accounts))
+	for i, account := range accounts {
+		response[i] = domainServiceAccountToAPI(account)
+	}
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
+// CreateServiceAccount creates a service account and returns its client secret once
+// NOTE: Authorization middleware checks authz:service_accounts permission before this is called
+func (h *ServiceAccountsHandler) CreateServiceAccount(w http.ResponseWriter, r *http.Request) {
+	userID := h.GetUserIDFromContext(r)
+
+	var req api.CreateServiceAccountRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	description := ""
+	if req.Description != nil {
+		description = *req.Description
+	}
+
+	account, secret, err := h.service.CreateAccount(r.Context(), userID, req.Name, description)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	created := domainServiceAccountToAPI(account)
+	h.WriteJSONResponse(w, r, api.CreatedServiceAccount{
+		Id:           created.Id,
+		Name:         created.Name,
+		Description:  created.Description,
+		ClientId:     created.ClientId,
+		CreatedBy:    created.CreatedBy,
+		CreatedAt:    created.CreatedAt,
+		ClientSecret: secret,
+	}, http.StatusCreated)
+}
+
+// RevokeServiceAccount revokes a service account and the tokens issued to it
+// NOTE: Authorization middleware checks authz:service_accounts permission before this is called
+func (h *ServiceAccountsHandler) RevokeServiceAccount(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	account, err := h.service.RevokeAccount(r.Context(), userID, uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainServiceAccountToAPI(account), http.StatusOK)
+}
+
+// ListServiceAccountActions returns the latest requests a service account made
+// NOTE: Authorization middleware checks authz:audit:view permission before this is called
+func (h *ServiceAccountsHandler) ListServiceAccountActions(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params api.ListServiceAccountActionsParams) {
+	userID := h.GetUserIDFromContext(r)
+
+	limit := 0
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	actions, err := h.service.ListActions(r.Context(), userID, uuid.UUID(id), limit)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	response := make([]api.ServiceAccountAction, len(actions))
+	for i, action := range actions {
+		response[i] = api.ServiceAccountAction{
+			AccountId:  openapi_types.UUID(action.AccountID),
+			Method:     action.Method,
+			Route:      action.Route,
+			Path:       action.Path,
+			Status:     action.Status,
+			RequestId:  action.RequestID,
+			OccurredAt: action.OccurredAt,
+		}
+	}
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
+func domainServiceAccountToAPI(account *domain.Account) api.ServiceAccount {
+	response := api.ServiceAccount{
+		Id:          openapi_types.UUID(account.ID),
+		Name:        account.Name,
+		Description: account.Description,
+		ClientId:    account.ClientID,
+		CreatedAt:   account.CreatedAt,
+		LastUsedAt:  account.LastUsedAt,
+		RevokedAt:   account.RevokedAt,
+	}
+	if account.CreatedBy != uuid.Nil {
+		createdBy := openapi_types.UUID(account.CreatedBy)
+		response.CreatedBy = &createdBy
+	}
+	return response
+}
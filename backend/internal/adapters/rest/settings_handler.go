@@ -0,0 +1,108 @@
+package rest
+
+import (
+	"net/http"
+
+	"backend/internal/adapters/api"
+	"backend/internal/settings/application"
+	"backend/internal/settings/domain"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// SettingsHandler handles HTTP requests for system, blog and theme settings
+type SettingsHandler struct {
+	*BaseHandler
+	service *application.SettingsService
+}
+
+// NewSettingsHandler creates a new settings handler
+func NewSettingsHandler(base *BaseHandler, service *application.SettingsService) *SettingsHandler {
+	return &SettingsHandler{
+		BaseHandler: base,
+		service:     service,
+	}
+}
+
+// GetSystemSettings returns the deployment-wide settings
+// NOTE: Authorization middleware checks settings:system permission before this is called
+func (h *SettingsHandler) GetSystemSettings(w http.ResponseWriter, r *http.Request) {
+	h.getSettings(w, r, domain.NamespaceSystem)
+}
+
+// UpdateSystemSettings replaces the deployment-wide settings
+// NOTE: Authorization middleware checks settings:system permission before this is called
+func (h *SettingsHandler) UpdateSystemSettings(w http.ResponseWriter, r *http.Request) {
+	h.updateSettings(w, r, domain.NamespaceSystem)
+}
+
+// GetBlogSettings returns the current blog's settings
+// NOTE: Authorization middleware checks settings:blog permission before this is called
+func (h *SettingsHandler) GetBlogSettings(w http.ResponseWriter, r *http.Request) {
+	h.getSettings(w, r, domain.NamespaceBlog)
+}
+
+// UpdateBlogSettings replaces the current blog's settings
+// NOTE: Authorization middleware checks settings:blog permission before this is called
+func (h *SettingsHandler) UpdateBlogSettings(w http.ResponseWriter, r *http.Request) {
+	h.updateSettings(w, r, domain.NamespaceBlog)
+}
+
+// GetThemeSettings returns the current blog's appearance settings
+// NOTE: Authorization middleware checks settings:theme permission before this is called
+func (h *SettingsHandler) GetThemeSettings(w http.ResponseWriter, r *http.Request) {
+	h.getSettings(w, r, domain.NamespaceTheme)
+}
+
+// UpdateThemeSettings replaces the current blog's appearance settings
+// NOTE: Authorization middleware checks settings:theme permission before this is called
+func (h *SettingsHandler) UpdateThemeSettings(w http.ResponseWriter, r *http.Request) {
+	h.updateSettings(w, r, domain.NamespaceTheme)
+}
+
+// Helper methods shared by every namespace
+
+func (h *SettingsHandler) getSettings(w http.ResponseWriter, r *http.Request, namespace domain.Namespace) {
+	userID := h.GetUserIDFromContext(r)
+
+	settings, err := h.service.GetSettings(r.Context(), userID, namespace)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainSettingsToAPI(settings), http.StatusOK)
+}
+
+func (h *SettingsHandler) updateSettings(w http.ResponseWriter, r *http.Request, namespace domain.Namespace) {
+	userID := h.GetUserIDFromContext(r)
+
+	var req api.SettingsValues
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	settings, err := h.service.UpdateSettings(r.Context(), userID, namespace, domain.Values(req))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainSettingsToAPI(settings), http.StatusOK)
+}
+
+// Helper functions for converting between domain and API types
+
+func domainSettingsToAPI(settings *domain.Settings) api.Settings {
+	apiSettings := api.Settings{
+		Namespace: api.SettingsNamespace(settings.Namespace),
+		Values:    api.SettingsValues(settings.Values),
+		UpdatedAt: settings.UpdatedAt,
+	}
+
+	if settings.UpdatedBy != nil {
+		updatedBy := openapi_types.UUID(*settings.UpdatedBy)
+		apiSettings.UpdatedBy = &updatedBy
+	}
+
+	return apiSettings
+}
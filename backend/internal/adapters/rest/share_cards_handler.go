@@ -0,0 +1,56 @@
+package rest
+
+import (
+	"net/http"
+
+	"backend/internal/adapters/api"
+	"backend/internal/platform/httpcache"
+	"backend/internal/sharecards/application"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// ShareCardsHandler handles HTTP requests for the share images of posts
+type ShareCardsHandler struct {
+	*BaseHandler
+	service *application.ShareCardService
+}
+
+// NewShareCardsHandler creates a new share cards handler
+func NewShareCardsHandler(base *BaseHandler, service *application.ShareCardService) *ShareCardsHandler {
+	return &ShareCardsHandler{
+		BaseHandler: base,
+		service:     service,
+	}
+}
+
+// GetPostOgImage serves the share card of a post
+// NOTE: Public endpoint - no authorization required
+func (h *ShareCardsHandler) GetPostOgImage(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, _ api.GetPostOgImageParams) {
+	var viewerID *uuid.UUID
+	if userID, ok := h.GetOptionalUserIDFromContext(r); ok {
+		viewerID = &userID
+	}
+
+	card, err := h.service.RenderPostCard(r.Context(), uuid.UUID(id), viewerID)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	header := w.Header()
+	etag := httpcache.ETag(card.Fingerprint)
+	header.Set(httpcache.HeaderETag, etag)
+	httpcache.AddSurrogateKeys(header, httpcache.PostKey(card.PostID))
+
+	if httpcache.NotModified(r, etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	header.Set("Content-Type", "image/svg+xml")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(card.SVG); err != nil {
+		h.logger.Warn(r.Context(), "failed to write share card", "error", err)
+	}
+}
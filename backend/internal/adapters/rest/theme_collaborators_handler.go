@@ -0,0 +1,85 @@
+package rest
+
+import (
+	"net/http"
+
+	"backend/internal/adapters/api"
+	"backend/internal/themes/application"
+	"backend/internal/themes/domain"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// ThemeCollaboratorsHandler handles HTTP requests for the collaborators of themes
+type ThemeCollaboratorsHandler struct {
+	*BaseHandler
+	service *application.CollaboratorsService
+}
+
+// NewThemeCollaboratorsHandler creates a new theme collaborators handler
+func NewThemeCollaboratorsHandler(base *BaseHandler, service *application.CollaboratorsService) *ThemeCollaboratorsHandler {
+	return &ThemeCollaboratorsHandler{
+		BaseHandler: base,
+		service:     service,
+	}
+}
+
+// ListThemeCollaborators lists the collaborators of a theme
+// NOTE: Authorization middleware checks themes:curate:own permission before this is called
+func (h *ThemeCollaboratorsHandler) ListThemeCollaborators(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	collaborators, err := h.service.ListCollaborators(r.Context(), userID, uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	response := make([]api.ThemeCollaborator, 0, len(collaborators))
+	for _, collaborator := range collaborators {
+		response = append(response, domainCollaboratorToAPI(collaborator))
+	}
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
+// SetThemeCollaborator adds a collaborator to a theme or changes their role
+// NOTE: Authorization middleware checks themes:update:own permission before this is called;
+// the service then only lets the curator and any-scope editors through
+func (h *ThemeCollaboratorsHandler) SetThemeCollaborator(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, userId openapi_types.UUID) {
+	actorID := h.GetUserIDFromContext(r)
+
+	var req api.SetThemeCollaboratorRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	collaborator, err := h.service.SetCollaborator(r.Context(), actorID, uuid.UUID(id), uuid.UUID(userId), domain.CollaboratorRole(req.Role))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainCollaboratorToAPI(collaborator), http.StatusOK)
+}
+
+// RemoveThemeCollaborator removes a collaborator from a theme
+// NOTE: Authorization middleware checks themes:curate:own permission before this is called
+func (h *ThemeCollaboratorsHandler) RemoveThemeCollaborator(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, userId openapi_types.UUID) {
+	actorID := h.GetUserIDFromContext(r)
+
+	if err := h.service.RemoveCollaborator(r.Context(), actorID, uuid.UUID(id), uuid.UUID(userId)); err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func domainCollaboratorToAPI(collaborator *domain.Collaborator) api.ThemeCollaborator {
+	return api.ThemeCollaborator{
+		UserId:  openapi_types.UUID(collaborator.UserID),
+		Role:    api.ThemeCollaboratorRole(collaborator.Role),
+		AddedBy: openapi_types.UUID(collaborator.AddedBy),
+		AddedAt: collaborator.AddedAt,
+	}
+}
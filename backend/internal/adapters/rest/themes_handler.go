@@ -1,13 +1,20 @@
 package rest
 
 import (
-	"encoding/json"
+	"context"
+	"fmt"
+	"io"
+	"mime"
 	"net/http"
+	"strings"
 
 	"backend/internal/adapters/api"
+	"backend/internal/adapters/rest/middleware"
+	"backend/internal/platform/httpcache"
 	"backend/internal/themes/application"
 	"backend/internal/themes/domain"
 	"backend/internal/themes/ports"
+	usersApp "backend/internal/users/application"
 	"github.com/google/uuid"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
@@ -16,13 +23,22 @@ import (
 type ThemesHandler struct {
 	*BaseHandler
 	service *application.ThemesService
+	pages   *application.ThemePageService
+	authors authorLoader
 }
 
 // NewThemesHandler creates a new themes handler
-func NewThemesHandler(base *BaseHandler, service *application.ThemesService) *ThemesHandler {
+func NewThemesHandler(
+	base *BaseHandler,
+	service *application.ThemesService,
+	pages *application.ThemePageService,
+	usersService *usersApp.UserService,
+) *ThemesHandler {
 	return &ThemesHandler{
 		BaseHandler: base,
 		service:     service,
+		pages:       pages,
+		authors:     authorLoader{BaseHandler: base, users: usersService},
 	}
 }
 
@@ -35,8 +51,7 @@ func (h *ThemesHandler) CreateTheme(w http.ResponseWriter, r *http.Request) {
 
 	// Parse request body
 	var req api.CreateThemeRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.WriteJSONError(w, r, "validation_error", "Invalid request body", http.StatusBadRequest)
+	if !h.DecodeJSON(w, r, &req) {
 		return
 	}
 
@@ -45,6 +60,9 @@ func (h *ThemesHandler) CreateTheme(w http.ResponseWriter, r *http.Request) {
 		Name:        req.Name,
 		Description: req.Description,
 	}
+	if req.Slug != nil {
+		params.Slug = *req.Slug
+	}
 
 	theme, err := h.service.CreateTheme(r.Context(), userID, params)
 	if err != nil {
@@ -57,9 +75,25 @@ func (h *ThemesHandler) CreateTheme(w http.ResponseWriter, r *http.Request) {
 	h.WriteJSONResponse(w, r, response, http.StatusCreated)
 }
 
+// CloneTheme copies a theme and its articles into a new theme curated by the caller
+// NOTE: Authorization middleware checks themes:create permission before this is called
+func (h *ThemesHandler) CloneTheme(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	theme, err := h.service.CloneTheme(r.Context(), userID, uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	// Convert to API response
+	response := domainThemeToAPI(theme)
+	h.WriteJSONResponse(w, r, response, http.StatusCreated)
+}
+
 // GetTheme retrieves a single theme by ID
 // NOTE: Public endpoint - no authorization required
-func (h *ThemesHandler) GetTheme(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+func (h *ThemesHandler) GetTheme(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params api.GetThemeParams) {
 	// Convert openapi UUID to google UUID
 	themeID := uuid.UUID(id)
 
@@ -69,25 +103,83 @@ func (h *ThemesHandler) GetTheme(w http.ResponseWriter, r *http.Request, id open
 		h.HandleError(w, r, err)
 		return
 	}
+	httpcache.AddSurrogateKeys(w.Header(), httpcache.ThemeKey(theme.ID))
 
 	// Convert to API response
-	response := domainThemeToAPI(theme)
-	h.WriteJSONResponse(w, r, response, http.StatusOK)
+	h.writeTheme(w, r, theme, newResponseShape(params.Fields, params.Embed))
 }
 
 // GetThemeBySlug retrieves a theme by its slug
 // NOTE: Public endpoint - no authorization required
-func (h *ThemesHandler) GetThemeBySlug(w http.ResponseWriter, r *http.Request, slug string) {
+func (h *ThemesHandler) GetThemeBySlug(w http.ResponseWriter, r *http.Request, slug string, params api.GetThemeBySlugParams) {
 	// Get the theme
 	theme, err := h.service.GetThemeBySlug(r.Context(), slug)
 	if err != nil {
 		h.HandleError(w, r, err)
 		return
 	}
+	httpcache.AddSurrogateKeys(w.Header(), httpcache.ThemeKey(theme.ID))
+
+	// The theme was renamed; point clients at its current slug
+	if theme.Slug != slug {
+		h.WriteSlugRedirect(w, r, theme.Slug)
+		return
+	}
+
+	// Slug lookups skip the articles, so load them when they are to be embedded
+	shape := newResponseShape(params.Fields, params.Embed)
+	if shape.wants(embedArticles) {
+		theme, err = h.service.GetTheme(r.Context(), theme.ID)
+		if err != nil {
+			h.HandleError(w, r, err)
+			return
+		}
+	}
 
 	// Convert to API response
+	h.writeTheme(w, r, theme, shape)
+}
+
+// GetThemePage returns everything a theme's landing page shows
+func (h *ThemesHandler) GetThemePage(w http.ResponseWriter, r *http.Request, slug string) {
+	page, err := h.pages.GetThemePage(r.Context(), slug)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+	httpcache.AddSurrogateKeys(w.Header(), httpcache.ThemeKey(page.Theme.ID))
+
+	// The theme was renamed; point clients at its current page
+	if page.Theme.Slug != slug {
+		h.WriteSubresourceSlugRedirect(w, r, page.Theme.Slug)
+		return
+	}
+
+	// The page changes when any post listed on it does
+	for _, article := range page.Articles {
+		httpcache.AddSurrogateKeys(w.Header(), httpcache.PostKey(article.PostID))
+	}
+
+	h.WriteJSONResponse(w, r, themePageToAPI(page), http.StatusOK)
+}
+
+// writeTheme attaches the requested embeds and writes the selected fields of a theme
+func (h *ThemesHandler) writeTheme(w http.ResponseWriter, r *http.Request, theme *domain.Theme, shape responseShape) {
 	response := domainThemeToAPI(theme)
-	h.WriteJSONResponse(w, r, response, http.StatusOK)
+
+	var embedded api.ThemeEmbedded
+	if shape.wants(embedAuthor) {
+		embedded.Author = h.authors.author(r, theme.CuratorID)
+	}
+	if shape.wants(embedArticles) {
+		articles := themeArticlesToAPI(theme.Articles)
+		embedded.Articles = &articles
+	}
+	if embedded.Author != nil || embedded.Articles != nil {
+		response.Embedded = &embedded
+	}
+
+	h.WriteShapedJSONResponse(w, r, response, shape, http.StatusOK)
 }
 
 // UpdateTheme updates an existing theme
@@ -101,8 +193,7 @@ func (h *ThemesHandler) UpdateTheme(w http.ResponseWriter, r *http.Request, id o
 
 	// Parse request body
 	var req api.UpdateThemeRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.WriteJSONError(w, r, "validation_error", "Invalid request body", http.StatusBadRequest)
+	if !h.DecodeJSON(w, r, &req) {
 		return
 	}
 
@@ -110,6 +201,7 @@ func (h *ThemesHandler) UpdateTheme(w http.ResponseWriter, r *http.Request, id o
 	params := application.UpdateThemeParams{
 		Name:        req.Name,
 		Description: req.Description,
+		Slug:        req.Slug,
 	}
 
 	theme, err := h.service.UpdateTheme(r.Context(), userID, themeID, params)
@@ -123,7 +215,43 @@ func (h *ThemesHandler) UpdateTheme(w http.ResponseWriter, r *http.Request, id o
 	h.WriteJSONResponse(w, r, response, http.StatusOK)
 }
 
-// DeleteTheme deletes a theme
+// PatchTheme applies a JSON Merge Patch to a theme, changing only the fields sent
+// NOTE: Authorization middleware checks themes:update:own permission before this is called
+func (h *ThemesHandler) PatchTheme(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	// Get authenticated user ID - middleware guarantees this exists
+	userID := h.GetUserIDFromContext(r)
+
+	patch, ok := h.DecodeMergePatch(w, r)
+	if !ok {
+		return
+	}
+
+	var params application.PatchThemeParams
+	fields := map[string]**string{
+		"name":        &params.Name,
+		"description": &params.Description,
+		"slug":        &params.Slug,
+	}
+	for name, field := range fields {
+		var err error
+		if *field, err = patch.stringField(name); err != nil {
+			h.WriteJSONError(w, r, "validation_error", err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	theme, err := h.service.PatchTheme(r.Context(), userID, uuid.UUID(id), params)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	// Convert to API response
+	response := domainThemeToAPI(theme)
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
+// DeleteTheme moves a theme to the trash
 // NOTE: Authorization middleware checks themes:delete:own permission before this is called
 func (h *ThemesHandler) DeleteTheme(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	// Get authenticated user ID - middleware guarantees this exists
@@ -143,7 +271,44 @@ func (h *ThemesHandler) DeleteTheme(w http.ResponseWriter, r *http.Request, id o
 	w.WriteHeader(http.StatusNoContent)
 }
 
-// ActivateTheme activates a theme
+// ListDeletedThemes returns a page of the themes in the trash
+// NOTE: Authenticated endpoint - the service limits curators to their own deleted themes
+func (h *ThemesHandler) ListDeletedThemes(w http.ResponseWriter, r *http.Request, params api.ListDeletedThemesParams) {
+	userID := h.GetUserIDFromContext(r)
+
+	// Pagination - convert page-based to offset-based
+	filter := ports.ListFilter{Limit: 20}
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+	if params.Page != nil && *params.Page > 0 {
+		filter.Offset = (*params.Page - 1) * filter.Limit
+	}
+
+	themes, total, err := h.service.ListDeletedThemes(r.Context(), userID, filter.Limit, filter.Offset)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, buildPaginatedThemesResponse(themes, total, filter), http.StatusOK)
+}
+
+// RestoreDeletedTheme takes a theme out of the trash
+// NOTE: Authenticated endpoint - the service checks themes:delete on the theme
+func (h *ThemesHandler) RestoreDeletedTheme(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	theme, err := h.service.RestoreDeletedTheme(r.Context(), userID, uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainThemeToAPI(theme), http.StatusOK)
+}
+
+// ActivateTheme lists a draft theme publicly
 // NOTE: Authorization middleware checks themes:update:own permission before this is called
 func (h *ThemesHandler) ActivateTheme(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	// Get authenticated user ID - middleware guarantees this exists
@@ -163,7 +328,7 @@ func (h *ThemesHandler) ActivateTheme(w http.ResponseWriter, r *http.Request, id
 	w.WriteHeader(http.StatusNoContent)
 }
 
-// DeactivateTheme deactivates a theme
+// DeactivateTheme takes an active theme back to draft
 // NOTE: Authorization middleware checks themes:update:own permission before this is called
 func (h *ThemesHandler) DeactivateTheme(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	// Get authenticated user ID - middleware guarantees this exists
@@ -183,11 +348,48 @@ func (h *ThemesHandler) DeactivateTheme(w http.ResponseWriter, r *http.Request,
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// ArchiveTheme retires a theme from every listing
+// NOTE: Authorization middleware checks themes:update:own permission before this is called
+func (h *ThemesHandler) ArchiveTheme(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	// Get authenticated user ID - middleware guarantees this exists
+	userID := h.GetUserIDFromContext(r)
+
+	// Archive the theme
+	err := h.service.ArchiveTheme(r.Context(), userID, uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	// Return success with no content
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RestoreTheme brings an archived theme back as a draft
+// NOTE: Authorization middleware checks themes:update:own permission before this is called
+func (h *ThemesHandler) RestoreTheme(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	// Get authenticated user ID - middleware guarantees this exists
+	userID := h.GetUserIDFromContext(r)
+
+	// Restore the theme
+	err := h.service.RestoreTheme(r.Context(), userID, uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	// Return success with no content
+	w.WriteHeader(http.StatusNoContent)
+}
+
 // ListThemes returns a paginated list of themes
 // NOTE: Public endpoint - returns only active themes for anonymous users
 func (h *ThemesHandler) ListThemes(w http.ResponseWriter, r *http.Request, params api.ListThemesParams) {
 	// Build filter from query parameters
 	filter := buildThemeListFilter(params)
+	if viewerID, ok := h.GetOptionalUserIDFromContext(r); ok {
+		filter.ViewerID = &viewerID
+	}
 
 	// Get themes and count
 	themes, total, err := h.service.ListThemes(r.Context(), filter)
@@ -195,14 +397,35 @@ func (h *ThemesHandler) ListThemes(w http.ResponseWriter, r *http.Request, param
 		h.HandleError(w, r, err)
 		return
 	}
+	httpcache.AddSurrogateKeys(w.Header(), httpcache.ThemesKey)
 
 	// Reuse the common response building logic
 	response := buildPaginatedThemesResponse(themes, total, filter)
-	h.WriteJSONResponse(w, r, response, http.StatusOK)
+	shape := newResponseShape(params.Fields, params.Embed)
+	if shape.wants(embedAuthor) {
+		h.embedThemeCurators(r, themes, response.Data)
+	}
+	h.WriteShapedListResponse(w, r, response, shape, http.StatusOK)
+}
+
+// embedThemeCurators attaches each listed theme's curator, loading all of them in one query
+func (h *ThemesHandler) embedThemeCurators(r *http.Request, themes []*ports.ThemeSummary, items []api.ThemeSummary) {
+	ids := make([]uuid.UUID, len(themes))
+	for i, theme := range themes {
+		ids[i] = theme.CuratorID
+	}
+
+	authors := h.authors.load(r, ids...)
+	for i, theme := range themes {
+		if author, ok := authors[theme.CuratorID]; ok {
+			items[i].Embedded = &api.ThemeEmbedded{Author: &author}
+		}
+	}
 }
 
 // GetUserThemes returns themes created by a specific user
-// NOTE: Public endpoint - shows only active themes unless requesting own themes
+// NOTE: Public endpoint - shows only active themes unless requesting own themes,
+// in which case drafts are included too. Archived themes are never listed here.
 func (h *ThemesHandler) GetUserThemes(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	// Convert openapi UUID to google UUID
 	userID := uuid.UUID(id)
@@ -211,9 +434,14 @@ func (h *ThemesHandler) GetUserThemes(w http.ResponseWriter, r *http.Request, id
 	// Note: In a future API version, we could accept query params here
 	filter := ports.ListFilter{
 		CuratorID: &userID,
+		Statuses:  []domain.ThemeStatus{domain.ThemeStatusActive},
 		Limit:     20,
 		Offset:    0,
 	}
+	if viewerID, ok := h.GetOptionalUserIDFromContext(r); ok && viewerID == userID {
+		filter.ViewerID = &viewerID
+		filter.Statuses = []domain.ThemeStatus{domain.ThemeStatusDraft, domain.ThemeStatusActive}
+	}
 
 	// Get themes and count
 	themes, total, err := h.service.ListThemes(r.Context(), filter)
@@ -239,6 +467,7 @@ func (h *ThemesHandler) GetThemeWithArticles(w http.ResponseWriter, r *http.Requ
 		h.HandleError(w, r, err)
 		return
 	}
+	httpcache.AddSurrogateKeys(w.Header(), httpcache.ThemeKey(theme.ID))
 
 	// Convert to API response with articles
 	response := domainThemeWithArticlesToAPI(theme)
@@ -246,7 +475,7 @@ func (h *ThemesHandler) GetThemeWithArticles(w http.ResponseWriter, r *http.Requ
 }
 
 // AddArticleToTheme adds an article to a theme
-// NOTE: Authorization middleware checks themes:update:own permission before this is called
+// NOTE: Authorization middleware checks themes:curate:own permission before this is called
 func (h *ThemesHandler) AddArticleToTheme(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	// Get authenticated user ID - middleware guarantees this exists
 	userID := h.GetUserIDFromContext(r)
@@ -256,8 +485,7 @@ func (h *ThemesHandler) AddArticleToTheme(w http.ResponseWriter, r *http.Request
 
 	// Parse request body
 	var req api.AddArticleRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.WriteJSONError(w, r, "validation_error", "Invalid request body", http.StatusBadRequest)
+	if !h.DecodeJSON(w, r, &req) {
 		return
 	}
 
@@ -275,8 +503,35 @@ func (h *ThemesHandler) AddArticleToTheme(w http.ResponseWriter, r *http.Request
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// AddArticlesToTheme adds several articles to a theme in one request
+// NOTE: Authorization middleware checks themes:curate:own permission before this is called
+func (h *ThemesHandler) AddArticlesToTheme(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	// Get authenticated user ID - middleware guarantees this exists
+	userID := h.GetUserIDFromContext(r)
+
+	// Parse request body
+	var req api.AddArticlesRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	postIDs := make([]uuid.UUID, len(req.PostIds))
+	for i, postID := range req.PostIds {
+		postIDs[i] = uuid.UUID(postID)
+	}
+
+	// Add the articles to the theme
+	results, err := h.service.AddArticlesToTheme(r.Context(), userID, uuid.UUID(id), postIDs)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, batchArticleResultsToAPI(r.Context(), results), http.StatusOK)
+}
+
 // RemoveArticleFromTheme removes an article from a theme
-// NOTE: Authorization middleware checks themes:update:own permission before this is called
+// NOTE: Authorization middleware checks themes:curate:own permission before this is called
 func (h *ThemesHandler) RemoveArticleFromTheme(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, postId openapi_types.UUID) {
 	// Get authenticated user ID - middleware guarantees this exists
 	userID := h.GetUserIDFromContext(r)
@@ -296,8 +551,42 @@ func (h *ThemesHandler) RemoveArticleFromTheme(w http.ResponseWriter, r *http.Re
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// PinThemeArticle pins an article to the top of a theme
+// NOTE: Authorization middleware checks themes:curate:own permission before this is called
+func (h *ThemesHandler) PinThemeArticle(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, postId openapi_types.UUID) {
+	// Get authenticated user ID - middleware guarantees this exists
+	userID := h.GetUserIDFromContext(r)
+
+	// Pin the article
+	err := h.service.PinThemeArticle(r.Context(), userID, uuid.UUID(id), uuid.UUID(postId))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	// Return success with no content
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// UnpinThemeArticle removes the pin from an article in a theme
+// NOTE: Authorization middleware checks themes:curate:own permission before this is called
+func (h *ThemesHandler) UnpinThemeArticle(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, postId openapi_types.UUID) {
+	// Get authenticated user ID - middleware guarantees this exists
+	userID := h.GetUserIDFromContext(r)
+
+	// Unpin the article
+	err := h.service.UnpinThemeArticle(r.Context(), userID, uuid.UUID(id), uuid.UUID(postId))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	// Return success with no content
+	w.WriteHeader(http.StatusNoContent)
+}
+
 // ReorderThemeArticles reorders articles within a theme
-// NOTE: Authorization middleware checks themes:update:own permission before this is called
+// NOTE: Authorization middleware checks themes:curate:own permission before this is called
 func (h *ThemesHandler) ReorderThemeArticles(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	// Get authenticated user ID - middleware guarantees this exists
 	userID := h.GetUserIDFromContext(r)
@@ -307,8 +596,7 @@ func (h *ThemesHandler) ReorderThemeArticles(w http.ResponseWriter, r *http.Requ
 
 	// Parse request body
 	var req api.ReorderArticlesRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.WriteJSONError(w, r, "validation_error", "Invalid request body", http.StatusBadRequest)
+	if !h.DecodeJSON(w, r, &req) {
 		return
 	}
 
@@ -329,6 +617,96 @@ func (h *ThemesHandler) ReorderThemeArticles(w http.ResponseWriter, r *http.Requ
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// RepairThemePositions renumbers a theme's article positions and reports what moved
+// NOTE: Authorization middleware checks settings:system permission before this is called
+func (h *ThemesHandler) RepairThemePositions(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	changes, err := h.service.RepairPositions(r.Context(), userID, uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	response := api.ThemePositionRepair{
+		ThemeId: id,
+		Changes: make([]api.ThemePositionChange, len(changes)),
+	}
+	for i, change := range changes {
+		response.Changes[i] = api.ThemePositionChange{
+			PostId:      openapi_types.UUID(change.PostID),
+			OldPosition: change.OldPosition,
+			NewPosition: change.NewPosition,
+		}
+	}
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
+// ExportTheme returns a theme as a reading list in JSON or OPML
+// NOTE: Authorization middleware checks themes:update:own permission before this is called
+func (h *ThemesHandler) ExportTheme(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params api.ExportThemeParams) {
+	userID := h.GetUserIDFromContext(r)
+
+	list, err := h.service.ExportReadingList(r.Context(), userID, uuid.UUID(id))
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	if params.Format == nil || *params.Format == api.Json {
+		h.WriteJSONResponse(w, r, readingListToAPI(list), http.StatusOK)
+		return
+	}
+
+	doc, err := domain.RenderOPML(list)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", opmlContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("theme-%s.opml", uuid.UUID(id))))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(doc); err != nil {
+		h.logger.Error(r.Context(), "failed to write reading list", "error", err)
+	}
+}
+
+// ImportTheme adds the posts of a JSON or OPML reading list to a theme
+// NOTE: Authorization middleware checks themes:update:own permission before this is called
+func (h *ThemesHandler) ImportTheme(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	userID := h.GetUserIDFromContext(r)
+
+	var list *domain.ReadingList
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType == opmlContentType {
+		data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, middleware.MaxRequestBodyBytes))
+		if err != nil {
+			h.HandleError(w, r, bodyError(err))
+			return
+		}
+		if list, err = domain.ParseOPML(data); err != nil {
+			h.HandleError(w, r, application.ErrInvalidReadingList.WithDetails(err.Error()))
+			return
+		}
+	} else {
+		var req api.ReadingList
+		if !h.DecodeJSON(w, r, &req) {
+			return
+		}
+		list = apiReadingListToDomain(req)
+	}
+
+	report, err := h.service.ImportReadingList(r.Context(), userID, uuid.UUID(id), list)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, readingListImportToAPI(report), http.StatusOK)
+}
+
+// opmlContentType is the media type of OPML reading lists
+const opmlContentType = "text/x-opml"
+
 // Helper functions
 
 func buildPaginatedThemesResponse(themes []*ports.ThemeSummary, total int, filter ports.ListFilter) api.PaginatedThemes {
@@ -371,9 +749,15 @@ func buildThemeListFilter(params api.ListThemesParams) ports.ListFilter {
 		filter.Offset = (*params.Page - 1) * filter.Limit
 	}
 
-	// IsActive filter
-	if params.IsActive != nil {
-		filter.IsActive = params.IsActive
+	// Status filter - the deprecated isActive flag is honoured when status is absent,
+	// and the public listing of active themes is the default
+	switch {
+	case params.Status != nil:
+		filter.Statuses = []domain.ThemeStatus{domain.ThemeStatus(*params.Status)}
+	case params.IsActive != nil && !*params.IsActive:
+		filter.Statuses = []domain.ThemeStatus{domain.ThemeStatusDraft, domain.ThemeStatusArchived}
+	default:
+		filter.Statuses = []domain.ThemeStatus{domain.ThemeStatusActive}
 	}
 
 	// Curator filter
@@ -382,8 +766,30 @@ func buildThemeListFilter(params api.ListThemesParams) ports.ListFilter {
 		filter.CuratorID = &curatorID
 	}
 
-	// Note: Sorting is not implemented in the repository yet
-	// This would need to be added to the ListFilter and repository implementation
+	// Search filter
+	if params.Q != nil {
+		filter.SearchQuery = strings.TrimSpace(*params.Q)
+	}
+
+	// Sorting - newest first unless asked otherwise
+	filter.OrderBy = ports.OrderByCreatedAt
+	filter.OrderDesc = true
+	if params.SortBy != nil {
+		switch *params.SortBy {
+		case api.ListThemesParamsSortByCreatedAt:
+			filter.OrderBy = ports.OrderByCreatedAt
+		case api.ListThemesParamsSortByUpdatedAt:
+			filter.OrderBy = ports.OrderByUpdatedAt
+		case api.ListThemesParamsSortByName:
+			filter.OrderBy = ports.OrderByName
+		case api.ListThemesParamsSortByArticleCount:
+			filter.OrderBy = ports.OrderByArticleCount
+		}
+	}
+
+	if params.SortOrder != nil && *params.SortOrder == api.ListThemesParamsSortOrderAsc {
+		filter.OrderDesc = false
+	}
 
 	return filter
 }
@@ -394,9 +800,11 @@ func themeSummaryToAPI(summary *ports.ThemeSummary) api.ThemeSummary {
 		Name:         summary.Name,
 		Description:  summary.Description,
 		Slug:         summary.Slug,
-		IsActive:     summary.IsActive,
+		Status:       api.ThemeStatus(summary.Status),
+		IsActive:     summary.Status == domain.ThemeStatusActive,
 		CuratorId:    openapi_types.UUID(summary.CuratorID),
 		CreatedAt:    summary.CreatedAt,
+		DeletedAt:    summary.DeletedAt,
 		ArticleCount: summary.ArticleCount,
 	}
 
@@ -409,7 +817,8 @@ func domainThemeToAPI(theme *domain.Theme) api.Theme {
 		Name:         theme.Name,
 		Description:  theme.Description,
 		Slug:         theme.Slug,
-		IsActive:     theme.IsActive,
+		Status:       api.ThemeStatus(theme.Status),
+		IsActive:     theme.IsActive(),
 		CuratorId:    openapi_types.UUID(theme.CuratorID),
 		CreatedAt:    theme.CreatedAt,
 		UpdatedAt:    theme.UpdatedAt,
@@ -425,23 +834,150 @@ func domainThemeWithArticlesToAPI(theme *domain.Theme) api.ThemeWithArticles {
 		Name:         theme.Name,
 		Description:  theme.Description,
 		Slug:         theme.Slug,
-		IsActive:     theme.IsActive,
+		Status:       api.ThemeStatus(theme.Status),
+		IsActive:     theme.IsActive(),
 		CuratorId:    openapi_types.UUID(theme.CuratorID),
 		CreatedAt:    theme.CreatedAt,
 		UpdatedAt:    theme.UpdatedAt,
 		ArticleCount: len(theme.Articles),
-		Articles:     make([]api.ThemeArticle, 0, len(theme.Articles)),
+		Articles:     themeArticlesToAPI(theme.Articles),
 	}
 
-	// Convert articles
-	for _, article := range theme.Articles {
-		apiTheme.Articles = append(apiTheme.Articles, api.ThemeArticle{
+	return apiTheme
+}
+
+func themeArticlesToAPI(articles []*domain.ThemeArticle) []api.ThemeArticle {
+	apiArticles := make([]api.ThemeArticle, 0, len(articles))
+	for _, article := range articles {
+		apiArticles = append(apiArticles, api.ThemeArticle{
 			PostId:   openapi_types.UUID(article.PostID),
 			Position: article.Position,
+			IsPinned: article.IsPinned,
 			AddedAt:  article.AddedAt,
 			AddedBy:  openapi_types.UUID(article.AddedBy),
+			Note:     stringToPointer(article.Note),
 		})
 	}
+	return apiArticles
+}
 
-	return apiTheme
+func themePageToAPI(page *application.ThemePage) api.ThemePage {
+	theme := domainThemeToAPI(page.Theme)
+	// Slug lookups skip the aggregate's articles; count the ones the page lists
+	theme.ArticleCount = len(page.Articles)
+
+	articles := make([]api.ThemePageArticle, 0, len(page.Articles))
+	for _, article := range page.Articles {
+		articles = append(articles, api.ThemePageArticle{
+			PostId:   openapi_types.UUID(article.PostID),
+			Position: article.Position,
+			IsPinned: article.IsPinned,
+			Note:     stringToPointer(article.Note),
+			Title:    article.PostTitle,
+			Slug:     article.PostSlug,
+			Excerpt:  stringToPointer(article.PostExcerpt),
+			Author: api.AuthorSummary{
+				Id:       openapi_types.UUID(article.AuthorID),
+				Username: article.AuthorName,
+			},
+			PublishedAt:    article.PublishedAt,
+			ReadingMinutes: domain.ReadingMinutes(article.WordCount),
+		})
+	}
+
+	related := make([]api.ThemeSummary, 0, len(page.Related))
+	for _, summary := range page.Related {
+		related = append(related, themeSummaryToAPI(summary))
+	}
+
+	response := api.ThemePage{
+		Theme:         theme,
+		Articles:      articles,
+		FollowerCount: page.FollowerCount,
+		RelatedThemes: related,
+	}
+	if page.Curator != nil {
+		response.Curator = &api.AuthorSummary{
+			Id:          openapi_types.UUID(page.Curator.ID),
+			Username:    page.Curator.Username,
+			DisplayName: stringToPointer(page.Curator.DisplayName),
+			AvatarUrl:   stringToPointer(page.Curator.AvatarURL),
+		}
+	}
+	return response
+}
+
+func readingListToAPI(list *domain.ReadingList) api.ReadingList {
+	return api.ReadingList{
+		Title:       list.Title,
+		Description: stringToPointer(list.Description),
+		Entries:     readingListEntriesToAPI(list.Entries),
+	}
+}
+
+func readingListEntriesToAPI(entries []domain.ReadingListEntry) []api.ReadingListEntry {
+	apiEntries := make([]api.ReadingListEntry, 0, len(entries))
+	for _, entry := range entries {
+		apiEntries = append(apiEntries, api.ReadingListEntry{
+			Title: entry.Title,
+			Slug:  stringToPointer(entry.Slug),
+			Url:   stringToPointer(entry.URL),
+			Note:  stringToPointer(entry.Note),
+		})
+	}
+	return apiEntries
+}
+
+func apiReadingListToDomain(req api.ReadingList) *domain.ReadingList {
+	list := &domain.ReadingList{
+		Title:       req.Title,
+		Description: getStringValue(req.Description),
+		Entries:     make([]domain.ReadingListEntry, 0, len(req.Entries)),
+	}
+	for _, entry := range req.Entries {
+		list.Entries = append(list.Entries, domain.ReadingListEntry{
+			Title: entry.Title,
+			Slug:  getStringValue(entry.Slug),
+			URL:   getStringValue(entry.Url),
+			Note:  getStringValue(entry.Note),
+		})
+	}
+	return list
+}
+
+func readingListImportToAPI(report *application.ReadingListImport) api.ReadingListImportReport {
+	response := api.ReadingListImportReport{
+		Matched:   make([]api.ReadingListMatch, 0, len(report.Matched)),
+		Unmatched: readingListEntriesToAPI(report.Unmatched),
+	}
+	for _, matched := range report.Matched {
+		response.Matched = append(response.Matched, api.ReadingListMatch{
+			PostId:   openapi_types.UUID(matched.PostID),
+			Slug:     matched.Slug,
+			Position: matched.Position,
+			Added:    matched.Added,
+		})
+	}
+	return response
+}
+
+func batchArticleResultsToAPI(ctx context.Context, results []application.BatchArticleResult) api.ThemeArticleBatchReport {
+	report := api.ThemeArticleBatchReport{
+		Results: make([]api.ThemeArticleBatchResult, 0, len(results)),
+	}
+	for _, result := range results {
+		item := api.ThemeArticleBatchResult{
+			PostId: openapi_types.UUID(result.PostID),
+			Added:  result.Err == nil,
+		}
+		if result.Err == nil {
+			position := result.Position
+			item.Position = &position
+			report.AddedCount++
+		} else {
+			item.Error = appErrorToAPI(ctx, result.Err)
+		}
+		report.Results = append(report.Results, item)
+	}
+	return report
 }
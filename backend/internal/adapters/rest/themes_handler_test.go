@@ -0,0 +1,50 @@
+package rest
+
+import (
+	"context"
+	"testing"
+
+	"backend/internal/platform/i18n"
+	"backend/internal/themes/application"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestBatchArticleResultsToAPI(t *testing.T) {
+	added, skipped := uuid.New(), uuid.New()
+
+	report := batchArticleResultsToAPI(context.Background(), []application.BatchArticleResult{
+		{PostID: added, Position: 4},
+		{PostID: skipped, Err: application.ErrPostNotPublished},
+	})
+
+	assert.Equal(t, 1, report.AddedCount)
+	require.Len(t, report.Results, 2)
+
+	assert.True(t, report.Results[0].Added)
+	require.NotNil(t, report.Results[0].Position)
+	assert.Equal(t, 4, *report.Results[0].Position)
+	assert.Nil(t, report.Results[0].Error)
+
+	assert.Equal(t, skipped, uuid.UUID(report.Results[1].PostId))
+	assert.False(t, report.Results[1].Added)
+	assert.Nil(t, report.Results[1].Position)
+	require.NotNil(t, report.Results[1].Error)
+	assert.Equal(t, "only published posts can be added to themes", report.Results[1].Error.Message)
+	assert.Equal(t, "CANNOT_ADD_TO_THEME", (*report.Results[1].Error.Details)["business_code"])
+}
+
+func TestBatchArticleResultsToAPI_Localized(t *testing.T) {
+	ctx := i18n.WithLanguage(context.Background(), language.MustParse("zh-TW"))
+
+	report := batchArticleResultsToAPI(ctx, []application.BatchArticleResult{
+		{PostID: uuid.New(), Err: application.ErrPostNotPublished},
+	})
+
+	require.Len(t, report.Results, 1)
+	require.NotNil(t, report.Results[0].Error)
+	assert.Equal(t, "此文章無法加入主題", report.Results[0].Error.Message)
+	assert.Equal(t, "CANNOT_ADD_TO_THEME", (*report.Results[0].Error.Details)["business_code"])
+}
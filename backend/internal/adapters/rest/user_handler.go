@@ -1,26 +1,30 @@
 package rest
 
 import (
-	"encoding/json"
 	"net/http"
+	"strings"
 
 	"backend/internal/adapters/api"
 	"backend/internal/adapters/rest/middleware"
+	followsApp "backend/internal/follows/application"
 	"backend/internal/users/application"
 	"backend/internal/users/domain"
+	"backend/internal/users/ports"
 	"github.com/google/uuid"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 type UserHandler struct {
 	*BaseHandler
-	service *application.UserService
+	service        *application.UserService
+	followsService *followsApp.FollowsService
 }
 
-func NewUserHandler(base *BaseHandler, service *application.UserService) *UserHandler {
+func NewUserHandler(base *BaseHandler, service *application.UserService, followsService *followsApp.FollowsService) *UserHandler {
 	return &UserHandler{
-		BaseHandler: base,
-		service:     service,
+		BaseHandler:    base,
+		service:        service,
+		followsService: followsService,
 	}
 }
 
@@ -44,8 +48,7 @@ func (h *UserHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
 
 	// Parse request body
 	var req api.NewUserRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.WriteJSONError(w, r, "validation_error", "Invalid request body", http.StatusBadRequest)
+	if !h.DecodeJSON(w, r, &req) {
 		return
 	}
 
@@ -59,8 +62,8 @@ func (h *UserHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
 		AvatarURL:   getStringValue(req.AvatarUrl),
 	}
 
-	// Call service to create user (validation happens in the service)
-	user, err := h.service.CreateUser(r.Context(), params)
+	// Call service to onboard the user (validation happens in the service)
+	user, created, err := h.service.CreateUser(r.Context(), params)
 	if err != nil {
 		h.HandleError(w, r, err)
 		return
@@ -69,8 +72,32 @@ func (h *UserHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
 	// Convert domain user to API response
 	response := domainUserToAPI(user)
 
-	// Return success response
-	h.WriteJSONResponse(w, r, response, http.StatusCreated)
+	// A retried onboarding returns the existing profile
+	status := http.StatusCreated
+	if !created {
+		status = http.StatusOK
+	}
+	h.WriteJSONResponse(w, r, response, status)
+}
+
+// CheckUsernameAvailability implements the OpenAPI generated ServerInterface
+// Like CreateUser, it sits behind JWT validation alone, since callers have no profile yet
+func (h *UserHandler) CheckUsernameAvailability(w http.ResponseWriter, r *http.Request, params api.CheckUsernameAvailabilityParams) {
+	availability, err := h.service.CheckUsernameAvailability(r.Context(), params.Name)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	response := api.UsernameAvailability{
+		Username:  availability.Username,
+		Available: availability.Available,
+	}
+	if availability.Reason != "" {
+		reason := api.UsernameAvailabilityReason(availability.Reason)
+		response.Reason = &reason
+	}
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
 }
 
 // GetCurrentUser implements the OpenAPI generated ServerInterface
@@ -89,10 +116,131 @@ func (h *UserHandler) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
 	// Convert domain user to API response
 	response := domainUserToAPI(user)
 
+	// Attach follower/following counts to the profile
+	stats, err := h.followsService.GetFollowStats(r.Context(), userID, nil)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+	response.FollowerCount = &stats.FollowerCount
+	response.FollowingCount = &stats.FollowingCount
+
 	// Return success response
 	h.WriteJSONResponse(w, r, response, http.StatusOK)
 }
 
+// RenameCurrentUser changes the authenticated user's username
+// NOTE: Authorization middleware checks users:update:self permission before this is called
+func (h *UserHandler) RenameCurrentUser(w http.ResponseWriter, r *http.Request) {
+	userID := h.GetUserIDFromContext(r)
+
+	var req api.RenameUserRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	user, err := h.service.RenameUser(r.Context(), userID.String(), req.Username)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainUserToAPI(user), http.StatusOK)
+}
+
+// SetCurrentUserTimezone changes the zone the authenticated user reads and writes times in
+// NOTE: Authorization middleware checks users:update:self permission before this is called
+func (h *UserHandler) SetCurrentUserTimezone(w http.ResponseWriter, r *http.Request) {
+	userID := h.GetUserIDFromContext(r)
+
+	var req api.SetTimezoneRequest
+	if !h.DecodeJSON(w, r, &req) {
+		return
+	}
+
+	user, err := h.service.SetUserTimezone(r.Context(), userID.String(), req.Timezone)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, r, domainUserToAPI(user), http.StatusOK)
+}
+
+// ListAdminUsers finds users by email or username, role and suspension
+// NOTE: Authorization middleware checks users:read:any permission before this is called
+func (h *UserHandler) ListAdminUsers(w http.ResponseWriter, r *http.Request, params api.ListAdminUsersParams) {
+	// Pagination - convert page-based to offset-based
+	limit := 20
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = *params.Limit
+	}
+	offset := 0
+	if params.Page != nil && *params.Page > 0 {
+		offset = (*params.Page - 1) * limit
+	}
+
+	filter := ports.ListFilter{Suspended: params.Suspended, Limit: limit, Offset: offset}
+	if params.Q != nil {
+		filter.Search = strings.TrimSpace(*params.Q)
+	}
+	if params.Role != nil {
+		filter.Role = *params.Role
+	}
+
+	users, total, err := h.service.ListUsers(r.Context(), filter)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	data := make([]api.AdminUser, len(users))
+	for i, listed := range users {
+		data[i] = api.AdminUser{
+			User:        domainUserToAPI(listed.User),
+			Roles:       listed.Roles,
+			SuspendedAt: listed.User.SuspendedAt,
+		}
+	}
+
+	response := api.PaginatedAdminUsers{
+		Data: data,
+		Meta: api.PaginationMeta{
+			TotalItems:   total,
+			ItemsPerPage: limit,
+			CurrentPage:  (offset / limit) + 1,
+			TotalPages:   (total + limit - 1) / limit,
+		},
+	}
+	h.WriteJSONResponse(w, r, response, http.StatusOK)
+}
+
+// GetAuthorByUsername returns an author's public profile
+// NOTE: Public endpoint - no authorization required
+func (h *UserHandler) GetAuthorByUsername(w http.ResponseWriter, r *http.Request, username string) {
+	user, err := h.service.GetAuthorByUsername(r.Context(), username)
+	if err != nil {
+		h.HandleError(w, r, err)
+		return
+	}
+
+	// The author renamed or the letter case differs; point clients at the current username
+	if user.Username != username {
+		h.WriteSlugRedirect(w, r, user.Username)
+		return
+	}
+
+	parsedUUID, _ := uuid.Parse(user.ID)
+	h.WriteJSONResponse(w, r, api.AuthorProfile{
+		Id:          openapi_types.UUID(parsedUUID),
+		Username:    user.Username,
+		DisplayName: stringToPointer(user.DisplayName),
+		Bio:         stringToPointer(user.Bio),
+		AvatarUrl:   stringToPointer(user.AvatarURL),
+		CreatedAt:   user.CreatedAt,
+	}, http.StatusOK)
+}
+
 // Helper function to convert domain User to API User
 func domainUserToAPI(user *domain.User) api.User {
 	// Parse UUID string (User.ID is a string, not uuid.UUID)
@@ -105,6 +253,7 @@ func domainUserToAPI(user *domain.User) api.User {
 		DisplayName: stringToPointer(user.DisplayName),
 		Bio:         stringToPointer(user.Bio),
 		AvatarUrl:   stringToPointer(user.AvatarURL),
+		Timezone:    stringToPointer(user.Timezone),
 		CreatedAt:   user.CreatedAt,
 		UpdatedAt:   user.UpdatedAt,
 	}
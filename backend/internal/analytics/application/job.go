@@ -0,0 +1,56 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend/internal/analytics/ports"
+	"backend/internal/platform/logger"
+	"backend/internal/platform/schedule"
+)
+
+// JobConfig schedules the view rollup job
+type JobConfig struct {
+	// Interval between runs; zero or less disables the job, leaving views
+	// recorded but missing from the breakdowns
+	Interval time.Duration
+}
+
+// Job folds recorded views into daily counts on a schedule, which keeps the
+// breakdowns cheap and the table of single views small
+type Job struct {
+	repo   ports.ViewRepository
+	config JobConfig
+	logger logger.Logger
+}
+
+// NewJob creates the scheduled view rollup job
+func NewJob(repo ports.ViewRepository, config JobConfig, logger logger.Logger) *Job {
+	return &Job{
+		repo:   repo,
+		config: config,
+		logger: logger,
+	}
+}
+
+// Run blocks until ctx is done, rolling views up once per interval
+func (j *Job) Run(ctx context.Context) {
+	schedule.Every(ctx, j.config.Interval, func(ctx context.Context) {
+		if err := j.RollUp(ctx); err != nil && ctx.Err() == nil {
+			j.logger.Error(ctx, "view rollup job failed", "error", err)
+		}
+	})
+}
+
+// RollUp folds every view recorded so far into the daily counts
+func (j *Job) RollUp(ctx context.Context) error {
+	views, err := j.repo.RollUp(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("Job.RollUp: %w", err)
+	}
+	if views > 0 {
+		j.logger.Info(ctx, "rolled up post views", "views", views)
+	}
+	return nil
+}
@@ -0,0 +1,9 @@
+package application
+
+import "github.com/google/wire"
+
+// ProviderSet is the wire provider set for the analytics application layer
+var ProviderSet = wire.NewSet(
+	NewAnalyticsService,
+	NewJob,
+)
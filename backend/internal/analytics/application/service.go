@@ -0,0 +1,176 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"backend/internal/analytics/domain"
+	"backend/internal/analytics/ports"
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/clientip"
+	"backend/internal/platform/logger"
+	"backend/internal/platform/ratelimit"
+	"github.com/google/uuid"
+)
+
+// ViewDedupWindow is how long repeated views of a post from one client address
+// count once, so reloads and crawlers retrying do not inflate the numbers
+const ViewDedupWindow = 30 * time.Minute
+
+// Breakdown defaults
+const (
+	DefaultTrafficDays = 30
+	TopSources         = 10 // Referrers and campaigns listed per breakdown
+)
+
+// TrendingWindow is how far back views count towards a post trending
+const TrendingWindow = 7 * 24 * time.Hour
+
+// Error definitions for service operations
+var (
+	ErrPostNotFound = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodePostNotFound,
+		"post not found",
+		http.StatusNotFound,
+	)
+
+	ErrInvalidRange = apperror.New(
+		apperror.CodeValidationFailed,
+		apperror.BusinessCodeInvalidFormat,
+		"invalid date range",
+		http.StatusBadRequest,
+	)
+)
+
+// TrackingConfig tunes view attribution
+type TrackingConfig struct {
+	// OwnHost is the host of the blog frontend; referrers from it are internal
+	// navigation, not a traffic source
+	OwnHost string
+}
+
+// AnalyticsService records post views with their traffic source and reports on them
+type AnalyticsService struct {
+	repo       ports.ViewRepository
+	authorizer ports.Authorizer
+	config     TrackingConfig
+	logger     logger.Logger
+	dedup      ratelimit.Limiter
+}
+
+// NewAnalyticsService creates a new analytics service
+func NewAnalyticsService(
+	repo ports.ViewRepository,
+	authorizer ports.Authorizer,
+	config TrackingConfig,
+	logger logger.Logger,
+) *AnalyticsService {
+	return &AnalyticsService{
+		repo:       repo,
+		authorizer: authorizer,
+		config:     config,
+		logger:     logger,
+		dedup:      ratelimit.NewFixedWindowLimiter(1, ViewDedupWindow),
+	}
+}
+
+// RecordView counts a reader's view of a published post, attributed to the
+// referrer and UTM parameters the frontend saw. A repeated view within
+// ViewDedupWindow is accepted but not counted.
+// Public, so no authorization is applied
+func (s *AnalyticsService) RecordView(ctx context.Context, postID uuid.UUID, referrer string, utm domain.UTM) error {
+	if ip := clientip.FromContext(ctx); ip != "" && !s.dedup.Allow(ip+"|"+postID.String()) {
+		return nil
+	}
+
+	source := domain.NewSource(referrer, utm, s.config.OwnHost)
+	if err := s.repo.RecordView(ctx, postID, source, time.Now()); err != nil {
+		if errors.Is(err, ports.ErrPostNotFound) {
+			return ErrPostNotFound
+		}
+		s.logger.Error(ctx, "failed to record view", "error", err, "postID", postID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to record view",
+			http.StatusInternalServerError,
+		)
+	}
+	return nil
+}
+
+// GetPostTraffic breaks down where a post's views came from, for its author
+// or those who may view any analytics
+func (s *AnalyticsService) GetPostTraffic(ctx context.Context, actorID uuid.UUID, postID uuid.UUID, from, to *time.Time) (*domain.Traffic, error) {
+	allowed, err := s.authorizer.Can(ctx, actorID, "analytics", "view", &postID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !allowed {
+		return nil, apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to view this post's analytics",
+			http.StatusForbidden,
+		)
+	}
+
+	dateRange, err := trafficRange(from, to, time.Now())
+	if err != nil {
+		return nil, ErrInvalidRange.WithDetails(err.Error())
+	}
+
+	traffic, err := s.repo.PostTraffic(ctx, postID, dateRange, TopSources)
+	if err != nil {
+		if errors.Is(err, ports.ErrPostNotFound) {
+			return nil, ErrPostNotFound
+		}
+		s.logger.Error(ctx, "failed to get post traffic", "error", err, "postID", postID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to retrieve post traffic",
+			http.StatusInternalServerError,
+		)
+	}
+	return traffic, nil
+}
+
+// TrendingPosts returns up to limit posts anonymous readers may see, ranked
+// by their views over the last TrendingWindow
+// Public, so no authorization is applied
+func (s *AnalyticsService) TrendingPosts(ctx context.Context, limit int) ([]domain.PostViews, error) {
+	trending, err := s.repo.TrendingPosts(ctx, time.Now().Add(-TrendingWindow), limit)
+	if err != nil {
+		s.logger.Error(ctx, "failed to rank trending posts", "error", err)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to retrieve trending posts",
+			http.StatusInternalServerError,
+		)
+	}
+	return trending, nil
+}
+
+// trafficRange fills in a missing end with today and a missing start with
+// DefaultTrafficDays before the end
+func trafficRange(from, to *time.Time, now time.Time) (domain.DateRange, error) {
+	end := now
+	if to != nil {
+		end = *to
+	}
+	if from == nil {
+		return domain.LastDays(end, DefaultTrafficDays), nil
+	}
+	return domain.NewDateRange(*from, end)
+}
@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Channel buckets traffic by how readers reached a post
+type Channel string
+
+const (
+	ChannelDirect   Channel = "direct"   // No referrer and no campaign
+	ChannelSearch   Channel = "search"   // A search engine
+	ChannelSocial   Channel = "social"   // A social network or link aggregator
+	ChannelEmail    Channel = "email"    // A newsletter or other email campaign
+	ChannelPaid     Channel = "paid"     // Paid advertising
+	ChannelReferral Channel = "referral" // Any other site linking to the post
+)
+
+// MaxUTMLength is how much of a UTM value is kept; longer values are cut
+const MaxUTMLength = 64
+
+// searchEngines and socialNetworks map referrer domains to their channel.
+// A domain matches when it equals an entry or is a subdomain of it.
+var (
+	searchEngines = []string{
+		"google.com", "bing.com", "duckduckgo.com", "yahoo.com", "yandex.ru",
+		"baidu.com", "ecosia.org", "search.brave.com", "startpage.com", "kagi.com",
+	}
+	socialNetworks = []string{
+		"twitter.com", "x.com", "t.co", "facebook.com", "l.facebook.com", "linkedin.com",
+		"lnkd.in", "reddit.com", "news.ycombinator.com", "mastodon.social", "bsky.app",
+		"threads.net", "instagram.com", "youtube.com",
+	}
+)
+
+// UTM holds the campaign parameters of the URL a reader landed on
+type UTM struct {
+	Source   string
+	Medium   string
+	Campaign string
+}
+
+// Source is where one view came from, reduced to values safe to store and group
+// by: the referrer's domain without its path or query, and UTM values normalized
+// to a short lowercase form
+type Source struct {
+	Channel        Channel
+	ReferrerDomain string // Empty for direct traffic
+	UTMSource      string
+	UTMMedium      string
+	UTMCampaign    string
+}
+
+// NewSource attributes a view from the referrer the browser reported and the
+// UTM parameters of the landing URL. Referrers that are not absolute http(s)
+// URLs, or that point at ownHost, count as no referrer.
+func NewSource(referrer string, utm UTM, ownHost string) Source {
+	source := Source{
+		ReferrerDomain: referrerDomain(referrer),
+		UTMSource:      sanitizeUTM(utm.Source),
+		UTMMedium:      sanitizeUTM(utm.Medium),
+		UTMCampaign:    sanitizeUTM(utm.Campaign),
+	}
+	if own := normalizeHost(ownHost); own != "" && source.ReferrerDomain == own {
+		source.ReferrerDomain = ""
+	}
+	source.Channel = classify(source)
+	return source
+}
+
+// classify buckets a source, trusting the campaign medium over the referrer
+// since links in emails and ads often arrive without one
+func classify(source Source) Channel {
+	switch source.UTMMedium {
+	case "email", "newsletter":
+		return ChannelEmail
+	case "cpc", "ppc", "paid", "paidsearch", "display", "paid-social":
+		return ChannelPaid
+	case "social", "social-network", "social-media":
+		return ChannelSocial
+	}
+
+	switch {
+	case source.ReferrerDomain == "":
+		if source.UTMSource != "" {
+			return ChannelReferral
+		}
+		return ChannelDirect
+	case matchesDomain(source.ReferrerDomain, searchEngines) || isGoogleDomain(source.ReferrerDomain):
+		return ChannelSearch
+	case matchesDomain(source.ReferrerDomain, socialNetworks):
+		return ChannelSocial
+	default:
+		return ChannelReferral
+	}
+}
+
+// referrerDomain extracts the host of an absolute http(s) URL
+func referrerDomain(referrer string) string {
+	u, err := url.Parse(strings.TrimSpace(referrer))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return ""
+	}
+	return normalizeHost(u.Hostname())
+}
+
+// normalizeHost lowercases a host and drops a leading www.
+func normalizeHost(host string) string {
+	host = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(host)), ".")
+	return strings.TrimPrefix(host, "www.")
+}
+
+// matchesDomain reports whether domain is one of domains or a subdomain of one
+func matchesDomain(domain string, domains []string) bool {
+	for _, d := range domains {
+		if domain == d || strings.HasSuffix(domain, "."+d) {
+			return true
+		}
+	}
+	return false
+}
+
+// isGoogleDomain matches Google's country domains, such as google.co.uk or google.de
+func isGoogleDomain(domain string) bool {
+	return strings.HasPrefix(domain, "google.") || strings.Contains(domain, ".google.")
+}
+
+// sanitizeUTM lowercases a UTM value, turns runs of anything but letters,
+// digits, dots and underscores into single dashes, and cuts it to MaxUTMLength
+func sanitizeUTM(value string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(strings.TrimSpace(value)) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '.' || r == '_' {
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		if !dash && b.Len() > 0 {
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+	sanitized := strings.TrimSuffix(b.String(), "-")
+	if len(sanitized) > MaxUTMLength {
+		sanitized = strings.TrimSuffix(sanitized[:MaxUTMLength], "-")
+	}
+	return sanitized
+}
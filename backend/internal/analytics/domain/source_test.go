@@ -0,0 +1,73 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"backend/internal/analytics/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSource(t *testing.T) {
+	tests := []struct {
+		name     string
+		referrer string
+		utm      domain.UTM
+		want     domain.Source
+	}{
+		{name: "direct", want: domain.Source{Channel: domain.ChannelDirect}},
+		{
+			name:     "search with path and query dropped",
+			referrer: "https://www.Google.co.uk/search?q=arch+blog",
+			want:     domain.Source{Channel: domain.ChannelSearch, ReferrerDomain: "google.co.uk"},
+		},
+		{
+			name:     "social subdomain",
+			referrer: "https://old.reddit.com/r/golang/comments/abc",
+			want:     domain.Source{Channel: domain.ChannelSocial, ReferrerDomain: "old.reddit.com"},
+		},
+		{
+			name:     "other site",
+			referrer: "http://example.org:8080/links",
+			want:     domain.Source{Channel: domain.ChannelReferral, ReferrerDomain: "example.org"},
+		},
+		{
+			name:     "own site counts as direct",
+			referrer: "https://blog.example.com/posts/other",
+			want:     domain.Source{Channel: domain.ChannelDirect},
+		},
+		{
+			name:     "not a web URL",
+			referrer: "android-app://com.slack",
+			want:     domain.Source{Channel: domain.ChannelDirect},
+		},
+		{
+			name:     "medium wins over referrer",
+			referrer: "https://mail.google.com/",
+			utm:      domain.UTM{Source: "Weekly Digest", Medium: "Email", Campaign: "2026/10 launch!"},
+			want: domain.Source{
+				Channel:        domain.ChannelEmail,
+				ReferrerDomain: "mail.google.com",
+				UTMSource:      "weekly-digest",
+				UTMMedium:      "email",
+				UTMCampaign:    "2026-10-launch",
+			},
+		},
+		{
+			name: "tagged link without referrer",
+			utm:  domain.UTM{Source: "slack"},
+			want: domain.Source{Channel: domain.ChannelReferral, UTMSource: "slack"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, domain.NewSource(tt.referrer, tt.utm, "blog.example.com"))
+		})
+	}
+}
+
+func TestNewSourceCutsLongUTMValues(t *testing.T) {
+	source := domain.NewSource("", domain.UTM{Campaign: strings.Repeat("a", 100)}, "")
+	assert.Len(t, source.UTMCampaign, domain.MaxUTMLength)
+}
@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxRangeDays bounds how many days one traffic breakdown may cover
+const MaxRangeDays = 366
+
+// ErrInvalidRange is returned for a date range that ends before it starts or is too long
+var ErrInvalidRange = errors.New("the range must end on or after its start and cover at most 366 days")
+
+// DateRange is a span of whole UTC days, both ends included
+type DateRange struct {
+	From time.Time
+	To   time.Time
+}
+
+// NewDateRange creates a range from two dates, truncated to UTC days, with validation
+func NewDateRange(from, to time.Time) (DateRange, error) {
+	r := DateRange{From: truncateDay(from), To: truncateDay(to)}
+	if r.To.Before(r.From) || r.Days() > MaxRangeDays {
+		return DateRange{}, ErrInvalidRange
+	}
+	return r, nil
+}
+
+// LastDays is the range of the given number of days ending on the day of now
+func LastDays(now time.Time, days int) DateRange {
+	to := truncateDay(now)
+	return DateRange{From: to.AddDate(0, 0, -(days - 1)), To: to}
+}
+
+// Days counts the days in the range
+func (r DateRange) Days() int {
+	return int(r.To.Sub(r.From).Hours()/24) + 1
+}
+
+func truncateDay(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// ChannelViews counts the views arriving through a channel
+type ChannelViews struct {
+	Channel Channel
+	Views   int
+}
+
+// ReferrerViews counts the views referred by a domain
+type ReferrerViews struct {
+	Domain string
+	Views  int
+}
+
+// CampaignViews counts the views tagged with one combination of UTM values
+type CampaignViews struct {
+	Source   string
+	Medium   string
+	Campaign string
+	Views    int
+}
+
+// Traffic breaks down where a post's views came from over a range of days
+type Traffic struct {
+	PostID    uuid.UUID
+	Range     DateRange
+	Views     int
+	Channels  []ChannelViews  // Every channel with views, most first
+	Referrers []ReferrerViews // The top referring domains, most first
+	Campaigns []CampaignViews // The top campaigns, most first
+}
+
+// PostViews counts the views of one post
+type PostViews struct {
+	PostID uuid.UUID
+	Views  int
+}
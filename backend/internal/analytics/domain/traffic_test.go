@@ -0,0 +1,35 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"backend/internal/analytics/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDateRange(t *testing.T) {
+	from := time.Date(2026, 10, 1, 15, 30, 0, 0, time.UTC)
+
+	r, err := domain.NewDateRange(from, from.AddDate(0, 0, 6))
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), r.From)
+	assert.Equal(t, 7, r.Days())
+
+	r, err = domain.NewDateRange(from, from)
+	require.NoError(t, err)
+	assert.Equal(t, 1, r.Days(), "a single day is a valid range")
+
+	_, err = domain.NewDateRange(from, from.AddDate(0, 0, -1))
+	assert.ErrorIs(t, err, domain.ErrInvalidRange)
+
+	_, err = domain.NewDateRange(from, from.AddDate(0, 0, domain.MaxRangeDays))
+	assert.ErrorIs(t, err, domain.ErrInvalidRange)
+}
+
+func TestLastDays(t *testing.T) {
+	r := domain.LastDays(time.Date(2026, 10, 16, 23, 0, 0, 0, time.UTC), 30)
+	assert.Equal(t, time.Date(2026, 9, 17, 0, 0, 0, 0, time.UTC), r.From)
+	assert.Equal(t, 30, r.Days())
+}
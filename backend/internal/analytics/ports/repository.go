@@ -0,0 +1,36 @@
+package ports
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"backend/internal/analytics/domain"
+	"github.com/google/uuid"
+)
+
+// Repository errors (canonical errors for the repository contract)
+var (
+	// ErrPostNotFound is returned when the post does not exist in the blog, or
+	// when recording a view, is not published
+	ErrPostNotFound = errors.New("post not found")
+)
+
+// ViewRepository defines the contract for view tracking persistence
+// Views are recorded one by one and later folded into per-day counts
+type ViewRepository interface {
+	// RecordView stores one view of a published post of the current blog
+	RecordView(ctx context.Context, postID uuid.UUID, source domain.Source, viewedAt time.Time) error
+
+	// RollUp folds the views of every blog recorded before the cutoff into the
+	// daily counts and deletes them, returning how many were folded
+	RollUp(ctx context.Context, before time.Time) (int64, error)
+
+	// PostTraffic breaks down the rolled-up views of a post of the current
+	// blog over a range, keeping the top referrers and campaigns
+	PostTraffic(ctx context.Context, postID uuid.UUID, dateRange domain.DateRange, top int) (*domain.Traffic, error)
+
+	// TrendingPosts ranks the current blog's posts listed to anonymous readers
+	// by their views since the cutoff, rolled up or not, most viewed first
+	TrendingPosts(ctx context.Context, since time.Time, limit int) ([]domain.PostViews, error)
+}
@@ -0,0 +1,8 @@
+package application
+
+import "github.com/google/wire"
+
+// ProviderSet is the wire provider set for the announcements application layer
+var ProviderSet = wire.NewSet(
+	NewAnnouncementsService,
+)
@@ -0,0 +1,255 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"backend/internal/announcements/domain"
+	"backend/internal/announcements/ports"
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/events"
+	"backend/internal/platform/logger"
+	"github.com/google/uuid"
+)
+
+// Error definitions for service operations
+var (
+	ErrAnnouncementNotFound = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodeAnnouncementNotFound,
+		"announcement not found",
+		http.StatusNotFound,
+	)
+
+	ErrInvalidAnnouncement = apperror.New(
+		apperror.CodeValidationFailed,
+		apperror.BusinessCodeAnnouncementInvalid,
+		"invalid announcement",
+		http.StatusBadRequest,
+	)
+)
+
+// AnnouncementsService manages the announcements broadcast to a blog's readers
+type AnnouncementsService struct {
+	repo       ports.AnnouncementRepository
+	authorizer ports.Authorizer
+	eventBus   *eventbus.Bus
+	logger     logger.Logger
+}
+
+// NewAnnouncementsService creates a new announcements service
+func NewAnnouncementsService(
+	repo ports.AnnouncementRepository,
+	authorizer ports.Authorizer,
+	eventBus *eventbus.Bus,
+	logger logger.Logger,
+) *AnnouncementsService {
+	return &AnnouncementsService{
+		repo:       repo,
+		authorizer: authorizer,
+		eventBus:   eventBus,
+		logger:     logger,
+	}
+}
+
+// AnnouncementParams contains the editable fields of an announcement
+type AnnouncementParams struct {
+	Kind     domain.Kind
+	Title    string
+	Message  string
+	StartsAt time.Time
+	EndsAt   time.Time
+}
+
+// CreateAnnouncement schedules a new announcement
+func (s *AnnouncementsService) CreateAnnouncement(ctx context.Context, actorID uuid.UUID, params AnnouncementParams) (*domain.Announcement, error) {
+	if err := s.checkCanManage(ctx, actorID); err != nil {
+		return nil, err
+	}
+
+	announcement, err := domain.NewAnnouncement(params.Kind, params.Title, params.Message, params.StartsAt, params.EndsAt, actorID)
+	if err != nil {
+		return nil, ErrInvalidAnnouncement.WithDetails(err.Error())
+	}
+
+	if err := s.repo.Create(ctx, announcement); err != nil {
+		s.logger.Error(ctx, "failed to create announcement", "error", err)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to create announcement",
+			http.StatusInternalServerError,
+		)
+	}
+
+	s.logger.Info(ctx, "announcement created", "announcementID", announcement.ID, "actorID", actorID)
+	s.eventBus.Publish(ctx, eventbus.Event{
+		Topic: events.AnnouncementCreatedTopic,
+		Payload: events.AnnouncementCreatedEvent{
+			AnnouncementID: announcement.ID,
+			ActorID:        actorID,
+			OccurredAt:     time.Now(),
+		},
+	})
+	return announcement, nil
+}
+
+// UpdateAnnouncement changes an announcement's content and window
+func (s *AnnouncementsService) UpdateAnnouncement(ctx context.Context, actorID uuid.UUID, id uuid.UUID, params AnnouncementParams) (*domain.Announcement, error) {
+	if err := s.checkCanManage(ctx, actorID); err != nil {
+		return nil, err
+	}
+
+	announcement, err := s.findAnnouncement(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := announcement.Update(params.Kind, params.Title, params.Message, params.StartsAt, params.EndsAt); err != nil {
+		return nil, ErrInvalidAnnouncement.WithDetails(err.Error())
+	}
+
+	if err := s.repo.Update(ctx, announcement); err != nil {
+		if errors.Is(err, ports.ErrAnnouncementNotFound) {
+			return nil, ErrAnnouncementNotFound
+		}
+		s.logger.Error(ctx, "failed to update announcement", "error", err, "announcementID", id)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to update announcement",
+			http.StatusInternalServerError,
+		)
+	}
+
+	s.eventBus.Publish(ctx, eventbus.Event{
+		Topic: events.AnnouncementUpdatedTopic,
+		Payload: events.AnnouncementUpdatedEvent{
+			AnnouncementID: id,
+			ActorID:        actorID,
+			OccurredAt:     time.Now(),
+		},
+	})
+	return announcement, nil
+}
+
+// DeleteAnnouncement removes an announcement, taking it down at once if it is shown
+func (s *AnnouncementsService) DeleteAnnouncement(ctx context.Context, actorID uuid.UUID, id uuid.UUID) error {
+	if err := s.checkCanManage(ctx, actorID); err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		if errors.Is(err, ports.ErrAnnouncementNotFound) {
+			return ErrAnnouncementNotFound
+		}
+		s.logger.Error(ctx, "failed to delete announcement", "error", err, "announcementID", id)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to delete announcement",
+			http.StatusInternalServerError,
+		)
+	}
+
+	s.logger.Info(ctx, "announcement deleted", "announcementID", id, "actorID", actorID)
+	s.eventBus.Publish(ctx, eventbus.Event{
+		Topic: events.AnnouncementDeletedTopic,
+		Payload: events.AnnouncementDeletedEvent{
+			AnnouncementID: id,
+			ActorID:        actorID,
+			OccurredAt:     time.Now(),
+		},
+	})
+	return nil
+}
+
+// GetAnnouncement retrieves an announcement for administration
+func (s *AnnouncementsService) GetAnnouncement(ctx context.Context, actorID uuid.UUID, id uuid.UUID) (*domain.Announcement, error) {
+	if err := s.checkCanManage(ctx, actorID); err != nil {
+		return nil, err
+	}
+	return s.findAnnouncement(ctx, id)
+}
+
+// ListAnnouncements returns every announcement of the blog, past and scheduled ones included
+func (s *AnnouncementsService) ListAnnouncements(ctx context.Context, actorID uuid.UUID) ([]*domain.Announcement, error) {
+	if err := s.checkCanManage(ctx, actorID); err != nil {
+		return nil, err
+	}
+
+	announcements, err := s.repo.List(ctx)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list announcements", "error", err)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to list announcements",
+			http.StatusInternalServerError,
+		)
+	}
+	return announcements, nil
+}
+
+// ListActiveAnnouncements returns the announcements readers see now
+// Public, so no authorization is applied
+func (s *AnnouncementsService) ListActiveAnnouncements(ctx context.Context) ([]*domain.Announcement, error) {
+	announcements, err := s.repo.ListActive(ctx, time.Now())
+	if err != nil {
+		s.logger.Error(ctx, "failed to list active announcements", "error", err)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to list announcements",
+			http.StatusInternalServerError,
+		)
+	}
+	return announcements, nil
+}
+
+// Private helper methods
+
+// findAnnouncement loads an announcement by ID and maps a miss to ErrAnnouncementNotFound
+func (s *AnnouncementsService) findAnnouncement(ctx context.Context, id uuid.UUID) (*domain.Announcement, error) {
+	announcement, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, ports.ErrAnnouncementNotFound) {
+			return nil, ErrAnnouncementNotFound
+		}
+		s.logger.Error(ctx, "failed to get announcement", "error", err, "announcementID", id)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to get announcement",
+			http.StatusInternalServerError,
+		)
+	}
+	return announcement, nil
+}
+
+// checkCanManage verifies the actor may manage the blog's announcements,
+// which are part of its settings and so need settings:blog
+func (s *AnnouncementsService) checkCanManage(ctx context.Context, actorID uuid.UUID) error {
+	allowed, err := s.authorizer.Can(ctx, actorID, "settings", "blog", nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !allowed {
+		return apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to manage announcements",
+			http.StatusForbidden,
+		)
+	}
+	return nil
+}
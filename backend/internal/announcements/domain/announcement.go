@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Kind tells the frontend how to present an announcement
+type Kind string
+
+const (
+	KindInfo        Kind = "info"
+	KindMaintenance Kind = "maintenance" // A planned outage or degraded service
+	KindFeature     Kind = "feature"     // Something new readers can try
+)
+
+// IsValid checks if the kind is a known value
+func (k Kind) IsValid() bool {
+	switch k {
+	case KindInfo, KindMaintenance, KindFeature:
+		return true
+	default:
+		return false
+	}
+}
+
+// Business rule constants
+const (
+	MaxTitleLength   = 150
+	MaxMessageLength = 1000
+)
+
+// Validation errors
+var (
+	ErrInvalidKind    = errors.New("kind must be info, maintenance or feature")
+	ErrInvalidTitle   = errors.New("title is required and must not exceed 150 characters")
+	ErrInvalidMessage = errors.New("message is required and must not exceed 1000 characters")
+	ErrInvalidWindow  = errors.New("an announcement must end after it starts")
+)
+
+// Announcement is a notice shown to every reader of a blog between its start
+// and end, such as a maintenance window or a new feature
+type Announcement struct {
+	ID        uuid.UUID
+	Kind      Kind
+	Title     string
+	Message   string
+	StartsAt  time.Time
+	EndsAt    time.Time
+	CreatedBy *uuid.UUID // Nil once the administrator who created it is deleted
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewAnnouncement creates an announcement with validation
+func NewAnnouncement(kind Kind, title, message string, startsAt, endsAt time.Time, createdBy uuid.UUID) (*Announcement, error) {
+	announcement := &Announcement{ID: uuid.New(), CreatedBy: &createdBy}
+	if err := announcement.Update(kind, title, message, startsAt, endsAt); err != nil {
+		return nil, err
+	}
+	announcement.CreatedAt = announcement.UpdatedAt
+	return announcement, nil
+}
+
+// Update replaces the announcement's content and window with validation
+func (a *Announcement) Update(kind Kind, title, message string, startsAt, endsAt time.Time) error {
+	if !kind.IsValid() {
+		return ErrInvalidKind
+	}
+
+	title = strings.TrimSpace(title)
+	if title == "" || len([]rune(title)) > MaxTitleLength {
+		return ErrInvalidTitle
+	}
+
+	message = strings.TrimSpace(message)
+	if message == "" || len([]rune(message)) > MaxMessageLength {
+		return ErrInvalidMessage
+	}
+
+	if !endsAt.After(startsAt) {
+		return ErrInvalidWindow
+	}
+
+	a.Kind = kind
+	a.Title = title
+	a.Message = message
+	// Stored in UTC whatever offset the window was given in
+	a.StartsAt = startsAt.UTC()
+	a.EndsAt = endsAt.UTC()
+	a.UpdatedAt = time.Now()
+	return nil
+}
+
+// IsActive reports whether the announcement is shown at now; its window
+// includes the start and excludes the end
+func (a *Announcement) IsActive(now time.Time) bool {
+	return !now.Before(a.StartsAt) && now.Before(a.EndsAt)
+}
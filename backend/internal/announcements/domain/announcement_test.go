@@ -0,0 +1,70 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"backend/internal/announcements/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAnnouncement(t *testing.T) {
+	start := time.Date(2026, 11, 1, 22, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Hour)
+
+	tests := []struct {
+		name    string
+		kind    domain.Kind
+		title   string
+		message string
+		endsAt  time.Time
+		wantErr error
+	}{
+		{name: "valid", kind: domain.KindMaintenance, title: " Scheduled maintenance ", message: "The site is read-only for two hours.", endsAt: end},
+		{name: "unknown kind", kind: "alert", title: "Maintenance", message: "Read-only", endsAt: end, wantErr: domain.ErrInvalidKind},
+		{name: "blank title", kind: domain.KindInfo, title: "  ", message: "Read-only", endsAt: end, wantErr: domain.ErrInvalidTitle},
+		{name: "long message", kind: domain.KindInfo, title: "Maintenance", message: strings.Repeat("x", domain.MaxMessageLength+1), endsAt: end, wantErr: domain.ErrInvalidMessage},
+		{name: "ends when it starts", kind: domain.KindInfo, title: "Maintenance", message: "Read-only", endsAt: start, wantErr: domain.ErrInvalidWindow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			announcement, err := domain.NewAnnouncement(tt.kind, tt.title, tt.message, start, tt.endsAt, uuid.New())
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "Scheduled maintenance", announcement.Title)
+			assert.NotNil(t, announcement.CreatedBy)
+			assert.Equal(t, announcement.CreatedAt, announcement.UpdatedAt)
+		})
+	}
+}
+
+func TestAnnouncementStoresItsWindowInUTC(t *testing.T) {
+	taipei := time.FixedZone("UTC+8", 8*3600)
+	start := time.Date(2026, 11, 2, 6, 0, 0, 0, taipei)
+
+	announcement, err := domain.NewAnnouncement(domain.KindInfo, "Maintenance", "Read-only", start, start.Add(time.Hour), uuid.New())
+	require.NoError(t, err)
+
+	assert.Equal(t, time.UTC, announcement.StartsAt.Location())
+	assert.Equal(t, time.Date(2026, 11, 1, 22, 0, 0, 0, time.UTC), announcement.StartsAt)
+	assert.Equal(t, time.UTC, announcement.EndsAt.Location())
+}
+
+func TestAnnouncementIsActive(t *testing.T) {
+	start := time.Date(2026, 11, 1, 22, 0, 0, 0, time.UTC)
+	announcement, err := domain.NewAnnouncement(domain.KindInfo, "Maintenance", "Read-only", start, start.Add(time.Hour), uuid.New())
+	require.NoError(t, err)
+
+	assert.False(t, announcement.IsActive(start.Add(-time.Second)))
+	assert.True(t, announcement.IsActive(start))
+	assert.True(t, announcement.IsActive(start.Add(59*time.Minute)))
+	assert.False(t, announcement.IsActive(start.Add(time.Hour)), "the end is not part of the window")
+}
@@ -0,0 +1,38 @@
+package ports
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"backend/internal/announcements/domain"
+	"github.com/google/uuid"
+)
+
+// Repository errors (canonical errors for the repository contract)
+var (
+	// ErrAnnouncementNotFound is returned when no announcement matches the lookup
+	ErrAnnouncementNotFound = errors.New("announcement not found")
+)
+
+// AnnouncementRepository defines the contract for announcement persistence
+// Announcements belong to the request's blog
+type AnnouncementRepository interface {
+	// Create stores a new announcement
+	Create(ctx context.Context, announcement *domain.Announcement) error
+
+	// Update saves changes to an existing announcement
+	Update(ctx context.Context, announcement *domain.Announcement) error
+
+	// Delete removes an announcement
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// FindByID retrieves an announcement by its ID
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.Announcement, error)
+
+	// List returns every announcement, latest start first
+	List(ctx context.Context) ([]*domain.Announcement, error)
+
+	// ListActive returns the announcements shown at now, earliest start first
+	ListActive(ctx context.Context, now time.Time) ([]*domain.Announcement, error)
+}
@@ -0,0 +1,363 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"backend/internal/apiclients/domain"
+	"backend/internal/apiclients/ports"
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/cache"
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/events"
+	"backend/internal/platform/logger"
+	"backend/internal/platform/ratelimit"
+	"backend/internal/platform/tenant"
+	"github.com/google/uuid"
+)
+
+// tokenCacheTTL bounds how long a token lookup is reused; revoking a client drops it at once
+const tokenCacheTTL = time.Minute
+
+// MaxUsageDays bounds the usage history returned at once
+const MaxUsageDays = 90
+
+// Error definitions for service operations
+var (
+	ErrClientNotFound = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodeAPIClientNotFound,
+		"api client not found",
+		http.StatusNotFound,
+	)
+
+	ErrClientLimitReached = apperror.New(
+		apperror.CodeConflict,
+		apperror.BusinessCodeAPIClientLimitReached,
+		"api client limit reached",
+		http.StatusConflict,
+	)
+
+	ErrInvalidClient = apperror.New(
+		apperror.CodeValidationFailed,
+		apperror.BusinessCodeInvalidFormat,
+		"invalid api client",
+		http.StatusBadRequest,
+	)
+
+	ErrInvalidAPIToken = apperror.New(
+		apperror.CodeUnauthorized,
+		apperror.BusinessCodeInvalidAPIToken,
+		"invalid or revoked api token",
+		http.StatusUnauthorized,
+	)
+
+	ErrScopeNotGranted = apperror.New(
+		apperror.CodeForbidden,
+		apperror.BusinessCodePermissionDenied,
+		"api token does not grant access to this endpoint",
+		http.StatusForbidden,
+	)
+
+	ErrRateLimited = apperror.New(
+		apperror.CodeTooManyRequests,
+		apperror.BusinessCodeRateLimited,
+		"api token rate limit exceeded",
+		http.StatusTooManyRequests,
+	)
+)
+
+// ClientConfig limits what owners may register and how hard tokens may be used
+type ClientConfig struct {
+	RateLimit   int           // Requests per token per RateWindow
+	RateWindow  time.Duration // Length of a rate limit window
+	MaxPerOwner int           // Active clients an owner may hold
+}
+
+// ClientService registers API clients for developers and admits the requests
+// their tokens make to the public API
+type ClientService struct {
+	repo       ports.ClientRepository
+	authorizer ports.Authorizer
+	cache      cache.Cache
+	limiter    *ratelimit.FixedWindowLimiter
+	meter      *UsageMeter
+	eventBus   *eventbus.Bus
+	config     ClientConfig
+	logger     logger.Logger
+}
+
+// NewClientService creates a new API client service
+func NewClientService(
+	repo ports.ClientRepository,
+	authorizer ports.Authorizer,
+	c cache.Cache,
+	meter *UsageMeter,
+	eventBus *eventbus.Bus,
+	config ClientConfig,
+	logger logger.Logger,
+) *ClientService {
+	return &ClientService{
+		repo:       repo,
+		authorizer: authorizer,
+		cache:      c,
+		limiter:    ratelimit.NewFixedWindowLimiter(config.RateLimit, config.RateWindow),
+		meter:      meter,
+		eventBus:   eventBus,
+		config:     config,
+		logger:     logger,
+	}
+}
+
+// RegisterClient creates a client for the actor and returns it with its token,
+// which is not retrievable afterwards
+func (s *ClientService) RegisterClient(ctx context.Context, actorID uuid.UUID, name string, scopes []string) (*domain.Client, string, error) {
+	if err := s.checkCanManage(ctx, actorID); err != nil {
+		return nil, "", err
+	}
+
+	parsed := make([]domain.Scope, len(scopes))
+	for i, scope := range scopes {
+		p, err := domain.ParseScope(scope)
+		if err != nil {
+			return nil, "", ErrInvalidClient.WithDetails(err.Error())
+		}
+		parsed[i] = p
+	}
+
+	active, err := s.repo.CountActiveByOwner(ctx, actorID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to count api clients", "error", err, "actorID", actorID)
+		return nil, "", apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to register api client",
+			http.StatusInternalServerError,
+		)
+	}
+	if active >= s.config.MaxPerOwner {
+		return nil, "", ErrClientLimitReached.WithDetails(fmt.Sprintf("revoke a client to register another; the limit is %d", s.config.MaxPerOwner))
+	}
+
+	client, token, err := domain.NewClient(actorID, name, parsed, s.config.RateLimit)
+	if err != nil {
+		return nil, "", ErrInvalidClient.WithDetails(err.Error())
+	}
+
+	if err := s.repo.Create(ctx, client); err != nil {
+		s.logger.Error(ctx, "failed to create api client", "error", err, "actorID", actorID)
+		return nil, "", apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to register api client",
+			http.StatusInternalServerError,
+		)
+	}
+
+	s.logger.Info(ctx, "api client registered", "clientID", client.ID, "ownerID", actorID)
+	s.publishClientChanged(ctx, events.APIClientRegisteredTopic, client)
+	return client, token, nil
+}
+
+// ListClients retrieves the actor's clients, revoked ones included
+func (s *ClientService) ListClients(ctx context.Context, actorID uuid.UUID) ([]*domain.Client, error) {
+	if err := s.checkCanManage(ctx, actorID); err != nil {
+		return nil, err
+	}
+
+	clients, err := s.repo.ListByOwner(ctx, actorID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list api clients", "error", err, "actorID", actorID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to list api clients",
+			http.StatusInternalServerError,
+		)
+	}
+	return clients, nil
+}
+
+// RevokeClient disables one of the actor's tokens; revoking twice is not an error
+func (s *ClientService) RevokeClient(ctx context.Context, actorID uuid.UUID, clientID uuid.UUID) (*domain.Client, error) {
+	client, err := s.getOwnClient(ctx, actorID, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if client.Revoked() {
+		return client, nil
+	}
+
+	if err := client.Revoke(); err != nil {
+		return nil, ErrInvalidClient.WithDetails(err.Error())
+	}
+	if err := s.repo.Revoke(ctx, client); err != nil {
+		s.logger.Error(ctx, "failed to revoke api client", "error", err, "clientID", clientID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to revoke api client",
+			http.StatusInternalServerError,
+		)
+	}
+
+	if err := s.cache.Delete(context.WithoutCancel(ctx), tokenKey(ctx, client.TokenHash)); err != nil {
+		s.logger.Warn(ctx, "failed to drop revoked api token from cache", "error", err, "clientID", clientID)
+	}
+
+	s.logger.Info(ctx, "api client revoked", "clientID", clientID, "ownerID", actorID)
+	s.publishClientChanged(ctx, events.APIClientRevokedTopic, client)
+	return client, nil
+}
+
+// GetUsage retrieves the daily usage of one of the actor's clients over the
+// last days, today included. Usage is written periodically, so the latest
+// requests may not be counted yet.
+func (s *ClientService) GetUsage(ctx context.Context, actorID uuid.UUID, clientID uuid.UUID, days int) ([]domain.DailyUsage, error) {
+	if _, err := s.getOwnClient(ctx, actorID, clientID); err != nil {
+		return nil, err
+	}
+	if days < 1 || days > MaxUsageDays {
+		return nil, ErrInvalidClient.WithDetails(fmt.Sprintf("days must be between 1 and %d", MaxUsageDays))
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	usage, err := s.repo.ListUsage(ctx, clientID, today.AddDate(0, 0, 1-days))
+	if err != nil {
+		s.logger.Error(ctx, "failed to list api client usage", "error", err, "clientID", clientID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to retrieve api client usage",
+			http.StatusInternalServerError,
+		)
+	}
+	return usage, nil
+}
+
+// Admit decides whether a request made with token may call an endpoint
+// needing scope. Each admitted or throttled request counts towards the
+// client's usage; requests with a bad token or scope do not.
+func (s *ClientService) Admit(ctx context.Context, token string, scope domain.Scope) (*domain.Client, error) {
+	client, err := s.lookup(ctx, domain.HashToken(token))
+	if err != nil {
+		return nil, err
+	}
+	if client.Revoked() {
+		return nil, ErrInvalidAPIToken
+	}
+	if !client.Allows(scope) {
+		return nil, ErrScopeNotGranted.WithDetails(fmt.Sprintf("requires the %s scope", scope))
+	}
+
+	if !s.limiter.AllowWithin(client.ID.String(), client.RateLimit) {
+		s.meter.Record(client.ID, true)
+		return nil, ErrRateLimited
+	}
+	s.meter.Record(client.ID, false)
+	return client, nil
+}
+
+// Private helper methods
+
+// lookup finds the client holding a token hash, reading through the cache
+func (s *ClientService) lookup(ctx context.Context, tokenHash string) (*domain.Client, error) {
+	key := tokenKey(ctx, tokenHash)
+
+	var cached domain.Client
+	found, err := cache.GetJSON(ctx, s.cache, key, &cached)
+	if err != nil {
+		s.logger.Warn(ctx, "failed to read api token from cache", "error", err)
+	}
+	if found {
+		return &cached, nil
+	}
+
+	client, err := s.repo.FindByTokenHash(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, ports.ErrClientNotFound) {
+			return nil, ErrInvalidAPIToken
+		}
+		s.logger.Error(ctx, "failed to find api client by token", "error", err)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to verify api token",
+			http.StatusInternalServerError,
+		)
+	}
+
+	if err := cache.SetJSON(ctx, s.cache, key, client, tokenCacheTTL); err != nil {
+		s.logger.Warn(ctx, "failed to cache api token", "error", err, "clientID", client.ID)
+	}
+	return client, nil
+}
+
+// getOwnClient loads a client the actor owns; other owners' clients are reported as not found
+func (s *ClientService) getOwnClient(ctx context.Context, actorID uuid.UUID, clientID uuid.UUID) (*domain.Client, error) {
+	if err := s.checkCanManage(ctx, actorID); err != nil {
+		return nil, err
+	}
+
+	client, err := s.repo.FindByID(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, ports.ErrClientNotFound) {
+			return nil, ErrClientNotFound
+		}
+		s.logger.Error(ctx, "failed to find api client", "error", err, "clientID", clientID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to retrieve api client",
+			http.StatusInternalServerError,
+		)
+	}
+	if client.OwnerID != actorID {
+		return nil, ErrClientNotFound
+	}
+	return client, nil
+}
+
+// checkCanManage verifies the actor may manage their API clients
+func (s *ClientService) checkCanManage(ctx context.Context, actorID uuid.UUID) error {
+	canManage, err := s.authorizer.Can(ctx, actorID, "api_clients", "manage", nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canManage {
+		return apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to manage api clients",
+			http.StatusForbidden,
+		)
+	}
+	return nil
+}
+
+// tokenKey is the key of the client holding a token in the current blog
+func tokenKey(ctx context.Context, tokenHash string) string {
+	return fmt.Sprintf("api_client:token:%s:%s", tenant.BlogID(ctx), tokenHash)
+}
+
+// publishClientChanged announces a client registered or revoked by its owner on topic
+func (s *ClientService) publishClientChanged(ctx context.Context, topic eventbus.Topic, client *domain.Client) {
+	s.eventBus.Publish(ctx, eventbus.Event{
+		Topic: topic,
+		Payload: events.APIClientChangedEvent{
+			ClientID:   client.ID,
+			OwnerID:    client.OwnerID,
+			Name:       client.Name,
+			OccurredAt: time.Now(),
+		},
+	})
+}
@@ -0,0 +1,148 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"backend/internal/apiclients/ports"
+	"backend/internal/platform/logger"
+	"backend/internal/platform/schedule"
+	"github.com/google/uuid"
+)
+
+// usageKey identifies the counters of one client on one UTC day
+type usageKey struct {
+	clientID uuid.UUID
+	day      time.Time
+}
+
+// UsageMeter counts API client requests in memory so the hot path never
+// writes to the database; a job flushes the counts on a schedule.
+// Nothing is counted while the flush job is disabled.
+type UsageMeter struct {
+	enabled bool
+	mu      sync.Mutex // Protects counts
+	counts  map[usageKey]*ports.UsageDelta
+}
+
+// NewUsageMeter creates an empty usage meter
+func NewUsageMeter(config UsageJobConfig) *UsageMeter {
+	return &UsageMeter{
+		enabled: config.Interval > 0,
+		counts:  make(map[usageKey]*ports.UsageDelta),
+	}
+}
+
+// Record counts one request of the client, throttled or let through
+func (m *UsageMeter) Record(clientID uuid.UUID, throttled bool) {
+	if !m.enabled {
+		return
+	}
+	now := time.Now().UTC()
+	key := usageKey{clientID: clientID, day: now.Truncate(24 * time.Hour)}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delta, ok := m.counts[key]
+	if !ok {
+		delta = &ports.UsageDelta{ClientID: clientID, Day: key.day}
+		m.counts[key] = delta
+	}
+	if throttled {
+		delta.Throttled++
+	} else {
+		delta.Requests++
+	}
+	delta.LastUsedAt = now
+}
+
+// take returns the counts recorded since the last call and resets them
+func (m *UsageMeter) take() []ports.UsageDelta {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	usage := make([]ports.UsageDelta, 0, len(m.counts))
+	for _, delta := range m.counts {
+		usage = append(usage, *delta)
+	}
+	m.counts = make(map[usageKey]*ports.UsageDelta)
+	return usage
+}
+
+// restore adds counts that failed to flush back into the meter
+func (m *UsageMeter) restore(usage []ports.UsageDelta) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, delta := range usage {
+		key := usageKey{clientID: delta.ClientID, day: delta.Day}
+		current, ok := m.counts[key]
+		if !ok {
+			d := delta
+			m.counts[key] = &d
+			continue
+		}
+		current.Requests += delta.Requests
+		current.Throttled += delta.Throttled
+		if delta.LastUsedAt.After(current.LastUsedAt) {
+			current.LastUsedAt = delta.LastUsedAt
+		}
+	}
+}
+
+// UsageJobConfig schedules the usage flush job
+type UsageJobConfig struct {
+	// Interval between flushes; zero or less disables the job, leaving usage unrecorded
+	Interval time.Duration
+}
+
+// UsageJob writes the metered usage to the database on a schedule
+type UsageJob struct {
+	meter  *UsageMeter
+	repo   ports.ClientRepository
+	config UsageJobConfig
+	logger logger.Logger
+}
+
+// NewUsageJob creates the scheduled usage flush job
+func NewUsageJob(meter *UsageMeter, repo ports.ClientRepository, config UsageJobConfig, logger logger.Logger) *UsageJob {
+	return &UsageJob{
+		meter:  meter,
+		repo:   repo,
+		config: config,
+		logger: logger,
+	}
+}
+
+// Run blocks until ctx is done, flushing once per interval and a last time on the way out
+func (j *UsageJob) Run(ctx context.Context) {
+	schedule.Every(ctx, j.config.Interval, func(ctx context.Context) {
+		if err := j.Flush(ctx); err != nil && ctx.Err() == nil {
+			j.logger.Error(ctx, "api client usage flush failed", "error", err)
+		}
+	})
+	if j.config.Interval <= 0 {
+		return
+	}
+
+	if err := j.Flush(context.WithoutCancel(ctx)); err != nil {
+		j.logger.Error(ctx, "final api client usage flush failed", "error", err)
+	}
+}
+
+// Flush writes the usage recorded since the last flush. Counts that fail to
+// write are kept for the next flush.
+func (j *UsageJob) Flush(ctx context.Context) error {
+	usage := j.meter.take()
+	if len(usage) == 0 {
+		return nil
+	}
+	if err := j.repo.RecordUsage(ctx, usage); err != nil {
+		j.meter.restore(usage)
+		return fmt.Errorf("UsageJob.Flush: %w", err)
+	}
+	return nil
+}
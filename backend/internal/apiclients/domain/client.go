@@ -0,0 +1,157 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TokenPrefix marks API client tokens so they are recognisable in logs and secret scanners
+const TokenPrefix = "abp_"
+
+// displayPrefixLength is how much of a token is kept to tell tokens apart in listings
+const displayPrefixLength = len(TokenPrefix) + 6
+
+// MaxNameLength bounds the label an owner gives a client
+const MaxNameLength = 100
+
+// Domain errors
+var (
+	ErrInvalidName  = errors.New("client name must be 1 to 100 characters")
+	ErrNoScopes     = errors.New("at least one scope is required")
+	ErrInvalidScope = errors.New("unknown scope")
+	ErrRevoked      = errors.New("client has been revoked")
+)
+
+// Scope is a read-only area of the public API a token may call
+type Scope string
+
+const (
+	ScopePostsRead  Scope = "posts:read"
+	ScopeThemesRead Scope = "themes:read"
+	ScopeSeriesRead Scope = "series:read"
+	ScopeUsersRead  Scope = "users:read"
+)
+
+// Scopes lists every scope a client may be granted
+var Scopes = []Scope{ScopePostsRead, ScopeThemesRead, ScopeSeriesRead, ScopeUsersRead}
+
+// ParseScope validates a scope name
+func ParseScope(s string) (Scope, error) {
+	for _, scope := range Scopes {
+		if string(scope) == s {
+			return scope, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %q", ErrInvalidScope, s)
+}
+
+// Client is an external application reading the public API with a token.
+// Only a hash of the token is kept; the token itself is shown once, when the
+// client is registered.
+type Client struct {
+	ID          uuid.UUID
+	OwnerID     uuid.UUID
+	Name        string
+	TokenPrefix string // The start of the token, to tell an owner's tokens apart
+	TokenHash   string
+	Scopes      []Scope
+	RateLimit   int // Requests allowed per rate window
+	CreatedAt   time.Time
+	LastUsedAt  *time.Time
+	RevokedAt   *time.Time
+}
+
+// NewClient registers a client for ownerID and returns it with its token
+func NewClient(ownerID uuid.UUID, name string, scopes []Scope, rateLimit int) (*Client, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > MaxNameLength {
+		return nil, "", ErrInvalidName
+	}
+	if len(scopes) == 0 {
+		return nil, "", ErrNoScopes
+	}
+
+	token, err := generateToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &Client{
+		ID:          uuid.New(),
+		OwnerID:     ownerID,
+		Name:        name,
+		TokenPrefix: token[:displayPrefixLength],
+		TokenHash:   HashToken(token),
+		Scopes:      uniqueScopes(scopes),
+		RateLimit:   rateLimit,
+		CreatedAt:   time.Now(),
+	}, token, nil
+}
+
+// Allows reports whether the client was granted scope
+func (c *Client) Allows(scope Scope) bool {
+	for _, s := range c.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Revoked reports whether the client's token no longer works
+func (c *Client) Revoked() bool {
+	return c.RevokedAt != nil
+}
+
+// Revoke disables the client's token for good
+func (c *Client) Revoke() error {
+	if c.Revoked() {
+		return ErrRevoked
+	}
+	now := time.Now()
+	c.RevokedAt = &now
+	return nil
+}
+
+// HashToken is the stored form of a token
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// generateToken returns a fresh random token
+func generateToken() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("generate token: %w", err)
+	}
+	return TokenPrefix + base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+// uniqueScopes drops repeated scopes, keeping the first occurrence
+func uniqueScopes(scopes []Scope) []Scope {
+	seen := make(map[Scope]bool, len(scopes))
+	unique := make([]Scope, 0, len(scopes))
+	for _, scope := range scopes {
+		if !seen[scope] {
+			seen[scope] = true
+			unique = append(unique, scope)
+		}
+	}
+	return unique
+}
+
+// DailyUsage counts a client's requests on one UTC day
+type DailyUsage struct {
+	Day       time.Time
+	Requests  int // Requests let through
+	Throttled int // Requests turned away by the rate limit
+}
@@ -0,0 +1,64 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"backend/internal/apiclients/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClient(t *testing.T) {
+	ownerID := uuid.New()
+	client, token, err := domain.NewClient(ownerID, "  Reader app ", []domain.Scope{domain.ScopePostsRead, domain.ScopePostsRead, domain.ScopeThemesRead}, 600)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Reader app", client.Name)
+	assert.Equal(t, ownerID, client.OwnerID)
+	assert.Equal(t, []domain.Scope{domain.ScopePostsRead, domain.ScopeThemesRead}, client.Scopes)
+	assert.Equal(t, 600, client.RateLimit)
+
+	assert.True(t, strings.HasPrefix(token, domain.TokenPrefix))
+	assert.True(t, strings.HasPrefix(token, client.TokenPrefix))
+	assert.Equal(t, domain.HashToken(token), client.TokenHash)
+	assert.NotContains(t, client.TokenHash, token, "the token itself is not kept")
+
+	assert.True(t, client.Allows(domain.ScopePostsRead))
+	assert.False(t, client.Allows(domain.ScopeSeriesRead))
+
+	_, other, err := domain.NewClient(ownerID, "Other", []domain.Scope{domain.ScopePostsRead}, 600)
+	require.NoError(t, err)
+	assert.NotEqual(t, token, other)
+}
+
+func TestNewClient_Validation(t *testing.T) {
+	_, _, err := domain.NewClient(uuid.New(), " ", []domain.Scope{domain.ScopePostsRead}, 600)
+	assert.ErrorIs(t, err, domain.ErrInvalidName)
+
+	_, _, err = domain.NewClient(uuid.New(), strings.Repeat("x", domain.MaxNameLength+1), []domain.Scope{domain.ScopePostsRead}, 600)
+	assert.ErrorIs(t, err, domain.ErrInvalidName)
+
+	_, _, err = domain.NewClient(uuid.New(), "App", nil, 600)
+	assert.ErrorIs(t, err, domain.ErrNoScopes)
+}
+
+func TestParseScope(t *testing.T) {
+	scope, err := domain.ParseScope("series:read")
+	require.NoError(t, err)
+	assert.Equal(t, domain.ScopeSeriesRead, scope)
+
+	_, err = domain.ParseScope("posts:update")
+	assert.ErrorIs(t, err, domain.ErrInvalidScope)
+}
+
+func TestClient_Revoke(t *testing.T) {
+	client, _, err := domain.NewClient(uuid.New(), "App", []domain.Scope{domain.ScopePostsRead}, 600)
+	require.NoError(t, err)
+	assert.False(t, client.Revoked())
+
+	require.NoError(t, client.Revoke())
+	assert.True(t, client.Revoked())
+	assert.ErrorIs(t, client.Revoke(), domain.ErrRevoked)
+}
@@ -0,0 +1,53 @@
+package ports
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"backend/internal/apiclients/domain"
+	"github.com/google/uuid"
+)
+
+// Repository errors (canonical errors for the repository contract)
+var (
+	// ErrClientNotFound is returned when a client does not exist in the current blog
+	ErrClientNotFound = errors.New("api client not found")
+)
+
+// ClientRepository defines the contract for API client persistence
+type ClientRepository interface {
+	// Create stores a newly registered client
+	Create(ctx context.Context, client *domain.Client) error
+
+	// FindByID retrieves a client of the current blog
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.Client, error)
+
+	// FindByTokenHash retrieves the client of the current blog holding a token, revoked or not
+	FindByTokenHash(ctx context.Context, tokenHash string) (*domain.Client, error)
+
+	// ListByOwner retrieves an owner's clients, newest first
+	ListByOwner(ctx context.Context, ownerID uuid.UUID) ([]*domain.Client, error)
+
+	// CountActiveByOwner counts an owner's clients that are not revoked
+	CountActiveByOwner(ctx context.Context, ownerID uuid.UUID) (int, error)
+
+	// Revoke stores the client's revocation
+	Revoke(ctx context.Context, client *domain.Client) error
+
+	// RecordUsage adds request counts to the clients' daily usage and moves
+	// their last use forward
+	RecordUsage(ctx context.Context, usage []UsageDelta) error
+
+	// ListUsage retrieves a client's daily usage from since onwards, oldest first
+	ListUsage(ctx context.Context, clientID uuid.UUID, since time.Time) ([]domain.DailyUsage, error)
+}
+
+// UsageDelta is the traffic of one client on one day since the last flush
+type UsageDelta struct {
+	ClientID   uuid.UUID
+	Day        time.Time
+	Requests   int
+	Throttled  int
+	LastUsedAt time.Time
+}
@@ -0,0 +1,79 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend/internal/authz/ports"
+	"github.com/google/uuid"
+)
+
+// ExportedRoles is a user's roles and role history as written to their data export
+type ExportedRoles struct {
+	Current []ExportedRole       `json:"current"`
+	History []ExportedRoleChange `json:"history"`
+}
+
+// ExportedRole is a role the user holds
+type ExportedRole struct {
+	Role      string     `json:"role"`
+	BlogID    *uuid.UUID `json:"blogId,omitempty"` // Absent when the role applies on every blog
+	GrantedAt time.Time  `json:"grantedAt"`
+	GrantedBy string     `json:"grantedBy,omitempty"` // Username of whoever granted it, when known
+}
+
+// ExportedRoleChange is one grant or revocation of a role
+type ExportedRoleChange struct {
+	Role       string     `json:"role"`
+	BlogID     *uuid.UUID `json:"blogId,omitempty"`
+	Change     string     `json:"change"`
+	Actor      string     `json:"actor,omitempty"`
+	OccurredAt time.Time  `json:"occurredAt"`
+}
+
+// RolesExporter exports the roles a user holds, and held, on every blog
+type RolesExporter struct {
+	repo ports.AuthzRepository
+}
+
+// NewRolesExporter creates a new roles exporter
+func NewRolesExporter(repo ports.AuthzRepository) *RolesExporter {
+	return &RolesExporter{repo: repo}
+}
+
+// Export returns the user's current roles and their history
+// Implements the dataexport.Exporter interface
+func (e *RolesExporter) Export(ctx context.Context, userID uuid.UUID) (any, error) {
+	roles, err := e.repo.ListUserRolesInEveryBlog(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("RolesExporter.Export: %w", err)
+	}
+	history, err := e.repo.ListUserRoleHistoryInEveryBlog(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("RolesExporter.Export: %w", err)
+	}
+
+	exported := ExportedRoles{
+		Current: make([]ExportedRole, 0, len(roles)),
+		History: make([]ExportedRoleChange, 0, len(history)),
+	}
+	for _, role := range roles {
+		exported.Current = append(exported.Current, ExportedRole{
+			Role:      role.Role.Name,
+			BlogID:    role.BlogID,
+			GrantedAt: role.GrantedAt,
+			GrantedBy: role.GrantedByUsername,
+		})
+	}
+	for _, entry := range history {
+		exported.History = append(exported.History, ExportedRoleChange{
+			Role:       entry.RoleName,
+			BlogID:     entry.BlogID,
+			Change:     string(entry.Change),
+			Actor:      entry.ActorUsername,
+			OccurredAt: entry.OccurredAt,
+		})
+	}
+	return exported, nil
+}
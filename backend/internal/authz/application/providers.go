@@ -7,4 +7,5 @@ import (
 // ProviderSet is the wire provider set for authz application services
 var ProviderSet = wire.NewSet(
 	NewAuthzService,
+	NewRolesExporter,
 )
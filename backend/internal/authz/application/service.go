@@ -6,13 +6,18 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"backend/internal/authz/domain"
 	"backend/internal/authz/permission"
 	"backend/internal/authz/ports"
+	"backend/internal/platform/actor"
 	"backend/internal/platform/apperror"
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/events"
 	"backend/internal/platform/logger"
 	"backend/internal/platform/ownership"
+	"backend/internal/platform/postgres"
 	"github.com/google/uuid"
 )
 
@@ -84,6 +89,12 @@ var (
 		"cannot update system role",
 		http.StatusConflict,
 	)
+	ErrDuplicateMatrixChange = apperror.New(
+		apperror.CodeBadRequest,
+		apperror.BusinessCodeDuplicateChange,
+		"permission changed more than once for the same role",
+		http.StatusBadRequest,
+	)
 	ErrCannotDeleteSystemRole = apperror.New(
 		apperror.CodeConflict,
 		apperror.BusinessCodeCannotDeleteSystem,
@@ -96,6 +107,8 @@ var (
 type AuthzService struct {
 	repo              ports.AuthzRepository
 	ownershipRegistry ownership.Registry
+	uow               postgres.UnitOfWork
+	eventBus          *eventbus.Bus
 	logger            logger.Logger
 }
 
@@ -103,11 +116,15 @@ type AuthzService struct {
 func NewAuthzService(
 	repo ports.AuthzRepository,
 	ownershipRegistry ownership.Registry,
+	uow postgres.UnitOfWork,
+	eventBus *eventbus.Bus,
 	logger logger.Logger,
 ) *AuthzService {
 	return &AuthzService{
 		repo:              repo,
 		ownershipRegistry: ownershipRegistry,
+		uow:               uow,
+		eventBus:          eventBus,
 		logger:            logger,
 	}
 }
@@ -171,8 +188,9 @@ func (s *AuthzService) HasPermissionForResource(
 			return true, nil // User has global permission, no need to check ownership
 		}
 
-		// Now check ownership since they don't have the "any" permission
-		isOwner, err := s.checkOwnership(ctx, userID, resourceType, resourceID)
+		// Now check ownership since they don't have the "any" permission; shared
+		// resources may also let users besides the owner through for this action
+		isOwner, err := s.checkOwnership(ctx, userID, resourceType, resourceID, perm.Action)
 		if err != nil {
 			return false, fmt.Errorf("AuthzService.HasPermissionForResource (ownership check): %w", err)
 		}
@@ -274,6 +292,21 @@ func (s *AuthzService) GetUserRoles(ctx context.Context, userID uuid.UUID) ([]st
 	return roles, nil
 }
 
+// ListUserIDsWithPermission lists the users holding a permission in the current blog,
+// through a role or a direct grant
+func (s *AuthzService) ListUserIDsWithPermission(ctx context.Context, permissionID string) ([]uuid.UUID, error) {
+	if err := s.validatePermissionID(permissionID); err != nil {
+		return nil, err
+	}
+
+	userIDs, err := s.repo.ListUserIDsWithPermission(ctx, permissionID)
+	if err != nil {
+		return nil, fmt.Errorf("AuthzService.ListUserIDsWithPermission: %w", err)
+	}
+
+	return userIDs, nil
+}
+
 // ===== COMMAND OPERATIONS (Modifications) =====
 
 // AssignRoleToUser assigns a role to a user
@@ -281,7 +314,7 @@ func (s *AuthzService) AssignRoleToUser(ctx context.Context, userID, roleID, gra
 	// Get the role to validate it can be assigned
 	role, err := s.repo.GetRoleByID(ctx, roleID)
 	if err != nil {
-		return fmt.Errorf("AuthzService.AssignRoleToUser (get role): %w", err)
+		return fmt.Errorf("AuthzService.AssignRoleToUser (get role): %w", fromRepository(err))
 	}
 
 	// Validate the role can be assigned
@@ -297,7 +330,7 @@ func (s *AuthzService) AssignRoleToUser(ctx context.Context, userID, roleID, gra
 			"granted_by", grantedBy,
 			"error", err,
 		)
-		return fmt.Errorf("AuthzService.AssignRoleToUser: %w", err)
+		return fmt.Errorf("AuthzService.AssignRoleToUser: %w", fromRepository(err))
 	}
 
 	s.logger.Info(ctx, "role assigned to user",
@@ -307,18 +340,35 @@ func (s *AuthzService) AssignRoleToUser(ctx context.Context, userID, roleID, gra
 		"granted_by", grantedBy,
 	)
 
+	s.publishRoleChanged(ctx, events.UserRoleGrantedTopic, userID, role, grantedBy)
 	return nil
 }
 
-// RemoveRoleFromUser removes a role from a user
-func (s *AuthzService) RemoveRoleFromUser(ctx context.Context, userID, roleID uuid.UUID) error {
-	if err := s.repo.RemoveRoleFromUser(ctx, userID, roleID); err != nil {
+// AssignRoleByName assigns a role looked up by name, for roles known by
+// configuration rather than by ID; assigning a held role again is not an error
+func (s *AuthzService) AssignRoleByName(ctx context.Context, userID uuid.UUID, roleName string, grantedBy uuid.UUID) error {
+	role, err := s.repo.GetRoleByName(ctx, roleName)
+	if err != nil {
+		return fmt.Errorf("AuthzService.AssignRoleByName (get role %q): %w", roleName, fromRepository(err))
+	}
+
+	return s.AssignRoleToUser(ctx, userID, role.ID, grantedBy)
+}
+
+// RemoveRoleFromUser removes a role from a user, recording who revoked it
+func (s *AuthzService) RemoveRoleFromUser(ctx context.Context, userID, roleID, revokedBy uuid.UUID) error {
+	role, err := s.repo.GetRoleByID(ctx, roleID)
+	if err != nil {
+		return fmt.Errorf("AuthzService.RemoveRoleFromUser (get role): %w", fromRepository(err))
+	}
+
+	if err := s.repo.RemoveRoleFromUser(ctx, userID, roleID, revokedBy); err != nil {
 		s.logger.Error(ctx, "failed to remove role from user",
 			"user_id", userID,
 			"role_id", roleID,
 			"error", err,
 		)
-		return fmt.Errorf("AuthzService.RemoveRoleFromUser: %w", err)
+		return fmt.Errorf("AuthzService.RemoveRoleFromUser: %w", fromRepository(err))
 	}
 
 	s.logger.Info(ctx, "role removed from user",
@@ -326,6 +376,7 @@ func (s *AuthzService) RemoveRoleFromUser(ctx context.Context, userID, roleID uu
 		"role_id", roleID,
 	)
 
+	s.publishRoleChanged(ctx, events.UserRoleRevokedTopic, userID, role, revokedBy)
 	return nil
 }
 
@@ -334,7 +385,7 @@ func (s *AuthzService) GrantPermissionToUser(ctx context.Context, userID, permis
 	// Verify the permission exists
 	perm, err := s.repo.GetPermissionByID(ctx, permissionID)
 	if err != nil {
-		return fmt.Errorf("AuthzService.GrantPermissionToUser (get permission): %w", err)
+		return fmt.Errorf("AuthzService.GrantPermissionToUser (get permission): %w", fromRepository(err))
 	}
 
 	// Grant the permission
@@ -345,7 +396,7 @@ func (s *AuthzService) GrantPermissionToUser(ctx context.Context, userID, permis
 			"granted_by", grantedBy,
 			"error", err,
 		)
-		return fmt.Errorf("AuthzService.GrantPermissionToUser: %w", err)
+		return fmt.Errorf("AuthzService.GrantPermissionToUser: %w", fromRepository(err))
 	}
 
 	s.logger.Info(ctx, "permission granted to user",
@@ -355,18 +406,24 @@ func (s *AuthzService) GrantPermissionToUser(ctx context.Context, userID, permis
 		"granted_by", grantedBy,
 	)
 
+	s.publishPermissionChanged(ctx, events.UserPermissionGrantedTopic, userID, perm, grantedBy)
 	return nil
 }
 
 // RevokePermissionFromUser revokes a custom permission from a user
 func (s *AuthzService) RevokePermissionFromUser(ctx context.Context, userID, permissionID uuid.UUID) error {
+	perm, err := s.repo.GetPermissionByID(ctx, permissionID)
+	if err != nil {
+		return fmt.Errorf("AuthzService.RevokePermissionFromUser (get permission): %w", fromRepository(err))
+	}
+
 	if err := s.repo.RevokePermissionFromUser(ctx, userID, permissionID); err != nil {
 		s.logger.Error(ctx, "failed to revoke permission from user",
 			"user_id", userID,
 			"permission_id", permissionID,
 			"error", err,
 		)
-		return fmt.Errorf("AuthzService.RevokePermissionFromUser: %w", err)
+		return fmt.Errorf("AuthzService.RevokePermissionFromUser: %w", fromRepository(err))
 	}
 
 	s.logger.Info(ctx, "permission revoked from user",
@@ -374,20 +431,29 @@ func (s *AuthzService) RevokePermissionFromUser(ctx context.Context, userID, per
 		"permission_id", permissionID,
 	)
 
+	s.publishPermissionChanged(ctx, events.UserPermissionRevokedTopic, userID, perm, actor.UserIDOr(ctx, uuid.Nil))
 	return nil
 }
 
 // ReplaceUserRoles replaces all user roles atomically
 func (s *AuthzService) ReplaceUserRoles(ctx context.Context, userID uuid.UUID, roleIDs []uuid.UUID, grantedBy uuid.UUID) error {
 	// Validate all roles first
+	roles := make(map[uuid.UUID]*domain.Role, len(roleIDs))
 	for _, roleID := range roleIDs {
 		role, err := s.repo.GetRoleByID(ctx, roleID)
 		if err != nil {
-			return fmt.Errorf("AuthzService.ReplaceUserRoles (get role %s): %w", roleID, err)
+			return fmt.Errorf("AuthzService.ReplaceUserRoles (get role %s): %w", roleID, fromRepository(err))
 		}
 		if err := role.Validate(); err != nil {
 			return fmt.Errorf("AuthzService.ReplaceUserRoles (validate role %s): %w", roleID, err)
 		}
+		roles[roleID] = role
+	}
+
+	// The roles held before tell which ones the replacement grants and revokes
+	before, err := s.GetUserRolesWithDetails(ctx, userID)
+	if err != nil && !errors.Is(err, ErrUserNotFound) {
+		return fmt.Errorf("AuthzService.ReplaceUserRoles (current roles): %w", err)
 	}
 
 	// Replace the roles
@@ -398,7 +464,7 @@ func (s *AuthzService) ReplaceUserRoles(ctx context.Context, userID uuid.UUID, r
 			"granted_by", grantedBy,
 			"error", err,
 		)
-		return fmt.Errorf("AuthzService.ReplaceUserRoles: %w", err)
+		return fmt.Errorf("AuthzService.ReplaceUserRoles: %w", fromRepository(err))
 	}
 
 	s.logger.Info(ctx, "user roles replaced",
@@ -407,6 +473,18 @@ func (s *AuthzService) ReplaceUserRoles(ctx context.Context, userID uuid.UUID, r
 		"granted_by", grantedBy,
 	)
 
+	held := make(map[uuid.UUID]bool, len(before))
+	for _, userRole := range before {
+		held[userRole.RoleID] = true
+		if _, kept := roles[userRole.RoleID]; !kept {
+			s.publishRoleChanged(ctx, events.UserRoleRevokedTopic, userID, userRole.Role, grantedBy)
+		}
+	}
+	for _, role := range roles {
+		if !held[role.ID] {
+			s.publishRoleChanged(ctx, events.UserRoleGrantedTopic, userID, role, grantedBy)
+		}
+	}
 	return nil
 }
 
@@ -436,8 +514,8 @@ func (s *AuthzService) GetAllRoles(ctx context.Context) ([]*domain.Role, error)
 func (s *AuthzService) GetRole(ctx context.Context, roleID uuid.UUID) (*domain.Role, error) {
 	role, err := s.repo.GetRoleByID(ctx, roleID)
 	if err != nil {
-		if errors.Is(err, ErrRoleNotFound) {
-			return nil, err
+		if errors.Is(err, ports.ErrRoleNotFound) {
+			return nil, ErrRoleNotFound
 		}
 		s.logger.Error(ctx, "failed to get role", "role_id", roleID, "error", err)
 		return nil, fmt.Errorf("AuthzService.GetRole: %w", err)
@@ -445,42 +523,82 @@ func (s *AuthzService) GetRole(ctx context.Context, roleID uuid.UUID) (*domain.R
 	return role, nil
 }
 
+// GetRoleUsage reports who holds a role on any blog and what they would lose
+// without it, with one page of its assignments
+func (s *AuthzService) GetRoleUsage(ctx context.Context, roleID uuid.UUID, limit, offset int) (*domain.RoleUsage, []*domain.RoleAssignment, error) {
+	if _, err := s.GetRole(ctx, roleID); err != nil {
+		return nil, nil, err
+	}
+
+	usage, err := s.repo.GetRoleUsage(ctx, roleID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("AuthzService.GetRoleUsage: %w", err)
+	}
+
+	assignments, err := s.repo.ListRoleAssignments(ctx, roleID, limit, offset)
+	if err != nil {
+		return nil, nil, fmt.Errorf("AuthzService.GetRoleUsage (list assignments): %w", err)
+	}
+
+	return usage, assignments, nil
+}
+
 // GetUserRolesWithDetails retrieves all roles assigned to a user with full details
 func (s *AuthzService) GetUserRolesWithDetails(ctx context.Context, userID uuid.UUID) ([]*domain.UserRole, error) {
 	// Get user authorization data
 	userAuthz, err := s.repo.GetUserAuthz(ctx, userID)
 	if err != nil {
-		if errors.Is(err, ErrUserNotFound) {
-			return nil, err
+		if errors.Is(err, ports.ErrUserNotFound) {
+			return nil, ErrUserNotFound
 		}
 		s.logger.Error(ctx, "failed to get user roles", "user_id", userID, "error", err)
 		return nil, fmt.Errorf("AuthzService.GetUserRolesWithDetails: %w", err)
 	}
 
-	// Map roles to UserRole objects
-	userRoles := make([]*domain.UserRole, 0, len(userAuthz.Roles))
+	grants, err := s.repo.GetUserRoleGrants(ctx, userID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to get user role grants", "user_id", userID, "error", err)
+		return nil, fmt.Errorf("AuthzService.GetUserRolesWithDetails: %w", err)
+	}
+
+	rolesByID := make(map[uuid.UUID]*domain.Role, len(userAuthz.Roles))
 	for _, role := range userAuthz.Roles {
-		userRole := &domain.UserRole{
-			UserID:    userID,
-			RoleID:    role.ID,
-			Role:      role,
-			GrantedAt: userAuthz.CreatedAt, // Using user creation time as fallback
-			GrantedBy: uuid.Nil,            // We don't track this currently
+		rolesByID[role.ID] = role
+	}
+
+	userRoles := make([]*domain.UserRole, 0, len(grants))
+	for _, grant := range grants {
+		role, ok := rolesByID[grant.RoleID]
+		if !ok {
+			continue
 		}
-		userRoles = append(userRoles, userRole)
+		grant.Role = role
+		userRoles = append(userRoles, grant)
 	}
 
 	return userRoles, nil
 }
 
+// ListUserRoleHistory returns a page of the user's role grants and revocations, newest first
+func (s *AuthzService) ListUserRoleHistory(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*domain.RoleHistoryEntry, int, error) {
+	entries, total, err := s.repo.ListUserRoleHistory(ctx, userID, limit, offset)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list user role history", "user_id", userID, "error", err)
+		return nil, 0, fmt.Errorf("AuthzService.ListUserRoleHistory: %w", err)
+	}
+
+	return entries, total, nil
+}
+
 // ===== ROLE MANAGEMENT =====
 
 // CreateRole creates a new role
 func (s *AuthzService) CreateRole(ctx context.Context, name, description string, isTemplate bool) (*domain.Role, error) {
 	// Check if role name already exists
-	existingRole, err := s.repo.GetRoleByName(ctx, name)
-	if err == nil && existingRole != nil {
+	if _, err := s.repo.GetRoleByName(ctx, name); err == nil {
 		return nil, ErrRoleNameExists
+	} else if !errors.Is(err, ports.ErrRoleNotFound) {
+		return nil, fmt.Errorf("AuthzService.CreateRole (check name): %w", err)
 	}
 
 	var role *domain.Role
@@ -513,7 +631,7 @@ func (s *AuthzService) CreateRoleFromTemplate(ctx context.Context, templateID uu
 	// Get the template role
 	template, err := s.repo.GetRoleByID(ctx, templateID)
 	if err != nil {
-		return nil, fmt.Errorf("AuthzService.CreateRoleFromTemplate (get template): %w", err)
+		return nil, fmt.Errorf("AuthzService.CreateRoleFromTemplate (get template): %w", fromRepository(err))
 	}
 
 	// Clone the template
@@ -546,8 +664,8 @@ func (s *AuthzService) UpdateRole(ctx context.Context, roleID uuid.UUID, name, d
 	// Get the existing role
 	role, err := s.repo.GetRoleByID(ctx, roleID)
 	if err != nil {
-		if errors.Is(err, ErrRoleNotFound) {
-			return nil, err
+		if errors.Is(err, ports.ErrRoleNotFound) {
+			return nil, ErrRoleNotFound
 		}
 		return nil, fmt.Errorf("AuthzService.UpdateRole (get role): %w", err)
 	}
@@ -562,8 +680,10 @@ func (s *AuthzService) UpdateRole(ctx context.Context, roleID uuid.UUID, name, d
 		// Check if new name already exists (if different from current)
 		if *name != role.Name {
 			existingRole, err := s.repo.GetRoleByName(ctx, *name)
-			if err == nil && existingRole != nil && existingRole.ID != roleID {
+			if err == nil && existingRole.ID != roleID {
 				return nil, ErrRoleNameExists
+			} else if err != nil && !errors.Is(err, ports.ErrRoleNotFound) {
+				return nil, fmt.Errorf("AuthzService.UpdateRole (check name): %w", err)
 			}
 		}
 		role.Name = *name
@@ -579,7 +699,7 @@ func (s *AuthzService) UpdateRole(ctx context.Context, roleID uuid.UUID, name, d
 			"role_id", roleID,
 			"error", err,
 		)
-		return nil, fmt.Errorf("AuthzService.UpdateRole: %w", err)
+		return nil, fmt.Errorf("AuthzService.UpdateRole: %w", fromRepository(err))
 	}
 
 	s.logger.Info(ctx, "role updated",
@@ -595,8 +715,8 @@ func (s *AuthzService) UpdateRolePermissions(ctx context.Context, roleID uuid.UU
 	// Get the existing role
 	role, err := s.repo.GetRoleByID(ctx, roleID)
 	if err != nil {
-		if errors.Is(err, ErrRoleNotFound) {
-			return nil, err
+		if errors.Is(err, ports.ErrRoleNotFound) {
+			return nil, ErrRoleNotFound
 		}
 		return nil, fmt.Errorf("AuthzService.UpdateRolePermissions (get role): %w", err)
 	}
@@ -610,7 +730,7 @@ func (s *AuthzService) UpdateRolePermissions(ctx context.Context, roleID uuid.UU
 	for _, permID := range permissionIDs {
 		_, err := s.repo.GetPermissionByID(ctx, permID)
 		if err != nil {
-			if errors.Is(err, ErrPermissionNotFound) {
+			if errors.Is(err, ports.ErrPermissionNotFound) {
 				return nil, ErrPermissionNotFound
 			}
 			return nil, fmt.Errorf("AuthzService.UpdateRolePermissions (verify permission %s): %w", permID, err)
@@ -641,12 +761,153 @@ func (s *AuthzService) UpdateRolePermissions(ctx context.Context, roleID uuid.UU
 	return updatedRole, nil
 }
 
+// GetPermissionMatrix returns every role against every registered permission, grouped by resource
+func (s *AuthzService) GetPermissionMatrix(ctx context.Context) (*domain.PermissionMatrix, error) {
+	roles, err := s.repo.GetAllRoles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("AuthzService.GetPermissionMatrix: %w", err)
+	}
+
+	return domain.NewPermissionMatrix(roles, registeredPermissions()), nil
+}
+
+// UpdatePermissionMatrix grants and revokes role permissions in one transaction.
+// Every change is validated before any is applied, so a rejected change leaves
+// the matrix untouched; changes that match the current grid are no-ops.
+func (s *AuthzService) UpdatePermissionMatrix(ctx context.Context, changes []domain.MatrixChange) (*domain.PermissionMatrix, error) {
+	roles, err := s.repo.GetAllRoles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("AuthzService.UpdatePermissionMatrix (get roles): %w", err)
+	}
+	rolesByID := make(map[uuid.UUID]*domain.Role, len(roles))
+	for _, role := range roles {
+		rolesByID[role.ID] = role
+	}
+
+	seen := make(map[domain.MatrixChange]bool, len(changes))
+	for i, change := range changes {
+		if !permission.IsValid(change.Permission) {
+			return nil, matrixChangeError(ErrInvalidPermission, i, change)
+		}
+		role, ok := rolesByID[change.RoleID]
+		if !ok {
+			return nil, matrixChangeError(ErrRoleNotFound, i, change)
+		}
+		if role.IsSystem {
+			return nil, matrixChangeError(ErrCannotUpdateSystemRole, i, change)
+		}
+
+		cell := domain.MatrixChange{RoleID: change.RoleID, Permission: change.Permission}
+		if seen[cell] {
+			return nil, matrixChangeError(ErrDuplicateMatrixChange, i, change)
+		}
+		seen[cell] = true
+	}
+
+	applied := 0
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		for i, change := range changes {
+			if rolesByID[change.RoleID].HasPermission(change.Permission) == change.Granted {
+				continue
+			}
+
+			perm, err := s.repo.GetPermissionByIDString(ctx, change.Permission)
+			if errors.Is(err, ports.ErrPermissionNotFound) {
+				// Registered but not seeded into this database
+				return matrixChangeError(ErrPermissionNotFound, i, change)
+			} else if err != nil {
+				return fmt.Errorf("change %d: %w", i, err)
+			}
+
+			if change.Granted {
+				err = s.repo.AddPermissionToRole(ctx, change.RoleID, perm.ID)
+			} else {
+				err = s.repo.RemovePermissionFromRole(ctx, change.RoleID, perm.ID)
+			}
+			if err != nil {
+				return fmt.Errorf("change %d: %w", i, err)
+			}
+			applied++
+		}
+		return nil
+	})
+	if err != nil {
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return nil, err
+		}
+		s.logger.Error(ctx, "failed to update permission matrix",
+			"change_count", len(changes),
+			"error", err,
+		)
+		return nil, fmt.Errorf("AuthzService.UpdatePermissionMatrix: %w", err)
+	}
+
+	s.logger.Info(ctx, "permission matrix updated",
+		"change_count", len(changes),
+		"applied_count", applied,
+	)
+
+	return s.GetPermissionMatrix(ctx)
+}
+
+// ReconcilePermissions compares the permissions declared in code with those
+// stored in the database. With seed set, declared permissions missing from the
+// database are created in one transaction; stored permissions the code no
+// longer declares are only reported, since roles may still grant them.
+func (s *AuthzService) ReconcilePermissions(ctx context.Context, seed bool) (*domain.PermissionDrift, error) {
+	stored, err := s.repo.GetAllPermissions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("AuthzService.ReconcilePermissions (get permissions): %w", err)
+	}
+
+	declared := registeredPermissions()
+	drift := domain.DetectPermissionDrift(declared, stored)
+
+	if seed && len(drift.MissingFromDatabase) > 0 {
+		byID := make(map[string]*domain.Permission, len(declared))
+		for _, perm := range declared {
+			byID[perm.IDString()] = perm
+		}
+
+		err := s.uow.Do(ctx, func(ctx context.Context) error {
+			for _, id := range drift.MissingFromDatabase {
+				if err := s.repo.CreatePermission(ctx, byID[id]); err != nil {
+					return fmt.Errorf("seed %s: %w", id, err)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("AuthzService.ReconcilePermissions: %w", err)
+		}
+
+		drift.Seeded, drift.MissingFromDatabase = drift.MissingFromDatabase, []string{}
+		s.logger.Info(ctx, "seeded missing permissions",
+			"permissions", drift.Seeded,
+		)
+	}
+
+	if len(drift.MissingFromDatabase) > 0 {
+		s.logger.Warn(ctx, "permissions declared in code are missing from the database and cannot be granted",
+			"permissions", drift.MissingFromDatabase,
+		)
+	}
+	if len(drift.UnknownToCode) > 0 {
+		s.logger.Warn(ctx, "database permissions are not declared in code and are never checked",
+			"permissions", drift.UnknownToCode,
+		)
+	}
+
+	return drift, nil
+}
+
 // DeleteRole deletes a role
 func (s *AuthzService) DeleteRole(ctx context.Context, roleID uuid.UUID) error {
 	// Get the role to validate it can be deleted
 	role, err := s.repo.GetRoleByID(ctx, roleID)
 	if err != nil {
-		return fmt.Errorf("AuthzService.DeleteRole (get role): %w", err)
+		return fmt.Errorf("AuthzService.DeleteRole (get role): %w", fromRepository(err))
 	}
 
 	// Validate the role can be deleted
@@ -660,7 +921,7 @@ func (s *AuthzService) DeleteRole(ctx context.Context, roleID uuid.UUID) error {
 			"role_id", roleID,
 			"error", err,
 		)
-		return fmt.Errorf("AuthzService.DeleteRole: %w", err)
+		return fmt.Errorf("AuthzService.DeleteRole: %w", fromRepository(err))
 	}
 
 	s.logger.Info(ctx, "role deleted",
@@ -673,6 +934,22 @@ func (s *AuthzService) DeleteRole(ctx context.Context, roleID uuid.UUID) error {
 
 // ===== PRIVATE HELPER METHODS =====
 
+// fromRepository maps the repository's errors to the matching service errors;
+// any other error is returned unchanged
+func fromRepository(err error) error {
+	switch {
+	case errors.Is(err, ports.ErrRoleNotFound):
+		return ErrRoleNotFound
+	case errors.Is(err, ports.ErrPermissionNotFound):
+		return ErrPermissionNotFound
+	case errors.Is(err, ports.ErrUserNotFound):
+		return ErrUserNotFound
+	case errors.Is(err, ports.ErrRoleNotAssigned):
+		return ErrRoleNotAssigned
+	}
+	return err
+}
+
 // validatePermissionID validates a single permission ID
 func (s *AuthzService) validatePermissionID(permissionID string) error {
 	if !permission.IsValid(permissionID) {
@@ -691,8 +968,28 @@ func (s *AuthzService) validatePermissionIDs(permissionIDs []string) error {
 	return nil
 }
 
-// checkOwnership checks if a user owns a resource
-func (s *AuthzService) checkOwnership(ctx context.Context, userID uuid.UUID, resourceType string, resourceID uuid.UUID) (bool, error) {
+// registeredPermissions returns the permissions declared in code as domain permissions
+func registeredPermissions() []*domain.Permission {
+	registered := permission.All()
+	permissions := make([]*domain.Permission, len(registered))
+	for i, perm := range registered {
+		permissions[i] = domain.NewPermission(perm.Resource, perm.Action, perm.Scope, perm.Description)
+	}
+	return permissions
+}
+
+// matrixChangeError copies reason with the position and content of the
+// rejected change as details, leaving the shared error value untouched
+func matrixChangeError(reason *apperror.AppError, index int, change domain.MatrixChange) error {
+	return apperror.New(reason.Code, reason.BusinessCode, reason.Message, reason.HTTPStatus).WithDetails(map[string]any{
+		"index":      index,
+		"roleId":     change.RoleID,
+		"permission": change.Permission,
+	})
+}
+
+// checkOwnership checks if a user owns a resource, or may perform action on it as though they did
+func (s *AuthzService) checkOwnership(ctx context.Context, userID uuid.UUID, resourceType string, resourceID uuid.UUID, action string) (bool, error) {
 	if s.ownershipRegistry == nil {
 		s.logger.Warn(ctx, "ownership registry not configured",
 			"resource_type", resourceType,
@@ -700,10 +997,38 @@ func (s *AuthzService) checkOwnership(ctx context.Context, userID uuid.UUID, res
 		return false, nil
 	}
 
-	isOwner, err := s.ownershipRegistry.CheckOwnership(ctx, userID, resourceType, resourceID)
+	isOwner, err := s.ownershipRegistry.CheckAccess(ctx, userID, resourceType, resourceID, action)
 	if err != nil {
 		return false, fmt.Errorf("checkOwnership: %w", err)
 	}
 
 	return isOwner, nil
 }
+
+// publishRoleChanged announces a role given to or taken from a user on topic
+func (s *AuthzService) publishRoleChanged(ctx context.Context, topic eventbus.Topic, userID uuid.UUID, role *domain.Role, actorID uuid.UUID) {
+	s.eventBus.Publish(ctx, eventbus.Event{
+		Topic: topic,
+		Payload: events.UserRoleChangedEvent{
+			UserID:     userID,
+			RoleID:     role.ID,
+			RoleName:   role.Name,
+			ActorID:    actorID,
+			OccurredAt: time.Now(),
+		},
+	})
+}
+
+// publishPermissionChanged announces a custom permission given to or taken from a user on topic
+func (s *AuthzService) publishPermissionChanged(ctx context.Context, topic eventbus.Topic, userID uuid.UUID, perm *domain.Permission, actorID uuid.UUID) {
+	s.eventBus.Publish(ctx, eventbus.Event{
+		Topic: topic,
+		Payload: events.UserPermissionChangedEvent{
+			UserID:       userID,
+			PermissionID: perm.ID,
+			Permission:   perm.IDString(),
+			ActorID:      actorID,
+			OccurredAt:   time.Now(),
+		},
+	})
+}
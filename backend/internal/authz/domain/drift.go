@@ -0,0 +1,48 @@
+package domain
+
+import "sort"
+
+// PermissionDrift lists where the permissions declared in code and the rows of
+// the permissions table disagree. Permissions missing from the database cannot
+// be granted; rows unknown to the code are never checked by any route.
+type PermissionDrift struct {
+	MissingFromDatabase []string // Declared in code with no row
+	UnknownToCode       []string // Stored but no longer declared
+	Seeded              []string // Missing permissions created while reconciling
+}
+
+// DetectPermissionDrift compares the declared permissions with the stored ones by ID
+func DetectPermissionDrift(declared, stored []*Permission) *PermissionDrift {
+	storedIDs := make(map[string]bool, len(stored))
+	for _, perm := range stored {
+		storedIDs[perm.IDString()] = true
+	}
+	declaredIDs := make(map[string]bool, len(declared))
+	for _, perm := range declared {
+		declaredIDs[perm.IDString()] = true
+	}
+
+	drift := &PermissionDrift{
+		MissingFromDatabase: []string{},
+		UnknownToCode:       []string{},
+		Seeded:              []string{},
+	}
+	for id := range declaredIDs {
+		if !storedIDs[id] {
+			drift.MissingFromDatabase = append(drift.MissingFromDatabase, id)
+		}
+	}
+	for id := range storedIDs {
+		if !declaredIDs[id] {
+			drift.UnknownToCode = append(drift.UnknownToCode, id)
+		}
+	}
+	sort.Strings(drift.MissingFromDatabase)
+	sort.Strings(drift.UnknownToCode)
+	return drift
+}
+
+// InSync reports whether code and database declare the same permissions
+func (d *PermissionDrift) InSync() bool {
+	return len(d.MissingFromDatabase) == 0 && len(d.UnknownToCode) == 0
+}
@@ -0,0 +1,39 @@
+package domain_test
+
+import (
+	"testing"
+
+	"backend/internal/authz/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectPermissionDrift(t *testing.T) {
+	declared := []*domain.Permission{
+		domain.NewPermission("posts", "create", "", ""),
+		domain.NewPermission("posts", "delete", "any", ""),
+		domain.NewPermission("comments", "moderate", "", ""),
+	}
+	// Stored rows carry their own IDs; only the permission identifiers are compared
+	stored := []*domain.Permission{
+		domain.NewPermission("posts", "create", "", ""),
+		domain.NewPermission("posts", "archive", "", ""),
+	}
+
+	drift := domain.DetectPermissionDrift(declared, stored)
+
+	assert.Equal(t, []string{"comments:moderate", "posts:delete:any"}, drift.MissingFromDatabase)
+	assert.Equal(t, []string{"posts:archive"}, drift.UnknownToCode)
+	assert.Empty(t, drift.Seeded)
+	assert.False(t, drift.InSync())
+}
+
+func TestDetectPermissionDrift_InSync(t *testing.T) {
+	declared := []*domain.Permission{domain.NewPermission("posts", "create", "", "")}
+	stored := []*domain.Permission{domain.NewPermission("posts", "create", "", "")}
+
+	drift := domain.DetectPermissionDrift(declared, stored)
+
+	assert.True(t, drift.InSync())
+	assert.NotNil(t, drift.MissingFromDatabase)
+	assert.NotNil(t, drift.UnknownToCode)
+}
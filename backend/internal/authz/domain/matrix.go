@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// PermissionMatrix is the grid of which roles grant which permissions
+type PermissionMatrix struct {
+	Roles     []*Role // Columns of the grid, in the order given
+	Resources []MatrixResource
+}
+
+// MatrixResource is the block of the grid covering one resource's permissions
+type MatrixResource struct {
+	Resource    string
+	Permissions []MatrixPermission
+}
+
+// MatrixPermission is one row of the grid
+type MatrixPermission struct {
+	ID          string // The permission identifier (e.g., "posts:create")
+	Description string
+	GrantedTo   []uuid.UUID // Roles granting the permission, in column order
+}
+
+// MatrixChange grants or revokes one permission of one role
+type MatrixChange struct {
+	RoleID     uuid.UUID
+	Permission string
+	Granted    bool
+}
+
+// NewPermissionMatrix lays out the roles against the permissions, grouped by
+// resource, with resources and the permissions within each sorted by name
+func NewPermissionMatrix(roles []*Role, permissions []*Permission) *PermissionMatrix {
+	sorted := make([]*Permission, len(permissions))
+	copy(sorted, permissions)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Resource != sorted[j].Resource {
+			return sorted[i].Resource < sorted[j].Resource
+		}
+		return sorted[i].IDString() < sorted[j].IDString()
+	})
+
+	matrix := &PermissionMatrix{Roles: roles}
+	for _, perm := range sorted {
+		if n := len(matrix.Resources); n == 0 || matrix.Resources[n-1].Resource != perm.Resource {
+			matrix.Resources = append(matrix.Resources, MatrixResource{Resource: perm.Resource})
+		}
+
+		row := MatrixPermission{
+			ID:          perm.IDString(),
+			Description: perm.Description,
+			GrantedTo:   []uuid.UUID{},
+		}
+		for _, role := range roles {
+			if role.HasPermission(row.ID) {
+				row.GrantedTo = append(row.GrantedTo, role.ID)
+			}
+		}
+
+		block := &matrix.Resources[len(matrix.Resources)-1]
+		block.Permissions = append(block.Permissions, row)
+	}
+	return matrix
+}
@@ -0,0 +1,54 @@
+package domain_test
+
+import (
+	"testing"
+
+	"backend/internal/authz/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPermissionMatrix(t *testing.T) {
+	postsCreate := domain.NewPermission("posts", "create", "", "Create posts")
+	postsDeleteAny := domain.NewPermission("posts", "delete", "any", "Delete any post")
+	commentsModerate := domain.NewPermission("comments", "moderate", "", "Moderate comments")
+
+	editor := domain.NewRole("editor", "Can edit content")
+	require.NoError(t, editor.AddPermission(postsCreate))
+	require.NoError(t, editor.AddPermission(commentsModerate))
+	admin := domain.NewRole("admin", "Administrator")
+	require.NoError(t, admin.AddPermission(postsCreate))
+	require.NoError(t, admin.AddPermission(postsDeleteAny))
+
+	roles := []*domain.Role{admin, editor}
+	matrix := domain.NewPermissionMatrix(roles, []*domain.Permission{postsDeleteAny, commentsModerate, postsCreate})
+
+	assert.Equal(t, roles, matrix.Roles)
+	require.Len(t, matrix.Resources, 2)
+
+	comments := matrix.Resources[0]
+	assert.Equal(t, "comments", comments.Resource)
+	require.Len(t, comments.Permissions, 1)
+	assert.Equal(t, "comments:moderate", comments.Permissions[0].ID)
+	assert.Equal(t, "Moderate comments", comments.Permissions[0].Description)
+	assert.Equal(t, []uuid.UUID{editor.ID}, comments.Permissions[0].GrantedTo)
+
+	posts := matrix.Resources[1]
+	assert.Equal(t, "posts", posts.Resource)
+	require.Len(t, posts.Permissions, 2)
+	assert.Equal(t, "posts:create", posts.Permissions[0].ID)
+	assert.Equal(t, []uuid.UUID{admin.ID, editor.ID}, posts.Permissions[0].GrantedTo)
+	assert.Equal(t, "posts:delete:any", posts.Permissions[1].ID)
+	assert.Equal(t, []uuid.UUID{admin.ID}, posts.Permissions[1].GrantedTo)
+}
+
+func TestNewPermissionMatrix_UngrantedPermission(t *testing.T) {
+	perm := domain.NewPermission("settings", "system", "", "")
+	matrix := domain.NewPermissionMatrix([]*domain.Role{domain.NewRole("viewer", "")}, []*domain.Permission{perm})
+
+	require.Len(t, matrix.Resources, 1)
+	// An empty list rather than nil, so the row serializes as []
+	assert.NotNil(t, matrix.Resources[0].Permissions[0].GrantedTo)
+	assert.Empty(t, matrix.Resources[0].Permissions[0].GrantedTo)
+}
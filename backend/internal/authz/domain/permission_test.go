@@ -1,7 +1,11 @@
 package domain_test
 
 import (
+	"math/rand"
+	"reflect"
+	"strings"
 	"testing"
+	"testing/quick"
 
 	"backend/internal/authz/domain"
 	"github.com/stretchr/testify/assert"
@@ -260,3 +264,78 @@ func TestParsePermissionID(t *testing.T) {
 		})
 	}
 }
+
+func FuzzParsePermissionID(f *testing.F) {
+	f.Add("posts:create")
+	f.Add("posts:update:own")
+	f.Add("posts:read:draft:any")
+	f.Add("authz:roles:assign")
+	f.Add("posts:read:")
+	f.Add("a:b:c:d:e")
+	f.Add("")
+
+	f.Fuzz(func(t *testing.T, id string) {
+		resource, action, scope := domain.ParsePermissionID(id)
+
+		colons := strings.Count(id, ":")
+		if colons < 1 || colons > 3 {
+			if resource != "" || action != "" || scope != "" {
+				t.Fatalf("ParsePermissionID(%q) = (%q, %q, %q), want no parts", id, resource, action, scope)
+			}
+			return
+		}
+
+		// The parts put back together must give the input
+		rebuilt := resource + ":" + action
+		if colons > 1 {
+			rebuilt += ":" + scope
+		}
+		if rebuilt != id {
+			t.Fatalf("ParsePermissionID(%q) = (%q, %q, %q), which rebuilds %q", id, resource, action, scope, rebuilt)
+		}
+
+		// IDString drops an empty scope, so only IDs without a trailing colon come back unchanged
+		if scope != "" || colons == 1 {
+			if got := domain.NewPermission(resource, action, scope, "").IDString(); got != id {
+				t.Fatalf("IDString of ParsePermissionID(%q) = %q", id, got)
+			}
+		}
+	})
+}
+
+// permissionParts is a well-formed resource, action and scope for quick.Check
+// An action may carry a qualifier such as "read:draft" only when a scope follows it
+type permissionParts struct {
+	Resource, Action, Scope string
+}
+
+func (permissionParts) Generate(r *rand.Rand, _ int) reflect.Value {
+	word := func() string {
+		const letters = "abcdefghijklmnopqrstuvwxyz_"
+		b := make([]byte, 1+r.Intn(12))
+		for i := range b {
+			b[i] = letters[r.Intn(len(letters))]
+		}
+		return string(b)
+	}
+
+	p := permissionParts{Resource: word(), Action: word()}
+	if r.Intn(3) > 0 {
+		p.Scope = word()
+		if r.Intn(2) == 0 {
+			p.Action += ":" + word()
+		}
+	}
+	return reflect.ValueOf(p)
+}
+
+func TestPermissionID_RoundTrip(t *testing.T) {
+	property := func(p permissionParts) bool {
+		id := domain.NewPermission(p.Resource, p.Action, p.Scope, "").IDString()
+		resource, action, scope := domain.ParsePermissionID(id)
+		return permissionParts{resource, action, scope} == p
+	}
+	if err := quick.Check(property, &quick.Config{MaxCount: 2000}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RoleAssignment is one grant of a role to a user, on one blog or on all of them
+type RoleAssignment struct {
+	UserID    uuid.UUID
+	Username  string
+	BlogID    *uuid.UUID // nil when the role applies on every blog
+	GrantedAt time.Time
+	GrantedBy *uuid.UUID // nil when not recorded
+}
+
+// UniquePermission is a permission some holders of a role get from no other
+// role and no direct grant, so they would lose it along with the role
+type UniquePermission struct {
+	PermissionID string
+	HolderCount  int // Holders who would lose the permission
+}
+
+// RoleUsage describes the reach of a role across every blog, for judging the
+// impact of changing or deleting it
+type RoleUsage struct {
+	RoleID            uuid.UUID
+	HolderCount       int        // Distinct users holding the role
+	AssignmentCount   int        // Grants, counting a user once per blog
+	LastGrantedAt     *time.Time // nil when the role is not held
+	UniquePermissions []UniquePermission
+}
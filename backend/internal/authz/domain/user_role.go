@@ -9,9 +9,32 @@ import (
 // UserRole represents the assignment of a role to a user
 // This is a simple struct for API responses
 type UserRole struct {
-	UserID    uuid.UUID
-	RoleID    uuid.UUID
-	Role      *Role
-	GrantedAt time.Time
-	GrantedBy uuid.UUID
+	UserID            uuid.UUID
+	RoleID            uuid.UUID
+	Role              *Role
+	BlogID            *uuid.UUID // nil when the role applies on every blog
+	GrantedAt         time.Time
+	GrantedBy         uuid.UUID // uuid.Nil when not recorded
+	GrantedByUsername string
+}
+
+// RoleChange is what happened to a role assignment
+type RoleChange string
+
+const (
+	RoleGranted RoleChange = "granted"
+	RoleRevoked RoleChange = "revoked"
+)
+
+// RoleHistoryEntry records one grant or revocation of a role
+type RoleHistoryEntry struct {
+	ID            uuid.UUID
+	UserID        uuid.UUID
+	RoleID        uuid.UUID
+	RoleName      string
+	BlogID        *uuid.UUID // nil when the assignment applied on every blog
+	Change        RoleChange
+	ActorID       *uuid.UUID // nil when unknown
+	ActorUsername string
+	OccurredAt    time.Time
 }
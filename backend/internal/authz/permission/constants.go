@@ -25,6 +25,24 @@ const (
 	PostsPublishOwn    = "posts:publish:own"
 	PostsPublishAny    = "posts:publish:any"
 	PostsFeature       = "posts:feature"
+	PostsReview        = "posts:review"
+	PostsReadPremium   = "posts:read:premium"
+
+	// Series permissions
+	SeriesCreate    = "series:create"
+	SeriesUpdateOwn = "series:update:own"
+	SeriesUpdateAny = "series:update:any"
+	SeriesDeleteOwn = "series:delete:own"
+	SeriesDeleteAny = "series:delete:any"
+
+	// Themes permissions
+	ThemesCreate    = "themes:create"
+	ThemesUpdateOwn = "themes:update:own"
+	ThemesUpdateAny = "themes:update:any"
+	ThemesCurateOwn = "themes:curate:own"
+	ThemesCurateAny = "themes:curate:any"
+	ThemesDeleteOwn = "themes:delete:own"
+	ThemesDeleteAny = "themes:delete:any"
 
 	// Comments permissions
 	CommentsCreate    = "comments:create"
@@ -35,6 +53,28 @@ const (
 	CommentsDeleteAny = "comments:delete:any"
 	CommentsModerate  = "comments:moderate"
 
+	// Reactions permissions
+	ReactionsCreate = "reactions:create"
+
+	// Reports permissions
+	ReportsCreate = "reports:create"
+
+	// Bookmarks permissions
+	BookmarksManage = "bookmarks:manage"
+
+	// Follows permissions
+	FollowsManage = "follows:manage"
+
+	// API clients permissions
+	APIClientsManage = "api_clients:manage"
+
+	// Quotas permissions
+	QuotasManage = "quotas:manage"
+
+	// Organizations permissions
+	OrganizationsCreate    = "organizations:create"
+	OrganizationsManageAny = "organizations:manage:any"
+
 	// Users permissions
 	UsersReadSelf   = "users:read:self"
 	UsersReadAny    = "users:read:any"
@@ -75,6 +115,9 @@ const (
 	SettingsBlog   = "settings:blog"
 	SettingsTheme  = "settings:theme"
 
+	// Blogs permissions (deployment-wide; only global role assignments grant them)
+	BlogsManage = "blogs:manage"
+
 	// Authorization permissions (meta permissions)
 	AuthzRolesCreate       = "authz:roles:create"
 	AuthzRolesRead         = "authz:roles:read"
@@ -85,6 +128,8 @@ const (
 	AuthzPermissionsGrant  = "authz:permissions:grant"
 	AuthzPermissionsRevoke = "authz:permissions:revoke"
 	AuthzAuditView         = "authz:audit:view"
+	AuthzImpersonate       = "authz:impersonate"
+	AuthzServiceAccounts   = "authz:service_accounts"
 )
 
 // registry holds all structured Permission objects
@@ -92,8 +137,8 @@ var registry = map[string]*Permission{
 	// Posts permissions
 	PostsCreate:        {ID: PostsCreate, Resource: "posts", Action: "create", Description: "Create new blog posts"},
 	PostsReadPublished: {ID: PostsReadPublished, Resource: "posts", Action: "read", Scope: "published", Description: "Read published posts"},
-	PostsReadDraftOwn:  {ID: PostsReadDraftOwn, Resource: "posts", Action: "read", Scope: "draft:own", Description: "Read own draft posts"},
-	PostsReadDraftAny:  {ID: PostsReadDraftAny, Resource: "posts", Action: "read", Scope: "draft:any", Description: "Read any draft posts"},
+	PostsReadDraftOwn:  {ID: PostsReadDraftOwn, Resource: "posts", Action: "read:draft", Scope: "own", Description: "Read own draft posts"},
+	PostsReadDraftAny:  {ID: PostsReadDraftAny, Resource: "posts", Action: "read:draft", Scope: "any", Description: "Read any draft posts"},
 	PostsUpdateOwn:     {ID: PostsUpdateOwn, Resource: "posts", Action: "update", Scope: "own", Description: "Update own posts"},
 	PostsUpdateAny:     {ID: PostsUpdateAny, Resource: "posts", Action: "update", Scope: "any", Description: "Update any posts"},
 	PostsDeleteOwn:     {ID: PostsDeleteOwn, Resource: "posts", Action: "delete", Scope: "own", Description: "Delete own posts"},
@@ -101,6 +146,24 @@ var registry = map[string]*Permission{
 	PostsPublishOwn:    {ID: PostsPublishOwn, Resource: "posts", Action: "publish", Scope: "own", Description: "Publish own posts"},
 	PostsPublishAny:    {ID: PostsPublishAny, Resource: "posts", Action: "publish", Scope: "any", Description: "Publish any posts"},
 	PostsFeature:       {ID: PostsFeature, Resource: "posts", Action: "feature", Description: "Feature posts on homepage"},
+	PostsReview:        {ID: PostsReview, Resource: "posts", Action: "review", Description: "Approve or request changes to posts submitted for review"},
+	PostsReadPremium:   {ID: PostsReadPremium, Resource: "posts", Action: "read", Scope: "premium", Description: "Read premium posts in full"},
+
+	// Series permissions
+	SeriesCreate:    {ID: SeriesCreate, Resource: "series", Action: "create", Description: "Create post series"},
+	SeriesUpdateOwn: {ID: SeriesUpdateOwn, Resource: "series", Action: "update", Scope: "own", Description: "Update own series"},
+	SeriesUpdateAny: {ID: SeriesUpdateAny, Resource: "series", Action: "update", Scope: "any", Description: "Update any series"},
+	SeriesDeleteOwn: {ID: SeriesDeleteOwn, Resource: "series", Action: "delete", Scope: "own", Description: "Delete own series"},
+	SeriesDeleteAny: {ID: SeriesDeleteAny, Resource: "series", Action: "delete", Scope: "any", Description: "Delete any series"},
+
+	// Themes permissions; "own" covers themes the user curates or collaborates on
+	ThemesCreate:    {ID: ThemesCreate, Resource: "themes", Action: "create", Description: "Create themes"},
+	ThemesUpdateOwn: {ID: ThemesUpdateOwn, Resource: "themes", Action: "update", Scope: "own", Description: "Update own themes"},
+	ThemesUpdateAny: {ID: ThemesUpdateAny, Resource: "themes", Action: "update", Scope: "any", Description: "Update any theme"},
+	ThemesCurateOwn: {ID: ThemesCurateOwn, Resource: "themes", Action: "curate", Scope: "own", Description: "Manage the articles of own themes"},
+	ThemesCurateAny: {ID: ThemesCurateAny, Resource: "themes", Action: "curate", Scope: "any", Description: "Manage the articles of any theme"},
+	ThemesDeleteOwn: {ID: ThemesDeleteOwn, Resource: "themes", Action: "delete", Scope: "own", Description: "Delete own themes"},
+	ThemesDeleteAny: {ID: ThemesDeleteAny, Resource: "themes", Action: "delete", Scope: "any", Description: "Delete any theme"},
 
 	// Comments permissions
 	CommentsCreate:    {ID: CommentsCreate, Resource: "comments", Action: "create", Description: "Create comments"},
@@ -111,6 +174,28 @@ var registry = map[string]*Permission{
 	CommentsDeleteAny: {ID: CommentsDeleteAny, Resource: "comments", Action: "delete", Scope: "any", Description: "Delete any comments"},
 	CommentsModerate:  {ID: CommentsModerate, Resource: "comments", Action: "moderate", Description: "Moderate comments"},
 
+	// Reactions permissions
+	ReactionsCreate: {ID: ReactionsCreate, Resource: "reactions", Action: "create", Description: "React to posts and comments"},
+
+	// Reports permissions
+	ReportsCreate: {ID: ReportsCreate, Resource: "reports", Action: "create", Description: "Report posts and comments to moderators"},
+
+	// Bookmarks permissions
+	BookmarksManage: {ID: BookmarksManage, Resource: "bookmarks", Action: "manage", Description: "Manage own reading list"},
+
+	// Follows permissions
+	FollowsManage: {ID: FollowsManage, Resource: "follows", Action: "manage", Description: "Follow and unfollow authors"},
+
+	// API clients permissions
+	APIClientsManage: {ID: APIClientsManage, Resource: "api_clients", Action: "manage", Description: "Register and revoke own public API tokens"},
+
+	// Quotas permissions
+	QuotasManage: {ID: QuotasManage, Resource: "quotas", Action: "manage", Description: "Override other users' content quotas"},
+
+	// Organizations permissions; members act on their teams' content through the ownership checks
+	OrganizationsCreate:    {ID: OrganizationsCreate, Resource: "organizations", Action: "create", Description: "Create organizations and own them"},
+	OrganizationsManageAny: {ID: OrganizationsManageAny, Resource: "organizations", Action: "manage", Scope: "any", Description: "Manage any organization and its members without belonging to it"},
+
 	// Users permissions
 	UsersReadSelf:   {ID: UsersReadSelf, Resource: "users", Action: "read", Scope: "self", Description: "Read own user profile"},
 	UsersReadAny:    {ID: UsersReadAny, Resource: "users", Action: "read", Scope: "any", Description: "Read any user profile"},
@@ -151,16 +236,21 @@ var registry = map[string]*Permission{
 	SettingsBlog:   {ID: SettingsBlog, Resource: "settings", Action: "blog", Description: "Manage blog settings"},
 	SettingsTheme:  {ID: SettingsTheme, Resource: "settings", Action: "theme", Description: "Manage theme settings"},
 
+	// Blogs permissions
+	BlogsManage: {ID: BlogsManage, Resource: "blogs", Action: "manage", Description: "Create and configure blogs hosted by the deployment"},
+
 	// Authorization permissions
-	AuthzRolesCreate:       {ID: AuthzRolesCreate, Resource: "authz", Action: "roles:create", Description: "Create roles"},
-	AuthzRolesRead:         {ID: AuthzRolesRead, Resource: "authz", Action: "roles:read", Description: "Read roles"},
-	AuthzRolesUpdate:       {ID: AuthzRolesUpdate, Resource: "authz", Action: "roles:update", Description: "Update roles"},
-	AuthzRolesDelete:       {ID: AuthzRolesDelete, Resource: "authz", Action: "roles:delete", Description: "Delete roles"},
-	AuthzRolesAssign:       {ID: AuthzRolesAssign, Resource: "authz", Action: "roles:assign", Description: "Assign roles to users"},
-	AuthzRolesRevoke:       {ID: AuthzRolesRevoke, Resource: "authz", Action: "roles:revoke", Description: "Revoke roles from users"},
-	AuthzPermissionsGrant:  {ID: AuthzPermissionsGrant, Resource: "authz", Action: "permissions:grant", Description: "Grant permissions"},
-	AuthzPermissionsRevoke: {ID: AuthzPermissionsRevoke, Resource: "authz", Action: "permissions:revoke", Description: "Revoke permissions"},
-	AuthzAuditView:         {ID: AuthzAuditView, Resource: "authz", Action: "audit:view", Description: "View audit logs"},
+	AuthzRolesCreate:       {ID: AuthzRolesCreate, Resource: "authz", Action: "roles", Scope: "create", Description: "Create roles"},
+	AuthzRolesRead:         {ID: AuthzRolesRead, Resource: "authz", Action: "roles", Scope: "read", Description: "Read roles"},
+	AuthzRolesUpdate:       {ID: AuthzRolesUpdate, Resource: "authz", Action: "roles", Scope: "update", Description: "Update roles"},
+	AuthzRolesDelete:       {ID: AuthzRolesDelete, Resource: "authz", Action: "roles", Scope: "delete", Description: "Delete roles"},
+	AuthzRolesAssign:       {ID: AuthzRolesAssign, Resource: "authz", Action: "roles", Scope: "assign", Description: "Assign roles to users"},
+	AuthzRolesRevoke:       {ID: AuthzRolesRevoke, Resource: "authz", Action: "roles", Scope: "revoke", Description: "Revoke roles from users"},
+	AuthzPermissionsGrant:  {ID: AuthzPermissionsGrant, Resource: "authz", Action: "permissions", Scope: "grant", Description: "Grant permissions"},
+	AuthzPermissionsRevoke: {ID: AuthzPermissionsRevoke, Resource: "authz", Action: "permissions", Scope: "revoke", Description: "Revoke permissions"},
+	AuthzAuditView:         {ID: AuthzAuditView, Resource: "authz", Action: "audit", Scope: "view", Description: "View audit logs"},
+	AuthzImpersonate:       {ID: AuthzImpersonate, Resource: "authz", Action: "impersonate", Description: "Act as another user through an audited, short-lived session"},
+	AuthzServiceAccounts:   {ID: AuthzServiceAccounts, Resource: "authz", Action: "service_accounts", Description: "Create and revoke the service accounts other services call the API as"},
 }
 
 // FromID looks up a permission by its ID and returns the structured Permission object
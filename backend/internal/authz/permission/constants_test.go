@@ -0,0 +1,24 @@
+package permission_test
+
+import (
+	"testing"
+
+	"backend/internal/authz/domain"
+	"backend/internal/authz/permission"
+	"github.com/stretchr/testify/assert"
+)
+
+// The seeder stores each permission by its fields while checks look it up by
+// ID, so every ID must parse back into exactly the fields registered for it
+func TestRegistry_IDsMatchFields(t *testing.T) {
+	for _, p := range permission.All() {
+		t.Run(p.ID, func(t *testing.T) {
+			resource, action, scope := domain.ParsePermissionID(p.ID)
+			assert.Equal(t, p.Resource, resource)
+			assert.Equal(t, p.Action, action)
+			assert.Equal(t, p.Scope, scope)
+
+			assert.Equal(t, p.ID, domain.NewPermission(p.Resource, p.Action, p.Scope, "").IDString())
+		})
+	}
+}
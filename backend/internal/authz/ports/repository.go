@@ -2,11 +2,28 @@ package ports
 
 import (
 	"context"
+	"errors"
 
 	"backend/internal/authz/domain"
 	"github.com/google/uuid"
 )
 
+// Repository errors (canonical errors for the repository contract)
+var (
+	// ErrRoleNotFound is returned when no role matches, or an assignment names a missing role
+	ErrRoleNotFound = errors.New("role not found")
+
+	// ErrPermissionNotFound is returned when no permission matches, or a role or
+	// user does not hold the permission being removed
+	ErrPermissionNotFound = errors.New("permission not found")
+
+	// ErrUserNotFound is returned when assigning a role or permission to a user who does not exist
+	ErrUserNotFound = errors.New("user not found")
+
+	// ErrRoleNotAssigned is returned when removing a role the user does not hold
+	ErrRoleNotAssigned = errors.New("user does not have this role")
+)
+
 // AuthzRepository defines the interface for authorization data persistence
 // It follows CQRS principles: separate methods for commands (mutations) and queries
 type AuthzRepository interface {
@@ -62,6 +79,13 @@ type AuthzRepository interface {
 	// RemovePermissionFromRole removes a single permission from a role
 	RemovePermissionFromRole(ctx context.Context, roleID uuid.UUID, permissionID uuid.UUID) error
 
+	// GetRoleUsage counts the holders of a role on every blog and finds the
+	// permissions some of them hold only through it
+	GetRoleUsage(ctx context.Context, roleID uuid.UUID) (*domain.RoleUsage, error)
+
+	// ListRoleAssignments returns a page of a role's assignments, most recently granted first
+	ListRoleAssignments(ctx context.Context, roleID uuid.UUID, limit, offset int) ([]*domain.RoleAssignment, error)
+
 	// ===== USER AUTHORIZATION OPERATIONS =====
 
 	// GetUserAuthz retrieves full authorization data for a user (for commands)
@@ -70,8 +94,24 @@ type AuthzRepository interface {
 	// AssignRoleToUser assigns a role to a user
 	AssignRoleToUser(ctx context.Context, userID uuid.UUID, roleID uuid.UUID, grantedBy uuid.UUID) error
 
-	// RemoveRoleFromUser removes a role from a user
-	RemoveRoleFromUser(ctx context.Context, userID uuid.UUID, roleID uuid.UUID) error
+	// RemoveRoleFromUser removes a role from a user, recording who revoked it
+	RemoveRoleFromUser(ctx context.Context, userID uuid.UUID, roleID uuid.UUID, revokedBy uuid.UUID) error
+
+	// GetUserRoleGrants returns how the user came by each role they hold on the
+	// request's blog: when, and by whom, it was granted; Role is left unset
+	GetUserRoleGrants(ctx context.Context, userID uuid.UUID) ([]*domain.UserRole, error)
+
+	// ListUserRoleHistory returns a page of the grants and revocations of the
+	// user's roles on the request's blog, newest first, and how many there are
+	ListUserRoleHistory(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*domain.RoleHistoryEntry, int, error)
+
+	// ListUserRolesInEveryBlog returns the roles the user holds on any blog,
+	// with Role carrying just the role's ID and name, oldest grant first
+	ListUserRolesInEveryBlog(ctx context.Context, userID uuid.UUID) ([]*domain.UserRole, error)
+
+	// ListUserRoleHistoryInEveryBlog returns every grant and revocation of the
+	// user's roles on any blog, oldest first
+	ListUserRoleHistoryInEveryBlog(ctx context.Context, userID uuid.UUID) ([]*domain.RoleHistoryEntry, error)
 
 	// GrantPermissionToUser grants a custom permission to a user
 	GrantPermissionToUser(ctx context.Context, userID uuid.UUID, permissionID uuid.UUID, grantedBy uuid.UUID) error
@@ -108,4 +148,7 @@ type AuthzRepository interface {
 
 	// GetUserRoleNames gets all role names for a user (optimized)
 	GetUserRoleNames(ctx context.Context, userID uuid.UUID) ([]string, error)
+
+	// ListUserIDsWithPermission lists the users holding a permission in the current blog
+	ListUserIDsWithPermission(ctx context.Context, permissionID string) ([]uuid.UUID, error)
 }
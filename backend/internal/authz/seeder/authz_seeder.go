@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"backend/internal/authz/permission"
+	"backend/internal/platform/postgres"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -56,42 +57,28 @@ func (s *AuthzSeeder) Seed(ctx context.Context, db *pgxpool.Pool) error {
 	return nil
 }
 
-// seedPermissions inserts all permissions from the constants registry
+// seedPermissions upserts all permissions from the constants registry
 func (s *AuthzSeeder) seedPermissions(ctx context.Context, tx pgx.Tx) error {
-	// Get all permissions from the registry
 	allPerms := permission.All()
 
-	// Use batch insert for better performance
-	batch := &pgx.Batch{}
+	rows := make([][]any, 0, len(allPerms))
 	for _, perm := range allPerms {
-		query := `
-			INSERT INTO permissions (resource, action, scope, description)
-			VALUES ($1, $2, $3, $4)
-			ON CONFLICT (resource, action, scope) 
-			DO UPDATE SET 
-				description = EXCLUDED.description,
-				updated_at = NOW()
-		`
 		// Handle NULL scope for permissions without scope
 		var scope *string
 		if perm.Scope != "" {
 			scope = &perm.Scope
 		}
-		batch.Queue(query, perm.Resource, perm.Action, scope, perm.Description)
+		rows = append(rows, []any{perm.Resource, perm.Action, scope, perm.Description})
 	}
 
-	// Execute the batch
-	br := tx.SendBatch(ctx, batch)
-	defer func() { _ = br.Close() }()
-
-	// Check for errors
-	for range allPerms {
-		if _, err := br.Exec(); err != nil {
-			return fmt.Errorf("failed to insert permission: %w", err)
-		}
-	}
-
-	return br.Close()
+	_, err := postgres.BulkUpsert(ctx, tx, "permissions",
+		[]string{"resource", "action", "scope", "description"}, rows, `
+		ON CONFLICT (resource, action, scope)
+		DO UPDATE SET
+			description = EXCLUDED.description,
+			updated_at = NOW()`,
+	)
+	return err
 }
 
 // seedRoles inserts default roles from data.go
@@ -125,7 +112,7 @@ func (s *AuthzSeeder) seedRoles(ctx context.Context, tx pgx.Tx) error {
 	return br.Close()
 }
 
-// seedRolePermissions assigns permissions to roles with optimized batch processing
+// seedRolePermissions assigns permissions to roles with one bulk copy
 func (s *AuthzSeeder) seedRolePermissions(ctx context.Context, tx pgx.Tx) error {
 	// Step 1: Fetch all role IDs into memory
 	roleNameToID, err := s.fetchRoleMapping(ctx, tx)
@@ -139,18 +126,13 @@ func (s *AuthzSeeder) seedRolePermissions(ctx context.Context, tx pgx.Tx) error
 		return fmt.Errorf("failed to fetch permission mappings: %w", err)
 	}
 
-	// Step 3: Prepare batch insert
-	batch := &pgx.Batch{}
+	// Step 3: Collect the grants
+	var rows [][]any
 
 	// Handle super_admin specially - gets ALL permissions
 	if superAdminID, exists := roleNameToID["super_admin"]; exists {
 		for _, permUUID := range permissionIDToUUID {
-			query := `
-				INSERT INTO role_permissions (role_id, permission_id)
-				VALUES ($1, $2)
-				ON CONFLICT (role_id, permission_id) DO NOTHING
-			`
-			batch.Queue(query, superAdminID, permUUID)
+			rows = append(rows, []any{superAdminID, permUUID})
 		}
 	}
 
@@ -166,32 +148,16 @@ func (s *AuthzSeeder) seedRolePermissions(ctx context.Context, tx pgx.Tx) error
 			if !exists {
 				return fmt.Errorf("permission %s not found in database", permID)
 			}
-
-			query := `
-				INSERT INTO role_permissions (role_id, permission_id)
-				VALUES ($1, $2)
-				ON CONFLICT (role_id, permission_id) DO NOTHING
-			`
-			batch.Queue(query, roleID, permUUID)
+			rows = append(rows, []any{roleID, permUUID})
 		}
 	}
 
-	// Step 4: Execute the batch
-	if batch.Len() > 0 {
-		br := tx.SendBatch(ctx, batch)
-		defer func() { _ = br.Close() }()
-
-		// Process all results
-		for i := 0; i < batch.Len(); i++ {
-			if _, err := br.Exec(); err != nil {
-				return fmt.Errorf("failed to insert role permission: %w", err)
-			}
-		}
-
-		return br.Close()
-	}
-
-	return nil
+	// Step 4: Copy them in, keeping grants that already exist
+	_, err = postgres.BulkUpsert(ctx, tx, "role_permissions",
+		[]string{"role_id", "permission_id"}, rows,
+		"ON CONFLICT (role_id, permission_id) DO NOTHING",
+	)
+	return err
 }
 
 // fetchRoleMapping fetches all roles and returns a map of name -> UUID
@@ -49,6 +49,12 @@ var DefaultRoles = []DefaultRole{
 		IsTemplate:  false,
 		IsSystem:    true,
 	},
+	{
+		Name:        "premium_subscriber",
+		Description: "Subscriber who can also read premium posts in full",
+		IsTemplate:  false,
+		IsSystem:    true,
+	},
 	// Role templates (for creating custom roles)
 	{
 		Name:        "content_manager_template",
@@ -70,25 +76,35 @@ var DefaultRolePermissions = map[string][]string{
 	"admin": {
 		// Admin can manage content and users but not system settings
 		permission.PostsCreate, permission.PostsReadPublished, permission.PostsReadDraftAny,
-		permission.PostsUpdateAny, permission.PostsDeleteAny, permission.PostsPublishAny, permission.PostsFeature,
+		permission.PostsUpdateAny, permission.PostsDeleteAny, permission.PostsPublishAny, permission.PostsFeature, permission.PostsReview,
+		permission.PostsReadPremium,
+		permission.SeriesCreate, permission.SeriesUpdateAny, permission.SeriesDeleteAny,
+		permission.ThemesCreate, permission.ThemesUpdateAny, permission.ThemesCurateAny, permission.ThemesDeleteAny,
 		permission.CommentsCreate, permission.CommentsRead, permission.CommentsUpdateAny,
 		permission.CommentsDeleteAny, permission.CommentsModerate,
-		permission.UsersReadAny, permission.UsersUpdateAny, permission.UsersSuspend,
+		permission.ReactionsCreate, permission.ReportsCreate, permission.BookmarksManage, permission.FollowsManage, permission.APIClientsManage,
+		permission.UsersReadAny, permission.UsersUpdateAny, permission.UsersDeleteAny, permission.UsersSuspend, permission.QuotasManage,
+		permission.OrganizationsCreate, permission.OrganizationsManageAny,
 		permission.MediaUploadAny, permission.MediaReadAny, permission.MediaDeleteAny,
 		permission.TagsCreate, permission.TagsRead, permission.TagsUpdate, permission.TagsDelete,
 		permission.CategoriesCreate, permission.CategoriesRead, permission.CategoriesUpdate, permission.CategoriesDelete,
 		permission.AnalyticsViewAny, permission.AnalyticsExportAny,
 		permission.SettingsBlog, permission.SettingsTheme,
 		permission.AuthzRolesRead, permission.AuthzRolesAssign, permission.AuthzRolesRevoke,
-		permission.AuthzAuditView,
+		permission.AuthzAuditView, permission.AuthzImpersonate, permission.AuthzServiceAccounts,
 	},
 	"editor": {
 		// Editor can manage all content but not users
 		permission.PostsCreate, permission.PostsReadPublished, permission.PostsReadDraftAny,
-		permission.PostsUpdateAny, permission.PostsDeleteAny, permission.PostsPublishAny, permission.PostsFeature,
+		permission.PostsUpdateAny, permission.PostsDeleteAny, permission.PostsPublishAny, permission.PostsFeature, permission.PostsReview,
+		permission.PostsReadPremium,
+		permission.SeriesCreate, permission.SeriesUpdateAny, permission.SeriesDeleteAny,
+		permission.ThemesCreate, permission.ThemesUpdateAny, permission.ThemesCurateAny, permission.ThemesDeleteAny,
 		permission.CommentsCreate, permission.CommentsRead, permission.CommentsUpdateAny,
 		permission.CommentsDeleteAny, permission.CommentsModerate,
-		permission.UsersReadSelf, permission.UsersUpdateSelf,
+		permission.ReactionsCreate, permission.ReportsCreate, permission.BookmarksManage, permission.FollowsManage, permission.APIClientsManage,
+		permission.OrganizationsCreate,
+		permission.UsersReadSelf, permission.UsersUpdateSelf, permission.UsersDeleteSelf,
 		permission.MediaUploadAny, permission.MediaReadAny, permission.MediaDeleteAny,
 		permission.TagsCreate, permission.TagsRead, permission.TagsUpdate, permission.TagsDelete,
 		permission.CategoriesCreate, permission.CategoriesRead, permission.CategoriesUpdate, permission.CategoriesDelete,
@@ -98,8 +114,12 @@ var DefaultRolePermissions = map[string][]string{
 		// Author can create and manage own content
 		permission.PostsCreate, permission.PostsReadPublished, permission.PostsReadDraftOwn,
 		permission.PostsUpdateOwn, permission.PostsDeleteOwn, permission.PostsPublishOwn,
+		permission.SeriesCreate, permission.SeriesUpdateOwn, permission.SeriesDeleteOwn,
+		permission.ThemesCreate, permission.ThemesUpdateOwn, permission.ThemesCurateOwn, permission.ThemesDeleteOwn,
 		permission.CommentsCreate, permission.CommentsRead, permission.CommentsUpdateOwn, permission.CommentsDeleteOwn,
-		permission.UsersReadSelf, permission.UsersUpdateSelf,
+		permission.ReactionsCreate, permission.ReportsCreate, permission.BookmarksManage, permission.FollowsManage, permission.APIClientsManage,
+		permission.OrganizationsCreate,
+		permission.UsersReadSelf, permission.UsersUpdateSelf, permission.UsersDeleteSelf,
 		permission.MediaUploadOwn, permission.MediaReadOwn, permission.MediaDeleteOwn,
 		permission.TagsRead, permission.CategoriesRead,
 		permission.AnalyticsViewOwn, permission.AnalyticsExportOwn,
@@ -108,8 +128,10 @@ var DefaultRolePermissions = map[string][]string{
 		// Contributor can create content but cannot publish
 		permission.PostsCreate, permission.PostsReadPublished, permission.PostsReadDraftOwn,
 		permission.PostsUpdateOwn, permission.PostsDeleteOwn,
+		permission.ThemesUpdateOwn, permission.ThemesCurateOwn, // Only take effect on themes they collaborate on
 		permission.CommentsCreate, permission.CommentsRead, permission.CommentsUpdateOwn, permission.CommentsDeleteOwn,
-		permission.UsersReadSelf, permission.UsersUpdateSelf,
+		permission.ReactionsCreate, permission.ReportsCreate, permission.BookmarksManage, permission.FollowsManage, permission.APIClientsManage,
+		permission.UsersReadSelf, permission.UsersUpdateSelf, permission.UsersDeleteSelf,
 		permission.MediaUploadOwn, permission.MediaReadOwn,
 		permission.TagsRead, permission.CategoriesRead,
 		permission.AnalyticsViewOwn,
@@ -118,13 +140,22 @@ var DefaultRolePermissions = map[string][]string{
 		// Subscriber can read content and manage own profile
 		permission.PostsReadPublished,
 		permission.CommentsCreate, permission.CommentsRead, permission.CommentsUpdateOwn, permission.CommentsDeleteOwn,
-		permission.UsersReadSelf, permission.UsersUpdateSelf,
+		permission.ReactionsCreate, permission.ReportsCreate, permission.BookmarksManage, permission.FollowsManage, permission.APIClientsManage,
+		permission.UsersReadSelf, permission.UsersUpdateSelf, permission.UsersDeleteSelf,
+		permission.TagsRead, permission.CategoriesRead,
+	},
+	"premium_subscriber": {
+		// Premium subscriber reads as a subscriber does, premium posts included
+		permission.PostsReadPublished, permission.PostsReadPremium,
+		permission.CommentsCreate, permission.CommentsRead, permission.CommentsUpdateOwn, permission.CommentsDeleteOwn,
+		permission.ReactionsCreate, permission.ReportsCreate, permission.BookmarksManage, permission.FollowsManage, permission.APIClientsManage,
+		permission.UsersReadSelf, permission.UsersUpdateSelf, permission.UsersDeleteSelf,
 		permission.TagsRead, permission.CategoriesRead,
 	},
 	"content_manager_template": {
 		// Template with content management permissions
 		permission.PostsCreate, permission.PostsReadDraftAny, permission.PostsUpdateAny,
-		permission.PostsPublishAny, permission.PostsFeature,
+		permission.PostsPublishAny, permission.PostsFeature, permission.PostsReview,
 		permission.MediaUploadAny, permission.MediaReadAny,
 		permission.TagsCreate, permission.TagsUpdate,
 		permission.CategoriesCreate, permission.CategoriesUpdate,
@@ -0,0 +1,230 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"backend/internal/blogs/domain"
+	"backend/internal/blogs/ports"
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/logger"
+	"backend/internal/platform/tenant"
+	"github.com/google/uuid"
+)
+
+// Error definitions for service operations
+var (
+	ErrBlogNotFound = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodeBlogNotFound,
+		"blog not found",
+		http.StatusNotFound,
+	)
+
+	ErrBlogAlreadyInUse = apperror.New(
+		apperror.CodeConflict,
+		apperror.BusinessCodeBlogAlreadyInUse,
+		"another blog already uses this slug or host",
+		http.StatusConflict,
+	)
+)
+
+// BlogsService manages the blogs hosted by the deployment
+type BlogsService struct {
+	repo       ports.BlogRepository
+	authorizer ports.Authorizer
+	logger     logger.Logger
+}
+
+// NewBlogsService creates a new blogs service
+func NewBlogsService(
+	repo ports.BlogRepository,
+	authorizer ports.Authorizer,
+	logger logger.Logger,
+) *BlogsService {
+	return &BlogsService{
+		repo:       repo,
+		authorizer: authorizer,
+		logger:     logger,
+	}
+}
+
+// BlogParams contains the editable fields of a blog
+type BlogParams struct {
+	Name string
+	Slug string
+	Host string
+}
+
+// CreateBlog adds a new blog to the deployment
+func (s *BlogsService) CreateBlog(ctx context.Context, actorID uuid.UUID, params BlogParams) (*domain.Blog, error) {
+	if err := s.checkCanManage(ctx, actorID); err != nil {
+		return nil, err
+	}
+
+	blog, err := domain.NewBlog(params.Name, params.Slug, params.Host)
+	if err != nil {
+		return nil, validationError(err)
+	}
+
+	if err := s.repo.Create(ctx, blog); err != nil {
+		if errors.Is(err, ports.ErrBlogConflict) {
+			return nil, ErrBlogAlreadyInUse
+		}
+		s.logger.Error(ctx, "failed to create blog", "error", err, "slug", blog.Slug)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to create blog",
+			http.StatusInternalServerError,
+		)
+	}
+
+	s.logger.Info(ctx, "blog created", "blogID", blog.ID, "slug", blog.Slug, "actorID", actorID)
+	return blog, nil
+}
+
+// UpdateBlog changes a blog's name, slug and host
+func (s *BlogsService) UpdateBlog(ctx context.Context, actorID uuid.UUID, id uuid.UUID, params BlogParams) (*domain.Blog, error) {
+	if err := s.checkCanManage(ctx, actorID); err != nil {
+		return nil, err
+	}
+
+	blog, err := s.findBlog(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := blog.Update(params.Name, params.Slug, params.Host); err != nil {
+		return nil, validationError(err)
+	}
+
+	if err := s.repo.Update(ctx, blog); err != nil {
+		if errors.Is(err, ports.ErrBlogConflict) {
+			return nil, ErrBlogAlreadyInUse
+		}
+		s.logger.Error(ctx, "failed to update blog", "error", err, "blogID", id)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to update blog",
+			http.StatusInternalServerError,
+		)
+	}
+
+	return blog, nil
+}
+
+// GetBlog retrieves a blog for administration
+func (s *BlogsService) GetBlog(ctx context.Context, actorID uuid.UUID, id uuid.UUID) (*domain.Blog, error) {
+	if err := s.checkCanManage(ctx, actorID); err != nil {
+		return nil, err
+	}
+	return s.findBlog(ctx, id)
+}
+
+// ListBlogs returns every blog in the deployment
+func (s *BlogsService) ListBlogs(ctx context.Context, actorID uuid.UUID) ([]*domain.Blog, error) {
+	if err := s.checkCanManage(ctx, actorID); err != nil {
+		return nil, err
+	}
+
+	blogs, err := s.repo.List(ctx)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list blogs", "error", err)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to list blogs",
+			http.StatusInternalServerError,
+		)
+	}
+	return blogs, nil
+}
+
+// ResolveBySlug finds the blog selected by a /blogs/{slug} path prefix
+// Used by the tenant middleware, so no authorization is applied
+func (s *BlogsService) ResolveBySlug(ctx context.Context, slug string) (*domain.Blog, error) {
+	return s.resolve(ctx, s.repo.FindBySlug, slug)
+}
+
+// ResolveByHost finds the blog selected by a Host header
+// Used by the tenant middleware, so no authorization is applied
+func (s *BlogsService) ResolveByHost(ctx context.Context, host string) (*domain.Blog, error) {
+	return s.resolve(ctx, s.repo.FindByHost, domain.NormalizeHost(host))
+}
+
+// Private helper methods
+
+// resolve runs a tenant lookup and maps a miss to ErrBlogNotFound
+func (s *BlogsService) resolve(ctx context.Context, find func(context.Context, string) (*domain.Blog, error), key string) (*domain.Blog, error) {
+	blog, err := find(ctx, key)
+	if err != nil {
+		if errors.Is(err, ports.ErrBlogNotFound) {
+			return nil, ErrBlogNotFound
+		}
+		s.logger.Error(ctx, "failed to resolve blog", "error", err, "key", key)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to resolve blog",
+			http.StatusInternalServerError,
+		)
+	}
+	return blog, nil
+}
+
+// findBlog loads a blog by ID and maps a miss to ErrBlogNotFound
+func (s *BlogsService) findBlog(ctx context.Context, id uuid.UUID) (*domain.Blog, error) {
+	blog, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, ports.ErrBlogNotFound) {
+			return nil, ErrBlogNotFound
+		}
+		s.logger.Error(ctx, "failed to get blog", "error", err, "blogID", id)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to get blog",
+			http.StatusInternalServerError,
+		)
+	}
+	return blog, nil
+}
+
+// checkCanManage verifies the actor may manage blogs across the deployment
+// Only role assignments that apply to every blog count, so an administrator
+// of a single blog cannot create or reconfigure other blogs
+func (s *BlogsService) checkCanManage(ctx context.Context, actorID uuid.UUID) error {
+	globalCtx := tenant.WithBlogID(ctx, tenant.DefaultBlogID)
+	canManage, err := s.authorizer.Can(globalCtx, actorID, "blogs", "manage", nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canManage {
+		return apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to manage blogs",
+			http.StatusForbidden,
+		)
+	}
+	return nil
+}
+
+// validationError wraps a domain validation failure
+func validationError(err error) error {
+	return apperror.New(
+		apperror.CodeValidationFailed,
+		apperror.BusinessCodeGeneral,
+		err.Error(),
+		http.StatusBadRequest,
+	)
+}
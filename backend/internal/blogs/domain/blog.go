@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"backend/internal/platform/validator"
+	"github.com/google/uuid"
+)
+
+// Blog is one publication hosted by the deployment
+// Posts, themes and role assignments are scoped to a blog
+type Blog struct {
+	ID        uuid.UUID
+	Name      string
+	Slug      string // Path prefix under /blogs/ that selects the blog
+	Host      string // Host name that selects the blog; empty when only reachable by slug
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Business rule constants
+const (
+	MaxNameLength = 150
+	MaxSlugLength = 100
+	MaxHostLength = 253
+)
+
+// Validation errors
+var (
+	ErrInvalidName = errors.New("name is required and must not exceed 150 characters")
+	ErrInvalidSlug = errors.New("slug must contain only lowercase letters, numbers, and hyphens and not exceed 100 characters")
+	ErrInvalidHost = errors.New("host must be a valid host name without scheme, port or path")
+)
+
+// hostRegex matches dot-separated DNS labels
+var hostRegex = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]*[a-z0-9])?\.)*[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+// NewBlog creates a new blog with validation
+func NewBlog(name, slug, host string) (*Blog, error) {
+	blog := &Blog{ID: uuid.New()}
+	if err := blog.Update(name, slug, host); err != nil {
+		return nil, err
+	}
+	blog.CreatedAt = blog.UpdatedAt
+	return blog, nil
+}
+
+// Update replaces the blog's name, slug and host with validation
+func (b *Blog) Update(name, slug, host string) error {
+	name = strings.TrimSpace(name)
+	if name == "" || len([]rune(name)) > MaxNameLength {
+		return ErrInvalidName
+	}
+
+	if err := validator.ValidateSlugFormat(slug, MaxSlugLength); err != nil {
+		return ErrInvalidSlug
+	}
+
+	host = NormalizeHost(host)
+	if host != "" && (len(host) > MaxHostLength || !hostRegex.MatchString(host)) {
+		return ErrInvalidHost
+	}
+
+	b.Name = name
+	b.Slug = slug
+	b.Host = host
+	b.UpdatedAt = time.Now()
+	return nil
+}
+
+// NormalizeHost lowercases a host name and strips any port and trailing dot,
+// so a Host header compares equal to the stored value
+func NormalizeHost(host string) string {
+	host = strings.ToLower(strings.TrimSpace(host))
+	if i := strings.LastIndexByte(host, ':'); i >= 0 && isPort(host[i+1:]) {
+		host = host[:i]
+	}
+	return strings.TrimSuffix(host, ".")
+}
+
+// isPort reports whether s is a non-empty run of digits
+func isPort(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,43 @@
+package domain_test
+
+import (
+	"testing"
+
+	"backend/internal/blogs/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBlog(t *testing.T) {
+	tests := []struct {
+		name     string
+		blogName string
+		slug     string
+		host     string
+		wantHost string
+		wantErr  error
+	}{
+		{name: "slug only", blogName: "Travel", slug: "travel", wantHost: ""},
+		{name: "host is normalized", blogName: "Travel", slug: "travel", host: "Travel.Example.com:8080", wantHost: "travel.example.com"},
+		{name: "trailing dot is dropped", blogName: "Travel", slug: "travel", host: "travel.example.com.", wantHost: "travel.example.com"},
+		{name: "blank name", blogName: "  ", slug: "travel", wantErr: domain.ErrInvalidName},
+		{name: "invalid slug", blogName: "Travel", slug: "Travel Blog", wantErr: domain.ErrInvalidSlug},
+		{name: "host with scheme", blogName: "Travel", slug: "travel", host: "https://travel.example.com", wantErr: domain.ErrInvalidHost},
+		{name: "host with path", blogName: "Travel", slug: "travel", host: "example.com/travel", wantErr: domain.ErrInvalidHost},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blog, err := domain.NewBlog(tt.blogName, tt.slug, tt.host)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.slug, blog.Slug)
+			assert.Equal(t, tt.wantHost, blog.Host)
+			assert.Equal(t, blog.CreatedAt, blog.UpdatedAt)
+		})
+	}
+}
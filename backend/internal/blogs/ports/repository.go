@@ -0,0 +1,40 @@
+package ports
+
+import (
+	"context"
+	"errors"
+
+	"backend/internal/blogs/domain"
+	"github.com/google/uuid"
+)
+
+// Repository errors (canonical errors for the repository contract)
+var (
+	// ErrBlogNotFound is returned when no blog matches the lookup
+	ErrBlogNotFound = errors.New("blog not found")
+
+	// ErrBlogConflict is returned when another blog already uses the slug or host
+	ErrBlogConflict = errors.New("blog slug or host already in use")
+)
+
+// BlogRepository defines the contract for blog persistence
+// Unlike content repositories it is not scoped to the request's blog
+type BlogRepository interface {
+	// Create stores a new blog
+	Create(ctx context.Context, blog *domain.Blog) error
+
+	// Update saves changes to an existing blog
+	Update(ctx context.Context, blog *domain.Blog) error
+
+	// FindByID retrieves a blog by its ID
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.Blog, error)
+
+	// FindBySlug retrieves the blog selected by a path prefix
+	FindBySlug(ctx context.Context, slug string) (*domain.Blog, error)
+
+	// FindByHost retrieves the blog selected by a normalized host name
+	FindByHost(ctx context.Context, host string) (*domain.Blog, error)
+
+	// List returns every blog ordered by name
+	List(ctx context.Context) ([]*domain.Blog, error)
+}
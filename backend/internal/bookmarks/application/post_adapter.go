@@ -0,0 +1,37 @@
+package application
+
+import (
+	"context"
+
+	postsApp "backend/internal/posts/application"
+	"github.com/google/uuid"
+)
+
+// PostAdapter implements the PostProvider interface
+// It adapts the posts service so the bookmarks context can validate posts
+type PostAdapter struct {
+	postsService *postsApp.PostsService
+}
+
+// NewPostAdapter creates a new post adapter
+func NewPostAdapter(postsService *postsApp.PostsService) *PostAdapter {
+	return &PostAdapter{
+		postsService: postsService,
+	}
+}
+
+// EnsurePublished returns an error unless the post exists and is published
+func (a *PostAdapter) EnsurePublished(ctx context.Context, postID uuid.UUID) error {
+	post, err := a.postsService.GetPost(ctx, postID)
+	if err != nil {
+		// Pass through the AppError from the posts service unchanged
+		return err
+	}
+
+	// Drafts and archived posts are hidden from readers, so treat them as missing
+	if !post.IsPublished() {
+		return postsApp.ErrPostNotFound
+	}
+
+	return nil
+}
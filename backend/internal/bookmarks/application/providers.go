@@ -0,0 +1,10 @@
+package application
+
+import "github.com/google/wire"
+
+// ProviderSet is the wire provider set for the bookmarks application layer
+var ProviderSet = wire.NewSet(
+	NewBookmarksService,
+	NewPostAdapter,
+	wire.Bind(new(PostProvider), new(*PostAdapter)),
+)
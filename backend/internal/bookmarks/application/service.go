@@ -0,0 +1,202 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"backend/internal/bookmarks/domain"
+	"backend/internal/bookmarks/ports"
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/events"
+	"backend/internal/platform/logger"
+	"github.com/google/uuid"
+)
+
+// Error definitions for service operations
+var (
+	ErrBookmarkNotFound = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodeBookmarkNotFound,
+		"bookmark not found",
+		http.StatusNotFound,
+	)
+)
+
+// PostProvider verifies that a post can be bookmarked
+// This avoids direct dependency on the posts bounded context
+type PostProvider interface {
+	EnsurePublished(ctx context.Context, postID uuid.UUID) error
+}
+
+// BookmarksService handles reading list business logic
+type BookmarksService struct {
+	repo         ports.BookmarkRepository
+	postProvider PostProvider
+	authorizer   ports.Authorizer
+	eventBus     *eventbus.Bus
+	logger       logger.Logger
+}
+
+// NewBookmarksService creates a new bookmarks service
+func NewBookmarksService(
+	repo ports.BookmarkRepository,
+	postProvider PostProvider,
+	authorizer ports.Authorizer,
+	eventBus *eventbus.Bus,
+	logger logger.Logger,
+) *BookmarksService {
+	return &BookmarksService{
+		repo:         repo,
+		postProvider: postProvider,
+		authorizer:   authorizer,
+		eventBus:     eventBus,
+		logger:       logger,
+	}
+}
+
+// BookmarkPost adds a published post to the actor's reading list
+// Bookmarking a post twice is not an error
+func (s *BookmarksService) BookmarkPost(ctx context.Context, actorID uuid.UUID, postID uuid.UUID) error {
+	if err := s.checkCanManage(ctx, actorID); err != nil {
+		return err
+	}
+
+	if err := s.postProvider.EnsurePublished(ctx, postID); err != nil {
+		return err
+	}
+
+	bookmark, err := domain.NewBookmark(actorID, postID)
+	if err != nil {
+		return apperror.New(
+			apperror.CodeValidationFailed,
+			apperror.BusinessCodeGeneral,
+			err.Error(),
+			http.StatusBadRequest,
+		)
+	}
+
+	if err := s.repo.Save(ctx, bookmark); err != nil {
+		s.logger.Error(ctx, "failed to save bookmark", "error", err, "actorID", actorID, "postID", postID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to save bookmark",
+			http.StatusInternalServerError,
+		)
+	}
+
+	// Publish event
+	s.publishBookmarkAddedEvent(ctx, bookmark)
+
+	return nil
+}
+
+// RemoveBookmark removes a post from the actor's reading list
+func (s *BookmarksService) RemoveBookmark(ctx context.Context, actorID uuid.UUID, postID uuid.UUID) error {
+	if err := s.checkCanManage(ctx, actorID); err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, actorID, postID); err != nil {
+		if errors.Is(err, ports.ErrBookmarkNotFound) {
+			return ErrBookmarkNotFound
+		}
+		s.logger.Error(ctx, "failed to delete bookmark", "error", err, "actorID", actorID, "postID", postID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to remove bookmark",
+			http.StatusInternalServerError,
+		)
+	}
+
+	// Publish event
+	s.publishBookmarkRemovedEvent(ctx, actorID, postID)
+
+	return nil
+}
+
+// ListBookmarks retrieves the actor's reading list
+func (s *BookmarksService) ListBookmarks(ctx context.Context, actorID uuid.UUID, limit, offset int) ([]*ports.BookmarkedPost, int, error) {
+	if err := s.checkCanManage(ctx, actorID); err != nil {
+		return nil, 0, err
+	}
+
+	bookmarks, err := s.repo.ListByUser(ctx, actorID, limit, offset)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list bookmarks", "error", err, "actorID", actorID)
+		return nil, 0, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to list bookmarks",
+			http.StatusInternalServerError,
+		)
+	}
+
+	count, err := s.repo.CountByUser(ctx, actorID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to count bookmarks", "error", err, "actorID", actorID)
+		return nil, 0, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to count bookmarks",
+			http.StatusInternalServerError,
+		)
+	}
+
+	return bookmarks, count, nil
+}
+
+// Private helper methods
+
+// checkCanManage verifies the actor may manage their reading list
+func (s *BookmarksService) checkCanManage(ctx context.Context, actorID uuid.UUID) error {
+	canManage, err := s.authorizer.Can(ctx, actorID, "bookmarks", "manage", nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canManage {
+		return apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to manage bookmarks",
+			http.StatusForbidden,
+		)
+	}
+	return nil
+}
+
+// Event publishing methods
+
+func (s *BookmarksService) publishBookmarkAddedEvent(ctx context.Context, bookmark *domain.Bookmark) {
+	event := eventbus.Event{
+		Topic: events.BookmarkAddedTopic,
+		Payload: events.BookmarkAddedEvent{
+			UserID:     bookmark.UserID,
+			PostID:     bookmark.PostID,
+			OccurredAt: time.Now(),
+		},
+	}
+	s.eventBus.Publish(ctx, event)
+}
+
+func (s *BookmarksService) publishBookmarkRemovedEvent(ctx context.Context, userID, postID uuid.UUID) {
+	event := eventbus.Event{
+		Topic: events.BookmarkRemovedTopic,
+		Payload: events.BookmarkRemovedEvent{
+			UserID:     userID,
+			PostID:     postID,
+			OccurredAt: time.Now(),
+		},
+	}
+	s.eventBus.Publish(ctx, event)
+}
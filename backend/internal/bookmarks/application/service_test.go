@@ -0,0 +1,112 @@
+package application_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"backend/internal/bookmarks/application"
+	"backend/internal/bookmarks/domain"
+	"backend/internal/bookmarks/ports"
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/errreport"
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/logger"
+	postsApp "backend/internal/posts/application"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubAuthorizer grants bookmarks:manage unless denied
+type stubAuthorizer struct{ denied bool }
+
+func (a stubAuthorizer) Can(context.Context, uuid.UUID, string, string, *uuid.UUID) (bool, error) {
+	return !a.denied, nil
+}
+
+// stubPosts knows which posts are published
+type stubPosts map[uuid.UUID]bool
+
+func (p stubPosts) EnsurePublished(_ context.Context, postID uuid.UUID) error {
+	if !p[postID] {
+		return postsApp.ErrPostNotFound
+	}
+	return nil
+}
+
+// memoryRepository keeps bookmarks by user and post
+type memoryRepository struct {
+	ports.BookmarkRepository
+	saved map[[2]uuid.UUID]*domain.Bookmark
+}
+
+func (r *memoryRepository) Save(_ context.Context, bookmark *domain.Bookmark) error {
+	key := [2]uuid.UUID{bookmark.UserID, bookmark.PostID}
+	if _, exists := r.saved[key]; !exists {
+		r.saved[key] = bookmark
+	}
+	return nil
+}
+
+func (r *memoryRepository) Delete(_ context.Context, userID, postID uuid.UUID) error {
+	key := [2]uuid.UUID{userID, postID}
+	if _, exists := r.saved[key]; !exists {
+		return ports.ErrBookmarkNotFound
+	}
+	delete(r.saved, key)
+	return nil
+}
+
+func newBookmarksService(authorizer stubAuthorizer, posts stubPosts) (*application.BookmarksService, *memoryRepository) {
+	log := logger.NewSlogAdapter("test", "error")
+	repo := &memoryRepository{saved: map[[2]uuid.UUID]*domain.Bookmark{}}
+	return application.NewBookmarksService(repo, posts, authorizer, eventbus.NewBus(log, errreport.Nop{}), log), repo
+}
+
+// statusOf returns the HTTP status of an AppError
+func statusOf(t *testing.T, err error) int {
+	t.Helper()
+	var appErr *apperror.AppError
+	require.ErrorAs(t, err, &appErr)
+	return appErr.HTTPStatus
+}
+
+func TestBookmarksService_BookmarkPost(t *testing.T) {
+	reader, published, draft := uuid.New(), uuid.New(), uuid.New()
+	service, repo := newBookmarksService(stubAuthorizer{}, stubPosts{published: true})
+	ctx := context.Background()
+
+	require.NoError(t, service.BookmarkPost(ctx, reader, published))
+	require.NoError(t, service.BookmarkPost(ctx, reader, published), "bookmarking twice is not an error")
+	assert.Len(t, repo.saved, 1)
+
+	err := service.BookmarkPost(ctx, reader, draft)
+	assert.Equal(t, http.StatusNotFound, statusOf(t, err), "unpublished posts cannot be bookmarked")
+	assert.Len(t, repo.saved, 1)
+}
+
+func TestBookmarksService_RemoveBookmark(t *testing.T) {
+	reader, post := uuid.New(), uuid.New()
+	service, repo := newBookmarksService(stubAuthorizer{}, stubPosts{post: true})
+	ctx := context.Background()
+
+	require.NoError(t, service.BookmarkPost(ctx, reader, post))
+	require.NoError(t, service.RemoveBookmark(ctx, reader, post))
+	assert.Empty(t, repo.saved)
+
+	err := service.RemoveBookmark(ctx, reader, post)
+	assert.ErrorIs(t, err, application.ErrBookmarkNotFound)
+}
+
+func TestBookmarksService_RequiresPermission(t *testing.T) {
+	reader, post := uuid.New(), uuid.New()
+	service, repo := newBookmarksService(stubAuthorizer{denied: true}, stubPosts{post: true})
+	ctx := context.Background()
+
+	assert.Equal(t, http.StatusForbidden, statusOf(t, service.BookmarkPost(ctx, reader, post)))
+	assert.Equal(t, http.StatusForbidden, statusOf(t, service.RemoveBookmark(ctx, reader, post)))
+	_, _, err := service.ListBookmarks(ctx, reader, 20, 0)
+	assert.Equal(t, http.StatusForbidden, statusOf(t, err))
+	assert.Empty(t, repo.saved)
+}
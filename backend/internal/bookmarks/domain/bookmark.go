@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Bookmark is a post saved to a user's private reading list
+type Bookmark struct {
+	UserID    uuid.UUID
+	PostID    uuid.UUID
+	CreatedAt time.Time
+}
+
+// Validation errors
+var (
+	ErrInvalidUserID = errors.New("user ID is required")
+	ErrInvalidPostID = errors.New("post ID is required")
+)
+
+// NewBookmark creates a new bookmark with validation
+func NewBookmark(userID, postID uuid.UUID) (*Bookmark, error) {
+	if userID == uuid.Nil {
+		return nil, ErrInvalidUserID
+	}
+
+	if postID == uuid.Nil {
+		return nil, ErrInvalidPostID
+	}
+
+	return &Bookmark{
+		UserID:    userID,
+		PostID:    postID,
+		CreatedAt: time.Now(),
+	}, nil
+}
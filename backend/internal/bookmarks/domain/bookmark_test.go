@@ -0,0 +1,25 @@
+package domain_test
+
+import (
+	"testing"
+
+	"backend/internal/bookmarks/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBookmark(t *testing.T) {
+	userID, postID := uuid.New(), uuid.New()
+
+	bookmark, err := domain.NewBookmark(userID, postID)
+	require.NoError(t, err)
+	assert.Equal(t, userID, bookmark.UserID)
+	assert.Equal(t, postID, bookmark.PostID)
+	assert.False(t, bookmark.CreatedAt.IsZero())
+
+	_, err = domain.NewBookmark(uuid.Nil, postID)
+	assert.ErrorIs(t, err, domain.ErrInvalidUserID)
+	_, err = domain.NewBookmark(userID, uuid.Nil)
+	assert.ErrorIs(t, err, domain.ErrInvalidPostID)
+}
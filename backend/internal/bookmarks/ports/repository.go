@@ -0,0 +1,43 @@
+package ports
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"backend/internal/bookmarks/domain"
+	"github.com/google/uuid"
+)
+
+// Repository errors (canonical errors for the repository contract)
+var (
+	// ErrBookmarkNotFound is returned when a user has not bookmarked a post
+	ErrBookmarkNotFound = errors.New("bookmark not found")
+)
+
+// BookmarkRepository defines the contract for bookmark persistence
+type BookmarkRepository interface {
+	// Save stores the bookmark; saving an existing bookmark is a no-op
+	Save(ctx context.Context, bookmark *domain.Bookmark) error
+
+	// Delete removes a user's bookmark on a post
+	Delete(ctx context.Context, userID, postID uuid.UUID) error
+
+	// ListByUser retrieves a user's bookmarked published posts, most recently bookmarked first
+	ListByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*BookmarkedPost, error)
+
+	// CountByUser returns the number of bookmarked published posts for a user
+	CountByUser(ctx context.Context, userID uuid.UUID) (int, error)
+}
+
+// BookmarkedPost is a read model for a post on a user's reading list
+type BookmarkedPost struct {
+	PostID       uuid.UUID
+	Title        string
+	Slug         string
+	Excerpt      string
+	AuthorID     uuid.UUID
+	AuthorName   string // Joined from users table
+	PublishedAt  *time.Time
+	BookmarkedAt time.Time
+}
@@ -0,0 +1,208 @@
+package application
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"backend/internal/export/domain"
+	"backend/internal/export/ports"
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/tenant"
+	"github.com/google/uuid"
+)
+
+// maxImportFileBytes caps each file read from an imported archive, so a small
+// archive cannot inflate into an unbounded amount of memory
+const maxImportFileBytes = 4 << 20
+
+// Single-post archive errors
+var (
+	ErrPostNotFound = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodePostNotFound,
+		"post not found",
+		http.StatusNotFound,
+	)
+
+	ErrInvalidPostArchive = apperror.New(
+		apperror.CodeValidationFailed,
+		apperror.BusinessCodeInvalidFormat,
+		"invalid post archive",
+		http.StatusBadRequest,
+	)
+)
+
+// ImportedPost describes the post created from an archive
+type ImportedPost struct {
+	ID   uuid.UUID
+	Slug string // May differ from the archived slug when that one is taken
+	// Media lists the URLs the content embeds; files are referenced, not copied,
+	// so they must be reachable from the importing environment
+	Media []string
+}
+
+// ExportPost writes a zip archive of one post: its Markdown document, metadata and media references.
+// Posts keep no revision history, so only the current version is archived.
+// The archive can be imported into another environment with ImportPost.
+func (s *ExportService) ExportPost(ctx context.Context, actorID, postID uuid.UUID, w io.Writer) error {
+	if err := s.checkCanExportPost(ctx, actorID, postID); err != nil {
+		return err
+	}
+
+	post, err := s.repo.FindPost(ctx, postID)
+	if err != nil {
+		if errors.Is(err, ports.ErrPostNotFound) {
+			return ErrPostNotFound
+		}
+		return s.exportFailed(ctx, "post", err)
+	}
+	post.Media = postMedia(post)
+
+	exportedAt := time.Now().UTC()
+	manifest := Manifest{
+		FormatVersion: domain.FormatVersion,
+		Kind:          domain.KindPost,
+		BlogID:        tenant.BlogID(ctx),
+		PostID:        &post.ID,
+		ExportedAt:    exportedAt,
+		ExportedBy:    actorID,
+		Posts:         1,
+		Media:         len(post.Media),
+	}
+	references := make([]MediaReference, 0, len(post.Media))
+	for _, url := range post.Media {
+		references = append(references, MediaReference{URL: url, Posts: []string{post.Slug}})
+	}
+
+	archive := zip.NewWriter(w)
+	if err := writeFile(archive, domain.PostContentFile, exportedAt, []byte(domain.RenderPostMarkdown(post.Title, post.Content))); err != nil {
+		return s.exportFailed(ctx, "post", err)
+	}
+	if err := writeJSON(archive, domain.PostMetadataFile, exportedAt, post); err != nil {
+		return s.exportFailed(ctx, "post", err)
+	}
+	if err := writeJSON(archive, domain.MediaFile, exportedAt, references); err != nil {
+		return s.exportFailed(ctx, "media", err)
+	}
+	if err := writeJSON(archive, domain.ManifestFile, exportedAt, manifest); err != nil {
+		return s.exportFailed(ctx, "manifest", err)
+	}
+	if err := archive.Close(); err != nil {
+		return s.exportFailed(ctx, "archive", err)
+	}
+
+	s.logger.Info(ctx, "post export completed", "actorID", actorID, "postID", postID, "media", manifest.Media)
+	return nil
+}
+
+// ImportPost creates a draft by the actor from an archive written by ExportPost.
+// The document's title and content win over the metadata, so authors may edit
+// index.md before importing. Status, authorship and dates are not carried over.
+func (s *ExportService) ImportPost(ctx context.Context, actorID uuid.UUID, data []byte) (*ImportedPost, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, ErrInvalidPostArchive.WithDetails("not a zip archive")
+	}
+
+	var manifest Manifest
+	if err := readJSON(archive, domain.ManifestFile, &manifest); err != nil {
+		return nil, ErrInvalidPostArchive.WithDetails(err.Error())
+	}
+	if manifest.Kind != domain.KindPost {
+		return nil, ErrInvalidPostArchive.WithDetails("archive does not hold a single post")
+	}
+	if manifest.FormatVersion > domain.FormatVersion {
+		return nil, ErrInvalidPostArchive.WithDetails(fmt.Sprintf("archive format %d is newer than this server supports", manifest.FormatVersion))
+	}
+
+	var post ports.Post
+	if err := readJSON(archive, domain.PostMetadataFile, &post); err != nil {
+		return nil, ErrInvalidPostArchive.WithDetails(err.Error())
+	}
+	doc, err := readFile(archive, domain.PostContentFile)
+	if err != nil {
+		return nil, ErrInvalidPostArchive.WithDetails(err.Error())
+	}
+	post.Title, post.Content, err = domain.ParsePostMarkdown(string(doc))
+	if err != nil {
+		return nil, ErrInvalidPostArchive.WithDetails(err.Error())
+	}
+
+	id, slug, err := s.posts.CreateImportedPost(ctx, actorID, &post)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info(ctx, "post imported", "actorID", actorID, "postID", id, "sourcePostID", post.ID, "sourceBlogID", manifest.BlogID)
+	return &ImportedPost{ID: id, Slug: slug, Media: postMedia(&post)}, nil
+}
+
+// checkCanExportPost verifies the actor may read the post's full source,
+// which takes the same access as editing it
+func (s *ExportService) checkCanExportPost(ctx context.Context, actorID, postID uuid.UUID) error {
+	canUpdate, err := s.authorizer.Can(ctx, actorID, "posts", "update", &postID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID, "postID", postID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canUpdate {
+		return apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to export this post",
+			http.StatusForbidden,
+		)
+	}
+	return nil
+}
+
+// postMedia lists the media a post embeds, its share image included
+func postMedia(post *ports.Post) []string {
+	media := domain.ExtractMediaURLs(post.Content)
+	if post.OGImageURL != "" {
+		media = append(media, post.OGImageURL)
+	}
+	return media
+}
+
+// readFile reads a file of an archive, refusing files over maxImportFileBytes
+func readFile(archive *zip.Reader, name string) ([]byte, error) {
+	f, err := archive.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("missing %s", name)
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(io.LimitReader(f, maxImportFileBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", name, err)
+	}
+	if len(data) > maxImportFileBytes {
+		return nil, fmt.Errorf("%s exceeds %d bytes", name, maxImportFileBytes)
+	}
+	return data, nil
+}
+
+// readJSON decodes a JSON file of an archive
+func readJSON(archive *zip.Reader, name string, v any) error {
+	data, err := readFile(archive, name)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("decode %s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package application
+
+import (
+	"context"
+
+	"backend/internal/export/ports"
+	postsApp "backend/internal/posts/application"
+	postsDomain "backend/internal/posts/domain"
+	"github.com/google/uuid"
+)
+
+// PostsAdapter implements the PostCreator interface
+// Imported posts go through the posts service, so they are sanitized,
+// validated and counted against quotas like any post written in the editor
+type PostsAdapter struct {
+	postsService *postsApp.PostsService
+}
+
+// NewPostsAdapter creates a new posts adapter
+func NewPostsAdapter(postsService *postsApp.PostsService) *PostsAdapter {
+	return &PostsAdapter{
+		postsService: postsService,
+	}
+}
+
+// CreateImportedPost creates a draft by the actor from an archived post, keeping its slug when it is free
+func (a *PostsAdapter) CreateImportedPost(ctx context.Context, actorID uuid.UUID, post *ports.Post) (uuid.UUID, string, error) {
+	created, err := a.postsService.CreatePost(ctx, actorID, postsApp.CreatePostParams{
+		Title:    post.Title,
+		Content:  post.Content,
+		Excerpt:  post.Excerpt,
+		Language: post.Language,
+		Slug:     post.Slug,
+		SEO: &postsDomain.SEOMetadata{
+			MetaTitle:       post.MetaTitle,
+			MetaDescription: post.MetaDescription,
+			CanonicalURL:    post.CanonicalURL,
+			OGImageURL:      post.OGImageURL,
+		},
+	})
+	if err != nil {
+		return uuid.Nil, "", err
+	}
+	return created.ID, created.Slug, nil
+}
@@ -0,0 +1,10 @@
+package application
+
+import "github.com/google/wire"
+
+// ProviderSet is the wire provider set for the export application layer
+var ProviderSet = wire.NewSet(
+	NewExportService,
+	NewPostsAdapter,
+	wire.Bind(new(PostCreator), new(*PostsAdapter)),
+)
@@ -0,0 +1,209 @@
+package application
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+
+	"backend/internal/export/domain"
+	"backend/internal/export/ports"
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/logger"
+	"backend/internal/platform/tenant"
+	"github.com/google/uuid"
+)
+
+// PostCreator creates the posts of imported archives
+// This avoids direct dependency on the posts bounded context
+type PostCreator interface {
+	CreateImportedPost(ctx context.Context, actorID uuid.UUID, post *ports.Post) (id uuid.UUID, slug string, err error)
+}
+
+// ExportService builds downloadable archives of the whole blog or of single posts,
+// and imports single-post archives
+type ExportService struct {
+	repo       ports.ExportRepository
+	authorizer ports.Authorizer
+	posts      PostCreator
+	logger     logger.Logger
+}
+
+// NewExportService creates a new export service
+func NewExportService(
+	repo ports.ExportRepository,
+	authorizer ports.Authorizer,
+	posts PostCreator,
+	logger logger.Logger,
+) *ExportService {
+	return &ExportService{
+		repo:       repo,
+		authorizer: authorizer,
+		posts:      posts,
+		logger:     logger,
+	}
+}
+
+// Manifest summarizes an archive; it is written last, once the counts are known
+type Manifest struct {
+	FormatVersion int        `json:"formatVersion"`
+	Kind          string     `json:"kind"` // domain.KindBlog or domain.KindPost
+	BlogID        uuid.UUID  `json:"blogId"`
+	PostID        *uuid.UUID `json:"postId,omitempty"` // The exported post of a single-post archive
+	ExportedAt    time.Time  `json:"exportedAt"`
+	ExportedBy    uuid.UUID  `json:"exportedBy"`
+	Posts         int        `json:"posts"`
+	Themes        int        `json:"themes"`
+	Media         int        `json:"media"`
+}
+
+// MediaReference lists the posts that embed a media URL
+type MediaReference struct {
+	URL   string   `json:"url"`
+	Posts []string `json:"posts"` // Slugs of the referencing posts
+}
+
+// ExportArchive streams a zip archive of the request's blog: its posts, themes and media references.
+// Authorization is checked before anything is written, so an error without
+// output can still be reported to the client. Posts and themes are read one
+// row at a time and written straight into the archive.
+func (s *ExportService) ExportArchive(ctx context.Context, actorID uuid.UUID, w io.Writer) error {
+	if err := s.checkCanExport(ctx, actorID); err != nil {
+		return err
+	}
+
+	archive := zip.NewWriter(w)
+	manifest := Manifest{
+		FormatVersion: domain.FormatVersion,
+		Kind:          domain.KindBlog,
+		BlogID:        tenant.BlogID(ctx),
+		ExportedAt:    time.Now().UTC(),
+		ExportedBy:    actorID,
+	}
+
+	// Media URLs are small, so the cross-post index is kept in memory
+	media := make(map[string][]string)
+
+	err := s.repo.StreamPosts(ctx, func(post *ports.Post) error {
+		post.Media = postMedia(post)
+		for _, url := range post.Media {
+			media[url] = append(media[url], post.Slug)
+		}
+
+		if err := writeFile(archive, domain.PostContentPath(post.Slug), manifest.ExportedAt, []byte(domain.RenderPostMarkdown(post.Title, post.Content))); err != nil {
+			return err
+		}
+		if err := writeJSON(archive, domain.PostMetadataPath(post.Slug), manifest.ExportedAt, post); err != nil {
+			return err
+		}
+
+		manifest.Posts++
+		return nil
+	})
+	if err != nil {
+		return s.exportFailed(ctx, "posts", err)
+	}
+
+	err = s.repo.StreamThemes(ctx, func(theme *ports.Theme) error {
+		if err := writeJSON(archive, domain.ThemePath(theme.Slug), manifest.ExportedAt, theme); err != nil {
+			return err
+		}
+
+		manifest.Themes++
+		return nil
+	})
+	if err != nil {
+		return s.exportFailed(ctx, "themes", err)
+	}
+
+	references := make([]MediaReference, 0, len(media))
+	for url, posts := range media {
+		references = append(references, MediaReference{URL: url, Posts: posts})
+	}
+	sort.Slice(references, func(i, j int) bool { return references[i].URL < references[j].URL })
+	manifest.Media = len(references)
+
+	if err := writeJSON(archive, domain.MediaFile, manifest.ExportedAt, references); err != nil {
+		return s.exportFailed(ctx, "media", err)
+	}
+	if err := writeJSON(archive, domain.ManifestFile, manifest.ExportedAt, manifest); err != nil {
+		return s.exportFailed(ctx, "manifest", err)
+	}
+
+	if err := archive.Close(); err != nil {
+		return s.exportFailed(ctx, "archive", err)
+	}
+
+	s.logger.Info(ctx, "blog export completed",
+		"actorID", actorID,
+		"posts", manifest.Posts,
+		"themes", manifest.Themes,
+		"media", manifest.Media,
+	)
+	return nil
+}
+
+// Private helper methods
+
+// checkCanExport verifies the actor may export the whole site
+func (s *ExportService) checkCanExport(ctx context.Context, actorID uuid.UUID) error {
+	canExport, err := s.authorizer.Can(ctx, actorID, "settings", "system", nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canExport {
+		return apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to export content",
+			http.StatusForbidden,
+		)
+	}
+	return nil
+}
+
+// exportFailed logs a failure partway through an export
+func (s *ExportService) exportFailed(ctx context.Context, stage string, err error) error {
+	s.logger.Error(ctx, "failed to export content", "error", err, "stage", stage)
+	return apperror.New(
+		apperror.CodeInternalError,
+		apperror.BusinessCodeGeneral,
+		"failed to export content",
+		http.StatusInternalServerError,
+	)
+}
+
+// writeFile adds a file to the archive
+func writeFile(archive *zip.Writer, name string, modified time.Time, data []byte) error {
+	f, err := archive.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: modified,
+	})
+	if err != nil {
+		return fmt.Errorf("create %s: %w", name, err)
+	}
+	if _, err := f.Write(data); err != nil {
+		return fmt.Errorf("write %s: %w", name, err)
+	}
+	return nil
+}
+
+// writeJSON adds an indented JSON file to the archive
+func writeJSON(archive *zip.Writer, name string, modified time.Time, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode %s: %w", name, err)
+	}
+	return writeFile(archive, name, modified, append(data, '\n'))
+}
@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"errors"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// FormatVersion identifies the archive layout so importers can detect changes
+const FormatVersion = 2
+
+// Archive layout
+const (
+	PostsDir     = "posts"
+	ThemesDir    = "themes"
+	MediaFile    = "media.json"
+	ManifestFile = "manifest.json"
+
+	// A single-post archive holds one post at its root, next to its media and manifest
+	PostContentFile  = "index.md"
+	PostMetadataFile = "meta.json"
+)
+
+// Kinds of archive, recorded in the manifest
+const (
+	KindBlog = "blog"
+	KindPost = "post"
+)
+
+// ErrMalformedMarkdown is returned for post documents RenderPostMarkdown did not write
+var ErrMalformedMarkdown = errors.New("post document must start with a \"# \" title line")
+
+// mediaSrcRegex matches the src attribute of embedded media elements.
+// Content is sanitized before it is stored, so attributes are always double-quoted.
+var mediaSrcRegex = regexp.MustCompile(`(?i)<(?:img|video|audio|source)\b[^>]*?\ssrc="([^"]+)"`)
+
+// PostContentPath is where a post's content is stored in the archive
+func PostContentPath(slug string) string {
+	return path.Join(PostsDir, slug, PostContentFile)
+}
+
+// PostMetadataPath is where a post's metadata is stored in the archive
+func PostMetadataPath(slug string) string {
+	return path.Join(PostsDir, slug, PostMetadataFile)
+}
+
+// ThemePath is where a theme is stored in the archive
+func ThemePath(slug string) string {
+	return path.Join(ThemesDir, slug+".json")
+}
+
+// RenderPostMarkdown renders a post as a Markdown document.
+// Post bodies are stored as HTML, which Markdown allows inline as-is.
+func RenderPostMarkdown(title, content string) string {
+	var b strings.Builder
+	b.WriteString("# ")
+	b.WriteString(title)
+	b.WriteString("\n\n")
+	b.WriteString(strings.TrimSpace(content))
+	b.WriteString("\n")
+	return b.String()
+}
+
+// ParsePostMarkdown splits a document written by RenderPostMarkdown back into its title and content
+func ParsePostMarkdown(doc string) (title, content string, err error) {
+	doc = strings.TrimPrefix(doc, "\ufeff")
+	heading, body, _ := strings.Cut(doc, "\n")
+	title, ok := strings.CutPrefix(strings.TrimRight(heading, "\r"), "# ")
+	if !ok || strings.TrimSpace(title) == "" {
+		return "", "", ErrMalformedMarkdown
+	}
+	return strings.TrimSpace(title), strings.TrimSpace(body), nil
+}
+
+// ExtractMediaURLs returns the distinct media URLs embedded in HTML content, in document order
+func ExtractMediaURLs(content string) []string {
+	matches := mediaSrcRegex.FindAllStringSubmatch(content, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(matches))
+	urls := make([]string, 0, len(matches))
+	for _, match := range matches {
+		url := match[1]
+		if !seen[url] {
+			seen[url] = true
+			urls = append(urls, url)
+		}
+	}
+	return urls
+}
@@ -0,0 +1,55 @@
+package domain_test
+
+import (
+	"testing"
+
+	"backend/internal/export/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractMediaURLs(t *testing.T) {
+	content := `<p>Intro</p>
+<img alt="diagram" src="https://cdn.example.com/a.png">
+<video controls src="/media/demo.mp4"></video>
+<p><a href="https://example.com/not-media">link</a></p>
+<IMG SRC="https://cdn.example.com/a.png">
+<audio><source type="audio/mpeg" src="/media/clip.mp3"></audio>`
+
+	assert.Equal(t, []string{
+		"https://cdn.example.com/a.png",
+		"/media/demo.mp4",
+		"/media/clip.mp3",
+	}, domain.ExtractMediaURLs(content))
+}
+
+func TestExtractMediaURLs_NoMedia(t *testing.T) {
+	assert.Nil(t, domain.ExtractMediaURLs("<p>Plain text</p>"))
+}
+
+func TestRenderPostMarkdown(t *testing.T) {
+	got := domain.RenderPostMarkdown("Hello", "\n<p>World</p>\n")
+	assert.Equal(t, "# Hello\n\n<p>World</p>\n", got)
+}
+
+func TestParsePostMarkdown(t *testing.T) {
+	title, content, err := domain.ParsePostMarkdown(domain.RenderPostMarkdown("Hello", "<p>World</p>\n<p>Again</p>"))
+	require.NoError(t, err)
+	assert.Equal(t, "Hello", title)
+	assert.Equal(t, "<p>World</p>\n<p>Again</p>", content)
+
+	title, _, err = domain.ParsePostMarkdown("\ufeff# Edited on Windows\r\n\r\n<p>Body</p>\r\n")
+	require.NoError(t, err)
+	assert.Equal(t, "Edited on Windows", title)
+
+	for _, doc := range []string{"", "Hello\n\n<p>World</p>", "#\n", "#   \n<p>World</p>"} {
+		_, _, err := domain.ParsePostMarkdown(doc)
+		assert.ErrorIs(t, err, domain.ErrMalformedMarkdown, doc)
+	}
+}
+
+func TestArchivePaths(t *testing.T) {
+	assert.Equal(t, "posts/hello-world/index.md", domain.PostContentPath("hello-world"))
+	assert.Equal(t, "posts/hello-world/meta.json", domain.PostMetadataPath("hello-world"))
+	assert.Equal(t, "themes/go-basics.json", domain.ThemePath("go-basics"))
+}
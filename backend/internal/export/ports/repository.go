@@ -0,0 +1,70 @@
+package ports
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrPostNotFound is returned when a post to export does not exist in the request's blog
+var ErrPostNotFound = errors.New("post not found")
+
+// ExportRepository reads site content for a full export
+// Each method invokes fn once per row while the result set is still being read,
+// so callers never hold the whole site in memory. An error from fn stops the stream.
+type ExportRepository interface {
+	// StreamPosts yields every post of the request's blog regardless of status, oldest first
+	StreamPosts(ctx context.Context, fn func(*Post) error) error
+
+	// FindPost retrieves one post of the request's blog regardless of status, for a single-post export
+	FindPost(ctx context.Context, id uuid.UUID) (*Post, error)
+
+	// StreamThemes yields every theme of the request's blog with its ordered article list, oldest first
+	StreamThemes(ctx context.Context, fn func(*Theme) error) error
+}
+
+// Post is the exported representation of a post
+type Post struct {
+	ID                 uuid.UUID  `json:"id"`
+	Title              string     `json:"title"`
+	Slug               string     `json:"slug"`
+	Content            string     `json:"-"` // Written to the Markdown file, not the metadata
+	Excerpt            string     `json:"excerpt"`
+	Status             string     `json:"status"`
+	AuthorID           uuid.UUID  `json:"authorId"`
+	AuthorName         string     `json:"authorName,omitempty"`
+	Language           string     `json:"language"`
+	TranslationGroupID *uuid.UUID `json:"translationGroupId,omitempty"`
+	Featured           bool       `json:"featured"`
+	MetaTitle          string     `json:"metaTitle,omitempty"`
+	MetaDescription    string     `json:"metaDescription,omitempty"`
+	CanonicalURL       string     `json:"canonicalUrl,omitempty"`
+	OGImageURL         string     `json:"ogImageUrl,omitempty"`
+	PublishedAt        *time.Time `json:"publishedAt,omitempty"`
+	CreatedAt          time.Time  `json:"createdAt"`
+	UpdatedAt          time.Time  `json:"updatedAt"`
+	Media              []string   `json:"media,omitempty"` // Filled in by the exporter from the content
+}
+
+// Theme is the exported representation of a theme
+type Theme struct {
+	ID          uuid.UUID      `json:"id"`
+	Name        string         `json:"name"`
+	Slug        string         `json:"slug"`
+	Description string         `json:"description"`
+	CuratorID   uuid.UUID      `json:"curatorId"`
+	Status      string         `json:"status"`
+	CreatedAt   time.Time      `json:"createdAt"`
+	UpdatedAt   time.Time      `json:"updatedAt"`
+	Articles    []ThemeArticle `json:"articles"`
+}
+
+// ThemeArticle is a post's place in an exported theme
+type ThemeArticle struct {
+	PostID   uuid.UUID `json:"postId"`
+	Position int       `json:"position"`
+	IsPinned bool      `json:"isPinned"`
+	Note     string    `json:"note,omitempty"`
+}
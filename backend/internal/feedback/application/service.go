@@ -0,0 +1,259 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"backend/internal/feedback/domain"
+	"backend/internal/feedback/ports"
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/clientip"
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/events"
+	"backend/internal/platform/logger"
+	"backend/internal/platform/ratelimit"
+	"backend/internal/platform/spam"
+	"github.com/google/uuid"
+)
+
+// Rate limit for contact form messages, per client address. Nobody signs the
+// form, so the address is all there is to stop one sender flooding the inbox.
+const (
+	FeedbackRateLimit  = 5
+	FeedbackRateWindow = time.Hour
+)
+
+// Error definitions for service operations
+var (
+	ErrFeedbackNotFound = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodeFeedbackNotFound,
+		"feedback not found",
+		http.StatusNotFound,
+	)
+
+	ErrInvalidFeedback = apperror.New(
+		apperror.CodeValidationFailed,
+		apperror.BusinessCodeFeedbackInvalid,
+		"invalid feedback",
+		http.StatusBadRequest,
+	)
+
+	ErrRateLimited = apperror.New(
+		apperror.CodeTooManyRequests,
+		apperror.BusinessCodeRateLimited,
+		"too many messages, please try again later",
+		http.StatusTooManyRequests,
+	)
+)
+
+// FeedbackService takes in contact form messages and lets administrators triage them
+type FeedbackService struct {
+	repo        ports.FeedbackRepository
+	spamChecker spam.Checker
+	authorizer  ports.Authorizer
+	eventBus    *eventbus.Bus
+	logger      logger.Logger
+	limiter     ratelimit.Limiter
+}
+
+// NewFeedbackService creates a new feedback service
+func NewFeedbackService(
+	repo ports.FeedbackRepository,
+	spamChecker spam.Checker,
+	authorizer ports.Authorizer,
+	eventBus *eventbus.Bus,
+	logger logger.Logger,
+) *FeedbackService {
+	return &FeedbackService{
+		repo:        repo,
+		spamChecker: spamChecker,
+		authorizer:  authorizer,
+		eventBus:    eventBus,
+		logger:      logger,
+		limiter:     ratelimit.NewFixedWindowLimiter(FeedbackRateLimit, FeedbackRateWindow),
+	}
+}
+
+// SubmitParams contains a contact form message and what the request tells about its sender
+type SubmitParams struct {
+	Name      string
+	Email     string
+	Subject   string
+	Message   string
+	UserAgent string
+	Referrer  string
+}
+
+// SubmitFeedback stores a message sent through the contact form and tells the
+// administrators about it. Messages judged spam are quarantined instead, and
+// the sender is not told so they cannot probe the checker.
+// Public, so no authorization is applied
+func (s *FeedbackService) SubmitFeedback(ctx context.Context, params SubmitParams) error {
+	feedback, err := domain.NewFeedback(params.Name, params.Email, params.Subject, params.Message)
+	if err != nil {
+		return ErrInvalidFeedback.WithDetails(err.Error())
+	}
+
+	// Checked after validation so rejected requests do not use up the allowance
+	ip := clientip.FromContext(ctx)
+	if ip != "" && !s.limiter.Allow(ip) {
+		s.logger.Warn(ctx, "feedback rate limit exceeded")
+		return ErrRateLimited
+	}
+
+	verdict, err := s.spamChecker.Check(ctx, spam.Content{
+		Kind:        spam.KindContact,
+		Body:        feedback.Subject + "\n" + feedback.Message,
+		AuthorName:  feedback.Name,
+		AuthorEmail: feedback.Email,
+		ClientIP:    ip,
+		UserAgent:   params.UserAgent,
+		Referrer:    params.Referrer,
+	})
+	if err != nil {
+		// An undecided check lets the message through; administrators see it anyway
+		s.logger.Warn(ctx, "spam check failed, accepting feedback", "error", err)
+	} else if verdict.Spam {
+		feedback.Quarantine(verdict.Reason)
+	}
+
+	if err := s.repo.Create(ctx, feedback); err != nil {
+		s.logger.Error(ctx, "failed to create feedback", "error", err)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to send message",
+			http.StatusInternalServerError,
+		)
+	}
+
+	if feedback.IsSpam() {
+		s.logger.Info(ctx, "feedback quarantined as spam", "feedbackID", feedback.ID, "reason", feedback.SpamReason)
+		return nil
+	}
+
+	s.eventBus.Publish(ctx, eventbus.Event{
+		Topic: events.FeedbackReceivedTopic,
+		Payload: events.FeedbackReceivedEvent{
+			FeedbackID: feedback.ID,
+			Name:       feedback.Name,
+			Subject:    feedback.Subject,
+			OccurredAt: time.Now(),
+		},
+	})
+	return nil
+}
+
+// ListFeedback returns the triage queue, or the quarantined messages when the filter asks for spam
+func (s *FeedbackService) ListFeedback(ctx context.Context, actorID uuid.UUID, filter ports.ListFilter) ([]*domain.Feedback, int, error) {
+	if err := s.checkCanTriage(ctx, actorID); err != nil {
+		return nil, 0, err
+	}
+
+	feedback, total, err := s.repo.List(ctx, filter)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list feedback", "error", err)
+		return nil, 0, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to list feedback",
+			http.StatusInternalServerError,
+		)
+	}
+	return feedback, total, nil
+}
+
+// GetFeedback retrieves a message for triage
+func (s *FeedbackService) GetFeedback(ctx context.Context, actorID uuid.UUID, id uuid.UUID) (*domain.Feedback, error) {
+	if err := s.checkCanTriage(ctx, actorID); err != nil {
+		return nil, err
+	}
+	return s.findFeedback(ctx, id)
+}
+
+// TriageFeedback moves a message to a new status
+func (s *FeedbackService) TriageFeedback(ctx context.Context, actorID uuid.UUID, id uuid.UUID, status domain.Status) (*domain.Feedback, error) {
+	if err := s.checkCanTriage(ctx, actorID); err != nil {
+		return nil, err
+	}
+
+	feedback, err := s.findFeedback(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := feedback.Triage(status); err != nil {
+		return nil, ErrInvalidFeedback.WithDetails(err.Error())
+	}
+
+	if err := s.repo.Update(ctx, feedback); err != nil {
+		if errors.Is(err, ports.ErrFeedbackNotFound) {
+			return nil, ErrFeedbackNotFound
+		}
+		s.logger.Error(ctx, "failed to update feedback", "error", err, "feedbackID", id)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to update feedback",
+			http.StatusInternalServerError,
+		)
+	}
+
+	s.eventBus.Publish(ctx, eventbus.Event{
+		Topic: events.FeedbackTriagedTopic,
+		Payload: events.FeedbackTriagedEvent{
+			FeedbackID: id,
+			ActorID:    actorID,
+			Status:     string(status),
+			OccurredAt: time.Now(),
+		},
+	})
+	return feedback, nil
+}
+
+// Private helper methods
+
+// findFeedback loads a message by ID and maps a miss to ErrFeedbackNotFound
+func (s *FeedbackService) findFeedback(ctx context.Context, id uuid.UUID) (*domain.Feedback, error) {
+	feedback, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, ports.ErrFeedbackNotFound) {
+			return nil, ErrFeedbackNotFound
+		}
+		s.logger.Error(ctx, "failed to get feedback", "error", err, "feedbackID", id)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to get feedback",
+			http.StatusInternalServerError,
+		)
+	}
+	return feedback, nil
+}
+
+// checkCanTriage verifies the actor may read and triage the blog's contact
+// form messages, which are addressed to the people running it and so need settings:blog
+func (s *FeedbackService) checkCanTriage(ctx context.Context, actorID uuid.UUID) error {
+	allowed, err := s.authorizer.Can(ctx, actorID, "settings", "blog", nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !allowed {
+		return apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to triage feedback",
+			http.StatusForbidden,
+		)
+	}
+	return nil
+}
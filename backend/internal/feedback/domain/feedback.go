@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Status is where a message stands in the administrators' triage
+type Status string
+
+const (
+	StatusNew    Status = "new"
+	StatusAck    Status = "ack"    // Read and being dealt with
+	StatusClosed Status = "closed" // Answered or needing no answer
+)
+
+// IsValid checks if the status is a known value
+func (s Status) IsValid() bool {
+	switch s {
+	case StatusNew, StatusAck, StatusClosed:
+		return true
+	default:
+		return false
+	}
+}
+
+// Business rule constants
+const (
+	MaxNameLength    = 100
+	MaxEmailLength   = 254
+	MaxSubjectLength = 150
+	MaxMessageLength = 5000
+)
+
+// Validation errors
+var (
+	ErrInvalidName    = errors.New("name is required and must not exceed 100 characters")
+	ErrInvalidEmail   = errors.New("a valid email address of at most 254 characters is required")
+	ErrInvalidSubject = errors.New("subject must not exceed 150 characters")
+	ErrInvalidMessage = errors.New("message is required and must not exceed 5000 characters")
+	ErrInvalidStatus  = errors.New("status must be new, ack or closed")
+)
+
+var emailPattern = regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
+
+// Feedback is a message a visitor sent through a blog's contact form
+type Feedback struct {
+	ID         uuid.UUID
+	Name       string
+	Email      string
+	Subject    string // Optional
+	Message    string
+	Status     Status
+	SpamReason string // Set when the spam checker quarantined the message
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// NewFeedback creates a new message with validation
+func NewFeedback(name, email, subject, message string) (*Feedback, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len([]rune(name)) > MaxNameLength {
+		return nil, ErrInvalidName
+	}
+
+	email = strings.TrimSpace(email)
+	if len(email) > MaxEmailLength || !emailPattern.MatchString(email) {
+		return nil, ErrInvalidEmail
+	}
+
+	subject = strings.TrimSpace(subject)
+	if len([]rune(subject)) > MaxSubjectLength {
+		return nil, ErrInvalidSubject
+	}
+
+	message = strings.TrimSpace(message)
+	if message == "" || len([]rune(message)) > MaxMessageLength {
+		return nil, ErrInvalidMessage
+	}
+
+	now := time.Now()
+	return &Feedback{
+		ID:        uuid.New(),
+		Name:      name,
+		Email:     email,
+		Subject:   subject,
+		Message:   message,
+		Status:    StatusNew,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// Quarantine marks the message as spam, keeping it out of the triage queue
+func (f *Feedback) Quarantine(reason string) {
+	f.SpamReason = reason
+}
+
+// IsSpam reports whether the message was quarantined as spam
+func (f *Feedback) IsSpam() bool {
+	return f.SpamReason != ""
+}
+
+// Triage moves the message to status; any status can follow any other so a
+// closed message can be reopened
+func (f *Feedback) Triage(status Status) error {
+	if !status.IsValid() {
+		return ErrInvalidStatus
+	}
+	f.Status = status
+	f.UpdatedAt = time.Now()
+	return nil
+}
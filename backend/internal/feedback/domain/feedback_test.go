@@ -0,0 +1,67 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"backend/internal/feedback/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFeedback(t *testing.T) {
+	tests := []struct {
+		name    string
+		sender  string
+		email   string
+		subject string
+		message string
+		wantErr error
+	}{
+		{name: "valid", sender: " Ada ", email: "ada@example.com", subject: " Typo ", message: "The second paragraph has a typo."},
+		{name: "no subject", sender: "Ada", email: "ada@example.com", message: "The second paragraph has a typo."},
+		{name: "blank name", sender: "  ", email: "ada@example.com", message: "Hello", wantErr: domain.ErrInvalidName},
+		{name: "bad email", sender: "Ada", email: "ada@", message: "Hello", wantErr: domain.ErrInvalidEmail},
+		{name: "long subject", sender: "Ada", email: "ada@example.com", subject: strings.Repeat("x", domain.MaxSubjectLength+1), message: "Hello", wantErr: domain.ErrInvalidSubject},
+		{name: "blank message", sender: "Ada", email: "ada@example.com", message: " ", wantErr: domain.ErrInvalidMessage},
+		{name: "long message", sender: "Ada", email: "ada@example.com", message: strings.Repeat("x", domain.MaxMessageLength+1), wantErr: domain.ErrInvalidMessage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feedback, err := domain.NewFeedback(tt.sender, tt.email, tt.subject, tt.message)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "Ada", feedback.Name)
+			assert.Equal(t, strings.TrimSpace(tt.subject), feedback.Subject)
+			assert.Equal(t, domain.StatusNew, feedback.Status)
+			assert.False(t, feedback.IsSpam())
+		})
+	}
+}
+
+func TestFeedbackTriage(t *testing.T) {
+	feedback, err := domain.NewFeedback("Ada", "ada@example.com", "", "Hello")
+	require.NoError(t, err)
+
+	require.NoError(t, feedback.Triage(domain.StatusClosed))
+	assert.Equal(t, domain.StatusClosed, feedback.Status)
+
+	require.NoError(t, feedback.Triage(domain.StatusNew), "closed messages can be reopened")
+	assert.Equal(t, domain.StatusNew, feedback.Status)
+
+	assert.ErrorIs(t, feedback.Triage("spam"), domain.ErrInvalidStatus)
+}
+
+func TestFeedbackQuarantine(t *testing.T) {
+	feedback, err := domain.NewFeedback("Ada", "ada@example.com", "", "Hello")
+	require.NoError(t, err)
+
+	feedback.Quarantine("contains 5 links")
+	assert.True(t, feedback.IsSpam())
+	assert.Equal(t, "contains 5 links", feedback.SpamReason)
+}
@@ -0,0 +1,39 @@
+package ports
+
+import (
+	"context"
+	"errors"
+
+	"backend/internal/feedback/domain"
+	"github.com/google/uuid"
+)
+
+// Repository errors (canonical errors for the repository contract)
+var (
+	// ErrFeedbackNotFound is returned when no message matches the lookup
+	ErrFeedbackNotFound = errors.New("feedback not found")
+)
+
+// FeedbackRepository defines the contract for feedback persistence
+// Messages belong to the request's blog
+type FeedbackRepository interface {
+	// Create stores a new message
+	Create(ctx context.Context, feedback *domain.Feedback) error
+
+	// Update saves a message's status
+	Update(ctx context.Context, feedback *domain.Feedback) error
+
+	// FindByID retrieves a message by its ID
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.Feedback, error)
+
+	// List retrieves messages matching the filter, newest first, with the total number of matches
+	List(ctx context.Context, filter ListFilter) ([]*domain.Feedback, int, error)
+}
+
+// ListFilter selects messages for the triage queue
+type ListFilter struct {
+	Status *domain.Status // Nil lists every status
+	Spam   bool           // Lists the quarantined messages instead of the others
+	Limit  int
+	Offset int
+}
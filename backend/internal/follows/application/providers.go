@@ -0,0 +1,10 @@
+package application
+
+import "github.com/google/wire"
+
+// ProviderSet is the wire provider set for the follows application layer
+var ProviderSet = wire.NewSet(
+	NewFollowsService,
+	NewUserAdapter,
+	wire.Bind(new(UserProvider), new(*UserAdapter)),
+)
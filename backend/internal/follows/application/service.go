@@ -0,0 +1,245 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"backend/internal/follows/domain"
+	"backend/internal/follows/ports"
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/events"
+	"backend/internal/platform/logger"
+	"github.com/google/uuid"
+)
+
+// Error definitions for service operations
+var (
+	ErrNotFollowing = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodeNotFollowing,
+		"not following this user",
+		http.StatusNotFound,
+	)
+
+	ErrCannotFollowSelf = apperror.New(
+		apperror.CodeValidationFailed,
+		apperror.BusinessCodeCannotFollowSelf,
+		"users cannot follow themselves",
+		http.StatusBadRequest,
+	)
+)
+
+// UserProvider verifies that a user exists
+// This avoids direct dependency on the users bounded context
+type UserProvider interface {
+	EnsureExists(ctx context.Context, userID uuid.UUID) error
+}
+
+// FollowsService handles author-following business logic
+type FollowsService struct {
+	repo         ports.FollowRepository
+	userProvider UserProvider
+	authorizer   ports.Authorizer
+	eventBus     *eventbus.Bus
+	logger       logger.Logger
+}
+
+// NewFollowsService creates a new follows service
+func NewFollowsService(
+	repo ports.FollowRepository,
+	userProvider UserProvider,
+	authorizer ports.Authorizer,
+	eventBus *eventbus.Bus,
+	logger logger.Logger,
+) *FollowsService {
+	return &FollowsService{
+		repo:         repo,
+		userProvider: userProvider,
+		authorizer:   authorizer,
+		eventBus:     eventBus,
+		logger:       logger,
+	}
+}
+
+// Follow makes the actor follow a user
+// Following a user twice is not an error
+func (s *FollowsService) Follow(ctx context.Context, actorID uuid.UUID, userID uuid.UUID) (*ports.FollowStats, error) {
+	if err := s.checkCanManage(ctx, actorID); err != nil {
+		return nil, err
+	}
+
+	follow, err := domain.NewFollow(actorID, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrCannotFollowSelf) {
+			return nil, ErrCannotFollowSelf
+		}
+		return nil, apperror.New(
+			apperror.CodeValidationFailed,
+			apperror.BusinessCodeGeneral,
+			err.Error(),
+			http.StatusBadRequest,
+		)
+	}
+
+	if err := s.userProvider.EnsureExists(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Save(ctx, follow); err != nil {
+		s.logger.Error(ctx, "failed to save follow", "error", err, "actorID", actorID, "userID", userID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to follow user",
+			http.StatusInternalServerError,
+		)
+	}
+
+	// Publish event
+	s.publishUserFollowedEvent(ctx, follow)
+
+	return s.GetFollowStats(ctx, userID, &actorID)
+}
+
+// Unfollow makes the actor stop following a user
+func (s *FollowsService) Unfollow(ctx context.Context, actorID uuid.UUID, userID uuid.UUID) (*ports.FollowStats, error) {
+	if err := s.checkCanManage(ctx, actorID); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Delete(ctx, actorID, userID); err != nil {
+		if errors.Is(err, ports.ErrFollowNotFound) {
+			return nil, ErrNotFollowing
+		}
+		s.logger.Error(ctx, "failed to delete follow", "error", err, "actorID", actorID, "userID", userID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to unfollow user",
+			http.StatusInternalServerError,
+		)
+	}
+
+	// Publish event
+	s.publishUserUnfollowedEvent(ctx, actorID, userID)
+
+	return s.GetFollowStats(ctx, userID, &actorID)
+}
+
+// GetFollowStats returns follower and following counts for a user
+// When viewerID is set, the result also reports whether the viewer follows the user
+func (s *FollowsService) GetFollowStats(ctx context.Context, userID uuid.UUID, viewerID *uuid.UUID) (*ports.FollowStats, error) {
+	followers, err := s.repo.CountFollowers(ctx, userID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to count followers", "error", err, "userID", userID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to count followers",
+			http.StatusInternalServerError,
+		)
+	}
+
+	following, err := s.repo.CountFollowing(ctx, userID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to count followed users", "error", err, "userID", userID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to count followed users",
+			http.StatusInternalServerError,
+		)
+	}
+
+	stats := &ports.FollowStats{
+		UserID:         userID,
+		FollowerCount:  followers,
+		FollowingCount: following,
+	}
+
+	if viewerID != nil && *viewerID != userID {
+		isFollowing, err := s.repo.Exists(ctx, *viewerID, userID)
+		if err != nil {
+			s.logger.Error(ctx, "failed to check follow", "error", err, "viewerID", *viewerID, "userID", userID)
+			return nil, apperror.New(
+				apperror.CodeInternalError,
+				apperror.BusinessCodeGeneral,
+				"failed to check follow",
+				http.StatusInternalServerError,
+			)
+		}
+		stats.Following = &isFollowing
+	}
+
+	return stats, nil
+}
+
+// ListFollowerIDs returns the IDs of every follower of a user
+// Used by other contexts (e.g. notifications) to fan out events
+func (s *FollowsService) ListFollowerIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	followerIDs, err := s.repo.ListFollowerIDs(ctx, userID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list followers", "error", err, "userID", userID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to list followers",
+			http.StatusInternalServerError,
+		)
+	}
+	return followerIDs, nil
+}
+
+// Private helper methods
+
+// checkCanManage verifies the actor may follow and unfollow users
+func (s *FollowsService) checkCanManage(ctx context.Context, actorID uuid.UUID) error {
+	canManage, err := s.authorizer.Can(ctx, actorID, "follows", "manage", nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canManage {
+		return apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to follow users",
+			http.StatusForbidden,
+		)
+	}
+	return nil
+}
+
+// Event publishing methods
+
+func (s *FollowsService) publishUserFollowedEvent(ctx context.Context, follow *domain.Follow) {
+	event := eventbus.Event{
+		Topic: events.UserFollowedTopic,
+		Payload: events.UserFollowedEvent{
+			FollowerID: follow.FollowerID,
+			FolloweeID: follow.FolloweeID,
+			OccurredAt: time.Now(),
+		},
+	}
+	s.eventBus.Publish(ctx, event)
+}
+
+func (s *FollowsService) publishUserUnfollowedEvent(ctx context.Context, followerID, followeeID uuid.UUID) {
+	event := eventbus.Event{
+		Topic: events.UserUnfollowedTopic,
+		Payload: events.UserUnfollowedEvent{
+			FollowerID: followerID,
+			FolloweeID: followeeID,
+			OccurredAt: time.Now(),
+		},
+	}
+	s.eventBus.Publish(ctx, event)
+}
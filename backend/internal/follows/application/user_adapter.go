@@ -0,0 +1,28 @@
+package application
+
+import (
+	"context"
+
+	usersApp "backend/internal/users/application"
+	"github.com/google/uuid"
+)
+
+// UserAdapter implements the UserProvider interface
+// It adapts the users service so the follows context can validate authors
+type UserAdapter struct {
+	userService *usersApp.UserService
+}
+
+// NewUserAdapter creates a new user adapter
+func NewUserAdapter(userService *usersApp.UserService) *UserAdapter {
+	return &UserAdapter{
+		userService: userService,
+	}
+}
+
+// EnsureExists returns an error unless the user exists
+func (a *UserAdapter) EnsureExists(ctx context.Context, userID uuid.UUID) error {
+	// Pass through the AppError from the users service unchanged
+	_, err := a.userService.GetUserByID(ctx, userID.String())
+	return err
+}
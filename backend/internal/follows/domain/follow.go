@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Follow records that one user follows an author
+type Follow struct {
+	FollowerID uuid.UUID
+	FolloweeID uuid.UUID
+	CreatedAt  time.Time
+}
+
+// Validation errors
+var (
+	ErrInvalidFollowerID = errors.New("follower ID is required")
+	ErrInvalidFolloweeID = errors.New("followee ID is required")
+	ErrCannotFollowSelf  = errors.New("users cannot follow themselves")
+)
+
+// NewFollow creates a new follow relationship with validation
+func NewFollow(followerID, followeeID uuid.UUID) (*Follow, error) {
+	if followerID == uuid.Nil {
+		return nil, ErrInvalidFollowerID
+	}
+
+	if followeeID == uuid.Nil {
+		return nil, ErrInvalidFolloweeID
+	}
+
+	if followerID == followeeID {
+		return nil, ErrCannotFollowSelf
+	}
+
+	return &Follow{
+		FollowerID: followerID,
+		FolloweeID: followeeID,
+		CreatedAt:  time.Now(),
+	}, nil
+}
@@ -0,0 +1,30 @@
+package domain_test
+
+import (
+	"testing"
+
+	"backend/internal/follows/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFollow(t *testing.T) {
+	followerID := uuid.New()
+	followeeID := uuid.New()
+
+	follow, err := domain.NewFollow(followerID, followeeID)
+	require.NoError(t, err)
+	assert.Equal(t, followerID, follow.FollowerID)
+	assert.Equal(t, followeeID, follow.FolloweeID)
+	assert.False(t, follow.CreatedAt.IsZero())
+
+	_, err = domain.NewFollow(followerID, followerID)
+	assert.ErrorIs(t, err, domain.ErrCannotFollowSelf)
+
+	_, err = domain.NewFollow(uuid.Nil, followeeID)
+	assert.ErrorIs(t, err, domain.ErrInvalidFollowerID)
+
+	_, err = domain.NewFollow(followerID, uuid.Nil)
+	assert.ErrorIs(t, err, domain.ErrInvalidFolloweeID)
+}
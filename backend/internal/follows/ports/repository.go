@@ -0,0 +1,44 @@
+package ports
+
+import (
+	"context"
+	"errors"
+
+	"backend/internal/follows/domain"
+	"github.com/google/uuid"
+)
+
+// Repository errors (canonical errors for the repository contract)
+var (
+	// ErrFollowNotFound is returned when a user does not follow an author
+	ErrFollowNotFound = errors.New("follow not found")
+)
+
+// FollowRepository defines the contract for follow persistence
+type FollowRepository interface {
+	// Save stores the follow; saving an existing follow is a no-op
+	Save(ctx context.Context, follow *domain.Follow) error
+
+	// Delete removes a follow relationship
+	Delete(ctx context.Context, followerID, followeeID uuid.UUID) error
+
+	// Exists reports whether followerID follows followeeID
+	Exists(ctx context.Context, followerID, followeeID uuid.UUID) (bool, error)
+
+	// CountFollowers returns how many users follow the user
+	CountFollowers(ctx context.Context, userID uuid.UUID) (int, error)
+
+	// CountFollowing returns how many users the user follows
+	CountFollowing(ctx context.Context, userID uuid.UUID) (int, error)
+
+	// ListFollowerIDs returns the IDs of every follower of the user
+	ListFollowerIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+}
+
+// FollowStats summarizes a user's follow graph
+type FollowStats struct {
+	UserID         uuid.UUID
+	FollowerCount  int
+	FollowingCount int
+	Following      *bool // Whether the viewer follows the user; nil when the viewer is unknown
+}
@@ -0,0 +1,34 @@
+package application
+
+import (
+	"context"
+
+	analyticsApp "backend/internal/analytics/application"
+)
+
+// AnalyticsAdapter implements the ViewRanker interface
+// It adapts the analytics service's trending ranking to the home page
+type AnalyticsAdapter struct {
+	analyticsService *analyticsApp.AnalyticsService
+}
+
+// NewAnalyticsAdapter creates a new analytics adapter
+func NewAnalyticsAdapter(analyticsService *analyticsApp.AnalyticsService) *AnalyticsAdapter {
+	return &AnalyticsAdapter{
+		analyticsService: analyticsService,
+	}
+}
+
+// TrendingPosts ranks the posts most viewed recently
+func (a *AnalyticsAdapter) TrendingPosts(ctx context.Context, limit int) ([]PostViews, error) {
+	trending, err := a.analyticsService.TrendingPosts(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	ranked := make([]PostViews, len(trending))
+	for i, entry := range trending {
+		ranked[i] = PostViews{PostID: entry.PostID, Views: entry.Views}
+	}
+	return ranked, nil
+}
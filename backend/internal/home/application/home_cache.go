@@ -0,0 +1,95 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend/internal/platform/cache"
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/events"
+	"backend/internal/platform/logger"
+	"backend/internal/platform/tenant"
+)
+
+// Section names a separately cached part of the home page
+type Section string
+
+const (
+	SectionFeatured Section = "featured"
+	SectionLatest   Section = "latest"
+	SectionTrending Section = "trending"
+)
+
+// HomeCache is a read-through cache for the post sections of each blog's home page.
+// Featured and latest posts are dropped whenever the published posts read model
+// they are listed from changes. The trending ranking is dropped too, since it may
+// name a post readers no longer see, but otherwise only expires: every view
+// moves it, and ranking it again on each one would defeat the cache.
+type HomeCache struct {
+	cache       cache.Cache
+	ttl         time.Duration
+	trendingTTL time.Duration
+	logger      logger.Logger
+}
+
+// NewHomeCache creates a new home page cache
+func NewHomeCache(c cache.Cache, cfg cache.Config, logger logger.Logger) *HomeCache {
+	return &HomeCache{
+		cache:       c,
+		ttl:         cfg.HomeTTL,
+		trendingTTL: cfg.TrendingTTL,
+		logger:      logger,
+	}
+}
+
+// Subscribe registers the invalidation handlers on the bus
+func (c *HomeCache) Subscribe(bus *eventbus.Bus) {
+	bus.Subscribe(events.PublishedPostRefreshedTopic, c.handlePostsChanged)
+}
+
+// Load decodes the cached section into dst, which must point to where fill
+// stores the section. On a miss fill loads it and the result is cached.
+// Cache failures are logged and treated as misses.
+func (c *HomeCache) Load(ctx context.Context, section Section, dst any, fill func() error) error {
+	key := sectionKey(ctx, section)
+	found, err := cache.GetJSON(ctx, c.cache, key, dst)
+	if err != nil {
+		c.logger.Warn(ctx, "failed to read home page cache", "error", err, "key", key)
+	}
+	if found {
+		return nil
+	}
+
+	if err := fill(); err != nil {
+		return err
+	}
+
+	ttl := c.ttl
+	if section == SectionTrending {
+		ttl = c.trendingTTL
+	}
+	if err := cache.SetJSON(ctx, c.cache, key, dst, ttl); err != nil {
+		c.logger.Warn(ctx, "failed to write home page cache", "error", err, "key", key)
+	}
+	return nil
+}
+
+// handlePostsChanged drops the current blog's post sections
+// Detached from the publisher's request so it is not cut short
+func (c *HomeCache) handlePostsChanged(ctx context.Context, event eventbus.Event) error {
+	keys := []string{
+		sectionKey(ctx, SectionFeatured),
+		sectionKey(ctx, SectionLatest),
+		sectionKey(ctx, SectionTrending),
+	}
+	if err := c.cache.Delete(context.WithoutCancel(ctx), keys...); err != nil {
+		return fmt.Errorf("HomeCache.handlePostsChanged: %w", err)
+	}
+	return nil
+}
+
+// sectionKey is the key of a section of the current blog's home page
+func sectionKey(ctx context.Context, section Section) string {
+	return fmt.Sprintf("home:%s:%s", tenant.BlogID(ctx), section)
+}
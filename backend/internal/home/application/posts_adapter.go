@@ -0,0 +1,69 @@
+package application
+
+import (
+	"context"
+
+	postsApp "backend/internal/posts/application"
+	postsPorts "backend/internal/posts/ports"
+	"github.com/google/uuid"
+)
+
+// PostsAdapter implements the PostLister interface
+// It adapts the posts service's anonymous listings to the home page
+type PostsAdapter struct {
+	postsService *postsApp.PostsService
+}
+
+// NewPostsAdapter creates a new posts adapter
+func NewPostsAdapter(postsService *postsApp.PostsService) *PostsAdapter {
+	return &PostsAdapter{
+		postsService: postsService,
+	}
+}
+
+// FeaturedPosts lists the most recently featured posts
+func (a *PostsAdapter) FeaturedPosts(ctx context.Context, limit int) ([]*Post, error) {
+	featured := true
+	return a.list(ctx, postsPorts.ListFilter{
+		Featured:  &featured,
+		OrderBy:   postsPorts.OrderByFeaturedAt,
+		OrderDesc: true,
+		Limit:     limit,
+	})
+}
+
+// LatestPosts lists the most recently published posts
+func (a *PostsAdapter) LatestPosts(ctx context.Context, limit int) ([]*Post, error) {
+	return a.list(ctx, postsPorts.ListFilter{
+		OrderBy:   postsPorts.OrderByPublishedAt,
+		OrderDesc: true,
+		Limit:     limit,
+	})
+}
+
+// PostsByIDs lists the posts among ids that anonymous readers see
+func (a *PostsAdapter) PostsByIDs(ctx context.Context, ids []uuid.UUID) ([]*Post, error) {
+	return a.list(ctx, postsPorts.ListFilter{IDs: ids, Limit: len(ids)})
+}
+
+// list runs an anonymous listing, so only posts everyone may read are returned
+func (a *PostsAdapter) list(ctx context.Context, filter postsPorts.ListFilter) ([]*Post, error) {
+	summaries, _, err := a.postsService.ListPosts(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	posts := make([]*Post, len(summaries))
+	for i, summary := range summaries {
+		posts[i] = &Post{
+			ID:          summary.ID,
+			Title:       summary.Title,
+			Slug:        summary.Slug,
+			Excerpt:     summary.Excerpt,
+			AuthorID:    summary.AuthorID,
+			AuthorName:  summary.AuthorName,
+			PublishedAt: summary.PublishedAt,
+		}
+	}
+	return posts, nil
+}
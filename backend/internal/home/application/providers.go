@@ -0,0 +1,15 @@
+package application
+
+import "github.com/google/wire"
+
+// ProviderSet is the wire provider set for the home application layer
+var ProviderSet = wire.NewSet(
+	NewHomeService,
+	NewHomeCache,
+	NewPostsAdapter,
+	NewThemesAdapter,
+	NewAnalyticsAdapter,
+	wire.Bind(new(PostLister), new(*PostsAdapter)),
+	wire.Bind(new(ThemeLister), new(*ThemesAdapter)),
+	wire.Bind(new(ViewRanker), new(*AnalyticsAdapter)),
+)
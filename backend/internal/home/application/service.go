@@ -0,0 +1,194 @@
+package application
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"backend/internal/platform/logger"
+	"github.com/google/uuid"
+)
+
+// Section sizes of the home page
+const (
+	FeaturedPostsLimit = 3
+	LatestPostsLimit   = 10
+	ActiveThemesLimit  = 6
+	TrendingPostsLimit = 5
+)
+
+// Post is a post as the home page lists it
+type Post struct {
+	ID          uuid.UUID
+	Title       string
+	Slug        string
+	Excerpt     string
+	AuthorID    uuid.UUID
+	AuthorName  string
+	PublishedAt *time.Time
+	Views       int // Recent views; only set in the trending section
+}
+
+// Theme is an active theme as the home page lists it
+type Theme struct {
+	ID           uuid.UUID
+	Name         string
+	Slug         string
+	Description  string
+	CuratorID    uuid.UUID
+	CuratorName  string
+	ArticleCount int
+	CreatedAt    time.Time
+}
+
+// PostViews counts the recent views of a post
+type PostViews struct {
+	PostID uuid.UUID
+	Views  int
+}
+
+// Home is the content of the home page, one field per section
+type Home struct {
+	Featured []*Post
+	Latest   []*Post
+	Themes   []*Theme
+	Trending []*Post // Most viewed first; empty when the ranking is unavailable
+}
+
+// PostLister is an interface to list the posts anonymous readers see
+// This avoids direct dependency on the posts bounded context
+type PostLister interface {
+	FeaturedPosts(ctx context.Context, limit int) ([]*Post, error)
+	LatestPosts(ctx context.Context, limit int) ([]*Post, error)
+
+	// PostsByIDs returns the posts among ids readers see, in no particular order
+	PostsByIDs(ctx context.Context, ids []uuid.UUID) ([]*Post, error)
+}
+
+// ThemeLister is an interface to list active themes
+// This avoids direct dependency on the themes bounded context
+type ThemeLister interface {
+	ActiveThemes(ctx context.Context, limit int) ([]*Theme, error)
+}
+
+// ViewRanker is an interface to rank posts by their recent views
+// This avoids direct dependency on the analytics bounded context
+type ViewRanker interface {
+	TrendingPosts(ctx context.Context, limit int) ([]PostViews, error)
+}
+
+// HomeService composes the home page from the other contexts, so the landing
+// page renders with a single round trip. Sections load in parallel and are
+// cached separately: the post sections in HomeCache, the themes by the themes
+// context's own listing cache.
+type HomeService struct {
+	posts  PostLister
+	themes ThemeLister
+	views  ViewRanker
+	cache  *HomeCache
+	logger logger.Logger
+}
+
+// NewHomeService creates a new home service
+func NewHomeService(
+	posts PostLister,
+	themes ThemeLister,
+	views ViewRanker,
+	cache *HomeCache,
+	logger logger.Logger,
+) *HomeService {
+	return &HomeService{
+		posts:  posts,
+		themes: themes,
+		views:  views,
+		cache:  cache,
+		logger: logger,
+	}
+}
+
+// GetHome returns the home page of the current blog as anonymous readers see it.
+// Trending posts are an extra: when they cannot be ranked the section is left
+// empty, while any other section failing fails the page.
+// Public, so no authorization is applied
+func (s *HomeService) GetHome(ctx context.Context) (*Home, error) {
+	home := &Home{}
+
+	var (
+		wg                     sync.WaitGroup
+		featuredErr, latestErr error
+		themesErr, trendingErr error
+	)
+	wg.Add(4)
+	go func() {
+		defer wg.Done()
+		featuredErr = s.cache.Load(ctx, SectionFeatured, &home.Featured, func() (err error) {
+			home.Featured, err = s.posts.FeaturedPosts(ctx, FeaturedPostsLimit)
+			return err
+		})
+	}()
+	go func() {
+		defer wg.Done()
+		latestErr = s.cache.Load(ctx, SectionLatest, &home.Latest, func() (err error) {
+			home.Latest, err = s.posts.LatestPosts(ctx, LatestPostsLimit)
+			return err
+		})
+	}()
+	go func() {
+		defer wg.Done()
+		home.Themes, themesErr = s.themes.ActiveThemes(ctx, ActiveThemesLimit)
+	}()
+	go func() {
+		defer wg.Done()
+		trendingErr = s.cache.Load(ctx, SectionTrending, &home.Trending, func() (err error) {
+			home.Trending, err = s.trendingPosts(ctx)
+			return err
+		})
+	}()
+	wg.Wait()
+
+	for _, err := range []error{featuredErr, latestErr, themesErr} {
+		if err != nil {
+			return nil, err
+		}
+	}
+	if trendingErr != nil {
+		s.logger.Warn(ctx, "failed to load trending posts for home page", "error", trendingErr)
+		home.Trending = nil
+	}
+
+	return home, nil
+}
+
+// Private helper methods
+
+// trendingPosts loads the most viewed posts, in ranking order
+func (s *HomeService) trendingPosts(ctx context.Context) ([]*Post, error) {
+	ranked, err := s.views.TrendingPosts(ctx, TrendingPostsLimit)
+	if err != nil || len(ranked) == 0 {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(ranked))
+	for i, entry := range ranked {
+		ids[i] = entry.PostID
+	}
+	posts, err := s.posts.PostsByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uuid.UUID]*Post, len(posts))
+	for _, post := range posts {
+		byID[post.ID] = post
+	}
+
+	// A post ranked but no longer listed, e.g. unpublished since, is skipped
+	trending := make([]*Post, 0, len(ranked))
+	for _, entry := range ranked {
+		if post, ok := byID[entry.PostID]; ok {
+			post.Views = entry.Views
+			trending = append(trending, post)
+		}
+	}
+	return trending, nil
+}
@@ -0,0 +1,48 @@
+package application
+
+import (
+	"context"
+
+	themesApp "backend/internal/themes/application"
+	themesDomain "backend/internal/themes/domain"
+	themesPorts "backend/internal/themes/ports"
+)
+
+// ThemesAdapter implements the ThemeLister interface
+// It adapts the themes service's public listing to the home page
+type ThemesAdapter struct {
+	themesService *themesApp.ThemesService
+}
+
+// NewThemesAdapter creates a new themes adapter
+func NewThemesAdapter(themesService *themesApp.ThemesService) *ThemesAdapter {
+	return &ThemesAdapter{
+		themesService: themesService,
+	}
+}
+
+// ActiveThemes lists the newest active themes, from the themes service's listing cache when it can
+func (a *ThemesAdapter) ActiveThemes(ctx context.Context, limit int) ([]*Theme, error) {
+	summaries, _, err := a.themesService.ListThemes(ctx, themesPorts.ListFilter{
+		Statuses: []themesDomain.ThemeStatus{themesDomain.ThemeStatusActive},
+		Limit:    limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	themes := make([]*Theme, len(summaries))
+	for i, summary := range summaries {
+		themes[i] = &Theme{
+			ID:           summary.ID,
+			Name:         summary.Name,
+			Slug:         summary.Slug,
+			Description:  summary.Description,
+			CuratorID:    summary.CuratorID,
+			CuratorName:  summary.CuratorName,
+			ArticleCount: summary.ArticleCount,
+			CreatedAt:    summary.CreatedAt,
+		}
+	}
+	return themes, nil
+}
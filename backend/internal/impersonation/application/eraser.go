@@ -0,0 +1,30 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"backend/internal/impersonation/ports"
+	"backend/internal/platform/erasure"
+)
+
+// AuditEraser pseudonymizes the impersonation audit trail. The trail itself is
+// kept, since it records what staff did; only what names the user is removed.
+type AuditEraser struct {
+	repo ports.SessionRepository
+}
+
+// NewAuditEraser creates a new impersonation audit eraser
+func NewAuditEraser(repo ports.SessionRepository) *AuditEraser {
+	return &AuditEraser{repo: repo}
+}
+
+// Erase pseudonymizes the sessions and requests the subject took part in
+// Implements the erasure.Eraser interface
+func (e *AuditEraser) Erase(ctx context.Context, subject erasure.Subject) (int, error) {
+	n, err := e.repo.Pseudonymize(ctx, subject.UserID)
+	if err != nil {
+		return 0, fmt.Errorf("AuditEraser.Erase: %w", err)
+	}
+	return n, nil
+}
@@ -0,0 +1,56 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend/internal/impersonation/ports"
+	"github.com/google/uuid"
+)
+
+// ExportedAction is a request recorded in the impersonation audit trail, as
+// written to the data export of a user who made it or had it made as them
+type ExportedAction struct {
+	SessionID      uuid.UUID `json:"sessionId"`
+	ImpersonatorID uuid.UUID `json:"impersonatorId"`
+	TargetID       uuid.UUID `json:"targetId"`
+	Method         string    `json:"method"`
+	Path           string    `json:"path"`
+	Status         int       `json:"status"`
+	OccurredAt     time.Time `json:"occurredAt"`
+}
+
+// AuditExporter exports the impersonation audit entries concerning a user
+type AuditExporter struct {
+	repo ports.SessionRepository
+}
+
+// NewAuditExporter creates a new impersonation audit exporter
+func NewAuditExporter(repo ports.SessionRepository) *AuditExporter {
+	return &AuditExporter{repo: repo}
+}
+
+// Export returns the requests the user made while impersonating someone and
+// those staff made while impersonating the user, oldest first
+// Implements the dataexport.Exporter interface
+func (e *AuditExporter) Export(ctx context.Context, userID uuid.UUID) (any, error) {
+	actions, err := e.repo.ListActionsByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("AuditExporter.Export: %w", err)
+	}
+
+	exported := make([]ExportedAction, 0, len(actions))
+	for _, action := range actions {
+		exported = append(exported, ExportedAction{
+			SessionID:      action.SessionID,
+			ImpersonatorID: action.ImpersonatorID,
+			TargetID:       action.TargetID,
+			Method:         action.Method,
+			Path:           action.Path,
+			Status:         action.Status,
+			OccurredAt:     action.OccurredAt,
+		})
+	}
+	return exported, nil
+}
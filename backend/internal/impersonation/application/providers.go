@@ -0,0 +1,10 @@
+package application
+
+import "github.com/google/wire"
+
+// ProviderSet is the wire provider set for the impersonation application layer
+var ProviderSet = wire.NewSet(
+	NewSessionService,
+	NewAuditEraser,
+	NewAuditExporter,
+)
@@ -0,0 +1,301 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"backend/internal/impersonation/domain"
+	"backend/internal/impersonation/ports"
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/logger"
+	"github.com/google/uuid"
+)
+
+// Error definitions for service operations
+var (
+	ErrSessionNotFound = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodeImpersonationNotFound,
+		"impersonation session not found",
+		http.StatusNotFound,
+	)
+
+	ErrUserNotFound = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodeUserNotFound,
+		"user not found",
+		http.StatusNotFound,
+	)
+
+	ErrInvalidSession = apperror.New(
+		apperror.CodeValidationFailed,
+		apperror.BusinessCodeInvalidFormat,
+		"invalid impersonation session",
+		http.StatusBadRequest,
+	)
+
+	ErrInvalidToken = apperror.New(
+		apperror.CodeUnauthorized,
+		apperror.BusinessCodeInvalidImpersonationToken,
+		"invalid, expired or ended impersonation token",
+		http.StatusUnauthorized,
+	)
+
+	ErrTargetNotImpersonable = apperror.New(
+		apperror.CodeForbidden,
+		apperror.BusinessCodePermissionDenied,
+		"users who may impersonate others cannot be impersonated",
+		http.StatusForbidden,
+	)
+)
+
+// SessionConfig bounds how long an impersonation session lasts
+type SessionConfig struct {
+	TTL time.Duration
+}
+
+// SessionService lets staff act as another user through short-lived
+// sessions, and keeps an audit trail of every request made that way
+type SessionService struct {
+	repo       ports.SessionRepository
+	authorizer ports.Authorizer
+	config     SessionConfig
+	logger     logger.Logger
+}
+
+// NewSessionService creates a new impersonation session service
+func NewSessionService(
+	repo ports.SessionRepository,
+	authorizer ports.Authorizer,
+	config SessionConfig,
+	logger logger.Logger,
+) *SessionService {
+	return &SessionService{
+		repo:       repo,
+		authorizer: authorizer,
+		config:     config,
+		logger:     logger,
+	}
+}
+
+// StartSession lets the actor act as the target until the session expires.
+// It returns the session with its token, which is not retrievable afterwards.
+// Users who may impersonate are refused as targets, so sessions cannot be
+// chained to borrow another member of staff's standing.
+func (s *SessionService) StartSession(ctx context.Context, actorID uuid.UUID, targetID uuid.UUID, reason string) (*domain.Session, string, error) {
+	if err := s.checkCanImpersonate(ctx, actorID); err != nil {
+		return nil, "", err
+	}
+
+	session, token, err := domain.NewSession(actorID, targetID, reason, s.config.TTL)
+	if err != nil {
+		return nil, "", ErrInvalidSession.WithDetails(err.Error())
+	}
+
+	targetIsStaff, err := s.authorizer.Can(ctx, targetID, "authz", "impersonate", nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "targetID", targetID)
+		return nil, "", apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if targetIsStaff {
+		return nil, "", ErrTargetNotImpersonable
+	}
+
+	if err := s.repo.Create(ctx, session); err != nil {
+		if errors.Is(err, ports.ErrUserNotFound) {
+			return nil, "", ErrUserNotFound
+		}
+		s.logger.Error(ctx, "failed to create impersonation session", "error", err, "actorID", actorID)
+		return nil, "", apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to start impersonation session",
+			http.StatusInternalServerError,
+		)
+	}
+
+	s.logger.Info(ctx, "impersonation session started",
+		"sessionID", session.ID,
+		"impersonatorID", actorID,
+		"targetID", targetID,
+		"expiresAt", session.ExpiresAt,
+	)
+	return session, token, nil
+}
+
+// EndSession closes one of the actor's sessions early; ending it twice is not an error
+func (s *SessionService) EndSession(ctx context.Context, actorID uuid.UUID, sessionID uuid.UUID) (*domain.Session, error) {
+	if err := s.checkCanImpersonate(ctx, actorID); err != nil {
+		return nil, err
+	}
+
+	session, err := s.getSession(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session.ImpersonatorID != actorID {
+		return nil, ErrSessionNotFound
+	}
+	if session.EndedAt != nil {
+		return session, nil
+	}
+
+	if err := session.End(); err != nil {
+		return nil, ErrInvalidSession.WithDetails(err.Error())
+	}
+	if err := s.repo.End(ctx, session); err != nil {
+		s.logger.Error(ctx, "failed to end impersonation session", "error", err, "sessionID", sessionID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to end impersonation session",
+			http.StatusInternalServerError,
+		)
+	}
+
+	s.logger.Info(ctx, "impersonation session ended", "sessionID", sessionID, "impersonatorID", actorID)
+	return session, nil
+}
+
+// Resolve returns the active session a token opens for the user really
+// making the request. The impersonator must still hold the permission, so
+// revoking it stops sessions already under way.
+func (s *SessionService) Resolve(ctx context.Context, realUserID uuid.UUID, token string) (*domain.Session, error) {
+	session, err := s.repo.FindByTokenHash(ctx, domain.HashToken(token))
+	if err != nil {
+		if errors.Is(err, ports.ErrSessionNotFound) {
+			return nil, ErrInvalidToken
+		}
+		s.logger.Error(ctx, "failed to find impersonation session by token", "error", err)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to verify impersonation token",
+			http.StatusInternalServerError,
+		)
+	}
+	if session.ImpersonatorID != realUserID || !session.Active(time.Now()) {
+		return nil, ErrInvalidToken
+	}
+
+	if err := s.checkCanImpersonate(ctx, realUserID); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// RecordAction appends a request made through a session to its audit trail
+func (s *SessionService) RecordAction(ctx context.Context, action *domain.Action) error {
+	if err := s.repo.RecordAction(ctx, action); err != nil {
+		s.logger.Error(ctx, "failed to record impersonated action",
+			"error", err,
+			"sessionID", action.SessionID,
+			"method", action.Method,
+			"path", action.Path,
+		)
+		return err
+	}
+	return nil
+}
+
+// CompleteAction stores the response status of a recorded action
+func (s *SessionService) CompleteAction(ctx context.Context, action *domain.Action) error {
+	if err := s.repo.CompleteAction(ctx, action); err != nil {
+		s.logger.Error(ctx, "failed to complete impersonated action",
+			"error", err,
+			"sessionID", action.SessionID,
+			"actionID", action.ID,
+			"status", action.Status,
+		)
+		return err
+	}
+	return nil
+}
+
+// ListActions retrieves the audit trail of a session, oldest first
+func (s *SessionService) ListActions(ctx context.Context, actorID uuid.UUID, sessionID uuid.UUID) ([]*domain.Action, error) {
+	canView, err := s.authorizer.Can(ctx, actorID, "authz:audit", "view", nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canView {
+		return nil, apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to view audit logs",
+			http.StatusForbidden,
+		)
+	}
+
+	if _, err := s.getSession(ctx, sessionID); err != nil {
+		return nil, err
+	}
+
+	actions, err := s.repo.ListActions(ctx, sessionID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list impersonated actions", "error", err, "sessionID", sessionID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to retrieve impersonation audit trail",
+			http.StatusInternalServerError,
+		)
+	}
+	return actions, nil
+}
+
+// Private helper methods
+
+// getSession loads a session of the current blog
+func (s *SessionService) getSession(ctx context.Context, sessionID uuid.UUID) (*domain.Session, error) {
+	session, err := s.repo.FindByID(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, ports.ErrSessionNotFound) {
+			return nil, ErrSessionNotFound
+		}
+		s.logger.Error(ctx, "failed to find impersonation session", "error", err, "sessionID", sessionID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to retrieve impersonation session",
+			http.StatusInternalServerError,
+		)
+	}
+	return session, nil
+}
+
+// checkCanImpersonate verifies the actor may impersonate other users
+func (s *SessionService) checkCanImpersonate(ctx context.Context, actorID uuid.UUID) error {
+	canImpersonate, err := s.authorizer.Can(ctx, actorID, "authz", "impersonate", nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canImpersonate {
+		return apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to impersonate users",
+			http.StatusForbidden,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"backend/internal/impersonation/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSession(t *testing.T) {
+	admin, author := uuid.New(), uuid.New()
+	session, token, err := domain.NewSession(admin, author, "  Reproduce ticket 42 ", 15*time.Minute)
+	require.NoError(t, err)
+
+	assert.Equal(t, admin, session.ImpersonatorID)
+	assert.Equal(t, author, session.TargetID)
+	assert.Equal(t, "Reproduce ticket 42", session.Reason)
+	assert.Equal(t, 15*time.Minute, session.ExpiresAt.Sub(session.CreatedAt))
+
+	assert.True(t, strings.HasPrefix(token, domain.TokenPrefix))
+	assert.Equal(t, domain.HashToken(token), session.TokenHash)
+	assert.NotContains(t, session.TokenHash, token, "the token itself is not kept")
+}
+
+func TestNewSession_Validation(t *testing.T) {
+	admin := uuid.New()
+
+	_, _, err := domain.NewSession(admin, admin, "Debugging", time.Minute)
+	assert.ErrorIs(t, err, domain.ErrSelfImpersonation)
+
+	_, _, err = domain.NewSession(admin, uuid.New(), " ", time.Minute)
+	assert.ErrorIs(t, err, domain.ErrReasonRequired)
+
+	_, _, err = domain.NewSession(admin, uuid.New(), strings.Repeat("x", domain.MaxReasonLength+1), time.Minute)
+	assert.ErrorIs(t, err, domain.ErrReasonRequired)
+}
+
+func TestSessionActive(t *testing.T) {
+	session, _, err := domain.NewSession(uuid.New(), uuid.New(), "Debugging", time.Minute)
+	require.NoError(t, err)
+
+	assert.True(t, session.Active(session.CreatedAt))
+	assert.False(t, session.Active(session.ExpiresAt), "expires at the end of its lifetime")
+
+	require.NoError(t, session.End())
+	assert.False(t, session.Active(session.CreatedAt), "ended sessions are closed at once")
+	assert.ErrorIs(t, session.End(), domain.ErrSessionEnded)
+}
@@ -0,0 +1,52 @@
+package ports
+
+import (
+	"context"
+	"errors"
+
+	"backend/internal/impersonation/domain"
+	"github.com/google/uuid"
+)
+
+// Repository errors (canonical errors for the repository contract)
+var (
+	// ErrSessionNotFound is returned when a session does not exist in the current blog
+	ErrSessionNotFound = errors.New("impersonation session not found")
+
+	// ErrUserNotFound is returned when the user to impersonate does not exist
+	ErrUserNotFound = errors.New("user not found")
+)
+
+// SessionRepository defines the contract for impersonation session persistence
+type SessionRepository interface {
+	// Create stores a newly started session
+	Create(ctx context.Context, session *domain.Session) error
+
+	// FindByID retrieves a session of the current blog
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.Session, error)
+
+	// FindByTokenHash retrieves the session of the current blog holding a token, ended or not
+	FindByTokenHash(ctx context.Context, tokenHash string) (*domain.Session, error)
+
+	// End stores the session's end
+	End(ctx context.Context, session *domain.Session) error
+
+	// RecordAction appends a request made through a session to its audit trail
+	// and sets the action's ID
+	RecordAction(ctx context.Context, action *domain.Action) error
+
+	// CompleteAction stores the response status of a recorded action
+	CompleteAction(ctx context.Context, action *domain.Action) error
+
+	// ListActions retrieves the requests made through a session, oldest first
+	ListActions(ctx context.Context, sessionID uuid.UUID) ([]*domain.Action, error)
+
+	// ListActionsByUser retrieves the requests made by or as a user through
+	// sessions of every blog, oldest first
+	ListActionsByUser(ctx context.Context, userID uuid.UUID) ([]*domain.Action, error)
+
+	// Pseudonymize strips what identifies a user from the audit trails of every
+	// blog, leaving only their ID: the paths of requests made by or as them and
+	// the reasons of sessions targeting them. It returns how many rows changed.
+	Pseudonymize(ctx context.Context, userID uuid.UUID) (int, error)
+}
@@ -0,0 +1,190 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"backend/internal/integrity/domain"
+	"backend/internal/integrity/ports"
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/events"
+	"backend/internal/platform/logger"
+	"backend/internal/platform/postgres"
+	"github.com/google/uuid"
+)
+
+// IntegrityService checks the references between modules that the database
+// cannot vouch for once its constraints have been bypassed, and repairs them
+type IntegrityService struct {
+	uow        postgres.UnitOfWork
+	repo       ports.IntegrityRepository
+	authorizer ports.Authorizer
+	eventBus   *eventbus.Bus
+	logger     logger.Logger
+}
+
+// NewIntegrityService creates a new integrity service
+func NewIntegrityService(
+	uow postgres.UnitOfWork,
+	repo ports.IntegrityRepository,
+	authorizer ports.Authorizer,
+	eventBus *eventbus.Bus,
+	logger logger.Logger,
+) *IntegrityService {
+	return &IntegrityService{
+		uow:        uow,
+		repo:       repo,
+		authorizer: authorizer,
+		eventBus:   eventBus,
+		logger:     logger,
+	}
+}
+
+// Check runs every consistency check and, when repair is set, fixes what it found.
+// All repairs are made in one transaction, so a failed repair changes nothing.
+func (s *IntegrityService) Check(ctx context.Context, actorID uuid.UUID, repair bool) (*domain.Report, error) {
+	canManage, err := s.authorizer.Can(ctx, actorID, "settings", "system", nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canManage {
+		return nil, apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to check data integrity",
+			http.StatusForbidden,
+		)
+	}
+
+	report := &domain.Report{
+		CheckedAt: time.Now().UTC(),
+		Repair:    repair,
+		Results:   make([]domain.CheckResult, 0, len(domain.Checks)),
+	}
+	for _, check := range domain.Checks {
+		findings, err := s.repo.FindViolations(ctx, check)
+		if err != nil {
+			return nil, s.checkFailed(ctx, "find", check, err)
+		}
+		report.Results = append(report.Results, domain.CheckResult{Check: check, Findings: findings})
+	}
+
+	if !repair || report.Consistent() {
+		return report, nil
+	}
+
+	repaired, err := s.repairAll(ctx, report)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info(ctx, "repaired data integrity findings", "actorID", actorID, "findings", report.FindingCount())
+	s.publishRepairEvents(ctx, repaired, actorID)
+
+	return report, nil
+}
+
+// repairAll fixes the checks that had findings within one transaction and
+// records the number of fixed rows on each result
+func (s *IntegrityService) repairAll(ctx context.Context, report *domain.Report) ([]domain.Finding, error) {
+	var repaired []domain.Finding
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		for i := range report.Results {
+			result := &report.Results[i]
+			if len(result.Findings) == 0 {
+				continue
+			}
+
+			fixed, err := s.repo.Repair(ctx, result.Check)
+			if err != nil {
+				return s.checkFailed(ctx, "repair", result.Check, err)
+			}
+			result.Repaired = len(fixed)
+			repaired = append(repaired, fixed...)
+		}
+		return nil
+	})
+	if err != nil {
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return nil, err
+		}
+		s.logger.Error(ctx, "failed to repair data integrity", "error", err)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to repair data integrity",
+			http.StatusInternalServerError,
+		)
+	}
+	return repaired, nil
+}
+
+// checkFailed logs a failed check or repair
+func (s *IntegrityService) checkFailed(ctx context.Context, stage string, check domain.Check, err error) error {
+	s.logger.Error(ctx, "failed to check data integrity", "error", err, "stage", stage, "check", check)
+	return apperror.New(
+		apperror.CodeInternalError,
+		apperror.BusinessCodeGeneral,
+		"failed to check data integrity",
+		http.StatusInternalServerError,
+	)
+}
+
+// publishRepairEvents announces removed theme articles, deleted posts,
+// corrected themes and refreshed read model rows so caches drop them; revoked
+// roles are not cached and need no event
+func (s *IntegrityService) publishRepairEvents(ctx context.Context, repaired []domain.Finding, actorID uuid.UUID) {
+	now := time.Now()
+	for _, finding := range repaired {
+		switch finding.Check {
+		case domain.CheckOrphanedThemeArticles:
+			s.eventBus.Publish(ctx, eventbus.Event{
+				Topic: events.ThemeArticleRemovedTopic,
+				Payload: events.ThemeArticleRemovedEvent{
+					ThemeID:    finding.OwnerID,
+					PostID:     finding.MissingID,
+					ActorID:    actorID,
+					OccurredAt: now,
+				},
+			})
+		case domain.CheckThemeArticleCounts, domain.CheckThemeCuratorNames:
+			// Only a derived column changed; the theme's name, slug and curator are not known here
+			s.eventBus.Publish(ctx, eventbus.Event{
+				Topic: events.ThemeUpdatedTopic,
+				Payload: events.ThemeUpdatedEvent{
+					ThemeID:    finding.OwnerID,
+					ActorID:    actorID,
+					OccurredAt: now,
+				},
+			})
+		case domain.CheckPublishedPosts, domain.CheckPostAuthorNames:
+			// A repaired author name was copied into the read model row as well
+			s.eventBus.Publish(ctx, eventbus.Event{
+				Topic: events.PublishedPostRefreshedTopic,
+				Payload: events.PublishedPostRefreshedEvent{
+					PostID:     finding.OwnerID,
+					OccurredAt: now,
+				},
+			})
+		case domain.CheckOrphanedPosts:
+			s.eventBus.Publish(ctx, eventbus.Event{
+				Topic: events.PostDeletedTopic,
+				Payload: events.PostDeletedEvent{
+					PostID:     finding.OwnerID,
+					ActorID:    actorID,
+					AuthorID:   finding.MissingID,
+					OccurredAt: now,
+				},
+			})
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Check names one kind of broken reference between modules, or of derived
+// data that disagrees with its source
+type Check string
+
+const (
+	// CheckOrphanedThemeArticles finds theme articles whose post no longer exists
+	CheckOrphanedThemeArticles Check = "orphaned_theme_articles"
+	// CheckDanglingUserRoles finds role assignments pointing at deleted roles
+	CheckDanglingUserRoles Check = "dangling_user_roles"
+	// CheckOrphanedPosts finds posts whose author no longer exists
+	CheckOrphanedPosts Check = "orphaned_posts"
+	// CheckThemeArticleCounts finds themes whose stored article count is wrong
+	CheckThemeArticleCounts Check = "theme_article_counts"
+	// CheckPublishedPosts finds posts the published posts read model holds wrongly or not at all
+	CheckPublishedPosts Check = "published_posts"
+	// CheckPostAuthorNames finds posts whose copy of the author's username is out of date
+	CheckPostAuthorNames Check = "post_author_names"
+	// CheckThemeCuratorNames finds themes whose copy of the curator's username is out of date
+	CheckThemeCuratorNames Check = "theme_curator_names"
+)
+
+// Checks lists every check in the order they are run and reported
+var Checks = []Check{
+	CheckOrphanedThemeArticles,
+	CheckDanglingUserRoles,
+	CheckOrphanedPosts,
+	CheckThemeArticleCounts,
+	CheckPostAuthorNames,
+	CheckThemeCuratorNames,
+	CheckPublishedPosts, // After the names, whose repair updates the read model too
+}
+
+// Description explains what a check looks for and what repairing it does
+func (c Check) Description() string {
+	switch c {
+	case CheckOrphanedThemeArticles:
+		return "Theme articles whose post no longer exists; repair removes them from the theme"
+	case CheckDanglingUserRoles:
+		return "Role assignments whose role no longer exists; repair revokes them"
+	case CheckOrphanedPosts:
+		return "Posts whose author no longer exists; repair deletes them"
+	case CheckThemeArticleCounts:
+		return "Themes whose stored article count differs from their articles; repair recounts them"
+	case CheckPublishedPosts:
+		return "Posts missing from, outdated in or wrongly kept in the published posts read model; repair refreshes them"
+	case CheckPostAuthorNames:
+		return "Posts whose stored author name differs from the author's username; repair copies the username"
+	case CheckThemeCuratorNames:
+		return "Themes whose stored curator name differs from the curator's username; repair copies the username"
+	default:
+		return ""
+	}
+}
+
+// Finding is one row holding a reference to a row that no longer exists, or
+// a derived value that is wrong. Foreign keys and triggers normally prevent
+// both, so findings point at data written with them bypassed, such as a
+// restore loaded in replica mode.
+type Finding struct {
+	Check     Check
+	OwnerID   uuid.UUID // The row holding the reference or value: a theme, a user or a post
+	MissingID uuid.UUID // The referenced row that is gone: a post, a role or a user; zero for wrong values
+	Detail    string    // What is wrong with a value, such as the stored and actual counts; empty for references
+}
+
+// CheckResult is the outcome of one check
+type CheckResult struct {
+	Check    Check
+	Findings []Finding
+	Repaired int // Rows fixed by the repair; zero when repair was not requested
+}
+
+// Report is the outcome of a consistency run
+type Report struct {
+	CheckedAt time.Time
+	Repair    bool // Whether repairs were requested
+	Results   []CheckResult
+}
+
+// FindingCount returns the number of findings across every check
+func (r *Report) FindingCount() int {
+	count := 0
+	for _, result := range r.Results {
+		count += len(result.Findings)
+	}
+	return count
+}
+
+// Consistent reports whether no check found anything
+func (r *Report) Consistent() bool {
+	return r.FindingCount() == 0
+}
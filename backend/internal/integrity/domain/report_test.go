@@ -0,0 +1,30 @@
+package domain_test
+
+import (
+	"testing"
+
+	"backend/internal/integrity/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportConsistency(t *testing.T) {
+	report := &domain.Report{Results: []domain.CheckResult{
+		{Check: domain.CheckOrphanedThemeArticles},
+		{Check: domain.CheckOrphanedPosts},
+	}}
+	assert.True(t, report.Consistent())
+
+	report.Results[1].Findings = []domain.Finding{
+		{Check: domain.CheckOrphanedPosts, OwnerID: uuid.New(), MissingID: uuid.New()},
+		{Check: domain.CheckOrphanedPosts, OwnerID: uuid.New(), MissingID: uuid.New()},
+	}
+	assert.False(t, report.Consistent())
+	assert.Equal(t, 2, report.FindingCount())
+}
+
+func TestEveryCheckIsDescribed(t *testing.T) {
+	for _, check := range domain.Checks {
+		assert.NotEmpty(t, check.Description(), check)
+	}
+}
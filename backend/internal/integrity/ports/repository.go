@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	"backend/internal/integrity/domain"
+)
+
+// IntegrityRepository looks for references that cross module boundaries and no
+// longer resolve. Every check is scoped to the request's blog; role assignments
+// use the same scope as role grants made on that blog.
+type IntegrityRepository interface {
+	// FindViolations returns every row that fails the check
+	FindViolations(ctx context.Context, check domain.Check) ([]domain.Finding, error)
+
+	// Repair fixes every row that fails the check and returns what it fixed
+	Repair(ctx context.Context, check domain.Check) ([]domain.Finding, error)
+}
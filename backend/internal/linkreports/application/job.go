@@ -0,0 +1,130 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend/internal/linkreports/domain"
+	"backend/internal/linkreports/ports"
+	"backend/internal/platform/linkcheck"
+	"backend/internal/platform/logger"
+	"backend/internal/platform/schedule"
+	"backend/internal/platform/tenant"
+)
+
+// JobConfig schedules the link check job
+type JobConfig struct {
+	// Interval between runs; zero or less disables the job
+	Interval time.Duration
+	// Concurrency bounds the links checked at once
+	Concurrency int
+}
+
+// Job checks the outbound links of every published post on a schedule
+type Job struct {
+	repo    ports.LinkReportRepository
+	checker linkcheck.Checker
+	config  JobConfig
+	logger  logger.Logger
+}
+
+// NewJob creates the scheduled link check job
+func NewJob(repo ports.LinkReportRepository, checker linkcheck.Checker, config JobConfig, logger logger.Logger) *Job {
+	return &Job{
+		repo:    repo,
+		checker: checker,
+		config:  config,
+		logger:  logger,
+	}
+}
+
+// Run blocks until ctx is done, checking every blog once per interval
+func (j *Job) Run(ctx context.Context) {
+	schedule.Every(ctx, j.config.Interval, func(ctx context.Context) {
+		if err := j.RunAll(ctx); err != nil && ctx.Err() == nil {
+			j.logger.Error(ctx, "link check job failed", "error", err)
+		}
+	})
+}
+
+// RunAll checks the links of each blog in turn. A blog that fails is logged
+// and does not stop the others.
+func (j *Job) RunAll(ctx context.Context) error {
+	blogIDs, err := j.repo.BlogIDs(ctx)
+	if err != nil {
+		return fmt.Errorf("Job.RunAll: %w", err)
+	}
+
+	for _, blogID := range blogIDs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		blogCtx := tenant.WithBlogID(ctx, blogID)
+		if err := j.CheckBlog(blogCtx); err != nil {
+			j.logger.Error(blogCtx, "failed to check links", "error", err, "blogID", blogID)
+		}
+	}
+	return nil
+}
+
+// CheckBlog checks the links of the published posts of the blog ctx is scoped
+// to. A link shared by several posts is requested only once.
+func (j *Job) CheckBlog(ctx context.Context) error {
+	posts, err := j.repo.ListPublishedContent(ctx)
+	if err != nil {
+		return fmt.Errorf("Job.CheckBlog: %w", err)
+	}
+
+	postLinks := make([][]string, len(posts))
+	var unique []string
+	seen := make(map[string]bool)
+	for i, post := range posts {
+		postLinks[i] = linkcheck.ExtractLinks(post.Content)
+		for _, link := range postLinks[i] {
+			if !seen[link] {
+				seen[link] = true
+				unique = append(unique, link)
+			}
+		}
+	}
+
+	results := make(map[string]linkcheck.Result, len(unique))
+	for _, result := range linkcheck.CheckAll(ctx, j.checker, unique, j.config.Concurrency) {
+		results[result.URL] = result
+	}
+	if ctx.Err() != nil {
+		// Requests cut short by shutdown would all read as broken
+		return ctx.Err()
+	}
+
+	checkedAt := time.Now()
+	broken := 0
+	for i, post := range posts {
+		links := make([]domain.Link, 0, len(postLinks[i]))
+		for _, url := range postLinks[i] {
+			result := results[url]
+			if result.Broken {
+				broken++
+			}
+			links = append(links, domain.Link{
+				URL:        url,
+				StatusCode: result.StatusCode,
+				Error:      result.Error,
+				Broken:     result.Broken,
+				CheckedAt:  checkedAt,
+			})
+		}
+		if err := j.repo.ReplaceLinks(ctx, post.ID, links); err != nil {
+			return fmt.Errorf("Job.CheckBlog: post %s: %w", post.ID, err)
+		}
+	}
+
+	if err := j.repo.DeleteUnpublished(ctx); err != nil {
+		return fmt.Errorf("Job.CheckBlog: %w", err)
+	}
+
+	j.logger.Info(ctx, "checked post links",
+		"blogID", tenant.BlogID(ctx), "posts", len(posts), "links", len(unique), "broken", broken)
+	return nil
+}
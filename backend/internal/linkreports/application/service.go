@@ -0,0 +1,105 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"backend/internal/linkreports/domain"
+	"backend/internal/linkreports/ports"
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/logger"
+	"github.com/google/uuid"
+)
+
+// Service errors
+var (
+	ErrPostNotFound = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodePostNotFound,
+		"post not found",
+		http.StatusNotFound,
+	)
+)
+
+// LinkReportService shows editors the broken links the link check job found
+type LinkReportService struct {
+	repo       ports.LinkReportRepository
+	authorizer ports.Authorizer
+	logger     logger.Logger
+}
+
+// NewLinkReportService creates a new link report service
+func NewLinkReportService(repo ports.LinkReportRepository, authorizer ports.Authorizer, logger logger.Logger) *LinkReportService {
+	return &LinkReportService{
+		repo:       repo,
+		authorizer: authorizer,
+		logger:     logger,
+	}
+}
+
+// GetPostReport returns the links of a post as last checked, for those who may edit it
+func (s *LinkReportService) GetPostReport(ctx context.Context, actorID uuid.UUID, postID uuid.UUID) (*domain.PostReport, error) {
+	if err := s.checkPermission(ctx, actorID, "update", &postID, "not authorized to view this post's links"); err != nil {
+		return nil, err
+	}
+
+	links, err := s.repo.FindByPost(ctx, postID)
+	if err != nil {
+		if errors.Is(err, ports.ErrPostNotFound) {
+			return nil, ErrPostNotFound
+		}
+		s.logger.Error(ctx, "failed to find post links", "error", err, "postID", postID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to retrieve link report",
+			http.StatusInternalServerError,
+		)
+	}
+
+	return &domain.PostReport{PostID: postID, Links: links}, nil
+}
+
+// ListBroken returns a page of the blog's broken links, for those who may edit any post
+func (s *LinkReportService) ListBroken(ctx context.Context, actorID uuid.UUID, limit, offset int) ([]domain.BrokenLink, int, error) {
+	if err := s.checkPermission(ctx, actorID, "update:any", nil, "not authorized to view the link report"); err != nil {
+		return nil, 0, err
+	}
+
+	links, total, err := s.repo.ListBroken(ctx, limit, offset)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list broken links", "error", err)
+		return nil, 0, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to retrieve link report",
+			http.StatusInternalServerError,
+		)
+	}
+
+	return links, total, nil
+}
+
+// checkPermission verifies the actor may take the posts action
+func (s *LinkReportService) checkPermission(ctx context.Context, actorID uuid.UUID, action string, postID *uuid.UUID, deniedMessage string) error {
+	allowed, err := s.authorizer.Can(ctx, actorID, "posts", action, postID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !allowed {
+		return apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			deniedMessage,
+			http.StatusForbidden,
+		)
+	}
+	return nil
+}
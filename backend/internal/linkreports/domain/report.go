@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Link is the outcome of the last check of one outbound link in a post
+type Link struct {
+	URL        string
+	StatusCode int    // Zero when no response arrived
+	Error      string // Why no response arrived; empty otherwise
+	Broken     bool
+	CheckedAt  time.Time
+}
+
+// PostReport lists the outbound links of one published post as last checked
+type PostReport struct {
+	PostID uuid.UUID
+	Links  []Link
+}
+
+// CheckedAt returns when the post's links were last checked, or nil if never
+func (r *PostReport) CheckedAt() *time.Time {
+	var latest *time.Time
+	for i := range r.Links {
+		if latest == nil || r.Links[i].CheckedAt.After(*latest) {
+			latest = &r.Links[i].CheckedAt
+		}
+	}
+	return latest
+}
+
+// BrokenCount returns the number of broken links
+func (r *PostReport) BrokenCount() int {
+	count := 0
+	for _, link := range r.Links {
+		if link.Broken {
+			count++
+		}
+	}
+	return count
+}
+
+// BrokenLink is a broken link together with the post it appears in
+type BrokenLink struct {
+	PostID    uuid.UUID
+	PostTitle string
+	PostSlug  string
+	AuthorID  uuid.UUID
+	Link      Link
+}
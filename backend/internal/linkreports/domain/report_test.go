@@ -0,0 +1,25 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"backend/internal/linkreports/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostReport(t *testing.T) {
+	report := &domain.PostReport{}
+	assert.Nil(t, report.CheckedAt(), "a post never checked has no check time")
+	assert.Zero(t, report.BrokenCount())
+
+	earlier := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	later := earlier.Add(time.Hour)
+	report.Links = []domain.Link{
+		{URL: "https://a.example", StatusCode: 200, CheckedAt: later},
+		{URL: "https://b.example", StatusCode: 404, Broken: true, CheckedAt: earlier},
+		{URL: "https://c.example", Error: "timed out", Broken: true, CheckedAt: earlier},
+	}
+	assert.Equal(t, later, *report.CheckedAt())
+	assert.Equal(t, 2, report.BrokenCount())
+}
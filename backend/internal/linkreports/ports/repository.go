@@ -0,0 +1,44 @@
+package ports
+
+import (
+	"context"
+	"errors"
+
+	"backend/internal/linkreports/domain"
+	"github.com/google/uuid"
+)
+
+// Repository errors (canonical errors for the repository contract)
+var (
+	// ErrPostNotFound is returned when the request's blog has no such post
+	ErrPostNotFound = errors.New("post not found")
+)
+
+// PostContent is the content of a published post whose links are checked
+type PostContent struct {
+	ID      uuid.UUID
+	Content string
+}
+
+// LinkReportRepository stores the outcome of link checks. Every method but
+// BlogIDs is scoped to the request's blog.
+type LinkReportRepository interface {
+	// ListPublishedContent returns the content of every published post
+	ListPublishedContent(ctx context.Context) ([]PostContent, error)
+
+	// ReplaceLinks stores the post's links in place of those checked before
+	ReplaceLinks(ctx context.Context, postID uuid.UUID, links []domain.Link) error
+
+	// DeleteUnpublished drops the links of posts that are no longer published
+	DeleteUnpublished(ctx context.Context) error
+
+	// FindByPost returns the post's links, broken ones first
+	// Returns ErrPostNotFound when the post does not exist
+	FindByPost(ctx context.Context, postID uuid.UUID) ([]domain.Link, error)
+
+	// ListBroken returns a page of broken links, most recently checked first, and their total
+	ListBroken(ctx context.Context, limit, offset int) ([]domain.BrokenLink, int, error)
+
+	// BlogIDs lists every blog, for the scheduled job that runs on each of them
+	BlogIDs(ctx context.Context) ([]uuid.UUID, error)
+}
@@ -0,0 +1,198 @@
+package application
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"backend/internal/live/domain"
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/events"
+	"backend/internal/platform/logger"
+	"github.com/google/uuid"
+)
+
+// Limits on live connections
+const (
+	// MessageBufferSize is how many messages a connection may fall behind by;
+	// further messages are dropped until the client catches up
+	MessageBufferSize = 32
+	// MaxConnectionsPerUser bounds the streams one user may hold open
+	MaxConnectionsPerUser = 5
+)
+
+// ErrTooManyConnections is returned when a user already holds the maximum number of streams
+var ErrTooManyConnections = apperror.New(
+	apperror.CodeTooManyRequests,
+	apperror.BusinessCodeRateLimited,
+	"too many open event streams",
+	http.StatusTooManyRequests,
+)
+
+// Subscription is one client's connection to the hub
+type Subscription struct {
+	filter   domain.Filter
+	messages chan domain.Message
+}
+
+// Messages delivers the messages the connection receives; it is closed when
+// the hub shuts down
+func (s *Subscription) Messages() <-chan domain.Message {
+	return s.messages
+}
+
+// Hub turns domain events into live messages and fans them out to the
+// connected clients whose filter they match.
+// Messages are only addressed to the owner of the changed post or theme,
+// so a connection never sees changes to content it does not own.
+type Hub struct {
+	mu            sync.Mutex
+	subscriptions map[*Subscription]struct{}
+	perUser       map[uuid.UUID]int
+	closed        bool
+	logger        logger.Logger
+}
+
+// NewHub creates a new hub
+func NewHub(logger logger.Logger) *Hub {
+	return &Hub{
+		subscriptions: make(map[*Subscription]struct{}),
+		perUser:       make(map[uuid.UUID]int),
+		logger:        logger,
+	}
+}
+
+// Subscribe registers the hub's event handlers on the bus
+func (h *Hub) Subscribe(bus *eventbus.Bus) {
+	for _, topic := range []eventbus.Topic{
+		events.PostPublishedTopic,
+		events.PostUpdatedTopic,
+		events.PostArchivedTopic,
+		events.PostDeletedTopic,
+		events.ThemeUpdatedTopic,
+		events.ThemeActivatedTopic,
+		events.ThemeDeactivatedTopic,
+		events.ThemeArchivedTopic,
+		events.ThemeRestoredTopic,
+		events.ThemeDeletedTopic,
+		events.ThemeRecoveredTopic,
+	} {
+		bus.Subscribe(topic, h.handleEvent)
+	}
+}
+
+// Connect opens a subscription for the user on the given topics, or on all of them
+func (h *Hub) Connect(ctx context.Context, userID uuid.UUID, topics []domain.Topic) (*Subscription, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"event streams are shutting down",
+			http.StatusServiceUnavailable,
+		)
+	}
+	if h.perUser[userID] >= MaxConnectionsPerUser {
+		h.logger.Warn(ctx, "event stream limit reached", "userID", userID)
+		return nil, ErrTooManyConnections
+	}
+
+	sub := &Subscription{
+		filter:   domain.NewFilter(userID, topics),
+		messages: make(chan domain.Message, MessageBufferSize),
+	}
+	h.subscriptions[sub] = struct{}{}
+	h.perUser[userID]++
+	return sub, nil
+}
+
+// Disconnect removes a subscription; it is safe to call after Close
+func (h *Hub) Disconnect(sub *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.subscriptions[sub]; !ok {
+		return
+	}
+	delete(h.subscriptions, sub)
+	if h.perUser[sub.filter.UserID]--; h.perUser[sub.filter.UserID] <= 0 {
+		delete(h.perUser, sub.filter.UserID)
+	}
+}
+
+// Close ends every subscription so streaming handlers return and the server
+// can shut down; no new connections are accepted afterwards
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	for sub := range h.subscriptions {
+		close(sub.messages)
+		delete(h.subscriptions, sub)
+	}
+	clear(h.perUser)
+}
+
+// handleEvent delivers the message an event maps to
+func (h *Hub) handleEvent(ctx context.Context, event eventbus.Event) error {
+	msg, ok := toMessage(event)
+	if !ok || msg.RecipientID == uuid.Nil {
+		return nil
+	}
+	h.dispatch(ctx, msg)
+	return nil
+}
+
+// dispatch sends the message to every matching subscription without blocking;
+// a connection whose buffer is full misses it
+func (h *Hub) dispatch(ctx context.Context, msg domain.Message) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subscriptions {
+		if !sub.filter.Matches(msg) {
+			continue
+		}
+		select {
+		case sub.messages <- msg:
+		default:
+			h.logger.Warn(ctx, "dropped live message for slow client",
+				"userID", sub.filter.UserID, "type", msg.Type, "resourceID", msg.ResourceID)
+		}
+	}
+}
+
+// toMessage maps the events the hub subscribes to onto messages for the
+// owner of the post or theme
+func toMessage(event eventbus.Event) (domain.Message, bool) {
+	switch payload := event.Payload.(type) {
+	case events.PostPublishedEvent:
+		return domain.Message{Type: domain.MessagePostPublished, RecipientID: payload.AuthorID, ResourceID: payload.PostID, ActorID: payload.ActorID, OccurredAt: payload.OccurredAt}, true
+	case events.PostUpdatedEvent:
+		return domain.Message{Type: domain.MessagePostUpdated, RecipientID: payload.AuthorID, ResourceID: payload.PostID, ActorID: payload.ActorID, OccurredAt: payload.OccurredAt}, true
+	case events.PostArchivedEvent:
+		return domain.Message{Type: domain.MessagePostArchived, RecipientID: payload.AuthorID, ResourceID: payload.PostID, ActorID: payload.ActorID, OccurredAt: payload.OccurredAt}, true
+	case events.PostDeletedEvent:
+		return domain.Message{Type: domain.MessagePostDeleted, RecipientID: payload.AuthorID, ResourceID: payload.PostID, ActorID: payload.ActorID, OccurredAt: payload.OccurredAt}, true
+	case events.ThemeUpdatedEvent:
+		return domain.Message{Type: domain.MessageThemeUpdated, RecipientID: payload.CuratorID, ResourceID: payload.ThemeID, ActorID: payload.ActorID, OccurredAt: payload.OccurredAt}, true
+	case events.ThemeActivatedEvent:
+		return domain.Message{Type: domain.MessageThemeActivated, RecipientID: payload.CuratorID, ResourceID: payload.ThemeID, ActorID: payload.ActorID, OccurredAt: payload.OccurredAt}, true
+	case events.ThemeDeactivatedEvent:
+		return domain.Message{Type: domain.MessageThemeDeactivated, RecipientID: payload.CuratorID, ResourceID: payload.ThemeID, ActorID: payload.ActorID, OccurredAt: payload.OccurredAt}, true
+	case events.ThemeArchivedEvent:
+		return domain.Message{Type: domain.MessageThemeArchived, RecipientID: payload.CuratorID, ResourceID: payload.ThemeID, ActorID: payload.ActorID, OccurredAt: payload.OccurredAt}, true
+	case events.ThemeRestoredEvent:
+		return domain.Message{Type: domain.MessageThemeRestored, RecipientID: payload.CuratorID, ResourceID: payload.ThemeID, ActorID: payload.ActorID, OccurredAt: payload.OccurredAt}, true
+	case events.ThemeDeletedEvent:
+		return domain.Message{Type: domain.MessageThemeDeleted, RecipientID: payload.CuratorID, ResourceID: payload.ThemeID, ActorID: payload.ActorID, OccurredAt: payload.OccurredAt}, true
+	case events.ThemeRecoveredEvent:
+		return domain.Message{Type: domain.MessageThemeRecovered, RecipientID: payload.CuratorID, ResourceID: payload.ThemeID, ActorID: payload.ActorID, OccurredAt: payload.OccurredAt}, true
+	default:
+		return domain.Message{}, false
+	}
+}
@@ -0,0 +1,8 @@
+package application
+
+import "github.com/google/wire"
+
+// ProviderSet is the wire provider set for the live events application layer
+var ProviderSet = wire.NewSet(
+	NewHub,
+)
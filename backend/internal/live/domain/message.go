@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Topic groups the messages a client can subscribe to
+type Topic string
+
+const (
+	// TopicPosts carries status changes of the subscriber's own posts
+	TopicPosts Topic = "posts"
+	// TopicThemes carries changes to the themes the subscriber curates
+	TopicThemes Topic = "themes"
+)
+
+// Topics lists every topic
+var Topics = []Topic{TopicPosts, TopicThemes}
+
+// ParseTopic validates a topic name
+func ParseTopic(name string) (Topic, error) {
+	for _, topic := range Topics {
+		if string(topic) == name {
+			return topic, nil
+		}
+	}
+	return "", fmt.Errorf("unknown topic %q", name)
+}
+
+// MessageType names what happened, as "<resource>.<change>"
+type MessageType string
+
+const (
+	MessagePostPublished    MessageType = "post.published"
+	MessagePostUpdated      MessageType = "post.updated" // Includes unpublishing
+	MessagePostArchived     MessageType = "post.archived"
+	MessagePostDeleted      MessageType = "post.deleted"
+	MessageThemeUpdated     MessageType = "theme.updated"
+	MessageThemeActivated   MessageType = "theme.activated"
+	MessageThemeDeactivated MessageType = "theme.deactivated"
+	MessageThemeArchived    MessageType = "theme.archived"
+	MessageThemeRestored    MessageType = "theme.restored"
+	MessageThemeDeleted     MessageType = "theme.deleted"   // Moved to the trash
+	MessageThemeRecovered   MessageType = "theme.recovered" // Restored from the trash
+)
+
+// Topic returns the topic the message type belongs to
+func (t MessageType) Topic() Topic {
+	switch {
+	case strings.HasPrefix(string(t), "post."):
+		return TopicPosts
+	case strings.HasPrefix(string(t), "theme."):
+		return TopicThemes
+	default:
+		return ""
+	}
+}
+
+// Message is one change pushed to a connected client
+type Message struct {
+	Type        MessageType
+	RecipientID uuid.UUID // The only user who may receive the message
+	ResourceID  uuid.UUID // The post or theme that changed
+	ActorID     uuid.UUID // Who made the change, which may be someone else, such as a moderator
+	OccurredAt  time.Time
+}
+
+// Filter selects the messages one connection receives
+type Filter struct {
+	UserID uuid.UUID
+	topics map[Topic]bool // Empty means every topic
+}
+
+// NewFilter creates a filter for the user's messages on the given topics, or on all of them
+func NewFilter(userID uuid.UUID, topics []Topic) Filter {
+	filter := Filter{UserID: userID, topics: make(map[Topic]bool, len(topics))}
+	for _, topic := range topics {
+		filter.topics[topic] = true
+	}
+	return filter
+}
+
+// Matches reports whether the message is addressed to the user and on a selected topic
+func (f Filter) Matches(msg Message) bool {
+	if msg.RecipientID != f.UserID {
+		return false
+	}
+	return len(f.topics) == 0 || f.topics[msg.Type.Topic()]
+}
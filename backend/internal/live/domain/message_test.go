@@ -0,0 +1,42 @@
+package domain_test
+
+import (
+	"testing"
+
+	"backend/internal/live/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTopic(t *testing.T) {
+	topic, err := domain.ParseTopic("themes")
+	assert.NoError(t, err)
+	assert.Equal(t, domain.TopicThemes, topic)
+
+	_, err = domain.ParseTopic("users")
+	assert.Error(t, err)
+}
+
+func TestMessageTypeTopic(t *testing.T) {
+	assert.Equal(t, domain.TopicPosts, domain.MessagePostArchived.Topic())
+	assert.Equal(t, domain.TopicThemes, domain.MessageThemeRestored.Topic())
+	assert.Equal(t, domain.TopicThemes, domain.MessageThemeRecovered.Topic())
+	assert.Equal(t, domain.Topic(""), domain.MessageType("series.updated").Topic())
+}
+
+func TestFilterMatches(t *testing.T) {
+	userID, otherID := uuid.New(), uuid.New()
+	postMsg := domain.Message{Type: domain.MessagePostPublished, RecipientID: userID}
+	themeMsg := domain.Message{Type: domain.MessageThemeUpdated, RecipientID: userID}
+
+	all := domain.NewFilter(userID, nil)
+	assert.True(t, all.Matches(postMsg))
+	assert.True(t, all.Matches(themeMsg))
+
+	postsOnly := domain.NewFilter(userID, []domain.Topic{domain.TopicPosts})
+	assert.True(t, postsOnly.Matches(postMsg))
+	assert.False(t, postsOnly.Matches(themeMsg))
+
+	other := domain.NewFilter(otherID, nil)
+	assert.False(t, other.Matches(postMsg), "messages only reach their recipient")
+}
@@ -0,0 +1,35 @@
+// Replays the targets the Go harness resolved from a scenario file at a
+// constant arrival rate. The harness passes TARGETS (a JSON file of
+// {method, url, header, body} with a base64 body), RATE and DURATION.
+import http from 'k6/http';
+import exec from 'k6/execution';
+import encoding from 'k6/encoding';
+
+const targets = JSON.parse(open(__ENV.TARGETS));
+const rate = parseInt(__ENV.RATE, 10);
+
+export const options = {
+  scenarios: {
+    load: {
+      executor: 'constant-arrival-rate',
+      rate: rate,
+      timeUnit: '1s',
+      duration: __ENV.DURATION,
+      preAllocatedVUs: Math.max(10, rate),
+      maxVUs: Math.max(50, rate * 4),
+    },
+  },
+};
+
+export default function () {
+  // Cycle through the mix in order, as vegeta does, so weights hold for both tools
+  const target = targets[exec.scenario.iterationInTest % targets.length];
+
+  const headers = {};
+  for (const [name, values] of Object.entries(target.header || {})) {
+    headers[name] = values.join(', ');
+  }
+  const body = target.body ? encoding.b64decode(target.body, 'std', 's') : null;
+
+  http.request(target.method, target.url, body, { headers: headers });
+}
@@ -0,0 +1,174 @@
+package loadtest
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// DefaultTolerance is how much slower a percentile may get than in the
+// baseline run before it is reported as a regression
+const DefaultTolerance = 0.10
+
+// Report judges a run against its budgets and an optional baseline run
+type Report struct {
+	Tolerance float64
+	Entries   []Entry
+}
+
+// Entry is the verdict on one scenario
+type Entry struct {
+	Scenario string
+	Budget   Budget
+	Current  *Result
+	Baseline *Result // Nil when the baseline did not run the scenario
+
+	Violations  []string // Budget breaches, which fail the run
+	Regressions []string // Slowdowns against the baseline beyond the tolerance
+}
+
+// Compare builds the report for the current results. Budgets come from the
+// scenarios; results of scenarios that are no longer defined are judged
+// against the baseline only.
+func Compare(scenarios []*Scenario, current, baseline []*Result, tolerance float64) *Report {
+	budgets := make(map[string]Budget, len(scenarios))
+	for _, s := range scenarios {
+		budgets[s.Name] = s.Budget
+	}
+	previous := make(map[string]*Result, len(baseline))
+	for _, r := range baseline {
+		previous[r.Scenario] = r
+	}
+
+	report := &Report{Tolerance: tolerance}
+	for _, r := range current {
+		entry := Entry{
+			Scenario: r.Scenario,
+			Budget:   budgets[r.Scenario],
+			Current:  r,
+			Baseline: previous[r.Scenario],
+		}
+		entry.Violations = entry.Budget.check(r)
+		if entry.Baseline != nil {
+			entry.Regressions = regressions(entry.Baseline, r, tolerance)
+		}
+		report.Entries = append(report.Entries, entry)
+	}
+	return report
+}
+
+// Failed reports whether any scenario broke its budget
+func (r *Report) Failed() bool {
+	for _, e := range r.Entries {
+		if len(e.Violations) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// check lists how the result breaks the budget
+func (b Budget) check(r *Result) []string {
+	var violations []string
+	if b.P95 > 0 && r.P95 > b.P95 {
+		violations = append(violations, fmt.Sprintf("p95 %s exceeds %s", formatDuration(r.P95), formatDuration(b.P95)))
+	}
+	if b.P99 > 0 && r.P99 > b.P99 {
+		violations = append(violations, fmt.Sprintf("p99 %s exceeds %s", formatDuration(r.P99), formatDuration(b.P99)))
+	}
+	if b.MaxErrorRate > 0 && r.ErrorRate > b.MaxErrorRate {
+		violations = append(violations, fmt.Sprintf("error rate %s exceeds %s", formatPercent(r.ErrorRate), formatPercent(b.MaxErrorRate)))
+	}
+	return violations
+}
+
+// regressions lists the percentiles that slowed down by more than tolerance
+func regressions(baseline, current *Result, tolerance float64) []string {
+	var found []string
+	for _, p := range []struct {
+		name        string
+		base, value Duration
+	}{
+		{"p50", baseline.P50, current.P50},
+		{"p95", baseline.P95, current.P95},
+		{"p99", baseline.P99, current.P99},
+	} {
+		if p.base > 0 && change(p.base, p.value) > tolerance {
+			found = append(found, fmt.Sprintf("%s %s, was %s (%s)",
+				p.name, formatDuration(p.value), formatDuration(p.base), formatChange(change(p.base, p.value))))
+		}
+	}
+	return found
+}
+
+// change is the relative difference from base to value
+func change(base, value Duration) float64 {
+	return float64(value-base) / float64(base)
+}
+
+// WriteMarkdown writes the report as a Markdown table followed by the
+// budget violations and regressions
+func (r *Report) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+
+	b.WriteString("# Load test report\n\n")
+	b.WriteString("| Scenario | Tool | Requests | Rate | Errors | p50 | p95 | p99 | Budget |\n")
+	b.WriteString("|---|---|---:|---:|---:|---:|---:|---:|---|\n")
+	for _, e := range r.Entries {
+		c := e.Current
+		verdict := "pass"
+		if len(e.Violations) > 0 {
+			verdict = "**FAIL**"
+		}
+		fmt.Fprintf(&b, "| %s | %s | %d | %.1f/s | %s | %s | %s | %s | %s |\n",
+			e.Scenario, c.Tool, c.Requests, c.Rate, formatPercent(c.ErrorRate),
+			e.percentile(func(r *Result) Duration { return r.P50 }),
+			e.percentile(func(r *Result) Duration { return r.P95 }),
+			e.percentile(func(r *Result) Duration { return r.P99 }),
+			verdict,
+		)
+	}
+
+	writeList(&b, "Budget violations", r.Entries, func(e Entry) []string { return e.Violations })
+	writeList(&b, fmt.Sprintf("Regressions beyond %s of the baseline", formatPercent(r.Tolerance)), r.Entries,
+		func(e Entry) []string { return e.Regressions })
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// percentile formats a percentile with its change against the baseline
+func (e Entry) percentile(get func(*Result) Duration) string {
+	value := formatDuration(get(e.Current))
+	if e.Baseline == nil || get(e.Baseline) == 0 {
+		return value
+	}
+	return value + " (" + formatChange(change(get(e.Baseline), get(e.Current))) + ")"
+}
+
+// writeList writes a section listing each scenario's findings, if there are any
+func writeList(b *strings.Builder, title string, entries []Entry, findings func(Entry) []string) {
+	header := false
+	for _, e := range entries {
+		for _, f := range findings(e) {
+			if !header {
+				fmt.Fprintf(b, "\n## %s\n\n", title)
+				header = true
+			}
+			fmt.Fprintf(b, "- %s: %s\n", e.Scenario, f)
+		}
+	}
+}
+
+func formatDuration(d Duration) string {
+	return time.Duration(d).Round(100 * time.Microsecond).String()
+}
+
+func formatPercent(f float64) string {
+	return fmt.Sprintf("%.2f%%", f*100)
+}
+
+func formatChange(f float64) string {
+	return fmt.Sprintf("%+.1f%%", f*100)
+}
@@ -0,0 +1,66 @@
+package loadtest_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"backend/internal/loadtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ms(n float64) loadtest.Duration {
+	return loadtest.Duration(n * float64(time.Millisecond))
+}
+
+func TestCompare(t *testing.T) {
+	scenarios := []*loadtest.Scenario{
+		{Name: "reads", Budget: loadtest.Budget{P95: ms(100), P99: ms(250), MaxErrorRate: 0.01}},
+		{Name: "writes", Budget: loadtest.Budget{P95: ms(200)}},
+	}
+	baseline := []*loadtest.Result{
+		{Scenario: "reads", P50: ms(10), P95: ms(50), P99: ms(100)},
+		{Scenario: "writes", P50: ms(20), P95: ms(100), P99: ms(200)},
+	}
+	current := []*loadtest.Result{
+		{Scenario: "reads", P50: ms(10.5), P95: ms(120), P99: ms(110), ErrorRate: 0.02},
+		{Scenario: "writes", P50: ms(18), P95: ms(105), P99: ms(190)},
+		{Scenario: "retired", P95: ms(999)},
+	}
+
+	report := loadtest.Compare(scenarios, current, baseline, 0.10)
+	require.Len(t, report.Entries, 3)
+
+	reads := report.Entries[0]
+	assert.Equal(t, []string{"p95 120ms exceeds 100ms", "error rate 2.00% exceeds 1.00%"}, reads.Violations)
+	assert.Equal(t, []string{"p95 120ms, was 50ms (+140.0%)"}, reads.Regressions, "p50 and p99 stay within the tolerance")
+
+	writes := report.Entries[1]
+	assert.Empty(t, writes.Violations)
+	assert.Empty(t, writes.Regressions)
+
+	retired := report.Entries[2]
+	assert.Empty(t, retired.Violations, "results without a scenario have no budget")
+	assert.Nil(t, retired.Baseline)
+
+	assert.True(t, report.Failed())
+	assert.False(t, loadtest.Compare(scenarios, current[1:2], baseline, 0.10).Failed())
+}
+
+func TestReport_WriteMarkdown(t *testing.T) {
+	scenarios := []*loadtest.Scenario{{Name: "reads", Budget: loadtest.Budget{P95: ms(100)}}}
+	baseline := []*loadtest.Result{{Scenario: "reads", P50: ms(10), P95: ms(50), P99: ms(80)}}
+	current := []*loadtest.Result{{
+		Scenario: "reads", Tool: "k6", Requests: 3000, Rate: 99.5,
+		P50: ms(10), P95: ms(120), P99: ms(80),
+	}}
+
+	var out strings.Builder
+	require.NoError(t, loadtest.Compare(scenarios, current, baseline, 0.10).WriteMarkdown(&out))
+
+	md := out.String()
+	assert.Contains(t, md, "| reads | k6 | 3000 | 99.5/s | 0.00% | 10ms (+0.0%) | 120ms (+140.0%) | 80ms (+0.0%) | **FAIL** |")
+	assert.Contains(t, md, "## Budget violations\n\n- reads: p95 120ms exceeds 100ms\n")
+	assert.Contains(t, md, "## Regressions beyond 10.00% of the baseline\n\n- reads: p95 120ms, was 50ms (+140.0%)\n")
+}
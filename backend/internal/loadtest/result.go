@@ -0,0 +1,129 @@
+package loadtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Result summarizes one run of a scenario
+type Result struct {
+	Scenario  string    `json:"scenario"`
+	Tool      string    `json:"tool"`
+	StartedAt time.Time `json:"startedAt"`
+	Requests  int       `json:"requests"`
+	Rate      float64   `json:"rate"`      // Achieved requests per second
+	ErrorRate float64   `json:"errorRate"` // Share of requests that failed, 0 to 1
+	P50       Duration  `json:"p50"`
+	P95       Duration  `json:"p95"`
+	P99       Duration  `json:"p99"`
+	Max       Duration  `json:"max"`
+}
+
+// SaveResults writes a run's results as JSON, creating the directory if needed
+func SaveResults(path string, results []*Result) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("save results: %w", err)
+	}
+
+	data, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return fmt.Errorf("save results: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("save results: %w", err)
+	}
+	return nil
+}
+
+// LoadResults reads results written by SaveResults
+func LoadResults(path string) ([]*Result, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("load results: %w", err)
+	}
+
+	var results []*Result
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, fmt.Errorf("load results: %s: %w", filepath.Base(path), err)
+	}
+	return results, nil
+}
+
+// vegetaReport is the part of `vegeta report -type=json` the harness reads
+type vegetaReport struct {
+	Latencies struct {
+		P50 int64 `json:"50th"`
+		P95 int64 `json:"95th"`
+		P99 int64 `json:"99th"`
+		Max int64 `json:"max"`
+	} `json:"latencies"`
+	Requests   int     `json:"requests"`
+	Throughput float64 `json:"throughput"`
+	Success    float64 `json:"success"`
+}
+
+// parseVegetaReport reads a vegeta JSON report, whose latencies are in nanoseconds
+func parseVegetaReport(data []byte) (*Result, error) {
+	var report vegetaReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("parse vegeta report: %w", err)
+	}
+
+	return &Result{
+		Requests:  report.Requests,
+		Rate:      report.Throughput,
+		ErrorRate: 1 - report.Success,
+		P50:       Duration(report.Latencies.P50),
+		P95:       Duration(report.Latencies.P95),
+		P99:       Duration(report.Latencies.P99),
+		Max:       Duration(report.Latencies.Max),
+	}, nil
+}
+
+// k6Summary is the part of `k6 run --summary-export` the harness reads
+type k6Summary struct {
+	Metrics struct {
+		Duration struct {
+			Med float64 `json:"med"`
+			P95 float64 `json:"p(95)"`
+			P99 float64 `json:"p(99)"`
+			Max float64 `json:"max"`
+		} `json:"http_req_duration"`
+		Requests struct {
+			Count int     `json:"count"`
+			Rate  float64 `json:"rate"`
+		} `json:"http_reqs"`
+		Failed struct {
+			Value float64 `json:"value"`
+		} `json:"http_req_failed"`
+	} `json:"metrics"`
+}
+
+// k6TrendStats are the statistics k6 must export for parseK6Summary
+const k6TrendStats = "avg,min,med,max,p(95),p(99)"
+
+// parseK6Summary reads a k6 summary export, whose durations are in milliseconds
+func parseK6Summary(data []byte) (*Result, error) {
+	var summary k6Summary
+	if err := json.Unmarshal(data, &summary); err != nil {
+		return nil, fmt.Errorf("parse k6 summary: %w", err)
+	}
+
+	m := summary.Metrics
+	return &Result{
+		Requests:  m.Requests.Count,
+		Rate:      m.Requests.Rate,
+		ErrorRate: m.Failed.Value,
+		P50:       milliseconds(m.Duration.Med),
+		P95:       milliseconds(m.Duration.P95),
+		P99:       milliseconds(m.Duration.P99),
+		Max:       milliseconds(m.Duration.Max),
+	}, nil
+}
+
+func milliseconds(ms float64) Duration {
+	return Duration(ms * float64(time.Millisecond))
+}
@@ -0,0 +1,62 @@
+package loadtest
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVegetaReport(t *testing.T) {
+	result, err := parseVegetaReport([]byte(`{
+		"latencies": {"total": 9000000000, "mean": 3000000, "50th": 2500000, "90th": 5000000,
+			"95th": 6000000, "99th": 12000000, "max": 40000000, "min": 900000},
+		"requests": 3000, "rate": 100.02, "throughput": 99.7, "success": 0.998,
+		"status_codes": {"200": 2994, "500": 6}, "errors": ["500 Internal Server Error"]
+	}`))
+	require.NoError(t, err)
+
+	assert.Equal(t, 3000, result.Requests)
+	assert.InDelta(t, 99.7, result.Rate, 1e-9)
+	assert.InDelta(t, 0.002, result.ErrorRate, 1e-9)
+	assert.Equal(t, Duration(2500*time.Microsecond), result.P50)
+	assert.Equal(t, Duration(6*time.Millisecond), result.P95)
+	assert.Equal(t, Duration(12*time.Millisecond), result.P99)
+	assert.Equal(t, Duration(40*time.Millisecond), result.Max)
+}
+
+func TestParseK6Summary(t *testing.T) {
+	result, err := parseK6Summary([]byte(`{
+		"metrics": {
+			"http_req_duration": {"avg": 3.1, "min": 0.9, "med": 2.5, "max": 40, "p(95)": 6, "p(99)": 12.5},
+			"http_reqs": {"count": 3000, "rate": 99.9},
+			"http_req_failed": {"passes": 6, "fails": 2994, "value": 0.002}
+		}
+	}`))
+	require.NoError(t, err)
+
+	assert.Equal(t, 3000, result.Requests)
+	assert.InDelta(t, 99.9, result.Rate, 1e-9)
+	assert.InDelta(t, 0.002, result.ErrorRate, 1e-9)
+	assert.Equal(t, Duration(2500*time.Microsecond), result.P50)
+	assert.Equal(t, Duration(6*time.Millisecond), result.P95)
+	assert.Equal(t, Duration(12500*time.Microsecond), result.P99)
+}
+
+func TestSaveAndLoadResults(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "results", "run.json")
+	saved := []*Result{{
+		Scenario:  "reads",
+		Tool:      "vegeta",
+		StartedAt: time.Date(2025, 8, 23, 10, 0, 0, 0, time.UTC),
+		Requests:  100,
+		P95:       Duration(5 * time.Millisecond),
+	}}
+
+	require.NoError(t, SaveResults(path, saved))
+	loaded, err := LoadResults(path)
+	require.NoError(t, err)
+	assert.Equal(t, saved, loaded)
+}
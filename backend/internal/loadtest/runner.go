@@ -0,0 +1,161 @@
+package loadtest
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// ErrUnknownTool is returned for a load generator the harness cannot drive
+var ErrUnknownTool = errors.New("unknown load tool")
+
+// Tools are the load generators the harness can drive
+var Tools = []string{"vegeta", "k6"}
+
+// Runner generates a scenario's load and summarizes it
+type Runner interface {
+	Run(ctx context.Context, s *Scenario, baseURL, token string) (*Result, error)
+}
+
+// NewRunner returns the runner for a tool named in Tools
+// The tool's binary must be on PATH when Run is called.
+func NewRunner(tool string) (Runner, error) {
+	switch tool {
+	case "vegeta":
+		return vegetaRunner{}, nil
+	case "k6":
+		return k6Runner{}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTool, tool)
+	}
+}
+
+// vegetaRunner attacks with `vegeta attack`, cycling through the targets in
+// order, and summarizes with `vegeta report`
+type vegetaRunner struct{}
+
+func (vegetaRunner) Run(ctx context.Context, s *Scenario, baseURL, token string) (*Result, error) {
+	dir, err := os.MkdirTemp("", "loadtest-vegeta-")
+	if err != nil {
+		return nil, fmt.Errorf("vegeta: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	// vegeta's JSON target format matches Target, one target per line
+	var targets bytes.Buffer
+	encoder := json.NewEncoder(&targets)
+	for _, t := range s.Targets(baseURL, token) {
+		if err := encoder.Encode(t); err != nil {
+			return nil, fmt.Errorf("vegeta: encode target: %w", err)
+		}
+	}
+
+	startedAt := time.Now()
+	results := filepath.Join(dir, "results.bin")
+	attack := exec.CommandContext(ctx, "vegeta", "attack",
+		"-format=json",
+		"-rate="+strconv.Itoa(s.Rate)+"/s",
+		"-duration="+time.Duration(s.Duration).String(),
+		"-output="+results,
+	)
+	attack.Stdin = &targets
+	if err := run(attack); err != nil {
+		return nil, fmt.Errorf("vegeta attack: %w", err)
+	}
+
+	report, err := output(exec.CommandContext(ctx, "vegeta", "report", "-type=json", results))
+	if err != nil {
+		return nil, fmt.Errorf("vegeta report: %w", err)
+	}
+
+	result, err := parseVegetaReport(report)
+	if err != nil {
+		return nil, err
+	}
+	result.Scenario, result.Tool, result.StartedAt = s.Name, "vegeta", startedAt
+	return result, nil
+}
+
+//go:embed k6/scenario.js
+var k6Script []byte
+
+// k6Runner runs an embedded script that replays the targets at a constant
+// arrival rate and reads k6's summary export
+type k6Runner struct{}
+
+func (k6Runner) Run(ctx context.Context, s *Scenario, baseURL, token string) (*Result, error) {
+	dir, err := os.MkdirTemp("", "loadtest-k6-")
+	if err != nil {
+		return nil, fmt.Errorf("k6: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	targets, err := json.Marshal(s.Targets(baseURL, token))
+	if err != nil {
+		return nil, fmt.Errorf("k6: encode targets: %w", err)
+	}
+
+	script := filepath.Join(dir, "scenario.js")
+	targetsFile := filepath.Join(dir, "targets.json")
+	summary := filepath.Join(dir, "summary.json")
+	if err := os.WriteFile(script, k6Script, 0o644); err != nil {
+		return nil, fmt.Errorf("k6: %w", err)
+	}
+	if err := os.WriteFile(targetsFile, targets, 0o644); err != nil {
+		return nil, fmt.Errorf("k6: %w", err)
+	}
+
+	startedAt := time.Now()
+	cmd := exec.CommandContext(ctx, "k6", "run", "--quiet",
+		"--summary-export="+summary,
+		"--summary-trend-stats="+k6TrendStats,
+		"--env", "TARGETS="+targetsFile,
+		"--env", "RATE="+strconv.Itoa(s.Rate),
+		"--env", "DURATION="+time.Duration(s.Duration).String(),
+		script,
+	)
+	if err := run(cmd); err != nil {
+		return nil, fmt.Errorf("k6 run: %w", err)
+	}
+
+	data, err := os.ReadFile(summary)
+	if err != nil {
+		return nil, fmt.Errorf("k6: read summary: %w", err)
+	}
+
+	result, err := parseK6Summary(data)
+	if err != nil {
+		return nil, err
+	}
+	result.Scenario, result.Tool, result.StartedAt = s.Name, "k6", startedAt
+	return result, nil
+}
+
+// run runs cmd, adding what it wrote to stderr to the error
+func run(cmd *exec.Cmd) error {
+	_, err := output(cmd)
+	return err
+}
+
+// output runs cmd and returns its stdout, adding what it wrote to stderr to the error
+func output(cmd *exec.Cmd) ([]byte, error) {
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := bytes.TrimSpace(stderr.Bytes()); len(msg) > 0 {
+			return nil, fmt.Errorf("%w: %s", err, msg)
+		}
+		return nil, err
+	}
+	return out, nil
+}
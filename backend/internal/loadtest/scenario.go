@@ -0,0 +1,197 @@
+// Package loadtest runs HTTP load scenarios against a running server and
+// judges the results against performance budgets.
+//
+// A scenario is a JSON file naming the requests to send, the arrival rate and
+// the budget the run must meet. The load itself is generated by k6 or vegeta,
+// whichever is asked for; the package turns the scenario into their input,
+// reads their summaries back into a Result, and compares results with their
+// budgets and with an earlier run.
+package loadtest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ErrInvalidScenario is returned for scenario files that cannot be run
+var ErrInvalidScenario = errors.New("invalid scenario")
+
+// Scenario is one load profile against the API
+type Scenario struct {
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	Rate        int       `json:"rate"`     // Requests per second
+	Duration    Duration  `json:"duration"` // How long to keep up the rate
+	Requests    []Request `json:"requests"`
+	Budget      Budget    `json:"budget"`
+}
+
+// Request is one kind of request in a scenario's mix
+type Request struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	// Weight is the request's share of the mix relative to the others; zero counts as one
+	Weight int             `json:"weight,omitempty"`
+	Auth   bool            `json:"auth,omitempty"` // Send the harness's bearer token
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+// Budget is the performance a run must meet; zero fields are not checked
+type Budget struct {
+	P95          Duration `json:"p95,omitempty"`
+	P99          Duration `json:"p99,omitempty"`
+	MaxErrorRate float64  `json:"maxErrorRate,omitempty"`
+}
+
+// Duration is a time.Duration written as a string such as "150ms" in JSON
+type Duration time.Duration
+
+// MarshalJSON writes the duration as a string
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// UnmarshalJSON reads a duration string
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("duration must be a string such as \"150ms\": %w", err)
+	}
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+// Validate reports the first problem that would stop the scenario from running
+func (s *Scenario) Validate() error {
+	switch {
+	case s.Name == "":
+		return fmt.Errorf("%w: name is required", ErrInvalidScenario)
+	case s.Rate <= 0:
+		return fmt.Errorf("%w: %s: rate must be positive", ErrInvalidScenario, s.Name)
+	case s.Duration <= 0:
+		return fmt.Errorf("%w: %s: duration must be positive", ErrInvalidScenario, s.Name)
+	case len(s.Requests) == 0:
+		return fmt.Errorf("%w: %s: at least one request is required", ErrInvalidScenario, s.Name)
+	}
+
+	for i, r := range s.Requests {
+		switch {
+		case r.Method == "" || strings.ToUpper(r.Method) != r.Method:
+			return fmt.Errorf("%w: %s: request %d: method must be upper case", ErrInvalidScenario, s.Name, i)
+		case !strings.HasPrefix(r.Path, "/"):
+			return fmt.Errorf("%w: %s: request %d: path must start with /", ErrInvalidScenario, s.Name, i)
+		case r.Weight < 0:
+			return fmt.Errorf("%w: %s: request %d: weight cannot be negative", ErrInvalidScenario, s.Name, i)
+		}
+	}
+
+	if s.Budget.MaxErrorRate < 0 || s.Budget.MaxErrorRate > 1 {
+		return fmt.Errorf("%w: %s: maxErrorRate must be between 0 and 1", ErrInvalidScenario, s.Name)
+	}
+	return nil
+}
+
+// NeedsAuth reports whether any request sends the bearer token
+func (s *Scenario) NeedsAuth() bool {
+	for _, r := range s.Requests {
+		if r.Auth {
+			return true
+		}
+	}
+	return false
+}
+
+// Mix expands the requests by weight into the rotation the load tools cycle through
+func (s *Scenario) Mix() []Request {
+	var mix []Request
+	for _, r := range s.Requests {
+		weight := r.Weight
+		if weight == 0 {
+			weight = 1
+		}
+		for i := 0; i < weight; i++ {
+			mix = append(mix, r)
+		}
+	}
+	return mix
+}
+
+// Target is a request ready to send: an absolute URL and its headers
+type Target struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   []byte      `json:"body,omitempty"`
+}
+
+// Targets resolves the request mix against baseURL, authenticating with token
+func (s *Scenario) Targets(baseURL, token string) []Target {
+	base := strings.TrimRight(baseURL, "/")
+
+	var targets []Target
+	for _, r := range s.Mix() {
+		t := Target{Method: r.Method, URL: base + r.Path, Header: http.Header{}}
+		if r.Auth {
+			t.Header.Set("Authorization", "Bearer "+token)
+		}
+		if len(r.Body) > 0 {
+			t.Header.Set("Content-Type", "application/json")
+			t.Body = r.Body
+		}
+		targets = append(targets, t)
+	}
+	return targets
+}
+
+// LoadScenario reads and validates a scenario file
+func LoadScenario(path string) (*Scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read scenario: %w", err)
+	}
+
+	var s Scenario
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", ErrInvalidScenario, filepath.Base(path), err)
+	}
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// LoadScenarios reads every *.json scenario in dir, sorted by name
+func LoadScenarios(dir string) ([]*Scenario, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("list scenarios: %w", err)
+	}
+
+	scenarios := make([]*Scenario, 0, len(paths))
+	seen := map[string]bool{}
+	for _, path := range paths {
+		s, err := LoadScenario(path)
+		if err != nil {
+			return nil, err
+		}
+		if seen[s.Name] {
+			return nil, fmt.Errorf("%w: duplicate name %s", ErrInvalidScenario, s.Name)
+		}
+		seen[s.Name] = true
+		scenarios = append(scenarios, s)
+	}
+
+	sort.Slice(scenarios, func(i, j int) bool { return scenarios[i].Name < scenarios[j].Name })
+	return scenarios, nil
+}
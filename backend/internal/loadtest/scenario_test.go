@@ -0,0 +1,99 @@
+package loadtest_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"backend/internal/loadtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadScenarios_Shipped(t *testing.T) {
+	scenarios, err := loadtest.LoadScenarios("../../loadtest/scenarios")
+	require.NoError(t, err)
+	require.NotEmpty(t, scenarios, "the scenario directory must hold the shipped scenarios")
+
+	for _, s := range scenarios {
+		assert.NotZero(t, s.Budget, "scenario %s has no budget", s.Name)
+	}
+}
+
+func TestLoadScenario(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "reads.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+		"name": "reads",
+		"rate": 20,
+		"duration": "1m30s",
+		"requests": [{"method": "GET", "path": "/api/v1/posts", "weight": 2}],
+		"budget": {"p95": "120ms", "maxErrorRate": 0.01}
+	}`), 0o644))
+
+	s, err := loadtest.LoadScenario(path)
+	require.NoError(t, err)
+	assert.Equal(t, "reads", s.Name)
+	assert.Equal(t, loadtest.Duration(90*time.Second), s.Duration)
+	assert.Equal(t, loadtest.Duration(120*time.Millisecond), s.Budget.P95)
+	assert.Zero(t, s.Budget.P99)
+	assert.InDelta(t, 0.01, s.Budget.MaxErrorRate, 1e-9)
+}
+
+func TestScenario_Validate(t *testing.T) {
+	valid := func() loadtest.Scenario {
+		return loadtest.Scenario{
+			Name:     "reads",
+			Rate:     10,
+			Duration: loadtest.Duration(time.Second),
+			Requests: []loadtest.Request{{Method: "GET", Path: "/api/v1/posts"}},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*loadtest.Scenario)
+	}{
+		{"no name", func(s *loadtest.Scenario) { s.Name = "" }},
+		{"no rate", func(s *loadtest.Scenario) { s.Rate = 0 }},
+		{"no duration", func(s *loadtest.Scenario) { s.Duration = 0 }},
+		{"no requests", func(s *loadtest.Scenario) { s.Requests = nil }},
+		{"lower-case method", func(s *loadtest.Scenario) { s.Requests[0].Method = "get" }},
+		{"relative path", func(s *loadtest.Scenario) { s.Requests[0].Path = "api/v1/posts" }},
+		{"negative weight", func(s *loadtest.Scenario) { s.Requests[0].Weight = -1 }},
+		{"error rate above one", func(s *loadtest.Scenario) { s.Budget.MaxErrorRate = 2 }},
+	}
+
+	s := valid()
+	require.NoError(t, s.Validate())
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := valid()
+			tt.modify(&s)
+			assert.ErrorIs(t, s.Validate(), loadtest.ErrInvalidScenario)
+		})
+	}
+}
+
+func TestScenario_Targets(t *testing.T) {
+	s := loadtest.Scenario{Requests: []loadtest.Request{
+		{Method: "GET", Path: "/api/v1/posts", Weight: 2},
+		{Method: "POST", Path: "/api/v1/posts", Auth: true, Body: json.RawMessage(`{"title":"Hi"}`)},
+	}}
+
+	targets := s.Targets("http://localhost:8080/", "secret")
+	require.Len(t, targets, 3, "a request appears once per unit of weight")
+
+	assert.Equal(t, "http://localhost:8080/api/v1/posts", targets[0].URL)
+	assert.Empty(t, targets[0].Header.Get("Authorization"))
+	assert.Equal(t, targets[0], targets[1])
+
+	assert.Equal(t, "POST", targets[2].Method)
+	assert.Equal(t, "Bearer secret", targets[2].Header.Get("Authorization"))
+	assert.Equal(t, "application/json", targets[2].Header.Get("Content-Type"))
+	assert.JSONEq(t, `{"title":"Hi"}`, string(targets[2].Body))
+	assert.True(t, s.NeedsAuth())
+}
@@ -0,0 +1,101 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend/internal/mentions/ports"
+	"backend/internal/platform/logger"
+	"backend/internal/platform/schedule"
+	"backend/internal/platform/tenant"
+	"backend/internal/platform/webmention"
+)
+
+// VerifyBatchSize bounds the mentions of a blog verified per run, so a flood
+// of mentions cannot keep one run going; the rest wait for the next
+const VerifyBatchSize = 50
+
+// JobConfig schedules the verification job
+type JobConfig struct {
+	// Interval between runs; zero or less disables the job
+	Interval time.Duration
+}
+
+// Job verifies received webmentions on a schedule by fetching their sources
+type Job struct {
+	repo     ports.MentionRepository
+	verifier webmention.Verifier
+	config   JobConfig
+	logger   logger.Logger
+}
+
+// NewJob creates the scheduled verification job
+func NewJob(repo ports.MentionRepository, verifier webmention.Verifier, config JobConfig, logger logger.Logger) *Job {
+	return &Job{
+		repo:     repo,
+		verifier: verifier,
+		config:   config,
+		logger:   logger,
+	}
+}
+
+// Run blocks until ctx is done, verifying pending mentions once per interval
+func (j *Job) Run(ctx context.Context) {
+	schedule.Every(ctx, j.config.Interval, func(ctx context.Context) {
+		if err := j.RunAll(ctx); err != nil && ctx.Err() == nil {
+			j.logger.Error(ctx, "webmention verification job failed", "error", err)
+		}
+	})
+}
+
+// RunAll verifies the pending mentions of each blog in turn. A blog that
+// fails is logged and does not stop the others.
+func (j *Job) RunAll(ctx context.Context) error {
+	blogIDs, err := j.repo.BlogIDs(ctx)
+	if err != nil {
+		return fmt.Errorf("Job.RunAll: %w", err)
+	}
+
+	for _, blogID := range blogIDs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		blogCtx := tenant.WithBlogID(ctx, blogID)
+		if err := j.VerifyBlog(blogCtx); err != nil {
+			j.logger.Error(blogCtx, "failed to verify webmentions", "error", err, "blogID", blogID)
+		}
+	}
+	return nil
+}
+
+// VerifyBlog verifies up to VerifyBatchSize pending mentions of the blog ctx is scoped to
+func (j *Job) VerifyBlog(ctx context.Context) error {
+	mentions, err := j.repo.ListUnverified(ctx, VerifyBatchSize)
+	if err != nil {
+		return fmt.Errorf("Job.VerifyBlog: %w", err)
+	}
+
+	verified := 0
+	for _, mention := range mentions {
+		result := j.verifier.Verify(ctx, mention.Source, mention.Target)
+		if ctx.Err() != nil {
+			// A fetch cut short by shutdown would read as a failure
+			return ctx.Err()
+		}
+
+		if result.Linked {
+			mention.Verify(result.Title, time.Now())
+			verified++
+		} else {
+			mention.FailVerification(result.Error, result.Temporary, time.Now())
+		}
+		if err := j.repo.Update(ctx, mention); err != nil {
+			return fmt.Errorf("Job.VerifyBlog: mention %s: %w", mention.ID, err)
+		}
+	}
+
+	j.logger.Info(ctx, "verified webmentions",
+		"blogID", tenant.BlogID(ctx), "mentions", len(mentions), "verified", verified)
+	return nil
+}
@@ -0,0 +1,49 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	postsApp "backend/internal/posts/application"
+	"github.com/google/uuid"
+)
+
+// PostsAdapter implements the PostResolver interface
+// Posts are read through the posts service, so mentions follow the posts'
+// own access policy
+type PostsAdapter struct {
+	postsService *postsApp.PostsService
+}
+
+// NewPostsAdapter creates a new posts adapter
+func NewPostsAdapter(postsService *postsApp.PostsService) *PostsAdapter {
+	return &PostsAdapter{
+		postsService: postsService,
+	}
+}
+
+// MentionablePost resolves a slug, including one the post had before a
+// rename, to a post anonymous readers may read
+func (a *PostsAdapter) MentionablePost(ctx context.Context, slug string) (uuid.UUID, bool, error) {
+	post, err := a.postsService.GetPublicPostBySlug(ctx, slug)
+	if err == nil {
+		err = a.postsService.CheckCanRead(ctx, post, nil)
+	}
+	if errors.Is(err, postsApp.ErrPostNotFound) || errors.Is(err, postsApp.ErrPostMembersOnly) {
+		return uuid.Nil, false, nil
+	}
+	if err != nil {
+		return uuid.Nil, false, err
+	}
+	return post.ID, true, nil
+}
+
+// CheckReadable refuses when the viewer may not read the post
+func (a *PostsAdapter) CheckReadable(ctx context.Context, postID uuid.UUID, viewerID *uuid.UUID) error {
+	// Pass through the AppErrors from the posts service unchanged
+	post, err := a.postsService.GetPost(ctx, postID)
+	if err != nil {
+		return err
+	}
+	return a.postsService.CheckCanRead(ctx, post, viewerID)
+}
@@ -0,0 +1,11 @@
+package application
+
+import "github.com/google/wire"
+
+// ProviderSet is the wire provider set for the mentions application layer
+var ProviderSet = wire.NewSet(
+	NewMentionsService,
+	NewJob,
+	NewPostsAdapter,
+	wire.Bind(new(PostResolver), new(*PostsAdapter)),
+)
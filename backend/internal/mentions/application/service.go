@@ -0,0 +1,259 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"backend/internal/mentions/domain"
+	"backend/internal/mentions/ports"
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/clientip"
+	"backend/internal/platform/logger"
+	"backend/internal/platform/ratelimit"
+	"github.com/google/uuid"
+)
+
+// Rate limit for received webmentions, per client address. Senders are
+// anonymous, and each mention costs a fetch of its source.
+const (
+	ReceiveRateLimit  = 20
+	ReceiveRateWindow = time.Hour
+)
+
+// Error definitions for service operations
+var (
+	ErrMentionNotFound = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodeMentionNotFound,
+		"mention not found",
+		http.StatusNotFound,
+	)
+
+	ErrInvalidMention = apperror.New(
+		apperror.CodeValidationFailed,
+		apperror.BusinessCodeMentionInvalid,
+		"invalid webmention",
+		http.StatusBadRequest,
+	)
+
+	ErrRateLimited = apperror.New(
+		apperror.CodeTooManyRequests,
+		apperror.BusinessCodeRateLimited,
+		"too many webmentions, please try again later",
+		http.StatusTooManyRequests,
+	)
+)
+
+// Config tells which URLs are the blog's own
+type Config struct {
+	// SiteURL is the public address of the blog frontend, where a post's page
+	// is SiteURL/posts/{slug}; mentions can only target such pages
+	SiteURL string
+}
+
+// PostResolver is an interface to find the posts mentions are about
+// This avoids direct dependency on the posts bounded context
+type PostResolver interface {
+	// MentionablePost returns the ID of the post a slug names, if anonymous
+	// readers may read it; ok is false when there is no such post
+	MentionablePost(ctx context.Context, slug string) (postID uuid.UUID, ok bool, err error)
+
+	// CheckReadable refuses with an AppError to pass on when the viewer may
+	// not read the post; viewerID is nil for anonymous readers
+	CheckReadable(ctx context.Context, postID uuid.UUID, viewerID *uuid.UUID) error
+}
+
+// MentionsService receives webmentions of posts and lets moderators approve them
+type MentionsService struct {
+	repo       ports.MentionRepository
+	posts      PostResolver
+	authorizer ports.Authorizer
+	config     Config
+	logger     logger.Logger
+	limiter    ratelimit.Limiter
+}
+
+// NewMentionsService creates a new mentions service
+func NewMentionsService(
+	repo ports.MentionRepository,
+	posts PostResolver,
+	authorizer ports.Authorizer,
+	config Config,
+	logger logger.Logger,
+) *MentionsService {
+	return &MentionsService{
+		repo:       repo,
+		posts:      posts,
+		authorizer: authorizer,
+		config:     config,
+		logger:     logger,
+		limiter:    ratelimit.NewFixedWindowLimiter(ReceiveRateLimit, ReceiveRateWindow),
+	}
+}
+
+// Receive accepts a webmention of one of the blog's posts. The source is not
+// fetched here: the mention is queued for the verification job, so senders
+// cannot make the blog fetch pages on their behalf while they wait.
+// Public, so no authorization is applied
+func (s *MentionsService) Receive(ctx context.Context, source, target string) error {
+	slug, ok := domain.PostSlug(s.config.SiteURL, target)
+	if !ok {
+		return ErrInvalidMention.WithDetails("target is not a post of this blog")
+	}
+
+	postID, ok, err := s.posts.MentionablePost(ctx, slug)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrInvalidMention.WithDetails("target is not a post that accepts webmentions")
+	}
+
+	mention, err := domain.NewMention(postID, source, target)
+	if err != nil {
+		return ErrInvalidMention.WithDetails(err.Error())
+	}
+
+	// Checked after validation so rejected requests do not use up the allowance
+	ip := clientip.FromContext(ctx)
+	if ip != "" && !s.limiter.Allow(ip) {
+		s.logger.Warn(ctx, "webmention rate limit exceeded")
+		return ErrRateLimited
+	}
+
+	if err := s.repo.Receive(ctx, mention); err != nil {
+		s.logger.Error(ctx, "failed to store webmention", "error", err, "postID", postID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to receive webmention",
+			http.StatusInternalServerError,
+		)
+	}
+	return nil
+}
+
+// ListPostMentions returns the verified mentions of a post the viewer may read.
+// Readers see the approved ones; other statuses are for moderators only.
+func (s *MentionsService) ListPostMentions(ctx context.Context, viewerID *uuid.UUID, postID uuid.UUID, filter ports.ListFilter) ([]*domain.Mention, int, error) {
+	if err := s.posts.CheckReadable(ctx, postID, viewerID); err != nil {
+		return nil, 0, err
+	}
+
+	if filter.Status == nil {
+		approved := domain.StatusApproved
+		filter.Status = &approved
+	}
+	if *filter.Status != domain.StatusApproved {
+		if viewerID == nil {
+			return nil, 0, apperror.New(
+				apperror.CodeUnauthorized,
+				apperror.BusinessCodeGeneral,
+				"sign in to list unapproved mentions",
+				http.StatusUnauthorized,
+			)
+		}
+		if err := s.checkCanModerate(ctx, *viewerID); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	filter.PostID = &postID
+	return s.list(ctx, filter)
+}
+
+// ListMentions returns the blog's verified mentions across posts, for the moderation queue
+func (s *MentionsService) ListMentions(ctx context.Context, actorID uuid.UUID, filter ports.ListFilter) ([]*domain.Mention, int, error) {
+	if err := s.checkCanModerate(ctx, actorID); err != nil {
+		return nil, 0, err
+	}
+	return s.list(ctx, filter)
+}
+
+// ModerateMention approves or rejects a verified mention of a post
+func (s *MentionsService) ModerateMention(ctx context.Context, actorID uuid.UUID, postID, id uuid.UUID, status domain.Status) (*domain.Mention, error) {
+	if err := s.checkCanModerate(ctx, actorID); err != nil {
+		return nil, err
+	}
+
+	mention, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, ports.ErrMentionNotFound) {
+			return nil, ErrMentionNotFound
+		}
+		s.logger.Error(ctx, "failed to get webmention", "error", err, "mentionID", id)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to get mention",
+			http.StatusInternalServerError,
+		)
+	}
+	// Unverified mentions are not listed, so they cannot be moderated either
+	if mention.PostID != postID || mention.Verification != domain.VerificationVerified {
+		return nil, ErrMentionNotFound
+	}
+
+	if err := mention.Moderate(status); err != nil {
+		return nil, ErrInvalidMention.WithDetails(err.Error())
+	}
+
+	if err := s.repo.Update(ctx, mention); err != nil {
+		if errors.Is(err, ports.ErrMentionNotFound) {
+			return nil, ErrMentionNotFound
+		}
+		s.logger.Error(ctx, "failed to update webmention", "error", err, "mentionID", id)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to update mention",
+			http.StatusInternalServerError,
+		)
+	}
+
+	s.logger.Info(ctx, "webmention moderated", "mentionID", id, "actorID", actorID, "status", status)
+	return mention, nil
+}
+
+// Private helper methods
+
+// list runs a listing and maps its failure to an AppError
+func (s *MentionsService) list(ctx context.Context, filter ports.ListFilter) ([]*domain.Mention, int, error) {
+	mentions, total, err := s.repo.List(ctx, filter)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list webmentions", "error", err)
+		return nil, 0, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to list mentions",
+			http.StatusInternalServerError,
+		)
+	}
+	return mentions, total, nil
+}
+
+// checkCanModerate verifies the actor may moderate mentions, which are
+// comments from other sites and so need comments:moderate
+func (s *MentionsService) checkCanModerate(ctx context.Context, actorID uuid.UUID) error {
+	allowed, err := s.authorizer.Can(ctx, actorID, "comments", "moderate", nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !allowed {
+		return apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to moderate mentions",
+			http.StatusForbidden,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+package domain
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Verification is whether the source of a mention was found to link to its target
+type Verification string
+
+const (
+	VerificationPending  Verification = "pending"  // Received; the source has not been fetched yet
+	VerificationVerified Verification = "verified" // The source links to the target
+	VerificationFailed   Verification = "failed"   // The source is gone or does not link to the target
+)
+
+// Status is where a verified mention stands in moderation
+type Status string
+
+const (
+	StatusPending  Status = "pending"  // Awaiting a moderator; not shown to readers
+	StatusApproved Status = "approved" // Shown under the post
+	StatusRejected Status = "rejected" // Hidden for good, even when sent again
+)
+
+// IsValid checks if the status is a known value
+func (s Status) IsValid() bool {
+	switch s {
+	case StatusPending, StatusApproved, StatusRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// Business rule constants
+const (
+	MaxURLLength      = 2048
+	MaxTitleLength    = 200
+	MaxVerifyAttempts = 3 // Fetches of a source that failed for a temporary reason before giving up
+)
+
+// Validation errors
+var (
+	ErrInvalidSource = errors.New("source must be an absolute http or https URL of at most 2048 characters")
+	ErrInvalidTarget = errors.New("target must be an absolute http or https URL of at most 2048 characters")
+	ErrSameURL       = errors.New("source and target must be different URLs")
+	ErrInvalidStatus = errors.New("status must be pending, approved or rejected")
+)
+
+// Mention is a Webmention: another page, the source, telling the blog that it
+// links to one of its posts, the target
+type Mention struct {
+	ID           uuid.UUID
+	PostID       uuid.UUID
+	Source       string
+	Target       string
+	Verification Verification
+	Status       Status
+	Title        string // The source page's title, once verified
+	Failure      string // Why the last verification failed; empty otherwise
+	Attempts     int    // Verifications that failed for a temporary reason
+	ReceivedAt   time.Time
+	VerifiedAt   *time.Time
+	UpdatedAt    time.Time
+}
+
+// NewMention creates a mention of a post awaiting verification and moderation
+func NewMention(postID uuid.UUID, source, target string) (*Mention, error) {
+	source = strings.TrimSpace(source)
+	if !isWebURL(source) {
+		return nil, ErrInvalidSource
+	}
+	target = strings.TrimSpace(target)
+	if !isWebURL(target) {
+		return nil, ErrInvalidTarget
+	}
+	if normalizeURL(source) == normalizeURL(target) {
+		return nil, ErrSameURL
+	}
+
+	now := time.Now()
+	return &Mention{
+		ID:           uuid.New(),
+		PostID:       postID,
+		Source:       source,
+		Target:       target,
+		Verification: VerificationPending,
+		Status:       StatusPending,
+		ReceivedAt:   now,
+		UpdatedAt:    now,
+	}, nil
+}
+
+// Verify records that the source links to the target
+func (m *Mention) Verify(title string, at time.Time) {
+	title = strings.Join(strings.Fields(title), " ")
+	if runes := []rune(title); len(runes) > MaxTitleLength {
+		title = string(runes[:MaxTitleLength])
+	}
+
+	m.Verification = VerificationVerified
+	m.Title = title
+	m.Failure = ""
+	m.VerifiedAt = &at
+	m.UpdatedAt = at
+}
+
+// FailVerification records why the source could not be verified. A temporary
+// failure, such as a timeout, leaves the mention pending to be tried again
+// until MaxVerifyAttempts is reached.
+func (m *Mention) FailVerification(reason string, temporary bool, at time.Time) {
+	m.Failure = reason
+	m.UpdatedAt = at
+	if temporary {
+		m.Attempts++
+		if m.Attempts < MaxVerifyAttempts {
+			return
+		}
+	}
+	m.Verification = VerificationFailed
+}
+
+// Moderate moves the mention to status; any status can follow any other so
+// a rejection can be undone
+func (m *Mention) Moderate(status Status) error {
+	if !status.IsValid() {
+		return ErrInvalidStatus
+	}
+	m.Status = status
+	m.UpdatedAt = time.Now()
+	return nil
+}
+
+// IsVisible reports whether readers see the mention
+func (m *Mention) IsVisible() bool {
+	return m.Verification == VerificationVerified && m.Status == StatusApproved
+}
+
+// PostSlug returns the slug of the post a target URL points to, when it is a
+// post page of the site at siteURL: siteURL followed by /posts/ and the slug.
+// Scheme and host compare case-insensitively; a trailing slash, query and
+// fragment are ignored.
+func PostSlug(siteURL, target string) (string, bool) {
+	site, err := url.Parse(strings.TrimRight(siteURL, "/"))
+	if err != nil || site.Host == "" {
+		return "", false
+	}
+	u, err := url.Parse(target)
+	if err != nil || !strings.EqualFold(u.Scheme, site.Scheme) || !strings.EqualFold(u.Host, site.Host) {
+		return "", false
+	}
+
+	slug, ok := strings.CutPrefix(u.Path, site.Path+"/posts/")
+	slug = strings.TrimSuffix(slug, "/")
+	if !ok || slug == "" || strings.Contains(slug, "/") {
+		return "", false
+	}
+	return slug, true
+}
+
+// normalizeURL reduces a URL to the form it is compared in: without
+// fragment or trailing slash, and with a lowercase scheme and host
+func normalizeURL(raw string) string {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return raw
+	}
+	u.Fragment = ""
+	u.RawFragment = ""
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	u.RawPath = ""
+	return u.String()
+}
+
+// isWebURL checks for an absolute http or https URL within MaxURLLength
+func isWebURL(raw string) bool {
+	if raw == "" || len(raw) > MaxURLLength {
+		return false
+	}
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return false
+	}
+	return u.Scheme == "http" || u.Scheme == "https"
+}
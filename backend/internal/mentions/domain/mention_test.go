@@ -0,0 +1,102 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"backend/internal/mentions/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMention(t *testing.T) {
+	postID := uuid.New()
+
+	mention, err := domain.NewMention(postID, " https://other.example/reply ", "https://blog.example/posts/hello")
+	require.NoError(t, err)
+	assert.Equal(t, postID, mention.PostID)
+	assert.Equal(t, "https://other.example/reply", mention.Source)
+	assert.Equal(t, domain.VerificationPending, mention.Verification)
+	assert.Equal(t, domain.StatusPending, mention.Status)
+	assert.False(t, mention.IsVisible())
+
+	tests := []struct {
+		name    string
+		source  string
+		target  string
+		wantErr error
+	}{
+		{name: "relative source", source: "/reply", target: "https://blog.example/posts/hello", wantErr: domain.ErrInvalidSource},
+		{name: "non-web source", source: "ftp://other.example/reply", target: "https://blog.example/posts/hello", wantErr: domain.ErrInvalidSource},
+		{name: "overlong source", source: "https://other.example/" + strings.Repeat("a", domain.MaxURLLength), target: "https://blog.example/posts/hello", wantErr: domain.ErrInvalidSource},
+		{name: "missing target", source: "https://other.example/reply", target: "", wantErr: domain.ErrInvalidTarget},
+		{name: "same URL", source: "https://blog.example/posts/hello#top", target: "https://BLOG.example/posts/hello/", wantErr: domain.ErrSameURL},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewMention(postID, tt.source, tt.target)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestMention_Verification(t *testing.T) {
+	now := time.Now()
+
+	mention, err := domain.NewMention(uuid.New(), "https://other.example/reply", "https://blog.example/posts/hello")
+	require.NoError(t, err)
+
+	// Temporary failures are retried until the attempts run out
+	for i := 1; i < domain.MaxVerifyAttempts; i++ {
+		mention.FailVerification("timed out", true, now)
+		assert.Equal(t, domain.VerificationPending, mention.Verification)
+	}
+	mention.FailVerification("timed out", true, now)
+	assert.Equal(t, domain.VerificationFailed, mention.Verification)
+	assert.Equal(t, "timed out", mention.Failure)
+
+	mention, err = domain.NewMention(uuid.New(), "https://other.example/reply", "https://blog.example/posts/hello")
+	require.NoError(t, err)
+	mention.FailVerification("source does not link to target", false, now)
+	assert.Equal(t, domain.VerificationFailed, mention.Verification)
+
+	mention.Verify("  A\n reply  ", now)
+	assert.Equal(t, domain.VerificationVerified, mention.Verification)
+	assert.Equal(t, "A reply", mention.Title)
+	assert.Empty(t, mention.Failure)
+	require.NotNil(t, mention.VerifiedAt)
+
+	require.NoError(t, mention.Moderate(domain.StatusApproved))
+	assert.True(t, mention.IsVisible())
+	assert.ErrorIs(t, mention.Moderate("spam"), domain.ErrInvalidStatus)
+}
+
+func TestPostSlug(t *testing.T) {
+	tests := []struct {
+		name    string
+		siteURL string
+		target  string
+		want    string
+		wantOK  bool
+	}{
+		{name: "post page", siteURL: "https://blog.example", target: "https://blog.example/posts/hello", want: "hello", wantOK: true},
+		{name: "trailing slash, query and fragment", siteURL: "https://blog.example/", target: "https://Blog.Example/posts/hello/?ref=x#c1", want: "hello", wantOK: true},
+		{name: "site under a path", siteURL: "https://example.com/blog", target: "https://example.com/blog/posts/hello", want: "hello", wantOK: true},
+		{name: "other host", siteURL: "https://blog.example", target: "https://evil.example/posts/hello"},
+		{name: "other scheme", siteURL: "https://blog.example", target: "http://blog.example/posts/hello"},
+		{name: "not a post", siteURL: "https://blog.example", target: "https://blog.example/themes/hello"},
+		{name: "nested path", siteURL: "https://blog.example", target: "https://blog.example/posts/hello/comments"},
+		{name: "no slug", siteURL: "https://blog.example", target: "https://blog.example/posts/"},
+		{name: "site not configured", siteURL: "", target: "https://blog.example/posts/hello"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slug, ok := domain.PostSlug(tt.siteURL, tt.target)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, slug)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package ports
+
+import (
+	"context"
+	"errors"
+
+	"backend/internal/mentions/domain"
+	"github.com/google/uuid"
+)
+
+// Repository errors (canonical errors for the repository contract)
+var (
+	// ErrMentionNotFound is returned when no mention matches the lookup
+	ErrMentionNotFound = errors.New("mention not found")
+)
+
+// MentionRepository defines the contract for webmention persistence
+// Mentions belong to the request's blog; a post has one mention per source
+type MentionRepository interface {
+	// Receive stores a new mention. A source that already mentioned the post
+	// is verified again, with its target updated and its moderation kept.
+	Receive(ctx context.Context, mention *domain.Mention) error
+
+	// Update saves a mention's verification and moderation
+	Update(ctx context.Context, mention *domain.Mention) error
+
+	// FindByID retrieves a mention by its ID
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.Mention, error)
+
+	// List retrieves verified mentions matching the filter, newest first,
+	// with the total number of matches
+	List(ctx context.Context, filter ListFilter) ([]*domain.Mention, int, error)
+
+	// ListUnverified retrieves mentions awaiting verification, oldest first
+	ListUnverified(ctx context.Context, limit int) ([]*domain.Mention, error)
+
+	// BlogIDs lists the blogs that have mentions awaiting verification
+	BlogIDs(ctx context.Context) ([]uuid.UUID, error)
+}
+
+// ListFilter selects verified mentions
+type ListFilter struct {
+	PostID *uuid.UUID     // Nil lists the mentions of every post
+	Status *domain.Status // Nil lists every status
+	Limit  int
+	Offset int
+}
@@ -0,0 +1,28 @@
+package application
+
+import (
+	"context"
+
+	authzApp "backend/internal/authz/application"
+	"backend/internal/authz/permission"
+	"github.com/google/uuid"
+)
+
+// AdministratorAdapter implements the AdministratorProvider interface
+// It adapts the authz service to tell the notifications context who runs a blog
+type AdministratorAdapter struct {
+	authzService *authzApp.AuthzService
+}
+
+// NewAdministratorAdapter creates a new administrator adapter
+func NewAdministratorAdapter(authzService *authzApp.AuthzService) *AdministratorAdapter {
+	return &AdministratorAdapter{
+		authzService: authzService,
+	}
+}
+
+// ListAdministratorIDs returns the IDs of the users who may manage the current
+// blog's settings, who are the ones answering its contact form
+func (a *AdministratorAdapter) ListAdministratorIDs(ctx context.Context) ([]uuid.UUID, error) {
+	return a.authzService.ListUserIDsWithPermission(ctx, permission.SettingsBlog)
+}
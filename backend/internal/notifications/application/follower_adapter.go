@@ -0,0 +1,26 @@
+package application
+
+import (
+	"context"
+
+	followsApp "backend/internal/follows/application"
+	"github.com/google/uuid"
+)
+
+// FollowerAdapter implements the FollowerProvider interface
+// It adapts the follows service to provide follower lists to the notifications context
+type FollowerAdapter struct {
+	followsService *followsApp.FollowsService
+}
+
+// NewFollowerAdapter creates a new follower adapter
+func NewFollowerAdapter(followsService *followsApp.FollowsService) *FollowerAdapter {
+	return &FollowerAdapter{
+		followsService: followsService,
+	}
+}
+
+// ListFollowerIDs returns the IDs of every follower of the user
+func (a *FollowerAdapter) ListFollowerIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	return a.followsService.ListFollowerIDs(ctx, userID)
+}
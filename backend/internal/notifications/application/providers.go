@@ -0,0 +1,12 @@
+package application
+
+import "github.com/google/wire"
+
+// ProviderSet is the wire provider set for the notifications application layer
+var ProviderSet = wire.NewSet(
+	NewNotificationsService,
+	NewFollowerAdapter,
+	wire.Bind(new(FollowerProvider), new(*FollowerAdapter)),
+	NewAdministratorAdapter,
+	wire.Bind(new(AdministratorProvider), new(*AdministratorAdapter)),
+)
@@ -0,0 +1,189 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"backend/internal/notifications/domain"
+	"backend/internal/notifications/ports"
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/events"
+	"backend/internal/platform/logger"
+	"github.com/google/uuid"
+)
+
+// FollowerProvider lists the followers of a user
+// This avoids direct dependency on the follows bounded context
+type FollowerProvider interface {
+	ListFollowerIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+}
+
+// AdministratorProvider lists the administrators of the current blog
+// This avoids direct dependency on the authz bounded context
+type AdministratorProvider interface {
+	ListAdministratorIDs(ctx context.Context) ([]uuid.UUID, error)
+}
+
+// NotificationsService fans domain events out into per-user notifications
+type NotificationsService struct {
+	repo             ports.NotificationRepository
+	followerProvider FollowerProvider
+	adminProvider    AdministratorProvider
+	logger           logger.Logger
+}
+
+// NewNotificationsService creates a new notifications service
+func NewNotificationsService(
+	repo ports.NotificationRepository,
+	followerProvider FollowerProvider,
+	adminProvider AdministratorProvider,
+	logger logger.Logger,
+) *NotificationsService {
+	return &NotificationsService{
+		repo:             repo,
+		followerProvider: followerProvider,
+		adminProvider:    adminProvider,
+		logger:           logger,
+	}
+}
+
+// Subscribe registers the service's event handlers on the bus
+func (s *NotificationsService) Subscribe(bus *eventbus.Bus) {
+	bus.Subscribe(events.PostPublishedTopic, s.handlePostPublished)
+	bus.Subscribe(events.UserRegisteredTopic, s.handleUserRegistered)
+	bus.Subscribe(events.DataExportReadyTopic, s.handleDataExportReady)
+	bus.Subscribe(events.FeedbackReceivedTopic, s.handleFeedbackReceived)
+}
+
+// handlePostPublished notifies every follower of the author that a new post is out
+func (s *NotificationsService) handlePostPublished(ctx context.Context, event eventbus.Event) error {
+	payload, ok := event.Payload.(events.PostPublishedEvent)
+	if !ok {
+		return fmt.Errorf("NotificationsService.handlePostPublished: unexpected payload %T", event.Payload)
+	}
+
+	// The bus hands us the publisher's request context, which is cancelled once
+	// the response is written; the fan-out must outlive it
+	ctx = context.WithoutCancel(ctx)
+
+	followerIDs, err := s.followerProvider.ListFollowerIDs(ctx, payload.AuthorID)
+	if err != nil {
+		return fmt.Errorf("NotificationsService.handlePostPublished: list followers: %w", err)
+	}
+	if len(followerIDs) == 0 {
+		return nil
+	}
+
+	notifications := make([]*domain.Notification, 0, len(followerIDs))
+	for _, followerID := range followerIDs {
+		notification, err := domain.NewNotification(
+			followerID,
+			domain.NotificationPostPublished,
+			payload.AuthorID,
+			payload.PostID,
+			payload.Title,
+		)
+		if err != nil {
+			return fmt.Errorf("NotificationsService.handlePostPublished: %w", err)
+		}
+		notifications = append(notifications, notification)
+	}
+
+	if err := s.repo.CreateMany(ctx, notifications); err != nil {
+		return fmt.Errorf("NotificationsService.handlePostPublished: %w", err)
+	}
+
+	s.logger.Debug(ctx, "notified followers of published post",
+		"postID", payload.PostID,
+		"authorID", payload.AuthorID,
+		"recipients", len(notifications),
+	)
+	return nil
+}
+
+// handleUserRegistered welcomes a user who finished onboarding
+func (s *NotificationsService) handleUserRegistered(ctx context.Context, event eventbus.Event) error {
+	payload, ok := event.Payload.(events.UserRegisteredEvent)
+	if !ok {
+		return fmt.Errorf("NotificationsService.handleUserRegistered: unexpected payload %T", event.Payload)
+	}
+	ctx = context.WithoutCancel(ctx)
+
+	notification, err := domain.NewNotification(
+		payload.UserID,
+		domain.NotificationWelcome,
+		payload.UserID,
+		payload.UserID,
+		fmt.Sprintf("Welcome aboard, %s!", payload.Username),
+	)
+	if err != nil {
+		return fmt.Errorf("NotificationsService.handleUserRegistered: %w", err)
+	}
+
+	if err := s.repo.CreateMany(ctx, []*domain.Notification{notification}); err != nil {
+		return fmt.Errorf("NotificationsService.handleUserRegistered: %w", err)
+	}
+	return nil
+}
+
+// handleDataExportReady tells a user the copy of their data can be downloaded
+func (s *NotificationsService) handleDataExportReady(ctx context.Context, event eventbus.Event) error {
+	payload, ok := event.Payload.(events.DataExportReadyEvent)
+	if !ok {
+		return fmt.Errorf("NotificationsService.handleDataExportReady: unexpected payload %T", event.Payload)
+	}
+	ctx = context.WithoutCancel(ctx)
+
+	notification, err := domain.NewNotification(
+		payload.UserID,
+		domain.NotificationDataExportReady,
+		payload.UserID,
+		payload.ExportID,
+		fmt.Sprintf("Your data export is ready to download until %s.", payload.ExpiresAt.UTC().Format("2 January 2006 15:04 MST")),
+	)
+	if err != nil {
+		return fmt.Errorf("NotificationsService.handleDataExportReady: %w", err)
+	}
+
+	if err := s.repo.CreateMany(ctx, []*domain.Notification{notification}); err != nil {
+		return fmt.Errorf("NotificationsService.handleDataExportReady: %w", err)
+	}
+	return nil
+}
+
+// handleFeedbackReceived tells the blog's administrators that a visitor sent a message
+func (s *NotificationsService) handleFeedbackReceived(ctx context.Context, event eventbus.Event) error {
+	payload, ok := event.Payload.(events.FeedbackReceivedEvent)
+	if !ok {
+		return fmt.Errorf("NotificationsService.handleFeedbackReceived: unexpected payload %T", event.Payload)
+	}
+	ctx = context.WithoutCancel(ctx)
+
+	adminIDs, err := s.adminProvider.ListAdministratorIDs(ctx)
+	if err != nil {
+		return fmt.Errorf("NotificationsService.handleFeedbackReceived: list administrators: %w", err)
+	}
+	if len(adminIDs) == 0 {
+		return nil
+	}
+
+	message := fmt.Sprintf("New message from %s", payload.Name)
+	if payload.Subject != "" {
+		message = fmt.Sprintf("New message from %s: %s", payload.Name, payload.Subject)
+	}
+
+	notifications := make([]*domain.Notification, 0, len(adminIDs))
+	for _, adminID := range adminIDs {
+		// The sender is an anonymous visitor, so no user is the actor
+		notification, err := domain.NewNotification(adminID, domain.NotificationFeedbackReceived, uuid.Nil, payload.FeedbackID, message)
+		if err != nil {
+			return fmt.Errorf("NotificationsService.handleFeedbackReceived: %w", err)
+		}
+		notifications = append(notifications, notification)
+	}
+
+	if err := s.repo.CreateMany(ctx, notifications); err != nil {
+		return fmt.Errorf("NotificationsService.handleFeedbackReceived: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationType identifies what a notification is about
+type NotificationType string
+
+const (
+	// NotificationPostPublished tells a follower that an author published a post
+	NotificationPostPublished NotificationType = "post_published"
+	// NotificationWelcome greets a user who just signed up
+	NotificationWelcome NotificationType = "welcome"
+	// NotificationDataExportReady tells a user that the copy of their data they asked for can be downloaded
+	NotificationDataExportReady NotificationType = "data_export_ready"
+	// NotificationFeedbackReceived tells an administrator that a visitor sent a message through the contact form
+	NotificationFeedbackReceived NotificationType = "feedback_received"
+)
+
+// IsValid checks if the notification type is supported
+func (t NotificationType) IsValid() bool {
+	switch t {
+	case NotificationPostPublished, NotificationWelcome, NotificationDataExportReady, NotificationFeedbackReceived:
+		return true
+	default:
+		return false
+	}
+}
+
+// Notification is a message delivered to a single recipient
+type Notification struct {
+	ID          uuid.UUID
+	RecipientID uuid.UUID
+	Type        NotificationType
+	ActorID     uuid.UUID // User whose action triggered the notification; Nil for anonymous visitors
+	SubjectID   uuid.UUID // Entity the notification refers to (e.g. the published post)
+	Message     string
+	CreatedAt   time.Time
+	ReadAt      *time.Time
+}
+
+// Validation errors
+var (
+	ErrInvalidRecipientID      = errors.New("recipient ID is required")
+	ErrInvalidNotificationType = errors.New("unsupported notification type")
+)
+
+// NewNotification creates a new unread notification with validation
+func NewNotification(recipientID uuid.UUID, notificationType NotificationType, actorID, subjectID uuid.UUID, message string) (*Notification, error) {
+	if recipientID == uuid.Nil {
+		return nil, ErrInvalidRecipientID
+	}
+
+	if !notificationType.IsValid() {
+		return nil, ErrInvalidNotificationType
+	}
+
+	return &Notification{
+		ID:          uuid.New(),
+		RecipientID: recipientID,
+		Type:        notificationType,
+		ActorID:     actorID,
+		SubjectID:   subjectID,
+		Message:     message,
+		CreatedAt:   time.Now(),
+	}, nil
+}
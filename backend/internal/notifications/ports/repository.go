@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"context"
+
+	"backend/internal/notifications/domain"
+)
+
+// NotificationRepository defines the contract for notification persistence
+type NotificationRepository interface {
+	// CreateMany inserts a batch of notifications
+	CreateMany(ctx context.Context, notifications []*domain.Notification) error
+}
@@ -0,0 +1,477 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"backend/internal/organizations/domain"
+	"backend/internal/organizations/ports"
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/logger"
+	"backend/internal/platform/postgres"
+	"github.com/google/uuid"
+)
+
+// Error definitions for service operations
+var (
+	ErrOrganizationNotFound = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodeOrganizationNotFound,
+		"organization not found",
+		http.StatusNotFound,
+	)
+
+	ErrSlugAlreadyExists = apperror.New(
+		apperror.CodeConflict,
+		apperror.BusinessCodeSlugAlreadyExists,
+		"another organization already uses this slug",
+		http.StatusConflict,
+	)
+
+	ErrMemberNotFound = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodeOrganizationMemberNotFound,
+		"user is not a member of this organization",
+		http.StatusNotFound,
+	)
+
+	ErrUserNotFound = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodeUserNotFound,
+		"user not found",
+		http.StatusNotFound,
+	)
+
+	ErrContentNotFound = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodeContentNotFound,
+		"content not found",
+		http.StatusNotFound,
+	)
+
+	ErrLastOwner = apperror.New(
+		apperror.CodeConflict,
+		apperror.BusinessCodeLastOrganizationOwner,
+		"an organization must keep at least one owner",
+		http.StatusConflict,
+	)
+)
+
+// Resource types organizations can own
+const (
+	ResourcePosts  = "posts"
+	ResourceThemes = "themes"
+)
+
+// OrganizationsService manages teams and the content they own together.
+// It also lets members through ownership checks on that content; see CheckMembership.
+type OrganizationsService struct {
+	uow        postgres.UnitOfWork
+	repo       ports.OrganizationRepository
+	authorizer ports.Authorizer
+	logger     logger.Logger
+}
+
+// NewOrganizationsService creates a new organizations service
+func NewOrganizationsService(
+	uow postgres.UnitOfWork,
+	repo ports.OrganizationRepository,
+	authorizer ports.Authorizer,
+	logger logger.Logger,
+) *OrganizationsService {
+	return &OrganizationsService{
+		uow:        uow,
+		repo:       repo,
+		authorizer: authorizer,
+		logger:     logger,
+	}
+}
+
+// OrganizationParams contains the editable fields of an organization
+type OrganizationParams struct {
+	Name        string
+	Slug        string
+	Description string
+}
+
+// CreateOrganization creates an organization owned by the actor
+func (s *OrganizationsService) CreateOrganization(ctx context.Context, actorID uuid.UUID, params OrganizationParams) (*domain.Organization, error) {
+	canCreate, err := s.authorizer.Can(ctx, actorID, "organizations", "create", nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID)
+		return nil, errAuthorizationFailed()
+	}
+	if !canCreate {
+		return nil, forbidden("not authorized to create organizations")
+	}
+
+	org, err := domain.NewOrganization(params.Name, params.Slug, params.Description, actorID)
+	if err != nil {
+		return nil, validationError(err)
+	}
+	owner, err := domain.NewMember(org.ID, actorID, domain.RoleOwner)
+	if err != nil {
+		return nil, validationError(err)
+	}
+
+	// The organization and its first owner are stored together, so no
+	// organization is ever left without an owner
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		if err := s.repo.Create(ctx, org); err != nil {
+			return err
+		}
+		return s.repo.SaveMember(ctx, owner)
+	})
+	if err != nil {
+		if errors.Is(err, ports.ErrSlugExists) {
+			return nil, ErrSlugAlreadyExists
+		}
+		s.logger.Error(ctx, "failed to create organization", "error", err, "slug", org.Slug)
+		return nil, internalError("failed to create organization")
+	}
+
+	s.logger.Info(ctx, "organization created", "organizationID", org.ID, "slug", org.Slug, "actorID", actorID)
+	return org, nil
+}
+
+// GetOrganization retrieves an organization for one of its members
+func (s *OrganizationsService) GetOrganization(ctx context.Context, actorID, id uuid.UUID) (*domain.Organization, error) {
+	org, err := s.findOrganization(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.requireMember(ctx, actorID, id); err != nil {
+		return nil, err
+	}
+	return org, nil
+}
+
+// ListMyOrganizations returns the organizations the actor belongs to
+func (s *OrganizationsService) ListMyOrganizations(ctx context.Context, actorID uuid.UUID) ([]*domain.Organization, error) {
+	orgs, err := s.repo.ListByMember(ctx, actorID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list organizations", "error", err, "userID", actorID)
+		return nil, internalError("failed to list organizations")
+	}
+	return orgs, nil
+}
+
+// UpdateOrganization changes an organization's name, slug and description
+func (s *OrganizationsService) UpdateOrganization(ctx context.Context, actorID, id uuid.UUID, params OrganizationParams) (*domain.Organization, error) {
+	org, err := s.findOrganization(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireTeamManager(ctx, actorID, id); err != nil {
+		return nil, err
+	}
+
+	if err := org.Update(params.Name, params.Slug, params.Description); err != nil {
+		return nil, validationError(err)
+	}
+	if err := s.repo.Update(ctx, org); err != nil {
+		switch {
+		case errors.Is(err, ports.ErrSlugExists):
+			return nil, ErrSlugAlreadyExists
+		case errors.Is(err, ports.ErrOrganizationNotFound):
+			return nil, ErrOrganizationNotFound
+		}
+		s.logger.Error(ctx, "failed to update organization", "error", err, "organizationID", id)
+		return nil, internalError("failed to update organization")
+	}
+	return org, nil
+}
+
+// DeleteOrganization removes an organization; its posts and themes stay with their authors and curators
+func (s *OrganizationsService) DeleteOrganization(ctx context.Context, actorID, id uuid.UUID) error {
+	if _, err := s.findOrganization(ctx, id); err != nil {
+		return err
+	}
+	if err := s.requireTeamManager(ctx, actorID, id); err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		if errors.Is(err, ports.ErrOrganizationNotFound) {
+			return ErrOrganizationNotFound
+		}
+		s.logger.Error(ctx, "failed to delete organization", "error", err, "organizationID", id)
+		return internalError("failed to delete organization")
+	}
+
+	s.logger.Info(ctx, "organization deleted", "organizationID", id, "actorID", actorID)
+	return nil
+}
+
+// ListMembers returns the members of an organization to one of them
+func (s *OrganizationsService) ListMembers(ctx context.Context, actorID, orgID uuid.UUID) ([]*domain.Member, error) {
+	if _, err := s.findOrganization(ctx, orgID); err != nil {
+		return nil, err
+	}
+	if _, err := s.requireMember(ctx, actorID, orgID); err != nil {
+		return nil, err
+	}
+
+	members, err := s.repo.ListMembers(ctx, orgID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list members", "error", err, "organizationID", orgID)
+		return nil, internalError("failed to list members")
+	}
+	return members, nil
+}
+
+// SetMember adds a user to an organization or changes their role
+func (s *OrganizationsService) SetMember(ctx context.Context, actorID, orgID, userID uuid.UUID, role domain.MemberRole) (*domain.Member, error) {
+	if _, err := s.findOrganization(ctx, orgID); err != nil {
+		return nil, err
+	}
+	if err := s.requireTeamManager(ctx, actorID, orgID); err != nil {
+		return nil, err
+	}
+
+	member, err := domain.NewMember(orgID, userID, role)
+	if err != nil {
+		return nil, validationError(err)
+	}
+
+	existing, err := s.repo.FindMember(ctx, orgID, userID)
+	switch {
+	case err == nil:
+		if err := s.checkOwnersRemain(ctx, existing, role); err != nil {
+			return nil, err
+		}
+		member.JoinedAt = existing.JoinedAt
+	case !errors.Is(err, ports.ErrMemberNotFound):
+		s.logger.Error(ctx, "failed to find member", "error", err, "organizationID", orgID, "userID", userID)
+		return nil, internalError("failed to save member")
+	}
+
+	if err := s.repo.SaveMember(ctx, member); err != nil {
+		if errors.Is(err, ports.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		s.logger.Error(ctx, "failed to save member", "error", err, "organizationID", orgID, "userID", userID)
+		return nil, internalError("failed to save member")
+	}
+
+	s.logger.Info(ctx, "organization member set", "organizationID", orgID, "userID", userID, "role", role, "actorID", actorID)
+	return member, nil
+}
+
+// RemoveMember removes a user from an organization
+// Members may always leave, unless they are its last owner
+func (s *OrganizationsService) RemoveMember(ctx context.Context, actorID, orgID, userID uuid.UUID) error {
+	if _, err := s.findOrganization(ctx, orgID); err != nil {
+		return err
+	}
+	if actorID != userID {
+		if err := s.requireTeamManager(ctx, actorID, orgID); err != nil {
+			return err
+		}
+	}
+
+	member, err := s.repo.FindMember(ctx, orgID, userID)
+	if err != nil {
+		if errors.Is(err, ports.ErrMemberNotFound) {
+			return ErrMemberNotFound
+		}
+		s.logger.Error(ctx, "failed to find member", "error", err, "organizationID", orgID, "userID", userID)
+		return internalError("failed to remove member")
+	}
+	if err := s.checkOwnersRemain(ctx, member, ""); err != nil {
+		return err
+	}
+
+	if err := s.repo.RemoveMember(ctx, orgID, userID); err != nil {
+		if errors.Is(err, ports.ErrMemberNotFound) {
+			return ErrMemberNotFound
+		}
+		s.logger.Error(ctx, "failed to remove member", "error", err, "organizationID", orgID, "userID", userID)
+		return internalError("failed to remove member")
+	}
+
+	s.logger.Info(ctx, "organization member removed", "organizationID", orgID, "userID", userID, "actorID", actorID)
+	return nil
+}
+
+// AssignContent moves a post or theme into an organization, or back to its
+// author or curator alone when orgID is nil. The actor must be able to update
+// the content and be an owner or editor of every organization involved.
+func (s *OrganizationsService) AssignContent(ctx context.Context, actorID uuid.UUID, resourceType string, resourceID uuid.UUID, orgID *uuid.UUID) error {
+	canUpdate, err := s.authorizer.Can(ctx, actorID, resourceType, "update", &resourceID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID, "resourceID", resourceID)
+		return errAuthorizationFailed()
+	}
+	if !canUpdate {
+		return forbidden("not authorized to update this content")
+	}
+
+	current, err := s.repo.ContentOrganization(ctx, resourceType, resourceID)
+	if err != nil {
+		if errors.Is(err, ports.ErrContentNotFound) {
+			return ErrContentNotFound
+		}
+		s.logger.Error(ctx, "failed to find content organization", "error", err, "resourceType", resourceType, "resourceID", resourceID)
+		return internalError("failed to assign content")
+	}
+
+	for _, involved := range []*uuid.UUID{current, orgID} {
+		if involved == nil {
+			continue
+		}
+		if _, err := s.findOrganization(ctx, *involved); err != nil {
+			return err
+		}
+		if err := s.requireContentAssigner(ctx, actorID, *involved); err != nil {
+			return err
+		}
+	}
+
+	if err := s.repo.SetContentOrganization(ctx, resourceType, resourceID, orgID); err != nil {
+		if errors.Is(err, ports.ErrContentNotFound) {
+			return ErrContentNotFound
+		}
+		s.logger.Error(ctx, "failed to assign content", "error", err, "resourceType", resourceType, "resourceID", resourceID)
+		return internalError("failed to assign content")
+	}
+
+	s.logger.Info(ctx, "content organization changed", "resourceType", resourceType, "resourceID", resourceID, "organizationID", orgID, "actorID", actorID)
+	return nil
+}
+
+// CheckMembership lets members of the organization owning a post or theme act
+// on it as their role allows. Implements the ownership.MembershipChecker interface.
+func (s *OrganizationsService) CheckMembership(ctx context.Context, userID uuid.UUID, resourceType string, resourceID uuid.UUID, action string) (bool, error) {
+	role, err := s.repo.ContentMemberRole(ctx, resourceType, resourceID, userID)
+	if err != nil {
+		if errors.Is(err, ports.ErrMemberNotFound) || errors.Is(err, ports.ErrUnsupportedContent) {
+			return false, nil
+		}
+		s.logger.Error(ctx, "failed to check organization membership", "error", err, "resourceType", resourceType, "resourceID", resourceID)
+		return false, err
+	}
+	return role.Allows(action), nil
+}
+
+// Private helper methods
+
+// findOrganization loads an organization and maps a miss to ErrOrganizationNotFound
+func (s *OrganizationsService) findOrganization(ctx context.Context, id uuid.UUID) (*domain.Organization, error) {
+	org, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, ports.ErrOrganizationNotFound) {
+			return nil, ErrOrganizationNotFound
+		}
+		s.logger.Error(ctx, "failed to get organization", "error", err, "organizationID", id)
+		return nil, internalError("failed to get organization")
+	}
+	return org, nil
+}
+
+// canManageAny reports whether the actor may manage every organization of the blog
+func (s *OrganizationsService) canManageAny(ctx context.Context, actorID uuid.UUID) (bool, error) {
+	canManage, err := s.authorizer.Can(ctx, actorID, "organizations:manage", "any", nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID)
+		return false, errAuthorizationFailed()
+	}
+	return canManage, nil
+}
+
+// requireMember returns the actor's membership, or nil for staff managing any organization
+func (s *OrganizationsService) requireMember(ctx context.Context, actorID, orgID uuid.UUID) (*domain.Member, error) {
+	member, err := s.repo.FindMember(ctx, orgID, actorID)
+	if err == nil {
+		return member, nil
+	}
+	if !errors.Is(err, ports.ErrMemberNotFound) {
+		s.logger.Error(ctx, "failed to find member", "error", err, "organizationID", orgID, "userID", actorID)
+		return nil, internalError("failed to check membership")
+	}
+
+	canManage, err := s.canManageAny(ctx, actorID)
+	if err != nil {
+		return nil, err
+	}
+	if !canManage {
+		return nil, forbidden("not a member of this organization")
+	}
+	return nil, nil
+}
+
+// requireTeamManager allows owners of the organization and staff managing any organization
+func (s *OrganizationsService) requireTeamManager(ctx context.Context, actorID, orgID uuid.UUID) error {
+	member, err := s.requireMember(ctx, actorID, orgID)
+	if err != nil {
+		return err
+	}
+	if member != nil && !member.Role.CanManageTeam() {
+		return forbidden("only owners can manage this organization")
+	}
+	return nil
+}
+
+// requireContentAssigner allows owners and editors of the organization and staff managing any organization
+func (s *OrganizationsService) requireContentAssigner(ctx context.Context, actorID, orgID uuid.UUID) error {
+	member, err := s.requireMember(ctx, actorID, orgID)
+	if err != nil {
+		return err
+	}
+	if member != nil && !member.Role.CanAssignContent() {
+		return forbidden("only owners and editors can move content into or out of this organization")
+	}
+	return nil
+}
+
+// checkOwnersRemain refuses to demote or remove the last owner
+func (s *OrganizationsService) checkOwnersRemain(ctx context.Context, member *domain.Member, newRole domain.MemberRole) error {
+	if member.Role != domain.RoleOwner {
+		return nil
+	}
+	owners, err := s.repo.CountOwners(ctx, member.OrganizationID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to count owners", "error", err, "organizationID", member.OrganizationID)
+		return internalError("failed to count owners")
+	}
+	if errors.Is(domain.CheckOwnersRemain(member, newRole, owners), domain.ErrLastOwner) {
+		return ErrLastOwner
+	}
+	return nil
+}
+
+// validationError wraps a domain validation failure
+func validationError(err error) error {
+	return apperror.New(
+		apperror.CodeValidationFailed,
+		apperror.BusinessCodeGeneral,
+		err.Error(),
+		http.StatusBadRequest,
+	)
+}
+
+// forbidden reports that the actor may not perform an operation
+func forbidden(message string) error {
+	return apperror.New(
+		apperror.CodeForbidden,
+		apperror.BusinessCodePermissionDenied,
+		message,
+		http.StatusForbidden,
+	)
+}
+
+// internalError reports an unexpected failure
+func internalError(message string) error {
+	return apperror.New(
+		apperror.CodeInternalError,
+		apperror.BusinessCodeGeneral,
+		message,
+		http.StatusInternalServerError,
+	)
+}
+
+// errAuthorizationFailed reports that a permission could not be checked
+func errAuthorizationFailed() error {
+	return internalError("authorization check failed")
+}
@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MemberRole is what a member may do within an organization
+type MemberRole string
+
+const (
+	RoleOwner  MemberRole = "owner"  // Manages the team and may do anything to its content
+	RoleEditor MemberRole = "editor" // Edits, publishes and deletes the team's content and moves content into it
+	RoleMember MemberRole = "member" // Edits the team's content
+)
+
+// Content actions granted by roles; they match the actions of the posts and themes permissions
+const (
+	ActionUpdate  = "update"
+	ActionCurate  = "curate"
+	ActionPublish = "publish"
+	ActionDelete  = "delete"
+)
+
+// IsValid checks if the role is a valid value
+func (r MemberRole) IsValid() bool {
+	switch r {
+	case RoleOwner, RoleEditor, RoleMember:
+		return true
+	default:
+		return false
+	}
+}
+
+// Allows reports whether the role lets a member perform action on the organization's content
+func (r MemberRole) Allows(action string) bool {
+	switch r {
+	case RoleOwner:
+		return true
+	case RoleEditor:
+		return action == ActionUpdate || action == ActionCurate || action == ActionPublish || action == ActionDelete
+	case RoleMember:
+		return action == ActionUpdate || action == ActionCurate
+	default:
+		return false
+	}
+}
+
+// CanManageTeam reports whether the role may change the organization and its members
+func (r MemberRole) CanManageTeam() bool {
+	return r == RoleOwner
+}
+
+// CanAssignContent reports whether the role may move content into or out of the organization
+func (r MemberRole) CanAssignContent() bool {
+	return r == RoleOwner || r == RoleEditor
+}
+
+// Member is a user belonging to an organization
+type Member struct {
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+	Role           MemberRole
+	JoinedAt       time.Time
+}
+
+// NewMember validates and creates a member of an organization
+func NewMember(organizationID, userID uuid.UUID, role MemberRole) (*Member, error) {
+	if !role.IsValid() {
+		return nil, ErrInvalidRole
+	}
+	return &Member{
+		OrganizationID: organizationID,
+		UserID:         userID,
+		Role:           role,
+		JoinedAt:       time.Now(),
+	}, nil
+}
+
+// CheckOwnersRemain refuses a change that would leave an organization
+// without owners: demoting or removing member when owners is the current owner count
+func CheckOwnersRemain(member *Member, newRole MemberRole, owners int) error {
+	if member.Role == RoleOwner && newRole != RoleOwner && owners <= 1 {
+		return ErrLastOwner
+	}
+	return nil
+}
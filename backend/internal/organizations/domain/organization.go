@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"backend/internal/platform/validator"
+	"github.com/google/uuid"
+)
+
+// Organization is a team that owns posts and themes together
+// Its members act on the team's content according to their role, whoever wrote it
+type Organization struct {
+	ID          uuid.UUID
+	Name        string
+	Slug        string
+	Description string
+	CreatedBy   uuid.UUID
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Business rule constants
+const (
+	MaxNameLength        = 100
+	MaxSlugLength        = 100
+	MaxDescriptionLength = 500
+)
+
+// Validation errors
+var (
+	ErrInvalidName        = errors.New("name is required and must not exceed 100 characters")
+	ErrInvalidSlug        = errors.New("slug must contain only lowercase letters, numbers, and hyphens and not exceed 100 characters")
+	ErrInvalidDescription = errors.New("description must not exceed 500 characters")
+	ErrInvalidRole        = errors.New("role must be owner, editor or member")
+	ErrLastOwner          = errors.New("an organization must keep at least one owner")
+)
+
+// NewOrganization creates a new organization with validation
+// The creator becomes its first owner; see NewMember
+func NewOrganization(name, slug, description string, createdBy uuid.UUID) (*Organization, error) {
+	org := &Organization{ID: uuid.New(), CreatedBy: createdBy}
+	if err := org.Update(name, slug, description); err != nil {
+		return nil, err
+	}
+	org.CreatedAt = org.UpdatedAt
+	return org, nil
+}
+
+// Update replaces the organization's name, slug and description with validation
+func (o *Organization) Update(name, slug, description string) error {
+	name = strings.TrimSpace(name)
+	if name == "" || len([]rune(name)) > MaxNameLength {
+		return ErrInvalidName
+	}
+	if err := validator.ValidateSlugFormat(slug, MaxSlugLength); err != nil {
+		return ErrInvalidSlug
+	}
+	description = strings.TrimSpace(description)
+	if len([]rune(description)) > MaxDescriptionLength {
+		return ErrInvalidDescription
+	}
+
+	o.Name = name
+	o.Slug = slug
+	o.Description = description
+	o.UpdatedAt = time.Now()
+	return nil
+}
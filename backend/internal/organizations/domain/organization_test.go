@@ -0,0 +1,72 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"backend/internal/organizations/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOrganization(t *testing.T) {
+	creator := uuid.New()
+
+	org, err := domain.NewOrganization("  Platform Team ", "platform-team", "Infra writers", creator)
+	require.NoError(t, err)
+	assert.Equal(t, "Platform Team", org.Name)
+	assert.Equal(t, creator, org.CreatedBy)
+	assert.Equal(t, org.CreatedAt, org.UpdatedAt)
+
+	_, err = domain.NewOrganization(" ", "platform-team", "", creator)
+	assert.ErrorIs(t, err, domain.ErrInvalidName)
+	_, err = domain.NewOrganization("Platform", "Platform Team", "", creator)
+	assert.ErrorIs(t, err, domain.ErrInvalidSlug)
+	_, err = domain.NewOrganization("Platform", "platform", strings.Repeat("d", domain.MaxDescriptionLength+1), creator)
+	assert.ErrorIs(t, err, domain.ErrInvalidDescription)
+}
+
+func TestMemberRole_Allows(t *testing.T) {
+	tests := []struct {
+		role    domain.MemberRole
+		action  string
+		allowed bool
+	}{
+		{domain.RoleOwner, domain.ActionDelete, true},
+		{domain.RoleOwner, "manage", true},
+		{domain.RoleEditor, domain.ActionPublish, true},
+		{domain.RoleEditor, domain.ActionDelete, true},
+		{domain.RoleEditor, "manage", false},
+		{domain.RoleMember, domain.ActionUpdate, true},
+		{domain.RoleMember, domain.ActionCurate, true},
+		{domain.RoleMember, domain.ActionPublish, false},
+		{domain.RoleMember, domain.ActionDelete, false},
+		{domain.MemberRole("guest"), domain.ActionUpdate, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.role)+"/"+tt.action, func(t *testing.T) {
+			assert.Equal(t, tt.allowed, tt.role.Allows(tt.action))
+		})
+	}
+}
+
+func TestNewMember(t *testing.T) {
+	_, err := domain.NewMember(uuid.New(), uuid.New(), domain.MemberRole("guest"))
+	assert.ErrorIs(t, err, domain.ErrInvalidRole)
+
+	member, err := domain.NewMember(uuid.New(), uuid.New(), domain.RoleEditor)
+	require.NoError(t, err)
+	assert.Equal(t, domain.RoleEditor, member.Role)
+}
+
+func TestCheckOwnersRemain(t *testing.T) {
+	owner := &domain.Member{Role: domain.RoleOwner}
+	editor := &domain.Member{Role: domain.RoleEditor}
+
+	assert.ErrorIs(t, domain.CheckOwnersRemain(owner, domain.RoleMember, 1), domain.ErrLastOwner)
+	assert.NoError(t, domain.CheckOwnersRemain(owner, domain.RoleMember, 2))
+	assert.NoError(t, domain.CheckOwnersRemain(owner, domain.RoleOwner, 1))
+	assert.NoError(t, domain.CheckOwnersRemain(editor, domain.RoleMember, 1))
+}
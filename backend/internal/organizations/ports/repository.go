@@ -0,0 +1,74 @@
+package ports
+
+import (
+	"context"
+	"errors"
+
+	"backend/internal/organizations/domain"
+	"github.com/google/uuid"
+)
+
+// Repository errors (canonical errors for the repository contract)
+var (
+	// ErrOrganizationNotFound is returned when no organization of the blog matches
+	ErrOrganizationNotFound = errors.New("organization not found")
+
+	// ErrSlugExists is returned when another organization of the blog uses the slug
+	ErrSlugExists = errors.New("organization slug already exists")
+
+	// ErrMemberNotFound is returned when a user does not belong to an organization
+	ErrMemberNotFound = errors.New("organization member not found")
+
+	// ErrUserNotFound is returned when adding a member who is not a user
+	ErrUserNotFound = errors.New("user not found")
+
+	// ErrContentNotFound is returned when the post or theme to assign does not exist
+	ErrContentNotFound = errors.New("content not found")
+
+	// ErrUnsupportedContent is returned for resource types organizations cannot own
+	ErrUnsupportedContent = errors.New("organizations cannot own this resource type")
+)
+
+// OrganizationRepository persists organizations, their members and which content they own
+// Every operation is scoped to the request's blog
+type OrganizationRepository interface {
+	// Create stores a new organization
+	Create(ctx context.Context, org *domain.Organization) error
+
+	// Update saves changes to an organization
+	Update(ctx context.Context, org *domain.Organization) error
+
+	// Delete removes an organization; the content it owned goes back to its authors and curators
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// FindByID retrieves an organization
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.Organization, error)
+
+	// ListByMember returns the organizations a user belongs to, ordered by name
+	ListByMember(ctx context.Context, userID uuid.UUID) ([]*domain.Organization, error)
+
+	// ListMembers returns the members of an organization, earliest joined first
+	ListMembers(ctx context.Context, orgID uuid.UUID) ([]*domain.Member, error)
+
+	// FindMember returns one member of an organization
+	FindMember(ctx context.Context, orgID, userID uuid.UUID) (*domain.Member, error)
+
+	// SaveMember adds a member or changes the role of an existing one
+	SaveMember(ctx context.Context, member *domain.Member) error
+
+	// RemoveMember removes a member, returning ErrMemberNotFound if there was none
+	RemoveMember(ctx context.Context, orgID, userID uuid.UUID) error
+
+	// CountOwners counts the owners of an organization
+	CountOwners(ctx context.Context, orgID uuid.UUID) (int, error)
+
+	// ContentOrganization returns the organization owning a post or theme, or nil when none does
+	ContentOrganization(ctx context.Context, resourceType string, resourceID uuid.UUID) (*uuid.UUID, error)
+
+	// SetContentOrganization moves a post or theme into an organization, or out of one when orgID is nil
+	SetContentOrganization(ctx context.Context, resourceType string, resourceID uuid.UUID, orgID *uuid.UUID) error
+
+	// ContentMemberRole returns the role a user holds in the organization
+	// owning a post or theme, or ErrMemberNotFound when they hold none
+	ContentMemberRole(ctx context.Context, resourceType string, resourceID, userID uuid.UUID) (domain.MemberRole, error)
+}
@@ -0,0 +1,78 @@
+// Package actor carries the user a request acts as.
+// The authentication middleware stores the caller in the context; services
+// read it back so the events they publish name who actually made a change,
+// which is not necessarily the owner of what changed (an admin editing an
+// author's post, for instance). While staff impersonate a user, the context
+// acts as that user and also records who is really behind the request. A
+// service account acts through a user ID of its own, and the context marks
+// it as a machine so audits can tell it apart from people.
+package actor
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// contextKey is a private type so no other package can collide with the key
+type contextKey struct{}
+
+// impersonatorKey holds the user really making a request made as someone else
+type impersonatorKey struct{}
+
+// serviceAccountKey marks a request made by a service account
+type serviceAccountKey struct{}
+
+// WithUserID returns a copy of ctx acting as the given user
+func WithUserID(ctx context.Context, userID uuid.UUID) context.Context {
+	return context.WithValue(ctx, contextKey{}, userID)
+}
+
+// UserID returns the user ctx acts as, if any
+func UserID(ctx context.Context) (uuid.UUID, bool) {
+	userID, ok := ctx.Value(contextKey{}).(uuid.UUID)
+	return userID, ok
+}
+
+// UserIDOr returns the user ctx acts as, or fallback when no one is acting,
+// as in seeding and other background work
+func UserIDOr(ctx context.Context, fallback uuid.UUID) uuid.UUID {
+	if userID, ok := UserID(ctx); ok {
+		return userID
+	}
+	return fallback
+}
+
+// WithImpersonator returns a copy of ctx marking that the user it acts as is
+// being impersonated by impersonatorID
+func WithImpersonator(ctx context.Context, impersonatorID uuid.UUID) context.Context {
+	return context.WithValue(ctx, impersonatorKey{}, impersonatorID)
+}
+
+// Impersonator returns the user really making the request when ctx acts as
+// someone else, and false otherwise
+func Impersonator(ctx context.Context) (uuid.UUID, bool) {
+	impersonatorID, ok := ctx.Value(impersonatorKey{}).(uuid.UUID)
+	return impersonatorID, ok
+}
+
+// RealUserID returns the user really making the request: the impersonator
+// when there is one, otherwise the user ctx acts as
+func RealUserID(ctx context.Context) (uuid.UUID, bool) {
+	if impersonatorID, ok := Impersonator(ctx); ok {
+		return impersonatorID, true
+	}
+	return UserID(ctx)
+}
+
+// WithServiceAccount returns a copy of ctx marking that the user it acts as
+// is a service account rather than a person
+func WithServiceAccount(ctx context.Context) context.Context {
+	return context.WithValue(ctx, serviceAccountKey{}, true)
+}
+
+// IsServiceAccount reports whether ctx acts as a service account
+func IsServiceAccount(ctx context.Context) bool {
+	machine, _ := ctx.Value(serviceAccountKey{}).(bool)
+	return machine
+}
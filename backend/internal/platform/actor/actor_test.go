@@ -0,0 +1,45 @@
+package actor_test
+
+import (
+	"context"
+	"testing"
+
+	"backend/internal/platform/actor"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserIDOr(t *testing.T) {
+	author, admin := uuid.New(), uuid.New()
+
+	assert.Equal(t, author, actor.UserIDOr(context.Background(), author), "falls back outside a request")
+
+	ctx := actor.WithUserID(context.Background(), admin)
+	assert.Equal(t, admin, actor.UserIDOr(ctx, author), "the acting user wins over the fallback")
+}
+
+func TestRealUserID(t *testing.T) {
+	admin, author := uuid.New(), uuid.New()
+
+	ctx := actor.WithUserID(context.Background(), admin)
+	realID, ok := actor.RealUserID(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, admin, realID, "the acting user is the real one when no one is impersonated")
+
+	ctx = actor.WithImpersonator(actor.WithUserID(ctx, author), admin)
+	effective, _ := actor.UserID(ctx)
+	realID, _ = actor.RealUserID(ctx)
+	assert.Equal(t, author, effective)
+	assert.Equal(t, admin, realID)
+}
+
+func TestIsServiceAccount(t *testing.T) {
+	account := uuid.New()
+
+	ctx := actor.WithUserID(context.Background(), account)
+	assert.False(t, actor.IsServiceAccount(ctx), "callers are people unless marked")
+
+	ctx = actor.WithServiceAccount(ctx)
+	assert.True(t, actor.IsServiceAccount(ctx))
+	assert.Equal(t, account, actor.UserIDOr(ctx, uuid.Nil))
+}
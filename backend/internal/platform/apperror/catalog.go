@@ -0,0 +1,106 @@
+package apperror
+
+// ErrorCodes lists every ErrorCode, for publishing the error catalog to clients
+var ErrorCodes = []ErrorCode{
+	CodeNotFound,
+	CodeConflict,
+	CodeValidationFailed,
+	CodeForbidden,
+	CodeUnauthorized,
+	CodeInternalError,
+	CodeBadRequest,
+	CodeTooManyRequests,
+	CodePayloadTooLarge,
+	CodeNotImplemented,
+}
+
+// BusinessCodes lists every BusinessCode, for publishing the error catalog to clients
+var BusinessCodes = []BusinessCode{
+	BusinessCodeGeneral,
+	BusinessCodeFeatureDisabled,
+	BusinessCodeUserNotFound,
+	BusinessCodeEmailExists,
+	BusinessCodeUsernameExists,
+	BusinessCodeInvalidEmail,
+	BusinessCodeInvalidUsername,
+	BusinessCodeUsernameReserved,
+	BusinessCodeAccountSuspended,
+	BusinessCodeSupabaseIDExists,
+	BusinessCodeInvalidTimezone,
+	BusinessCodeRoleNotFound,
+	BusinessCodeRoleNameExists,
+	BusinessCodeRoleAlreadyAssigned,
+	BusinessCodeRoleNotAssigned,
+	BusinessCodeCannotUpdateSystem,
+	BusinessCodeCannotDeleteSystem,
+	BusinessCodeTemplateCannotAssign,
+	BusinessCodePermissionNotFound,
+	BusinessCodeInvalidPermission,
+	BusinessCodePermissionDenied,
+	BusinessCodeDuplicateChange,
+	BusinessCodeMissingRequiredField,
+	BusinessCodeInvalidFormat,
+	BusinessCodeValueTooLong,
+	BusinessCodeValueTooShort,
+	BusinessCodePostNotFound,
+	BusinessCodeSlugAlreadyExists,
+	BusinessCodeInvalidStatusTransition,
+	BusinessCodeCannotAddToTheme,
+	BusinessCodePostNotFeaturable,
+	BusinessCodeInvalidTranslation,
+	BusinessCodeTranslationLanguageExists,
+	BusinessCodeCommentsNotAllowed,
+	BusinessCodePostMembersOnly,
+	BusinessCodeThemeNotFound,
+	BusinessCodeThemeNameExists,
+	BusinessCodePostAlreadyInTheme,
+	BusinessCodePostNotInTheme,
+	BusinessCodePinLimitReached,
+	BusinessCodeCollaboratorNotFound,
+	BusinessCodeSeriesNotFound,
+	BusinessCodePostAlreadyInSeries,
+	BusinessCodePostNotInSeries,
+	BusinessCodePostNotOwnedByAuthor,
+	BusinessCodeReactionNotFound,
+	BusinessCodeInvalidReactionType,
+	BusinessCodeReactionTargetInvalid,
+	BusinessCodeBookmarkNotFound,
+	BusinessCodeNotFollowing,
+	BusinessCodeCannotFollowSelf,
+	BusinessCodeBlogNotFound,
+	BusinessCodeBlogAlreadyInUse,
+	BusinessCodeReportNotFound,
+	BusinessCodeReportInvalid,
+	BusinessCodeAlreadyReported,
+	BusinessCodeReportAlreadyClosed,
+	BusinessCodeSettingsNamespaceNotFound,
+	BusinessCodeSettingsInvalid,
+	BusinessCodeAPIClientNotFound,
+	BusinessCodeAPIClientLimitReached,
+	BusinessCodeInvalidAPIToken,
+	BusinessCodeQuotaExceeded,
+	BusinessCodeImpersonationNotFound,
+	BusinessCodeInvalidImpersonationToken,
+	BusinessCodeServiceAccountNotFound,
+	BusinessCodeInvalidClientCredentials,
+	BusinessCodeInvalidMachineToken,
+	BusinessCodeErasureRequestNotFound,
+	BusinessCodeErasureRequestOpen,
+	BusinessCodeErasureNotRetryable,
+	BusinessCodeErasureNotCompleted,
+	BusinessCodeDataExportNotFound,
+	BusinessCodeDataExportPending,
+	BusinessCodeDataExportNotReady,
+	BusinessCodeDataExportExpired,
+	BusinessCodeOrganizationNotFound,
+	BusinessCodeOrganizationMemberNotFound,
+	BusinessCodeLastOrganizationOwner,
+	BusinessCodeContentNotFound,
+	BusinessCodeAnnouncementNotFound,
+	BusinessCodeAnnouncementInvalid,
+	BusinessCodeFeedbackNotFound,
+	BusinessCodeFeedbackInvalid,
+	BusinessCodeMentionNotFound,
+	BusinessCodeMentionInvalid,
+	BusinessCodeRateLimited,
+}
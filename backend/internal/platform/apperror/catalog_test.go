@@ -0,0 +1,55 @@
+package apperror_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"testing"
+
+	"backend/internal/platform/apperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// declaredCodes reads the values of the constants of each code type from codes.go
+func declaredCodes(t *testing.T) map[string][]string {
+	t.Helper()
+	file, err := parser.ParseFile(token.NewFileSet(), "codes.go", nil, 0)
+	require.NoError(t, err)
+
+	codes := map[string][]string{}
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			value := spec.(*ast.ValueSpec)
+			typeName, ok := value.Type.(*ast.Ident)
+			if !ok || len(value.Values) != 1 {
+				continue
+			}
+			literal, err := strconv.Unquote(value.Values[0].(*ast.BasicLit).Value)
+			require.NoError(t, err)
+			codes[typeName.Name] = append(codes[typeName.Name], literal)
+		}
+	}
+	return codes
+}
+
+func TestCatalogListsEveryCode(t *testing.T) {
+	declared := declaredCodes(t)
+
+	errorCodes := make([]string, len(apperror.ErrorCodes))
+	for i, code := range apperror.ErrorCodes {
+		errorCodes[i] = string(code)
+	}
+	assert.ElementsMatch(t, declared["ErrorCode"], errorCodes)
+
+	businessCodes := make([]string, len(apperror.BusinessCodes))
+	for i, code := range apperror.BusinessCodes {
+		businessCodes[i] = string(code)
+	}
+	assert.ElementsMatch(t, declared["BusinessCode"], businessCodes)
+}
@@ -13,6 +13,9 @@ const (
 	CodeUnauthorized     ErrorCode = "UNAUTHORIZED"
 	CodeInternalError    ErrorCode = "INTERNAL_SERVER_ERROR"
 	CodeBadRequest       ErrorCode = "BAD_REQUEST"
+	CodeTooManyRequests  ErrorCode = "TOO_MANY_REQUESTS"
+	CodePayloadTooLarge  ErrorCode = "PAYLOAD_TOO_LARGE"
+	CodeNotImplemented   ErrorCode = "NOT_IMPLEMENTED"
 )
 
 // BusinessCode is the specific, fine-grained business reason.
@@ -20,7 +23,8 @@ type BusinessCode string
 
 const (
 	// Generic business codes
-	BusinessCodeGeneral BusinessCode = "GENERAL"
+	BusinessCodeGeneral         BusinessCode = "GENERAL"
+	BusinessCodeFeatureDisabled BusinessCode = "FEATURE_DISABLED"
 
 	// User-specific business codes
 	BusinessCodeUserNotFound     BusinessCode = "USER_NOT_FOUND"
@@ -28,8 +32,10 @@ const (
 	BusinessCodeUsernameExists   BusinessCode = "USERNAME_ALREADY_EXISTS"
 	BusinessCodeInvalidEmail     BusinessCode = "INVALID_EMAIL"
 	BusinessCodeInvalidUsername  BusinessCode = "INVALID_USERNAME"
+	BusinessCodeUsernameReserved BusinessCode = "USERNAME_RESERVED"
 	BusinessCodeAccountSuspended BusinessCode = "ACCOUNT_SUSPENDED"
 	BusinessCodeSupabaseIDExists BusinessCode = "SUPABASE_ID_ALREADY_EXISTS"
+	BusinessCodeInvalidTimezone  BusinessCode = "INVALID_TIMEZONE"
 
 	// Role-specific business codes
 	BusinessCodeRoleNotFound         BusinessCode = "ROLE_NOT_FOUND"
@@ -44,6 +50,7 @@ const (
 	BusinessCodePermissionNotFound BusinessCode = "PERMISSION_NOT_FOUND"
 	BusinessCodeInvalidPermission  BusinessCode = "INVALID_PERMISSION"
 	BusinessCodePermissionDenied   BusinessCode = "PERMISSION_DENIED"
+	BusinessCodeDuplicateChange    BusinessCode = "DUPLICATE_PERMISSION_CHANGE"
 
 	// Validation-specific business codes
 	BusinessCodeMissingRequiredField BusinessCode = "MISSING_REQUIRED_FIELD"
@@ -52,14 +59,101 @@ const (
 	BusinessCodeValueTooShort        BusinessCode = "VALUE_TOO_SHORT"
 
 	// Post-specific business codes
-	BusinessCodePostNotFound            BusinessCode = "POST_NOT_FOUND"
-	BusinessCodeSlugAlreadyExists       BusinessCode = "SLUG_ALREADY_EXISTS"
-	BusinessCodeInvalidStatusTransition BusinessCode = "INVALID_STATUS_TRANSITION"
-	BusinessCodeCannotAddToTheme        BusinessCode = "CANNOT_ADD_TO_THEME"
+	BusinessCodePostNotFound              BusinessCode = "POST_NOT_FOUND"
+	BusinessCodeSlugAlreadyExists         BusinessCode = "SLUG_ALREADY_EXISTS"
+	BusinessCodeInvalidStatusTransition   BusinessCode = "INVALID_STATUS_TRANSITION"
+	BusinessCodeCannotAddToTheme          BusinessCode = "CANNOT_ADD_TO_THEME"
+	BusinessCodePostNotFeaturable         BusinessCode = "POST_NOT_FEATURABLE"
+	BusinessCodeInvalidTranslation        BusinessCode = "INVALID_TRANSLATION"
+	BusinessCodeTranslationLanguageExists BusinessCode = "TRANSLATION_LANGUAGE_EXISTS"
+	BusinessCodeCommentsNotAllowed        BusinessCode = "COMMENTS_NOT_ALLOWED"
+	BusinessCodePostMembersOnly           BusinessCode = "POST_MEMBERS_ONLY"
 
 	// Theme-specific business codes
-	BusinessCodeThemeNotFound      BusinessCode = "THEME_NOT_FOUND"
-	BusinessCodeThemeNameExists    BusinessCode = "THEME_NAME_ALREADY_EXISTS"
-	BusinessCodePostAlreadyInTheme BusinessCode = "POST_ALREADY_IN_THEME"
-	BusinessCodePostNotInTheme     BusinessCode = "POST_NOT_IN_THEME"
+	BusinessCodeThemeNotFound        BusinessCode = "THEME_NOT_FOUND"
+	BusinessCodeThemeNameExists      BusinessCode = "THEME_NAME_ALREADY_EXISTS"
+	BusinessCodePostAlreadyInTheme   BusinessCode = "POST_ALREADY_IN_THEME"
+	BusinessCodePostNotInTheme       BusinessCode = "POST_NOT_IN_THEME"
+	BusinessCodePinLimitReached      BusinessCode = "PIN_LIMIT_REACHED"
+	BusinessCodeCollaboratorNotFound BusinessCode = "COLLABORATOR_NOT_FOUND"
+
+	// Series-specific business codes
+	BusinessCodeSeriesNotFound       BusinessCode = "SERIES_NOT_FOUND"
+	BusinessCodePostAlreadyInSeries  BusinessCode = "POST_ALREADY_IN_SERIES"
+	BusinessCodePostNotInSeries      BusinessCode = "POST_NOT_IN_SERIES"
+	BusinessCodePostNotOwnedByAuthor BusinessCode = "POST_NOT_OWNED_BY_AUTHOR"
+
+	// Reaction-specific business codes
+	BusinessCodeReactionNotFound      BusinessCode = "REACTION_NOT_FOUND"
+	BusinessCodeInvalidReactionType   BusinessCode = "INVALID_REACTION_TYPE"
+	BusinessCodeReactionTargetInvalid BusinessCode = "REACTION_TARGET_INVALID"
+
+	// Bookmark-specific business codes
+	BusinessCodeBookmarkNotFound BusinessCode = "BOOKMARK_NOT_FOUND"
+
+	// Follow-specific business codes
+	BusinessCodeNotFollowing     BusinessCode = "NOT_FOLLOWING"
+	BusinessCodeCannotFollowSelf BusinessCode = "CANNOT_FOLLOW_SELF"
+
+	// Blog-specific business codes
+	BusinessCodeBlogNotFound     BusinessCode = "BLOG_NOT_FOUND"
+	BusinessCodeBlogAlreadyInUse BusinessCode = "BLOG_SLUG_OR_HOST_IN_USE"
+
+	// Report-specific business codes
+	BusinessCodeReportNotFound      BusinessCode = "REPORT_NOT_FOUND"
+	BusinessCodeReportInvalid       BusinessCode = "REPORT_INVALID"
+	BusinessCodeAlreadyReported     BusinessCode = "ALREADY_REPORTED"
+	BusinessCodeReportAlreadyClosed BusinessCode = "REPORT_ALREADY_RESOLVED"
+
+	// Settings-specific business codes
+	BusinessCodeSettingsNamespaceNotFound BusinessCode = "SETTINGS_NAMESPACE_NOT_FOUND"
+	BusinessCodeSettingsInvalid           BusinessCode = "SETTINGS_INVALID"
+
+	// API client-specific business codes
+	BusinessCodeAPIClientNotFound     BusinessCode = "API_CLIENT_NOT_FOUND"
+	BusinessCodeAPIClientLimitReached BusinessCode = "API_CLIENT_LIMIT_REACHED"
+	BusinessCodeInvalidAPIToken       BusinessCode = "INVALID_API_TOKEN"
+
+	// Quota-specific business codes
+	BusinessCodeQuotaExceeded BusinessCode = "QUOTA_EXCEEDED"
+
+	// Impersonation-specific business codes
+	BusinessCodeImpersonationNotFound     BusinessCode = "IMPERSONATION_NOT_FOUND"
+	BusinessCodeInvalidImpersonationToken BusinessCode = "INVALID_IMPERSONATION_TOKEN"
+
+	// Service account-specific business codes
+	BusinessCodeServiceAccountNotFound   BusinessCode = "SERVICE_ACCOUNT_NOT_FOUND"
+	BusinessCodeInvalidClientCredentials BusinessCode = "INVALID_CLIENT_CREDENTIALS"
+	BusinessCodeInvalidMachineToken      BusinessCode = "INVALID_MACHINE_TOKEN"
+
+	// Privacy-specific business codes
+	BusinessCodeErasureRequestNotFound BusinessCode = "ERASURE_REQUEST_NOT_FOUND"
+	BusinessCodeErasureRequestOpen     BusinessCode = "ERASURE_REQUEST_OPEN"
+	BusinessCodeErasureNotRetryable    BusinessCode = "ERASURE_NOT_RETRYABLE"
+	BusinessCodeErasureNotCompleted    BusinessCode = "ERASURE_NOT_COMPLETED"
+	BusinessCodeDataExportNotFound     BusinessCode = "DATA_EXPORT_NOT_FOUND"
+	BusinessCodeDataExportPending      BusinessCode = "DATA_EXPORT_PENDING"
+	BusinessCodeDataExportNotReady     BusinessCode = "DATA_EXPORT_NOT_READY"
+	BusinessCodeDataExportExpired      BusinessCode = "DATA_EXPORT_EXPIRED"
+
+	// Organization-specific business codes
+	BusinessCodeOrganizationNotFound       BusinessCode = "ORGANIZATION_NOT_FOUND"
+	BusinessCodeOrganizationMemberNotFound BusinessCode = "ORGANIZATION_MEMBER_NOT_FOUND"
+	BusinessCodeLastOrganizationOwner      BusinessCode = "LAST_ORGANIZATION_OWNER"
+	BusinessCodeContentNotFound            BusinessCode = "CONTENT_NOT_FOUND"
+
+	// Announcement-specific business codes
+	BusinessCodeAnnouncementNotFound BusinessCode = "ANNOUNCEMENT_NOT_FOUND"
+	BusinessCodeAnnouncementInvalid  BusinessCode = "ANNOUNCEMENT_INVALID"
+
+	// Feedback-specific business codes
+	BusinessCodeFeedbackNotFound BusinessCode = "FEEDBACK_NOT_FOUND"
+	BusinessCodeFeedbackInvalid  BusinessCode = "FEEDBACK_INVALID"
+
+	// Webmention-specific business codes
+	BusinessCodeMentionNotFound BusinessCode = "MENTION_NOT_FOUND"
+	BusinessCodeMentionInvalid  BusinessCode = "MENTION_INVALID"
+
+	// Rate limiting business codes
+	BusinessCodeRateLimited BusinessCode = "RATE_LIMITED"
 )
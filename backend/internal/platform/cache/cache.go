@@ -0,0 +1,68 @@
+// Package cache provides a key-value cache port with Redis and in-memory adapters.
+// Values are opaque bytes; JSON helpers cover the common case of caching read models.
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrMiss is returned by Get when the key is absent or expired
+var ErrMiss = errors.New("cache miss")
+
+// Cache stores values under string keys with a time-to-live
+// Implementations must be safe for concurrent use
+type Cache interface {
+	// Get returns the value stored under key, or ErrMiss
+	Get(ctx context.Context, key string) ([]byte, error)
+
+	// Set stores value under key for ttl
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+
+	// Delete removes the keys; missing keys are ignored
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// Config selects the cache backend and the lifetime of each cached read path
+type Config struct {
+	RedisURL     string        // Empty selects the in-memory cache
+	PostTTL      time.Duration // Posts looked up by slug
+	ThemeTTL     time.Duration // Themes looked up by slug
+	ThemeListTTL time.Duration // Pages of active themes
+	SettingsTTL  time.Duration // Effective settings of a namespace
+	HomeTTL      time.Duration // Featured and latest posts on the home page
+	TrendingTTL  time.Duration // Trending posts on the home page; views move the ranking, so it only expires
+
+	// RenderedContentTTL keeps highlighted post content and share cards; entries are
+	// keyed by what they render, so this only bounds how long unused renderings linger
+	RenderedContentTTL time.Duration
+}
+
+// GetJSON decodes the value stored under key into dst
+// It reports false on a miss; a value that no longer decodes is treated as a miss
+func GetJSON(ctx context.Context, c Cache, key string, dst any) (bool, error) {
+	data, err := c.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, ErrMiss) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if err := json.Unmarshal(data, dst); err != nil {
+		return false, nil
+	}
+	return true, nil
+}
+
+// SetJSON encodes v and stores it under key for ttl
+func SetJSON(ctx context.Context, c Cache, key string, v any, ttl time.Duration) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("cache.SetJSON: encode %s: %w", key, err)
+	}
+	return c.Set(ctx, key, data, ttl)
+}
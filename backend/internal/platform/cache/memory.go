@@ -0,0 +1,89 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepInterval bounds how often expired entries are evicted
+const sweepInterval = time.Minute
+
+// entry is a stored value with its expiry
+type entry struct {
+	value   []byte
+	expires time.Time
+}
+
+// MemoryCache keeps entries in process memory
+// Each instance has its own contents, so it suits single-instance deployments and tests
+type MemoryCache struct {
+	entries map[string]entry
+	swept   time.Time    // Last time expired entries were evicted
+	mu      sync.RWMutex // Protects entries and swept
+	now     func() time.Time
+}
+
+// NewMemoryCache creates an empty in-memory cache
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{
+		entries: make(map[string]entry),
+		now:     time.Now,
+	}
+}
+
+// Get returns the value stored under key, or ErrMiss
+func (c *MemoryCache) Get(ctx context.Context, key string) ([]byte, error) {
+	c.mu.RLock()
+	e, found := c.entries[key]
+	c.mu.RUnlock()
+
+	if !found || !c.now().Before(e.expires) {
+		return nil, ErrMiss
+	}
+	return e.value, nil
+}
+
+// Set stores a copy of value under key for ttl
+func (c *MemoryCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	now := c.now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.evictExpired(now)
+	c.entries[key] = entry{
+		value:   append([]byte(nil), value...),
+		expires: now.Add(ttl),
+	}
+	return nil
+}
+
+// Delete removes the keys; missing keys are ignored
+func (c *MemoryCache) Delete(ctx context.Context, keys ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range keys {
+		delete(c.entries, key)
+	}
+	return nil
+}
+
+// evictExpired drops expired entries so keys that are never read again don't accumulate.
+// It runs at most once per sweepInterval to keep Set cheap. Caller must hold c.mu.
+func (c *MemoryCache) evictExpired(now time.Time) {
+	if now.Sub(c.swept) < sweepInterval {
+		return
+	}
+	c.swept = now
+
+	for key, e := range c.entries {
+		if !now.Before(e.expires) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// Ensure MemoryCache implements Cache
+var _ Cache = (*MemoryCache)(nil)
@@ -0,0 +1,89 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryCache_GetSetDelete(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewMemoryCache()
+	c.now = func() time.Time { return now }
+
+	_, err := c.Get(ctx, "post:a")
+	assert.ErrorIs(t, err, ErrMiss)
+
+	require.NoError(t, c.Set(ctx, "post:a", []byte("one"), time.Minute))
+	value, err := c.Get(ctx, "post:a")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("one"), value)
+
+	// Entries expire after their TTL
+	now = now.Add(time.Minute)
+	_, err = c.Get(ctx, "post:a")
+	assert.ErrorIs(t, err, ErrMiss)
+
+	require.NoError(t, c.Set(ctx, "post:b", []byte("two"), time.Minute))
+	require.NoError(t, c.Delete(ctx, "post:b", "post:missing"))
+	_, err = c.Get(ctx, "post:b")
+	assert.ErrorIs(t, err, ErrMiss)
+}
+
+func TestMemoryCache_EvictsExpiredEntries(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewMemoryCache()
+	c.now = func() time.Time { return now }
+
+	require.NoError(t, c.Set(ctx, "post:a", []byte("one"), time.Second))
+	require.NoError(t, c.Set(ctx, "post:b", []byte("two"), time.Second))
+
+	now = now.Add(2 * sweepInterval)
+	require.NoError(t, c.Set(ctx, "post:c", []byte("three"), time.Second))
+
+	assert.Len(t, c.entries, 1)
+}
+
+func TestInstrumented_CountsOutcomes(t *testing.T) {
+	ctx := context.Background()
+	c := NewInstrumented(NewMemoryCache())
+	before := Stats()
+
+	_, _ = c.Get(ctx, "metrics-test:a")
+	require.NoError(t, c.Set(ctx, "metrics-test:a", []byte("one"), time.Minute))
+	_, _ = c.Get(ctx, "metrics-test:a")
+	_, _ = c.Get(ctx, "metrics-test:a")
+	require.NoError(t, c.Delete(ctx, "metrics-test:a"))
+
+	after := Stats()
+	assert.Equal(t, int64(2), after["metrics-test.hits"]-before["metrics-test.hits"])
+	assert.Equal(t, int64(1), after["metrics-test.misses"]-before["metrics-test.misses"])
+	assert.Equal(t, int64(1), after["metrics-test.invalidations"]-before["metrics-test.invalidations"])
+}
+
+func TestGetJSON(t *testing.T) {
+	ctx := context.Background()
+	c := NewMemoryCache()
+
+	var got struct{ Name string }
+	found, err := GetJSON(ctx, c, "theme:a", &got)
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	require.NoError(t, SetJSON(ctx, c, "theme:a", struct{ Name string }{Name: "Go"}, time.Minute))
+	found, err = GetJSON(ctx, c, "theme:a", &got)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "Go", got.Name)
+
+	// Values that no longer decode are treated as misses
+	require.NoError(t, c.Set(ctx, "theme:b", []byte("{"), time.Minute))
+	found, err = GetJSON(ctx, c, "theme:b", &got)
+	require.NoError(t, err)
+	assert.False(t, found)
+}
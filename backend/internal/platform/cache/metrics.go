@@ -0,0 +1,75 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"strings"
+	"time"
+)
+
+// stats counts lookups per key namespace, e.g. "post.hits" and "post.misses".
+// It is published through expvar under "cache" for scraping.
+var stats = expvar.NewMap("cache")
+
+// Instrumented wraps a Cache and records hits, misses and errors
+// Keys are grouped by namespace, the part before the first colon
+type Instrumented struct {
+	next Cache
+}
+
+// NewInstrumented wraps next with hit/miss counters
+func NewInstrumented(next Cache) *Instrumented {
+	return &Instrumented{next: next}
+}
+
+// Get returns the value stored under key, or ErrMiss, and records the outcome
+func (c *Instrumented) Get(ctx context.Context, key string) ([]byte, error) {
+	value, err := c.next.Get(ctx, key)
+	switch {
+	case err == nil:
+		stats.Add(namespace(key)+".hits", 1)
+	case errors.Is(err, ErrMiss):
+		stats.Add(namespace(key)+".misses", 1)
+	default:
+		stats.Add(namespace(key)+".errors", 1)
+	}
+	return value, err
+}
+
+// Set stores value under key for ttl
+func (c *Instrumented) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	err := c.next.Set(ctx, key, value, ttl)
+	if err != nil {
+		stats.Add(namespace(key)+".errors", 1)
+	}
+	return err
+}
+
+// Delete removes the keys and records them as invalidations
+func (c *Instrumented) Delete(ctx context.Context, keys ...string) error {
+	for _, key := range keys {
+		stats.Add(namespace(key)+".invalidations", 1)
+	}
+	return c.next.Delete(ctx, keys...)
+}
+
+// Stats returns a snapshot of the counters, keyed by "<namespace>.<outcome>"
+func Stats() map[string]int64 {
+	snapshot := make(map[string]int64)
+	stats.Do(func(kv expvar.KeyValue) {
+		if counter, ok := kv.Value.(*expvar.Int); ok {
+			snapshot[kv.Key] = counter.Value()
+		}
+	})
+	return snapshot
+}
+
+// namespace returns the part of key before the first colon
+func namespace(key string) string {
+	ns, _, _ := strings.Cut(key, ":")
+	return ns
+}
+
+// Ensure Instrumented implements Cache
+var _ Cache = (*Instrumented)(nil)
@@ -0,0 +1,44 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend/internal/platform/logger"
+	"github.com/redis/go-redis/v9"
+)
+
+// connectTimeout bounds the startup ping to Redis
+const connectTimeout = 5 * time.Second
+
+// ProvideCache builds the configured cache, wrapped with hit/miss metrics.
+// With a Redis URL the connection is verified at startup, so a misconfigured
+// cache fails fast instead of degrading every request.
+func ProvideCache(ctx context.Context, cfg Config, log logger.Logger) (Cache, func(), error) {
+	if cfg.RedisURL == "" {
+		log.Info(ctx, "using in-memory cache")
+		return NewInstrumented(NewMemoryCache()), func() {}, nil
+	}
+
+	opts, err := redis.ParseURL(cfg.RedisURL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cache.ProvideCache: parse REDIS_URL: %w", err)
+	}
+
+	client := redis.NewClient(opts)
+	pingCtx, cancel := context.WithTimeout(ctx, connectTimeout)
+	defer cancel()
+	if err := client.Ping(pingCtx).Err(); err != nil {
+		_ = client.Close()
+		return nil, nil, fmt.Errorf("cache.ProvideCache: ping redis: %w", err)
+	}
+
+	log.Info(ctx, "connected to redis cache", "addr", opts.Addr)
+	cleanup := func() {
+		if err := client.Close(); err != nil {
+			log.Error(context.Background(), "failed to close redis client", "error", err)
+		}
+	}
+	return NewInstrumented(NewRedisCache(client)), cleanup, nil
+}
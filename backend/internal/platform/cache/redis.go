@@ -0,0 +1,54 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisCache stores entries in Redis, so all instances of the API share them
+type RedisCache struct {
+	client *redis.Client
+}
+
+// NewRedisCache creates a cache backed by the given Redis client
+func NewRedisCache(client *redis.Client) *RedisCache {
+	return &RedisCache{client: client}
+}
+
+// Get returns the value stored under key, or ErrMiss
+func (c *RedisCache) Get(ctx context.Context, key string) ([]byte, error) {
+	value, err := c.client.Get(ctx, key).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrMiss
+		}
+		return nil, fmt.Errorf("RedisCache.Get: %w", err)
+	}
+	return value, nil
+}
+
+// Set stores value under key for ttl
+func (c *RedisCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if err := c.client.Set(ctx, key, value, ttl).Err(); err != nil {
+		return fmt.Errorf("RedisCache.Set: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the keys; missing keys are ignored
+func (c *RedisCache) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	if err := c.client.Del(ctx, keys...).Err(); err != nil {
+		return fmt.Errorf("RedisCache.Delete: %w", err)
+	}
+	return nil
+}
+
+// Ensure RedisCache implements Cache
+var _ Cache = (*RedisCache)(nil)
@@ -0,0 +1,144 @@
+// Package clientip carries the address of the client a request came from.
+// Behind a load balancer the TCP peer is the balancer, so the HTTP layer
+// resolves the client from forwarding headers, trusting them only when the
+// peer is a configured proxy, and stores the result in the context for
+// logging and rate limiting.
+package clientip
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// Forwarding headers set by proxies
+const (
+	HeaderForwardedFor = "X-Forwarded-For"
+	HeaderRealIP       = "X-Real-IP"
+)
+
+// contextKey is a private type so no other package can collide with the key
+type contextKey struct{}
+
+// WithIP returns a copy of ctx carrying the client IP
+func WithIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, contextKey{}, ip)
+}
+
+// FromContext returns the client IP ctx carries, or "" when there is none
+func FromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(contextKey{}).(string)
+	return ip
+}
+
+// Resolver finds the client address of a request
+type Resolver struct {
+	trusted []netip.Prefix
+}
+
+// NewResolver creates a resolver trusting forwarding headers from the given
+// proxies, each a CIDR or a single address. With none, the peer is the client.
+func NewResolver(trustedProxies []string) (*Resolver, error) {
+	r := &Resolver{}
+	for _, proxy := range trustedProxies {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+		if !strings.Contains(proxy, "/") {
+			addr, err := netip.ParseAddr(proxy)
+			if err != nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q: %w", proxy, err)
+			}
+			r.trusted = append(r.trusted, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", proxy, err)
+		}
+		r.trusted = append(r.trusted, prefix.Masked())
+	}
+	return r, nil
+}
+
+// Resolve returns the client IP of the request.
+//
+// Forwarding headers are read only when the peer is a trusted proxy. The
+// X-Forwarded-For chain is walked from the right, skipping trusted proxies,
+// since only the entries they appended can be believed; the first untrusted
+// entry is the client. X-Real-IP is used when there is no chain.
+func (r *Resolver) Resolve(req *http.Request) string {
+	peer, ok := parseAddr(hostOf(req.RemoteAddr))
+	if !ok {
+		return req.RemoteAddr
+	}
+	if !r.isTrusted(peer) {
+		return peer.String()
+	}
+
+	hops := forwardedHops(req.Header.Values(HeaderForwardedFor))
+	if len(hops) == 0 {
+		if realIP, ok := parseAddr(req.Header.Get(HeaderRealIP)); ok {
+			return realIP.String()
+		}
+		return peer.String()
+	}
+
+	client := peer
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop, ok := parseAddr(hops[i])
+		if !ok {
+			// A malformed entry cannot be attributed; stop at the last good hop
+			break
+		}
+		client = hop
+		if !r.isTrusted(hop) {
+			break
+		}
+	}
+	return client.String()
+}
+
+// isTrusted reports whether addr belongs to a trusted proxy
+func (r *Resolver) isTrusted(addr netip.Addr) bool {
+	for _, prefix := range r.trusted {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// forwardedHops splits X-Forwarded-For values into their entries, in order
+func forwardedHops(values []string) []string {
+	var hops []string
+	for _, value := range values {
+		for _, hop := range strings.Split(value, ",") {
+			if hop = strings.TrimSpace(hop); hop != "" {
+				hops = append(hops, hop)
+			}
+		}
+	}
+	return hops
+}
+
+// hostOf strips the port from a host:port address, if it has one
+func hostOf(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+// parseAddr parses an IP, accepting an optional port and IPv4-mapped IPv6 forms
+func parseAddr(s string) (netip.Addr, bool) {
+	addr, err := netip.ParseAddr(strings.Trim(hostOf(strings.TrimSpace(s)), "[]"))
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addr.Unmap(), true
+}
@@ -0,0 +1,73 @@
+package clientip_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"backend/internal/platform/clientip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContext(t *testing.T) {
+	assert.Empty(t, clientip.FromContext(context.Background()))
+	assert.Equal(t, "203.0.113.7", clientip.FromContext(clientip.WithIP(context.Background(), "203.0.113.7")))
+}
+
+func TestNewResolverRejectsInvalidProxies(t *testing.T) {
+	_, err := clientip.NewResolver([]string{"10.0.0.0/8", "not-an-ip"})
+	assert.Error(t, err)
+
+	_, err = clientip.NewResolver([]string{"10.0.0.0/33"})
+	assert.Error(t, err)
+}
+
+func TestResolve(t *testing.T) {
+	resolver, err := clientip.NewResolver([]string{"10.0.0.0/8", "192.0.2.1", ""})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		peer      string
+		forwarded []string
+		realIP    string
+		want      string
+	}{
+		{"untrusted peer ignores headers", "198.51.100.9:5000", []string{"203.0.113.7"}, "203.0.113.8", "198.51.100.9"},
+		{"trusted peer without headers", "10.1.2.3:5000", nil, "", "10.1.2.3"},
+		{"single forwarded hop", "10.1.2.3:5000", []string{"203.0.113.7"}, "", "203.0.113.7"},
+		{"spoofed entries left of the client are ignored", "10.1.2.3:5000", []string{"1.1.1.1, 203.0.113.7"}, "", "203.0.113.7"},
+		{"trusted hops are skipped", "10.1.2.3:5000", []string{"203.0.113.7, 192.0.2.1", "10.9.9.9"}, "", "203.0.113.7"},
+		{"all hops trusted", "10.1.2.3:5000", []string{"10.4.4.4, 10.5.5.5"}, "", "10.4.4.4"},
+		{"malformed hop stops the walk", "10.1.2.3:5000", []string{"203.0.113.7, garbage, 10.5.5.5"}, "", "10.5.5.5"},
+		{"forwarded hop with port", "10.1.2.3:5000", []string{"203.0.113.7:4711"}, "", "203.0.113.7"},
+		{"ipv6 hop", "10.1.2.3:5000", []string{"[2001:db8::1]:443"}, "", "2001:db8::1"},
+		{"real IP without chain", "10.1.2.3:5000", nil, "203.0.113.8", "203.0.113.8"},
+		{"ipv4-mapped peer", "[::ffff:198.51.100.9]:5000", nil, "", "198.51.100.9"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/posts", nil)
+			req.RemoteAddr = tt.peer
+			for _, value := range tt.forwarded {
+				req.Header.Add(clientip.HeaderForwardedFor, value)
+			}
+			if tt.realIP != "" {
+				req.Header.Set(clientip.HeaderRealIP, tt.realIP)
+			}
+			assert.Equal(t, tt.want, resolver.Resolve(req))
+		})
+	}
+}
+
+func TestResolveWithoutTrustedProxies(t *testing.T) {
+	resolver, err := clientip.NewResolver(nil)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/posts", nil)
+	req.RemoteAddr = "10.1.2.3:5000"
+	req.Header.Set(clientip.HeaderForwardedFor, "203.0.113.7")
+	assert.Equal(t, "10.1.2.3", resolver.Resolve(req))
+}
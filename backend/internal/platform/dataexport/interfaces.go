@@ -0,0 +1,31 @@
+package dataexport
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Exporter gathers the data one bounded context holds about a user, for the
+// copy of their data a user may ask for. Whatever it returns is written to
+// the archive as JSON, so it should carry json tags.
+type Exporter interface {
+	// Export returns the user's data; a user with none gets an empty value, not an error
+	Export(ctx context.Context, userID uuid.UUID) (any, error)
+}
+
+// Section is an exporter as registered under its name
+type Section struct {
+	Name     string
+	Exporter Exporter
+}
+
+// Registry holds the exporters of every context holding personal data
+// The privacy module writes each one's data to its own file of the archive
+type Registry interface {
+	// Register adds an exporter under a name, which becomes the file name of its section
+	Register(name string, exporter Exporter)
+
+	// Sections returns the registered exporters in registration order
+	Sections() []Section
+}
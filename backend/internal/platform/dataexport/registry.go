@@ -0,0 +1,37 @@
+package dataexport
+
+import (
+	"fmt"
+	"sync"
+)
+
+// DefaultRegistry is the default implementation of Registry
+type DefaultRegistry struct {
+	sections []Section
+	mu       sync.RWMutex
+}
+
+// NewRegistry creates a new data export registry
+func NewRegistry() *DefaultRegistry {
+	return &DefaultRegistry{}
+}
+
+// Register adds an exporter under a name. Names become file names in the
+// archive, so registering one twice is a programming error and panics.
+func (r *DefaultRegistry) Register(name string, exporter Exporter) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, section := range r.sections {
+		if section.Name == name {
+			panic(fmt.Sprintf("dataexport: exporter %q registered twice", name))
+		}
+	}
+	r.sections = append(r.sections, Section{Name: name, Exporter: exporter})
+}
+
+// Sections returns a copy of the registered exporters in registration order
+func (r *DefaultRegistry) Sections() []Section {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]Section(nil), r.sections...)
+}
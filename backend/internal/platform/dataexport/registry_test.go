@@ -0,0 +1,33 @@
+package dataexport
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// fixed exports the same value for every user
+type fixed string
+
+func (f fixed) Export(context.Context, uuid.UUID) (any, error) {
+	return string(f), nil
+}
+
+func TestDefaultRegistry_Sections(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register("profile", fixed("ada"))
+	registry.Register("posts", fixed("[]"))
+
+	sections := registry.Sections()
+	if assert.Len(t, sections, 2) {
+		assert.Equal(t, "profile", sections[0].Name, "sections keep registration order")
+		assert.Equal(t, "posts", sections[1].Name)
+	}
+
+	sections[0].Name = "changed"
+	assert.Equal(t, "profile", registry.Sections()[0].Name, "callers get a copy")
+
+	assert.Panics(t, func() { registry.Register("posts", fixed("")) })
+}
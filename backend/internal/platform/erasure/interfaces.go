@@ -0,0 +1,40 @@
+package erasure
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Subject is the user whose personal data is being erased
+type Subject struct {
+	// UserID identifies the user; it stays in place so that records keep
+	// pointing at the same, now anonymous, account
+	UserID uuid.UUID
+	// Pseudonym replaces the user's name wherever a name is displayed or copied
+	Pseudonym string
+}
+
+// Eraser removes or pseudonymizes the personal data one bounded context
+// holds about a subject. It must be idempotent: an erasure interrupted part
+// way is run again from the first eraser that had not finished.
+type Eraser interface {
+	// Erase erases the subject's data and returns how many records it changed
+	Erase(ctx context.Context, subject Subject) (int, error)
+}
+
+// Step is an eraser as registered under its name
+type Step struct {
+	Name   string
+	Eraser Eraser
+}
+
+// Registry holds the erasers of every context holding personal data
+// The privacy module runs them, in registration order, for each erasure request
+type Registry interface {
+	// Register adds an eraser under a name recorded in erasure progress and certificates
+	Register(name string, eraser Eraser)
+
+	// Steps returns the registered erasers in registration order
+	Steps() []Step
+}
@@ -0,0 +1,9 @@
+package erasure
+
+import "github.com/google/wire"
+
+// ProviderSet is the wire provider set for the erasure registry
+var ProviderSet = wire.NewSet(
+	NewRegistry,
+	wire.Bind(new(Registry), new(*DefaultRegistry)),
+)
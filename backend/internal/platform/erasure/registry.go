@@ -0,0 +1,37 @@
+package erasure
+
+import (
+	"fmt"
+	"sync"
+)
+
+// DefaultRegistry is the default implementation of Registry
+type DefaultRegistry struct {
+	steps []Step
+	mu    sync.RWMutex
+}
+
+// NewRegistry creates a new erasure registry
+func NewRegistry() *DefaultRegistry {
+	return &DefaultRegistry{}
+}
+
+// Register adds an eraser under a name. Names are recorded with every
+// erasure, so registering one twice is a programming error and panics.
+func (r *DefaultRegistry) Register(name string, eraser Eraser) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, step := range r.steps {
+		if step.Name == name {
+			panic(fmt.Sprintf("erasure: eraser %q registered twice", name))
+		}
+	}
+	r.steps = append(r.steps, Step{Name: name, Eraser: eraser})
+}
+
+// Steps returns a copy of the registered erasers in registration order
+func (r *DefaultRegistry) Steps() []Step {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]Step(nil), r.steps...)
+}
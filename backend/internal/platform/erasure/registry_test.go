@@ -0,0 +1,32 @@
+package erasure
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// counting erases a fixed number of records
+type counting int
+
+func (c counting) Erase(context.Context, Subject) (int, error) {
+	return int(c), nil
+}
+
+func TestDefaultRegistry_Steps(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register("users", counting(1))
+	registry.Register("posts", counting(3))
+
+	steps := registry.Steps()
+	if assert.Len(t, steps, 2) {
+		assert.Equal(t, "users", steps[0].Name, "erasers run in registration order")
+		assert.Equal(t, "posts", steps[1].Name)
+	}
+
+	steps[0].Name = "changed"
+	assert.Equal(t, "users", registry.Steps()[0].Name, "callers get a copy")
+
+	assert.Panics(t, func() { registry.Register("posts", counting(0)) })
+}
@@ -0,0 +1,36 @@
+// Package errreport sends unexpected errors to an error tracking service.
+//
+// Expected failures, such as validation errors and missing resources, are
+// answered to the caller and never reported. What reaches a Reporter is the
+// rest: errors the HTTP layer turns into a 500 and failing event handlers.
+// Reports carry the request ID, trace ID and acting user from the context so
+// an issue in the tracker can be matched to the logs of the same request.
+package errreport
+
+import (
+	"context"
+	"net/http"
+)
+
+// Report describes one unexpected error
+type Report struct {
+	Err     error
+	Message string            // What was being done when the error surfaced
+	Request *http.Request     // The request being served, if any
+	Tags    map[string]string // Searchable labels, such as the event topic
+}
+
+// Reporter sends reports to an error tracking service.
+// Reporting must never fail the caller, so delivery problems are only logged.
+type Reporter interface {
+	Report(ctx context.Context, report Report)
+}
+
+// Nop discards every report, for when no tracking service is configured
+type Nop struct{}
+
+// Report does nothing
+func (Nop) Report(context.Context, Report) {}
+
+// Ensure Nop implements Reporter
+var _ Reporter = Nop{}
@@ -0,0 +1,50 @@
+package errreport
+
+import (
+	"context"
+	"time"
+
+	"backend/internal/platform/logger"
+)
+
+// closeTimeout bounds how long shutdown waits for queued reports to be sent
+const closeTimeout = 5 * time.Second
+
+// Config selects and tunes error reporting
+type Config struct {
+	DSN         string // Reporting is enabled only when a DSN is set
+	Environment string
+	Release     string
+	SampleRate  float64
+	ScrubFields []string // Added to DefaultScrubFields
+	SendPII     bool
+}
+
+// ProvideReporter creates the error reporter: Sentry when a DSN is configured,
+// otherwise one that discards reports. The cleanup sends what is still queued.
+func ProvideReporter(cfg Config, log logger.Logger) (Reporter, func(), error) {
+	if cfg.DSN == "" {
+		log.Info(context.Background(), "error reporting disabled; set ERROR_REPORT_DSN to enable it")
+		return Nop{}, func() {}, nil
+	}
+
+	fields := append(append([]string{}, DefaultScrubFields...), cfg.ScrubFields...)
+	reporter, err := NewSentryReporter(cfg.DSN, SentryOptions{
+		Environment: cfg.Environment,
+		Release:     cfg.Release,
+		SampleRate:  cfg.SampleRate,
+		Scrubber:    NewScrubber(fields, cfg.SendPII),
+	}, log)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cleanup := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), closeTimeout)
+		defer cancel()
+		if err := reporter.Close(ctx); err != nil {
+			log.Warn(ctx, "error reports still queued at shutdown were dropped", "error", err)
+		}
+	}
+	return reporter, cleanup, nil
+}
@@ -0,0 +1,106 @@
+package errreport
+
+import (
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Filtered replaces values the scrubber removed
+const Filtered = "[Filtered]"
+
+// DefaultScrubFields are the name fragments whose values are never reported
+var DefaultScrubFields = []string{"authorization", "cookie", "password", "secret", "token", "api_key", "apikey"}
+
+// emailPattern finds email addresses in error messages
+var emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+
+// Scrubber removes personal and secret data from reports before they leave the
+// service. Header, query parameter and tag values are filtered when their name
+// contains one of the fields; without sendPII, email addresses in messages are
+// masked and the client IP is left out.
+type Scrubber struct {
+	fields  []string
+	sendPII bool
+}
+
+// NewScrubber creates a scrubber filtering the given name fragments, matched
+// case-insensitively
+func NewScrubber(fields []string, sendPII bool) *Scrubber {
+	lowered := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if field = strings.ToLower(strings.TrimSpace(field)); field != "" {
+			lowered = append(lowered, field)
+		}
+	}
+	return &Scrubber{fields: lowered, sendPII: sendPII}
+}
+
+// sensitive reports whether values named name must be filtered
+func (s *Scrubber) sensitive(name string) bool {
+	name = strings.ToLower(name)
+	for _, field := range s.fields {
+		if strings.Contains(name, field) {
+			return true
+		}
+	}
+	return false
+}
+
+// Text masks email addresses in free text unless PII may be sent
+func (s *Scrubber) Text(text string) string {
+	if s.sendPII {
+		return text
+	}
+	return emailPattern.ReplaceAllString(text, Filtered)
+}
+
+// Headers returns the request headers with sensitive values filtered
+func (s *Scrubber) Headers(header http.Header) map[string]string {
+	headers := make(map[string]string, len(header))
+	for name := range header {
+		if s.sensitive(name) {
+			headers[name] = Filtered
+			continue
+		}
+		headers[name] = header.Get(name)
+	}
+	return headers
+}
+
+// Query returns a query string with sensitive parameters filtered
+func (s *Scrubber) Query(rawQuery string) string {
+	values, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return Filtered
+	}
+	for name := range values {
+		if s.sensitive(name) {
+			values[name] = []string{Filtered}
+		} else {
+			for i, value := range values[name] {
+				values[name][i] = s.Text(value)
+			}
+		}
+	}
+	return values.Encode()
+}
+
+// Tags returns the tags with sensitive values filtered
+func (s *Scrubber) Tags(tags map[string]string) map[string]string {
+	scrubbed := make(map[string]string, len(tags))
+	for name, value := range tags {
+		if s.sensitive(name) {
+			scrubbed[name] = Filtered
+			continue
+		}
+		scrubbed[name] = s.Text(value)
+	}
+	return scrubbed
+}
+
+// SendPII reports whether personal data such as the client IP may be sent
+func (s *Scrubber) SendPII() bool {
+	return s.sendPII
+}
@@ -0,0 +1,321 @@
+package errreport
+
+import (
+	"bytes"
+	"context"
+	cryptorand "crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"backend/internal/platform/actor"
+	"backend/internal/platform/clientip"
+	"backend/internal/platform/logger"
+	"backend/internal/platform/requestid"
+)
+
+const (
+	// sentryQueueSize bounds the reports waiting to be sent; more are dropped
+	sentryQueueSize = 100
+
+	// maxStackFrames bounds the stack trace captured for each report
+	maxStackFrames = 64
+
+	// modulePrefix marks the application's own code in stack traces
+	modulePrefix = "backend/"
+)
+
+// SentryOptions tunes what a SentryReporter sends
+type SentryOptions struct {
+	Environment string
+	Release     string
+	SampleRate  float64 // Share of reports sent, from 0 to 1
+	Scrubber    *Scrubber
+}
+
+// SentryReporter sends reports to Sentry, or any service speaking its store API.
+// Reports are queued and posted by a background worker, so reporting never
+// holds up a request; Close sends what is still queued.
+type SentryReporter struct {
+	endpoint string
+	auth     string
+	options  SentryOptions
+	client   *http.Client
+	logger   logger.Logger
+	sample   func() float64
+
+	queue     chan sentryEvent
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewSentryReporter creates a reporter for the project a DSN names, such as
+// https://<key>@o0.ingest.sentry.io/<project>, and starts its worker
+func NewSentryReporter(dsn string, options SentryOptions, log logger.Logger) (*SentryReporter, error) {
+	endpoint, key, err := parseDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+	if options.Scrubber == nil {
+		options.Scrubber = NewScrubber(DefaultScrubFields, false)
+	}
+
+	r := &SentryReporter{
+		endpoint: endpoint,
+		auth:     fmt.Sprintf("Sentry sentry_version=7, sentry_client=arch-blog/1.0, sentry_key=%s", key),
+		options:  options,
+		client:   &http.Client{Timeout: 5 * time.Second},
+		logger:   log,
+		sample:   rand.Float64,
+		queue:    make(chan sentryEvent, sentryQueueSize),
+		done:     make(chan struct{}),
+	}
+	go r.run()
+	return r, nil
+}
+
+// parseDSN returns the store endpoint and public key of a DSN
+func parseDSN(dsn string) (endpoint, key string, err error) {
+	parsed, err := url.Parse(dsn)
+	if err != nil {
+		return "", "", fmt.Errorf("errreport: parse DSN: %w", err)
+	}
+	key = parsed.User.Username()
+	path := strings.Trim(parsed.Path, "/")
+	slash := strings.LastIndex(path, "/")
+	project := path[slash+1:]
+	if key == "" || parsed.Host == "" || project == "" {
+		return "", "", errors.New("errreport: DSN must name a key, host and project")
+	}
+
+	prefix := ""
+	if slash >= 0 {
+		prefix = "/" + path[:slash]
+	}
+	return fmt.Sprintf("%s://%s%s/api/%s/store/", parsed.Scheme, parsed.Host, prefix, project), key, nil
+}
+
+// Report queues a report, subject to sampling. The stack trace is the caller's.
+func (r *SentryReporter) Report(ctx context.Context, report Report) {
+	if report.Err == nil || r.sample() >= r.options.SampleRate {
+		return
+	}
+
+	event := r.buildEvent(ctx, report)
+	select {
+	case r.queue <- event:
+	default:
+		r.logger.Warn(ctx, "error report dropped, queue full", "event_id", event.EventID)
+	}
+}
+
+// Close stops accepting reports and waits for the queued ones to be sent, or for ctx to end
+func (r *SentryReporter) Close(ctx context.Context) error {
+	r.closeOnce.Do(func() { close(r.queue) })
+	select {
+	case <-r.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// run sends queued events until the queue is closed
+func (r *SentryReporter) run() {
+	defer close(r.done)
+	for event := range r.queue {
+		if err := r.send(event); err != nil {
+			r.logger.Warn(context.Background(), "failed to send error report", "error", err, "event_id", event.EventID)
+		}
+	}
+}
+
+// send posts one event to the store endpoint
+func (r *SentryReporter) send(event sentryEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("SentryReporter.send: encode: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, r.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("SentryReporter.send: build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Sentry-Auth", r.auth)
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("SentryReporter.send: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("SentryReporter.send: unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// sentryEvent is the subset of Sentry's event payload the reporter fills in
+type sentryEvent struct {
+	EventID     string            `json:"event_id"`
+	Timestamp   string            `json:"timestamp"`
+	Platform    string            `json:"platform"`
+	Level       string            `json:"level"`
+	Environment string            `json:"environment,omitempty"`
+	Release     string            `json:"release,omitempty"`
+	Message     string            `json:"message,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty"`
+	User        *sentryUser       `json:"user,omitempty"`
+	Request     *sentryRequest    `json:"request,omitempty"`
+	Exception   sentryExceptions  `json:"exception"`
+}
+
+type sentryUser struct {
+	ID        string `json:"id,omitempty"`
+	IPAddress string `json:"ip_address,omitempty"`
+}
+
+type sentryRequest struct {
+	Method      string            `json:"method"`
+	URL         string            `json:"url"`
+	QueryString string            `json:"query_string,omitempty"`
+	Headers     map[string]string `json:"headers,omitempty"`
+}
+
+type sentryExceptions struct {
+	Values []sentryException `json:"values"`
+}
+
+type sentryException struct {
+	Type       string            `json:"type"`
+	Value      string            `json:"value"`
+	Stacktrace *sentryStacktrace `json:"stacktrace,omitempty"`
+}
+
+type sentryStacktrace struct {
+	Frames []sentryFrame `json:"frames"`
+}
+
+type sentryFrame struct {
+	Function string `json:"function"`
+	Module   string `json:"module,omitempty"`
+	Filename string `json:"filename"`
+	Lineno   int    `json:"lineno"`
+	InApp    bool   `json:"in_app"`
+}
+
+// buildEvent describes a report with what the context knows about the request,
+// scrubbed. Called from Report, so the stack skips Report and buildEvent.
+func (r *SentryReporter) buildEvent(ctx context.Context, report Report) sentryEvent {
+	scrub := r.options.Scrubber
+
+	tags := scrub.Tags(report.Tags)
+	if id := requestid.FromContext(ctx); id != "" {
+		tags["request_id"] = id
+	}
+	if traceID := requestid.TraceIDFromContext(ctx); traceID != "" {
+		tags["trace_id"] = traceID
+	}
+	if impersonatorID, ok := actor.Impersonator(ctx); ok {
+		tags["impersonator_id"] = impersonatorID.String()
+	}
+
+	event := sentryEvent{
+		EventID:     newEventID(),
+		Timestamp:   time.Now().UTC().Format(time.RFC3339),
+		Platform:    "go",
+		Level:       "error",
+		Environment: r.options.Environment,
+		Release:     r.options.Release,
+		Message:     scrub.Text(report.Message),
+		Tags:        tags,
+		Exception: sentryExceptions{Values: []sentryException{{
+			Type:       reflect.TypeOf(report.Err).String(),
+			Value:      scrub.Text(report.Err.Error()),
+			Stacktrace: callerStack(3),
+		}}},
+	}
+
+	user := &sentryUser{}
+	if userID, ok := actor.UserID(ctx); ok {
+		user.ID = userID.String()
+	}
+	if scrub.SendPII() {
+		user.IPAddress = clientip.FromContext(ctx)
+	}
+	if *user != (sentryUser{}) {
+		event.User = user
+	}
+
+	if req := report.Request; req != nil {
+		event.Request = &sentryRequest{
+			Method:      req.Method,
+			URL:         req.URL.Path,
+			QueryString: scrub.Query(req.URL.RawQuery),
+			Headers:     scrub.Headers(req.Header),
+		}
+	}
+	return event
+}
+
+// callerStack captures the stack above skip frames, oldest call first as Sentry expects
+func callerStack(skip int) *sentryStacktrace {
+	pcs := make([]uintptr, maxStackFrames)
+	n := runtime.Callers(skip+1, pcs)
+	if n == 0 {
+		return nil
+	}
+
+	var frames []sentryFrame
+	iter := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := iter.Next()
+		module, function := splitFunction(frame.Function)
+		frames = append(frames, sentryFrame{
+			Function: function,
+			Module:   module,
+			Filename: frame.File,
+			Lineno:   frame.Line,
+			InApp:    strings.HasPrefix(frame.Function, modulePrefix),
+		})
+		if !more {
+			break
+		}
+	}
+	for i, j := 0, len(frames)-1; i < j; i, j = i+1, j-1 {
+		frames[i], frames[j] = frames[j], frames[i]
+	}
+	return &sentryStacktrace{Frames: frames}
+}
+
+// splitFunction separates a qualified function name into its package path and name
+func splitFunction(qualified string) (module, function string) {
+	slash := strings.LastIndex(qualified, "/") + 1
+	dot := strings.Index(qualified[slash:], ".")
+	if dot < 0 {
+		return "", qualified
+	}
+	return qualified[:slash+dot], qualified[slash+dot+1:]
+}
+
+// newEventID returns a random 32-character hex ID, as Sentry requires
+func newEventID() string {
+	var id [16]byte
+	_, _ = cryptorand.Read(id[:])
+	return hex.EncodeToString(id[:])
+}
+
+// Ensure SentryReporter implements Reporter
+var _ Reporter = (*SentryReporter)(nil)
@@ -0,0 +1,134 @@
+package errreport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"backend/internal/platform/actor"
+	"backend/internal/platform/clientip"
+	"backend/internal/platform/logger"
+	"backend/internal/platform/requestid"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestReporter points a reporter at a recording server and returns the
+// events it received once the reporter is closed
+func newTestReporter(t *testing.T, options SentryOptions) (*SentryReporter, func() []map[string]any) {
+	t.Helper()
+	var events []map[string]any
+	var auth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/42/store/", r.URL.Path)
+		auth = r.Header.Get("X-Sentry-Auth")
+		var event map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		events = append(events, event)
+	}))
+	t.Cleanup(server.Close)
+
+	dsn := strings.Replace(server.URL, "://", "://public-key@", 1) + "/42"
+	reporter, err := NewSentryReporter(dsn, options, logger.NewSlogAdapter("test", "error"))
+	require.NoError(t, err)
+
+	return reporter, func() []map[string]any {
+		require.NoError(t, reporter.Close(context.Background()))
+		if len(events) > 0 {
+			assert.Contains(t, auth, "sentry_key=public-key")
+		}
+		return events
+	}
+}
+
+func TestSentryReporterSendsRequestContext(t *testing.T) {
+	reporter, received := newTestReporter(t, SentryOptions{
+		Environment: "production",
+		SampleRate:  1,
+		Scrubber:    NewScrubber(DefaultScrubFields, false),
+	})
+
+	userID := uuid.New()
+	ctx := requestid.WithID(actor.WithUserID(context.Background(), userID), "req-1")
+	ctx = clientip.WithIP(ctx, "203.0.113.7")
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/posts?page=2&token=abc", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	req.Header.Set("Accept", "application/json")
+
+	reporter.Report(ctx, Report{
+		Err:     errors.New("lookup of jane@example.com failed"),
+		Message: "unhandled internal error",
+		Request: req,
+	})
+
+	events := received()
+	require.Len(t, events, 1)
+	event := events[0]
+
+	assert.Equal(t, "production", event["environment"])
+	assert.Equal(t, "req-1", event["tags"].(map[string]any)["request_id"])
+	assert.Equal(t, map[string]any{"id": userID.String()}, event["user"], "the client IP is PII")
+
+	request := event["request"].(map[string]any)
+	assert.Equal(t, "/api/v1/posts", request["url"])
+	assert.Equal(t, "page=2&token=%5BFiltered%5D", request["query_string"])
+	headers := request["headers"].(map[string]any)
+	assert.Equal(t, Filtered, headers["Authorization"])
+	assert.Equal(t, "application/json", headers["Accept"])
+
+	exception := event["exception"].(map[string]any)["values"].([]any)[0].(map[string]any)
+	assert.Equal(t, "lookup of [Filtered] failed", exception["value"])
+	frames := exception["stacktrace"].(map[string]any)["frames"].([]any)
+	last := frames[len(frames)-1].(map[string]any)
+	assert.Equal(t, "TestSentryReporterSendsRequestContext", last["function"], "the stack starts at the caller of Report")
+	assert.Equal(t, true, last["in_app"])
+}
+
+func TestSentryReporterSendsPIIWhenAllowed(t *testing.T) {
+	reporter, received := newTestReporter(t, SentryOptions{SampleRate: 1, Scrubber: NewScrubber(nil, true)})
+
+	ctx := clientip.WithIP(context.Background(), "203.0.113.7")
+	reporter.Report(ctx, Report{Err: errors.New("lookup of jane@example.com failed")})
+
+	events := received()
+	require.Len(t, events, 1)
+	assert.Equal(t, "203.0.113.7", events[0]["user"].(map[string]any)["ip_address"])
+	exception := events[0]["exception"].(map[string]any)["values"].([]any)[0].(map[string]any)
+	assert.Contains(t, exception["value"], "jane@example.com")
+}
+
+func TestSentryReporterSamples(t *testing.T) {
+	reporter, received := newTestReporter(t, SentryOptions{SampleRate: 0.5})
+	draws := []float64{0.2, 0.7, 0.49, 0.5}
+	reporter.sample = func() float64 {
+		draw := draws[0]
+		draws = draws[1:]
+		return draw
+	}
+
+	for range 4 {
+		reporter.Report(context.Background(), Report{Err: errors.New("boom")})
+	}
+	assert.Len(t, received(), 2)
+}
+
+func TestParseDSN(t *testing.T) {
+	endpoint, key, err := parseDSN("https://abc@o1.ingest.sentry.io/123")
+	require.NoError(t, err)
+	assert.Equal(t, "https://o1.ingest.sentry.io/api/123/store/", endpoint)
+	assert.Equal(t, "abc", key)
+
+	endpoint, _, err = parseDSN("https://abc@errors.example.com/sentry/7")
+	require.NoError(t, err)
+	assert.Equal(t, "https://errors.example.com/sentry/api/7/store/", endpoint)
+
+	for _, dsn := range []string{"https://o1.ingest.sentry.io/123", "https://abc@o1.ingest.sentry.io/", "not a dsn"} {
+		_, _, err := parseDSN(dsn)
+		assert.Error(t, err, dsn)
+	}
+}
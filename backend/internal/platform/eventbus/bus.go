@@ -5,21 +5,26 @@ import (
 	"errors"
 	"sync"
 
+	"backend/internal/platform/errreport"
 	"backend/internal/platform/logger"
+	"backend/internal/platform/requestid"
 )
 
 // Bus manages subscriptions and event dispatching.
 type Bus struct {
 	subscriptions map[Topic][]Handler
-	mu            sync.RWMutex // Protects the subscriptions map
+	mu            sync.RWMutex   // Protects the subscriptions map
+	inflight      sync.WaitGroup // Tracks running Publish handlers so shutdown can wait for them
 	logger        logger.Logger
+	reporter      errreport.Reporter // Receives the errors of Publish handlers, which have no caller to return them to
 }
 
 // NewBus creates a new event bus.
-func NewBus(logger logger.Logger) *Bus {
+func NewBus(logger logger.Logger, reporter errreport.Reporter) *Bus {
 	return &Bus{
 		subscriptions: make(map[Topic][]Handler),
 		logger:        logger,
+		reporter:      reporter,
 	}
 }
 
@@ -31,22 +36,51 @@ func (b *Bus) Subscribe(topic Topic, handler Handler) {
 }
 
 // Publish sends an event to all subscribers of a topic (Fire-and-Forget).
+// Handlers receive a context carrying the event's request ID, so what they
+// log and publish in turn is correlated with the originating request.
 func (b *Bus) Publish(ctx context.Context, event Event) {
+	ctx, event = withRequestID(ctx, event)
+
 	b.mu.RLock()
 	defer b.mu.RUnlock()
 
 	if handlers, found := b.subscriptions[event.Topic]; found {
 		for _, handler := range handlers {
 			// Run each handler in its own goroutine for true asynchronicity.
+			b.inflight.Add(1)
 			go func(h Handler) {
+				defer b.inflight.Done()
 				if err := h(ctx, event); err != nil {
 					b.logger.Error(ctx, "event handler failed", "topic", event.Topic, "error", err)
+					b.reporter.Report(ctx, errreport.Report{
+						Err:     err,
+						Message: "event handler failed",
+						Tags:    map[string]string{"topic": string(event.Topic)},
+					})
 				}
 			}(handler)
 		}
 	}
 }
 
+// Drain waits for handlers started by Publish to finish, or for ctx to end.
+// Call it once publishers have stopped (e.g. after the HTTP server has shut down);
+// events published by the handlers being drained are waited for as well.
+func (b *Bus) Drain(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		b.inflight.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // Request sends an event and waits for a single reply.
 func (b *Bus) Request(ctx context.Context, event Event) (Event, error) {
 	b.mu.RLock()
@@ -57,6 +91,8 @@ func (b *Bus) Request(ctx context.Context, event Event) (Event, error) {
 		return Event{}, errors.New("no handler registered for request topic: " + string(event.Topic))
 	}
 
+	ctx, event = withRequestID(ctx, event)
+
 	// For request/reply, we typically expect only one handler. Use the first one.
 	handler := handlers[0]
 
@@ -81,3 +117,14 @@ func (b *Bus) Request(ctx context.Context, event Event) (Event, error) {
 		return Event{}, ctx.Err()
 	}
 }
+
+// withRequestID stamps the event with the context's request ID, or the context
+// with the event's when the event was built elsewhere, so both carry the same ID
+func withRequestID(ctx context.Context, event Event) (context.Context, Event) {
+	if event.RequestID == "" {
+		event.RequestID = requestid.FromContext(ctx)
+	} else if requestid.FromContext(ctx) != event.RequestID {
+		ctx = requestid.WithID(ctx, event.RequestID)
+	}
+	return ctx, event
+}
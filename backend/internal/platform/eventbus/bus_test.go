@@ -3,11 +3,14 @@ package eventbus_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 	"testing"
 	"time"
 
+	"backend/internal/platform/errreport"
 	"backend/internal/platform/eventbus"
+	"backend/internal/platform/requestid"
 )
 
 // mockLogger implements the logger.Logger interface for testing
@@ -35,7 +38,7 @@ func (m *mockLogger) getErrors() []string {
 
 func TestBusSubscribeAndPublish(t *testing.T) {
 	logger := &mockLogger{}
-	bus := eventbus.NewBus(logger)
+	bus := eventbus.NewBus(logger, errreport.Nop{})
 
 	topic := eventbus.Topic("test.event")
 
@@ -106,7 +109,7 @@ func TestBusSubscribeAndPublish(t *testing.T) {
 
 func TestBusPublishWithNoSubscribers(t *testing.T) {
 	logger := &mockLogger{}
-	bus := eventbus.NewBus(logger)
+	bus := eventbus.NewBus(logger, errreport.Nop{})
 
 	// Publish to a topic with no subscribers (should not panic)
 	event := eventbus.Event{
@@ -122,9 +125,22 @@ func TestBusPublishWithNoSubscribers(t *testing.T) {
 	}
 }
 
+// recordingReporter keeps the errors it is asked to report
+type recordingReporter struct {
+	mu      sync.Mutex
+	reports []errreport.Report
+}
+
+func (r *recordingReporter) Report(ctx context.Context, report errreport.Report) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reports = append(r.reports, report)
+}
+
 func TestBusPublishWithHandlerError(t *testing.T) {
 	logger := &mockLogger{}
-	bus := eventbus.NewBus(logger)
+	reporter := &recordingReporter{}
+	bus := eventbus.NewBus(logger, reporter)
 
 	topic := eventbus.Topic("error.event")
 
@@ -153,11 +169,21 @@ func TestBusPublishWithHandlerError(t *testing.T) {
 	if errors[0] != "event handler failed" {
 		t.Errorf("expected 'event handler failed', got %v", errors[0])
 	}
+
+	// Verify error was reported with its topic
+	reporter.mu.Lock()
+	defer reporter.mu.Unlock()
+	if len(reporter.reports) != 1 {
+		t.Fatalf("expected 1 report, got %d", len(reporter.reports))
+	}
+	if reporter.reports[0].Err != handlerErr || reporter.reports[0].Tags["topic"] != string(topic) {
+		t.Errorf("unexpected report %+v", reporter.reports[0])
+	}
 }
 
 func TestBusRequest(t *testing.T) {
 	logger := &mockLogger{}
-	bus := eventbus.NewBus(logger)
+	bus := eventbus.NewBus(logger, errreport.Nop{})
 
 	topic := eventbus.Topic("request.event")
 
@@ -203,7 +229,7 @@ func TestBusRequest(t *testing.T) {
 
 func TestBusRequestWithNoHandler(t *testing.T) {
 	logger := &mockLogger{}
-	bus := eventbus.NewBus(logger)
+	bus := eventbus.NewBus(logger, errreport.Nop{})
 
 	// Send request to topic with no handler
 	ctx := context.Background()
@@ -223,7 +249,7 @@ func TestBusRequestWithNoHandler(t *testing.T) {
 
 func TestBusRequestWithHandlerError(t *testing.T) {
 	logger := &mockLogger{}
-	bus := eventbus.NewBus(logger)
+	bus := eventbus.NewBus(logger, errreport.Nop{})
 
 	topic := eventbus.Topic("error.request")
 
@@ -253,7 +279,7 @@ func TestBusRequestWithHandlerError(t *testing.T) {
 
 func TestBusRequestWithTimeout(t *testing.T) {
 	logger := &mockLogger{}
-	bus := eventbus.NewBus(logger)
+	bus := eventbus.NewBus(logger, errreport.Nop{})
 
 	topic := eventbus.Topic("slow.request")
 
@@ -285,7 +311,7 @@ func TestBusRequestWithTimeout(t *testing.T) {
 
 func TestBusRequestWithMultipleHandlers(t *testing.T) {
 	logger := &mockLogger{}
-	bus := eventbus.NewBus(logger)
+	bus := eventbus.NewBus(logger, errreport.Nop{})
 
 	topic := eventbus.Topic("multi.request")
 
@@ -333,7 +359,7 @@ func TestBusRequestWithMultipleHandlers(t *testing.T) {
 
 func TestBusConcurrentSubscribe(t *testing.T) {
 	logger := &mockLogger{}
-	bus := eventbus.NewBus(logger)
+	bus := eventbus.NewBus(logger, errreport.Nop{})
 
 	topic := eventbus.Topic("concurrent.subscribe")
 
@@ -364,7 +390,7 @@ func TestBusConcurrentSubscribe(t *testing.T) {
 
 func TestBusConcurrentPublish(t *testing.T) {
 	logger := &mockLogger{}
-	bus := eventbus.NewBus(logger)
+	bus := eventbus.NewBus(logger, errreport.Nop{})
 
 	topic := eventbus.Topic("concurrent.publish")
 
@@ -408,3 +434,93 @@ func TestBusConcurrentPublish(t *testing.T) {
 		t.Errorf("expected %d handler calls, got %d", publishCount, callCount)
 	}
 }
+
+func TestBusDrainWaitsForHandlers(t *testing.T) {
+	logger := &mockLogger{}
+	bus := eventbus.NewBus(logger, errreport.Nop{})
+
+	topic := eventbus.Topic("test.drain")
+	release := make(chan struct{})
+	var finished sync.WaitGroup
+	finished.Add(1)
+
+	bus.Subscribe(topic, func(ctx context.Context, event eventbus.Event) error {
+		<-release
+		finished.Done()
+		return nil
+	})
+	bus.Publish(context.Background(), eventbus.Event{Topic: topic})
+
+	// The handler is blocked, so a short drain must time out
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := bus.Drain(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+
+	// Once released, draining completes after the handler returns
+	close(release)
+	if err := bus.Drain(context.Background()); err != nil {
+		t.Fatalf("expected drain to succeed, got %v", err)
+	}
+	finished.Wait()
+}
+
+func TestBusDrainWithNoHandlers(t *testing.T) {
+	bus := eventbus.NewBus(&mockLogger{}, errreport.Nop{})
+
+	if err := bus.Drain(context.Background()); err != nil {
+		t.Fatalf("expected drain to succeed immediately, got %v", err)
+	}
+}
+
+func TestBusPublishCarriesRequestID(t *testing.T) {
+	bus := eventbus.NewBus(&mockLogger{}, errreport.Nop{})
+	topic := eventbus.Topic("test.correlation")
+
+	type seen struct{ event, ctx string }
+	received := make(chan seen, 2)
+	bus.Subscribe(topic, func(ctx context.Context, event eventbus.Event) error {
+		received <- seen{event: event.RequestID, ctx: requestid.FromContext(ctx)}
+		return nil
+	})
+
+	// The ID is taken from the publisher's context
+	bus.Publish(requestid.WithID(context.Background(), "req-1"), eventbus.Event{Topic: topic})
+	if got := <-received; got.event != "req-1" || got.ctx != "req-1" {
+		t.Errorf("expected req-1 on event and context, got %+v", got)
+	}
+
+	// An event that already carries an ID hands it to the handler's context
+	bus.Publish(context.Background(), eventbus.Event{Topic: topic, RequestID: "req-2"})
+	if got := <-received; got.event != "req-2" || got.ctx != "req-2" {
+		t.Errorf("expected req-2 on event and context, got %+v", got)
+	}
+}
+
+// BenchmarkBusPublish measures Publish until every subscriber has run, so the
+// cost of starting one goroutine per handler shows up as the fan-out grows
+func BenchmarkBusPublish(b *testing.B) {
+	for _, subscribers := range []int{1, 10, 100} {
+		b.Run(fmt.Sprintf("subscribers=%d", subscribers), func(b *testing.B) {
+			bus := eventbus.NewBus(&mockLogger{}, errreport.Nop{})
+			topic := eventbus.Topic("bench.fanout")
+
+			var wg sync.WaitGroup
+			for i := 0; i < subscribers; i++ {
+				bus.Subscribe(topic, func(ctx context.Context, event eventbus.Event) error {
+					wg.Done()
+					return nil
+				})
+			}
+
+			ctx := context.Background()
+			b.ReportAllocs()
+			for b.Loop() {
+				wg.Add(subscribers)
+				bus.Publish(ctx, eventbus.Event{Topic: topic, Payload: "payload"})
+				wg.Wait()
+			}
+		})
+	}
+}
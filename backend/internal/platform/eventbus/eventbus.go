@@ -10,6 +10,10 @@ type Event struct {
 	Topic   Topic
 	Payload any // The data associated with the event.
 
+	// RequestID correlates the event with the request that caused it.
+	// Publish fills it from the context when it is empty.
+	RequestID string
+
 	// For the Request/Reply pattern
 	ReplyChannel chan Event
 	ErrorChannel chan error
@@ -0,0 +1,36 @@
+package events
+
+import (
+	"time"
+
+	"backend/internal/platform/eventbus"
+	"github.com/google/uuid"
+)
+
+// Announcement event topics
+const (
+	AnnouncementCreatedTopic eventbus.Topic = "announcement.created"
+	AnnouncementUpdatedTopic eventbus.Topic = "announcement.updated"
+	AnnouncementDeletedTopic eventbus.Topic = "announcement.deleted"
+)
+
+// AnnouncementCreatedEvent is published when an administrator creates an announcement
+type AnnouncementCreatedEvent struct {
+	AnnouncementID uuid.UUID
+	ActorID        uuid.UUID
+	OccurredAt     time.Time
+}
+
+// AnnouncementUpdatedEvent is published when an announcement's content or window changes
+type AnnouncementUpdatedEvent struct {
+	AnnouncementID uuid.UUID
+	ActorID        uuid.UUID
+	OccurredAt     time.Time
+}
+
+// AnnouncementDeletedEvent is published when an announcement is deleted
+type AnnouncementDeletedEvent struct {
+	AnnouncementID uuid.UUID
+	ActorID        uuid.UUID
+	OccurredAt     time.Time
+}
@@ -0,0 +1,23 @@
+package events
+
+import (
+	"time"
+
+	"backend/internal/platform/eventbus"
+	"github.com/google/uuid"
+)
+
+// API client event topics
+const (
+	APIClientRegisteredTopic eventbus.Topic = "apiclients.registered"
+	APIClientRevokedTopic    eventbus.Topic = "apiclients.revoked"
+)
+
+// APIClientChangedEvent is published on APIClientRegisteredTopic and
+// APIClientRevokedTopic when an owner registers or revokes a client
+type APIClientChangedEvent struct {
+	ClientID   uuid.UUID
+	OwnerID    uuid.UUID
+	Name       string
+	OccurredAt time.Time
+}
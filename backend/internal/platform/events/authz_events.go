@@ -0,0 +1,36 @@
+package events
+
+import (
+	"time"
+
+	"backend/internal/platform/eventbus"
+	"github.com/google/uuid"
+)
+
+// Authorization event topics, fired when what a user may do changes
+const (
+	UserRoleGrantedTopic       eventbus.Topic = "authz.user_role.granted"
+	UserRoleRevokedTopic       eventbus.Topic = "authz.user_role.revoked"
+	UserPermissionGrantedTopic eventbus.Topic = "authz.user_permission.granted"
+	UserPermissionRevokedTopic eventbus.Topic = "authz.user_permission.revoked"
+)
+
+// UserRoleChangedEvent is published on UserRoleGrantedTopic and
+// UserRoleRevokedTopic when a role is given to or taken from a user
+type UserRoleChangedEvent struct {
+	UserID     uuid.UUID
+	RoleID     uuid.UUID
+	RoleName   string
+	ActorID    uuid.UUID // User who made the change; uuid.Nil when not known
+	OccurredAt time.Time
+}
+
+// UserPermissionChangedEvent is published on UserPermissionGrantedTopic and
+// UserPermissionRevokedTopic when a custom permission is given to or taken from a user
+type UserPermissionChangedEvent struct {
+	UserID       uuid.UUID
+	PermissionID uuid.UUID
+	Permission   string    // e.g. "posts:publish"
+	ActorID      uuid.UUID // User who made the change; uuid.Nil when not known
+	OccurredAt   time.Time
+}
@@ -0,0 +1,28 @@
+package events
+
+import (
+	"time"
+
+	"backend/internal/platform/eventbus"
+	"github.com/google/uuid"
+)
+
+// Bookmark event topics
+const (
+	BookmarkAddedTopic   eventbus.Topic = "bookmarks.added"
+	BookmarkRemovedTopic eventbus.Topic = "bookmarks.removed"
+)
+
+// BookmarkAddedEvent is published when a user saves a post to their reading list
+type BookmarkAddedEvent struct {
+	UserID     uuid.UUID
+	PostID     uuid.UUID
+	OccurredAt time.Time
+}
+
+// BookmarkRemovedEvent is published when a user removes a post from their reading list
+type BookmarkRemovedEvent struct {
+	UserID     uuid.UUID
+	PostID     uuid.UUID
+	OccurredAt time.Time
+}
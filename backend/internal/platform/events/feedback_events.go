@@ -0,0 +1,31 @@
+package events
+
+import (
+	"time"
+
+	"backend/internal/platform/eventbus"
+	"github.com/google/uuid"
+)
+
+// Feedback event topics
+const (
+	FeedbackReceivedTopic eventbus.Topic = "feedback.received"
+	FeedbackTriagedTopic  eventbus.Topic = "feedback.triaged"
+)
+
+// FeedbackReceivedEvent is published when a visitor sends a message through the
+// contact form that was not judged spam, so administrators can be told about it
+type FeedbackReceivedEvent struct {
+	FeedbackID uuid.UUID
+	Name       string
+	Subject    string
+	OccurredAt time.Time
+}
+
+// FeedbackTriagedEvent is published when an administrator changes a message's status
+type FeedbackTriagedEvent struct {
+	FeedbackID uuid.UUID
+	ActorID    uuid.UUID
+	Status     string // "new", "ack" or "closed"
+	OccurredAt time.Time
+}
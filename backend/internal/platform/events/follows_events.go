@@ -0,0 +1,28 @@
+package events
+
+import (
+	"time"
+
+	"backend/internal/platform/eventbus"
+	"github.com/google/uuid"
+)
+
+// Follow event topics
+const (
+	UserFollowedTopic   eventbus.Topic = "follows.created"
+	UserUnfollowedTopic eventbus.Topic = "follows.removed"
+)
+
+// UserFollowedEvent is published when a user starts following an author
+type UserFollowedEvent struct {
+	FollowerID uuid.UUID
+	FolloweeID uuid.UUID
+	OccurredAt time.Time
+}
+
+// UserUnfollowedEvent is published when a user stops following an author
+type UserUnfollowedEvent struct {
+	FollowerID uuid.UUID
+	FolloweeID uuid.UUID
+	OccurredAt time.Time
+}
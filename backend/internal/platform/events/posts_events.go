@@ -14,6 +14,20 @@ const (
 	PostPublishedTopic eventbus.Topic = "posts.published"
 	PostArchivedTopic  eventbus.Topic = "posts.archived"
 	PostDeletedTopic   eventbus.Topic = "posts.deleted"
+
+	// Editorial review topics; an approval is also announced on PostPublishedTopic
+	PostSubmittedForReviewTopic eventbus.Topic = "posts.review.submitted"
+	PostApprovedTopic           eventbus.Topic = "posts.review.approved"
+	PostChangesRequestedTopic   eventbus.Topic = "posts.review.changes_requested"
+
+	// FeaturedPostsChangedTopic fires whenever the set of featured posts changes
+	FeaturedPostsChangedTopic eventbus.Topic = "posts.featured.changed"
+
+	// PublishedPostRefreshedTopic fires once the published posts read model has caught up with a post
+	PublishedPostRefreshedTopic eventbus.Topic = "posts.read_model.refreshed"
+
+	// PostAuthorNamesUpdatedTopic fires once an author's posts carry their new name
+	PostAuthorNamesUpdatedTopic eventbus.Topic = "posts.author_names.updated"
 )
 
 // PostCreatedEvent is published when a new post is created
@@ -29,6 +43,7 @@ type PostCreatedEvent struct {
 type PostUpdatedEvent struct {
 	PostID     uuid.UUID
 	ActorID    uuid.UUID // User who updated the post
+	AuthorID   uuid.UUID // Author of the post
 	Title      string
 	Slug       string
 	OccurredAt time.Time
@@ -38,6 +53,8 @@ type PostUpdatedEvent struct {
 type PostPublishedEvent struct {
 	PostID      uuid.UUID
 	ActorID     uuid.UUID // User who published the post
+	AuthorID    uuid.UUID // Author whose followers are notified
+	Title       string
 	PublishedAt time.Time
 	OccurredAt  time.Time
 }
@@ -46,6 +63,7 @@ type PostPublishedEvent struct {
 type PostArchivedEvent struct {
 	PostID     uuid.UUID
 	ActorID    uuid.UUID // User who archived the post
+	AuthorID   uuid.UUID // Author of the post
 	OccurredAt time.Time
 }
 
@@ -53,5 +71,58 @@ type PostArchivedEvent struct {
 type PostDeletedEvent struct {
 	PostID     uuid.UUID
 	ActorID    uuid.UUID // User who deleted the post
+	AuthorID   uuid.UUID // Author of the post
+	OccurredAt time.Time
+}
+
+// PostSubmittedForReviewEvent is published when a post enters the review queue
+type PostSubmittedForReviewEvent struct {
+	PostID     uuid.UUID
+	ActorID    uuid.UUID // User who submitted the post
+	AuthorID   uuid.UUID // Author of the post
+	Title      string
+	OccurredAt time.Time
+}
+
+// PostApprovedEvent is published when a reviewer approves a post, which publishes it
+type PostApprovedEvent struct {
+	PostID     uuid.UUID
+	ActorID    uuid.UUID // Reviewer who approved the post
+	AuthorID   uuid.UUID // Author of the post
+	OccurredAt time.Time
+}
+
+// PostChangesRequestedEvent is published when a reviewer sends a post back to its author
+type PostChangesRequestedEvent struct {
+	PostID     uuid.UUID
+	ActorID    uuid.UUID // Reviewer who requested the changes
+	AuthorID   uuid.UUID // Author of the post
+	OccurredAt time.Time
+}
+
+// FeaturedPostsChangedEvent is published when a post is featured or unfeatured,
+// including when a featured post is unpublished or archived.
+// Subscribers use it to invalidate cached featured listings.
+type FeaturedPostsChangedEvent struct {
+	PostID     uuid.UUID
+	ActorID    uuid.UUID // User who changed the featured state
+	Featured   bool      // Whether the post is featured after the change
+	OccurredAt time.Time
+}
+
+// PublishedPostRefreshedEvent is published after the read model of published
+// posts was refreshed for a post. Responses cached between the post's change
+// and the refresh may hold the old row, so caches purge the post again.
+type PublishedPostRefreshedEvent struct {
+	PostID     uuid.UUID
+	OccurredAt time.Time
+}
+
+// PostAuthorNamesUpdatedEvent is published after the name copied onto an
+// author's posts was rewritten, so listings cached with the old name are dropped
+type PostAuthorNamesUpdatedEvent struct {
+	AuthorID   uuid.UUID
+	AuthorName string
+	Posts      int // Number of posts rewritten
 	OccurredAt time.Time
 }
@@ -0,0 +1,21 @@
+package events
+
+import (
+	"time"
+
+	"backend/internal/platform/eventbus"
+	"github.com/google/uuid"
+)
+
+// Privacy event topics
+const (
+	DataExportReadyTopic eventbus.Topic = "privacy.data_export_ready"
+)
+
+// DataExportReadyEvent is published when a copy of a user's data is ready to download
+type DataExportReadyEvent struct {
+	ExportID   uuid.UUID
+	UserID     uuid.UUID
+	ExpiresAt  time.Time // When the archive is deleted
+	OccurredAt time.Time
+}
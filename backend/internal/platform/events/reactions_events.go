@@ -0,0 +1,33 @@
+package events
+
+import (
+	"time"
+
+	"backend/internal/platform/eventbus"
+	"github.com/google/uuid"
+)
+
+// Reaction event topics
+const (
+	ReactionAddedTopic   eventbus.Topic = "reactions.added"
+	ReactionRemovedTopic eventbus.Topic = "reactions.removed"
+)
+
+// ReactionAddedEvent is published when a user reacts to a target or changes their reaction
+type ReactionAddedEvent struct {
+	UserID       uuid.UUID
+	TargetType   string // "post" or "comment"
+	TargetID     uuid.UUID
+	ReactionType string
+	PreviousType string // Empty unless the user replaced an earlier reaction
+	OccurredAt   time.Time
+}
+
+// ReactionRemovedEvent is published when a user removes their reaction from a target
+type ReactionRemovedEvent struct {
+	UserID       uuid.UUID
+	TargetType   string
+	TargetID     uuid.UUID
+	ReactionType string
+	OccurredAt   time.Time
+}
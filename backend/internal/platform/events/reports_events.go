@@ -0,0 +1,37 @@
+package events
+
+import (
+	"time"
+
+	"backend/internal/platform/eventbus"
+	"github.com/google/uuid"
+)
+
+// Report event topics
+// Both events form the moderation audit trail, so they name the acting user
+const (
+	ReportFiledTopic    eventbus.Topic = "reports.filed"
+	ReportResolvedTopic eventbus.Topic = "reports.resolved"
+)
+
+// ReportFiledEvent is published when a reader reports a post or comment
+type ReportFiledEvent struct {
+	ReportID   uuid.UUID
+	ReporterID uuid.UUID
+	TargetType string // "post" or "comment"
+	TargetID   uuid.UUID
+	Reason     string
+	OccurredAt time.Time
+}
+
+// ReportResolvedEvent is published when a moderator dismisses a report or acts on it
+type ReportResolvedEvent struct {
+	ReportID       uuid.UUID
+	ModeratorID    uuid.UUID
+	TargetType     string
+	TargetID       uuid.UUID
+	TargetAuthorID *uuid.UUID
+	Action         string // "dismiss", "unpublish" or "suspend_user"
+	Note           string
+	OccurredAt     time.Time
+}
@@ -0,0 +1,28 @@
+package events
+
+import (
+	"time"
+
+	"backend/internal/platform/eventbus"
+	"github.com/google/uuid"
+)
+
+// Retention event topics
+// Every purge is announced; the audit trail of what was deleted and under
+// which policy is written with the purge itself, in retention_purges
+const (
+	PostPurgedTopic eventbus.Topic = "retention.post_purged"
+)
+
+// PostPurgedEvent is published for each post a retention policy deleted.
+// A PostDeletedEvent is published alongside it for the caches and read models.
+type PostPurgedEvent struct {
+	PostID     uuid.UUID
+	BlogID     uuid.UUID
+	AuthorID   uuid.UUID
+	Title      string
+	Policy     string    // "stale_drafts" or "archived_posts"
+	UpdatedAt  time.Time // When the post was last changed
+	ActorID    uuid.UUID // Admin who ran the purge; zero for the scheduled job
+	OccurredAt time.Time
+}
@@ -0,0 +1,68 @@
+package events
+
+import (
+	"time"
+
+	"backend/internal/platform/eventbus"
+	"github.com/google/uuid"
+)
+
+// Series event topics
+const (
+	SeriesCreatedTopic        eventbus.Topic = "series.created"
+	SeriesUpdatedTopic        eventbus.Topic = "series.updated"
+	SeriesDeletedTopic        eventbus.Topic = "series.deleted"
+	SeriesPostAddedTopic      eventbus.Topic = "series.post.added"
+	SeriesPostRemovedTopic    eventbus.Topic = "series.post.removed"
+	SeriesPostsReorderedTopic eventbus.Topic = "series.posts.reordered"
+)
+
+// SeriesCreatedEvent is published when a new series is created
+type SeriesCreatedEvent struct {
+	SeriesID   uuid.UUID
+	ActorID    uuid.UUID // Author who created the series
+	Title      string
+	Slug       string
+	OccurredAt time.Time
+}
+
+// SeriesUpdatedEvent is published when a series is updated
+type SeriesUpdatedEvent struct {
+	SeriesID   uuid.UUID
+	ActorID    uuid.UUID // User who updated the series
+	Title      string
+	Slug       string
+	OccurredAt time.Time
+}
+
+// SeriesDeletedEvent is published when a series is deleted
+type SeriesDeletedEvent struct {
+	SeriesID   uuid.UUID
+	ActorID    uuid.UUID // User who deleted the series
+	OccurredAt time.Time
+}
+
+// SeriesPostAddedEvent is published when a post is added to a series
+type SeriesPostAddedEvent struct {
+	SeriesID   uuid.UUID
+	PostID     uuid.UUID
+	Position   int
+	ActorID    uuid.UUID // User who added the post
+	OccurredAt time.Time
+}
+
+// SeriesPostRemovedEvent is published when a post is removed from a series
+type SeriesPostRemovedEvent struct {
+	SeriesID   uuid.UUID
+	PostID     uuid.UUID
+	ActorID    uuid.UUID // User who removed the post
+	OccurredAt time.Time
+}
+
+// SeriesPostsReorderedEvent is published when the posts in a series are reordered
+type SeriesPostsReorderedEvent struct {
+	SeriesID       uuid.UUID
+	OrderedPostIDs []uuid.UUID
+	ActorID        uuid.UUID // User who reordered the posts
+	OccurredAt     time.Time
+}
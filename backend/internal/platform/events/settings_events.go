@@ -0,0 +1,21 @@
+package events
+
+import (
+	"time"
+
+	"backend/internal/platform/eventbus"
+	"github.com/google/uuid"
+)
+
+// Settings event topics
+const (
+	SettingsUpdatedTopic eventbus.Topic = "settings.updated"
+)
+
+// SettingsUpdatedEvent is published when a namespace's values are replaced
+type SettingsUpdatedEvent struct {
+	Namespace  string     // "system", "blog" or "theme"
+	BlogID     *uuid.UUID // Nil for the deployment-wide system namespace
+	ActorID    uuid.UUID
+	OccurredAt time.Time
+}
@@ -13,10 +13,18 @@ const (
 	ThemeUpdatedTopic           eventbus.Topic = "themes.updated"
 	ThemeActivatedTopic         eventbus.Topic = "themes.activated"
 	ThemeDeactivatedTopic       eventbus.Topic = "themes.deactivated"
+	ThemeArchivedTopic          eventbus.Topic = "themes.archived"
+	ThemeRestoredTopic          eventbus.Topic = "themes.restored"
 	ThemeDeletedTopic           eventbus.Topic = "themes.deleted"
+	ThemeRecoveredTopic         eventbus.Topic = "themes.recovered"
 	ThemeArticleAddedTopic      eventbus.Topic = "themes.article.added"
 	ThemeArticleRemovedTopic    eventbus.Topic = "themes.article.removed"
 	ThemeArticlesReorderedTopic eventbus.Topic = "themes.articles.reordered"
+	ThemeArticlePinnedTopic     eventbus.Topic = "themes.article.pinned"
+	ThemeArticleUnpinnedTopic   eventbus.Topic = "themes.article.unpinned"
+
+	// ThemeCuratorNamesUpdatedTopic fires once a curator's themes carry their new name
+	ThemeCuratorNamesUpdatedTopic eventbus.Topic = "themes.curator_names.updated"
 )
 
 // ThemeCreatedEvent is published when a new theme is created
@@ -32,6 +40,7 @@ type ThemeCreatedEvent struct {
 type ThemeUpdatedEvent struct {
 	ThemeID    uuid.UUID
 	ActorID    uuid.UUID // User who updated the theme
+	CuratorID  uuid.UUID // Curator of the theme
 	Name       string
 	Slug       string
 	OccurredAt time.Time
@@ -41,6 +50,7 @@ type ThemeUpdatedEvent struct {
 type ThemeActivatedEvent struct {
 	ThemeID    uuid.UUID
 	ActorID    uuid.UUID // User who activated the theme
+	CuratorID  uuid.UUID // Curator of the theme
 	OccurredAt time.Time
 }
 
@@ -48,13 +58,39 @@ type ThemeActivatedEvent struct {
 type ThemeDeactivatedEvent struct {
 	ThemeID    uuid.UUID
 	ActorID    uuid.UUID // User who deactivated the theme
+	CuratorID  uuid.UUID // Curator of the theme
 	OccurredAt time.Time
 }
 
-// ThemeDeletedEvent is published when a theme is deleted
+// ThemeArchivedEvent is published when a theme is archived
+type ThemeArchivedEvent struct {
+	ThemeID    uuid.UUID
+	ActorID    uuid.UUID // User who archived the theme
+	CuratorID  uuid.UUID // Curator of the theme
+	OccurredAt time.Time
+}
+
+// ThemeRestoredEvent is published when an archived theme is restored to draft
+type ThemeRestoredEvent struct {
+	ThemeID    uuid.UUID
+	ActorID    uuid.UUID // User who restored the theme
+	CuratorID  uuid.UUID // Curator of the theme
+	OccurredAt time.Time
+}
+
+// ThemeDeletedEvent is published when a theme is moved to the trash
 type ThemeDeletedEvent struct {
 	ThemeID    uuid.UUID
 	ActorID    uuid.UUID // User who deleted the theme
+	CuratorID  uuid.UUID // Curator of the theme
+	OccurredAt time.Time
+}
+
+// ThemeRecoveredEvent is published when a theme is restored from the trash
+type ThemeRecoveredEvent struct {
+	ThemeID    uuid.UUID
+	ActorID    uuid.UUID // User who restored the theme
+	CuratorID  uuid.UUID // Curator of the theme
 	OccurredAt time.Time
 }
 
@@ -82,3 +118,29 @@ type ThemeArticlesReorderedEvent struct {
 	ActorID        uuid.UUID // User who reordered the articles
 	OccurredAt     time.Time
 }
+
+// ThemeArticlePinnedEvent is published when an article is pinned to the top of a theme
+type ThemeArticlePinnedEvent struct {
+	ThemeID    uuid.UUID
+	PostID     uuid.UUID
+	ActorID    uuid.UUID // User who pinned the article
+	OccurredAt time.Time
+}
+
+// ThemeArticleUnpinnedEvent is published when an article is unpinned from a theme
+type ThemeArticleUnpinnedEvent struct {
+	ThemeID    uuid.UUID
+	PostID     uuid.UUID
+	ActorID    uuid.UUID // User who unpinned the article
+	OccurredAt time.Time
+}
+
+// ThemeCuratorNamesUpdatedEvent is published after the name copied onto a
+// curator's themes was rewritten. A curator's themes can span blogs, so the
+// event lists each blog whose theme listings hold the old name.
+type ThemeCuratorNamesUpdatedEvent struct {
+	CuratorID   uuid.UUID
+	CuratorName string
+	BlogIDs     []uuid.UUID
+	OccurredAt  time.Time
+}
@@ -0,0 +1,43 @@
+package events
+
+import (
+	"time"
+
+	"backend/internal/platform/eventbus"
+	"github.com/google/uuid"
+)
+
+// User event topics
+const (
+	UserRegisteredTopic eventbus.Topic = "users.registered"
+	UserUpdatedTopic    eventbus.Topic = "users.updated"
+
+	// UserSessionsRevokedTopic fires when every session of a user stops working at once
+	UserSessionsRevokedTopic eventbus.Topic = "users.sessions_revoked"
+)
+
+// UserRegisteredEvent is published once, when a user finishes onboarding
+type UserRegisteredEvent struct {
+	UserID     uuid.UUID
+	Username   string
+	OccurredAt time.Time
+}
+
+// UserUpdatedEvent is published when a user's profile changes.
+// Modules that copy the username onto their rows rewrite the copies.
+type UserUpdatedEvent struct {
+	UserID      uuid.UUID
+	ActorID     uuid.UUID // User who made the change: the user or a moderator
+	Username    string
+	DisplayName string
+	OccurredAt  time.Time
+}
+
+// UserSessionsRevokedEvent is published when a user's sessions are revoked,
+// as happens when the account is suspended
+type UserSessionsRevokedEvent struct {
+	UserID     uuid.UUID
+	ActorID    uuid.UUID // User who revoked them; uuid.Nil when not known
+	Reason     string    // e.g. "account_suspended"
+	OccurredAt time.Time
+}
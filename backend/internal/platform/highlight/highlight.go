@@ -0,0 +1,102 @@
+// Package highlight colours the code blocks of post content on the server.
+//
+// Content is sanitized HTML, so a code block is a <pre><code> pair whose code
+// element may name its language as class="language-x". Blocks without a
+// language, or naming one chroma does not know, are analysed to guess it and
+// fall back to plain text. Colours are inlined as style attributes, so the
+// rendered content needs no stylesheet to go with it.
+package highlight
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/alecthomas/chroma/v2"
+	chromahtml "github.com/alecthomas/chroma/v2/formatters/html"
+	"github.com/alecthomas/chroma/v2/lexers"
+	"github.com/alecthomas/chroma/v2/styles"
+)
+
+// DefaultStyle is the chroma style used when none is configured
+const DefaultStyle = "github"
+
+// codeBlock matches a code block as the sanitizer writes it, capturing the
+// language hint and the escaped code
+var codeBlock = regexp.MustCompile(`(?s)<pre>\s*<code(?: class="language-([^"]+)")?>(.*?)</code>\s*</pre>`)
+
+// Highlighter renders code blocks with a chroma style
+type Highlighter struct {
+	style     *chroma.Style
+	formatter *chromahtml.Formatter
+}
+
+// New creates a highlighter for the named chroma style
+func New(style string) (*Highlighter, error) {
+	if style == "" {
+		style = DefaultStyle
+	}
+	s, ok := styles.Registry[strings.ToLower(style)]
+	if !ok {
+		return nil, fmt.Errorf("highlight.New: unknown style %q", style)
+	}
+	return &Highlighter{
+		style:     s,
+		formatter: chromahtml.New(chromahtml.WithClasses(false), chromahtml.TabWidth(4)),
+	}, nil
+}
+
+// Style is the name of the highlighter's chroma style
+func (h *Highlighter) Style() string {
+	return h.style.Name
+}
+
+// Highlight returns content with each code block replaced by its highlighted
+// rendering. Blocks holding markup rather than plain code are left alone.
+func (h *Highlighter) Highlight(content string) string {
+	if !strings.Contains(content, "<pre>") {
+		return content
+	}
+
+	return codeBlock.ReplaceAllStringFunc(content, func(block string) string {
+		match := codeBlock.FindStringSubmatch(block)
+		if strings.Contains(match[2], "<") {
+			return block
+		}
+		code := html.UnescapeString(match[2])
+
+		rendered, err := h.render(lexerFor(match[1], code), code)
+		if err != nil {
+			return block
+		}
+		return rendered
+	})
+}
+
+// render formats code with the lexer
+func (h *Highlighter) render(lexer chroma.Lexer, code string) (string, error) {
+	iterator, err := chroma.Coalesce(lexer).Tokenise(nil, code)
+	if err != nil {
+		return "", err
+	}
+	var out strings.Builder
+	if err := h.formatter.Format(&out, h.style, iterator); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
+
+// lexerFor picks the lexer for the language hint, guessing from the code when
+// there is no usable hint
+func lexerFor(language, code string) chroma.Lexer {
+	if language != "" {
+		if lexer := lexers.Get(language); lexer != nil {
+			return lexer
+		}
+	}
+	if lexer := lexers.Analyse(code); lexer != nil {
+		return lexer
+	}
+	return lexers.Fallback
+}
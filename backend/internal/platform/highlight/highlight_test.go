@@ -0,0 +1,59 @@
+package highlight_test
+
+import (
+	"testing"
+
+	"backend/internal/platform/highlight"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHighlight(t *testing.T) {
+	h, err := highlight.New("monokai")
+	require.NoError(t, err)
+
+	content := `<p>Run it:</p><pre><code class="language-go">fmt.Println(&#34;a &lt; b&#34;)</code></pre>`
+	rendered := h.Highlight(content)
+
+	assert.Contains(t, rendered, "<p>Run it:</p>")
+	assert.Contains(t, rendered, `style="`, "colours are inlined")
+	assert.Contains(t, rendered, "Println")
+	assert.Contains(t, rendered, "&lt; b", "code stays escaped")
+	assert.NotContains(t, rendered, "language-go")
+}
+
+func TestHighlight_GuessesUnknownLanguages(t *testing.T) {
+	h, err := highlight.New("")
+	require.NoError(t, err)
+
+	for _, content := range []string{
+		"<pre><code>#!/bin/sh\necho hi</code></pre>",
+		`<pre><code class="language-nosuchlang">plain words</code></pre>`,
+	} {
+		rendered := h.Highlight(content)
+		assert.NotEqual(t, content, rendered)
+		assert.Contains(t, rendered, "<code>")
+	}
+}
+
+func TestHighlight_LeavesOtherContentAlone(t *testing.T) {
+	h, err := highlight.New("github")
+	require.NoError(t, err)
+
+	for _, content := range []string{
+		"<p>No code here</p>",
+		"<p>Inline <code>x := 1</code> code</p>",
+		"<pre><code><b>marked up</b></code></pre>",
+	} {
+		assert.Equal(t, content, h.Highlight(content))
+	}
+}
+
+func TestNew_RejectsUnknownStyles(t *testing.T) {
+	_, err := highlight.New("no-such-style")
+	assert.Error(t, err)
+
+	h, err := highlight.ProvideHighlighter(highlight.Config{Enabled: false, Style: "no-such-style"})
+	assert.NoError(t, err)
+	assert.Nil(t, h)
+}
@@ -0,0 +1,16 @@
+package highlight
+
+// Config enables server-side highlighting
+type Config struct {
+	Enabled bool
+	Style   string // Chroma style name; DefaultStyle when empty
+}
+
+// ProvideHighlighter creates the highlighter, or nil when highlighting is disabled.
+// An unknown style fails startup rather than silently falling back.
+func ProvideHighlighter(cfg Config) (*Highlighter, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	return New(cfg.Style)
+}
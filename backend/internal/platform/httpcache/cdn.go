@@ -0,0 +1,69 @@
+package httpcache
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// maxKeysPerPurge keeps the surrogate key header within common CDN limits
+const maxKeysPerPurge = 256
+
+// CDNPurger purges a CDN by surrogate key. Each purge is a POST to the
+// configured endpoint carrying the keys in a Surrogate-Key header, modelled on
+// Fastly's batch purge API; CDNs without surrogate keys need a purge proxy.
+type CDNPurger struct {
+	url    string
+	token  string
+	client *http.Client
+}
+
+// NewCDNPurger creates a purger for the endpoint at url
+func NewCDNPurger(url, token string) *CDNPurger {
+	return &CDNPurger{
+		url:    url,
+		token:  token,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Purge asks the CDN to drop responses tagged with any of the keys
+func (p *CDNPurger) Purge(ctx context.Context, keys ...string) error {
+	for start := 0; start < len(keys); start += maxKeysPerPurge {
+		end := min(start+maxKeysPerPurge, len(keys))
+		if err := p.purge(ctx, keys[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// purge sends a single purge request
+func (p *CDNPurger) purge(ctx context.Context, keys []string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, nil)
+	if err != nil {
+		return fmt.Errorf("CDNPurger.Purge: build request: %w", err)
+	}
+	req.Header.Set(HeaderSurrogateKey, strings.Join(keys, " "))
+	if p.token != "" {
+		req.Header.Set("Authorization", "Bearer "+p.token)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("CDNPurger.Purge: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("CDNPurger.Purge: unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Ensure CDNPurger implements Purger
+var _ Purger = (*CDNPurger)(nil)
@@ -0,0 +1,44 @@
+package httpcache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+)
+
+// Conditional request headers
+const (
+	HeaderETag        = "ETag"
+	HeaderIfNoneMatch = "If-None-Match"
+)
+
+// ETag returns a strong entity tag for a response built from parts, in order
+func ETag(parts ...string) string {
+	hash := sha256.New()
+	for _, part := range parts {
+		// The length prefix keeps ("ab", "c") and ("a", "bc") apart
+		hash.Write([]byte{byte(len(part) >> 8), byte(len(part))})
+		hash.Write([]byte(part))
+	}
+	return `"` + hex.EncodeToString(hash.Sum(nil)[:16]) + `"`
+}
+
+// NotModified reports whether the request's If-None-Match header matches etag,
+// so the client's copy is current. Tags compare weakly, as RFC 9110 requires
+// for If-None-Match; compression turns the tags it serves into weak ones.
+func NotModified(r *http.Request, etag string) bool {
+	header := r.Header.Get(HeaderIfNoneMatch)
+	if header == "" {
+		return false
+	}
+	if strings.TrimSpace(header) == "*" {
+		return true
+	}
+	for _, candidate := range strings.Split(header, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(candidate), "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,41 @@
+package httpcache
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestETag(t *testing.T) {
+	assert.Equal(t, ETag("posts:create", "author"), ETag("posts:create", "author"))
+	assert.NotEqual(t, ETag("ab", "c"), ETag("a", "bc"))
+	assert.Regexp(t, `^"[0-9a-f]{32}"$`, ETag("posts:create"))
+}
+
+func TestNotModified(t *testing.T) {
+	etag := ETag("posts:create")
+
+	tests := []struct {
+		name        string
+		ifNoneMatch string
+		want        bool
+	}{
+		{"no header", "", false},
+		{"same tag", etag, true},
+		{"weakened by compression", "W/" + etag, true},
+		{"one of several", `"stale", ` + etag, true},
+		{"any", "*", true},
+		{"other tag", `"stale"`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/api/v1/users/me/permissions", nil)
+			if tt.ifNoneMatch != "" {
+				r.Header.Set(HeaderIfNoneMatch, tt.ifNoneMatch)
+			}
+			assert.Equal(t, tt.want, NotModified(r, etag))
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// Package httpcache supports caching public API responses in browsers, CDNs and
+// the server's own response store.
+//
+// Responses are tagged with surrogate keys naming the content they render, such
+// as "post:{id}". When that content changes the keys are purged, which drops every
+// cached response tagged with them regardless of the URL it was served under.
+package httpcache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HeaderSurrogateKey lists a response's surrogate keys, space separated
+const HeaderSurrogateKey = "Surrogate-Key"
+
+// Collection keys tag listings, which change whenever any member does
+const (
+	PostsKey         = "posts"
+	ThemesKey        = "themes"
+	SeriesKey        = "series"
+	AnnouncementsKey = "announcements"
+)
+
+// PostKey tags responses that render the post
+func PostKey(id uuid.UUID) string {
+	return "post:" + id.String()
+}
+
+// ThemeKey tags responses that render the theme
+func ThemeKey(id uuid.UUID) string {
+	return "theme:" + id.String()
+}
+
+// SeriesItemKey tags responses that render the series
+func SeriesItemKey(id uuid.UUID) string {
+	return "series:" + id.String()
+}
+
+// AddSurrogateKeys appends keys to the response's surrogate key header
+func AddSurrogateKeys(h http.Header, keys ...string) {
+	if len(keys) == 0 {
+		return
+	}
+	all := strings.Fields(h.Get(HeaderSurrogateKey))
+	all = append(all, keys...)
+	h.Set(HeaderSurrogateKey, strings.Join(all, " "))
+}
+
+// SurrogateKeys returns the keys in the response's surrogate key header
+func SurrogateKeys(h http.Header) []string {
+	return strings.Fields(h.Get(HeaderSurrogateKey))
+}
+
+// Policy is the caching policy of a class of routes
+type Policy struct {
+	MaxAge               time.Duration // How long browsers may reuse the response
+	SharedMaxAge         time.Duration // How long CDNs and the response store may reuse it
+	StaleWhileRevalidate time.Duration // How long shared caches may serve it stale while refetching
+}
+
+// CacheControl renders the policy as a Cache-Control header value
+func (p Policy) CacheControl() string {
+	value := fmt.Sprintf("public, max-age=%d, s-maxage=%d", seconds(p.MaxAge), seconds(p.SharedMaxAge))
+	if p.StaleWhileRevalidate > 0 {
+		value += fmt.Sprintf(", stale-while-revalidate=%d", seconds(p.StaleWhileRevalidate))
+	}
+	return value
+}
+
+// seconds truncates d to whole seconds
+func seconds(d time.Duration) int64 {
+	return int64(d / time.Second)
+}
+
+// Purger drops cached responses tagged with any of the keys
+type Purger interface {
+	Purge(ctx context.Context, keys ...string) error
+}
+
+// Purgers fans a purge out to several caches
+type Purgers []Purger
+
+// Purge purges the keys from every cache, reporting all failures
+func (p Purgers) Purge(ctx context.Context, keys ...string) error {
+	var errs []error
+	for _, purger := range p {
+		if err := purger.Purge(ctx, keys...); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Config selects the response caches that are purged
+type Config struct {
+	StoreEnabled  bool   // Serve anonymous public reads from the response store
+	CDNPurgeURL   string // Endpoint that purges the CDN by surrogate key; empty disables CDN purges
+	CDNPurgeToken string // Bearer token sent with CDN purges
+}
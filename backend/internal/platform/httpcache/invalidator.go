@@ -0,0 +1,164 @@
+package httpcache
+
+import (
+	"context"
+	"fmt"
+
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/events"
+	"backend/internal/platform/logger"
+	"github.com/google/uuid"
+)
+
+// Invalidator purges cached responses when the content they render changes
+type Invalidator struct {
+	purger Purger
+	logger logger.Logger
+}
+
+// NewInvalidator creates an invalidator that purges through purger
+func NewInvalidator(purger Purger, logger logger.Logger) *Invalidator {
+	return &Invalidator{
+		purger: purger,
+		logger: logger,
+	}
+}
+
+// Subscribe registers the invalidator on every content event
+func (i *Invalidator) Subscribe(bus *eventbus.Bus) {
+	for _, topic := range []eventbus.Topic{
+		events.PostCreatedTopic,
+		events.PostUpdatedTopic,
+		events.PostPublishedTopic,
+		events.PostArchivedTopic,
+		events.PostDeletedTopic,
+		events.FeaturedPostsChangedTopic,
+		events.PublishedPostRefreshedTopic,
+		events.PostAuthorNamesUpdatedTopic,
+		events.ThemeCreatedTopic,
+		events.ThemeUpdatedTopic,
+		events.ThemeActivatedTopic,
+		events.ThemeDeactivatedTopic,
+		events.ThemeArchivedTopic,
+		events.ThemeRestoredTopic,
+		events.ThemeDeletedTopic,
+		events.ThemeRecoveredTopic,
+		events.ThemeArticleAddedTopic,
+		events.ThemeArticleRemovedTopic,
+		events.ThemeArticlesReorderedTopic,
+		events.ThemeArticlePinnedTopic,
+		events.ThemeArticleUnpinnedTopic,
+		events.ThemeCuratorNamesUpdatedTopic,
+		events.SeriesCreatedTopic,
+		events.SeriesUpdatedTopic,
+		events.SeriesDeletedTopic,
+		events.SeriesPostAddedTopic,
+		events.SeriesPostRemovedTopic,
+		events.SeriesPostsReorderedTopic,
+		events.AnnouncementCreatedTopic,
+		events.AnnouncementUpdatedTopic,
+		events.AnnouncementDeletedTopic,
+	} {
+		bus.Subscribe(topic, i.handleContentChanged)
+	}
+}
+
+// Purge drops cached responses tagged with any of the keys
+func (i *Invalidator) Purge(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	if err := i.purger.Purge(ctx, keys...); err != nil {
+		return fmt.Errorf("Invalidator.Purge: %w", err)
+	}
+	i.logger.Debug(ctx, "purged cached responses", "keys", keys)
+	return nil
+}
+
+// handleContentChanged purges the keys of the content named by the event
+func (i *Invalidator) handleContentChanged(ctx context.Context, event eventbus.Event) error {
+	keys, err := keysFor(event.Payload)
+	if err != nil {
+		return fmt.Errorf("Invalidator.handleContentChanged: %w", err)
+	}
+	// The purge outlives the publisher's request, which may already be complete
+	return i.Purge(context.WithoutCancel(ctx), keys...)
+}
+
+// keysFor maps an event payload to the surrogate keys it invalidates
+func keysFor(payload any) ([]string, error) {
+	switch p := payload.(type) {
+	case events.PostCreatedEvent:
+		return []string{PostsKey}, nil
+	case events.PostUpdatedEvent:
+		return []string{PostKey(p.PostID), PostsKey}, nil
+	case events.PostPublishedEvent:
+		return []string{PostKey(p.PostID), PostsKey}, nil
+	case events.PostArchivedEvent:
+		return []string{PostKey(p.PostID), PostsKey}, nil
+	case events.PostDeletedEvent:
+		return []string{PostKey(p.PostID), PostsKey, ThemesKey}, nil
+	case events.FeaturedPostsChangedEvent:
+		return []string{PostKey(p.PostID), PostsKey}, nil
+	case events.PublishedPostRefreshedEvent:
+		return []string{PostKey(p.PostID), PostsKey}, nil
+	case events.PostAuthorNamesUpdatedEvent:
+		return []string{PostsKey}, nil
+
+	case events.ThemeCreatedEvent:
+		return []string{ThemesKey}, nil
+	case events.ThemeUpdatedEvent:
+		return []string{ThemeKey(p.ThemeID), ThemesKey}, nil
+	case events.ThemeActivatedEvent:
+		return []string{ThemeKey(p.ThemeID), ThemesKey}, nil
+	case events.ThemeDeactivatedEvent:
+		return []string{ThemeKey(p.ThemeID), ThemesKey}, nil
+	case events.ThemeArchivedEvent:
+		return []string{ThemeKey(p.ThemeID), ThemesKey}, nil
+	case events.ThemeRestoredEvent:
+		return []string{ThemeKey(p.ThemeID), ThemesKey}, nil
+	case events.ThemeDeletedEvent:
+		return []string{ThemeKey(p.ThemeID), ThemesKey}, nil
+	case events.ThemeRecoveredEvent:
+		return []string{ThemeKey(p.ThemeID), ThemesKey}, nil
+	case events.ThemeArticleAddedEvent:
+		return []string{ThemeKey(p.ThemeID), ThemesKey}, nil
+	case events.ThemeArticleRemovedEvent:
+		return []string{ThemeKey(p.ThemeID), ThemesKey}, nil
+	case events.ThemeArticlesReorderedEvent:
+		return []string{ThemeKey(p.ThemeID)}, nil
+	case events.ThemeArticlePinnedEvent:
+		return []string{ThemeKey(p.ThemeID)}, nil
+	case events.ThemeArticleUnpinnedEvent:
+		return []string{ThemeKey(p.ThemeID)}, nil
+	case events.ThemeCuratorNamesUpdatedEvent:
+		return []string{ThemesKey}, nil
+
+	// Posts render their series navigation, so series membership changes purge the posts too
+	case events.SeriesCreatedEvent:
+		return []string{SeriesKey}, nil
+	case events.SeriesUpdatedEvent:
+		return []string{SeriesItemKey(p.SeriesID), SeriesKey}, nil
+	case events.SeriesDeletedEvent:
+		return []string{SeriesItemKey(p.SeriesID), SeriesKey}, nil
+	case events.SeriesPostAddedEvent:
+		return []string{SeriesItemKey(p.SeriesID), SeriesKey, PostKey(p.PostID)}, nil
+	case events.SeriesPostRemovedEvent:
+		return []string{SeriesItemKey(p.SeriesID), SeriesKey, PostKey(p.PostID)}, nil
+	case events.SeriesPostsReorderedEvent:
+		return append([]string{SeriesItemKey(p.SeriesID)}, postKeys(p.OrderedPostIDs)...), nil
+
+	case events.AnnouncementCreatedEvent, events.AnnouncementUpdatedEvent, events.AnnouncementDeletedEvent:
+		return []string{AnnouncementsKey}, nil
+	}
+	return nil, fmt.Errorf("unexpected payload %T", payload)
+}
+
+// postKeys returns the surrogate keys of the posts
+func postKeys(ids []uuid.UUID) []string {
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, PostKey(id))
+	}
+	return keys
+}
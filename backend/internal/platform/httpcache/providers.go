@@ -0,0 +1,30 @@
+package httpcache
+
+import (
+	"context"
+
+	"backend/internal/platform/cache"
+	"backend/internal/platform/logger"
+)
+
+// ProvideStore creates the response store, or nil when it is disabled
+func ProvideStore(cfg Config, c cache.Cache) *Store {
+	if !cfg.StoreEnabled {
+		return nil
+	}
+	return NewStore(c)
+}
+
+// ProvidePurger combines the response store and the CDN into a single purger
+func ProvidePurger(cfg Config, store *Store, log logger.Logger) Purger {
+	var purgers Purgers
+	if store != nil {
+		purgers = append(purgers, store)
+	}
+	if cfg.CDNPurgeURL != "" {
+		purgers = append(purgers, NewCDNPurger(cfg.CDNPurgeURL, cfg.CDNPurgeToken))
+	} else {
+		log.Info(context.Background(), "CDN purging disabled; set CDN_PURGE_URL to enable it")
+	}
+	return purgers
+}
@@ -0,0 +1,122 @@
+package httpcache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"backend/internal/platform/cache"
+	"github.com/google/uuid"
+)
+
+// keyVersionTTL outlives any stored response, so a version only disappears
+// when its key is purged or has gone unused for a day
+const keyVersionTTL = 24 * time.Hour
+
+// Store is the server's own shared response cache, kept in the platform cache.
+//
+// Purging must reach every response tagged with a key without tracking which
+// ones those are, so each surrogate key has a version token. A stored response
+// records the versions of its keys, and purging a key deletes its version;
+// responses recorded against the old version then read as misses.
+type Store struct {
+	cache cache.Cache
+}
+
+// Entry is a stored response
+type Entry struct {
+	Status   int
+	Header   http.Header
+	Body     []byte
+	Versions map[string]string // Surrogate key versions at the time the response was stored
+}
+
+// NewStore creates a response store backed by c
+func NewStore(c cache.Cache) *Store {
+	return &Store{cache: c}
+}
+
+// Get returns the response stored under key, unless any of its surrogate keys was purged since
+func (s *Store) Get(ctx context.Context, key string) (*Entry, bool, error) {
+	var entry Entry
+	found, err := cache.GetJSON(ctx, s.cache, responseKey(key), &entry)
+	if err != nil || !found {
+		return nil, false, err
+	}
+
+	for surrogateKey, version := range entry.Versions {
+		current, err := s.cache.Get(ctx, versionKey(surrogateKey))
+		if errors.Is(err, cache.ErrMiss) {
+			return nil, false, nil
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		if string(current) != version {
+			return nil, false, nil
+		}
+	}
+	return &entry, true, nil
+}
+
+// Set stores a response under key for ttl, tagged with the surrogate keys in its header
+func (s *Store) Set(ctx context.Context, key string, status int, header http.Header, body []byte, ttl time.Duration) error {
+	entry := Entry{
+		Status:   status,
+		Header:   header,
+		Body:     body,
+		Versions: make(map[string]string),
+	}
+	for _, surrogateKey := range SurrogateKeys(header) {
+		version, err := s.version(ctx, surrogateKey)
+		if err != nil {
+			return err
+		}
+		entry.Versions[surrogateKey] = version
+	}
+	return cache.SetJSON(ctx, s.cache, responseKey(key), entry, ttl)
+}
+
+// Purge drops every stored response tagged with any of the keys
+func (s *Store) Purge(ctx context.Context, keys ...string) error {
+	versionKeys := make([]string, 0, len(keys))
+	for _, key := range keys {
+		versionKeys = append(versionKeys, versionKey(key))
+	}
+	if err := s.cache.Delete(ctx, versionKeys...); err != nil {
+		return fmt.Errorf("Store.Purge: %w", err)
+	}
+	return nil
+}
+
+// version returns the current version of a surrogate key, starting one if needed
+func (s *Store) version(ctx context.Context, surrogateKey string) (string, error) {
+	current, err := s.cache.Get(ctx, versionKey(surrogateKey))
+	if err == nil {
+		return string(current), nil
+	}
+	if !errors.Is(err, cache.ErrMiss) {
+		return "", fmt.Errorf("Store.version: %w", err)
+	}
+
+	version := uuid.NewString()
+	if err := s.cache.Set(ctx, versionKey(surrogateKey), []byte(version), keyVersionTTL); err != nil {
+		return "", fmt.Errorf("Store.version: %w", err)
+	}
+	return version, nil
+}
+
+// responseKey is the cache key of a stored response
+func responseKey(key string) string {
+	return "response:" + key
+}
+
+// versionKey is the cache key of a surrogate key's version
+func versionKey(surrogateKey string) string {
+	return "surrogate:" + surrogateKey
+}
+
+// Ensure Store implements Purger
+var _ Purger = (*Store)(nil)
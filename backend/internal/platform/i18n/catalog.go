@@ -0,0 +1,93 @@
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"backend/internal/platform/apperror"
+	"golang.org/x/text/language"
+)
+
+//go:embed catalogs/*.json
+var files embed.FS
+
+// catalog holds the translations into one language
+type catalog struct {
+	language language.Tag
+	// Errors maps error and business codes to the message for them
+	Errors map[string]string `json:"errors"`
+}
+
+// catalogs is loaded once, ordered by language; a catalog that fails to parse is a build defect
+var catalogs = mustLoad()
+
+func mustLoad() []*catalog {
+	entries, err := files.ReadDir("catalogs")
+	if err != nil {
+		panic(fmt.Sprintf("i18n: read catalogs: %v", err))
+	}
+
+	loaded := make([]*catalog, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		tag, err := language.Parse(strings.TrimSuffix(name, path.Ext(name)))
+		if err != nil {
+			panic(fmt.Sprintf("i18n: catalog %s is not named by a language tag: %v", name, err))
+		}
+		data, err := files.ReadFile("catalogs/" + name)
+		if err != nil {
+			panic(fmt.Sprintf("i18n: read catalog %s: %v", name, err))
+		}
+		c := &catalog{language: tag}
+		if err := json.Unmarshal(data, c); err != nil {
+			panic(fmt.Sprintf("i18n: parse catalog %s: %v", name, err))
+		}
+		loaded = append(loaded, c)
+	}
+
+	sort.Slice(loaded, func(i, j int) bool {
+		return loaded[i].language.String() < loaded[j].language.String()
+	})
+	return loaded
+}
+
+func supportedLanguages() []language.Tag {
+	tags := []language.Tag{DefaultLanguage}
+	for _, c := range catalogs {
+		tags = append(tags, c.language)
+	}
+	return tags
+}
+
+// catalogFor returns the catalog of a supported language; DefaultLanguage has none
+func catalogFor(lang language.Tag) (*catalog, bool) {
+	for _, c := range catalogs {
+		if c.language == lang {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+// ErrorMessage translates the message of an error into lang. Business codes
+// are translated where the catalog has them, and the error code otherwise;
+// BusinessCodeGeneral covers too many errors to be translated by itself.
+// ok is false in DefaultLanguage and for codes no catalog knows, where the
+// message as raised should be kept.
+func ErrorMessage(lang language.Tag, code apperror.ErrorCode, businessCode apperror.BusinessCode) (message string, ok bool) {
+	c, found := catalogFor(lang)
+	if !found {
+		return "", false
+	}
+	if businessCode != apperror.BusinessCodeGeneral {
+		if message, ok := c.Errors[string(businessCode)]; ok {
+			return message, true
+		}
+	}
+	message, ok = c.Errors[string(code)]
+	return message, ok
+}
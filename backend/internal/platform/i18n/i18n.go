@@ -0,0 +1,69 @@
+// Package i18n translates the messages of API responses.
+//
+// English is the source language: messages are written in English where they
+// are raised, and the catalogs under catalogs/ hold their translations, one
+// file per language named by its BCP 47 tag. A request's language is negotiated
+// from its Accept-Language header against the languages with a catalog.
+//
+// Error messages are translated by business code, falling back to the error
+// code, so clients get a localized message for every error while the codes
+// they match on stay the same in every language.
+package i18n
+
+import (
+	"context"
+	"slices"
+
+	"golang.org/x/text/language"
+)
+
+// DefaultLanguage is the language messages are written in, used when a
+// request accepts none of the catalogs
+var DefaultLanguage = language.English
+
+// supported lists DefaultLanguage, then the language of each catalog
+var supported = supportedLanguages()
+
+// matcher picks the best supported language for an Accept-Language header
+var matcher = language.NewMatcher(supported)
+
+// Languages returns the languages responses can be given in, DefaultLanguage first
+func Languages() []language.Tag {
+	return slices.Clone(supported)
+}
+
+// Negotiate returns the supported language best matching an Accept-Language
+// header. A missing or malformed header, or one accepting none of the
+// supported languages, gets DefaultLanguage.
+func Negotiate(acceptLanguage string) language.Tag {
+	if acceptLanguage == "" {
+		return DefaultLanguage
+	}
+	accepted, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(accepted) == 0 {
+		return DefaultLanguage
+	}
+
+	// The matched tag may carry extensions, so the supported tag is returned by index
+	_, index, confidence := matcher.Match(accepted...)
+	if confidence == language.No {
+		return DefaultLanguage
+	}
+	return supported[index]
+}
+
+// contextKey is a private type so no other package can collide with the key
+type contextKey struct{}
+
+// WithLanguage returns a context carrying the language to respond in
+func WithLanguage(ctx context.Context, tag language.Tag) context.Context {
+	return context.WithValue(ctx, contextKey{}, tag)
+}
+
+// FromContext returns the language to respond in, DefaultLanguage when none was negotiated
+func FromContext(ctx context.Context) language.Tag {
+	if tag, ok := ctx.Value(contextKey{}).(language.Tag); ok {
+		return tag
+	}
+	return DefaultLanguage
+}
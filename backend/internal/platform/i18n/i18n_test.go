@@ -0,0 +1,87 @@
+package i18n_test
+
+import (
+	"context"
+	"testing"
+
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/i18n"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+var (
+	traditionalChinese = language.MustParse("zh-TW")
+	japanese           = language.Japanese
+)
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   language.Tag
+	}{
+		{name: "no header", header: "", want: language.English},
+		{name: "exact tag", header: "zh-TW", want: traditionalChinese},
+		{name: "script matches region", header: "zh-Hant", want: traditionalChinese},
+		{name: "regional variant", header: "ja-JP", want: japanese},
+		{name: "weights are honored", header: "ja;q=0.5, zh-TW;q=0.8", want: traditionalChinese},
+		{name: "unsupported language falls through to the next", header: "fr-FR, ja;q=0.7", want: japanese},
+		{name: "nothing supported", header: "fr, de;q=0.5", want: language.English},
+		{name: "English variant", header: "en-GB", want: language.English},
+		{name: "malformed header", header: ";;q=x", want: language.English},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, i18n.Negotiate(tt.header))
+		})
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	assert.Equal(t, language.English, i18n.FromContext(context.Background()))
+
+	ctx := i18n.WithLanguage(context.Background(), japanese)
+	assert.Equal(t, japanese, i18n.FromContext(ctx))
+}
+
+func TestErrorMessage(t *testing.T) {
+	t.Run("business code is translated", func(t *testing.T) {
+		message, ok := i18n.ErrorMessage(traditionalChinese, apperror.CodeNotFound, apperror.BusinessCodePostNotFound)
+		assert.True(t, ok)
+		assert.Equal(t, "找不到此文章", message)
+	})
+
+	t.Run("general business code falls back to the error code", func(t *testing.T) {
+		message, ok := i18n.ErrorMessage(japanese, apperror.CodeNotFound, apperror.BusinessCodeGeneral)
+		assert.True(t, ok)
+		assert.Equal(t, "リソースが見つかりません", message)
+	})
+
+	t.Run("default language keeps the message as raised", func(t *testing.T) {
+		_, ok := i18n.ErrorMessage(language.English, apperror.CodeNotFound, apperror.BusinessCodePostNotFound)
+		assert.False(t, ok)
+	})
+
+	t.Run("unknown codes are left alone", func(t *testing.T) {
+		_, ok := i18n.ErrorMessage(japanese, "not_found", "")
+		assert.False(t, ok)
+	})
+}
+
+func TestCatalogsTranslateEveryCode(t *testing.T) {
+	for _, lang := range i18n.Languages()[1:] {
+		for _, code := range apperror.ErrorCodes {
+			_, ok := i18n.ErrorMessage(lang, code, apperror.BusinessCodeGeneral)
+			assert.True(t, ok, "%s has no message for error code %s", lang, code)
+		}
+		for _, businessCode := range apperror.BusinessCodes {
+			if businessCode == apperror.BusinessCodeGeneral {
+				continue
+			}
+			_, ok := i18n.ErrorMessage(lang, "", businessCode)
+			assert.True(t, ok, "%s has no message for business code %s", lang, businessCode)
+		}
+	}
+}
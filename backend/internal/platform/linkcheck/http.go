@@ -0,0 +1,91 @@
+package linkcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"backend/internal/platform/safehttp"
+)
+
+// Defaults for the HTTP checker
+const (
+	DefaultTimeout   = 10 * time.Second
+	DefaultUserAgent = "arch-blog-linkcheck/1.0"
+
+	// maxRedirects bounds the redirects followed before a link counts as broken
+	maxRedirects = 5
+)
+
+// HTTPChecker probes links over HTTP
+type HTTPChecker struct {
+	client    *http.Client
+	userAgent string
+}
+
+// NewHTTPChecker creates a checker whose requests each give up after timeout.
+// Unless allowPrivate is set, links to private, loopback and link-local
+// addresses are refused; only tests and local development should set it.
+func NewHTTPChecker(timeout time.Duration, userAgent string, allowPrivate bool) *HTTPChecker {
+	return &HTTPChecker{
+		client: &http.Client{
+			Timeout:   timeout,
+			Transport: safehttp.NewTransport(timeout, allowPrivate),
+			CheckRedirect: func(_ *http.Request, via []*http.Request) error {
+				if len(via) >= maxRedirects {
+					return fmt.Errorf("stopped after %d redirects", maxRedirects)
+				}
+				return nil
+			},
+		},
+		userAgent: userAgent,
+	}
+}
+
+// Check sends a HEAD request for the link, then a GET if HEAD is refused
+func (c *HTTPChecker) Check(ctx context.Context, link string) Result {
+	status, err := c.probe(ctx, http.MethodHead, link)
+	if err == nil && (status == http.StatusMethodNotAllowed || status == http.StatusNotImplemented) {
+		status, err = c.probe(ctx, http.MethodGet, link)
+	}
+	if err != nil {
+		return Result{URL: link, Error: describe(err), Broken: true}
+	}
+	return Result{URL: link, StatusCode: status, Broken: IsBroken(status)}
+}
+
+// probe sends one request and returns the final response status
+func (c *HTTPChecker) probe(ctx context.Context, method, link string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, link, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// Only the status matters; a GET reads a little of the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	return resp.StatusCode, nil
+}
+
+// describe shortens a request error to what an editor needs to know
+func describe(err error) string {
+	if errors.Is(err, safehttp.ErrPrivateAddress) {
+		return "link " + safehttp.ErrPrivateAddress.Error()
+	}
+	var urlErr interface{ Timeout() bool }
+	if errors.As(err, &urlErr) && urlErr.Timeout() {
+		return "timed out"
+	}
+	return err.Error()
+}
+
+// Ensure HTTPChecker implements Checker
+var _ Checker = (*HTTPChecker)(nil)
@@ -0,0 +1,108 @@
+// Package linkcheck finds the outbound links in post content and checks that
+// they still resolve.
+//
+// Links are probed over HTTP with a HEAD request, retried as a GET when the
+// server refuses HEAD. Only answers that say the page is gone count as broken:
+// servers that turn away bots or ask for credentials are reported with their
+// status but not flagged, since a reader's browser would most likely get through.
+package linkcheck
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/html"
+)
+
+// Result is the outcome of checking one link
+type Result struct {
+	URL        string
+	StatusCode int    // Zero when no response arrived
+	Error      string // Why no response arrived, such as a timeout; empty otherwise
+	Broken     bool
+}
+
+// Checker probes a link.
+// Failures to reach the link are reported in the Result rather than as errors.
+type Checker interface {
+	Check(ctx context.Context, link string) Result
+}
+
+// IsBroken reports whether a response status means the linked page is gone.
+// Not found, gone and server errors are; a status of zero means there was no response.
+func IsBroken(status int) bool {
+	return status == 0 || status == http.StatusNotFound || status == http.StatusGone || status >= 500
+}
+
+// ExtractLinks returns the absolute http and https links of the anchors in
+// HTML content, without fragments, each once and in order of appearance.
+// Relative links point within the blog and are left out.
+func ExtractLinks(content string) []string {
+	var links []string
+	seen := make(map[string]bool)
+
+	tokenizer := html.NewTokenizer(strings.NewReader(content))
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			return links
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := tokenizer.Token()
+			if token.Data != "a" {
+				continue
+			}
+			for _, attr := range token.Attr {
+				if attr.Key != "href" {
+					continue
+				}
+				link, ok := outbound(attr.Val)
+				if ok && !seen[link] {
+					seen[link] = true
+					links = append(links, link)
+				}
+			}
+		}
+	}
+}
+
+// outbound normalizes an href, reporting false unless it is an absolute http or https URL
+func outbound(href string) (string, bool) {
+	u, err := url.Parse(strings.TrimSpace(href))
+	if err != nil || u.Host == "" {
+		return "", false
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", false
+	}
+	u.Fragment = ""
+	u.RawFragment = ""
+	return u.String(), true
+}
+
+// CheckAll checks the links with at most concurrency requests in flight and
+// returns the results in the order of the links
+func CheckAll(ctx context.Context, checker Checker, links []string, concurrency int) []Result {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	results := make([]Result, len(links))
+	slots := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, link := range links {
+		slots <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			results[i] = checker.Check(ctx, link)
+		}()
+	}
+	wg.Wait()
+	return results
+}
@@ -0,0 +1,115 @@
+package linkcheck_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"backend/internal/platform/linkcheck"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractLinks(t *testing.T) {
+	content := `<p>See <a href="https://a.example/page#intro">this</a> and
+		<a href="/posts/local">that</a>, <a href="mailto:me@example.com">mail me</a>,
+		<a href=" http://b.example ">b</a> and <a href="https://a.example/page">again</a>.</p>
+		<img src="https://images.example/x.png">`
+
+	assert.Equal(t, []string{"https://a.example/page", "http://b.example"}, linkcheck.ExtractLinks(content))
+	assert.Empty(t, linkcheck.ExtractLinks("<p>No links here</p>"))
+}
+
+func TestHTTPChecker(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ok":
+			w.WriteHeader(http.StatusOK)
+		case "/head-refused":
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		case "/forbidden":
+			w.WriteHeader(http.StatusForbidden)
+		case "/moved":
+			http.Redirect(w, r, "/gone", http.StatusMovedPermanently)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	checker := linkcheck.NewHTTPChecker(time.Second, linkcheck.DefaultUserAgent, true)
+	tests := []struct {
+		path   string
+		status int
+		broken bool
+	}{
+		{"/ok", http.StatusOK, false},
+		{"/head-refused", http.StatusOK, false},
+		{"/forbidden", http.StatusForbidden, false},
+		{"/moved", http.StatusNotFound, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			result := checker.Check(context.Background(), server.URL+tt.path)
+			assert.Equal(t, tt.status, result.StatusCode)
+			assert.Equal(t, tt.broken, result.Broken)
+		})
+	}
+
+	unreachable := checker.Check(context.Background(), "http://127.0.0.1:1/")
+	assert.True(t, unreachable.Broken)
+	assert.Zero(t, unreachable.StatusCode)
+	assert.NotEmpty(t, unreachable.Error)
+}
+
+func TestHTTPChecker_RefusesPrivateAddresses(t *testing.T) {
+	reached := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reached = true
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	checker := linkcheck.NewHTTPChecker(time.Second, linkcheck.DefaultUserAgent, false)
+	result := checker.Check(context.Background(), server.URL+"/ok")
+	assert.False(t, reached, "the server on 127.0.0.1 is never contacted")
+	assert.True(t, result.Broken)
+	assert.Zero(t, result.StatusCode)
+	assert.Contains(t, result.Error, "private address")
+}
+
+// countingChecker records how many checks run at once
+type countingChecker struct {
+	inFlight, peak atomic.Int32
+}
+
+func (c *countingChecker) Check(_ context.Context, link string) linkcheck.Result {
+	n := c.inFlight.Add(1)
+	for {
+		peak := c.peak.Load()
+		if n <= peak || c.peak.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+	time.Sleep(5 * time.Millisecond)
+	c.inFlight.Add(-1)
+	return linkcheck.Result{URL: link, StatusCode: http.StatusOK}
+}
+
+func TestCheckAllBoundsConcurrency(t *testing.T) {
+	checker := &countingChecker{}
+	links := []string{"https://a.example", "https://b.example", "https://c.example", "https://d.example", "https://e.example"}
+
+	results := linkcheck.CheckAll(context.Background(), checker, links, 2)
+
+	for i, result := range results {
+		assert.Equal(t, links[i], result.URL, "results keep the order of the links")
+	}
+	assert.LessOrEqual(t, checker.peak.Load(), int32(2))
+}
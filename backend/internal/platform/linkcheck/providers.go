@@ -0,0 +1,22 @@
+package linkcheck
+
+import "time"
+
+// Config tunes the HTTP checker
+type Config struct {
+	Timeout   time.Duration // Per request; DefaultTimeout when zero
+	UserAgent string        // DefaultUserAgent when empty
+}
+
+// ProvideChecker creates the HTTP link checker, which never reaches private addresses
+func ProvideChecker(cfg Config) Checker {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	userAgent := cfg.UserAgent
+	if userAgent == "" {
+		userAgent = DefaultUserAgent
+	}
+	return NewHTTPChecker(timeout, userAgent, false)
+}
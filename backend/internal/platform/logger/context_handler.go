@@ -0,0 +1,50 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+
+	"backend/internal/platform/actor"
+	"backend/internal/platform/clientip"
+	"backend/internal/platform/requestid"
+)
+
+// contextHandler adds request-scoped values from the context to every record,
+// so callers get correlation fields without passing them to each log call
+type contextHandler struct {
+	slog.Handler
+}
+
+// Handle adds the request ID, trace ID, client IP and acting user, when the
+// context carries them, before writing the record
+func (h contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if id := requestid.FromContext(ctx); id != "" {
+		record.AddAttrs(slog.String("request_id", id))
+	}
+	if traceID := requestid.TraceIDFromContext(ctx); traceID != "" {
+		record.AddAttrs(slog.String("trace_id", traceID))
+	}
+	if ip := clientip.FromContext(ctx); ip != "" {
+		record.AddAttrs(slog.String("client_ip", ip))
+	}
+	if userID, ok := actor.UserID(ctx); ok {
+		record.AddAttrs(slog.String("user_id", userID.String()))
+	}
+	if impersonatorID, ok := actor.Impersonator(ctx); ok {
+		record.AddAttrs(slog.String("impersonator_id", impersonatorID.String()))
+	}
+	if actor.IsServiceAccount(ctx) {
+		record.AddAttrs(slog.String("principal_type", "service_account"))
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+// WithAttrs keeps the wrapper around handlers derived with attributes
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+// WithGroup keeps the wrapper around handlers derived with a group
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
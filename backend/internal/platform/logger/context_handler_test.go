@@ -0,0 +1,75 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"backend/internal/platform/actor"
+	"backend/internal/platform/clientip"
+	"backend/internal/platform/requestid"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContextHandlerAddsRequestID(t *testing.T) {
+	var out bytes.Buffer
+	adapter := &SlogAdapter{logger: slog.New(contextHandler{slog.NewJSONHandler(&out, nil)})}
+
+	adapter.Info(requestid.WithID(context.Background(), "req-1"), "with id", "key", "value")
+	adapter.Info(context.Background(), "without id")
+
+	lines := bytes.Split(bytes.TrimSpace(out.Bytes()), []byte("\n"))
+	require.Len(t, lines, 2)
+
+	var first, second map[string]any
+	require.NoError(t, json.Unmarshal(lines[0], &first))
+	require.NoError(t, json.Unmarshal(lines[1], &second))
+
+	assert.Equal(t, "req-1", first["request_id"])
+	assert.Equal(t, "value", first["key"])
+	assert.NotContains(t, second, "request_id")
+}
+
+func TestContextHandlerAddsClientIP(t *testing.T) {
+	var out bytes.Buffer
+	adapter := &SlogAdapter{logger: slog.New(contextHandler{slog.NewJSONHandler(&out, nil)})}
+
+	adapter.Info(clientip.WithIP(context.Background(), "203.0.113.7"), "with address")
+
+	var line map[string]any
+	require.NoError(t, json.Unmarshal(bytes.TrimSpace(out.Bytes()), &line))
+	assert.Equal(t, "203.0.113.7", line["client_ip"])
+}
+
+func TestContextHandlerAddsTraceAndUser(t *testing.T) {
+	var out bytes.Buffer
+	adapter := &SlogAdapter{logger: slog.New(contextHandler{slog.NewJSONHandler(&out, nil)})}
+
+	userID := uuid.New()
+	ctx := requestid.WithTraceID(actor.WithUserID(context.Background(), userID), "4bf92f3577b34da6a3ce929d0e0e4736")
+	adapter.Info(ctx, "acting")
+
+	var line map[string]any
+	require.NoError(t, json.Unmarshal(bytes.TrimSpace(out.Bytes()), &line))
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", line["trace_id"])
+	assert.Equal(t, userID.String(), line["user_id"])
+	assert.NotContains(t, line, "impersonator_id")
+	assert.NotContains(t, line, "principal_type")
+}
+
+func TestContextHandlerMarksServiceAccounts(t *testing.T) {
+	var out bytes.Buffer
+	adapter := &SlogAdapter{logger: slog.New(contextHandler{slog.NewJSONHandler(&out, nil)})}
+
+	accountID := uuid.New()
+	adapter.Info(actor.WithServiceAccount(actor.WithUserID(context.Background(), accountID)), "acting")
+
+	var line map[string]any
+	require.NoError(t, json.Unmarshal(bytes.TrimSpace(out.Bytes()), &line))
+	assert.Equal(t, accountID.String(), line["user_id"])
+	assert.Equal(t, "service_account", line["principal_type"])
+}
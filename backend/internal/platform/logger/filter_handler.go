@@ -0,0 +1,184 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ParseLevel reads a level name, accepting debug, info, warn and error
+func ParseLevel(name string) (slog.Level, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "info", "":
+		return slog.LevelInfo, nil
+	case "warn":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	default:
+		return slog.LevelInfo, fmt.Errorf("unknown log level %q", name)
+	}
+}
+
+// ParseModuleLevels reads per-module overrides written as module=level, such as
+// posts=debug or platform/eventbus=warn
+func ParseModuleLevels(entries []string) (map[string]slog.Level, error) {
+	levels := make(map[string]slog.Level, len(entries))
+	for _, entry := range entries {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		module, name, ok := strings.Cut(entry, "=")
+		module = strings.Trim(strings.TrimSpace(module), "/")
+		if !ok || module == "" {
+			return nil, fmt.Errorf("module level %q must be written as module=level", entry)
+		}
+		level, err := ParseLevel(name)
+		if err != nil {
+			return nil, fmt.Errorf("module %s: %w", module, err)
+		}
+		levels[module] = level
+	}
+	return levels, nil
+}
+
+// moduleOf names the module a log call came from: the package directory under
+// internal/, such as posts/application or platform/eventbus, or "" outside it
+func moduleOf(pc uintptr) string {
+	if pc == 0 {
+		return ""
+	}
+	frame, _ := runtime.CallersFrames([]uintptr{pc}).Next()
+	function := frame.Function
+	_, path, found := strings.Cut(function, "/internal/")
+	if !found {
+		return ""
+	}
+	// The function name follows the package path after its last slash
+	slash := strings.LastIndex(path, "/") + 1
+	name, _, _ := strings.Cut(path[slash:], ".")
+	return path[:slash] + name
+}
+
+// filterHandler applies per-module levels and samples debug records before
+// passing them on. Records name their module by the program counter of the
+// log call, which SlogAdapter sets to its caller.
+type filterHandler struct {
+	slog.Handler
+	level   slog.Level
+	modules map[string]slog.Level
+	minimum slog.Level // Lowest of level and the module levels, for the cheap Enabled check
+	sampler *sampler   // Nil when debug records are not sampled
+	cache   *sync.Map  // Program counter to the level of its module
+}
+
+func newFilterHandler(next slog.Handler, level slog.Level, modules map[string]slog.Level, sampler *sampler) *filterHandler {
+	minimum := level
+	for _, moduleLevel := range modules {
+		minimum = min(minimum, moduleLevel)
+	}
+	return &filterHandler{
+		Handler: next,
+		level:   level,
+		modules: modules,
+		minimum: minimum,
+		sampler: sampler,
+		cache:   &sync.Map{},
+	}
+}
+
+// Enabled reports whether any module logs at this level
+func (h *filterHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.minimum
+}
+
+// Handle drops records below their module's level and debug records the sampler rejects
+func (h *filterHandler) Handle(ctx context.Context, record slog.Record) error {
+	if record.Level < h.levelFor(record.PC) {
+		return nil
+	}
+	if record.Level < slog.LevelInfo && h.sampler != nil && !h.sampler.allow(record.Message, record.Time) {
+		return nil
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+// levelFor returns the level of the module a log call came from. The most
+// specific override wins, so posts=debug also covers posts/application.
+func (h *filterHandler) levelFor(pc uintptr) slog.Level {
+	if len(h.modules) == 0 {
+		return h.level
+	}
+	if level, ok := h.cache.Load(pc); ok {
+		return level.(slog.Level)
+	}
+
+	level := h.level
+	for module := moduleOf(pc); module != ""; {
+		if moduleLevel, ok := h.modules[module]; ok {
+			level = moduleLevel
+			break
+		}
+		slash := strings.LastIndex(module, "/")
+		if slash < 0 {
+			break
+		}
+		module = module[:slash]
+	}
+	h.cache.Store(pc, level)
+	return level
+}
+
+// WithAttrs keeps the filter around handlers derived with attributes
+func (h *filterHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	derived := *h
+	derived.Handler = h.Handler.WithAttrs(attrs)
+	return &derived
+}
+
+// WithGroup keeps the filter around handlers derived with a group
+func (h *filterHandler) WithGroup(name string) slog.Handler {
+	derived := *h
+	derived.Handler = h.Handler.WithGroup(name)
+	return &derived
+}
+
+// sampler bounds how often one message is logged: within each period the first
+// records of a message pass, then only every thereafter-th one, so a debug line
+// in a hot loop cannot flood the output
+type sampler struct {
+	period     time.Duration
+	first      int
+	thereafter int
+
+	mu     sync.Mutex
+	start  time.Time
+	counts map[string]int
+}
+
+func newSampler(period time.Duration, first, thereafter int) *sampler {
+	return &sampler{period: period, first: first, thereafter: thereafter, counts: make(map[string]int)}
+}
+
+// allow counts a record of the message and reports whether it may be logged
+func (s *sampler) allow(message string, at time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if at.Sub(s.start) >= s.period {
+		s.start = at
+		clear(s.counts)
+	}
+	s.counts[message]++
+	n := s.counts[message]
+	if n <= s.first {
+		return true
+	}
+	return s.thereafter > 0 && (n-s.first)%s.thereafter == 0
+}
@@ -0,0 +1,94 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAdapter(t *testing.T, config Config) (*SlogAdapter, *bytes.Buffer) {
+	t.Helper()
+	var out bytes.Buffer
+	config.Format = "json"
+	adapter, err := newSlogAdapter(&out, config)
+	require.NoError(t, err)
+	return adapter, &out
+}
+
+func countLines(out *bytes.Buffer) int {
+	return strings.Count(out.String(), "\n")
+}
+
+func TestModuleLevels(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("override lowers the level of the calling module", func(t *testing.T) {
+		adapter, out := newTestAdapter(t, Config{LogLevel: "info", ModuleLevels: []string{"platform/logger=debug"}})
+		adapter.Debug(ctx, "detail")
+		assert.Equal(t, 1, countLines(out))
+	})
+
+	t.Run("parent module override covers its packages", func(t *testing.T) {
+		adapter, out := newTestAdapter(t, Config{LogLevel: "info", ModuleLevels: []string{"platform=error"}})
+		adapter.Warn(ctx, "quiet")
+		adapter.Error(ctx, "loud")
+		assert.Equal(t, 1, countLines(out))
+	})
+
+	t.Run("other modules keep the default level", func(t *testing.T) {
+		adapter, out := newTestAdapter(t, Config{LogLevel: "info", ModuleLevels: []string{"posts=debug"}})
+		adapter.Debug(ctx, "detail")
+		adapter.Info(ctx, "note")
+		assert.Equal(t, 1, countLines(out))
+	})
+}
+
+func TestParseModuleLevels(t *testing.T) {
+	levels, err := ParseModuleLevels([]string{"posts=debug", " platform/eventbus/ = WARN", ""})
+	require.NoError(t, err)
+	assert.Len(t, levels, 2)
+	assert.Contains(t, levels, "platform/eventbus")
+
+	_, err = ParseModuleLevels([]string{"posts"})
+	assert.Error(t, err)
+	_, err = ParseModuleLevels([]string{"posts=loud"})
+	assert.Error(t, err)
+}
+
+func TestDebugSampling(t *testing.T) {
+	adapter, out := newTestAdapter(t, Config{LogLevel: "debug", DebugSampleFirst: 2, DebugSampleThereafter: 3})
+	ctx := context.Background()
+
+	for range 8 {
+		adapter.Debug(ctx, "hot loop")
+	}
+	// The first two pass, then the fifth and the eighth
+	assert.Equal(t, 4, countLines(out))
+
+	for range 8 {
+		adapter.Info(ctx, "not sampled")
+	}
+	assert.Equal(t, 12, countLines(out))
+}
+
+func TestSamplerResetsEachPeriod(t *testing.T) {
+	s := newSampler(time.Second, 1, 0)
+	start := time.Now()
+
+	assert.True(t, s.allow("tick", start))
+	assert.False(t, s.allow("tick", start.Add(500*time.Millisecond)))
+	assert.True(t, s.allow("other", start.Add(500*time.Millisecond)))
+	assert.True(t, s.allow("tick", start.Add(time.Second)))
+}
+
+func TestUnknownLogSettingsAreRejected(t *testing.T) {
+	_, err := NewConfiguredLogger(Config{LogLevel: "verbose"})
+	assert.Error(t, err)
+	_, err = NewConfiguredLogger(Config{LogLevel: "info", Format: "xml"})
+	assert.Error(t, err)
+}
@@ -1,6 +1,8 @@
 package logger
 
 import (
+	"os"
+
 	"github.com/google/wire"
 )
 
@@ -15,9 +17,21 @@ var ProviderSet = wire.NewSet(
 type Config struct {
 	Environment string
 	LogLevel    string
+
+	// Format is json or text; empty picks text in development and JSON elsewhere
+	Format string
+
+	// ModuleLevels override LogLevel for parts of the application, as module=level
+	// entries naming a directory under internal/, such as posts=debug
+	ModuleLevels []string
+
+	// Debug records of one message beyond the first DebugSampleFirst each second
+	// are sampled, keeping every DebugSampleThereafter-th; zero first disables sampling
+	DebugSampleFirst      int
+	DebugSampleThereafter int
 }
 
 // NewConfiguredLogger creates the main application logger from config
-func NewConfiguredLogger(config Config) *SlogAdapter {
-	return NewSlogAdapter(config.Environment, config.LogLevel)
+func NewConfiguredLogger(config Config) (*SlogAdapter, error) {
+	return newSlogAdapter(os.Stdout, config)
 }
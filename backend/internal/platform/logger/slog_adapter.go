@@ -2,59 +2,106 @@ package logger
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"log/slog"
 	"os"
+	"runtime"
+	"time"
 )
 
+// debugSamplePeriod is the window within which debug records of one message are counted
+const debugSamplePeriod = time.Second
+
 // SlogAdapter implements the Logger interface using Go's standard slog library.
 type SlogAdapter struct {
 	logger *slog.Logger
 }
 
 // NewSlogAdapter creates a new logger based on the application configuration.
+// Unknown levels fall back to info.
 func NewSlogAdapter(env string, level string) *SlogAdapter {
-	var logLevel slog.Level
-	switch level {
-	case "debug":
-		logLevel = slog.LevelDebug
-	case "warn":
-		logLevel = slog.LevelWarn
-	case "error":
-		logLevel = slog.LevelError
-	default:
-		logLevel = slog.LevelInfo
+	if _, err := ParseLevel(level); err != nil {
+		level = "info"
+	}
+	adapter, _ := newSlogAdapter(os.Stdout, Config{Environment: env, LogLevel: level})
+	return adapter
+}
+
+// newSlogAdapter builds the handler chain: records are filtered by module and
+// sampled first, so dropped ones are never enriched or encoded
+func newSlogAdapter(out io.Writer, config Config) (*SlogAdapter, error) {
+	level, err := ParseLevel(config.LogLevel)
+	if err != nil {
+		return nil, err
+	}
+	modules, err := ParseModuleLevels(config.ModuleLevels)
+	if err != nil {
+		return nil, err
+	}
+
+	format := config.Format
+	if format == "" {
+		// Human-readable text in development, JSON for machine parsing elsewhere
+		format = "json"
+		if config.Environment == "development" {
+			format = "text"
+		}
 	}
 
+	// The filter decides levels, so the encoder passes everything it is given
+	options := &slog.HandlerOptions{Level: slog.LevelDebug}
 	var handler slog.Handler
-	if env == "development" {
-		// Use a more human-readable text handler for development.
-		handler = slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: logLevel})
-	} else {
-		// Use JSON handler for production, which is better for machine parsing.
-		handler = slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: logLevel})
+	switch format {
+	case "json":
+		handler = slog.NewJSONHandler(out, options)
+	case "text":
+		handler = slog.NewTextHandler(out, options)
+	default:
+		return nil, fmt.Errorf("unknown log format %q", config.Format)
 	}
 
-	return &SlogAdapter{
-		logger: slog.New(handler),
+	var debugSampler *sampler
+	if config.DebugSampleFirst > 0 {
+		debugSampler = newSampler(debugSamplePeriod, config.DebugSampleFirst, config.DebugSampleThereafter)
 	}
+
+	return &SlogAdapter{
+		logger: slog.New(newFilterHandler(contextHandler{handler}, level, modules, debugSampler)),
+	}, nil
 }
 
 // Debug logs a message at debug level
 func (s *SlogAdapter) Debug(ctx context.Context, msg string, args ...any) {
-	s.logger.DebugContext(ctx, msg, args...)
+	s.log(ctx, slog.LevelDebug, msg, args)
 }
 
 // Info logs a message at info level
 func (s *SlogAdapter) Info(ctx context.Context, msg string, args ...any) {
-	s.logger.InfoContext(ctx, msg, args...)
+	s.log(ctx, slog.LevelInfo, msg, args)
 }
 
 // Warn logs a message at warn level
 func (s *SlogAdapter) Warn(ctx context.Context, msg string, args ...any) {
-	s.logger.WarnContext(ctx, msg, args...)
+	s.log(ctx, slog.LevelWarn, msg, args)
 }
 
 // Error logs a message at error level
 func (s *SlogAdapter) Error(ctx context.Context, msg string, args ...any) {
-	s.logger.ErrorContext(ctx, msg, args...)
+	s.log(ctx, slog.LevelError, msg, args)
+}
+
+// log writes a record attributed to the caller of the level method, rather than
+// to the adapter, so module levels apply to the code that logged
+func (s *SlogAdapter) log(ctx context.Context, level slog.Level, msg string, args []any) {
+	handler := s.logger.Handler()
+	if !handler.Enabled(ctx, level) {
+		return
+	}
+
+	var pcs [1]uintptr
+	runtime.Callers(3, pcs[:]) // Skip Callers, log and the level method
+	record := slog.NewRecord(time.Now(), level, msg, pcs[0])
+	record.Add(args...)
+	_ = handler.Handle(ctx, record)
 }
@@ -13,15 +13,42 @@ type Checker interface {
 	CheckOwnership(ctx context.Context, userID uuid.UUID, resourceID uuid.UUID) (bool, error)
 }
 
+// ActionChecker is implemented by checkers whose resources can be shared, so
+// that users besides the owner may perform some actions on them, such as the
+// collaborators of a theme. The action is that of the permission being
+// checked, e.g. "update" for "themes:update:own".
+type ActionChecker interface {
+	Checker
+
+	// CheckAccess verifies if a user may perform action on a resource as though they owned it
+	CheckAccess(ctx context.Context, userID uuid.UUID, resourceID uuid.UUID, action string) (bool, error)
+}
+
+// MembershipChecker lets the members of a group, such as an organization,
+// act on the resources the group owns, on top of what each resource type's
+// own Checker allows
+type MembershipChecker interface {
+	// CheckMembership verifies if a user may perform action on a resource through a group that owns it
+	CheckMembership(ctx context.Context, userID uuid.UUID, resourceType string, resourceID uuid.UUID, action string) (bool, error)
+}
+
 // Registry holds ownership checkers for different resource types
 // This is used by the AuthzService to verify ownership-based permissions
 type Registry interface {
 	// RegisterChecker registers an ownership checker for a resource type
 	RegisterChecker(resourceType string, checker Checker)
 
+	// SetMembershipChecker makes CheckAccess also consider group membership
+	SetMembershipChecker(checker MembershipChecker)
+
 	// GetChecker retrieves the ownership checker for a resource type
 	GetChecker(resourceType string) (Checker, bool)
 
 	// CheckOwnership checks ownership for any registered resource type
 	CheckOwnership(ctx context.Context, userID uuid.UUID, resourceType string, resourceID uuid.UUID) (bool, error)
+
+	// CheckAccess checks whether a user may perform action on a resource as
+	// though they owned it; checkers that cannot share resources fall back to
+	// ownership, and members of a group owning the resource may pass as well
+	CheckAccess(ctx context.Context, userID uuid.UUID, resourceType string, resourceID uuid.UUID, action string) (bool, error)
 }
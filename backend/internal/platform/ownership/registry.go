@@ -10,8 +10,9 @@ import (
 
 // DefaultRegistry is the default implementation of Registry
 type DefaultRegistry struct {
-	checkers map[string]Checker
-	mu       sync.RWMutex
+	checkers    map[string]Checker
+	memberships MembershipChecker
+	mu          sync.RWMutex
 }
 
 // NewRegistry creates a new ownership registry
@@ -28,6 +29,13 @@ func (r *DefaultRegistry) RegisterChecker(resourceType string, checker Checker)
 	r.checkers[resourceType] = checker
 }
 
+// SetMembershipChecker sets the checker consulted for group-owned resources
+func (r *DefaultRegistry) SetMembershipChecker(checker MembershipChecker) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.memberships = checker
+}
+
 // GetChecker retrieves the ownership checker for a resource type
 func (r *DefaultRegistry) GetChecker(resourceType string) (Checker, bool) {
 	r.mu.RLock()
@@ -45,3 +53,30 @@ func (r *DefaultRegistry) CheckOwnership(ctx context.Context, userID uuid.UUID,
 
 	return checker.CheckOwnership(ctx, userID, resourceID)
 }
+
+// CheckAccess checks access for any registered resource type
+func (r *DefaultRegistry) CheckAccess(ctx context.Context, userID uuid.UUID, resourceType string, resourceID uuid.UUID, action string) (bool, error) {
+	checker, exists := r.GetChecker(resourceType)
+	if !exists {
+		return false, fmt.Errorf("no ownership checker registered for resource type: %s", resourceType)
+	}
+
+	var allowed bool
+	var err error
+	if actionChecker, ok := checker.(ActionChecker); ok {
+		allowed, err = actionChecker.CheckAccess(ctx, userID, resourceID, action)
+	} else {
+		allowed, err = checker.CheckOwnership(ctx, userID, resourceID)
+	}
+	if err != nil || allowed {
+		return allowed, err
+	}
+
+	r.mu.RLock()
+	memberships := r.memberships
+	r.mu.RUnlock()
+	if memberships == nil {
+		return false, nil
+	}
+	return memberships.CheckMembership(ctx, userID, resourceType, resourceID, action)
+}
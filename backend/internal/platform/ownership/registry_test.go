@@ -0,0 +1,87 @@
+package ownership
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ownerOnly lets the owner do anything
+type ownerOnly struct{ owner uuid.UUID }
+
+func (c ownerOnly) CheckOwnership(_ context.Context, userID, _ uuid.UUID) (bool, error) {
+	return userID == c.owner, nil
+}
+
+// shared also lets a collaborator update
+type shared struct {
+	ownerOnly
+	collaborator uuid.UUID
+}
+
+func (c shared) CheckAccess(ctx context.Context, userID, resourceID uuid.UUID, action string) (bool, error) {
+	if userID == c.collaborator && action == "update" {
+		return true, nil
+	}
+	return c.CheckOwnership(ctx, userID, resourceID)
+}
+
+func TestDefaultRegistry_CheckAccess(t *testing.T) {
+	ctx := context.Background()
+	owner, collaborator := uuid.New(), uuid.New()
+
+	registry := NewRegistry()
+	registry.RegisterChecker("posts", ownerOnly{owner: owner})
+	registry.RegisterChecker("themes", shared{ownerOnly: ownerOnly{owner: owner}, collaborator: collaborator})
+
+	check := func(userID uuid.UUID, resourceType, action string) bool {
+		t.Helper()
+		ok, err := registry.CheckAccess(ctx, userID, resourceType, uuid.New(), action)
+		require.NoError(t, err)
+		return ok
+	}
+
+	assert.True(t, check(owner, "posts", "update"))
+	assert.False(t, check(collaborator, "posts", "update"), "checkers without sharing fall back to ownership")
+	assert.True(t, check(collaborator, "themes", "update"))
+	assert.False(t, check(collaborator, "themes", "delete"), "sharing is per action")
+
+	_, err := registry.CheckAccess(ctx, owner, "series", uuid.New(), "update")
+	assert.Error(t, err)
+}
+
+// teams lets a member update posts
+type teams struct{ member uuid.UUID }
+
+func (t teams) CheckMembership(_ context.Context, userID uuid.UUID, resourceType string, _ uuid.UUID, action string) (bool, error) {
+	return userID == t.member && resourceType == "posts" && action == "update", nil
+}
+
+func TestDefaultRegistry_CheckAccessConsidersMembership(t *testing.T) {
+	ctx := context.Background()
+	owner, member := uuid.New(), uuid.New()
+
+	registry := NewRegistry()
+	registry.RegisterChecker("posts", ownerOnly{owner: owner})
+
+	ok, err := registry.CheckAccess(ctx, member, "posts", uuid.New(), "update")
+	require.NoError(t, err)
+	assert.False(t, ok, "no membership checker is set yet")
+
+	registry.SetMembershipChecker(teams{member: member})
+
+	ok, err = registry.CheckAccess(ctx, member, "posts", uuid.New(), "update")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = registry.CheckAccess(ctx, member, "posts", uuid.New(), "delete")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	ok, err = registry.CheckAccess(ctx, owner, "posts", uuid.New(), "delete")
+	require.NoError(t, err)
+	assert.True(t, ok, "owners pass without membership")
+}
@@ -16,6 +16,7 @@ type Querier interface {
 	Query(ctx context.Context, sql string, arguments ...any) (pgx.Rows, error)
 	QueryRow(ctx context.Context, sql string, arguments ...any) pgx.Row
 	SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults
+	CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error)
 }
 
 // BaseRepository contains the common database components that all repositories need
@@ -25,14 +26,16 @@ type BaseRepository struct {
 }
 
 // NewBaseRepository creates a new base repository with a database pool
+// Its queries run in the transaction of the unit of work their context belongs to.
 func NewBaseRepository(db *pgxpool.Pool) BaseRepository {
 	return BaseRepository{
-		DB: db,
+		DB: NewContextQuerier(db),
 		SB: sq.StatementBuilder.PlaceholderFormat(sq.Dollar), // PostgreSQL $1, $2 placeholders
 	}
 }
 
 // WithTx creates a new BaseRepository that uses the provided transaction
+// whatever the context, as tests do to run inside a transaction they roll back
 func (b BaseRepository) WithTx(tx pgx.Tx) BaseRepository {
 	return BaseRepository{
 		DB: tx,
@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// CopyThreshold is the row count from which COPY beats a batch of INSERTs.
+// Below it a batch is as fast and can share a round trip with other statements.
+const CopyThreshold = 50
+
+// BulkInsert copies rows into table with the COPY protocol, which streams
+// them in one round trip without per-row statement overhead. Every row holds a
+// value per column, in order. COPY cannot resolve conflicts, so the rows must
+// be new; use BulkUpsert otherwise. It returns the number of rows copied.
+func BulkInsert(ctx context.Context, db Querier, table string, columns []string, rows [][]any) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	n, err := db.CopyFrom(ctx, pgx.Identifier{table}, columns, pgx.CopyFromRows(rows))
+	if err != nil {
+		return 0, fmt.Errorf("BulkInsert: copy into %s: %w", table, err)
+	}
+	return n, nil
+}
+
+// BulkUpsert copies rows into a temporary table shaped like table and moves
+// them over with one INSERT ... SELECT, so conflicts are settled by the
+// conflict clause, such as "ON CONFLICT (role_id, permission_id) DO NOTHING".
+// The staging table lives on the transaction's connection, hence the pgx.Tx.
+// It returns the number of rows inserted or updated.
+func BulkUpsert(ctx context.Context, tx pgx.Tx, table string, columns []string, rows [][]any, conflict string) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	target := pgx.Identifier{table}.Sanitize()
+	staging := pgx.Identifier{"bulk_" + table}.Sanitize()
+
+	quoted := make([]string, len(columns))
+	for i, column := range columns {
+		quoted[i] = pgx.Identifier{column}.Sanitize()
+	}
+	columnList := strings.Join(quoted, ", ")
+
+	// Defaults let the staging table accept rows that leave out generated columns such as id
+	create := fmt.Sprintf("CREATE TEMP TABLE %s (LIKE %s INCLUDING DEFAULTS) ON COMMIT DROP", staging, target)
+	if _, err := tx.Exec(ctx, create); err != nil {
+		return 0, fmt.Errorf("BulkUpsert: create staging table for %s: %w", table, err)
+	}
+
+	if _, err := tx.CopyFrom(ctx, pgx.Identifier{"bulk_" + table}, columns, pgx.CopyFromRows(rows)); err != nil {
+		return 0, fmt.Errorf("BulkUpsert: copy into staging table for %s: %w", table, err)
+	}
+
+	insert := fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM %s %s", target, columnList, columnList, staging, conflict)
+	tag, err := tx.Exec(ctx, insert)
+	if err != nil {
+		return 0, fmt.Errorf("BulkUpsert: insert into %s: %w", table, err)
+	}
+
+	// Drop it now so the table can be upserted again in the same transaction
+	if _, err := tx.Exec(ctx, "DROP TABLE "+staging); err != nil {
+		return 0, fmt.Errorf("BulkUpsert: drop staging table for %s: %w", table, err)
+	}
+
+	return tag.RowsAffected(), nil
+}
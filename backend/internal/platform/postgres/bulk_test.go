@@ -0,0 +1,68 @@
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"backend/internal/platform/postgres"
+	"backend/internal/testing/pgtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulkInsert(t *testing.T) {
+	tx := pgtest.Tx(t)
+	ctx := context.Background()
+
+	_, err := tx.Exec(ctx, `CREATE TEMP TABLE bulk_items (id INT PRIMARY KEY, name TEXT NOT NULL)`)
+	require.NoError(t, err)
+
+	rows := make([][]any, 200)
+	for i := range rows {
+		rows[i] = []any{i, fmt.Sprintf("item %d", i)}
+	}
+
+	n, err := postgres.BulkInsert(ctx, tx, "bulk_items", []string{"id", "name"}, rows)
+	require.NoError(t, err)
+	assert.EqualValues(t, 200, n)
+
+	var name string
+	require.NoError(t, tx.QueryRow(ctx, `SELECT name FROM bulk_items WHERE id = 199`).Scan(&name))
+	assert.Equal(t, "item 199", name)
+
+	n, err = postgres.BulkInsert(ctx, tx, "bulk_items", []string{"id", "name"}, nil)
+	require.NoError(t, err)
+	assert.Zero(t, n, "no rows is a no-op")
+}
+
+func TestBulkUpsert(t *testing.T) {
+	tx := pgtest.Tx(t)
+	ctx := context.Background()
+
+	_, err := tx.Exec(ctx, `
+		CREATE TABLE upsert_items (
+			id INT PRIMARY KEY,
+			name TEXT NOT NULL,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)`)
+	require.NoError(t, err)
+	_, err = tx.Exec(ctx, `INSERT INTO upsert_items (id, name) VALUES (1, 'old')`)
+	require.NoError(t, err)
+
+	conflict := "ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name"
+	n, err := postgres.BulkUpsert(ctx, tx, "upsert_items", []string{"id", "name"},
+		[][]any{{1, "new"}, {2, "two"}}, conflict)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, n)
+
+	var name string
+	require.NoError(t, tx.QueryRow(ctx, `SELECT name FROM upsert_items WHERE id = 1`).Scan(&name))
+	assert.Equal(t, "new", name)
+
+	// The staging table is gone, so the same table can be upserted again
+	n, err = postgres.BulkUpsert(ctx, tx, "upsert_items", []string{"id", "name"},
+		[][]any{{2, "two"}}, "ON CONFLICT (id) DO NOTHING")
+	require.NoError(t, err)
+	assert.Zero(t, n)
+}
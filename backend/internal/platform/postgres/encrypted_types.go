@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// EncryptedString is a string stored encrypted in a bytea column. Queries
+// take and scan it like any other value once RegisterEncryptedTypes has run
+// on the connection, so a repository declares a column encrypted by the Go
+// type it binds, e.g. postgres.EncryptedString(secret), and scans into.
+type EncryptedString string
+
+// EncryptedBytes is a byte slice stored encrypted in a bytea column; nil is stored as NULL
+type EncryptedBytes []byte
+
+// RegisterEncryptedTypes teaches a connection's type map to encrypt
+// EncryptedString and EncryptedBytes values on the way in and decrypt them
+// on the way out. It is meant for pgxpool.Config.AfterConnect. Without an
+// envelope such values fail to encode rather than being stored in the clear.
+//
+// Codecs have no request context, so key providers are called with a
+// background one.
+func RegisterEncryptedTypes(m *pgtype.Map, envelope *Envelope) {
+	m.TryWrapEncodePlanFuncs = append([]pgtype.TryWrapEncodePlanFunc{
+		func(value any) (pgtype.WrappedEncodePlanNextSetter, any, bool) {
+			switch value.(type) {
+			case EncryptedString, EncryptedBytes:
+				return &encryptedEncodePlan{envelope: envelope}, []byte{}, true
+			}
+			return nil, nil, false
+		},
+	}, m.TryWrapEncodePlanFuncs...)
+
+	m.TryWrapScanPlanFuncs = append([]pgtype.TryWrapScanPlanFunc{
+		func(target any) (pgtype.WrappedScanPlanNextSetter, any, bool) {
+			switch target.(type) {
+			case *EncryptedString, *EncryptedBytes:
+				return &encryptedScanPlan{envelope: envelope}, new([]byte), true
+			}
+			return nil, nil, false
+		},
+	}, m.TryWrapScanPlanFuncs...)
+}
+
+// encryptedEncodePlan seals a value and hands the ciphertext to the bytea encoder
+type encryptedEncodePlan struct {
+	next     pgtype.EncodePlan
+	envelope *Envelope
+}
+
+func (p *encryptedEncodePlan) SetNext(next pgtype.EncodePlan) { p.next = next }
+
+func (p *encryptedEncodePlan) Encode(value any, buf []byte) ([]byte, error) {
+	var plaintext []byte
+	switch v := value.(type) {
+	case EncryptedString:
+		plaintext = []byte(v)
+	case EncryptedBytes:
+		if v == nil {
+			return nil, nil
+		}
+		plaintext = v
+	}
+
+	sealed, err := p.envelope.Seal(context.Background(), plaintext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("encrypt %T: %w", value, err)
+	}
+	return p.next.Encode(sealed, buf)
+}
+
+// encryptedScanPlan reads ciphertext with the bytea scanner and opens it into the target
+type encryptedScanPlan struct {
+	next     pgtype.ScanPlan
+	envelope *Envelope
+}
+
+func (p *encryptedScanPlan) SetNext(next pgtype.ScanPlan) { p.next = next }
+
+func (p *encryptedScanPlan) Scan(src []byte, target any) error {
+	var sealed []byte
+	if err := p.next.Scan(src, &sealed); err != nil {
+		return err
+	}
+
+	if sealed == nil {
+		if dst, ok := target.(*EncryptedBytes); ok {
+			*dst = nil
+			return nil
+		}
+		return errors.New("cannot scan NULL into *postgres.EncryptedString")
+	}
+
+	plaintext, err := p.envelope.Open(context.Background(), sealed, nil)
+	if err != nil {
+		return fmt.Errorf("decrypt into %T: %w", target, err)
+	}
+	switch dst := target.(type) {
+	case *EncryptedString:
+		*dst = EncryptedString(plaintext)
+	case *EncryptedBytes:
+		*dst = plaintext
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"backend/internal/platform/postgres"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encryptingTypeMap(t *testing.T) (*pgtype.Map, *postgres.Envelope) {
+	t.Helper()
+	provider, err := postgres.NewLocalKeyProvider("k1", map[string][]byte{"k1": masterKey(1)})
+	require.NoError(t, err)
+	envelope := postgres.NewEnvelope(provider)
+
+	m := pgtype.NewMap()
+	postgres.RegisterEncryptedTypes(m, envelope)
+	return m, envelope
+}
+
+func TestEncryptedTypes_RoundTrip(t *testing.T) {
+	m, envelope := encryptingTypeMap(t)
+
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		encoded, err := m.Encode(pgtype.ByteaOID, format, postgres.EncryptedString("ada@example.com"), nil)
+		require.NoError(t, err)
+		assert.NotContains(t, string(encoded), "ada@example.com")
+
+		var email postgres.EncryptedString
+		require.NoError(t, m.Scan(pgtype.ByteaOID, format, encoded, &email))
+		assert.Equal(t, postgres.EncryptedString("ada@example.com"), email)
+	}
+
+	// What is stored is an ordinary sealed value
+	encoded, err := m.Encode(pgtype.ByteaOID, pgtype.BinaryFormatCode, postgres.EncryptedBytes("raw secret"), nil)
+	require.NoError(t, err)
+	plaintext, err := envelope.Open(context.Background(), encoded, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "raw secret", string(plaintext))
+}
+
+func TestEncryptedTypes_Null(t *testing.T) {
+	m, _ := encryptingTypeMap(t)
+
+	encoded, err := m.Encode(pgtype.ByteaOID, pgtype.BinaryFormatCode, postgres.EncryptedBytes(nil), nil)
+	require.NoError(t, err)
+	assert.Nil(t, encoded, "nil bytes are stored as NULL")
+
+	bytes := postgres.EncryptedBytes("stale")
+	require.NoError(t, m.Scan(pgtype.ByteaOID, pgtype.BinaryFormatCode, nil, &bytes))
+	assert.Nil(t, bytes)
+
+	var optional *postgres.EncryptedString
+	require.NoError(t, m.Scan(pgtype.ByteaOID, pgtype.BinaryFormatCode, nil, &optional))
+	assert.Nil(t, optional, "nullable columns scan into pointers")
+
+	var required postgres.EncryptedString
+	assert.Error(t, m.Scan(pgtype.ByteaOID, pgtype.BinaryFormatCode, nil, &required))
+}
+
+func TestEncryptedTypes_RefuseWithoutEnvelope(t *testing.T) {
+	m := pgtype.NewMap()
+	postgres.RegisterEncryptedTypes(m, nil)
+
+	_, err := m.Encode(pgtype.ByteaOID, pgtype.BinaryFormatCode, postgres.EncryptedString("secret"), nil)
+	assert.ErrorIs(t, err, postgres.ErrEncryptionNotConfigured, "values are never stored in the clear")
+}
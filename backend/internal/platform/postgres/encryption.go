@@ -0,0 +1,240 @@
+package postgres
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// DataKeyLength is the size of the AES-256 keys values are encrypted with, and of local master keys
+const DataKeyLength = 32
+
+// envelopeVersion leads every sealed value, so the layout can change without
+// breaking values already stored
+const envelopeVersion byte = 1
+
+// Encryption errors
+var (
+	ErrEncryptionNotConfigured = errors.New("field encryption is not configured")
+	ErrMalformedCiphertext     = errors.New("encrypted value is malformed")
+	ErrUnknownKey              = errors.New("encrypted value names an unknown master key")
+	ErrDecryptionFailed        = errors.New("encrypted value could not be decrypted")
+)
+
+// KeyProvider holds the master keys that protect data keys. A provider
+// backed by a KMS sends data keys to it to be wrapped and unwrapped, so the
+// master keys never leave it; LocalKeyProvider keeps them in memory.
+type KeyProvider interface {
+	// WrapKey encrypts a data key under the current master key and returns it with that key's ID
+	WrapKey(ctx context.Context, dataKey []byte) (wrapped []byte, keyID string, err error)
+
+	// UnwrapKey decrypts a data key wrapped under the master key keyID
+	UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error)
+}
+
+// Envelope encrypts values for storage with envelope encryption: each value
+// gets a fresh data key, the data key is wrapped by the key provider and
+// stored beside the ciphertext, and only the provider can unwrap it. Values
+// name the master key that wrapped them, so rotating to a new master key
+// leaves older values readable.
+//
+// A sealed value is laid out as
+//
+//	version | key ID length | key ID | wrapped key length (2 bytes) | wrapped key | nonce | ciphertext
+//
+// with the ciphertext sealed by AES-256-GCM.
+type Envelope struct {
+	provider KeyProvider
+}
+
+// NewEnvelope creates an envelope encrypting under the provider's keys
+func NewEnvelope(provider KeyProvider) *Envelope {
+	return &Envelope{provider: provider}
+}
+
+// Seal encrypts plaintext. Associated data, such as the ID of the row a value
+// belongs to, is authenticated but not stored; Open needs the same bytes,
+// which stops a sealed value being copied to another row.
+func (e *Envelope) Seal(ctx context.Context, plaintext, associatedData []byte) ([]byte, error) {
+	if e == nil || e.provider == nil {
+		return nil, ErrEncryptionNotConfigured
+	}
+
+	dataKey := make([]byte, DataKeyLength)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, fmt.Errorf("generate data key: %w", err)
+	}
+	wrapped, keyID, err := e.provider.WrapKey(ctx, dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("wrap data key: %w", err)
+	}
+	if len(keyID) > 255 || len(wrapped) > 65535 {
+		return nil, fmt.Errorf("wrap data key: key ID or wrapped key too long")
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generate nonce: %w", err)
+	}
+
+	sealed := make([]byte, 0, 4+len(keyID)+len(wrapped)+len(nonce)+len(plaintext)+aead.Overhead())
+	sealed = append(sealed, envelopeVersion, byte(len(keyID)))
+	sealed = append(sealed, keyID...)
+	sealed = binary.BigEndian.AppendUint16(sealed, uint16(len(wrapped)))
+	sealed = append(sealed, wrapped...)
+	sealed = append(sealed, nonce...)
+	return aead.Seal(sealed, nonce, plaintext, associatedData), nil
+}
+
+// Open decrypts a value sealed with the same associated data
+func (e *Envelope) Open(ctx context.Context, sealed, associatedData []byte) ([]byte, error) {
+	if e == nil || e.provider == nil {
+		return nil, ErrEncryptionNotConfigured
+	}
+
+	keyID, wrapped, rest, err := splitEnvelope(sealed)
+	if err != nil {
+		return nil, err
+	}
+	dataKey, err := e.provider.UnwrapKey(ctx, keyID, wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("unwrap data key: %w", err)
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) < aead.NonceSize()+aead.Overhead() {
+		return nil, ErrMalformedCiphertext
+	}
+	nonce, ciphertext := rest[:aead.NonceSize()], rest[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, associatedData)
+	if err != nil {
+		return nil, ErrDecryptionFailed
+	}
+	return plaintext, nil
+}
+
+// splitEnvelope separates the header of a sealed value from its nonce and ciphertext
+func splitEnvelope(sealed []byte) (keyID string, wrapped, rest []byte, err error) {
+	if len(sealed) < 2 || sealed[0] != envelopeVersion {
+		return "", nil, nil, ErrMalformedCiphertext
+	}
+	idEnd := 2 + int(sealed[1])
+	if len(sealed) < idEnd+2 {
+		return "", nil, nil, ErrMalformedCiphertext
+	}
+	keyID = string(sealed[2:idEnd])
+	wrappedEnd := idEnd + 2 + int(binary.BigEndian.Uint16(sealed[idEnd:]))
+	if len(sealed) < wrappedEnd {
+		return "", nil, nil, ErrMalformedCiphertext
+	}
+	return keyID, sealed[idEnd+2 : wrappedEnd], sealed[wrappedEnd:], nil
+}
+
+// newAEAD creates the AES-GCM cipher for a 32-byte key
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("create cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// LocalKeyProvider wraps data keys with master keys held in memory, for
+// deployments without a KMS. New data keys are wrapped with the current key;
+// the others are kept to unwrap data keys wrapped before a rotation.
+type LocalKeyProvider struct {
+	currentID string
+	keys      map[string]cipher.AEAD
+}
+
+// NewLocalKeyProvider creates a provider wrapping with the key currentID,
+// which must be among keys. Every key must be DataKeyLength bytes.
+func NewLocalKeyProvider(currentID string, keys map[string][]byte) (*LocalKeyProvider, error) {
+	if _, ok := keys[currentID]; !ok {
+		return nil, fmt.Errorf("current master key %q is not among the keys", currentID)
+	}
+
+	aeads := make(map[string]cipher.AEAD, len(keys))
+	for id, key := range keys {
+		if id == "" || len(id) > 255 {
+			return nil, fmt.Errorf("master key ID %q must be 1 to 255 bytes", id)
+		}
+		if len(key) != DataKeyLength {
+			return nil, fmt.Errorf("master key %q must be %d bytes, got %d", id, DataKeyLength, len(key))
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+		aeads[id] = aead
+	}
+	return &LocalKeyProvider{currentID: currentID, keys: aeads}, nil
+}
+
+// ParseLocalKeys creates a provider from a comma-separated list of
+// "id:base64-key" pairs. The first key is the current one, so a rotation
+// puts the new key in front and keeps the old ones after it.
+func ParseLocalKeys(spec string) (*LocalKeyProvider, error) {
+	keys := make(map[string][]byte)
+	currentID := ""
+	for _, pair := range strings.Split(spec, ",") {
+		id, encoded, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok {
+			return nil, fmt.Errorf("master key %q is not of the form id:base64-key", pair)
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("master key %q is not valid base64: %w", id, err)
+		}
+		if _, dup := keys[id]; dup {
+			return nil, fmt.Errorf("master key %q is listed twice", id)
+		}
+		keys[id] = key
+		if currentID == "" {
+			currentID = id
+		}
+	}
+	return NewLocalKeyProvider(currentID, keys)
+}
+
+// WrapKey encrypts a data key with the current master key
+func (p *LocalKeyProvider) WrapKey(_ context.Context, dataKey []byte) ([]byte, string, error) {
+	aead := p.keys[p.currentID]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, "", fmt.Errorf("generate nonce: %w", err)
+	}
+	return aead.Seal(nonce, nonce, dataKey, []byte(p.currentID)), p.currentID, nil
+}
+
+// UnwrapKey decrypts a data key with the master key keyID
+func (p *LocalKeyProvider) UnwrapKey(_ context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	aead, ok := p.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownKey, keyID)
+	}
+	if len(wrapped) < aead.NonceSize() {
+		return nil, ErrMalformedCiphertext
+	}
+	dataKey, err := aead.Open(nil, wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():], []byte(keyID))
+	if err != nil {
+		return nil, ErrDecryptionFailed
+	}
+	return dataKey, nil
+}
+
+// Compile-time check to ensure LocalKeyProvider implements KeyProvider
+var _ KeyProvider = (*LocalKeyProvider)(nil)
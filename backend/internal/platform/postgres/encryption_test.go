@@ -0,0 +1,94 @@
+package postgres_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"backend/internal/platform/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func masterKey(fill byte) []byte {
+	return bytes.Repeat([]byte{fill}, postgres.DataKeyLength)
+}
+
+func TestEnvelope_SealOpen(t *testing.T) {
+	provider, err := postgres.NewLocalKeyProvider("k1", map[string][]byte{"k1": masterKey(1)})
+	require.NoError(t, err)
+	envelope := postgres.NewEnvelope(provider)
+	ctx := context.Background()
+
+	sealed, err := envelope.Seal(ctx, []byte("whsec_abc123"), []byte("webhook-42"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(sealed), "whsec_abc123")
+
+	again, err := envelope.Seal(ctx, []byte("whsec_abc123"), []byte("webhook-42"))
+	require.NoError(t, err)
+	assert.NotEqual(t, sealed, again, "every value gets its own data key and nonce")
+
+	plaintext, err := envelope.Open(ctx, sealed, []byte("webhook-42"))
+	require.NoError(t, err)
+	assert.Equal(t, "whsec_abc123", string(plaintext))
+
+	_, err = envelope.Open(ctx, sealed, []byte("webhook-43"))
+	assert.ErrorIs(t, err, postgres.ErrDecryptionFailed, "values cannot be moved to another row")
+
+	tampered := bytes.Clone(sealed)
+	tampered[len(tampered)-1] ^= 1
+	_, err = envelope.Open(ctx, tampered, []byte("webhook-42"))
+	assert.ErrorIs(t, err, postgres.ErrDecryptionFailed)
+
+	_, err = envelope.Open(ctx, sealed[:3], nil)
+	assert.ErrorIs(t, err, postgres.ErrMalformedCiphertext)
+}
+
+func TestEnvelope_KeyRotation(t *testing.T) {
+	ctx := context.Background()
+	before, err := postgres.NewLocalKeyProvider("k1", map[string][]byte{"k1": masterKey(1)})
+	require.NoError(t, err)
+	sealed, err := postgres.NewEnvelope(before).Seal(ctx, []byte("ada@example.com"), nil)
+	require.NoError(t, err)
+
+	rotated, err := postgres.NewLocalKeyProvider("k2", map[string][]byte{"k1": masterKey(1), "k2": masterKey(2)})
+	require.NoError(t, err)
+	plaintext, err := postgres.NewEnvelope(rotated).Open(ctx, sealed, nil)
+	require.NoError(t, err, "values sealed before a rotation stay readable")
+	assert.Equal(t, "ada@example.com", string(plaintext))
+
+	retired, err := postgres.NewLocalKeyProvider("k2", map[string][]byte{"k2": masterKey(2)})
+	require.NoError(t, err)
+	_, err = postgres.NewEnvelope(retired).Open(ctx, sealed, nil)
+	assert.ErrorIs(t, err, postgres.ErrUnknownKey)
+}
+
+func TestEnvelope_NotConfigured(t *testing.T) {
+	var envelope *postgres.Envelope
+	_, err := envelope.Seal(context.Background(), []byte("secret"), nil)
+	assert.ErrorIs(t, err, postgres.ErrEncryptionNotConfigured)
+}
+
+func TestParseLocalKeys(t *testing.T) {
+	k1 := base64.StdEncoding.EncodeToString(masterKey(1))
+	k2 := base64.StdEncoding.EncodeToString(masterKey(2))
+	ctx := context.Background()
+
+	provider, err := postgres.ParseLocalKeys("k2:" + k2 + ", k1:" + k1)
+	require.NoError(t, err)
+	_, keyID, err := provider.WrapKey(ctx, masterKey(9))
+	require.NoError(t, err)
+	assert.Equal(t, "k2", keyID, "the first key is the current one")
+
+	for _, spec := range []string{
+		"k1",                     // no key
+		"k1:not-base64!",         // bad encoding
+		"k1:" + k1[:8],           // too short
+		"k1:" + k1 + ",k1:" + k2, // duplicate ID
+		":" + k1,                 // empty ID
+	} {
+		_, err := postgres.ParseLocalKeys(spec)
+		assert.Error(t, err, spec)
+	}
+}
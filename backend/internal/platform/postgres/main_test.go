@@ -0,0 +1,12 @@
+package postgres_test
+
+import (
+	"os"
+	"testing"
+
+	"backend/internal/testing/pgtest"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(pgtest.Main(m))
+}
@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrInvalidPoolOptions is returned for pool settings pgx cannot use
+var ErrInvalidPoolOptions = errors.New("invalid database pool options")
+
+// queryExecModes maps the names accepted in configuration, the same ones the
+// default_query_exec_mode connection parameter takes, to pgx's modes
+var queryExecModes = map[string]pgx.QueryExecMode{
+	"cache_statement": pgx.QueryExecModeCacheStatement,
+	"cache_describe":  pgx.QueryExecModeCacheDescribe,
+	"describe_exec":   pgx.QueryExecModeDescribeExec,
+	"exec":            pgx.QueryExecModeExec,
+	"simple_protocol": pgx.QueryExecModeSimpleProtocol,
+}
+
+// PoolOptions tunes the connection pool and how pgx prepares statements.
+// Zero values keep what the connection string or pgx's defaults set.
+type PoolOptions struct {
+	MaxConns          int32
+	MinConns          int32
+	MaxConnLifetime   time.Duration
+	MaxConnIdleTime   time.Duration
+	HealthCheckPeriod time.Duration
+
+	// QueryExecMode is one of cache_statement, cache_describe, describe_exec,
+	// exec or simple_protocol. cache_statement prepares each statement once per
+	// connection, which a transaction-pooling proxy such as PgBouncer breaks;
+	// behind one use cache_describe or exec.
+	QueryExecMode string
+
+	// StatementCacheCapacity bounds the prepared statements kept per connection in cache_statement mode
+	StatementCacheCapacity int
+
+	// DescriptionCacheCapacity bounds the statement descriptions kept per connection in cache_describe mode
+	DescriptionCacheCapacity int
+}
+
+// Apply validates the options and sets them on the pool configuration
+func (o PoolOptions) Apply(config *pgxpool.Config) error {
+	if o.MaxConns < 0 || o.MinConns < 0 {
+		return fmt.Errorf("%w: connection counts cannot be negative", ErrInvalidPoolOptions)
+	}
+	if o.MaxConns > 0 && o.MinConns > o.MaxConns {
+		return fmt.Errorf("%w: min conns %d exceeds max conns %d", ErrInvalidPoolOptions, o.MinConns, o.MaxConns)
+	}
+	if o.StatementCacheCapacity < 0 || o.DescriptionCacheCapacity < 0 {
+		return fmt.Errorf("%w: cache capacities cannot be negative", ErrInvalidPoolOptions)
+	}
+
+	if o.QueryExecMode != "" {
+		mode, ok := queryExecModes[o.QueryExecMode]
+		if !ok {
+			return fmt.Errorf("%w: unknown query exec mode %q", ErrInvalidPoolOptions, o.QueryExecMode)
+		}
+		config.ConnConfig.DefaultQueryExecMode = mode
+	}
+
+	if o.MaxConns > 0 {
+		config.MaxConns = o.MaxConns
+	}
+	if o.MinConns > 0 {
+		config.MinConns = o.MinConns
+	}
+	if o.MaxConnLifetime > 0 {
+		config.MaxConnLifetime = o.MaxConnLifetime
+	}
+	if o.MaxConnIdleTime > 0 {
+		config.MaxConnIdleTime = o.MaxConnIdleTime
+	}
+	if o.HealthCheckPeriod > 0 {
+		config.HealthCheckPeriod = o.HealthCheckPeriod
+	}
+	if o.StatementCacheCapacity > 0 {
+		config.ConnConfig.StatementCacheCapacity = o.StatementCacheCapacity
+	}
+	if o.DescriptionCacheCapacity > 0 {
+		config.ConnConfig.DescriptionCacheCapacity = o.DescriptionCacheCapacity
+	}
+	return nil
+}
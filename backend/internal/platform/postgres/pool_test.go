@@ -0,0 +1,67 @@
+package postgres_test
+
+import (
+	"testing"
+	"time"
+
+	"backend/internal/platform/postgres"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func parsePoolConfig(t *testing.T) *pgxpool.Config {
+	t.Helper()
+	config, err := pgxpool.ParseConfig("postgres://localhost:5432/archblog?pool_max_conns=7&statement_cache_capacity=64")
+	require.NoError(t, err)
+	return config
+}
+
+func TestPoolOptions_Apply(t *testing.T) {
+	config := parsePoolConfig(t)
+
+	err := postgres.PoolOptions{
+		MaxConns:                 25,
+		MinConns:                 5,
+		MaxConnLifetime:          5 * time.Minute,
+		HealthCheckPeriod:        30 * time.Second,
+		QueryExecMode:            "cache_describe",
+		DescriptionCacheCapacity: 256,
+	}.Apply(config)
+	require.NoError(t, err)
+
+	assert.EqualValues(t, 25, config.MaxConns)
+	assert.EqualValues(t, 5, config.MinConns)
+	assert.Equal(t, 5*time.Minute, config.MaxConnLifetime)
+	assert.Equal(t, 30*time.Second, config.HealthCheckPeriod)
+	assert.Equal(t, pgx.QueryExecModeCacheDescribe, config.ConnConfig.DefaultQueryExecMode)
+	assert.Equal(t, 256, config.ConnConfig.DescriptionCacheCapacity)
+	assert.Equal(t, 64, config.ConnConfig.StatementCacheCapacity, "unset options keep the connection string's value")
+}
+
+func TestPoolOptions_ApplyKeepsConnectionStringWhenZero(t *testing.T) {
+	config := parsePoolConfig(t)
+	require.NoError(t, postgres.PoolOptions{}.Apply(config))
+
+	assert.EqualValues(t, 7, config.MaxConns)
+	assert.Equal(t, pgx.QueryExecModeCacheStatement, config.ConnConfig.DefaultQueryExecMode)
+}
+
+func TestPoolOptions_ApplyRejectsInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		options postgres.PoolOptions
+	}{
+		{"unknown exec mode", postgres.PoolOptions{QueryExecMode: "prepared"}},
+		{"min above max", postgres.PoolOptions{MaxConns: 2, MinConns: 3}},
+		{"negative conns", postgres.PoolOptions{MaxConns: -1}},
+		{"negative cache", postgres.PoolOptions{StatementCacheCapacity: -1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.options.Apply(parsePoolConfig(t)), postgres.ErrInvalidPoolOptions)
+		})
+	}
+}
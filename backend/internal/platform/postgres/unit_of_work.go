@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// UnitOfWork runs work in a database transaction that every repository shares
+// Repositories built on NewContextQuerier find the transaction in the context
+// passed to fn, so services call them as usual and need no transactional copies.
+type UnitOfWork interface {
+	// Do runs fn in a transaction, committed when fn returns nil and rolled
+	// back otherwise. Inside another unit of work, fn runs in a savepoint of the
+	// outer transaction: its failure undoes only its own writes, and its writes
+	// are committed with the outer transaction.
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// txKey carries the current transaction in a context
+type txKey struct{}
+
+// ContextWithTx returns a context whose repository calls run in tx
+func ContextWithTx(ctx context.Context, tx pgx.Tx) context.Context {
+	return context.WithValue(ctx, txKey{}, tx)
+}
+
+// TxFromContext returns the transaction of the unit of work ctx belongs to, if any
+func TxFromContext(ctx context.Context) (pgx.Tx, bool) {
+	tx, ok := ctx.Value(txKey{}).(pgx.Tx)
+	return tx, ok
+}
+
+// PoolUnitOfWork implements UnitOfWork with transactions from a pgxpool.Pool
+type PoolUnitOfWork struct {
+	pool *pgxpool.Pool
+}
+
+// NewUnitOfWork creates a unit of work over the pool
+func NewUnitOfWork(pool *pgxpool.Pool) UnitOfWork {
+	return &PoolUnitOfWork{pool: pool}
+}
+
+// Do implements UnitOfWork
+func (u *PoolUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	var (
+		tx  pgx.Tx
+		err error
+	)
+	if outer, ok := TxFromContext(ctx); ok {
+		tx, err = outer.Begin(ctx) // A savepoint
+	} else {
+		tx, err = u.pool.Begin(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := fn(ContextWithTx(ctx, tx)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
+// contextQuerier runs each statement in the transaction of the context it is
+// given, or on the pool outside a unit of work
+type contextQuerier struct {
+	pool *pgxpool.Pool
+}
+
+// NewContextQuerier creates a Querier that takes part in units of work
+func NewContextQuerier(pool *pgxpool.Pool) Querier {
+	return contextQuerier{pool: pool}
+}
+
+func (q contextQuerier) conn(ctx context.Context) Querier {
+	if tx, ok := TxFromContext(ctx); ok {
+		return tx
+	}
+	return q.pool
+}
+
+// Exec implements Querier
+func (q contextQuerier) Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error) {
+	return q.conn(ctx).Exec(ctx, sql, arguments...)
+}
+
+// Query implements Querier
+func (q contextQuerier) Query(ctx context.Context, sql string, arguments ...any) (pgx.Rows, error) {
+	return q.conn(ctx).Query(ctx, sql, arguments...)
+}
+
+// QueryRow implements Querier
+func (q contextQuerier) QueryRow(ctx context.Context, sql string, arguments ...any) pgx.Row {
+	return q.conn(ctx).QueryRow(ctx, sql, arguments...)
+}
+
+// SendBatch implements Querier
+func (q contextQuerier) SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults {
+	return q.conn(ctx).SendBatch(ctx, b)
+}
+
+// CopyFrom implements Querier
+func (q contextQuerier) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	return q.conn(ctx).CopyFrom(ctx, tableName, columnNames, rowSrc)
+}
@@ -0,0 +1,80 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"backend/internal/platform/postgres"
+	"backend/internal/testing/pgtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitOfWork_NestedWorkUsesSavepoints(t *testing.T) {
+	tx := pgtest.Tx(t)
+	uow := postgres.NewUnitOfWork(pgtest.Pool(t))
+	db := postgres.NewContextQuerier(pgtest.Pool(t))
+
+	// Run inside the test's transaction so nothing outlives the test
+	ctx := postgres.ContextWithTx(context.Background(), tx)
+	_, err := tx.Exec(ctx, `CREATE TEMP TABLE uow_items (id INT PRIMARY KEY)`)
+	require.NoError(t, err)
+
+	errAbort := errors.New("abort")
+	err = uow.Do(ctx, func(ctx context.Context) error {
+		if _, err := db.Exec(ctx, `INSERT INTO uow_items (id) VALUES (1)`); err != nil {
+			return err
+		}
+
+		inner := uow.Do(ctx, func(ctx context.Context) error {
+			if _, err := db.Exec(ctx, `INSERT INTO uow_items (id) VALUES (2)`); err != nil {
+				return err
+			}
+			return errAbort
+		})
+		assert.ErrorIs(t, inner, errAbort)
+
+		return uow.Do(ctx, func(ctx context.Context) error {
+			_, err := db.Exec(ctx, `INSERT INTO uow_items (id) VALUES (3)`)
+			return err
+		})
+	})
+	require.NoError(t, err)
+
+	var ids []int32
+	rows, err := tx.Query(ctx, `SELECT id FROM uow_items ORDER BY id`)
+	require.NoError(t, err)
+	for rows.Next() {
+		var id int32
+		require.NoError(t, rows.Scan(&id))
+		ids = append(ids, id)
+	}
+	require.NoError(t, rows.Err())
+	assert.Equal(t, []int32{1, 3}, ids, "the failed nested unit only undid its own insert")
+}
+
+func TestUnitOfWork_FailureRollsBack(t *testing.T) {
+	tx := pgtest.Tx(t)
+	uow := postgres.NewUnitOfWork(pgtest.Pool(t))
+	db := postgres.NewContextQuerier(pgtest.Pool(t))
+
+	ctx := postgres.ContextWithTx(context.Background(), tx)
+	_, err := tx.Exec(ctx, `CREATE TEMP TABLE uow_rollback (id INT PRIMARY KEY)`)
+	require.NoError(t, err)
+
+	errAbort := errors.New("abort")
+	err = uow.Do(ctx, func(ctx context.Context) error {
+		_, ok := postgres.TxFromContext(ctx)
+		assert.True(t, ok)
+		if _, err := db.Exec(ctx, `INSERT INTO uow_rollback (id) VALUES (1)`); err != nil {
+			return err
+		}
+		return errAbort
+	})
+	require.ErrorIs(t, err, errAbort)
+
+	var count int
+	require.NoError(t, db.QueryRow(ctx, `SELECT COUNT(*) FROM uow_rollback`).Scan(&count))
+	assert.Zero(t, count)
+}
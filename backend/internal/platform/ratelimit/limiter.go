@@ -0,0 +1,82 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// Limiter decides whether an action identified by key may proceed.
+type Limiter interface {
+	Allow(key string) bool
+}
+
+// window tracks the number of hits for a key within the current window.
+type window struct {
+	start time.Time
+	count int
+}
+
+// FixedWindowLimiter allows up to limit actions per key within each window.
+// State is kept in memory, so limits apply per process.
+type FixedWindowLimiter struct {
+	limit   int
+	period  time.Duration
+	windows map[string]*window
+	swept   time.Time  // Last time expired windows were evicted
+	mu      sync.Mutex // Protects windows and swept
+	now     func() time.Time
+}
+
+// NewFixedWindowLimiter creates a limiter allowing limit actions per key every period.
+func NewFixedWindowLimiter(limit int, period time.Duration) *FixedWindowLimiter {
+	return &FixedWindowLimiter{
+		limit:   limit,
+		period:  period,
+		windows: make(map[string]*window),
+		now:     time.Now,
+	}
+}
+
+// Allow records a hit for key and reports whether it is within the limit.
+func (l *FixedWindowLimiter) Allow(key string) bool {
+	return l.AllowWithin(key, l.limit)
+}
+
+// AllowWithin records a hit for key and reports whether it is within limit,
+// which overrides the limiter's own for keys that carry a limit of their own.
+func (l *FixedWindowLimiter) AllowWithin(key string, limit int) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	w, found := l.windows[key]
+	if !found || now.Sub(w.start) >= l.period {
+		l.evictExpired(now)
+		l.windows[key] = &window{start: now, count: 1}
+		return true
+	}
+
+	if w.count >= limit {
+		return false
+	}
+	w.count++
+	return true
+}
+
+// evictExpired drops windows that have ended so idle keys don't accumulate.
+// It runs at most once per period to keep Allow cheap. Caller must hold l.mu.
+func (l *FixedWindowLimiter) evictExpired(now time.Time) {
+	if now.Sub(l.swept) < l.period {
+		return
+	}
+	l.swept = now
+
+	for key, w := range l.windows {
+		if now.Sub(w.start) >= l.period {
+			delete(l.windows, key)
+		}
+	}
+}
+
+// Ensure FixedWindowLimiter implements Limiter
+var _ Limiter = (*FixedWindowLimiter)(nil)
@@ -0,0 +1,53 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFixedWindowLimiter_Allow(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := NewFixedWindowLimiter(2, time.Minute)
+	limiter.now = func() time.Time { return now }
+
+	assert.True(t, limiter.Allow("user-1"))
+	assert.True(t, limiter.Allow("user-1"))
+	assert.False(t, limiter.Allow("user-1"), "third hit in the window should be rejected")
+
+	// Keys are limited independently
+	assert.True(t, limiter.Allow("user-2"))
+
+	// A new window resets the count
+	now = now.Add(time.Minute)
+	assert.True(t, limiter.Allow("user-1"))
+}
+
+func TestFixedWindowLimiter_EvictsExpiredWindows(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := NewFixedWindowLimiter(1, time.Minute)
+	limiter.now = func() time.Time { return now }
+
+	limiter.Allow("user-1")
+	limiter.Allow("user-2")
+
+	now = now.Add(2 * time.Minute)
+	limiter.Allow("user-3")
+
+	assert.Len(t, limiter.windows, 1)
+}
+
+func TestFixedWindowLimiter_AllowWithin(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := NewFixedWindowLimiter(1, time.Minute)
+	limiter.now = func() time.Time { return now }
+
+	assert.True(t, limiter.AllowWithin("token-1", 3))
+	assert.True(t, limiter.AllowWithin("token-1", 3))
+	assert.True(t, limiter.AllowWithin("token-1", 3))
+	assert.False(t, limiter.AllowWithin("token-1", 3), "the key's own limit applies")
+
+	assert.True(t, limiter.Allow("token-2"))
+	assert.False(t, limiter.Allow("token-2"), "other keys keep the default limit")
+}
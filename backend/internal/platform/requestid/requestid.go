@@ -0,0 +1,54 @@
+// Package requestid carries the ID that correlates everything done for one request.
+// The HTTP layer accepts the caller's X-Request-ID or generates one and stores it
+// in the context; the logger and the event bus read it back so log lines and the
+// event handlers a request triggers can be traced to it.
+package requestid
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Header is the HTTP header that carries the request ID in both directions
+const Header = "X-Request-ID"
+
+// MaxLength bounds an ID accepted from a caller
+const MaxLength = 128
+
+// contextKey is a private type so no other package can collide with the key
+type contextKey struct{}
+
+// WithID returns a copy of ctx carrying the given request ID
+func WithID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the request ID ctx carries, or "" when there is none
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
+
+// New generates a request ID
+func New() string {
+	return uuid.NewString()
+}
+
+// Valid reports whether an ID supplied by a caller is safe to adopt: non-empty,
+// at most MaxLength characters, and limited to letters, digits and - _ . :
+// so it cannot forge log fields or response headers
+func Valid(id string) bool {
+	if id == "" || len(id) > MaxLength {
+		return false
+	}
+	for _, c := range id {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case c == '-', c == '_', c == '.', c == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
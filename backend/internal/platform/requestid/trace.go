@@ -0,0 +1,47 @@
+package requestid
+
+import (
+	"context"
+	"strings"
+)
+
+// TraceParentHeader is the W3C Trace Context header that carries a distributed
+// trace across services: version-traceid-parentid-flags
+const TraceParentHeader = "traceparent"
+
+// traceKey is a private type so no other package can collide with the key
+type traceKey struct{}
+
+// WithTraceID returns a copy of ctx carrying the given trace ID
+func WithTraceID(ctx context.Context, traceID string) context.Context {
+	return context.WithValue(ctx, traceKey{}, traceID)
+}
+
+// TraceIDFromContext returns the trace ID ctx carries, or "" when there is none
+func TraceIDFromContext(ctx context.Context) string {
+	traceID, _ := ctx.Value(traceKey{}).(string)
+	return traceID
+}
+
+// ParseTraceParent returns the trace ID of a traceparent header, and false when
+// the header is malformed or carries the all-zero ID the spec marks invalid
+func ParseTraceParent(header string) (string, bool) {
+	parts := strings.Split(strings.TrimSpace(header), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" {
+		return "", false
+	}
+	traceID := parts[1]
+	if len(traceID) != 32 || !isLowerHex(traceID) || strings.Trim(traceID, "0") == "" {
+		return "", false
+	}
+	return traceID, true
+}
+
+func isLowerHex(s string) bool {
+	for _, c := range s {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,49 @@
+// Package safehttp builds HTTP transports for fetching URLs supplied by users,
+// which must not be able to reach the network the server runs in.
+package safehttp
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// ErrPrivateAddress is returned when a URL resolves to an address the transport must not reach
+var ErrPrivateAddress = errors.New("resolves to a private address")
+
+// NewTransport creates a transport whose connections each give up dialing after timeout.
+// Unless allowPrivate is set, private, loopback and link-local addresses are
+// refused and proxies are ignored; only tests and local development should set it.
+func NewTransport(timeout time.Duration, allowPrivate bool) *http.Transport {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivate {
+		// Checked on the address actually dialed, so DNS cannot point elsewhere after a check
+		dialer.Control = func(_, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil || !IsPublic(addrPort.Addr()) {
+				return ErrPrivateAddress
+			}
+			return nil
+		}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return transport
+}
+
+// IsPublic reports whether an address is on the public internet
+func IsPublic(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsValid() &&
+		!addr.IsLoopback() &&
+		!addr.IsPrivate() &&
+		!addr.IsLinkLocalUnicast() &&
+		!addr.IsLinkLocalMulticast() &&
+		!addr.IsMulticast() &&
+		!addr.IsUnspecified()
+}
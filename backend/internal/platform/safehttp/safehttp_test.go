@@ -0,0 +1,32 @@
+package safehttp_test
+
+import (
+	"net/netip"
+	"testing"
+
+	"backend/internal/platform/safehttp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsPublic(t *testing.T) {
+	tests := []struct {
+		addr   string
+		public bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.0.0.8", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"::ffff:127.0.0.1", false},
+		{"0.0.0.0", false},
+		{"fd00::1", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			assert.Equal(t, tt.public, safehttp.IsPublic(netip.MustParseAddr(tt.addr)))
+		})
+	}
+}
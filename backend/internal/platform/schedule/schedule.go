@@ -0,0 +1,28 @@
+// Package schedule runs background work at a fixed interval.
+package schedule
+
+import (
+	"context"
+	"time"
+)
+
+// Every calls fn once per interval until ctx is done. The first call waits a
+// full interval, so restarting the process does not trigger the work on boot.
+// A zero or negative interval disables the work and returns at once.
+func Every(ctx context.Context, interval time.Duration, fn func(ctx context.Context)) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			fn(ctx)
+		}
+	}
+}
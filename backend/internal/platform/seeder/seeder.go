@@ -2,9 +2,12 @@ package seeder
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 
 	"backend/internal/platform/logger"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -18,40 +21,176 @@ type Seeder interface {
 	Seed(ctx context.Context, db *pgxpool.Pool) error
 }
 
-// Orchestrator manages and runs multiple seeders in order
-type Orchestrator struct {
-	seeders []Seeder
+// Profile selects which seeders a run includes
+type Profile string
+
+const (
+	// ProfileProd is the baseline every deployment needs: permissions, roles, default settings
+	ProfileProd Profile = "prod"
+
+	// ProfileDemo adds sample users and content on top of the baseline, for local development
+	ProfileDemo Profile = "demo"
+)
+
+// ParseProfile validates a profile named on the command line
+func ParseProfile(s string) (Profile, error) {
+	switch p := Profile(s); p {
+	case ProfileProd, ProfileDemo:
+		return p, nil
+	default:
+		return "", fmt.Errorf("unknown seed profile %q, want prod or demo", s)
+	}
+}
+
+// includes reports whether a run of p includes seeders registered for other
+func (p Profile) includes(other Profile) bool {
+	return p == other || (p == ProfileDemo && other == ProfileProd)
+}
+
+// Entry registers a seeder with the registry
+type Entry struct {
+	Seeder  Seeder
+	Profile Profile // Lowest profile whose runs include the seeder
+
+	// Once skips the seeder after its first successful run. Baseline seeders
+	// run every time so new permissions and defaults reach existing databases;
+	// sample data runs once so it is not recreated after being edited away.
+	Once bool
+}
+
+// ErrUnknownSeeder is returned when a run names a seeder that is not registered
+var ErrUnknownSeeder = errors.New("unknown seeder")
+
+// Registry runs registered seeders in registration order and records each
+// completed run in the seed_history table
+type Registry struct {
+	entries []Entry
 	logger  logger.Logger
 	db      *pgxpool.Pool
 }
 
-// NewOrchestrator creates a new seeder orchestrator with all seeders injected
-func NewOrchestrator(logger logger.Logger, db *pgxpool.Pool, seeders []Seeder) *Orchestrator {
-	return &Orchestrator{
-		seeders: seeders,
+// NewRegistry creates a new seeder registry; entries run in the order given
+func NewRegistry(logger logger.Logger, db *pgxpool.Pool, entries []Entry) (*Registry, error) {
+	seen := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		name := entry.Seeder.Name()
+		if seen[name] {
+			return nil, fmt.Errorf("seeder %s registered twice", name)
+		}
+		seen[name] = true
+	}
+
+	return &Registry{
+		entries: entries,
 		logger:  logger,
 		db:      db,
+	}, nil
+}
+
+// Names returns the registered seeder names in run order
+func (r *Registry) Names() []string {
+	names := make([]string, len(r.entries))
+	for i, entry := range r.entries {
+		names[i] = entry.Seeder.Name()
+	}
+	return names
+}
+
+// Run executes every seeder the profile includes
+// Seeders registered with Once are skipped when the history shows they already ran
+func (r *Registry) Run(ctx context.Context, profile Profile) error {
+	var selected []Entry
+	for _, entry := range r.entries {
+		if profile.includes(entry.Profile) {
+			selected = append(selected, entry)
+		}
 	}
+
+	r.logger.Info(ctx, "starting data seeding", "profile", profile, "seeder_count", len(selected))
+	return r.run(ctx, selected, false)
 }
 
-// RunAll executes all registered seeders in order
-func (o *Orchestrator) RunAll(ctx context.Context) error {
-	o.logger.Info(ctx, "starting data seeding", "seeder_count", len(o.seeders))
+// RunNamed executes the named seeders, in registration order, whatever their
+// profile. Naming a seeder forces it to run even if it is registered with Once.
+func (r *Registry) RunNamed(ctx context.Context, names []string) error {
+	var selected []Entry
+	for _, name := range names {
+		if !slices.ContainsFunc(r.entries, func(e Entry) bool { return e.Seeder.Name() == name }) {
+			return fmt.Errorf("%w: %s", ErrUnknownSeeder, name)
+		}
+	}
+	for _, entry := range r.entries {
+		if slices.Contains(names, entry.Seeder.Name()) {
+			selected = append(selected, entry)
+		}
+	}
+
+	r.logger.Info(ctx, "starting data seeding", "seeders", names)
+	return r.run(ctx, selected, true)
+}
+
+// run executes entries in order, stopping at the first failure
+func (r *Registry) run(ctx context.Context, entries []Entry, force bool) error {
+	for _, entry := range entries {
+		name := entry.Seeder.Name()
+
+		if entry.Once && !force {
+			ran, err := r.hasRun(ctx, name)
+			if err != nil {
+				return fmt.Errorf("seeder %s: %w", name, err)
+			}
+			if ran {
+				r.logger.Info(ctx, "skipping seeder that already ran", "seeder", name)
+				continue
+			}
+		}
 
-	for _, seeder := range o.seeders {
-		o.logger.Info(ctx, "running seeder", "seeder", seeder.Name())
+		r.logger.Info(ctx, "running seeder", "seeder", name)
 
-		if err := seeder.Seed(ctx, o.db); err != nil {
-			o.logger.Error(ctx, "seeder failed",
-				"seeder", seeder.Name(),
+		if err := entry.Seeder.Seed(ctx, r.db); err != nil {
+			r.logger.Error(ctx, "seeder failed",
+				"seeder", name,
 				"error", err,
 			)
-			return fmt.Errorf("seeder %s failed: %w", seeder.Name(), err)
+			return fmt.Errorf("seeder %s failed: %w", name, err)
 		}
 
-		o.logger.Info(ctx, "seeder completed successfully", "seeder", seeder.Name())
+		if err := r.recordRun(ctx, name); err != nil {
+			return fmt.Errorf("seeder %s: %w", name, err)
+		}
+
+		r.logger.Info(ctx, "seeder completed successfully", "seeder", name)
+	}
+
+	r.logger.Info(ctx, "all seeders completed successfully")
+	return nil
+}
+
+// hasRun reports whether the history holds a completed run of the seeder
+func (r *Registry) hasRun(ctx context.Context, name string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(ctx, `SELECT true FROM seed_history WHERE name = $1`, name).Scan(&exists)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to read seed history: %w", err)
 	}
+	return exists, nil
+}
 
-	o.logger.Info(ctx, "all seeders completed successfully")
+// recordRun adds a completed run of the seeder to the history
+func (r *Registry) recordRun(ctx context.Context, name string) error {
+	query := `
+		INSERT INTO seed_history (name, run_count, first_run_at, last_run_at)
+		VALUES ($1, 1, NOW(), NOW())
+		ON CONFLICT (name)
+		DO UPDATE SET
+			run_count = seed_history.run_count + 1,
+			last_run_at = NOW()
+	`
+	if _, err := r.db.Exec(ctx, query, name); err != nil {
+		return fmt.Errorf("failed to record seed history: %w", err)
+	}
 	return nil
 }
@@ -0,0 +1,59 @@
+package seeder
+
+import (
+	"context"
+	"testing"
+
+	"backend/internal/platform/logger"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type namedSeeder string
+
+func (s namedSeeder) Name() string                                  { return string(s) }
+func (s namedSeeder) Seed(_ context.Context, _ *pgxpool.Pool) error { return nil }
+
+func TestParseProfile(t *testing.T) {
+	profile, err := ParseProfile("demo")
+	require.NoError(t, err)
+	assert.Equal(t, ProfileDemo, profile)
+
+	_, err = ParseProfile("staging")
+	assert.Error(t, err)
+}
+
+func TestProfileIncludes(t *testing.T) {
+	assert.True(t, ProfileProd.includes(ProfileProd))
+	assert.False(t, ProfileProd.includes(ProfileDemo), "prod runs never create sample data")
+	assert.True(t, ProfileDemo.includes(ProfileProd), "demo runs build on the baseline")
+	assert.True(t, ProfileDemo.includes(ProfileDemo))
+}
+
+func TestNewRegistry(t *testing.T) {
+	log := logger.NewSlogAdapter("test", "error")
+
+	registry, err := NewRegistry(log, nil, []Entry{
+		{Seeder: namedSeeder("Baseline"), Profile: ProfileProd},
+		{Seeder: namedSeeder("Sample"), Profile: ProfileDemo, Once: true},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Baseline", "Sample"}, registry.Names())
+
+	_, err = NewRegistry(log, nil, []Entry{
+		{Seeder: namedSeeder("Baseline"), Profile: ProfileProd},
+		{Seeder: namedSeeder("Baseline"), Profile: ProfileDemo},
+	})
+	assert.Error(t, err, "names identify seeders in the history, so they must be unique")
+}
+
+func TestRunNamedRejectsUnknownSeeders(t *testing.T) {
+	registry, err := NewRegistry(logger.NewSlogAdapter("test", "error"), nil, []Entry{
+		{Seeder: namedSeeder("Baseline"), Profile: ProfileProd},
+	})
+	require.NoError(t, err)
+
+	err = registry.RunNamed(context.Background(), []string{"Baseline", "Missing"})
+	assert.ErrorIs(t, err, ErrUnknownSeeder)
+}
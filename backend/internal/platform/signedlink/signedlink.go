@@ -0,0 +1,185 @@
+// Package signedlink issues and verifies expiring action links.
+// A link lets whoever follows it perform one action on one resource as the
+// user it was issued to, without a session: an emailed "unpublish this post"
+// button, for instance. The action, resource, user, expiry and a nonce are
+// signed with HMAC-SHA256 and carried in the query string; each nonce is
+// accepted once, so a link cannot be replayed.
+package signedlink
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MinSecretLength is the shortest signing secret accepted, in bytes
+const MinSecretLength = 32
+
+// Query parameters carrying a link
+const (
+	ParamAction    = "action"
+	ParamResource  = "resource"
+	ParamSubject   = "subject"
+	ParamExpires   = "expires"
+	ParamNonce     = "nonce"
+	ParamSignature = "sig"
+)
+
+// Verification errors
+var (
+	ErrMalformed        = errors.New("signed link is malformed")
+	ErrInvalidSignature = errors.New("signed link signature does not match")
+	ErrWrongTarget      = errors.New("signed link was issued for another action or resource")
+	ErrExpired          = errors.New("signed link has expired")
+	ErrReplayed         = errors.New("signed link has already been used")
+)
+
+// Link is one action on one resource, performed as Subject
+type Link struct {
+	Action    string
+	Resource  string
+	Subject   uuid.UUID
+	ExpiresAt time.Time
+	Nonce     string
+}
+
+// NonceStore remembers the nonces of links already followed
+type NonceStore interface {
+	// Consume marks nonce as used until expiresAt and reports whether it was
+	// still unused. It must be atomic, so concurrent requests with one link
+	// cannot both succeed.
+	Consume(ctx context.Context, nonce string, expiresAt time.Time) (bool, error)
+}
+
+// Config holds the signing secret, shared by every instance, and how long links work
+type Config struct {
+	Secret []byte
+	TTL    time.Duration
+}
+
+// Signer issues and verifies links with a shared secret
+type Signer struct {
+	secret []byte
+	ttl    time.Duration
+	nonces NonceStore
+	now    func() time.Time
+}
+
+// NewSigner creates a signer, refusing secrets too short to be safe
+func NewSigner(config Config, nonces NonceStore) (*Signer, error) {
+	if len(config.Secret) < MinSecretLength {
+		return nil, fmt.Errorf("signed link secret must be at least %d bytes", MinSecretLength)
+	}
+	return &Signer{secret: config.Secret, ttl: config.TTL, nonces: nonces, now: time.Now}, nil
+}
+
+// Issue signs a link letting subject perform action on resource until the
+// configured lifetime has passed, and returns it as query parameters
+func (s *Signer) Issue(action, resource string, subject uuid.UUID) (*Link, url.Values, error) {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, nil, fmt.Errorf("generate nonce: %w", err)
+	}
+
+	link := &Link{
+		Action:    action,
+		Resource:  resource,
+		Subject:   subject,
+		ExpiresAt: s.now().Add(s.ttl).Truncate(time.Second),
+		Nonce:     base64.RawURLEncoding.EncodeToString(nonce),
+	}
+
+	query := url.Values{}
+	query.Set(ParamAction, link.Action)
+	query.Set(ParamResource, link.Resource)
+	query.Set(ParamSubject, link.Subject.String())
+	query.Set(ParamExpires, strconv.FormatInt(link.ExpiresAt.Unix(), 10))
+	query.Set(ParamNonce, link.Nonce)
+	query.Set(ParamSignature, s.sign(link))
+	return link, query, nil
+}
+
+// Verify checks a link received as query parameters for action on resource
+// and uses it up. A link failing any check is not used up.
+func (s *Signer) Verify(ctx context.Context, query url.Values, action, resource string) (*Link, error) {
+	link, err := parse(query)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(query.Get(ParamSignature))
+	if err != nil {
+		return nil, ErrMalformed
+	}
+	expected, _ := base64.RawURLEncoding.DecodeString(s.sign(link))
+	if !hmac.Equal(signature, expected) {
+		return nil, ErrInvalidSignature
+	}
+
+	if link.Action != action || link.Resource != resource {
+		return nil, ErrWrongTarget
+	}
+	if !s.now().Before(link.ExpiresAt) {
+		return nil, ErrExpired
+	}
+
+	fresh, err := s.nonces.Consume(ctx, link.Nonce, link.ExpiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("signedlink.Verify: %w", err)
+	}
+	if !fresh {
+		return nil, ErrReplayed
+	}
+	return link, nil
+}
+
+// sign computes the signature of a link. Fields are separated by a byte
+// that cannot occur in them, so no two links share a signed message.
+func (s *Signer) sign(link *Link) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(strings.Join([]string{
+		link.Action,
+		link.Resource,
+		link.Subject.String(),
+		strconv.FormatInt(link.ExpiresAt.Unix(), 10),
+		link.Nonce,
+	}, "\x00")))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// parse reads the fields of a link from query parameters
+func parse(query url.Values) (*Link, error) {
+	for _, param := range []string{ParamAction, ParamResource, ParamSubject, ParamExpires, ParamNonce, ParamSignature} {
+		value := query.Get(param)
+		if value == "" || strings.ContainsRune(value, 0) {
+			return nil, ErrMalformed
+		}
+	}
+
+	subject, err := uuid.Parse(query.Get(ParamSubject))
+	if err != nil {
+		return nil, ErrMalformed
+	}
+	expires, err := strconv.ParseInt(query.Get(ParamExpires), 10, 64)
+	if err != nil {
+		return nil, ErrMalformed
+	}
+
+	return &Link{
+		Action:    query.Get(ParamAction),
+		Resource:  query.Get(ParamResource),
+		Subject:   subject,
+		ExpiresAt: time.Unix(expires, 0),
+		Nonce:     query.Get(ParamNonce),
+	}, nil
+}
@@ -0,0 +1,103 @@
+package signedlink
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryNonces is an in-memory NonceStore
+type memoryNonces struct {
+	mu   sync.Mutex
+	used map[string]bool
+}
+
+func (m *memoryNonces) Consume(_ context.Context, nonce string, _ time.Time) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.used[nonce] {
+		return false, nil
+	}
+	m.used[nonce] = true
+	return true, nil
+}
+
+func newTestSigner(t *testing.T) *Signer {
+	t.Helper()
+	signer, err := NewSigner(Config{Secret: []byte(strings.Repeat("k", MinSecretLength)), TTL: time.Hour}, &memoryNonces{used: map[string]bool{}})
+	require.NoError(t, err)
+	return signer
+}
+
+func TestNewSigner_RejectsShortSecret(t *testing.T) {
+	_, err := NewSigner(Config{Secret: []byte("short"), TTL: time.Hour}, &memoryNonces{})
+	assert.Error(t, err)
+}
+
+func TestSigner_IssueAndVerify(t *testing.T) {
+	signer := newTestSigner(t)
+	ctx := context.Background()
+	subject := uuid.New()
+
+	issued, query, err := signer.Issue("posts.unpublish", "post-1", subject)
+	require.NoError(t, err)
+
+	link, err := signer.Verify(ctx, query, "posts.unpublish", "post-1")
+	require.NoError(t, err)
+	assert.Equal(t, subject, link.Subject)
+	assert.True(t, issued.ExpiresAt.Equal(link.ExpiresAt))
+
+	_, err = signer.Verify(ctx, query, "posts.unpublish", "post-1")
+	assert.ErrorIs(t, err, ErrReplayed, "each link is accepted once")
+}
+
+func TestSigner_VerifyRejections(t *testing.T) {
+	signer := newTestSigner(t)
+	ctx := context.Background()
+
+	_, query, err := signer.Issue("posts.approve", "post-1", uuid.New())
+	require.NoError(t, err)
+
+	_, err = signer.Verify(ctx, query, "posts.unpublish", "post-1")
+	assert.ErrorIs(t, err, ErrWrongTarget)
+	_, err = signer.Verify(ctx, query, "posts.approve", "post-2")
+	assert.ErrorIs(t, err, ErrWrongTarget)
+
+	tampered := cloneQuery(query)
+	tampered.Set(ParamSubject, uuid.NewString())
+	_, err = signer.Verify(ctx, tampered, "posts.approve", "post-1")
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+
+	tampered = cloneQuery(query)
+	tampered.Del(ParamNonce)
+	_, err = signer.Verify(ctx, tampered, "posts.approve", "post-1")
+	assert.ErrorIs(t, err, ErrMalformed)
+
+	other, err := NewSigner(Config{Secret: []byte(strings.Repeat("x", MinSecretLength)), TTL: time.Hour}, &memoryNonces{used: map[string]bool{}})
+	require.NoError(t, err)
+	_, err = other.Verify(ctx, query, "posts.approve", "post-1")
+	assert.ErrorIs(t, err, ErrInvalidSignature, "links from another secret are refused")
+
+	signer.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	_, err = signer.Verify(ctx, query, "posts.approve", "post-1")
+	assert.ErrorIs(t, err, ErrExpired)
+
+	signer.now = time.Now
+	_, err = signer.Verify(ctx, query, "posts.approve", "post-1")
+	assert.NoError(t, err, "failed checks do not use the link up")
+}
+
+func cloneQuery(query url.Values) url.Values {
+	clone := make(url.Values, len(query))
+	for k, v := range query {
+		clone[k] = append([]string(nil), v...)
+	}
+	return clone
+}
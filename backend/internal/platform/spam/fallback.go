@@ -0,0 +1,34 @@
+package spam
+
+import (
+	"context"
+
+	"backend/internal/platform/logger"
+)
+
+// FallbackChecker asks a primary checker and, when it cannot decide, a fallback,
+// so an outage of a hosted service neither blocks submissions nor lets them through unchecked
+type FallbackChecker struct {
+	primary  Checker
+	fallback Checker
+	log      logger.Logger
+}
+
+// NewFallbackChecker creates a checker that falls back when primary fails
+func NewFallbackChecker(primary, fallback Checker, log logger.Logger) *FallbackChecker {
+	return &FallbackChecker{primary: primary, fallback: fallback, log: log}
+}
+
+// Check returns the primary verdict, or the fallback verdict if the primary fails
+func (c *FallbackChecker) Check(ctx context.Context, content Content) (Verdict, error) {
+	verdict, err := c.primary.Check(ctx, content)
+	if err == nil {
+		return verdict, nil
+	}
+
+	c.log.Warn(ctx, "spam check failed, using fallback", "kind", content.Kind, "error", err)
+	return c.fallback.Check(ctx, content)
+}
+
+// Ensure FallbackChecker implements Checker
+var _ Checker = (*FallbackChecker)(nil)
@@ -0,0 +1,57 @@
+package spam
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DefaultMaxLinks is how many links content may carry before it looks like spam
+const DefaultMaxLinks = 3
+
+var (
+	linkPattern   = regexp.MustCompile(`(?i)\b(?:https?://|www\.)`)
+	bbcodePattern = regexp.MustCompile(`(?i)\[url[=\]]`)
+)
+
+// HeuristicChecker judges content with local rules and never fails. It catches
+// the crudest link spam only, so it backs a hosted service rather than replacing one.
+type HeuristicChecker struct {
+	maxLinks  int
+	blocklist []string
+}
+
+// NewHeuristicChecker creates a checker allowing up to maxLinks links and
+// refusing content containing any blocklisted term, compared case-insensitively
+func NewHeuristicChecker(maxLinks int, blocklist []string) *HeuristicChecker {
+	terms := make([]string, 0, len(blocklist))
+	for _, term := range blocklist {
+		if term = strings.ToLower(strings.TrimSpace(term)); term != "" {
+			terms = append(terms, term)
+		}
+	}
+	return &HeuristicChecker{maxLinks: maxLinks, blocklist: terms}
+}
+
+// Check applies the rules to the content's body, author name and author URL
+func (c *HeuristicChecker) Check(_ context.Context, content Content) (Verdict, error) {
+	if bbcodePattern.MatchString(content.Body) {
+		// Nothing on this site renders BBCode; only bots paste it into forms
+		return Verdict{Spam: true, Reason: "contains BBCode links"}, nil
+	}
+	if links := len(linkPattern.FindAllStringIndex(content.Body, -1)); links > c.maxLinks {
+		return Verdict{Spam: true, Reason: fmt.Sprintf("contains %d links", links)}, nil
+	}
+
+	text := strings.ToLower(content.Body + "\n" + content.AuthorName + "\n" + content.AuthorURL)
+	for _, term := range c.blocklist {
+		if strings.Contains(text, term) {
+			return Verdict{Spam: true, Reason: fmt.Sprintf("contains blocked term %q", term)}, nil
+		}
+	}
+	return Ham, nil
+}
+
+// Ensure HeuristicChecker implements Checker
+var _ Checker = (*HeuristicChecker)(nil)
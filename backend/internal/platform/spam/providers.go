@@ -0,0 +1,37 @@
+package spam
+
+import (
+	"context"
+
+	"backend/internal/platform/logger"
+)
+
+// Config selects and tunes the spam checker
+type Config struct {
+	AkismetKey string // Hosted checking is used only when a key is set
+	AkismetURL string
+	SiteURL    string // Public URL of the blog, sent to Akismet to identify it
+	MaxLinks   int
+	Blocklist  []string
+}
+
+// ProvideChecker creates the spam checker: Akismet backed by the heuristics when
+// a key is configured, otherwise the heuristics alone
+func ProvideChecker(cfg Config, log logger.Logger) Checker {
+	maxLinks := cfg.MaxLinks
+	if maxLinks <= 0 {
+		maxLinks = DefaultMaxLinks
+	}
+	heuristics := NewHeuristicChecker(maxLinks, cfg.Blocklist)
+
+	if cfg.AkismetKey == "" {
+		log.Info(context.Background(), "Akismet spam checking disabled; set SPAM_AKISMET_KEY to enable it")
+		return heuristics
+	}
+
+	endpoint := cfg.AkismetURL
+	if endpoint == "" {
+		endpoint = DefaultAkismetURL
+	}
+	return NewFallbackChecker(NewAkismetChecker(endpoint, cfg.AkismetKey, cfg.SiteURL), heuristics, log)
+}
@@ -0,0 +1,45 @@
+// Package spam screens user-generated content before it is stored.
+//
+// Modules that accept content from readers, such as comments or the contact
+// form, describe each submission as Content and ask a Checker for a Verdict.
+// Content judged spam is kept in quarantine for a moderator to release or
+// confirm rather than being published or thrown away.
+package spam
+
+import "context"
+
+// Kind names the type of content being checked
+type Kind string
+
+const (
+	KindComment Kind = "comment"
+	KindContact Kind = "contact-form"
+)
+
+// Content is one submission along with what is known about who sent it
+type Content struct {
+	Kind        Kind
+	Body        string
+	AuthorName  string
+	AuthorEmail string
+	AuthorURL   string
+	ClientIP    string
+	UserAgent   string
+	Referrer    string
+	Permalink   string // Absolute URL of the page the content was submitted on
+}
+
+// Verdict is a checker's judgement of one submission
+type Verdict struct {
+	Spam   bool
+	Reason string // Why the content was judged spam; empty for ham
+}
+
+// Ham is the verdict for content that looks legitimate
+var Ham = Verdict{}
+
+// Checker judges whether content is spam.
+// An error means the checker could not decide, not that the content is spam.
+type Checker interface {
+	Check(ctx context.Context, content Content) (Verdict, error)
+}
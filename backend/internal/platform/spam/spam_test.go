@@ -0,0 +1,86 @@
+package spam_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"backend/internal/platform/logger"
+	"backend/internal/platform/spam"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeuristicChecker(t *testing.T) {
+	checker := spam.NewHeuristicChecker(2, []string{" Casino ", ""})
+
+	tests := []struct {
+		name    string
+		content spam.Content
+		spam    bool
+	}{
+		{"plain text", spam.Content{Body: "Great write-up, thanks!"}, false},
+		{"links within limit", spam.Content{Body: "See https://a.example and www.b.example"}, false},
+		{"too many links", spam.Content{Body: "http://a.example http://b.example https://c.example"}, true},
+		{"bbcode", spam.Content{Body: "[URL=http://a.example]cheap[/URL]"}, true},
+		{"blocked term in body", spam.Content{Body: "Best CASINO bonus"}, true},
+		{"blocked term in author", spam.Content{Body: "Nice", AuthorURL: "https://casino.example"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verdict, err := checker.Check(context.Background(), tt.content)
+			require.NoError(t, err)
+			assert.Equal(t, tt.spam, verdict.Spam)
+			assert.Equal(t, tt.spam, verdict.Reason != "")
+		})
+	}
+}
+
+func TestAkismetChecker(t *testing.T) {
+	answer := "true"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "key", r.PostForm.Get("api_key"))
+		assert.Equal(t, "https://blog.example", r.PostForm.Get("blog"))
+		assert.Equal(t, "comment", r.PostForm.Get("comment_type"))
+		assert.Equal(t, "203.0.113.7", r.PostForm.Get("user_ip"))
+		assert.False(t, r.PostForm.Has("comment_author_url"), "empty fields are left out")
+		_, _ = w.Write([]byte(answer))
+	}))
+	defer server.Close()
+
+	checker := spam.NewAkismetChecker(server.URL, "key", "https://blog.example")
+	content := spam.Content{Kind: spam.KindComment, Body: "Hello", ClientIP: "203.0.113.7"}
+
+	verdict, err := checker.Check(context.Background(), content)
+	require.NoError(t, err)
+	assert.True(t, verdict.Spam)
+
+	answer = "false"
+	verdict, err = checker.Check(context.Background(), content)
+	require.NoError(t, err)
+	assert.Equal(t, spam.Ham, verdict)
+
+	answer = "invalid"
+	_, err = checker.Check(context.Background(), content)
+	assert.Error(t, err)
+}
+
+func TestFallbackChecker(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	checker := spam.NewFallbackChecker(
+		spam.NewAkismetChecker(server.URL, "key", "https://blog.example"),
+		spam.NewHeuristicChecker(spam.DefaultMaxLinks, []string{"casino"}),
+		logger.NewBootstrapLogger(),
+	)
+
+	verdict, err := checker.Check(context.Background(), spam.Content{Body: "casino"})
+	require.NoError(t, err)
+	assert.True(t, verdict.Spam)
+}
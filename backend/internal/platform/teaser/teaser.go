@@ -0,0 +1,96 @@
+// Package teaser cuts HTML content down to its opening, to show readers a
+// preview of content they may not read in full.
+package teaser
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+)
+
+// Ellipsis ends a teaser to show the content goes on
+const Ellipsis = "…"
+
+// voidElements have no closing tag, so they are never left open at the cut
+var voidElements = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true, "hr": true, "img": true,
+	"input": true, "link": true, "meta": true, "source": true, "track": true, "wbr": true,
+}
+
+// Extract returns the leading part of HTML content holding at most maxChars
+// characters of text, and whether anything was cut. The cut falls between
+// words where it can, and the elements open at the cut are closed, so the
+// teaser is well-formed on its own. Content short enough is returned as is.
+func Extract(content string, maxChars int) (string, bool) {
+	var b strings.Builder
+	var open []string
+	remaining := maxChars
+
+	tokenizer := html.NewTokenizer(strings.NewReader(content))
+	for {
+		tokenType := tokenizer.Next()
+		switch tokenType {
+		case html.ErrorToken:
+			return content, false
+
+		case html.TextToken:
+			text := string(tokenizer.Text())
+			length := utf8.RuneCountInString(text)
+			// Whitespace between elements is layout, not text a reader sees
+			if length <= remaining || strings.TrimSpace(text) == "" {
+				b.Write(tokenizer.Raw())
+				if strings.TrimSpace(text) != "" {
+					remaining -= length
+				}
+				continue
+			}
+
+			b.WriteString(html.EscapeString(cutAtWord(text, remaining)))
+			b.WriteString(Ellipsis)
+			for i := len(open) - 1; i >= 0; i-- {
+				b.WriteString("</" + open[i] + ">")
+			}
+			return b.String(), true
+
+		case html.StartTagToken:
+			b.Write(tokenizer.Raw())
+			name, _ := tokenizer.TagName()
+			if !voidElements[string(name)] {
+				open = append(open, string(name))
+			}
+
+		case html.EndTagToken:
+			b.Write(tokenizer.Raw())
+			name, _ := tokenizer.TagName()
+			for i := len(open) - 1; i >= 0; i-- {
+				if open[i] == string(name) {
+					open = open[:i]
+					break
+				}
+			}
+
+		default:
+			b.Write(tokenizer.Raw())
+		}
+	}
+}
+
+// cutAtWord returns the first n runes of text, backed up to the end of the
+// last whole word when the cut would split one
+func cutAtWord(text string, n int) string {
+	runes := []rune(text)
+	cut := runes[:n]
+	if !unicode.IsSpace(runes[n]) {
+		end := 0
+		for i := len(cut) - 1; i > 0; i-- {
+			if unicode.IsSpace(cut[i]) {
+				end = i
+				break
+			}
+		}
+		cut = cut[:end]
+	}
+	return strings.TrimRightFunc(string(cut), unicode.IsSpace)
+}
@@ -0,0 +1,68 @@
+package teaser_test
+
+import (
+	"testing"
+
+	"backend/internal/platform/teaser"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		maxChars int
+		want     string
+		cut      bool
+	}{
+		{
+			name:     "short content is kept whole",
+			content:  "<p>Hello world</p>",
+			maxChars: 50,
+			want:     "<p>Hello world</p>",
+		},
+		{
+			name:     "cuts between words and closes open elements",
+			content:  "<p>The quick <strong>brown fox</strong> jumps</p><p>Second paragraph</p>",
+			maxChars: 18,
+			want:     "<p>The quick <strong>brown…</strong></p>",
+			cut:      true,
+		},
+		{
+			name:     "whitespace between blocks is not counted",
+			content:  "<p>One</p>\n\n<p>Two</p>\n<p>Three</p>",
+			maxChars: 6,
+			want:     "<p>One</p>\n\n<p>Two</p>\n<p>…</p>",
+			cut:      true,
+		},
+		{
+			name:     "a word split at the start of a text is dropped",
+			content:  "<p>Plain <em>emphasis</em></p>",
+			maxChars: 9,
+			want:     "<p>Plain <em>…</em></p>",
+			cut:      true,
+		},
+		{
+			name:     "void elements are not closed",
+			content:  "<p><img src=\"a.png\">Caption text here</p>",
+			maxChars: 7,
+			want:     "<p><img src=\"a.png\">Caption…</p>",
+			cut:      true,
+		},
+		{
+			name:     "text is escaped after the cut",
+			content:  "<p>Fish &amp; chips and peas</p>",
+			maxChars: 12,
+			want:     "<p>Fish &amp; chips…</p>",
+			cut:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, cut := teaser.Extract(tt.content, tt.maxChars)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.cut, cut)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+// Package tenant carries the blog a request is served for.
+// The HTTP layer resolves the blog once per request and stores its ID in the
+// context; repositories read it back to scope every query to that blog.
+package tenant
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// DefaultBlogID identifies the blog created with the deployment.
+// It owns all content that predates multi-blog support and serves
+// requests that no other blog claims.
+var DefaultBlogID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+// contextKey is a private type so no other package can collide with the key
+type contextKey struct{}
+
+// WithBlogID returns a copy of ctx scoped to the given blog
+func WithBlogID(ctx context.Context, blogID uuid.UUID) context.Context {
+	return context.WithValue(ctx, contextKey{}, blogID)
+}
+
+// BlogID returns the blog ctx is scoped to, or DefaultBlogID when none was set
+// Background work such as seeding and event handlers therefore acts on the default blog
+func BlogID(ctx context.Context) uuid.UUID {
+	if blogID, ok := ctx.Value(contextKey{}).(uuid.UUID); ok {
+		return blogID
+	}
+	return DefaultBlogID
+}
+
+// IsDefault reports whether ctx is scoped to the default blog
+func IsDefault(ctx context.Context) bool {
+	return BlogID(ctx) == DefaultBlogID
+}
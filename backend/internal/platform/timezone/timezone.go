@@ -0,0 +1,50 @@
+// Package timezone carries the time zone a request's user reads and writes
+// times in. Times are always stored in UTC; the authentication middleware
+// stores the user's preferred zone in the context so services can place
+// times on the user's calendar days and responses can show them locally.
+package timezone
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	// Embedded so zones load on hosts without a zoneinfo database
+	_ "time/tzdata"
+)
+
+// Default is the zone of users who have not chosen one, and of requests made by no user
+const Default = "UTC"
+
+// ErrInvalid is returned for names that are not IANA time zones
+var ErrInvalid = errors.New("time zone must be an IANA time zone name such as Europe/Paris")
+
+// Load returns the zone an IANA name such as "Asia/Taipei" stands for. Unlike
+// time.LoadLocation it refuses the empty name and "Local", which depend on
+// the server rather than on the user.
+func Load(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return nil, ErrInvalid
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, ErrInvalid
+	}
+	return loc, nil
+}
+
+// contextKey is a private type so no other package can collide with the key
+type contextKey struct{}
+
+// WithLocation returns a copy of ctx whose user reads times in loc
+func WithLocation(ctx context.Context, loc *time.Location) context.Context {
+	return context.WithValue(ctx, contextKey{}, loc)
+}
+
+// FromContext returns the zone ctx's user reads times in, or UTC when none was set
+func FromContext(ctx context.Context) *time.Location {
+	if loc, ok := ctx.Value(contextKey{}).(*time.Location); ok && loc != nil {
+		return loc
+	}
+	return time.UTC
+}
@@ -0,0 +1,34 @@
+package timezone_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"backend/internal/platform/timezone"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	loc, err := timezone.Load("Asia/Taipei")
+	require.NoError(t, err)
+	assert.Equal(t, "Asia/Taipei", loc.String())
+
+	loc, err = timezone.Load(timezone.Default)
+	require.NoError(t, err)
+	assert.Equal(t, time.UTC.String(), loc.String())
+
+	for _, name := range []string{"", "Local", "Mars/Olympus_Mons", "+08:00"} {
+		_, err := timezone.Load(name)
+		assert.ErrorIs(t, err, timezone.ErrInvalid, name)
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	assert.Equal(t, time.UTC, timezone.FromContext(context.Background()))
+
+	taipei, err := timezone.Load("Asia/Taipei")
+	require.NoError(t, err)
+	assert.Equal(t, taipei, timezone.FromContext(timezone.WithLocation(context.Background(), taipei)))
+}
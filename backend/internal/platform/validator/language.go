@@ -0,0 +1,80 @@
+package validator
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Language validation errors
+var (
+	ErrInvalidLanguageTag = errors.New("language must be an ISO 639 code with an optional region, e.g. en or zh-TW")
+)
+
+// languageTagRegex accepts a primary language subtag with an optional region subtag
+var languageTagRegex = regexp.MustCompile(`^[a-z]{2,3}(-[A-Z]{2})?$`)
+
+// NormalizeLanguageTag canonicalizes the case of a language tag ("EN-us" becomes "en-US")
+// and validates it. Scripts, variants and other extended subtags are not supported.
+func NormalizeLanguageTag(tag string) (string, error) {
+	tag = strings.ReplaceAll(strings.TrimSpace(tag), "_", "-")
+
+	primary, region, hasRegion := strings.Cut(tag, "-")
+	normalized := strings.ToLower(primary)
+	if hasRegion {
+		normalized += "-" + strings.ToUpper(region)
+	}
+
+	if !languageTagRegex.MatchString(normalized) {
+		return "", ErrInvalidLanguageTag
+	}
+
+	return normalized, nil
+}
+
+// ParseAcceptLanguage returns the valid language tags from an Accept-Language
+// header, normalized and ordered from most to least preferred.
+// The wildcard and entries with q=0 are dropped.
+func ParseAcceptLanguage(header string) []string {
+	type weighted struct {
+		tag     string
+		quality float64
+	}
+
+	var entries []weighted
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+
+		quality := 1.0
+		if value, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			q, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			quality = q
+		}
+
+		if quality <= 0 || tag == "*" {
+			continue
+		}
+
+		normalized, err := NormalizeLanguageTag(tag)
+		if err != nil {
+			continue
+		}
+		entries = append(entries, weighted{tag: normalized, quality: quality})
+	}
+
+	// Stable sort keeps header order for equal weights
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].quality > entries[j].quality
+	})
+
+	tags := make([]string, len(entries))
+	for i, entry := range entries {
+		tags[i] = entry.tag
+	}
+	return tags
+}
@@ -0,0 +1,50 @@
+package validator_test
+
+import (
+	"testing"
+
+	"backend/internal/platform/validator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeLanguageTag(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{input: "en", want: "en"},
+		{input: "EN-us", want: "en-US"},
+		{input: "zh_tw", want: "zh-TW"},
+		{input: " fr ", want: "fr"},
+		{input: "", wantErr: true},
+		{input: "english", wantErr: true},
+		{input: "zh-Hant-TW", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := validator.NormalizeLanguageTag(tt.input)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, validator.ErrInvalidLanguageTag)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	assert.Equal(t,
+		[]string{"zh-TW", "zh", "en-US", "en"},
+		validator.ParseAcceptLanguage("zh-TW,zh;q=0.9,en-US;q=0.8,en;q=0.7"),
+	)
+	assert.Equal(t,
+		[]string{"fr", "de"},
+		validator.ParseAcceptLanguage("de;q=0.5, *;q=0.1, fr, es;q=0"),
+	)
+	assert.Empty(t, validator.ParseAcceptLanguage(""))
+	assert.Empty(t, validator.ParseAcceptLanguage("en;q=abc"))
+}
@@ -0,0 +1,143 @@
+package validator_test
+
+import (
+	"strings"
+	"testing"
+	"testing/quick"
+
+	"backend/internal/platform/validator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateSlug(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		maxLength int
+		want      string
+	}{
+		{"words", "Hexagonal Architecture in Go", 100, "hexagonal-architecture-in-go"},
+		{"punctuation", "  What's new?! (2025)  ", 100, "what-s-new-2025"},
+		{"hyphen runs", "a -- b", 100, "a-b"},
+		{"truncated at hyphen", "event driven design", 6, "event"},
+		{"nothing usable", "日本語", 100, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, validator.GenerateSlug(tt.text, tt.maxLength))
+		})
+	}
+}
+
+func TestValidateSlugFormat(t *testing.T) {
+	tests := []struct {
+		name string
+		slug string
+		want error
+	}{
+		{"valid", "my-post-2", nil},
+		{"empty", "", validator.ErrSlugEmpty},
+		{"too long", strings.Repeat("a", 11), validator.ErrSlugTooLong},
+		{"uppercase", "My-Post", validator.ErrInvalidSlugFormat},
+		{"space", "my post", validator.ErrInvalidSlugFormat},
+		{"non-ASCII", "café", validator.ErrInvalidSlugFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, validator.ValidateSlugFormat(tt.slug, 10))
+		})
+	}
+}
+
+// boundedLength maps an arbitrary fuzz or quick input onto a usable maxLength
+func boundedLength(n int) int {
+	if n < 0 {
+		n = -(n + 1)
+	}
+	return n%300 + 1
+}
+
+// checkGeneratedSlug reports why slug is not an acceptable GenerateSlug result
+func checkGeneratedSlug(t *testing.T, text, slug string, maxLength int) {
+	t.Helper()
+
+	if len(slug) > maxLength {
+		t.Fatalf("GenerateSlug(%q, %d) = %q, longer than the limit", text, maxLength, slug)
+	}
+	if slug == "" {
+		return
+	}
+	if err := validator.ValidateSlugFormat(slug, maxLength); err != nil {
+		t.Fatalf("GenerateSlug(%q, %d) = %q, which does not validate: %v", text, maxLength, slug, err)
+	}
+	if strings.HasPrefix(slug, "-") || strings.HasSuffix(slug, "-") || strings.Contains(slug, "--") {
+		t.Fatalf("GenerateSlug(%q, %d) = %q, which has stray hyphens", text, maxLength, slug)
+	}
+	if again := validator.GenerateSlug(slug, maxLength); again != slug {
+		t.Fatalf("GenerateSlug is not idempotent: %q became %q", slug, again)
+	}
+}
+
+func FuzzGenerateSlug(f *testing.F) {
+	f.Add("Hexagonal Architecture in Go", 100)
+	f.Add("  --Leading and trailing--  ", 20)
+	f.Add("Ünïcödé & émoji 🚀 titles", 50)
+	f.Add("KELVIN K and dotted İ", 30)
+	f.Add("a - b", 2)
+	f.Add("", 1)
+
+	f.Fuzz(func(t *testing.T, text string, n int) {
+		maxLength := boundedLength(n)
+		checkGeneratedSlug(t, text, validator.GenerateSlug(text, maxLength), maxLength)
+	})
+}
+
+func FuzzValidateSlugFormat(f *testing.F) {
+	f.Add("my-first-post")
+	f.Add("-leading")
+	f.Add("UPPER")
+	f.Add("naïve")
+	f.Add("line\nbreak")
+
+	f.Fuzz(func(t *testing.T, slug string) {
+		if validator.ValidateSlugFormat(slug, 100) != nil {
+			return
+		}
+		for i := 0; i < len(slug); i++ {
+			c := slug[i]
+			if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '-' {
+				t.Fatalf("ValidateSlugFormat accepted %q with byte %q", slug, c)
+			}
+		}
+	})
+}
+
+func TestGenerateSlug_Properties(t *testing.T) {
+	property := func(text string, n int) bool {
+		maxLength := boundedLength(n)
+		checkGeneratedSlug(t, text, validator.GenerateSlug(text, maxLength), maxLength)
+		return true
+	}
+	if err := quick.Check(property, &quick.Config{MaxCount: 2000}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMakeSlugUniqueWithMaxLength_Properties(t *testing.T) {
+	property := func(text string, suffix uint16, n int) bool {
+		base := validator.GenerateSlug(text, 100)
+		if base == "" || suffix == 0 {
+			return true
+		}
+		// Leave room for at least one character of the base next to "-<suffix>"
+		maxLength := boundedLength(n) + 7
+
+		slug := validator.MakeSlugUniqueWithMaxLength(base, int(suffix), maxLength)
+		return len(slug) <= maxLength && validator.ValidateSlugFormat(slug, maxLength) == nil
+	}
+	if err := quick.Check(property, &quick.Config{MaxCount: 2000}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,31 @@
+package validator
+
+import (
+	"errors"
+	"net/url"
+)
+
+// URL validation errors
+var (
+	ErrInvalidURL = errors.New("URL must be an absolute http or https URL")
+	ErrURLTooLong = errors.New("URL is too long")
+)
+
+// ValidateWebURL checks that raw is an absolute http(s) URL with a host,
+// as needed for links that leave the site (canonical URLs, share images)
+func ValidateWebURL(raw string, maxLength int) error {
+	if len(raw) > maxLength {
+		return ErrURLTooLong
+	}
+
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return ErrInvalidURL
+	}
+
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return ErrInvalidURL
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package validator_test
+
+import (
+	"strings"
+	"testing"
+
+	"backend/internal/platform/validator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateWebURL(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+		want error
+	}{
+		{"https", "https://example.com/posts/hello", nil},
+		{"http with port", "http://localhost:3000/image.png", nil},
+		{"relative path", "/posts/hello", validator.ErrInvalidURL},
+		{"missing host", "https://", validator.ErrInvalidURL},
+		{"other scheme", "javascript:alert(1)", validator.ErrInvalidURL},
+		{"unparseable", "https://exa mple.com/%zz", validator.ErrInvalidURL},
+		{"too long", "https://example.com/" + strings.Repeat("a", 50), validator.ErrURLTooLong},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, validator.ValidateWebURL(tt.url, 60))
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package validator
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Username length bounds, counted on the normalized name
+const (
+	UsernameMinLength = 3
+	UsernameMaxLength = 30
+)
+
+// Username validation errors
+var (
+	ErrUsernameTooShort      = errors.New("username must be at least 3 characters")
+	ErrUsernameTooLong       = errors.New("username must not exceed 30 characters")
+	ErrInvalidUsernameFormat = errors.New("username must contain only letters, numbers, underscores and hyphens")
+	ErrUsernameReserved      = errors.New("username is reserved")
+)
+
+var usernameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// reservedUsernames cannot be registered in any letter case, because they
+// would read as the site itself or clash with URL segments. Keys are folded.
+var reservedUsernames = map[string]struct{}{
+	"admin":         {},
+	"administrator": {},
+	"anonymous":     {},
+	"api":           {},
+	"authors":       {},
+	"blog":          {},
+	"help":          {},
+	"login":         {},
+	"logout":        {},
+	"moderator":     {},
+	"null":          {},
+	"official":      {},
+	"root":          {},
+	"security":      {},
+	"settings":      {},
+	"signup":        {},
+	"staff":         {},
+	"super_admin":   {},
+	"support":       {},
+	"system":        {},
+	"undefined":     {},
+	"users":         {},
+	"www":           {},
+}
+
+// NormalizeUsername turns compatibility characters such as full-width
+// letters into their plain form and trims surrounding space. Letter case is
+// kept, since it is how the user wants their name displayed.
+func NormalizeUsername(name string) string {
+	return strings.TrimSpace(norm.NFKC.String(name))
+}
+
+// UsernameKey is the form usernames are compared in for uniqueness: normalized
+// and case folded, so "Ada", "ADA" and "ａｄａ" are the same name
+func UsernameKey(name string) string {
+	return cases.Fold().String(NormalizeUsername(name))
+}
+
+// IsReservedUsername reports whether a username is kept from registration
+func IsReservedUsername(name string) bool {
+	_, reserved := reservedUsernames[UsernameKey(name)]
+	return reserved
+}
+
+// ValidateUsername checks the format of a normalized username and that it is not reserved
+func ValidateUsername(name string) error {
+	if len(name) < UsernameMinLength {
+		return ErrUsernameTooShort
+	}
+	if len(name) > UsernameMaxLength {
+		return ErrUsernameTooLong
+	}
+	if !usernameRegex.MatchString(name) {
+		return ErrInvalidUsernameFormat
+	}
+	if IsReservedUsername(name) {
+		return ErrUsernameReserved
+	}
+	return nil
+}
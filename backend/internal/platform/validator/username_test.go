@@ -0,0 +1,50 @@
+package validator_test
+
+import (
+	"testing"
+
+	"backend/internal/platform/validator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsernameKey(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"ada", "ada"},
+		{"Ada_Lovelace", "ada_lovelace"},
+		{"ａｄａ", "ada"},
+		{"  ada  ", "ada"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, validator.UsernameKey(tt.name))
+		})
+	}
+}
+
+func TestValidateUsername(t *testing.T) {
+	tests := []struct {
+		name     string
+		username string
+		want     error
+	}{
+		{"valid", "ada_lovelace", nil},
+		{"digits and hyphen", "writer-42", nil},
+		{"too short", "ab", validator.ErrUsernameTooShort},
+		{"too long", "abcdefghijklmnopqrstuvwxyz12345", validator.ErrUsernameTooLong},
+		{"space", "ada lovelace", validator.ErrInvalidUsernameFormat},
+		{"non-ascii letter", "adà", validator.ErrInvalidUsernameFormat},
+		{"reserved", "admin", validator.ErrUsernameReserved},
+		{"reserved in another case", "Support", validator.ErrUsernameReserved},
+		{"reserved word as prefix", "administrators", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, validator.ValidateUsername(tt.username), tt.want)
+		})
+	}
+}
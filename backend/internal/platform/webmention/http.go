@@ -0,0 +1,116 @@
+package webmention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"backend/internal/platform/safehttp"
+)
+
+// Defaults for the HTTP verifier
+const (
+	DefaultTimeout   = 10 * time.Second
+	DefaultUserAgent = "arch-blog-webmention/1.0"
+
+	// maxRedirects bounds the redirects followed from a source
+	maxRedirects = 5
+	// maxBodyBytes caps how much of a source is read; links past it are not seen
+	maxBodyBytes = 1 << 20
+)
+
+// HTTPVerifier fetches sources over HTTP
+type HTTPVerifier struct {
+	client    *http.Client
+	userAgent string
+}
+
+// NewHTTPVerifier creates a verifier whose fetches each give up after timeout.
+// Unless allowPrivate is set, sources on private, loopback and link-local
+// addresses are refused; only tests and local development should set it.
+func NewHTTPVerifier(timeout time.Duration, userAgent string, allowPrivate bool) *HTTPVerifier {
+	transport := safehttp.NewTransport(timeout, allowPrivate)
+
+	return &HTTPVerifier{
+		client: &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+			CheckRedirect: func(_ *http.Request, via []*http.Request) error {
+				if len(via) >= maxRedirects {
+					return fmt.Errorf("stopped after %d redirects", maxRedirects)
+				}
+				return nil
+			},
+		},
+		userAgent: userAgent,
+	}
+}
+
+// Verify fetches the source and looks for a link to the target
+func (v *HTTPVerifier) Verify(ctx context.Context, source, target string) Result {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+	if err != nil {
+		return Result{Error: "invalid source URL"}
+	}
+	req.Header.Set("User-Agent", v.userAgent)
+	req.Header.Set("Accept", "text/html, text/plain;q=0.5")
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return describe(err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		return Result{Error: "source is gone"}
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return Result{Error: fmt.Sprintf("source answered %d", resp.StatusCode), Temporary: true}
+	case resp.StatusCode >= 300:
+		return Result{Error: fmt.Sprintf("source answered %d", resp.StatusCode)}
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBodyBytes))
+	if err != nil {
+		return describe(err)
+	}
+
+	// The final URL after redirects is what relative links resolve against
+	base := resp.Request.URL.String()
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	switch mediaType {
+	case "text/html", "application/xhtml+xml", "":
+		linked, title := FindLink(string(body), base, target)
+		if !linked {
+			return Result{Error: "source does not link to target"}
+		}
+		return Result{Linked: true, Title: title}
+	case "text/plain":
+		if !strings.Contains(string(body), target) {
+			return Result{Error: "source does not link to target"}
+		}
+		return Result{Linked: true}
+	default:
+		return Result{Error: "source is not an HTML or text document"}
+	}
+}
+
+// describe turns a fetch error into a Result, telling apart the failures that may pass
+func describe(err error) Result {
+	if errors.Is(err, safehttp.ErrPrivateAddress) {
+		return Result{Error: "source " + safehttp.ErrPrivateAddress.Error()}
+	}
+	var timeout interface{ Timeout() bool }
+	if errors.As(err, &timeout) && timeout.Timeout() {
+		return Result{Error: "timed out", Temporary: true}
+	}
+	return Result{Error: err.Error(), Temporary: true}
+}
+
+// Ensure HTTPVerifier implements Verifier
+var _ Verifier = (*HTTPVerifier)(nil)
@@ -0,0 +1,22 @@
+package webmention
+
+import "time"
+
+// Config tunes the HTTP verifier
+type Config struct {
+	Timeout   time.Duration // Per fetch; DefaultTimeout when zero
+	UserAgent string        // DefaultUserAgent when empty
+}
+
+// ProvideVerifier creates the HTTP source verifier, which never reaches private addresses
+func ProvideVerifier(cfg Config) Verifier {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	userAgent := cfg.UserAgent
+	if userAgent == "" {
+		userAgent = DefaultUserAgent
+	}
+	return NewHTTPVerifier(timeout, userAgent, false)
+}
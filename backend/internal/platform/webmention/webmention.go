@@ -0,0 +1,90 @@
+// Package webmention verifies that the source of a Webmention links to its target.
+//
+// The source is fetched with a GET and searched for the target in the href and
+// src attributes of HTML documents, or anywhere in plain text ones. Sources are
+// named by whoever sends the mention, so the verifier refuses to connect to
+// private, loopback and link-local addresses, caps what it reads, and reports
+// failures that may pass, such as timeouts, as temporary so they can be retried.
+package webmention
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// Result is the outcome of verifying one source
+type Result struct {
+	Linked    bool
+	Title     string // The source's title, when it is an HTML document that has one
+	Error     string // Why the source could not be verified; empty when it was
+	Temporary bool   // Whether trying again later may succeed
+}
+
+// Verifier fetches a source and checks that it links to a target.
+// Failures to fetch the source are reported in the Result rather than as errors.
+type Verifier interface {
+	Verify(ctx context.Context, source, target string) Result
+}
+
+// FindLink reports whether the HTML document at base links to target in an
+// href or src attribute, and returns its title. Relative links are resolved
+// against base; links compare without fragment or trailing slash.
+func FindLink(document, base, target string) (linked bool, title string) {
+	baseURL, err := url.Parse(base)
+	if err != nil {
+		return false, ""
+	}
+	want := normalize(target)
+
+	inTitle := false
+	tokenizer := html.NewTokenizer(strings.NewReader(document))
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			return linked, strings.TrimSpace(title)
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := tokenizer.Token()
+			if token.Data == "title" && title == "" {
+				inTitle = true
+			}
+			for _, attr := range token.Attr {
+				if attr.Key != "href" && attr.Key != "src" {
+					continue
+				}
+				ref, err := baseURL.Parse(strings.TrimSpace(attr.Val))
+				if err == nil && normalize(ref.String()) == want {
+					linked = true
+				}
+			}
+		case html.TextToken:
+			if inTitle {
+				title += string(tokenizer.Text())
+			}
+		case html.EndTagToken:
+			if inTitle {
+				if name, _ := tokenizer.TagName(); string(name) == "title" {
+					inTitle = false
+				}
+			}
+		}
+	}
+}
+
+// normalize reduces a URL to the form links compare in: without fragment or
+// trailing slash, and with a lowercase scheme and host
+func normalize(raw string) string {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return raw
+	}
+	u.Fragment = ""
+	u.RawFragment = ""
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	u.RawPath = ""
+	return u.String()
+}
@@ -0,0 +1,92 @@
+package webmention_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"backend/internal/platform/webmention"
+	"github.com/stretchr/testify/assert"
+)
+
+const target = "https://blog.example/posts/hello"
+
+func TestFindLink(t *testing.T) {
+	document := `<html><head><title> A reply
+		to hello </title></head><body>
+		<p>Replying to <a href="https://BLOG.example/posts/hello/#comments">this post</a>.</p>
+		</body></html>`
+
+	linked, title := webmention.FindLink(document, "https://other.example/reply", target)
+	assert.True(t, linked)
+	assert.Equal(t, "A reply\n\t\tto hello", title)
+
+	// Relative links resolve against the source
+	linked, _ = webmention.FindLink(`<img src="../posts/hello">`, "https://blog.example/themes/x", target)
+	assert.True(t, linked)
+
+	linked, _ = webmention.FindLink(`<a href="https://blog.example/posts/hello-world">close</a> `+target, "https://other.example/", target)
+	assert.False(t, linked, "text that is not in a link does not count")
+}
+
+func TestHTTPVerifier(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/links":
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			_, _ = w.Write([]byte(`<title>Reply</title><a href="` + target + `">hello</a>`))
+		case "/plain":
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = w.Write([]byte("See " + target))
+		case "/no-link":
+			w.Header().Set("Content-Type", "text/html")
+			_, _ = w.Write([]byte(`<a href="https://elsewhere.example/">elsewhere</a>`))
+		case "/moved":
+			http.Redirect(w, r, "/links", http.StatusMovedPermanently)
+		case "/busy":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusGone)
+		}
+	}))
+	defer server.Close()
+
+	verifier := webmention.NewHTTPVerifier(time.Second, webmention.DefaultUserAgent, true)
+	tests := []struct {
+		path      string
+		linked    bool
+		title     string
+		temporary bool
+	}{
+		{path: "/links", linked: true, title: "Reply"},
+		{path: "/plain", linked: true},
+		{path: "/no-link"},
+		{path: "/moved", linked: true, title: "Reply"},
+		{path: "/busy", temporary: true},
+		{path: "/deleted"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			result := verifier.Verify(context.Background(), server.URL+tt.path, target)
+			assert.Equal(t, tt.linked, result.Linked)
+			assert.Equal(t, tt.title, result.Title)
+			assert.Equal(t, tt.temporary, result.Temporary)
+			assert.Equal(t, tt.linked, result.Error == "")
+		})
+	}
+}
+
+func TestHTTPVerifier_RefusesPrivateAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<a href="` + target + `">hello</a>`))
+	}))
+	defer server.Close()
+
+	verifier := webmention.NewHTTPVerifier(time.Second, webmention.DefaultUserAgent, false)
+	result := verifier.Verify(context.Background(), server.URL, target)
+	assert.False(t, result.Linked)
+	assert.False(t, result.Temporary)
+	assert.Contains(t, result.Error, "private address")
+}
@@ -0,0 +1,91 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend/internal/platform/erasure"
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/events"
+	"backend/internal/posts/ports"
+	"github.com/google/uuid"
+)
+
+// renameBatchSize bounds the posts one statement renames, so a prolific
+// author's rename holds no lock for long
+const renameBatchSize = 500
+
+// AuthorNames keeps the author name copied onto each post in step with the
+// author's username, which listings read instead of joining users
+type AuthorNames struct {
+	repo     ports.PostRepository
+	eventBus *eventbus.Bus
+}
+
+// NewAuthorNames creates a new author name subscriber
+func NewAuthorNames(repo ports.PostRepository, eventBus *eventbus.Bus) *AuthorNames {
+	return &AuthorNames{
+		repo:     repo,
+		eventBus: eventBus,
+	}
+}
+
+// Subscribe registers the rename handler on the bus
+func (a *AuthorNames) Subscribe(bus *eventbus.Bus) {
+	bus.Subscribe(events.UserUpdatedTopic, a.handleUserUpdated)
+}
+
+// handleUserUpdated rewrites the user's posts to their new username. Updates
+// that leave the username alone find nothing to rewrite.
+func (a *AuthorNames) handleUserUpdated(ctx context.Context, event eventbus.Event) error {
+	payload, ok := event.Payload.(events.UserUpdatedEvent)
+	if !ok {
+		return fmt.Errorf("AuthorNames.handleUserUpdated: unexpected payload %T", event.Payload)
+	}
+
+	// Detached from the publisher's request so it is not cut short
+	if _, err := a.rename(context.WithoutCancel(ctx), payload.UserID, payload.Username); err != nil {
+		return fmt.Errorf("AuthorNames.handleUserUpdated: %w", err)
+	}
+	return nil
+}
+
+// Erase replaces the subject's name on their posts with their pseudonym
+// Implements the erasure.Eraser interface
+func (a *AuthorNames) Erase(ctx context.Context, subject erasure.Subject) (int, error) {
+	n, err := a.rename(ctx, subject.UserID, subject.Pseudonym)
+	if err != nil {
+		return 0, fmt.Errorf("AuthorNames.Erase: %w", err)
+	}
+	return n, nil
+}
+
+// rename rewrites the author's posts batch by batch until none carries another
+// name, and announces the change so cached listings are dropped
+func (a *AuthorNames) rename(ctx context.Context, authorID uuid.UUID, name string) (int, error) {
+	total := 0
+	for {
+		n, err := a.repo.RenameAuthor(ctx, authorID, name, renameBatchSize)
+		if err != nil {
+			return total, err
+		}
+		total += n
+		if n < renameBatchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		a.eventBus.Publish(ctx, eventbus.Event{
+			Topic: events.PostAuthorNamesUpdatedTopic,
+			Payload: events.PostAuthorNamesUpdatedEvent{
+				AuthorID:   authorID,
+				AuthorName: name,
+				Posts:      total,
+				OccurredAt: time.Now(),
+			},
+		})
+	}
+	return total, nil
+}
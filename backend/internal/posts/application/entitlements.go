@@ -0,0 +1,30 @@
+package application
+
+import (
+	"context"
+
+	"backend/internal/posts/ports"
+	"github.com/google/uuid"
+)
+
+// PermissionEntitlements implements ports.Entitlements with the
+// posts:read:premium permission, which the premium_subscriber role grants.
+// It stands in until billing decides entitlements from subscriptions.
+type PermissionEntitlements struct {
+	authorizer ports.Authorizer
+}
+
+// NewPermissionEntitlements creates entitlements backed by permissions
+func NewPermissionEntitlements(authorizer ports.Authorizer) *PermissionEntitlements {
+	return &PermissionEntitlements{
+		authorizer: authorizer,
+	}
+}
+
+// CanReadPremium reports whether the user holds posts:read:premium
+func (e *PermissionEntitlements) CanReadPremium(ctx context.Context, userID uuid.UUID) (bool, error) {
+	return e.authorizer.Can(ctx, userID, "posts", "read:premium", nil)
+}
+
+// Compile-time check to ensure PermissionEntitlements implements ports.Entitlements
+var _ ports.Entitlements = (*PermissionEntitlements)(nil)
@@ -0,0 +1,68 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend/internal/posts/ports"
+	"github.com/google/uuid"
+)
+
+// ExportedPost is a post as written to its author's data export
+type ExportedPost struct {
+	ID              uuid.UUID  `json:"id"`
+	Title           string     `json:"title"`
+	Slug            string     `json:"slug"`
+	Excerpt         string     `json:"excerpt"`
+	Content         string     `json:"content"`
+	Status          string     `json:"status"`
+	Language        string     `json:"language"`
+	MetaTitle       string     `json:"metaTitle,omitempty"`
+	MetaDescription string     `json:"metaDescription,omitempty"`
+	CanonicalURL    string     `json:"canonicalUrl,omitempty"`
+	OGImageURL      string     `json:"ogImageUrl,omitempty"`
+	PublishedAt     *time.Time `json:"publishedAt,omitempty"`
+	CreatedAt       time.Time  `json:"createdAt"`
+	UpdatedAt       time.Time  `json:"updatedAt"`
+}
+
+// PostsExporter exports the posts a user wrote, in every blog
+type PostsExporter struct {
+	repo ports.PostRepository
+}
+
+// NewPostsExporter creates a new posts exporter
+func NewPostsExporter(repo ports.PostRepository) *PostsExporter {
+	return &PostsExporter{repo: repo}
+}
+
+// Export returns the user's posts in any status, oldest first
+// Implements the dataexport.Exporter interface
+func (e *PostsExporter) Export(ctx context.Context, userID uuid.UUID) (any, error) {
+	posts, err := e.repo.ListByAuthorInEveryBlog(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("PostsExporter.Export: %w", err)
+	}
+
+	exported := make([]ExportedPost, 0, len(posts))
+	for _, post := range posts {
+		exported = append(exported, ExportedPost{
+			ID:              post.ID,
+			Title:           post.Title,
+			Slug:            post.Slug,
+			Excerpt:         post.Excerpt,
+			Content:         post.Content,
+			Status:          string(post.Status),
+			Language:        post.Language,
+			MetaTitle:       post.SEO.MetaTitle,
+			MetaDescription: post.SEO.MetaDescription,
+			CanonicalURL:    post.SEO.CanonicalURL,
+			OGImageURL:      post.SEO.OGImageURL,
+			PublishedAt:     post.PublishedAt,
+			CreatedAt:       post.CreatedAt,
+			UpdatedAt:       post.UpdatedAt,
+		})
+	}
+	return exported, nil
+}
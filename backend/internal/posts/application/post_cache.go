@@ -0,0 +1,142 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend/internal/platform/cache"
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/events"
+	"backend/internal/platform/logger"
+	"backend/internal/platform/tenant"
+	"backend/internal/posts/domain"
+	"github.com/google/uuid"
+)
+
+// PostCache is a read-through cache for posts looked up by slug.
+// A slug maps to a post ID, and the post itself is stored under its ID, so an
+// event only needs the post ID to drop the post however it was looked up.
+// Slug mappings are left to expire: a stale one points at an ID whose post was
+// already dropped, which sends the lookup back to the database.
+type PostCache struct {
+	cache  cache.Cache
+	ttl    time.Duration
+	logger logger.Logger
+}
+
+// NewPostCache creates a new post cache
+func NewPostCache(c cache.Cache, cfg cache.Config, logger logger.Logger) *PostCache {
+	return &PostCache{
+		cache:  c,
+		ttl:    cfg.PostTTL,
+		logger: logger,
+	}
+}
+
+// Subscribe registers the invalidation handlers on the bus
+func (c *PostCache) Subscribe(bus *eventbus.Bus) {
+	bus.Subscribe(events.PostCreatedTopic, c.handlePostCreated)
+	bus.Subscribe(events.PostUpdatedTopic, c.handlePostUpdated)
+	bus.Subscribe(events.PostPublishedTopic, c.handlePostChanged)
+	bus.Subscribe(events.PostArchivedTopic, c.handlePostChanged)
+	bus.Subscribe(events.PostDeletedTopic, c.handlePostChanged)
+	bus.Subscribe(events.PostSubmittedForReviewTopic, c.handlePostChanged)
+	bus.Subscribe(events.PostChangesRequestedTopic, c.handlePostChanged)
+	bus.Subscribe(events.FeaturedPostsChangedTopic, c.handlePostChanged)
+}
+
+// GetBySlug returns the cached post for slug in the current blog
+// Cache failures are logged and reported as misses so reads fall back to the database
+func (c *PostCache) GetBySlug(ctx context.Context, slug string) (*domain.Post, bool) {
+	var id uuid.UUID
+	found, err := cache.GetJSON(ctx, c.cache, slugKey(ctx, slug), &id)
+	if err != nil {
+		c.logger.Warn(ctx, "failed to read post slug from cache", "error", err, "slug", slug)
+		return nil, false
+	}
+	if !found {
+		return nil, false
+	}
+
+	var post domain.Post
+	found, err = cache.GetJSON(ctx, c.cache, postKey(id), &post)
+	if err != nil {
+		c.logger.Warn(ctx, "failed to read post from cache", "error", err, "postID", id)
+		return nil, false
+	}
+	if !found {
+		return nil, false
+	}
+	return &post, true
+}
+
+// SetBySlug caches post as the result of looking up slug, which may be a previous slug
+func (c *PostCache) SetBySlug(ctx context.Context, slug string, post *domain.Post) {
+	if err := cache.SetJSON(ctx, c.cache, postKey(post.ID), post, c.ttl); err != nil {
+		c.logger.Warn(ctx, "failed to cache post", "error", err, "postID", post.ID)
+		return
+	}
+	if err := cache.SetJSON(ctx, c.cache, slugKey(ctx, slug), post.ID, c.ttl); err != nil {
+		c.logger.Warn(ctx, "failed to cache post slug", "error", err, "slug", slug)
+	}
+}
+
+// handlePostCreated drops any mapping for the new post's slug, which may
+// previously have redirected to another post
+func (c *PostCache) handlePostCreated(ctx context.Context, event eventbus.Event) error {
+	payload, ok := event.Payload.(events.PostCreatedEvent)
+	if !ok {
+		return fmt.Errorf("PostCache.handlePostCreated: unexpected payload %T", event.Payload)
+	}
+	return c.invalidate(ctx, slugKey(ctx, payload.Slug))
+}
+
+// handlePostUpdated drops the post and the mapping for its current slug
+func (c *PostCache) handlePostUpdated(ctx context.Context, event eventbus.Event) error {
+	payload, ok := event.Payload.(events.PostUpdatedEvent)
+	if !ok {
+		return fmt.Errorf("PostCache.handlePostUpdated: unexpected payload %T", event.Payload)
+	}
+	return c.invalidate(ctx, postKey(payload.PostID), slugKey(ctx, payload.Slug))
+}
+
+// handlePostChanged drops the post named by any other post event
+func (c *PostCache) handlePostChanged(ctx context.Context, event eventbus.Event) error {
+	var postID uuid.UUID
+	switch payload := event.Payload.(type) {
+	case events.PostPublishedEvent:
+		postID = payload.PostID
+	case events.PostArchivedEvent:
+		postID = payload.PostID
+	case events.PostDeletedEvent:
+		postID = payload.PostID
+	case events.PostSubmittedForReviewEvent:
+		postID = payload.PostID
+	case events.PostChangesRequestedEvent:
+		postID = payload.PostID
+	case events.FeaturedPostsChangedEvent:
+		postID = payload.PostID
+	default:
+		return fmt.Errorf("PostCache.handlePostChanged: unexpected payload %T", event.Payload)
+	}
+	return c.invalidate(ctx, postKey(postID))
+}
+
+// invalidate deletes keys, detached from the publisher's request so it is not cut short
+func (c *PostCache) invalidate(ctx context.Context, keys ...string) error {
+	if err := c.cache.Delete(context.WithoutCancel(ctx), keys...); err != nil {
+		return fmt.Errorf("PostCache.invalidate: %w", err)
+	}
+	return nil
+}
+
+// slugKey is the key of the post ID a slug resolves to in the current blog
+func slugKey(ctx context.Context, slug string) string {
+	return fmt.Sprintf("post:slug:%s:%s", tenant.BlogID(ctx), slug)
+}
+
+// postKey is the key of a cached post
+func postKey(id uuid.UUID) string {
+	return fmt.Sprintf("post:id:%s", id)
+}
@@ -1,9 +1,21 @@
 package application
 
-import "github.com/google/wire"
+import (
+	"backend/internal/posts/ports"
+	"github.com/google/wire"
+)
 
 // ProviderSet is the wire provider set for the posts application layer
 var ProviderSet = wire.NewSet(
 	NewPostsService,
 	NewPostsOwnershipChecker,
+	NewPostCache,
+	NewContentRenderer,
+	NewPublishedPostsProjection,
+	NewAuthorNames,
+	NewPostsExporter,
+	NewQuotaAdapter,
+	NewPermissionEntitlements,
+	wire.Bind(new(QuotaChecker), new(*QuotaAdapter)),
+	wire.Bind(new(ports.Entitlements), new(*PermissionEntitlements)),
 )
@@ -0,0 +1,75 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/events"
+	"backend/internal/posts/ports"
+	"github.com/google/uuid"
+)
+
+// PublishedPostsProjection keeps the published posts read model in step with
+// the posts. Every handler refreshes the named post's row from the posts
+// table, so it matters neither what changed nor in which order events arrive.
+// Posts are created as drafts, so creation leaves the read model alone.
+type PublishedPostsProjection struct {
+	repo     ports.PublishedPostRepository
+	eventBus *eventbus.Bus
+}
+
+// NewPublishedPostsProjection creates a new published posts projection
+func NewPublishedPostsProjection(repo ports.PublishedPostRepository, eventBus *eventbus.Bus) *PublishedPostsProjection {
+	return &PublishedPostsProjection{
+		repo:     repo,
+		eventBus: eventBus,
+	}
+}
+
+// Subscribe registers the refresh handlers on the bus
+func (p *PublishedPostsProjection) Subscribe(bus *eventbus.Bus) {
+	for _, topic := range []eventbus.Topic{
+		events.PostUpdatedTopic,
+		events.PostPublishedTopic,
+		events.PostArchivedTopic,
+		events.PostDeletedTopic,
+		events.FeaturedPostsChangedTopic,
+	} {
+		bus.Subscribe(topic, p.handlePostChanged)
+	}
+}
+
+// handlePostChanged refreshes the post named by the event
+func (p *PublishedPostsProjection) handlePostChanged(ctx context.Context, event eventbus.Event) error {
+	var postID uuid.UUID
+	switch payload := event.Payload.(type) {
+	case events.PostUpdatedEvent:
+		postID = payload.PostID
+	case events.PostPublishedEvent:
+		postID = payload.PostID
+	case events.PostArchivedEvent:
+		postID = payload.PostID
+	case events.PostDeletedEvent:
+		postID = payload.PostID
+	case events.FeaturedPostsChangedEvent:
+		postID = payload.PostID
+	default:
+		return fmt.Errorf("PublishedPostsProjection.handlePostChanged: unexpected payload %T", event.Payload)
+	}
+
+	// Detached from the publisher's request so it is not cut short
+	if err := p.repo.Refresh(context.WithoutCancel(ctx), postID); err != nil {
+		return fmt.Errorf("PublishedPostsProjection.handlePostChanged: %w", err)
+	}
+
+	p.eventBus.Publish(ctx, eventbus.Event{
+		Topic: events.PublishedPostRefreshedTopic,
+		Payload: events.PublishedPostRefreshedEvent{
+			PostID:     postID,
+			OccurredAt: time.Now(),
+		},
+	})
+	return nil
+}
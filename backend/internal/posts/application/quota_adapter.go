@@ -0,0 +1,31 @@
+package application
+
+import (
+	"context"
+
+	quotasApp "backend/internal/quotas/application"
+	"github.com/google/uuid"
+)
+
+// QuotaAdapter implements the QuotaChecker interface
+// It adapts the quotas service to the limits the posts context enforces
+type QuotaAdapter struct {
+	quotaService *quotasApp.QuotaService
+}
+
+// NewQuotaAdapter creates a new quota adapter
+func NewQuotaAdapter(quotaService *quotasApp.QuotaService) *QuotaAdapter {
+	return &QuotaAdapter{
+		quotaService: quotaService,
+	}
+}
+
+// CheckDraftQuota fails when the author may not start another draft
+func (a *QuotaAdapter) CheckDraftQuota(ctx context.Context, userID uuid.UUID) error {
+	return a.quotaService.CheckDraftQuota(ctx, userID)
+}
+
+// CheckPostSize fails when content of size bytes exceeds the author's limit
+func (a *QuotaAdapter) CheckPostSize(ctx context.Context, userID uuid.UUID, size int) error {
+	return a.quotaService.CheckPostSize(ctx, userID, size)
+}
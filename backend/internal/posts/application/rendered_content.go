@@ -0,0 +1,63 @@
+package application
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"backend/internal/platform/cache"
+	"backend/internal/platform/highlight"
+	"backend/internal/platform/logger"
+)
+
+// ContentRenderer highlights the code blocks of post content and caches the result.
+// Entries are keyed by a hash of the content and the style, so an edited post
+// simply misses and nothing needs invalidating; old renderings expire.
+type ContentRenderer struct {
+	highlighter *highlight.Highlighter // Nil when highlighting is disabled
+	cache       cache.Cache
+	ttl         time.Duration
+	logger      logger.Logger
+}
+
+// NewContentRenderer creates a new content renderer
+func NewContentRenderer(highlighter *highlight.Highlighter, c cache.Cache, cfg cache.Config, logger logger.Logger) *ContentRenderer {
+	return &ContentRenderer{
+		highlighter: highlighter,
+		cache:       c,
+		ttl:         cfg.RenderedContentTTL,
+		logger:      logger,
+	}
+}
+
+// Render returns the highlighted content, or false when highlighting is disabled
+// Cache failures are logged and the content is rendered again
+func (r *ContentRenderer) Render(ctx context.Context, content string) (string, bool) {
+	if r.highlighter == nil {
+		return "", false
+	}
+
+	key := r.key(content)
+	cached, err := r.cache.Get(ctx, key)
+	if err == nil {
+		return string(cached), true
+	}
+	if !errors.Is(err, cache.ErrMiss) {
+		r.logger.Warn(ctx, "failed to read rendered content from cache", "error", err)
+	}
+
+	rendered := r.highlighter.Highlight(content)
+	if err := r.cache.Set(ctx, key, []byte(rendered), r.ttl); err != nil {
+		r.logger.Warn(ctx, "failed to cache rendered content", "error", err)
+	}
+	return rendered, true
+}
+
+// key is the cache key of content rendered in the highlighter's style
+func (r *ContentRenderer) key(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return fmt.Sprintf("post:rendered:%s:%s", r.highlighter.Style(), hex.EncodeToString(sum[:]))
+}
@@ -5,12 +5,17 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"regexp"
 	"time"
 
+	"backend/internal/platform/actor"
 	"backend/internal/platform/apperror"
 	"backend/internal/platform/eventbus"
 	"backend/internal/platform/events"
 	"backend/internal/platform/logger"
+	"backend/internal/platform/postgres"
+	"backend/internal/platform/teaser"
+	"backend/internal/platform/timezone"
 	"backend/internal/platform/validator"
 	"backend/internal/posts/domain"
 	"backend/internal/posts/ports"
@@ -41,6 +46,41 @@ var (
 		http.StatusConflict,
 	)
 
+	ErrPostNotFeaturable = apperror.New(
+		apperror.CodeConflict,
+		apperror.BusinessCodePostNotFeaturable,
+		"post cannot be featured",
+		http.StatusConflict,
+	)
+
+	ErrInvalidTranslation = apperror.New(
+		apperror.CodeConflict,
+		apperror.BusinessCodeInvalidTranslation,
+		"post cannot be linked as a translation",
+		http.StatusConflict,
+	)
+
+	ErrTranslationLanguageExists = apperror.New(
+		apperror.CodeConflict,
+		apperror.BusinessCodeTranslationLanguageExists,
+		"translation group already has a post in this language",
+		http.StatusConflict,
+	)
+
+	ErrCommentsNotAllowed = apperror.New(
+		apperror.CodeForbidden,
+		apperror.BusinessCodeCommentsNotAllowed,
+		"post does not accept comments",
+		http.StatusForbidden,
+	)
+
+	ErrPostMembersOnly = apperror.New(
+		apperror.CodeUnauthorized,
+		apperror.BusinessCodePostMembersOnly,
+		"sign in to read this post",
+		http.StatusUnauthorized,
+	)
+
 	ErrInvalidPostData = apperror.New(
 		apperror.CodeValidationFailed,
 		apperror.BusinessCodeInvalidFormat,
@@ -49,39 +89,92 @@ var (
 	)
 )
 
+// premiumTeaserLength is how many characters of text a premium post shows readers without an entitlement
+const premiumTeaserLength = 600
+
+// codeLanguageClass is the class naming a code block's language, as markdown renderers write it
+var codeLanguageClass = regexp.MustCompile(`^language-[A-Za-z0-9_+#.-]+$`)
+
+// QuotaChecker enforces the content limits of post authors
+// This avoids direct dependency on the quotas bounded context
+type QuotaChecker interface {
+	CheckDraftQuota(ctx context.Context, userID uuid.UUID) error
+	CheckPostSize(ctx context.Context, userID uuid.UUID, size int) error
+}
+
 // PostsService handles post-related business logic
 type PostsService struct {
 	repo       ports.PostRepository
+	published  ports.PublishedPostRepository // Read model serving anonymous reads
 	authorizer ports.Authorizer
 	eventBus   *eventbus.Bus
 	logger     logger.Logger
 	sanitizer  *bluemonday.Policy
+	uow        postgres.UnitOfWork
+	cache      *PostCache
+	renderer   *ContentRenderer
+	quotas     QuotaChecker
+	workflow   *domain.Workflow
+
+	entitlements ports.Entitlements // Decides who reads premium posts in full
 }
 
 // NewPostsService creates a new posts service
 func NewPostsService(
 	repo ports.PostRepository,
+	published ports.PublishedPostRepository,
 	authorizer ports.Authorizer,
 	eventBus *eventbus.Bus,
 	logger logger.Logger,
+	uow postgres.UnitOfWork,
+	cache *PostCache,
+	renderer *ContentRenderer,
+	quotas QuotaChecker,
+	workflow *domain.Workflow,
+	entitlements ports.Entitlements,
 ) *PostsService {
 	// Create a strict HTML sanitizer policy
+	// Code blocks may name their language for the highlighter
 	sanitizer := bluemonday.UGCPolicy()
+	sanitizer.AllowAttrs("class").Matching(codeLanguageClass).OnElements("code")
 
 	return &PostsService{
 		repo:       repo,
+		published:  published,
 		authorizer: authorizer,
 		eventBus:   eventBus,
 		logger:     logger,
 		sanitizer:  sanitizer,
+		uow:        uow,
+		cache:      cache,
+		renderer:   renderer,
+		quotas:     quotas,
+		workflow:   workflow,
+
+		entitlements: entitlements,
 	}
 }
 
 // CreatePostParams contains parameters for creating a new post
 type CreatePostParams struct {
-	Title   string
-	Content string
-	Excerpt string
+	Title    string
+	Content  string
+	Excerpt  string
+	Language string              // Optional; defaults to domain.DefaultLanguage
+	Slug     string              // Optional; derived from the title when empty
+	SEO      *domain.SEOMetadata // Optional; nil leaves all SEO fields unset
+
+	// TargetPublishDate plans the publication day for the calendar; nil leaves it unplanned
+	TargetPublishDate *time.Time
+
+	// CommentPolicy is optional; nil applies domain.DefaultCommentPolicy
+	CommentPolicy *domain.CommentPolicy
+
+	// AccessPolicy is optional; nil applies domain.DefaultAccessPolicy
+	AccessPolicy *domain.AccessPolicy
+
+	// Premium marks the post as premium content; nil leaves it free to read
+	Premium *bool
 }
 
 // CreatePost creates a new blog post
@@ -105,8 +198,16 @@ func (s *PostsService) CreatePost(ctx context.Context, actorID uuid.UUID, params
 			http.StatusForbidden,
 		)
 	}
+	// New posts start as drafts, so they count against the author's draft quota
+	if err := s.quotas.CheckDraftQuota(ctx, actorID); err != nil {
+		return nil, err
+	}
+
 	// Sanitize HTML content
 	sanitizedContent := s.sanitizer.Sanitize(params.Content)
+	if err := s.quotas.CheckPostSize(ctx, actorID, len(sanitizedContent)); err != nil {
+		return nil, err
+	}
 
 	// Create the post domain object (it will generate its own slug)
 	// The actor becomes the author
@@ -120,8 +221,47 @@ func (s *PostsService) CreatePost(ctx context.Context, actorID uuid.UUID, params
 		return nil, ErrInvalidPostData.WithDetails(err.Error())
 	}
 
+	if params.Language != "" {
+		if err := post.SetLanguage(params.Language); err != nil {
+			return nil, ErrInvalidPostData.WithDetails(err.Error())
+		}
+	}
+
+	if params.SEO != nil {
+		if err := post.UpdateSEO(*params.SEO); err != nil {
+			return nil, ErrInvalidPostData.WithDetails(err.Error())
+		}
+	}
+
+	post.SetTargetPublishDate(params.TargetPublishDate)
+
+	if params.CommentPolicy != nil {
+		if err := post.UpdateCommentPolicy(*params.CommentPolicy); err != nil {
+			return nil, ErrInvalidPostData.WithDetails(err.Error())
+		}
+	}
+
+	if params.AccessPolicy != nil {
+		if err := post.UpdateAccessPolicy(*params.AccessPolicy); err != nil {
+			return nil, ErrInvalidPostData.WithDetails(err.Error())
+		}
+	}
+
+	if params.Premium != nil {
+		post.SetPremium(*params.Premium)
+	}
+
+	// An explicit slug replaces the one derived from the title
+	baseSlug := post.Slug
+	if params.Slug != "" {
+		if err := validator.ValidateSlugFormat(params.Slug, domain.MaxSlugLength); err != nil {
+			return nil, ErrInvalidPostData.WithDetails(err.Error())
+		}
+		baseSlug = params.Slug
+	}
+
 	// Ensure slug uniqueness
-	uniqueSlug, err := s.ensureUniqueSlug(ctx, post.Slug, nil)
+	uniqueSlug, err := s.ensureUniqueSlug(ctx, baseSlug, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -152,13 +292,102 @@ func (s *PostsService) CreatePost(ctx context.Context, actorID uuid.UUID, params
 
 // UpdatePostParams contains parameters for updating a post
 type UpdatePostParams struct {
-	Title   string
-	Content string
-	Excerpt string
+	Title    string
+	Content  string
+	Excerpt  string
+	Language *string             // Optional; nil keeps the current language
+	Slug     *string             // Optional; nil derives a new slug only when the title changes
+	SEO      *domain.SEOMetadata // Optional; nil keeps the current SEO metadata
+
+	// TargetPublishDate is optional; nil keeps the current plan
+	TargetPublishDate *time.Time
+
+	// CommentPolicy is optional; nil keeps the current settings
+	CommentPolicy *domain.CommentPolicy
+
+	// AccessPolicy is optional; nil keeps the current settings
+	AccessPolicy *domain.AccessPolicy
+
+	// Premium is optional; nil keeps the current mark
+	Premium *bool
 }
 
-// UpdatePost updates an existing post
+// UpdatePost replaces the editable content of a post
 func (s *PostsService) UpdatePost(ctx context.Context, actorID uuid.UUID, id uuid.UUID, params UpdatePostParams) (*domain.Post, error) {
+	patch := PatchPostParams{
+		Title:    &params.Title,
+		Content:  &params.Content,
+		Excerpt:  &params.Excerpt,
+		Language: params.Language,
+		Slug:     params.Slug,
+
+		TargetPublishDate: params.TargetPublishDate,
+		CommentPolicy:     params.CommentPolicy,
+		AccessPolicy:      params.AccessPolicy,
+		Premium:           params.Premium,
+	}
+	// Replacing the SEO metadata as a whole clears the fields the request omits
+	if params.SEO != nil {
+		patch.SEO = &SEOPatch{
+			MetaTitle:       &params.SEO.MetaTitle,
+			MetaDescription: &params.SEO.MetaDescription,
+			CanonicalURL:    &params.SEO.CanonicalURL,
+			OGImageURL:      &params.SEO.OGImageURL,
+		}
+	}
+	return s.PatchPost(ctx, actorID, id, patch)
+}
+
+// PatchPostParams contains a partial update of a post
+// Nil fields keep their current value, so only what the client sent is changed
+type PatchPostParams struct {
+	Title    *string
+	Content  *string
+	Excerpt  *string
+	Language *string
+	Slug     *string   // Nil derives a new slug only when the title changes
+	SEO      *SEOPatch // Nil keeps the current SEO metadata
+
+	// TargetPublishDate is nil to keep the current plan; the zero time clears it
+	TargetPublishDate *time.Time
+
+	// CommentPolicy replaces the comment settings as a whole; nil keeps them
+	CommentPolicy *domain.CommentPolicy
+
+	// AccessPolicy replaces the visibility and embargo as a whole; nil keeps them
+	AccessPolicy *domain.AccessPolicy
+
+	// Premium marks or unmarks the post as premium content; nil keeps it
+	Premium *bool
+}
+
+// SEOPatch contains a partial update of a post's SEO metadata; an empty string clears a field
+type SEOPatch struct {
+	MetaTitle       *string
+	MetaDescription *string
+	CanonicalURL    *string
+	OGImageURL      *string
+}
+
+// apply merges the patch into the current metadata
+func (p SEOPatch) apply(seo domain.SEOMetadata) domain.SEOMetadata {
+	if p.MetaTitle != nil {
+		seo.MetaTitle = *p.MetaTitle
+	}
+	if p.MetaDescription != nil {
+		seo.MetaDescription = *p.MetaDescription
+	}
+	if p.CanonicalURL != nil {
+		seo.CanonicalURL = *p.CanonicalURL
+	}
+	if p.OGImageURL != nil {
+		seo.OGImageURL = *p.OGImageURL
+	}
+	return seo
+}
+
+// PatchPost changes only the fields present in params
+func (s *PostsService) PatchPost(ctx context.Context, actorID uuid.UUID, id uuid.UUID, params PatchPostParams) (*domain.Post, error) {
 	// Check authorization - user must be able to update this specific post
 	canUpdate, err := s.authorizer.Can(ctx, actorID, "posts", "update", &id)
 	if err != nil {
@@ -184,16 +413,84 @@ func (s *PostsService) UpdatePost(ctx context.Context, actorID uuid.UUID, id uui
 		return nil, err
 	}
 
-	// Sanitize HTML content
-	sanitizedContent := s.sanitizer.Sanitize(params.Content)
+	// Update the post content, keeping the parts the patch leaves out
+	titleChanged := params.Title != nil && *params.Title != post.Title
+	if params.Title != nil || params.Content != nil || params.Excerpt != nil {
+		title, content, excerpt := post.Title, post.Content, post.Excerpt
+		if params.Title != nil {
+			title = *params.Title
+		}
+		if params.Content != nil {
+			// The size limit is the author's, whoever edits the post. Edits that do not
+			// grow the content pass, so lowering a limit never locks a post.
+			content = s.sanitizer.Sanitize(*params.Content)
+			if len(content) > len(post.Content) {
+				if err := s.quotas.CheckPostSize(ctx, post.AuthorID, len(content)); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if params.Excerpt != nil {
+			excerpt = *params.Excerpt
+		}
+		if err := post.UpdateContent(title, content, excerpt); err != nil {
+			return nil, ErrInvalidPostData.WithDetails(err.Error())
+		}
+	}
 
-	// Update the post content
-	if err := post.UpdateContent(params.Title, sanitizedContent, params.Excerpt); err != nil {
-		return nil, ErrInvalidPostData.WithDetails(err.Error())
+	if params.SEO != nil {
+		if err := post.UpdateSEO(params.SEO.apply(post.SEO)); err != nil {
+			return nil, ErrInvalidPostData.WithDetails(err.Error())
+		}
+	}
+
+	if params.CommentPolicy != nil {
+		if err := post.UpdateCommentPolicy(*params.CommentPolicy); err != nil {
+			return nil, ErrInvalidPostData.WithDetails(err.Error())
+		}
+	}
+
+	if params.AccessPolicy != nil {
+		if err := post.UpdateAccessPolicy(*params.AccessPolicy); err != nil {
+			return nil, ErrInvalidPostData.WithDetails(err.Error())
+		}
+	}
+
+	if params.Premium != nil {
+		post.SetPremium(*params.Premium)
+	}
+
+	if params.TargetPublishDate != nil {
+		if params.TargetPublishDate.IsZero() {
+			post.SetTargetPublishDate(nil)
+		} else {
+			post.SetTargetPublishDate(params.TargetPublishDate)
+		}
+	}
+
+	// Change the language, keeping it unique within the translation group
+	if params.Language != nil {
+		if err := post.SetLanguage(*params.Language); err != nil {
+			return nil, ErrInvalidPostData.WithDetails(err.Error())
+		}
+		if post.TranslationGroupID != nil {
+			if err := s.ensureLanguageAvailable(ctx, *post.TranslationGroupID, post.Language, post.ID); err != nil {
+				return nil, err
+			}
+		}
 	}
 
-	// Check if title changed and we need a new slug
-	newSlug := validator.GenerateSlug(params.Title, domain.MaxSlugLength)
+	// An explicit slug wins; otherwise a new title brings a new slug.
+	// Leaving the slug alone on other edits keeps custom slugs intact.
+	newSlug := post.Slug
+	if params.Slug != nil {
+		if err := validator.ValidateSlugFormat(*params.Slug, domain.MaxSlugLength); err != nil {
+			return nil, ErrInvalidPostData.WithDetails(err.Error())
+		}
+		newSlug = *params.Slug
+	} else if titleChanged {
+		newSlug = validator.GenerateSlug(post.Title, domain.MaxSlugLength)
+	}
 	if newSlug != post.Slug {
 		uniqueSlug, err := s.ensureUniqueSlug(ctx, newSlug, &id)
 		if err != nil {
@@ -204,15 +501,9 @@ func (s *PostsService) UpdatePost(ctx context.Context, actorID uuid.UUID, id uui
 		}
 	}
 
-	// Save to repository
-	if err := s.repo.Update(ctx, post); err != nil {
-		s.logger.Error(ctx, "failed to update post", "error", err, "postID", id)
-		return nil, apperror.New(
-			apperror.CodeInternalError,
-			apperror.BusinessCodeGeneral,
-			"failed to update post",
-			http.StatusInternalServerError,
-		)
+	// Save in a transaction so a slug change and its history entry land together
+	if err := s.updatePostsWithTransaction(ctx, post); err != nil {
+		return nil, err
 	}
 
 	// Publish event
@@ -247,7 +538,7 @@ func (s *PostsService) PublishPost(ctx context.Context, actorID uuid.UUID, id uu
 		return nil, err
 	}
 
-	if err := post.Publish(); err != nil {
+	if err := post.Publish(s.workflow); err != nil {
 		return nil, ErrInvalidStatusTransition.WithDetails(err.Error())
 	}
 
@@ -267,6 +558,98 @@ func (s *PostsService) PublishPost(ctx context.Context, actorID uuid.UUID, id uu
 	return post, nil
 }
 
+// SubmitPostForReview puts a draft, or a post revised after review, in the review queue
+func (s *PostsService) SubmitPostForReview(ctx context.Context, actorID uuid.UUID, id uuid.UUID) (*domain.Post, error) {
+	// Submitting is part of writing the post, so it needs the same access as editing it
+	if err := s.checkCanUpdate(ctx, actorID, id); err != nil {
+		return nil, err
+	}
+	post, err := s.getPostByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := post.SubmitForReview(s.workflow); err != nil {
+		return nil, ErrInvalidStatusTransition.WithDetails(err.Error())
+	}
+
+	if err := s.repo.Update(ctx, post); err != nil {
+		s.logger.Error(ctx, "failed to submit post for review", "error", err, "postID", id)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to submit post for review",
+			http.StatusInternalServerError,
+		)
+	}
+
+	// Publish event
+	s.publishPostSubmittedForReviewEvent(ctx, actorID, post)
+
+	return post, nil
+}
+
+// ApprovePost publishes a post that is in review
+func (s *PostsService) ApprovePost(ctx context.Context, actorID uuid.UUID, id uuid.UUID) (*domain.Post, error) {
+	if err := s.checkCanReview(ctx, actorID); err != nil {
+		return nil, err
+	}
+	post, err := s.getPostByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := post.Approve(s.workflow); err != nil {
+		return nil, ErrInvalidStatusTransition.WithDetails(err.Error())
+	}
+
+	if err := s.repo.Update(ctx, post); err != nil {
+		s.logger.Error(ctx, "failed to approve post", "error", err, "postID", id)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to approve post",
+			http.StatusInternalServerError,
+		)
+	}
+
+	// Publish events; subscribers reacting to publication need not know about the review step
+	s.publishPostApprovedEvent(ctx, actorID, post)
+	s.publishPostPublishedEvent(ctx, post)
+
+	return post, nil
+}
+
+// RequestPostChanges sends a post in review back to its author for revision
+func (s *PostsService) RequestPostChanges(ctx context.Context, actorID uuid.UUID, id uuid.UUID) (*domain.Post, error) {
+	if err := s.checkCanReview(ctx, actorID); err != nil {
+		return nil, err
+	}
+	post, err := s.getPostByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := post.RequestChanges(s.workflow); err != nil {
+		return nil, ErrInvalidStatusTransition.WithDetails(err.Error())
+	}
+
+	if err := s.repo.Update(ctx, post); err != nil {
+		s.logger.Error(ctx, "failed to request post changes", "error", err, "postID", id)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to request post changes",
+			http.StatusInternalServerError,
+		)
+	}
+
+	// Publish event
+	s.publishPostChangesRequestedEvent(ctx, actorID, post)
+
+	return post, nil
+}
+
 // ArchivePost transitions a post to archived status
 func (s *PostsService) ArchivePost(ctx context.Context, actorID uuid.UUID, id uuid.UUID) (*domain.Post, error) {
 	// Check authorization - user must be able to archive this specific post
@@ -293,7 +676,8 @@ func (s *PostsService) ArchivePost(ctx context.Context, actorID uuid.UUID, id uu
 		return nil, err
 	}
 
-	if err := post.Archive(); err != nil {
+	wasFeatured := post.Featured
+	if err := post.Archive(s.workflow); err != nil {
 		return nil, ErrInvalidStatusTransition.WithDetails(err.Error())
 	}
 
@@ -307,16 +691,19 @@ func (s *PostsService) ArchivePost(ctx context.Context, actorID uuid.UUID, id uu
 		)
 	}
 
-	// Publish event
+	// Publish events
 	s.publishPostArchivedEvent(ctx, post)
+	if wasFeatured {
+		s.publishFeaturedPostsChangedEvent(ctx, actorID, post)
+	}
 
 	return post, nil
 }
 
 // UnpublishPost transitions a post back to draft status
 func (s *PostsService) UnpublishPost(ctx context.Context, actorID uuid.UUID, id uuid.UUID) (*domain.Post, error) {
-	// Check authorization - user must be able to unpublish this specific post
-	canUnpublish, err := s.authorizer.Can(ctx, actorID, "posts", "unpublish", &id)
+	// Check authorization - unpublishing takes the same right as publishing this specific post
+	canUnpublish, err := s.authorizer.Can(ctx, actorID, "posts", "publish", &id)
 	if err != nil {
 		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID, "postID", id)
 		return nil, apperror.New(
@@ -339,7 +726,8 @@ func (s *PostsService) UnpublishPost(ctx context.Context, actorID uuid.UUID, id
 		return nil, err
 	}
 
-	if err := post.Unpublish(); err != nil {
+	wasFeatured := post.Featured
+	if err := post.Unpublish(s.workflow); err != nil {
 		return nil, ErrInvalidStatusTransition.WithDetails(err.Error())
 	}
 
@@ -353,64 +741,259 @@ func (s *PostsService) UnpublishPost(ctx context.Context, actorID uuid.UUID, id
 		)
 	}
 
-	// Publish event
+	// Publish events
 	s.publishPostUpdatedEvent(ctx, post)
+	if wasFeatured {
+		s.publishFeaturedPostsChangedEvent(ctx, actorID, post)
+	}
 
 	return post, nil
 }
 
-// DeletePost removes a post from the system
-func (s *PostsService) DeletePost(ctx context.Context, actorID uuid.UUID, id uuid.UUID) error {
-	// Check authorization - user must be able to delete this specific post
-	canDelete, err := s.authorizer.Can(ctx, actorID, "posts", "delete", &id)
+// TransitionPost moves a post to any status the workflow allows from its current one,
+// including statuses a deployment added, checking the permission the transition requires
+func (s *PostsService) TransitionPost(ctx context.Context, actorID uuid.UUID, id uuid.UUID, target domain.PostStatus) (*domain.Post, error) {
+	if !s.workflow.Has(target) {
+		return nil, ErrInvalidPostData.WithDetails(fmt.Sprintf("unknown status %q", target))
+	}
+	post, err := s.getPostByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	transition, ok := s.workflow.Transition(post.Status, target)
+	if !ok {
+		return nil, ErrInvalidStatusTransition.WithDetails(fmt.Sprintf("cannot move from %s to %s", post.Status, target))
+	}
+	if err := s.checkCanTransition(ctx, actorID, id, transition); err != nil {
+		return nil, err
+	}
+
+	from := post.Status
+	wasFeatured := post.Featured
+	if err := post.TransitionTo(s.workflow, target); err != nil {
+		return nil, ErrInvalidStatusTransition.WithDetails(err.Error())
+	}
+
+	if err := s.repo.Update(ctx, post); err != nil {
+		s.logger.Error(ctx, "failed to transition post", "error", err, "postID", id, "from", from, "to", target)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to change post status",
+			http.StatusInternalServerError,
+		)
+	}
+
+	// Publish the events of the dedicated endpoints, so subscribers need not know the workflow
+	switch target {
+	case domain.PostStatusPublished:
+		if from == domain.PostStatusInReview {
+			s.publishPostApprovedEvent(ctx, actorID, post)
+		}
+		s.publishPostPublishedEvent(ctx, post)
+	case domain.PostStatusArchived:
+		s.publishPostArchivedEvent(ctx, post)
+	case domain.PostStatusInReview:
+		s.publishPostSubmittedForReviewEvent(ctx, actorID, post)
+	case domain.PostStatusChangesRequested:
+		s.publishPostChangesRequestedEvent(ctx, actorID, post)
+	default:
+		s.publishPostUpdatedEvent(ctx, post)
+	}
+	if wasFeatured && !post.Featured {
+		s.publishFeaturedPostsChangedEvent(ctx, actorID, post)
+	}
+
+	return post, nil
+}
+
+// Workflow returns the statuses posts may take and the transitions between them
+func (s *PostsService) Workflow() *domain.Workflow {
+	return s.workflow
+}
+
+// FeaturePost marks a published post as featured
+func (s *PostsService) FeaturePost(ctx context.Context, actorID uuid.UUID, id uuid.UUID) (*domain.Post, error) {
+	// Check authorization - featuring is an editorial action, not tied to ownership
+	canFeature, err := s.authorizer.Can(ctx, actorID, "posts", "feature", nil)
 	if err != nil {
 		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID, "postID", id)
-		return apperror.New(
+		return nil, apperror.New(
 			apperror.CodeInternalError,
 			apperror.BusinessCodeGeneral,
 			"authorization check failed",
 			http.StatusInternalServerError,
 		)
 	}
-	if !canDelete {
-		return apperror.New(
+	if !canFeature {
+		return nil, apperror.New(
 			apperror.CodeForbidden,
 			apperror.BusinessCodePermissionDenied,
-			"not authorized to delete this post",
+			"not authorized to feature posts",
 			http.StatusForbidden,
 		)
 	}
-	// Check if post exists
 	post, err := s.getPostByID(ctx, id)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// Delete from repository
-	if err := s.repo.Delete(ctx, id); err != nil {
-		s.logger.Error(ctx, "failed to delete post", "error", err, "postID", id)
-		return apperror.New(
+	if post.Featured {
+		return post, nil
+	}
+
+	if err := post.Feature(); err != nil {
+		return nil, ErrPostNotFeaturable.WithDetails(err.Error())
+	}
+
+	if err := s.repo.Update(ctx, post); err != nil {
+		s.logger.Error(ctx, "failed to feature post", "error", err, "postID", id)
+		return nil, apperror.New(
 			apperror.CodeInternalError,
 			apperror.BusinessCodeGeneral,
-			"failed to delete post",
+			"failed to feature post",
 			http.StatusInternalServerError,
 		)
 	}
 
-	// Publish event so other modules can clean up
-	s.publishPostDeletedEvent(ctx, post)
-
-	return nil
-}
+	// Publish event
+	s.publishFeaturedPostsChangedEvent(ctx, actorID, post)
 
-// GetPost retrieves a post by ID
-func (s *PostsService) GetPost(ctx context.Context, id uuid.UUID) (*domain.Post, error) {
-	return s.getPostByID(ctx, id)
+	return post, nil
+}
+
+// UnfeaturePost removes a post from the featured set
+func (s *PostsService) UnfeaturePost(ctx context.Context, actorID uuid.UUID, id uuid.UUID) (*domain.Post, error) {
+	// Check authorization - featuring is an editorial action, not tied to ownership
+	canFeature, err := s.authorizer.Can(ctx, actorID, "posts", "feature", nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID, "postID", id)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canFeature {
+		return nil, apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to unfeature posts",
+			http.StatusForbidden,
+		)
+	}
+	post, err := s.getPostByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !post.Featured {
+		return post, nil
+	}
+
+	post.Unfeature()
+
+	if err := s.repo.Update(ctx, post); err != nil {
+		s.logger.Error(ctx, "failed to unfeature post", "error", err, "postID", id)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to unfeature post",
+			http.StatusInternalServerError,
+		)
+	}
+
+	// Publish event
+	s.publishFeaturedPostsChangedEvent(ctx, actorID, post)
+
+	return post, nil
+}
+
+// DeletePost removes a post from the system
+func (s *PostsService) DeletePost(ctx context.Context, actorID uuid.UUID, id uuid.UUID) error {
+	// Check authorization - user must be able to delete this specific post
+	canDelete, err := s.authorizer.Can(ctx, actorID, "posts", "delete", &id)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID, "postID", id)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canDelete {
+		return apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to delete this post",
+			http.StatusForbidden,
+		)
+	}
+	// Check if post exists
+	post, err := s.getPostByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Delete from repository
+	if err := s.repo.Delete(ctx, id); err != nil {
+		s.logger.Error(ctx, "failed to delete post", "error", err, "postID", id)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to delete post",
+			http.StatusInternalServerError,
+		)
+	}
+
+	// Publish event so other modules can clean up
+	s.publishPostDeletedEvent(ctx, post)
+
+	return nil
+}
+
+// GetPost retrieves a post by ID
+// Premium posts are cut to a teaser unless the context's reader is entitled to them
+func (s *PostsService) GetPost(ctx context.Context, id uuid.UUID) (*domain.Post, error) {
+	post, err := s.getPostByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.forReader(ctx, post)
+}
+
+// GetPostsByIDs loads several posts in one query
+// Unknown IDs are left out rather than reported as not found
+func (s *PostsService) GetPostsByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Post, error) {
+	posts, err := s.repo.FindByIDs(ctx, ids)
+	if err != nil {
+		s.logger.Error(ctx, "failed to find posts by IDs", "error", err, "count", len(ids))
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to retrieve posts",
+			http.StatusInternalServerError,
+		)
+	}
+	return posts, nil
 }
 
 // GetPostBySlug retrieves a post by its slug
+// Slugs a post had before being renamed still resolve; callers can compare
+// the returned post's slug with the requested one to redirect to the current URL.
+// Premium posts are cut to a teaser as by GetPost.
 func (s *PostsService) GetPostBySlug(ctx context.Context, slug string) (*domain.Post, error) {
+	if post, ok := s.cache.GetBySlug(ctx, slug); ok {
+		return s.forReader(ctx, post)
+	}
+
 	post, err := s.repo.FindBySlug(ctx, slug)
+	if errors.Is(err, ports.ErrPostNotFound) {
+		post, err = s.repo.FindByPreviousSlug(ctx, slug)
+	}
 	if err != nil {
 		if errors.Is(err, ports.ErrPostNotFound) {
 			return nil, ErrPostNotFound
@@ -423,12 +1006,94 @@ func (s *PostsService) GetPostBySlug(ctx context.Context, slug string) (*domain.
 			http.StatusInternalServerError,
 		)
 	}
-	return post, nil
+
+	s.cache.SetBySlug(ctx, slug, post)
+	return s.forReader(ctx, post)
 }
 
-// ListPosts retrieves a list of post summaries
+// GetPublicPostBySlug retrieves a post by its slug for an anonymous reader.
+// Published posts come from the read model; anything it does not hold, such as
+// a previous slug or a post whose publication is still being projected, falls
+// back to GetPostBySlug. Read model hits are not cached: a row read just
+// before its refresh would otherwise outlive the invalidation.
+func (s *PostsService) GetPublicPostBySlug(ctx context.Context, slug string) (*domain.Post, error) {
+	if post, ok := s.cache.GetBySlug(ctx, slug); ok {
+		return s.forReader(ctx, post)
+	}
+
+	post, err := s.published.FindBySlug(ctx, slug)
+	if err == nil {
+		return s.forReader(ctx, post)
+	}
+	if !errors.Is(err, ports.ErrPostNotFound) {
+		s.logger.Warn(ctx, "failed to read published post, falling back to posts", "error", err, "slug", slug)
+	}
+	return s.GetPostBySlug(ctx, slug)
+}
+
+// CheckCanRead reports whether the viewer may read a post they looked up by ID
+// or slug under its access policy; viewerID is nil for anonymous readers.
+// Authors and readers of any draft are exempt. Embargoed posts are reported
+// as not found, so their existence does not leak before the embargo lifts.
+func (s *PostsService) CheckCanRead(ctx context.Context, post *domain.Post, viewerID *uuid.UUID) error {
+	refusal := post.CheckCanRead(time.Now(), viewerID != nil)
+	if refusal == nil {
+		return nil
+	}
+
+	if viewerID != nil {
+		if *viewerID == post.AuthorID {
+			return nil
+		}
+		canReadAny, err := s.authorizer.Can(ctx, *viewerID, "posts", "read:draft:any", nil)
+		if err != nil {
+			s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", *viewerID)
+			return apperror.New(
+				apperror.CodeInternalError,
+				apperror.BusinessCodeGeneral,
+				"authorization check failed",
+				http.StatusInternalServerError,
+			)
+		}
+		if canReadAny {
+			return nil
+		}
+	}
+
+	if errors.Is(refusal, domain.ErrPostMembersOnly) {
+		return ErrPostMembersOnly
+	}
+	return ErrPostNotFound
+}
+
+// RenderContent returns the post's content with its code blocks highlighted,
+// or nil when server-side highlighting is disabled
+func (s *PostsService) RenderContent(ctx context.Context, post *domain.Post) *string {
+	rendered, ok := s.renderer.Render(ctx, post.Content)
+	if !ok {
+		return nil
+	}
+	return &rendered
+}
+
+// ListPosts retrieves a list of post summaries the viewer may read.
+// Anonymous viewers get published public posts only, read from the read model;
+// signed-in viewers also get members-only posts, and authors their own drafts,
+// archived, unlisted and embargoed posts. Readers of any draft get every post.
 func (s *PostsService) ListPosts(ctx context.Context, filter ports.ListFilter) ([]*ports.PostSummary, int, error) {
-	summaries, err := s.repo.ListSummaries(ctx, filter)
+	visibility, err := s.listVisibility(ctx, filter.ViewerID)
+	if err != nil {
+		return nil, 0, err
+	}
+	filter.Visibility = visibility
+
+	// Anonymous viewers see published posts only, which the read model holds
+	lister := ports.PostLister(s.repo)
+	if filter.ViewerID == nil {
+		lister = s.published
+	}
+
+	summaries, err := lister.ListSummaries(ctx, filter)
 	if err != nil {
 		s.logger.Error(ctx, "failed to list posts", "error", err)
 		return nil, 0, apperror.New(
@@ -439,7 +1104,7 @@ func (s *PostsService) ListPosts(ctx context.Context, filter ports.ListFilter) (
 		)
 	}
 
-	count, err := s.repo.Count(ctx, filter)
+	count, err := lister.Count(ctx, filter)
 	if err != nil {
 		s.logger.Error(ctx, "failed to count posts", "error", err)
 		return nil, 0, apperror.New(
@@ -453,8 +1118,404 @@ func (s *PostsService) ListPosts(ctx context.Context, filter ports.ListFilter) (
 	return summaries, count, nil
 }
 
+// GetArchive counts published posts by month for archive navigation, newest month first.
+// Only AuthorID and Language of the filter apply.
+func (s *PostsService) GetArchive(ctx context.Context, filter ports.ListFilter) ([]ports.ArchiveMonth, error) {
+	months, err := s.published.CountByMonth(ctx, ports.ListFilter{
+		AuthorID: filter.AuthorID,
+		Language: filter.Language,
+	})
+	if err != nil {
+		s.logger.Error(ctx, "failed to count posts by month", "error", err)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to retrieve post archive",
+			http.StatusInternalServerError,
+		)
+	}
+	return months, nil
+}
+
+// CheckCanComment reports whether the post accepts a comment now under its comment policy
+// It implements ports.CommentPolicyProvider for the comments module
+func (s *PostsService) CheckCanComment(ctx context.Context, postID uuid.UUID, member bool) error {
+	post, err := s.getPostByID(ctx, postID)
+	if err != nil {
+		return err
+	}
+
+	if err := post.CheckCanComment(time.Now(), member); err != nil {
+		return ErrCommentsNotAllowed.WithDetails(err.Error())
+	}
+	return nil
+}
+
+// GetCalendar lays out the publication calendar between from and to, inclusive:
+// published posts on their publication day and planned drafts on their target date.
+// Days are those of the zone in ctx: a post published late in the evening in
+// Taipei sits on that evening's day there, though it is still morning in UTC.
+// Drafts are editorial information, so the actor must be able to read every draft.
+func (s *PostsService) GetCalendar(ctx context.Context, actorID uuid.UUID, from, to time.Time) ([]domain.CalendarDay, error) {
+	canReadAny, err := s.authorizer.Can(ctx, actorID, "posts", "read:draft:any", nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canReadAny {
+		return nil, apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to view the publication calendar",
+			http.StatusForbidden,
+		)
+	}
+
+	if err := domain.ValidateCalendarRange(from, to); err != nil {
+		return nil, apperror.New(
+			apperror.CodeValidationFailed,
+			apperror.BusinessCodeInvalidFormat,
+			err.Error(),
+			http.StatusBadRequest,
+		)
+	}
+
+	loc := timezone.FromContext(ctx)
+	entries, err := s.repo.ListCalendar(ctx, domain.CalendarDate(from), domain.CalendarDate(to), loc)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list calendar", "error", err)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to list calendar",
+			http.StatusInternalServerError,
+		)
+	}
+
+	return domain.BuildCalendar(entries, time.Now().In(loc)), nil
+}
+
+// LinkTranslation marks a post as a translation of another post
+// Both posts end up in the same translation group, so the actor must be able to update both
+func (s *PostsService) LinkTranslation(ctx context.Context, actorID uuid.UUID, id uuid.UUID, sourceID uuid.UUID) (*domain.Post, error) {
+	if err := s.checkCanUpdate(ctx, actorID, id); err != nil {
+		return nil, err
+	}
+
+	post, err := s.getPostByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := s.getPostByID(ctx, sourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Linking lists the post among the source's translations, whether or not the source changes
+	if err := s.checkCanUpdate(ctx, actorID, sourceID); err != nil {
+		return nil, err
+	}
+
+	if source.TranslationGroupID != nil {
+		if err := s.ensureLanguageAvailable(ctx, *source.TranslationGroupID, post.Language, post.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	sourceHadGroup := source.TranslationGroupID != nil
+	if err := post.LinkTranslationOf(source); err != nil {
+		return nil, ErrInvalidTranslation.WithDetails(err.Error())
+	}
+
+	// Starting a new group changes the source too, so save both atomically
+	changed := []*domain.Post{post}
+	if !sourceHadGroup {
+		changed = append(changed, source)
+	}
+	if err := s.updatePostsWithTransaction(ctx, changed...); err != nil {
+		return nil, err
+	}
+
+	// Publish events; a new group changed the source as well
+	for _, changedPost := range changed {
+		s.publishPostUpdatedEvent(ctx, changedPost)
+	}
+
+	return post, nil
+}
+
+// UnlinkTranslation removes a post from its translation group
+func (s *PostsService) UnlinkTranslation(ctx context.Context, actorID uuid.UUID, id uuid.UUID) (*domain.Post, error) {
+	if err := s.checkCanUpdate(ctx, actorID, id); err != nil {
+		return nil, err
+	}
+
+	post, err := s.getPostByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := post.UnlinkTranslation(); err != nil {
+		return nil, ErrInvalidTranslation.WithDetails(err.Error())
+	}
+
+	if err := s.repo.Update(ctx, post); err != nil {
+		s.logger.Error(ctx, "failed to update post", "error", err, "postID", id)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to update post",
+			http.StatusInternalServerError,
+		)
+	}
+
+	// Publish event
+	s.publishPostUpdatedEvent(ctx, post)
+
+	return post, nil
+}
+
+// ListPublishedTranslations returns the published translations of a post, excluding the post itself
+func (s *PostsService) ListPublishedTranslations(ctx context.Context, post *domain.Post) ([]*ports.Translation, error) {
+	if post.TranslationGroupID == nil {
+		return nil, nil
+	}
+
+	translations, err := s.repo.ListTranslations(ctx, *post.TranslationGroupID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list translations", "error", err, "postID", post.ID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to list translations",
+			http.StatusInternalServerError,
+		)
+	}
+
+	published := make([]*ports.Translation, 0, len(translations))
+	for _, translation := range translations {
+		if translation.PostID != post.ID && translation.Status == domain.PostStatusPublished {
+			published = append(published, translation)
+		}
+	}
+	return published, nil
+}
+
+// ListFeed retrieves the viewer's personalized feed of posts from followed authors
+// It returns the cursor for the next page, or nil when there are no more posts
+func (s *PostsService) ListFeed(ctx context.Context, viewerID uuid.UUID, cursor *ports.FeedCursor, limit int) ([]*ports.PostSummary, *ports.FeedCursor, error) {
+	limit = domain.ClampFeedLimit(limit)
+
+	// Fetch one extra row to learn whether another page exists
+	summaries, err := s.repo.ListFeed(ctx, viewerID, cursor, limit+1)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list feed", "error", err, "viewerID", viewerID)
+		return nil, nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to list feed",
+			http.StatusInternalServerError,
+		)
+	}
+
+	if len(summaries) <= limit {
+		return summaries, nil, nil
+	}
+
+	summaries = summaries[:limit]
+	last := summaries[len(summaries)-1]
+	next := &ports.FeedCursor{PostID: last.ID}
+	if last.PublishedAt != nil {
+		next.PublishedAt = *last.PublishedAt
+	}
+
+	return summaries, next, nil
+}
+
 // Private helper methods
 
+// listVisibility decides which posts a listing may show the viewer
+func (s *PostsService) listVisibility(ctx context.Context, viewerID *uuid.UUID) (ports.Visibility, error) {
+	if viewerID == nil {
+		return ports.Visibility{}, nil
+	}
+
+	canReadAny, err := s.authorizer.Can(ctx, *viewerID, "posts", "read:draft:any", nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", *viewerID)
+		return ports.Visibility{}, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if canReadAny {
+		return ports.Visibility{AllStatuses: true}, nil
+	}
+
+	return ports.Visibility{Member: true, OwnerID: viewerID}, nil
+}
+
+// forReader returns the post as the context's reader may see it. Premium
+// posts are cut to a teaser unless the reader wrote the post or is entitled
+// to premium content; the cached post is never changed.
+func (s *PostsService) forReader(ctx context.Context, post *domain.Post) (*domain.Post, error) {
+	if !post.Premium {
+		return post, nil
+	}
+
+	if readerID, ok := actor.UserID(ctx); ok {
+		if readerID == post.AuthorID {
+			return post, nil
+		}
+		entitled, err := s.entitlements.CanReadPremium(ctx, readerID)
+		if err != nil {
+			s.logger.Error(ctx, "failed to check premium entitlement", "error", err, "userID", readerID)
+			return nil, apperror.New(
+				apperror.CodeInternalError,
+				apperror.BusinessCodeGeneral,
+				"entitlement check failed",
+				http.StatusInternalServerError,
+			)
+		}
+		if entitled {
+			return post, nil
+		}
+	}
+
+	content, cut := teaser.Extract(post.Content, premiumTeaserLength)
+	if !cut {
+		return post, nil
+	}
+	return post.WithTeaser(content), nil
+}
+
+// checkCanUpdate verifies the actor may update the post
+func (s *PostsService) checkCanUpdate(ctx context.Context, actorID uuid.UUID, id uuid.UUID) error {
+	canUpdate, err := s.authorizer.Can(ctx, actorID, "posts", "update", &id)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID, "postID", id)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canUpdate {
+		return apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to update this post",
+			http.StatusForbidden,
+		)
+	}
+	return nil
+}
+
+// checkCanReview verifies the actor may approve posts or send them back
+// Reviewing is an editorial action, not tied to ownership
+func (s *PostsService) checkCanReview(ctx context.Context, actorID uuid.UUID) error {
+	canReview, err := s.authorizer.Can(ctx, actorID, "posts", "review", nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canReview {
+		return apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to review posts",
+			http.StatusForbidden,
+		)
+	}
+	return nil
+}
+
+// checkCanTransition verifies the actor holds the permission a workflow transition requires
+func (s *PostsService) checkCanTransition(ctx context.Context, actorID uuid.UUID, id uuid.UUID, transition domain.Transition) error {
+	resource, action, scoped := transition.SplitPermission()
+	var resourceID *uuid.UUID
+	if scoped {
+		resourceID = &id
+	}
+
+	allowed, err := s.authorizer.Can(ctx, actorID, resource, action, resourceID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID, "postID", id)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !allowed {
+		return apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			fmt.Sprintf("not authorized to move this post to %s", transition.To),
+			http.StatusForbidden,
+		)
+	}
+	return nil
+}
+
+// ensureLanguageAvailable checks that no other post in the group uses the language
+func (s *PostsService) ensureLanguageAvailable(ctx context.Context, groupID uuid.UUID, language string, excludeID uuid.UUID) error {
+	translations, err := s.repo.ListTranslations(ctx, groupID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list translations", "error", err, "groupID", groupID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to validate translation",
+			http.StatusInternalServerError,
+		)
+	}
+
+	for _, translation := range translations {
+		if translation.PostID != excludeID && translation.Language == language {
+			return ErrTranslationLanguageExists.WithDetails(language)
+		}
+	}
+	return nil
+}
+
+// updatePostsWithTransaction saves several posts atomically
+func (s *PostsService) updatePostsWithTransaction(ctx context.Context, posts ...*domain.Post) error {
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		for _, post := range posts {
+			if err := s.repo.Update(ctx, post); err != nil {
+				return fmt.Errorf("update post %s: %w", post.ID, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Error(ctx, "failed to update posts", "error", err)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to update post",
+			http.StatusInternalServerError,
+		)
+	}
+	return nil
+}
+
 // getPostByID fetches a post and handles not-found errors consistently
 func (s *PostsService) getPostByID(ctx context.Context, id uuid.UUID) (*domain.Post, error) {
 	post, err := s.repo.FindByID(ctx, id)
@@ -507,13 +1568,15 @@ func (s *PostsService) ensureUniqueSlug(ctx context.Context, baseSlug string, ex
 }
 
 // Event publishing methods
+// Events name the user acting in ctx, falling back to the post's author for work
+// done outside a request
 
 func (s *PostsService) publishPostCreatedEvent(ctx context.Context, post *domain.Post) {
 	event := eventbus.Event{
 		Topic: events.PostCreatedTopic,
 		Payload: events.PostCreatedEvent{
 			PostID:     post.ID,
-			ActorID:    post.AuthorID,
+			ActorID:    actor.UserIDOr(ctx, post.AuthorID),
 			Title:      post.Title,
 			Slug:       post.Slug,
 			OccurredAt: time.Now(),
@@ -527,7 +1590,8 @@ func (s *PostsService) publishPostUpdatedEvent(ctx context.Context, post *domain
 		Topic: events.PostUpdatedTopic,
 		Payload: events.PostUpdatedEvent{
 			PostID:     post.ID,
-			ActorID:    post.AuthorID, // In a real system, this might come from context
+			ActorID:    actor.UserIDOr(ctx, post.AuthorID),
+			AuthorID:   post.AuthorID,
 			Title:      post.Title,
 			Slug:       post.Slug,
 			OccurredAt: time.Now(),
@@ -541,7 +1605,9 @@ func (s *PostsService) publishPostPublishedEvent(ctx context.Context, post *doma
 		Topic: events.PostPublishedTopic,
 		Payload: events.PostPublishedEvent{
 			PostID:      post.ID,
-			ActorID:     post.AuthorID, // In a real system, this might come from context
+			ActorID:     actor.UserIDOr(ctx, post.AuthorID),
+			AuthorID:    post.AuthorID,
+			Title:       post.Title,
 			PublishedAt: *post.PublishedAt,
 			OccurredAt:  time.Now(),
 		},
@@ -554,7 +1620,8 @@ func (s *PostsService) publishPostArchivedEvent(ctx context.Context, post *domai
 		Topic: events.PostArchivedTopic,
 		Payload: events.PostArchivedEvent{
 			PostID:     post.ID,
-			ActorID:    post.AuthorID, // In a real system, this might come from context
+			ActorID:    actor.UserIDOr(ctx, post.AuthorID),
+			AuthorID:   post.AuthorID,
 			OccurredAt: time.Now(),
 		},
 	}
@@ -566,9 +1633,66 @@ func (s *PostsService) publishPostDeletedEvent(ctx context.Context, post *domain
 		Topic: events.PostDeletedTopic,
 		Payload: events.PostDeletedEvent{
 			PostID:     post.ID,
-			ActorID:    post.AuthorID, // In a real system, this might come from context
+			ActorID:    actor.UserIDOr(ctx, post.AuthorID),
+			AuthorID:   post.AuthorID,
+			OccurredAt: time.Now(),
+		},
+	}
+	s.eventBus.Publish(ctx, event)
+}
+
+func (s *PostsService) publishPostSubmittedForReviewEvent(ctx context.Context, actorID uuid.UUID, post *domain.Post) {
+	event := eventbus.Event{
+		Topic: events.PostSubmittedForReviewTopic,
+		Payload: events.PostSubmittedForReviewEvent{
+			PostID:     post.ID,
+			ActorID:    actorID,
+			AuthorID:   post.AuthorID,
+			Title:      post.Title,
 			OccurredAt: time.Now(),
 		},
 	}
 	s.eventBus.Publish(ctx, event)
 }
+
+func (s *PostsService) publishPostApprovedEvent(ctx context.Context, actorID uuid.UUID, post *domain.Post) {
+	event := eventbus.Event{
+		Topic: events.PostApprovedTopic,
+		Payload: events.PostApprovedEvent{
+			PostID:     post.ID,
+			ActorID:    actorID,
+			AuthorID:   post.AuthorID,
+			OccurredAt: time.Now(),
+		},
+	}
+	s.eventBus.Publish(ctx, event)
+}
+
+func (s *PostsService) publishPostChangesRequestedEvent(ctx context.Context, actorID uuid.UUID, post *domain.Post) {
+	event := eventbus.Event{
+		Topic: events.PostChangesRequestedTopic,
+		Payload: events.PostChangesRequestedEvent{
+			PostID:     post.ID,
+			ActorID:    actorID,
+			AuthorID:   post.AuthorID,
+			OccurredAt: time.Now(),
+		},
+	}
+	s.eventBus.Publish(ctx, event)
+}
+
+func (s *PostsService) publishFeaturedPostsChangedEvent(ctx context.Context, actorID uuid.UUID, post *domain.Post) {
+	event := eventbus.Event{
+		Topic: events.FeaturedPostsChangedTopic,
+		Payload: events.FeaturedPostsChangedEvent{
+			PostID:     post.ID,
+			ActorID:    actorID,
+			Featured:   post.Featured,
+			OccurredAt: time.Now(),
+		},
+	}
+	s.eventBus.Publish(ctx, event)
+}
+
+// Compile-time check that the service serves the comment policy port
+var _ ports.CommentPolicyProvider = (*PostsService)(nil)
@@ -0,0 +1,194 @@
+package application_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/errreport"
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/logger"
+	"backend/internal/posts/application"
+	"backend/internal/posts/domain"
+	"backend/internal/posts/ports"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubAuthorizer grants the actions it lists to everyone
+type stubAuthorizer map[string]bool
+
+func (a stubAuthorizer) Can(_ context.Context, _ uuid.UUID, _ string, action string, _ *uuid.UUID) (bool, error) {
+	return a[action], nil
+}
+
+// recordingRepository remembers the filter it was last asked to list with
+type recordingRepository struct {
+	ports.PostRepository
+	filter *ports.ListFilter
+}
+
+func (r *recordingRepository) ListSummaries(_ context.Context, filter ports.ListFilter) ([]*ports.PostSummary, error) {
+	r.filter = &filter
+	return nil, nil
+}
+
+func (r *recordingRepository) Count(_ context.Context, _ ports.ListFilter) (int, error) {
+	return 0, nil
+}
+
+// recordingReadModel remembers the filter it was last asked to list with
+type recordingReadModel struct {
+	ports.PublishedPostRepository
+	filter *ports.ListFilter
+}
+
+func (r *recordingReadModel) ListSummaries(_ context.Context, filter ports.ListFilter) ([]*ports.PostSummary, error) {
+	r.filter = &filter
+	return nil, nil
+}
+
+func (r *recordingReadModel) Count(_ context.Context, _ ports.ListFilter) (int, error) {
+	return 0, nil
+}
+
+func TestPostsService_ListPostsScopesToTheViewer(t *testing.T) {
+	list := func(authorizer stubAuthorizer, viewerID *uuid.UUID) (*recordingRepository, *recordingReadModel) {
+		repo := &recordingRepository{}
+		published := &recordingReadModel{}
+		service := application.NewPostsService(
+			repo, published, authorizer, nil, logger.NewSlogAdapter("test", "error"),
+			nil, nil, nil, nil, nil, nil,
+		)
+
+		filter := ports.DefaultListFilter()
+		filter.ViewerID = viewerID
+		_, _, err := service.ListPosts(context.Background(), filter)
+		require.NoError(t, err)
+		return repo, published
+	}
+
+	t.Run("anonymous readers list published posts only", func(t *testing.T) {
+		repo, published := list(stubAuthorizer{"read:draft:any": true}, nil)
+		assert.Nil(t, repo.filter, "drafts live in the write model only")
+		require.NotNil(t, published.filter)
+		assert.Equal(t, ports.Visibility{}, published.filter.Visibility)
+	})
+
+	t.Run("authors list their own drafts", func(t *testing.T) {
+		author := uuid.New()
+		repo, published := list(stubAuthorizer{}, &author)
+		assert.Nil(t, published.filter)
+		require.NotNil(t, repo.filter)
+		assert.Equal(t, ports.Visibility{Member: true, OwnerID: &author}, repo.filter.Visibility)
+	})
+
+	t.Run("readers of any draft list every post", func(t *testing.T) {
+		editor := uuid.New()
+		repo, _ := list(stubAuthorizer{"read:draft:any": true}, &editor)
+		require.NotNil(t, repo.filter)
+		assert.Equal(t, ports.Visibility{AllStatuses: true}, repo.filter.Visibility)
+	})
+}
+
+// slugRepository stores posts in memory and knows which slugs they take
+type slugRepository struct {
+	ports.PostRepository
+	posts map[uuid.UUID]*domain.Post
+}
+
+func (r *slugRepository) SlugExists(_ context.Context, slug string, excludeID *uuid.UUID) (bool, error) {
+	for _, post := range r.posts {
+		if post.Slug == slug && (excludeID == nil || post.ID != *excludeID) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *slugRepository) Create(_ context.Context, post *domain.Post) error {
+	r.posts[post.ID] = post
+	return nil
+}
+
+func (r *slugRepository) FindByID(_ context.Context, id uuid.UUID) (*domain.Post, error) {
+	post, ok := r.posts[id]
+	if !ok {
+		return nil, ports.ErrPostNotFound
+	}
+	copied := *post
+	return &copied, nil
+}
+
+func (r *slugRepository) Update(_ context.Context, post *domain.Post) error {
+	r.posts[post.ID] = post
+	return nil
+}
+
+// noQuotas lets every author write as much as they like
+type noQuotas struct{}
+
+func (noQuotas) CheckDraftQuota(context.Context, uuid.UUID) error    { return nil }
+func (noQuotas) CheckPostSize(context.Context, uuid.UUID, int) error { return nil }
+
+// inlineUnitOfWork runs fn without a transaction
+type inlineUnitOfWork struct{}
+
+func (inlineUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestPostsService_CustomSlugs(t *testing.T) {
+	log := logger.NewSlogAdapter("test", "error")
+	repo := &slugRepository{posts: map[uuid.UUID]*domain.Post{}}
+	service := application.NewPostsService(
+		repo, nil, stubAuthorizer{"create": true, "update": true}, eventbus.NewBus(log, errreport.Nop{}), log,
+		inlineUnitOfWork{}, nil, nil, noQuotas{}, nil, nil,
+	)
+	ctx := context.Background()
+	author := uuid.New()
+
+	create := func(title, slug string) (*domain.Post, error) {
+		return service.CreatePost(ctx, author, application.CreatePostParams{
+			Title: title, Content: "<p>Body</p>", Slug: slug,
+		})
+	}
+
+	custom, err := create("Hexagonal Architecture Explained", "hexagonal")
+	require.NoError(t, err)
+	assert.Equal(t, "hexagonal", custom.Slug, "an explicit slug replaces the derived one")
+
+	derived, err := create("Ports and Adapters", "")
+	require.NoError(t, err)
+	assert.Equal(t, "ports-and-adapters", derived.Slug)
+
+	taken, err := create("Another Take", "hexagonal")
+	require.NoError(t, err)
+	assert.Equal(t, "hexagonal-1", taken.Slug, "a taken slug gets a suffix")
+
+	_, err = create("Bad Slug", "Not A Slug!")
+	var appErr *apperror.AppError
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, http.StatusBadRequest, appErr.HTTPStatus)
+
+	t.Run("edits keep a custom slug unless the title changes", func(t *testing.T) {
+		excerpt := "Updated"
+		updated, err := service.PatchPost(ctx, author, custom.ID, application.PatchPostParams{Excerpt: &excerpt})
+		require.NoError(t, err)
+		assert.Equal(t, "hexagonal", updated.Slug)
+
+		title := "Hexagonal Architecture Revisited"
+		updated, err = service.PatchPost(ctx, author, custom.ID, application.PatchPostParams{Title: &title})
+		require.NoError(t, err)
+		assert.Equal(t, "hexagonal-architecture-revisited", updated.Slug)
+	})
+
+	t.Run("an explicit slug wins over a new title", func(t *testing.T) {
+		title, slug := "Adapters in Practice", "adapters"
+		updated, err := service.PatchPost(ctx, author, derived.ID, application.PatchPostParams{Title: &title, Slug: &slug})
+		require.NoError(t, err)
+		assert.Equal(t, "adapters", updated.Slug)
+	})
+}
@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// PostVisibility says who may find and read a published post
+type PostVisibility string
+
+const (
+	VisibilityPublic      PostVisibility = "public"       // Listed and readable by anyone
+	VisibilityMembersOnly PostVisibility = "members_only" // Listed and readable by signed-in users only
+	VisibilityUnlisted    PostVisibility = "unlisted"     // Readable by anyone with its slug, but never listed
+)
+
+// IsValid checks if the visibility is a valid value
+func (v PostVisibility) IsValid() bool {
+	switch v {
+	case VisibilityPublic, VisibilityMembersOnly, VisibilityUnlisted:
+		return true
+	default:
+		return false
+	}
+}
+
+// AccessPolicy controls who may read one post and from when. It narrows what
+// the post's status allows: an unpublished post stays hidden whatever its policy.
+type AccessPolicy struct {
+	Visibility PostVisibility
+	// EmbargoUntil keeps the post from readers until then, even once published; nil for no embargo
+	EmbargoUntil *time.Time
+}
+
+// DefaultAccessPolicy is the policy of posts that never set one
+var DefaultAccessPolicy = AccessPolicy{Visibility: VisibilityPublic}
+
+// Access policy errors
+var (
+	ErrInvalidVisibility = errors.New("visibility must be public, members_only or unlisted")
+
+	// Reasons a reader is refused
+	ErrPostEmbargoed   = errors.New("post is under embargo")
+	ErrPostMembersOnly = errors.New("only members may read this post")
+)
+
+// Validate checks the policy's fields
+func (p AccessPolicy) Validate() error {
+	if !p.Visibility.IsValid() {
+		return ErrInvalidVisibility
+	}
+	return nil
+}
+
+// IsEmbargoed reports whether the embargo is still in force at now
+func (p AccessPolicy) IsEmbargoed(now time.Time) bool {
+	return p.EmbargoUntil != nil && now.Before(*p.EmbargoUntil)
+}
+
+// UpdateAccessPolicy replaces the post's access policy with validation
+func (p *Post) UpdateAccessPolicy(policy AccessPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	if policy.Visibility == p.AccessPolicy.Visibility && equalDates(policy.EmbargoUntil, p.AccessPolicy.EmbargoUntil) {
+		return nil
+	}
+
+	p.AccessPolicy = policy
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
+// CheckCanRead reports why a reader would be refused the post at now under
+// its access policy, or nil if they may read it. member is whether the
+// reader is signed in. Authors and editors are not subject to the policy,
+// so callers skip the check for them.
+func (p *Post) CheckCanRead(now time.Time, member bool) error {
+	if p.AccessPolicy.IsEmbargoed(now) {
+		return ErrPostEmbargoed
+	}
+	if p.AccessPolicy.Visibility == VisibilityMembersOnly && !member {
+		return ErrPostMembersOnly
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"backend/internal/posts/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessPolicyValidate(t *testing.T) {
+	assert.NoError(t, domain.DefaultAccessPolicy.Validate())
+	assert.NoError(t, domain.AccessPolicy{Visibility: domain.VisibilityUnlisted}.Validate())
+	assert.ErrorIs(t, domain.AccessPolicy{Visibility: "private"}.Validate(), domain.ErrInvalidVisibility)
+}
+
+func TestCheckCanRead(t *testing.T) {
+	post := newDraft(t)
+	require.NoError(t, post.Publish(domain.DefaultWorkflow()))
+
+	now := time.Date(2025, time.March, 1, 9, 0, 0, 0, time.UTC)
+	until := now.Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		policy  domain.AccessPolicy
+		now     time.Time
+		member  bool
+		wantErr error
+	}{
+		{name: "public", policy: domain.DefaultAccessPolicy, now: now},
+		{name: "unlisted is readable by slug", policy: domain.AccessPolicy{Visibility: domain.VisibilityUnlisted}, now: now},
+		{name: "members only refuses guests", policy: domain.AccessPolicy{Visibility: domain.VisibilityMembersOnly}, now: now, wantErr: domain.ErrPostMembersOnly},
+		{name: "members only admits members", policy: domain.AccessPolicy{Visibility: domain.VisibilityMembersOnly}, now: now, member: true},
+		{name: "under embargo", policy: domain.AccessPolicy{Visibility: domain.VisibilityPublic, EmbargoUntil: &until}, now: now, member: true, wantErr: domain.ErrPostEmbargoed},
+		{name: "embargo lifted", policy: domain.AccessPolicy{Visibility: domain.VisibilityPublic, EmbargoUntil: &until}, now: until},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, post.UpdateAccessPolicy(tt.policy))
+			assert.ErrorIs(t, post.CheckCanRead(tt.now, tt.member), tt.wantErr)
+		})
+	}
+}
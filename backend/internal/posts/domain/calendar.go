@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CalendarEntryKind says why a post appears on the publication calendar
+type CalendarEntryKind string
+
+const (
+	// CalendarEntryPublished is a post shown on the day it was published
+	CalendarEntryPublished CalendarEntryKind = "published"
+	// CalendarEntryScheduled is a draft planned for today or a later day
+	CalendarEntryScheduled CalendarEntryKind = "scheduled"
+	// CalendarEntryDraft is a draft whose planned day has passed without it being published
+	CalendarEntryDraft CalendarEntryKind = "draft"
+)
+
+// MaxCalendarDays caps how many days one calendar request may span
+const MaxCalendarDays = 92
+
+// Calendar errors
+var (
+	ErrInvalidCalendarRange = errors.New("calendar range must end on or after its start")
+	ErrCalendarRangeTooLong = errors.New("calendar range must not exceed 92 days")
+)
+
+// CalendarEntry is a post placed on the publication calendar.
+// Published posts sit on their publication day; drafts sit on their target publish date.
+type CalendarEntry struct {
+	PostID      uuid.UUID
+	Title       string
+	Slug        string
+	AuthorID    uuid.UUID
+	Status      PostStatus
+	Date        time.Time // The calendar day, at midnight UTC
+	PublishedAt *time.Time
+	Kind        CalendarEntryKind
+}
+
+// CalendarDay holds the entries that fall on one day
+type CalendarDay struct {
+	Date    time.Time
+	Entries []CalendarEntry
+}
+
+// CalendarDate returns midnight UTC of t's calendar day in t's own location
+func CalendarDate(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// ValidateCalendarRange checks the inclusive range [from, to] of calendar days
+func ValidateCalendarRange(from, to time.Time) error {
+	from, to = CalendarDate(from), CalendarDate(to)
+	if to.Before(from) {
+		return ErrInvalidCalendarRange
+	}
+	if to.Sub(from) >= MaxCalendarDays*24*time.Hour {
+		return ErrCalendarRangeTooLong
+	}
+	return nil
+}
+
+// BuildCalendar classifies the entries against today and groups them by day.
+// Entries must be ordered by date; days without entries are left out.
+func BuildCalendar(entries []CalendarEntry, today time.Time) []CalendarDay {
+	today = CalendarDate(today)
+
+	days := make([]CalendarDay, 0)
+	for _, entry := range entries {
+		entry.Date = CalendarDate(entry.Date)
+		switch {
+		case entry.Status == PostStatusPublished:
+			entry.Kind = CalendarEntryPublished
+		case entry.Date.Before(today):
+			entry.Kind = CalendarEntryDraft
+		default:
+			entry.Kind = CalendarEntryScheduled
+		}
+
+		if n := len(days); n > 0 && days[n-1].Date.Equal(entry.Date) {
+			days[n-1].Entries = append(days[n-1].Entries, entry)
+			continue
+		}
+		days = append(days, CalendarDay{Date: entry.Date, Entries: []CalendarEntry{entry}})
+	}
+	return days
+}
+
+// equalDates reports whether two optional dates name the same instant
+func equalDates(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
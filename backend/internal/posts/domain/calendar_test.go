@@ -0,0 +1,98 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"backend/internal/posts/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestCalendarDateKeepsTheLocalDay(t *testing.T) {
+	evening := time.Date(2025, time.March, 9, 23, 30, 0, 0, time.FixedZone("UTC-5", -5*3600))
+
+	assert.Equal(t, day(2025, time.March, 9), domain.CalendarDate(evening))
+}
+
+func TestValidateCalendarRange(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    time.Time
+		to      time.Time
+		wantErr error
+	}{
+		{name: "single day", from: day(2025, time.March, 1), to: day(2025, time.March, 1)},
+		{name: "longest range", from: day(2025, time.January, 1), to: day(2025, time.April, 2)},
+		{name: "reversed", from: day(2025, time.March, 2), to: day(2025, time.March, 1), wantErr: domain.ErrInvalidCalendarRange},
+		{name: "too long", from: day(2025, time.January, 1), to: day(2025, time.April, 3), wantErr: domain.ErrCalendarRangeTooLong},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, domain.ValidateCalendarRange(tt.from, tt.to), tt.wantErr)
+		})
+	}
+}
+
+func TestBuildCalendarGroupsAndClassifies(t *testing.T) {
+	today := time.Date(2025, time.March, 10, 15, 0, 0, 0, time.UTC)
+	entries := []domain.CalendarEntry{
+		{Title: "overdue", Status: domain.PostStatusDraft, Date: day(2025, time.March, 9)},
+		{Title: "released", Status: domain.PostStatusPublished, Date: time.Date(2025, time.March, 9, 8, 0, 0, 0, time.UTC)},
+		{Title: "due today", Status: domain.PostStatusDraft, Date: day(2025, time.March, 10)},
+		{Title: "planned", Status: domain.PostStatusDraft, Date: day(2025, time.March, 12)},
+	}
+
+	days := domain.BuildCalendar(entries, today)
+
+	require.Len(t, days, 3)
+	assert.Equal(t, day(2025, time.March, 9), days[0].Date)
+	require.Len(t, days[0].Entries, 2)
+	assert.Equal(t, domain.CalendarEntryDraft, days[0].Entries[0].Kind)
+	assert.Equal(t, domain.CalendarEntryPublished, days[0].Entries[1].Kind)
+	assert.Equal(t, day(2025, time.March, 9), days[0].Entries[1].Date)
+
+	assert.Equal(t, domain.CalendarEntryScheduled, days[1].Entries[0].Kind)
+	assert.Equal(t, day(2025, time.March, 12), days[2].Date)
+	assert.Equal(t, domain.CalendarEntryScheduled, days[2].Entries[0].Kind)
+}
+
+func TestBuildCalendarUsesTodayOfTheViewersZone(t *testing.T) {
+	taipei, err := time.LoadLocation("Asia/Taipei")
+	require.NoError(t, err)
+	entries := []domain.CalendarEntry{
+		{Title: "due yesterday in Taipei", Status: domain.PostStatusDraft, Date: day(2025, time.March, 10)},
+	}
+
+	// 20:00 UTC on the 10th is already the 11th in Taipei
+	now := time.Date(2025, time.March, 10, 20, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, domain.CalendarEntryScheduled, domain.BuildCalendar(entries, now)[0].Entries[0].Kind)
+	assert.Equal(t, domain.CalendarEntryDraft, domain.BuildCalendar(entries, now.In(taipei))[0].Entries[0].Kind)
+}
+
+func TestBuildCalendarWithoutEntries(t *testing.T) {
+	days := domain.BuildCalendar(nil, time.Now())
+
+	assert.NotNil(t, days)
+	assert.Empty(t, days)
+}
+
+func TestSetTargetPublishDateDropsTimeOfDay(t *testing.T) {
+	post, err := domain.NewPost("Planned", "<p>Body</p>", "", uuid.New())
+	require.NoError(t, err)
+
+	planned := time.Date(2025, time.June, 1, 17, 45, 0, 0, time.UTC)
+	post.SetTargetPublishDate(&planned)
+	require.NotNil(t, post.TargetPublishDate)
+	assert.Equal(t, day(2025, time.June, 1), *post.TargetPublishDate)
+
+	post.SetTargetPublishDate(nil)
+	assert.Nil(t, post.TargetPublishDate)
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// CommentMode says who may comment on a post
+type CommentMode string
+
+const (
+	CommentsEnabled     CommentMode = "enabled"      // Anyone may comment
+	CommentsMembersOnly CommentMode = "members_only" // Only signed-in users may comment
+	CommentsDisabled    CommentMode = "disabled"     // Nobody may comment
+)
+
+// IsValid checks if the mode is a valid value
+func (m CommentMode) IsValid() bool {
+	switch m {
+	case CommentsEnabled, CommentsMembersOnly, CommentsDisabled:
+		return true
+	default:
+		return false
+	}
+}
+
+// MaxCommentAutoCloseDays caps the auto-close delay at roughly ten years
+const MaxCommentAutoCloseDays = 3650
+
+// CommentPolicy controls commenting on one post
+type CommentPolicy struct {
+	Mode CommentMode
+	// AutoCloseAfterDays closes comments this many days after publication; zero keeps them open
+	AutoCloseAfterDays int
+}
+
+// DefaultCommentPolicy is the policy of posts that never set one
+var DefaultCommentPolicy = CommentPolicy{Mode: CommentsEnabled}
+
+// Comment policy errors
+var (
+	ErrInvalidCommentMode      = errors.New("comment mode must be enabled, members_only or disabled")
+	ErrInvalidCommentAutoClose = errors.New("comment auto-close must be between 0 and 3650 days")
+
+	// Reasons a comment is refused
+	ErrCommentsNotPublished = errors.New("comments are only accepted on published posts")
+	ErrCommentsDisabled     = errors.New("comments are disabled on this post")
+	ErrCommentsMembersOnly  = errors.New("only members may comment on this post")
+	ErrCommentsClosed       = errors.New("comments on this post are closed")
+)
+
+// Validate checks the policy's fields
+func (p CommentPolicy) Validate() error {
+	if !p.Mode.IsValid() {
+		return ErrInvalidCommentMode
+	}
+	if p.AutoCloseAfterDays < 0 || p.AutoCloseAfterDays > MaxCommentAutoCloseDays {
+		return ErrInvalidCommentAutoClose
+	}
+	return nil
+}
+
+// ClosesAt returns when comments close for a post published at publishedAt;
+// nil when they never close on their own or the post is not published
+func (p CommentPolicy) ClosesAt(publishedAt *time.Time) *time.Time {
+	if p.AutoCloseAfterDays == 0 || publishedAt == nil {
+		return nil
+	}
+	closesAt := publishedAt.AddDate(0, 0, p.AutoCloseAfterDays)
+	return &closesAt
+}
+
+// UpdateCommentPolicy replaces the post's comment policy with validation
+func (p *Post) UpdateCommentPolicy(policy CommentPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	if policy == p.CommentPolicy {
+		return nil
+	}
+
+	p.CommentPolicy = policy
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
+// CheckCanComment reports why a comment would be refused at now, or nil if it
+// is accepted. member is whether the commenter is signed in.
+func (p *Post) CheckCanComment(now time.Time, member bool) error {
+	if !p.IsPublished() {
+		return ErrCommentsNotPublished
+	}
+	switch p.CommentPolicy.Mode {
+	case CommentsDisabled:
+		return ErrCommentsDisabled
+	case CommentsMembersOnly:
+		if !member {
+			return ErrCommentsMembersOnly
+		}
+	}
+	if closesAt := p.CommentPolicy.ClosesAt(p.PublishedAt); closesAt != nil && !now.Before(*closesAt) {
+		return ErrCommentsClosed
+	}
+	return nil
+}
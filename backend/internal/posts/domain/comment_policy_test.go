@@ -0,0 +1,60 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"backend/internal/posts/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommentPolicyValidate(t *testing.T) {
+	assert.NoError(t, domain.DefaultCommentPolicy.Validate())
+	assert.NoError(t, domain.CommentPolicy{Mode: domain.CommentsMembersOnly, AutoCloseAfterDays: 30}.Validate())
+
+	assert.ErrorIs(t, domain.CommentPolicy{Mode: "everyone"}.Validate(), domain.ErrInvalidCommentMode)
+	assert.ErrorIs(t, domain.CommentPolicy{Mode: domain.CommentsEnabled, AutoCloseAfterDays: -1}.Validate(), domain.ErrInvalidCommentAutoClose)
+	assert.ErrorIs(t, domain.CommentPolicy{Mode: domain.CommentsEnabled, AutoCloseAfterDays: 3651}.Validate(), domain.ErrInvalidCommentAutoClose)
+}
+
+func TestCheckCanComment(t *testing.T) {
+	post := newDraft(t)
+	assert.ErrorIs(t, post.CheckCanComment(time.Now(), true), domain.ErrCommentsNotPublished)
+
+	require.NoError(t, post.Publish(domain.DefaultWorkflow()))
+	publishedAt := *post.PublishedAt
+
+	tests := []struct {
+		name    string
+		policy  domain.CommentPolicy
+		now     time.Time
+		member  bool
+		wantErr error
+	}{
+		{name: "open to anyone", policy: domain.DefaultCommentPolicy, now: publishedAt},
+		{name: "disabled", policy: domain.CommentPolicy{Mode: domain.CommentsDisabled}, now: publishedAt, member: true, wantErr: domain.ErrCommentsDisabled},
+		{name: "members only refuses guests", policy: domain.CommentPolicy{Mode: domain.CommentsMembersOnly}, now: publishedAt, wantErr: domain.ErrCommentsMembersOnly},
+		{name: "members only admits members", policy: domain.CommentPolicy{Mode: domain.CommentsMembersOnly}, now: publishedAt, member: true},
+		{name: "before auto-close", policy: domain.CommentPolicy{Mode: domain.CommentsEnabled, AutoCloseAfterDays: 7}, now: publishedAt.AddDate(0, 0, 6)},
+		{name: "after auto-close", policy: domain.CommentPolicy{Mode: domain.CommentsEnabled, AutoCloseAfterDays: 7}, now: publishedAt.AddDate(0, 0, 7), wantErr: domain.ErrCommentsClosed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, post.UpdateCommentPolicy(tt.policy))
+			assert.ErrorIs(t, post.CheckCanComment(tt.now, tt.member), tt.wantErr)
+		})
+	}
+}
+
+func TestCommentPolicyClosesAt(t *testing.T) {
+	publishedAt := time.Date(2025, time.January, 30, 12, 0, 0, 0, time.UTC)
+
+	assert.Nil(t, domain.DefaultCommentPolicy.ClosesAt(&publishedAt))
+	assert.Nil(t, domain.CommentPolicy{Mode: domain.CommentsEnabled, AutoCloseAfterDays: 5}.ClosesAt(nil))
+
+	closesAt := domain.CommentPolicy{Mode: domain.CommentsEnabled, AutoCloseAfterDays: 5}.ClosesAt(&publishedAt)
+	require.NotNil(t, closesAt)
+	assert.Equal(t, time.Date(2025, time.February, 4, 12, 0, 0, 0, time.UTC), *closesAt)
+}
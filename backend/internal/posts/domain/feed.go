@@ -0,0 +1,20 @@
+package domain
+
+// Page sizes of the personalized feed
+// MaxFeedLimit matches the maximum the API spec declares for the feed's limit parameter
+const (
+	DefaultFeedLimit = 20
+	MaxFeedLimit     = 100
+)
+
+// ClampFeedLimit returns the feed page size to apply: the default when none is
+// asked for, and never more than MaxFeedLimit
+func ClampFeedLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultFeedLimit
+	}
+	if limit > MaxFeedLimit {
+		return MaxFeedLimit
+	}
+	return limit
+}
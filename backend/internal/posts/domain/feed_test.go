@@ -0,0 +1,16 @@
+package domain_test
+
+import (
+	"testing"
+
+	"backend/internal/posts/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClampFeedLimit(t *testing.T) {
+	assert.Equal(t, domain.DefaultFeedLimit, domain.ClampFeedLimit(0))
+	assert.Equal(t, domain.DefaultFeedLimit, domain.ClampFeedLimit(-5))
+	assert.Equal(t, 30, domain.ClampFeedLimit(30))
+	assert.Equal(t, domain.MaxFeedLimit, domain.ClampFeedLimit(domain.MaxFeedLimit+1))
+	assert.Equal(t, domain.MaxFeedLimit, domain.ClampFeedLimit(1_000_000))
+}
@@ -3,7 +3,9 @@ package domain
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"time"
+	"unicode/utf8"
 
 	"backend/internal/platform/validator"
 	"github.com/google/uuid"
@@ -13,38 +15,29 @@ import (
 type PostStatus string
 
 const (
-	PostStatusDraft     PostStatus = "draft"
-	PostStatusPublished PostStatus = "published"
-	PostStatusArchived  PostStatus = "archived"
+	PostStatusDraft            PostStatus = "draft"
+	PostStatusInReview         PostStatus = "in_review"
+	PostStatusChangesRequested PostStatus = "changes_requested"
+	PostStatusPublished        PostStatus = "published"
+	PostStatusArchived         PostStatus = "archived"
 )
 
-// IsValid checks if the status is a valid value
+// statusFormat matches status names; which statuses exist is up to the Workflow
+var statusFormat = regexp.MustCompile(`^[a-z][a-z_]*$`)
+
+// IsValid checks if the status is a well-formed status name
 func (s PostStatus) IsValid() bool {
-	switch s {
-	case PostStatusDraft, PostStatusPublished, PostStatusArchived:
-		return true
-	default:
-		return false
-	}
+	return len(s) <= MaxStatusLength && statusFormat.MatchString(string(s))
 }
 
-// CanTransitionTo checks if a status transition is allowed
-func (s PostStatus) CanTransitionTo(target PostStatus) bool {
-	switch s {
-	case PostStatusDraft:
-		// Draft can go to published or archived
-		return target == PostStatusPublished || target == PostStatusArchived
-	case PostStatusPublished:
-		// Published can only go to archived
-		return target == PostStatusArchived
-	case PostStatusArchived:
-		// Archived can go back to draft or published
-		return target == PostStatusDraft || target == PostStatusPublished
-	default:
-		return false
-	}
+// IsInProgress reports whether the post is still being written or reviewed
+func (s PostStatus) IsInProgress() bool {
+	return s == PostStatusDraft || s == PostStatusInReview || s == PostStatusChangesRequested
 }
 
+// InProgressStatuses lists the statuses for which IsInProgress holds
+var InProgressStatuses = []PostStatus{PostStatusDraft, PostStatusInReview, PostStatusChangesRequested}
+
 // Post represents a blog post in the domain
 type Post struct {
 	ID          uuid.UUID
@@ -55,8 +48,31 @@ type Post struct {
 	AuthorID    uuid.UUID
 	Status      PostStatus
 	PublishedAt *time.Time
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	Featured    bool
+	FeaturedAt  *time.Time // When the post was featured; drives featured ordering
+	Language    string     // Normalized language tag, e.g. "en" or "zh-TW"
+	// TranslationGroupID links translations of the same post; nil when the post has none
+	TranslationGroupID *uuid.UUID
+	// TargetPublishDate is the day an editor plans to publish the post, at midnight UTC; nil when unplanned
+	TargetPublishDate *time.Time
+	SEO               SEOMetadata
+	CommentPolicy     CommentPolicy
+	AccessPolicy      AccessPolicy
+	// Premium posts show readers without an entitlement a teaser instead of the full content
+	Premium bool
+	// ContentTruncated marks a copy whose content was cut to a teaser; it is never stored
+	ContentTruncated bool
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// SEOMetadata overrides what search engines and link previews show for a post
+// Empty fields fall back to the post's own title, excerpt and URL
+type SEOMetadata struct {
+	MetaTitle       string
+	MetaDescription string
+	CanonicalURL    string // Absolute URL of the original when the post is republished
+	OGImageURL      string // Open Graph share image
 }
 
 // Business rule constants
@@ -64,6 +80,14 @@ const (
 	MaxTitleLength   = 200
 	MaxSlugLength    = 250
 	MaxExcerptLength = 500
+
+	// Search engines truncate longer titles and descriptions
+	MaxMetaTitleLength       = 70
+	MaxMetaDescriptionLength = 160
+	MaxSEOURLLength          = 2048
+
+	// DefaultLanguage is used for posts created without an explicit language
+	DefaultLanguage = "en"
 )
 
 // Validation errors
@@ -75,6 +99,18 @@ var (
 	ErrInvalidAuthorID   = errors.New("author ID is required")
 	ErrInvalidStatus     = errors.New("invalid post status")
 	ErrInvalidTransition = errors.New("invalid status transition")
+	ErrCannotFeature     = errors.New("only published posts can be featured")
+	ErrInvalidLanguage   = errors.New("language must be an ISO 639 code with an optional region")
+
+	ErrInvalidMetaTitle       = errors.New("meta title must not exceed 70 characters")
+	ErrInvalidMetaDescription = errors.New("meta description must not exceed 160 characters")
+	ErrInvalidCanonicalURL    = errors.New("canonical URL must be an absolute http or https URL")
+	ErrInvalidOGImageURL      = errors.New("share image must be an absolute http or https URL")
+
+	ErrSelfTranslation    = errors.New("a post cannot be a translation of itself")
+	ErrSameLanguage       = errors.New("a translation must be in a different language")
+	ErrAlreadyTranslation = errors.New("post already belongs to a translation group")
+	ErrNotTranslation     = errors.New("post does not belong to a translation group")
 )
 
 // NewPost creates a new post with validation
@@ -103,15 +139,18 @@ func NewPost(title, content, excerpt string, authorID uuid.UUID) (*Post, error)
 
 	now := time.Now()
 	return &Post{
-		ID:        uuid.New(),
-		Title:     title,
-		Slug:      slug,
-		Content:   content,
-		Excerpt:   excerpt,
-		AuthorID:  authorID,
-		Status:    PostStatusDraft,
-		CreatedAt: now,
-		UpdatedAt: now,
+		ID:            uuid.New(),
+		Title:         title,
+		Slug:          slug,
+		Content:       content,
+		Excerpt:       excerpt,
+		AuthorID:      authorID,
+		Status:        PostStatusDraft,
+		Language:      DefaultLanguage,
+		CommentPolicy: DefaultCommentPolicy,
+		AccessPolicy:  DefaultAccessPolicy,
+		CreatedAt:     now,
+		UpdatedAt:     now,
 	}, nil
 }
 
@@ -149,42 +188,165 @@ func (p *Post) UpdateSlug(slug string) error {
 	return nil
 }
 
-// Publish transitions the post to published status
-func (p *Post) Publish() error {
-	if !p.Status.CanTransitionTo(PostStatusPublished) {
-		return fmt.Errorf("%w: cannot publish from %s", ErrInvalidTransition, p.Status)
+// SetLanguage changes the post language
+// Note: uniqueness of the language within a translation group must be checked by the service layer
+func (p *Post) SetLanguage(language string) error {
+	normalized, err := validator.NormalizeLanguageTag(language)
+	if err != nil {
+		return ErrInvalidLanguage
+	}
+	if normalized == p.Language {
+		return nil
+	}
+
+	p.Language = normalized
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
+// LinkTranslationOf makes the post a translation of source
+// When source has no translation group yet, one is started using source's ID,
+// so source may change as well and must be persisted alongside the post.
+// Note: the service layer must check no other post in the group shares this language
+func (p *Post) LinkTranslationOf(source *Post) error {
+	if p.ID == source.ID {
+		return ErrSelfTranslation
+	}
+	if p.Language == source.Language {
+		return ErrSameLanguage
+	}
+	if p.TranslationGroupID != nil {
+		return ErrAlreadyTranslation
 	}
 
-	p.Status = PostStatusPublished
 	now := time.Now()
-	p.PublishedAt = &now
+	if source.TranslationGroupID == nil {
+		groupID := source.ID
+		source.TranslationGroupID = &groupID
+		source.UpdatedAt = now
+	}
+
+	groupID := *source.TranslationGroupID
+	p.TranslationGroupID = &groupID
 	p.UpdatedAt = now
 	return nil
 }
 
-// Archive transitions the post to archived status
-func (p *Post) Archive() error {
-	if !p.Status.CanTransitionTo(PostStatusArchived) {
-		return fmt.Errorf("%w: cannot archive from %s", ErrInvalidTransition, p.Status)
+// UnlinkTranslation removes the post from its translation group
+func (p *Post) UnlinkTranslation() error {
+	if p.TranslationGroupID == nil {
+		return ErrNotTranslation
 	}
 
-	p.Status = PostStatusArchived
+	p.TranslationGroupID = nil
 	p.UpdatedAt = time.Now()
 	return nil
 }
 
+// TransitionTo moves the post to any status the workflow allows from its current one
+// Publishing stamps the publication time, which going back to draft clears;
+// posts leaving the published state stop being featured.
+func (p *Post) TransitionTo(workflow *Workflow, target PostStatus) error {
+	if !workflow.Allows(p.Status, target) {
+		return fmt.Errorf("%w: cannot move from %s to %s", ErrInvalidTransition, p.Status, target)
+	}
+
+	now := time.Now()
+	switch target {
+	case PostStatusPublished:
+		p.PublishedAt = &now
+	case PostStatusDraft:
+		p.PublishedAt = nil
+	}
+	if p.Status == PostStatusPublished {
+		p.clearFeatured()
+	}
+	p.Status = target
+	p.UpdatedAt = now
+	return nil
+}
+
+// Publish transitions the post to published status
+func (p *Post) Publish(workflow *Workflow) error {
+	if !workflow.Allows(p.Status, PostStatusPublished) {
+		return fmt.Errorf("%w: cannot publish from %s", ErrInvalidTransition, p.Status)
+	}
+	return p.TransitionTo(workflow, PostStatusPublished)
+}
+
+// SubmitForReview sends a draft, or a post revised after review, to the review queue
+func (p *Post) SubmitForReview(workflow *Workflow) error {
+	if !workflow.Allows(p.Status, PostStatusInReview) {
+		return fmt.Errorf("%w: cannot submit for review from %s", ErrInvalidTransition, p.Status)
+	}
+	return p.TransitionTo(workflow, PostStatusInReview)
+}
+
+// Approve publishes a post that passed review
+func (p *Post) Approve(workflow *Workflow) error {
+	if p.Status != PostStatusInReview {
+		return fmt.Errorf("%w: cannot approve from %s", ErrInvalidTransition, p.Status)
+	}
+	return p.Publish(workflow)
+}
+
+// RequestChanges sends a post under review back to its author
+func (p *Post) RequestChanges(workflow *Workflow) error {
+	if p.Status != PostStatusInReview || !workflow.Allows(p.Status, PostStatusChangesRequested) {
+		return fmt.Errorf("%w: cannot request changes from %s", ErrInvalidTransition, p.Status)
+	}
+	return p.TransitionTo(workflow, PostStatusChangesRequested)
+}
+
+// Archive transitions the post to archived status
+func (p *Post) Archive(workflow *Workflow) error {
+	if !workflow.Allows(p.Status, PostStatusArchived) {
+		return fmt.Errorf("%w: cannot archive from %s", ErrInvalidTransition, p.Status)
+	}
+	return p.TransitionTo(workflow, PostStatusArchived)
+}
+
 // Unpublish transitions the post back to draft status
-func (p *Post) Unpublish() error {
-	if !p.Status.CanTransitionTo(PostStatusDraft) {
+func (p *Post) Unpublish(workflow *Workflow) error {
+	if !workflow.Allows(p.Status, PostStatusDraft) {
 		return fmt.Errorf("%w: cannot unpublish from %s", ErrInvalidTransition, p.Status)
 	}
+	return p.TransitionTo(workflow, PostStatusDraft)
+}
 
-	p.Status = PostStatusDraft
-	p.PublishedAt = nil
-	p.UpdatedAt = time.Now()
+// Feature marks a published post as featured
+// Featuring an already featured post keeps its original featured time
+func (p *Post) Feature() error {
+	if !p.IsPublished() {
+		return ErrCannotFeature
+	}
+	if p.Featured {
+		return nil
+	}
+
+	now := time.Now()
+	p.Featured = true
+	p.FeaturedAt = &now
+	p.UpdatedAt = now
 	return nil
 }
 
+// Unfeature removes the featured flag from the post
+func (p *Post) Unfeature() {
+	if !p.Featured {
+		return
+	}
+
+	p.clearFeatured()
+	p.UpdatedAt = time.Now()
+}
+
+// clearFeatured drops the featured flag; posts leaving the published state stop being featured
+func (p *Post) clearFeatured() {
+	p.Featured = false
+	p.FeaturedAt = nil
+}
+
 // IsPublished checks if the post is currently published
 func (p *Post) IsPublished() bool {
 	return p.Status == PostStatusPublished
@@ -207,6 +369,63 @@ func (p *Post) GetAuthorID() uuid.UUID {
 	return p.AuthorID
 }
 
+// GetTitle returns the post title
+// Implements themes/domain.PostInfo interface
+func (p *Post) GetTitle() string {
+	return p.Title
+}
+
+// GetSlug returns the post slug
+// Implements themes/domain.PostInfo interface
+func (p *Post) GetSlug() string {
+	return p.Slug
+}
+
+// SetTargetPublishDate plans the day the post should be published; nil clears the plan
+// Only the calendar day is kept, so any time of day is dropped
+func (p *Post) SetTargetPublishDate(date *time.Time) {
+	if date != nil {
+		day := CalendarDate(*date)
+		date = &day
+	}
+	if equalDates(p.TargetPublishDate, date) {
+		return
+	}
+
+	p.TargetPublishDate = date
+	p.UpdatedAt = time.Now()
+}
+
+// SetPremium marks the post as premium content or lifts the mark
+func (p *Post) SetPremium(premium bool) {
+	if p.Premium == premium {
+		return
+	}
+
+	p.Premium = premium
+	p.UpdatedAt = time.Now()
+}
+
+// WithTeaser returns a copy of the post whose content is replaced by teaser,
+// for a reader not entitled to the premium content
+func (p *Post) WithTeaser(teaser string) *Post {
+	teased := *p
+	teased.Content = teaser
+	teased.ContentTruncated = true
+	return &teased
+}
+
+// UpdateSEO replaces the post's SEO metadata with validation
+func (p *Post) UpdateSEO(seo SEOMetadata) error {
+	if err := validateSEO(seo); err != nil {
+		return err
+	}
+
+	p.SEO = seo
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
 // Validation helpers
 
 func validateTitle(title string) error {
@@ -231,6 +450,22 @@ func validateExcerpt(excerpt string) error {
 	return nil
 }
 
+func validateSEO(seo SEOMetadata) error {
+	if utf8.RuneCountInString(seo.MetaTitle) > MaxMetaTitleLength {
+		return ErrInvalidMetaTitle
+	}
+	if utf8.RuneCountInString(seo.MetaDescription) > MaxMetaDescriptionLength {
+		return ErrInvalidMetaDescription
+	}
+	if seo.CanonicalURL != "" && validator.ValidateWebURL(seo.CanonicalURL, MaxSEOURLLength) != nil {
+		return ErrInvalidCanonicalURL
+	}
+	if seo.OGImageURL != "" && validator.ValidateWebURL(seo.OGImageURL, MaxSEOURLLength) != nil {
+		return ErrInvalidOGImageURL
+	}
+	return nil
+}
+
 func validateSlug(slug string) error {
 	if err := validator.ValidateSlugFormat(slug, MaxSlugLength); err != nil {
 		return ErrInvalidSlug
@@ -0,0 +1,69 @@
+package domain_test
+
+import (
+	"testing"
+
+	"backend/internal/posts/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newDraft(t *testing.T) *domain.Post {
+	t.Helper()
+	post, err := domain.NewPost("Review me", "<p>Body</p>", "", uuid.New())
+	require.NoError(t, err)
+	return post
+}
+
+func TestReviewWorkflow(t *testing.T) {
+	workflow := domain.DefaultWorkflow()
+	post := newDraft(t)
+
+	require.NoError(t, post.SubmitForReview(workflow))
+	assert.Equal(t, domain.PostStatusInReview, post.Status)
+
+	require.NoError(t, post.RequestChanges(workflow))
+	assert.Equal(t, domain.PostStatusChangesRequested, post.Status)
+
+	require.NoError(t, post.SubmitForReview(workflow))
+	require.NoError(t, post.Approve(workflow))
+	assert.Equal(t, domain.PostStatusPublished, post.Status)
+	assert.NotNil(t, post.PublishedAt)
+}
+
+func TestReviewTransitionsNeedAPostInReview(t *testing.T) {
+	workflow := domain.DefaultWorkflow()
+	post := newDraft(t)
+
+	assert.ErrorIs(t, post.Approve(workflow), domain.ErrInvalidTransition)
+	assert.ErrorIs(t, post.RequestChanges(workflow), domain.ErrInvalidTransition)
+
+	require.NoError(t, post.SubmitForReview(workflow))
+	assert.ErrorIs(t, post.SubmitForReview(workflow), domain.ErrInvalidTransition, "already in review")
+
+	require.NoError(t, post.Approve(workflow))
+	assert.ErrorIs(t, post.SubmitForReview(workflow), domain.ErrInvalidTransition, "published posts are not reviewed")
+}
+
+func TestInProgressStatuses(t *testing.T) {
+	for _, status := range domain.InProgressStatuses {
+		assert.True(t, status.IsInProgress(), status)
+		assert.True(t, status.IsValid(), status)
+	}
+	assert.False(t, domain.PostStatusPublished.IsInProgress())
+	assert.False(t, domain.PostStatusArchived.IsInProgress())
+}
+
+func TestWithTeaserLeavesThePostWhole(t *testing.T) {
+	post := newDraft(t)
+	post.SetPremium(true)
+
+	teased := post.WithTeaser("<p>Bo…</p>")
+	assert.Equal(t, "<p>Bo…</p>", teased.Content)
+	assert.True(t, teased.ContentTruncated)
+	assert.True(t, teased.Premium)
+
+	assert.Equal(t, "<p>Body</p>", post.Content, "cached posts must keep their full content")
+	assert.False(t, post.ContentTruncated)
+}
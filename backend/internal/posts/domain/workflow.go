@@ -0,0 +1,158 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Transition lets posts move from one status to another
+type Transition struct {
+	From PostStatus
+	To   PostStatus
+	// Permission is required to make the transition. A permission ending in
+	// ":own" is checked against the post, so ":any" holders pass as well.
+	Permission string
+}
+
+// Workflow is the set of statuses posts may take and the transitions between them
+// Deployments may replace the default one to add states such as "scheduled"
+type Workflow struct {
+	states      []PostStatus
+	known       map[PostStatus]bool
+	transitions map[PostStatus]map[PostStatus]Transition
+}
+
+// RequiredStatuses must be part of every workflow: posts are created as
+// drafts and only published posts are shown to readers
+var RequiredStatuses = []PostStatus{PostStatusDraft, PostStatusPublished}
+
+// MaxStatusLength is the longest status the posts table stores
+const MaxStatusLength = 20
+
+// ErrInvalidWorkflow is returned for workflow definitions the domain cannot run
+var ErrInvalidWorkflow = errors.New("invalid post workflow")
+
+// workflowPermissions matches the posts permissions a transition may require
+var workflowPermissions = regexp.MustCompile(`^posts(:[a-z_]+)+$`)
+
+// NewWorkflow creates a workflow from its statuses and transitions with validation
+func NewWorkflow(states []PostStatus, transitions []Transition) (*Workflow, error) {
+	w := &Workflow{
+		known:       make(map[PostStatus]bool, len(states)),
+		transitions: make(map[PostStatus]map[PostStatus]Transition),
+	}
+
+	for _, state := range states {
+		if !state.IsValid() {
+			return nil, fmt.Errorf("%w: status %q must be lowercase letters and underscores, at most %d characters", ErrInvalidWorkflow, state, MaxStatusLength)
+		}
+		if w.known[state] {
+			return nil, fmt.Errorf("%w: status %q is listed twice", ErrInvalidWorkflow, state)
+		}
+		w.known[state] = true
+		w.states = append(w.states, state)
+	}
+	for _, required := range RequiredStatuses {
+		if !w.known[required] {
+			return nil, fmt.Errorf("%w: status %q is required", ErrInvalidWorkflow, required)
+		}
+	}
+
+	for _, t := range transitions {
+		if !w.known[t.From] || !w.known[t.To] {
+			return nil, fmt.Errorf("%w: transition %s -> %s uses an unknown status", ErrInvalidWorkflow, t.From, t.To)
+		}
+		if t.From == t.To {
+			return nil, fmt.Errorf("%w: transition %s -> %s does not change the status", ErrInvalidWorkflow, t.From, t.To)
+		}
+		if !workflowPermissions.MatchString(t.Permission) {
+			return nil, fmt.Errorf("%w: transition %s -> %s needs a posts permission, got %q", ErrInvalidWorkflow, t.From, t.To, t.Permission)
+		}
+		if _, exists := w.transitions[t.From][t.To]; exists {
+			return nil, fmt.Errorf("%w: transition %s -> %s is listed twice", ErrInvalidWorkflow, t.From, t.To)
+		}
+		if w.transitions[t.From] == nil {
+			w.transitions[t.From] = make(map[PostStatus]Transition)
+		}
+		w.transitions[t.From][t.To] = t
+	}
+
+	return w, nil
+}
+
+// DefaultWorkflow returns the editorial workflow used unless a deployment configures its own
+// Each transition asks for the permission of the endpoint that makes it.
+func DefaultWorkflow() *Workflow {
+	w, err := NewWorkflow(
+		[]PostStatus{PostStatusDraft, PostStatusInReview, PostStatusChangesRequested, PostStatusPublished, PostStatusArchived},
+		[]Transition{
+			// Draft can be published directly, sent for review, or archived
+			{From: PostStatusDraft, To: PostStatusPublished, Permission: "posts:publish:own"},
+			{From: PostStatusDraft, To: PostStatusInReview, Permission: "posts:update:own"},
+			{From: PostStatusDraft, To: PostStatusArchived, Permission: "posts:archive:own"},
+			// A reviewer approves (publishes) or sends the post back; the author may withdraw it
+			{From: PostStatusInReview, To: PostStatusPublished, Permission: "posts:review"},
+			{From: PostStatusInReview, To: PostStatusChangesRequested, Permission: "posts:review"},
+			{From: PostStatusInReview, To: PostStatusDraft, Permission: "posts:publish:own"},
+			{From: PostStatusInReview, To: PostStatusArchived, Permission: "posts:archive:own"},
+			// Once revised, the post goes back into review
+			{From: PostStatusChangesRequested, To: PostStatusInReview, Permission: "posts:update:own"},
+			{From: PostStatusChangesRequested, To: PostStatusDraft, Permission: "posts:publish:own"},
+			{From: PostStatusChangesRequested, To: PostStatusArchived, Permission: "posts:archive:own"},
+			// Published can only go to archived
+			{From: PostStatusPublished, To: PostStatusArchived, Permission: "posts:archive:own"},
+			// Archived can go back to draft or published
+			{From: PostStatusArchived, To: PostStatusDraft, Permission: "posts:publish:own"},
+			{From: PostStatusArchived, To: PostStatusPublished, Permission: "posts:publish:own"},
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+	return w
+}
+
+// States returns the workflow's statuses in the order they were defined
+func (w *Workflow) States() []PostStatus {
+	return append([]PostStatus(nil), w.states...)
+}
+
+// Has reports whether the workflow knows a status
+func (w *Workflow) Has(status PostStatus) bool {
+	return w.known[status]
+}
+
+// Transition returns the transition between two statuses, if the workflow allows it
+func (w *Workflow) Transition(from, to PostStatus) (Transition, bool) {
+	t, ok := w.transitions[from][to]
+	return t, ok
+}
+
+// Allows reports whether posts may move from one status to another
+func (w *Workflow) Allows(from, to PostStatus) bool {
+	_, ok := w.Transition(from, to)
+	return ok
+}
+
+// Transitions lists where posts may move from a status
+func (w *Workflow) Transitions(from PostStatus) []Transition {
+	var transitions []Transition
+	for _, to := range w.states {
+		if t, ok := w.transitions[from][to]; ok {
+			transitions = append(transitions, t)
+		}
+	}
+	return transitions
+}
+
+// SplitPermission splits a transition's permission into the resource and
+// action to check, and whether it is checked against the post
+func (t Transition) SplitPermission() (resource, action string, scoped bool) {
+	resource, action, _ = strings.Cut(t.Permission, ":")
+	if trimmed, ok := strings.CutSuffix(action, ":own"); ok {
+		return resource, trimmed, true
+	}
+	return resource, action, false
+}
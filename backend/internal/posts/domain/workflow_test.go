@@ -0,0 +1,85 @@
+package domain_test
+
+import (
+	"testing"
+
+	"backend/internal/posts/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const statusScheduled domain.PostStatus = "scheduled"
+
+func scheduledWorkflow(t *testing.T) *domain.Workflow {
+	t.Helper()
+	workflow, err := domain.NewWorkflow(
+		[]domain.PostStatus{domain.PostStatusDraft, statusScheduled, domain.PostStatusPublished},
+		[]domain.Transition{
+			{From: domain.PostStatusDraft, To: statusScheduled, Permission: "posts:publish:own"},
+			{From: statusScheduled, To: domain.PostStatusPublished, Permission: "posts:publish:any"},
+			{From: statusScheduled, To: domain.PostStatusDraft, Permission: "posts:update:own"},
+			{From: domain.PostStatusPublished, To: domain.PostStatusDraft, Permission: "posts:publish:own"},
+		},
+	)
+	require.NoError(t, err)
+	return workflow
+}
+
+func TestNewWorkflow_Validation(t *testing.T) {
+	states := []domain.PostStatus{domain.PostStatusDraft, domain.PostStatusPublished}
+	publish := domain.Transition{From: domain.PostStatusDraft, To: domain.PostStatusPublished, Permission: "posts:publish:own"}
+
+	tests := []struct {
+		name        string
+		states      []domain.PostStatus
+		transitions []domain.Transition
+	}{
+		{"missing required status", []domain.PostStatus{domain.PostStatusDraft}, nil},
+		{"malformed status", append(states, "In Review"), nil},
+		{"status too long", append(states, "waiting_for_legal_signoff"), nil},
+		{"duplicate status", append(states, domain.PostStatusDraft), nil},
+		{"unknown status", states, []domain.Transition{{From: domain.PostStatusDraft, To: statusScheduled, Permission: "posts:publish:own"}}},
+		{"self transition", states, []domain.Transition{{From: domain.PostStatusDraft, To: domain.PostStatusDraft, Permission: "posts:update:own"}}},
+		{"missing permission", states, []domain.Transition{{From: domain.PostStatusDraft, To: domain.PostStatusPublished}}},
+		{"foreign permission", states, []domain.Transition{{From: domain.PostStatusDraft, To: domain.PostStatusPublished, Permission: "themes:update:own"}}},
+		{"duplicate transition", states, []domain.Transition{publish, publish}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewWorkflow(tt.states, tt.transitions)
+			assert.ErrorIs(t, err, domain.ErrInvalidWorkflow)
+		})
+	}
+}
+
+func TestWorkflow_CustomStatus(t *testing.T) {
+	workflow := scheduledWorkflow(t)
+	post := newDraft(t)
+
+	assert.ErrorIs(t, post.Publish(workflow), domain.ErrInvalidTransition, "drafts must be scheduled first")
+	assert.ErrorIs(t, post.SubmitForReview(workflow), domain.ErrInvalidTransition, "this workflow has no review")
+
+	require.NoError(t, post.TransitionTo(workflow, statusScheduled))
+	assert.Equal(t, statusScheduled, post.Status)
+	assert.Nil(t, post.PublishedAt)
+
+	require.NoError(t, post.Publish(workflow))
+	assert.NotNil(t, post.PublishedAt)
+	require.NoError(t, post.Feature())
+
+	require.NoError(t, post.Unpublish(workflow))
+	assert.Nil(t, post.PublishedAt)
+	assert.False(t, post.Featured, "posts leaving the published state stop being featured")
+}
+
+func TestTransition_SplitPermission(t *testing.T) {
+	resource, action, scoped := domain.Transition{Permission: "posts:publish:own"}.SplitPermission()
+	assert.Equal(t, "posts", resource)
+	assert.Equal(t, "publish", action)
+	assert.True(t, scoped)
+
+	resource, action, scoped = domain.Transition{Permission: "posts:review"}.SplitPermission()
+	assert.Equal(t, "posts", resource)
+	assert.Equal(t, "review", action)
+	assert.False(t, scoped)
+}
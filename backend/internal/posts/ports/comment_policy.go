@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// CommentPolicyProvider answers whether a post accepts a comment right now.
+// This is a driving port: the posts module implements it so a comments module
+// can enforce each post's settings without reading posts itself.
+type CommentPolicyProvider interface {
+	// CheckCanComment returns nil when the post accepts a comment, or an error
+	// explaining the refusal. member is whether the commenter is signed in.
+	CheckCanComment(ctx context.Context, postID uuid.UUID, member bool) error
+}
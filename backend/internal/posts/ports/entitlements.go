@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Entitlements decides which readers may read premium posts in full.
+// This is a driven port: a billing module can answer from subscriptions
+// without the posts module knowing how access is sold.
+type Entitlements interface {
+	CanReadPremium(ctx context.Context, userID uuid.UUID) (bool, error)
+}
@@ -0,0 +1,48 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"backend/internal/posts/domain"
+	"github.com/google/uuid"
+)
+
+// PostLister lists post summaries; both the posts and their read model can
+type PostLister interface {
+	ListSummaries(ctx context.Context, filter ListFilter) ([]*PostSummary, error)
+	Count(ctx context.Context, filter ListFilter) (int, error)
+}
+
+// PublishedPostRepository is the read model of published posts.
+// Anonymous readers are served from it so their listings and post pages skip
+// the joins PostRepository makes. Rows are refreshed from post events, so they
+// trail writes briefly; readers who must see their own changes use PostRepository.
+type PublishedPostRepository interface {
+	// Refresh copies the post into the read model if it is published and
+	// removes it otherwise, including when the post no longer exists
+	Refresh(ctx context.Context, postID uuid.UUID) error
+
+	// FindBySlug retrieves a published post by its current slug
+	// Returns ErrPostNotFound when no published post has the slug
+	FindBySlug(ctx context.Context, slug string) (*domain.Post, error)
+
+	// ListSummaries retrieves summaries of published posts.
+	// ViewerID and Visibility are ignored: every row is visible and none is bookmarked.
+	ListSummaries(ctx context.Context, filter ListFilter) ([]*PostSummary, error)
+
+	// Count returns the number of published posts matching the filter
+	Count(ctx context.Context, filter ListFilter) (int, error)
+
+	// CountByMonth counts the published posts matching the filter by the UTC
+	// month of their publication, newest first. Months without posts are left
+	// out; pagination and sorting are ignored.
+	CountByMonth(ctx context.Context, filter ListFilter) ([]ArchiveMonth, error)
+}
+
+// ArchiveMonth is the number of posts published in one month
+type ArchiveMonth struct {
+	Year  int
+	Month time.Month
+	Count int
+}
@@ -20,16 +20,21 @@ var (
 // PostSummary is a lightweight DTO for list views
 // It contains only the essential fields needed for displaying posts in lists
 type PostSummary struct {
-	ID          uuid.UUID
-	Title       string
-	Slug        string
-	Excerpt     string
-	AuthorID    uuid.UUID
-	AuthorName  string // Joined from users table
-	Status      domain.PostStatus
-	PublishedAt *time.Time
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID            uuid.UUID
+	Title         string
+	Slug          string
+	Excerpt       string
+	AuthorID      uuid.UUID
+	AuthorName    string // Username of the author, copied onto the post
+	Status        domain.PostStatus
+	PublishedAt   *time.Time
+	Featured      bool
+	FeaturedAt    *time.Time
+	Language      string
+	ReactionCount int  // Total reactions on the post, aggregated from the reactions table
+	Bookmarked    bool // Whether the viewer bookmarked the post; false when ListFilter.ViewerID is nil
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
 }
 
 // PostRepository defines the interface for post persistence
@@ -40,10 +45,16 @@ type PostRepository interface {
 	// FindByID retrieves a full post by its ID (includes content)
 	FindByID(ctx context.Context, id uuid.UUID) (*domain.Post, error)
 
+	// FindByIDs retrieves several full posts in one query; unknown IDs are left out
+	FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Post, error)
+
 	// FindBySlug retrieves a full post by its slug (includes content)
 	FindBySlug(ctx context.Context, slug string) (*domain.Post, error)
 
-	// Update modifies an existing post
+	// FindByPreviousSlug retrieves a post by a slug it used before being renamed
+	FindByPreviousSlug(ctx context.Context, slug string) (*domain.Post, error)
+
+	// Update modifies an existing post, keeping a changed slug in the slug history
 	Update(ctx context.Context, post *domain.Post) error
 
 	// Delete removes a post from the database
@@ -63,8 +74,43 @@ type PostRepository interface {
 	// FindSummariesByAuthor retrieves post summaries by a specific author
 	FindSummariesByAuthor(ctx context.Context, authorID uuid.UUID, filter ListFilter) ([]*PostSummary, error)
 
+	// RenameAuthor sets the author name copied onto up to limit of the author's
+	// posts that carry another one, in every blog, and returns how many it changed
+	RenameAuthor(ctx context.Context, authorID uuid.UUID, name string, limit int) (int, error)
+
+	// ListByAuthorInEveryBlog retrieves every post the author wrote, in any
+	// status and in every blog, oldest first, for a copy of the author's data
+	ListByAuthorInEveryBlog(ctx context.Context, authorID uuid.UUID) ([]*domain.Post, error)
+
 	// GetPostAuthor retrieves just the author ID for a post (for ownership checks)
 	GetPostAuthor(ctx context.Context, postID uuid.UUID) (uuid.UUID, error)
+
+	// ListFeed retrieves published posts by authors the follower follows,
+	// newest first, starting after the cursor (nil for the first page)
+	ListFeed(ctx context.Context, followerID uuid.UUID, cursor *FeedCursor, limit int) ([]*PostSummary, error)
+
+	// ListTranslations retrieves every post in a translation group
+	ListTranslations(ctx context.Context, groupID uuid.UUID) ([]*Translation, error)
+
+	// ListCalendar retrieves the posts whose calendar day falls within [from, to], ordered by day:
+	// published posts by their publication day in loc, drafts by their target publish date
+	ListCalendar(ctx context.Context, from, to time.Time, loc *time.Location) ([]domain.CalendarEntry, error)
+}
+
+// Translation is a lightweight reference to one language version of a post
+type Translation struct {
+	PostID   uuid.UUID
+	Language string
+	Title    string
+	Slug     string
+	Status   domain.PostStatus
+}
+
+// FeedCursor marks a position in a feed for keyset pagination
+// It identifies the last post of the previous page by (published_at, id)
+type FeedCursor struct {
+	PublishedAt time.Time
+	PostID      uuid.UUID
 }
 
 // ListFilter contains filtering and pagination options for listing posts
@@ -72,12 +118,39 @@ type ListFilter struct {
 	// Status filters by post status (nil means all statuses)
 	Status *domain.PostStatus
 
+	// IDs restricts the listing to these posts (empty means any post)
+	IDs []uuid.UUID
+
 	// AuthorID filters by author (nil means all authors)
 	AuthorID *uuid.UUID
 
+	// Featured filters by featured flag (nil means featured and non-featured)
+	Featured *bool
+
+	// Language filters by exact language tag (empty means all languages)
+	Language string
+
+	// PreferredLanguage collapses translation groups: when a group has a post in
+	// this language, its other translations are left out of the results
+	PreferredLanguage string
+
+	// ViewerID identifies the requesting user so summaries can report their bookmarks
+	ViewerID *uuid.UUID
+
+	// Visibility limits the results to the posts the viewer may read.
+	// PostsService.ListPosts sets it from ViewerID; whatever the caller passes is replaced.
+	Visibility Visibility
+
 	// SearchQuery for full-text search in title and excerpt
 	SearchQuery string
 
+	// Date ranges, each from After inclusive to Before exclusive (nil leaves that end open).
+	// Posts without a publication time never match a published range.
+	PublishedAfter  *time.Time
+	PublishedBefore *time.Time
+	CreatedAfter    *time.Time
+	CreatedBefore   *time.Time
+
 	// Pagination
 	Limit  int
 	Offset int
@@ -87,6 +160,19 @@ type ListFilter struct {
 	OrderDesc bool
 }
 
+// Visibility describes which posts a listing may include.
+// The zero value admits published public posts out of embargo only.
+type Visibility struct {
+	// AllStatuses admits posts in every status and under any access policy, for readers of any draft
+	AllStatuses bool
+
+	// Member additionally admits members-only posts, for signed-in readers
+	Member bool
+
+	// OwnerID additionally admits every post written by this user
+	OwnerID *uuid.UUID
+}
+
 // OrderField represents the field to order posts by
 type OrderField string
 
@@ -95,6 +181,7 @@ const (
 	OrderByUpdatedAt   OrderField = "updated_at"
 	OrderByPublishedAt OrderField = "published_at"
 	OrderByTitle       OrderField = "title"
+	OrderByFeaturedAt  OrderField = "featured_at"
 )
 
 // DefaultListFilter returns a sensible default filter
@@ -0,0 +1,116 @@
+package seeder
+
+import (
+	"context"
+	"fmt"
+
+	usersSeeder "backend/internal/users/seeder"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Fixed IDs of the published demo posts, so the demo themes can curate them
+var (
+	DemoHexagonalPostID = uuid.MustParse("00000000-0000-0000-0000-00000000e001")
+	DemoEventsPostID    = uuid.MustParse("00000000-0000-0000-0000-00000000e002")
+	DemoCachingPostID   = uuid.MustParse("00000000-0000-0000-0000-00000000e003")
+)
+
+// demoPost is a sample post; drafts have no publication age
+type demoPost struct {
+	ID        uuid.UUID
+	Title     string
+	Slug      string
+	Excerpt   string
+	Content   string
+	Status    string
+	AgeInDays int // Days since publication, for published posts
+}
+
+var demoPosts = []demoPost{
+	{
+		DemoHexagonalPostID, "Ports and Adapters in Practice", "ports-and-adapters-in-practice",
+		"How a blog backend keeps its domain free of HTTP and SQL.",
+		"<p>Each bounded context owns its domain, the ports it needs and the application services that tie them together.</p>",
+		"published", 14,
+	},
+	{
+		DemoEventsPostID, "Domain Events Without a Broker", "domain-events-without-a-broker",
+		"An in-process bus is enough until it is not.",
+		"<p>Publishing events in process decouples modules without adding infrastructure.</p>",
+		"published", 7,
+	},
+	{
+		DemoCachingPostID, "Cache Invalidation by Event", "cache-invalidation-by-event",
+		"Let the writes tell the caches what changed.",
+		"<p>Caches subscribe to the events their entries depend on and drop them when they fire.</p>",
+		"published", 2,
+	},
+	{
+		uuid.MustParse("00000000-0000-0000-0000-00000000e004"), "Notes on Multi-Tenancy", "notes-on-multi-tenancy",
+		"",
+		"<p>Work in progress.</p>",
+		"draft", 0,
+	},
+}
+
+// DemoPostsSeeder creates sample posts on the default blog, written by the demo author
+// It needs the users created by DemoUsersSeeder
+type DemoPostsSeeder struct{}
+
+// NewDemoPostsSeeder creates a new demo posts seeder
+func NewDemoPostsSeeder() *DemoPostsSeeder {
+	return &DemoPostsSeeder{}
+}
+
+// Name returns the name of this seeder
+func (s *DemoPostsSeeder) Name() string {
+	return "DemoPostsSeeder"
+}
+
+// Seed inserts the demo posts, leaving existing rows alone
+func (s *DemoPostsSeeder) Seed(ctx context.Context, db *pgxpool.Pool) error {
+	batch := &pgx.Batch{}
+	for _, post := range demoPosts {
+		batch.Queue(`
+			INSERT INTO posts (id, title, slug, excerpt, content, author_id, author_name, status, published_at)
+			VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6, (SELECT username FROM users WHERE id = $6), $7,
+				CASE WHEN $7 = 'published' THEN NOW() - make_interval(days => $8) END)
+			ON CONFLICT DO NOTHING`,
+			post.ID, post.Title, post.Slug, post.Excerpt, post.Content,
+			usersSeeder.DemoAuthorID, post.Status, post.AgeInDays,
+		)
+	}
+
+	// No events fire for seeded posts, so copy the published ones into their read model here
+	ids := make([]uuid.UUID, len(demoPosts))
+	for i, post := range demoPosts {
+		ids[i] = post.ID
+	}
+	batch.Queue(`
+		INSERT INTO published_posts (
+			post_id, blog_id, title, slug, content, excerpt, author_id, author_name,
+			published_at, featured, featured_at, language, translation_group_id, target_publish_date,
+			meta_title, meta_description, canonical_url, og_image_url,
+			comment_mode, comment_auto_close_days, visibility, embargo_until, premium, created_at, updated_at
+		)
+		SELECT p.id, p.blog_id, p.title, p.slug, p.content, p.excerpt, p.author_id, p.author_name,
+			p.published_at, p.featured, p.featured_at, p.language, p.translation_group_id, p.target_publish_date,
+			p.meta_title, p.meta_description, p.canonical_url, p.og_image_url,
+			p.comment_mode, p.comment_auto_close_days, p.visibility, p.embargo_until, p.premium, p.created_at, p.updated_at
+		FROM posts p
+		WHERE p.id = ANY($1) AND p.status = 'published'
+		ON CONFLICT DO NOTHING`,
+		ids,
+	)
+
+	br := db.SendBatch(ctx, batch)
+	for i := 0; i < batch.Len(); i++ {
+		if _, err := br.Exec(); err != nil {
+			_ = br.Close()
+			return fmt.Errorf("failed to insert demo posts: %w", err)
+		}
+	}
+	return br.Close()
+}
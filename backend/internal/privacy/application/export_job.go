@@ -0,0 +1,40 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"backend/internal/platform/logger"
+	"backend/internal/platform/schedule"
+)
+
+// ExportJobConfig schedules the data export job
+type ExportJobConfig struct {
+	// Interval between runs; zero or less disables the job
+	Interval time.Duration
+}
+
+// ExportJob builds queued data exports and deletes expired ones on a schedule
+type ExportJob struct {
+	service *DataExportService
+	config  ExportJobConfig
+	logger  logger.Logger
+}
+
+// NewExportJob creates the scheduled data export job
+func NewExportJob(service *DataExportService, config ExportJobConfig, logger logger.Logger) *ExportJob {
+	return &ExportJob{
+		service: service,
+		config:  config,
+		logger:  logger,
+	}
+}
+
+// Run blocks until ctx is done, working through the queue once per interval
+func (j *ExportJob) Run(ctx context.Context) {
+	schedule.Every(ctx, j.config.Interval, func(ctx context.Context) {
+		if err := j.service.RunPending(ctx); err != nil && ctx.Err() == nil {
+			j.logger.Error(ctx, "data export job failed", "error", err)
+		}
+	})
+}
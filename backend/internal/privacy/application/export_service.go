@@ -0,0 +1,301 @@
+package application
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/dataexport"
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/events"
+	"backend/internal/platform/logger"
+	"backend/internal/privacy/domain"
+	"backend/internal/privacy/ports"
+	"github.com/google/uuid"
+)
+
+// Data export errors
+var (
+	ErrExportNotFound = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodeDataExportNotFound,
+		"data export not found",
+		http.StatusNotFound,
+	)
+
+	ErrExportPending = apperror.New(
+		apperror.CodeConflict,
+		apperror.BusinessCodeDataExportPending,
+		"a data export is already being prepared",
+		http.StatusConflict,
+	)
+
+	ErrExportNotReady = apperror.New(
+		apperror.CodeConflict,
+		apperror.BusinessCodeDataExportNotReady,
+		"data export is not ready to download",
+		http.StatusConflict,
+	)
+
+	ErrExportExpired = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodeDataExportExpired,
+		"data export has expired; request a new one",
+		http.StatusGone,
+	)
+)
+
+// exportFormatVersion identifies the archive layout so readers can detect changes
+const exportFormatVersion = 1
+
+// exportBatchSize bounds the exports one run of the job builds
+const exportBatchSize = 10
+
+// ExportConfig holds data export settings
+type ExportConfig struct {
+	// ArchiveTTL is how long a finished archive can be downloaded before it is deleted
+	ArchiveTTL time.Duration
+}
+
+// ExportManifest summarizes a data export archive
+type ExportManifest struct {
+	FormatVersion int       `json:"formatVersion"`
+	ExportID      uuid.UUID `json:"exportId"`
+	UserID        uuid.UUID `json:"userId"`
+	ExportedAt    time.Time `json:"exportedAt"`
+	Sections      []string  `json:"sections"` // Files holding the data, one per exporter
+}
+
+// DataExportService handles users' requests for a copy of their personal
+// data. The scheduled job gathers it from every registered exporter into a
+// zip archive, tells the user it is ready, and deletes it once it expires.
+type DataExportService struct {
+	repo      ports.DataExportRepository
+	exporters dataexport.Registry
+	eventBus  *eventbus.Bus
+	config    ExportConfig
+	logger    logger.Logger
+}
+
+// NewDataExportService creates a new data export service
+func NewDataExportService(
+	repo ports.DataExportRepository,
+	exporters dataexport.Registry,
+	eventBus *eventbus.Bus,
+	config ExportConfig,
+	logger logger.Logger,
+) *DataExportService {
+	return &DataExportService{
+		repo:      repo,
+		exporters: exporters,
+		eventBus:  eventBus,
+		config:    config,
+		logger:    logger,
+	}
+}
+
+// RequestExport queues an export of the actor's own data
+func (s *DataExportService) RequestExport(ctx context.Context, actorID uuid.UUID) (*domain.Export, error) {
+	export := domain.NewExport(actorID)
+	if err := s.repo.Create(ctx, export); err != nil {
+		switch {
+		case errors.Is(err, ports.ErrExportPending):
+			return nil, ErrExportPending
+		case errors.Is(err, ports.ErrSubjectNotFound):
+			return nil, ErrSubjectNotFound
+		}
+		s.logger.Error(ctx, "failed to save data export", "error", err, "userID", actorID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to request data export",
+			http.StatusInternalServerError,
+		)
+	}
+
+	s.logger.Info(ctx, "data export requested", "exportID", export.ID, "userID", actorID)
+	return export, nil
+}
+
+// GetOwnExport retrieves the actor's most recent export
+func (s *DataExportService) GetOwnExport(ctx context.Context, actorID uuid.UUID) (*domain.Export, error) {
+	export, err := s.repo.FindLatestByUser(ctx, actorID)
+	if err != nil {
+		if errors.Is(err, ports.ErrExportNotFound) {
+			return nil, ErrExportNotFound
+		}
+		s.logger.Error(ctx, "failed to find data export", "error", err, "userID", actorID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to retrieve data export",
+			http.StatusInternalServerError,
+		)
+	}
+	return export, nil
+}
+
+// GetArchive retrieves the archive of one of the actor's exports while it can
+// be downloaded. Other users' exports are reported as not found.
+func (s *DataExportService) GetArchive(ctx context.Context, actorID uuid.UUID, exportID uuid.UUID) (*domain.Export, []byte, error) {
+	export, err := s.repo.FindByID(ctx, exportID)
+	if err != nil && !errors.Is(err, ports.ErrExportNotFound) {
+		s.logger.Error(ctx, "failed to find data export", "error", err, "exportID", exportID)
+		return nil, nil, s.archiveFailed()
+	}
+	if err != nil || export.UserID != actorID {
+		return nil, nil, ErrExportNotFound
+	}
+
+	if err := export.CheckDownloadable(time.Now()); err != nil {
+		if errors.Is(err, domain.ErrExportExpired) {
+			return nil, nil, ErrExportExpired
+		}
+		return nil, nil, ErrExportNotReady
+	}
+
+	archive, err := s.repo.FindArchive(ctx, exportID)
+	if err != nil {
+		if errors.Is(err, ports.ErrExportNotFound) {
+			// Expired between the two reads
+			return nil, nil, ErrExportExpired
+		}
+		s.logger.Error(ctx, "failed to read data export archive", "error", err, "exportID", exportID)
+		return nil, nil, s.archiveFailed()
+	}
+
+	s.logger.Info(ctx, "data export downloaded", "exportID", exportID, "userID", actorID)
+	return export, archive, nil
+}
+
+// RunPending deletes expired archives and builds the queued exports, as the
+// scheduled job does. An export that fails is marked so and does not stop the others.
+func (s *DataExportService) RunPending(ctx context.Context) error {
+	expired, err := s.repo.ExpireArchives(ctx, time.Now())
+	if err != nil {
+		s.logger.Error(ctx, "failed to delete expired data export archives", "error", err)
+		return err
+	}
+	if expired > 0 {
+		s.logger.Info(ctx, "deleted expired data export archives", "count", expired)
+	}
+
+	exports, err := s.repo.ListPending(ctx, exportBatchSize)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list data exports to build", "error", err)
+		return err
+	}
+
+	for _, export := range exports {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := s.build(ctx, export); err != nil && ctx.Err() == nil {
+			s.logger.Error(ctx, "data export failed", "error", err, "exportID", export.ID)
+		}
+	}
+	return nil
+}
+
+// Private helper methods
+
+// build gathers the data of every exporter into the export's archive and
+// tells the user it is ready. The first exporter to fail marks the export failed.
+func (s *DataExportService) build(ctx context.Context, export *domain.Export) error {
+	manifest := ExportManifest{
+		FormatVersion: exportFormatVersion,
+		ExportID:      export.ID,
+		UserID:        export.UserID,
+		ExportedAt:    time.Now().UTC(),
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for _, section := range s.exporters.Sections() {
+		data, err := section.Exporter.Export(ctx, export.UserID)
+		if err != nil {
+			if ctx.Err() != nil {
+				// Shutting down; the export stays pending and is built next run
+				return err
+			}
+			return s.fail(ctx, export, section.Name, err)
+		}
+
+		name := domain.ExportSectionFile(section.Name)
+		if err := writeArchiveJSON(archive, name, manifest.ExportedAt, data); err != nil {
+			return s.fail(ctx, export, section.Name, err)
+		}
+		manifest.Sections = append(manifest.Sections, name)
+	}
+	if err := writeArchiveJSON(archive, domain.ExportManifestFile, manifest.ExportedAt, manifest); err != nil {
+		return s.fail(ctx, export, "manifest", err)
+	}
+	if err := archive.Close(); err != nil {
+		return s.fail(ctx, export, "archive", err)
+	}
+
+	if err := export.Finish(int64(buf.Len()), time.Now(), s.config.ArchiveTTL); err != nil {
+		return err
+	}
+	if err := s.repo.Update(ctx, export, buf.Bytes()); err != nil {
+		return err
+	}
+
+	s.eventBus.Publish(ctx, eventbus.Event{
+		Topic: events.DataExportReadyTopic,
+		Payload: events.DataExportReadyEvent{
+			ExportID:   export.ID,
+			UserID:     export.UserID,
+			ExpiresAt:  *export.ExpiresAt,
+			OccurredAt: time.Now(),
+		},
+	})
+
+	s.logger.Info(ctx, "data export ready", "exportID", export.ID, "sections", len(manifest.Sections), "bytes", export.Size)
+	return nil
+}
+
+// fail records why an export's archive could not be built
+func (s *DataExportService) fail(ctx context.Context, export *domain.Export, section string, cause error) error {
+	export.Fail(section+": "+cause.Error(), time.Now())
+	if err := s.repo.Update(ctx, export, nil); err != nil {
+		s.logger.Error(ctx, "failed to mark data export failed", "error", err, "exportID", export.ID)
+	}
+	return cause
+}
+
+// archiveFailed is returned when an archive cannot be read
+func (s *DataExportService) archiveFailed() error {
+	return apperror.New(
+		apperror.CodeInternalError,
+		apperror.BusinessCodeGeneral,
+		"failed to retrieve data export",
+		http.StatusInternalServerError,
+	)
+}
+
+// writeArchiveJSON adds an indented JSON file to the archive
+func writeArchiveJSON(archive *zip.Writer, name string, modified time.Time, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode %s: %w", name, err)
+	}
+	f, err := archive.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: modified,
+	})
+	if err != nil {
+		return fmt.Errorf("create %s: %w", name, err)
+	}
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("write %s: %w", name, err)
+	}
+	return nil
+}
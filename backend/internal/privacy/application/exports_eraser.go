@@ -0,0 +1,30 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"backend/internal/platform/erasure"
+	"backend/internal/privacy/ports"
+)
+
+// ExportsEraser deletes the copies of a user's data made for them, which
+// would otherwise keep what every other eraser removed
+type ExportsEraser struct {
+	repo ports.DataExportRepository
+}
+
+// NewExportsEraser creates a new data exports eraser
+func NewExportsEraser(repo ports.DataExportRepository) *ExportsEraser {
+	return &ExportsEraser{repo: repo}
+}
+
+// Erase deletes the subject's data exports with their archives
+// Implements the erasure.Eraser interface
+func (e *ExportsEraser) Erase(ctx context.Context, subject erasure.Subject) (int, error) {
+	n, err := e.repo.DeleteByUser(ctx, subject.UserID)
+	if err != nil {
+		return 0, fmt.Errorf("ExportsEraser.Erase: %w", err)
+	}
+	return n, nil
+}
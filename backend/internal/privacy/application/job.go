@@ -0,0 +1,40 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"backend/internal/platform/logger"
+	"backend/internal/platform/schedule"
+)
+
+// JobConfig schedules the erasure job
+type JobConfig struct {
+	// Interval between runs; zero or less disables the job
+	Interval time.Duration
+}
+
+// Job carries out queued erasure requests on a schedule
+type Job struct {
+	service *ErasureService
+	config  JobConfig
+	logger  logger.Logger
+}
+
+// NewJob creates the scheduled erasure job
+func NewJob(service *ErasureService, config JobConfig, logger logger.Logger) *Job {
+	return &Job{
+		service: service,
+		config:  config,
+		logger:  logger,
+	}
+}
+
+// Run blocks until ctx is done, working through the queue once per interval
+func (j *Job) Run(ctx context.Context) {
+	schedule.Every(ctx, j.config.Interval, func(ctx context.Context) {
+		if err := j.service.RunPending(ctx); err != nil && ctx.Err() == nil {
+			j.logger.Error(ctx, "erasure job failed", "error", err)
+		}
+	})
+}
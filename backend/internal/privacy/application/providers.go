@@ -0,0 +1,12 @@
+package application
+
+import "github.com/google/wire"
+
+// ProviderSet is the wire provider set for the privacy application layer
+var ProviderSet = wire.NewSet(
+	NewErasureService,
+	NewJob,
+	NewDataExportService,
+	NewExportJob,
+	NewExportsEraser,
+)
@@ -0,0 +1,370 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/erasure"
+	"backend/internal/platform/logger"
+	"backend/internal/platform/postgres"
+	"backend/internal/privacy/domain"
+	"backend/internal/privacy/ports"
+	"github.com/google/uuid"
+)
+
+// Error definitions for service operations
+var (
+	ErrRequestNotFound = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodeErasureRequestNotFound,
+		"erasure request not found",
+		http.StatusNotFound,
+	)
+
+	ErrSubjectNotFound = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodeUserNotFound,
+		"user not found",
+		http.StatusNotFound,
+	)
+
+	ErrRequestOpen = apperror.New(
+		apperror.CodeConflict,
+		apperror.BusinessCodeErasureRequestOpen,
+		"an erasure request for this user is already open",
+		http.StatusConflict,
+	)
+
+	ErrNotRetryable = apperror.New(
+		apperror.CodeConflict,
+		apperror.BusinessCodeErasureNotRetryable,
+		"only failed erasure requests can be retried",
+		http.StatusConflict,
+	)
+
+	ErrNotCompleted = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodeErasureNotCompleted,
+		"erasure request has not completed, so it has no certificate",
+		http.StatusNotFound,
+	)
+)
+
+// Request listing page sizes
+const (
+	DefaultListLimit = 50
+	MaxListLimit     = 200
+)
+
+// runBatchSize bounds the requests one run of the job works through
+const runBatchSize = 20
+
+// ErasureService handles requests to erase a user's personal data. Users
+// may ask for their own erasure; admins may ask on anyone's behalf. The
+// scheduled job then runs every registered eraser for each request and
+// issues a certificate once all of them have finished.
+type ErasureService struct {
+	uow        postgres.UnitOfWork
+	repo       ports.ErasureRepository
+	authorizer ports.Authorizer
+	erasers    erasure.Registry
+	logger     logger.Logger
+}
+
+// NewErasureService creates a new erasure service
+func NewErasureService(
+	uow postgres.UnitOfWork,
+	repo ports.ErasureRepository,
+	authorizer ports.Authorizer,
+	erasers erasure.Registry,
+	logger logger.Logger,
+) *ErasureService {
+	return &ErasureService{
+		uow:        uow,
+		repo:       repo,
+		authorizer: authorizer,
+		erasers:    erasers,
+		logger:     logger,
+	}
+}
+
+// RequestErasure queues the erasure of a subject's data. Actors may request
+// their own erasure; requesting another user's takes users:delete:any.
+func (s *ErasureService) RequestErasure(ctx context.Context, actorID uuid.UUID, subjectID uuid.UUID) (*domain.Request, error) {
+	if subjectID != actorID {
+		if err := s.checkCanEraseAny(ctx, actorID); err != nil {
+			return nil, err
+		}
+	}
+
+	request, err := domain.NewRequest(subjectID, actorID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to create erasure request", "error", err, "subjectID", subjectID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to create erasure request",
+			http.StatusInternalServerError,
+		)
+	}
+
+	if err := s.repo.Create(ctx, request); err != nil {
+		switch {
+		case errors.Is(err, ports.ErrSubjectNotFound):
+			return nil, ErrSubjectNotFound
+		case errors.Is(err, ports.ErrRequestOpen):
+			return nil, ErrRequestOpen
+		}
+		s.logger.Error(ctx, "failed to save erasure request", "error", err, "subjectID", subjectID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to create erasure request",
+			http.StatusInternalServerError,
+		)
+	}
+
+	s.logger.Info(ctx, "erasure requested", "requestID", request.ID, "subjectID", subjectID, "requestedBy", actorID)
+	return request, nil
+}
+
+// GetOwnRequest retrieves the actor's most recent erasure request
+func (s *ErasureService) GetOwnRequest(ctx context.Context, actorID uuid.UUID) (*domain.Request, error) {
+	request, err := s.repo.FindLatestBySubject(ctx, actorID)
+	if err != nil {
+		if errors.Is(err, ports.ErrRequestNotFound) {
+			return nil, ErrRequestNotFound
+		}
+		s.logger.Error(ctx, "failed to find erasure request", "error", err, "subjectID", actorID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to retrieve erasure request",
+			http.StatusInternalServerError,
+		)
+	}
+	return request, nil
+}
+
+// GetRequest retrieves any erasure request with its progress
+func (s *ErasureService) GetRequest(ctx context.Context, actorID uuid.UUID, requestID uuid.UUID) (*domain.Request, error) {
+	if err := s.checkCanEraseAny(ctx, actorID); err != nil {
+		return nil, err
+	}
+	return s.getRequest(ctx, requestID)
+}
+
+// ListRequests retrieves erasure requests, newest first. A limit of zero or
+// less uses DefaultListLimit; larger ones are capped at MaxListLimit.
+func (s *ErasureService) ListRequests(ctx context.Context, actorID uuid.UUID, filter ports.ListFilter) ([]*domain.Request, error) {
+	if err := s.checkCanEraseAny(ctx, actorID); err != nil {
+		return nil, err
+	}
+	if filter.Status != "" && !filter.Status.Valid() {
+		return nil, apperror.New(
+			apperror.CodeValidationFailed,
+			apperror.BusinessCodeInvalidFormat,
+			"unknown erasure request status",
+			http.StatusBadRequest,
+		)
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultListLimit
+	}
+	filter.Limit = min(filter.Limit, MaxListLimit)
+	filter.Offset = max(filter.Offset, 0)
+
+	requests, err := s.repo.List(ctx, filter)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list erasure requests", "error", err)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to retrieve erasure requests",
+			http.StatusInternalServerError,
+		)
+	}
+	return requests, nil
+}
+
+// RetryRequest puts a failed request back in the queue; the job resumes it
+// with the erasers that had not finished
+func (s *ErasureService) RetryRequest(ctx context.Context, actorID uuid.UUID, requestID uuid.UUID) (*domain.Request, error) {
+	if err := s.checkCanEraseAny(ctx, actorID); err != nil {
+		return nil, err
+	}
+	request, err := s.getRequest(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := request.Retry(); err != nil {
+		return nil, ErrNotRetryable
+	}
+	if err := s.repo.Update(ctx, request); err != nil {
+		s.logger.Error(ctx, "failed to retry erasure request", "error", err, "requestID", requestID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to retry erasure request",
+			http.StatusInternalServerError,
+		)
+	}
+
+	s.logger.Info(ctx, "erasure request retried", "requestID", requestID, "actorID", actorID)
+	return request, nil
+}
+
+// GetCertificate retrieves the certificate of a completed request
+func (s *ErasureService) GetCertificate(ctx context.Context, actorID uuid.UUID, requestID uuid.UUID) (*domain.Certificate, error) {
+	if err := s.checkCanEraseAny(ctx, actorID); err != nil {
+		return nil, err
+	}
+
+	certificate, err := s.repo.FindCertificate(ctx, requestID)
+	if err != nil {
+		if errors.Is(err, ports.ErrCertificateNotFound) {
+			// Tell a missing request apart from one still in progress
+			if _, err := s.getRequest(ctx, requestID); err != nil {
+				return nil, err
+			}
+			return nil, ErrNotCompleted
+		}
+		s.logger.Error(ctx, "failed to find erasure certificate", "error", err, "requestID", requestID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to retrieve erasure certificate",
+			http.StatusInternalServerError,
+		)
+	}
+	return certificate, nil
+}
+
+// RunPending works through the queued requests, as the scheduled job does.
+// A request that fails is marked so and does not stop the others.
+func (s *ErasureService) RunPending(ctx context.Context) error {
+	requests, err := s.repo.ListRunnable(ctx, runBatchSize)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list erasure requests to run", "error", err)
+		return err
+	}
+
+	for _, request := range requests {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := s.run(ctx, request); err != nil && ctx.Err() == nil {
+			s.logger.Error(ctx, "erasure request failed", "error", err, "requestID", request.ID)
+		}
+	}
+	return nil
+}
+
+// Private helper methods
+
+// run executes the erasers a request has not finished, recording each one
+// as it finishes, and issues the certificate once none is left. The first
+// eraser to fail stops the run and marks the request failed.
+func (s *ErasureService) run(ctx context.Context, request *domain.Request) error {
+	steps := s.erasers.Steps()
+	if err := request.Start(len(steps), time.Now()); err != nil {
+		return err
+	}
+	if err := s.repo.Update(ctx, request); err != nil {
+		return err
+	}
+
+	subject := erasure.Subject{UserID: request.SubjectID, Pseudonym: request.Pseudonym}
+	names := make([]string, 0, len(steps))
+	for _, step := range steps {
+		names = append(names, step.Name)
+		if request.Done(step.Name) {
+			continue
+		}
+
+		records, err := step.Eraser.Erase(ctx, subject)
+		if err != nil {
+			if ctx.Err() != nil {
+				// Shutting down; the request stays running and is resumed next run
+				return err
+			}
+			return s.fail(ctx, request, step.Name, err)
+		}
+		done := request.CompleteStep(step.Name, records, time.Now())
+		if err := s.repo.RecordStep(ctx, request.ID, done); err != nil {
+			return s.fail(ctx, request, step.Name, err)
+		}
+		s.logger.Info(ctx, "eraser finished", "requestID", request.ID, "eraser", step.Name, "records", records)
+	}
+
+	certificate, err := request.Complete(names, time.Now())
+	if err != nil {
+		return err
+	}
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		if err := s.repo.SaveCertificate(ctx, certificate); err != nil {
+			return err
+		}
+		return s.repo.Update(ctx, request)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info(ctx, "erasure completed", "requestID", request.ID, "certificateID", certificate.ID, "erasers", len(names))
+	return nil
+}
+
+// fail records why a request's run stopped at an eraser
+func (s *ErasureService) fail(ctx context.Context, request *domain.Request, eraser string, cause error) error {
+	request.Fail(eraser + ": " + cause.Error())
+	if err := s.repo.Update(ctx, request); err != nil {
+		s.logger.Error(ctx, "failed to mark erasure request failed", "error", err, "requestID", request.ID)
+	}
+	return cause
+}
+
+// getRequest loads a request
+func (s *ErasureService) getRequest(ctx context.Context, requestID uuid.UUID) (*domain.Request, error) {
+	request, err := s.repo.FindByID(ctx, requestID)
+	if err != nil {
+		if errors.Is(err, ports.ErrRequestNotFound) {
+			return nil, ErrRequestNotFound
+		}
+		s.logger.Error(ctx, "failed to find erasure request", "error", err, "requestID", requestID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to retrieve erasure request",
+			http.StatusInternalServerError,
+		)
+	}
+	return request, nil
+}
+
+// checkCanEraseAny verifies the actor may erase and oversee the erasure of any user's data
+func (s *ErasureService) checkCanEraseAny(ctx context.Context, actorID uuid.UUID) error {
+	canErase, err := s.authorizer.Can(ctx, actorID, "users", "delete:any", nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canErase {
+		return apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to erase other users' data",
+			http.StatusForbidden,
+		)
+	}
+	return nil
+}
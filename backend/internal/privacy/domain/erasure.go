@@ -0,0 +1,213 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PseudonymPrefix starts the username an erased user is renamed to
+const PseudonymPrefix = "erased-"
+
+// digestTimeLayout writes times to microseconds, the precision they are stored
+// at, so a certificate read back from the database verifies
+const digestTimeLayout = "2006-01-02T15:04:05.000000Z"
+
+// Status is where an erasure request is in its lifecycle
+type Status string
+
+const (
+	StatusPending   Status = "pending"   // Waiting for the erasure job
+	StatusRunning   Status = "running"   // Picked up by the job; some erasers may have finished
+	StatusCompleted Status = "completed" // Every eraser finished and a certificate was issued
+	StatusFailed    Status = "failed"    // An eraser failed; retrying resumes where it stopped
+)
+
+// Valid reports whether s is a known status
+func (s Status) Valid() bool {
+	switch s {
+	case StatusPending, StatusRunning, StatusCompleted, StatusFailed:
+		return true
+	}
+	return false
+}
+
+// Domain errors
+var (
+	ErrNotRetryable      = errors.New("only failed erasure requests can be retried")
+	ErrAlreadyCompleted  = errors.New("erasure request has already completed")
+	ErrStepsOutstanding  = errors.New("erasure request still has erasers to run")
+	ErrCertificateDigest = errors.New("erasure certificate does not match its digest")
+)
+
+// Step records an eraser that has finished for a request, so a request
+// interrupted part way resumes with the erasers that have not
+type Step struct {
+	Name        string
+	Records     int // How many records the eraser changed
+	CompletedAt time.Time
+}
+
+// Request asks for a user's personal data to be erased from every context
+// holding it. The user's ID stays as the subject: records keep pointing at
+// the account, which no longer says who it belonged to.
+type Request struct {
+	ID          uuid.UUID
+	SubjectID   uuid.UUID
+	RequestedBy uuid.UUID // The subject, or an admin acting on their behalf
+	Pseudonym   string    // Username the subject is renamed to
+	Status      Status
+	TotalSteps  int // Erasers registered when the request was last started
+	Steps       []Step
+	LastError   string // Why the last run failed, cleared on retry
+	CreatedAt   time.Time
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+}
+
+// NewRequest creates a pending request to erase a subject's data
+func NewRequest(subjectID, requestedBy uuid.UUID) (*Request, error) {
+	pseudonym, err := newPseudonym()
+	if err != nil {
+		return nil, err
+	}
+	return &Request{
+		ID:          uuid.New(),
+		SubjectID:   subjectID,
+		RequestedBy: requestedBy,
+		Pseudonym:   pseudonym,
+		Status:      StatusPending,
+		CreatedAt:   time.Now(),
+	}, nil
+}
+
+// Done reports whether the named eraser has already finished for the request
+func (r *Request) Done(name string) bool {
+	for _, step := range r.Steps {
+		if step.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Start marks the request running with totalSteps erasers to get through
+func (r *Request) Start(totalSteps int, now time.Time) error {
+	if r.Status == StatusCompleted {
+		return ErrAlreadyCompleted
+	}
+	r.Status = StatusRunning
+	r.TotalSteps = totalSteps
+	if r.StartedAt == nil {
+		r.StartedAt = &now
+	}
+	return nil
+}
+
+// CompleteStep records that the named eraser finished, changing records records
+func (r *Request) CompleteStep(name string, records int, now time.Time) Step {
+	step := Step{Name: name, Records: records, CompletedAt: now}
+	r.Steps = append(r.Steps, step)
+	return step
+}
+
+// Fail marks the request failed with the reason its run stopped
+func (r *Request) Fail(reason string) {
+	r.Status = StatusFailed
+	r.LastError = reason
+}
+
+// Retry puts a failed request back in the queue
+func (r *Request) Retry() error {
+	if r.Status != StatusFailed {
+		return ErrNotRetryable
+	}
+	r.Status = StatusPending
+	r.LastError = ""
+	return nil
+}
+
+// Complete marks the request completed and issues its certificate. Every
+// one of stepNames, the erasers registered now, must have finished.
+func (r *Request) Complete(stepNames []string, now time.Time) (*Certificate, error) {
+	if r.Status == StatusCompleted {
+		return nil, ErrAlreadyCompleted
+	}
+	for _, name := range stepNames {
+		if !r.Done(name) {
+			return nil, fmt.Errorf("%w: %s", ErrStepsOutstanding, name)
+		}
+	}
+
+	r.Status = StatusCompleted
+	r.CompletedAt = &now
+	certificate := &Certificate{
+		ID:          uuid.New(),
+		RequestID:   r.ID,
+		SubjectID:   r.SubjectID,
+		Steps:       append([]Step(nil), r.Steps...),
+		RequestedAt: r.CreatedAt,
+		CompletedAt: now,
+	}
+	certificate.Digest = certificate.ComputeDigest()
+	return certificate, nil
+}
+
+// Progress returns how many erasers have finished out of how many there are
+func (r *Request) Progress() (done, total int) {
+	total = r.TotalSteps
+	if len(r.Steps) > total {
+		total = len(r.Steps)
+	}
+	return len(r.Steps), total
+}
+
+// Certificate records that a subject's data was erased: which erasers ran,
+// what they changed and when. It names the subject only by ID, which no
+// longer leads to anything personal. The digest covers every other field,
+// so a certificate altered after it was issued can be told apart.
+type Certificate struct {
+	ID          uuid.UUID
+	RequestID   uuid.UUID
+	SubjectID   uuid.UUID
+	Steps       []Step
+	RequestedAt time.Time
+	CompletedAt time.Time
+	Digest      string // SHA-256 of the certificate's contents, hex encoded
+}
+
+// ComputeDigest hashes the certificate's contents in a fixed layout
+func (c *Certificate) ComputeDigest() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "certificate:%s\nrequest:%s\nsubject:%s\nrequested:%s\ncompleted:%s\n",
+		c.ID, c.RequestID, c.SubjectID,
+		c.RequestedAt.UTC().Format(digestTimeLayout), c.CompletedAt.UTC().Format(digestTimeLayout))
+	for _, step := range c.Steps {
+		fmt.Fprintf(&b, "step:%s:%d:%s\n", step.Name, step.Records, step.CompletedAt.UTC().Format(digestTimeLayout))
+	}
+	sum := sha256.Sum256([]byte(b.String()))
+	return hex.EncodeToString(sum[:])
+}
+
+// Verify checks the certificate against its digest
+func (c *Certificate) Verify() error {
+	if c.ComputeDigest() != c.Digest {
+		return ErrCertificateDigest
+	}
+	return nil
+}
+
+// newPseudonym generates a username no one has, that fits the username rules
+func newPseudonym() (string, error) {
+	b := make([]byte, 11)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate pseudonym: %w", err)
+	}
+	return PseudonymPrefix + hex.EncodeToString(b), nil
+}
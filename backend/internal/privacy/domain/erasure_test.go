@@ -0,0 +1,84 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"backend/internal/privacy/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRequest(t *testing.T) {
+	subject, admin := uuid.New(), uuid.New()
+
+	request, err := domain.NewRequest(subject, admin)
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusPending, request.Status)
+	assert.True(t, strings.HasPrefix(request.Pseudonym, domain.PseudonymPrefix))
+	assert.LessOrEqual(t, len(request.Pseudonym), 30, "pseudonyms fit the username column")
+
+	other, err := domain.NewRequest(subject, admin)
+	require.NoError(t, err)
+	assert.NotEqual(t, request.Pseudonym, other.Pseudonym)
+}
+
+func TestRequest_Lifecycle(t *testing.T) {
+	request, err := domain.NewRequest(uuid.New(), uuid.New())
+	require.NoError(t, err)
+	now := time.Now()
+	steps := []string{"users", "posts"}
+
+	require.NoError(t, request.Start(len(steps), now))
+	request.CompleteStep("users", 1, now)
+	request.Fail("posts: connection reset")
+	done, total := request.Progress()
+	assert.Equal(t, 1, done)
+	assert.Equal(t, 2, total)
+
+	_, err = request.Complete(steps, now)
+	assert.ErrorIs(t, err, domain.ErrStepsOutstanding)
+
+	require.NoError(t, request.Retry())
+	assert.Equal(t, domain.StatusPending, request.Status)
+	assert.Empty(t, request.LastError)
+	assert.ErrorIs(t, request.Retry(), domain.ErrNotRetryable)
+
+	require.NoError(t, request.Start(len(steps), now.Add(time.Minute)))
+	assert.Equal(t, now, *request.StartedAt, "a resumed request keeps its first start")
+	assert.True(t, request.Done("users"))
+	assert.False(t, request.Done("posts"))
+	request.CompleteStep("posts", 12, now)
+
+	certificate, err := request.Complete(steps, now)
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusCompleted, request.Status)
+	assert.Equal(t, request.SubjectID, certificate.SubjectID)
+	assert.Len(t, certificate.Steps, 2)
+
+	_, err = request.Complete(steps, now)
+	assert.ErrorIs(t, err, domain.ErrAlreadyCompleted)
+	assert.ErrorIs(t, request.Start(2, now), domain.ErrAlreadyCompleted)
+}
+
+func TestCertificate_Verify(t *testing.T) {
+	request, err := domain.NewRequest(uuid.New(), uuid.New())
+	require.NoError(t, err)
+	now := time.Now()
+	require.NoError(t, request.Start(1, now))
+	request.CompleteStep("users", 1, now)
+	certificate, err := request.Complete([]string{"users"}, now)
+	require.NoError(t, err)
+	require.NoError(t, certificate.Verify())
+
+	// Storage keeps microseconds; the digest is unaffected
+	stored := *certificate
+	stored.CompletedAt = stored.CompletedAt.Truncate(time.Microsecond)
+	assert.NoError(t, stored.Verify())
+
+	tampered := *certificate
+	tampered.Steps = []domain.Step{{Name: "users", Records: 0, CompletedAt: now}}
+	assert.ErrorIs(t, tampered.Verify(), domain.ErrCertificateDigest)
+}
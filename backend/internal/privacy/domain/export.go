@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ExportManifestFile summarizes a data export archive; each exporter's
+// section sits beside it in a file of its own, named by ExportSectionFile
+const ExportManifestFile = "manifest.json"
+
+// ExportSectionFile is the file an exporter's section is written to
+func ExportSectionFile(name string) string {
+	return name + ".json"
+}
+
+// ExportStatus is where a data export is in its lifecycle
+type ExportStatus string
+
+const (
+	ExportPending ExportStatus = "pending" // Waiting for the export job
+	ExportReady   ExportStatus = "ready"   // Built and downloadable until it expires
+	ExportFailed  ExportStatus = "failed"  // An exporter failed; the user may ask again
+	ExportExpired ExportStatus = "expired" // The archive has been deleted
+)
+
+// Domain errors
+var (
+	ErrExportNotPending = errors.New("data export has already been built or has failed")
+	ErrExportNotReady   = errors.New("data export is not ready")
+	ErrExportExpired    = errors.New("data export has expired")
+)
+
+// Export is a user's request for a copy of their personal data. The export
+// job builds the archive; it can then be downloaded until ExpiresAt, after
+// which it is deleted.
+type Export struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	Status      ExportStatus
+	Size        int64  // Bytes in the archive once ready
+	LastError   string // Why building the archive failed
+	CreatedAt   time.Time
+	CompletedAt *time.Time
+	ExpiresAt   *time.Time
+}
+
+// NewExport creates a pending export of a user's data
+func NewExport(userID uuid.UUID) *Export {
+	return &Export{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Status:    ExportPending,
+		CreatedAt: time.Now(),
+	}
+}
+
+// Finish marks the export ready with an archive of size bytes, kept for ttl
+func (e *Export) Finish(size int64, now time.Time, ttl time.Duration) error {
+	if e.Status != ExportPending {
+		return ErrExportNotPending
+	}
+	expiresAt := now.Add(ttl)
+	e.Status = ExportReady
+	e.Size = size
+	e.CompletedAt = &now
+	e.ExpiresAt = &expiresAt
+	return nil
+}
+
+// Fail marks the export failed with the reason its archive was not built
+func (e *Export) Fail(reason string, now time.Time) {
+	e.Status = ExportFailed
+	e.LastError = reason
+	e.CompletedAt = &now
+}
+
+// CheckDownloadable reports why the archive cannot be downloaded at now, if it cannot
+func (e *Export) CheckDownloadable(now time.Time) error {
+	switch {
+	case e.Status == ExportExpired:
+		return ErrExportExpired
+	case e.Status != ExportReady:
+		return ErrExportNotReady
+	case !now.Before(*e.ExpiresAt):
+		return ErrExportExpired
+	}
+	return nil
+}
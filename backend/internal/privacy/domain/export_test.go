@@ -0,0 +1,40 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"backend/internal/privacy/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExport_Lifecycle(t *testing.T) {
+	export := domain.NewExport(uuid.New())
+	now := time.Date(2025, 8, 25, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, domain.ExportPending, export.Status)
+	assert.ErrorIs(t, export.CheckDownloadable(now), domain.ErrExportNotReady)
+
+	require.NoError(t, export.Finish(2048, now, 7*24*time.Hour))
+	assert.Equal(t, domain.ExportReady, export.Status)
+	assert.Equal(t, int64(2048), export.Size)
+	assert.Equal(t, now.Add(7*24*time.Hour), *export.ExpiresAt)
+	assert.ErrorIs(t, export.Finish(1, now, time.Hour), domain.ErrExportNotPending)
+
+	assert.NoError(t, export.CheckDownloadable(now.Add(time.Hour)))
+	assert.ErrorIs(t, export.CheckDownloadable(*export.ExpiresAt), domain.ErrExportExpired)
+
+	export.Status = domain.ExportExpired
+	assert.ErrorIs(t, export.CheckDownloadable(now), domain.ErrExportExpired, "the archive is gone")
+}
+
+func TestExport_Fail(t *testing.T) {
+	export := domain.NewExport(uuid.New())
+	now := time.Now()
+
+	export.Fail("posts: connection reset", now)
+	assert.Equal(t, domain.ExportFailed, export.Status)
+	assert.Equal(t, "posts: connection reset", export.LastError)
+	assert.ErrorIs(t, export.CheckDownloadable(now), domain.ErrExportNotReady)
+}
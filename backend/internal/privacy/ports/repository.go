@@ -0,0 +1,100 @@
+package ports
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"backend/internal/privacy/domain"
+	"github.com/google/uuid"
+)
+
+// Repository errors (canonical errors for the repository contract)
+var (
+	// ErrRequestNotFound is returned when an erasure request does not exist
+	ErrRequestNotFound = errors.New("erasure request not found")
+
+	// ErrCertificateNotFound is returned when a request has no certificate, as it has not completed
+	ErrCertificateNotFound = errors.New("erasure certificate not found")
+
+	// ErrSubjectNotFound is returned when the user to erase does not exist
+	ErrSubjectNotFound = errors.New("user not found")
+
+	// ErrRequestOpen is returned when the subject already has a request that has not completed
+	ErrRequestOpen = errors.New("an erasure request for this user is already open")
+
+	// ErrExportNotFound is returned when a data export does not exist
+	ErrExportNotFound = errors.New("data export not found")
+
+	// ErrExportPending is returned when the user already has an export waiting to be built
+	ErrExportPending = errors.New("a data export for this user is already pending")
+)
+
+// ListFilter selects erasure requests for the admin listing
+type ListFilter struct {
+	Status domain.Status // Empty matches every status
+	Limit  int
+	Offset int
+}
+
+// ErasureRepository defines the contract for erasure request persistence.
+// Users span blogs, so requests are not scoped to the request's blog.
+type ErasureRepository interface {
+	// Create stores a new request; a subject may have one open request at a time
+	Create(ctx context.Context, request *domain.Request) error
+
+	// FindByID retrieves a request with its finished steps
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.Request, error)
+
+	// FindLatestBySubject retrieves the subject's most recent request
+	FindLatestBySubject(ctx context.Context, subjectID uuid.UUID) (*domain.Request, error)
+
+	// List retrieves the requests matching filter, newest first
+	List(ctx context.Context, filter ListFilter) ([]*domain.Request, error)
+
+	// ListRunnable retrieves up to limit pending and running requests, oldest
+	// first; running ones were interrupted and are resumed
+	ListRunnable(ctx context.Context, limit int) ([]*domain.Request, error)
+
+	// Update stores a request's status, progress total, error and timestamps
+	Update(ctx context.Context, request *domain.Request) error
+
+	// RecordStep stores an eraser that finished for a request
+	RecordStep(ctx context.Context, requestID uuid.UUID, step domain.Step) error
+
+	// SaveCertificate stores the certificate issued when a request completed
+	SaveCertificate(ctx context.Context, certificate *domain.Certificate) error
+
+	// FindCertificate retrieves the certificate of a request
+	FindCertificate(ctx context.Context, requestID uuid.UUID) (*domain.Certificate, error)
+}
+
+// DataExportRepository defines the contract for data export persistence.
+// Users span blogs, so exports are not scoped to the request's blog.
+type DataExportRepository interface {
+	// Create stores a new export; a user may have one pending export at a time
+	Create(ctx context.Context, export *domain.Export) error
+
+	// FindByID retrieves an export without its archive
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.Export, error)
+
+	// FindLatestByUser retrieves the user's most recent export without its archive
+	FindLatestByUser(ctx context.Context, userID uuid.UUID) (*domain.Export, error)
+
+	// ListPending retrieves up to limit exports waiting to be built, oldest first
+	ListPending(ctx context.Context, limit int) ([]*domain.Export, error)
+
+	// Update stores an export's status, size, error and timestamps, and its
+	// archive when one is given
+	Update(ctx context.Context, export *domain.Export, archive []byte) error
+
+	// FindArchive retrieves the archive of a ready export
+	FindArchive(ctx context.Context, id uuid.UUID) ([]byte, error)
+
+	// ExpireArchives deletes the archives of exports that expired by now and
+	// returns how many it deleted
+	ExpireArchives(ctx context.Context, now time.Time) (int, error)
+
+	// DeleteByUser deletes every export of a user with its archive and returns how many it deleted
+	DeleteByUser(ctx context.Context, userID uuid.UUID) (int, error)
+}
@@ -0,0 +1,8 @@
+package application
+
+import "github.com/google/wire"
+
+// ProviderSet is the wire provider set for the quotas application layer
+var ProviderSet = wire.NewSet(
+	NewQuotaService,
+)
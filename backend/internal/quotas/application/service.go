@@ -0,0 +1,255 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/logger"
+	"backend/internal/quotas/domain"
+	"backend/internal/quotas/ports"
+	"github.com/google/uuid"
+)
+
+// Error definitions for service operations
+var (
+	ErrQuotaExceeded = apperror.New(
+		apperror.CodeConflict,
+		apperror.BusinessCodeQuotaExceeded,
+		"quota exceeded",
+		http.StatusConflict,
+	)
+
+	ErrInvalidLimits = apperror.New(
+		apperror.CodeValidationFailed,
+		apperror.BusinessCodeInvalidFormat,
+		"invalid limits",
+		http.StatusBadRequest,
+	)
+
+	ErrUserNotFound = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodeUserNotFound,
+		"user not found",
+		http.StatusNotFound,
+	)
+
+	ErrOverrideNotFound = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodeGeneral,
+		"the user's limits were not overridden",
+		http.StatusNotFound,
+	)
+)
+
+// QuotaConfig holds the limits of users without an override
+type QuotaConfig struct {
+	Defaults domain.Limits
+}
+
+// QuotaService enforces per-user content limits for the posts and themes
+// modules and lets administrators override them per user
+type QuotaService struct {
+	repo       ports.QuotaRepository
+	authorizer ports.Authorizer
+	config     QuotaConfig
+	logger     logger.Logger
+}
+
+// NewQuotaService creates a new quota service
+func NewQuotaService(repo ports.QuotaRepository, authorizer ports.Authorizer, config QuotaConfig, logger logger.Logger) *QuotaService {
+	return &QuotaService{
+		repo:       repo,
+		authorizer: authorizer,
+		config:     config,
+		logger:     logger,
+	}
+}
+
+// CheckDraftQuota returns ErrQuotaExceeded when the user may not start another draft
+func (s *QuotaService) CheckDraftQuota(ctx context.Context, userID uuid.UUID) error {
+	limits, _, err := s.limitsFor(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if limits.MaxDrafts == 0 {
+		return nil
+	}
+
+	drafts, err := s.repo.CountDrafts(ctx, userID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to count drafts", "error", err, "userID", userID)
+		return errCheckFailed()
+	}
+	if drafts >= limits.MaxDrafts {
+		return ErrQuotaExceeded.WithDetails(fmt.Sprintf("you may keep at most %d unpublished posts; publish or delete one first", limits.MaxDrafts))
+	}
+	return nil
+}
+
+// CheckPostSize returns ErrQuotaExceeded when content of size bytes is too large for the user's posts
+func (s *QuotaService) CheckPostSize(ctx context.Context, userID uuid.UUID, size int) error {
+	limits, _, err := s.limitsFor(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if limits.MaxPostBytes > 0 && size > limits.MaxPostBytes {
+		return ErrQuotaExceeded.WithDetails(fmt.Sprintf("post content is %d bytes; the limit is %d", size, limits.MaxPostBytes))
+	}
+	return nil
+}
+
+// CheckThemeQuota returns ErrQuotaExceeded when the user may not curate another theme
+func (s *QuotaService) CheckThemeQuota(ctx context.Context, userID uuid.UUID) error {
+	limits, _, err := s.limitsFor(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if limits.MaxThemes == 0 {
+		return nil
+	}
+
+	themes, err := s.repo.CountThemes(ctx, userID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to count themes", "error", err, "userID", userID)
+		return errCheckFailed()
+	}
+	if themes >= limits.MaxThemes {
+		return ErrQuotaExceeded.WithDetails(fmt.Sprintf("you may curate at most %d themes; archive or delete one first", limits.MaxThemes))
+	}
+	return nil
+}
+
+// GetQuota returns the user's own limits and usage
+func (s *QuotaService) GetQuota(ctx context.Context, userID uuid.UUID) (*domain.Quota, error) {
+	limits, overridden, err := s.limitsFor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	drafts, err := s.repo.CountDrafts(ctx, userID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to count drafts", "error", err, "userID", userID)
+		return nil, errCheckFailed()
+	}
+	themes, err := s.repo.CountThemes(ctx, userID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to count themes", "error", err, "userID", userID)
+		return nil, errCheckFailed()
+	}
+
+	return &domain.Quota{
+		Limits:     limits,
+		Usage:      domain.Usage{Drafts: drafts, Themes: themes},
+		Overridden: overridden,
+	}, nil
+}
+
+// GetUserQuota returns another user's limits and usage for an administrator
+func (s *QuotaService) GetUserQuota(ctx context.Context, actorID uuid.UUID, userID uuid.UUID) (*domain.Quota, error) {
+	if err := s.checkCanManage(ctx, actorID); err != nil {
+		return nil, err
+	}
+	return s.GetQuota(ctx, userID)
+}
+
+// SetUserLimits overrides some of a user's limits; nil limits keep the defaults and zero lifts a limit
+func (s *QuotaService) SetUserLimits(ctx context.Context, actorID uuid.UUID, userID uuid.UUID, maxDrafts, maxPostBytes, maxThemes *int) (*domain.Quota, error) {
+	if err := s.checkCanManage(ctx, actorID); err != nil {
+		return nil, err
+	}
+
+	override, err := domain.NewOverride(userID, maxDrafts, maxPostBytes, maxThemes, actorID)
+	if err != nil {
+		return nil, ErrInvalidLimits.WithDetails(err.Error())
+	}
+
+	if err := s.repo.SaveOverride(ctx, override); err != nil {
+		if errors.Is(err, ports.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		s.logger.Error(ctx, "failed to save limits override", "error", err, "userID", userID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to save limits",
+			http.StatusInternalServerError,
+		)
+	}
+
+	s.logger.Info(ctx, "user limits overridden", "userID", userID, "actorID", actorID)
+	return s.GetQuota(ctx, userID)
+}
+
+// ClearUserLimits removes a user's override so the defaults apply again
+func (s *QuotaService) ClearUserLimits(ctx context.Context, actorID uuid.UUID, userID uuid.UUID) error {
+	if err := s.checkCanManage(ctx, actorID); err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteOverride(ctx, userID); err != nil {
+		if errors.Is(err, ports.ErrOverrideNotFound) {
+			return ErrOverrideNotFound
+		}
+		s.logger.Error(ctx, "failed to delete limits override", "error", err, "userID", userID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to reset limits",
+			http.StatusInternalServerError,
+		)
+	}
+
+	s.logger.Info(ctx, "user limits reset", "userID", userID, "actorID", actorID)
+	return nil
+}
+
+// Private helper methods
+
+// limitsFor returns the user's effective limits and whether they were overridden
+func (s *QuotaService) limitsFor(ctx context.Context, userID uuid.UUID) (domain.Limits, bool, error) {
+	override, err := s.repo.FindOverride(ctx, userID)
+	if err != nil {
+		if errors.Is(err, ports.ErrOverrideNotFound) {
+			return s.config.Defaults, false, nil
+		}
+		s.logger.Error(ctx, "failed to find limits override", "error", err, "userID", userID)
+		return domain.Limits{}, false, errCheckFailed()
+	}
+	return s.config.Defaults.With(override), true, nil
+}
+
+// checkCanManage verifies the actor may change other users' limits
+func (s *QuotaService) checkCanManage(ctx context.Context, actorID uuid.UUID) error {
+	canManage, err := s.authorizer.Can(ctx, actorID, "quotas", "manage", nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canManage {
+		return apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to manage user limits",
+			http.StatusForbidden,
+		)
+	}
+	return nil
+}
+
+// errCheckFailed is returned when usage cannot be read; quotas fail closed
+func errCheckFailed() error {
+	return apperror.New(
+		apperror.CodeInternalError,
+		apperror.BusinessCodeGeneral,
+		"failed to check quota",
+		http.StatusInternalServerError,
+	)
+}
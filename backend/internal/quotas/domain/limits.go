@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Limit errors
+var (
+	ErrNegativeLimit = errors.New("limits must not be negative")
+	ErrNoLimits      = errors.New("an override must set at least one limit")
+)
+
+// Limits caps what a user may hold; zero leaves a limit off
+type Limits struct {
+	MaxDrafts    int // Unpublished posts the user authors
+	MaxPostBytes int // Size of a post's content in bytes
+	MaxThemes    int // Themes the user curates that are not archived
+}
+
+// With applies an override's limits over l; a nil override keeps l
+func (l Limits) With(override *Override) Limits {
+	if override == nil {
+		return l
+	}
+	if override.MaxDrafts != nil {
+		l.MaxDrafts = *override.MaxDrafts
+	}
+	if override.MaxPostBytes != nil {
+		l.MaxPostBytes = *override.MaxPostBytes
+	}
+	if override.MaxThemes != nil {
+		l.MaxThemes = *override.MaxThemes
+	}
+	return l
+}
+
+// Override replaces some of a user's limits in one blog.
+// A nil limit keeps the configured default.
+type Override struct {
+	UserID       uuid.UUID
+	MaxDrafts    *int
+	MaxPostBytes *int
+	MaxThemes    *int
+	UpdatedBy    uuid.UUID
+	UpdatedAt    time.Time
+}
+
+// NewOverride validates and creates an override of a user's limits
+func NewOverride(userID uuid.UUID, maxDrafts, maxPostBytes, maxThemes *int, updatedBy uuid.UUID) (*Override, error) {
+	if maxDrafts == nil && maxPostBytes == nil && maxThemes == nil {
+		return nil, ErrNoLimits
+	}
+	for _, limit := range []*int{maxDrafts, maxPostBytes, maxThemes} {
+		if limit != nil && *limit < 0 {
+			return nil, ErrNegativeLimit
+		}
+	}
+	return &Override{
+		UserID:       userID,
+		MaxDrafts:    maxDrafts,
+		MaxPostBytes: maxPostBytes,
+		MaxThemes:    maxThemes,
+		UpdatedBy:    updatedBy,
+		UpdatedAt:    time.Now(),
+	}, nil
+}
+
+// Usage is how much of the counted limits a user currently holds
+type Usage struct {
+	Drafts int
+	Themes int
+}
+
+// Quota is a user's effective limits next to their usage
+type Quota struct {
+	Limits     Limits
+	Usage      Usage
+	Overridden bool // Whether an administrator changed the user's limits
+}
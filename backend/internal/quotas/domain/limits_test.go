@@ -0,0 +1,43 @@
+package domain_test
+
+import (
+	"testing"
+
+	"backend/internal/quotas/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func intPtr(v int) *int { return &v }
+
+func TestLimits_With(t *testing.T) {
+	defaults := domain.Limits{MaxDrafts: 50, MaxPostBytes: 1 << 20, MaxThemes: 20}
+
+	assert.Equal(t, defaults, defaults.With(nil))
+
+	override, err := domain.NewOverride(uuid.New(), intPtr(0), nil, intPtr(5), uuid.New())
+	require.NoError(t, err)
+	assert.Equal(t, domain.Limits{MaxDrafts: 0, MaxPostBytes: 1 << 20, MaxThemes: 5}, defaults.With(override))
+}
+
+func TestNewOverride(t *testing.T) {
+	tests := []struct {
+		name      string
+		maxDrafts *int
+		maxBytes  *int
+		maxThemes *int
+		wantErr   error
+	}{
+		{name: "one limit", maxThemes: intPtr(3)},
+		{name: "zero lifts a limit", maxDrafts: intPtr(0)},
+		{name: "no limits", wantErr: domain.ErrNoLimits},
+		{name: "negative", maxBytes: intPtr(-1), wantErr: domain.ErrNegativeLimit},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewOverride(uuid.New(), tt.maxDrafts, tt.maxBytes, tt.maxThemes, uuid.New())
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package ports
+
+import (
+	"context"
+	"errors"
+
+	"backend/internal/quotas/domain"
+	"github.com/google/uuid"
+)
+
+// Repository errors
+var (
+	// ErrOverrideNotFound is returned when a user's limits were never overridden
+	ErrOverrideNotFound = errors.New("limits override not found")
+
+	// ErrUserNotFound is returned when saving an override for an unknown user
+	ErrUserNotFound = errors.New("user not found")
+)
+
+// QuotaRepository stores limit overrides and counts usage, both in the blog in ctx
+type QuotaRepository interface {
+	// FindOverride retrieves a user's override
+	FindOverride(ctx context.Context, userID uuid.UUID) (*domain.Override, error)
+
+	// SaveOverride creates or replaces a user's override
+	SaveOverride(ctx context.Context, override *domain.Override) error
+
+	// DeleteOverride removes a user's override, returning ErrOverrideNotFound when there is none
+	DeleteOverride(ctx context.Context, userID uuid.UUID) error
+
+	// CountDrafts counts the user's posts that are not published or archived
+	CountDrafts(ctx context.Context, userID uuid.UUID) (int, error)
+
+	// CountThemes counts the themes the user curates that are not archived
+	CountThemes(ctx context.Context, userID uuid.UUID) (int, error)
+}
@@ -0,0 +1,10 @@
+package application
+
+import "github.com/google/wire"
+
+// ProviderSet is the wire provider set for the reactions application layer
+var ProviderSet = wire.NewSet(
+	NewReactionsService,
+	NewTargetAdapter,
+	wire.Bind(new(TargetProvider), new(*TargetAdapter)),
+)
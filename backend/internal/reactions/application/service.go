@@ -0,0 +1,309 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/clientip"
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/events"
+	"backend/internal/platform/logger"
+	"backend/internal/platform/ratelimit"
+	"backend/internal/reactions/domain"
+	"backend/internal/reactions/ports"
+	"github.com/google/uuid"
+)
+
+// Rate limits for reaction changes, per user and per client address.
+// The address limit is looser, since several users may share one network.
+const (
+	ReactionRateLimit   = 30
+	ReactionIPRateLimit = 120
+	ReactionRateWindow  = time.Minute
+)
+
+// Error definitions for service operations
+var (
+	ErrReactionNotFound = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodeReactionNotFound,
+		"reaction not found",
+		http.StatusNotFound,
+	)
+
+	ErrInvalidReactionType = apperror.New(
+		apperror.CodeValidationFailed,
+		apperror.BusinessCodeInvalidReactionType,
+		"unsupported reaction type",
+		http.StatusBadRequest,
+	)
+
+	ErrTargetNotReactable = apperror.New(
+		apperror.CodeValidationFailed,
+		apperror.BusinessCodeReactionTargetInvalid,
+		"target cannot be reacted to",
+		http.StatusBadRequest,
+	)
+
+	ErrRateLimited = apperror.New(
+		apperror.CodeTooManyRequests,
+		apperror.BusinessCodeRateLimited,
+		"too many reactions, please slow down",
+		http.StatusTooManyRequests,
+	)
+)
+
+// TargetProvider verifies that a target exists and accepts reactions
+// This avoids direct dependency on the posts (and future comments) bounded contexts
+type TargetProvider interface {
+	EnsureReactable(ctx context.Context, targetType domain.TargetType, targetID uuid.UUID) error
+}
+
+// ReactionsService handles reaction-related business logic
+type ReactionsService struct {
+	repo           ports.ReactionRepository
+	targetProvider TargetProvider
+	authorizer     ports.Authorizer
+	eventBus       *eventbus.Bus
+	logger         logger.Logger
+	limiter        ratelimit.Limiter
+	ipLimiter      ratelimit.Limiter
+}
+
+// NewReactionsService creates a new reactions service
+func NewReactionsService(
+	repo ports.ReactionRepository,
+	targetProvider TargetProvider,
+	authorizer ports.Authorizer,
+	eventBus *eventbus.Bus,
+	logger logger.Logger,
+) *ReactionsService {
+	return &ReactionsService{
+		repo:           repo,
+		targetProvider: targetProvider,
+		authorizer:     authorizer,
+		eventBus:       eventBus,
+		logger:         logger,
+		limiter:        ratelimit.NewFixedWindowLimiter(ReactionRateLimit, ReactionRateWindow),
+		ipLimiter:      ratelimit.NewFixedWindowLimiter(ReactionIPRateLimit, ReactionRateWindow),
+	}
+}
+
+// React sets the actor's reaction on a target, replacing any earlier reaction
+func (s *ReactionsService) React(ctx context.Context, actorID uuid.UUID, targetType domain.TargetType, targetID uuid.UUID, reactionType domain.ReactionType) (*ports.ReactionSummary, error) {
+	if err := s.checkCanReact(ctx, actorID); err != nil {
+		return nil, err
+	}
+
+	if !reactionType.IsValid() {
+		return nil, ErrInvalidReactionType.WithDetails(string(reactionType))
+	}
+
+	if err := s.targetProvider.EnsureReactable(ctx, targetType, targetID); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.FindByUserAndTarget(ctx, actorID, targetType, targetID)
+	if err != nil && !errors.Is(err, ports.ErrReactionNotFound) {
+		s.logger.Error(ctx, "failed to find reaction", "error", err, "actorID", actorID, "targetID", targetID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to retrieve reaction",
+			http.StatusInternalServerError,
+		)
+	}
+
+	// Reacting again with the same type is a no-op
+	if existing != nil && existing.Type == reactionType {
+		return s.summarize(ctx, actorID, targetType, targetID)
+	}
+
+	var reaction *domain.Reaction
+	var previousType domain.ReactionType
+	if existing != nil {
+		previousType = existing.Type
+		if err := existing.ChangeType(reactionType); err != nil {
+			return nil, ErrInvalidReactionType.WithDetails(err.Error())
+		}
+		reaction = existing
+	} else {
+		reaction, err = domain.NewReaction(actorID, targetType, targetID, reactionType)
+		if err != nil {
+			return nil, ErrTargetNotReactable.WithDetails(err.Error())
+		}
+	}
+
+	if err := s.repo.Save(ctx, reaction); err != nil {
+		s.logger.Error(ctx, "failed to save reaction", "error", err, "actorID", actorID, "targetID", targetID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to save reaction",
+			http.StatusInternalServerError,
+		)
+	}
+
+	// Publish event
+	s.publishReactionAddedEvent(ctx, reaction, previousType)
+
+	return s.summarize(ctx, actorID, targetType, targetID)
+}
+
+// RemoveReaction removes the actor's reaction from a target
+func (s *ReactionsService) RemoveReaction(ctx context.Context, actorID uuid.UUID, targetType domain.TargetType, targetID uuid.UUID) error {
+	if err := s.checkCanReact(ctx, actorID); err != nil {
+		return err
+	}
+
+	existing, err := s.repo.FindByUserAndTarget(ctx, actorID, targetType, targetID)
+	if err != nil {
+		if errors.Is(err, ports.ErrReactionNotFound) {
+			return ErrReactionNotFound
+		}
+		s.logger.Error(ctx, "failed to find reaction", "error", err, "actorID", actorID, "targetID", targetID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to retrieve reaction",
+			http.StatusInternalServerError,
+		)
+	}
+
+	if err := s.repo.Delete(ctx, actorID, targetType, targetID); err != nil {
+		if errors.Is(err, ports.ErrReactionNotFound) {
+			return ErrReactionNotFound
+		}
+		s.logger.Error(ctx, "failed to delete reaction", "error", err, "actorID", actorID, "targetID", targetID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to remove reaction",
+			http.StatusInternalServerError,
+		)
+	}
+
+	// Publish event
+	s.publishReactionRemovedEvent(ctx, existing)
+
+	return nil
+}
+
+// GetReactionSummary returns the aggregated reactions on a target
+func (s *ReactionsService) GetReactionSummary(ctx context.Context, targetType domain.TargetType, targetID uuid.UUID) (*ports.ReactionSummary, error) {
+	if err := s.targetProvider.EnsureReactable(ctx, targetType, targetID); err != nil {
+		return nil, err
+	}
+
+	return s.summarize(ctx, uuid.Nil, targetType, targetID)
+}
+
+// Private helper methods
+
+// checkCanReact verifies the actor may react and is within the rate limit
+func (s *ReactionsService) checkCanReact(ctx context.Context, actorID uuid.UUID) error {
+	canReact, err := s.authorizer.Can(ctx, actorID, "reactions", "create", nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canReact {
+		return apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to react",
+			http.StatusForbidden,
+		)
+	}
+
+	if !s.limiter.Allow(actorID.String()) {
+		s.logger.Warn(ctx, "reaction rate limit exceeded", "actorID", actorID)
+		return ErrRateLimited
+	}
+	if ip := clientip.FromContext(ctx); ip != "" && !s.ipLimiter.Allow(ip) {
+		s.logger.Warn(ctx, "reaction rate limit exceeded for client address", "actorID", actorID)
+		return ErrRateLimited
+	}
+
+	return nil
+}
+
+// summarize loads reaction counts for a target, including the viewer's own reaction when viewerID is set
+func (s *ReactionsService) summarize(ctx context.Context, viewerID uuid.UUID, targetType domain.TargetType, targetID uuid.UUID) (*ports.ReactionSummary, error) {
+	counts, err := s.repo.CountByTarget(ctx, targetType, targetID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to count reactions", "error", err, "targetID", targetID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to count reactions",
+			http.StatusInternalServerError,
+		)
+	}
+
+	summary := &ports.ReactionSummary{
+		TargetType: targetType,
+		TargetID:   targetID,
+		Counts:     counts,
+	}
+	for _, count := range counts {
+		summary.Total += count
+	}
+
+	if viewerID != uuid.Nil {
+		reaction, err := s.repo.FindByUserAndTarget(ctx, viewerID, targetType, targetID)
+		if err != nil && !errors.Is(err, ports.ErrReactionNotFound) {
+			s.logger.Error(ctx, "failed to find viewer reaction", "error", err, "targetID", targetID)
+			return nil, apperror.New(
+				apperror.CodeInternalError,
+				apperror.BusinessCodeGeneral,
+				"failed to retrieve reaction",
+				http.StatusInternalServerError,
+			)
+		}
+		if reaction != nil {
+			summary.ViewerReaction = &reaction.Type
+		}
+	}
+
+	return summary, nil
+}
+
+// Event publishing methods
+
+func (s *ReactionsService) publishReactionAddedEvent(ctx context.Context, reaction *domain.Reaction, previousType domain.ReactionType) {
+	event := eventbus.Event{
+		Topic: events.ReactionAddedTopic,
+		Payload: events.ReactionAddedEvent{
+			UserID:       reaction.UserID,
+			TargetType:   string(reaction.TargetType),
+			TargetID:     reaction.TargetID,
+			ReactionType: string(reaction.Type),
+			PreviousType: string(previousType),
+			OccurredAt:   time.Now(),
+		},
+	}
+	s.eventBus.Publish(ctx, event)
+}
+
+func (s *ReactionsService) publishReactionRemovedEvent(ctx context.Context, reaction *domain.Reaction) {
+	event := eventbus.Event{
+		Topic: events.ReactionRemovedTopic,
+		Payload: events.ReactionRemovedEvent{
+			UserID:       reaction.UserID,
+			TargetType:   string(reaction.TargetType),
+			TargetID:     reaction.TargetID,
+			ReactionType: string(reaction.Type),
+			OccurredAt:   time.Now(),
+		},
+	}
+	s.eventBus.Publish(ctx, event)
+}
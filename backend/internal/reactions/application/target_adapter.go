@@ -0,0 +1,42 @@
+package application
+
+import (
+	"context"
+
+	postsApp "backend/internal/posts/application"
+	"backend/internal/reactions/domain"
+	"github.com/google/uuid"
+)
+
+// TargetAdapter implements the TargetProvider interface
+// It adapts the posts service so the reactions context can validate targets
+type TargetAdapter struct {
+	postsService *postsApp.PostsService
+}
+
+// NewTargetAdapter creates a new target adapter
+func NewTargetAdapter(postsService *postsApp.PostsService) *TargetAdapter {
+	return &TargetAdapter{
+		postsService: postsService,
+	}
+}
+
+// EnsureReactable returns an error unless the target exists and accepts reactions
+func (a *TargetAdapter) EnsureReactable(ctx context.Context, targetType domain.TargetType, targetID uuid.UUID) error {
+	switch targetType {
+	case domain.TargetPost:
+		post, err := a.postsService.GetPost(ctx, targetID)
+		if err != nil {
+			// Pass through the AppError from the posts service unchanged
+			return err
+		}
+		// Only published posts can be reacted to
+		if !post.IsPublished() {
+			return postsApp.ErrPostNotFound
+		}
+		return nil
+	default:
+		// Comments have no bounded context yet, so they cannot be resolved
+		return ErrTargetNotReactable.WithDetails(string(targetType))
+	}
+}
@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReactionType is the kind of reaction a user leaves on a target
+type ReactionType string
+
+// The supported reaction set is intentionally small
+const (
+	ReactionLike       ReactionType = "like"
+	ReactionLove       ReactionType = "love"
+	ReactionLaugh      ReactionType = "laugh"
+	ReactionInsightful ReactionType = "insightful"
+	ReactionCelebrate  ReactionType = "celebrate"
+)
+
+// ReactionTypes lists every supported reaction in display order
+var ReactionTypes = []ReactionType{
+	ReactionLike,
+	ReactionLove,
+	ReactionLaugh,
+	ReactionInsightful,
+	ReactionCelebrate,
+}
+
+// IsValid checks if the reaction type is supported
+func (t ReactionType) IsValid() bool {
+	switch t {
+	case ReactionLike, ReactionLove, ReactionLaugh, ReactionInsightful, ReactionCelebrate:
+		return true
+	default:
+		return false
+	}
+}
+
+// TargetType is the kind of content a reaction is attached to
+type TargetType string
+
+const (
+	TargetPost    TargetType = "post"
+	TargetComment TargetType = "comment"
+)
+
+// IsValid checks if the target type is supported
+func (t TargetType) IsValid() bool {
+	switch t {
+	case TargetPost, TargetComment:
+		return true
+	default:
+		return false
+	}
+}
+
+// Reaction is a single user's reaction to a target
+// A user has at most one reaction per target; reacting again replaces the type
+type Reaction struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	TargetType TargetType
+	TargetID   uuid.UUID
+	Type       ReactionType
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// Validation errors
+var (
+	ErrInvalidReactionType = errors.New("unsupported reaction type")
+	ErrInvalidTargetType   = errors.New("unsupported reaction target")
+	ErrInvalidUserID       = errors.New("user ID is required")
+	ErrInvalidTargetID     = errors.New("target ID is required")
+)
+
+// NewReaction creates a new reaction with validation
+func NewReaction(userID uuid.UUID, targetType TargetType, targetID uuid.UUID, reactionType ReactionType) (*Reaction, error) {
+	if userID == uuid.Nil {
+		return nil, ErrInvalidUserID
+	}
+
+	if !targetType.IsValid() {
+		return nil, ErrInvalidTargetType
+	}
+
+	if targetID == uuid.Nil {
+		return nil, ErrInvalidTargetID
+	}
+
+	if !reactionType.IsValid() {
+		return nil, ErrInvalidReactionType
+	}
+
+	now := time.Now()
+	return &Reaction{
+		ID:         uuid.New(),
+		UserID:     userID,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Type:       reactionType,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}, nil
+}
+
+// ChangeType replaces the reaction type
+func (r *Reaction) ChangeType(reactionType ReactionType) error {
+	if !reactionType.IsValid() {
+		return ErrInvalidReactionType
+	}
+
+	r.Type = reactionType
+	r.UpdatedAt = time.Now()
+	return nil
+}
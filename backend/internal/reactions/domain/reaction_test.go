@@ -0,0 +1,54 @@
+package domain_test
+
+import (
+	"testing"
+
+	"backend/internal/reactions/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReaction(t *testing.T) {
+	userID := uuid.New()
+	targetID := uuid.New()
+
+	reaction, err := domain.NewReaction(userID, domain.TargetPost, targetID, domain.ReactionLike)
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, reaction.ID)
+	assert.Equal(t, userID, reaction.UserID)
+	assert.Equal(t, targetID, reaction.TargetID)
+	assert.Equal(t, domain.ReactionLike, reaction.Type)
+
+	tests := []struct {
+		name         string
+		userID       uuid.UUID
+		targetType   domain.TargetType
+		targetID     uuid.UUID
+		reactionType domain.ReactionType
+		wantErr      error
+	}{
+		{"missing user", uuid.Nil, domain.TargetPost, targetID, domain.ReactionLike, domain.ErrInvalidUserID},
+		{"unknown target type", userID, domain.TargetType("page"), targetID, domain.ReactionLike, domain.ErrInvalidTargetType},
+		{"missing target", userID, domain.TargetComment, uuid.Nil, domain.ReactionLike, domain.ErrInvalidTargetID},
+		{"unknown reaction", userID, domain.TargetPost, targetID, domain.ReactionType("angry"), domain.ErrInvalidReactionType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewReaction(tt.userID, tt.targetType, tt.targetID, tt.reactionType)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestReaction_ChangeType(t *testing.T) {
+	reaction, err := domain.NewReaction(uuid.New(), domain.TargetPost, uuid.New(), domain.ReactionLike)
+	require.NoError(t, err)
+
+	require.NoError(t, reaction.ChangeType(domain.ReactionInsightful))
+	assert.Equal(t, domain.ReactionInsightful, reaction.Type)
+
+	assert.ErrorIs(t, reaction.ChangeType(domain.ReactionType("")), domain.ErrInvalidReactionType)
+	assert.Equal(t, domain.ReactionInsightful, reaction.Type)
+}
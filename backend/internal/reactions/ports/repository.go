@@ -0,0 +1,39 @@
+package ports
+
+import (
+	"context"
+	"errors"
+
+	"backend/internal/reactions/domain"
+	"github.com/google/uuid"
+)
+
+// Repository errors (canonical errors for the repository contract)
+var (
+	// ErrReactionNotFound is returned when a user has no reaction on a target
+	ErrReactionNotFound = errors.New("reaction not found")
+)
+
+// ReactionRepository defines the contract for reaction persistence
+type ReactionRepository interface {
+	// Save inserts the reaction or replaces the type of the user's existing reaction on the target
+	Save(ctx context.Context, reaction *domain.Reaction) error
+
+	// Delete removes a user's reaction from a target
+	Delete(ctx context.Context, userID uuid.UUID, targetType domain.TargetType, targetID uuid.UUID) error
+
+	// FindByUserAndTarget retrieves a user's reaction on a target
+	FindByUserAndTarget(ctx context.Context, userID uuid.UUID, targetType domain.TargetType, targetID uuid.UUID) (*domain.Reaction, error)
+
+	// CountByTarget returns the number of reactions of each type on a target
+	CountByTarget(ctx context.Context, targetType domain.TargetType, targetID uuid.UUID) (map[domain.ReactionType]int, error)
+}
+
+// ReactionSummary aggregates the reactions on a target
+type ReactionSummary struct {
+	TargetType     domain.TargetType
+	TargetID       uuid.UUID
+	Total          int
+	Counts         map[domain.ReactionType]int
+	ViewerReaction *domain.ReactionType // The requesting user's reaction, if known
+}
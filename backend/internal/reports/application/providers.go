@@ -0,0 +1,12 @@
+package application
+
+import "github.com/google/wire"
+
+// ProviderSet is the wire provider set for the reports application layer
+var ProviderSet = wire.NewSet(
+	NewReportsService,
+	NewTargetAdapter,
+	wire.Bind(new(TargetProvider), new(*TargetAdapter)),
+	NewUserAdapter,
+	wire.Bind(new(UserSuspender), new(*UserAdapter)),
+)
@@ -0,0 +1,295 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/events"
+	"backend/internal/platform/logger"
+	"backend/internal/platform/ratelimit"
+	"backend/internal/reports/domain"
+	"backend/internal/reports/ports"
+	"github.com/google/uuid"
+)
+
+// Rate limit for filing reports, per reporter. Genuine readers report rarely,
+// so the limit mainly stops one account from flooding the moderation queue.
+const (
+	ReportRateLimit  = 10
+	ReportRateWindow = time.Hour
+)
+
+// Error definitions for service operations
+var (
+	ErrReportNotFound = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodeReportNotFound,
+		"report not found",
+		http.StatusNotFound,
+	)
+
+	ErrInvalidReport = apperror.New(
+		apperror.CodeValidationFailed,
+		apperror.BusinessCodeReportInvalid,
+		"invalid report",
+		http.StatusBadRequest,
+	)
+
+	ErrTargetNotReportable = apperror.New(
+		apperror.CodeValidationFailed,
+		apperror.BusinessCodeReportInvalid,
+		"target cannot be reported",
+		http.StatusBadRequest,
+	)
+
+	ErrAlreadyReported = apperror.New(
+		apperror.CodeConflict,
+		apperror.BusinessCodeAlreadyReported,
+		"you have already reported this content",
+		http.StatusConflict,
+	)
+
+	ErrReportAlreadyResolved = apperror.New(
+		apperror.CodeConflict,
+		apperror.BusinessCodeReportAlreadyClosed,
+		"report is already resolved",
+		http.StatusConflict,
+	)
+
+	ErrRateLimited = apperror.New(
+		apperror.CodeTooManyRequests,
+		apperror.BusinessCodeRateLimited,
+		"too many reports, please try again later",
+		http.StatusTooManyRequests,
+	)
+)
+
+// TargetProvider resolves reported content and takes it down
+// This avoids direct dependency on the posts (and future comments) bounded contexts
+type TargetProvider interface {
+	// FindReportable returns the author of a target that readers can see
+	FindReportable(ctx context.Context, targetType domain.TargetType, targetID uuid.UUID) (uuid.UUID, error)
+
+	// Unpublish takes the target down on behalf of the actor
+	Unpublish(ctx context.Context, actorID uuid.UUID, targetType domain.TargetType, targetID uuid.UUID) error
+}
+
+// UserSuspender suspends user accounts
+type UserSuspender interface {
+	SuspendUser(ctx context.Context, userID uuid.UUID) error
+}
+
+// ReportsService handles abuse reports and their moderation
+type ReportsService struct {
+	repo           ports.ReportRepository
+	targetProvider TargetProvider
+	userSuspender  UserSuspender
+	authorizer     ports.Authorizer
+	eventBus       *eventbus.Bus
+	logger         logger.Logger
+	limiter        ratelimit.Limiter
+}
+
+// NewReportsService creates a new reports service
+func NewReportsService(
+	repo ports.ReportRepository,
+	targetProvider TargetProvider,
+	userSuspender UserSuspender,
+	authorizer ports.Authorizer,
+	eventBus *eventbus.Bus,
+	logger logger.Logger,
+) *ReportsService {
+	return &ReportsService{
+		repo:           repo,
+		targetProvider: targetProvider,
+		userSuspender:  userSuspender,
+		authorizer:     authorizer,
+		eventBus:       eventBus,
+		logger:         logger,
+		limiter:        ratelimit.NewFixedWindowLimiter(ReportRateLimit, ReportRateWindow),
+	}
+}
+
+// FileReport records a reader's report against a target
+func (s *ReportsService) FileReport(ctx context.Context, reporterID uuid.UUID, targetType domain.TargetType, targetID uuid.UUID, reason domain.Reason, details string) (*domain.Report, error) {
+	if err := s.checkPermission(ctx, reporterID, "reports", "create", "not authorized to report content"); err != nil {
+		return nil, err
+	}
+
+	authorID, err := s.targetProvider.FindReportable(ctx, targetType, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := domain.NewReport(reporterID, targetType, targetID, &authorID, reason, details)
+	if err != nil {
+		return nil, ErrInvalidReport.WithDetails(err.Error())
+	}
+
+	// Checked after validation so rejected requests do not use up the allowance
+	if !s.limiter.Allow(reporterID.String()) {
+		s.logger.Warn(ctx, "report rate limit exceeded", "reporterID", reporterID)
+		return nil, ErrRateLimited
+	}
+
+	if err := s.repo.Create(ctx, report); err != nil {
+		if errors.Is(err, ports.ErrDuplicateReport) {
+			return nil, ErrAlreadyReported
+		}
+		s.logger.Error(ctx, "failed to create report", "error", err, "reporterID", reporterID, "targetID", targetID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to save report",
+			http.StatusInternalServerError,
+		)
+	}
+
+	// Publish event
+	s.publishReportFiledEvent(ctx, report)
+
+	return report, nil
+}
+
+// ListReports returns the moderation queue, oldest reports first
+func (s *ReportsService) ListReports(ctx context.Context, actorID uuid.UUID, filter ports.ListFilter) ([]*domain.Report, int, error) {
+	if err := s.checkPermission(ctx, actorID, "comments", "moderate", "not authorized to moderate reports"); err != nil {
+		return nil, 0, err
+	}
+
+	reports, total, err := s.repo.List(ctx, filter)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list reports", "error", err)
+		return nil, 0, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to list reports",
+			http.StatusInternalServerError,
+		)
+	}
+
+	return reports, total, nil
+}
+
+// ResolveReport closes a report, first carrying out the moderator's action.
+// Unpublishing needs the moderator's own right to unpublish the content, and
+// suspending its author needs users:suspend.
+func (s *ReportsService) ResolveReport(ctx context.Context, actorID uuid.UUID, id uuid.UUID, action domain.Action, note string) (*domain.Report, error) {
+	if err := s.checkPermission(ctx, actorID, "comments", "moderate", "not authorized to moderate reports"); err != nil {
+		return nil, err
+	}
+
+	report, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, ports.ErrReportNotFound) {
+			return nil, ErrReportNotFound
+		}
+		s.logger.Error(ctx, "failed to find report", "error", err, "reportID", id)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to retrieve report",
+			http.StatusInternalServerError,
+		)
+	}
+
+	// Resolve before acting so an invalid request leaves the content untouched
+	if err := report.Resolve(action, actorID, note); err != nil {
+		if errors.Is(err, domain.ErrAlreadyResolved) {
+			return nil, ErrReportAlreadyResolved
+		}
+		return nil, ErrInvalidReport.WithDetails(err.Error())
+	}
+
+	switch action {
+	case domain.ActionUnpublish:
+		if err := s.targetProvider.Unpublish(ctx, actorID, report.TargetType, report.TargetID); err != nil {
+			return nil, err
+		}
+	case domain.ActionSuspendUser:
+		if err := s.checkPermission(ctx, actorID, "users", "suspend", "not authorized to suspend users"); err != nil {
+			return nil, err
+		}
+		if err := s.userSuspender.SuspendUser(ctx, *report.TargetAuthorID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repo.Update(ctx, report); err != nil {
+		s.logger.Error(ctx, "failed to resolve report", "error", err, "reportID", id)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to resolve report",
+			http.StatusInternalServerError,
+		)
+	}
+
+	// Publish event
+	s.publishReportResolvedEvent(ctx, report)
+
+	return report, nil
+}
+
+// Private helper methods
+
+// checkPermission verifies the actor holds a global permission
+func (s *ReportsService) checkPermission(ctx context.Context, actorID uuid.UUID, resource, action, deniedMessage string) error {
+	allowed, err := s.authorizer.Can(ctx, actorID, resource, action, nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !allowed {
+		return apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			deniedMessage,
+			http.StatusForbidden,
+		)
+	}
+	return nil
+}
+
+// Event publishing methods
+
+func (s *ReportsService) publishReportFiledEvent(ctx context.Context, report *domain.Report) {
+	event := eventbus.Event{
+		Topic: events.ReportFiledTopic,
+		Payload: events.ReportFiledEvent{
+			ReportID:   report.ID,
+			ReporterID: report.ReporterID,
+			TargetType: string(report.TargetType),
+			TargetID:   report.TargetID,
+			Reason:     string(report.Reason),
+			OccurredAt: time.Now(),
+		},
+	}
+	s.eventBus.Publish(ctx, event)
+}
+
+func (s *ReportsService) publishReportResolvedEvent(ctx context.Context, report *domain.Report) {
+	event := eventbus.Event{
+		Topic: events.ReportResolvedTopic,
+		Payload: events.ReportResolvedEvent{
+			ReportID:       report.ID,
+			ModeratorID:    *report.ResolvedBy,
+			TargetType:     string(report.TargetType),
+			TargetID:       report.TargetID,
+			TargetAuthorID: report.TargetAuthorID,
+			Action:         string(*report.Resolution),
+			Note:           report.ResolutionNote,
+			OccurredAt:     time.Now(),
+		},
+	}
+	s.eventBus.Publish(ctx, event)
+}
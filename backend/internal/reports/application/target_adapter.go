@@ -0,0 +1,54 @@
+package application
+
+import (
+	"context"
+
+	postsApp "backend/internal/posts/application"
+	"backend/internal/reports/domain"
+	"github.com/google/uuid"
+)
+
+// TargetAdapter implements the TargetProvider interface
+// It adapts the posts service so the reports context can resolve and take down targets
+type TargetAdapter struct {
+	postsService *postsApp.PostsService
+}
+
+// NewTargetAdapter creates a new target adapter
+func NewTargetAdapter(postsService *postsApp.PostsService) *TargetAdapter {
+	return &TargetAdapter{
+		postsService: postsService,
+	}
+}
+
+// FindReportable returns the author of a target readers can see and report
+func (a *TargetAdapter) FindReportable(ctx context.Context, targetType domain.TargetType, targetID uuid.UUID) (uuid.UUID, error) {
+	switch targetType {
+	case domain.TargetPost:
+		post, err := a.postsService.GetPost(ctx, targetID)
+		if err != nil {
+			// Pass through the AppError from the posts service unchanged
+			return uuid.Nil, err
+		}
+		// Readers only see published posts
+		if !post.IsPublished() {
+			return uuid.Nil, postsApp.ErrPostNotFound
+		}
+		return post.AuthorID, nil
+	default:
+		// Comments have no bounded context yet, so they cannot be resolved
+		return uuid.Nil, ErrTargetNotReportable.WithDetails(string(targetType))
+	}
+}
+
+// Unpublish takes a target down on the moderator's behalf
+func (a *TargetAdapter) Unpublish(ctx context.Context, actorID uuid.UUID, targetType domain.TargetType, targetID uuid.UUID) error {
+	switch targetType {
+	case domain.TargetPost:
+		// The posts service checks that the moderator may unpublish the post
+		_, err := a.postsService.UnpublishPost(ctx, actorID, targetID)
+		return err
+	default:
+		return ErrTargetNotReportable.WithDetails(string(targetType))
+	}
+}
@@ -0,0 +1,28 @@
+package application
+
+import (
+	"context"
+
+	usersApp "backend/internal/users/application"
+	"github.com/google/uuid"
+)
+
+// UserAdapter implements the UserSuspender interface
+// It adapts the users service so moderators can suspend the authors of reported content
+type UserAdapter struct {
+	userService *usersApp.UserService
+}
+
+// NewUserAdapter creates a new user adapter
+func NewUserAdapter(userService *usersApp.UserService) *UserAdapter {
+	return &UserAdapter{
+		userService: userService,
+	}
+}
+
+// SuspendUser suspends the user's account
+func (a *UserAdapter) SuspendUser(ctx context.Context, userID uuid.UUID) error {
+	// Pass through the AppError from the users service unchanged
+	_, err := a.userService.SuspendUser(ctx, userID.String())
+	return err
+}
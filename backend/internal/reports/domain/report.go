@@ -0,0 +1,204 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TargetType is the kind of content a report is filed against
+type TargetType string
+
+const (
+	TargetPost    TargetType = "post"
+	TargetComment TargetType = "comment"
+)
+
+// IsValid checks if the target type is supported
+func (t TargetType) IsValid() bool {
+	switch t {
+	case TargetPost, TargetComment:
+		return true
+	default:
+		return false
+	}
+}
+
+// Reason is the reporter's reason code
+type Reason string
+
+const (
+	ReasonSpam           Reason = "spam"
+	ReasonHarassment     Reason = "harassment"
+	ReasonHateSpeech     Reason = "hate_speech"
+	ReasonMisinformation Reason = "misinformation"
+	ReasonCopyright      Reason = "copyright"
+	ReasonOther          Reason = "other" // Needs details explaining the problem
+)
+
+// IsValid checks if the reason is a known code
+func (r Reason) IsValid() bool {
+	switch r {
+	case ReasonSpam, ReasonHarassment, ReasonHateSpeech, ReasonMisinformation, ReasonCopyright, ReasonOther:
+		return true
+	default:
+		return false
+	}
+}
+
+// Status is where a report stands in the moderation queue
+type Status string
+
+const (
+	StatusOpen      Status = "open"
+	StatusDismissed Status = "dismissed" // Resolved without action
+	StatusActioned  Status = "actioned"  // Resolved by acting on the content or its author
+)
+
+// IsValid checks if the status is a known value
+func (s Status) IsValid() bool {
+	switch s {
+	case StatusOpen, StatusDismissed, StatusActioned:
+		return true
+	default:
+		return false
+	}
+}
+
+// Action is what a moderator does to resolve a report
+type Action string
+
+const (
+	ActionDismiss     Action = "dismiss"
+	ActionUnpublish   Action = "unpublish"    // Take the reported content down
+	ActionSuspendUser Action = "suspend_user" // Suspend the author of the reported content
+)
+
+// IsValid checks if the action is a known value
+func (a Action) IsValid() bool {
+	switch a {
+	case ActionDismiss, ActionUnpublish, ActionSuspendUser:
+		return true
+	default:
+		return false
+	}
+}
+
+// MaxDetailsLength bounds the free text a reporter or moderator may add
+const MaxDetailsLength = 1000
+
+// Report is a reader's complaint about a post or comment
+type Report struct {
+	ID             uuid.UUID
+	ReporterID     uuid.UUID
+	TargetType     TargetType
+	TargetID       uuid.UUID
+	TargetAuthorID *uuid.UUID // Author of the content when the report was filed
+	Reason         Reason
+	Details        string
+	Status         Status
+	Resolution     *Action
+	ResolutionNote string
+	ResolvedBy     *uuid.UUID
+	ResolvedAt     *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Validation errors
+var (
+	ErrInvalidReporterID  = errors.New("reporter ID is required")
+	ErrInvalidTargetType  = errors.New("unsupported report target")
+	ErrInvalidTargetID    = errors.New("target ID is required")
+	ErrInvalidReason      = errors.New("reason must be spam, harassment, hate_speech, misinformation, copyright or other")
+	ErrDetailsRequired    = errors.New("details are required when the reason is other")
+	ErrDetailsTooLong     = errors.New("details must not exceed 1000 characters")
+	ErrReportOwnContent   = errors.New("you cannot report your own content")
+	ErrInvalidAction      = errors.New("action must be dismiss, unpublish or suspend_user")
+	ErrAlreadyResolved    = errors.New("report is already resolved")
+	ErrNoAuthorToSuspend  = errors.New("reported content has no author to suspend")
+	ErrInvalidModeratorID = errors.New("moderator ID is required")
+)
+
+// NewReport creates an open report with validation; authorID is the author of
+// the reported content, or nil when it is unknown
+func NewReport(reporterID uuid.UUID, targetType TargetType, targetID uuid.UUID, authorID *uuid.UUID, reason Reason, details string) (*Report, error) {
+	if reporterID == uuid.Nil {
+		return nil, ErrInvalidReporterID
+	}
+	if !targetType.IsValid() {
+		return nil, ErrInvalidTargetType
+	}
+	if targetID == uuid.Nil {
+		return nil, ErrInvalidTargetID
+	}
+	if !reason.IsValid() {
+		return nil, ErrInvalidReason
+	}
+
+	details = strings.TrimSpace(details)
+	if reason == ReasonOther && details == "" {
+		return nil, ErrDetailsRequired
+	}
+	if len([]rune(details)) > MaxDetailsLength {
+		return nil, ErrDetailsTooLong
+	}
+	if authorID != nil && *authorID == reporterID {
+		return nil, ErrReportOwnContent
+	}
+
+	now := time.Now()
+	return &Report{
+		ID:             uuid.New(),
+		ReporterID:     reporterID,
+		TargetType:     targetType,
+		TargetID:       targetID,
+		TargetAuthorID: authorID,
+		Reason:         reason,
+		Details:        details,
+		Status:         StatusOpen,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}, nil
+}
+
+// IsOpen reports whether the report still awaits a moderator
+func (r *Report) IsOpen() bool {
+	return r.Status == StatusOpen
+}
+
+// Resolve closes the report with the moderator's action. Carrying out the
+// action is the caller's job; this only records the outcome.
+func (r *Report) Resolve(action Action, moderatorID uuid.UUID, note string) error {
+	if !r.IsOpen() {
+		return ErrAlreadyResolved
+	}
+	if !action.IsValid() {
+		return ErrInvalidAction
+	}
+	if moderatorID == uuid.Nil {
+		return ErrInvalidModeratorID
+	}
+
+	note = strings.TrimSpace(note)
+	if len([]rune(note)) > MaxDetailsLength {
+		return ErrDetailsTooLong
+	}
+	if action == ActionSuspendUser && r.TargetAuthorID == nil {
+		return ErrNoAuthorToSuspend
+	}
+
+	now := time.Now()
+	r.Status = StatusActioned
+	if action == ActionDismiss {
+		r.Status = StatusDismissed
+	}
+	r.Resolution = &action
+	r.ResolutionNote = note
+	r.ResolvedBy = &moderatorID
+	r.ResolvedAt = &now
+	r.UpdatedAt = now
+	return nil
+}
@@ -0,0 +1,78 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"backend/internal/reports/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReport(t *testing.T) {
+	reporterID := uuid.New()
+	authorID := uuid.New()
+	targetID := uuid.New()
+
+	report, err := domain.NewReport(reporterID, domain.TargetPost, targetID, &authorID, domain.ReasonSpam, "  buy now  ")
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusOpen, report.Status)
+	assert.Equal(t, "buy now", report.Details)
+	assert.True(t, report.IsOpen())
+
+	tests := []struct {
+		name       string
+		reporterID uuid.UUID
+		targetType domain.TargetType
+		targetID   uuid.UUID
+		reason     domain.Reason
+		details    string
+		wantErr    error
+	}{
+		{"missing reporter", uuid.Nil, domain.TargetPost, targetID, domain.ReasonSpam, "", domain.ErrInvalidReporterID},
+		{"unknown target type", reporterID, domain.TargetType("theme"), targetID, domain.ReasonSpam, "", domain.ErrInvalidTargetType},
+		{"missing target", reporterID, domain.TargetComment, uuid.Nil, domain.ReasonSpam, "", domain.ErrInvalidTargetID},
+		{"unknown reason", reporterID, domain.TargetPost, targetID, domain.Reason("boring"), "", domain.ErrInvalidReason},
+		{"other without details", reporterID, domain.TargetPost, targetID, domain.ReasonOther, "   ", domain.ErrDetailsRequired},
+		{"details too long", reporterID, domain.TargetPost, targetID, domain.ReasonSpam, strings.Repeat("x", 1001), domain.ErrDetailsTooLong},
+		{"own content", authorID, domain.TargetPost, targetID, domain.ReasonSpam, "", domain.ErrReportOwnContent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewReport(tt.reporterID, tt.targetType, tt.targetID, &authorID, tt.reason, tt.details)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestReport_Resolve(t *testing.T) {
+	authorID := uuid.New()
+	moderatorID := uuid.New()
+
+	report, err := domain.NewReport(uuid.New(), domain.TargetPost, uuid.New(), &authorID, domain.ReasonHarassment, "")
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, report.Resolve(domain.Action("ban"), moderatorID, ""), domain.ErrInvalidAction)
+	assert.ErrorIs(t, report.Resolve(domain.ActionDismiss, uuid.Nil, ""), domain.ErrInvalidModeratorID)
+
+	require.NoError(t, report.Resolve(domain.ActionSuspendUser, moderatorID, " repeat offender "))
+	assert.Equal(t, domain.StatusActioned, report.Status)
+	assert.Equal(t, domain.ActionSuspendUser, *report.Resolution)
+	assert.Equal(t, "repeat offender", report.ResolutionNote)
+	assert.Equal(t, moderatorID, *report.ResolvedBy)
+	assert.NotNil(t, report.ResolvedAt)
+
+	assert.ErrorIs(t, report.Resolve(domain.ActionDismiss, moderatorID, ""), domain.ErrAlreadyResolved)
+}
+
+func TestReport_ResolveDismissAndAuthorless(t *testing.T) {
+	report, err := domain.NewReport(uuid.New(), domain.TargetComment, uuid.New(), nil, domain.ReasonSpam, "")
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, report.Resolve(domain.ActionSuspendUser, uuid.New(), ""), domain.ErrNoAuthorToSuspend)
+
+	require.NoError(t, report.Resolve(domain.ActionDismiss, uuid.New(), ""))
+	assert.Equal(t, domain.StatusDismissed, report.Status)
+}
@@ -0,0 +1,42 @@
+package ports
+
+import (
+	"context"
+	"errors"
+
+	"backend/internal/reports/domain"
+	"github.com/google/uuid"
+)
+
+// Repository errors (canonical errors for the repository contract)
+var (
+	// ErrReportNotFound is returned when a report does not exist
+	ErrReportNotFound = errors.New("report not found")
+
+	// ErrDuplicateReport is returned when the reporter already has an open report on the target
+	ErrDuplicateReport = errors.New("target already reported")
+)
+
+// ReportRepository defines the contract for report persistence
+type ReportRepository interface {
+	// Create stores a new report
+	Create(ctx context.Context, report *domain.Report) error
+
+	// Update saves a report's status and resolution
+	Update(ctx context.Context, report *domain.Report) error
+
+	// FindByID retrieves a report
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.Report, error)
+
+	// List retrieves reports matching the filter, oldest first, with the total number of matches
+	List(ctx context.Context, filter ListFilter) ([]*domain.Report, int, error)
+}
+
+// ListFilter selects reports for the moderation queue
+type ListFilter struct {
+	Status     *domain.Status     // Nil lists every status
+	TargetType *domain.TargetType // Nil lists every target type
+	TargetID   *uuid.UUID
+	Limit      int
+	Offset     int
+}
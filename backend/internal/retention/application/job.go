@@ -0,0 +1,42 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"backend/internal/platform/logger"
+	"backend/internal/platform/schedule"
+)
+
+// JobConfig schedules the retention job
+type JobConfig struct {
+	// Interval between runs; zero or less disables the job
+	Interval time.Duration
+	// DryRun makes the job log the posts it would purge instead of purging them
+	DryRun bool
+}
+
+// Job applies the retention policies to every blog on a schedule
+type Job struct {
+	service *RetentionService
+	config  JobConfig
+	logger  logger.Logger
+}
+
+// NewJob creates the scheduled retention job
+func NewJob(service *RetentionService, config JobConfig, logger logger.Logger) *Job {
+	return &Job{
+		service: service,
+		config:  config,
+		logger:  logger,
+	}
+}
+
+// Run blocks until ctx is done, running the policies once per interval
+func (j *Job) Run(ctx context.Context) {
+	schedule.Every(ctx, j.config.Interval, func(ctx context.Context) {
+		if err := j.service.RunAll(ctx, j.config.DryRun); err != nil && ctx.Err() == nil {
+			j.logger.Error(ctx, "retention job failed", "error", err)
+		}
+	})
+}
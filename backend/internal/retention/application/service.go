@@ -0,0 +1,229 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/events"
+	"backend/internal/platform/logger"
+	"backend/internal/platform/postgres"
+	"backend/internal/platform/tenant"
+	"backend/internal/retention/domain"
+	"backend/internal/retention/ports"
+	"github.com/google/uuid"
+)
+
+// RetentionService purges the posts that the configured policies no longer keep
+type RetentionService struct {
+	uow        postgres.UnitOfWork
+	repo       ports.RetentionRepository
+	authorizer ports.Authorizer
+	eventBus   *eventbus.Bus
+	logger     logger.Logger
+	settings   domain.Settings
+}
+
+// NewRetentionService creates a new retention service
+func NewRetentionService(
+	uow postgres.UnitOfWork,
+	repo ports.RetentionRepository,
+	authorizer ports.Authorizer,
+	eventBus *eventbus.Bus,
+	logger logger.Logger,
+	settings domain.Settings,
+) *RetentionService {
+	return &RetentionService{
+		uow:        uow,
+		repo:       repo,
+		authorizer: authorizer,
+		eventBus:   eventBus,
+		logger:     logger,
+		settings:   settings,
+	}
+}
+
+// Run applies every enabled policy to the request's blog on behalf of an
+// admin. A dry run only reports the posts that would be purged.
+func (s *RetentionService) Run(ctx context.Context, actorID uuid.UUID, dryRun bool) (*domain.Report, error) {
+	canManage, err := s.authorizer.Can(ctx, actorID, "settings", "system", nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canManage {
+		return nil, apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to purge posts",
+			http.StatusForbidden,
+		)
+	}
+
+	return s.run(ctx, actorID, dryRun)
+}
+
+// RunAll applies every enabled policy to each blog in turn, as the scheduled
+// job does. A blog that fails is logged and does not stop the others.
+func (s *RetentionService) RunAll(ctx context.Context, dryRun bool) error {
+	blogIDs, err := s.repo.BlogIDs(ctx)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list blogs for retention", "error", err)
+		return err
+	}
+
+	for _, blogID := range blogIDs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		blogCtx := tenant.WithBlogID(ctx, blogID)
+		report, err := s.run(blogCtx, uuid.Nil, dryRun)
+		if err != nil {
+			continue // Logged by run
+		}
+		if dryRun {
+			s.logDryRun(blogCtx, report)
+		}
+	}
+	return nil
+}
+
+// logDryRun reports each post a scheduled dry run would have purged
+func (s *RetentionService) logDryRun(ctx context.Context, report *domain.Report) {
+	for _, result := range report.Results {
+		for _, post := range result.Candidates {
+			s.logger.Info(ctx, "retention dry run would purge post",
+				"blogID", tenant.BlogID(ctx), "policy", result.Policy, "postID", post.PostID,
+				"authorID", post.AuthorID, "updatedAt", post.UpdatedAt)
+		}
+	}
+}
+
+// run applies the policies to the blog ctx is scoped to. All purges and their
+// audit entries are written in one transaction, so a failed policy deletes nothing.
+func (s *RetentionService) run(ctx context.Context, actorID uuid.UUID, dryRun bool) (*domain.Report, error) {
+	now := time.Now().UTC()
+	report := &domain.Report{
+		RanAt:   now,
+		DryRun:  dryRun,
+		Results: make([]domain.PolicyResult, 0, len(s.settings.Rules)),
+	}
+
+	if dryRun {
+		for _, rule := range s.enabledRules() {
+			cutoff := rule.Cutoff(now)
+			candidates, err := s.repo.FindCandidates(ctx, rule.Policy, cutoff, s.settings.ExemptAuthors)
+			if err != nil {
+				return nil, s.policyFailed(ctx, "find", rule.Policy, err)
+			}
+			report.Results = append(report.Results, domain.PolicyResult{Policy: rule.Policy, Cutoff: cutoff, Candidates: candidates})
+		}
+		return report, nil
+	}
+
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		for _, rule := range s.enabledRules() {
+			cutoff := rule.Cutoff(now)
+			purged, err := s.repo.Purge(ctx, rule.Policy, cutoff, s.settings.ExemptAuthors)
+			if err != nil {
+				return s.policyFailed(ctx, "purge", rule.Policy, err)
+			}
+			// Recorded in the same transaction, so no post is purged without its audit entry
+			if err := s.repo.RecordPurges(ctx, rule.Policy, purged, actorID, now); err != nil {
+				return s.policyFailed(ctx, "record", rule.Policy, err)
+			}
+			report.Results = append(report.Results, domain.PolicyResult{
+				Policy:     rule.Policy,
+				Cutoff:     cutoff,
+				Candidates: purged,
+				Purged:     len(purged),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return nil, err
+		}
+		s.logger.Error(ctx, "failed to purge posts", "error", err)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to purge posts",
+			http.StatusInternalServerError,
+		)
+	}
+
+	if report.PurgedCount() > 0 {
+		s.logger.Info(ctx, "purged posts under retention policies",
+			"actorID", actorID, "blogID", tenant.BlogID(ctx), "posts", report.PurgedCount())
+		s.publishPurgeEvents(ctx, report, actorID)
+	}
+	return report, nil
+}
+
+// enabledRules returns the rules that purge anything, in policy order
+func (s *RetentionService) enabledRules() []domain.Rule {
+	rules := make([]domain.Rule, 0, len(s.settings.Rules))
+	for _, policy := range domain.Policies {
+		for _, rule := range s.settings.Rules {
+			if rule.Policy == policy && rule.Enabled() {
+				rules = append(rules, rule)
+			}
+		}
+	}
+	return rules
+}
+
+// policyFailed logs a failed policy
+func (s *RetentionService) policyFailed(ctx context.Context, stage string, policy domain.Policy, err error) error {
+	s.logger.Error(ctx, "failed to apply retention policy", "error", err, "stage", stage, "policy", policy)
+	return apperror.New(
+		apperror.CodeInternalError,
+		apperror.BusinessCodeGeneral,
+		"failed to apply retention policies",
+		http.StatusInternalServerError,
+	)
+}
+
+// publishPurgeEvents announces every purged post, and its deletion so caches
+// and read models drop it
+func (s *RetentionService) publishPurgeEvents(ctx context.Context, report *domain.Report, actorID uuid.UUID) {
+	now := time.Now()
+	blogID := tenant.BlogID(ctx)
+	for _, result := range report.Results {
+		for _, post := range result.Candidates {
+			s.eventBus.Publish(ctx, eventbus.Event{
+				Topic: events.PostPurgedTopic,
+				Payload: events.PostPurgedEvent{
+					PostID:     post.PostID,
+					BlogID:     blogID,
+					AuthorID:   post.AuthorID,
+					Title:      post.Title,
+					Policy:     string(result.Policy),
+					UpdatedAt:  post.UpdatedAt,
+					ActorID:    actorID,
+					OccurredAt: now,
+				},
+			})
+			s.eventBus.Publish(ctx, eventbus.Event{
+				Topic: events.PostDeletedTopic,
+				Payload: events.PostDeletedEvent{
+					PostID:     post.PostID,
+					ActorID:    actorID,
+					AuthorID:   post.AuthorID,
+					OccurredAt: now,
+				},
+			})
+		}
+	}
+}
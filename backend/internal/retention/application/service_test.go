@@ -0,0 +1,102 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"backend/internal/platform/errreport"
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/logger"
+	"backend/internal/retention/application"
+	"backend/internal/retention/domain"
+	"backend/internal/retention/ports"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// txKey marks contexts inside the stub unit of work
+type txKey struct{}
+
+// stubUnitOfWork runs fn with a context marked as transactional
+type stubUnitOfWork struct{}
+
+func (stubUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(context.WithValue(ctx, txKey{}, true))
+}
+
+// allowAll grants every action
+type allowAll struct{}
+
+func (allowAll) Can(context.Context, uuid.UUID, string, string, *uuid.UUID) (bool, error) {
+	return true, nil
+}
+
+// purgeRecord is one RecordPurges call
+type purgeRecord struct {
+	policy  domain.Policy
+	purged  []domain.Candidate
+	actorID uuid.UUID
+	inTx    bool
+}
+
+// stubRepository purges the candidates it holds and keeps the records written
+type stubRepository struct {
+	ports.RetentionRepository
+	candidates []domain.Candidate
+	records    []purgeRecord
+	recordErr  error
+}
+
+func (r *stubRepository) Purge(_ context.Context, policy domain.Policy, _ time.Time, _ []uuid.UUID) ([]domain.Candidate, error) {
+	if policy != domain.PolicyArchivedPosts {
+		return nil, nil
+	}
+	return r.candidates, nil
+}
+
+func (r *stubRepository) RecordPurges(ctx context.Context, policy domain.Policy, purged []domain.Candidate, actorID uuid.UUID, _ time.Time) error {
+	if r.recordErr != nil {
+		return r.recordErr
+	}
+	inTx, _ := ctx.Value(txKey{}).(bool)
+	r.records = append(r.records, purgeRecord{policy: policy, purged: purged, actorID: actorID, inTx: inTx})
+	return nil
+}
+
+func newRetentionService(repo *stubRepository) *application.RetentionService {
+	log := logger.NewSlogAdapter("test", "error")
+	return application.NewRetentionService(
+		stubUnitOfWork{}, repo, allowAll{}, eventbus.NewBus(log, errreport.Nop{}), log,
+		domain.Settings{Rules: []domain.Rule{{Policy: domain.PolicyArchivedPosts, Months: 6}}},
+	)
+}
+
+func TestRetentionService_RunRecordsEveryPurge(t *testing.T) {
+	admin := uuid.New()
+	post := domain.Candidate{PostID: uuid.New(), AuthorID: uuid.New(), Title: "Old news", UpdatedAt: time.Now().AddDate(-1, 0, 0)}
+	repo := &stubRepository{candidates: []domain.Candidate{post}}
+
+	report, err := newRetentionService(repo).Run(context.Background(), admin, false)
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.PurgedCount())
+
+	require.Len(t, repo.records, 1, "the purge is written to the audit trail")
+	record := repo.records[0]
+	assert.Equal(t, domain.PolicyArchivedPosts, record.policy)
+	assert.Equal(t, []domain.Candidate{post}, record.purged)
+	assert.Equal(t, admin, record.actorID)
+	assert.True(t, record.inTx, "in the transaction that purged the posts")
+}
+
+func TestRetentionService_RunFailsWhenThePurgeCannotBeRecorded(t *testing.T) {
+	repo := &stubRepository{
+		candidates: []domain.Candidate{{PostID: uuid.New(), AuthorID: uuid.New()}},
+		recordErr:  errors.New("audit trail unavailable"),
+	}
+
+	_, err := newRetentionService(repo).Run(context.Background(), uuid.New(), false)
+	assert.Error(t, err, "the transaction is rolled back, undoing the purge")
+}
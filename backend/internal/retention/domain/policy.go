@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Policy names one kind of post that is purged once it has sat untouched long enough
+type Policy string
+
+const (
+	// PolicyStaleDrafts purges drafts that were never published. Unpublishing
+	// clears a post's publication time, so unpublished drafts count too.
+	PolicyStaleDrafts Policy = "stale_drafts"
+	// PolicyArchivedPosts permanently deletes archived posts
+	PolicyArchivedPosts Policy = "archived_posts"
+)
+
+// Policies lists every policy in the order they are run and reported
+var Policies = []Policy{
+	PolicyStaleDrafts,
+	PolicyArchivedPosts,
+}
+
+// Description explains which posts a policy purges
+func (p Policy) Description() string {
+	switch p {
+	case PolicyStaleDrafts:
+		return "Drafts without a publication time, which unpublishing clears, that have not been edited within the retention period"
+	case PolicyArchivedPosts:
+		return "Archived posts that have not been changed within the retention period"
+	default:
+		return ""
+	}
+}
+
+// Rule sets how many months a policy keeps posts; zero months disables it
+type Rule struct {
+	Policy Policy
+	Months int
+}
+
+// Enabled reports whether the rule purges anything
+func (r Rule) Enabled() bool {
+	return r.Months > 0
+}
+
+// Cutoff returns the time before which a post's last change makes it eligible
+func (r Rule) Cutoff(now time.Time) time.Time {
+	return now.AddDate(0, -r.Months, 0)
+}
+
+// Settings configure the retention job
+type Settings struct {
+	Rules []Rule
+	// ExemptAuthors are users whose posts no policy purges
+	ExemptAuthors []uuid.UUID
+}
+
+// Candidate is a post a policy selects for purging
+type Candidate struct {
+	PostID    uuid.UUID
+	AuthorID  uuid.UUID
+	Title     string
+	UpdatedAt time.Time
+}
+
+// PolicyResult is the outcome of one policy
+type PolicyResult struct {
+	Policy     Policy
+	Cutoff     time.Time
+	Candidates []Candidate
+	Purged     int // Posts deleted; zero on a dry run
+}
+
+// Report is the outcome of a retention run on one blog
+type Report struct {
+	RanAt   time.Time
+	DryRun  bool // Whether the candidates were only reported
+	Results []PolicyResult
+}
+
+// CandidateCount returns the number of candidates across every policy
+func (r *Report) CandidateCount() int {
+	count := 0
+	for _, result := range r.Results {
+		count += len(result.Candidates)
+	}
+	return count
+}
+
+// PurgedCount returns the number of posts deleted across every policy
+func (r *Report) PurgedCount() int {
+	count := 0
+	for _, result := range r.Results {
+		count += result.Purged
+	}
+	return count
+}
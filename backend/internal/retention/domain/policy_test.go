@@ -0,0 +1,34 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"backend/internal/retention/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRuleCutoff(t *testing.T) {
+	now := time.Date(2025, 8, 31, 12, 0, 0, 0, time.UTC)
+	rule := domain.Rule{Policy: domain.PolicyStaleDrafts, Months: 6}
+
+	assert.True(t, rule.Enabled())
+	assert.Equal(t, time.Date(2025, 3, 3, 12, 0, 0, 0, time.UTC), rule.Cutoff(now), "AddDate normalizes the missing day")
+	assert.False(t, domain.Rule{Policy: domain.PolicyArchivedPosts}.Enabled())
+}
+
+func TestReportCounts(t *testing.T) {
+	report := &domain.Report{Results: []domain.PolicyResult{
+		{Policy: domain.PolicyStaleDrafts, Candidates: []domain.Candidate{{PostID: uuid.New()}, {PostID: uuid.New()}}, Purged: 2},
+		{Policy: domain.PolicyArchivedPosts, Candidates: []domain.Candidate{{PostID: uuid.New()}}},
+	}}
+	assert.Equal(t, 3, report.CandidateCount())
+	assert.Equal(t, 2, report.PurgedCount())
+}
+
+func TestEveryPolicyIsDescribed(t *testing.T) {
+	for _, policy := range domain.Policies {
+		assert.NotEmpty(t, policy.Description(), policy)
+	}
+}
@@ -0,0 +1,26 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"backend/internal/retention/domain"
+	"github.com/google/uuid"
+)
+
+// RetentionRepository selects and deletes the posts a policy no longer keeps.
+// Both are scoped to the request's blog and pass over the exempt authors' posts.
+type RetentionRepository interface {
+	// FindCandidates returns the posts the policy selects, last changed before the cutoff
+	FindCandidates(ctx context.Context, policy domain.Policy, cutoff time.Time, exempt []uuid.UUID) ([]domain.Candidate, error)
+
+	// Purge deletes the posts the policy selects and returns what it deleted
+	Purge(ctx context.Context, policy domain.Policy, cutoff time.Time, exempt []uuid.UUID) ([]domain.Candidate, error)
+
+	// RecordPurges appends the posts a policy deleted to the blog's audit trail.
+	// actorID is uuid.Nil for the scheduled job.
+	RecordPurges(ctx context.Context, policy domain.Policy, purged []domain.Candidate, actorID uuid.UUID, purgedAt time.Time) error
+
+	// BlogIDs lists every blog, for the scheduled job that runs on each of them
+	BlogIDs(ctx context.Context) ([]uuid.UUID, error)
+}
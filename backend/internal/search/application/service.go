@@ -0,0 +1,171 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/logger"
+	"backend/internal/search/domain"
+	"backend/internal/search/ports"
+	"github.com/google/uuid"
+)
+
+// Suggestions are the completions for a prefix, grouped by kind
+// A kind that was not asked for is left empty
+type Suggestions struct {
+	Query   string // The prefix as matched, after normalization
+	Posts   []domain.Suggestion
+	Themes  []domain.Suggestion
+	Authors []domain.Suggestion
+}
+
+// SearchService completes what readers type in the search box
+type SearchService struct {
+	repo       ports.SuggestionRepository
+	authorizer ports.Authorizer
+	logger     logger.Logger
+}
+
+// NewSearchService creates a new search service
+func NewSearchService(
+	repo ports.SuggestionRepository,
+	authorizer ports.Authorizer,
+	logger logger.Logger,
+) *SearchService {
+	return &SearchService{
+		repo:       repo,
+		authorizer: authorizer,
+		logger:     logger,
+	}
+}
+
+// Suggest completes the prefix q with up to limit suggestions of each kind
+// asked for, every kind when none is. Kinds are looked up in parallel.
+// Public: anonymous viewers only see what is listed to readers, signed-in
+// viewers also their own drafts and themes, and editors every post
+func (s *SearchService) Suggest(ctx context.Context, viewerID *uuid.UUID, q string, kinds []domain.Kind, limit int) (*Suggestions, error) {
+	prefix, err := domain.NormalizeQuery(q)
+	if err != nil {
+		return nil, mapQueryError(err)
+	}
+	limit = domain.ClampLimit(limit)
+
+	wanted := make(map[domain.Kind]bool, len(domain.Kinds()))
+	for _, kind := range kinds {
+		if !kind.IsValid() {
+			return nil, apperror.New(
+				apperror.CodeValidationFailed,
+				apperror.BusinessCodeInvalidFormat,
+				"unknown suggestion type: "+string(kind),
+				http.StatusBadRequest,
+			)
+		}
+		wanted[kind] = true
+	}
+	if len(wanted) == 0 {
+		for _, kind := range domain.Kinds() {
+			wanted[kind] = true
+		}
+	}
+
+	scope := ports.PostScope{}
+	if wanted[domain.KindPost] {
+		scope, err = s.postScope(ctx, viewerID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	suggestions := &Suggestions{Query: prefix}
+	var (
+		wg                           sync.WaitGroup
+		postsErr, themesErr, authErr error
+	)
+	if wanted[domain.KindPost] {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			suggestions.Posts, postsErr = s.repo.SuggestPosts(ctx, prefix, scope, limit)
+		}()
+	}
+	if wanted[domain.KindTheme] {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			suggestions.Themes, themesErr = s.repo.SuggestThemes(ctx, prefix, viewerID, limit)
+		}()
+	}
+	if wanted[domain.KindAuthor] {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			suggestions.Authors, authErr = s.repo.SuggestAuthors(ctx, prefix, limit)
+		}()
+	}
+	wg.Wait()
+
+	for _, err := range []error{postsErr, themesErr, authErr} {
+		if err != nil {
+			s.logger.Error(ctx, "failed to look up search suggestions", "error", err, "query", prefix)
+			return nil, apperror.New(
+				apperror.CodeInternalError,
+				apperror.BusinessCodeGeneral,
+				"failed to retrieve search suggestions",
+				http.StatusInternalServerError,
+			)
+		}
+	}
+
+	return suggestions, nil
+}
+
+// Private helper methods
+
+// postScope returns the posts the viewer may be suggested, matching what the
+// posts listing shows them
+func (s *SearchService) postScope(ctx context.Context, viewerID *uuid.UUID) (ports.PostScope, error) {
+	if viewerID == nil {
+		return ports.PostScope{}, nil
+	}
+
+	canReadAny, err := s.authorizer.Can(ctx, *viewerID, "posts", "read:draft:any", nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", *viewerID)
+		return ports.PostScope{}, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if canReadAny {
+		return ports.PostScope{AllStatuses: true}, nil
+	}
+
+	return ports.PostScope{Member: true, OwnerID: viewerID}, nil
+}
+
+// mapQueryError converts a query validation error to an AppError
+func mapQueryError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrQueryTooShort):
+		return apperror.New(
+			apperror.CodeValidationFailed,
+			apperror.BusinessCodeValueTooShort,
+			"search query must be at least 2 characters",
+			http.StatusBadRequest,
+		)
+	case errors.Is(err, domain.ErrQueryTooLong):
+		return apperror.New(
+			apperror.CodeValidationFailed,
+			apperror.BusinessCodeValueTooLong,
+			"search query must be at most 100 characters",
+			http.StatusBadRequest,
+		)
+	default:
+		return err
+	}
+}
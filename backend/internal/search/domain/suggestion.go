@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Query bounds and per-type result limits
+const (
+	MinQueryLength = 2   // Shorter prefixes match too much to be useful
+	MaxQueryLength = 100 // Longer than any title worth suggesting
+	DefaultLimit   = 5
+	MaxLimit       = 10
+)
+
+// Domain errors
+var (
+	ErrQueryTooShort = errors.New("search query is too short")
+	ErrQueryTooLong  = errors.New("search query is too long")
+)
+
+// Kind is the type of thing a suggestion points to
+type Kind string
+
+const (
+	KindPost   Kind = "post"
+	KindTheme  Kind = "theme"
+	KindAuthor Kind = "author"
+)
+
+// IsValid checks if the kind is one suggestions are made for
+func (k Kind) IsValid() bool {
+	switch k {
+	case KindPost, KindTheme, KindAuthor:
+		return true
+	default:
+		return false
+	}
+}
+
+// Kinds lists every kind, in the order suggestions are shown
+func Kinds() []Kind {
+	return []Kind{KindPost, KindTheme, KindAuthor}
+}
+
+// NormalizeQuery trims a typed prefix and collapses its inner whitespace, so
+// "  hexagonal   arch" suggests the same as "hexagonal arch"
+func NormalizeQuery(q string) (string, error) {
+	normalized := strings.Join(strings.Fields(q), " ")
+
+	length := utf8.RuneCountInString(normalized)
+	if length < MinQueryLength {
+		return "", ErrQueryTooShort
+	}
+	if length > MaxQueryLength {
+		return "", ErrQueryTooLong
+	}
+	return normalized, nil
+}
+
+// ClampLimit returns the per-type limit to apply: the default when none is
+// asked for, and never more than MaxLimit
+func ClampLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultLimit
+	}
+	if limit > MaxLimit {
+		return MaxLimit
+	}
+	return limit
+}
+
+// Suggestion is one completion for a typed prefix
+type Suggestion struct {
+	Kind  Kind
+	ID    uuid.UUID // The post, theme or author
+	Label string    // What matched: the title, name or display name
+	Slug  string    // Post or theme slug, or the author's username
+}
@@ -0,0 +1,52 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"backend/internal/search/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		q       string
+		want    string
+		wantErr error
+	}{
+		{name: "trims and collapses whitespace", q: "  hexagonal \t  arch ", want: "hexagonal arch"},
+		{name: "minimum length", q: "go", want: "go"},
+		{name: "too short", q: " g ", wantErr: domain.ErrQueryTooShort},
+		{name: "blank", q: "   ", wantErr: domain.ErrQueryTooShort},
+		{name: "counts runes not bytes", q: "日本", want: "日本"},
+		{name: "too long", q: strings.Repeat("a", domain.MaxQueryLength+1), wantErr: domain.ErrQueryTooLong},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := domain.NormalizeQuery(tt.q)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClampLimit(t *testing.T) {
+	assert.Equal(t, domain.DefaultLimit, domain.ClampLimit(0))
+	assert.Equal(t, domain.DefaultLimit, domain.ClampLimit(-3))
+	assert.Equal(t, 3, domain.ClampLimit(3))
+	assert.Equal(t, domain.MaxLimit, domain.ClampLimit(domain.MaxLimit+5))
+}
+
+func TestKind_IsValid(t *testing.T) {
+	for _, kind := range domain.Kinds() {
+		assert.True(t, kind.IsValid())
+	}
+	assert.False(t, domain.Kind("tag").IsValid())
+	assert.False(t, domain.Kind("").IsValid())
+}
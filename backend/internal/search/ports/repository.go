@@ -0,0 +1,31 @@
+package ports
+
+import (
+	"context"
+
+	"backend/internal/search/domain"
+	"github.com/google/uuid"
+)
+
+// PostScope narrows the posts suggested to those the viewer may read
+type PostScope struct {
+	AllStatuses bool       // Every post, whatever its status; for editors
+	Member      bool       // Members-only posts too; for signed-in readers
+	OwnerID     *uuid.UUID // The viewer's own posts too, drafts included
+}
+
+// SuggestionRepository defines the contract for prefix lookups
+// Every lookup is scoped to the current blog and matches the prefix at the
+// start of the label or of any word in it, case-insensitively
+type SuggestionRepository interface {
+	// SuggestPosts completes post titles among the posts the scope allows
+	SuggestPosts(ctx context.Context, prefix string, scope PostScope, limit int) ([]domain.Suggestion, error)
+
+	// SuggestThemes completes the names of active themes, and of the
+	// curator's own themes whatever their status when curatorID is set
+	SuggestThemes(ctx context.Context, prefix string, curatorID *uuid.UUID, limit int) ([]domain.Suggestion, error)
+
+	// SuggestAuthors completes the usernames and display names of the
+	// unsuspended users with a post listed to anonymous readers
+	SuggestAuthors(ctx context.Context, prefix string, limit int) ([]domain.Suggestion, error)
+}
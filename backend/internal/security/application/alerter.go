@@ -0,0 +1,35 @@
+package application
+
+import (
+	"context"
+
+	"backend/internal/platform/logger"
+	"backend/internal/security/domain"
+	"backend/internal/security/ports"
+)
+
+// LogAlerter writes alerts to the log instead of delivering them. It stands
+// in until a mail adapter implements ports.Alerter, so that high-risk
+// changes are still visible to operators.
+type LogAlerter struct {
+	logger logger.Logger
+}
+
+// NewLogAlerter creates a new logging alerter
+func NewLogAlerter(logger logger.Logger) *LogAlerter {
+	return &LogAlerter{logger: logger}
+}
+
+// Alert logs the alert the user would have been sent
+func (a *LogAlerter) Alert(ctx context.Context, event *domain.Event) error {
+	a.logger.Warn(ctx, "security alert",
+		"userID", event.UserID,
+		"kind", event.Kind,
+		"description", event.Description,
+		"occurredAt", event.OccurredAt,
+	)
+	return nil
+}
+
+// Compile-time check to ensure LogAlerter implements ports.Alerter
+var _ ports.Alerter = (*LogAlerter)(nil)
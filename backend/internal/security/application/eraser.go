@@ -0,0 +1,29 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"backend/internal/platform/erasure"
+	"backend/internal/security/ports"
+)
+
+// EventsEraser deletes a user's security log
+type EventsEraser struct {
+	repo ports.SecurityEventRepository
+}
+
+// NewEventsEraser creates a new security log eraser
+func NewEventsEraser(repo ports.SecurityEventRepository) *EventsEraser {
+	return &EventsEraser{repo: repo}
+}
+
+// Erase deletes every entry of the subject's security log
+// Implements the erasure.Eraser interface
+func (e *EventsEraser) Erase(ctx context.Context, subject erasure.Subject) (int, error) {
+	n, err := e.repo.DeleteByUser(ctx, subject.UserID)
+	if err != nil {
+		return 0, fmt.Errorf("EventsEraser.Erase: %w", err)
+	}
+	return n, nil
+}
@@ -0,0 +1,54 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend/internal/security/ports"
+	"github.com/google/uuid"
+)
+
+// exportPageSize is how many entries the exporter reads at a time
+const exportPageSize = 500
+
+// ExportedSecurityEvent is one entry of the security log as written to a data export
+type ExportedSecurityEvent struct {
+	Kind        string     `json:"kind"`
+	Description string     `json:"description"`
+	ActorID     *uuid.UUID `json:"actorId,omitempty"`
+	OccurredAt  time.Time  `json:"occurredAt"`
+}
+
+// EventsExporter exports a user's security log
+type EventsExporter struct {
+	repo ports.SecurityEventRepository
+}
+
+// NewEventsExporter creates a new security log exporter
+func NewEventsExporter(repo ports.SecurityEventRepository) *EventsExporter {
+	return &EventsExporter{repo: repo}
+}
+
+// Export returns every entry of the user's security log, newest first
+// Implements the dataexport.Exporter interface
+func (e *EventsExporter) Export(ctx context.Context, userID uuid.UUID) (any, error) {
+	exported := make([]ExportedSecurityEvent, 0)
+	for offset := 0; ; offset += exportPageSize {
+		entries, total, err := e.repo.ListByUser(ctx, userID, exportPageSize, offset)
+		if err != nil {
+			return nil, fmt.Errorf("EventsExporter.Export: %w", err)
+		}
+		for _, entry := range entries {
+			exported = append(exported, ExportedSecurityEvent{
+				Kind:        string(entry.Kind),
+				Description: entry.Description,
+				ActorID:     entry.ActorID,
+				OccurredAt:  entry.OccurredAt,
+			})
+		}
+		if len(entries) == 0 || offset+len(entries) >= total {
+			return exported, nil
+		}
+	}
+}
@@ -0,0 +1,15 @@
+package application
+
+import (
+	"backend/internal/security/ports"
+	"github.com/google/wire"
+)
+
+// ProviderSet is the wire provider set for the security log application layer
+var ProviderSet = wire.NewSet(
+	NewSecurityLogService,
+	NewLogAlerter,
+	wire.Bind(new(ports.Alerter), new(*LogAlerter)),
+	NewEventsEraser,
+	NewEventsExporter,
+)
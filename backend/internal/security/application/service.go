@@ -0,0 +1,151 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/events"
+	"backend/internal/platform/logger"
+	"backend/internal/security/domain"
+	"backend/internal/security/ports"
+	"github.com/google/uuid"
+)
+
+// SecurityLogService keeps each user's log of changes to their account's
+// access, fed by the events of the contexts making those changes, and alerts
+// the user to the high-risk ones
+type SecurityLogService struct {
+	repo    ports.SecurityEventRepository
+	alerter ports.Alerter
+	logger  logger.Logger
+}
+
+// NewSecurityLogService creates a new security log service
+func NewSecurityLogService(
+	repo ports.SecurityEventRepository,
+	alerter ports.Alerter,
+	logger logger.Logger,
+) *SecurityLogService {
+	return &SecurityLogService{
+		repo:    repo,
+		alerter: alerter,
+		logger:  logger,
+	}
+}
+
+// Subscribe registers the service's event handlers on the bus
+func (s *SecurityLogService) Subscribe(bus *eventbus.Bus) {
+	bus.Subscribe(events.UserRoleGrantedTopic, s.handleRoleChanged(domain.EventRoleGranted, "granted"))
+	bus.Subscribe(events.UserRoleRevokedTopic, s.handleRoleChanged(domain.EventRoleRevoked, "revoked"))
+	bus.Subscribe(events.UserPermissionGrantedTopic, s.handlePermissionChanged(domain.EventPermissionGranted, "granted"))
+	bus.Subscribe(events.UserPermissionRevokedTopic, s.handlePermissionChanged(domain.EventPermissionRevoked, "revoked"))
+	bus.Subscribe(events.APIClientRegisteredTopic, s.handleAPIClientChanged(domain.EventAPIClientCreated, "created"))
+	bus.Subscribe(events.APIClientRevokedTopic, s.handleAPIClientChanged(domain.EventAPIClientRevoked, "revoked"))
+	bus.Subscribe(events.UserSessionsRevokedTopic, s.handleSessionsRevoked)
+}
+
+// ListOwnEvents returns a page of the user's security log, newest first, and its length
+func (s *SecurityLogService) ListOwnEvents(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*domain.Event, int, error) {
+	entries, total, err := s.repo.ListByUser(ctx, userID, limit, offset)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list security events", "error", err, "userID", userID)
+		return nil, 0, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to list security events",
+			http.StatusInternalServerError,
+		)
+	}
+	return entries, total, nil
+}
+
+// handleRoleChanged records a role given to or taken from the user
+func (s *SecurityLogService) handleRoleChanged(kind domain.EventKind, verb string) eventbus.Handler {
+	return func(ctx context.Context, event eventbus.Event) error {
+		payload, ok := event.Payload.(events.UserRoleChangedEvent)
+		if !ok {
+			return fmt.Errorf("SecurityLogService.handleRoleChanged: unexpected payload %T", event.Payload)
+		}
+		entry, err := domain.NewEvent(payload.UserID, kind, payload.ActorID, payload.RoleID,
+			fmt.Sprintf("Role %q %s", payload.RoleName, verb), payload.OccurredAt)
+		if err != nil {
+			return fmt.Errorf("SecurityLogService.handleRoleChanged: %w", err)
+		}
+		return s.record(ctx, entry)
+	}
+}
+
+// handlePermissionChanged records a custom permission given to or taken from the user
+func (s *SecurityLogService) handlePermissionChanged(kind domain.EventKind, verb string) eventbus.Handler {
+	return func(ctx context.Context, event eventbus.Event) error {
+		payload, ok := event.Payload.(events.UserPermissionChangedEvent)
+		if !ok {
+			return fmt.Errorf("SecurityLogService.handlePermissionChanged: unexpected payload %T", event.Payload)
+		}
+		entry, err := domain.NewEvent(payload.UserID, kind, payload.ActorID, payload.PermissionID,
+			fmt.Sprintf("Permission %q %s", payload.Permission, verb), payload.OccurredAt)
+		if err != nil {
+			return fmt.Errorf("SecurityLogService.handlePermissionChanged: %w", err)
+		}
+		return s.record(ctx, entry)
+	}
+}
+
+// handleAPIClientChanged records an API token the user registered or revoked
+func (s *SecurityLogService) handleAPIClientChanged(kind domain.EventKind, verb string) eventbus.Handler {
+	return func(ctx context.Context, event eventbus.Event) error {
+		payload, ok := event.Payload.(events.APIClientChangedEvent)
+		if !ok {
+			return fmt.Errorf("SecurityLogService.handleAPIClientChanged: unexpected payload %T", event.Payload)
+		}
+		// Only owners manage their clients, so the owner is the actor
+		entry, err := domain.NewEvent(payload.OwnerID, kind, payload.OwnerID, payload.ClientID,
+			fmt.Sprintf("API client %q %s", payload.Name, verb), payload.OccurredAt)
+		if err != nil {
+			return fmt.Errorf("SecurityLogService.handleAPIClientChanged: %w", err)
+		}
+		return s.record(ctx, entry)
+	}
+}
+
+// handleSessionsRevoked records that every session of the user stopped working
+func (s *SecurityLogService) handleSessionsRevoked(ctx context.Context, event eventbus.Event) error {
+	payload, ok := event.Payload.(events.UserSessionsRevokedEvent)
+	if !ok {
+		return fmt.Errorf("SecurityLogService.handleSessionsRevoked: unexpected payload %T", event.Payload)
+	}
+	description := "All sessions revoked"
+	if payload.Reason != "" {
+		description += ": " + strings.ReplaceAll(payload.Reason, "_", " ")
+	}
+	entry, err := domain.NewEvent(payload.UserID, domain.EventSessionsRevoked, payload.ActorID, uuid.Nil,
+		description, payload.OccurredAt)
+	if err != nil {
+		return fmt.Errorf("SecurityLogService.handleSessionsRevoked: %w", err)
+	}
+	return s.record(ctx, entry)
+}
+
+// Private helper methods
+
+// record stores an entry and, when it is high-risk, alerts the user to it
+func (s *SecurityLogService) record(ctx context.Context, entry *domain.Event) error {
+	// The bus hands us the publisher's request context, which is cancelled
+	// once the response is written; the entry must be kept regardless
+	ctx = context.WithoutCancel(ctx)
+
+	if err := s.repo.Create(ctx, entry); err != nil {
+		return fmt.Errorf("SecurityLogService.record: %w", err)
+	}
+	if !entry.Kind.HighRisk() {
+		return nil
+	}
+	if err := s.alerter.Alert(ctx, entry); err != nil {
+		return fmt.Errorf("SecurityLogService.record: alert %s: %w", entry.Kind, err)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventKind identifies a change to what an account can do or who can use it
+type EventKind string
+
+const (
+	EventRoleGranted       EventKind = "role_granted"
+	EventRoleRevoked       EventKind = "role_revoked"
+	EventPermissionGranted EventKind = "permission_granted"
+	EventPermissionRevoked EventKind = "permission_revoked"
+	EventAPIClientCreated  EventKind = "api_client_created"
+	EventAPIClientRevoked  EventKind = "api_client_revoked"
+	EventSessionsRevoked   EventKind = "sessions_revoked"
+)
+
+// IsValid checks if the event kind is supported
+func (k EventKind) IsValid() bool {
+	switch k {
+	case EventRoleGranted, EventRoleRevoked,
+		EventPermissionGranted, EventPermissionRevoked,
+		EventAPIClientCreated, EventAPIClientRevoked,
+		EventSessionsRevoked:
+		return true
+	default:
+		return false
+	}
+}
+
+// HighRisk reports whether the user is alerted to the change outside the
+// app as well. Changes granting access are, since they are what an attacker
+// holding the account would make; so is losing every session, after which
+// the user cannot sign in to read the log.
+func (k EventKind) HighRisk() bool {
+	switch k {
+	case EventRoleGranted, EventPermissionGranted, EventAPIClientCreated, EventSessionsRevoked:
+		return true
+	default:
+		return false
+	}
+}
+
+// Event is one entry of a user's security log
+type Event struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	Kind        EventKind
+	ActorID     *uuid.UUID // User who made the change; nil when not known
+	SubjectID   *uuid.UUID // Role, permission or API client changed; nil for sessions
+	Description string
+	OccurredAt  time.Time
+}
+
+// Validation errors
+var (
+	ErrInvalidUserID    = errors.New("user ID is required")
+	ErrInvalidEventKind = errors.New("unsupported security event kind")
+)
+
+// NewEvent creates a security log entry. A nil actor or subject ID is stored as unknown.
+func NewEvent(userID uuid.UUID, kind EventKind, actorID, subjectID uuid.UUID, description string, occurredAt time.Time) (*Event, error) {
+	if userID == uuid.Nil {
+		return nil, ErrInvalidUserID
+	}
+	if !kind.IsValid() {
+		return nil, ErrInvalidEventKind
+	}
+
+	event := &Event{
+		ID:          uuid.New(),
+		UserID:      userID,
+		Kind:        kind,
+		Description: description,
+		OccurredAt:  occurredAt,
+	}
+	if actorID != uuid.Nil {
+		event.ActorID = &actorID
+	}
+	if subjectID != uuid.Nil {
+		event.SubjectID = &subjectID
+	}
+	return event, nil
+}
@@ -0,0 +1,36 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"backend/internal/security/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEvent(t *testing.T) {
+	userID, roleID := uuid.New(), uuid.New()
+	now := time.Date(2025, 8, 26, 9, 0, 0, 0, time.UTC)
+
+	event, err := domain.NewEvent(userID, domain.EventRoleGranted, uuid.Nil, roleID, `Role "editor" granted`, now)
+	require.NoError(t, err)
+	assert.Nil(t, event.ActorID, "an unknown actor is not stored as the nil UUID")
+	require.NotNil(t, event.SubjectID)
+	assert.Equal(t, roleID, *event.SubjectID)
+	assert.Equal(t, now, event.OccurredAt)
+
+	_, err = domain.NewEvent(uuid.Nil, domain.EventRoleGranted, uuid.Nil, roleID, "", now)
+	assert.ErrorIs(t, err, domain.ErrInvalidUserID)
+	_, err = domain.NewEvent(userID, domain.EventKind("password_changed"), uuid.Nil, uuid.Nil, "", now)
+	assert.ErrorIs(t, err, domain.ErrInvalidEventKind)
+}
+
+func TestEventKind_HighRisk(t *testing.T) {
+	assert.True(t, domain.EventPermissionGranted.HighRisk())
+	assert.True(t, domain.EventAPIClientCreated.HighRisk())
+	assert.True(t, domain.EventSessionsRevoked.HighRisk(), "the user cannot sign in to see it")
+	assert.False(t, domain.EventRoleRevoked.HighRisk())
+	assert.False(t, domain.EventAPIClientRevoked.HighRisk())
+}
@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+
+	"backend/internal/security/domain"
+)
+
+// Alerter tells a user about a high-risk change to their account outside
+// the app, typically by email. This is a driven port: the security log
+// decides when to alert, an adapter decides how the message is delivered.
+type Alerter interface {
+	Alert(ctx context.Context, event *domain.Event) error
+}
@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+
+	"backend/internal/security/domain"
+	"github.com/google/uuid"
+)
+
+// SecurityEventRepository defines the contract for security log persistence
+type SecurityEventRepository interface {
+	// Create stores an event; events of users who no longer exist are dropped
+	Create(ctx context.Context, event *domain.Event) error
+
+	// ListByUser returns a page of the user's events, newest first, and how many there are
+	ListByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*domain.Event, int, error)
+
+	// DeleteByUser deletes every event of a user and returns how many there were
+	DeleteByUser(ctx context.Context, userID uuid.UUID) (int, error)
+}
@@ -0,0 +1,48 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	"backend/internal/platform/logger"
+	"backend/internal/platform/ownership"
+	"backend/internal/series/ports"
+	"github.com/google/uuid"
+)
+
+// SeriesOwnershipChecker checks ownership of series
+// It depends directly on the repository, not the service, for cleaner architecture
+type SeriesOwnershipChecker struct {
+	repo   ports.SeriesRepository
+	logger logger.Logger
+}
+
+// NewSeriesOwnershipChecker creates a new series ownership checker
+func NewSeriesOwnershipChecker(repo ports.SeriesRepository, logger logger.Logger) *SeriesOwnershipChecker {
+	return &SeriesOwnershipChecker{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// CheckOwnership checks if a user is the author of a specific series
+// Implements the ownership.Checker interface
+func (c *SeriesOwnershipChecker) CheckOwnership(ctx context.Context, userID uuid.UUID, resourceID uuid.UUID) (bool, error) {
+	authorID, err := c.repo.GetSeriesAuthor(ctx, resourceID)
+	if err != nil {
+		if errors.Is(err, ports.ErrSeriesNotFound) {
+			// Series doesn't exist, so user doesn't own it
+			return false, nil
+		}
+		c.logger.Error(ctx, "failed to get series author", "error", err, "seriesID", resourceID)
+		return false, err
+	}
+
+	return authorID == userID, nil
+}
+
+// RegisterSeriesOwnership registers the series ownership checker with the registry
+func RegisterSeriesOwnership(registry ownership.Registry, repo ports.SeriesRepository, logger logger.Logger) {
+	checker := NewSeriesOwnershipChecker(repo, logger)
+	registry.RegisterChecker("series", checker)
+}
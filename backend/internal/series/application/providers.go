@@ -0,0 +1,11 @@
+package application
+
+import "github.com/google/wire"
+
+// ProviderSet is the wire provider set for the series application layer
+var ProviderSet = wire.NewSet(
+	NewSeriesService,
+	NewSeriesOwnershipChecker,
+	NewPostAdapter,
+	wire.Bind(new(PostProvider), new(*PostAdapter)),
+)
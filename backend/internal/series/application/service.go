@@ -0,0 +1,681 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/events"
+	"backend/internal/platform/logger"
+	"backend/internal/platform/postgres"
+	"backend/internal/platform/validator"
+	"backend/internal/series/domain"
+	"backend/internal/series/ports"
+	"github.com/google/uuid"
+)
+
+// Error definitions for service operations
+var (
+	ErrSeriesNotFound = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodeSeriesNotFound,
+		"series not found",
+		http.StatusNotFound,
+	)
+
+	ErrSlugAlreadyExists = apperror.New(
+		apperror.CodeConflict,
+		apperror.BusinessCodeSlugAlreadyExists,
+		"slug already exists",
+		http.StatusConflict,
+	)
+
+	ErrInvalidSeriesData = apperror.New(
+		apperror.CodeValidationFailed,
+		apperror.BusinessCodeInvalidFormat,
+		"invalid series data",
+		http.StatusBadRequest,
+	)
+
+	ErrPostNotOwned = apperror.New(
+		apperror.CodeForbidden,
+		apperror.BusinessCodePostNotOwnedByAuthor,
+		"only the series author's own posts can be added",
+		http.StatusForbidden,
+	)
+
+	ErrPostAlreadyInSeries = apperror.New(
+		apperror.CodeConflict,
+		apperror.BusinessCodePostAlreadyInSeries,
+		"post already belongs to a series",
+		http.StatusConflict,
+	)
+
+	ErrPostNotInSeries = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodePostNotInSeries,
+		"post not found in series",
+		http.StatusNotFound,
+	)
+)
+
+// PostProvider is an interface to get post information
+// This avoids direct dependency on the posts bounded context
+type PostProvider interface {
+	GetPost(ctx context.Context, id uuid.UUID) (domain.PostInfo, error)
+}
+
+// SeriesService handles series-related business logic
+type SeriesService struct {
+	uow          postgres.UnitOfWork
+	repo         ports.SeriesRepository
+	postProvider PostProvider
+	authorizer   ports.Authorizer
+	eventBus     *eventbus.Bus
+	logger       logger.Logger
+}
+
+// NewSeriesService creates a new series service
+func NewSeriesService(
+	uow postgres.UnitOfWork,
+	repo ports.SeriesRepository,
+	postProvider PostProvider,
+	authorizer ports.Authorizer,
+	eventBus *eventbus.Bus,
+	logger logger.Logger,
+) *SeriesService {
+	return &SeriesService{
+		uow:          uow,
+		repo:         repo,
+		postProvider: postProvider,
+		authorizer:   authorizer,
+		eventBus:     eventBus,
+		logger:       logger,
+	}
+}
+
+// CreateSeriesParams contains parameters for creating a new series
+type CreateSeriesParams struct {
+	Title       string
+	Description string
+}
+
+// CreateSeries creates a new series owned by the actor
+func (s *SeriesService) CreateSeries(ctx context.Context, actorID uuid.UUID, params CreateSeriesParams) (*domain.Series, error) {
+	// Check authorization - user must be able to create series
+	canCreate, err := s.authorizer.Can(ctx, actorID, "series", "create", nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canCreate {
+		return nil, apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to create series",
+			http.StatusForbidden,
+		)
+	}
+
+	// The actor becomes the author of the series
+	series, err := domain.NewSeries(params.Title, params.Description, actorID)
+	if err != nil {
+		return nil, ErrInvalidSeriesData.WithDetails(err.Error())
+	}
+
+	uniqueSlug, err := s.ensureUniqueSlug(ctx, series.Slug, nil)
+	if err != nil {
+		return nil, err
+	}
+	if uniqueSlug != series.Slug {
+		if err := series.UpdateSlug(uniqueSlug); err != nil {
+			return nil, ErrInvalidSeriesData.WithDetails(err.Error())
+		}
+	}
+
+	if err := s.repo.Create(ctx, series); err != nil {
+		s.logger.Error(ctx, "failed to create series", "error", err)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to create series",
+			http.StatusInternalServerError,
+		)
+	}
+
+	s.publishSeriesCreatedEvent(ctx, series, actorID)
+
+	return series, nil
+}
+
+// UpdateSeriesParams contains parameters for updating a series
+type UpdateSeriesParams struct {
+	Title       string
+	Description string
+}
+
+// UpdateSeries updates an existing series' details
+func (s *SeriesService) UpdateSeries(ctx context.Context, actorID uuid.UUID, id uuid.UUID, params UpdateSeriesParams) (*domain.Series, error) {
+	// Check authorization - user must be able to update this specific series
+	canUpdate, err := s.authorizer.Can(ctx, actorID, "series", "update", &id)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID, "seriesID", id)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canUpdate {
+		return nil, apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to update this series",
+			http.StatusForbidden,
+		)
+	}
+
+	// Load the full aggregate so Save doesn't drop the existing posts
+	series, err := s.loadSeriesWithPosts(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := series.Update(params.Title, params.Description); err != nil {
+		return nil, ErrInvalidSeriesData.WithDetails(err.Error())
+	}
+
+	// Regenerate the slug if the title changed
+	newSlug := validator.GenerateSlug(params.Title, domain.MaxSlugLength)
+	if newSlug != series.Slug {
+		uniqueSlug, err := s.ensureUniqueSlug(ctx, newSlug, &id)
+		if err != nil {
+			return nil, err
+		}
+		if err := series.UpdateSlug(uniqueSlug); err != nil {
+			return nil, ErrInvalidSeriesData.WithDetails(err.Error())
+		}
+	}
+
+	if err := s.saveSeriesWithTransaction(ctx, series); err != nil {
+		s.logger.Error(ctx, "failed to update series", "error", err, "seriesID", id)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to update series",
+			http.StatusInternalServerError,
+		)
+	}
+
+	s.publishSeriesUpdatedEvent(ctx, series, actorID)
+
+	return series, nil
+}
+
+// DeleteSeries removes a series; the posts themselves are left untouched
+func (s *SeriesService) DeleteSeries(ctx context.Context, actorID uuid.UUID, id uuid.UUID) error {
+	// Check authorization - user must be able to delete this specific series
+	canDelete, err := s.authorizer.Can(ctx, actorID, "series", "delete", &id)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID, "seriesID", id)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canDelete {
+		return apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to delete this series",
+			http.StatusForbidden,
+		)
+	}
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		if errors.Is(err, ports.ErrSeriesNotFound) {
+			return ErrSeriesNotFound
+		}
+		s.logger.Error(ctx, "failed to delete series", "error", err, "seriesID", id)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to delete series",
+			http.StatusInternalServerError,
+		)
+	}
+
+	s.publishSeriesDeletedEvent(ctx, id, actorID)
+
+	return nil
+}
+
+// AddPostToSeries appends one of the author's posts to the end of a series
+func (s *SeriesService) AddPostToSeries(ctx context.Context, actorID uuid.UUID, seriesID, postID uuid.UUID) error {
+	// Check authorization - user must be able to update this specific series
+	canUpdate, err := s.authorizer.Can(ctx, actorID, "series", "update", &seriesID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID, "seriesID", seriesID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canUpdate {
+		return apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to update this series",
+			http.StatusForbidden,
+		)
+	}
+
+	series, err := s.loadSeriesWithPosts(ctx, seriesID)
+	if err != nil {
+		return err
+	}
+
+	post, err := s.postProvider.GetPost(ctx, postID)
+	if err != nil {
+		return err
+	}
+
+	// A post belongs to at most one series so that its navigation is unambiguous
+	existing, err := s.repo.FindSeriesByPost(ctx, postID)
+	if err != nil && !errors.Is(err, ports.ErrSeriesNotFound) {
+		s.logger.Error(ctx, "failed to look up series for post", "error", err, "postID", postID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to add post to series",
+			http.StatusInternalServerError,
+		)
+	}
+	if existing != nil {
+		return ErrPostAlreadyInSeries.WithDetails(map[string]any{"seriesId": existing.ID})
+	}
+
+	if err := series.AddPost(post); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPostNotOwned):
+			return ErrPostNotOwned
+		case errors.Is(err, domain.ErrDuplicatePost):
+			return ErrPostAlreadyInSeries
+		default:
+			return ErrInvalidSeriesData.WithDetails(err.Error())
+		}
+	}
+
+	if err := s.saveSeriesWithTransaction(ctx, series); err != nil {
+		s.logger.Error(ctx, "failed to save series", "error", err, "seriesID", seriesID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to add post to series",
+			http.StatusInternalServerError,
+		)
+	}
+
+	if seriesPost, exists := series.GetPost(postID); exists {
+		s.publishSeriesPostAddedEvent(ctx, seriesID, postID, seriesPost.Position, actorID)
+	}
+
+	return nil
+}
+
+// RemovePostFromSeries removes a post from a series
+func (s *SeriesService) RemovePostFromSeries(ctx context.Context, actorID uuid.UUID, seriesID, postID uuid.UUID) error {
+	// Check authorization - user must be able to update this specific series
+	canUpdate, err := s.authorizer.Can(ctx, actorID, "series", "update", &seriesID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID, "seriesID", seriesID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canUpdate {
+		return apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to update this series",
+			http.StatusForbidden,
+		)
+	}
+
+	series, err := s.loadSeriesWithPosts(ctx, seriesID)
+	if err != nil {
+		return err
+	}
+
+	if err := series.RemovePost(postID); err != nil {
+		if errors.Is(err, domain.ErrPostNotFound) {
+			return ErrPostNotInSeries
+		}
+		return ErrInvalidSeriesData.WithDetails(err.Error())
+	}
+
+	if err := s.saveSeriesWithTransaction(ctx, series); err != nil {
+		s.logger.Error(ctx, "failed to save series", "error", err, "seriesID", seriesID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to remove post from series",
+			http.StatusInternalServerError,
+		)
+	}
+
+	s.publishSeriesPostRemovedEvent(ctx, seriesID, postID, actorID)
+
+	return nil
+}
+
+// ReorderSeriesPosts changes the order of posts in a series
+func (s *SeriesService) ReorderSeriesPosts(ctx context.Context, actorID uuid.UUID, seriesID uuid.UUID, orderedPostIDs []uuid.UUID) error {
+	// Check authorization - user must be able to update this specific series
+	canUpdate, err := s.authorizer.Can(ctx, actorID, "series", "update", &seriesID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID, "seriesID", seriesID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canUpdate {
+		return apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to update this series",
+			http.StatusForbidden,
+		)
+	}
+
+	series, err := s.loadSeriesWithPosts(ctx, seriesID)
+	if err != nil {
+		return err
+	}
+
+	if err := series.ReorderPosts(orderedPostIDs); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidPostCount):
+			return apperror.New(
+				apperror.CodeValidationFailed,
+				apperror.BusinessCodeInvalidFormat,
+				err.Error(),
+				http.StatusBadRequest,
+			)
+		case errors.Is(err, domain.ErrInvalidSeriesPostID):
+			return ErrPostNotInSeries
+		default:
+			return ErrInvalidSeriesData.WithDetails(err.Error())
+		}
+	}
+
+	if err := s.saveSeriesWithTransaction(ctx, series); err != nil {
+		s.logger.Error(ctx, "failed to save series", "error", err, "seriesID", seriesID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to reorder series posts",
+			http.StatusInternalServerError,
+		)
+	}
+
+	s.publishSeriesPostsReorderedEvent(ctx, seriesID, orderedPostIDs, actorID)
+
+	return nil
+}
+
+// GetSeries retrieves a series by ID with its posts
+func (s *SeriesService) GetSeries(ctx context.Context, id uuid.UUID) (*domain.Series, error) {
+	return s.loadSeriesWithPosts(ctx, id)
+}
+
+// GetSeriesBySlug retrieves a series by its slug with its posts
+func (s *SeriesService) GetSeriesBySlug(ctx context.Context, slug string) (*domain.Series, error) {
+	series, err := s.repo.FindBySlug(ctx, slug)
+	if err != nil {
+		if errors.Is(err, ports.ErrSeriesNotFound) {
+			return nil, ErrSeriesNotFound
+		}
+		s.logger.Error(ctx, "failed to find series by slug", "error", err, "slug", slug)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to retrieve series",
+			http.StatusInternalServerError,
+		)
+	}
+	return s.loadSeriesWithPosts(ctx, series.ID)
+}
+
+// ListSeries retrieves a list of series summaries
+func (s *SeriesService) ListSeries(ctx context.Context, filter ports.ListFilter) ([]*ports.SeriesSummary, int, error) {
+	summaries, err := s.repo.ListSeries(ctx, filter)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list series", "error", err)
+		return nil, 0, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to list series",
+			http.StatusInternalServerError,
+		)
+	}
+
+	count, err := s.repo.CountSeries(ctx, filter)
+	if err != nil {
+		s.logger.Error(ctx, "failed to count series", "error", err)
+		return nil, 0, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to count series",
+			http.StatusInternalServerError,
+		)
+	}
+
+	return summaries, count, nil
+}
+
+// GetPostNavigation returns previous/next navigation for a post within its series
+// Only published posts listed for readers take part in navigation. Returns nil when
+// the post is not part of a series or does not take part itself.
+func (s *SeriesService) GetPostNavigation(ctx context.Context, postID uuid.UUID) (*ports.PostNavigation, error) {
+	series, err := s.repo.FindSeriesByPost(ctx, postID)
+	if err != nil {
+		if errors.Is(err, ports.ErrSeriesNotFound) {
+			return nil, nil
+		}
+		s.logger.Error(ctx, "failed to find series for post", "error", err, "postID", postID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to retrieve series navigation",
+			http.StatusInternalServerError,
+		)
+	}
+
+	entries, err := s.repo.ListPublishedEntries(ctx, series.ID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list series entries", "error", err, "seriesID", series.ID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to retrieve series navigation",
+			http.StatusInternalServerError,
+		)
+	}
+
+	for i, entry := range entries {
+		if entry.PostID != postID {
+			continue
+		}
+
+		nav := &ports.PostNavigation{
+			SeriesID:    series.ID,
+			SeriesTitle: series.Title,
+			SeriesSlug:  series.Slug,
+			Position:    i + 1,
+			Total:       len(entries),
+		}
+		if i > 0 {
+			nav.Previous = entries[i-1]
+		}
+		if i < len(entries)-1 {
+			nav.Next = entries[i+1]
+		}
+		return nav, nil
+	}
+
+	return nil, nil
+}
+
+// Private helper methods
+
+// loadSeriesWithPosts loads the full aggregate and handles not-found errors consistently
+func (s *SeriesService) loadSeriesWithPosts(ctx context.Context, id uuid.UUID) (*domain.Series, error) {
+	series, err := s.repo.LoadSeriesWithPosts(ctx, id)
+	if err != nil {
+		if errors.Is(err, ports.ErrSeriesNotFound) {
+			return nil, ErrSeriesNotFound
+		}
+		s.logger.Error(ctx, "failed to load series", "error", err, "seriesID", id)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to retrieve series",
+			http.StatusInternalServerError,
+		)
+	}
+	return series, nil
+}
+
+// saveSeriesWithTransaction saves the series aggregate within a unit of work
+func (s *SeriesService) saveSeriesWithTransaction(ctx context.Context, series *domain.Series) error {
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.Save(ctx, series)
+	})
+}
+
+// ensureUniqueSlug ensures a slug is unique, potentially adding a numeric suffix
+func (s *SeriesService) ensureUniqueSlug(ctx context.Context, baseSlug string, excludeID *uuid.UUID) (string, error) {
+	slug := baseSlug
+	suffix := 1
+
+	for {
+		exists, err := s.repo.SlugExists(ctx, slug, excludeID)
+		if err != nil {
+			s.logger.Error(ctx, "failed to check slug existence", "error", err, "slug", slug)
+			return "", apperror.New(
+				apperror.CodeInternalError,
+				apperror.BusinessCodeGeneral,
+				"failed to validate slug",
+				http.StatusInternalServerError,
+			)
+		}
+
+		if !exists {
+			return slug, nil
+		}
+
+		slug = validator.MakeSlugUniqueWithMaxLength(baseSlug, suffix, domain.MaxSlugLength)
+		suffix++
+
+		// Prevent infinite loop
+		if suffix > 100 {
+			return "", ErrSlugAlreadyExists.WithDetails("unable to generate unique slug")
+		}
+	}
+}
+
+// Event publishing methods
+
+func (s *SeriesService) publishSeriesCreatedEvent(ctx context.Context, series *domain.Series, actorID uuid.UUID) {
+	s.eventBus.Publish(ctx, eventbus.Event{
+		Topic: events.SeriesCreatedTopic,
+		Payload: events.SeriesCreatedEvent{
+			SeriesID:   series.ID,
+			ActorID:    actorID,
+			Title:      series.Title,
+			Slug:       series.Slug,
+			OccurredAt: time.Now(),
+		},
+	})
+}
+
+func (s *SeriesService) publishSeriesUpdatedEvent(ctx context.Context, series *domain.Series, actorID uuid.UUID) {
+	s.eventBus.Publish(ctx, eventbus.Event{
+		Topic: events.SeriesUpdatedTopic,
+		Payload: events.SeriesUpdatedEvent{
+			SeriesID:   series.ID,
+			ActorID:    actorID,
+			Title:      series.Title,
+			Slug:       series.Slug,
+			OccurredAt: time.Now(),
+		},
+	})
+}
+
+func (s *SeriesService) publishSeriesDeletedEvent(ctx context.Context, seriesID, actorID uuid.UUID) {
+	s.eventBus.Publish(ctx, eventbus.Event{
+		Topic: events.SeriesDeletedTopic,
+		Payload: events.SeriesDeletedEvent{
+			SeriesID:   seriesID,
+			ActorID:    actorID,
+			OccurredAt: time.Now(),
+		},
+	})
+}
+
+func (s *SeriesService) publishSeriesPostAddedEvent(ctx context.Context, seriesID, postID uuid.UUID, position int, actorID uuid.UUID) {
+	s.eventBus.Publish(ctx, eventbus.Event{
+		Topic: events.SeriesPostAddedTopic,
+		Payload: events.SeriesPostAddedEvent{
+			SeriesID:   seriesID,
+			PostID:     postID,
+			Position:   position,
+			ActorID:    actorID,
+			OccurredAt: time.Now(),
+		},
+	})
+}
+
+func (s *SeriesService) publishSeriesPostRemovedEvent(ctx context.Context, seriesID, postID, actorID uuid.UUID) {
+	s.eventBus.Publish(ctx, eventbus.Event{
+		Topic: events.SeriesPostRemovedTopic,
+		Payload: events.SeriesPostRemovedEvent{
+			SeriesID:   seriesID,
+			PostID:     postID,
+			ActorID:    actorID,
+			OccurredAt: time.Now(),
+		},
+	})
+}
+
+func (s *SeriesService) publishSeriesPostsReorderedEvent(ctx context.Context, seriesID uuid.UUID, orderedPostIDs []uuid.UUID, actorID uuid.UUID) {
+	s.eventBus.Publish(ctx, eventbus.Event{
+		Topic: events.SeriesPostsReorderedTopic,
+		Payload: events.SeriesPostsReorderedEvent{
+			SeriesID:       seriesID,
+			OrderedPostIDs: orderedPostIDs,
+			ActorID:        actorID,
+			OccurredAt:     time.Now(),
+		},
+	})
+}
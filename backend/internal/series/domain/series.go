@@ -0,0 +1,243 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"backend/internal/platform/validator"
+	"github.com/google/uuid"
+)
+
+// PostInfo is the minimal view of a post the series aggregate needs
+// It is satisfied by the posts domain model without importing it
+type PostInfo interface {
+	GetID() uuid.UUID
+	GetAuthorID() uuid.UUID
+}
+
+// Series represents an author's ordered sequence of their own posts
+// Unlike themes, a series may only contain posts written by its author
+type Series struct {
+	ID          uuid.UUID
+	Title       string
+	Slug        string
+	Description string
+	AuthorID    uuid.UUID
+	Posts       []*SeriesPost // Posts in this series, ordered by position
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Business rule constants
+const (
+	MaxTitleLength       = 200
+	MaxSlugLength        = 250
+	MaxDescriptionLength = 1000
+)
+
+// Validation errors
+var (
+	ErrInvalidTitle        = errors.New("title is required and must not exceed 200 characters")
+	ErrInvalidSlug         = errors.New("slug is invalid or too long")
+	ErrInvalidDescription  = errors.New("description must not exceed 1000 characters")
+	ErrInvalidAuthorID     = errors.New("author ID is required")
+	ErrPostNotOwned        = errors.New("only the series author's own posts can be added")
+	ErrPostNotFound        = errors.New("post not found in series")
+	ErrInvalidPostCount    = errors.New("number of post IDs doesn't match number of posts in series")
+	ErrInvalidSeriesPostID = errors.New("post ID not found in series")
+)
+
+// NewSeries creates a new series with validation
+func NewSeries(title, description string, authorID uuid.UUID) (*Series, error) {
+	if err := validateTitle(title); err != nil {
+		return nil, err
+	}
+
+	// Generate slug from title
+	slug := validator.GenerateSlug(title, MaxSlugLength)
+	if err := validateSeriesSlug(slug); err != nil {
+		return nil, err
+	}
+
+	if err := validateDescription(description); err != nil {
+		return nil, err
+	}
+
+	if authorID == uuid.Nil {
+		return nil, ErrInvalidAuthorID
+	}
+
+	now := time.Now()
+	return &Series{
+		ID:          uuid.New(),
+		Title:       title,
+		Slug:        slug,
+		Description: description,
+		AuthorID:    authorID,
+		Posts:       make([]*SeriesPost, 0),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+// Update updates the series details with validation
+func (s *Series) Update(title, description string) error {
+	if err := validateTitle(title); err != nil {
+		return err
+	}
+
+	if err := validateDescription(description); err != nil {
+		return err
+	}
+
+	s.Title = title
+	s.Description = description
+	s.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// UpdateSlug updates the series slug with validation
+func (s *Series) UpdateSlug(slug string) error {
+	if err := validateSeriesSlug(slug); err != nil {
+		return err
+	}
+
+	s.Slug = slug
+	s.UpdatedAt = time.Now()
+	return nil
+}
+
+// Post Management Methods (Aggregate Root pattern)
+
+// AddPost appends a post to the end of the series
+func (s *Series) AddPost(post PostInfo) error {
+	// Business rule: A series only contains its author's own posts
+	if post.GetAuthorID() != s.AuthorID {
+		return ErrPostNotOwned
+	}
+
+	postID := post.GetID()
+	if s.HasPost(postID) {
+		return ErrDuplicatePost
+	}
+
+	seriesPost, err := NewSeriesPost(s.ID, postID, len(s.Posts)+1)
+	if err != nil {
+		return err
+	}
+
+	s.Posts = append(s.Posts, seriesPost)
+	s.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// RemovePost removes a post from the series and closes the gap in positions
+func (s *Series) RemovePost(postID uuid.UUID) error {
+	var found bool
+	var removedPosition int
+	remaining := make([]*SeriesPost, 0, len(s.Posts))
+
+	for _, seriesPost := range s.Posts {
+		if seriesPost.PostID == postID {
+			found = true
+			removedPosition = seriesPost.Position
+		} else {
+			remaining = append(remaining, seriesPost)
+		}
+	}
+
+	if !found {
+		return ErrPostNotFound
+	}
+
+	for _, seriesPost := range remaining {
+		if seriesPost.Position > removedPosition {
+			seriesPost.Position--
+			seriesPost.UpdatedAt = time.Now()
+		}
+	}
+
+	s.Posts = remaining
+	s.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// ReorderPosts changes the order of posts in the series
+func (s *Series) ReorderPosts(orderedPostIDs []uuid.UUID) error {
+	if len(orderedPostIDs) != len(s.Posts) {
+		return ErrInvalidPostCount
+	}
+
+	postMap := make(map[uuid.UUID]*SeriesPost, len(s.Posts))
+	for _, seriesPost := range s.Posts {
+		postMap[seriesPost.PostID] = seriesPost
+	}
+
+	// Validate every ID exists and none is repeated
+	seen := make(map[uuid.UUID]bool, len(orderedPostIDs))
+	for _, postID := range orderedPostIDs {
+		if _, exists := postMap[postID]; !exists || seen[postID] {
+			return ErrInvalidSeriesPostID
+		}
+		seen[postID] = true
+	}
+
+	reordered := make([]*SeriesPost, len(orderedPostIDs))
+	for i, postID := range orderedPostIDs {
+		seriesPost := postMap[postID]
+		seriesPost.Position = i + 1
+		seriesPost.UpdatedAt = time.Now()
+		reordered[i] = seriesPost
+	}
+
+	s.Posts = reordered
+	s.UpdatedAt = time.Now()
+	return nil
+}
+
+// GetPost retrieves a specific post entry from the series
+func (s *Series) GetPost(postID uuid.UUID) (*SeriesPost, bool) {
+	for _, seriesPost := range s.Posts {
+		if seriesPost.PostID == postID {
+			return seriesPost, true
+		}
+	}
+	return nil, false
+}
+
+// HasPost checks if a post is in the series
+func (s *Series) HasPost(postID uuid.UUID) bool {
+	_, exists := s.GetPost(postID)
+	return exists
+}
+
+// PostCount returns the number of posts in the series
+func (s *Series) PostCount() int {
+	return len(s.Posts)
+}
+
+// Validation helpers
+
+func validateTitle(title string) error {
+	if title == "" || len(title) > MaxTitleLength {
+		return ErrInvalidTitle
+	}
+	return nil
+}
+
+func validateDescription(description string) error {
+	if len(description) > MaxDescriptionLength {
+		return ErrInvalidDescription
+	}
+	return nil
+}
+
+func validateSeriesSlug(slug string) error {
+	if err := validator.ValidateSlugFormat(slug, MaxSlugLength); err != nil {
+		return ErrInvalidSlug
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SeriesPost represents a post's place within a series
+// This is part of the Series aggregate and should only be created/modified through Series methods
+type SeriesPost struct {
+	SeriesID  uuid.UUID
+	PostID    uuid.UUID
+	Position  int // Order within the series (1-based)
+	AddedAt   time.Time
+	UpdatedAt time.Time
+}
+
+// Additional validation errors for series posts
+var (
+	ErrDuplicatePost = errors.New("post is already in this series")
+)
+
+// NewSeriesPost creates a new series post entry
+// This is an internal factory used by the Series aggregate
+func NewSeriesPost(seriesID, postID uuid.UUID, position int) (*SeriesPost, error) {
+	if seriesID == uuid.Nil {
+		return nil, errors.New("series ID is required")
+	}
+
+	if postID == uuid.Nil {
+		return nil, errors.New("post ID is required")
+	}
+
+	if position <= 0 {
+		return nil, errors.New("position must be greater than 0")
+	}
+
+	now := time.Now()
+	return &SeriesPost{
+		SeriesID:  seriesID,
+		PostID:    postID,
+		Position:  position,
+		AddedAt:   now,
+		UpdatedAt: now,
+	}, nil
+}
@@ -0,0 +1,101 @@
+package domain_test
+
+import (
+	"testing"
+
+	"backend/internal/series/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testPost struct {
+	id       uuid.UUID
+	authorID uuid.UUID
+}
+
+func (p testPost) GetID() uuid.UUID       { return p.id }
+func (p testPost) GetAuthorID() uuid.UUID { return p.authorID }
+
+func newTestSeries(t *testing.T) *domain.Series {
+	t.Helper()
+	series, err := domain.NewSeries("Building a Blog", "A step by step guide", uuid.New())
+	require.NoError(t, err)
+	return series
+}
+
+func TestNewSeries(t *testing.T) {
+	authorID := uuid.New()
+	series, err := domain.NewSeries("Building a Blog", "A step by step guide", authorID)
+
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, series.ID)
+	assert.Equal(t, authorID, series.AuthorID)
+	assert.Empty(t, series.Posts)
+
+	_, err = domain.NewSeries("", "", authorID)
+	assert.ErrorIs(t, err, domain.ErrInvalidTitle)
+
+	_, err = domain.NewSeries("Title", "", uuid.Nil)
+	assert.ErrorIs(t, err, domain.ErrInvalidAuthorID)
+}
+
+func TestSeries_AddPost(t *testing.T) {
+	series := newTestSeries(t)
+	first := testPost{id: uuid.New(), authorID: series.AuthorID}
+	second := testPost{id: uuid.New(), authorID: series.AuthorID}
+
+	require.NoError(t, series.AddPost(first))
+	require.NoError(t, series.AddPost(second))
+	assert.Equal(t, 2, series.PostCount())
+	assert.Equal(t, 1, series.Posts[0].Position)
+	assert.Equal(t, 2, series.Posts[1].Position)
+
+	// Duplicate posts are rejected
+	assert.ErrorIs(t, series.AddPost(first), domain.ErrDuplicatePost)
+
+	// Posts by another author are rejected
+	foreign := testPost{id: uuid.New(), authorID: uuid.New()}
+	assert.ErrorIs(t, series.AddPost(foreign), domain.ErrPostNotOwned)
+}
+
+func TestSeries_RemovePost(t *testing.T) {
+	series := newTestSeries(t)
+	posts := []testPost{
+		{id: uuid.New(), authorID: series.AuthorID},
+		{id: uuid.New(), authorID: series.AuthorID},
+		{id: uuid.New(), authorID: series.AuthorID},
+	}
+	for _, p := range posts {
+		require.NoError(t, series.AddPost(p))
+	}
+
+	require.NoError(t, series.RemovePost(posts[0].id))
+	require.Len(t, series.Posts, 2)
+
+	// Remaining posts close the gap
+	assert.Equal(t, posts[1].id, series.Posts[0].PostID)
+	assert.Equal(t, 1, series.Posts[0].Position)
+	assert.Equal(t, posts[2].id, series.Posts[1].PostID)
+	assert.Equal(t, 2, series.Posts[1].Position)
+
+	assert.ErrorIs(t, series.RemovePost(uuid.New()), domain.ErrPostNotFound)
+}
+
+func TestSeries_ReorderPosts(t *testing.T) {
+	series := newTestSeries(t)
+	first := testPost{id: uuid.New(), authorID: series.AuthorID}
+	second := testPost{id: uuid.New(), authorID: series.AuthorID}
+	require.NoError(t, series.AddPost(first))
+	require.NoError(t, series.AddPost(second))
+
+	require.NoError(t, series.ReorderPosts([]uuid.UUID{second.id, first.id}))
+	assert.Equal(t, second.id, series.Posts[0].PostID)
+	assert.Equal(t, 1, series.Posts[0].Position)
+	assert.Equal(t, first.id, series.Posts[1].PostID)
+	assert.Equal(t, 2, series.Posts[1].Position)
+
+	assert.ErrorIs(t, series.ReorderPosts([]uuid.UUID{first.id}), domain.ErrInvalidPostCount)
+	assert.ErrorIs(t, series.ReorderPosts([]uuid.UUID{first.id, first.id}), domain.ErrInvalidSeriesPostID)
+	assert.ErrorIs(t, series.ReorderPosts([]uuid.UUID{first.id, uuid.New()}), domain.ErrInvalidSeriesPostID)
+}
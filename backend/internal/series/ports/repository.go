@@ -0,0 +1,88 @@
+package ports
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"backend/internal/series/domain"
+	"github.com/google/uuid"
+)
+
+// Repository errors (canonical errors for the repository contract)
+var (
+	// ErrSeriesNotFound is returned when a series cannot be found
+	ErrSeriesNotFound = errors.New("series not found")
+)
+
+// SeriesRepository defines the contract for series persistence
+// Like themes, the Series aggregate (including its posts) is persisted
+// atomically through a single Save operation
+type SeriesRepository interface {
+	// Core aggregate operations
+	Create(ctx context.Context, series *domain.Series) error
+
+	// Save persists the entire aggregate:
+	// - Updates series fields in the series table
+	// - Diffs series.Posts against database state and syncs series_posts
+	Save(ctx context.Context, series *domain.Series) error
+
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// Loading operations
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.Series, error)                   // Loads series without posts
+	FindBySlug(ctx context.Context, slug string) (*domain.Series, error)                  // Loads series without posts
+	LoadSeriesWithPosts(ctx context.Context, id uuid.UUID) (*domain.Series, error)        // Loads full aggregate
+	FindSeriesByPost(ctx context.Context, postID uuid.UUID) (*domain.Series, error)       // Series containing a post, without posts
+	ListPublishedEntries(ctx context.Context, seriesID uuid.UUID) ([]*SeriesEntry, error) // Published posts listed for readers, ordered by position
+
+	// Series listing and filtering
+	ListSeries(ctx context.Context, filter ListFilter) ([]*SeriesSummary, error)
+	CountSeries(ctx context.Context, filter ListFilter) (int, error)
+
+	// Slug operations
+	SlugExists(ctx context.Context, slug string, excludeID *uuid.UUID) (bool, error)
+
+	// GetSeriesAuthor retrieves just the author ID for a series (for ownership checks)
+	GetSeriesAuthor(ctx context.Context, seriesID uuid.UUID) (uuid.UUID, error)
+}
+
+// ListFilter defines filtering options for series listings
+type ListFilter struct {
+	AuthorID *uuid.UUID
+	Limit    int
+	Offset   int
+}
+
+// SeriesSummary is a lightweight DTO for series listings
+type SeriesSummary struct {
+	ID          uuid.UUID
+	Title       string
+	Slug        string
+	Description string
+	AuthorID    uuid.UUID
+	AuthorName  string // Joined from users table
+	PostCount   int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// SeriesEntry is a lightweight view of a post within a series
+// Used to build previous/next navigation for readers
+type SeriesEntry struct {
+	PostID   uuid.UUID
+	Title    string
+	Slug     string
+	Position int
+}
+
+// PostNavigation describes where a post sits within its series
+type PostNavigation struct {
+	SeriesID    uuid.UUID
+	SeriesTitle string
+	SeriesSlug  string
+	Position    int // 1-based position among the published posts of the series
+	Total       int // Number of published posts in the series
+	Previous    *SeriesEntry
+	Next        *SeriesEntry
+}
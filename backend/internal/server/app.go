@@ -2,32 +2,96 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
-	"os"
 	"os/signal"
+	"sync"
 	"syscall"
-	"time"
+
+	analyticsApp "backend/internal/analytics/application"
+	apiclientsApp "backend/internal/apiclients/application"
+	authzApp "backend/internal/authz/application"
+	linkreportsApp "backend/internal/linkreports/application"
+	mentionsApp "backend/internal/mentions/application"
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/seeder"
+	privacyApp "backend/internal/privacy/application"
+	retentionApp "backend/internal/retention/application"
 )
 
 type App struct {
-	server *http.Server
-	config Config
+	server  *http.Server
+	config  Config
+	bus     *eventbus.Bus
+	seeders *seeder.Registry
+	authz   *authzApp.AuthzService
+	jobs    []job
+	running sync.WaitGroup // The jobs started by startJobs, awaited at shutdown
+}
+
+// job is background work that runs until the context it is given is done
+type job interface {
+	Run(ctx context.Context)
 }
 
-func NewApp(server *http.Server, config Config) *App {
+// NewApp creates the application. EventSubscriptions, OwnershipCheckers,
+// Erasers and Exporters are taken only so that they are registered before the
+// server starts.
+func NewApp(
+	server *http.Server,
+	config Config,
+	bus *eventbus.Bus,
+	seeders *seeder.Registry,
+	authz *authzApp.AuthzService,
+	retention *retentionApp.Job,
+	linkCheck *linkreportsApp.Job,
+	apiClientUsage *apiclientsApp.UsageJob,
+	erasure *privacyApp.Job,
+	dataExport *privacyApp.ExportJob,
+	viewRollup *analyticsApp.Job,
+	mentionVerify *mentionsApp.Job,
+	_ EventSubscriptions,
+	_ OwnershipCheckers,
+	_ Erasers,
+	_ Exporters,
+) *App {
 	return &App{
-		server: server,
-		config: config,
+		server:  server,
+		config:  config,
+		bus:     bus,
+		seeders: seeders,
+		authz:   authz,
+		jobs:    []job{retention, linkCheck, apiClientUsage, erasure, dataExport, viewRollup, mentionVerify},
 	}
 }
 
+// Handler returns the root HTTP handler, so tests can serve the full stack without listening
+func (a *App) Handler() http.Handler {
+	return a.server.Handler
+}
+
+// Seed runs the seeders of a profile; call it before Run so the server starts on seeded data
+func (a *App) Seed(ctx context.Context, profile seeder.Profile) error {
+	return a.seeders.Run(ctx, profile)
+}
+
 // Run starts the application and handles graceful shutdown
 func (a *App) Run() error {
 	// Set up signal handling for graceful shutdown
-	sigChan := make(chan os.Signal, 1)
-	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// Report permissions that drifted between code and database, after any
+	// seeding; the check failing is logged rather than keeping the API down
+	if _, err := a.authz.ReconcilePermissions(ctx, a.config.AuthzSeedMissingPermissions); err != nil {
+		log.Printf("Permission reconciliation failed: %v", err)
+	}
+
+	// Scheduled jobs stop with the shutdown signal; work they leave
+	// uncommitted is rolled back and picked up by their next run
+	a.startJobs(ctx)
 
 	// Start server in a goroutine
 	serverErrors := make(chan error, 1)
@@ -39,22 +103,79 @@ func (a *App) Run() error {
 	// Wait for shutdown signal or server error
 	select {
 	case err := <-serverErrors:
+		// Stop the jobs and let them finish before the caller closes what they use
+		stop()
+		if waitErr := a.shutdown(); waitErr != nil {
+			log.Printf("Shutdown after server error: %v", waitErr)
+		}
 		if err != nil && err != http.ErrServerClosed {
 			return fmt.Errorf("server error: %w", err)
 		}
-	case sig := <-sigChan:
-		log.Printf("Received signal: %v", sig)
-		log.Println("Shutting down server...")
-
-		// Graceful shutdown with timeout
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
+	case <-ctx.Done():
+		// Restore default signal handling so a second signal kills the process
+		stop()
+		log.Println("Received shutdown signal, draining...")
 
-		if err := a.server.Shutdown(ctx); err != nil {
-			return fmt.Errorf("failed to gracefully shutdown server: %w", err)
+		if err := a.shutdown(); err != nil {
+			return err
 		}
 	}
 
 	log.Println("Server stopped")
 	return nil
 }
+
+// startJobs runs every job in the background until ctx is done
+func (a *App) startJobs(ctx context.Context) {
+	for _, j := range a.jobs {
+		a.running.Add(1)
+		go func() {
+			defer a.running.Done()
+			j.Run(ctx)
+		}()
+	}
+}
+
+// shutdown drains the application within the configured timeout, once the
+// jobs' context is done. In-flight requests finish first, since they may still
+// publish events; the jobs and the event handlers they all started are then
+// awaited, so none is cut off mid-transaction by the database pool closing.
+func (a *App) shutdown() error {
+	ctx, cancel := context.WithTimeout(context.Background(), a.config.ShutdownTimeout)
+	defer cancel()
+
+	var errs []error
+
+	// Stop accepting connections and wait for in-flight requests
+	if err := a.server.Shutdown(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("failed to gracefully shutdown server: %w", err))
+	}
+
+	// Wait for the jobs, which may also publish events
+	if err := waitGroup(ctx, &a.running); err != nil {
+		errs = append(errs, fmt.Errorf("jobs still running at shutdown: %w", err))
+	}
+
+	// Wait for asynchronous event handlers, even if requests were cut off
+	if err := a.bus.Drain(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("event handlers still running at shutdown: %w", err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// waitGroup waits for wg, giving up when ctx is done
+func waitGroup(ctx context.Context, wg *sync.WaitGroup) error {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
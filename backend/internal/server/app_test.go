@@ -0,0 +1,56 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"backend/internal/platform/errreport"
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+// slowJob takes a while to wind down once its context is done
+type slowJob struct {
+	finished atomic.Bool
+}
+
+func (j *slowJob) Run(ctx context.Context) {
+	<-ctx.Done()
+	time.Sleep(20 * time.Millisecond)
+	j.finished.Store(true)
+}
+
+func TestAppShutdownWaitsForJobs(t *testing.T) {
+	newApp := func(timeout time.Duration, j job) *App {
+		return &App{
+			server: &http.Server{},
+			config: Config{ShutdownTimeout: timeout},
+			bus:    eventbus.NewBus(logger.NewSlogAdapter("test", "error"), errreport.Nop{}),
+			jobs:   []job{j},
+		}
+	}
+
+	t.Run("jobs finish before shutdown returns", func(t *testing.T) {
+		j := &slowJob{}
+		app := newApp(time.Second, j)
+		ctx, cancel := context.WithCancel(context.Background())
+		app.startJobs(ctx)
+
+		cancel()
+		assert.NoError(t, app.shutdown())
+		assert.True(t, j.finished.Load())
+	})
+
+	t.Run("shutdown gives up on jobs at the timeout", func(t *testing.T) {
+		app := newApp(time.Millisecond, &slowJob{})
+		ctx, cancel := context.WithCancel(context.Background())
+		app.startJobs(ctx)
+
+		cancel()
+		assert.ErrorIs(t, app.shutdown(), context.DeadlineExceeded)
+	})
+}
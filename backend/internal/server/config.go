@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"strings"
+	"time"
 
 	"backend/internal/platform/logger"
 	"github.com/joho/godotenv"
@@ -18,8 +20,177 @@ type Config struct {
 	ServerAddress string `mapstructure:"SERVER_ADDRESS"`
 	Environment   string `mapstructure:"ENVIRONMENT"`
 	LogLevel      string `mapstructure:"LOG_LEVEL"` // Logging level (debug, info, warn, error)
+
+	// Log output; LOG_MODULE_LEVELS overrides the level per module, as posts=debug,
+	// and debug lines repeated within a second beyond the first few are sampled
+	LogFormat                string   `mapstructure:"LOG_FORMAT"`
+	LogModuleLevels          []string `mapstructure:"LOG_MODULE_LEVELS"`
+	LogDebugSampleFirst      int      `mapstructure:"LOG_DEBUG_SAMPLE_FIRST"`
+	LogDebugSampleThereafter int      `mapstructure:"LOG_DEBUG_SAMPLE_THEREAFTER"`
+
+	// Database pool and statement cache tuning; see postgres.PoolOptions
+	DBMaxConns                 int32         `mapstructure:"DB_MAX_CONNS"`
+	DBMinConns                 int32         `mapstructure:"DB_MIN_CONNS"`
+	DBMaxConnLifetime          time.Duration `mapstructure:"DB_MAX_CONN_LIFETIME"`
+	DBMaxConnIdleTime          time.Duration `mapstructure:"DB_MAX_CONN_IDLE_TIME"`
+	DBHealthCheckPeriod        time.Duration `mapstructure:"DB_HEALTH_CHECK_PERIOD"`
+	DBQueryExecMode            string        `mapstructure:"DB_QUERY_EXEC_MODE"`
+	DBStatementCacheCapacity   int           `mapstructure:"DB_STATEMENT_CACHE_CAPACITY"`
+	DBDescriptionCacheCapacity int           `mapstructure:"DB_DESCRIPTION_CACHE_CAPACITY"`
+
+	// Master keys for encrypted columns, as comma-separated id:base64-key pairs
+	// with the current key first; see postgres.ParseLocalKeys
+	FieldEncryptionKeys string `mapstructure:"FIELD_ENCRYPTION_KEYS"`
+
+	// ShutdownTimeout bounds how long shutdown waits for requests, jobs and event handlers to finish
+	ShutdownTimeout time.Duration `mapstructure:"SHUTDOWN_TIMEOUT"`
+
+	// Cache settings; without REDIS_URL each instance caches in memory
+	RedisURL          string        `mapstructure:"REDIS_URL"`
+	CachePostTTL      time.Duration `mapstructure:"CACHE_POST_TTL"`
+	CacheThemeTTL     time.Duration `mapstructure:"CACHE_THEME_TTL"`
+	CacheThemeListTTL time.Duration `mapstructure:"CACHE_THEME_LIST_TTL"`
+	CacheSettingsTTL  time.Duration `mapstructure:"CACHE_SETTINGS_TTL"`
+	CacheHomeTTL      time.Duration `mapstructure:"CACHE_HOME_TTL"`
+	CacheTrendingTTL  time.Duration `mapstructure:"CACHE_TRENDING_TTL"`
+	CacheRenderedTTL  time.Duration `mapstructure:"CACHE_RENDERED_TTL"`
+
+	// HTTP response caching; purges reach the CDN only when CDN_PURGE_URL is set
+	ResponseCacheEnabled bool   `mapstructure:"RESPONSE_CACHE_ENABLED"`
+	CDNPurgeURL          string `mapstructure:"CDN_PURGE_URL"`
+	CDNPurgeToken        string `mapstructure:"CDN_PURGE_TOKEN"`
+
+	// CORS policy for browser frontends on other origins; origins accept "*"
+	// or a wildcard host such as https://*.preview.example.com. Without
+	// CORS_ALLOWED_ORIGINS no cross-origin access is allowed, except for
+	// local frontends in development
+	CORSAllowedOrigins   []string      `mapstructure:"CORS_ALLOWED_ORIGINS"`
+	CORSAllowedMethods   []string      `mapstructure:"CORS_ALLOWED_METHODS"`
+	CORSAllowedHeaders   []string      `mapstructure:"CORS_ALLOWED_HEADERS"`
+	CORSAllowCredentials bool          `mapstructure:"CORS_ALLOW_CREDENTIALS"`
+	CORSMaxAge           time.Duration `mapstructure:"CORS_MAX_AGE"`
+
+	// HSTSMaxAge is how long browsers must reach the API over HTTPS only;
+	// it defaults to a year outside development, where it is off
+	HSTSMaxAge time.Duration `mapstructure:"HSTS_MAX_AGE"`
+
+	// TrustedProxies lists the load balancers, as CIDRs or addresses, whose
+	// X-Forwarded-For and X-Real-IP headers name the client; none are trusted by default
+	TrustedProxies []string `mapstructure:"TRUSTED_PROXIES"`
+
+	// Response compression; bodies under the minimum size or of other media types are sent as they are
+	CompressionMinSize      int      `mapstructure:"COMPRESSION_MIN_SIZE"`
+	CompressionContentTypes []string `mapstructure:"COMPRESSION_CONTENT_TYPES"`
+
+	// Retention policies; a policy with zero months keeps posts forever and a
+	// zero interval disables the scheduled job. Exempt authors are user IDs.
+	RetentionInterval         time.Duration `mapstructure:"RETENTION_INTERVAL"`
+	RetentionDryRun           bool          `mapstructure:"RETENTION_DRY_RUN"`
+	RetentionStaleDraftMonths int           `mapstructure:"RETENTION_STALE_DRAFT_MONTHS"`
+	RetentionArchivedMonths   int           `mapstructure:"RETENTION_ARCHIVED_MONTHS"`
+	RetentionExemptAuthors    []string      `mapstructure:"RETENTION_EXEMPT_AUTHORS"`
+
+	// Link checking of published posts; a zero interval disables the job
+	LinkCheckInterval    time.Duration `mapstructure:"LINK_CHECK_INTERVAL"`
+	LinkCheckConcurrency int           `mapstructure:"LINK_CHECK_CONCURRENCY"`
+	LinkCheckTimeout     time.Duration `mapstructure:"LINK_CHECK_TIMEOUT"`
+
+	// Verification of received webmentions; a zero interval leaves them pending
+	WebmentionVerifyInterval time.Duration `mapstructure:"WEBMENTION_VERIFY_INTERVAL"`
+	WebmentionVerifyTimeout  time.Duration `mapstructure:"WEBMENTION_VERIFY_TIMEOUT"`
+
+	// Public API client tokens; each token may make APIClientRateLimit requests
+	// per window, and a zero flush interval leaves their usage unrecorded
+	APIClientRateLimit          int           `mapstructure:"API_CLIENT_RATE_LIMIT"`
+	APIClientRateWindow         time.Duration `mapstructure:"API_CLIENT_RATE_WINDOW"`
+	APIClientMaxPerOwner        int           `mapstructure:"API_CLIENT_MAX_PER_OWNER"`
+	APIClientUsageFlushInterval time.Duration `mapstructure:"API_CLIENT_USAGE_FLUSH_INTERVAL"`
+
+	// Content quotas of each user in a blog; zero leaves a quota off.
+	// Administrators can override them per user.
+	QuotaMaxDrafts    int `mapstructure:"QUOTA_MAX_DRAFTS"`
+	QuotaMaxPostBytes int `mapstructure:"QUOTA_MAX_POST_BYTES"`
+	QuotaMaxThemes    int `mapstructure:"QUOTA_MAX_THEMES"`
+
+	// Role granted to users when they onboard; empty grants none
+	OnboardingDefaultRole string `mapstructure:"ONBOARDING_DEFAULT_ROLE"`
+
+	// Whether startup creates permissions declared in code but missing from the
+	// database; drift is reported either way
+	AuthzSeedMissingPermissions bool `mapstructure:"AUTHZ_SEED_MISSING_PERMISSIONS"`
+
+	// How long an impersonation session's token works once started
+	ImpersonationTTL time.Duration `mapstructure:"IMPERSONATION_TTL"`
+
+	// How long an access token issued to a service account works
+	ServiceAccountTokenTTL time.Duration `mapstructure:"SERVICE_ACCOUNT_TOKEN_TTL"`
+
+	// How often queued requests to erase users' personal data are carried out;
+	// zero disables the job, leaving requests queued
+	ErasureInterval time.Duration `mapstructure:"ERASURE_INTERVAL"`
+
+	// How often requested copies of users' data are built and expired ones
+	// deleted, and how long a finished copy can be downloaded
+	DataExportInterval time.Duration `mapstructure:"DATA_EXPORT_INTERVAL"`
+	DataExportTTL      time.Duration `mapstructure:"DATA_EXPORT_TTL"`
+
+	// How often recorded post views are folded into the daily traffic
+	// breakdowns; zero disables the job, so views stop showing up in them
+	AnalyticsRollupInterval time.Duration `mapstructure:"ANALYTICS_ROLLUP_INTERVAL"`
+
+	// Signed action links, such as one-click unpublish; the secret must be
+	// shared by every instance and is generated per process in development
+	SignedLinkSecret string        `mapstructure:"SIGNED_LINK_SECRET"`
+	SignedLinkTTL    time.Duration `mapstructure:"SIGNED_LINK_TTL"`
+
+	// Server-side syntax highlighting of code blocks, with a chroma style name
+	HighlightEnabled bool   `mapstructure:"HIGHLIGHT_ENABLED"`
+	HighlightStyle   string `mapstructure:"HIGHLIGHT_STYLE"`
+
+	// PostWorkflowFile is a JSON file replacing the default post statuses and
+	// transitions; see postWorkflowFile for its format
+	PostWorkflowFile string `mapstructure:"POST_WORKFLOW_FILE"`
+
+	// SiteURL is the public address of the blog frontend, used for the post
+	// links of exported reading lists, to tell internal navigation from referrers
+	// and to accept only webmentions of the blog's own posts
+	SiteURL string `mapstructure:"SITE_URL"`
+
+	// Spam checking of contact form messages; Akismet is asked only when
+	// SPAM_AKISMET_KEY is set, and local heuristics decide otherwise or when it fails
+	SpamAkismetKey string   `mapstructure:"SPAM_AKISMET_KEY"`
+	SpamAkismetURL string   `mapstructure:"SPAM_AKISMET_URL"`
+	SpamMaxLinks   int      `mapstructure:"SPAM_MAX_LINKS"`
+	SpamBlocklist  []string `mapstructure:"SPAM_BLOCKLIST"`
+
+	// Error reporting to Sentry; without ERROR_REPORT_DSN nothing is reported.
+	// Unless ERROR_REPORT_SEND_PII is set, client IPs and email addresses are left out.
+	ErrorReportDSN         string   `mapstructure:"ERROR_REPORT_DSN"`
+	ErrorReportSampleRate  float64  `mapstructure:"ERROR_REPORT_SAMPLE_RATE"`
+	ErrorReportScrubFields []string `mapstructure:"ERROR_REPORT_SCRUB_FIELDS"`
+	ErrorReportSendPII     bool     `mapstructure:"ERROR_REPORT_SEND_PII"`
+
+	// ErrorFormat is json, which answers with RFC 7807 problem details only when the
+	// request accepts application/problem+json, or problem to always use them
+	ErrorFormat        string `mapstructure:"ERROR_FORMAT"`
+	ProblemTypeBaseURL string `mapstructure:"PROBLEM_TYPE_BASE_URL"`
+
+	// APIV1Sunset is the date, as YYYY-MM-DD, after which the deprecated v1
+	// endpoints may stop being served; empty leaves the Sunset header out
+	APIV1Sunset string `mapstructure:"API_V1_SUNSET"`
+
+	// PublicAPIURL is where clients reach the API, as published in the servers
+	// of /api/v1/openapi.json; empty publishes the relative /api/v1
+	PublicAPIURL string `mapstructure:"PUBLIC_API_URL"`
+
+	// Features enables the endpoints of unfinished API surfaces, named by the
+	// x-feature of their operations; the others answer 501 Not Implemented
+	Features []string `mapstructure:"FEATURES"`
 }
 
+// devCORSOrigins are allowed in development when no origins are configured
+var devCORSOrigins = []string{"http://localhost:3000", "http://localhost:5173"}
+
 func LoadConfig(bootstrapLogger *logger.BootstrapLogger) (Config, error) {
 	ctx := context.Background()
 
@@ -36,9 +207,90 @@ func LoadConfig(bootstrapLogger *logger.BootstrapLogger) (Config, error) {
 
 	// Set default values
 	v.SetDefault("DATABASE_URL", "postgresql://localhost:5432/archblog?sslmode=disable")
+	v.SetDefault("DB_MAX_CONNS", 25)
+	v.SetDefault("DB_MIN_CONNS", 5)
+	v.SetDefault("DB_MAX_CONN_LIFETIME", "5m")
+	v.SetDefault("DB_MAX_CONN_IDLE_TIME", "1m")
+	v.SetDefault("DB_HEALTH_CHECK_PERIOD", "1m")
+	v.SetDefault("DB_QUERY_EXEC_MODE", "")
+	v.SetDefault("DB_STATEMENT_CACHE_CAPACITY", 0)
+	v.SetDefault("DB_DESCRIPTION_CACHE_CAPACITY", 0)
+	v.SetDefault("FIELD_ENCRYPTION_KEYS", "")
 	v.SetDefault("SERVER_ADDRESS", ":8080")
+	// Required, but Unmarshal only reads environment variables for keys Viper knows
+	v.SetDefault("JWKS_ENDPOINT", "")
+	v.SetDefault("JWT_ISSUER", "")
 	v.SetDefault("ENVIRONMENT", "development")
 	v.SetDefault("LOG_LEVEL", "info")
+	v.SetDefault("LOG_FORMAT", "")
+	v.SetDefault("LOG_MODULE_LEVELS", "")
+	v.SetDefault("LOG_DEBUG_SAMPLE_FIRST", 100)
+	v.SetDefault("LOG_DEBUG_SAMPLE_THEREAFTER", 100)
+	v.SetDefault("SHUTDOWN_TIMEOUT", "30s")
+	v.SetDefault("REDIS_URL", "")
+	v.SetDefault("CACHE_POST_TTL", "5m")
+	v.SetDefault("CACHE_THEME_TTL", "5m")
+	v.SetDefault("CACHE_THEME_LIST_TTL", "1m")
+	v.SetDefault("CACHE_SETTINGS_TTL", "10m")
+	v.SetDefault("CACHE_HOME_TTL", "5m")
+	v.SetDefault("CACHE_TRENDING_TTL", "15m")
+	v.SetDefault("CACHE_RENDERED_TTL", "24h")
+	v.SetDefault("RESPONSE_CACHE_ENABLED", true)
+	v.SetDefault("CDN_PURGE_URL", "")
+	v.SetDefault("CDN_PURGE_TOKEN", "")
+	v.SetDefault("CORS_ALLOWED_ORIGINS", "")
+	v.SetDefault("CORS_ALLOWED_METHODS", "GET,POST,PUT,PATCH,DELETE,OPTIONS")
+	v.SetDefault("CORS_ALLOWED_HEADERS", "Authorization,Content-Type,Accept-Language,If-None-Match,X-Request-ID,X-CSRF-Token,X-API-Token")
+	v.SetDefault("CORS_ALLOW_CREDENTIALS", false)
+	v.SetDefault("CORS_MAX_AGE", "10m")
+	v.SetDefault("HSTS_MAX_AGE", "8760h")
+	v.SetDefault("TRUSTED_PROXIES", "")
+	v.SetDefault("COMPRESSION_MIN_SIZE", 1024)
+	v.SetDefault("COMPRESSION_CONTENT_TYPES", "application/json,application/merge-patch+json,text/plain,text/html,text/css,text/csv,application/javascript,application/xml,application/rss+xml,application/atom+xml,image/svg+xml")
+	v.SetDefault("RETENTION_INTERVAL", "24h")
+	v.SetDefault("RETENTION_DRY_RUN", false)
+	v.SetDefault("RETENTION_STALE_DRAFT_MONTHS", 0)
+	v.SetDefault("RETENTION_ARCHIVED_MONTHS", 0)
+	v.SetDefault("RETENTION_EXEMPT_AUTHORS", "")
+	v.SetDefault("LINK_CHECK_INTERVAL", "24h")
+	v.SetDefault("LINK_CHECK_CONCURRENCY", 8)
+	v.SetDefault("LINK_CHECK_TIMEOUT", "10s")
+	v.SetDefault("WEBMENTION_VERIFY_INTERVAL", "1m")
+	v.SetDefault("WEBMENTION_VERIFY_TIMEOUT", "10s")
+	v.SetDefault("API_CLIENT_RATE_LIMIT", 600)
+	v.SetDefault("API_CLIENT_RATE_WINDOW", "1m")
+	v.SetDefault("API_CLIENT_MAX_PER_OWNER", 5)
+	v.SetDefault("API_CLIENT_USAGE_FLUSH_INTERVAL", "1m")
+	v.SetDefault("QUOTA_MAX_DRAFTS", 50)
+	v.SetDefault("QUOTA_MAX_POST_BYTES", 1<<20)
+	v.SetDefault("QUOTA_MAX_THEMES", 25)
+	v.SetDefault("ONBOARDING_DEFAULT_ROLE", "subscriber")
+	v.SetDefault("AUTHZ_SEED_MISSING_PERMISSIONS", false)
+	v.SetDefault("IMPERSONATION_TTL", "15m")
+	v.SetDefault("SERVICE_ACCOUNT_TOKEN_TTL", "1h")
+	v.SetDefault("ERASURE_INTERVAL", "5m")
+	v.SetDefault("DATA_EXPORT_INTERVAL", "1m")
+	v.SetDefault("DATA_EXPORT_TTL", "168h")
+	v.SetDefault("ANALYTICS_ROLLUP_INTERVAL", "1h")
+	v.SetDefault("SIGNED_LINK_SECRET", "")
+	v.SetDefault("SIGNED_LINK_TTL", "72h")
+	v.SetDefault("HIGHLIGHT_ENABLED", false)
+	v.SetDefault("HIGHLIGHT_STYLE", "github")
+	v.SetDefault("POST_WORKFLOW_FILE", "")
+	v.SetDefault("SITE_URL", "")
+	v.SetDefault("SPAM_AKISMET_KEY", "")
+	v.SetDefault("SPAM_AKISMET_URL", "")
+	v.SetDefault("SPAM_MAX_LINKS", 3)
+	v.SetDefault("SPAM_BLOCKLIST", "")
+	v.SetDefault("ERROR_REPORT_DSN", "")
+	v.SetDefault("ERROR_REPORT_SAMPLE_RATE", 1.0)
+	v.SetDefault("ERROR_REPORT_SCRUB_FIELDS", "")
+	v.SetDefault("ERROR_REPORT_SEND_PII", false)
+	v.SetDefault("ERROR_FORMAT", "json")
+	v.SetDefault("PROBLEM_TYPE_BASE_URL", "/problems/")
+	v.SetDefault("API_V1_SUNSET", "")
+	v.SetDefault("PUBLIC_API_URL", "")
+	v.SetDefault("FEATURES", "")
 
 	// Enable automatic environment variable reading
 	// Viper will now see all environment variables, including those loaded by godotenv
@@ -54,6 +306,15 @@ func LoadConfig(bootstrapLogger *logger.BootstrapLogger) (Config, error) {
 		return Config{}, fmt.Errorf("failed to unmarshal configuration: %w", err)
 	}
 
+	if config.Environment == "development" {
+		if len(config.CORSAllowedOrigins) == 0 {
+			config.CORSAllowedOrigins = devCORSOrigins
+		}
+		if _, set := os.LookupEnv("HSTS_MAX_AGE"); !set {
+			config.HSTSMaxAge = 0
+		}
+	}
+
 	bootstrapLogger.Info(ctx, "configuration loaded",
 		"environment", config.Environment,
 		"log_level", config.LogLevel,
@@ -72,6 +333,29 @@ func LoadConfig(bootstrapLogger *logger.BootstrapLogger) (Config, error) {
 		return Config{}, err
 	}
 
+	if config.SignedLinkSecret == "" && config.Environment != "development" {
+		err := errors.New("SIGNED_LINK_SECRET is required outside development")
+		bootstrapLogger.Error(ctx, "configuration validation failed", "error", err)
+		return Config{}, err
+	}
+
+	if _, err := config.apiV1Sunset(); err != nil {
+		bootstrapLogger.Error(ctx, "configuration validation failed", "error", err)
+		return Config{}, err
+	}
+
 	bootstrapLogger.Info(ctx, "configuration validated successfully")
 	return config, nil
 }
+
+// apiV1Sunset parses APIV1Sunset, returning the zero time when it is not set
+func (c Config) apiV1Sunset() (time.Time, error) {
+	if c.APIV1Sunset == "" {
+		return time.Time{}, nil
+	}
+	sunset, err := time.Parse(time.DateOnly, c.APIV1Sunset)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("API_V1_SUNSET must be a date as YYYY-MM-DD: %w", err)
+	}
+	return sunset, nil
+}
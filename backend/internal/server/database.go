@@ -3,14 +3,15 @@ package server
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"backend/internal/platform/logger"
+	"backend/internal/platform/postgres"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // ConnectDatabase creates a new database connection pool and returns it with a cleanup function
-func ConnectDatabase(ctx context.Context, config Config, log logger.Logger) (*pgxpool.Pool, func(), error) {
+func ConnectDatabase(ctx context.Context, config Config, envelope *postgres.Envelope, log logger.Logger) (*pgxpool.Pool, func(), error) {
 	log.Info(ctx, "connecting to database")
 
 	// Parse config from URL and set pool defaults
@@ -20,17 +21,37 @@ func ConnectDatabase(ctx context.Context, config Config, log logger.Logger) (*pg
 		return nil, nil, fmt.Errorf("failed to parse database URL: %w", err)
 	}
 
-	// Configure connection pool settings
-	poolConfig.MaxConns = 25
-	poolConfig.MinConns = 5
-	poolConfig.MaxConnLifetime = 5 * time.Minute
-	poolConfig.MaxConnIdleTime = 1 * time.Minute
+	// Configure connection pool and statement cache settings
+	options := postgres.PoolOptions{
+		MaxConns:                 config.DBMaxConns,
+		MinConns:                 config.DBMinConns,
+		MaxConnLifetime:          config.DBMaxConnLifetime,
+		MaxConnIdleTime:          config.DBMaxConnIdleTime,
+		HealthCheckPeriod:        config.DBHealthCheckPeriod,
+		QueryExecMode:            config.DBQueryExecMode,
+		StatementCacheCapacity:   config.DBStatementCacheCapacity,
+		DescriptionCacheCapacity: config.DBDescriptionCacheCapacity,
+	}
+	if err := options.Apply(poolConfig); err != nil {
+		log.Error(ctx, "invalid database pool configuration", "error", err)
+		return nil, nil, err
+	}
+
+	// Encrypted columns are sealed and opened by every connection's codecs
+	poolConfig.AfterConnect = func(_ context.Context, conn *pgx.Conn) error {
+		postgres.RegisterEncryptedTypes(conn.TypeMap(), envelope)
+		return nil
+	}
 
 	log.Debug(ctx, "database pool configuration",
 		"max_conns", poolConfig.MaxConns,
 		"min_conns", poolConfig.MinConns,
 		"max_conn_lifetime", poolConfig.MaxConnLifetime,
 		"max_conn_idle_time", poolConfig.MaxConnIdleTime,
+		"health_check_period", poolConfig.HealthCheckPeriod,
+		"query_exec_mode", poolConfig.ConnConfig.DefaultQueryExecMode.String(),
+		"statement_cache_capacity", poolConfig.ConnConfig.StatementCacheCapacity,
+		"description_cache_capacity", poolConfig.ConnConfig.DescriptionCacheCapacity,
 	)
 
 	// Create the connection pool
@@ -0,0 +1,31 @@
+package server
+
+import (
+	authzApp "backend/internal/authz/application"
+	impersonationApp "backend/internal/impersonation/application"
+	"backend/internal/platform/dataexport"
+	postsApp "backend/internal/posts/application"
+	securityApp "backend/internal/security/application"
+	usersApp "backend/internal/users/application"
+)
+
+// Exporters is a marker proving that data exporters are registered
+type Exporters struct{}
+
+// RegisterExporters registers the exporter of every context holding personal
+// data; each one's name is the file its section is written to
+func RegisterExporters(
+	registry dataexport.Registry,
+	users *usersApp.UsersExporter,
+	posts *postsApp.PostsExporter,
+	roles *authzApp.RolesExporter,
+	audit *impersonationApp.AuditExporter,
+	security *securityApp.EventsExporter,
+) Exporters {
+	registry.Register("profile", users)
+	registry.Register("posts", posts)
+	registry.Register("roles", roles)
+	registry.Register("audit", audit)
+	registry.Register("security_events", security)
+	return Exporters{}
+}
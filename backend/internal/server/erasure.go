@@ -0,0 +1,36 @@
+package server
+
+import (
+	impersonationApp "backend/internal/impersonation/application"
+	"backend/internal/platform/erasure"
+	postsApp "backend/internal/posts/application"
+	privacyApp "backend/internal/privacy/application"
+	securityApp "backend/internal/security/application"
+	themesApp "backend/internal/themes/application"
+	usersApp "backend/internal/users/application"
+)
+
+// Erasers is a marker proving that erasers are registered
+type Erasers struct{}
+
+// RegisterErasers registers the eraser of every context holding personal
+// data, in the order an erasure runs them. The account goes first, so that
+// the pseudonym it takes is the name the other contexts copy; copies of the
+// user's data made for them go last, as they hold what the others removed.
+func RegisterErasers(
+	registry erasure.Registry,
+	users *usersApp.UsersEraser,
+	posts *postsApp.AuthorNames,
+	themes *themesApp.CuratorNames,
+	impersonation *impersonationApp.AuditEraser,
+	security *securityApp.EventsEraser,
+	exports *privacyApp.ExportsEraser,
+) Erasers {
+	registry.Register("users", users)
+	registry.Register("posts", posts)
+	registry.Register("themes", themes)
+	registry.Register("impersonation_audit", impersonation)
+	registry.Register("security_events", security)
+	registry.Register("data_exports", exports)
+	return Erasers{}
+}
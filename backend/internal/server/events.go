@@ -0,0 +1,45 @@
+package server
+
+import (
+	homeApp "backend/internal/home/application"
+	liveApp "backend/internal/live/application"
+	notificationsApp "backend/internal/notifications/application"
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/httpcache"
+	postsApp "backend/internal/posts/application"
+	securityApp "backend/internal/security/application"
+	settingsApp "backend/internal/settings/application"
+	themesApp "backend/internal/themes/application"
+)
+
+// EventSubscriptions is a marker proving that event subscribers are registered
+type EventSubscriptions struct{}
+
+// RegisterEventSubscriptions attaches every asynchronous event subscriber to the bus
+func RegisterEventSubscriptions(
+	bus *eventbus.Bus,
+	notifications *notificationsApp.NotificationsService,
+	postCache *postsApp.PostCache,
+	publishedPosts *postsApp.PublishedPostsProjection,
+	authorNames *postsApp.AuthorNames,
+	themeCache *themesApp.ThemeCache,
+	curatorNames *themesApp.CuratorNames,
+	homeCache *homeApp.HomeCache,
+	settingsCache *settingsApp.SettingsCache,
+	responseInvalidator *httpcache.Invalidator,
+	liveHub *liveApp.Hub,
+	securityLog *securityApp.SecurityLogService,
+) EventSubscriptions {
+	notifications.Subscribe(bus)
+	postCache.Subscribe(bus)
+	publishedPosts.Subscribe(bus)
+	authorNames.Subscribe(bus)
+	themeCache.Subscribe(bus)
+	curatorNames.Subscribe(bus)
+	homeCache.Subscribe(bus)
+	settingsCache.Subscribe(bus)
+	responseInvalidator.Subscribe(bus)
+	liveHub.Subscribe(bus)
+	securityLog.Subscribe(bus)
+	return EventSubscriptions{}
+}
@@ -0,0 +1,54 @@
+package server
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// routeFeatureExtension names the feature an operation belongs to while its API
+// surface is unfinished, as x-feature: comments. Operations without one are
+// always served.
+const routeFeatureExtension = "x-feature"
+
+// routeFeatures returns the feature of every operation declaring one, keyed
+// by method and pattern under baseURL
+func routeFeatures(spec *openapi3.T, baseURL string) (map[string]string, error) {
+	features := make(map[string]string)
+	for path, item := range spec.Paths.Map() {
+		for method, op := range item.Operations() {
+			raw, ok := op.Extensions[routeFeatureExtension]
+			if !ok {
+				continue
+			}
+			feature, ok := raw.(string)
+			if !ok || feature == "" {
+				return nil, fmt.Errorf("%s %s%s: %s must be a feature name", method, baseURL, path, routeFeatureExtension)
+			}
+			features[method+" "+baseURL+path] = feature
+		}
+	}
+	return features, nil
+}
+
+// disabledRoutes returns the routes of features not among enabled, and the
+// enabled features no route belongs to, which are likely misspelt
+func disabledRoutes(features map[string]string, enabled []string) (disabled map[string]string, unknown []string) {
+	disabled = make(map[string]string)
+	declared := make(map[string]bool)
+	for pattern, feature := range features {
+		declared[feature] = true
+		if !slices.Contains(enabled, feature) {
+			disabled[pattern] = feature
+		}
+	}
+	for _, feature := range enabled {
+		if !declared[feature] {
+			unknown = append(unknown, feature)
+		}
+	}
+	sort.Strings(unknown)
+	return disabled, unknown
+}
@@ -0,0 +1,22 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledRoutes(t *testing.T) {
+	features := map[string]string{
+		"GET /api/v1/comments":  "comments",
+		"POST /api/v1/comments": "comments",
+		"POST /api/v1/media":    "media",
+	}
+
+	disabled, unknown := disabledRoutes(features, []string{"media", "comets"})
+	assert.Equal(t, map[string]string{
+		"GET /api/v1/comments":  "comments",
+		"POST /api/v1/comments": "comments",
+	}, disabled)
+	assert.Equal(t, []string{"comets"}, unknown)
+}
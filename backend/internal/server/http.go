@@ -1,11 +1,16 @@
 package server
 
 import (
+	"context"
+	"fmt"
 	"net/http"
 	"time"
 
 	"backend/internal/adapters/api"
 	"backend/internal/adapters/rest/middleware"
+	apiclientsDomain "backend/internal/apiclients/domain"
+	liveApp "backend/internal/live/application"
+	"backend/internal/platform/httpcache"
 	"backend/internal/platform/logger"
 	"github.com/go-chi/chi/v5"
 	chimw "github.com/go-chi/chi/v5/middleware"
@@ -18,19 +23,50 @@ func NewHTTPServer(
 	jwtMiddleware *middleware.JWTMiddleware,
 	authzMiddleware *middleware.AuthorizationMiddleware,
 	authAdapter *middleware.AuthAdapter,
+	tenantMiddleware *middleware.TenantMiddleware,
+	responseCacheMiddleware *middleware.ResponseCacheMiddleware,
+	requestValidator *middleware.RequestValidator,
+	corsMiddleware *middleware.CORSMiddleware,
+	securityHeaders *middleware.SecurityHeadersMiddleware,
+	csrfMiddleware *middleware.CSRFMiddleware,
+	clientIPMiddleware *middleware.ClientIPMiddleware,
+	compressionMiddleware *middleware.CompressionMiddleware,
+	apiClientMiddleware *middleware.APIClientMiddleware,
+	impersonationMiddleware *middleware.ImpersonationMiddleware,
+	serviceAccountMiddleware *middleware.ServiceAccountMiddleware,
+	signedLinkMiddleware *middleware.SignedLinkMiddleware,
+	liveHub *liveApp.Hub,
 	log logger.Logger,
-) *http.Server {
+) (*http.Server, error) {
 	// Create chi router
 	r := chi.NewRouter()
 
 	// Protected endpoints (JWT auth required)
+	// Cookie-authenticated sessions must pass the CSRF check before anything else
+	// Service accounts authenticate with machine tokens instead of going through the JWT chain
 	protectedMiddlewares := []api.MiddlewareFunc{
-		wrapMiddleware(jwtMiddleware.Middleware),
-		wrapMiddleware(authAdapter.Middleware), // Convert Supabase ID to internal UUID
+		wrapMiddleware(csrfMiddleware.Middleware),
+		wrapMiddleware(serviceAccountMiddleware.Middleware(
+			jwtMiddleware.Middleware,
+			authAdapter.Middleware, // Convert Supabase ID to internal UUID
+		)),
+		wrapMiddleware(impersonationMiddleware.Middleware), // Act as the impersonated user, so permissions are theirs
+	}
+
+	// Public endpoints identify the caller when credentials are supplied
+	// so responses can be personalised (e.g. bookmarked flags);
+	// credentials that fail to verify are ignored and the caller reads anonymously
+	optionalMiddlewares := []api.MiddlewareFunc{
+		wrapMiddleware(csrfMiddleware.Middleware),
+		wrapMiddleware(serviceAccountMiddleware.OptionalMiddleware(
+			jwtMiddleware.OptionalMiddleware,
+			authAdapter.OptionalMiddleware,
+		)),
 	}
 
 	// JWT-only endpoints (no AuthAdapter because user doesn't exist yet)
 	jwtOnlyMiddlewares := []api.MiddlewareFunc{
+		wrapMiddleware(csrfMiddleware.Middleware),
 		wrapMiddleware(jwtMiddleware.Middleware),
 	}
 
@@ -50,80 +86,167 @@ func NewHTTPServer(
 		)
 	}
 
-	// Build route pattern maps for chi
-	publicPatterns := map[string]bool{
-		"GET /api/v1/health/live":  true,
-		"GET /api/v1/health/ready": true,
-
-		// Public posts endpoints (read-only)
-		"GET /api/v1/posts":             true,
-		"GET /api/v1/posts/{id}":        true, // Get by ID
-		"GET /api/v1/posts/slug/{slug}": true, // Get by slug
-
-		// Public themes endpoints (read-only)
-		"GET /api/v1/themes":               true,
-		"GET /api/v1/themes/{id}":          true, // Get by ID
-		"GET /api/v1/themes/slug/{slug}":   true, // Get by slug
-		"GET /api/v1/themes/{id}/articles": true, // Get theme with articles
-	}
-
-	permissionPatterns := map[string][]api.MiddlewareFunc{
-		// User creation (JWT only, no AuthAdapter since user doesn't exist yet)
-		"POST /api/v1/users": jwtOnlyMiddlewares,
-
-		// Permission endpoints
-		"GET /api/v1/permissions": createAuthzMiddleware("authz:permissions:read"),
-
-		// Role management
-		"GET /api/v1/roles":                  createAuthzMiddleware("authz:roles:read"),
-		"POST /api/v1/roles":                 createAuthzMiddleware("authz:roles:create"),
-		"GET /api/v1/roles/{id}":             createAuthzMiddleware("authz:roles:read"),
-		"PUT /api/v1/roles/{id}":             createAuthzMiddleware("authz:roles:update"),
-		"DELETE /api/v1/roles/{id}":          createAuthzMiddleware("authz:roles:delete"),
-		"PUT /api/v1/roles/{id}/permissions": createAuthzMiddleware("authz:roles:update"),
-
-		// User role management
-		"GET /api/v1/users/{id}/roles":             createAuthzMiddleware("authz:users:read"),
-		"POST /api/v1/users/{id}/roles":            createAuthzMiddleware("authz:users:assign"),
-		"DELETE /api/v1/users/{id}/roles/{roleId}": createAuthzMiddleware("authz:users:revoke"),
-
-		// Posts endpoints (mutation requires authorization)
-		"POST /api/v1/posts":                createAuthzMiddleware("posts:create"),
-		"PUT /api/v1/posts/{id}":            createOwnershipMiddleware("posts", "id", "update"),
-		"POST /api/v1/posts/{id}/publish":   createOwnershipMiddleware("posts", "id", "publish"),
-		"POST /api/v1/posts/{id}/unpublish": createOwnershipMiddleware("posts", "id", "publish"),
-		"POST /api/v1/posts/{id}/archive":   createOwnershipMiddleware("posts", "id", "archive"),
-		"DELETE /api/v1/posts/{id}":         createOwnershipMiddleware("posts", "id", "delete"),
-
-		// Themes endpoints (mutation requires authorization)
-		"POST /api/v1/themes":                          createAuthzMiddleware("themes:create"),
-		"PUT /api/v1/themes/{id}":                      createOwnershipMiddleware("themes", "id", "update"),
-		"POST /api/v1/themes/{id}/activate":            createOwnershipMiddleware("themes", "id", "update"),
-		"POST /api/v1/themes/{id}/deactivate":          createOwnershipMiddleware("themes", "id", "update"),
-		"POST /api/v1/themes/{id}/articles":            createOwnershipMiddleware("themes", "id", "update"),
-		"DELETE /api/v1/themes/{id}/articles/{postId}": createOwnershipMiddleware("themes", "id", "update"),
-		"PUT /api/v1/themes/{id}/articles":             createOwnershipMiddleware("themes", "id", "update"),
-	}
-
-	// Register API routes on chi router with a route-aware middleware
-	_ = api.HandlerWithOptions(server, api.ChiServerOptions{
-		BaseURL:    "/api/v1",
-		BaseRouter: r,
-		Middlewares: []api.MiddlewareFunc{
-			routeAwareChiMiddleware(publicPatterns, permissionPatterns, protectedMiddlewares),
-		},
+	// Signed action links authenticate by their signature instead of a session,
+	// so neither cookies nor JWTs are involved and CSRF checks do not apply
+	createSignedLinkMiddleware := func(action api.SignedLinkAction) []api.MiddlewareFunc {
+		return []api.MiddlewareFunc{
+			wrapMiddleware(signedLinkMiddleware.Require(string(action))),
+		}
+	}
+
+	// Who may call each route is declared in the spec, so routes cannot drift from it
+	spec, err := api.GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("load API spec: %w", err)
+	}
+	publicPatterns, permissionPatterns, err := routesFromSpec(spec, "/api/v1", routeChains{
+		Onboarding: jwtOnlyMiddlewares,
+		Permission: createAuthzMiddleware,
+		Ownership:  createOwnershipMiddleware,
+		SignedLink: createSignedLinkMiddleware,
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Endpoints of unfinished features stay registered but answer 501 until enabled
+	features, err := routeFeatures(spec, "/api/v1")
+	if err != nil {
+		return nil, err
+	}
+	disabledPatterns, unknownFeatures := disabledRoutes(features, config.Features)
+	if len(unknownFeatures) > 0 {
+		log.Warn(context.Background(), "enabled features have no endpoints", "features", unknownFeatures)
+	}
+
+	// Scopes API client tokens need, by public read; tokens may call nothing else
+	tokenScopes := map[string]apiclientsDomain.Scope{
+		"GET /api/v1/home":                    apiclientsDomain.ScopePostsRead,
+		"GET /api/v1/posts":                   apiclientsDomain.ScopePostsRead,
+		"GET /api/v1/posts/archive":           apiclientsDomain.ScopePostsRead,
+		"GET /api/v1/posts/{id}":              apiclientsDomain.ScopePostsRead,
+		"GET /api/v1/posts/slug/{slug}":       apiclientsDomain.ScopePostsRead,
+		"GET /api/v1/posts/{id}/reactions":    apiclientsDomain.ScopePostsRead,
+		"GET /api/v1/posts/{id}/mentions":     apiclientsDomain.ScopePostsRead,
+		"GET /api/v1/search/suggest":          apiclientsDomain.ScopePostsRead,
+		"GET /api/v1/users/{id}/follow-stats": apiclientsDomain.ScopeUsersRead,
+		"GET /api/v1/authors/{username}":      apiclientsDomain.ScopeUsersRead,
+		"GET /api/v1/themes":                  apiclientsDomain.ScopeThemesRead,
+		"GET /api/v1/themes/{id}":             apiclientsDomain.ScopeThemesRead,
+		"GET /api/v1/themes/slug/{slug}":      apiclientsDomain.ScopeThemesRead,
+		"GET /api/v1/themes/slug/{slug}/page": apiclientsDomain.ScopeThemesRead,
+		"GET /api/v1/themes/{id}/articles":    apiclientsDomain.ScopeThemesRead,
+		"GET /api/v1/series":                  apiclientsDomain.ScopeSeriesRead,
+		"GET /api/v1/series/{id}":             apiclientsDomain.ScopeSeriesRead,
+		"GET /api/v1/series/slug/{slug}":      apiclientsDomain.ScopeSeriesRead,
+	}
+
+	// Caching policies for public reads, by route class
+	// Single items change rarely and are purged when they do; listings turn over faster
+	itemPolicy := httpcache.Policy{MaxAge: time.Minute, SharedMaxAge: 5 * time.Minute, StaleWhileRevalidate: time.Minute}
+	listingPolicy := httpcache.Policy{MaxAge: 0, SharedMaxAge: time.Minute, StaleWhileRevalidate: 30 * time.Second}
+	cachePolicies := map[string]httpcache.Policy{
+		"GET /api/v1/home":                    listingPolicy,
+		"GET /api/v1/posts":                   listingPolicy,
+		"GET /api/v1/posts/archive":           listingPolicy,
+		"GET /api/v1/openapi.json":            itemPolicy,
+		"GET /api/v1/posts/workflow":          itemPolicy,
+		"GET /api/v1/posts/{id}":              itemPolicy,
+		"GET /api/v1/posts/slug/{slug}":       itemPolicy,
+		"GET /api/v1/posts/{id}/og-image":     itemPolicy,
+		"GET /api/v1/search/suggest":          listingPolicy,
+		"GET /api/v1/themes":                  listingPolicy,
+		"GET /api/v1/themes/{id}":             itemPolicy,
+		"GET /api/v1/themes/slug/{slug}":      itemPolicy,
+		"GET /api/v1/themes/slug/{slug}/page": itemPolicy,
+		"GET /api/v1/themes/{id}/articles":    itemPolicy,
+		"GET /api/v1/series":                  listingPolicy,
+		"GET /api/v1/series/{id}":             itemPolicy,
+		"GET /api/v1/series/slug/{slug}":      itemPolicy,
+		"GET /api/v1/announcements/active":    listingPolicy,
+	}
+
+	// Register every API version on chi router with a route-aware middleware
+	// Middlewares listed later wrap earlier ones, so stored responses are served before
+	// authentication and requests are validated against the spec only once authenticated.
+	// API tokens are admitted outermost, so stored responses count against their rate limits,
+	// and deprecation headers are set on every response of a deprecated route, errors included.
+	// Routes of disabled features are turned away before authentication, whoever calls them.
+	// The spec describes v1 only; requests to other versions pass validation untouched.
+	v1Sunset, _ := config.apiV1Sunset() // Validated by LoadConfig
+	versions := []apiVersion{
+		{
+			BaseURL: "/api/v1",
+			Routes: routeTable{
+				Public:        publicPatterns,
+				Permissions:   permissionPatterns,
+				TokenScopes:   tokenScopes,
+				CachePolicies: cachePolicies,
+				Deprecations:  v1Deprecations(v1Sunset),
+				Disabled:      disabledPatterns,
+			},
+			Register: func(r chi.Router, baseURL string, middlewares []api.MiddlewareFunc) {
+				_ = api.HandlerWithOptions(server, api.ChiServerOptions{
+					BaseURL:     baseURL,
+					BaseRouter:  r,
+					Middlewares: middlewares,
+				})
+			},
+		},
+		{
+			// Every v2 route requires authentication until it is listed in a route table
+			BaseURL:  "/api/v2",
+			Register: registerRoutes(v2Routes()),
+		},
+	}
+	for _, version := range versions {
+		routes := version.Routes
+		version.Register(r, version.BaseURL, []api.MiddlewareFunc{
+			wrapMiddleware(requestValidator.Middleware),
+			routeAwareChiMiddleware(routes.Public, routes.Permissions, protectedMiddlewares, optionalMiddlewares),
+			wrapMiddleware(responseCacheMiddleware.Middleware(routes.CachePolicies)),
+			wrapMiddleware(apiClientMiddleware.Middleware(routes.TokenScopes)),
+			wrapMiddleware(middleware.FeatureGate(routes.Disabled)),
+			wrapMiddleware(middleware.Deprecations(routes.Deprecations)),
+		})
+	}
+	// Resolve the blog before routing, since a /blogs/{slug} prefix is stripped from the path
+	handler := tenantMiddleware.Middleware(r)
+
+	// Compress outside the response store, which keeps bodies uncompressed for every client
+	handler = compressionMiddleware.Middleware(handler)
+
+	// Every API response is JSON and gets the locked-down policy
+	handler = securityHeaders.Middleware(middleware.APISecurityPolicy)(handler)
+
+	// Answer preflights before tenant resolution and routing, which they never need
+	handler = corsMiddleware.Middleware(handler)
+
 	// Wrap with observability middleware
-	handler := withObservability(r, log)
+	handler = withObservability(handler, log)
+
+	// Resolve the client address before the request is logged
+	handler = clientIPMiddleware.Middleware(handler)
+
+	// Negotiate the language of error messages before anything can fail
+	handler = middleware.Language(handler)
+
+	// Assign the request ID outermost so the request log and everything within carry it
+	handler = middleware.RequestID(handler)
 
 	// Create and return HTTP server
-	return &http.Server{
+	srv := &http.Server{
 		Addr:         config.ServerAddress,
 		Handler:      handler,
 		ReadTimeout:  15 * time.Second,
-		WriteTimeout: 15 * time.Second,
+		WriteTimeout: 15 * time.Second, // Event streams lift it for themselves
 		IdleTimeout:  60 * time.Second,
 	}
+
+	// Shutdown waits for requests to finish, which event streams never do on their own
+	srv.RegisterOnShutdown(liveHub.Close)
+
+	return srv, nil
 }
 
 // routeAwareChiMiddleware applies auth middlewares based on matched chi route pattern
@@ -131,6 +254,7 @@ func routeAwareChiMiddleware(
 	public map[string]bool,
 	specific map[string][]api.MiddlewareFunc,
 	defaults []api.MiddlewareFunc,
+	optional []api.MiddlewareFunc,
 ) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -145,9 +269,13 @@ func routeAwareChiMiddleware(
 				pattern = method + " " + routeCtx.RoutePattern()
 			}
 
-			// Public endpoints bypass
+			// Public endpoints bypass required auth but still identify callers who send credentials
 			if public[pattern] || public[method+" "+r.URL.Path] {
-				next.ServeHTTP(w, r)
+				handler := next
+				for i := len(optional) - 1; i >= 0; i-- {
+					handler = optional[i](handler)
+				}
+				handler.ServeHTTP(w, r)
 				return
 			}
 
@@ -203,7 +331,7 @@ func withObservability(handler http.Handler, log logger.Logger) http.Handler {
 			"path", r.URL.Path,
 			"status", wrr.Status(),
 			"duration_ms", duration.Milliseconds(),
-			"remote_addr", r.RemoteAddr,
+			"remote_addr", r.RemoteAddr, // The peer, which is the proxy behind a load balancer
 			"user_agent", r.UserAgent(),
 			"user_id", userID,
 		)
@@ -0,0 +1,31 @@
+package server
+
+import (
+	organizationsApp "backend/internal/organizations/application"
+	"backend/internal/platform/ownership"
+	postsApp "backend/internal/posts/application"
+	seriesApp "backend/internal/series/application"
+	themesApp "backend/internal/themes/application"
+)
+
+// OwnershipCheckers is a marker proving that ownership checkers are registered
+type OwnershipCheckers struct{}
+
+// RegisterOwnershipCheckers registers the checker of every resource guarded by
+// "own"-scoped permissions, so those permissions can be resolved per resource.
+// A post's analytics belong to its author, so they resolve through the posts
+// checker. Organizations then let their members through on the content they own.
+func RegisterOwnershipCheckers(
+	registry ownership.Registry,
+	posts *postsApp.PostsOwnershipChecker,
+	themes *themesApp.ThemesOwnershipChecker,
+	series *seriesApp.SeriesOwnershipChecker,
+	organizations *organizationsApp.OrganizationsService,
+) OwnershipCheckers {
+	registry.RegisterChecker("posts", posts)
+	registry.RegisterChecker("analytics", posts)
+	registry.RegisterChecker("themes", themes)
+	registry.RegisterChecker("series", series)
+	registry.SetMembershipChecker(organizations)
+	return OwnershipCheckers{}
+}
@@ -0,0 +1,146 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"backend/internal/adapters/api"
+	"backend/internal/authz/permission"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// routeAccessExtension is the spec extension every operation declares its access with.
+// Its value is one of
+//
+//	x-permissions: public          # anyone; callers sending credentials are still identified
+//	x-permissions: authenticated   # any signed-in user
+//	x-permissions: onboarding      # a valid JWT for a user who may not exist yet
+//	x-permissions:
+//	  permission: posts:create     # a permission, by ID
+//	x-permissions:
+//	  ownership: posts:update      # the "own" scope of a permission on the {id} resource
+//	x-permissions:
+//	  signedLink: posts.approve    # a signed action link instead of a session
+const routeAccessExtension = "x-permissions"
+
+// routeChains builds the middleware chain of each kind of access declaration
+type routeChains struct {
+	Onboarding []api.MiddlewareFunc
+	Permission func(permission string) []api.MiddlewareFunc
+	Ownership  func(resource, urlParam, action string) []api.MiddlewareFunc
+	SignedLink func(action api.SignedLinkAction) []api.MiddlewareFunc
+}
+
+// routesFromSpec reads the access declaration of every operation in the spec
+// and returns the public routes and the routes with their own middleware chain,
+// keyed by method and pattern under baseURL. Authenticated routes are in neither
+// and get the default chain. Every operation must declare its access, so a new
+// endpoint cannot fall back to a default nobody chose; all missing or invalid
+// declarations are reported together.
+func routesFromSpec(spec *openapi3.T, baseURL string, chains routeChains) (map[string]bool, map[string][]api.MiddlewareFunc, error) {
+	signedLinkActions := specEnum(spec, "SignedLinkAction")
+
+	public := make(map[string]bool)
+	specific := make(map[string][]api.MiddlewareFunc)
+	var errs []error
+	for path, item := range spec.Paths.Map() {
+		for method, op := range item.Operations() {
+			pattern := method + " " + baseURL + path
+			fail := func(format string, args ...any) {
+				errs = append(errs, fmt.Errorf("%s: %s", pattern, fmt.Sprintf(format, args...)))
+			}
+
+			kind, value, err := parseRouteAccess(op.Extensions[routeAccessExtension])
+			if err != nil {
+				fail("%v", err)
+				continue
+			}
+
+			switch kind {
+			case "public", "authenticated", "onboarding":
+				if value != "" {
+					fail("%s %s takes no value", routeAccessExtension, kind)
+					continue
+				}
+				if kind == "public" {
+					public[pattern] = true
+				} else if kind == "onboarding" {
+					specific[pattern] = chains.Onboarding
+				}
+			case "permission":
+				if !permission.IsValid(value) {
+					fail("unknown permission %q", value)
+					continue
+				}
+				specific[pattern] = chains.Permission(value)
+			case "ownership":
+				resource, action, ok := strings.Cut(value, ":")
+				if !ok || !permission.IsValid(value+":own") {
+					fail("no permission %q to check ownership with", value+":own")
+					continue
+				}
+				if !strings.Contains(path, "{id}") {
+					fail("ownership needs an {id} path parameter")
+					continue
+				}
+				specific[pattern] = chains.Ownership(resource, "id", action)
+			case "signedLink":
+				if !slices.Contains(signedLinkActions, value) {
+					fail("unknown signed link action %q", value)
+					continue
+				}
+				specific[pattern] = chains.SignedLink(api.SignedLinkAction(value))
+			default:
+				fail("unknown %s kind %q", routeAccessExtension, kind)
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		sort.Slice(errs, func(i, j int) bool { return errs[i].Error() < errs[j].Error() })
+		return nil, nil, fmt.Errorf("operations without a valid %s declaration:\n%w", routeAccessExtension, errors.Join(errs...))
+	}
+	return public, specific, nil
+}
+
+// parseRouteAccess splits a declaration into its kind and, for object forms, its value
+func parseRouteAccess(raw any) (kind, value string, err error) {
+	switch declared := raw.(type) {
+	case nil:
+		return "", "", fmt.Errorf("missing %s", routeAccessExtension)
+	case string:
+		return declared, "", nil
+	case map[string]any:
+		if len(declared) != 1 {
+			return "", "", fmt.Errorf("%s must have exactly one key", routeAccessExtension)
+		}
+		for key, v := range declared {
+			s, ok := v.(string)
+			if !ok || s == "" {
+				return "", "", fmt.Errorf("%s.%s must be a non-empty string", routeAccessExtension, key)
+			}
+			kind, value = key, s
+		}
+		return kind, value, nil
+	default:
+		return "", "", fmt.Errorf("%s must be a string or an object", routeAccessExtension)
+	}
+}
+
+// specEnum returns the string values of a component schema's enum
+func specEnum(spec *openapi3.T, schema string) []string {
+	ref, ok := spec.Components.Schemas[schema]
+	if !ok || ref.Value == nil {
+		return nil
+	}
+	var values []string
+	for _, v := range ref.Value.Enum {
+		if s, ok := v.(string); ok {
+			values = append(values, s)
+		}
+	}
+	return values
+}
@@ -0,0 +1,62 @@
+package server
+
+import (
+	"testing"
+
+	"backend/internal/adapters/api"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// chainOf returns a chain recognisable by its length, with no middleware to run
+func chainOf(n int) []api.MiddlewareFunc {
+	return make([]api.MiddlewareFunc, n)
+}
+
+var testChains = routeChains{
+	Onboarding: chainOf(1),
+	Permission: func(string) []api.MiddlewareFunc { return chainOf(2) },
+	Ownership:  func(string, string, string) []api.MiddlewareFunc { return chainOf(3) },
+	SignedLink: func(api.SignedLinkAction) []api.MiddlewareFunc { return chainOf(4) },
+}
+
+func TestRoutesFromSpec_EmbeddedSpec(t *testing.T) {
+	spec, err := api.GetSwagger()
+	require.NoError(t, err)
+
+	public, specific, err := routesFromSpec(spec, "/api/v1", testChains)
+	require.NoError(t, err, "every operation declares its access")
+
+	assert.True(t, public["GET /api/v1/posts/{id}"])
+	assert.NotContains(t, specific, "GET /api/v1/users/me", "authenticated routes use the default chain")
+	assert.Len(t, specific["POST /api/v1/users"], 1)
+	assert.Len(t, specific["POST /api/v1/posts"], 2)
+	assert.Len(t, specific["PUT /api/v1/posts/{id}"], 3)
+	assert.Len(t, specific["POST /api/v1/action-links/posts/{id}/approve"], 4)
+	assert.Len(t, specific["POST /api/v1/action-links/users/{id}/follow"], 4)
+}
+
+func TestRoutesFromSpec_RejectsUndeclaredOperations(t *testing.T) {
+	op := func(access any) *openapi3.Operation {
+		o := openapi3.NewOperation()
+		if access != nil {
+			o.Extensions = map[string]any{routeAccessExtension: access}
+		}
+		return o
+	}
+	spec := &openapi3.T{Components: &openapi3.Components{}, Paths: openapi3.NewPaths(
+		openapi3.WithPath("/ok", &openapi3.PathItem{Get: op("public")}),
+		openapi3.WithPath("/missing", &openapi3.PathItem{Get: op(nil)}),
+		openapi3.WithPath("/typo", &openapi3.PathItem{Get: op("pubic")}),
+		openapi3.WithPath("/unknown", &openapi3.PathItem{Get: op(map[string]any{"permission": "posts:fly"})}),
+		openapi3.WithPath("/no-id", &openapi3.PathItem{Get: op(map[string]any{"ownership": "posts:update"})}),
+	)}
+
+	_, _, err := routesFromSpec(spec, "/api/v1", testChains)
+	require.Error(t, err)
+	for _, pattern := range []string{"GET /api/v1/missing", "GET /api/v1/typo", "GET /api/v1/unknown", "GET /api/v1/no-id"} {
+		assert.Contains(t, err.Error(), pattern)
+	}
+	assert.NotContains(t, err.Error(), "GET /api/v1/ok")
+}
@@ -0,0 +1,27 @@
+package server
+
+import (
+	authzSeeder "backend/internal/authz/seeder"
+	"backend/internal/platform/logger"
+	"backend/internal/platform/seeder"
+	postsSeeder "backend/internal/posts/seeder"
+	settingsSeeder "backend/internal/settings/seeder"
+	themesSeeder "backend/internal/themes/seeder"
+	usersSeeder "backend/internal/users/seeder"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// provideSeederRegistry registers every seeder in the order they must run
+// Later seeders may rely on rows created by earlier ones
+func provideSeederRegistry(log logger.Logger, db *pgxpool.Pool) (*seeder.Registry, error) {
+	return seeder.NewRegistry(log, db, []seeder.Entry{
+		// Baseline data every deployment needs
+		{Seeder: authzSeeder.NewAuthzSeeder(), Profile: seeder.ProfileProd},
+		{Seeder: settingsSeeder.NewSettingsSeeder(), Profile: seeder.ProfileProd},
+
+		// Sample content for local development
+		{Seeder: usersSeeder.NewDemoUsersSeeder(), Profile: seeder.ProfileDemo, Once: true},
+		{Seeder: postsSeeder.NewDemoPostsSeeder(), Profile: seeder.ProfileDemo, Once: true},
+		{Seeder: themesSeeder.NewDemoThemesSeeder(), Profile: seeder.ProfileDemo, Once: true},
+	})
+}
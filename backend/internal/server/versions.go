@@ -0,0 +1,78 @@
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"backend/internal/adapters/api"
+	"backend/internal/adapters/rest/middleware"
+	apiclientsDomain "backend/internal/apiclients/domain"
+	"backend/internal/platform/httpcache"
+	"github.com/go-chi/chi/v5"
+)
+
+// apiVersion is one major version of the API, served under its own base URL.
+// Every version gets its own route table but shares the authentication chains
+// and outer middleware, so tenancy, CORS and logging behave the same in all of
+// them. Breaking changes, such as a new response shape, ship in a new version
+// while the old endpoint is marked deprecated and keeps working until its sunset.
+type apiVersion struct {
+	BaseURL string
+	Routes  routeTable
+	// Register adds the version's endpoints to the router, each wrapped with middlewares
+	Register func(r chi.Router, baseURL string, middlewares []api.MiddlewareFunc)
+}
+
+// routeTable holds a version's per-route settings, keyed by method and route pattern
+type routeTable struct {
+	Public        map[string]bool
+	Permissions   map[string][]api.MiddlewareFunc
+	TokenScopes   map[string]apiclientsDomain.Scope
+	CachePolicies map[string]httpcache.Policy
+	Deprecations  map[string]middleware.Deprecation
+	Disabled      map[string]string // Routes of features not enabled, naming the feature
+}
+
+// versionRoute is an endpoint registered by hand, for versions without generated routes
+type versionRoute struct {
+	Method  string
+	Pattern string // Relative to the version's base URL
+	Handler http.HandlerFunc
+}
+
+// registerRoutes returns a Register function adding the routes under the base URL
+func registerRoutes(routes []versionRoute) func(r chi.Router, baseURL string, middlewares []api.MiddlewareFunc) {
+	return func(r chi.Router, baseURL string, middlewares []api.MiddlewareFunc) {
+		r.Route(baseURL, func(sub chi.Router) {
+			for _, route := range routes {
+				// Later middlewares wrap earlier ones, as in the generated routes
+				var handler http.Handler = route.Handler
+				for _, mw := range middlewares {
+					handler = mw(handler)
+				}
+				sub.Method(route.Method, route.Pattern, handler)
+			}
+		})
+	}
+}
+
+// v1DeprecatedAt is when the offset-paginated listings of v1 were deprecated
+var v1DeprecatedAt = time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+
+// v1Deprecations lists the v1 endpoints whose responses change in v2: listings
+// move from page numbers and totals to cursor pagination. Until v2 serves their
+// replacement they carry no successor link.
+func v1Deprecations(sunset time.Time) map[string]middleware.Deprecation {
+	deprecation := middleware.Deprecation{Since: v1DeprecatedAt, Sunset: sunset}
+	return map[string]middleware.Deprecation{
+		"GET /api/v1/posts":  deprecation,
+		"GET /api/v1/themes": deprecation,
+		"GET /api/v1/series": deprecation,
+	}
+}
+
+// v2Routes are the endpoints of the next major version, which is still taking
+// shape; a replaced v1 endpoint should name its v2 path as the successor
+func v2Routes() []versionRoute {
+	return nil
+}
@@ -4,20 +4,67 @@
 package server
 
 import (
+	apiclientsApp "backend/internal/apiclients/application"
 	"context"
+	"crypto/rand"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
 
+	"backend/internal/adapters/api"
 	"backend/internal/adapters/authz_adapter"
 	"backend/internal/adapters/postgres"
 	"backend/internal/adapters/rest"
 	"backend/internal/adapters/rest/middleware"
+	analyticsApp "backend/internal/analytics/application"
+	announcementsApp "backend/internal/announcements/application"
 	authzApp "backend/internal/authz/application"
+	blogsApp "backend/internal/blogs/application"
+	bookmarksApp "backend/internal/bookmarks/application"
+	exportApp "backend/internal/export/application"
+	feedbackApp "backend/internal/feedback/application"
+	followsApp "backend/internal/follows/application"
+	homeApp "backend/internal/home/application"
+	impersonationApp "backend/internal/impersonation/application"
+	integrityApp "backend/internal/integrity/application"
+	linkreportsApp "backend/internal/linkreports/application"
+	liveApp "backend/internal/live/application"
+	mentionsApp "backend/internal/mentions/application"
+	notificationsApp "backend/internal/notifications/application"
+	organizationsApp "backend/internal/organizations/application"
+	"backend/internal/platform/cache"
+	"backend/internal/platform/dataexport"
+	"backend/internal/platform/erasure"
+	"backend/internal/platform/errreport"
 	"backend/internal/platform/eventbus"
+	"backend/internal/platform/highlight"
+	"backend/internal/platform/httpcache"
+	"backend/internal/platform/linkcheck"
 	"backend/internal/platform/logger"
 	"backend/internal/platform/ownership"
 	postgresDb "backend/internal/platform/postgres"
+	"backend/internal/platform/seeder"
+	"backend/internal/platform/signedlink"
+	"backend/internal/platform/spam"
+	"backend/internal/platform/webmention"
 	postsApp "backend/internal/posts/application"
+	privacyApp "backend/internal/privacy/application"
+	quotasApp "backend/internal/quotas/application"
+	quotasDomain "backend/internal/quotas/domain"
+	reactionsApp "backend/internal/reactions/application"
+	reportsApp "backend/internal/reports/application"
+	retentionApp "backend/internal/retention/application"
+	retentionDomain "backend/internal/retention/domain"
+	searchApp "backend/internal/search/application"
+	securityApp "backend/internal/security/application"
+	seriesApp "backend/internal/series/application"
+	serviceaccountsApp "backend/internal/serviceaccounts/application"
+	settingsApp "backend/internal/settings/application"
+	sharecardsApp "backend/internal/sharecards/application"
 	themesApp "backend/internal/themes/application"
 	"backend/internal/users/application"
+	"github.com/google/uuid"
 	"github.com/google/wire"
 )
 
@@ -36,12 +83,28 @@ func InitializeApp(ctx context.Context) (*App, func(), error) {
 		wire.Bind(new(logger.Logger), new(*logger.SlogAdapter)),
 
 		// Database
+		provideFieldEncryption,
 		ConnectDatabase,
+		provideSeederRegistry,
 
 		// Platform services
-		postgresDb.NewTransactionManager,
+		postgresDb.NewUnitOfWork,
 		ownership.ProviderSet,
+		erasure.ProviderSet,
+		dataexport.ProviderSet,
+		provideErrorReportConfig,
+		errreport.ProvideReporter,
 		eventbus.NewBus,
+		provideCacheConfig,
+		cache.ProvideCache,
+		provideHTTPCacheConfig,
+		httpcache.ProvideStore,
+		provideHighlightConfig,
+		highlight.ProvideHighlighter,
+		provideSpamConfig,
+		spam.ProvideChecker,
+		httpcache.ProvidePurger,
+		httpcache.NewInvalidator,
 
 		// Repository providers (includes interface binding)
 		postgres.ProviderSet,
@@ -54,15 +117,106 @@ func InitializeApp(ctx context.Context) (*App, func(), error) {
 		authzApp.ProviderSet,
 		postsApp.ProviderSet,
 		themesApp.ProviderSet,
+		seriesApp.ProviderSet,
+		reactionsApp.ProviderSet,
+		reportsApp.ProviderSet,
+		bookmarksApp.ProviderSet,
+		followsApp.ProviderSet,
+		notificationsApp.ProviderSet,
+		exportApp.ProviderSet,
+		blogsApp.ProviderSet,
+		announcementsApp.ProviderSet,
+		feedbackApp.ProviderSet,
+		mentionsApp.ProviderSet,
+		analyticsApp.ProviderSet,
+		sharecardsApp.ProviderSet,
+		homeApp.ProviderSet,
+		searchApp.ProviderSet,
+		integrityApp.ProviderSet,
+		retentionApp.ProviderSet,
+		linkreportsApp.ProviderSet,
+		apiclientsApp.ProviderSet,
+		quotasApp.ProviderSet,
+		impersonationApp.ProviderSet,
+		serviceaccountsApp.ProviderSet,
+		organizationsApp.ProviderSet,
+		settingsApp.ProviderSet,
+		liveApp.ProviderSet,
+		privacyApp.ProviderSet,
+		securityApp.ProviderSet,
+
+		// Event subscribers, ownership checkers, erasers and exporters
+		RegisterEventSubscriptions,
+		RegisterOwnershipCheckers,
+		RegisterErasers,
+		RegisterExporters,
 
 		// REST handlers
 		rest.ProviderSet,
 		provideVersion, // Provide version string for HealthHandler
+		provideErrorConfig,
+		provideOpenAPIConfig,
 
 		// Auth middleware
 		provideJWTConfig,
+		provideCORSConfig,
+		provideSecurityConfig,
+		provideClientIPConfig,
+		provideCompressionConfig,
 		middleware.ProviderSet,
 
+		// Retention policies and their scheduled job
+		provideRetentionSettings,
+		provideRetentionJobConfig,
+
+		// Link checking and its scheduled job
+		provideLinkCheckConfig,
+		linkcheck.ProvideChecker,
+		provideLinkCheckJobConfig,
+
+		// Webmentions and their verification job
+		provideMentionsConfig,
+		provideWebmentionConfig,
+		webmention.ProvideVerifier,
+		provideMentionsJobConfig,
+
+		// Public API clients and their usage flush job
+		provideAPIClientConfig,
+		provideAPIClientUsageJobConfig,
+
+		// Content quotas
+		provideQuotaConfig,
+
+		// User onboarding
+		provideOnboardingConfig,
+
+		// Impersonation sessions
+		provideImpersonationConfig,
+
+		// Service accounts
+		provideServiceAccountConfig,
+
+		// Personal data erasure and its scheduled job
+		provideErasureJobConfig,
+
+		// Personal data export and its scheduled job
+		provideDataExportConfig,
+		provideDataExportJobConfig,
+
+		// Post view tracking and its rollup job
+		provideAnalyticsTrackingConfig,
+		provideAnalyticsJobConfig,
+
+		// Signed action links
+		provideSignedLinkConfig,
+		signedlink.NewSigner,
+
+		// Post workflow, from POST_WORKFLOW_FILE when set
+		providePostWorkflow,
+
+		// Theme reading lists
+		provideReadingListConfig,
+
 		// HTTP Server
 		NewHTTPServer,
 
@@ -73,6 +227,23 @@ func InitializeApp(ctx context.Context) (*App, func(), error) {
 	return nil, nil, nil
 }
 
+// InitializeSeeders creates the seeder registry with only the configuration,
+// logger and database it needs, for running seeders without starting the server
+func InitializeSeeders(ctx context.Context) (*seeder.Registry, func(), error) {
+	wire.Build(
+		logger.NewBootstrapLogger,
+		LoadConfig,
+		provideLoggerConfig,
+		logger.NewConfiguredLogger,
+		wire.Bind(new(logger.Logger), new(*logger.SlogAdapter)),
+		provideFieldEncryption,
+		ConnectDatabase,
+		provideSeederRegistry,
+	)
+
+	return nil, nil, nil
+}
+
 // provideVersion provides the application version
 func provideVersion() string {
 	return "1.0.0"
@@ -81,9 +252,294 @@ func provideVersion() string {
 // provideLoggerConfig creates logger config from server config
 func provideLoggerConfig(config Config) logger.Config {
 	return logger.Config{
+		Environment:           config.Environment,
+		LogLevel:              config.LogLevel,
+		Format:                config.LogFormat,
+		ModuleLevels:          config.LogModuleLevels,
+		DebugSampleFirst:      config.LogDebugSampleFirst,
+		DebugSampleThereafter: config.LogDebugSampleThereafter,
+	}
+}
+
+// provideErrorReportConfig creates error reporting config from server config
+func provideErrorReportConfig(config Config) errreport.Config {
+	return errreport.Config{
+		DSN:         config.ErrorReportDSN,
 		Environment: config.Environment,
-		LogLevel:    config.LogLevel,
+		Release:     provideVersion(),
+		SampleRate:  config.ErrorReportSampleRate,
+		ScrubFields: config.ErrorReportScrubFields,
+		SendPII:     config.ErrorReportSendPII,
+	}
+}
+
+// provideErrorConfig creates the error response config from server config
+func provideErrorConfig(config Config) (rest.ErrorConfig, error) {
+	format := rest.ErrorFormat(config.ErrorFormat)
+	if format != rest.ErrorFormatJSON && format != rest.ErrorFormatProblem {
+		return rest.ErrorConfig{}, fmt.Errorf("ERROR_FORMAT must be json or problem, got %q", config.ErrorFormat)
+	}
+	return rest.ErrorConfig{Format: format, ProblemTypeBase: config.ProblemTypeBaseURL}, nil
+}
+
+// provideOpenAPIConfig describes this deployment for the published API document,
+// including whether each feature endpoints are gated on is enabled
+func provideOpenAPIConfig(config Config) (rest.OpenAPIConfig, error) {
+	features := map[string]bool{
+		"responseCache":           config.ResponseCacheEnabled,
+		"syntaxHighlighting":      config.HighlightEnabled,
+		"problemDetailsByDefault": config.ErrorFormat == string(rest.ErrorFormatProblem),
+	}
+
+	spec, err := api.GetSwagger()
+	if err != nil {
+		return rest.OpenAPIConfig{}, fmt.Errorf("load API spec: %w", err)
+	}
+	routes, err := routeFeatures(spec, "/api/v1")
+	if err != nil {
+		return rest.OpenAPIConfig{}, err
+	}
+	for _, feature := range routes {
+		features[feature] = slices.Contains(config.Features, feature)
+	}
+
+	return rest.OpenAPIConfig{PublicURL: config.PublicAPIURL, Features: features}, nil
+}
+
+// provideCacheConfig creates cache config from server config
+func provideCacheConfig(config Config) cache.Config {
+	return cache.Config{
+		RedisURL:     config.RedisURL,
+		PostTTL:      config.CachePostTTL,
+		ThemeTTL:     config.CacheThemeTTL,
+		ThemeListTTL: config.CacheThemeListTTL,
+		SettingsTTL:  config.CacheSettingsTTL,
+		HomeTTL:      config.CacheHomeTTL,
+		TrendingTTL:  config.CacheTrendingTTL,
+
+		RenderedContentTTL: config.CacheRenderedTTL,
+	}
+}
+
+// provideHighlightConfig creates the syntax highlighting settings from server config
+func provideHighlightConfig(config Config) highlight.Config {
+	return highlight.Config{
+		Enabled: config.HighlightEnabled,
+		Style:   config.HighlightStyle,
+	}
+}
+
+// provideSpamConfig creates the spam checker settings from server config
+func provideSpamConfig(config Config) spam.Config {
+	return spam.Config{
+		AkismetKey: config.SpamAkismetKey,
+		AkismetURL: config.SpamAkismetURL,
+		SiteURL:    config.SiteURL,
+		MaxLinks:   config.SpamMaxLinks,
+		Blocklist:  config.SpamBlocklist,
+	}
+}
+
+// provideHTTPCacheConfig creates response cache config from server config
+func provideHTTPCacheConfig(config Config) httpcache.Config {
+	return httpcache.Config{
+		StoreEnabled:  config.ResponseCacheEnabled,
+		CDNPurgeURL:   config.CDNPurgeURL,
+		CDNPurgeToken: config.CDNPurgeToken,
+	}
+}
+
+// provideCORSConfig creates the CORS policy from server config
+func provideCORSConfig(config Config) middleware.CORSConfig {
+	return middleware.CORSConfig{
+		AllowedOrigins:   config.CORSAllowedOrigins,
+		AllowedMethods:   config.CORSAllowedMethods,
+		AllowedHeaders:   config.CORSAllowedHeaders,
+		ExposedHeaders:   []string{middleware.HeaderDeprecation, middleware.HeaderSunset, middleware.HeaderLink},
+		AllowCredentials: config.CORSAllowCredentials,
+		MaxAge:           config.CORSMaxAge,
+	}
+}
+
+// provideSecurityConfig creates the security settings from server config
+// Cookies are restricted to HTTPS everywhere except local development
+func provideSecurityConfig(config Config) middleware.SecurityConfig {
+	return middleware.SecurityConfig{
+		HSTSMaxAge:    config.HSTSMaxAge,
+		SecureCookies: config.Environment != "development",
+	}
+}
+
+// provideClientIPConfig creates the client IP settings from server config
+func provideClientIPConfig(config Config) middleware.ClientIPConfig {
+	return middleware.ClientIPConfig{TrustedProxies: config.TrustedProxies}
+}
+
+// provideCompressionConfig creates the response compression settings from server config
+func provideCompressionConfig(config Config) middleware.CompressionConfig {
+	return middleware.CompressionConfig{
+		MinSize:      config.CompressionMinSize,
+		ContentTypes: config.CompressionContentTypes,
+	}
+}
+
+// provideRetentionSettings creates the retention policies from server config
+func provideRetentionSettings(config Config) (retentionDomain.Settings, error) {
+	exempt := make([]uuid.UUID, 0, len(config.RetentionExemptAuthors))
+	for _, raw := range config.RetentionExemptAuthors {
+		id, err := uuid.Parse(strings.TrimSpace(raw))
+		if err != nil {
+			return retentionDomain.Settings{}, fmt.Errorf("RETENTION_EXEMPT_AUTHORS: invalid user ID %q: %w", raw, err)
+		}
+		exempt = append(exempt, id)
+	}
+
+	return retentionDomain.Settings{
+		Rules: []retentionDomain.Rule{
+			{Policy: retentionDomain.PolicyStaleDrafts, Months: config.RetentionStaleDraftMonths},
+			{Policy: retentionDomain.PolicyArchivedPosts, Months: config.RetentionArchivedMonths},
+		},
+		ExemptAuthors: exempt,
+	}, nil
+}
+
+// provideRetentionJobConfig creates the retention job schedule from server config
+func provideRetentionJobConfig(config Config) retentionApp.JobConfig {
+	return retentionApp.JobConfig{
+		Interval: config.RetentionInterval,
+		DryRun:   config.RetentionDryRun,
+	}
+}
+
+// provideLinkCheckConfig creates the link checker settings from server config
+func provideLinkCheckConfig(config Config) linkcheck.Config {
+	return linkcheck.Config{Timeout: config.LinkCheckTimeout}
+}
+
+// provideLinkCheckJobConfig creates the link check job schedule from server config
+func provideLinkCheckJobConfig(config Config) linkreportsApp.JobConfig {
+	return linkreportsApp.JobConfig{
+		Interval:    config.LinkCheckInterval,
+		Concurrency: config.LinkCheckConcurrency,
+	}
+}
+
+// provideMentionsConfig tells the mentions service which URLs are the blog's posts
+func provideMentionsConfig(config Config) mentionsApp.Config {
+	return mentionsApp.Config{SiteURL: config.SiteURL}
+}
+
+// provideWebmentionConfig creates the webmention source verifier config from server config
+func provideWebmentionConfig(config Config) webmention.Config {
+	return webmention.Config{Timeout: config.WebmentionVerifyTimeout}
+}
+
+// provideMentionsJobConfig creates the webmention verification schedule from server config
+func provideMentionsJobConfig(config Config) mentionsApp.JobConfig {
+	return mentionsApp.JobConfig{Interval: config.WebmentionVerifyInterval}
+}
+
+// provideAPIClientConfig creates the API client limits from server config
+func provideAPIClientConfig(config Config) apiclientsApp.ClientConfig {
+	return apiclientsApp.ClientConfig{
+		RateLimit:   config.APIClientRateLimit,
+		RateWindow:  config.APIClientRateWindow,
+		MaxPerOwner: config.APIClientMaxPerOwner,
+	}
+}
+
+// provideAPIClientUsageJobConfig creates the API client usage flush schedule from server config
+func provideAPIClientUsageJobConfig(config Config) apiclientsApp.UsageJobConfig {
+	return apiclientsApp.UsageJobConfig{Interval: config.APIClientUsageFlushInterval}
+}
+
+// provideQuotaConfig creates the default content quotas from server config
+func provideQuotaConfig(config Config) quotasApp.QuotaConfig {
+	return quotasApp.QuotaConfig{
+		Defaults: quotasDomain.Limits{
+			MaxDrafts:    config.QuotaMaxDrafts,
+			MaxPostBytes: config.QuotaMaxPostBytes,
+			MaxThemes:    config.QuotaMaxThemes,
+		},
+	}
+}
+
+// provideOnboardingConfig creates the new user defaults from server config
+func provideOnboardingConfig(config Config) application.OnboardingConfig {
+	return application.OnboardingConfig{DefaultRole: config.OnboardingDefaultRole}
+}
+
+// provideReadingListConfig creates the reading list link settings from server config
+func provideReadingListConfig(config Config) themesApp.ReadingListConfig {
+	return themesApp.ReadingListConfig{SiteURL: config.SiteURL}
+}
+
+// provideFieldEncryption creates the envelope sealing encrypted columns from
+// the configured master keys; without keys such columns refuse to be written
+func provideFieldEncryption(config Config, log logger.Logger) (*postgresDb.Envelope, error) {
+	if config.FieldEncryptionKeys == "" {
+		log.Warn(context.Background(), "FIELD_ENCRYPTION_KEYS is not set; encrypted columns cannot be read or written")
+		return nil, nil
+	}
+	provider, err := postgresDb.ParseLocalKeys(config.FieldEncryptionKeys)
+	if err != nil {
+		return nil, fmt.Errorf("configure field encryption: %w", err)
+	}
+	return postgresDb.NewEnvelope(provider), nil
+}
+
+// provideImpersonationConfig creates the impersonation session lifetime from server config
+func provideImpersonationConfig(config Config) impersonationApp.SessionConfig {
+	return impersonationApp.SessionConfig{TTL: config.ImpersonationTTL}
+}
+
+// provideServiceAccountConfig creates the machine token lifetime from server config
+func provideServiceAccountConfig(config Config) serviceaccountsApp.AccountConfig {
+	return serviceaccountsApp.AccountConfig{TokenTTL: config.ServiceAccountTokenTTL}
+}
+
+// provideErasureJobConfig creates the erasure job schedule from server config
+func provideErasureJobConfig(config Config) privacyApp.JobConfig {
+	return privacyApp.JobConfig{Interval: config.ErasureInterval}
+}
+
+// provideDataExportConfig creates the data export archive lifetime from server config
+func provideDataExportConfig(config Config) privacyApp.ExportConfig {
+	return privacyApp.ExportConfig{ArchiveTTL: config.DataExportTTL}
+}
+
+// provideDataExportJobConfig creates the data export job schedule from server config
+func provideDataExportJobConfig(config Config) privacyApp.ExportJobConfig {
+	return privacyApp.ExportJobConfig{Interval: config.DataExportInterval}
+}
+
+// provideAnalyticsTrackingConfig creates view attribution settings from server
+// config; an unparsable SITE_URL leaves every referrer counted as external
+func provideAnalyticsTrackingConfig(config Config) analyticsApp.TrackingConfig {
+	var host string
+	if site, err := url.Parse(config.SiteURL); err == nil {
+		host = site.Hostname()
+	}
+	return analyticsApp.TrackingConfig{OwnHost: host}
+}
+
+// provideAnalyticsJobConfig creates the view rollup job schedule from server config
+func provideAnalyticsJobConfig(config Config) analyticsApp.JobConfig {
+	return analyticsApp.JobConfig{Interval: config.AnalyticsRollupInterval}
+}
+
+// provideSignedLinkConfig creates the action link settings from server config,
+// with a throwaway secret in development when none is configured
+func provideSignedLinkConfig(config Config, log logger.Logger) (signedlink.Config, error) {
+	secret := []byte(config.SignedLinkSecret)
+	if len(secret) == 0 {
+		secret = make([]byte, signedlink.MinSecretLength)
+		if _, err := rand.Read(secret); err != nil {
+			return signedlink.Config{}, fmt.Errorf("generate signed link secret: %w", err)
+		}
+		log.Warn(context.Background(), "SIGNED_LINK_SECRET is not set; signed links will not survive a restart")
 	}
+	return signedlink.Config{Secret: secret, TTL: config.SignedLinkTTL}, nil
 }
 
 // provideJWTConfig adapts server Config into middleware.JWTConfig to avoid package cycles
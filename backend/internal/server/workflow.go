@@ -0,0 +1,66 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"backend/internal/platform/logger"
+	postsDomain "backend/internal/posts/domain"
+)
+
+// postWorkflowFile is the format of POST_WORKFLOW_FILE, for example:
+//
+//	{
+//	  "statuses": ["draft", "scheduled", "published", "archived"],
+//	  "transitions": [
+//	    {"from": "draft", "to": "scheduled", "permission": "posts:publish:own"},
+//	    {"from": "scheduled", "to": "published", "permission": "posts:publish:own"}
+//	  ]
+//	}
+type postWorkflowFile struct {
+	Statuses    []string `json:"statuses"`
+	Transitions []struct {
+		From       string `json:"from"`
+		To         string `json:"to"`
+		Permission string `json:"permission"`
+	} `json:"transitions"`
+}
+
+// providePostWorkflow loads the post workflow configured for this deployment,
+// refusing to start on a definition the posts domain rejects
+func providePostWorkflow(config Config, log logger.Logger) (*postsDomain.Workflow, error) {
+	if config.PostWorkflowFile == "" {
+		return postsDomain.DefaultWorkflow(), nil
+	}
+
+	data, err := os.ReadFile(config.PostWorkflowFile)
+	if err != nil {
+		return nil, fmt.Errorf("POST_WORKFLOW_FILE: %w", err)
+	}
+	var file postWorkflowFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("POST_WORKFLOW_FILE: %w", err)
+	}
+
+	statuses := make([]postsDomain.PostStatus, 0, len(file.Statuses))
+	for _, status := range file.Statuses {
+		statuses = append(statuses, postsDomain.PostStatus(status))
+	}
+	transitions := make([]postsDomain.Transition, 0, len(file.Transitions))
+	for _, t := range file.Transitions {
+		transitions = append(transitions, postsDomain.Transition{
+			From:       postsDomain.PostStatus(t.From),
+			To:         postsDomain.PostStatus(t.To),
+			Permission: t.Permission,
+		})
+	}
+
+	workflow, err := postsDomain.NewWorkflow(statuses, transitions)
+	if err != nil {
+		return nil, fmt.Errorf("POST_WORKFLOW_FILE: %w", err)
+	}
+	log.Info(context.Background(), "loaded post workflow", "file", config.PostWorkflowFile, "statuses", len(statuses), "transitions", len(transitions))
+	return workflow, nil
+}
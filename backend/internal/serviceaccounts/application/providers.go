@@ -0,0 +1,8 @@
+package application
+
+import "github.com/google/wire"
+
+// ProviderSet is the wire provider set for the service accounts application layer
+var ProviderSet = wire.NewSet(
+	NewAccountService,
+)
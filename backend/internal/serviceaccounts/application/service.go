@@ -0,0 +1,345 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/logger"
+	"backend/internal/serviceaccounts/domain"
+	"backend/internal/serviceaccounts/ports"
+	"github.com/google/uuid"
+)
+
+// Error definitions for service operations
+var (
+	ErrAccountNotFound = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodeServiceAccountNotFound,
+		"service account not found",
+		http.StatusNotFound,
+	)
+
+	ErrInvalidAccount = apperror.New(
+		apperror.CodeValidationFailed,
+		apperror.BusinessCodeInvalidFormat,
+		"invalid service account",
+		http.StatusBadRequest,
+	)
+
+	ErrInvalidClientCredentials = apperror.New(
+		apperror.CodeUnauthorized,
+		apperror.BusinessCodeInvalidClientCredentials,
+		"invalid client credentials",
+		http.StatusUnauthorized,
+	)
+
+	ErrInvalidAccessToken = apperror.New(
+		apperror.CodeUnauthorized,
+		apperror.BusinessCodeInvalidMachineToken,
+		"invalid or expired access token",
+		http.StatusUnauthorized,
+	)
+)
+
+// Audit trail page sizes
+const (
+	DefaultActionsLimit = 50
+	MaxActionsLimit     = 200
+)
+
+// AccountConfig bounds how long issued access tokens last
+type AccountConfig struct {
+	TokenTTL time.Duration
+}
+
+// AccountService manages the service accounts other services call the API
+// as. Accounts trade their client credentials for short-lived access
+// tokens, are authorized through the roles granted to them like any user,
+// and have their requests audited apart from those of people.
+type AccountService struct {
+	repo       ports.AccountRepository
+	authorizer ports.Authorizer
+	config     AccountConfig
+	logger     logger.Logger
+}
+
+// NewAccountService creates a new service account service
+func NewAccountService(
+	repo ports.AccountRepository,
+	authorizer ports.Authorizer,
+	config AccountConfig,
+	logger logger.Logger,
+) *AccountService {
+	return &AccountService{
+		repo:       repo,
+		authorizer: authorizer,
+		config:     config,
+		logger:     logger,
+	}
+}
+
+// CreateAccount registers an account and returns it with its client secret,
+// which is not retrievable afterwards. The account starts without roles;
+// they are granted to its ID like to any user.
+func (s *AccountService) CreateAccount(ctx context.Context, actorID uuid.UUID, name, description string) (*domain.Account, string, error) {
+	if err := s.checkCanManage(ctx, actorID); err != nil {
+		return nil, "", err
+	}
+
+	account, secret, err := domain.NewAccount(actorID, name, description)
+	if err != nil {
+		return nil, "", ErrInvalidAccount.WithDetails(err.Error())
+	}
+
+	if err := s.repo.Create(ctx, account); err != nil {
+		s.logger.Error(ctx, "failed to create service account", "error", err, "actorID", actorID)
+		return nil, "", apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to create service account",
+			http.StatusInternalServerError,
+		)
+	}
+
+	s.logger.Info(ctx, "service account created", "accountID", account.ID, "clientID", account.ClientID, "createdBy", actorID)
+	return account, secret, nil
+}
+
+// ListAccounts retrieves the accounts of the current blog, newest first
+func (s *AccountService) ListAccounts(ctx context.Context, actorID uuid.UUID) ([]*domain.Account, error) {
+	if err := s.checkCanManage(ctx, actorID); err != nil {
+		return nil, err
+	}
+
+	accounts, err := s.repo.List(ctx)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list service accounts", "error", err)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to retrieve service accounts",
+			http.StatusInternalServerError,
+		)
+	}
+	return accounts, nil
+}
+
+// RevokeAccount disables an account, rejecting its credentials and the
+// tokens already issued to it; revoking it twice is not an error
+func (s *AccountService) RevokeAccount(ctx context.Context, actorID uuid.UUID, accountID uuid.UUID) (*domain.Account, error) {
+	if err := s.checkCanManage(ctx, actorID); err != nil {
+		return nil, err
+	}
+
+	account, err := s.getAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if account.Revoked() {
+		return account, nil
+	}
+
+	if err := account.Revoke(); err != nil {
+		return nil, ErrInvalidAccount.WithDetails(err.Error())
+	}
+	if err := s.repo.Revoke(ctx, account); err != nil {
+		if errors.Is(err, ports.ErrAccountNotFound) {
+			return nil, ErrAccountNotFound
+		}
+		s.logger.Error(ctx, "failed to revoke service account", "error", err, "accountID", accountID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to revoke service account",
+			http.StatusInternalServerError,
+		)
+	}
+
+	s.logger.Info(ctx, "service account revoked", "accountID", accountID, "revokedBy", actorID)
+	return account, nil
+}
+
+// IssueToken trades an account's client credentials for an access token,
+// returning the token with its record. Unknown clients and wrong secrets
+// are refused alike, so the response does not reveal which client IDs exist.
+func (s *AccountService) IssueToken(ctx context.Context, clientID, secret string) (*domain.AccessToken, string, error) {
+	account, err := s.repo.FindByClientID(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, ports.ErrAccountNotFound) {
+			return nil, "", ErrInvalidClientCredentials
+		}
+		s.logger.Error(ctx, "failed to find service account by client ID", "error", err)
+		return nil, "", apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to verify client credentials",
+			http.StatusInternalServerError,
+		)
+	}
+	if account.Revoked() || !account.VerifySecret(secret) {
+		return nil, "", ErrInvalidClientCredentials
+	}
+
+	token, raw, err := domain.NewAccessToken(account.ID, s.config.TokenTTL)
+	if err != nil {
+		s.logger.Error(ctx, "failed to generate access token", "error", err, "accountID", account.ID)
+		return nil, "", apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to issue access token",
+			http.StatusInternalServerError,
+		)
+	}
+	if err := s.repo.SaveToken(ctx, token); err != nil {
+		s.logger.Error(ctx, "failed to save access token", "error", err, "accountID", account.ID)
+		return nil, "", apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to issue access token",
+			http.StatusInternalServerError,
+		)
+	}
+
+	s.logger.Info(ctx, "service account token issued", "accountID", account.ID, "expiresAt", token.ExpiresAt)
+	return token, raw, nil
+}
+
+// Authenticate returns the account an access token belongs to, as long as
+// the token has not expired and the account has not been revoked
+func (s *AccountService) Authenticate(ctx context.Context, token string) (*domain.Account, error) {
+	account, accessToken, err := s.repo.FindByTokenHash(ctx, domain.HashToken(token))
+	if err != nil {
+		if errors.Is(err, ports.ErrTokenNotFound) {
+			return nil, ErrInvalidAccessToken
+		}
+		s.logger.Error(ctx, "failed to find service account by token", "error", err)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to verify access token",
+			http.StatusInternalServerError,
+		)
+	}
+	if account.Revoked() || !accessToken.Active(time.Now()) {
+		return nil, ErrInvalidAccessToken
+	}
+	return account, nil
+}
+
+// RecordAction appends a request made by an account to its audit trail
+func (s *AccountService) RecordAction(ctx context.Context, action *domain.Action) error {
+	if err := s.repo.RecordAction(ctx, action); err != nil {
+		s.logger.Error(ctx, "failed to record service account action",
+			"error", err,
+			"accountID", action.AccountID,
+			"method", action.Method,
+			"path", action.Path,
+		)
+		return err
+	}
+	return nil
+}
+
+// CompleteAction stores the response status of a recorded action
+func (s *AccountService) CompleteAction(ctx context.Context, action *domain.Action) error {
+	if err := s.repo.CompleteAction(ctx, action); err != nil {
+		s.logger.Error(ctx, "failed to complete service account action",
+			"error", err,
+			"accountID", action.AccountID,
+			"actionID", action.ID,
+			"status", action.Status,
+		)
+		return err
+	}
+	return nil
+}
+
+// ListActions retrieves the latest requests made by an account, newest first.
+// A limit of zero or less uses DefaultActionsLimit; larger ones are capped at MaxActionsLimit.
+func (s *AccountService) ListActions(ctx context.Context, actorID uuid.UUID, accountID uuid.UUID, limit int) ([]*domain.Action, error) {
+	canView, err := s.authorizer.Can(ctx, actorID, "authz:audit", "view", nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canView {
+		return nil, apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to view audit logs",
+			http.StatusForbidden,
+		)
+	}
+
+	if _, err := s.getAccount(ctx, accountID); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = DefaultActionsLimit
+	}
+	limit = min(limit, MaxActionsLimit)
+
+	actions, err := s.repo.ListActions(ctx, accountID, limit)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list service account actions", "error", err, "accountID", accountID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to retrieve service account audit trail",
+			http.StatusInternalServerError,
+		)
+	}
+	return actions, nil
+}
+
+// Private helper methods
+
+// getAccount loads an account of the current blog
+func (s *AccountService) getAccount(ctx context.Context, accountID uuid.UUID) (*domain.Account, error) {
+	account, err := s.repo.FindByID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, ports.ErrAccountNotFound) {
+			return nil, ErrAccountNotFound
+		}
+		s.logger.Error(ctx, "failed to find service account", "error", err, "accountID", accountID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to retrieve service account",
+			http.StatusInternalServerError,
+		)
+	}
+	return account, nil
+}
+
+// checkCanManage verifies the actor may create and revoke service accounts
+func (s *AccountService) checkCanManage(ctx context.Context, actorID uuid.UUID) error {
+	canManage, err := s.authorizer.Can(ctx, actorID, "authz", "service_accounts", nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canManage {
+		return apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to manage service accounts",
+			http.StatusForbidden,
+		)
+	}
+	return nil
+}
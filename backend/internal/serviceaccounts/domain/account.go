@@ -0,0 +1,158 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Prefixes mark service account credentials so they are recognisable in logs and secret scanners
+const (
+	ClientIDPrefix    = "absa_" // Public identifier of an account
+	SecretPrefix      = "abss_" // Client secret, exchanged for access tokens
+	AccessTokenPrefix = "abm_"  // Short-lived machine token sent as a bearer token
+)
+
+// Field limits
+const (
+	MaxNameLength        = 100
+	MaxDescriptionLength = 500
+)
+
+// Domain errors
+var (
+	ErrInvalidName        = errors.New("service account name must be 1 to 100 characters")
+	ErrDescriptionTooLong = errors.New("service account description must be at most 500 characters")
+	ErrRevoked            = errors.New("service account has been revoked")
+)
+
+// Account is a machine principal another service calls the API as. It holds
+// roles and permissions like a user does, and its ID is that of the user
+// record they are granted to. Only a hash of the client secret is kept; the
+// secret is shown once, when the account is created.
+type Account struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+	ClientID    string
+	SecretHash  string
+	CreatedBy   uuid.UUID
+	CreatedAt   time.Time
+	LastUsedAt  *time.Time // When an access token was last issued
+	RevokedAt   *time.Time
+}
+
+// NewAccount creates an account and returns it with its client secret
+func NewAccount(createdBy uuid.UUID, name, description string) (*Account, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > MaxNameLength {
+		return nil, "", ErrInvalidName
+	}
+	description = strings.TrimSpace(description)
+	if len(description) > MaxDescriptionLength {
+		return nil, "", ErrDescriptionTooLong
+	}
+
+	id := uuid.New()
+	secret, err := generateSecret(SecretPrefix)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &Account{
+		ID:          id,
+		Name:        name,
+		Description: description,
+		ClientID:    ClientIDPrefix + strings.ReplaceAll(id.String(), "-", ""),
+		SecretHash:  HashToken(secret),
+		CreatedBy:   createdBy,
+		CreatedAt:   time.Now(),
+	}, secret, nil
+}
+
+// VerifySecret reports whether secret is the account's client secret
+func (a *Account) VerifySecret(secret string) bool {
+	return subtle.ConstantTimeCompare([]byte(HashToken(secret)), []byte(a.SecretHash)) == 1
+}
+
+// Revoked reports whether the account's credentials and tokens no longer work
+func (a *Account) Revoked() bool {
+	return a.RevokedAt != nil
+}
+
+// Revoke disables the account for good
+func (a *Account) Revoke() error {
+	if a.Revoked() {
+		return ErrRevoked
+	}
+	now := time.Now()
+	a.RevokedAt = &now
+	return nil
+}
+
+// AccessToken lets an account make requests until it expires. Only a hash
+// is kept; the token is handed over once, when it is issued.
+type AccessToken struct {
+	TokenHash string
+	AccountID uuid.UUID
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// NewAccessToken issues a token for accountID lasting ttl and returns it with the token itself
+func NewAccessToken(accountID uuid.UUID, ttl time.Duration) (*AccessToken, string, error) {
+	token, err := generateSecret(AccessTokenPrefix)
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now()
+	return &AccessToken{
+		TokenHash: HashToken(token),
+		AccountID: accountID,
+		IssuedAt:  now,
+		ExpiresAt: now.Add(ttl),
+	}, token, nil
+}
+
+// Active reports whether the token may still be used at now
+func (t *AccessToken) Active(now time.Time) bool {
+	return now.Before(t.ExpiresAt)
+}
+
+// Action is one request made by an account, kept as an audit trail. Requests
+// that may change state are recorded before they run, and their status once
+// the response is sent.
+type Action struct {
+	ID         int64
+	AccountID  uuid.UUID
+	Method     string
+	Route      string // The matched route pattern, such as /api/v1/posts/{id}
+	Path       string
+	Status     int // The response status, 0 until it is sent or when the request never completed
+	RequestID  string
+	OccurredAt time.Time
+}
+
+// HashToken is the stored form of a secret or access token
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// generateSecret returns a fresh random credential starting with prefix
+func generateSecret(prefix string) (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("generate secret: %w", err)
+	}
+	return prefix + base64.RawURLEncoding.EncodeToString(secret), nil
+}
@@ -0,0 +1,62 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"backend/internal/serviceaccounts/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAccount(t *testing.T) {
+	admin := uuid.New()
+	account, secret, err := domain.NewAccount(admin, "  Search indexer ", " Reindexes published posts ")
+	require.NoError(t, err)
+
+	assert.Equal(t, "Search indexer", account.Name)
+	assert.Equal(t, "Reindexes published posts", account.Description)
+	assert.Equal(t, admin, account.CreatedBy)
+	assert.True(t, strings.HasPrefix(account.ClientID, domain.ClientIDPrefix))
+
+	assert.True(t, strings.HasPrefix(secret, domain.SecretPrefix))
+	assert.NotContains(t, account.SecretHash, secret, "the secret itself is not kept")
+	assert.True(t, account.VerifySecret(secret))
+	assert.False(t, account.VerifySecret(secret+"x"))
+}
+
+func TestNewAccount_Validation(t *testing.T) {
+	_, _, err := domain.NewAccount(uuid.New(), " ", "")
+	assert.ErrorIs(t, err, domain.ErrInvalidName)
+
+	_, _, err = domain.NewAccount(uuid.New(), strings.Repeat("x", domain.MaxNameLength+1), "")
+	assert.ErrorIs(t, err, domain.ErrInvalidName)
+
+	_, _, err = domain.NewAccount(uuid.New(), "Indexer", strings.Repeat("x", domain.MaxDescriptionLength+1))
+	assert.ErrorIs(t, err, domain.ErrDescriptionTooLong)
+}
+
+func TestAccountRevoke(t *testing.T) {
+	account, _, err := domain.NewAccount(uuid.New(), "Indexer", "")
+	require.NoError(t, err)
+
+	require.NoError(t, account.Revoke())
+	assert.True(t, account.Revoked())
+	assert.ErrorIs(t, account.Revoke(), domain.ErrRevoked)
+}
+
+func TestNewAccessToken(t *testing.T) {
+	accountID := uuid.New()
+	token, raw, err := domain.NewAccessToken(accountID, time.Hour)
+	require.NoError(t, err)
+
+	assert.Equal(t, accountID, token.AccountID)
+	assert.True(t, strings.HasPrefix(raw, domain.AccessTokenPrefix))
+	assert.Equal(t, domain.HashToken(raw), token.TokenHash)
+	assert.Equal(t, time.Hour, token.ExpiresAt.Sub(token.IssuedAt))
+
+	assert.True(t, token.Active(token.IssuedAt))
+	assert.False(t, token.Active(token.ExpiresAt), "expires at the end of its lifetime")
+}
@@ -0,0 +1,52 @@
+package ports
+
+import (
+	"context"
+	"errors"
+
+	"backend/internal/serviceaccounts/domain"
+	"github.com/google/uuid"
+)
+
+// Repository errors (canonical errors for the repository contract)
+var (
+	// ErrAccountNotFound is returned when an account does not exist in the current blog
+	ErrAccountNotFound = errors.New("service account not found")
+
+	// ErrTokenNotFound is returned when no account of the current blog holds a token
+	ErrTokenNotFound = errors.New("service account token not found")
+)
+
+// AccountRepository defines the contract for service account persistence
+type AccountRepository interface {
+	// Create stores a new account in the current blog, along with the user record its roles are granted to
+	Create(ctx context.Context, account *domain.Account) error
+
+	// FindByID retrieves an account of the current blog
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.Account, error)
+
+	// FindByClientID retrieves the account of the current blog with a client ID, revoked or not
+	FindByClientID(ctx context.Context, clientID string) (*domain.Account, error)
+
+	// List retrieves the accounts of the current blog, newest first
+	List(ctx context.Context) ([]*domain.Account, error)
+
+	// Revoke stores the account's revocation and discards its tokens
+	Revoke(ctx context.Context, account *domain.Account) error
+
+	// SaveToken stores a newly issued token and marks its account as used
+	SaveToken(ctx context.Context, token *domain.AccessToken) error
+
+	// FindByTokenHash retrieves a token and the account of the current blog holding it, expired or not
+	FindByTokenHash(ctx context.Context, tokenHash string) (*domain.Account, *domain.AccessToken, error)
+
+	// RecordAction appends a request made by an account to its audit trail
+	// and sets the action's ID
+	RecordAction(ctx context.Context, action *domain.Action) error
+
+	// CompleteAction stores the response status of a recorded action
+	CompleteAction(ctx context.Context, action *domain.Action) error
+
+	// ListActions retrieves up to limit of the requests an account made, newest first
+	ListActions(ctx context.Context, accountID uuid.UUID, limit int) ([]*domain.Action, error)
+}
@@ -0,0 +1,206 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/events"
+	"backend/internal/platform/logger"
+	"backend/internal/platform/tenant"
+	"backend/internal/settings/domain"
+	"backend/internal/settings/ports"
+	"backend/internal/settings/schema"
+	"github.com/google/uuid"
+)
+
+// Error definitions for service operations
+var (
+	ErrInvalidNamespace = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodeSettingsNamespaceNotFound,
+		"settings namespace not found",
+		http.StatusNotFound,
+	)
+
+	ErrInvalidSettings = apperror.New(
+		apperror.CodeValidationFailed,
+		apperror.BusinessCodeSettingsInvalid,
+		"invalid settings",
+		http.StatusBadRequest,
+	)
+)
+
+// SettingsService manages the typed settings of each namespace
+type SettingsService struct {
+	repo       ports.SettingsRepository
+	cache      *SettingsCache
+	authorizer ports.Authorizer
+	eventBus   *eventbus.Bus
+	logger     logger.Logger
+}
+
+// NewSettingsService creates a new settings service
+func NewSettingsService(
+	repo ports.SettingsRepository,
+	cache *SettingsCache,
+	authorizer ports.Authorizer,
+	eventBus *eventbus.Bus,
+	logger logger.Logger,
+) *SettingsService {
+	return &SettingsService{
+		repo:       repo,
+		cache:      cache,
+		authorizer: authorizer,
+		eventBus:   eventBus,
+		logger:     logger,
+	}
+}
+
+// GetSettings returns a namespace's effective values for an administrator
+// It reads past the cache so the response carries who changed them last
+func (s *SettingsService) GetSettings(ctx context.Context, actorID uuid.UUID, namespace domain.Namespace) (*domain.Settings, error) {
+	namespaceSchema, err := s.schemaFor(namespace)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkPermission(ctx, actorID, namespace); err != nil {
+		return nil, err
+	}
+
+	settings, err := s.repo.Get(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	settings.Values = effective(namespaceSchema, settings)
+	return settings, nil
+}
+
+// UpdateSettings replaces a namespace's values
+// Keys left out of values revert to their defaults
+func (s *SettingsService) UpdateSettings(ctx context.Context, actorID uuid.UUID, namespace domain.Namespace, values domain.Values) (*domain.Settings, error) {
+	namespaceSchema, err := s.schemaFor(namespace)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkPermission(ctx, actorID, namespace); err != nil {
+		return nil, err
+	}
+
+	if err := namespaceSchema.Validate(values); err != nil {
+		return nil, ErrInvalidSettings.WithDetails(err.Error())
+	}
+
+	now := time.Now()
+	settings := &domain.Settings{
+		Namespace: namespace,
+		Values:    values,
+		UpdatedBy: &actorID,
+		UpdatedAt: &now,
+	}
+	if err := s.repo.Replace(ctx, settings); err != nil {
+		return nil, err
+	}
+
+	s.publishSettingsUpdatedEvent(ctx, settings)
+
+	settings.Values = effective(namespaceSchema, settings)
+	return settings, nil
+}
+
+// Effective returns a namespace's values with defaults filled in, for other
+// modules to read. It performs no authorization check.
+func (s *SettingsService) Effective(ctx context.Context, namespace domain.Namespace) (domain.Values, error) {
+	namespaceSchema, err := schema.For(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if values, ok := s.cache.Get(ctx, namespace); ok {
+		return values, nil
+	}
+
+	settings, err := s.repo.Get(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	values := effective(namespaceSchema, settings)
+	s.cache.Set(ctx, namespace, values)
+	return values, nil
+}
+
+// Helper methods
+
+// schemaFor returns the schema of a namespace named in a request
+func (s *SettingsService) schemaFor(namespace domain.Namespace) (*schema.Schema, error) {
+	namespaceSchema, err := schema.For(namespace)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidNamespace) {
+			return nil, ErrInvalidNamespace
+		}
+		return nil, err
+	}
+	return namespaceSchema, nil
+}
+
+// checkPermission verifies the actor may manage the namespace, which needs settings:<namespace>
+func (s *SettingsService) checkPermission(ctx context.Context, actorID uuid.UUID, namespace domain.Namespace) error {
+	allowed, err := s.authorizer.Can(ctx, actorID, "settings", string(namespace), nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !allowed {
+		return apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to manage "+string(namespace)+" settings",
+			http.StatusForbidden,
+		)
+	}
+	return nil
+}
+
+// effective layers stored values over the schema's defaults. Keys the schema
+// no longer defines are dropped, so retired settings never reach readers.
+func effective(namespaceSchema *schema.Schema, settings *domain.Settings) domain.Values {
+	defaults := namespaceSchema.Defaults()
+	values := settings.WithDefaults(defaults)
+	for key := range values {
+		if _, known := defaults[key]; !known {
+			delete(values, key)
+		}
+	}
+	return values
+}
+
+// Event publishing methods
+
+func (s *SettingsService) publishSettingsUpdatedEvent(ctx context.Context, settings *domain.Settings) {
+	var blogID *uuid.UUID
+	if settings.Namespace.IsBlogScoped() {
+		id := tenant.BlogID(ctx)
+		blogID = &id
+	}
+
+	event := eventbus.Event{
+		Topic: events.SettingsUpdatedTopic,
+		Payload: events.SettingsUpdatedEvent{
+			Namespace:  string(settings.Namespace),
+			BlogID:     blogID,
+			ActorID:    *settings.UpdatedBy,
+			OccurredAt: *settings.UpdatedAt,
+		},
+	}
+	s.eventBus.Publish(ctx, event)
+}
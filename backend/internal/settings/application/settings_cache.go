@@ -0,0 +1,90 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend/internal/platform/cache"
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/events"
+	"backend/internal/platform/logger"
+	"backend/internal/platform/tenant"
+	"backend/internal/settings/domain"
+	"github.com/google/uuid"
+)
+
+// SettingsCache is a read-through cache for the effective values of a
+// namespace, defaults included. Settings are read on nearly every request and
+// change rarely, so entries live long and are dropped when an update event
+// names their namespace and blog.
+type SettingsCache struct {
+	cache  cache.Cache
+	ttl    time.Duration
+	logger logger.Logger
+}
+
+// NewSettingsCache creates a new settings cache
+func NewSettingsCache(c cache.Cache, cfg cache.Config, logger logger.Logger) *SettingsCache {
+	return &SettingsCache{
+		cache:  c,
+		ttl:    cfg.SettingsTTL,
+		logger: logger,
+	}
+}
+
+// Subscribe registers the invalidation handler on the bus
+func (c *SettingsCache) Subscribe(bus *eventbus.Bus) {
+	bus.Subscribe(events.SettingsUpdatedTopic, c.handleSettingsUpdated)
+}
+
+// Get returns the cached effective values of namespace in the current blog
+// Cache failures are logged and reported as misses so reads fall back to the database
+func (c *SettingsCache) Get(ctx context.Context, namespace domain.Namespace) (domain.Values, bool) {
+	var values domain.Values
+	found, err := cache.GetJSON(ctx, c.cache, settingsKey(namespace, scopeOf(ctx, namespace)), &values)
+	if err != nil {
+		c.logger.Warn(ctx, "failed to read settings from cache", "error", err, "namespace", namespace)
+		return nil, false
+	}
+	return values, found
+}
+
+// Set caches the effective values of namespace in the current blog
+func (c *SettingsCache) Set(ctx context.Context, namespace domain.Namespace, values domain.Values) {
+	if err := cache.SetJSON(ctx, c.cache, settingsKey(namespace, scopeOf(ctx, namespace)), values, c.ttl); err != nil {
+		c.logger.Warn(ctx, "failed to cache settings", "error", err, "namespace", namespace)
+	}
+}
+
+// handleSettingsUpdated drops the namespace's values for the blog that changed
+func (c *SettingsCache) handleSettingsUpdated(ctx context.Context, event eventbus.Event) error {
+	payload, ok := event.Payload.(events.SettingsUpdatedEvent)
+	if !ok {
+		return fmt.Errorf("SettingsCache.handleSettingsUpdated: unexpected payload %T", event.Payload)
+	}
+
+	key := settingsKey(domain.Namespace(payload.Namespace), payload.BlogID)
+	if err := c.cache.Delete(context.WithoutCancel(ctx), key); err != nil {
+		return fmt.Errorf("SettingsCache.handleSettingsUpdated: %w", err)
+	}
+	return nil
+}
+
+// scopeOf returns the blog whose values of namespace apply in ctx, or nil for
+// the deployment-wide system namespace
+func scopeOf(ctx context.Context, namespace domain.Namespace) *uuid.UUID {
+	if !namespace.IsBlogScoped() {
+		return nil
+	}
+	blogID := tenant.BlogID(ctx)
+	return &blogID
+}
+
+// settingsKey is the key of a namespace's effective values in a blog
+func settingsKey(namespace domain.Namespace, blogID *uuid.UUID) string {
+	if blogID == nil {
+		return fmt.Sprintf("settings:%s:global", namespace)
+	}
+	return fmt.Sprintf("settings:%s:%s", namespace, blogID)
+}
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Namespace groups settings managed under one permission
+type Namespace string
+
+const (
+	NamespaceSystem Namespace = "system" // Deployment-wide; settings:system
+	NamespaceBlog   Namespace = "blog"   // Per blog; settings:blog
+	NamespaceTheme  Namespace = "theme"  // Per blog appearance; settings:theme
+)
+
+// Namespaces lists every namespace
+var Namespaces = []Namespace{NamespaceSystem, NamespaceBlog, NamespaceTheme}
+
+// IsValid checks if the namespace is known
+func (n Namespace) IsValid() bool {
+	switch n {
+	case NamespaceSystem, NamespaceBlog, NamespaceTheme:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsBlogScoped reports whether each blog keeps its own values for the namespace
+func (n Namespace) IsBlogScoped() bool {
+	return n != NamespaceSystem
+}
+
+// Values maps setting keys to JSON values: strings, float64 numbers, booleans,
+// slices and maps, as encoding/json decodes them
+type Values map[string]any
+
+// Settings are the values stored for a namespace
+type Settings struct {
+	Namespace Namespace
+	Values    Values
+	UpdatedBy *uuid.UUID // Nil until someone changes the defaults
+	UpdatedAt *time.Time
+}
+
+// Errors
+var (
+	ErrInvalidNamespace = errors.New("namespace must be system, blog or theme")
+)
+
+// WithDefaults returns the settings' values layered over defaults, so keys
+// never stored read as their default
+func (s *Settings) WithDefaults(defaults Values) Values {
+	merged := make(Values, len(defaults)+len(s.Values))
+	for key, value := range defaults {
+		merged[key] = value
+	}
+	for key, value := range s.Values {
+		merged[key] = value
+	}
+	return merged
+}
@@ -0,0 +1,31 @@
+package domain_test
+
+import (
+	"testing"
+
+	"backend/internal/settings/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamespace(t *testing.T) {
+	assert.True(t, domain.NamespaceSystem.IsValid())
+	assert.False(t, domain.Namespace("plugins").IsValid())
+
+	assert.False(t, domain.NamespaceSystem.IsBlogScoped())
+	assert.True(t, domain.NamespaceBlog.IsBlogScoped())
+	assert.True(t, domain.NamespaceTheme.IsBlogScoped())
+}
+
+func TestWithDefaults(t *testing.T) {
+	defaults := domain.Values{"title": "Arch Blog", "postsPerPage": 10}
+	settings := &domain.Settings{
+		Namespace: domain.NamespaceBlog,
+		Values:    domain.Values{"postsPerPage": 25},
+	}
+
+	assert.Equal(t, domain.Values{"title": "Arch Blog", "postsPerPage": 25}, settings.WithDefaults(defaults))
+
+	// Neither input is modified
+	assert.Equal(t, 10, defaults["postsPerPage"])
+	assert.Len(t, settings.Values, 1)
+}
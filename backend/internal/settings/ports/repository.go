@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+
+	"backend/internal/settings/domain"
+)
+
+// SettingsRepository defines the contract for settings persistence
+// Blog-scoped namespaces are read and written for the blog in ctx; the system
+// namespace is shared by every blog
+type SettingsRepository interface {
+	// Get retrieves the stored values of a namespace
+	// A namespace nobody has written yet comes back with no values, not an error
+	Get(ctx context.Context, namespace domain.Namespace) (*domain.Settings, error)
+
+	// Replace stores settings.Values as the namespace's complete set of values
+	// Keys left out are removed and fall back to their defaults
+	Replace(ctx context.Context, settings *domain.Settings) error
+}
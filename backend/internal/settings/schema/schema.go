@@ -0,0 +1,116 @@
+// Package schema holds the JSON Schema of each settings namespace.
+//
+// The schemas under schemas/ are the single source of truth for which keys a
+// namespace has, their types and limits, and their defaults. Values written
+// through the API are validated against them, and the seeder stores their
+// defaults.
+package schema
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"backend/internal/settings/domain"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+//go:embed schemas/*.json
+var files embed.FS
+
+// ErrInvalidValues wraps every validation failure
+var ErrInvalidValues = errors.New("invalid settings")
+
+// Schema describes the settings of one namespace
+type Schema struct {
+	namespace domain.Namespace
+	schema    *openapi3.Schema
+}
+
+// schemas is loaded once; a schema that fails to parse is a build defect
+var schemas = mustLoad()
+
+// For returns the schema of a namespace
+func For(namespace domain.Namespace) (*Schema, error) {
+	s, ok := schemas[namespace]
+	if !ok {
+		return nil, domain.ErrInvalidNamespace
+	}
+	return s, nil
+}
+
+// Defaults returns a fresh copy of every key's default value
+func (s *Schema) Defaults() domain.Values {
+	defaults := make(domain.Values, len(s.schema.Properties))
+	for key, property := range s.schema.Properties {
+		if property.Value != nil && property.Value.Default != nil {
+			defaults[key] = property.Value.Default
+		}
+	}
+	return defaults
+}
+
+// Validate checks values against the schema. Keys may be left out, but every
+// key present must be known and hold a value of the right type and range.
+func (s *Schema) Validate(values domain.Values) error {
+	// Round-trip through JSON so typed Go values validate like decoded requests
+	data, err := json.Marshal(values)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidValues, err)
+	}
+	var document any
+	if err := json.Unmarshal(data, &document); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidValues, err)
+	}
+
+	if err := s.schema.VisitJSON(document, openapi3.MultiErrors()); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidValues, describe(err))
+	}
+	return nil
+}
+
+// describe turns schema errors into one line naming each offending key
+func describe(err error) string {
+	var multi openapi3.MultiError
+	if !errors.As(err, &multi) {
+		return schemaErrorText(err)
+	}
+
+	messages := make([]string, 0, len(multi))
+	for _, e := range multi {
+		messages = append(messages, schemaErrorText(e))
+	}
+	return strings.Join(messages, "; ")
+}
+
+// schemaErrorText prefixes a schema error's reason with the key it concerns
+func schemaErrorText(err error) string {
+	var schemaErr *openapi3.SchemaError
+	if !errors.As(err, &schemaErr) {
+		return err.Error()
+	}
+	if path := schemaErr.JSONPointer(); len(path) > 0 {
+		return strings.Join(path, ".") + ": " + schemaErr.Reason
+	}
+	return schemaErr.Reason
+}
+
+// mustLoad parses the embedded schema of every namespace
+func mustLoad() map[domain.Namespace]*Schema {
+	loaded := make(map[domain.Namespace]*Schema, len(domain.Namespaces))
+	for _, namespace := range domain.Namespaces {
+		data, err := files.ReadFile("schemas/" + string(namespace) + ".json")
+		if err != nil {
+			panic(fmt.Sprintf("settings schema %s: %v", namespace, err))
+		}
+
+		var s openapi3.Schema
+		if err := json.Unmarshal(data, &s); err != nil {
+			panic(fmt.Sprintf("settings schema %s: %v", namespace, err))
+		}
+		loaded[namespace] = &Schema{namespace: namespace, schema: &s}
+	}
+	return loaded
+}
@@ -0,0 +1,67 @@
+package schema_test
+
+import (
+	"testing"
+
+	"backend/internal/settings/domain"
+	"backend/internal/settings/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultsAreValid(t *testing.T) {
+	for _, namespace := range domain.Namespaces {
+		s, err := schema.For(namespace)
+		require.NoError(t, err)
+
+		defaults := s.Defaults()
+		assert.NotEmpty(t, defaults, namespace)
+		assert.NoError(t, s.Validate(defaults), namespace)
+	}
+}
+
+func TestForUnknownNamespace(t *testing.T) {
+	_, err := schema.For(domain.Namespace("plugins"))
+	assert.ErrorIs(t, err, domain.ErrInvalidNamespace)
+}
+
+func TestValidate(t *testing.T) {
+	s, err := schema.For(domain.NamespaceBlog)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		values  domain.Values
+		wantErr string
+	}{
+		{name: "partial", values: domain.Values{"title": "Field Notes"}},
+		{name: "integer from Go", values: domain.Values{"postsPerPage": 25}},
+		{name: "integer from JSON", values: domain.Values{"postsPerPage": float64(25)}},
+		{name: "unknown key", values: domain.Values{"sidebar": true}, wantErr: "sidebar"},
+		{name: "wrong type", values: domain.Values{"title": 42}, wantErr: "title"},
+		{name: "out of range", values: domain.Values{"postsPerPage": 500}, wantErr: "postsPerPage"},
+		{name: "fractional integer", values: domain.Values{"postsPerPage": 2.5}, wantErr: "postsPerPage"},
+		{name: "bad pattern", values: domain.Values{"contactEmail": "nobody"}, wantErr: "contactEmail"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.Validate(tt.values)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, schema.ErrInvalidValues)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestDefaultsAreCopies(t *testing.T) {
+	s, err := schema.For(domain.NamespaceTheme)
+	require.NoError(t, err)
+
+	defaults := s.Defaults()
+	defaults["colorScheme"] = "dark"
+	assert.Equal(t, "auto", s.Defaults()["colorScheme"])
+}
@@ -0,0 +1,71 @@
+package seeder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"backend/internal/platform/tenant"
+	"backend/internal/settings/domain"
+	"backend/internal/settings/schema"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// SettingsSeeder stores the schema defaults of every namespace
+// System defaults are stored once; blog and theme defaults for the default blog
+type SettingsSeeder struct{}
+
+// NewSettingsSeeder creates a new settings seeder
+func NewSettingsSeeder() *SettingsSeeder {
+	return &SettingsSeeder{}
+}
+
+// Name returns the name of this seeder
+func (s *SettingsSeeder) Name() string {
+	return "SettingsSeeder"
+}
+
+// Seed inserts every default that has no stored value yet
+// Values an administrator has already changed are left alone
+func (s *SettingsSeeder) Seed(ctx context.Context, db *pgxpool.Pool) error {
+	batch := &pgx.Batch{}
+	for _, namespace := range domain.Namespaces {
+		namespaceSchema, err := schema.For(namespace)
+		if err != nil {
+			return fmt.Errorf("failed to load %s schema: %w", namespace, err)
+		}
+
+		var blogID pgtype.UUID
+		if namespace.IsBlogScoped() {
+			blogID = pgtype.UUID{Bytes: tenant.DefaultBlogID, Valid: true}
+		}
+
+		for key, value := range namespaceSchema.Defaults() {
+			raw, err := json.Marshal(value)
+			if err != nil {
+				return fmt.Errorf("failed to encode default %s.%s: %w", namespace, key, err)
+			}
+			batch.Queue(
+				`INSERT INTO settings (blog_id, namespace, key, value)
+				 VALUES ($1, $2, $3, $4)
+				 ON CONFLICT ON CONSTRAINT settings_scope_key DO NOTHING`,
+				blogID, string(namespace), key, raw,
+			)
+		}
+	}
+
+	br := db.SendBatch(ctx, batch)
+	for i := 0; i < batch.Len(); i++ {
+		if _, err := br.Exec(); err != nil {
+			_ = br.Close()
+			return fmt.Errorf("failed to seed setting: %w", err)
+		}
+	}
+	if err := br.Close(); err != nil {
+		return fmt.Errorf("failed to close batch: %w", err)
+	}
+
+	return nil
+}
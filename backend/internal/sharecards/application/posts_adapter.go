@@ -0,0 +1,39 @@
+package application
+
+import (
+	"context"
+
+	postsApp "backend/internal/posts/application"
+	"github.com/google/uuid"
+)
+
+// PostsAdapter implements the PostProvider interface
+// Posts are read through the posts service, so a card is served only to
+// those who may read the post itself
+type PostsAdapter struct {
+	postsService *postsApp.PostsService
+}
+
+// NewPostsAdapter creates a new posts adapter
+func NewPostsAdapter(postsService *postsApp.PostsService) *PostsAdapter {
+	return &PostsAdapter{
+		postsService: postsService,
+	}
+}
+
+// ReadablePost returns the post if the viewer may read it under its access policy
+func (a *PostsAdapter) ReadablePost(ctx context.Context, postID uuid.UUID, viewerID *uuid.UUID) (*CardPost, error) {
+	// Pass through the AppErrors from the posts service unchanged
+	post, err := a.postsService.GetPost(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.postsService.CheckCanRead(ctx, post, viewerID); err != nil {
+		return nil, err
+	}
+	return &CardPost{
+		ID:       post.ID,
+		Title:    post.Title,
+		AuthorID: post.AuthorID,
+	}, nil
+}
@@ -0,0 +1,14 @@
+package application
+
+import "github.com/google/wire"
+
+// ProviderSet is the wire provider set for the share cards application layer
+var ProviderSet = wire.NewSet(
+	NewShareCardService,
+	NewPostsAdapter,
+	NewUserAdapter,
+	NewSettingsAdapter,
+	wire.Bind(new(PostProvider), new(*PostsAdapter)),
+	wire.Bind(new(AuthorProvider), new(*UserAdapter)),
+	wire.Bind(new(ThemeProvider), new(*SettingsAdapter)),
+)
@@ -0,0 +1,126 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"backend/internal/platform/cache"
+	"backend/internal/platform/logger"
+	"backend/internal/sharecards/domain"
+	"github.com/google/uuid"
+)
+
+// CardPost is what a share card shows of a post
+type CardPost struct {
+	ID       uuid.UUID
+	Title    string
+	AuthorID uuid.UUID
+}
+
+// PostProvider is an interface for reading the posts cards are drawn for
+type PostProvider interface {
+	// ReadablePost returns the post if the viewer may read it; viewerID is nil
+	// for anonymous readers. Refusals come back as AppErrors to pass on.
+	ReadablePost(ctx context.Context, postID uuid.UUID, viewerID *uuid.UUID) (*CardPost, error)
+}
+
+// AuthorProvider is an interface for naming authors on cards
+type AuthorProvider interface {
+	AuthorName(ctx context.Context, authorID uuid.UUID) (string, error)
+}
+
+// ThemeProvider is an interface for reading the current blog's colors
+type ThemeProvider interface {
+	ThemeColors(ctx context.Context) (accent string, scheme domain.Scheme, err error)
+}
+
+// RenderedCard is a post's share card as served
+type RenderedCard struct {
+	PostID      uuid.UUID
+	Fingerprint string
+	SVG         []byte
+}
+
+// ShareCardService draws the share images link previews show for posts.
+// Cards are cached by fingerprint, so a retitled post or recolored theme
+// simply misses and is drawn again; old cards expire.
+type ShareCardService struct {
+	posts   PostProvider
+	authors AuthorProvider
+	themes  ThemeProvider
+	cache   cache.Cache
+	ttl     time.Duration
+	logger  logger.Logger
+}
+
+// NewShareCardService creates a new share card service
+func NewShareCardService(
+	posts PostProvider,
+	authors AuthorProvider,
+	themes ThemeProvider,
+	c cache.Cache,
+	cfg cache.Config,
+	logger logger.Logger,
+) *ShareCardService {
+	return &ShareCardService{
+		posts:   posts,
+		authors: authors,
+		themes:  themes,
+		cache:   c,
+		ttl:     cfg.RenderedContentTTL,
+		logger:  logger,
+	}
+}
+
+// RenderPostCard returns the share card of a post the viewer may read.
+// A card is always drawn once the post is found: an author or theme that
+// cannot be read leaves the byline out or falls back to the default colors.
+// Public, so no authorization is applied beyond the post's access policy
+func (s *ShareCardService) RenderPostCard(ctx context.Context, postID uuid.UUID, viewerID *uuid.UUID) (*RenderedCard, error) {
+	post, err := s.posts.ReadablePost(ctx, postID, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	author, err := s.authors.AuthorName(ctx, post.AuthorID)
+	if err != nil {
+		s.logger.Warn(ctx, "failed to get author for share card", "error", err, "postID", postID)
+	}
+
+	accent, scheme, err := s.themes.ThemeColors(ctx)
+	if err != nil {
+		s.logger.Warn(ctx, "failed to get theme colors for share card", "error", err)
+		accent, scheme = domain.DefaultAccent, domain.SchemeLight
+	}
+
+	card := domain.NewCard(post.Title, author, accent, scheme)
+	fingerprint := card.Fingerprint()
+	return &RenderedCard{
+		PostID:      postID,
+		Fingerprint: fingerprint,
+		SVG:         s.draw(ctx, card, fingerprint),
+	}, nil
+}
+
+// Helper methods
+
+// draw returns the card's SVG from the cache, drawing and caching it on a miss
+// Cache failures are logged and the card is drawn again
+func (s *ShareCardService) draw(ctx context.Context, card domain.Card, fingerprint string) []byte {
+	key := fmt.Sprintf("sharecard:svg:%s", fingerprint)
+	cached, err := s.cache.Get(ctx, key)
+	if err == nil {
+		return cached
+	}
+	if !errors.Is(err, cache.ErrMiss) {
+		s.logger.Warn(ctx, "failed to read share card from cache", "error", err)
+	}
+
+	svg := card.SVG()
+	if err := s.cache.Set(ctx, key, svg, s.ttl); err != nil {
+		s.logger.Warn(ctx, "failed to cache share card", "error", err)
+	}
+	return svg
+}
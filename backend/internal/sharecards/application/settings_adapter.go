@@ -0,0 +1,34 @@
+package application
+
+import (
+	"context"
+
+	settingsApp "backend/internal/settings/application"
+	settingsDomain "backend/internal/settings/domain"
+	"backend/internal/sharecards/domain"
+)
+
+// SettingsAdapter implements the ThemeProvider interface
+// It reads the accent and color scheme of the blog's theme settings
+type SettingsAdapter struct {
+	settingsService *settingsApp.SettingsService
+}
+
+// NewSettingsAdapter creates a new settings adapter
+func NewSettingsAdapter(settingsService *settingsApp.SettingsService) *SettingsAdapter {
+	return &SettingsAdapter{
+		settingsService: settingsService,
+	}
+}
+
+// ThemeColors returns the current blog's accent color and color scheme
+// Values of an unexpected type read as empty, which the card replaces with its defaults
+func (a *SettingsAdapter) ThemeColors(ctx context.Context) (string, domain.Scheme, error) {
+	values, err := a.settingsService.Effective(ctx, settingsDomain.NamespaceTheme)
+	if err != nil {
+		return "", "", err
+	}
+	accent, _ := values["accentColor"].(string)
+	scheme, _ := values["colorScheme"].(string)
+	return accent, domain.Scheme(scheme), nil
+}
@@ -0,0 +1,29 @@
+package application
+
+import (
+	"context"
+
+	usersApp "backend/internal/users/application"
+	"github.com/google/uuid"
+)
+
+// UserAdapter implements the AuthorProvider interface
+type UserAdapter struct {
+	userService *usersApp.UserService
+}
+
+// NewUserAdapter creates a new user adapter
+func NewUserAdapter(userService *usersApp.UserService) *UserAdapter {
+	return &UserAdapter{
+		userService: userService,
+	}
+}
+
+// AuthorName returns the author's username, the name posts are credited to
+func (a *UserAdapter) AuthorName(ctx context.Context, authorID uuid.UUID) (string, error) {
+	user, err := a.userService.GetUserByID(ctx, authorID.String())
+	if err != nil {
+		return "", err
+	}
+	return user.Username, nil
+}
@@ -0,0 +1,172 @@
+package domain
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Scheme is the color scheme a card is drawn in
+type Scheme string
+
+const (
+	SchemeLight Scheme = "light"
+	SchemeDark  Scheme = "dark"
+)
+
+// Card dimensions, the size link previews expect of an Open Graph image
+const (
+	Width  = 1200
+	Height = 630
+)
+
+// Title layout: lines are wrapped by character count, which suits the
+// proportional sans-serif face closely enough to stay inside the card
+const (
+	MaxTitleLines   = 4
+	MaxLineChars    = 28
+	MaxAuthorLength = 60
+)
+
+// DefaultAccent is the accent of blogs whose theme settings carry none
+const DefaultAccent = "#3b82f6"
+
+// renderVersion changes whenever the drawing does, so cached cards are redrawn
+const renderVersion = "1"
+
+var hexColor = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// palette holds the colors a scheme draws with besides the accent
+type palette struct {
+	background string
+	title      string
+	byline     string
+}
+
+var palettes = map[Scheme]palette{
+	SchemeLight: {background: "#ffffff", title: "#111827", byline: "#4b5563"},
+	SchemeDark:  {background: "#0f172a", title: "#f8fafc", byline: "#cbd5e1"},
+}
+
+// Card is the share image of a post: its title and author in the blog's colors
+type Card struct {
+	Title  string
+	Author string // Empty leaves the byline out
+	Accent string // Hex color, as #rrggbb
+	Scheme Scheme
+}
+
+// NewCard creates a card, falling back to DefaultAccent for a malformed accent
+// and to the light scheme for anything but dark, "auto" included: crawlers
+// fetching the image have no preference to follow
+func NewCard(title, author, accent string, scheme Scheme) Card {
+	card := Card{
+		Title:  strings.Join(strings.Fields(title), " "),
+		Author: truncate(strings.Join(strings.Fields(author), " "), MaxAuthorLength),
+		Accent: strings.ToLower(accent),
+		Scheme: scheme,
+	}
+	if !hexColor.MatchString(card.Accent) {
+		card.Accent = DefaultAccent
+	}
+	if card.Scheme != SchemeDark {
+		card.Scheme = SchemeLight
+	}
+	return card
+}
+
+// Fingerprint identifies what the card shows; cards with the same fingerprint
+// render identically, and changing the title or colors changes it
+func (c Card) Fingerprint() string {
+	hash := sha256.New()
+	for _, part := range []string{renderVersion, c.Title, c.Author, c.Accent, string(c.Scheme)} {
+		// The length prefix keeps ("ab", "c") and ("a", "bc") apart
+		fmt.Fprintf(hash, "%d:%s", len(part), part)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// SVG draws the card as a standalone SVG document
+func (c Card) SVG() []byte {
+	colors := palettes[c.Scheme]
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`, Width, Height, Width, Height)
+	fmt.Fprintf(&b, `<rect width="%d" height="%d" fill="%s"/>`, Width, Height, colors.background)
+	fmt.Fprintf(&b, `<rect width="24" height="%d" fill="%s"/>`, Height, c.Accent)
+
+	b.WriteString(`<g font-family="Inter, Helvetica, Arial, sans-serif">`)
+	fmt.Fprintf(&b, `<text x="96" y="150" font-size="64" font-weight="700" fill="%s">`, colors.title)
+	for i, line := range WrapTitle(c.Title) {
+		dy := "0"
+		if i > 0 {
+			dy = "80"
+		}
+		fmt.Fprintf(&b, `<tspan x="96" dy="%s">%s</tspan>`, dy, html.EscapeString(line))
+	}
+	b.WriteString(`</text>`)
+
+	fmt.Fprintf(&b, `<rect x="96" y="498" width="120" height="8" fill="%s"/>`, c.Accent)
+	if c.Author != "" {
+		fmt.Fprintf(&b, `<text x="96" y="564" font-size="36" fill="%s">%s</text>`, colors.byline, html.EscapeString(c.Author))
+	}
+	b.WriteString(`</g></svg>`)
+	return b.Bytes()
+}
+
+// WrapTitle breaks a title into at most MaxTitleLines lines of at most
+// MaxLineChars characters, between words where it can. A title that does
+// not fit ends in an ellipsis.
+func WrapTitle(title string) []string {
+	var lines []string
+	var current string
+	for _, word := range strings.Fields(title) {
+		// Words too long for a line of their own are split across lines
+		for utf8.RuneCountInString(word) > MaxLineChars {
+			if current != "" {
+				lines = append(lines, current)
+				current = ""
+			}
+			runes := []rune(word)
+			lines = append(lines, string(runes[:MaxLineChars]))
+			word = string(runes[MaxLineChars:])
+		}
+
+		switch {
+		case current == "":
+			current = word
+		case utf8.RuneCountInString(current)+1+utf8.RuneCountInString(word) <= MaxLineChars:
+			current += " " + word
+		default:
+			lines = append(lines, current)
+			current = word
+		}
+	}
+	if current != "" {
+		lines = append(lines, current)
+	}
+
+	if len(lines) > MaxTitleLines {
+		// Room is left on the last line shown for the ellipsis
+		last := []rune(lines[MaxTitleLines-1])
+		if len(last) > MaxLineChars-2 {
+			last = last[:MaxLineChars-2]
+		}
+		lines = append(lines[:MaxTitleLines-1], strings.TrimSpace(string(last))+" …")
+	}
+	return lines
+}
+
+// truncate cuts s to at most max characters, marking the cut with an ellipsis
+func truncate(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return strings.TrimSpace(string(runes[:max-1])) + "…"
+}
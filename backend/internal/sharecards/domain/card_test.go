@@ -0,0 +1,97 @@
+package domain_test
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"backend/internal/sharecards/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCard(t *testing.T) {
+	card := domain.NewCard("  Hexagonal\n Architecture ", "ada", "#AABBCC", domain.SchemeDark)
+	assert.Equal(t, "Hexagonal Architecture", card.Title)
+	assert.Equal(t, "ada", card.Author)
+	assert.Equal(t, "#aabbcc", card.Accent)
+	assert.Equal(t, domain.SchemeDark, card.Scheme)
+
+	card = domain.NewCard("Title", "ada", "blue", "auto")
+	assert.Equal(t, domain.DefaultAccent, card.Accent)
+	assert.Equal(t, domain.SchemeLight, card.Scheme)
+}
+
+func TestCard_Fingerprint(t *testing.T) {
+	card := domain.NewCard("Title", "ada", "#3b82f6", domain.SchemeLight)
+	assert.Equal(t, card.Fingerprint(), domain.NewCard("Title", "ada", "#3b82f6", domain.SchemeLight).Fingerprint())
+
+	assert.NotEqual(t, card.Fingerprint(), domain.NewCard("Retitled", "ada", "#3b82f6", domain.SchemeLight).Fingerprint())
+	assert.NotEqual(t, card.Fingerprint(), domain.NewCard("Title", "ada", "#ff0000", domain.SchemeLight).Fingerprint())
+	assert.NotEqual(t, card.Fingerprint(), domain.NewCard("Title", "ada", "#3b82f6", domain.SchemeDark).Fingerprint())
+	assert.NotEqual(t,
+		domain.NewCard("ab", "c", "#3b82f6", domain.SchemeLight).Fingerprint(),
+		domain.NewCard("a", "bc", "#3b82f6", domain.SchemeLight).Fingerprint(),
+	)
+}
+
+func TestCard_SVG(t *testing.T) {
+	card := domain.NewCard(`Tips & <tricks> for "Go"`, "ada", "#ff0000", domain.SchemeLight)
+	svg := string(card.SVG())
+
+	// The document must stay well-formed whatever the title holds
+	decoder := xml.NewDecoder(strings.NewReader(svg))
+	for {
+		_, err := decoder.Token()
+		if err != nil {
+			require.ErrorContains(t, err, "EOF")
+			break
+		}
+	}
+
+	assert.Contains(t, svg, `width="1200" height="630"`)
+	assert.Contains(t, svg, "Tips &amp; &lt;tricks&gt; for &#34;Go&#34;")
+	assert.Contains(t, svg, ">ada</text>")
+	assert.Contains(t, svg, `fill="#ff0000"`)
+
+	noAuthor := string(domain.NewCard("Title", "", "#ff0000", domain.SchemeLight).SVG())
+	assert.NotContains(t, noAuthor, `font-size="36"`)
+}
+
+func TestWrapTitle(t *testing.T) {
+	tests := []struct {
+		name  string
+		title string
+		want  []string
+	}{
+		{name: "fits one line", title: "Short title", want: []string{"Short title"}},
+		{
+			name:  "wraps between words",
+			title: "Introduction to Hexagonal Architecture in Go",
+			want:  []string{"Introduction to Hexagonal", "Architecture in Go"},
+		},
+		{
+			name:  "splits words longer than a line",
+			title: "a " + strings.Repeat("x", 30),
+			want:  []string{"a", strings.Repeat("x", 28), "xx"},
+		},
+		{name: "empty", title: "", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, domain.WrapTitle(tt.title))
+		})
+	}
+}
+
+func TestWrapTitle_Overflow(t *testing.T) {
+	lines := domain.WrapTitle(strings.Repeat("lorem ipsum dolor sit amet ", 10))
+
+	require.Len(t, lines, domain.MaxTitleLines)
+	assert.True(t, strings.HasSuffix(lines[domain.MaxTitleLines-1], " …"))
+	for _, line := range lines {
+		assert.LessOrEqual(t, utf8.RuneCountInString(line), domain.MaxLineChars)
+	}
+}
@@ -0,0 +1,52 @@
+package apitest_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"backend/internal/adapters/rest/middleware"
+	"backend/internal/testing/apitest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The issuer's tokens must pass the production JWT middleware unchanged
+func TestIssuerTokensPassJWTMiddleware(t *testing.T) {
+	issuer := apitest.NewIssuer(t)
+
+	jwtMiddleware, err := middleware.NewJWTMiddleware(context.Background(), issuer.JWKSURL(), issuer.URL)
+	require.NoError(t, err)
+
+	handler := jwtMiddleware.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		subject, _ := middleware.GetJWTUserID(r.Context())
+		_, _ = w.Write([]byte(subject))
+	}))
+	client := apitest.NewClient(handler)
+
+	res := client.As(issuer.Token(t, "supabase-user", "user@example.com")).Get(t, "/")
+	res.RequireStatus(t, http.StatusOK)
+	assert.Equal(t, "supabase-user", string(res.Body))
+
+	client.Get(t, "/").RequireStatus(t, http.StatusUnauthorized)
+	client.As(issuer.ExpiredToken(t, "supabase-user", "user@example.com")).Get(t, "/").RequireStatus(t, http.StatusUnauthorized)
+
+	other := apitest.NewIssuer(t)
+	client.As(other.Token(t, "supabase-user", "user@example.com")).Get(t, "/").RequireStatus(t, http.StatusUnauthorized)
+}
+
+func TestClientSendsJSON(t *testing.T) {
+	client := apitest.NewClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"method":"` + r.Method + `","contentType":"` + r.Header.Get("Content-Type") + `"}`))
+	}))
+
+	var body struct {
+		Method      string `json:"method"`
+		ContentType string `json:"contentType"`
+	}
+	client.Post(t, "/things", map[string]string{"name": "x"}).RequireStatus(t, http.StatusCreated).JSON(t, &body)
+	assert.Equal(t, http.MethodPost, body.Method)
+	assert.Equal(t, "application/json", body.ContentType)
+}
@@ -0,0 +1,128 @@
+// Package apitest drives HTTP handlers in integration tests.
+//
+// Client sends requests to a handler and decodes the responses, Issuer signs
+// the tokens that authenticate them, and NewServer assembles the complete
+// application against the pgtest database:
+//
+//	srv := apitest.NewServer(t)
+//	author := factory.NewUser().WithRole("author").Create(t, pgtest.Pool(t))
+//	res := srv.Client.As(srv.TokenFor(t, author)).Post(t, "/api/v1/posts", body)
+//	res.RequireStatus(t, http.StatusCreated)
+package apitest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// Client sends requests to a handler in process
+type Client struct {
+	handler http.Handler
+	header  http.Header
+}
+
+// NewClient creates a client for the handler
+func NewClient(handler http.Handler) *Client {
+	return &Client{handler: handler, header: http.Header{}}
+}
+
+// As returns a copy of the client that authenticates with the bearer token
+func (c *Client) As(token string) *Client {
+	return c.WithHeader("Authorization", "Bearer "+token)
+}
+
+// WithHeader returns a copy of the client that sends the header on every request
+func (c *Client) WithHeader(key, value string) *Client {
+	clone := &Client{handler: c.handler, header: c.header.Clone()}
+	clone.header.Set(key, value)
+	return clone
+}
+
+// Get sends a GET request
+func (c *Client) Get(t testing.TB, path string) *Response {
+	t.Helper()
+	return c.Do(t, http.MethodGet, path, nil)
+}
+
+// Post sends a POST request with body encoded as JSON
+func (c *Client) Post(t testing.TB, path string, body any) *Response {
+	t.Helper()
+	return c.Do(t, http.MethodPost, path, body)
+}
+
+// Put sends a PUT request with body encoded as JSON
+func (c *Client) Put(t testing.TB, path string, body any) *Response {
+	t.Helper()
+	return c.Do(t, http.MethodPut, path, body)
+}
+
+// Patch sends a PATCH request with body encoded as JSON
+func (c *Client) Patch(t testing.TB, path string, body any) *Response {
+	t.Helper()
+	return c.Do(t, http.MethodPatch, path, body)
+}
+
+// Delete sends a DELETE request
+func (c *Client) Delete(t testing.TB, path string) *Response {
+	t.Helper()
+	return c.Do(t, http.MethodDelete, path, nil)
+}
+
+// Do sends a request; a non-nil body is encoded as JSON
+func (c *Client) Do(t testing.TB, method, path string, body any) *Response {
+	t.Helper()
+
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			t.Fatalf("apitest: encode request body: %v", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req := httptest.NewRequest(method, path, reader)
+	for key, values := range c.header {
+		req.Header[key] = values
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	recorder := httptest.NewRecorder()
+	c.handler.ServeHTTP(recorder, req)
+
+	return &Response{
+		Status: recorder.Code,
+		Header: recorder.Header(),
+		Body:   recorder.Body.Bytes(),
+	}
+}
+
+// Response is a recorded response
+type Response struct {
+	Status int
+	Header http.Header
+	Body   []byte
+}
+
+// RequireStatus fails the test unless the response has the status, showing the body
+func (r *Response) RequireStatus(t testing.TB, status int) *Response {
+	t.Helper()
+	if r.Status != status {
+		t.Fatalf("apitest: status %d, want %d; body: %s", r.Status, status, r.Body)
+	}
+	return r
+}
+
+// JSON decodes the body into dst
+func (r *Response) JSON(t testing.TB, dst any) {
+	t.Helper()
+	if err := json.Unmarshal(r.Body, dst); err != nil {
+		t.Fatalf("apitest: decode response body: %v; body: %s", err, r.Body)
+	}
+}
@@ -0,0 +1,52 @@
+package apitest
+
+import (
+	"context"
+	"testing"
+
+	"backend/internal/server"
+	"backend/internal/testing/factory"
+	"backend/internal/testing/pgtest"
+)
+
+// Server is the complete application wired against the test database
+type Server struct {
+	Client *Client
+	Issuer *Issuer
+}
+
+// NewServer builds the application the way main does, configured through the
+// environment to use the pgtest database, the in-memory cache and Issuer's keys.
+// The test's package must set up pgtest in TestMain; the test is skipped when
+// no database is available.
+func NewServer(t *testing.T) *Server {
+	t.Helper()
+
+	databaseURL := pgtest.URL(t)
+	issuer := NewIssuer(t)
+
+	t.Setenv("DATABASE_URL", databaseURL)
+	t.Setenv("JWKS_ENDPOINT", issuer.JWKSURL())
+	t.Setenv("JWT_ISSUER", issuer.URL)
+	t.Setenv("REDIS_URL", "")
+	t.Setenv("RESPONSE_CACHE_ENABLED", "false")
+	t.Setenv("ENVIRONMENT", "test")
+	t.Setenv("LOG_LEVEL", "error")
+
+	app, cleanup, err := server.InitializeApp(context.Background())
+	if err != nil {
+		t.Fatalf("apitest: initialize app: %v", err)
+	}
+	t.Cleanup(cleanup)
+
+	return &Server{
+		Client: NewClient(app.Handler()),
+		Issuer: issuer,
+	}
+}
+
+// TokenFor signs a token for a user made by the factory
+func (s *Server) TokenFor(t testing.TB, user factory.User) string {
+	t.Helper()
+	return s.Issuer.Token(t, user.SupabaseID, user.Email)
+}
@@ -0,0 +1,379 @@
+// Package factory builds rows for integration tests.
+//
+// Each builder starts from valid, unique defaults, so a test spells out only
+// what it cares about:
+//
+//	author := factory.NewUser().WithRole("author").Create(t, tx)
+//	post := factory.NewPost(author.ID).Published().Create(t, tx)
+//	factory.NewTheme(author.ID).Active().Articles(post.ID).Create(t, tx)
+//
+// Builders write through any postgres.Querier, normally the transaction from
+// pgtest.Tx, and fail the test on error.
+package factory
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"backend/internal/authz/domain"
+	"backend/internal/platform/postgres"
+	"backend/internal/platform/tenant"
+	"github.com/google/uuid"
+)
+
+// sequence makes default names unique across the tests of one binary
+var sequence atomic.Int64
+
+// next returns a fresh suffix for default names
+func next() int64 {
+	return sequence.Add(1)
+}
+
+// User is a created user
+type User struct {
+	ID         uuid.UUID
+	SupabaseID string // Subject of the user's tokens
+	Email      string
+	Username   string
+}
+
+// UserBuilder builds a user
+type UserBuilder struct {
+	user  User
+	roles []string
+}
+
+// NewUser starts a user with a unique username and email
+func NewUser() *UserBuilder {
+	n := next()
+	return &UserBuilder{user: User{
+		ID:         uuid.New(),
+		SupabaseID: fmt.Sprintf("test-user-%d-%s", n, uuid.NewString()[:8]),
+		Email:      fmt.Sprintf("user%d@example.com", n),
+		Username:   fmt.Sprintf("user%d", n),
+	}}
+}
+
+// Username sets the username
+func (b *UserBuilder) Username(username string) *UserBuilder {
+	b.user.Username = username
+	return b
+}
+
+// Email sets the email
+func (b *UserBuilder) Email(email string) *UserBuilder {
+	b.user.Email = email
+	return b
+}
+
+// WithRole assigns a role by name on every blog
+func (b *UserBuilder) WithRole(name string) *UserBuilder {
+	b.roles = append(b.roles, name)
+	return b
+}
+
+// Create inserts the user and its role assignments
+func (b *UserBuilder) Create(t testing.TB, q postgres.Querier) User {
+	t.Helper()
+	ctx := context.Background()
+
+	_, err := q.Exec(ctx,
+		`INSERT INTO users (id, supabase_id, email, username) VALUES ($1, $2, $3, $4)`,
+		b.user.ID, b.user.SupabaseID, b.user.Email, b.user.Username,
+	)
+	if err != nil {
+		t.Fatalf("factory: create user: %v", err)
+	}
+
+	for _, role := range b.roles {
+		tag, err := q.Exec(ctx,
+			`INSERT INTO user_roles (user_id, role_id) SELECT $1, id FROM roles WHERE name = $2`,
+			b.user.ID, role,
+		)
+		if err != nil {
+			t.Fatalf("factory: assign role %s: %v", role, err)
+		}
+		if tag.RowsAffected() == 0 {
+			t.Fatalf("factory: assign role %s: no such role", role)
+		}
+	}
+
+	return b.user
+}
+
+// Role is a created role
+type Role struct {
+	ID   uuid.UUID
+	Name string
+}
+
+// RoleBuilder builds a custom role
+type RoleBuilder struct {
+	name        string
+	permissions []string
+}
+
+// NewRole starts a role with a unique name and no permissions
+func NewRole() *RoleBuilder {
+	return &RoleBuilder{name: fmt.Sprintf("test_role_%d", next())}
+}
+
+// Name sets the role name
+func (b *RoleBuilder) Name(name string) *RoleBuilder {
+	b.name = name
+	return b
+}
+
+// Permissions grants permissions by ID, such as "posts:update:own"
+func (b *RoleBuilder) Permissions(ids ...string) *RoleBuilder {
+	b.permissions = append(b.permissions, ids...)
+	return b
+}
+
+// Create inserts the role and its permissions; the permissions must be seeded
+func (b *RoleBuilder) Create(t testing.TB, q postgres.Querier) Role {
+	t.Helper()
+	ctx := context.Background()
+
+	role := Role{Name: b.name}
+	err := q.QueryRow(ctx,
+		`INSERT INTO roles (name, description) VALUES ($1, 'Created by a test') RETURNING id`,
+		b.name,
+	).Scan(&role.ID)
+	if err != nil {
+		t.Fatalf("factory: create role: %v", err)
+	}
+
+	for _, id := range b.permissions {
+		resource, action, scope := domain.ParsePermissionID(id)
+		tag, err := q.Exec(ctx, `
+			INSERT INTO role_permissions (role_id, permission_id)
+			SELECT $1, id FROM permissions
+			WHERE resource = $2 AND action = $3 AND scope IS NOT DISTINCT FROM NULLIF($4, '')`,
+			role.ID, resource, action, scope,
+		)
+		if err != nil {
+			t.Fatalf("factory: grant %s: %v", id, err)
+		}
+		if tag.RowsAffected() == 0 {
+			t.Fatalf("factory: grant %s: no such permission", id)
+		}
+	}
+
+	return role
+}
+
+// Post is a created post
+type Post struct {
+	ID       uuid.UUID
+	BlogID   uuid.UUID
+	AuthorID uuid.UUID
+	Title    string
+	Slug     string
+	Status   string
+}
+
+// PostBuilder builds a post
+type PostBuilder struct {
+	post        Post
+	content     string
+	publishedAt *time.Time
+}
+
+// NewPost starts a draft by the author on the default blog
+func NewPost(authorID uuid.UUID) *PostBuilder {
+	n := next()
+	return &PostBuilder{
+		post: Post{
+			ID:       uuid.New(),
+			BlogID:   tenant.DefaultBlogID,
+			AuthorID: authorID,
+			Title:    fmt.Sprintf("Test Post %d", n),
+			Slug:     fmt.Sprintf("test-post-%d", n),
+			Status:   "draft",
+		},
+		content: "<p>Test content.</p>",
+	}
+}
+
+// Title sets the title
+func (b *PostBuilder) Title(title string) *PostBuilder {
+	b.post.Title = title
+	return b
+}
+
+// Slug sets the slug
+func (b *PostBuilder) Slug(slug string) *PostBuilder {
+	b.post.Slug = slug
+	return b
+}
+
+// Content sets the HTML content
+func (b *PostBuilder) Content(content string) *PostBuilder {
+	b.content = content
+	return b
+}
+
+// Blog places the post on another blog
+func (b *PostBuilder) Blog(blogID uuid.UUID) *PostBuilder {
+	b.post.BlogID = blogID
+	return b
+}
+
+// Published publishes the post now
+func (b *PostBuilder) Published() *PostBuilder {
+	return b.PublishedAt(time.Now())
+}
+
+// PublishedAt publishes the post at the given time
+func (b *PostBuilder) PublishedAt(at time.Time) *PostBuilder {
+	b.post.Status = "published"
+	b.publishedAt = &at
+	return b
+}
+
+// Status sets any other status, such as "archived" or "in_review"
+func (b *PostBuilder) Status(status string) *PostBuilder {
+	b.post.Status = status
+	return b
+}
+
+// Create inserts the post
+func (b *PostBuilder) Create(t testing.TB, q postgres.Querier) Post {
+	t.Helper()
+
+	_, err := q.Exec(context.Background(), `
+		INSERT INTO posts (id, blog_id, author_id, author_name, title, slug, content, status, published_at)
+		VALUES ($1, $2, $3, (SELECT username FROM users WHERE id = $3), $4, $5, $6, $7, $8)`,
+		b.post.ID, b.post.BlogID, b.post.AuthorID, b.post.Title, b.post.Slug, b.content, b.post.Status, b.publishedAt,
+	)
+	if err != nil {
+		t.Fatalf("factory: create post: %v", err)
+	}
+	return b.post
+}
+
+// Theme is a created theme
+type Theme struct {
+	ID        uuid.UUID
+	BlogID    uuid.UUID
+	CuratorID uuid.UUID
+	Name      string
+	Slug      string
+	Status    string
+}
+
+// ThemeBuilder builds a theme
+type ThemeBuilder struct {
+	theme   Theme
+	postIDs []uuid.UUID
+}
+
+// NewTheme starts a draft theme curated by the user on the default blog
+func NewTheme(curatorID uuid.UUID) *ThemeBuilder {
+	n := next()
+	return &ThemeBuilder{theme: Theme{
+		ID:        uuid.New(),
+		BlogID:    tenant.DefaultBlogID,
+		CuratorID: curatorID,
+		Name:      fmt.Sprintf("Test Theme %d", n),
+		Slug:      fmt.Sprintf("test-theme-%d", n),
+		Status:    "draft",
+	}}
+}
+
+// Name sets the name
+func (b *ThemeBuilder) Name(name string) *ThemeBuilder {
+	b.theme.Name = name
+	return b
+}
+
+// Slug sets the slug
+func (b *ThemeBuilder) Slug(slug string) *ThemeBuilder {
+	b.theme.Slug = slug
+	return b
+}
+
+// Blog places the theme on another blog
+func (b *ThemeBuilder) Blog(blogID uuid.UUID) *ThemeBuilder {
+	b.theme.BlogID = blogID
+	return b
+}
+
+// Active makes the theme visible to readers
+func (b *ThemeBuilder) Active() *ThemeBuilder {
+	b.theme.Status = "active"
+	return b
+}
+
+// Articles adds posts to the theme in order; they must be published
+func (b *ThemeBuilder) Articles(postIDs ...uuid.UUID) *ThemeBuilder {
+	b.postIDs = append(b.postIDs, postIDs...)
+	return b
+}
+
+// Create inserts the theme and its articles
+func (b *ThemeBuilder) Create(t testing.TB, q postgres.Querier) Theme {
+	t.Helper()
+	ctx := context.Background()
+
+	_, err := q.Exec(ctx, `
+		INSERT INTO themes (id, blog_id, curator_id, curator_name, name, slug, status)
+		VALUES ($1, $2, $3, (SELECT username FROM users WHERE id = $3), $4, $5, $6)`,
+		b.theme.ID, b.theme.BlogID, b.theme.CuratorID, b.theme.Name, b.theme.Slug, b.theme.Status,
+	)
+	if err != nil {
+		t.Fatalf("factory: create theme: %v", err)
+	}
+
+	for i, postID := range b.postIDs {
+		_, err := q.Exec(ctx,
+			`INSERT INTO theme_articles (theme_id, post_id, position, added_by) VALUES ($1, $2, $3, $4)`,
+			b.theme.ID, postID, i+1, b.theme.CuratorID,
+		)
+		if err != nil {
+			t.Fatalf("factory: add article: %v", err)
+		}
+	}
+
+	return b.theme
+}
+
+// Blog is a created blog
+type Blog struct {
+	ID   uuid.UUID
+	Name string
+	Slug string
+}
+
+// BlogBuilder builds a blog besides the default one
+type BlogBuilder struct {
+	blog Blog
+}
+
+// NewBlog starts a blog with a unique slug and no host
+func NewBlog() *BlogBuilder {
+	n := next()
+	return &BlogBuilder{blog: Blog{
+		ID:   uuid.New(),
+		Name: fmt.Sprintf("Test Blog %d", n),
+		Slug: fmt.Sprintf("test-blog-%d", n),
+	}}
+}
+
+// Create inserts the blog
+func (b *BlogBuilder) Create(t testing.TB, q postgres.Querier) Blog {
+	t.Helper()
+
+	_, err := q.Exec(context.Background(),
+		`INSERT INTO blogs (id, name, slug) VALUES ($1, $2, $3)`,
+		b.blog.ID, b.blog.Name, b.blog.Slug,
+	)
+	if err != nil {
+		t.Fatalf("factory: create blog: %v", err)
+	}
+	return b.blog
+}
@@ -0,0 +1,332 @@
+// Package pgtest provides a migrated PostgreSQL database for integration tests.
+//
+// A test binary gets one fresh database, created from the server named by
+// TEST_DATABASE_URL or, when that is unset, from a throwaway Docker container.
+// Every migration under supabase/migrations is applied and the baseline
+// seeders are run, so roles, permissions and default settings exist. When
+// neither a server nor Docker is available the tests that need the database
+// are skipped rather than failed.
+//
+// Packages opt in from TestMain:
+//
+//	func TestMain(m *testing.M) { os.Exit(pgtest.Main(m)) }
+//
+// Tests then take a transaction that is rolled back when they finish, or the
+// shared pool when the code under test commits on its own.
+package pgtest
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	authzSeeder "backend/internal/authz/seeder"
+	"backend/internal/platform/postgres"
+	settingsSeeder "backend/internal/settings/seeder"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// EnvDatabaseURL names a server the harness may create test databases on
+const EnvDatabaseURL = "TEST_DATABASE_URL"
+
+// postgresImage is the image started when no server is configured
+const postgresImage = "postgres:16-alpine"
+
+// database is the state shared by the tests of one binary
+var database struct {
+	pool       *pgxpool.Pool
+	url        string
+	skipReason string
+}
+
+// Main provisions the database, runs the tests and removes the database again
+// It returns the exit code for os.Exit
+func Main(m *testing.M) int {
+	ctx := context.Background()
+
+	teardown, err := setUp(ctx)
+	if err != nil {
+		database.skipReason = err.Error()
+		log.Printf("pgtest: integration tests will be skipped: %v", err)
+	}
+
+	code := m.Run()
+
+	if teardown != nil {
+		teardown()
+	}
+	return code
+}
+
+// Pool returns the shared pool, skipping the test when no database is available
+// Data written through it is visible to later tests, so clean up what you create
+func Pool(t testing.TB) *pgxpool.Pool {
+	t.Helper()
+	if database.pool == nil {
+		if database.skipReason == "" {
+			t.Fatal("pgtest: database not set up; call pgtest.Main from TestMain")
+		}
+		t.Skipf("pgtest: no database: %s", database.skipReason)
+	}
+	return database.pool
+}
+
+// URL returns the connection string of the test database, for code that
+// opens its own connections, such as the full application
+func URL(t testing.TB) string {
+	t.Helper()
+	Pool(t)
+	return database.url
+}
+
+// Tx begins a transaction that is rolled back when the test ends, so tests
+// sharing the database never see each other's data. Repositories run inside it
+// through their WithTx method.
+func Tx(t testing.TB) pgx.Tx {
+	t.Helper()
+	ctx := context.Background()
+
+	tx, err := Pool(t).Begin(ctx)
+	if err != nil {
+		t.Fatalf("pgtest: begin transaction: %v", err)
+	}
+	t.Cleanup(func() { _ = tx.Rollback(ctx) })
+	return tx
+}
+
+// setUp creates, migrates and seeds the test database
+// The returned teardown drops it and stops any container that was started
+func setUp(ctx context.Context) (func(), error) {
+	var cleanups []func()
+	teardown := func() {
+		for i := len(cleanups) - 1; i >= 0; i-- {
+			cleanups[i]()
+		}
+	}
+
+	serverURL := os.Getenv(EnvDatabaseURL)
+	if serverURL == "" {
+		url, stop, err := startContainer(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%s is unset and %w", EnvDatabaseURL, err)
+		}
+		serverURL = url
+		cleanups = append(cleanups, stop)
+	}
+
+	url, drop, err := createDatabase(ctx, serverURL)
+	if err != nil {
+		teardown()
+		return nil, err
+	}
+	cleanups = append(cleanups, drop)
+
+	poolConfig, err := pgxpool.ParseConfig(url)
+	if err != nil {
+		teardown()
+		return nil, fmt.Errorf("parse test database URL: %w", err)
+	}
+	envelope, err := testEnvelope()
+	if err != nil {
+		teardown()
+		return nil, err
+	}
+	poolConfig.AfterConnect = func(_ context.Context, conn *pgx.Conn) error {
+		postgres.RegisterEncryptedTypes(conn.TypeMap(), envelope)
+		return nil
+	}
+	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
+	if err != nil {
+		teardown()
+		return nil, fmt.Errorf("connect to test database: %w", err)
+	}
+	cleanups = append(cleanups, pool.Close)
+
+	if err := migrate(ctx, pool); err != nil {
+		teardown()
+		return nil, err
+	}
+
+	if err := authzSeeder.NewAuthzSeeder().Seed(ctx, pool); err != nil {
+		teardown()
+		return nil, fmt.Errorf("seed authorization data: %w", err)
+	}
+	if err := settingsSeeder.NewSettingsSeeder().Seed(ctx, pool); err != nil {
+		teardown()
+		return nil, fmt.Errorf("seed settings: %w", err)
+	}
+
+	database.pool = pool
+	database.url = url
+	return teardown, nil
+}
+
+// createDatabase creates a uniquely named database on the server
+func createDatabase(ctx context.Context, serverURL string) (string, func(), error) {
+	config, err := pgx.ParseConfig(serverURL)
+	if err != nil {
+		return "", nil, fmt.Errorf("parse %s: %w", EnvDatabaseURL, err)
+	}
+
+	admin, err := pgx.ConnectConfig(ctx, config)
+	if err != nil {
+		return "", nil, fmt.Errorf("connect to database server: %w", err)
+	}
+	defer admin.Close(ctx)
+
+	suffix := make([]byte, 6)
+	_, _ = rand.Read(suffix)
+	name := "archblog_test_" + hex.EncodeToString(suffix)
+
+	if _, err := admin.Exec(ctx, "CREATE DATABASE "+pgx.Identifier{name}.Sanitize()); err != nil {
+		return "", nil, fmt.Errorf("create test database: %w", err)
+	}
+
+	drop := func() {
+		conn, err := pgx.ConnectConfig(context.Background(), config)
+		if err != nil {
+			log.Printf("pgtest: drop %s: %v", name, err)
+			return
+		}
+		defer conn.Close(context.Background())
+		if _, err := conn.Exec(context.Background(), "DROP DATABASE "+pgx.Identifier{name}.Sanitize()+" WITH (FORCE)"); err != nil {
+			log.Printf("pgtest: drop %s: %v", name, err)
+		}
+	}
+
+	return withDatabase(serverURL, name), drop, nil
+}
+
+// withDatabase replaces the database named in a postgres:// URL
+func withDatabase(serverURL, name string) string {
+	rest, query, _ := strings.Cut(serverURL, "?")
+	scheme, hostAndPath, _ := strings.Cut(rest, "://")
+	host, _, _ := strings.Cut(hostAndPath, "/")
+
+	url := scheme + "://" + host + "/" + name
+	if query != "" {
+		url += "?" + query
+	}
+	return url
+}
+
+// migrate applies every migration file in name order, as the Supabase CLI does
+func migrate(ctx context.Context, pool *pgxpool.Pool) error {
+	dir, err := migrationsDir()
+	if err != nil {
+		return err
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.sql"))
+	if err != nil {
+		return fmt.Errorf("list migrations: %w", err)
+	}
+	sort.Strings(files)
+
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	for _, file := range files {
+		sql, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("read migration: %w", err)
+		}
+		// Migrations hold several statements, which only the simple protocol accepts
+		if _, err := conn.Conn().PgConn().Exec(ctx, string(sql)).ReadAll(); err != nil {
+			return fmt.Errorf("apply %s: %w", filepath.Base(file), err)
+		}
+	}
+	return nil
+}
+
+// migrationsDir finds supabase/migrations above the test's working directory
+func migrationsDir() (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", fmt.Errorf("find migrations: %w", err)
+	}
+	for {
+		candidate := filepath.Join(dir, "supabase", "migrations")
+		if info, err := os.Stat(candidate); err == nil && info.IsDir() {
+			return candidate, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("find migrations: no supabase/migrations above the working directory")
+		}
+		dir = parent
+	}
+}
+
+// startContainer runs a disposable Postgres server with the Docker CLI and
+// waits until it accepts connections
+func startContainer(ctx context.Context) (string, func(), error) {
+	if _, err := exec.LookPath("docker"); err != nil {
+		return "", nil, fmt.Errorf("docker is not installed")
+	}
+
+	out, err := exec.CommandContext(ctx, "docker", "run", "--detach", "--rm",
+		"--env", "POSTGRES_PASSWORD=postgres",
+		"--publish", "127.0.0.1::5432",
+		postgresImage,
+	).Output()
+	if err != nil {
+		return "", nil, fmt.Errorf("start postgres container: %w", err)
+	}
+	id := strings.TrimSpace(string(out))
+	stop := func() {
+		_ = exec.Command("docker", "rm", "--force", id).Run()
+	}
+
+	out, err = exec.CommandContext(ctx, "docker", "port", id, "5432/tcp").Output()
+	if err != nil {
+		stop()
+		return "", nil, fmt.Errorf("find postgres container port: %w", err)
+	}
+	address := strings.TrimSpace(strings.Split(string(out), "\n")[0])
+	url := "postgres://postgres:postgres@" + address + "/postgres?sslmode=disable"
+
+	// The server restarts once while the image initializes, so wait for a query to succeed
+	deadline := time.Now().Add(30 * time.Second)
+	for {
+		conn, err := pgx.Connect(ctx, url)
+		if err == nil {
+			_, err = conn.Exec(ctx, "SELECT 1")
+			conn.Close(ctx)
+			if err == nil {
+				return url, stop, nil
+			}
+		}
+		if time.Now().After(deadline) {
+			stop()
+			return "", nil, fmt.Errorf("postgres container not ready: %w", err)
+		}
+		time.Sleep(250 * time.Millisecond)
+	}
+}
+
+// testEnvelope seals encrypted columns under a master key made for this test binary
+func testEnvelope() (*postgres.Envelope, error) {
+	key := make([]byte, postgres.DataKeyLength)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("generate test master key: %w", err)
+	}
+	provider, err := postgres.NewLocalKeyProvider("test", map[string][]byte{"test": key})
+	if err != nil {
+		return nil, err
+	}
+	return postgres.NewEnvelope(provider), nil
+}
@@ -0,0 +1,227 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/logger"
+	"backend/internal/themes/domain"
+	"backend/internal/themes/ports"
+	"github.com/google/uuid"
+)
+
+// Collaborator errors
+var (
+	ErrCollaboratorNotFound = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodeCollaboratorNotFound,
+		"user does not collaborate on this theme",
+		http.StatusNotFound,
+	)
+
+	ErrCollaboratorUserNotFound = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodeUserNotFound,
+		"user not found",
+		http.StatusNotFound,
+	)
+
+	ErrInvalidCollaborator = apperror.New(
+		apperror.CodeValidationFailed,
+		apperror.BusinessCodeInvalidFormat,
+		"invalid collaborator",
+		http.StatusBadRequest,
+	)
+
+	ErrCollaboratorsForbidden = apperror.New(
+		apperror.CodeForbidden,
+		apperror.BusinessCodePermissionDenied,
+		"only the curator can manage the collaborators of this theme",
+		http.StatusForbidden,
+	)
+)
+
+// CollaboratorsService manages who helps curators maintain their themes.
+// What collaborators may then do is decided by ThemesOwnershipChecker.
+type CollaboratorsService struct {
+	themes        ports.ThemeRepository
+	collaborators ports.CollaboratorRepository
+	authorizer    ports.Authorizer
+	logger        logger.Logger
+}
+
+// NewCollaboratorsService creates a new collaborators service
+func NewCollaboratorsService(
+	themes ports.ThemeRepository,
+	collaborators ports.CollaboratorRepository,
+	authorizer ports.Authorizer,
+	logger logger.Logger,
+) *CollaboratorsService {
+	return &CollaboratorsService{
+		themes:        themes,
+		collaborators: collaborators,
+		authorizer:    authorizer,
+		logger:        logger,
+	}
+}
+
+// ListCollaborators lists the collaborators of a theme to anyone who may curate it
+func (s *CollaboratorsService) ListCollaborators(ctx context.Context, actorID, themeID uuid.UUID) ([]*domain.Collaborator, error) {
+	if _, err := s.findTheme(ctx, themeID); err != nil {
+		return nil, err
+	}
+
+	canCurate, err := s.authorizer.Can(ctx, actorID, "themes", "curate", &themeID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID, "themeID", themeID)
+		return nil, errAuthorizationFailed()
+	}
+	if !canCurate {
+		return nil, apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to view the collaborators of this theme",
+			http.StatusForbidden,
+		)
+	}
+
+	collaborators, err := s.collaborators.List(ctx, themeID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list collaborators", "error", err, "themeID", themeID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to list collaborators",
+			http.StatusInternalServerError,
+		)
+	}
+	return collaborators, nil
+}
+
+// SetCollaborator adds a user to a theme's collaborators, or changes their role
+func (s *CollaboratorsService) SetCollaborator(ctx context.Context, actorID, themeID, userID uuid.UUID, role domain.CollaboratorRole) (*domain.Collaborator, error) {
+	theme, err := s.findTheme(ctx, themeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeManage(ctx, actorID, theme); err != nil {
+		return nil, err
+	}
+
+	collaborator, err := domain.NewCollaborator(theme, userID, role, actorID)
+	if err != nil {
+		return nil, ErrInvalidCollaborator.WithDetails(err.Error())
+	}
+
+	if err := s.collaborators.Save(ctx, collaborator); err != nil {
+		switch {
+		case errors.Is(err, ports.ErrCollaboratorUserNotFound):
+			return nil, ErrCollaboratorUserNotFound
+		case errors.Is(err, ports.ErrThemeNotFound):
+			return nil, ErrThemeNotFound
+		}
+		s.logger.Error(ctx, "failed to save collaborator", "error", err, "themeID", themeID, "userID", userID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to save collaborator",
+			http.StatusInternalServerError,
+		)
+	}
+
+	// Reload so a changed role carries who first added them
+	saved, err := s.collaborators.Find(ctx, themeID, userID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to reload collaborator", "error", err, "themeID", themeID, "userID", userID)
+		return collaborator, nil
+	}
+
+	s.logger.Info(ctx, "theme collaborator set", "themeID", themeID, "userID", userID, "role", role, "actorID", actorID)
+	return saved, nil
+}
+
+// RemoveCollaborator removes a user from a theme's collaborators
+// Collaborators may always leave a theme themselves
+func (s *CollaboratorsService) RemoveCollaborator(ctx context.Context, actorID, themeID, userID uuid.UUID) error {
+	theme, err := s.findTheme(ctx, themeID)
+	if err != nil {
+		return err
+	}
+	if actorID != userID {
+		if err := s.authorizeManage(ctx, actorID, theme); err != nil {
+			return err
+		}
+	}
+
+	if err := s.collaborators.Remove(ctx, themeID, userID); err != nil {
+		if errors.Is(err, ports.ErrCollaboratorNotFound) {
+			return ErrCollaboratorNotFound
+		}
+		s.logger.Error(ctx, "failed to remove collaborator", "error", err, "themeID", themeID, "userID", userID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to remove collaborator",
+			http.StatusInternalServerError,
+		)
+	}
+
+	s.logger.Info(ctx, "theme collaborator removed", "themeID", themeID, "userID", userID, "actorID", actorID)
+	return nil
+}
+
+// authorizeManage allows the curator, while they may still update the theme,
+// and whoever may update any theme. Collaborators cannot add each other.
+func (s *CollaboratorsService) authorizeManage(ctx context.Context, actorID uuid.UUID, theme *domain.Theme) error {
+	canUpdateAny, err := s.authorizer.Can(ctx, actorID, "themes:update", "any", nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID, "themeID", theme.ID)
+		return errAuthorizationFailed()
+	}
+	if canUpdateAny {
+		return nil
+	}
+	if theme.CuratorID != actorID {
+		return ErrCollaboratorsForbidden
+	}
+
+	canUpdate, err := s.authorizer.Can(ctx, actorID, "themes", "update", &theme.ID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID, "themeID", theme.ID)
+		return errAuthorizationFailed()
+	}
+	if !canUpdate {
+		return ErrCollaboratorsForbidden
+	}
+	return nil
+}
+
+// findTheme loads a theme of the current blog
+func (s *CollaboratorsService) findTheme(ctx context.Context, themeID uuid.UUID) (*domain.Theme, error) {
+	theme, err := s.themes.FindByID(ctx, themeID)
+	if err != nil {
+		if errors.Is(err, ports.ErrThemeNotFound) {
+			return nil, ErrThemeNotFound
+		}
+		s.logger.Error(ctx, "failed to find theme", "error", err, "themeID", themeID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to retrieve theme",
+			http.StatusInternalServerError,
+		)
+	}
+	return theme, nil
+}
+
+// errAuthorizationFailed reports that a permission could not be checked
+func errAuthorizationFailed() error {
+	return apperror.New(
+		apperror.CodeInternalError,
+		apperror.BusinessCodeGeneral,
+		"authorization check failed",
+		http.StatusInternalServerError,
+	)
+}
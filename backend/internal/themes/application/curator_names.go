@@ -0,0 +1,97 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend/internal/platform/erasure"
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/events"
+	"backend/internal/themes/ports"
+	"github.com/google/uuid"
+)
+
+// renameBatchSize bounds the themes one statement renames
+const renameBatchSize = 500
+
+// CuratorNames keeps the curator name copied onto each theme in step with the
+// curator's username, which theme listings read instead of joining users
+type CuratorNames struct {
+	repo     ports.ThemeRepository
+	eventBus *eventbus.Bus
+}
+
+// NewCuratorNames creates a new curator name subscriber
+func NewCuratorNames(repo ports.ThemeRepository, eventBus *eventbus.Bus) *CuratorNames {
+	return &CuratorNames{
+		repo:     repo,
+		eventBus: eventBus,
+	}
+}
+
+// Subscribe registers the rename handler on the bus
+func (c *CuratorNames) Subscribe(bus *eventbus.Bus) {
+	bus.Subscribe(events.UserUpdatedTopic, c.handleUserUpdated)
+}
+
+// handleUserUpdated rewrites the user's themes to their new username
+func (c *CuratorNames) handleUserUpdated(ctx context.Context, event eventbus.Event) error {
+	payload, ok := event.Payload.(events.UserUpdatedEvent)
+	if !ok {
+		return fmt.Errorf("CuratorNames.handleUserUpdated: unexpected payload %T", event.Payload)
+	}
+
+	// Detached from the publisher's request so it is not cut short
+	if _, err := c.rename(context.WithoutCancel(ctx), payload.UserID, payload.Username); err != nil {
+		return fmt.Errorf("CuratorNames.handleUserUpdated: %w", err)
+	}
+	return nil
+}
+
+// Erase replaces the subject's name on the themes they curate with their pseudonym
+// Implements the erasure.Eraser interface
+func (c *CuratorNames) Erase(ctx context.Context, subject erasure.Subject) (int, error) {
+	n, err := c.rename(ctx, subject.UserID, subject.Pseudonym)
+	if err != nil {
+		return 0, fmt.Errorf("CuratorNames.Erase: %w", err)
+	}
+	return n, nil
+}
+
+// rename rewrites the curator's themes batch by batch and announces the blogs
+// whose listings changed; it returns how many themes it rewrote
+func (c *CuratorNames) rename(ctx context.Context, curatorID uuid.UUID, name string) (int, error) {
+	seen := make(map[uuid.UUID]bool)
+	var blogIDs []uuid.UUID
+	total := 0
+	for {
+		renamed, err := c.repo.RenameCurator(ctx, curatorID, name, renameBatchSize)
+		if err != nil {
+			return total, err
+		}
+		total += len(renamed)
+		for _, blogID := range renamed {
+			if !seen[blogID] {
+				seen[blogID] = true
+				blogIDs = append(blogIDs, blogID)
+			}
+		}
+		if len(renamed) < renameBatchSize {
+			break
+		}
+	}
+
+	if len(blogIDs) > 0 {
+		c.eventBus.Publish(ctx, eventbus.Event{
+			Topic: events.ThemeCuratorNamesUpdatedTopic,
+			Payload: events.ThemeCuratorNamesUpdatedEvent{
+				CuratorID:   curatorID,
+				CuratorName: name,
+				BlogIDs:     blogIDs,
+				OccurredAt:  time.Now(),
+			},
+		})
+	}
+	return total, nil
+}
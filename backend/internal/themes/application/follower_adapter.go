@@ -0,0 +1,30 @@
+package application
+
+import (
+	"context"
+
+	followsApp "backend/internal/follows/application"
+	"github.com/google/uuid"
+)
+
+// FollowerAdapter implements the FollowerCounter interface
+// It adapts the follows service to count the followers of curators
+type FollowerAdapter struct {
+	followsService *followsApp.FollowsService
+}
+
+// NewFollowerAdapter creates a new follower adapter
+func NewFollowerAdapter(followsService *followsApp.FollowsService) *FollowerAdapter {
+	return &FollowerAdapter{
+		followsService: followsService,
+	}
+}
+
+// CountFollowers returns how many users follow the user
+func (a *FollowerAdapter) CountFollowers(ctx context.Context, userID uuid.UUID) (int, error) {
+	stats, err := a.followsService.GetFollowStats(ctx, userID, nil)
+	if err != nil {
+		return 0, err
+	}
+	return stats.FollowerCount, nil
+}
@@ -11,17 +11,19 @@ import (
 )
 
 // ThemesOwnershipChecker checks ownership of themes
-// It depends directly on the repository, not the service, for cleaner architecture
+// It depends directly on the repositories, not the service, for cleaner architecture
 type ThemesOwnershipChecker struct {
-	repo   ports.ThemeRepository
-	logger logger.Logger
+	repo          ports.ThemeRepository
+	collaborators ports.CollaboratorRepository
+	logger        logger.Logger
 }
 
 // NewThemesOwnershipChecker creates a new themes ownership checker
-func NewThemesOwnershipChecker(repo ports.ThemeRepository, logger logger.Logger) *ThemesOwnershipChecker {
+func NewThemesOwnershipChecker(repo ports.ThemeRepository, collaborators ports.CollaboratorRepository, logger logger.Logger) *ThemesOwnershipChecker {
 	return &ThemesOwnershipChecker{
-		repo:   repo,
-		logger: logger,
+		repo:          repo,
+		collaborators: collaborators,
+		logger:        logger,
 	}
 }
 
@@ -41,8 +43,29 @@ func (t *ThemesOwnershipChecker) CheckOwnership(ctx context.Context, userID uuid
 	return curatorID == userID, nil
 }
 
+// CheckAccess lets the curator perform any action on a theme, and its
+// collaborators the actions their role grants
+// Implements the ownership.ActionChecker interface
+func (t *ThemesOwnershipChecker) CheckAccess(ctx context.Context, userID uuid.UUID, resourceID uuid.UUID, action string) (bool, error) {
+	isCurator, err := t.CheckOwnership(ctx, userID, resourceID)
+	if err != nil || isCurator {
+		return isCurator, err
+	}
+
+	collaborator, err := t.collaborators.Find(ctx, resourceID, userID)
+	if err != nil {
+		if errors.Is(err, ports.ErrCollaboratorNotFound) {
+			return false, nil
+		}
+		t.logger.Error(ctx, "failed to get theme collaborator", "error", err, "themeID", resourceID)
+		return false, err
+	}
+
+	return collaborator.Role.Allows(action), nil
+}
+
 // RegisterThemesOwnership registers the themes ownership checker with the registry
-func RegisterThemesOwnership(registry ownership.Registry, repo ports.ThemeRepository, logger logger.Logger) {
-	checker := NewThemesOwnershipChecker(repo, logger)
+func RegisterThemesOwnership(registry ownership.Registry, repo ports.ThemeRepository, collaborators ports.CollaboratorRepository, logger logger.Logger) {
+	checker := NewThemesOwnershipChecker(repo, collaborators, logger)
 	registry.RegisterChecker("themes", checker)
 }
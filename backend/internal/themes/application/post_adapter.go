@@ -2,6 +2,7 @@ package application
 
 import (
 	"context"
+	"errors"
 
 	postsApp "backend/internal/posts/application"
 	"backend/internal/themes/domain"
@@ -33,3 +34,38 @@ func (a *PostAdapter) GetPost(ctx context.Context, id uuid.UUID) (domain.PostInf
 	// The Post domain object directly implements PostInfo interface
 	return post, nil
 }
+
+// GetPosts retrieves several posts in one query, keyed by ID
+// Posts that do not exist are absent from the map
+func (a *PostAdapter) GetPosts(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]domain.PostInfo, error) {
+	posts, err := a.postsService.GetPostsByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make(map[uuid.UUID]domain.PostInfo, len(posts))
+	for _, post := range posts {
+		infos[post.ID] = post
+	}
+	return infos, nil
+}
+
+// GetPostsBySlugs resolves posts by slug, keyed by the requested slug
+// Previous slugs of renamed posts resolve too; unknown slugs are absent from the map
+func (a *PostAdapter) GetPostsBySlugs(ctx context.Context, slugs []string) (map[string]domain.PostInfo, error) {
+	infos := make(map[string]domain.PostInfo, len(slugs))
+	for _, slug := range slugs {
+		if _, seen := infos[slug]; seen {
+			continue
+		}
+		post, err := a.postsService.GetPostBySlug(ctx, slug)
+		if err != nil {
+			if errors.Is(err, postsApp.ErrPostNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		infos[slug] = post
+	}
+	return infos, nil
+}
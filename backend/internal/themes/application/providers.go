@@ -6,6 +6,16 @@ import "github.com/google/wire"
 var ProviderSet = wire.NewSet(
 	NewThemesService,
 	NewThemesOwnershipChecker,
+	NewCollaboratorsService,
+	NewThemeCache,
+	NewCuratorNames,
+	NewThemePageService,
 	NewPostAdapter,
 	wire.Bind(new(PostProvider), new(*PostAdapter)),
+	NewQuotaAdapter,
+	wire.Bind(new(QuotaChecker), new(*QuotaAdapter)),
+	NewUserAdapter,
+	wire.Bind(new(CuratorProvider), new(*UserAdapter)),
+	NewFollowerAdapter,
+	wire.Bind(new(FollowerCounter), new(*FollowerAdapter)),
 )
@@ -0,0 +1,26 @@
+package application
+
+import (
+	"context"
+
+	quotasApp "backend/internal/quotas/application"
+	"github.com/google/uuid"
+)
+
+// QuotaAdapter implements the QuotaChecker interface
+// It adapts the quotas service to the theme limit of curators
+type QuotaAdapter struct {
+	quotaService *quotasApp.QuotaService
+}
+
+// NewQuotaAdapter creates a new quota adapter
+func NewQuotaAdapter(quotaService *quotasApp.QuotaService) *QuotaAdapter {
+	return &QuotaAdapter{
+		quotaService: quotaService,
+	}
+}
+
+// CheckThemeQuota fails when the curator may not create another theme
+func (a *QuotaAdapter) CheckThemeQuota(ctx context.Context, userID uuid.UUID) error {
+	return a.quotaService.CheckThemeQuota(ctx, userID)
+}
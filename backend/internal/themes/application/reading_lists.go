@@ -0,0 +1,200 @@
+package application
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"backend/internal/platform/apperror"
+	"backend/internal/themes/domain"
+	"github.com/google/uuid"
+)
+
+// ReadingListConfig holds the settings for sharing themes as reading lists
+type ReadingListConfig struct {
+	// SiteURL is the public address of the blog's frontend, where posts are
+	// served under /posts/{slug}. Without it, exported links are relative.
+	SiteURL string
+}
+
+// ErrInvalidReadingList is returned for reading lists that cannot be imported
+var ErrInvalidReadingList = apperror.New(
+	apperror.CodeValidationFailed,
+	apperror.BusinessCodeInvalidFormat,
+	"invalid reading list",
+	http.StatusBadRequest,
+)
+
+// ImportedArticle is a reading list entry that matched a post
+type ImportedArticle struct {
+	PostID   uuid.UUID
+	Slug     string
+	Position int
+	Added    bool // False when the post was already in the theme
+}
+
+// ReadingListImport reports how the entries of an imported reading list were applied
+type ReadingListImport struct {
+	Matched []ImportedArticle
+	// Unmatched lists the entries that point to no published post of the blog
+	Unmatched []domain.ReadingListEntry
+}
+
+// ExportReadingList returns a theme's articles as a reading list, pinned articles first
+func (s *ThemesService) ExportReadingList(ctx context.Context, actorID uuid.UUID, themeID uuid.UUID) (*domain.ReadingList, error) {
+	if err := s.checkCanUpdate(ctx, actorID, themeID); err != nil {
+		return nil, err
+	}
+
+	theme, err := s.GetThemeWithArticles(ctx, themeID)
+	if err != nil {
+		return nil, err
+	}
+
+	postIDs := make([]uuid.UUID, len(theme.Articles))
+	for i, article := range theme.Articles {
+		postIDs[i] = article.PostID
+	}
+	posts, err := s.postProvider.GetPosts(ctx, postIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &domain.ReadingList{
+		Title:       theme.Name,
+		Description: theme.Description,
+		Entries:     make([]domain.ReadingListEntry, 0, len(theme.Articles)),
+	}
+	for _, article := range theme.Articles {
+		post, ok := posts[article.PostID]
+		if !ok {
+			continue
+		}
+		list.Entries = append(list.Entries, domain.ReadingListEntry{
+			Title: post.GetTitle(),
+			Slug:  post.GetSlug(),
+			URL:   s.postURL(post.GetSlug()),
+			Note:  article.Note,
+		})
+	}
+	return list, nil
+}
+
+// ImportReadingList adds the published posts a reading list points to, matched by
+// slug or by the post URL, to the end of a theme in the list's order. Notes are
+// copied onto the matched articles, including those already in the theme.
+func (s *ThemesService) ImportReadingList(ctx context.Context, actorID uuid.UUID, themeID uuid.UUID, list *domain.ReadingList) (*ReadingListImport, error) {
+	if err := s.checkCanUpdate(ctx, actorID, themeID); err != nil {
+		return nil, err
+	}
+	if err := list.Validate(); err != nil {
+		return nil, ErrInvalidReadingList.WithDetails(err.Error())
+	}
+
+	theme, err := s.GetThemeWithArticles(ctx, themeID)
+	if err != nil {
+		return nil, err
+	}
+	if theme.Status == domain.ThemeStatusArchived {
+		return nil, ErrThemeArchived
+	}
+
+	slugs := make([]string, 0, len(list.Entries))
+	for _, entry := range list.Entries {
+		if slug := entry.MatchSlug(); slug != "" {
+			slugs = append(slugs, slug)
+		}
+	}
+	posts, err := s.postProvider.GetPostsBySlugs(ctx, slugs)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ReadingListImport{}
+	changed := false
+	for _, entry := range list.Entries {
+		// Drafts are reported like unknown posts, so a list cannot probe for them
+		post, ok := posts[entry.MatchSlug()]
+		if !ok || !post.IsPublished() {
+			report.Unmatched = append(report.Unmatched, entry)
+			continue
+		}
+
+		postID := post.GetID()
+		added := !theme.HasArticle(postID)
+		if added {
+			if err := theme.AddArticle(post, actorID); err != nil {
+				return nil, ErrInvalidThemeData.WithDetails(err.Error())
+			}
+			changed = true
+		}
+		if entry.Note != "" {
+			article, _ := theme.GetArticle(postID)
+			changed = changed || article.Note != entry.Note
+			if err := theme.SetArticleNote(postID, entry.Note); err != nil {
+				return nil, ErrInvalidReadingList.WithDetails(err.Error())
+			}
+		}
+
+		article, _ := theme.GetArticle(postID)
+		report.Matched = append(report.Matched, ImportedArticle{
+			PostID:   postID,
+			Slug:     post.GetSlug(),
+			Position: article.Position,
+			Added:    added,
+		})
+	}
+
+	if !changed {
+		return report, nil
+	}
+
+	if err := s.saveThemeWithTransaction(ctx, theme); err != nil {
+		s.logger.Error(ctx, "failed to save theme", "error", err, "themeID", themeID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to import reading list",
+			http.StatusInternalServerError,
+		)
+	}
+
+	for _, matched := range report.Matched {
+		if matched.Added {
+			s.publishThemeArticleAddedEvent(ctx, themeID, matched.PostID, matched.Position, actorID)
+		}
+	}
+	s.publishThemeUpdatedEvent(ctx, theme, actorID)
+
+	s.logger.Info(ctx, "reading list imported", "themeID", themeID, "actorID", actorID,
+		"matched", len(report.Matched), "unmatched", len(report.Unmatched))
+	return report, nil
+}
+
+// checkCanUpdate verifies the actor may update this specific theme
+func (s *ThemesService) checkCanUpdate(ctx context.Context, actorID, themeID uuid.UUID) error {
+	canUpdate, err := s.authorizer.Can(ctx, actorID, "themes", "update", &themeID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID, "themeID", themeID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canUpdate {
+		return apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to update this theme",
+			http.StatusForbidden,
+		)
+	}
+	return nil
+}
+
+// postURL links to a post's page on the configured site
+func (s *ThemesService) postURL(slug string) string {
+	return strings.TrimRight(s.readingLists.SiteURL, "/") + "/posts/" + slug
+}
@@ -3,7 +3,9 @@ package application
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
+	"sort"
 	"time"
 
 	"backend/internal/platform/apperror"
@@ -47,13 +49,27 @@ var (
 		http.StatusBadRequest,
 	)
 
-	ErrThemeInactive = apperror.New(
+	ErrThemeArchived = apperror.New(
 		apperror.CodeConflict,
 		apperror.BusinessCodeInvalidStatusTransition,
-		"cannot modify an inactive theme",
+		"cannot modify the articles of an archived theme",
 		http.StatusConflict,
 	)
 
+	ErrInvalidStatusTransition = apperror.New(
+		apperror.CodeConflict,
+		apperror.BusinessCodeInvalidStatusTransition,
+		"theme cannot move to the requested status",
+		http.StatusConflict,
+	)
+
+	ErrHiddenThemesForbidden = apperror.New(
+		apperror.CodeForbidden,
+		apperror.BusinessCodePermissionDenied,
+		"drafts and archived themes can only be listed by their curator",
+		http.StatusForbidden,
+	)
+
 	ErrPostAlreadyInTheme = apperror.New(
 		apperror.CodeConflict,
 		apperror.BusinessCodePostAlreadyInTheme,
@@ -61,46 +77,94 @@ var (
 		http.StatusConflict,
 	)
 
+	ErrPostNotFound = apperror.New(
+		apperror.CodeNotFound,
+		apperror.BusinessCodePostNotFound,
+		"post not found",
+		http.StatusNotFound,
+	)
+
+	ErrBatchTooLarge = apperror.New(
+		apperror.CodeValidationFailed,
+		apperror.BusinessCodeValueTooLong,
+		fmt.Sprintf("at most %d articles can be added at once", MaxArticlesPerBatch),
+		http.StatusBadRequest,
+	)
+
 	ErrPostNotInTheme = apperror.New(
 		apperror.CodeNotFound,
 		apperror.BusinessCodePostNotInTheme,
 		"post not found in theme",
 		http.StatusNotFound,
 	)
+
+	ErrPinLimitReached = apperror.New(
+		apperror.CodeConflict,
+		apperror.BusinessCodePinLimitReached,
+		"theme already has the maximum number of pinned articles",
+		http.StatusConflict,
+	)
 )
 
+// MaxArticlesPerBatch bounds how many posts AddArticlesToTheme accepts in one call
+const MaxArticlesPerBatch = 50
+
+// BatchArticleResult is the outcome of adding one post in a batch
+type BatchArticleResult struct {
+	PostID   uuid.UUID
+	Position int   // The article's position when it was added
+	Err      error // Why the post was skipped; nil when it was added
+}
+
 // PostProvider is an interface to get post information
 // This avoids direct dependency on the posts bounded context
 type PostProvider interface {
 	GetPost(ctx context.Context, id uuid.UUID) (domain.PostInfo, error)
+	GetPosts(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]domain.PostInfo, error)
+	GetPostsBySlugs(ctx context.Context, slugs []string) (map[string]domain.PostInfo, error)
+}
+
+// QuotaChecker enforces how many themes a curator may hold
+// This avoids direct dependency on the quotas bounded context
+type QuotaChecker interface {
+	CheckThemeQuota(ctx context.Context, userID uuid.UUID) error
 }
 
 // ThemesService handles theme-related business logic
 type ThemesService struct {
-	txManager    postgres.TransactionManager // Transaction management interface from postgres package
+	uow          postgres.UnitOfWork // Transactions shared by the repositories
 	repo         ports.ThemeRepository
 	postProvider PostProvider
 	authorizer   ports.Authorizer // Using the port interface
 	eventBus     *eventbus.Bus
 	logger       logger.Logger
+	cache        *ThemeCache
+	quotas       QuotaChecker
+	readingLists ReadingListConfig
 }
 
 // NewThemesService creates a new themes service
 func NewThemesService(
-	txManager postgres.TransactionManager,
+	uow postgres.UnitOfWork,
 	repo ports.ThemeRepository,
 	postProvider PostProvider,
 	authorizer ports.Authorizer,
 	eventBus *eventbus.Bus,
 	logger logger.Logger,
+	cache *ThemeCache,
+	quotas QuotaChecker,
+	readingLists ReadingListConfig,
 ) *ThemesService {
 	return &ThemesService{
-		txManager:    txManager,
+		uow:          uow,
 		repo:         repo,
 		postProvider: postProvider,
 		authorizer:   authorizer,
 		eventBus:     eventBus,
 		logger:       logger,
+		cache:        cache,
+		quotas:       quotas,
+		readingLists: readingLists,
 	}
 }
 
@@ -108,6 +172,7 @@ func NewThemesService(
 type CreateThemeParams struct {
 	Name        string
 	Description string
+	Slug        string // Optional; derived from the name when empty
 }
 
 // CreateTheme creates a new theme
@@ -131,6 +196,10 @@ func (s *ThemesService) CreateTheme(ctx context.Context, actorID uuid.UUID, para
 			http.StatusForbidden,
 		)
 	}
+	if err := s.quotas.CheckThemeQuota(ctx, actorID); err != nil {
+		return nil, err
+	}
+
 	// Create the theme domain object (it will generate its own slug)
 	// The actor becomes the curator
 	theme, err := domain.NewTheme(params.Name, params.Description, actorID)
@@ -138,8 +207,17 @@ func (s *ThemesService) CreateTheme(ctx context.Context, actorID uuid.UUID, para
 		return nil, ErrInvalidThemeData.WithDetails(err.Error())
 	}
 
+	// An explicit slug replaces the one derived from the name
+	baseSlug := theme.Slug
+	if params.Slug != "" {
+		if err := validator.ValidateSlugFormat(params.Slug, domain.MaxSlugLength); err != nil {
+			return nil, ErrInvalidThemeData.WithDetails(err.Error())
+		}
+		baseSlug = params.Slug
+	}
+
 	// Ensure slug uniqueness
-	uniqueSlug, err := s.ensureUniqueSlug(ctx, theme.Slug, nil)
+	uniqueSlug, err := s.ensureUniqueSlug(ctx, baseSlug, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -168,14 +246,104 @@ func (s *ThemesService) CreateTheme(ctx context.Context, actorID uuid.UUID, para
 	return theme, nil
 }
 
+// CloneTheme copies a theme and all of its articles into a new theme curated by the actor
+// The copy and its articles are written in a single transaction
+func (s *ThemesService) CloneTheme(ctx context.Context, actorID uuid.UUID, sourceID uuid.UUID) (*domain.Theme, error) {
+	// Cloning creates a theme, so it needs the same permission as creating one
+	canCreate, err := s.authorizer.Can(ctx, actorID, "themes", "create", nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canCreate {
+		return nil, apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to create themes",
+			http.StatusForbidden,
+		)
+	}
+
+	source, err := s.repo.LoadThemeWithArticles(ctx, sourceID)
+	if err != nil {
+		if errors.Is(err, ports.ErrThemeNotFound) {
+			return nil, ErrThemeNotFound
+		}
+		s.logger.Error(ctx, "failed to load theme", "error", err, "themeID", sourceID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to load theme",
+			http.StatusInternalServerError,
+		)
+	}
+
+	clone, err := source.Clone(actorID)
+	if err != nil {
+		return nil, ErrInvalidThemeData.WithDetails(err.Error())
+	}
+
+	uniqueSlug, err := s.ensureUniqueSlug(ctx, clone.Slug, nil)
+	if err != nil {
+		return nil, err
+	}
+	if uniqueSlug != clone.Slug {
+		if err := clone.UpdateSlug(uniqueSlug); err != nil {
+			return nil, ErrInvalidThemeData.WithDetails(err.Error())
+		}
+	}
+
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.CreateWithArticles(ctx, clone)
+	})
+	if err != nil {
+		s.logger.Error(ctx, "failed to clone theme", "error", err, "themeID", sourceID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to clone theme",
+			http.StatusInternalServerError,
+		)
+	}
+
+	s.logger.Info(ctx, "theme cloned", "sourceID", sourceID, "themeID", clone.ID, "articles", len(clone.Articles), "actorID", actorID)
+
+	// Publish event
+	s.publishThemeCreatedEvent(ctx, clone, actorID)
+
+	return clone, nil
+}
+
 // UpdateThemeParams contains parameters for updating a theme
 type UpdateThemeParams struct {
 	Name        string
 	Description string
+	Slug        *string // Optional; nil derives a new slug only when the name changes
 }
 
-// UpdateTheme updates an existing theme's details
+// UpdateTheme replaces an existing theme's details
 func (s *ThemesService) UpdateTheme(ctx context.Context, actorID uuid.UUID, id uuid.UUID, params UpdateThemeParams) (*domain.Theme, error) {
+	return s.PatchTheme(ctx, actorID, id, PatchThemeParams{
+		Name:        &params.Name,
+		Description: &params.Description,
+		Slug:        params.Slug,
+	})
+}
+
+// PatchThemeParams contains a partial update of a theme; nil fields keep their current value
+type PatchThemeParams struct {
+	Name        *string
+	Description *string
+	Slug        *string // Nil derives a new slug only when the name changes
+}
+
+// PatchTheme changes only the theme details present in params
+func (s *ThemesService) PatchTheme(ctx context.Context, actorID uuid.UUID, id uuid.UUID, params PatchThemeParams) (*domain.Theme, error) {
 	// Check authorization - user must be able to update this specific theme
 	canUpdate, err := s.authorizer.Can(ctx, actorID, "themes", "update", &id)
 	if err != nil {
@@ -201,13 +369,31 @@ func (s *ThemesService) UpdateTheme(ctx context.Context, actorID uuid.UUID, id u
 		return nil, err
 	}
 
-	// Update the theme details
-	if err := theme.Update(params.Name, params.Description); err != nil {
-		return nil, ErrInvalidThemeData.WithDetails(err.Error())
+	// Update the theme details, keeping the ones the patch leaves out
+	nameChanged := params.Name != nil && *params.Name != theme.Name
+	if params.Name != nil || params.Description != nil {
+		name, description := theme.Name, theme.Description
+		if params.Name != nil {
+			name = *params.Name
+		}
+		if params.Description != nil {
+			description = *params.Description
+		}
+		if err := theme.Update(name, description); err != nil {
+			return nil, ErrInvalidThemeData.WithDetails(err.Error())
+		}
 	}
 
-	// Check if name changed and we need a new slug
-	newSlug := validator.GenerateSlug(params.Name, domain.MaxSlugLength)
+	// An explicit slug wins; otherwise only a renamed theme gets a new slug
+	newSlug := theme.Slug
+	if params.Slug != nil {
+		if err := validator.ValidateSlugFormat(*params.Slug, domain.MaxSlugLength); err != nil {
+			return nil, ErrInvalidThemeData.WithDetails(err.Error())
+		}
+		newSlug = *params.Slug
+	} else if nameChanged {
+		newSlug = validator.GenerateSlug(theme.Name, domain.MaxSlugLength)
+	}
 	if newSlug != theme.Slug {
 		uniqueSlug, err := s.ensureUniqueSlug(ctx, newSlug, &id)
 		if err != nil {
@@ -218,15 +404,9 @@ func (s *ThemesService) UpdateTheme(ctx context.Context, actorID uuid.UUID, id u
 		}
 	}
 
-	// Save to repository (no transaction needed - only updating theme, not articles)
-	if err := s.repo.Save(ctx, theme); err != nil {
-		s.logger.Error(ctx, "failed to update theme", "error", err, "themeID", id)
-		return nil, apperror.New(
-			apperror.CodeInternalError,
-			apperror.BusinessCodeGeneral,
-			"failed to update theme",
-			http.StatusInternalServerError,
-		)
+	// Save in a transaction so a slug change and its history entry land together
+	if err := s.saveThemeWithTransaction(ctx, theme); err != nil {
+		return nil, err
 	}
 
 	// Publish event
@@ -237,8 +417,8 @@ func (s *ThemesService) UpdateTheme(ctx context.Context, actorID uuid.UUID, id u
 
 // AddArticleToTheme adds a post to a theme
 func (s *ThemesService) AddArticleToTheme(ctx context.Context, actorID uuid.UUID, themeID, postID uuid.UUID) error {
-	// Check authorization - user must be able to update this specific theme
-	canUpdate, err := s.authorizer.Can(ctx, actorID, "themes", "update", &themeID)
+	// Check authorization - user must be able to curate the articles of this specific theme
+	canCurate, err := s.authorizer.Can(ctx, actorID, "themes", "curate", &themeID)
 	if err != nil {
 		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID, "themeID", themeID)
 		return apperror.New(
@@ -248,11 +428,11 @@ func (s *ThemesService) AddArticleToTheme(ctx context.Context, actorID uuid.UUID
 			http.StatusInternalServerError,
 		)
 	}
-	if !canUpdate {
+	if !canCurate {
 		return apperror.New(
 			apperror.CodeForbidden,
 			apperror.BusinessCodePermissionDenied,
-			"not authorized to update this theme",
+			"not authorized to curate the articles of this theme",
 			http.StatusForbidden,
 		)
 	}
@@ -283,8 +463,8 @@ func (s *ThemesService) AddArticleToTheme(ctx context.Context, actorID uuid.UUID
 		switch {
 		case errors.Is(err, domain.ErrPostNotPublished):
 			return ErrPostNotPublished
-		case errors.Is(err, domain.ErrThemeInactive):
-			return ErrThemeInactive
+		case errors.Is(err, domain.ErrThemeArchived):
+			return ErrThemeArchived
 		case errors.Is(err, domain.ErrDuplicateArticle):
 			return ErrPostAlreadyInTheme
 		default:
@@ -312,10 +492,115 @@ func (s *ThemesService) AddArticleToTheme(ctx context.Context, actorID uuid.UUID
 	return nil
 }
 
+// AddArticlesToTheme adds several posts to a theme in one save
+// The theme and the posts are loaded once; posts that cannot be added are skipped
+// and reported in their result, while the rest are saved together.
+func (s *ThemesService) AddArticlesToTheme(ctx context.Context, actorID uuid.UUID, themeID uuid.UUID, postIDs []uuid.UUID) ([]BatchArticleResult, error) {
+	if len(postIDs) > MaxArticlesPerBatch {
+		return nil, ErrBatchTooLarge
+	}
+
+	// Check authorization - user must be able to curate the articles of this specific theme
+	canCurate, err := s.authorizer.Can(ctx, actorID, "themes", "curate", &themeID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID, "themeID", themeID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canCurate {
+		return nil, apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to curate the articles of this theme",
+			http.StatusForbidden,
+		)
+	}
+	// Load the full aggregate with articles
+	theme, err := s.repo.LoadThemeWithArticles(ctx, themeID)
+	if err != nil {
+		if errors.Is(err, ports.ErrThemeNotFound) {
+			return nil, ErrThemeNotFound
+		}
+		s.logger.Error(ctx, "failed to load theme", "error", err, "themeID", themeID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to load theme",
+			http.StatusInternalServerError,
+		)
+	}
+	if theme.Status == domain.ThemeStatusArchived {
+		return nil, ErrThemeArchived
+	}
+
+	// Get the information of every post in one call
+	posts, err := s.postProvider.GetPosts(ctx, postIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add each article using domain logic, recording why any were skipped
+	results := make([]BatchArticleResult, len(postIDs))
+	added := 0
+	for i, postID := range postIDs {
+		results[i].PostID = postID
+
+		post, ok := posts[postID]
+		if !ok {
+			results[i].Err = ErrPostNotFound
+			continue
+		}
+
+		if err := theme.AddArticle(post, actorID); err != nil {
+			switch {
+			case errors.Is(err, domain.ErrPostNotPublished):
+				results[i].Err = ErrPostNotPublished
+			case errors.Is(err, domain.ErrDuplicateArticle):
+				results[i].Err = ErrPostAlreadyInTheme
+			default:
+				results[i].Err = ErrInvalidThemeData.WithDetails(err.Error())
+			}
+			continue
+		}
+
+		article, _ := theme.GetArticle(postID)
+		results[i].Position = article.Position
+		added++
+	}
+
+	if added == 0 {
+		return results, nil
+	}
+
+	// Save the entire aggregate atomically within a transaction
+	if err := s.saveThemeWithTransaction(ctx, theme); err != nil {
+		s.logger.Error(ctx, "failed to save theme", "error", err, "themeID", themeID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to add articles to theme",
+			http.StatusInternalServerError,
+		)
+	}
+
+	// Publish an event for every article that was added
+	for _, result := range results {
+		if result.Err == nil {
+			s.publishThemeArticleAddedEvent(ctx, themeID, result.PostID, result.Position, actorID)
+		}
+	}
+
+	return results, nil
+}
+
 // RemoveArticleFromTheme removes a post from a theme
 func (s *ThemesService) RemoveArticleFromTheme(ctx context.Context, actorID uuid.UUID, themeID, postID uuid.UUID) error {
-	// Check authorization - user must be able to update this specific theme
-	canUpdate, err := s.authorizer.Can(ctx, actorID, "themes", "update", &themeID)
+	// Check authorization - user must be able to curate the articles of this specific theme
+	canCurate, err := s.authorizer.Can(ctx, actorID, "themes", "curate", &themeID)
 	if err != nil {
 		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID, "themeID", themeID)
 		return apperror.New(
@@ -325,11 +610,11 @@ func (s *ThemesService) RemoveArticleFromTheme(ctx context.Context, actorID uuid
 			http.StatusInternalServerError,
 		)
 	}
-	if !canUpdate {
+	if !canCurate {
 		return apperror.New(
 			apperror.CodeForbidden,
 			apperror.BusinessCodePermissionDenied,
-			"not authorized to update this theme",
+			"not authorized to curate the articles of this theme",
 			http.StatusForbidden,
 		)
 	}
@@ -352,8 +637,8 @@ func (s *ThemesService) RemoveArticleFromTheme(ctx context.Context, actorID uuid
 	if err := theme.RemoveArticle(postID); err != nil {
 		// Map domain errors to service errors
 		switch {
-		case errors.Is(err, domain.ErrThemeInactive):
-			return ErrThemeInactive
+		case errors.Is(err, domain.ErrThemeArchived):
+			return ErrThemeArchived
 		case errors.Is(err, domain.ErrArticleNotFound):
 			return ErrPostNotInTheme
 		default:
@@ -380,8 +665,8 @@ func (s *ThemesService) RemoveArticleFromTheme(ctx context.Context, actorID uuid
 
 // ReorderThemeArticles changes the order of articles in a theme
 func (s *ThemesService) ReorderThemeArticles(ctx context.Context, actorID uuid.UUID, themeID uuid.UUID, orderedPostIDs []uuid.UUID) error {
-	// Check authorization - user must be able to update this specific theme
-	canUpdate, err := s.authorizer.Can(ctx, actorID, "themes", "update", &themeID)
+	// Check authorization - user must be able to curate the articles of this specific theme
+	canCurate, err := s.authorizer.Can(ctx, actorID, "themes", "curate", &themeID)
 	if err != nil {
 		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID, "themeID", themeID)
 		return apperror.New(
@@ -391,11 +676,11 @@ func (s *ThemesService) ReorderThemeArticles(ctx context.Context, actorID uuid.U
 			http.StatusInternalServerError,
 		)
 	}
-	if !canUpdate {
+	if !canCurate {
 		return apperror.New(
 			apperror.CodeForbidden,
 			apperror.BusinessCodePermissionDenied,
-			"not authorized to update this theme",
+			"not authorized to curate the articles of this theme",
 			http.StatusForbidden,
 		)
 	}
@@ -418,8 +703,8 @@ func (s *ThemesService) ReorderThemeArticles(ctx context.Context, actorID uuid.U
 	if err := theme.ReorderArticles(orderedPostIDs); err != nil {
 		// Map domain errors to service errors
 		switch {
-		case errors.Is(err, domain.ErrThemeInactive):
-			return ErrThemeInactive
+		case errors.Is(err, domain.ErrThemeArchived):
+			return ErrThemeArchived
 		case errors.Is(err, domain.ErrInvalidArticleCount):
 			return apperror.New(
 				apperror.CodeValidationFailed,
@@ -451,12 +736,83 @@ func (s *ThemesService) ReorderThemeArticles(ctx context.Context, actorID uuid.U
 	return nil
 }
 
-// ActivateTheme activates an inactive theme
-func (s *ThemesService) ActivateTheme(ctx context.Context, actorID uuid.UUID, id uuid.UUID) error {
-	// Check authorization - user must be able to update this specific theme
-	canUpdate, err := s.authorizer.Can(ctx, actorID, "themes", "update", &id)
+// RepairPositions renumbers a theme's article positions to 1..n and reports the
+// articles that moved. It is an administrative fix for positions left duplicated or
+// gapped by edits made outside the application, so it needs settings:system rather
+// than theme ownership and applies to inactive themes too.
+func (s *ThemesService) RepairPositions(ctx context.Context, actorID uuid.UUID, themeID uuid.UUID) ([]domain.PositionChange, error) {
+	canRepair, err := s.authorizer.Can(ctx, actorID, "settings", "system", nil)
 	if err != nil {
-		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID, "themeID", id)
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID, "themeID", themeID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canRepair {
+		return nil, apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to repair themes",
+			http.StatusForbidden,
+		)
+	}
+
+	// Read and rewrite the positions under the theme's lock, so an article added
+	// meanwhile is neither dropped nor left out of the renumbering
+	var (
+		theme   *domain.Theme
+		changes []domain.PositionChange
+	)
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+		if theme, err = s.repo.LockThemeWithArticles(ctx, themeID); err != nil {
+			return err
+		}
+		if changes = theme.RepairPositions(); len(changes) == 0 {
+			return nil
+		}
+		return s.repo.Save(ctx, theme)
+	})
+	if err != nil {
+		if errors.Is(err, ports.ErrThemeNotFound) {
+			return nil, ErrThemeNotFound
+		}
+		s.logger.Error(ctx, "failed to repair theme positions", "error", err, "themeID", themeID)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to repair theme positions",
+			http.StatusInternalServerError,
+		)
+	}
+	if len(changes) == 0 {
+		return changes, nil
+	}
+
+	s.logger.Info(ctx, "theme positions repaired", "themeID", themeID, "moved", len(changes), "actorID", actorID)
+
+	// Announce the corrected order like any other reorder
+	ordered := make([]*domain.ThemeArticle, len(theme.Articles))
+	copy(ordered, theme.Articles)
+	sort.Slice(ordered, func(i, j int) bool { return ordered[i].Position < ordered[j].Position })
+	orderedPostIDs := make([]uuid.UUID, len(ordered))
+	for i, article := range ordered {
+		orderedPostIDs[i] = article.PostID
+	}
+	s.publishThemeArticlesReorderedEvent(ctx, themeID, orderedPostIDs, actorID)
+
+	return changes, nil
+}
+
+// PinThemeArticle pins an article to the top of a theme
+func (s *ThemesService) PinThemeArticle(ctx context.Context, actorID uuid.UUID, themeID, postID uuid.UUID) error {
+	// Check authorization - user must be able to curate the articles of this specific theme
+	canCurate, err := s.authorizer.Can(ctx, actorID, "themes", "curate", &themeID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID, "themeID", themeID)
 		return apperror.New(
 			apperror.CodeInternalError,
 			apperror.BusinessCodeGeneral,
@@ -464,39 +820,151 @@ func (s *ThemesService) ActivateTheme(ctx context.Context, actorID uuid.UUID, id
 			http.StatusInternalServerError,
 		)
 	}
-	if !canUpdate {
+	if !canCurate {
 		return apperror.New(
 			apperror.CodeForbidden,
 			apperror.BusinessCodePermissionDenied,
-			"not authorized to update this theme",
+			"not authorized to curate the articles of this theme",
 			http.StatusForbidden,
 		)
 	}
-	theme, err := s.getThemeByID(ctx, id)
+	// Pin and save under the theme's lock, so concurrent pins cannot both pass
+	// the pin limit and edits made meanwhile are not overwritten
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		theme, err := s.repo.LockThemeWithArticles(ctx, themeID)
+		if err != nil {
+			return err
+		}
+		if err := theme.PinArticle(postID); err != nil {
+			// Map domain errors to service errors
+			switch {
+			case errors.Is(err, domain.ErrThemeArchived):
+				return ErrThemeArchived
+			case errors.Is(err, domain.ErrArticleNotFound):
+				return ErrPostNotInTheme
+			case errors.Is(err, domain.ErrPinLimitReached):
+				return ErrPinLimitReached
+			default:
+				return ErrInvalidThemeData.WithDetails(err.Error())
+			}
+		}
+		return s.repo.Save(ctx, theme)
+	})
 	if err != nil {
-		return err
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return err
+		}
+		if errors.Is(err, ports.ErrThemeNotFound) {
+			return ErrThemeNotFound
+		}
+		s.logger.Error(ctx, "failed to pin theme article", "error", err, "themeID", themeID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to pin theme article",
+			http.StatusInternalServerError,
+		)
 	}
 
-	theme.Activate()
+	// Publish event
+	s.publishThemeArticlePinnedEvent(ctx, themeID, postID, actorID)
 
-	if err := s.repo.Save(ctx, theme); err != nil {
-		s.logger.Error(ctx, "failed to activate theme", "error", err, "themeID", id)
+	return nil
+}
+
+// UnpinThemeArticle removes the pin from an article in a theme
+func (s *ThemesService) UnpinThemeArticle(ctx context.Context, actorID uuid.UUID, themeID, postID uuid.UUID) error {
+	// Check authorization - user must be able to curate the articles of this specific theme
+	canCurate, err := s.authorizer.Can(ctx, actorID, "themes", "curate", &themeID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID, "themeID", themeID)
 		return apperror.New(
 			apperror.CodeInternalError,
 			apperror.BusinessCodeGeneral,
-			"failed to activate theme",
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canCurate {
+		return apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to curate the articles of this theme",
+			http.StatusForbidden,
+		)
+	}
+	// Unpin and save under the theme's lock, so edits made meanwhile are not overwritten
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		theme, err := s.repo.LockThemeWithArticles(ctx, themeID)
+		if err != nil {
+			return err
+		}
+		if err := theme.UnpinArticle(postID); err != nil {
+			// Map domain errors to service errors
+			switch {
+			case errors.Is(err, domain.ErrThemeArchived):
+				return ErrThemeArchived
+			case errors.Is(err, domain.ErrArticleNotFound):
+				return ErrPostNotInTheme
+			default:
+				return ErrInvalidThemeData.WithDetails(err.Error())
+			}
+		}
+		return s.repo.Save(ctx, theme)
+	})
+	if err != nil {
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return err
+		}
+		if errors.Is(err, ports.ErrThemeNotFound) {
+			return ErrThemeNotFound
+		}
+		s.logger.Error(ctx, "failed to unpin theme article", "error", err, "themeID", themeID)
+		return apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to unpin theme article",
 			http.StatusInternalServerError,
 		)
 	}
 
 	// Publish event
-	s.publishThemeActivatedEvent(ctx, theme, actorID)
+	s.publishThemeArticleUnpinnedEvent(ctx, themeID, postID, actorID)
 
 	return nil
 }
 
-// DeactivateTheme deactivates an active theme
+// ActivateTheme lists a draft theme publicly
+func (s *ThemesService) ActivateTheme(ctx context.Context, actorID uuid.UUID, id uuid.UUID) error {
+	return s.changeThemeStatus(ctx, actorID, id, "activate", (*domain.Theme).Activate, s.publishThemeActivatedEvent)
+}
+
+// DeactivateTheme takes an active theme back to draft
 func (s *ThemesService) DeactivateTheme(ctx context.Context, actorID uuid.UUID, id uuid.UUID) error {
+	return s.changeThemeStatus(ctx, actorID, id, "deactivate", (*domain.Theme).Deactivate, s.publishThemeDeactivatedEvent)
+}
+
+// ArchiveTheme retires a theme: it leaves every listing and its articles are frozen
+func (s *ThemesService) ArchiveTheme(ctx context.Context, actorID uuid.UUID, id uuid.UUID) error {
+	return s.changeThemeStatus(ctx, actorID, id, "archive", (*domain.Theme).Archive, s.publishThemeArchivedEvent)
+}
+
+// RestoreTheme brings an archived theme back as a draft
+func (s *ThemesService) RestoreTheme(ctx context.Context, actorID uuid.UUID, id uuid.UUID) error {
+	return s.changeThemeStatus(ctx, actorID, id, "restore", (*domain.Theme).Restore, s.publishThemeRestoredEvent)
+}
+
+// changeThemeStatus applies a lifecycle transition to a theme the actor may update
+func (s *ThemesService) changeThemeStatus(
+	ctx context.Context,
+	actorID uuid.UUID,
+	id uuid.UUID,
+	action string,
+	transition func(*domain.Theme) error,
+	publish func(context.Context, *domain.Theme, uuid.UUID),
+) error {
 	// Check authorization - user must be able to update this specific theme
 	canUpdate, err := s.authorizer.Can(ctx, actorID, "themes", "update", &id)
 	if err != nil {
@@ -521,25 +989,30 @@ func (s *ThemesService) DeactivateTheme(ctx context.Context, actorID uuid.UUID,
 		return err
 	}
 
-	theme.Deactivate()
+	if err := transition(theme); err != nil {
+		if errors.Is(err, domain.ErrInvalidTransition) {
+			return ErrInvalidStatusTransition
+		}
+		return err
+	}
 
 	if err := s.repo.Save(ctx, theme); err != nil {
-		s.logger.Error(ctx, "failed to deactivate theme", "error", err, "themeID", id)
+		s.logger.Error(ctx, "failed to "+action+" theme", "error", err, "themeID", id)
 		return apperror.New(
 			apperror.CodeInternalError,
 			apperror.BusinessCodeGeneral,
-			"failed to deactivate theme",
+			"failed to "+action+" theme",
 			http.StatusInternalServerError,
 		)
 	}
 
 	// Publish event
-	s.publishThemeDeactivatedEvent(ctx, theme, actorID)
+	publish(ctx, theme, actorID)
 
 	return nil
 }
 
-// DeleteTheme removes a theme from the system
+// DeleteTheme moves a theme to the trash, from which RestoreDeletedTheme brings it back
 func (s *ThemesService) DeleteTheme(ctx context.Context, actorID uuid.UUID, id uuid.UUID) error {
 	// Check authorization - user must be able to delete this specific theme
 	canDelete, err := s.authorizer.Can(ctx, actorID, "themes", "delete", &id)
@@ -561,7 +1034,7 @@ func (s *ThemesService) DeleteTheme(ctx context.Context, actorID uuid.UUID, id u
 		)
 	}
 	// Check if theme exists
-	_, err = s.getThemeByID(ctx, id)
+	theme, err := s.getThemeByID(ctx, id)
 	if err != nil {
 		return err
 	}
@@ -578,19 +1051,154 @@ func (s *ThemesService) DeleteTheme(ctx context.Context, actorID uuid.UUID, id u
 	}
 
 	// Publish event
-	s.publishThemeDeletedEvent(ctx, id, actorID)
+	s.publishThemeDeletedEvent(ctx, theme, actorID)
 
 	return nil
 }
 
+// ListDeletedThemes lists the themes in the trash, most recently deleted first.
+// Curators see their own; actors who may delete any theme see the whole blog's.
+func (s *ThemesService) ListDeletedThemes(ctx context.Context, actorID uuid.UUID, limit, offset int) ([]*ports.ThemeSummary, int, error) {
+	canDeleteAny, err := s.authorizer.Can(ctx, actorID, "themes", "delete:any", nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID)
+		return nil, 0, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+
+	filter := ports.ListFilter{
+		Deleted:   true,
+		Limit:     limit,
+		Offset:    offset,
+		OrderBy:   ports.OrderByDeletedAt,
+		OrderDesc: true,
+	}
+	if !canDeleteAny {
+		filter.CuratorID = &actorID
+	}
+
+	summaries, err := s.repo.ListThemes(ctx, filter)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list deleted themes", "error", err)
+		return nil, 0, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to list deleted themes",
+			http.StatusInternalServerError,
+		)
+	}
+
+	count, err := s.repo.CountThemes(ctx, filter)
+	if err != nil {
+		s.logger.Error(ctx, "failed to count deleted themes", "error", err)
+		return nil, 0, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to count deleted themes",
+			http.StatusInternalServerError,
+		)
+	}
+
+	return summaries, count, nil
+}
+
+// RestoreDeletedTheme takes a theme out of the trash with its articles and
+// collaborators, in the status it was deleted in. A live theme that took its
+// slug in the meantime keeps it, and the restored theme gets a numbered one.
+func (s *ThemesService) RestoreDeletedTheme(ctx context.Context, actorID uuid.UUID, id uuid.UUID) (*domain.Theme, error) {
+	// Restoring undoes a deletion, so it takes the same permission
+	canDelete, err := s.authorizer.Can(ctx, actorID, "themes", "delete", &id)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check authorization", "error", err, "actorID", actorID, "themeID", id)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"authorization check failed",
+			http.StatusInternalServerError,
+		)
+	}
+	if !canDelete {
+		return nil, apperror.New(
+			apperror.CodeForbidden,
+			apperror.BusinessCodePermissionDenied,
+			"not authorized to restore this theme",
+			http.StatusForbidden,
+		)
+	}
+
+	theme, err := s.repo.FindDeletedByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, ports.ErrThemeNotFound) {
+			return nil, ErrThemeNotFound
+		}
+		s.logger.Error(ctx, "failed to find deleted theme", "error", err, "themeID", id)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to retrieve theme",
+			http.StatusInternalServerError,
+		)
+	}
+
+	// Themes in the trash do not count against the quota, archived ones never do
+	if theme.Status != domain.ThemeStatusArchived {
+		if err := s.quotas.CheckThemeQuota(ctx, theme.CuratorID); err != nil {
+			return nil, err
+		}
+	}
+
+	slug, err := s.ensureUniqueSlug(ctx, theme.Slug, &theme.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Restore(ctx, id, slug); err != nil {
+		if errors.Is(err, ports.ErrThemeNotFound) {
+			return nil, ErrThemeNotFound
+		}
+		if errors.Is(err, ports.ErrThemeSlugExists) {
+			return nil, ErrSlugAlreadyExists
+		}
+		s.logger.Error(ctx, "failed to restore theme", "error", err, "themeID", id)
+		return nil, apperror.New(
+			apperror.CodeInternalError,
+			apperror.BusinessCodeGeneral,
+			"failed to restore theme",
+			http.StatusInternalServerError,
+		)
+	}
+
+	// Read it back for the slug and update time it now has
+	restored, err := s.getThemeByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishThemeRecoveredEvent(ctx, restored, actorID)
+
+	return restored, nil
+}
+
 // GetTheme retrieves a theme by ID (without articles)
 func (s *ThemesService) GetTheme(ctx context.Context, id uuid.UUID) (*domain.Theme, error) {
 	return s.getThemeByID(ctx, id)
 }
 
 // GetThemeBySlug retrieves a theme by its slug (without articles)
+// Slugs from before a rename still resolve to the theme, which carries its current slug
 func (s *ThemesService) GetThemeBySlug(ctx context.Context, slug string) (*domain.Theme, error) {
+	if theme, ok := s.cache.GetBySlug(ctx, slug); ok {
+		return theme, nil
+	}
+
 	theme, err := s.repo.FindBySlug(ctx, slug)
+	if errors.Is(err, ports.ErrThemeNotFound) {
+		theme, err = s.repo.FindByPreviousSlug(ctx, slug)
+	}
 	if err != nil {
 		if errors.Is(err, ports.ErrThemeNotFound) {
 			return nil, ErrThemeNotFound
@@ -603,6 +1211,8 @@ func (s *ThemesService) GetThemeBySlug(ctx context.Context, slug string) (*domai
 			http.StatusInternalServerError,
 		)
 	}
+
+	s.cache.SetBySlug(ctx, slug, theme)
 	return theme, nil
 }
 
@@ -625,7 +1235,23 @@ func (s *ThemesService) GetThemeWithArticles(ctx context.Context, id uuid.UUID)
 }
 
 // ListThemes retrieves a list of theme summaries
+// The public listing of active themes is served from the cache when possible.
+// Listings that include drafts or archived themes are limited to the viewer's own.
 func (s *ThemesService) ListThemes(ctx context.Context, filter ports.ListFilter) ([]*ports.ThemeSummary, int, error) {
+	if !onlyActive(filter) {
+		if filter.ViewerID == nil || (filter.CuratorID != nil && *filter.CuratorID != *filter.ViewerID) {
+			return nil, 0, ErrHiddenThemesForbidden
+		}
+		filter.CuratorID = filter.ViewerID
+	}
+
+	cacheable := isActiveListing(filter)
+	if cacheable {
+		if summaries, count, ok := s.cache.GetActiveList(ctx, filter.Limit, filter.Offset); ok {
+			return summaries, count, nil
+		}
+	}
+
 	summaries, err := s.repo.ListThemes(ctx, filter)
 	if err != nil {
 		s.logger.Error(ctx, "failed to list themes", "error", err)
@@ -648,11 +1274,37 @@ func (s *ThemesService) ListThemes(ctx context.Context, filter ports.ListFilter)
 		)
 	}
 
+	if cacheable {
+		s.cache.SetActiveList(ctx, filter.Limit, filter.Offset, summaries, count)
+	}
 	return summaries, count, nil
 }
 
 // Private helper methods
 
+// isActiveListing reports whether filter selects the whole public listing of active themes in its default order
+func isActiveListing(filter ports.ListFilter) bool {
+	return filter.CuratorID == nil && filter.SearchQuery == "" && onlyActive(filter) && newestFirst(filter)
+}
+
+// newestFirst reports whether filter keeps the default order of the listing
+func newestFirst(filter ports.ListFilter) bool {
+	return filter.OrderBy == "" || (filter.OrderBy == ports.OrderByCreatedAt && filter.OrderDesc)
+}
+
+// onlyActive reports whether filter selects active themes and nothing else
+func onlyActive(filter ports.ListFilter) bool {
+	if len(filter.Statuses) == 0 {
+		return false
+	}
+	for _, status := range filter.Statuses {
+		if status != domain.ThemeStatusActive {
+			return false
+		}
+	}
+	return true
+}
+
 // getThemeByID fetches a theme and handles not-found errors consistently
 func (s *ThemesService) getThemeByID(ctx context.Context, id uuid.UUID) (*domain.Theme, error) {
 	theme, err := s.repo.FindByID(ctx, id)
@@ -671,44 +1323,12 @@ func (s *ThemesService) getThemeByID(ctx context.Context, id uuid.UUID) (*domain
 	return theme, nil
 }
 
-// saveThemeWithTransaction saves a theme within a transaction
+// saveThemeWithTransaction saves a theme within a unit of work
 // This is used when saving a theme with articles to ensure atomicity
 func (s *ThemesService) saveThemeWithTransaction(ctx context.Context, theme *domain.Theme) error {
-	// Begin transaction
-	tx, err := s.txManager.BeginTx(ctx)
-	if err != nil {
-		s.logger.Error(ctx, "failed to begin transaction", "error", err, "themeID", theme.ID)
-		return apperror.New(
-			apperror.CodeInternalError,
-			apperror.BusinessCodeGeneral,
-			"failed to begin transaction",
-			http.StatusInternalServerError,
-		)
-	}
-	defer func() { _ = tx.Rollback(ctx) }()
-
-	// Get a transactional repository
-	txRepo := s.repo.WithTx(tx.Tx())
-
-	// Save the theme using the transactional repository
-	if err := txRepo.Save(ctx, theme); err != nil {
-		// The error is already logged at repository level
-		// Just return it as-is (repository errors should already be apperror types)
-		return err
-	}
-
-	// Commit the transaction
-	if err := tx.Commit(ctx); err != nil {
-		s.logger.Error(ctx, "failed to commit transaction", "error", err, "themeID", theme.ID)
-		return apperror.New(
-			apperror.CodeInternalError,
-			apperror.BusinessCodeGeneral,
-			"failed to commit transaction",
-			http.StatusInternalServerError,
-		)
-	}
-
-	return nil
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.Save(ctx, theme)
+	})
 }
 
 // ensureUniqueSlug ensures a slug is unique, potentially adding a numeric suffix
@@ -765,6 +1385,7 @@ func (s *ThemesService) publishThemeUpdatedEvent(ctx context.Context, theme *dom
 		Payload: events.ThemeUpdatedEvent{
 			ThemeID:    theme.ID,
 			ActorID:    actorID,
+			CuratorID:  theme.CuratorID,
 			Name:       theme.Name,
 			Slug:       theme.Slug,
 			OccurredAt: time.Now(),
@@ -779,6 +1400,7 @@ func (s *ThemesService) publishThemeActivatedEvent(ctx context.Context, theme *d
 		Payload: events.ThemeActivatedEvent{
 			ThemeID:    theme.ID,
 			ActorID:    actorID,
+			CuratorID:  theme.CuratorID,
 			OccurredAt: time.Now(),
 		},
 	}
@@ -791,18 +1413,59 @@ func (s *ThemesService) publishThemeDeactivatedEvent(ctx context.Context, theme
 		Payload: events.ThemeDeactivatedEvent{
 			ThemeID:    theme.ID,
 			ActorID:    actorID,
+			CuratorID:  theme.CuratorID,
+			OccurredAt: time.Now(),
+		},
+	}
+	s.eventBus.Publish(ctx, event)
+}
+
+func (s *ThemesService) publishThemeArchivedEvent(ctx context.Context, theme *domain.Theme, actorID uuid.UUID) {
+	event := eventbus.Event{
+		Topic: events.ThemeArchivedTopic,
+		Payload: events.ThemeArchivedEvent{
+			ThemeID:    theme.ID,
+			ActorID:    actorID,
+			CuratorID:  theme.CuratorID,
+			OccurredAt: time.Now(),
+		},
+	}
+	s.eventBus.Publish(ctx, event)
+}
+
+func (s *ThemesService) publishThemeRestoredEvent(ctx context.Context, theme *domain.Theme, actorID uuid.UUID) {
+	event := eventbus.Event{
+		Topic: events.ThemeRestoredTopic,
+		Payload: events.ThemeRestoredEvent{
+			ThemeID:    theme.ID,
+			ActorID:    actorID,
+			CuratorID:  theme.CuratorID,
 			OccurredAt: time.Now(),
 		},
 	}
 	s.eventBus.Publish(ctx, event)
 }
 
-func (s *ThemesService) publishThemeDeletedEvent(ctx context.Context, themeID uuid.UUID, actorID uuid.UUID) {
+func (s *ThemesService) publishThemeDeletedEvent(ctx context.Context, theme *domain.Theme, actorID uuid.UUID) {
 	event := eventbus.Event{
 		Topic: events.ThemeDeletedTopic,
 		Payload: events.ThemeDeletedEvent{
-			ThemeID:    themeID,
+			ThemeID:    theme.ID,
+			ActorID:    actorID,
+			CuratorID:  theme.CuratorID,
+			OccurredAt: time.Now(),
+		},
+	}
+	s.eventBus.Publish(ctx, event)
+}
+
+func (s *ThemesService) publishThemeRecoveredEvent(ctx context.Context, theme *domain.Theme, actorID uuid.UUID) {
+	event := eventbus.Event{
+		Topic: events.ThemeRecoveredTopic,
+		Payload: events.ThemeRecoveredEvent{
+			ThemeID:    theme.ID,
 			ActorID:    actorID,
+			CuratorID:  theme.CuratorID,
 			OccurredAt: time.Now(),
 		},
 	}
@@ -848,3 +1511,29 @@ func (s *ThemesService) publishThemeArticlesReorderedEvent(ctx context.Context,
 	}
 	s.eventBus.Publish(ctx, event)
 }
+
+func (s *ThemesService) publishThemeArticlePinnedEvent(ctx context.Context, themeID, postID, actorID uuid.UUID) {
+	event := eventbus.Event{
+		Topic: events.ThemeArticlePinnedTopic,
+		Payload: events.ThemeArticlePinnedEvent{
+			ThemeID:    themeID,
+			PostID:     postID,
+			ActorID:    actorID,
+			OccurredAt: time.Now(),
+		},
+	}
+	s.eventBus.Publish(ctx, event)
+}
+
+func (s *ThemesService) publishThemeArticleUnpinnedEvent(ctx context.Context, themeID, postID, actorID uuid.UUID) {
+	event := eventbus.Event{
+		Topic: events.ThemeArticleUnpinnedTopic,
+		Payload: events.ThemeArticleUnpinnedEvent{
+			ThemeID:    themeID,
+			PostID:     postID,
+			ActorID:    actorID,
+			OccurredAt: time.Now(),
+		},
+	}
+	s.eventBus.Publish(ctx, event)
+}
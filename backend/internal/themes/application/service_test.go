@@ -0,0 +1,125 @@
+package application_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/errreport"
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/logger"
+	"backend/internal/themes/application"
+	"backend/internal/themes/domain"
+	"backend/internal/themes/ports"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubAuthorizer grants the actions it lists to everyone
+type stubAuthorizer map[string]bool
+
+func (a stubAuthorizer) Can(_ context.Context, _ uuid.UUID, _ string, action string, _ *uuid.UUID) (bool, error) {
+	return a[action], nil
+}
+
+// memoryRepository stores themes in memory and knows which slugs they take
+type memoryRepository struct {
+	ports.ThemeRepository
+	themes map[uuid.UUID]*domain.Theme
+}
+
+func (r *memoryRepository) SlugExists(_ context.Context, slug string, excludeID *uuid.UUID) (bool, error) {
+	for _, theme := range r.themes {
+		if theme.Slug == slug && (excludeID == nil || theme.ID != *excludeID) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *memoryRepository) Create(_ context.Context, theme *domain.Theme) error {
+	r.themes[theme.ID] = theme
+	return nil
+}
+
+func (r *memoryRepository) FindByID(_ context.Context, id uuid.UUID) (*domain.Theme, error) {
+	theme, ok := r.themes[id]
+	if !ok {
+		return nil, ports.ErrThemeNotFound
+	}
+	copied := *theme
+	return &copied, nil
+}
+
+func (r *memoryRepository) Save(_ context.Context, theme *domain.Theme) error {
+	r.themes[theme.ID] = theme
+	return nil
+}
+
+// noQuotas lets every curator create as many themes as they like
+type noQuotas struct{}
+
+func (noQuotas) CheckThemeQuota(context.Context, uuid.UUID) error { return nil }
+
+// inlineUnitOfWork runs fn without a transaction
+type inlineUnitOfWork struct{}
+
+func (inlineUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestThemesService_CustomSlugs(t *testing.T) {
+	log := logger.NewSlogAdapter("test", "error")
+	repo := &memoryRepository{themes: map[uuid.UUID]*domain.Theme{}}
+	service := application.NewThemesService(
+		inlineUnitOfWork{}, repo, nil, stubAuthorizer{"create": true, "update": true},
+		eventbus.NewBus(log, errreport.Nop{}), log, nil, noQuotas{}, application.ReadingListConfig{},
+	)
+	ctx := context.Background()
+	curator := uuid.New()
+
+	create := func(name, slug string) (*domain.Theme, error) {
+		return service.CreateTheme(ctx, curator, application.CreateThemeParams{
+			Name: name, Description: "A theme", Slug: slug,
+		})
+	}
+
+	custom, err := create("Domain-Driven Design", "ddd")
+	require.NoError(t, err)
+	assert.Equal(t, "ddd", custom.Slug, "an explicit slug replaces the derived one")
+
+	derived, err := create("Event Sourcing", "")
+	require.NoError(t, err)
+	assert.Equal(t, "event-sourcing", derived.Slug)
+
+	taken, err := create("Another Take", "ddd")
+	require.NoError(t, err)
+	assert.Equal(t, "ddd-1", taken.Slug, "a taken slug gets a suffix")
+
+	_, err = create("Bad Slug", "Not A Slug!")
+	var appErr *apperror.AppError
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, http.StatusBadRequest, appErr.HTTPStatus)
+
+	t.Run("edits keep a custom slug unless the name changes", func(t *testing.T) {
+		description := "Updated"
+		updated, err := service.PatchTheme(ctx, curator, custom.ID, application.PatchThemeParams{Description: &description})
+		require.NoError(t, err)
+		assert.Equal(t, "ddd", updated.Slug)
+
+		name := "Domain-Driven Design Revisited"
+		updated, err = service.PatchTheme(ctx, curator, custom.ID, application.PatchThemeParams{Name: &name})
+		require.NoError(t, err)
+		assert.Equal(t, "domain-driven-design-revisited", updated.Slug)
+	})
+
+	t.Run("an explicit slug wins over a new name", func(t *testing.T) {
+		name, slug := "Events in Practice", "events"
+		updated, err := service.PatchTheme(ctx, curator, derived.ID, application.PatchThemeParams{Name: &name, Slug: &slug})
+		require.NoError(t, err)
+		assert.Equal(t, "events", updated.Slug)
+	})
+}
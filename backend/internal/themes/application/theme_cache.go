@@ -0,0 +1,248 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend/internal/platform/cache"
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/events"
+	"backend/internal/platform/logger"
+	"backend/internal/platform/tenant"
+	"backend/internal/themes/domain"
+	"backend/internal/themes/ports"
+	"github.com/google/uuid"
+)
+
+// ThemeCache is a read-through cache for themes looked up by slug and for
+// pages of the active theme listing.
+//
+// Themes are stored under their ID with a slug-to-ID mapping beside them, as
+// the post cache does. Listing pages are keyed by a per-blog version token;
+// any theme change replaces the token, which orphans every cached page at once
+// instead of enumerating the limit/offset combinations that were cached.
+type ThemeCache struct {
+	cache   cache.Cache
+	ttl     time.Duration
+	listTTL time.Duration
+	logger  logger.Logger
+}
+
+// cachedThemeList is the cached form of one page of the active theme listing
+type cachedThemeList struct {
+	Themes []*ports.ThemeSummary
+	Total  int
+}
+
+// NewThemeCache creates a new theme cache
+func NewThemeCache(c cache.Cache, cfg cache.Config, logger logger.Logger) *ThemeCache {
+	return &ThemeCache{
+		cache:   c,
+		ttl:     cfg.ThemeTTL,
+		listTTL: cfg.ThemeListTTL,
+		logger:  logger,
+	}
+}
+
+// Subscribe registers the invalidation handlers on the bus
+func (c *ThemeCache) Subscribe(bus *eventbus.Bus) {
+	bus.Subscribe(events.ThemeCreatedTopic, c.handleThemeCreated)
+	bus.Subscribe(events.ThemeUpdatedTopic, c.handleThemeUpdated)
+	for _, topic := range []eventbus.Topic{
+		events.ThemeActivatedTopic,
+		events.ThemeDeactivatedTopic,
+		events.ThemeArchivedTopic,
+		events.ThemeRestoredTopic,
+		events.ThemeDeletedTopic,
+		events.ThemeRecoveredTopic,
+		events.ThemeArticleAddedTopic,
+		events.ThemeArticleRemovedTopic,
+		events.ThemeArticlesReorderedTopic,
+		events.ThemeArticlePinnedTopic,
+		events.ThemeArticleUnpinnedTopic,
+	} {
+		bus.Subscribe(topic, c.handleThemeChanged)
+	}
+	// Deleting a post removes it from its themes, changing their article counts
+	bus.Subscribe(events.PostDeletedTopic, c.handlePostDeleted)
+	bus.Subscribe(events.ThemeCuratorNamesUpdatedTopic, c.handleCuratorNamesUpdated)
+}
+
+// GetBySlug returns the cached theme for slug in the current blog
+// Cache failures are logged and reported as misses so reads fall back to the database
+func (c *ThemeCache) GetBySlug(ctx context.Context, slug string) (*domain.Theme, bool) {
+	var id uuid.UUID
+	if !c.get(ctx, themeSlugKey(ctx, slug), &id) {
+		return nil, false
+	}
+
+	var theme domain.Theme
+	if !c.get(ctx, themeKey(id), &theme) {
+		return nil, false
+	}
+	return &theme, true
+}
+
+// SetBySlug caches theme as the result of looking up slug, which may be a previous slug
+func (c *ThemeCache) SetBySlug(ctx context.Context, slug string, theme *domain.Theme) {
+	if c.set(ctx, themeKey(theme.ID), theme, c.ttl) {
+		c.set(ctx, themeSlugKey(ctx, slug), theme.ID, c.ttl)
+	}
+}
+
+// GetActiveList returns a cached page of active themes and the total count
+func (c *ThemeCache) GetActiveList(ctx context.Context, limit, offset int) ([]*ports.ThemeSummary, int, bool) {
+	version, ok := c.listVersion(ctx, false)
+	if !ok {
+		return nil, 0, false
+	}
+
+	var list cachedThemeList
+	if !c.get(ctx, themeListKey(ctx, version, limit, offset), &list) {
+		return nil, 0, false
+	}
+	return list.Themes, list.Total, true
+}
+
+// SetActiveList caches a page of active themes and the total count
+func (c *ThemeCache) SetActiveList(ctx context.Context, limit, offset int, themes []*ports.ThemeSummary, total int) {
+	version, ok := c.listVersion(ctx, true)
+	if !ok {
+		return
+	}
+	c.set(ctx, themeListKey(ctx, version, limit, offset), cachedThemeList{Themes: themes, Total: total}, c.listTTL)
+}
+
+// listVersion returns the current blog's listing version, creating one if asked
+func (c *ThemeCache) listVersion(ctx context.Context, create bool) (string, bool) {
+	var version string
+	if c.get(ctx, themeListVersionKey(ctx), &version) {
+		return version, true
+	}
+	if !create {
+		return "", false
+	}
+
+	version = uuid.NewString()
+	if !c.set(ctx, themeListVersionKey(ctx), version, c.listTTL) {
+		return "", false
+	}
+	return version, true
+}
+
+// handleThemeCreated drops the mapping for the new theme's slug and the listing
+func (c *ThemeCache) handleThemeCreated(ctx context.Context, event eventbus.Event) error {
+	payload, ok := event.Payload.(events.ThemeCreatedEvent)
+	if !ok {
+		return fmt.Errorf("ThemeCache.handleThemeCreated: unexpected payload %T", event.Payload)
+	}
+	return c.invalidate(ctx, themeSlugKey(ctx, payload.Slug), themeListVersionKey(ctx))
+}
+
+// handleThemeUpdated drops the theme, the mapping for its current slug and the listing
+func (c *ThemeCache) handleThemeUpdated(ctx context.Context, event eventbus.Event) error {
+	payload, ok := event.Payload.(events.ThemeUpdatedEvent)
+	if !ok {
+		return fmt.Errorf("ThemeCache.handleThemeUpdated: unexpected payload %T", event.Payload)
+	}
+	return c.invalidate(ctx, themeKey(payload.ThemeID), themeSlugKey(ctx, payload.Slug), themeListVersionKey(ctx))
+}
+
+// handleThemeChanged drops the theme named by any other theme event and the listing
+func (c *ThemeCache) handleThemeChanged(ctx context.Context, event eventbus.Event) error {
+	var themeID uuid.UUID
+	switch payload := event.Payload.(type) {
+	case events.ThemeActivatedEvent:
+		themeID = payload.ThemeID
+	case events.ThemeDeactivatedEvent:
+		themeID = payload.ThemeID
+	case events.ThemeArchivedEvent:
+		themeID = payload.ThemeID
+	case events.ThemeRestoredEvent:
+		themeID = payload.ThemeID
+	case events.ThemeDeletedEvent:
+		themeID = payload.ThemeID
+	case events.ThemeRecoveredEvent:
+		themeID = payload.ThemeID
+	case events.ThemeArticleAddedEvent:
+		themeID = payload.ThemeID
+	case events.ThemeArticleRemovedEvent:
+		themeID = payload.ThemeID
+	case events.ThemeArticlesReorderedEvent:
+		themeID = payload.ThemeID
+	case events.ThemeArticlePinnedEvent:
+		themeID = payload.ThemeID
+	case events.ThemeArticleUnpinnedEvent:
+		themeID = payload.ThemeID
+	default:
+		return fmt.Errorf("ThemeCache.handleThemeChanged: unexpected payload %T", event.Payload)
+	}
+	return c.invalidate(ctx, themeKey(themeID), themeListVersionKey(ctx))
+}
+
+// handlePostDeleted drops the listing, whose article counts may have changed
+func (c *ThemeCache) handlePostDeleted(ctx context.Context, event eventbus.Event) error {
+	return c.invalidate(ctx, themeListVersionKey(ctx))
+}
+
+// handleCuratorNamesUpdated drops the listings of every blog where a curator's themes were renamed
+func (c *ThemeCache) handleCuratorNamesUpdated(ctx context.Context, event eventbus.Event) error {
+	payload, ok := event.Payload.(events.ThemeCuratorNamesUpdatedEvent)
+	if !ok {
+		return fmt.Errorf("ThemeCache.handleCuratorNamesUpdated: unexpected payload %T", event.Payload)
+	}
+
+	keys := make([]string, len(payload.BlogIDs))
+	for i, blogID := range payload.BlogIDs {
+		keys[i] = themeListVersionKey(tenant.WithBlogID(ctx, blogID))
+	}
+	return c.invalidate(ctx, keys...)
+}
+
+// get reads a cached value, logging failures and reporting them as misses
+func (c *ThemeCache) get(ctx context.Context, key string, dst any) bool {
+	found, err := cache.GetJSON(ctx, c.cache, key, dst)
+	if err != nil {
+		c.logger.Warn(ctx, "failed to read theme cache", "error", err, "key", key)
+		return false
+	}
+	return found
+}
+
+// set writes a cached value, logging failures
+func (c *ThemeCache) set(ctx context.Context, key string, v any, ttl time.Duration) bool {
+	if err := cache.SetJSON(ctx, c.cache, key, v, ttl); err != nil {
+		c.logger.Warn(ctx, "failed to write theme cache", "error", err, "key", key)
+		return false
+	}
+	return true
+}
+
+// invalidate deletes keys, detached from the publisher's request so it is not cut short
+func (c *ThemeCache) invalidate(ctx context.Context, keys ...string) error {
+	if err := c.cache.Delete(context.WithoutCancel(ctx), keys...); err != nil {
+		return fmt.Errorf("ThemeCache.invalidate: %w", err)
+	}
+	return nil
+}
+
+// themeSlugKey is the key of the theme ID a slug resolves to in the current blog
+func themeSlugKey(ctx context.Context, slug string) string {
+	return fmt.Sprintf("theme:slug:%s:%s", tenant.BlogID(ctx), slug)
+}
+
+// themeKey is the key of a cached theme
+func themeKey(id uuid.UUID) string {
+	return fmt.Sprintf("theme:id:%s", id)
+}
+
+// themeListVersionKey is the key of the current blog's listing version
+func themeListVersionKey(ctx context.Context) string {
+	return fmt.Sprintf("themes:ver:%s", tenant.BlogID(ctx))
+}
+
+// themeListKey is the key of one page of the current blog's active themes
+func themeListKey(ctx context.Context, version string, limit, offset int) string {
+	return fmt.Sprintf("themes:active:%s:%s:%d:%d", tenant.BlogID(ctx), version, limit, offset)
+}
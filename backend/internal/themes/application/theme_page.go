@@ -0,0 +1,142 @@
+package application
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"backend/internal/platform/apperror"
+	"backend/internal/platform/logger"
+	"backend/internal/themes/domain"
+	"backend/internal/themes/ports"
+	"github.com/google/uuid"
+)
+
+// MaxRelatedThemes bounds how many related themes a theme page suggests
+const MaxRelatedThemes = 4
+
+// Curator is the public profile of a theme's curator
+type Curator struct {
+	ID          uuid.UUID
+	Username    string
+	DisplayName string
+	AvatarURL   string
+}
+
+// CuratorProvider is an interface to read curator profiles
+// This avoids direct dependency on the users bounded context
+type CuratorProvider interface {
+	GetCurator(ctx context.Context, userID uuid.UUID) (*Curator, error)
+}
+
+// FollowerCounter is an interface to count a user's followers
+// This avoids direct dependency on the follows bounded context
+type FollowerCounter interface {
+	CountFollowers(ctx context.Context, userID uuid.UUID) (int, error)
+}
+
+// ThemePage is everything a theme's landing page shows
+type ThemePage struct {
+	Theme         *domain.Theme
+	Articles      []*ports.ArticleDetail
+	Curator       *Curator // nil when the curator's profile could not be read
+	FollowerCount int      // Followers of the curator
+	Related       []*ports.ThemeSummary
+}
+
+// ThemePageService assembles theme landing pages in one call, so readers'
+// clients do not have to fetch the theme, its posts and its curator one by one
+type ThemePageService struct {
+	themes    *ThemesService
+	repo      ports.ThemeRepository
+	curators  CuratorProvider
+	followers FollowerCounter
+	logger    logger.Logger
+}
+
+// NewThemePageService creates a new theme page service
+func NewThemePageService(
+	themes *ThemesService,
+	repo ports.ThemeRepository,
+	curators CuratorProvider,
+	followers FollowerCounter,
+	logger logger.Logger,
+) *ThemePageService {
+	return &ThemePageService{
+		themes:    themes,
+		repo:      repo,
+		curators:  curators,
+		followers: followers,
+		logger:    logger,
+	}
+}
+
+// GetThemePage resolves a theme by slug and loads the rest of its page in
+// parallel. A slug from before a rename returns the page with only the theme
+// set, carrying the current slug to redirect to. The curator's profile and
+// follower count are extras: when they cannot be read the page goes without.
+// Public, so no authorization is applied
+func (s *ThemePageService) GetThemePage(ctx context.Context, slug string) (*ThemePage, error) {
+	theme, err := s.themes.GetThemeBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &ThemePage{Theme: theme}
+	if theme.Slug != slug {
+		return page, nil
+	}
+
+	var (
+		wg                       sync.WaitGroup
+		articlesErr, relatedErr  error
+		curatorErr, followersErr error
+	)
+	wg.Add(4)
+	go func() {
+		defer wg.Done()
+		page.Articles, articlesErr = s.repo.ListArticleDetails(ctx, theme.ID)
+	}()
+	go func() {
+		defer wg.Done()
+		page.Related, relatedErr = s.repo.ListRelatedThemes(ctx, theme.ID, MaxRelatedThemes)
+	}()
+	go func() {
+		defer wg.Done()
+		page.Curator, curatorErr = s.curators.GetCurator(ctx, theme.CuratorID)
+	}()
+	go func() {
+		defer wg.Done()
+		page.FollowerCount, followersErr = s.followers.CountFollowers(ctx, theme.CuratorID)
+	}()
+	wg.Wait()
+
+	if articlesErr != nil {
+		s.logger.Error(ctx, "failed to list theme article details", "error", articlesErr, "themeID", theme.ID)
+		return nil, errThemePageFailed()
+	}
+	if relatedErr != nil {
+		s.logger.Error(ctx, "failed to list related themes", "error", relatedErr, "themeID", theme.ID)
+		return nil, errThemePageFailed()
+	}
+	if curatorErr != nil {
+		s.logger.Warn(ctx, "failed to get curator for theme page", "error", curatorErr, "curatorID", theme.CuratorID)
+		page.Curator = nil
+	}
+	if followersErr != nil {
+		s.logger.Warn(ctx, "failed to count curator followers for theme page", "error", followersErr, "curatorID", theme.CuratorID)
+		page.FollowerCount = 0
+	}
+
+	return page, nil
+}
+
+// errThemePageFailed is returned when part of a theme page that cannot be left out fails to load
+func errThemePageFailed() error {
+	return apperror.New(
+		apperror.CodeInternalError,
+		apperror.BusinessCodeGeneral,
+		"failed to retrieve theme page",
+		http.StatusInternalServerError,
+	)
+}
@@ -0,0 +1,36 @@
+package application
+
+import (
+	"context"
+
+	usersApp "backend/internal/users/application"
+	"github.com/google/uuid"
+)
+
+// UserAdapter implements the CuratorProvider interface
+// It adapts the users service to the public profiles of curators
+type UserAdapter struct {
+	userService *usersApp.UserService
+}
+
+// NewUserAdapter creates a new user adapter
+func NewUserAdapter(userService *usersApp.UserService) *UserAdapter {
+	return &UserAdapter{
+		userService: userService,
+	}
+}
+
+// GetCurator returns the public profile of the user
+func (a *UserAdapter) GetCurator(ctx context.Context, userID uuid.UUID) (*Curator, error) {
+	user, err := a.userService.GetUserByID(ctx, userID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &Curator{
+		ID:          userID,
+		Username:    user.Username,
+		DisplayName: user.DisplayName,
+		AvatarURL:   user.AvatarURL,
+	}, nil
+}
@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CollaboratorRole is what a collaborator may do to a theme besides reading it
+type CollaboratorRole string
+
+const (
+	CollaboratorRoleEditor      CollaboratorRole = "editor"      // Edits the theme's details and curates its articles
+	CollaboratorRoleContributor CollaboratorRole = "contributor" // Only curates its articles
+)
+
+// Theme actions a collaborator can be granted; they match the actions of the themes permissions
+const (
+	ActionUpdate = "update" // Change the theme's name, description and status
+	ActionCurate = "curate" // Add, remove, reorder and pin articles
+)
+
+// Collaborator errors
+var (
+	ErrInvalidCollaboratorRole = errors.New("collaborator role must be editor or contributor")
+	ErrCuratorAsCollaborator   = errors.New("the curator of a theme cannot be its collaborator")
+)
+
+// IsValid checks if the role is a valid value
+func (r CollaboratorRole) IsValid() bool {
+	switch r {
+	case CollaboratorRoleEditor, CollaboratorRoleContributor:
+		return true
+	default:
+		return false
+	}
+}
+
+// Allows reports whether the role grants action. Only curators delete
+// themes or manage their collaborators, so no role grants those.
+func (r CollaboratorRole) Allows(action string) bool {
+	switch r {
+	case CollaboratorRoleEditor:
+		return action == ActionUpdate || action == ActionCurate
+	case CollaboratorRoleContributor:
+		return action == ActionCurate
+	default:
+		return false
+	}
+}
+
+// Collaborator is a user who helps the curator maintain a theme
+type Collaborator struct {
+	ThemeID uuid.UUID
+	UserID  uuid.UUID
+	Role    CollaboratorRole
+	AddedBy uuid.UUID
+	AddedAt time.Time
+}
+
+// NewCollaborator validates and creates a collaborator of theme
+func NewCollaborator(theme *Theme, userID uuid.UUID, role CollaboratorRole, addedBy uuid.UUID) (*Collaborator, error) {
+	if !role.IsValid() {
+		return nil, ErrInvalidCollaboratorRole
+	}
+	if userID == theme.CuratorID {
+		return nil, ErrCuratorAsCollaborator
+	}
+	return &Collaborator{
+		ThemeID: theme.ID,
+		UserID:  userID,
+		Role:    role,
+		AddedBy: addedBy,
+		AddedAt: time.Now(),
+	}, nil
+}
@@ -0,0 +1,49 @@
+package domain_test
+
+import (
+	"testing"
+
+	"backend/internal/themes/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollaboratorRole_Allows(t *testing.T) {
+	tests := []struct {
+		role    domain.CollaboratorRole
+		action  string
+		allowed bool
+	}{
+		{domain.CollaboratorRoleEditor, domain.ActionUpdate, true},
+		{domain.CollaboratorRoleEditor, domain.ActionCurate, true},
+		{domain.CollaboratorRoleEditor, "delete", false},
+		{domain.CollaboratorRoleContributor, domain.ActionCurate, true},
+		{domain.CollaboratorRoleContributor, domain.ActionUpdate, false},
+		{domain.CollaboratorRoleContributor, "delete", false},
+		{domain.CollaboratorRole("owner"), domain.ActionCurate, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.role)+"/"+tt.action, func(t *testing.T) {
+			assert.Equal(t, tt.allowed, tt.role.Allows(tt.action))
+		})
+	}
+}
+
+func TestNewCollaborator(t *testing.T) {
+	theme := newTestTheme(t)
+	userID := uuid.New()
+
+	collaborator, err := domain.NewCollaborator(theme, userID, domain.CollaboratorRoleEditor, theme.CuratorID)
+	require.NoError(t, err)
+	assert.Equal(t, theme.ID, collaborator.ThemeID)
+	assert.Equal(t, userID, collaborator.UserID)
+	assert.Equal(t, theme.CuratorID, collaborator.AddedBy)
+
+	_, err = domain.NewCollaborator(theme, userID, domain.CollaboratorRole("owner"), theme.CuratorID)
+	assert.ErrorIs(t, err, domain.ErrInvalidCollaboratorRole)
+
+	_, err = domain.NewCollaborator(theme, theme.CuratorID, domain.CollaboratorRoleContributor, theme.CuratorID)
+	assert.ErrorIs(t, err, domain.ErrCuratorAsCollaborator)
+}
@@ -8,4 +8,6 @@ type PostInfo interface {
 	GetID() uuid.UUID
 	IsPublished() bool
 	GetAuthorID() uuid.UUID
+	GetTitle() string
+	GetSlug() string
 }
@@ -0,0 +1,141 @@
+package domain
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ReadingList is a theme shared as a list of links, so curators can pass
+// their selections between blogs and feed readers
+type ReadingList struct {
+	Title       string
+	Description string
+	Entries     []ReadingListEntry
+}
+
+// ReadingListEntry is one article of a reading list
+type ReadingListEntry struct {
+	Title string
+	Slug  string // Empty when the list only carries URLs, as OPML does
+	URL   string
+	Note  string
+}
+
+// MaxReadingListEntries bounds how many entries one import may carry
+const MaxReadingListEntries = 200
+
+// Reading list errors
+var (
+	ErrMalformedOPML         = errors.New("malformed OPML document")
+	ErrTooManyReadingEntries = errors.New("reading list must not have more than 200 entries")
+)
+
+// MatchSlug returns the slug of the post an entry points to: its own slug, or else
+// the last segment of its URL's path, so links to the post page on any host match
+func (e ReadingListEntry) MatchSlug() string {
+	if e.Slug != "" {
+		return e.Slug
+	}
+	parsed, err := url.Parse(strings.TrimSpace(e.URL))
+	if err != nil {
+		return ""
+	}
+	path := strings.TrimRight(parsed.Path, "/")
+	return path[strings.LastIndex(path, "/")+1:]
+}
+
+// Validate checks the limits an imported list must respect
+func (l *ReadingList) Validate() error {
+	if len(l.Entries) > MaxReadingListEntries {
+		return ErrTooManyReadingEntries
+	}
+	for _, entry := range l.Entries {
+		if len(entry.Note) > MaxArticleNoteLength {
+			return ErrInvalidArticleNote
+		}
+	}
+	return nil
+}
+
+// opmlDocument is the subset of OPML 2.0 used for reading lists: each article is
+// an outline of type "link", and outlines holding others are read as folders
+type opmlDocument struct {
+	XMLName xml.Name `xml:"opml"`
+	Version string   `xml:"version,attr"`
+	Head    struct {
+		Title string `xml:"title"`
+	} `xml:"head"`
+	Outlines []opmlOutline `xml:"body>outline"`
+}
+
+type opmlOutline struct {
+	Text        string        `xml:"text,attr"`
+	Title       string        `xml:"title,attr,omitempty"`
+	Type        string        `xml:"type,attr,omitempty"`
+	URL         string        `xml:"url,attr,omitempty"`
+	HTMLURL     string        `xml:"htmlUrl,attr,omitempty"` // Where feed readers put the page of a feed
+	Description string        `xml:"description,attr,omitempty"`
+	Outlines    []opmlOutline `xml:"outline"`
+}
+
+// RenderOPML writes a reading list as an OPML 2.0 document
+// OPML has no place for the list's description, which only JSON carries.
+func RenderOPML(list *ReadingList) ([]byte, error) {
+	doc := opmlDocument{Version: "2.0"}
+	doc.Head.Title = list.Title
+	for _, entry := range list.Entries {
+		doc.Outlines = append(doc.Outlines, opmlOutline{
+			Text:        entry.Title,
+			Type:        "link",
+			URL:         entry.URL,
+			Description: entry.Note,
+		})
+	}
+
+	body, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("render OPML: %w", err)
+	}
+	return append([]byte(xml.Header), body...), nil
+}
+
+// ParseOPML reads a reading list from an OPML document
+// Folders are flattened in document order; outlines without a link are kept so
+// they can be reported as unmatched.
+func ParseOPML(data []byte) (*ReadingList, error) {
+	var doc opmlDocument
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedOPML, err)
+	}
+
+	list := &ReadingList{Title: doc.Head.Title}
+	var walk func(outlines []opmlOutline)
+	walk = func(outlines []opmlOutline) {
+		for _, outline := range outlines {
+			link := outline.URL
+			if link == "" {
+				link = outline.HTMLURL
+			}
+			if link == "" && len(outline.Outlines) > 0 {
+				walk(outline.Outlines)
+				continue
+			}
+
+			title := outline.Text
+			if title == "" {
+				title = outline.Title
+			}
+			list.Entries = append(list.Entries, ReadingListEntry{
+				Title: title,
+				URL:   link,
+				Note:  outline.Description,
+			})
+		}
+	}
+	walk(doc.Outlines)
+
+	return list, nil
+}
@@ -0,0 +1,84 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"backend/internal/themes/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadingListOPMLRoundTrip(t *testing.T) {
+	list := &domain.ReadingList{
+		Title: "Hexagonal architecture",
+		Entries: []domain.ReadingListEntry{
+			{Title: "Ports & adapters", URL: "https://blog.example.com/posts/ports-and-adapters", Note: "Start <here>"},
+			{Title: "Testing the core", URL: "/posts/testing-the-core"},
+		},
+	}
+
+	doc, err := domain.RenderOPML(list)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(doc), "<?xml"))
+
+	parsed, err := domain.ParseOPML(doc)
+	require.NoError(t, err)
+	assert.Equal(t, list, parsed)
+}
+
+func TestParseOPMLFlattensFolders(t *testing.T) {
+	doc := `<?xml version="1.0"?>
+<opml version="2.0">
+  <head><title>Feeds</title></head>
+  <body>
+    <outline text="Architecture">
+      <outline text="Ports" type="rss" xmlUrl="https://example.com/feed" htmlUrl="https://example.com/posts/ports"/>
+      <outline title="No link"/>
+    </outline>
+    <outline text="Adapters" type="link" url="https://example.com/posts/adapters/" description="Short read"/>
+  </body>
+</opml>`
+
+	list, err := domain.ParseOPML([]byte(doc))
+	require.NoError(t, err)
+	assert.Equal(t, "Feeds", list.Title)
+	assert.Equal(t, []domain.ReadingListEntry{
+		{Title: "Ports", URL: "https://example.com/posts/ports"},
+		{Title: "No link"},
+		{Title: "Adapters", URL: "https://example.com/posts/adapters/", Note: "Short read"},
+	}, list.Entries)
+
+	_, err = domain.ParseOPML([]byte("<opml><body>"))
+	assert.ErrorIs(t, err, domain.ErrMalformedOPML)
+}
+
+func TestReadingListEntryMatchSlug(t *testing.T) {
+	tests := []struct {
+		name  string
+		entry domain.ReadingListEntry
+		want  string
+	}{
+		{"slug wins", domain.ReadingListEntry{Slug: "own", URL: "https://example.com/posts/other"}, "own"},
+		{"absolute URL", domain.ReadingListEntry{URL: "https://example.com/posts/ports?ref=list#top"}, "ports"},
+		{"trailing slash", domain.ReadingListEntry{URL: "https://example.com/posts/ports/"}, "ports"},
+		{"relative URL", domain.ReadingListEntry{URL: "/posts/ports"}, "ports"},
+		{"no link", domain.ReadingListEntry{Title: "Ports"}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.entry.MatchSlug())
+		})
+	}
+}
+
+func TestReadingListValidate(t *testing.T) {
+	list := &domain.ReadingList{Entries: make([]domain.ReadingListEntry, domain.MaxReadingListEntries)}
+	assert.NoError(t, list.Validate())
+
+	list.Entries = append(list.Entries, domain.ReadingListEntry{})
+	assert.ErrorIs(t, list.Validate(), domain.ErrTooManyReadingEntries)
+
+	list.Entries = []domain.ReadingListEntry{{Note: strings.Repeat("a", domain.MaxArticleNoteLength+1)}}
+	assert.ErrorIs(t, list.Validate(), domain.ErrInvalidArticleNote)
+}
@@ -2,12 +2,51 @@ package domain
 
 import (
 	"errors"
+	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"backend/internal/platform/validator"
 	"github.com/google/uuid"
 )
 
+// ThemeStatus is a theme's place in its lifecycle
+type ThemeStatus string
+
+const (
+	ThemeStatusDraft    ThemeStatus = "draft"    // Being curated; not listed publicly
+	ThemeStatusActive   ThemeStatus = "active"   // Listed publicly
+	ThemeStatusArchived ThemeStatus = "archived" // Retired; hidden from listings and its articles are frozen
+)
+
+// IsValid checks if the status is a valid value
+func (s ThemeStatus) IsValid() bool {
+	switch s {
+	case ThemeStatusDraft, ThemeStatusActive, ThemeStatusArchived:
+		return true
+	default:
+		return false
+	}
+}
+
+// CanTransitionTo checks if a status transition is allowed
+func (s ThemeStatus) CanTransitionTo(target ThemeStatus) bool {
+	switch s {
+	case ThemeStatusDraft:
+		// Draft can be activated or archived
+		return target == ThemeStatusActive || target == ThemeStatusArchived
+	case ThemeStatusActive:
+		// Active can be taken back to draft or archived
+		return target == ThemeStatusDraft || target == ThemeStatusArchived
+	case ThemeStatusArchived:
+		// Archived themes are restored as drafts, to be reviewed before going live again
+		return target == ThemeStatusDraft
+	default:
+		return false
+	}
+}
+
 // Theme represents a curated collection of articles
 type Theme struct {
 	ID          uuid.UUID
@@ -15,7 +54,7 @@ type Theme struct {
 	Slug        string
 	Description string
 	CuratorID   uuid.UUID // The user who created/manages this theme
-	IsActive    bool
+	Status      ThemeStatus
 	Articles    []*ThemeArticle // Articles in this theme
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
@@ -26,6 +65,7 @@ const (
 	MaxNameLength        = 100
 	MaxSlugLength        = 150
 	MaxDescriptionLength = 1000
+	MaxPinnedArticles    = 3
 )
 
 // Validation errors
@@ -35,13 +75,15 @@ var (
 	ErrInvalidDescription   = errors.New("description must not exceed 1000 characters")
 	ErrInvalidCuratorID     = errors.New("curator ID is required")
 	ErrPostNotPublished     = errors.New("only published posts can be added to themes")
-	ErrThemeInactive        = errors.New("cannot modify an inactive theme")
+	ErrThemeArchived        = errors.New("cannot modify the articles of an archived theme")
+	ErrInvalidTransition    = errors.New("invalid status transition")
 	ErrArticleNotFound      = errors.New("article not found in theme")
 	ErrInvalidArticleCount  = errors.New("number of post IDs doesn't match number of articles")
 	ErrInvalidArticlePostID = errors.New("post ID not found in theme")
+	ErrPinLimitReached      = errors.New("theme already has the maximum number of pinned articles")
 )
 
-// NewTheme creates a new theme with validation
+// NewTheme creates a new draft theme with validation
 func NewTheme(name, description string, curatorID uuid.UUID) (*Theme, error) {
 	if err := validateName(name); err != nil {
 		return nil, err
@@ -68,7 +110,7 @@ func NewTheme(name, description string, curatorID uuid.UUID) (*Theme, error) {
 		Slug:        slug,
 		Description: description,
 		CuratorID:   curatorID,
-		IsActive:    true,
+		Status:      ThemeStatusDraft,
 		Articles:    make([]*ThemeArticle, 0),
 		CreatedAt:   now,
 		UpdatedAt:   now,
@@ -103,25 +145,86 @@ func (t *Theme) UpdateSlug(slug string) error {
 	return nil
 }
 
-// Deactivate marks the theme as inactive
-func (t *Theme) Deactivate() {
-	t.IsActive = false
-	t.UpdatedAt = time.Now()
+// IsActive reports whether the theme is listed publicly
+func (t *Theme) IsActive() bool {
+	return t.Status == ThemeStatusActive
+}
+
+// Activate lists a draft theme publicly
+func (t *Theme) Activate() error {
+	return t.transitionTo(ThemeStatusActive, "activate")
+}
+
+// Deactivate takes an active theme back to draft
+func (t *Theme) Deactivate() error {
+	if t.Status != ThemeStatusActive {
+		return fmt.Errorf("%w: cannot deactivate from %s", ErrInvalidTransition, t.Status)
+	}
+	return t.transitionTo(ThemeStatusDraft, "deactivate")
+}
+
+// Archive retires the theme, hiding it from listings and freezing its articles
+func (t *Theme) Archive() error {
+	return t.transitionTo(ThemeStatusArchived, "archive")
 }
 
-// Activate marks the theme as active
-func (t *Theme) Activate() {
-	t.IsActive = true
+// Restore brings an archived theme back as a draft
+func (t *Theme) Restore() error {
+	if t.Status != ThemeStatusArchived {
+		return fmt.Errorf("%w: cannot restore from %s", ErrInvalidTransition, t.Status)
+	}
+	return t.transitionTo(ThemeStatusDraft, "restore")
+}
+
+// transitionTo moves the theme to target if the lifecycle allows it
+func (t *Theme) transitionTo(target ThemeStatus, action string) error {
+	if !t.Status.CanTransitionTo(target) {
+		return fmt.Errorf("%w: cannot %s from %s", ErrInvalidTransition, action, t.Status)
+	}
+
+	t.Status = target
 	t.UpdatedAt = time.Now()
+	return nil
+}
+
+// CloneNameSuffix marks the name of a theme copied with Clone
+const CloneNameSuffix = " (copy)"
+
+// Clone copies the theme for a new curator: the name gains CloneNameSuffix, and the
+// articles keep their order and pins but are recorded as added by the new curator.
+// The copy starts as a draft and gets a slug derived from its own name.
+func (t *Theme) Clone(curatorID uuid.UUID) (*Theme, error) {
+	// Shorten the original name, a rune at a time, until the suffix fits
+	base := []rune(t.Name)
+	for len(string(base))+len(CloneNameSuffix) > MaxNameLength && len(base) > 0 {
+		base = base[:len(base)-1]
+	}
+
+	clone, err := NewTheme(strings.TrimSpace(string(base))+CloneNameSuffix, t.Description, curatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, article := range t.Articles {
+		copied, err := NewThemeArticle(clone.ID, article.PostID, article.Position, curatorID)
+		if err != nil {
+			return nil, err
+		}
+		copied.IsPinned = article.IsPinned
+		copied.Note = article.Note
+		clone.Articles = append(clone.Articles, copied)
+	}
+
+	return clone, nil
 }
 
 // Article Management Methods (Aggregate Root pattern)
 
 // AddArticle adds a post to the theme with business rule validation
 func (t *Theme) AddArticle(post PostInfo, addedBy uuid.UUID) error {
-	// Business rule: Cannot modify inactive themes
-	if !t.IsActive {
-		return ErrThemeInactive
+	// Business rule: Archived themes keep their articles as they were
+	if t.Status == ThemeStatusArchived {
+		return ErrThemeArchived
 	}
 
 	// Business rule: Only published posts can be added to themes
@@ -155,9 +258,9 @@ func (t *Theme) AddArticle(post PostInfo, addedBy uuid.UUID) error {
 
 // RemoveArticle removes a post from the theme
 func (t *Theme) RemoveArticle(postID uuid.UUID) error {
-	// Business rule: Cannot modify inactive themes
-	if !t.IsActive {
-		return ErrThemeInactive
+	// Business rule: Archived themes keep their articles as they were
+	if t.Status == ThemeStatusArchived {
+		return ErrThemeArchived
 	}
 
 	var found bool
@@ -194,9 +297,9 @@ func (t *Theme) RemoveArticle(postID uuid.UUID) error {
 
 // ReorderArticles changes the order of articles in the theme
 func (t *Theme) ReorderArticles(orderedPostIDs []uuid.UUID) error {
-	// Business rule: Cannot modify inactive themes
-	if !t.IsActive {
-		return ErrThemeInactive
+	// Business rule: Archived themes keep their articles as they were
+	if t.Status == ThemeStatusArchived {
+		return ErrThemeArchived
 	}
 
 	// Validate that all post IDs are present
@@ -228,6 +331,142 @@ func (t *Theme) ReorderArticles(orderedPostIDs []uuid.UUID) error {
 	return nil
 }
 
+// PositionChange records an article moved by RepairPositions
+type PositionChange struct {
+	PostID      uuid.UUID
+	OldPosition int
+	NewPosition int
+}
+
+// RepairPositions renumbers the articles 1..n, closing gaps and splitting duplicate
+// positions left behind by edits made outside the aggregate. The current order is
+// kept; articles sharing a position are ordered by when they were added.
+// Archived themes are repaired too, since the data is wrong either way.
+func (t *Theme) RepairPositions() []PositionChange {
+	ordered := make([]*ThemeArticle, len(t.Articles))
+	copy(ordered, t.Articles)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		a, b := ordered[i], ordered[j]
+		if a.Position != b.Position {
+			return a.Position < b.Position
+		}
+		if !a.AddedAt.Equal(b.AddedAt) {
+			return a.AddedAt.Before(b.AddedAt)
+		}
+		return a.PostID.String() < b.PostID.String()
+	})
+
+	var changes []PositionChange
+	now := time.Now()
+	for i, article := range ordered {
+		if article.Position == i+1 {
+			continue
+		}
+		changes = append(changes, PositionChange{
+			PostID:      article.PostID,
+			OldPosition: article.Position,
+			NewPosition: i + 1,
+		})
+		article.Position = i + 1
+		article.UpdatedAt = now
+	}
+
+	if len(changes) > 0 {
+		t.UpdatedAt = now
+	}
+	return changes
+}
+
+// PinArticle pins an article to the top of the theme
+// Pinning does not change the article's position; pinned articles are simply listed first
+func (t *Theme) PinArticle(postID uuid.UUID) error {
+	// Business rule: Archived themes keep their articles as they were
+	if t.Status == ThemeStatusArchived {
+		return ErrThemeArchived
+	}
+
+	article, exists := t.GetArticle(postID)
+	if !exists {
+		return ErrArticleNotFound
+	}
+
+	if article.IsPinned {
+		return nil
+	}
+
+	// Business rule: Only a limited number of articles can be pinned
+	if t.PinnedCount() >= MaxPinnedArticles {
+		return ErrPinLimitReached
+	}
+
+	article.IsPinned = true
+	article.UpdatedAt = time.Now()
+	t.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// UnpinArticle removes the pin from an article
+func (t *Theme) UnpinArticle(postID uuid.UUID) error {
+	// Business rule: Archived themes keep their articles as they were
+	if t.Status == ThemeStatusArchived {
+		return ErrThemeArchived
+	}
+
+	article, exists := t.GetArticle(postID)
+	if !exists {
+		return ErrArticleNotFound
+	}
+
+	if !article.IsPinned {
+		return nil
+	}
+
+	article.IsPinned = false
+	article.UpdatedAt = time.Now()
+	t.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// SetArticleNote records the curator's note on an article; an empty note removes it
+func (t *Theme) SetArticleNote(postID uuid.UUID, note string) error {
+	// Business rule: Archived themes keep their articles as they were
+	if t.Status == ThemeStatusArchived {
+		return ErrThemeArchived
+	}
+
+	if len(note) > MaxArticleNoteLength {
+		return ErrInvalidArticleNote
+	}
+
+	article, exists := t.GetArticle(postID)
+	if !exists {
+		return ErrArticleNotFound
+	}
+
+	if article.Note == note {
+		return nil
+	}
+
+	article.Note = note
+	article.UpdatedAt = time.Now()
+	t.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// PinnedCount returns the number of pinned articles in the theme
+func (t *Theme) PinnedCount() int {
+	count := 0
+	for _, article := range t.Articles {
+		if article.IsPinned {
+			count++
+		}
+	}
+	return count
+}
+
 // GetArticle retrieves a specific article from the theme
 func (t *Theme) GetArticle(postID uuid.UUID) (*ThemeArticle, bool) {
 	for _, article := range t.Articles {
@@ -13,15 +13,21 @@ type ThemeArticle struct {
 	ID        uuid.UUID
 	ThemeID   uuid.UUID
 	PostID    uuid.UUID
-	Position  int // Order within the theme (1-based)
+	Position  int    // Order within the theme (1-based)
+	IsPinned  bool   // Pinned articles are listed before the rest, regardless of position
+	Note      string // The curator's remark on why the article belongs in the theme
 	AddedBy   uuid.UUID
 	AddedAt   time.Time
 	UpdatedAt time.Time
 }
 
+// MaxArticleNoteLength bounds a curator's note on an article
+const MaxArticleNoteLength = 500
+
 // Additional validation errors for articles
 var (
-	ErrDuplicateArticle = errors.New("post is already in this theme")
+	ErrDuplicateArticle   = errors.New("post is already in this theme")
+	ErrInvalidArticleNote = errors.New("article note must not exceed 500 characters")
 )
 
 // NewThemeArticle creates a new theme article association
@@ -54,3 +60,15 @@ func NewThemeArticle(themeID, postID uuid.UUID, position int, addedBy uuid.UUID)
 		UpdatedAt: now,
 	}, nil
 }
+
+// ReadingWordsPerMinute is the reading pace reading times are estimated at
+const ReadingWordsPerMinute = 200
+
+// ReadingMinutes estimates how long reading an article of the given length
+// takes, rounded up so even a short note counts as a minute
+func ReadingMinutes(words int) int {
+	if words <= 0 {
+		return 1
+	}
+	return (words + ReadingWordsPerMinute - 1) / ReadingWordsPerMinute
+}
@@ -0,0 +1,253 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"backend/internal/themes/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTheme(t *testing.T, positions ...int) *domain.Theme {
+	t.Helper()
+	theme, err := domain.NewTheme("Architecture", "Ports and adapters", uuid.New())
+	require.NoError(t, err)
+
+	addedAt := time.Now().Add(-time.Hour)
+	for i, position := range positions {
+		theme.Articles = append(theme.Articles, &domain.ThemeArticle{
+			ThemeID:  theme.ID,
+			PostID:   uuid.New(),
+			Position: position,
+			AddedAt:  addedAt.Add(time.Duration(i) * time.Minute),
+		})
+	}
+	return theme
+}
+
+type publishedPost struct{ id uuid.UUID }
+
+func (p publishedPost) GetID() uuid.UUID       { return p.id }
+func (p publishedPost) IsPublished() bool      { return true }
+func (p publishedPost) GetAuthorID() uuid.UUID { return uuid.Nil }
+func (p publishedPost) GetTitle() string       { return "" }
+func (p publishedPost) GetSlug() string        { return "" }
+
+func positionsByPost(theme *domain.Theme) map[uuid.UUID]int {
+	positions := make(map[uuid.UUID]int, len(theme.Articles))
+	for _, article := range theme.Articles {
+		positions[article.PostID] = article.Position
+	}
+	return positions
+}
+
+func TestRepairPositions(t *testing.T) {
+	t.Run("consistent positions are left alone", func(t *testing.T) {
+		theme := newTestTheme(t, 1, 2, 3)
+		updatedAt := theme.UpdatedAt
+
+		assert.Empty(t, theme.RepairPositions())
+		assert.Equal(t, updatedAt, theme.UpdatedAt)
+	})
+
+	t.Run("gaps are closed in order", func(t *testing.T) {
+		theme := newTestTheme(t, 2, 5, 9)
+		first, second, third := theme.Articles[0].PostID, theme.Articles[1].PostID, theme.Articles[2].PostID
+
+		changes := theme.RepairPositions()
+
+		assert.Equal(t, map[uuid.UUID]int{first: 1, second: 2, third: 3}, positionsByPost(theme))
+		assert.Equal(t, []domain.PositionChange{
+			{PostID: first, OldPosition: 2, NewPosition: 1},
+			{PostID: second, OldPosition: 5, NewPosition: 2},
+			{PostID: third, OldPosition: 9, NewPosition: 3},
+		}, changes)
+	})
+
+	t.Run("duplicates are split by when they were added", func(t *testing.T) {
+		theme := newTestTheme(t, 1, 2, 2)
+		older, newer := theme.Articles[1].PostID, theme.Articles[2].PostID
+
+		changes := theme.RepairPositions()
+
+		require.Len(t, changes, 1)
+		assert.Equal(t, domain.PositionChange{PostID: newer, OldPosition: 2, NewPosition: 3}, changes[0])
+		assert.Equal(t, 2, positionsByPost(theme)[older])
+	})
+
+	t.Run("archived themes are repaired too", func(t *testing.T) {
+		theme := newTestTheme(t, 3)
+		require.NoError(t, theme.Archive())
+
+		assert.Len(t, theme.RepairPositions(), 1)
+		assert.Equal(t, 1, theme.Articles[0].Position)
+	})
+}
+
+func TestLifecycle(t *testing.T) {
+	t.Run("new themes start as drafts", func(t *testing.T) {
+		theme := newTestTheme(t)
+		assert.Equal(t, domain.ThemeStatusDraft, theme.Status)
+		assert.False(t, theme.IsActive())
+	})
+
+	t.Run("draft to active to archived and back to draft", func(t *testing.T) {
+		theme := newTestTheme(t)
+
+		require.NoError(t, theme.Activate())
+		assert.True(t, theme.IsActive())
+
+		require.NoError(t, theme.Archive())
+		assert.Equal(t, domain.ThemeStatusArchived, theme.Status)
+
+		require.NoError(t, theme.Restore())
+		assert.Equal(t, domain.ThemeStatusDraft, theme.Status)
+	})
+
+	t.Run("archived themes cannot be activated directly", func(t *testing.T) {
+		theme := newTestTheme(t)
+		require.NoError(t, theme.Archive())
+
+		assert.ErrorIs(t, theme.Activate(), domain.ErrInvalidTransition)
+		assert.ErrorIs(t, theme.Deactivate(), domain.ErrInvalidTransition)
+		assert.Equal(t, domain.ThemeStatusArchived, theme.Status)
+	})
+
+	t.Run("only archived themes can be restored", func(t *testing.T) {
+		theme := newTestTheme(t)
+		assert.ErrorIs(t, theme.Restore(), domain.ErrInvalidTransition)
+	})
+
+	t.Run("archived themes freeze their articles", func(t *testing.T) {
+		theme := newTestTheme(t, 1)
+		require.NoError(t, theme.Archive())
+
+		assert.ErrorIs(t, theme.AddArticle(publishedPost{id: uuid.New()}, uuid.New()), domain.ErrThemeArchived)
+		assert.ErrorIs(t, theme.RemoveArticle(theme.Articles[0].PostID), domain.ErrThemeArchived)
+		assert.NoError(t, theme.Update("Retired architecture", theme.Description))
+	})
+}
+
+func TestClone(t *testing.T) {
+	source := newTestTheme(t, 1, 2)
+	source.Articles[1].IsPinned = true
+	source.Articles[1].Note = "Start here"
+	curatorID := uuid.New()
+
+	clone, err := source.Clone(curatorID)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, source.ID, clone.ID)
+	assert.Equal(t, "Architecture (copy)", clone.Name)
+	assert.Equal(t, "architecture-copy", clone.Slug)
+	assert.Equal(t, source.Description, clone.Description)
+	assert.Equal(t, curatorID, clone.CuratorID)
+	assert.Equal(t, domain.ThemeStatusDraft, clone.Status)
+
+	require.Len(t, clone.Articles, 2)
+	for i, article := range clone.Articles {
+		assert.Equal(t, clone.ID, article.ThemeID)
+		assert.Equal(t, source.Articles[i].PostID, article.PostID)
+		assert.Equal(t, source.Articles[i].Position, article.Position)
+		assert.Equal(t, source.Articles[i].IsPinned, article.IsPinned)
+		assert.Equal(t, source.Articles[i].Note, article.Note)
+		assert.Equal(t, curatorID, article.AddedBy)
+	}
+}
+
+func TestCloneShortensLongNames(t *testing.T) {
+	source, err := domain.NewTheme(strings.Repeat("a", domain.MaxNameLength-3)+"é", "", uuid.New())
+	require.NoError(t, err)
+
+	clone, err := source.Clone(uuid.New())
+	require.NoError(t, err)
+
+	assert.LessOrEqual(t, len(clone.Name), domain.MaxNameLength)
+	assert.True(t, strings.HasSuffix(clone.Name, domain.CloneNameSuffix))
+	assert.True(t, utf8.ValidString(clone.Name))
+}
+
+func TestSetArticleNote(t *testing.T) {
+	theme := newTestTheme(t, 1)
+	postID := theme.Articles[0].PostID
+
+	require.NoError(t, theme.SetArticleNote(postID, "Read this first"))
+	assert.Equal(t, "Read this first", theme.Articles[0].Note)
+
+	assert.ErrorIs(t, theme.SetArticleNote(postID, strings.Repeat("a", domain.MaxArticleNoteLength+1)), domain.ErrInvalidArticleNote)
+	assert.ErrorIs(t, theme.SetArticleNote(uuid.New(), "Missing"), domain.ErrArticleNotFound)
+
+	require.NoError(t, theme.SetArticleNote(postID, ""))
+	assert.Empty(t, theme.Articles[0].Note)
+
+	require.NoError(t, theme.Archive())
+	assert.ErrorIs(t, theme.SetArticleNote(postID, "Too late"), domain.ErrThemeArchived)
+}
+
+func TestPinArticle(t *testing.T) {
+	t.Run("only a limited number of articles can be pinned", func(t *testing.T) {
+		theme := newTestTheme(t, 1, 2, 3, 4)
+		for _, article := range theme.Articles[:domain.MaxPinnedArticles] {
+			require.NoError(t, theme.PinArticle(article.PostID))
+		}
+		assert.Equal(t, domain.MaxPinnedArticles, theme.PinnedCount())
+
+		last := theme.Articles[domain.MaxPinnedArticles]
+		assert.ErrorIs(t, theme.PinArticle(last.PostID), domain.ErrPinLimitReached)
+		assert.False(t, last.IsPinned)
+	})
+
+	t.Run("pinning a pinned article again is a no-op at the limit", func(t *testing.T) {
+		theme := newTestTheme(t, 1, 2, 3)
+		for _, article := range theme.Articles {
+			require.NoError(t, theme.PinArticle(article.PostID))
+		}
+
+		assert.NoError(t, theme.PinArticle(theme.Articles[0].PostID))
+		assert.Equal(t, domain.MaxPinnedArticles, theme.PinnedCount())
+	})
+
+	t.Run("unpinning frees a slot", func(t *testing.T) {
+		theme := newTestTheme(t, 1, 2, 3, 4)
+		for _, article := range theme.Articles[:domain.MaxPinnedArticles] {
+			require.NoError(t, theme.PinArticle(article.PostID))
+		}
+
+		require.NoError(t, theme.UnpinArticle(theme.Articles[0].PostID))
+		assert.False(t, theme.Articles[0].IsPinned)
+		assert.NoError(t, theme.UnpinArticle(theme.Articles[0].PostID), "unpinning twice is a no-op")
+
+		require.NoError(t, theme.PinArticle(theme.Articles[3].PostID))
+		assert.Equal(t, domain.MaxPinnedArticles, theme.PinnedCount())
+	})
+
+	t.Run("pins keep positions and need an article of the theme", func(t *testing.T) {
+		theme := newTestTheme(t, 1, 2)
+		require.NoError(t, theme.PinArticle(theme.Articles[1].PostID))
+		assert.Equal(t, 2, theme.Articles[1].Position)
+
+		assert.ErrorIs(t, theme.PinArticle(uuid.New()), domain.ErrArticleNotFound)
+		assert.ErrorIs(t, theme.UnpinArticle(uuid.New()), domain.ErrArticleNotFound)
+	})
+
+	t.Run("archived themes keep their pins", func(t *testing.T) {
+		theme := newTestTheme(t, 1, 2)
+		require.NoError(t, theme.PinArticle(theme.Articles[0].PostID))
+		require.NoError(t, theme.Archive())
+
+		assert.ErrorIs(t, theme.PinArticle(theme.Articles[1].PostID), domain.ErrThemeArchived)
+		assert.ErrorIs(t, theme.UnpinArticle(theme.Articles[0].PostID), domain.ErrThemeArchived)
+	})
+}
+
+func TestReadingMinutes(t *testing.T) {
+	assert.Equal(t, 1, domain.ReadingMinutes(0))
+	assert.Equal(t, 1, domain.ReadingMinutes(120))
+	assert.Equal(t, 1, domain.ReadingMinutes(domain.ReadingWordsPerMinute))
+	assert.Equal(t, 2, domain.ReadingMinutes(domain.ReadingWordsPerMinute+1))
+	assert.Equal(t, 8, domain.ReadingMinutes(1500))
+}
@@ -0,0 +1,33 @@
+package ports
+
+import (
+	"context"
+	"errors"
+
+	"backend/internal/themes/domain"
+	"github.com/google/uuid"
+)
+
+// Collaborator repository errors
+var (
+	// ErrCollaboratorNotFound is returned when a user does not collaborate on a theme
+	ErrCollaboratorNotFound = errors.New("theme collaborator not found")
+
+	// ErrCollaboratorUserNotFound is returned when adding a collaborator who is not a user
+	ErrCollaboratorUserNotFound = errors.New("collaborator user not found")
+)
+
+// CollaboratorRepository persists the collaborators of themes
+type CollaboratorRepository interface {
+	// List returns the collaborators of a theme, earliest added first
+	List(ctx context.Context, themeID uuid.UUID) ([]*domain.Collaborator, error)
+
+	// Find returns one collaborator of a theme
+	Find(ctx context.Context, themeID, userID uuid.UUID) (*domain.Collaborator, error)
+
+	// Save adds a collaborator or changes the role of an existing one
+	Save(ctx context.Context, collaborator *domain.Collaborator) error
+
+	// Remove removes a collaborator, returning ErrCollaboratorNotFound if there was none
+	Remove(ctx context.Context, themeID, userID uuid.UUID) error
+}
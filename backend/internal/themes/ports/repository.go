@@ -7,7 +7,6 @@ import (
 
 	"backend/internal/themes/domain"
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5"
 )
 
 // Repository errors (canonical errors for the repository contract)
@@ -23,46 +22,102 @@ var (
 // Following DDD aggregate pattern: the entire Theme aggregate (including articles)
 // is persisted atomically through a single Save operation
 type ThemeRepository interface {
-	// Transaction support
-	WithTx(tx pgx.Tx) ThemeRepository
-
 	// Core aggregate operations
 	Create(ctx context.Context, theme *domain.Theme) error
 
 	// Save persists the entire aggregate atomically:
+	// - Records a changed slug in slug_history so old links keep resolving
 	// - Updates theme fields in themes table
 	// - Diffs theme.Articles against database state
 	// - Performs necessary INSERTs, UPDATEs, and DELETEs on theme_articles
 	// All within a single transaction
 	Save(ctx context.Context, theme *domain.Theme) error
 
+	// CreateWithArticles inserts a new theme together with its articles.
+	// Like Save, it expects the service to run it inside a transaction.
+	CreateWithArticles(ctx context.Context, theme *domain.Theme) error
+
+	// Delete moves a live theme to the trash, keeping its articles and collaborators
 	Delete(ctx context.Context, id uuid.UUID) error
 
-	// Loading operations
+	// Restore takes a theme out of the trash under slug, which must be free
+	// among live themes; ErrThemeNotFound when the theme is not in the trash
+	Restore(ctx context.Context, id uuid.UUID, slug string) error
+
+	// FindDeletedByID loads a theme in the trash (without articles)
+	FindDeletedByID(ctx context.Context, id uuid.UUID) (*domain.Theme, error)
+
+	// Loading operations - themes in the trash are never found
 	FindByID(ctx context.Context, id uuid.UUID) (*domain.Theme, error)              // Loads theme without articles
 	FindBySlug(ctx context.Context, slug string) (*domain.Theme, error)             // Loads theme without articles
+	FindByPreviousSlug(ctx context.Context, slug string) (*domain.Theme, error)     // Resolves a slug the theme used before being renamed
 	LoadThemeWithArticles(ctx context.Context, id uuid.UUID) (*domain.Theme, error) // Loads full aggregate
 
+	// LockThemeWithArticles loads the full aggregate and locks the theme until the
+	// transaction ends, so edits made from the snapshot cannot overwrite or drop
+	// articles another request changes meanwhile. It must run in a unit of work.
+	LockThemeWithArticles(ctx context.Context, id uuid.UUID) (*domain.Theme, error)
+
+	// ListArticleDetails returns the articles of a theme whose posts readers
+	// may see, with what a theme page shows of each post, pinned articles first
+	ListArticleDetails(ctx context.Context, themeID uuid.UUID) ([]*ArticleDetail, error)
+
+	// ListRelatedThemes returns up to limit other active themes sharing articles
+	// with the theme, those sharing the most first
+	ListRelatedThemes(ctx context.Context, themeID uuid.UUID, limit int) ([]*ThemeSummary, error)
+
 	// Theme listing and filtering
 	ListThemes(ctx context.Context, filter ListFilter) ([]*ThemeSummary, error)
 	CountThemes(ctx context.Context, filter ListFilter) (int, error)
 
-	// Slug operations
+	// Slug operations - slugs of themes in the trash are free
 	SlugExists(ctx context.Context, slug string, excludeID *uuid.UUID) (bool, error)
 
 	// Theme curator operations (for ownership checks)
+	// GetThemeCurator also answers for themes in the trash, so curators may restore them
 	GetThemeCurator(ctx context.Context, themeID uuid.UUID) (uuid.UUID, error)
 	ListThemesByCurator(ctx context.Context, curatorID uuid.UUID) ([]*ThemeSummary, error)
+
+	// RenameCurator sets the curator name copied onto up to limit of the
+	// curator's themes that carry another one, in every blog, and returns the
+	// blog of each theme it changed
+	RenameCurator(ctx context.Context, curatorID uuid.UUID, name string, limit int) ([]uuid.UUID, error)
 }
 
 // ListFilter defines filtering options for theme listings
 type ListFilter struct {
 	CuratorID *uuid.UUID
-	IsActive  *bool
-	Limit     int
-	Offset    int
+	Statuses  []domain.ThemeStatus // Empty selects every status
+
+	// SearchQuery matches themes whose name or description contains it, ignoring case
+	SearchQuery string
+
+	// Deleted lists the themes in the trash instead of the live ones
+	Deleted bool
+
+	Limit  int
+	Offset int
+
+	// ViewerID identifies the requesting user. Drafts and archived themes are
+	// only listed for their own curator; ThemesService.ListThemes enforces it.
+	ViewerID *uuid.UUID
+
+	// Sorting - an empty OrderBy lists the newest themes first
+	OrderBy   OrderField
+	OrderDesc bool
 }
 
+// OrderField represents the field to order themes by
+type OrderField string
+
+const (
+	OrderByCreatedAt    OrderField = "created_at"
+	OrderByUpdatedAt    OrderField = "updated_at"
+	OrderByName         OrderField = "name"
+	OrderByArticleCount OrderField = "article_count"
+	OrderByDeletedAt    OrderField = "deleted_at" // Only meaningful for the trash
+)
+
 // ThemeSummary is a lightweight DTO for theme listings
 type ThemeSummary struct {
 	ID           uuid.UUID
@@ -70,24 +125,27 @@ type ThemeSummary struct {
 	Slug         string
 	Description  string
 	CuratorID    uuid.UUID
-	CuratorName  string // Joined from users table
-	IsActive     bool
+	CuratorName  string // Username of the curator, copied onto the theme
+	Status       domain.ThemeStatus
 	ArticleCount int // Count of articles in the theme
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
+	DeletedAt    *time.Time // Set for themes in the trash
 }
 
 // ArticleDetail provides detailed information about an article in a theme
-// Used when loading a theme with its articles for display
+// Used when presenting a theme's articles to readers without loading each post
 type ArticleDetail struct {
 	Position    int
+	IsPinned    bool
+	Note        string
 	PostID      uuid.UUID
 	PostTitle   string
 	PostSlug    string
 	PostExcerpt string
 	AuthorID    uuid.UUID
 	AuthorName  string
-	AddedBy     uuid.UUID
-	AddedByName string
+	PublishedAt time.Time
+	WordCount   int // Words of the post's text, markup left out
 	AddedAt     time.Time
 }
@@ -0,0 +1,79 @@
+package seeder
+
+import (
+	"context"
+	"fmt"
+
+	postsSeeder "backend/internal/posts/seeder"
+	usersSeeder "backend/internal/users/seeder"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// demoTheme is a sample theme and the posts it curates, in order
+type demoTheme struct {
+	ID          uuid.UUID
+	Name        string
+	Slug        string
+	Description string
+	Status      string
+	PostIDs     []uuid.UUID
+}
+
+var demoThemes = []demoTheme{
+	{
+		uuid.MustParse("00000000-0000-0000-0000-00000000f001"), "Backend Architecture", "backend-architecture",
+		"A reading path through the design of this blog's backend.", "active",
+		[]uuid.UUID{postsSeeder.DemoHexagonalPostID, postsSeeder.DemoEventsPostID, postsSeeder.DemoCachingPostID},
+	},
+	{
+		uuid.MustParse("00000000-0000-0000-0000-00000000f002"), "Performance", "performance",
+		"Making reads cheap.", "draft",
+		[]uuid.UUID{postsSeeder.DemoCachingPostID},
+	},
+}
+
+// DemoThemesSeeder creates sample themes on the default blog, curated by the demo editor
+// It needs the posts created by DemoPostsSeeder
+type DemoThemesSeeder struct{}
+
+// NewDemoThemesSeeder creates a new demo themes seeder
+func NewDemoThemesSeeder() *DemoThemesSeeder {
+	return &DemoThemesSeeder{}
+}
+
+// Name returns the name of this seeder
+func (s *DemoThemesSeeder) Name() string {
+	return "DemoThemesSeeder"
+}
+
+// Seed inserts the demo themes and their articles, leaving existing rows alone
+func (s *DemoThemesSeeder) Seed(ctx context.Context, db *pgxpool.Pool) error {
+	batch := &pgx.Batch{}
+	for _, theme := range demoThemes {
+		batch.Queue(`
+			INSERT INTO themes (id, name, slug, description, curator_id, curator_name, status)
+			VALUES ($1, $2, $3, $4, $5, (SELECT username FROM users WHERE id = $5), $6)
+			ON CONFLICT DO NOTHING`,
+			theme.ID, theme.Name, theme.Slug, theme.Description, usersSeeder.DemoEditorID, theme.Status,
+		)
+		for i, postID := range theme.PostIDs {
+			batch.Queue(`
+				INSERT INTO theme_articles (theme_id, post_id, position, added_by)
+				VALUES ($1, $2, $3, $4)
+				ON CONFLICT DO NOTHING`,
+				theme.ID, postID, i+1, usersSeeder.DemoEditorID,
+			)
+		}
+	}
+
+	br := db.SendBatch(ctx, batch)
+	for i := 0; i < batch.Len(); i++ {
+		if _, err := br.Exec(); err != nil {
+			_ = br.Close()
+			return fmt.Errorf("failed to insert demo theme: %w", err)
+		}
+	}
+	return br.Close()
+}
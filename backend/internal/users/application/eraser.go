@@ -0,0 +1,30 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"backend/internal/platform/erasure"
+	"backend/internal/users/ports"
+)
+
+// UsersEraser erases the personal data on a user's account: their email,
+// login and profile, and the usernames they used before
+type UsersEraser struct {
+	repo ports.UserRepository
+}
+
+// NewUsersEraser creates a new users eraser
+func NewUsersEraser(repo ports.UserRepository) *UsersEraser {
+	return &UsersEraser{repo: repo}
+}
+
+// Erase scrubs the subject's account and renames it to their pseudonym
+// Implements the erasure.Eraser interface
+func (e *UsersEraser) Erase(ctx context.Context, subject erasure.Subject) (int, error) {
+	n, err := e.repo.Erase(ctx, subject.UserID.String(), subject.Pseudonym)
+	if err != nil {
+		return 0, fmt.Errorf("UsersEraser.Erase: %w", err)
+	}
+	return n, nil
+}
@@ -0,0 +1,53 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend/internal/users/ports"
+	"github.com/google/uuid"
+)
+
+// ExportedProfile is a user's account as written to their data export
+type ExportedProfile struct {
+	ID          string     `json:"id"`
+	Email       string     `json:"email"`
+	Username    string     `json:"username"`
+	DisplayName string     `json:"displayName,omitempty"`
+	Bio         string     `json:"bio,omitempty"`
+	AvatarURL   string     `json:"avatarUrl,omitempty"`
+	SuspendedAt *time.Time `json:"suspendedAt,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+}
+
+// UsersExporter exports a user's account and profile
+type UsersExporter struct {
+	repo ports.UserRepository
+}
+
+// NewUsersExporter creates a new users exporter
+func NewUsersExporter(repo ports.UserRepository) *UsersExporter {
+	return &UsersExporter{repo: repo}
+}
+
+// Export returns the user's profile
+// Implements the dataexport.Exporter interface
+func (e *UsersExporter) Export(ctx context.Context, userID uuid.UUID) (any, error) {
+	user, err := e.repo.FindByID(ctx, userID.String())
+	if err != nil {
+		return nil, fmt.Errorf("UsersExporter.Export: %w", err)
+	}
+	return ExportedProfile{
+		ID:          user.ID,
+		Email:       user.Email,
+		Username:    user.Username,
+		DisplayName: user.DisplayName,
+		Bio:         user.Bio,
+		AvatarURL:   user.AvatarURL,
+		SuspendedAt: user.SuspendedAt,
+		CreatedAt:   user.CreatedAt,
+		UpdatedAt:   user.UpdatedAt,
+	}, nil
+}
@@ -5,4 +5,8 @@ import "github.com/google/wire"
 // ProviderSet is the wire provider set for application services
 var ProviderSet = wire.NewSet(
 	NewUserService,
+	NewRoleAdapter,
+	NewUsersEraser,
+	NewUsersExporter,
+	wire.Bind(new(RoleAssigner), new(*RoleAdapter)),
 )
@@ -0,0 +1,31 @@
+package application
+
+import (
+	"context"
+
+	authzApp "backend/internal/authz/application"
+	"github.com/google/uuid"
+)
+
+// RoleAdapter implements the RoleAssigner interface
+// It adapts the authz service to grant roles to onboarding users
+type RoleAdapter struct {
+	authzService *authzApp.AuthzService
+}
+
+// NewRoleAdapter creates a new role adapter
+func NewRoleAdapter(authzService *authzApp.AuthzService) *RoleAdapter {
+	return &RoleAdapter{
+		authzService: authzService,
+	}
+}
+
+// GetUserRoles lists the names of the roles a user holds on the request's blog
+func (a *RoleAdapter) GetUserRoles(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	return a.authzService.GetUserRoles(ctx, userID)
+}
+
+// AssignRoleByName grants a role by name on the request's blog
+func (a *RoleAdapter) AssignRoleByName(ctx context.Context, userID uuid.UUID, roleName string, grantedBy uuid.UUID) error {
+	return a.authzService.AssignRoleByName(ctx, userID, roleName, grantedBy)
+}
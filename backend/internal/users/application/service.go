@@ -2,11 +2,18 @@ package application
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"time"
 
+	"backend/internal/platform/actor"
 	"backend/internal/platform/apperror"
+	"backend/internal/platform/eventbus"
+	"backend/internal/platform/events"
+	"backend/internal/platform/validator"
 	"backend/internal/users/domain"
 	"backend/internal/users/ports"
+	"github.com/google/uuid"
 )
 
 var (
@@ -28,12 +35,6 @@ var (
 		"username already taken",
 		http.StatusConflict,
 	)
-	ErrSupabaseIDAlreadyExists = apperror.New(
-		apperror.CodeConflict,
-		apperror.BusinessCodeSupabaseIDExists,
-		"user with this Supabase ID already exists",
-		http.StatusConflict,
-	)
 	ErrMissingSupabaseID = apperror.New(
 		apperror.CodeValidationFailed,
 		apperror.BusinessCodeMissingRequiredField,
@@ -52,8 +53,45 @@ var (
 		"username is required",
 		http.StatusBadRequest,
 	).WithDetails(map[string]string{"field": "username"})
+	ErrInvalidUsername = apperror.New(
+		apperror.CodeValidationFailed,
+		apperror.BusinessCodeInvalidUsername,
+		"invalid username",
+		http.StatusBadRequest,
+	)
+	ErrReservedUsername = apperror.New(
+		apperror.CodeValidationFailed,
+		apperror.BusinessCodeUsernameReserved,
+		"username is reserved",
+		http.StatusBadRequest,
+	)
+	ErrInvalidTimezone = apperror.New(
+		apperror.CodeValidationFailed,
+		apperror.BusinessCodeInvalidTimezone,
+		domain.ErrInvalidTimezone.Error(),
+		http.StatusBadRequest,
+	).WithDetails(map[string]string{"field": "timezone"})
+)
+
+// Reasons a username is unavailable
+const (
+	UsernameInvalid  = "invalid"
+	UsernameReserved = "reserved"
+	UsernameTaken    = "taken"
 )
 
+// RoleAssigner grants roles to users
+// This avoids direct dependency on the authz bounded context
+type RoleAssigner interface {
+	GetUserRoles(ctx context.Context, userID uuid.UUID) ([]string, error)
+	AssignRoleByName(ctx context.Context, userID uuid.UUID, roleName string, grantedBy uuid.UUID) error
+}
+
+// OnboardingConfig sets what new users start with
+type OnboardingConfig struct {
+	DefaultRole string // Role granted on registration; empty grants none
+}
+
 // CreateUserParams contains all parameters needed to create a new user
 type CreateUserParams struct {
 	SupabaseID  string
@@ -72,57 +110,84 @@ type UpdateUserParams struct {
 	AvatarURL   string
 }
 
+// UsernameAvailability tells whether a username can be registered, and if not, why
+type UsernameAvailability struct {
+	Username  string
+	Available bool
+	Reason    string // One of the Username* reasons; empty when available
+}
+
 type UserService struct {
-	repo ports.UserRepository
+	repo     ports.UserRepository
+	roles    RoleAssigner
+	eventBus *eventbus.Bus
+	config   OnboardingConfig
 }
 
-func NewUserService(repo ports.UserRepository) *UserService {
+func NewUserService(repo ports.UserRepository, roles RoleAssigner, eventBus *eventbus.Bus, config OnboardingConfig) *UserService {
 	return &UserService{
-		repo: repo,
+		repo:     repo,
+		roles:    roles,
+		eventBus: eventBus,
+		config:   config,
 	}
 }
 
-func (s *UserService) CreateUser(ctx context.Context, params CreateUserParams) (*domain.User, error) {
+// CreateUser onboards the owner of a Supabase account: it saves their
+// profile, announces the registration and grants the default role.
+// Retrying is safe. Once the profile exists it is returned as is, with
+// created false, after finishing any step an earlier attempt left undone.
+func (s *UserService) CreateUser(ctx context.Context, params CreateUserParams) (*domain.User, bool, error) {
 	// Validate required fields
 	if params.SupabaseID == "" {
-		return nil, ErrMissingSupabaseID
+		return nil, false, ErrMissingSupabaseID
 	}
 	if params.Email == "" {
-		return nil, ErrMissingEmail
+		return nil, false, ErrMissingEmail
 	}
 	if params.Username == "" {
-		return nil, ErrMissingUsername
+		return nil, false, ErrMissingUsername
 	}
-	// Check if user already exists with this Supabase ID
+
+	// A retry of an onboarding that already saved the profile
 	existingUser, err := s.repo.FindBySupabaseID(ctx, params.SupabaseID)
-	if err == nil && existingUser != nil {
-		return nil, ErrSupabaseIDAlreadyExists
+	if err != nil {
+		return nil, false, apperror.Wrap(err, apperror.CodeInternalError, apperror.BusinessCodeGeneral,
+			"failed to find user", http.StatusInternalServerError)
+	}
+	if existingUser != nil {
+		return s.resumeOnboarding(ctx, existingUser)
+	}
+
+	username := validator.NormalizeUsername(params.Username)
+	if err := domain.ValidateUsername(username); err != nil {
+		return nil, false, usernameError(err)
 	}
 
-	// Check if username is already taken
-	exists, err := s.repo.ExistsByUsername(ctx, params.Username)
+	// Check if username is already taken, now or as someone's former name
+	holder, err := s.repo.UsernameHolder(ctx, username)
 	if err != nil {
-		return nil, apperror.Wrap(err, apperror.CodeInternalError, apperror.BusinessCodeGeneral,
+		return nil, false, apperror.Wrap(err, apperror.CodeInternalError, apperror.BusinessCodeGeneral,
 			"failed to check username availability", http.StatusInternalServerError)
 	}
-	if exists {
-		return nil, ErrUsernameAlreadyExists
+	if holder != "" {
+		return nil, false, ErrUsernameAlreadyExists
 	}
 
 	// Check if email is already registered
-	exists, err = s.repo.ExistsByEmail(ctx, params.Email)
+	exists, err := s.repo.ExistsByEmail(ctx, params.Email)
 	if err != nil {
-		return nil, apperror.Wrap(err, apperror.CodeInternalError, apperror.BusinessCodeGeneral,
+		return nil, false, apperror.Wrap(err, apperror.CodeInternalError, apperror.BusinessCodeGeneral,
 			"failed to check email availability", http.StatusInternalServerError)
 	}
 	if exists {
-		return nil, ErrEmailAlreadyExists
+		return nil, false, ErrEmailAlreadyExists
 	}
 
 	// Create new user domain object
-	user, err := domain.NewUser(params.SupabaseID, params.Email, params.Username)
+	user, err := domain.NewUser(params.SupabaseID, params.Email, username)
 	if err != nil {
-		return nil, apperror.Wrap(err, apperror.CodeValidationFailed, apperror.BusinessCodeInvalidFormat,
+		return nil, false, apperror.Wrap(err, apperror.CodeValidationFailed, apperror.BusinessCodeInvalidFormat,
 			"failed to create user", http.StatusBadRequest)
 	}
 
@@ -131,10 +196,137 @@ func (s *UserService) CreateUser(ctx context.Context, params CreateUserParams) (
 
 	// Persist to repository
 	if err := s.repo.Create(ctx, user); err != nil {
-		return nil, apperror.Wrap(err, apperror.CodeInternalError, apperror.BusinessCodeGeneral,
+		// A concurrent attempt for the same account may have saved it first
+		if existingUser, findErr := s.repo.FindBySupabaseID(ctx, params.SupabaseID); findErr == nil && existingUser != nil {
+			return s.resumeOnboarding(ctx, existingUser)
+		}
+		if errors.Is(err, ports.ErrUsernameTaken) {
+			return nil, false, ErrUsernameAlreadyExists
+		}
+		return nil, false, apperror.Wrap(err, apperror.CodeInternalError, apperror.BusinessCodeGeneral,
 			"failed to save user", http.StatusInternalServerError)
 	}
 
+	// Announced before the role is granted: a retry after a failed grant
+	// finds the saved profile and must not welcome the user twice
+	s.publishUserRegisteredEvent(ctx, user)
+
+	if err := s.grantDefaultRole(ctx, user); err != nil {
+		return nil, false, err
+	}
+
+	return user, true, nil
+}
+
+// CheckUsernameAvailability tells whether a username could be registered right
+// now. The username is reported back normalized, as it would be saved.
+func (s *UserService) CheckUsernameAvailability(ctx context.Context, username string) (*UsernameAvailability, error) {
+	username = validator.NormalizeUsername(username)
+	availability := &UsernameAvailability{Username: username}
+
+	switch err := domain.ValidateUsername(username); {
+	case errors.Is(err, domain.ErrReservedUsername):
+		availability.Reason = UsernameReserved
+		return availability, nil
+	case err != nil:
+		availability.Reason = UsernameInvalid
+		return availability, nil
+	}
+
+	holder, err := s.repo.UsernameHolder(ctx, username)
+	if err != nil {
+		return nil, apperror.Wrap(err, apperror.CodeInternalError, apperror.BusinessCodeGeneral,
+			"failed to check username availability", http.StatusInternalServerError)
+	}
+	if holder != "" {
+		availability.Reason = UsernameTaken
+		return availability, nil
+	}
+
+	availability.Available = true
+	return availability, nil
+}
+
+// RenameUser changes a user's username. The previous one keeps resolving to
+// the user's author page, and only they may take it back.
+func (s *UserService) RenameUser(ctx context.Context, id string, username string) (*domain.User, error) {
+	user, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, apperror.Wrap(err, apperror.CodeInternalError, apperror.BusinessCodeGeneral,
+			"failed to find user", http.StatusInternalServerError)
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	username = validator.NormalizeUsername(username)
+	if username == user.Username {
+		return user, nil
+	}
+
+	previous, err := user.Rename(username)
+	if err != nil {
+		return nil, usernameError(err)
+	}
+
+	holder, err := s.repo.UsernameHolder(ctx, username)
+	if err != nil {
+		return nil, apperror.Wrap(err, apperror.CodeInternalError, apperror.BusinessCodeGeneral,
+			"failed to check username availability", http.StatusInternalServerError)
+	}
+	if holder != "" && holder != user.ID {
+		return nil, ErrUsernameAlreadyExists
+	}
+
+	if err := s.repo.ChangeUsername(ctx, user, previous); err != nil {
+		if errors.Is(err, ports.ErrUsernameTaken) {
+			return nil, ErrUsernameAlreadyExists
+		}
+		return nil, apperror.Wrap(err, apperror.CodeInternalError, apperror.BusinessCodeGeneral,
+			"failed to rename user", http.StatusInternalServerError)
+	}
+
+	s.publishUserUpdatedEvent(ctx, user)
+	return user, nil
+}
+
+// SetUserTimezone changes the zone a user's times are read and shown in
+func (s *UserService) SetUserTimezone(ctx context.Context, id string, name string) (*domain.User, error) {
+	user, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, apperror.Wrap(err, apperror.CodeInternalError, apperror.BusinessCodeGeneral,
+			"failed to find user", http.StatusInternalServerError)
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	if err := user.SetTimezone(name); err != nil {
+		return nil, ErrInvalidTimezone
+	}
+
+	if err := s.repo.Update(ctx, user); err != nil {
+		return nil, apperror.Wrap(err, apperror.CodeInternalError, apperror.BusinessCodeGeneral,
+			"failed to update user", http.StatusInternalServerError)
+	}
+	return user, nil
+}
+
+// GetAuthorByUsername finds the public profile behind a username, following
+// renames: a former username finds the user under their current one.
+// Suspended users have no public profile.
+func (s *UserService) GetAuthorByUsername(ctx context.Context, username string) (*domain.User, error) {
+	user, err := s.repo.FindByUsername(ctx, username)
+	if err == nil && user == nil {
+		user, err = s.repo.FindByRetiredUsername(ctx, username)
+	}
+	if err != nil {
+		return nil, apperror.Wrap(err, apperror.CodeInternalError, apperror.BusinessCodeGeneral,
+			"failed to find user", http.StatusInternalServerError)
+	}
+	if user == nil || user.IsSuspended() {
+		return nil, ErrUserNotFound
+	}
 	return user, nil
 }
 
@@ -162,6 +354,17 @@ func (s *UserService) GetUserByID(ctx context.Context, id string) (*domain.User,
 	return user, nil
 }
 
+// GetUsersByIDs loads several users in one query, for embedding authors in listings
+// Unknown IDs are left out rather than reported as not found
+func (s *UserService) GetUsersByIDs(ctx context.Context, ids []string) ([]*domain.User, error) {
+	users, err := s.repo.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, apperror.Wrap(err, apperror.CodeInternalError, apperror.BusinessCodeGeneral,
+			"failed to find users", http.StatusInternalServerError)
+	}
+	return users, nil
+}
+
 func (s *UserService) UpdateUserProfile(ctx context.Context, params UpdateUserParams) (*domain.User, error) {
 	user, err := s.repo.FindByID(ctx, params.UserID)
 	if err != nil {
@@ -179,5 +382,143 @@ func (s *UserService) UpdateUserProfile(ctx context.Context, params UpdateUserPa
 			"failed to update user", http.StatusInternalServerError)
 	}
 
+	s.publishUserUpdatedEvent(ctx, user)
 	return user, nil
 }
+
+// ListUsers returns a page of the users matching filter for administration, and how many match
+// Callers are responsible for checking that the actor may read any user
+func (s *UserService) ListUsers(ctx context.Context, filter ports.ListFilter) ([]*ports.ListedUser, int, error) {
+	users, total, err := s.repo.List(ctx, filter)
+	if err != nil {
+		return nil, 0, apperror.Wrap(err, apperror.CodeInternalError, apperror.BusinessCodeGeneral,
+			"failed to list users", http.StatusInternalServerError)
+	}
+	return users, total, nil
+}
+
+// SuspendUser suspends a user account, barring it from authenticated requests
+// Callers are responsible for checking that the actor may suspend users
+func (s *UserService) SuspendUser(ctx context.Context, id string) (*domain.User, error) {
+	user, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, apperror.Wrap(err, apperror.CodeInternalError, apperror.BusinessCodeGeneral,
+			"failed to find user", http.StatusInternalServerError)
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	if user.IsSuspended() {
+		return user, nil
+	}
+
+	user.Suspend()
+
+	if err := s.repo.Update(ctx, user); err != nil {
+		return nil, apperror.Wrap(err, apperror.CodeInternalError, apperror.BusinessCodeGeneral,
+			"failed to suspend user", http.StatusInternalServerError)
+	}
+
+	s.publishSessionsRevokedEvent(ctx, user, "account_suspended")
+	return user, nil
+}
+
+// resumeOnboarding finishes the onboarding of a saved user
+func (s *UserService) resumeOnboarding(ctx context.Context, user *domain.User) (*domain.User, bool, error) {
+	if err := s.grantDefaultRole(ctx, user); err != nil {
+		return nil, false, err
+	}
+	return user, false, nil
+}
+
+// grantDefaultRole gives a user without roles the configured default role
+// Users already holding a role were set up by an admin and are left alone
+func (s *UserService) grantDefaultRole(ctx context.Context, user *domain.User) error {
+	if s.config.DefaultRole == "" {
+		return nil
+	}
+	userID, err := uuid.Parse(user.ID)
+	if err != nil {
+		return apperror.Wrap(err, apperror.CodeInternalError, apperror.BusinessCodeGeneral,
+			"invalid user ID", http.StatusInternalServerError)
+	}
+
+	roles, err := s.roles.GetUserRoles(ctx, userID)
+	if err != nil {
+		return apperror.Wrap(err, apperror.CodeInternalError, apperror.BusinessCodeGeneral,
+			"failed to load user roles", http.StatusInternalServerError)
+	}
+	if len(roles) > 0 {
+		return nil
+	}
+
+	if err := s.roles.AssignRoleByName(ctx, userID, s.config.DefaultRole, userID); err != nil {
+		return apperror.Wrap(err, apperror.CodeInternalError, apperror.BusinessCodeGeneral,
+			"failed to assign default role", http.StatusInternalServerError)
+	}
+	return nil
+}
+
+// usernameError maps a username policy error to its API error
+func usernameError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrReservedUsername):
+		return ErrReservedUsername
+	default:
+		return ErrInvalidUsername.WithDetails(err.Error())
+	}
+}
+
+// publishUserRegisteredEvent announces a new user, e.g. so they are welcomed
+func (s *UserService) publishUserRegisteredEvent(ctx context.Context, user *domain.User) {
+	userID, err := uuid.Parse(user.ID)
+	if err != nil {
+		// IDs are assigned by the repository on save, so this cannot happen for a saved user
+		return
+	}
+	s.eventBus.Publish(ctx, eventbus.Event{
+		Topic: events.UserRegisteredTopic,
+		Payload: events.UserRegisteredEvent{
+			UserID:     userID,
+			Username:   user.Username,
+			OccurredAt: time.Now(),
+		},
+	})
+}
+
+// publishUserUpdatedEvent lets modules holding copies of the user's name rewrite them
+func (s *UserService) publishUserUpdatedEvent(ctx context.Context, user *domain.User) {
+	userID, err := uuid.Parse(user.ID)
+	if err != nil {
+		// IDs are read back from the database, so this cannot happen for a saved user
+		return
+	}
+	s.eventBus.Publish(ctx, eventbus.Event{
+		Topic: events.UserUpdatedTopic,
+		Payload: events.UserUpdatedEvent{
+			UserID:      userID,
+			ActorID:     actor.UserIDOr(ctx, userID),
+			Username:    user.Username,
+			DisplayName: user.DisplayName,
+			OccurredAt:  time.Now(),
+		},
+	})
+}
+
+// publishSessionsRevokedEvent records in the user's security log that their sessions stopped working
+func (s *UserService) publishSessionsRevokedEvent(ctx context.Context, user *domain.User, reason string) {
+	userID, err := uuid.Parse(user.ID)
+	if err != nil {
+		// IDs are read back from the database, so this cannot happen for a saved user
+		return
+	}
+	s.eventBus.Publish(ctx, eventbus.Event{
+		Topic: events.UserSessionsRevokedTopic,
+		Payload: events.UserSessionsRevokedEvent{
+			UserID:     userID,
+			ActorID:    actor.UserIDOr(ctx, uuid.Nil),
+			Reason:     reason,
+			OccurredAt: time.Now(),
+		},
+	})
+}
@@ -4,18 +4,21 @@ import (
 	"errors"
 	"regexp"
 	"time"
+
+	"backend/internal/platform/timezone"
+	"backend/internal/platform/validator"
 )
 
 var (
-	ErrInvalidUsername  = errors.New("invalid username format")
-	ErrUsernameTooShort = errors.New("username must be at least 3 characters")
-	ErrUsernameTooLong  = errors.New("username must not exceed 30 characters")
+	ErrInvalidUsername  = validator.ErrInvalidUsernameFormat
+	ErrUsernameTooShort = validator.ErrUsernameTooShort
+	ErrUsernameTooLong  = validator.ErrUsernameTooLong
+	ErrReservedUsername = validator.ErrUsernameReserved
 	ErrInvalidEmail     = errors.New("invalid email format")
 	ErrEmptySupabaseID  = errors.New("supabase ID cannot be empty")
+	ErrInvalidTimezone  = timezone.ErrInvalid
 )
 
-var usernameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
-
 type User struct {
 	ID          string
 	SupabaseID  string
@@ -24,10 +27,13 @@ type User struct {
 	DisplayName string
 	Bio         string
 	AvatarURL   string
+	Timezone    string     // IANA name of the zone the user reads and writes times in
+	SuspendedAt *time.Time // Set while a moderator has suspended the account
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
 
+// NewUser creates a user, normalizing the username as ValidateUsername does
 func NewUser(supabaseID, email, username string) (*User, error) {
 	if err := validateSupabaseID(supabaseID); err != nil {
 		return nil, err
@@ -37,7 +43,8 @@ func NewUser(supabaseID, email, username string) (*User, error) {
 		return nil, err
 	}
 
-	if err := validateUsername(username); err != nil {
+	username = validator.NormalizeUsername(username)
+	if err := ValidateUsername(username); err != nil {
 		return nil, err
 	}
 
@@ -46,6 +53,7 @@ func NewUser(supabaseID, email, username string) (*User, error) {
 		SupabaseID: supabaseID,
 		Email:      email,
 		Username:   username,
+		Timezone:   timezone.Default,
 		CreatedAt:  now,
 		UpdatedAt:  now,
 	}, nil
@@ -64,6 +72,53 @@ func (u *User) UpdateProfile(displayName, bio, avatarURL string) {
 	u.UpdatedAt = time.Now()
 }
 
+// SetTimezone changes the zone the user reads and writes times in
+func (u *User) SetTimezone(name string) error {
+	loc, err := timezone.Load(name)
+	if err != nil {
+		return err
+	}
+	u.Timezone = loc.String()
+	u.UpdatedAt = time.Now()
+	return nil
+}
+
+// Location returns the user's zone, or UTC when the stored name no longer loads
+func (u *User) Location() *time.Location {
+	loc, err := timezone.Load(u.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// IsSuspended reports whether the account is suspended
+func (u *User) IsSuspended() bool {
+	return u.SuspendedAt != nil
+}
+
+// Rename changes the username, returning the one it replaces
+func (u *User) Rename(username string) (string, error) {
+	username = validator.NormalizeUsername(username)
+	if err := ValidateUsername(username); err != nil {
+		return "", err
+	}
+	previous := u.Username
+	u.Username = username
+	u.UpdatedAt = time.Now()
+	return previous, nil
+}
+
+// Suspend bars the user from the API; suspending a suspended user keeps the original time
+func (u *User) Suspend() {
+	if u.IsSuspended() {
+		return
+	}
+	now := time.Now()
+	u.SuspendedAt = &now
+	u.UpdatedAt = now
+}
+
 func validateSupabaseID(id string) error {
 	if id == "" {
 		return ErrEmptySupabaseID
@@ -82,15 +137,7 @@ func validateEmail(email string) error {
 	return nil
 }
 
-func validateUsername(username string) error {
-	if len(username) < 3 {
-		return ErrUsernameTooShort
-	}
-	if len(username) > 30 {
-		return ErrUsernameTooLong
-	}
-	if !usernameRegex.MatchString(username) {
-		return ErrInvalidUsername
-	}
-	return nil
+// ValidateUsername checks a normalized username against the shared username policy
+func ValidateUsername(username string) error {
+	return validator.ValidateUsername(username)
 }
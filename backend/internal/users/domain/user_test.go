@@ -0,0 +1,48 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"backend/internal/users/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUserNormalizesUsername(t *testing.T) {
+	user, err := domain.NewUser("supabase-id", "ada@example.com", "ＡｄａＬ")
+	require.NoError(t, err)
+	assert.Equal(t, "AdaL", user.Username)
+
+	_, err = domain.NewUser("supabase-id", "ada@example.com", "Admin")
+	assert.ErrorIs(t, err, domain.ErrReservedUsername)
+}
+
+func TestUserRename(t *testing.T) {
+	user, err := domain.NewUser("supabase-id", "ada@example.com", "ada")
+	require.NoError(t, err)
+
+	previous, err := user.Rename("ada_lovelace")
+	require.NoError(t, err)
+	assert.Equal(t, "ada", previous)
+	assert.Equal(t, "ada_lovelace", user.Username)
+
+	_, err = user.Rename("x")
+	assert.ErrorIs(t, err, domain.ErrUsernameTooShort)
+	assert.Equal(t, "ada_lovelace", user.Username)
+}
+
+func TestUserSetTimezone(t *testing.T) {
+	user, err := domain.NewUser("supabase-id", "ada@example.com", "ada")
+	require.NoError(t, err)
+	assert.Equal(t, "UTC", user.Timezone)
+	assert.Equal(t, time.UTC, user.Location())
+
+	require.NoError(t, user.SetTimezone("Asia/Taipei"))
+	assert.Equal(t, "Asia/Taipei", user.Timezone)
+	assert.Equal(t, "Asia/Taipei", user.Location().String())
+
+	err = user.SetTimezone("Asia/Atlantis")
+	assert.ErrorIs(t, err, domain.ErrInvalidTimezone)
+	assert.Equal(t, "Asia/Taipei", user.Timezone)
+}
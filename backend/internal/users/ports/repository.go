@@ -2,17 +2,51 @@ package ports
 
 import (
 	"context"
+	"errors"
 
 	"backend/internal/users/domain"
 )
 
+// ErrUsernameTaken is returned when a username is claimed by another user in the meantime
+var ErrUsernameTaken = errors.New("username taken")
+
+// ListFilter selects users for the admin listing
+type ListFilter struct {
+	Search    string // Part of the email or username, matched case-insensitively; empty matches all
+	Role      string // Only holders of the role on the current blog; empty matches all
+	Suspended *bool  // Nil lists suspended and active users alike
+	Limit     int
+	Offset    int
+}
+
+// ListedUser is a user in the admin listing, with the roles they hold on the current blog
+type ListedUser struct {
+	User  *domain.User
+	Roles []string
+}
+
 type UserRepository interface {
 	Create(ctx context.Context, user *domain.User) error
 	FindByID(ctx context.Context, id string) (*domain.User, error)
+	FindByIDs(ctx context.Context, ids []string) ([]*domain.User, error)
 	FindBySupabaseID(ctx context.Context, supabaseID string) (*domain.User, error)
+	// FindByUsername matches the current username by its key; see validator.UsernameKey
 	FindByUsername(ctx context.Context, username string) (*domain.User, error)
+	// FindByRetiredUsername finds the user who gave up a username by renaming
+	FindByRetiredUsername(ctx context.Context, username string) (*domain.User, error)
 	FindByEmail(ctx context.Context, email string) (*domain.User, error)
 	Update(ctx context.Context, user *domain.User) error
-	ExistsByUsername(ctx context.Context, username string) (bool, error)
+	// ChangeUsername saves the user's new username and keeps the previous one
+	// for redirects, atomically; it returns ErrUsernameTaken on a lost race
+	ChangeUsername(ctx context.Context, user *domain.User, previous string) error
+	// UsernameHolder returns the ID of the user holding a username, as their
+	// current or a retired name, or an empty string when the name is free
+	UsernameHolder(ctx context.Context, username string) (string, error)
 	ExistsByEmail(ctx context.Context, email string) (bool, error)
+	// List returns a page of the users matching filter, newest first, and how many match
+	List(ctx context.Context, filter ListFilter) ([]*ListedUser, int, error)
+	// Erase replaces the user's email, login and profile with placeholders,
+	// renames them to pseudonym and forgets their retired usernames, atomically;
+	// it returns how many rows changed
+	Erase(ctx context.Context, id string, pseudonym string) (int, error)
 }
@@ -0,0 +1,78 @@
+package seeder
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Fixed IDs of the demo users, so the other demo seeders can attribute content to them
+var (
+	DemoAdminID  = uuid.MustParse("00000000-0000-0000-0000-00000000d001")
+	DemoEditorID = uuid.MustParse("00000000-0000-0000-0000-00000000d002")
+	DemoAuthorID = uuid.MustParse("00000000-0000-0000-0000-00000000d003")
+	DemoReaderID = uuid.MustParse("00000000-0000-0000-0000-00000000d004")
+)
+
+// demoUser is a sample account and the role it holds on every blog
+type demoUser struct {
+	ID          uuid.UUID
+	Username    string
+	DisplayName string
+	Bio         string
+	Role        string
+}
+
+var demoUsers = []demoUser{
+	{DemoAdminID, "demo-admin", "Ada Admin", "Keeps the lights on.", "admin"},
+	{DemoEditorID, "demo-editor", "Eddie Editor", "Reviews everything twice.", "editor"},
+	{DemoAuthorID, "demo-author", "Aria Author", "Writes about software architecture.", "author"},
+	{DemoReaderID, "demo-reader", "Rey Reader", "", "subscriber"},
+}
+
+// DemoUsersSeeder creates sample accounts for local development
+// Their Supabase IDs are placeholders: to sign in as one, point its supabase_id
+// at an account in the local Supabase instance
+type DemoUsersSeeder struct{}
+
+// NewDemoUsersSeeder creates a new demo users seeder
+func NewDemoUsersSeeder() *DemoUsersSeeder {
+	return &DemoUsersSeeder{}
+}
+
+// Name returns the name of this seeder
+func (s *DemoUsersSeeder) Name() string {
+	return "DemoUsersSeeder"
+}
+
+// Seed inserts the demo users and their roles, leaving existing rows alone
+// It needs the roles created by AuthzSeeder
+func (s *DemoUsersSeeder) Seed(ctx context.Context, db *pgxpool.Pool) error {
+	batch := &pgx.Batch{}
+	for _, user := range demoUsers {
+		batch.Queue(`
+			INSERT INTO users (id, supabase_id, email, username, display_name, bio)
+			VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''))
+			ON CONFLICT DO NOTHING`,
+			user.ID, "demo-"+user.ID.String(), user.Username+"@example.com", user.Username, user.DisplayName, user.Bio,
+		)
+		batch.Queue(`
+			INSERT INTO user_roles (user_id, role_id)
+			SELECT $1, id FROM roles WHERE name = $2
+			ON CONFLICT DO NOTHING`,
+			user.ID, user.Role,
+		)
+	}
+
+	br := db.SendBatch(ctx, batch)
+	for i := 0; i < batch.Len(); i++ {
+		if _, err := br.Exec(); err != nil {
+			_ = br.Close()
+			return fmt.Errorf("failed to insert demo user: %w", err)
+		}
+	}
+	return br.Close()
+}
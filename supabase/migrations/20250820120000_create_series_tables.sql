@@ -0,0 +1,76 @@
+-- Create series table
+-- A series is an author-owned, ordered sequence of that author's own posts
+CREATE TABLE series (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    title VARCHAR(200) NOT NULL,
+    slug VARCHAR(250) UNIQUE NOT NULL,
+    description VARCHAR(1000),
+    author_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- Data integrity constraints
+    CONSTRAINT check_series_title_not_empty
+        CHECK (LENGTH(TRIM(title)) > 0),
+
+    CONSTRAINT check_series_slug_format
+        CHECK (slug ~ '^[a-z0-9-]+$')
+);
+
+-- Create indexes for series
+CREATE INDEX idx_series_author_id ON series(author_id);
+CREATE INDEX idx_series_created_at ON series(created_at DESC);
+
+-- Create series_posts table (ordered membership of posts in a series)
+CREATE TABLE series_posts (
+    series_id UUID NOT NULL REFERENCES series(id) ON DELETE CASCADE,
+    post_id UUID NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL CHECK (position > 0),
+    added_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (series_id, post_id),
+    -- A post can belong to at most one series so navigation is unambiguous
+    CONSTRAINT unique_series_post UNIQUE (post_id),
+    -- Positions are unique within a series; deferred so reorders can swap positions
+    CONSTRAINT unique_series_position UNIQUE (series_id, position) DEFERRABLE INITIALLY DEFERRED
+);
+
+-- Create a function to check the post belongs to the series author
+CREATE OR REPLACE FUNCTION check_series_post_author()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM posts p
+        JOIN series s ON s.id = NEW.series_id
+        WHERE p.id = NEW.post_id
+        AND p.author_id = s.author_id
+    ) THEN
+        RAISE EXCEPTION 'Only the series author''s own posts can be added to a series';
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Create trigger to enforce the business rule
+CREATE TRIGGER ensure_series_post_author
+    BEFORE INSERT OR UPDATE ON series_posts
+    FOR EACH ROW
+    EXECUTE FUNCTION check_series_post_author();
+
+-- Create indexes for series_posts
+CREATE INDEX idx_series_posts_position ON series_posts(series_id, position);
+
+-- Create updated_at triggers
+CREATE TRIGGER update_series_updated_at BEFORE UPDATE ON series
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_series_posts_updated_at BEFORE UPDATE ON series_posts
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Add comments for documentation
+COMMENT ON TABLE series IS 'Author-owned ordered sequences of posts';
+COMMENT ON TABLE series_posts IS 'Junction table linking posts to a series with ordering';
+
+COMMENT ON COLUMN series.author_id IS 'User who owns the series; only their posts may be added';
+COMMENT ON COLUMN series_posts.position IS '1-based position of the post within the series';
@@ -0,0 +1,22 @@
+-- Add featured flag to posts
+-- Featured posts are curated by editors and ordered by when they were featured
+ALTER TABLE posts
+    ADD COLUMN featured BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN featured_at TIMESTAMPTZ;
+
+-- Data integrity constraints
+ALTER TABLE posts
+    ADD CONSTRAINT check_featured_at_when_featured
+        CHECK ((featured AND featured_at IS NOT NULL) OR
+               (NOT featured AND featured_at IS NULL));
+
+ALTER TABLE posts
+    ADD CONSTRAINT check_featured_only_when_published
+        CHECK (NOT featured OR status = 'published');
+
+-- Create index for featured listings
+CREATE INDEX idx_posts_featured_at ON posts(featured_at DESC) WHERE featured;
+
+-- Add comments for documentation
+COMMENT ON COLUMN posts.featured IS 'Whether the post is featured; only published posts can be featured';
+COMMENT ON COLUMN posts.featured_at IS 'When the post was featured; used to order featured listings';
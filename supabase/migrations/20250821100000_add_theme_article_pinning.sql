@@ -0,0 +1,10 @@
+-- Add pinning to theme articles
+-- Pinned articles are listed at the top of a theme regardless of their position
+ALTER TABLE theme_articles
+    ADD COLUMN is_pinned BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Create index for pinned-first ordering
+CREATE INDEX idx_theme_articles_pinned_position ON theme_articles(theme_id, is_pinned DESC, position);
+
+-- Add comments for documentation
+COMMENT ON COLUMN theme_articles.is_pinned IS 'Whether the article is pinned to the top of the theme; the pin limit is enforced by the domain';
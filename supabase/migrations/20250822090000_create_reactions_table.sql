@@ -0,0 +1,44 @@
+-- Create reactions table
+-- Reactions are polymorphic: target_type/target_id point at a post or comment
+CREATE TABLE reactions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    target_type VARCHAR(20) NOT NULL CHECK (target_type IN ('post', 'comment')),
+    target_id UUID NOT NULL,
+    reaction_type VARCHAR(20) NOT NULL
+        CHECK (reaction_type IN ('like', 'love', 'laugh', 'insightful', 'celebrate')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- A user has at most one reaction per target
+    CONSTRAINT unique_user_reaction_per_target UNIQUE (user_id, target_type, target_id)
+);
+
+-- Create indexes for reactions
+CREATE INDEX idx_reactions_target ON reactions(target_type, target_id);
+CREATE INDEX idx_reactions_user_id ON reactions(user_id);
+
+-- Create a function to remove reactions when their post is deleted
+-- target_id cannot carry a foreign key because it is polymorphic
+CREATE OR REPLACE FUNCTION delete_post_reactions()
+RETURNS TRIGGER AS $$
+BEGIN
+    DELETE FROM reactions WHERE target_type = 'post' AND target_id = OLD.id;
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Create trigger to clean up reactions
+CREATE TRIGGER cleanup_post_reactions
+    AFTER DELETE ON posts
+    FOR EACH ROW
+    EXECUTE FUNCTION delete_post_reactions();
+
+-- Create updated_at trigger
+CREATE TRIGGER update_reactions_updated_at BEFORE UPDATE ON reactions
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Add comments for documentation
+COMMENT ON TABLE reactions IS 'User reactions (likes and emoji) on posts and comments';
+COMMENT ON COLUMN reactions.target_type IS 'Kind of content reacted to: post or comment';
+COMMENT ON COLUMN reactions.reaction_type IS 'Reaction kind from the fixed reaction set';
@@ -0,0 +1,18 @@
+-- Create bookmarks table
+-- Each user keeps a private reading list of posts
+CREATE TABLE bookmarks (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    post_id UUID NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- A post appears at most once in a reading list
+    PRIMARY KEY (user_id, post_id)
+);
+
+-- Create indexes for bookmarks
+CREATE INDEX idx_bookmarks_user_created ON bookmarks(user_id, created_at DESC);
+CREATE INDEX idx_bookmarks_post_id ON bookmarks(post_id);
+
+-- Add comments for documentation
+COMMENT ON TABLE bookmarks IS 'Posts saved by users to their private reading lists';
+COMMENT ON COLUMN bookmarks.created_at IS 'When the post was bookmarked; reading lists are ordered by this';
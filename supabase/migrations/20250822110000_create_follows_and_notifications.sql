@@ -0,0 +1,40 @@
+-- Create follows table
+-- A follow is a directed edge from a reader to an author
+CREATE TABLE follows (
+    follower_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    followee_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (follower_id, followee_id),
+    CONSTRAINT no_self_follow CHECK (follower_id <> followee_id)
+);
+
+-- Create indexes for follows
+-- The primary key serves "who do I follow"; this one serves "who follows me"
+CREATE INDEX idx_follows_followee_id ON follows(followee_id);
+
+-- Support keyset pagination of the feed: published posts per author, newest first
+CREATE INDEX idx_posts_author_feed ON posts(author_id, published_at DESC, id DESC)
+    WHERE status = 'published';
+
+-- Create notifications table
+CREATE TABLE notifications (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    recipient_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    type VARCHAR(50) NOT NULL CHECK (type IN ('post_published')),
+    actor_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    subject_id UUID,
+    message TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    read_at TIMESTAMPTZ
+);
+
+-- Create indexes for notifications
+CREATE INDEX idx_notifications_recipient_created ON notifications(recipient_id, created_at DESC);
+CREATE INDEX idx_notifications_unread ON notifications(recipient_id) WHERE read_at IS NULL;
+
+-- Add comments for documentation
+COMMENT ON TABLE follows IS 'Readers following authors; drives the personalized feed';
+COMMENT ON TABLE notifications IS 'Per-user notifications fanned out from domain events';
+COMMENT ON COLUMN notifications.subject_id IS 'Entity the notification refers to, e.g. the published post';
+COMMENT ON COLUMN notifications.read_at IS 'When the recipient read the notification; NULL while unread';
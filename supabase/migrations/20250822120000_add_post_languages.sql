@@ -0,0 +1,17 @@
+-- Add language and translation groups to posts
+-- Posts that translate each other share a translation_group_id
+ALTER TABLE posts
+    ADD COLUMN language VARCHAR(10) NOT NULL DEFAULT 'en',
+    ADD COLUMN translation_group_id UUID;
+
+-- A translation group holds at most one post per language
+CREATE UNIQUE INDEX idx_posts_translation_group_language
+    ON posts(translation_group_id, language)
+    WHERE translation_group_id IS NOT NULL;
+
+-- Create index for language filtering
+CREATE INDEX idx_posts_language ON posts(language);
+
+-- Add comments for documentation
+COMMENT ON COLUMN posts.language IS 'BCP 47 language tag of the post content, e.g. en or pt-BR';
+COMMENT ON COLUMN posts.translation_group_id IS 'Shared by a post and its translations; NULL when the post has none';
@@ -0,0 +1,16 @@
+-- Create slug history table
+-- Renaming a post or theme changes its slug; old slugs are kept here so links keep working
+CREATE TABLE slug_history (
+    entity_type VARCHAR(20) NOT NULL CHECK (entity_type IN ('post', 'theme')),
+    entity_id UUID NOT NULL,
+    slug VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- A retired slug redirects to exactly one entity of its type
+    PRIMARY KEY (entity_type, slug)
+);
+
+-- Add comments for documentation
+COMMENT ON TABLE slug_history IS 'Previous slugs of posts and themes, resolved as permanent redirects';
+COMMENT ON COLUMN slug_history.entity_id IS 'ID of the post or theme; rows for deleted entities are ignored by lookups';
+COMMENT ON COLUMN slug_history.created_at IS 'When the slug was retired';
@@ -0,0 +1,13 @@
+-- Add SEO metadata to posts
+-- All fields are optional overrides; NULL falls back to the post's own title, excerpt and URL
+ALTER TABLE posts
+    ADD COLUMN meta_title VARCHAR(70),
+    ADD COLUMN meta_description VARCHAR(160),
+    ADD COLUMN canonical_url VARCHAR(2048),
+    ADD COLUMN og_image_url VARCHAR(2048);
+
+-- Add comments for documentation
+COMMENT ON COLUMN posts.meta_title IS 'Title for search results and link previews';
+COMMENT ON COLUMN posts.meta_description IS 'Description for search results and link previews';
+COMMENT ON COLUMN posts.canonical_url IS 'Absolute URL of the original when the post is republished';
+COMMENT ON COLUMN posts.og_image_url IS 'Open Graph image shown when the post is shared';
@@ -0,0 +1,72 @@
+-- Create blogs table
+-- A deployment can host several blogs; each request is resolved to one of them
+-- by path prefix (/blogs/{slug}/...) or by Host header
+CREATE TABLE blogs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(150) NOT NULL,
+    slug VARCHAR(100) UNIQUE NOT NULL,
+    host VARCHAR(253) UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER update_blogs_updated_at BEFORE UPDATE ON blogs
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- The default blog owns all existing content and serves requests no other blog claims
+INSERT INTO blogs (id, name, slug)
+VALUES ('00000000-0000-0000-0000-000000000001', 'Default', 'default');
+
+-- Scope posts, themes and series to a blog
+ALTER TABLE posts
+    ADD COLUMN blog_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001'
+        REFERENCES blogs(id) ON DELETE CASCADE;
+
+ALTER TABLE themes
+    ADD COLUMN blog_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001'
+        REFERENCES blogs(id) ON DELETE CASCADE;
+
+ALTER TABLE series
+    ADD COLUMN blog_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001'
+        REFERENCES blogs(id) ON DELETE CASCADE;
+
+-- Slugs are unique within a blog rather than across the deployment
+ALTER TABLE posts DROP CONSTRAINT posts_slug_key;
+ALTER TABLE posts ADD CONSTRAINT posts_blog_slug_key UNIQUE (blog_id, slug);
+DROP INDEX idx_posts_slug;
+
+ALTER TABLE themes DROP CONSTRAINT themes_slug_key;
+ALTER TABLE themes ADD CONSTRAINT themes_blog_slug_key UNIQUE (blog_id, slug);
+DROP INDEX idx_themes_slug;
+
+ALTER TABLE series DROP CONSTRAINT series_slug_key;
+ALTER TABLE series ADD CONSTRAINT series_blog_slug_key UNIQUE (blog_id, slug);
+
+-- Listings are always filtered by blog first
+CREATE INDEX idx_posts_blog_status_published ON posts(blog_id, status, published_at DESC);
+CREATE INDEX idx_themes_blog_id ON themes(blog_id);
+CREATE INDEX idx_series_blog_created_at ON series(blog_id, created_at DESC);
+
+-- Retired slugs are scoped the same way
+ALTER TABLE slug_history
+    ADD COLUMN blog_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001'
+        REFERENCES blogs(id) ON DELETE CASCADE;
+ALTER TABLE slug_history DROP CONSTRAINT slug_history_pkey;
+ALTER TABLE slug_history ADD PRIMARY KEY (blog_id, entity_type, slug);
+
+-- Role assignments apply to one blog, or to every blog when blog_id is NULL
+ALTER TABLE user_roles
+    ADD COLUMN blog_id UUID REFERENCES blogs(id) ON DELETE CASCADE;
+ALTER TABLE user_roles DROP CONSTRAINT user_roles_pkey;
+ALTER TABLE user_roles ADD CONSTRAINT user_roles_user_role_blog_key
+    UNIQUE NULLS NOT DISTINCT (user_id, role_id, blog_id);
+
+-- Add comments for documentation
+COMMENT ON TABLE blogs IS 'Blogs hosted by this deployment';
+COMMENT ON COLUMN blogs.slug IS 'Path prefix under /blogs/ that selects the blog';
+COMMENT ON COLUMN blogs.host IS 'Host name that selects the blog; NULL when only reachable by path prefix';
+COMMENT ON COLUMN posts.blog_id IS 'Blog the post belongs to';
+COMMENT ON COLUMN themes.blog_id IS 'Blog the theme belongs to';
+COMMENT ON COLUMN series.blog_id IS 'Blog the series belongs to';
+COMMENT ON COLUMN slug_history.blog_id IS 'Blog whose slug namespace the retired slug belongs to';
+COMMENT ON COLUMN user_roles.blog_id IS 'Blog the role applies to; NULL grants the role on every blog';
@@ -0,0 +1,8 @@
+-- Check theme article positions at commit instead of per row
+-- Renumbering a theme (reorders, position repair) moves articles through positions
+-- another article still holds until the same transaction updates it
+ALTER TABLE theme_articles
+    DROP CONSTRAINT theme_articles_theme_id_position_key;
+
+ALTER TABLE theme_articles
+    ADD CONSTRAINT unique_theme_position UNIQUE (theme_id, position) DEFERRABLE INITIALLY DEFERRED;
@@ -0,0 +1,15 @@
+-- Replace the themes' active flag with an explicit lifecycle: draft -> active -> archived
+-- Existing active themes stay active; inactive ones become drafts, since nothing was archived before
+ALTER TABLE themes
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'draft'
+        CONSTRAINT check_theme_status CHECK (status IN ('draft', 'active', 'archived'));
+
+UPDATE themes SET status = CASE WHEN is_active THEN 'active' ELSE 'draft' END;
+
+DROP INDEX idx_themes_is_active;
+ALTER TABLE themes DROP COLUMN is_active;
+
+CREATE INDEX idx_themes_status ON themes(status);
+
+-- Add comments for documentation
+COMMENT ON COLUMN themes.status IS 'Lifecycle state: draft themes are being curated, active themes are listed publicly, archived themes are retired with their articles frozen';
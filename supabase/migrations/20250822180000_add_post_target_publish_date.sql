@@ -0,0 +1,11 @@
+-- Add a planned publication day to posts for the editorial calendar
+-- The date is a plan only; publishing still happens through the publish endpoint
+ALTER TABLE posts
+    ADD COLUMN target_publish_date DATE;
+
+-- The calendar looks up unpublished posts by their planned day
+CREATE INDEX idx_posts_target_publish_date ON posts(blog_id, target_publish_date)
+    WHERE status = 'draft' AND target_publish_date IS NOT NULL;
+
+-- Add comments for documentation
+COMMENT ON COLUMN posts.target_publish_date IS 'Day an editor plans to publish the post; NULL when unplanned';
@@ -0,0 +1,17 @@
+-- Add editorial review states to posts: draft -> in_review -> published,
+-- with changes_requested sending a post back to its author for revision
+ALTER TABLE posts DROP CONSTRAINT posts_status_check;
+ALTER TABLE posts
+    ADD CONSTRAINT check_post_status
+        CHECK (status IN ('draft', 'in_review', 'changes_requested', 'published', 'archived'));
+
+-- Posts in review also carry a planned publication day
+DROP INDEX idx_posts_target_publish_date;
+CREATE INDEX idx_posts_target_publish_date ON posts(blog_id, target_publish_date)
+    WHERE status IN ('draft', 'in_review', 'changes_requested') AND target_publish_date IS NOT NULL;
+
+-- Reviewers list the queue oldest submission first
+CREATE INDEX idx_posts_review_queue ON posts(blog_id, updated_at) WHERE status = 'in_review';
+
+-- Add comments for documentation
+COMMENT ON COLUMN posts.status IS 'Workflow state: draft, in_review, changes_requested, published or archived';
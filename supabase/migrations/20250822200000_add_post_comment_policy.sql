@@ -0,0 +1,11 @@
+-- Add per-post comment settings
+-- Existing posts keep accepting comments from anyone, with no auto-close
+ALTER TABLE posts
+    ADD COLUMN comment_mode VARCHAR(20) NOT NULL DEFAULT 'enabled'
+        CONSTRAINT check_post_comment_mode CHECK (comment_mode IN ('enabled', 'members_only', 'disabled')),
+    ADD COLUMN comment_auto_close_days INTEGER NOT NULL DEFAULT 0
+        CONSTRAINT check_post_comment_auto_close_days CHECK (comment_auto_close_days BETWEEN 0 AND 3650);
+
+-- Add comments for documentation
+COMMENT ON COLUMN posts.comment_mode IS 'Who may comment: enabled for anyone, members_only for signed-in users, or disabled';
+COMMENT ON COLUMN posts.comment_auto_close_days IS 'Days after publication when comments close; 0 keeps them open';
@@ -0,0 +1,60 @@
+-- Create reports table
+-- Readers flag posts and comments for moderators; target_type/target_id is polymorphic like reactions
+CREATE TABLE reports (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    reporter_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    target_type VARCHAR(20) NOT NULL CHECK (target_type IN ('post', 'comment')),
+    target_id UUID NOT NULL,
+    -- Author of the reported content when the report was filed
+    target_author_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    reason VARCHAR(30) NOT NULL
+        CHECK (reason IN ('spam', 'harassment', 'hate_speech', 'misinformation', 'copyright', 'other')),
+    details TEXT NOT NULL DEFAULT '' CHECK (char_length(details) <= 1000),
+    status VARCHAR(20) NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'dismissed', 'actioned')),
+    resolution VARCHAR(20) CHECK (resolution IN ('dismiss', 'unpublish', 'suspend_user')),
+    resolution_note TEXT NOT NULL DEFAULT '',
+    resolved_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    resolved_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- A resolved report records how and when it was resolved
+    CONSTRAINT check_report_resolution CHECK ((status = 'open') = (resolved_at IS NULL))
+);
+
+-- A reader has at most one open report per target
+CREATE UNIQUE INDEX idx_reports_open_per_reporter
+    ON reports(reporter_id, target_type, target_id) WHERE status = 'open';
+
+-- Create indexes for the moderation queue
+CREATE INDEX idx_reports_status_created ON reports(status, created_at);
+CREATE INDEX idx_reports_target ON reports(target_type, target_id);
+
+-- Create a function to remove reports when their post is deleted
+CREATE OR REPLACE FUNCTION delete_post_reports()
+RETURNS TRIGGER AS $$
+BEGIN
+    DELETE FROM reports WHERE target_type = 'post' AND target_id = OLD.id;
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Create trigger to clean up reports
+CREATE TRIGGER cleanup_post_reports
+    AFTER DELETE ON posts
+    FOR EACH ROW
+    EXECUTE FUNCTION delete_post_reports();
+
+-- Create updated_at trigger
+CREATE TRIGGER update_reports_updated_at BEFORE UPDATE ON reports
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Suspended users keep their content but can no longer sign in to the API
+ALTER TABLE users ADD COLUMN suspended_at TIMESTAMPTZ;
+
+-- Add comments for documentation
+COMMENT ON TABLE reports IS 'Abuse reports filed by readers against posts and comments';
+COMMENT ON COLUMN reports.reason IS 'Reason code chosen by the reporter';
+COMMENT ON COLUMN reports.status IS 'open until a moderator dismisses it or acts on it';
+COMMENT ON COLUMN reports.resolution IS 'Action the moderator took: dismiss, unpublish or suspend_user';
+COMMENT ON COLUMN users.suspended_at IS 'When a moderator suspended the user; NULL for active users';
@@ -0,0 +1,22 @@
+-- Create settings table
+-- One row per key; the JSON Schema of each namespace in the backend decides
+-- which keys exist, and keys without a row read as the schema's default
+CREATE TABLE settings (
+    -- Blog the value applies to; NULL for deployment-wide (system) settings
+    blog_id UUID REFERENCES blogs(id) ON DELETE CASCADE,
+    namespace VARCHAR(20) NOT NULL CHECK (namespace IN ('system', 'blog', 'theme')),
+    key VARCHAR(100) NOT NULL,
+    value JSONB NOT NULL,
+    updated_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT settings_scope_key UNIQUE NULLS NOT DISTINCT (blog_id, namespace, key),
+    -- System settings are shared by every blog; the others belong to one
+    CONSTRAINT check_settings_scope CHECK ((namespace = 'system') = (blog_id IS NULL))
+);
+
+-- Add comments for documentation
+COMMENT ON TABLE settings IS 'Typed settings per namespace, validated against the namespace''s JSON Schema';
+COMMENT ON COLUMN settings.blog_id IS 'Blog the setting applies to; NULL for system settings';
+COMMENT ON COLUMN settings.value IS 'JSON value of the key';
+COMMENT ON COLUMN settings.updated_by IS 'User who last replaced the namespace; NULL for seeded defaults';
@@ -0,0 +1,14 @@
+-- Create seed_history table
+-- The Go seeder registry records each seeder's completed runs here, so
+-- run-once seeders such as the demo content are not applied twice
+CREATE TABLE seed_history (
+    name VARCHAR(100) PRIMARY KEY,
+    run_count INTEGER NOT NULL DEFAULT 1 CHECK (run_count > 0),
+    first_run_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_run_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Add comments for documentation
+COMMENT ON TABLE seed_history IS 'Completed runs of the Go data seeders';
+COMMENT ON COLUMN seed_history.name IS 'Name the seeder reports';
+COMMENT ON COLUMN seed_history.run_count IS 'Number of completed runs';
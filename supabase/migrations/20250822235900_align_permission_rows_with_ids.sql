@@ -0,0 +1,23 @@
+-- Store permissions the way their IDs parse
+-- Checks look permissions up by splitting the ID ("resource:action:scope", or
+-- "resource:action:qualifier:scope" with the qualifier kept in the action),
+-- but the seeder wrote two groups of rows differently, so they never matched.
+-- Role grants point at the row IDs and are kept.
+
+-- "posts:read:draft:any" is action "read:draft" with scope "any", not action "read" with scope "draft:any"
+UPDATE permissions
+SET action = 'read:draft',
+    scope = substring(scope FROM 7),
+    updated_at = NOW()
+WHERE resource = 'posts'
+  AND action = 'read'
+  AND scope IN ('draft:own', 'draft:any');
+
+-- "authz:roles:create" is action "roles" with scope "create", not action "roles:create"
+UPDATE permissions
+SET action = split_part(action, ':', 1),
+    scope = split_part(action, ':', 2),
+    updated_at = NOW()
+WHERE resource = 'authz'
+  AND scope IS NULL
+  AND action LIKE '%:%';
@@ -0,0 +1,46 @@
+-- Keep each theme's article count on the theme
+-- Theme listings read it instead of counting theme_articles on every request.
+-- Statement-level triggers maintain it for every write, including the
+-- cascade when a post is deleted; the integrity check
+-- theme_article_counts repairs counts written with triggers disabled.
+ALTER TABLE themes
+    ADD COLUMN article_count INTEGER NOT NULL DEFAULT 0
+    CONSTRAINT themes_article_count_check CHECK (article_count >= 0);
+
+UPDATE themes t
+SET article_count = (SELECT COUNT(*) FROM theme_articles ta WHERE ta.theme_id = t.id);
+
+-- Articles never move between themes, so inserts and deletes are all that change a count
+CREATE OR REPLACE FUNCTION increment_theme_article_count()
+RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE themes t
+    SET article_count = t.article_count + added.n
+    FROM (SELECT theme_id, COUNT(*) AS n FROM new_articles GROUP BY theme_id) added
+    WHERE t.id = added.theme_id;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION decrement_theme_article_count()
+RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE themes t
+    SET article_count = t.article_count - removed.n
+    FROM (SELECT theme_id, COUNT(*) AS n FROM old_articles GROUP BY theme_id) removed
+    WHERE t.id = removed.theme_id;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER theme_articles_count_insert
+    AFTER INSERT ON theme_articles
+    REFERENCING NEW TABLE AS new_articles
+    FOR EACH STATEMENT EXECUTE FUNCTION increment_theme_article_count();
+
+CREATE TRIGGER theme_articles_count_delete
+    AFTER DELETE ON theme_articles
+    REFERENCING OLD TABLE AS old_articles
+    FOR EACH STATEMENT EXECUTE FUNCTION decrement_theme_article_count();
+
+COMMENT ON COLUMN themes.article_count IS 'Number of theme_articles rows, maintained by triggers';
@@ -0,0 +1,56 @@
+-- Create published_posts read model
+-- A copy of each published post with its author's name, which anonymous
+-- listings and post pages read instead of joining posts and users.
+-- The backend refreshes a post's row whenever a post event names it; the
+-- integrity check published_posts repairs rows an unhandled event left stale.
+CREATE TABLE published_posts (
+    post_id UUID PRIMARY KEY REFERENCES posts(id) ON DELETE CASCADE,
+    blog_id UUID NOT NULL REFERENCES blogs(id) ON DELETE CASCADE,
+    title VARCHAR(200) NOT NULL,
+    slug VARCHAR(250) NOT NULL,
+    content TEXT NOT NULL,
+    excerpt VARCHAR(500),
+    author_id UUID NOT NULL,
+    author_name VARCHAR(30),
+    published_at TIMESTAMPTZ NOT NULL,
+    featured BOOLEAN NOT NULL,
+    featured_at TIMESTAMPTZ,
+    language VARCHAR(10) NOT NULL,
+    translation_group_id UUID,
+    target_publish_date DATE,
+    meta_title VARCHAR(70),
+    meta_description VARCHAR(160),
+    canonical_url VARCHAR(2048),
+    og_image_url VARCHAR(2048),
+    comment_mode VARCHAR(20) NOT NULL,
+    comment_auto_close_days INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+-- Not unique: while a renamed post's event is pending, another post may already hold its old slug
+CREATE INDEX idx_published_posts_blog_slug ON published_posts(blog_id, slug);
+
+-- Listings page through a blog's posts newest first, or its featured posts
+CREATE INDEX idx_published_posts_blog_published_at ON published_posts(blog_id, published_at DESC);
+CREATE INDEX idx_published_posts_blog_featured_at ON published_posts(blog_id, featured_at DESC) WHERE featured;
+CREATE INDEX idx_published_posts_author_id ON published_posts(author_id);
+
+INSERT INTO published_posts (
+    post_id, blog_id, title, slug, content, excerpt, author_id, author_name,
+    published_at, featured, featured_at, language, translation_group_id, target_publish_date,
+    meta_title, meta_description, canonical_url, og_image_url,
+    comment_mode, comment_auto_close_days, created_at, updated_at
+)
+SELECT
+    p.id, p.blog_id, p.title, p.slug, p.content, p.excerpt, p.author_id, u.username,
+    p.published_at, p.featured, p.featured_at, p.language, p.translation_group_id, p.target_publish_date,
+    p.meta_title, p.meta_description, p.canonical_url, p.og_image_url,
+    p.comment_mode, p.comment_auto_close_days, p.created_at, p.updated_at
+FROM posts p
+LEFT JOIN users u ON u.id = p.author_id
+WHERE p.status = 'published';
+
+-- Add comments for documentation
+COMMENT ON TABLE published_posts IS 'Read model of published posts for anonymous reads, refreshed from post events';
+COMMENT ON COLUMN published_posts.author_name IS 'Username of the author when the row was last refreshed';
@@ -0,0 +1,18 @@
+-- Copy authors' and curators' usernames onto their posts and themes
+-- Listings read the copies instead of joining users on every request. Inserts
+-- copy the current username; the backend rewrites the copies in batches when a
+-- user is updated, and the integrity checks post_author_names and
+-- theme_curator_names repair any it missed.
+ALTER TABLE posts ADD COLUMN author_name VARCHAR(30);
+ALTER TABLE themes ADD COLUMN curator_name VARCHAR(30);
+
+UPDATE posts p SET author_name = u.username FROM users u WHERE u.id = p.author_id;
+UPDATE themes t SET curator_name = u.username FROM users u WHERE u.id = t.curator_id;
+
+-- The read model now copies the name from the post
+UPDATE published_posts pp SET author_name = p.author_name FROM posts p WHERE p.id = pp.post_id;
+
+-- Add comments for documentation
+COMMENT ON COLUMN posts.author_name IS 'Username of the author, copied on insert and rewritten when the user is updated';
+COMMENT ON COLUMN themes.curator_name IS 'Username of the curator, copied on insert and rewritten when the user is updated';
+COMMENT ON COLUMN published_posts.author_name IS 'Username of the author, copied from posts.author_name';
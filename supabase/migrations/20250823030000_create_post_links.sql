@@ -0,0 +1,24 @@
+-- Create post_links table
+-- The link check job stores the outcome of checking each outbound link of a
+-- published post, replacing a post's rows on every run
+CREATE TABLE post_links (
+    post_id UUID NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    blog_id UUID NOT NULL REFERENCES blogs(id) ON DELETE CASCADE,
+    url TEXT NOT NULL,
+    status_code INTEGER,
+    error TEXT,
+    broken BOOLEAN NOT NULL,
+    checked_at TIMESTAMPTZ NOT NULL,
+
+    -- A post's link is checked once however often it appears
+    PRIMARY KEY (post_id, url)
+);
+
+-- The admin report lists a blog's broken links, most recently checked first
+CREATE INDEX idx_post_links_broken ON post_links(blog_id, checked_at DESC) WHERE broken;
+
+-- Add comments for documentation
+COMMENT ON TABLE post_links IS 'Outbound links of published posts as last checked by the link check job';
+COMMENT ON COLUMN post_links.status_code IS 'Final HTTP status after redirects; NULL when no response arrived';
+COMMENT ON COLUMN post_links.error IS 'Why no response arrived, such as a timeout';
+COMMENT ON COLUMN post_links.broken IS 'Whether the page is gone: no response, 404, 410 or a server error';
@@ -0,0 +1,40 @@
+-- Create api_clients table
+-- Developers register clients to read a blog's public API with a token.
+-- Only a hash of each token is stored; the token is shown once on registration
+CREATE TABLE api_clients (
+    id UUID PRIMARY KEY,
+    blog_id UUID NOT NULL REFERENCES blogs(id) ON DELETE CASCADE,
+    owner_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    token_prefix VARCHAR(20) NOT NULL,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    scopes TEXT[] NOT NULL,
+    rate_limit INTEGER NOT NULL CHECK (rate_limit > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_used_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ
+);
+
+-- Owners list their clients newest first
+CREATE INDEX idx_api_clients_owner ON api_clients(blog_id, owner_id, created_at DESC);
+
+-- Create api_client_usage table
+-- Request counts are metered in memory and added here periodically
+CREATE TABLE api_client_usage (
+    client_id UUID NOT NULL REFERENCES api_clients(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    requests INTEGER NOT NULL DEFAULT 0,
+    throttled INTEGER NOT NULL DEFAULT 0,
+
+    PRIMARY KEY (client_id, day)
+);
+
+-- Add comments for documentation
+COMMENT ON TABLE api_clients IS 'External applications reading the public API with read-only tokens';
+COMMENT ON COLUMN api_clients.token_prefix IS 'Start of the token, so owners can tell their tokens apart';
+COMMENT ON COLUMN api_clients.token_hash IS 'SHA-256 of the token, hex encoded';
+COMMENT ON COLUMN api_clients.scopes IS 'Read-only areas of the API the token may call, such as posts:read';
+COMMENT ON COLUMN api_clients.rate_limit IS 'Requests allowed per rate limit window';
+COMMENT ON COLUMN api_clients.revoked_at IS 'When the owner revoked the token; revoked tokens are rejected';
+COMMENT ON TABLE api_client_usage IS 'Requests made with each API client token per UTC day';
+COMMENT ON COLUMN api_client_usage.throttled IS 'Requests turned away by the rate limit';
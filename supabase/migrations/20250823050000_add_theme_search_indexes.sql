@@ -0,0 +1,7 @@
+-- Index theme names and descriptions for substring search
+-- The themes listing matches its q parameter with ILIKE '%term%', which a
+-- btree index cannot serve; trigram indexes can.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX idx_themes_name_trgm ON themes USING gin (name gin_trgm_ops);
+CREATE INDEX idx_themes_description_trgm ON themes USING gin (description gin_trgm_ops);
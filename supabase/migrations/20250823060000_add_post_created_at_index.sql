@@ -0,0 +1,5 @@
+-- Serve post listings restricted to a creation range
+-- Publication ranges already use idx_posts_blog_status_published and
+-- idx_published_posts_blog_published_at; the old created_at index is not
+-- scoped to a blog.
+CREATE INDEX idx_posts_blog_created_at ON posts(blog_id, created_at DESC);
@@ -0,0 +1,21 @@
+-- Create user_limits table
+-- Content quotas come from the deployment's configuration; a row here lets an
+-- administrator raise or lower them for one user in one blog
+CREATE TABLE user_limits (
+    blog_id UUID NOT NULL REFERENCES blogs(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    max_drafts INTEGER CHECK (max_drafts >= 0),
+    max_post_bytes INTEGER CHECK (max_post_bytes >= 0),
+    max_themes INTEGER CHECK (max_themes >= 0),
+    updated_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (blog_id, user_id)
+);
+
+-- Add comments for documentation
+COMMENT ON TABLE user_limits IS 'Per-user overrides of the configured content quotas';
+COMMENT ON COLUMN user_limits.max_drafts IS 'Unpublished posts the user may author; NULL keeps the default, 0 lifts the limit';
+COMMENT ON COLUMN user_limits.max_post_bytes IS 'Largest post content in bytes; NULL keeps the default, 0 lifts the limit';
+COMMENT ON COLUMN user_limits.max_themes IS 'Unarchived themes the user may curate; NULL keeps the default, 0 lifts the limit';
+COMMENT ON COLUMN user_limits.updated_by IS 'Administrator who last set the override';
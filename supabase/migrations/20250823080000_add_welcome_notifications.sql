@@ -0,0 +1,4 @@
+-- Welcome notifications greet users once they finish onboarding
+ALTER TABLE notifications DROP CONSTRAINT notifications_type_check;
+ALTER TABLE notifications ADD CONSTRAINT notifications_type_check
+    CHECK (type IN ('post_published', 'welcome'));
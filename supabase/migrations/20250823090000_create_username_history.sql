@@ -0,0 +1,22 @@
+-- Usernames are unique regardless of letter case
+CREATE UNIQUE INDEX users_username_lower_key ON users (LOWER(username));
+DROP INDEX idx_users_username;
+
+-- Usernames given up by renaming, kept so author pages can redirect to the
+-- new name; nobody else can register them
+CREATE TABLE username_history (
+    username_key VARCHAR(30) PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    username VARCHAR(30) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT username_history_key_folded CHECK (username_key = LOWER(username))
+);
+
+CREATE INDEX idx_username_history_user ON username_history(user_id);
+
+-- Add comments for documentation
+COMMENT ON TABLE username_history IS 'Previous usernames of renamed users, resolved as permanent redirects';
+COMMENT ON COLUMN username_history.username_key IS 'Case folded username, the form usernames are compared in';
+COMMENT ON COLUMN username_history.username IS 'The username as it was displayed';
+COMMENT ON COLUMN username_history.created_at IS 'When the username was retired';
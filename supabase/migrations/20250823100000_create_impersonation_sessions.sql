@@ -0,0 +1,45 @@
+-- Create impersonation_sessions table
+-- Staff holding authz:impersonate act as another user for a short while.
+-- Only a hash of each token is stored; the token is shown once when the session starts
+CREATE TABLE impersonation_sessions (
+    id UUID PRIMARY KEY,
+    blog_id UUID NOT NULL REFERENCES blogs(id) ON DELETE CASCADE,
+    impersonator_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    target_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reason VARCHAR(500) NOT NULL,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    ended_at TIMESTAMPTZ,
+
+    CHECK (impersonator_id <> target_id),
+    CHECK (expires_at > created_at)
+);
+
+-- Create impersonation_actions table
+-- Every request made through a session is recorded for audit
+CREATE TABLE impersonation_actions (
+    id BIGSERIAL PRIMARY KEY,
+    session_id UUID NOT NULL REFERENCES impersonation_sessions(id) ON DELETE CASCADE,
+    impersonator_id UUID NOT NULL,
+    target_id UUID NOT NULL,
+    method VARCHAR(10) NOT NULL,
+    route TEXT NOT NULL,
+    path TEXT NOT NULL,
+    status INTEGER NOT NULL,
+    request_id VARCHAR(128) NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Audit trails are read per session in order
+CREATE INDEX idx_impersonation_actions_session ON impersonation_actions(session_id, occurred_at);
+
+-- Add comments for documentation
+COMMENT ON TABLE impersonation_sessions IS 'Short-lived sessions letting staff act as another user';
+COMMENT ON COLUMN impersonation_sessions.reason IS 'Why the impersonator needed the session, such as a support ticket';
+COMMENT ON COLUMN impersonation_sessions.token_hash IS 'SHA-256 of the token, hex encoded';
+COMMENT ON COLUMN impersonation_sessions.ended_at IS 'When the impersonator ended the session before it expired';
+COMMENT ON TABLE impersonation_actions IS 'Requests made through impersonation sessions, kept as an audit trail';
+COMMENT ON COLUMN impersonation_actions.impersonator_id IS 'The staff member really making the request';
+COMMENT ON COLUMN impersonation_actions.target_id IS 'The user the request was made as';
+COMMENT ON COLUMN impersonation_actions.route IS 'Matched route pattern, such as /api/v1/posts/{id}';
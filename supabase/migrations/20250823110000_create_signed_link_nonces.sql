@@ -0,0 +1,14 @@
+-- Create signed_link_nonces table
+-- Signed action links are accepted once; the nonce of each followed link is
+-- kept until the link expires, after which the signature check refuses it anyway
+CREATE TABLE signed_link_nonces (
+    nonce VARCHAR(64) PRIMARY KEY,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+-- Expired nonces are pruned as new ones are consumed
+CREATE INDEX idx_signed_link_nonces_expires ON signed_link_nonces(expires_at);
+
+-- Add comments for documentation
+COMMENT ON TABLE signed_link_nonces IS 'Nonces of signed action links already followed, for replay protection';
+COMMENT ON COLUMN signed_link_nonces.expires_at IS 'When the link expires and the nonce no longer needs keeping';
@@ -0,0 +1,20 @@
+-- Create theme_collaborators table
+-- Curators share a theme with other users, who pass themes:*:own checks on it
+-- for the actions their role grants
+CREATE TABLE theme_collaborators (
+    theme_id UUID NOT NULL REFERENCES themes(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('editor', 'contributor')),
+    added_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (theme_id, user_id)
+);
+
+-- Removing a user drops their collaborations
+CREATE INDEX idx_theme_collaborators_user ON theme_collaborators(user_id);
+
+-- Add comments for documentation
+COMMENT ON TABLE theme_collaborators IS 'Users helping the curator maintain a theme';
+COMMENT ON COLUMN theme_collaborators.role IS 'editor: edits details and articles; contributor: articles only';
+COMMENT ON COLUMN theme_collaborators.added_by IS 'The curator or admin who added the collaborator';
@@ -0,0 +1,43 @@
+-- Create organizations table
+-- Teams that own posts and themes together; their members pass the
+-- posts:*:own and themes:*:own checks on that content as their role allows
+CREATE TABLE organizations (
+    id UUID PRIMARY KEY,
+    blog_id UUID NOT NULL REFERENCES blogs(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    slug VARCHAR(100) NOT NULL,
+    description VARCHAR(500) NOT NULL DEFAULT '',
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    UNIQUE (blog_id, slug)
+);
+
+-- Create organization_members table
+CREATE TABLE organization_members (
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('owner', 'editor', 'member')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (organization_id, user_id)
+);
+
+-- A user's organizations are listed from their memberships
+CREATE INDEX idx_organization_members_user ON organization_members(user_id);
+
+-- Posts and themes may be owned by an organization besides their author or curator.
+-- Deleting the organization hands the content back to them alone.
+ALTER TABLE posts ADD COLUMN organization_id UUID REFERENCES organizations(id) ON DELETE SET NULL;
+ALTER TABLE themes ADD COLUMN organization_id UUID REFERENCES organizations(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_posts_organization ON posts(organization_id) WHERE organization_id IS NOT NULL;
+CREATE INDEX idx_themes_organization ON themes(organization_id) WHERE organization_id IS NOT NULL;
+
+-- Add comments for documentation
+COMMENT ON TABLE organizations IS 'Teams owning posts and themes together';
+COMMENT ON TABLE organization_members IS 'Users belonging to organizations';
+COMMENT ON COLUMN organization_members.role IS 'owner: manages the team; editor: edits, publishes and deletes its content; member: edits its content';
+COMMENT ON COLUMN posts.organization_id IS 'Organization owning the post together with its author, if any';
+COMMENT ON COLUMN themes.organization_id IS 'Organization owning the theme together with its curator, if any';
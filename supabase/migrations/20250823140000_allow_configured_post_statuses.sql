@@ -0,0 +1,8 @@
+-- Post statuses come from the configured workflow, so deployments can add
+-- statuses such as 'scheduled'; the database only checks their format
+ALTER TABLE posts DROP CONSTRAINT check_post_status;
+ALTER TABLE posts
+    ADD CONSTRAINT check_post_status
+        CHECK (status ~ '^[a-z][a-z_]*$');
+
+COMMENT ON COLUMN posts.status IS 'Workflow state; draft, in_review, changes_requested, published and archived unless the deployment configures others';
@@ -0,0 +1,10 @@
+-- Add curator notes to theme articles
+-- Notes travel with a theme when it is shared as a reading list
+ALTER TABLE theme_articles
+    ADD COLUMN note TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE theme_articles
+    ADD CONSTRAINT check_theme_article_note_length CHECK (char_length(note) <= 500);
+
+-- Add comments for documentation
+COMMENT ON COLUMN theme_articles.note IS 'The curator''s remark on the article; empty when there is none';
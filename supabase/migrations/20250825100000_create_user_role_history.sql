@@ -0,0 +1,20 @@
+-- Create user_role_history table
+-- user_roles only holds current assignments; this keeps every grant and
+-- revocation, so admins can see who held a role and who took it away
+CREATE TABLE user_role_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role_id UUID NOT NULL REFERENCES roles(id) ON DELETE CASCADE,
+    blog_id UUID REFERENCES blogs(id) ON DELETE CASCADE,
+    action VARCHAR(10) NOT NULL CHECK (action IN ('granted', 'revoked')),
+    actor_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- A user's history is read newest first
+CREATE INDEX idx_user_role_history_user ON user_role_history(user_id, occurred_at DESC);
+
+-- Add comments for documentation
+COMMENT ON TABLE user_role_history IS 'Grants and revocations of roles, kept after the assignment is gone';
+COMMENT ON COLUMN user_role_history.blog_id IS 'Blog the assignment applied to; NULL for every blog, as in user_roles';
+COMMENT ON COLUMN user_role_history.actor_id IS 'Who granted or revoked the role; NULL when unknown or since deleted';
@@ -0,0 +1,61 @@
+-- Create service_accounts table
+-- Other services call the API as a service account, exchanging its client
+-- credentials for short-lived access tokens. Each account is backed by a users
+-- row, so roles and permissions are granted to it exactly as to a person.
+-- Only a hash of each secret is stored; the secret is shown once on creation
+CREATE TABLE service_accounts (
+    id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    blog_id UUID NOT NULL REFERENCES blogs(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    description VARCHAR(500) NOT NULL DEFAULT '',
+    client_id VARCHAR(40) NOT NULL UNIQUE,
+    secret_hash CHAR(64) NOT NULL,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_used_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ
+);
+
+-- Accounts are listed per blog, newest first
+CREATE INDEX idx_service_accounts_blog ON service_accounts(blog_id, created_at DESC);
+
+-- Create service_account_tokens table
+-- Access tokens exchanged for client credentials, valid until they expire or
+-- the account is revoked
+CREATE TABLE service_account_tokens (
+    token_hash CHAR(64) PRIMARY KEY,
+    account_id UUID NOT NULL REFERENCES service_accounts(id) ON DELETE CASCADE,
+    issued_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+
+    CHECK (expires_at > issued_at)
+);
+
+CREATE INDEX idx_service_account_tokens_account ON service_account_tokens(account_id, expires_at);
+
+-- Create service_account_actions table
+-- Every request made with an access token is recorded, apart from those of people
+CREATE TABLE service_account_actions (
+    id BIGSERIAL PRIMARY KEY,
+    account_id UUID NOT NULL REFERENCES service_accounts(id) ON DELETE CASCADE,
+    method VARCHAR(10) NOT NULL,
+    route TEXT NOT NULL,
+    path TEXT NOT NULL,
+    status INTEGER NOT NULL,
+    request_id VARCHAR(128) NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Audit trails are read per account, newest first
+CREATE INDEX idx_service_account_actions_account ON service_account_actions(account_id, occurred_at DESC);
+
+-- Add comments for documentation
+COMMENT ON TABLE service_accounts IS 'Machine principals other services authenticate as with client credentials';
+COMMENT ON COLUMN service_accounts.id IS 'Also the ID of the users row holding the account''s roles';
+COMMENT ON COLUMN service_accounts.client_id IS 'Public identifier sent with the secret to obtain access tokens';
+COMMENT ON COLUMN service_accounts.secret_hash IS 'SHA-256 of the client secret, hex encoded';
+COMMENT ON COLUMN service_accounts.revoked_at IS 'When the account was revoked; its credentials and tokens are rejected';
+COMMENT ON TABLE service_account_tokens IS 'Short-lived access tokens issued to service accounts';
+COMMENT ON COLUMN service_account_tokens.token_hash IS 'SHA-256 of the token, hex encoded';
+COMMENT ON TABLE service_account_actions IS 'Requests made by service accounts, kept as an audit trail';
+COMMENT ON COLUMN service_account_actions.route IS 'Matched route pattern, such as /api/v1/posts/{id}';
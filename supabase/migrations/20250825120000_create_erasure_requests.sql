@@ -0,0 +1,59 @@
+-- Create erasure_requests table
+-- A request to erase a user's personal data from every context holding it.
+-- Users span blogs, so requests are not tied to one. The subject is kept as a
+-- bare ID: the user row survives erasure, scrubbed, and the request must too.
+CREATE TABLE erasure_requests (
+    id UUID PRIMARY KEY,
+    subject_id UUID NOT NULL,
+    requested_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    pseudonym VARCHAR(30) NOT NULL UNIQUE,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'running', 'completed', 'failed')),
+    total_steps INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    started_at TIMESTAMPTZ,
+    completed_at TIMESTAMPTZ
+);
+
+-- A subject has at most one request that has not completed
+CREATE UNIQUE INDEX erasure_requests_open_subject ON erasure_requests(subject_id)
+    WHERE status <> 'completed';
+
+-- The job picks up the oldest runnable requests
+CREATE INDEX idx_erasure_requests_runnable ON erasure_requests(created_at)
+    WHERE status IN ('pending', 'running');
+
+-- Create erasure_request_steps table
+-- Each eraser that finished for a request, so an interrupted request resumes
+-- with the ones that have not
+CREATE TABLE erasure_request_steps (
+    request_id UUID NOT NULL REFERENCES erasure_requests(id) ON DELETE CASCADE,
+    name VARCHAR(50) NOT NULL,
+    records INTEGER NOT NULL,
+    completed_at TIMESTAMPTZ NOT NULL,
+
+    PRIMARY KEY (request_id, name)
+);
+
+-- Create erasure_certificates table
+-- Issued once a request completes, as the record that the erasure was done
+CREATE TABLE erasure_certificates (
+    id UUID PRIMARY KEY,
+    request_id UUID NOT NULL UNIQUE REFERENCES erasure_requests(id) ON DELETE RESTRICT,
+    subject_id UUID NOT NULL,
+    steps JSONB NOT NULL,
+    requested_at TIMESTAMPTZ NOT NULL,
+    completed_at TIMESTAMPTZ NOT NULL,
+    digest CHAR(64) NOT NULL
+);
+
+-- Add comments for documentation
+COMMENT ON TABLE erasure_requests IS 'Requests to erase a user''s personal data, processed by the erasure job';
+COMMENT ON COLUMN erasure_requests.subject_id IS 'The user whose data is erased; not a foreign key so the record outlives the account';
+COMMENT ON COLUMN erasure_requests.pseudonym IS 'Username the subject is renamed to, fixed so that retries agree';
+COMMENT ON COLUMN erasure_requests.total_steps IS 'Erasers registered when the request was last started, for progress';
+COMMENT ON TABLE erasure_request_steps IS 'Erasers that finished for a request and how many records they changed';
+COMMENT ON TABLE erasure_certificates IS 'Records of completed erasures; never updated or deleted';
+COMMENT ON COLUMN erasure_certificates.steps IS 'The erasers that ran, as an array of {name, records, completedAt}';
+COMMENT ON COLUMN erasure_certificates.digest IS 'SHA-256 of the certificate contents, hex encoded, to detect tampering';
@@ -0,0 +1,40 @@
+-- Create data_exports table
+-- A user's request for a copy of their personal data. The export job builds
+-- the archive and stores it here until it expires, when it is deleted and
+-- the row kept as a record of the export.
+CREATE TABLE data_exports (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'ready', 'failed', 'expired')),
+    archive BYTEA,
+    size BIGINT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMPTZ,
+    expires_at TIMESTAMPTZ,
+
+    CONSTRAINT data_exports_archive_when_ready CHECK (archive IS NULL OR status = 'ready')
+);
+
+-- A user has at most one export waiting to be built
+CREATE UNIQUE INDEX data_exports_pending_user ON data_exports(user_id)
+    WHERE status = 'pending';
+
+-- A user's exports are read newest first
+CREATE INDEX idx_data_exports_user ON data_exports(user_id, created_at DESC);
+
+-- The job picks up the oldest pending exports and purges expired archives
+CREATE INDEX idx_data_exports_pending ON data_exports(created_at) WHERE status = 'pending';
+CREATE INDEX idx_data_exports_expiry ON data_exports(expires_at) WHERE status = 'ready';
+
+-- Add comments for documentation
+COMMENT ON TABLE data_exports IS 'Copies of a user''s personal data, built by the export job';
+COMMENT ON COLUMN data_exports.archive IS 'Zip archive of the data; NULL until ready and again once expired';
+COMMENT ON COLUMN data_exports.size IS 'Bytes in the archive, kept after it is deleted';
+COMMENT ON COLUMN data_exports.expires_at IS 'When the archive is deleted; set once it is ready';
+
+-- Users are told when their export is ready to download
+ALTER TABLE notifications DROP CONSTRAINT notifications_type_check;
+ALTER TABLE notifications ADD CONSTRAINT notifications_type_check
+    CHECK (type IN ('post_published', 'welcome', 'data_export_ready'));
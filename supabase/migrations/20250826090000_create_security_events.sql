@@ -0,0 +1,28 @@
+-- Create security_events table
+-- Each user's log of changes to what their account can do and who can use
+-- it: roles and permissions granted or revoked, API clients created or
+-- revoked, sessions revoked. Entries are written from domain events and
+-- never updated.
+CREATE TABLE security_events (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind VARCHAR(40) NOT NULL
+        CHECK (kind IN (
+            'role_granted', 'role_revoked',
+            'permission_granted', 'permission_revoked',
+            'api_client_created', 'api_client_revoked',
+            'sessions_revoked'
+        )),
+    actor_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    subject_id UUID,
+    description TEXT NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- A user's log is read newest first
+CREATE INDEX idx_security_events_user ON security_events(user_id, occurred_at DESC);
+
+-- Add comments for documentation
+COMMENT ON TABLE security_events IS 'Per-user log of security-relevant changes to an account';
+COMMENT ON COLUMN security_events.actor_id IS 'User who made the change; NULL when not known';
+COMMENT ON COLUMN security_events.subject_id IS 'Role, permission or API client changed; NULL for sessions';
@@ -0,0 +1,15 @@
+-- Add per-post visibility and embargo
+-- Existing posts stay public with no embargo
+ALTER TABLE posts
+    ADD COLUMN visibility VARCHAR(20) NOT NULL DEFAULT 'public'
+        CONSTRAINT check_post_visibility CHECK (visibility IN ('public', 'members_only', 'unlisted')),
+    ADD COLUMN embargo_until TIMESTAMPTZ;
+
+-- The read model copies both, and its rows already match the defaults
+ALTER TABLE published_posts
+    ADD COLUMN visibility VARCHAR(20) NOT NULL DEFAULT 'public',
+    ADD COLUMN embargo_until TIMESTAMPTZ;
+
+-- Add comments for documentation
+COMMENT ON COLUMN posts.visibility IS 'Who may find the post: public, members_only for signed-in users, or unlisted to leave it out of lists and feeds';
+COMMENT ON COLUMN posts.embargo_until IS 'Readers cannot see the published post before this time; NULL for no embargo';
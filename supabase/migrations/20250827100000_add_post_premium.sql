@@ -0,0 +1,11 @@
+-- Add the premium flag to posts
+-- Readers without an entitlement get a teaser of a premium post's content
+ALTER TABLE posts
+    ADD COLUMN premium BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- The read model copies it, and its rows already match the default
+ALTER TABLE published_posts
+    ADD COLUMN premium BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Add comments for documentation
+COMMENT ON COLUMN posts.premium IS 'Whether the full content is reserved for readers entitled to premium posts';
@@ -0,0 +1,30 @@
+-- Create announcements table
+-- Site-wide notices shown to every reader of a blog while they are in effect,
+-- such as maintenance windows or new features
+CREATE TABLE announcements (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    blog_id UUID NOT NULL REFERENCES blogs(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL DEFAULT 'info' CHECK (kind IN ('info', 'maintenance', 'feature')),
+    title VARCHAR(150) NOT NULL,
+    message TEXT NOT NULL CHECK (char_length(message) <= 1000),
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT check_announcement_window CHECK (ends_at > starts_at)
+);
+
+-- Active announcements are looked up per blog by the end of their window
+CREATE INDEX idx_announcements_blog_ends ON announcements(blog_id, ends_at);
+
+-- Create updated_at trigger
+CREATE TRIGGER update_announcements_updated_at BEFORE UPDATE ON announcements
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Add comments for documentation
+COMMENT ON TABLE announcements IS 'Time-bound notices administrators broadcast to the readers of a blog';
+COMMENT ON COLUMN announcements.kind IS 'info, maintenance or feature, for the frontend to style the notice';
+COMMENT ON COLUMN announcements.starts_at IS 'When the announcement starts being shown';
+COMMENT ON COLUMN announcements.ends_at IS 'When the announcement stops being shown';
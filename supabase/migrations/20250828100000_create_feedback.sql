@@ -0,0 +1,34 @@
+-- Create feedback table
+-- Messages visitors send through a blog's contact form, triaged by its administrators
+CREATE TABLE feedback (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    blog_id UUID NOT NULL REFERENCES blogs(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    email VARCHAR(254) NOT NULL,
+    subject VARCHAR(150) NOT NULL DEFAULT '',
+    message TEXT NOT NULL CHECK (char_length(message) <= 5000),
+    status VARCHAR(20) NOT NULL DEFAULT 'new' CHECK (status IN ('new', 'ack', 'closed')),
+    spam_reason TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- The triage queue lists a blog's messages by status, newest first, with spam kept apart
+CREATE INDEX idx_feedback_blog_status_created ON feedback(blog_id, status, created_at DESC)
+    WHERE spam_reason IS NULL;
+CREATE INDEX idx_feedback_blog_spam_created ON feedback(blog_id, created_at DESC)
+    WHERE spam_reason IS NOT NULL;
+
+-- Create updated_at trigger
+CREATE TRIGGER update_feedback_updated_at BEFORE UPDATE ON feedback
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Administrators are told about each message that is not spam
+ALTER TABLE notifications DROP CONSTRAINT notifications_type_check;
+ALTER TABLE notifications ADD CONSTRAINT notifications_type_check
+    CHECK (type IN ('post_published', 'welcome', 'data_export_ready', 'feedback_received'));
+
+-- Add comments for documentation
+COMMENT ON TABLE feedback IS 'Messages sent through the contact form of a blog';
+COMMENT ON COLUMN feedback.status IS 'new, ack (being dealt with) or closed';
+COMMENT ON COLUMN feedback.spam_reason IS 'Why the spam checker quarantined the message; NULL for messages that passed';
@@ -0,0 +1,41 @@
+-- Create post_views table
+-- Single views of published posts with where they came from, kept only until
+-- the rollup job folds them into post_traffic_daily. No reader is identified.
+CREATE TABLE post_views (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    blog_id UUID NOT NULL REFERENCES blogs(id) ON DELETE CASCADE,
+    post_id UUID NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    viewed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    channel VARCHAR(20) NOT NULL CHECK (channel IN ('direct', 'search', 'social', 'email', 'paid', 'referral')),
+    referrer_domain VARCHAR(253) NOT NULL DEFAULT '',
+    utm_source VARCHAR(64) NOT NULL DEFAULT '',
+    utm_medium VARCHAR(64) NOT NULL DEFAULT '',
+    utm_campaign VARCHAR(64) NOT NULL DEFAULT ''
+);
+
+-- The rollup takes views by the time they were recorded
+CREATE INDEX idx_post_views_viewed_at ON post_views(viewed_at);
+
+-- Create post_traffic_daily table
+-- Views per post, day and traffic source; empty strings stand for no referrer
+-- or UTM value so every source is a distinct key
+CREATE TABLE post_traffic_daily (
+    blog_id UUID NOT NULL REFERENCES blogs(id) ON DELETE CASCADE,
+    post_id UUID NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    channel VARCHAR(20) NOT NULL,
+    referrer_domain VARCHAR(253) NOT NULL,
+    utm_source VARCHAR(64) NOT NULL,
+    utm_medium VARCHAR(64) NOT NULL,
+    utm_campaign VARCHAR(64) NOT NULL,
+    views INTEGER NOT NULL CHECK (views > 0),
+
+    PRIMARY KEY (post_id, day, channel, referrer_domain, utm_source, utm_medium, utm_campaign)
+);
+
+-- Add comments for documentation
+COMMENT ON TABLE post_views IS 'Post views not yet rolled up, attributed to a traffic source';
+COMMENT ON COLUMN post_views.channel IS 'direct, search, social, email, paid or referral';
+COMMENT ON COLUMN post_views.referrer_domain IS 'Host of the referring page without www.; empty for none';
+COMMENT ON TABLE post_traffic_daily IS 'Daily view counts per post and traffic source, built by the rollup job';
+COMMENT ON COLUMN post_traffic_daily.day IS 'UTC day the views were recorded on';
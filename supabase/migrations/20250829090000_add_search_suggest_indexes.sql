@@ -0,0 +1,9 @@
+-- Index the labels search suggestions complete
+-- GET /search/suggest matches a typed prefix at the start of any word with
+-- ILIKE '% term%', which a btree index cannot serve; trigram indexes can.
+-- Theme names are already indexed for the themes listing's search.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX idx_posts_title_trgm ON posts USING gin (title gin_trgm_ops);
+CREATE INDEX idx_users_username_trgm ON users USING gin (username gin_trgm_ops);
+CREATE INDEX idx_users_display_name_trgm ON users USING gin (display_name gin_trgm_ops);
@@ -0,0 +1,41 @@
+-- Create webmentions table
+-- Pages elsewhere that told a blog they link to one of its posts. Each is
+-- verified by fetching its source, then held for moderators to approve.
+CREATE TABLE webmentions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    blog_id UUID NOT NULL REFERENCES blogs(id) ON DELETE CASCADE,
+    post_id UUID NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    source VARCHAR(2048) NOT NULL,
+    target VARCHAR(2048) NOT NULL,
+    verification VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (verification IN ('pending', 'verified', 'failed')),
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'approved', 'rejected')),
+    title VARCHAR(200) NOT NULL DEFAULT '',
+    failure TEXT NOT NULL DEFAULT '',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    received_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    verified_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    -- A source sent again is verified again rather than stored twice
+    UNIQUE (post_id, source)
+);
+
+-- Readers list a post's approved mentions and moderators the blog's queue, newest first
+CREATE INDEX idx_webmentions_post_status_received ON webmentions(post_id, status, received_at DESC)
+    WHERE verification = 'verified';
+CREATE INDEX idx_webmentions_blog_status_received ON webmentions(blog_id, status, received_at DESC)
+    WHERE verification = 'verified';
+-- The verification job picks up pending mentions, oldest first
+CREATE INDEX idx_webmentions_unverified ON webmentions(blog_id, received_at)
+    WHERE verification = 'pending';
+
+-- Create updated_at trigger
+CREATE TRIGGER update_webmentions_updated_at BEFORE UPDATE ON webmentions
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Add comments for documentation
+COMMENT ON TABLE webmentions IS 'Webmentions of the posts of a blog';
+COMMENT ON COLUMN webmentions.verification IS 'pending until the source is fetched, then verified if it links to the target or failed';
+COMMENT ON COLUMN webmentions.status IS 'Moderation: pending, approved (shown under the post) or rejected';
+COMMENT ON COLUMN webmentions.attempts IS 'Fetches of the source that failed for a temporary reason';
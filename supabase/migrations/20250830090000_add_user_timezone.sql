@@ -0,0 +1,8 @@
+-- Add the time zone preference to users
+-- Times are stored in UTC; the zone decides which calendar day a time falls on
+-- for the user and how responses show it to them.
+ALTER TABLE users
+    ADD COLUMN timezone VARCHAR(64) NOT NULL DEFAULT 'UTC';
+
+-- Add comments for documentation
+COMMENT ON COLUMN users.timezone IS 'IANA time zone name, such as Europe/Paris, the user reads and writes times in';
@@ -0,0 +1,16 @@
+-- Add soft deletion to themes
+-- Deleting a theme moves it to the trash, from which its curator can restore
+-- it with its articles and collaborators. Themes in the trash are left out of
+-- every listing and lookup.
+ALTER TABLE themes
+    ADD COLUMN deleted_at TIMESTAMPTZ;
+
+-- A theme in the trash gives up its slug, so only live themes must have unique ones
+ALTER TABLE themes DROP CONSTRAINT themes_blog_slug_key;
+CREATE UNIQUE INDEX themes_blog_slug_key ON themes(blog_id, slug) WHERE deleted_at IS NULL;
+
+-- The trash lists a blog's deleted themes, most recently deleted first
+CREATE INDEX idx_themes_blog_deleted ON themes(blog_id, deleted_at DESC) WHERE deleted_at IS NOT NULL;
+
+-- Add comments for documentation
+COMMENT ON COLUMN themes.deleted_at IS 'When the theme was moved to the trash; NULL for live themes';
@@ -0,0 +1,6 @@
+-- Impersonated requests are written to the audit trail before they run, so a
+-- request that cannot be audited is refused instead of going unrecorded. The
+-- status is filled in once the response is sent.
+ALTER TABLE impersonation_actions ALTER COLUMN status DROP NOT NULL;
+
+COMMENT ON COLUMN impersonation_actions.status IS 'Response status sent, NULL while the request runs or when it never completed';
@@ -0,0 +1,24 @@
+-- Create retention_purges table
+-- Every post a retention policy deletes is recorded in the same transaction,
+-- so a purge cannot happen without its audit entry
+CREATE TABLE retention_purges (
+    id BIGSERIAL PRIMARY KEY,
+    blog_id UUID NOT NULL REFERENCES blogs(id) ON DELETE CASCADE,
+    post_id UUID NOT NULL,
+    author_id UUID NOT NULL,
+    title TEXT NOT NULL,
+    policy VARCHAR(32) NOT NULL,
+    post_updated_at TIMESTAMPTZ NOT NULL,
+    actor_id UUID,
+    purged_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Audit trails are read per blog, newest first
+CREATE INDEX idx_retention_purges_blog_purged_at ON retention_purges(blog_id, purged_at DESC);
+
+-- Add comments for documentation
+COMMENT ON TABLE retention_purges IS 'Posts deleted by retention policies, kept as an audit trail';
+COMMENT ON COLUMN retention_purges.post_id IS 'The deleted post; not a foreign key, as the post is gone';
+COMMENT ON COLUMN retention_purges.policy IS 'The policy that selected the post, such as stale_drafts';
+COMMENT ON COLUMN retention_purges.post_updated_at IS 'When the post was last changed before it was purged';
+COMMENT ON COLUMN retention_purges.actor_id IS 'Admin who ran the purge, NULL for the scheduled job';
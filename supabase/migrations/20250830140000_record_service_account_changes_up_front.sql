@@ -0,0 +1,6 @@
+-- Requests that may change state are written to a service account's audit
+-- trail before they run, so one that cannot be audited is refused instead of
+-- going unrecorded. The status is filled in once the response is sent.
+ALTER TABLE service_account_actions ALTER COLUMN status DROP NOT NULL;
+
+COMMENT ON COLUMN service_account_actions.status IS 'Response status sent, NULL while the request runs or when it never completed';